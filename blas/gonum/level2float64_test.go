@@ -7,6 +7,7 @@ package gonum
 import (
 	"testing"
 
+	"gonum.org/v1/gonum/blas"
 	"gonum.org/v1/gonum/blas/testblas"
 )
 
@@ -14,10 +15,79 @@ func TestDgemv(t *testing.T) {
 	testblas.DgemvTest(t, impl)
 }
 
+func TestDgemvTransZeroBeta(t *testing.T) {
+	// Elements of y beyond its n elements must not be modified when
+	// beta is zero.
+	const m, n = 3, 2
+	a := []float64{
+		1, 2,
+		3, 4,
+		5, 6,
+	}
+	x := []float64{1, 1, 1}
+	y := []float64{-1, -1, 7, 8}
+	impl.Dgemv(blas.Trans, m, n, 1, a, n, x, 1, 0, y, 1)
+	want := []float64{9, 12, 7, 8}
+	for i := range y {
+		if y[i] != want[i] {
+			t.Errorf("unexpected y[%d]: got %v, want %v", i, y[i], want[i])
+		}
+	}
+}
+
 func TestDger(t *testing.T) {
 	testblas.DgerTest(t, impl)
 }
 
+func TestDgerNegativeIncrement(t *testing.T) {
+	// Check that negative increments traverse x and y in reverse
+	// for sizes that exercise all of the kernel's blocking.
+	for _, m := range []int{1, 2, 3, 4, 5, 7} {
+		for _, n := range []int{1, 2, 3, 4, 5, 7} {
+			for _, inc := range []struct{ x, y int }{{-1, 1}, {1, -1}, {-2, -3}, {2, -1}} {
+				incX, incY := inc.x, inc.y
+				x := make([]float64, 1+(m-1)*max(incX, -incX))
+				for i := range x {
+					x[i] = float64(i + 1)
+				}
+				y := make([]float64, 1+(n-1)*max(incY, -incY))
+				for i := range y {
+					y[i] = float64(-2 * i)
+				}
+				const lda = 9
+				a := make([]float64, (m-1)*lda+n)
+				for i := range a {
+					a[i] = float64(i) / 10
+				}
+				want := make([]float64, len(a))
+				copy(want, a)
+				var kx, ky int
+				if incX < 0 {
+					kx = (1 - m) * incX
+				}
+				if incY < 0 {
+					ky = (1 - n) * incY
+				}
+				const alpha = 0.5
+				for i := 0; i < m; i++ {
+					for j := 0; j < n; j++ {
+						want[i*lda+j] += alpha * x[kx+i*incX] * y[ky+j*incY]
+					}
+				}
+
+				impl.Dger(m, n, alpha, x, incX, y, incY, a, lda)
+				for i := range a {
+					if a[i] != want[i] {
+						t.Errorf("m=%d n=%d incX=%d incY=%d: unexpected a[%d]: got %v, want %v",
+							m, n, incX, incY, i, a[i], want[i])
+						break
+					}
+				}
+			}
+		}
+	}
+}
+
 func TestDtxmv(t *testing.T) {
 	testblas.DtxmvTest(t, impl)
 }
@@ -5,8 +5,10 @@
 package gonum
 
 import (
+	"math"
 	"testing"
 
+	"gonum.org/v1/gonum/blas"
 	"gonum.org/v1/gonum/blas/testblas"
 )
 
@@ -14,6 +16,47 @@ func TestDgemm(t *testing.T) {
 	testblas.TestDgemm(t, impl)
 }
 
+func TestDgemmZeroAlpha(t *testing.T) {
+	// A and B must not be referenced when alpha is zero, so non-finite
+	// values in them must not reach C.
+	const m, n, k = 3, 4, 5
+	a := make([]float64, m*k)
+	b := make([]float64, k*n)
+	for i := range a {
+		a[i] = math.Inf(1)
+	}
+	for i := range b {
+		b[i] = math.NaN()
+	}
+	for _, beta := range []float64{0, 1, 2} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, tB := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				lda, ldb := k, n
+				if tA == blas.Trans {
+					lda = m
+				}
+				if tB == blas.Trans {
+					ldb = k
+				}
+				c := make([]float64, m*n)
+				want := make([]float64, m*n)
+				for i := range c {
+					c[i] = float64(i)
+					want[i] = beta * c[i]
+				}
+				impl.Dgemm(tA, tB, m, n, k, 0, a, lda, b, ldb, beta, c, n)
+				for i := range c {
+					if c[i] != want[i] {
+						t.Errorf("tA=%v tB=%v beta=%v: unexpected c[%d]: got %v, want %v",
+							tA, tB, beta, i, c[i], want[i])
+						break
+					}
+				}
+			}
+		}
+	}
+}
+
 func TestDsymm(t *testing.T) {
 	testblas.DsymmTest(t, impl)
 }
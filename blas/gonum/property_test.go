@@ -0,0 +1,43 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/blas/testblas"
+)
+
+func TestDdotProperty(t *testing.T) {
+	testblas.DdotPropertyTest(t, impl)
+}
+
+func TestDaxpyProperty(t *testing.T) {
+	testblas.DaxpyPropertyTest(t, impl)
+}
+
+func TestDscalProperty(t *testing.T) {
+	testblas.DscalPropertyTest(t, impl)
+}
+
+func TestDnrm2Property(t *testing.T) {
+	testblas.Dnrm2PropertyTest(t, impl)
+}
+
+func TestDasumProperty(t *testing.T) {
+	testblas.DasumPropertyTest(t, impl)
+}
+
+func TestDgemvProperty(t *testing.T) {
+	testblas.DgemvPropertyTest(t, impl)
+}
+
+func TestDgerProperty(t *testing.T) {
+	testblas.DgerPropertyTest(t, impl)
+}
+
+func TestDgemmProperty(t *testing.T) {
+	testblas.DgemmPropertyTest(t, impl)
+}
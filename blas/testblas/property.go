@@ -0,0 +1,603 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testblas
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// The property tests in this file check implementations on randomly
+// generated problems with random sizes, strides, leading dimensions and
+// scalars. Results are compared with a reference computed in compensated
+// arithmetic using the standard componentwise rounding error bounds for
+// inner products,
+//
+//	|fl(Σ x_i*y_i) - Σ x_i*y_i| ≤ γ_n * Σ |x_i*y_i|,  γ_n = n*u/(1-n*u),
+//
+// where u is the unit roundoff, as given in
+//
+//	Higham, N. J. "Accuracy and Stability of Numerical Algorithms",
+//	2nd ed., SIAM, 2002, §3.1.
+//
+// The bounds hold for any summation order, blocking or use of fused
+// multiply-add, so they validate alternative backends and assembly kernels
+// without tuning tolerances for each one, while catching any error larger
+// than rounding.
+//
+// In addition, the tests check that elements outside the referenced parts of
+// the output are not modified, that inputs are not modified, and that outputs
+// are not read when beta is zero and inputs are not read when alpha is zero,
+// as required by the reference BLAS.
+
+const (
+	// propertyTrials is the number of random problems tested for each
+	// routine.
+	propertyTrials = 300
+
+	// unitRoundoff is the unit roundoff u for float64.
+	unitRoundoff = 0x1p-53
+)
+
+// gamma returns γ_n = n*u/(1-n*u), the bound on the relative error
+// accumulated by n floating-point operations.
+func gamma(n int) float64 {
+	nu := float64(n) * unitRoundoff
+	return nu / (1 - nu)
+}
+
+// withinBound returns whether got agrees with the accurately computed want
+// to within the error of an n-operation computation whose terms have the
+// absolute sum abs. The term u*|want| accounts for the rounding of want.
+func withinBound(got, want float64, n int, abs float64) bool {
+	if math.IsNaN(got) || math.IsInf(got, 0) {
+		return false
+	}
+	return math.Abs(got-want) <= gamma(n)*abs+unitRoundoff*math.Abs(want)
+}
+
+// accumulator sums products in compensated arithmetic so that the result is
+// as accurate as if computed in twice the working precision. It also sums
+// the absolute values of the products for use in error bounds.
+//
+// See Ogita, T., Rump, S. M., Oishi, S. "Accurate sum and dot product."
+// SIAM Journal on Scientific Computing 26.6 (2005).
+type accumulator struct {
+	sum, comp, abs float64
+}
+
+// add adds x*y to the accumulator.
+func (a *accumulator) add(x, y float64) {
+	p := x * y
+	pe := math.FMA(x, y, -p)
+	s := a.sum + p
+	z := s - a.sum
+	se := (a.sum - (s - z)) + (p - z)
+	a.sum = s
+	a.comp += pe + se
+	a.abs += math.Abs(p)
+}
+
+// value returns the accumulated sum.
+func (a *accumulator) value() float64 {
+	return a.sum + a.comp
+}
+
+// scaled returns alpha*a + beta*c computed in compensated arithmetic and the
+// absolute sum of its terms.
+func (a *accumulator) scaled(alpha, beta, c float64) (v, abs float64) {
+	var r accumulator
+	r.add(alpha, a.sum)
+	r.add(alpha, a.comp)
+	if beta != 0 {
+		r.add(beta, c)
+	}
+	return r.value(), math.Abs(alpha)*a.abs + math.Abs(beta*c)
+}
+
+// propertySize returns a random problem size. Small sizes are favored but
+// larger sizes occur so that unrolled and blocked code paths are exercised.
+func propertySize(rnd *rand.Rand, large int) int {
+	switch r := rnd.Float64(); {
+	case r < 0.1:
+		return 0
+	case r < 0.2:
+		return 1
+	case r < 0.85:
+		return 2 + rnd.Intn(16)
+	default:
+		return 18 + rnd.Intn(large)
+	}
+}
+
+// propertyInc returns a random non-zero increment. Negative increments are
+// only returned if neg is true.
+func propertyInc(rnd *rand.Rand, neg bool) int {
+	inc := 1 + rnd.Intn(3)
+	if rnd.Float64() < 0.5 {
+		inc = 1
+	}
+	if neg && rnd.Float64() < 0.3 {
+		inc = -inc
+	}
+	return inc
+}
+
+// propertyScalar returns a random scalar with the special values 0, 1 and -1
+// occurring with high probability.
+func propertyScalar(rnd *rand.Rand) float64 {
+	switch rnd.Intn(6) {
+	case 0:
+		return 0
+	case 1:
+		return 1
+	case 2:
+		return -1
+	default:
+		return rnd.NormFloat64()
+	}
+}
+
+// propertyValue returns a random value whose magnitude varies over several
+// orders so that cancellation occurs.
+func propertyValue(rnd *rand.Rand) float64 {
+	return math.Ldexp(rnd.NormFloat64(), rnd.Intn(21)-10)
+}
+
+// propertyFill returns a slice of length l filled with random values.
+func propertyFill(rnd *rand.Rand, l int) []float64 {
+	s := make([]float64, l)
+	for i := range s {
+		s[i] = propertyValue(rnd)
+	}
+	return s
+}
+
+// propertyVector returns a random strided vector of n elements with
+// increment inc, with random padding after the last element.
+func propertyVector(rnd *rand.Rand, n, inc int) []float64 {
+	l := rnd.Intn(3)
+	if n > 0 {
+		l += 1 + (n-1)*abs(inc)
+	}
+	return propertyFill(rnd, l)
+}
+
+// propertyMatrix returns a random r×c row-major matrix with a leading
+// dimension of at least max(1, c).
+func propertyMatrix(rnd *rand.Rand, r, c int) ([]float64, int) {
+	ld := max(1, c) + rnd.Intn(3)
+	l := 0
+	if r > 0 {
+		l = (r-1)*ld + c
+	}
+	return propertyFill(rnd, l), ld
+}
+
+// vecIndex returns the position of the i-th element of a strided vector of
+// n elements with increment inc.
+func vecIndex(i, n, inc int) int {
+	if inc < 0 {
+		return (n - 1 - i) * -inc
+	}
+	return i * inc
+}
+
+// fillStrided sets the n elements of the strided vector x to v.
+func fillStrided(x []float64, n, inc int, v float64) {
+	for i := 0; i < n; i++ {
+		x[vecIndex(i, n, inc)] = v
+	}
+}
+
+// fillMatrix sets the elements of the r×c matrix a to v.
+func fillMatrix(a []float64, r, c, ld int, v float64) {
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			a[i*ld+j] = v
+		}
+	}
+}
+
+// checkUnmodified reports an error if an element of got that is not
+// referenced, as reported by referenced, differs from the corresponding
+// element of orig. Elements are compared bitwise.
+func checkUnmodified(t *testing.T, prefix, name string, got, orig []float64, referenced func(k int) bool) {
+	t.Helper()
+	for k := range got {
+		if referenced != nil && referenced(k) {
+			continue
+		}
+		if math.Float64bits(got[k]) != math.Float64bits(orig[k]) {
+			t.Errorf("%s: unexpected modification of %s[%d]", prefix, name, k)
+			return
+		}
+	}
+}
+
+// stridedReferenced returns a function reporting whether a position in a
+// strided vector is one of its n elements.
+func stridedReferenced(n, inc int) func(int) bool {
+	return func(k int) bool {
+		if n == 0 {
+			return false
+		}
+		inc := abs(inc)
+		return k%inc == 0 && k/inc < n
+	}
+}
+
+// matrixReferenced returns a function reporting whether a position in a
+// row-major matrix is one of its r×c elements.
+func matrixReferenced(r, c, ld int) func(int) bool {
+	return func(k int) bool {
+		return k/ld < r && k%ld < c
+	}
+}
+
+// DdotPropertyTest tests Ddot on random problems.
+func DdotPropertyTest(t *testing.T, impl Ddotter) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < propertyTrials; trial++ {
+		n := propertySize(rnd, 300)
+		incX := propertyInc(rnd, true)
+		incY := propertyInc(rnd, true)
+		x := propertyVector(rnd, n, incX)
+		y := propertyVector(rnd, n, incY)
+		xCopy := sliceCopy(x)
+		yCopy := sliceCopy(y)
+
+		var acc accumulator
+		for i := 0; i < n; i++ {
+			acc.add(x[vecIndex(i, n, incX)], y[vecIndex(i, n, incY)])
+		}
+
+		got := impl.Ddot(n, x, incX, y, incY)
+
+		prefix := fmt.Sprintf("trial %d: n=%d,incX=%d,incY=%d", trial, n, incX, incY)
+		checkUnmodified(t, prefix, "x", x, xCopy, nil)
+		checkUnmodified(t, prefix, "y", y, yCopy, nil)
+		if !withinBound(got, acc.value(), n, acc.abs) {
+			t.Errorf("%s: result outside error bound: got %v, want %v", prefix, got, acc.value())
+		}
+	}
+}
+
+// DaxpyPropertyTest tests Daxpy on random problems.
+func DaxpyPropertyTest(t *testing.T, impl Daxpyer) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < propertyTrials; trial++ {
+		n := propertySize(rnd, 300)
+		incX := propertyInc(rnd, true)
+		incY := propertyInc(rnd, true)
+		alpha := propertyScalar(rnd)
+		x := propertyVector(rnd, n, incX)
+		y := propertyVector(rnd, n, incY)
+		if alpha == 0 {
+			// x must not be referenced.
+			fillStrided(x, n, incX, math.NaN())
+		}
+		xCopy := sliceCopy(x)
+		yCopy := sliceCopy(y)
+
+		impl.Daxpy(n, alpha, x, incX, y, incY)
+
+		prefix := fmt.Sprintf("trial %d: n=%d,alpha=%v,incX=%d,incY=%d", trial, n, alpha, incX, incY)
+		checkUnmodified(t, prefix, "x", x, xCopy, nil)
+		checkUnmodified(t, prefix, "y", y, yCopy, stridedReferenced(n, incY))
+		for i := 0; i < n; i++ {
+			iy := vecIndex(i, n, incY)
+			if alpha == 0 {
+				if math.Float64bits(y[iy]) != math.Float64bits(yCopy[iy]) {
+					t.Errorf("%s: unexpected modification of y with zero alpha", prefix)
+					break
+				}
+				continue
+			}
+			var acc accumulator
+			acc.add(alpha, xCopy[vecIndex(i, n, incX)])
+			acc.add(1, yCopy[iy])
+			if !withinBound(y[iy], acc.value(), 2, acc.abs) {
+				t.Errorf("%s: element %d outside error bound: got %v, want %v", prefix, i, y[iy], acc.value())
+				break
+			}
+		}
+	}
+}
+
+// DscalPropertyTest tests Dscal on random problems.
+func DscalPropertyTest(t *testing.T, impl Dscaler) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < propertyTrials; trial++ {
+		n := propertySize(rnd, 300)
+		incX := propertyInc(rnd, false)
+		alpha := propertyScalar(rnd)
+		x := propertyVector(rnd, n, incX)
+		xCopy := sliceCopy(x)
+
+		impl.Dscal(n, alpha, x, incX)
+
+		prefix := fmt.Sprintf("trial %d: n=%d,alpha=%v,incX=%d", trial, n, alpha, incX)
+		checkUnmodified(t, prefix, "x", x, xCopy, stridedReferenced(n, incX))
+		for i := 0; i < n; i++ {
+			// A single multiplication is correctly rounded.
+			ix := i * incX
+			if x[ix] != alpha*xCopy[ix] {
+				t.Errorf("%s: unexpected element %d: got %v, want %v", prefix, i, x[ix], alpha*xCopy[ix])
+				break
+			}
+		}
+
+		// Non-positive increments are a no-op.
+		if n > 0 {
+			x = sliceCopy(xCopy)
+			impl.Dscal(n, alpha, x, -incX)
+			checkUnmodified(t, prefix, "x", x, xCopy, nil)
+		}
+	}
+}
+
+// Dnrm2PropertyTest tests Dnrm2 on random problems, including vectors whose
+// elements would overflow or underflow if squared.
+func Dnrm2PropertyTest(t *testing.T, impl Dnrm2er) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < propertyTrials; trial++ {
+		n := propertySize(rnd, 300)
+		incX := propertyInc(rnd, false)
+		x := propertyVector(rnd, n, incX)
+		scale := []float64{0x1p-600, 1, 0x1p600}[rnd.Intn(3)]
+		var acc accumulator
+		for i := 0; i < n; i++ {
+			v := x[i*incX]
+			acc.add(v, v)
+			x[i*incX] = v * scale
+		}
+		want := math.Sqrt(acc.value()) * scale
+		xCopy := sliceCopy(x)
+
+		got := impl.Dnrm2(n, x, incX)
+
+		prefix := fmt.Sprintf("trial %d: n=%d,incX=%d,scale=%v", trial, n, incX, scale)
+		checkUnmodified(t, prefix, "x", x, xCopy, nil)
+		// The sum of squares has relative error at most γ_(n+1),
+		// which the square root halves. Scaling introduces at most
+		// a further two roundings.
+		if !withinBound(got, want, n+4, want) {
+			t.Errorf("%s: result outside error bound: got %v, want %v", prefix, got, want)
+		}
+		if n > 0 {
+			if got := impl.Dnrm2(n, x, -incX); got != 0 {
+				t.Errorf("%s: unexpected result for negative increment: got %v, want 0", prefix, got)
+			}
+		}
+	}
+}
+
+// DasumPropertyTest tests Dasum on random problems.
+func DasumPropertyTest(t *testing.T, impl Dasumer) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < propertyTrials; trial++ {
+		n := propertySize(rnd, 300)
+		incX := propertyInc(rnd, false)
+		x := propertyVector(rnd, n, incX)
+		xCopy := sliceCopy(x)
+
+		var acc accumulator
+		for i := 0; i < n; i++ {
+			acc.add(1, math.Abs(x[i*incX]))
+		}
+
+		got := impl.Dasum(n, x, incX)
+
+		prefix := fmt.Sprintf("trial %d: n=%d,incX=%d", trial, n, incX)
+		checkUnmodified(t, prefix, "x", x, xCopy, nil)
+		if !withinBound(got, acc.value(), n, acc.abs) {
+			t.Errorf("%s: result outside error bound: got %v, want %v", prefix, got, acc.value())
+		}
+		if n > 0 {
+			if got := impl.Dasum(n, x, -incX); got != 0 {
+				t.Errorf("%s: unexpected result for negative increment: got %v, want 0", prefix, got)
+			}
+		}
+	}
+}
+
+// DgemvPropertyTest tests Dgemv on random problems.
+func DgemvPropertyTest(t *testing.T, impl Dgemver) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < propertyTrials; trial++ {
+		tA := []blas.Transpose{blas.NoTrans, blas.Trans}[rnd.Intn(2)]
+		m := propertySize(rnd, 80)
+		n := propertySize(rnd, 80)
+		lenX, lenY := n, m
+		if tA != blas.NoTrans {
+			lenX, lenY = m, n
+		}
+		incX := propertyInc(rnd, true)
+		incY := propertyInc(rnd, true)
+		alpha := propertyScalar(rnd)
+		beta := propertyScalar(rnd)
+		a, lda := propertyMatrix(rnd, m, n)
+		x := propertyVector(rnd, lenX, incX)
+		y := propertyVector(rnd, lenY, incY)
+		if alpha == 0 {
+			// A and x must not be referenced.
+			fillMatrix(a, m, n, lda, math.NaN())
+			fillStrided(x, lenX, incX, math.NaN())
+		}
+		if beta == 0 {
+			// y must not be read.
+			fillStrided(y, lenY, incY, math.NaN())
+		}
+		aCopy := sliceCopy(a)
+		xCopy := sliceCopy(x)
+		yCopy := sliceCopy(y)
+
+		impl.Dgemv(tA, m, n, alpha, a, lda, x, incX, beta, y, incY)
+
+		prefix := fmt.Sprintf("trial %d: tA=%v,m=%d,n=%d,lda=%d,incX=%d,incY=%d,alpha=%v,beta=%v",
+			trial, transString(tA), m, n, lda, incX, incY, alpha, beta)
+		checkUnmodified(t, prefix, "A", a, aCopy, nil)
+		checkUnmodified(t, prefix, "x", x, xCopy, nil)
+		if m == 0 || n == 0 {
+			// y is not updated for an empty A.
+			checkUnmodified(t, prefix, "y", y, yCopy, nil)
+			continue
+		}
+		checkUnmodified(t, prefix, "y", y, yCopy, stridedReferenced(lenY, incY))
+		for i := 0; i < lenY; i++ {
+			iy := vecIndex(i, lenY, incY)
+			var acc accumulator
+			if alpha != 0 {
+				for j := 0; j < lenX; j++ {
+					var aij float64
+					if tA == blas.NoTrans {
+						aij = aCopy[i*lda+j]
+					} else {
+						aij = aCopy[j*lda+i]
+					}
+					acc.add(aij, xCopy[vecIndex(j, lenX, incX)])
+				}
+			}
+			yi := yCopy[iy]
+			if beta == 0 {
+				yi = 0
+			}
+			want, abs := acc.scaled(alpha, beta, yi)
+			if !withinBound(y[iy], want, lenX+3, abs) {
+				t.Errorf("%s: element %d outside error bound: got %v, want %v", prefix, i, y[iy], want)
+				break
+			}
+		}
+	}
+}
+
+// DgerPropertyTest tests Dger on random problems.
+func DgerPropertyTest(t *testing.T, impl Dgerer) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < propertyTrials; trial++ {
+		m := propertySize(rnd, 80)
+		n := propertySize(rnd, 80)
+		incX := propertyInc(rnd, true)
+		incY := propertyInc(rnd, true)
+		alpha := propertyScalar(rnd)
+		x := propertyVector(rnd, m, incX)
+		y := propertyVector(rnd, n, incY)
+		a, lda := propertyMatrix(rnd, m, n)
+		if alpha == 0 {
+			// x and y must not be referenced.
+			fillStrided(x, m, incX, math.NaN())
+			fillStrided(y, n, incY, math.NaN())
+		}
+		xCopy := sliceCopy(x)
+		yCopy := sliceCopy(y)
+		aCopy := sliceCopy(a)
+
+		impl.Dger(m, n, alpha, x, incX, y, incY, a, lda)
+
+		prefix := fmt.Sprintf("trial %d: m=%d,n=%d,lda=%d,incX=%d,incY=%d,alpha=%v", trial, m, n, lda, incX, incY, alpha)
+		checkUnmodified(t, prefix, "x", x, xCopy, nil)
+		checkUnmodified(t, prefix, "y", y, yCopy, nil)
+		if alpha == 0 {
+			checkUnmodified(t, prefix, "A", a, aCopy, nil)
+			continue
+		}
+		checkUnmodified(t, prefix, "A", a, aCopy, matrixReferenced(m, n, lda))
+	loop:
+		for i := 0; i < m; i++ {
+			xi := xCopy[vecIndex(i, m, incX)]
+			for j := 0; j < n; j++ {
+				var acc accumulator
+				acc.add(alpha*xi, yCopy[vecIndex(j, n, incY)])
+				acc.add(1, aCopy[i*lda+j])
+				if !withinBound(a[i*lda+j], acc.value(), 3, acc.abs) {
+					t.Errorf("%s: element (%d,%d) outside error bound: got %v, want %v", prefix, i, j, a[i*lda+j], acc.value())
+					break loop
+				}
+			}
+		}
+	}
+}
+
+// DgemmPropertyTest tests Dgemm on random problems.
+func DgemmPropertyTest(t *testing.T, impl Dgemmer) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < propertyTrials; trial++ {
+		tA := []blas.Transpose{blas.NoTrans, blas.Trans}[rnd.Intn(2)]
+		tB := []blas.Transpose{blas.NoTrans, blas.Trans}[rnd.Intn(2)]
+		m := propertySize(rnd, 80)
+		n := propertySize(rnd, 80)
+		k := propertySize(rnd, 80)
+		rowA, colA := m, k
+		if tA != blas.NoTrans {
+			rowA, colA = k, m
+		}
+		rowB, colB := k, n
+		if tB != blas.NoTrans {
+			rowB, colB = n, k
+		}
+		alpha := propertyScalar(rnd)
+		beta := propertyScalar(rnd)
+		a, lda := propertyMatrix(rnd, rowA, colA)
+		b, ldb := propertyMatrix(rnd, rowB, colB)
+		c, ldc := propertyMatrix(rnd, m, n)
+		if alpha == 0 {
+			// A and B must not be referenced.
+			fillMatrix(a, rowA, colA, lda, math.NaN())
+			fillMatrix(b, rowB, colB, ldb, math.NaN())
+		}
+		if beta == 0 {
+			// C must not be read.
+			fillMatrix(c, m, n, ldc, math.NaN())
+		}
+		aCopy := sliceCopy(a)
+		bCopy := sliceCopy(b)
+		cCopy := sliceCopy(c)
+
+		impl.Dgemm(tA, tB, m, n, k, alpha, a, lda, b, ldb, beta, c, ldc)
+
+		prefix := fmt.Sprintf("trial %d: tA=%v,tB=%v,m=%d,n=%d,k=%d,lda=%d,ldb=%d,ldc=%d,alpha=%v,beta=%v",
+			trial, transString(tA), transString(tB), m, n, k, lda, ldb, ldc, alpha, beta)
+		checkUnmodified(t, prefix, "A", a, aCopy, nil)
+		checkUnmodified(t, prefix, "B", b, bCopy, nil)
+		checkUnmodified(t, prefix, "C", c, cCopy, matrixReferenced(m, n, ldc))
+	loop:
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				var acc accumulator
+				if alpha != 0 {
+					for l := 0; l < k; l++ {
+						var ail, blj float64
+						if tA == blas.NoTrans {
+							ail = aCopy[i*lda+l]
+						} else {
+							ail = aCopy[l*lda+i]
+						}
+						if tB == blas.NoTrans {
+							blj = bCopy[l*ldb+j]
+						} else {
+							blj = bCopy[j*ldb+l]
+						}
+						acc.add(ail, blj)
+					}
+				}
+				cij := cCopy[i*ldc+j]
+				if beta == 0 {
+					cij = 0
+				}
+				want, abs := acc.scaled(alpha, beta, cij)
+				if !withinBound(c[i*ldc+j], want, k+3, abs) {
+					t.Errorf("%s: element (%d,%d) outside error bound: got %v, want %v", prefix, i, j, c[i*ldc+j], want)
+					break loop
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import "gonum.org/v1/gonum/mat"
+
+// ComplexStep is the default step size of the complex-step approximation.
+// Since the approximation does not subtract function values, the step can
+// be chosen small enough that the truncation error is far below the
+// rounding error of the function evaluation.
+const ComplexStep = 1e-20
+
+// ComplexStepDerivative estimates the first derivative of the real function
+// f at x using the complex-step approximation
+//
+//	f'(x) ≈ Im(f(x + i*h)) / h.
+//
+// The function f must be the analytic extension of a real function to the
+// complex plane, that is, it must be real for real arguments and evaluated
+// with complex arithmetic throughout. Since no function values are
+// subtracted the approximation does not suffer from cancellation, and with
+// a sufficiently small step the estimate is accurate to working precision.
+// Functions that use abs, comparisons on the real part or other non-analytic
+// operations may need to be adapted so that they remain analytic.
+//
+// If step is zero, ComplexStep is used. ComplexStepDerivative panics if step
+// is negative.
+//
+// See J. R. R. A. Martins, P. Sturdza and J. J. Alonso, "The complex-step
+// derivative approximation", ACM Trans. Math. Softw. 29(3), 2003, for details.
+func ComplexStepDerivative(f func(complex128) complex128, x, step float64) float64 {
+	step = complexStep(step)
+	return imag(f(complex(x, step))) / step
+}
+
+// ComplexStepGradient estimates the gradient of the real multivariate
+// function f at x using the complex-step approximation. If dst is not nil,
+// the result will be stored in-place into dst and returned, otherwise a new
+// slice will be allocated first. See ComplexStepDerivative for the
+// requirements on f and the meaning of step.
+//
+// ComplexStepGradient panics if the length of dst and x is not equal or if
+// step is negative.
+func ComplexStepGradient(dst []float64, f func([]complex128) complex128, x []float64, step float64) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(x))
+	}
+	if len(dst) != len(x) {
+		panic("fd: slice length mismatch")
+	}
+	step = complexStep(step)
+
+	xc := make([]complex128, len(x))
+	for i, v := range x {
+		xc[i] = complex(v, 0)
+	}
+	for i, v := range x {
+		xc[i] = complex(v, step)
+		dst[i] = imag(f(xc)) / step
+		xc[i] = complex(v, 0)
+	}
+	return dst
+}
+
+// ComplexStepJacobian estimates the Jacobian matrix of the real
+// vector-valued function f at x using the complex-step approximation and
+// stores the result in-place into dst. See ComplexStepDerivative for the
+// requirements on f and the meaning of step, and Jacobian for the layout of
+// the Jacobian matrix.
+//
+// dst must be non-nil and the number of its columns must equal the length of
+// x, otherwise ComplexStepJacobian will panic. ComplexStepJacobian also
+// panics if step is negative.
+func ComplexStepJacobian(dst *mat.Dense, f func(y, x []complex128), x []float64, step float64) {
+	n := len(x)
+	if n == 0 {
+		panic("jacobian: x has zero length")
+	}
+	m, c := dst.Dims()
+	if c != n {
+		panic("jacobian: mismatched matrix size")
+	}
+	step = complexStep(step)
+
+	xc := make([]complex128, n)
+	for i, v := range x {
+		xc[i] = complex(v, 0)
+	}
+	y := make([]complex128, m)
+	for j, v := range x {
+		xc[j] = complex(v, step)
+		f(y, xc)
+		for i, yi := range y {
+			dst.Set(i, j, imag(yi)/step)
+		}
+		xc[j] = complex(v, 0)
+	}
+}
+
+// complexStep returns the step to use for the complex-step approximation.
+func complexStep(step float64) float64 {
+	switch {
+	case step < 0:
+		panic(negativeStep)
+	case step == 0:
+		return ComplexStep
+	}
+	return step
+}
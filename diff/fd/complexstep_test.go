@@ -0,0 +1,138 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestComplexStepDerivative(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		f    func(complex128) complex128
+		df   func(float64) float64
+	}{
+		{
+			name: "exp sin",
+			f:    func(z complex128) complex128 { return cmplx.Exp(z) * cmplx.Sin(z) },
+			df:   func(x float64) float64 { return math.Exp(x) * (math.Sin(x) + math.Cos(x)) },
+		},
+		{
+			// Squire and Trapp's test function.
+			name: "squire trapp",
+			f: func(z complex128) complex128 {
+				s, c := cmplx.Sin(z), cmplx.Cos(z)
+				return cmplx.Exp(z) / cmplx.Sqrt(s*s*s+c*c*c)
+			},
+			df: func(x float64) float64 {
+				s, c := math.Sin(x), math.Cos(x)
+				g := s*s*s + c*c*c
+				dg := 3*s*s*c - 3*c*c*s
+				return math.Exp(x) * (1/math.Sqrt(g) - dg/(2*g*math.Sqrt(g)))
+			},
+		},
+		{
+			name: "polynomial",
+			f:    func(z complex128) complex128 { return z*z*z - 2*z + 1 },
+			df:   func(x float64) float64 { return 3*x*x - 2 },
+		},
+	} {
+		for _, x := range []float64{-0.5, 0, 0.3, 1.5, 2} {
+			want := test.df(x)
+			for _, step := range []float64{0, 1e-10, 1e-100} {
+				got := ComplexStepDerivative(test.f, x, step)
+				if !scalar.EqualWithinAbsOrRel(got, want, 1e-15, 1e-14) {
+					t.Errorf("unexpected derivative of %s at %v with step %v: got:%v want:%v", test.name, x, step, got, want)
+				}
+			}
+		}
+	}
+	if !panics(func() { ComplexStepDerivative(cmplx.Sin, 0, -1) }) {
+		t.Errorf("expected panic for negative step")
+	}
+}
+
+func TestComplexStepGradient(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	// The extended Rosenbrock function.
+	f := func(x []complex128) complex128 {
+		var s complex128
+		for i := 0; i < len(x)-1; i++ {
+			a := x[i+1] - x[i]*x[i]
+			b := 1 - x[i]
+			s += 100*a*a + b*b
+		}
+		return s
+	}
+	grad := func(x []float64) []float64 {
+		g := make([]float64, len(x))
+		for i := 0; i < len(x)-1; i++ {
+			a := x[i+1] - x[i]*x[i]
+			g[i] += -400*a*x[i] - 2*(1-x[i])
+			g[i+1] += 200 * a
+		}
+		return g
+	}
+	for _, n := range []int{2, 5, 10} {
+		x := make([]float64, n)
+		for i := range x {
+			x[i] = rnd.NormFloat64()
+		}
+		xcopy := make([]float64, n)
+		copy(xcopy, x)
+		want := grad(x)
+
+		got := ComplexStepGradient(nil, f, x, 0)
+		if !floats.EqualApprox(got, want, 1e-12) {
+			t.Errorf("unexpected gradient for n=%d: got:%v want:%v", n, got, want)
+		}
+		dst := make([]float64, n)
+		ComplexStepGradient(dst, f, x, 0)
+		if !floats.Equal(dst, got) {
+			t.Errorf("unexpected gradient stored into dst for n=%d", n)
+		}
+		if !floats.Equal(x, xcopy) {
+			t.Errorf("x modified for n=%d", n)
+		}
+	}
+	if !panics(func() { ComplexStepGradient(make([]float64, 2), f, make([]float64, 3), 0) }) {
+		t.Errorf("expected panic for mismatched lengths")
+	}
+}
+
+func TestComplexStepJacobian(t *testing.T) {
+	t.Parallel()
+	f := func(y, x []complex128) {
+		y[0] = x[0] + 1
+		y[1] = 5*x[2] + 1
+		y[2] = 4*x[1]*x[1] - 2*x[2] + 1
+		y[3] = x[2]*cmplx.Sin(x[0]) + 1
+	}
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 10; i++ {
+		x := []float64{rnd.NormFloat64(), rnd.NormFloat64(), rnd.NormFloat64()}
+		want := mat.NewDense(4, 3, nil)
+		vecFunc43Jac(want, x)
+		got := mat.NewDense(4, 3, nil)
+		ComplexStepJacobian(got, f, x, 0)
+		if !mat.EqualApprox(got, want, 1e-15) {
+			t.Errorf("unexpected Jacobian at %v:\ngot: %v\nwant: %v", x,
+				mat.Formatted(got, mat.Prefix("     ")), mat.Formatted(want, mat.Prefix("      ")))
+		}
+	}
+	if !panics(func() { ComplexStepJacobian(mat.NewDense(4, 2, nil), f, make([]float64, 3), 0) }) {
+		t.Errorf("expected panic for mismatched dimensions")
+	}
+}
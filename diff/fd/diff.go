@@ -47,6 +47,12 @@ type Settings struct {
 	OriginValue float64 // Value at the origin (only used if OriginKnown is true).
 
 	Concurrent bool // Should the function calls be executed concurrently.
+
+	// Executor schedules the function evaluations of Gradient
+	// and GradientContext. If Executor is nil, the evaluations
+	// are performed serially, or by a WorkerPool if Concurrent
+	// is true. If Executor is not nil, Concurrent is ignored.
+	Executor Executor
 }
 
 // Forward represents a first-order accurate forward approximation
@@ -0,0 +1,117 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrEvaluationLimit is returned when a batch of function evaluations would
+// exceed the evaluation budget of an executor.
+var ErrEvaluationLimit = errors.New("fd: evaluation limit reached")
+
+// Executor schedules the function evaluations of a finite difference
+// estimate. Implementations allow callers to control how expensive function
+// evaluations are distributed over workers and how many are performed.
+type Executor interface {
+	// Workers returns the number of workers used by Execute.
+	// Callers use it to allocate scratch space for each worker.
+	Workers() int
+
+	// Execute calls eval once for each index in [0, n). The
+	// worker argument of eval is in [0, Workers()) and calls to
+	// eval with the same worker do not run concurrently, so the
+	// worker can be used to select scratch space that is not
+	// shared between concurrent evaluations.
+	//
+	// If Execute returns a non-nil error, some evaluations may
+	// not have been performed and the results must be discarded.
+	Execute(ctx context.Context, n int, eval func(worker, i int)) error
+}
+
+// WorkerPool is an Executor that runs evaluations on a fixed number of
+// goroutines and optionally limits the total number of evaluations it
+// performs over its lifetime. A WorkerPool may be shared between sequential
+// finite difference estimates so that the limit applies to all of them. The
+// zero value is a pool with runtime.GOMAXPROCS(0) workers and no limit.
+type WorkerPool struct {
+	// NumWorkers is the number of workers. If NumWorkers is
+	// zero, runtime.GOMAXPROCS(0) is used.
+	NumWorkers int
+
+	// MaxEvaluations is the maximum number of evaluations the
+	// pool performs. If MaxEvaluations is zero, the number of
+	// evaluations is not limited.
+	MaxEvaluations int
+
+	evaluations int64
+}
+
+var _ Executor = (*WorkerPool)(nil)
+
+// Workers returns the number of workers of the pool.
+func (p *WorkerPool) Workers() int {
+	if p.NumWorkers <= 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return p.NumWorkers
+}
+
+// Evaluations returns the number of evaluations performed by the pool.
+func (p *WorkerPool) Evaluations() int {
+	return int(atomic.LoadInt64(&p.evaluations))
+}
+
+// Execute calls eval for each index in [0, n) using the workers of the pool.
+// If performing n evaluations would exceed MaxEvaluations, Execute performs no
+// evaluations and returns ErrEvaluationLimit. If ctx is cancelled, Execute
+// stops scheduling evaluations and returns the error of the context.
+func (p *WorkerPool) Execute(ctx context.Context, n int, eval func(worker, i int)) error {
+	if n == 0 {
+		return ctx.Err()
+	}
+	if p.MaxEvaluations > 0 {
+		if atomic.AddInt64(&p.evaluations, int64(n)) > int64(p.MaxEvaluations) {
+			atomic.AddInt64(&p.evaluations, -int64(n))
+			return ErrEvaluationLimit
+		}
+	} else {
+		atomic.AddInt64(&p.evaluations, int64(n))
+	}
+
+	workers := min(p.Workers(), n)
+	var (
+		next int64 = -1
+		done int64
+		wg   sync.WaitGroup
+	)
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func(w int) {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= n {
+					return
+				}
+				eval(w, i)
+				atomic.AddInt64(&done, 1)
+			}
+		}(w)
+	}
+	wg.Wait()
+	// Evaluations that were not performed because of
+	// cancellation are not counted.
+	performed := atomic.LoadInt64(&done)
+	if performed != int64(n) {
+		atomic.AddInt64(&p.evaluations, performed-int64(n))
+		return ctx.Err()
+	}
+	return nil
+}
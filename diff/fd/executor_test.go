@@ -0,0 +1,152 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestWorkerPool(t *testing.T) {
+	t.Parallel()
+	for _, workers := range []int{0, 1, 3, 16} {
+		p := &WorkerPool{NumWorkers: workers}
+		const n = 100
+		var (
+			calls [n]int64
+			busy  = make([]int64, p.Workers())
+		)
+		err := p.Execute(context.Background(), n, func(w, i int) {
+			if atomic.AddInt64(&busy[w], 1) != 1 {
+				t.Errorf("workers=%d: concurrent use of worker %d", workers, w)
+			}
+			atomic.AddInt64(&calls[i], 1)
+			atomic.AddInt64(&busy[w], -1)
+		})
+		if err != nil {
+			t.Errorf("workers=%d: unexpected error: %v", workers, err)
+		}
+		for i, c := range calls {
+			if c != 1 {
+				t.Errorf("workers=%d: index %d evaluated %d times", workers, i, c)
+			}
+		}
+		if p.Evaluations() != n {
+			t.Errorf("workers=%d: unexpected number of evaluations: got:%d want:%d", workers, p.Evaluations(), n)
+		}
+	}
+}
+
+func TestWorkerPoolLimit(t *testing.T) {
+	t.Parallel()
+	p := &WorkerPool{NumWorkers: 2, MaxEvaluations: 10}
+	var calls int64
+	eval := func(_, _ int) { atomic.AddInt64(&calls, 1) }
+	if err := p.Execute(context.Background(), 6, eval); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := p.Execute(context.Background(), 6, eval); err != ErrEvaluationLimit {
+		t.Errorf("unexpected error: got:%v want:%v", err, ErrEvaluationLimit)
+	}
+	if calls != 6 || p.Evaluations() != 6 {
+		t.Errorf("unexpected evaluations after limit: calls:%d evaluations:%d want:6", calls, p.Evaluations())
+	}
+	if err := p.Execute(context.Background(), 4, eval); err != nil {
+		t.Errorf("unexpected error within limit: %v", err)
+	}
+}
+
+func TestWorkerPoolCancel(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &WorkerPool{NumWorkers: 1}
+	var calls int64
+	err := p.Execute(ctx, 100, func(_, i int) {
+		if atomic.AddInt64(&calls, 1) == 10 {
+			cancel()
+		}
+	})
+	if err != context.Canceled {
+		t.Errorf("unexpected error: got:%v want:%v", err, context.Canceled)
+	}
+	if calls != 10 || p.Evaluations() != 10 {
+		t.Errorf("unexpected evaluations after cancellation: calls:%d evaluations:%d want:10", calls, p.Evaluations())
+	}
+}
+
+// serialExecutor is an Executor that evaluates in reverse order on a single
+// worker.
+type serialExecutor struct {
+	evals int
+}
+
+func (e *serialExecutor) Workers() int { return 1 }
+
+func (e *serialExecutor) Execute(ctx context.Context, n int, eval func(worker, i int)) error {
+	for i := n - 1; i >= 0; i-- {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		eval(0, i)
+		e.evals++
+	}
+	return nil
+}
+
+func TestGradientExecutor(t *testing.T) {
+	t.Parallel()
+	r := Rosenbrock{nDim: 6}
+	x := []float64{0.1, -0.2, 0.3, 1.2, 0.5, -1.1}
+	for _, formula := range []Formula{Forward, Backward, Central} {
+		want := Gradient(nil, r.F, x, &Settings{Formula: formula})
+
+		pool := &WorkerPool{NumWorkers: 4}
+		got, err := GradientContext(context.Background(), nil, r.F, x, &Settings{Formula: formula, Executor: pool})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !floats.EqualApprox(got, want, 1e-14) {
+			t.Errorf("unexpected gradient with worker pool: got:%v want:%v", got, want)
+		}
+		evals := len(formula.Stencil) * len(x)
+		if usesOrigin(formula.Stencil) {
+			evals -= len(x) - 1
+		}
+		if pool.Evaluations() != evals {
+			t.Errorf("unexpected number of evaluations: got:%d want:%d", pool.Evaluations(), evals)
+		}
+
+		// The result must not depend on the order of evaluation.
+		serial := &serialExecutor{}
+		got2 := Gradient(nil, r.F, x, &Settings{Formula: formula, Executor: serial})
+		if !floats.Equal(got, got2) {
+			t.Errorf("result depends on evaluation order: got:%v want:%v", got2, got)
+		}
+		if serial.evals != evals {
+			t.Errorf("unexpected number of evaluations by custom executor: got:%d want:%d", serial.evals, evals)
+		}
+	}
+
+	// An evaluation limit is reported as an error.
+	pool := &WorkerPool{MaxEvaluations: len(x)}
+	_, err := GradientContext(context.Background(), nil, r.F, x, &Settings{Executor: pool})
+	if err != ErrEvaluationLimit {
+		t.Errorf("unexpected error: got:%v want:%v", err, ErrEvaluationLimit)
+	}
+	if !panics(func() { Gradient(nil, r.F, x, &Settings{Executor: pool}) }) {
+		t.Errorf("expected panic from Gradient for executor error")
+	}
+
+	// A cancelled context is reported as an error.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = GradientContext(ctx, nil, r.F, x, &Settings{Executor: &WorkerPool{}})
+	if err != context.Canceled {
+		t.Errorf("unexpected error: got:%v want:%v", err, context.Canceled)
+	}
+}
@@ -4,7 +4,11 @@
 
 package fd
 
-import "gonum.org/v1/gonum/floats"
+import (
+	"context"
+
+	"gonum.org/v1/gonum/floats"
+)
 
 // Gradient estimates the gradient of the multivariate function f at the
 // location x. If dst is not nil, the result will be stored in-place into dst
@@ -13,9 +17,27 @@ import "gonum.org/v1/gonum/floats"
 // nil, the gradient will be estimated using the Forward formula and a default
 // step size.
 //
-// Gradient panics if the length of dst and x is not equal, or if the derivative
-// order of the formula is not 1.
+// Gradient panics if the length of dst and x is not equal, if the derivative
+// order of the formula is not 1, or if settings.Executor returns an error.
+// GradientContext should be used to handle cancellation and evaluation limits.
 func Gradient(dst []float64, f func([]float64) float64, x []float64, settings *Settings) []float64 {
+	dst, err := GradientContext(context.Background(), dst, f, x, settings)
+	if err != nil {
+		panic(err)
+	}
+	return dst
+}
+
+// GradientContext estimates the gradient of the multivariate function f at
+// the location x as described for Gradient. The evaluations of f are
+// scheduled by settings.Executor as a single batch. If the
+// executor returns an error, for example because ctx is cancelled or an
+// evaluation limit is reached, GradientContext returns the error and the
+// contents of dst are undefined.
+//
+// GradientContext panics if the length of dst and x is not equal, or if the
+// derivative order of the formula is not 1.
+func GradientContext(ctx context.Context, dst []float64, f func([]float64) float64, x []float64, settings *Settings) ([]float64, error) {
 	if dst == nil {
 		dst = make([]float64, len(x))
 	}
@@ -28,6 +50,7 @@ func Gradient(dst []float64, f func([]float64) float64, x []float64, settings *S
 	step := formula.Step
 	var originValue float64
 	var originKnown, concurrent bool
+	var exec Executor
 
 	// Use user settings if provided.
 	if settings != nil {
@@ -45,20 +68,25 @@ func Gradient(dst []float64, f func([]float64) float64, x []float64, settings *S
 		originKnown = settings.OriginKnown
 		originValue = settings.OriginValue
 		concurrent = settings.Concurrent
+		exec = settings.Executor
 	}
 
 	evals := len(formula.Stencil) * len(x)
-	nWorkers := computeWorkers(concurrent, evals)
-
-	hasOrigin := usesOrigin(formula.Stencil)
-	// Copy x in case it is modified during the call.
-	xcopy := make([]float64, len(x))
-	if hasOrigin && !originKnown {
-		copy(xcopy, x)
-		originValue = f(xcopy)
+	if exec == nil {
+		nWorkers := computeWorkers(concurrent, evals)
+		if nWorkers > 1 {
+			exec = &WorkerPool{NumWorkers: nWorkers}
+		}
 	}
 
-	if nWorkers == 1 {
+	hasOrigin := usesOrigin(formula.Stencil)
+	if exec == nil {
+		// Copy x in case it is modified during the call.
+		xcopy := make([]float64, len(x))
+		if hasOrigin && !originKnown {
+			copy(xcopy, x)
+			originValue = f(xcopy)
+		}
 		for i := range xcopy {
 			var deriv float64
 			for _, pt := range formula.Stencil {
@@ -77,65 +105,64 @@ func Gradient(dst []float64, f func([]float64) float64, x []float64, settings *S
 			}
 			dst[i] = deriv / step
 		}
-		return dst
+		return dst, nil
 	}
 
-	sendChan := make(chan fdrun, evals)
-	ansChan := make(chan fdrun, evals)
-	quit := make(chan struct{})
-	defer close(quit)
-
-	// Launch workers. Workers receive an index and a step, and compute the answer.
-	for i := 0; i < nWorkers; i++ {
-		go func(sendChan <-chan fdrun, ansChan chan<- fdrun, quit <-chan struct{}) {
-			xcopy := make([]float64, len(x))
-			for {
-				select {
-				case <-quit:
-					return
-				case run := <-sendChan:
-					// See above comment on the copy.
-					copy(xcopy, x)
-					xcopy[run.idx] += run.pt.Loc * step
-					run.result = f(xcopy)
-					ansChan <- run
-				}
-			}
-		}(sendChan, ansChan, quit)
+	// Collect the evaluations and schedule them as a single batch.
+	// The evaluation at the origin, if needed, is marked by a
+	// negative index.
+	var runs []fdrun
+	if hasOrigin && !originKnown {
+		runs = append(runs, fdrun{idx: -1})
 	}
-
-	// Launch the distributor. Distributor sends the cases to be computed.
-	go func(sendChan chan<- fdrun, ansChan chan<- fdrun) {
-		for i := range x {
-			for _, pt := range formula.Stencil {
-				if pt.Loc == 0 {
-					// Answer already known. Send the answer on the answer channel.
-					ansChan <- fdrun{
-						idx:    i,
-						pt:     pt,
-						result: originValue,
-					}
-					continue
-				}
-				// Answer not known, send the answer to be computed.
-				sendChan <- fdrun{
-					idx: i,
-					pt:  pt,
-				}
+	for i := range x {
+		for _, pt := range formula.Stencil {
+			if pt.Loc != 0 {
+				runs = append(runs, fdrun{idx: i, pt: pt})
 			}
 		}
-	}(sendChan, ansChan)
+	}
+	// Each worker has its own copy of x. See above comment on the copy.
+	scratch := make([][]float64, exec.Workers())
+	err := exec.Execute(ctx, len(runs), func(worker, k int) {
+		xw := scratch[worker]
+		if xw == nil {
+			xw = make([]float64, len(x))
+			scratch[worker] = xw
+		}
+		run := &runs[k]
+		copy(xw, x)
+		if run.idx >= 0 {
+			xw[run.idx] += run.pt.Loc * step
+		}
+		run.result = f(xw)
+	})
+	if err != nil {
+		return dst, err
+	}
+	k := 0
+	if hasOrigin && !originKnown {
+		originValue = runs[0].result
+		k++
+	}
 
+	// Accumulate the results in a fixed order so that the estimate
+	// does not depend on the scheduling of the evaluations.
 	for i := range dst {
 		dst[i] = 0
 	}
-	// Read in all of the results.
-	for i := 0; i < evals; i++ {
-		run := <-ansChan
-		dst[run.idx] += run.pt.Coeff * run.result
+	for i := range x {
+		for _, pt := range formula.Stencil {
+			if pt.Loc == 0 {
+				dst[i] += pt.Coeff * originValue
+				continue
+			}
+			dst[i] += pt.Coeff * runs[k].result
+			k++
+		}
 	}
 	floats.Scale(1/step, dst)
-	return dst
+	return dst, nil
 }
 
 type fdrun struct {
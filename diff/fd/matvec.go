@@ -0,0 +1,180 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+// JacobianVec approximates the product of the Jacobian matrix of the
+// vector-valued function f at x with the vector v,
+//
+//	J⋅v = d/dt f(x + t*v) at t = 0,
+//
+// by a finite difference along the direction v, without forming the Jacobian.
+// The result is stored in-place into dst and returned. The length of dst must
+// equal the length of the output of f. If f is the gradient of a scalar
+// function, JacobianVec approximates the Hessian-vector product with a single
+// directional difference of the gradient.
+//
+// Finite difference formula and other options are specified by settings. If
+// settings is nil, the product will be estimated using the Forward formula
+// and a default step size. The step is scaled by the inverse of the norm of v
+// so that the perturbation of x has the length of the step. The formula only
+// requires len(Formula.Stencil) evaluations of f, independent of the length
+// of x. If settings.OriginValue is not nil, it is used as the value of f at x.
+//
+// JacobianVec panics if dst is nil, if the lengths of x and v are not equal,
+// or if the derivative order of the formula is not 1.
+func JacobianVec(dst []float64, f func(y, x []float64), x, v []float64, settings *JacobianSettings) []float64 {
+	if dst == nil {
+		panic("fd: nil destination")
+	}
+	if len(x) != len(v) {
+		panic("fd: slice length mismatch")
+	}
+
+	// Default settings.
+	formula := Forward
+	step := formula.Step
+	var originValue []float64
+
+	// Use user settings if provided.
+	if settings != nil {
+		if !settings.Formula.isZero() {
+			formula = settings.Formula
+			step = formula.Step
+			checkFormula(formula)
+			if formula.Derivative != 1 {
+				panic(badDerivOrder)
+			}
+		}
+		if settings.Step != 0 {
+			if settings.Step < 0 {
+				panic(negativeStep)
+			}
+			step = settings.Step
+		}
+		originValue = settings.OriginValue
+		if originValue != nil && len(originValue) != len(dst) {
+			panic("fd: mismatched OriginValue length")
+		}
+	}
+
+	for i := range dst {
+		dst[i] = 0
+	}
+	vNorm := floats.Norm(v, 2)
+	if vNorm == 0 {
+		return dst
+	}
+	t := step / vNorm
+
+	xt := make([]float64, len(x))
+	y := make([]float64, len(dst))
+	for _, pt := range formula.Stencil {
+		if pt.Loc == 0 && originValue != nil {
+			floats.AddScaled(dst, pt.Coeff, originValue)
+			continue
+		}
+		copy(xt, x)
+		floats.AddScaled(xt, t*pt.Loc, v)
+		f(y, xt)
+		floats.AddScaled(dst, pt.Coeff, y)
+	}
+	floats.Scale(1/t, dst)
+	return dst
+}
+
+// HessianVec approximates the product of the Hessian matrix of the
+// multivariate function f at x with the vector v,
+//
+//	H⋅v = d/dt ∇f(x + t*v) at t = 0,
+//
+// without forming the Hessian. The gradients are estimated by finite
+// differences and the product by a finite difference of the gradients along v,
+// so with the Forward formula the estimate is forward-over-forward
+// differencing. If dst is not nil, the result will be stored in-place into
+// dst and returned, otherwise a new slice will be allocated first. If the
+// gradient of f is available, JacobianVec applied to the gradient function is
+// cheaper and more accurate.
+//
+// Finite difference formula and other options are specified by settings. If
+// settings is nil, the product will be estimated using the Forward formula
+// and a default step size, the square root of the step of the formula as for
+// Hessian. The formula is used both for the gradients and for the difference
+// along v, which requires len(Formula.Stencil)² * len(x) evaluations of f.
+// The settings are otherwise interpreted as by Gradient.
+//
+// HessianVec panics if the lengths of dst, x and v are not equal, or if the
+// derivative order of the formula is not 1.
+func HessianVec(dst []float64, f func(x []float64) float64, x, v []float64, settings *Settings) []float64 {
+	n := len(x)
+	if dst == nil {
+		dst = make([]float64, n)
+	}
+	if len(dst) != n || len(v) != n {
+		panic("fd: slice length mismatch")
+	}
+
+	// Default settings.
+	formula := Forward
+	step := math.Sqrt(formula.Step) // Use the sqrt because taking derivatives of derivatives.
+	var originValue float64
+	var originKnown, concurrent bool
+
+	// Use user settings if provided.
+	if settings != nil {
+		if !settings.Formula.isZero() {
+			formula = settings.Formula
+			step = math.Sqrt(formula.Step)
+			checkFormula(formula)
+			if formula.Derivative != 1 {
+				panic(badDerivOrder)
+			}
+		}
+		if settings.Step != 0 {
+			if settings.Step < 0 {
+				panic(negativeStep)
+			}
+			step = settings.Step
+		}
+		originKnown = settings.OriginKnown
+		originValue = settings.OriginValue
+		concurrent = settings.Concurrent
+	}
+
+	for i := range dst {
+		dst[i] = 0
+	}
+	vNorm := floats.Norm(v, 2)
+	if vNorm == 0 {
+		return dst
+	}
+	t := step / vNorm
+
+	xt := make([]float64, n)
+	grad := make([]float64, n)
+	for _, pt := range formula.Stencil {
+		copy(xt, x)
+		gs := &Settings{
+			Formula:    formula,
+			Step:       step,
+			Concurrent: concurrent,
+		}
+		if pt.Loc == 0 {
+			gs.OriginKnown = originKnown
+			gs.OriginValue = originValue
+		} else {
+			floats.AddScaled(xt, t*pt.Loc, v)
+		}
+		Gradient(grad, f, xt, gs)
+		floats.AddScaled(dst, pt.Coeff, grad)
+	}
+	floats.Scale(1/t, dst)
+	return dst
+}
@@ -0,0 +1,126 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestJacobianVec(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for i, test := range []struct {
+		m, n int
+		fn   func([]float64, []float64)
+		jac  func(*mat.Dense, []float64)
+	}{
+		{m: 1, n: 3, fn: vecFunc13, jac: vecFunc13Jac},
+		{m: 2, n: 2, fn: vecFunc22, jac: vecFunc22Jac},
+		{m: 4, n: 3, fn: vecFunc43, jac: vecFunc43Jac},
+		{m: 8, n: 8, fn: broydenTridiagonal, jac: broydenTridiagonalJac},
+	} {
+		for _, settings := range []struct {
+			settings *JacobianSettings
+			tol      float64
+		}{
+			{settings: nil, tol: 1e-6},
+			{settings: &JacobianSettings{Formula: Central}, tol: 1e-8},
+			{settings: &JacobianSettings{Formula: Backward, Step: 1e-7}, tol: 1e-5},
+		} {
+			x := randomSlice(rnd, test.n, 2)
+			v := randomSlice(rnd, test.n, 10)
+			jac := mat.NewDense(test.m, test.n, nil)
+			test.jac(jac, x)
+			want := mat.NewVecDense(test.m, nil)
+			want.MulVec(jac, mat.NewVecDense(test.n, v))
+
+			got := JacobianVec(make([]float64, test.m), test.fn, x, v, settings.settings)
+			if !floats.EqualApprox(got, want.RawVector().Data, settings.tol*floats.Norm(v, 2)) {
+				t.Errorf("case %d: unexpected Jacobian-vector product: got:%v want:%v", i, got, want.RawVector().Data)
+			}
+		}
+
+		// A known origin value must not change the result.
+		x := randomSlice(rnd, test.n, 2)
+		v := randomSlice(rnd, test.n, 1)
+		y := make([]float64, test.m)
+		test.fn(y, x)
+		want := JacobianVec(make([]float64, test.m), test.fn, x, v, nil)
+		got := JacobianVec(make([]float64, test.m), test.fn, x, v, &JacobianSettings{OriginValue: y})
+		if !floats.Equal(got, want) {
+			t.Errorf("case %d: unexpected result with origin value: got:%v want:%v", i, got, want)
+		}
+
+		// A zero direction gives a zero product.
+		got = JacobianVec(make([]float64, test.m), test.fn, x, make([]float64, test.n), nil)
+		if floats.Norm(got, 2) != 0 {
+			t.Errorf("case %d: unexpected non-zero product for zero direction: %v", i, got)
+		}
+	}
+}
+
+func TestHessianVec(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for cas, test := range hessianTestCases() {
+		n := len(test.x)
+		v := randomSlice(rnd, n, 1)
+		hess := mat.NewSymDense(n, nil)
+		test.h.Hess(hess, test.x)
+		want := mat.NewVecDense(n, nil)
+		want.MulVec(hess, mat.NewVecDense(n, v))
+
+		got := HessianVec(nil, test.h.Func, test.x, v, test.settings)
+		if !floats.EqualApprox(got, want.RawVector().Data, test.tol*floats.Norm(want.RawVector().Data, 2)+test.tol) {
+			t.Errorf("case %d: unexpected Hessian-vector product: got:%v want:%v", cas, got, want.RawVector().Data)
+		}
+
+		// Test that concurrency works.
+		settings := test.settings
+		if settings == nil {
+			settings = &Settings{}
+		}
+		settings.Concurrent = true
+		got2 := HessianVec(make([]float64, n), test.h.Func, test.x, v, settings)
+		if !floats.EqualApprox(got, got2, 1e-14) {
+			t.Errorf("case %d: concurrent and serial results differ: got:%v want:%v", cas, got2, got)
+		}
+
+		// The product of the analytic gradient agrees.
+		got3 := JacobianVec(make([]float64, n), func(y, x []float64) { test.h.Grad(y, x) }, test.x, v,
+			&JacobianSettings{Formula: Central})
+		if !floats.EqualApprox(got3, want.RawVector().Data, 1e-6*floats.Norm(want.RawVector().Data, 2)+1e-6) {
+			t.Errorf("case %d: unexpected gradient-vector product: got:%v want:%v", cas, got3, want.RawVector().Data)
+		}
+	}
+}
+
+func TestMatVecPanics(t *testing.T) {
+	t.Parallel()
+	f := func(x []float64) float64 { return x[0] * x[1] }
+	g := func(y, x []float64) { y[0] = x[0] * x[1] }
+	for i, fn := range []func(){
+		func() { JacobianVec(nil, g, []float64{1, 2}, []float64{1, 0}, nil) },
+		func() { JacobianVec(make([]float64, 1), g, []float64{1, 2}, []float64{1}, nil) },
+		func() {
+			JacobianVec(make([]float64, 1), g, []float64{1, 2}, []float64{1, 0}, &JacobianSettings{Formula: Central2nd})
+		},
+		func() {
+			JacobianVec(make([]float64, 1), g, []float64{1, 2}, []float64{1, 0}, &JacobianSettings{OriginValue: []float64{1, 2}})
+		},
+		func() { HessianVec(make([]float64, 1), f, []float64{1, 2}, []float64{1, 0}, nil) },
+		func() { HessianVec(nil, f, []float64{1, 2}, []float64{1}, nil) },
+		func() { HessianVec(nil, f, []float64{1, 2}, []float64{1, 0}, &Settings{Step: -1}) },
+	} {
+		if !panics(fn) {
+			t.Errorf("case %d: expected panic", i)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import "math"
+
+// RiddersSettings holds the options for Ridders' extrapolated derivative
+// estimation.
+type RiddersSettings struct {
+	// Formula is the finite difference formula that is
+	// extrapolated. The zero value indicates Central.
+	Formula Formula
+
+	// Step is the initial step. The step should be large
+	// enough that the truncation error dominates the rounding
+	// error, since it is reduced by the extrapolation. If Step
+	// is zero, 0.1*max(1, |x|) is used.
+	Step float64
+
+	// Shrink is the factor by which the step is reduced at
+	// each iteration. Shrink must be greater than one. If
+	// Shrink is zero, 1.4 is used.
+	Shrink float64
+
+	// MaxIter is the maximum number of steps evaluated. If
+	// MaxIter is zero, 10 is used.
+	MaxIter int
+}
+
+// RiddersDerivative estimates the derivative of the function f at x by
+// Ridders' method, Richardson extrapolation of a finite difference formula
+// to zero step size over a sequence of decreasing steps, and returns the
+// estimate and an estimate of its absolute error.
+//
+// The extrapolation tableau is built until the error estimate stops
+// decreasing, so the step that balances truncation and rounding error is
+// selected automatically. This makes the method robust for badly scaled
+// functions where no fixed step is appropriate. The truncation error of
+// the formula is assumed to be a series in even powers of the step if the
+// stencil is symmetric about zero and in all powers of the step otherwise.
+//
+// If settings is nil, the first derivative is estimated from the Central
+// formula with the default options described in RiddersSettings.
+// RiddersDerivative panics if the step is negative, the shrink factor is
+// not greater than one, or MaxIter is negative.
+//
+// See C. J. F. Ridders, "Accurate computation of F′(x) and F′(x)F″(x)",
+// Adv. Eng. Softw. 4(2), 1982, for details.
+func RiddersDerivative(f func(float64) float64, x float64, settings *RiddersSettings) (deriv, err float64) {
+	formula := Central
+	step := 0.1 * math.Max(1, math.Abs(x))
+	shrink := 1.4
+	maxIter := 10
+	if settings != nil {
+		if !settings.Formula.isZero() {
+			formula = settings.Formula
+			checkFormula(formula)
+		}
+		if settings.Step < 0 {
+			panic(negativeStep)
+		}
+		if settings.Step != 0 {
+			step = settings.Step
+		}
+		if settings.Shrink != 0 {
+			if !(settings.Shrink > 1) {
+				panic("fd: invalid shrink factor")
+			}
+			shrink = settings.Shrink
+		}
+		if settings.MaxIter < 0 {
+			panic("fd: negative maximum iterations")
+		}
+		if settings.MaxIter != 0 {
+			maxIter = settings.MaxIter
+		}
+	}
+
+	// The ratio of successive truncation error terms.
+	ratio := shrink
+	if symmetricStencil(formula.Stencil) {
+		ratio *= shrink
+	}
+	// safe is the factor by which the error of the diagonal may exceed
+	// the best error before the tableau is abandoned.
+	const safe = 2
+
+	diff := func(h float64) float64 {
+		var d float64
+		for _, pt := range formula.Stencil {
+			d += pt.Coeff * f(x+h*pt.Loc)
+		}
+		return d / math.Pow(h, float64(formula.Derivative))
+	}
+
+	prev := make([]float64, maxIter)
+	curr := make([]float64, maxIter)
+	prev[0] = diff(step)
+	deriv = prev[0]
+	err = math.Inf(1)
+	for i := 1; i < maxIter; i++ {
+		step /= shrink
+		curr[0] = diff(step)
+		fac := ratio
+		for j := 1; j <= i; j++ {
+			curr[j] = (curr[j-1]*fac - prev[j-1]) / (fac - 1)
+			fac *= ratio
+			e := math.Max(math.Abs(curr[j]-curr[j-1]), math.Abs(curr[j]-prev[j-1]))
+			if e <= err {
+				err = e
+				deriv = curr[j]
+			}
+		}
+		if math.Abs(curr[i]-prev[i-1]) >= safe*err {
+			break
+		}
+		prev, curr = curr, prev
+	}
+	return deriv, err
+}
+
+// symmetricStencil returns whether the stencil points are symmetric about
+// zero with coefficients that are symmetric or antisymmetric, so that the
+// truncation error contains only even powers of the step.
+func symmetricStencil(stencil []Point) bool {
+	var sign float64
+	for _, pt := range stencil {
+		if pt.Loc == 0 {
+			continue
+		}
+		found := false
+		for _, q := range stencil {
+			if q.Loc != -pt.Loc {
+				continue
+			}
+			s := 1.0
+			if q.Coeff != pt.Coeff {
+				if q.Coeff != -pt.Coeff {
+					return false
+				}
+				s = -1
+			}
+			if sign == 0 {
+				sign = s
+			} else if sign != s {
+				return false
+			}
+			found = true
+			break
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,103 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRiddersDerivative(t *testing.T) {
+	t.Parallel()
+	for i, test := range []struct {
+		f        func(float64) float64
+		x        float64
+		settings *RiddersSettings
+		want     float64
+		tol      float64
+	}{
+		{f: math.Sin, x: 0.3, want: math.Cos(0.3), tol: 1e-12},
+		{f: math.Exp, x: 2, want: math.Exp(2), tol: 1e-12},
+		{f: math.Exp, x: 2, settings: &RiddersSettings{Formula: Forward}, want: math.Exp(2), tol: 1e-9},
+		{f: math.Exp, x: 2, settings: &RiddersSettings{Formula: Backward, Shrink: 2, MaxIter: 15}, want: math.Exp(2), tol: 1e-9},
+		{f: math.Sin, x: 0.3, settings: &RiddersSettings{Formula: Central2nd}, want: -math.Sin(0.3), tol: 1e-9},
+		{f: math.Log, x: 1e-4, settings: &RiddersSettings{Step: 5e-5}, want: 1e4, tol: 1e-6},
+		{
+			// Badly scaled: the function varies on a scale of 1e-6.
+			f:        func(x float64) float64 { return math.Sin(1e6 * x) },
+			x:        1e-7,
+			settings: &RiddersSettings{Step: 1e-7},
+			want:     1e6 * math.Cos(0.1),
+			tol:      1e-9,
+		},
+		{
+			// Badly scaled: large offset relative to the variation.
+			f:    func(x float64) float64 { return 1e8 + math.Tanh(x) },
+			x:    0.5,
+			want: 1 / (math.Cosh(0.5) * math.Cosh(0.5)),
+			tol:  1e-6,
+		},
+	} {
+		got, err := RiddersDerivative(test.f, test.x, test.settings)
+		relErr := math.Abs(got-test.want) / math.Max(1, math.Abs(test.want))
+		if relErr > test.tol {
+			t.Errorf("case %d: unexpected derivative: got:%v want:%v", i, got, test.want)
+		}
+		if math.IsInf(err, 0) || math.IsNaN(err) {
+			t.Errorf("case %d: unexpected error estimate: %v", i, err)
+		}
+		// The error estimate should bound the actual error
+		// to within a modest factor.
+		if actual := math.Abs(got - test.want); actual > 100*err+1e-14*math.Abs(test.want) {
+			t.Errorf("case %d: error estimate too small: got:%v actual:%v", i, err, actual)
+		}
+	}
+}
+
+func TestRiddersDerivativeBeatsFixedStep(t *testing.T) {
+	t.Parallel()
+	f := func(x float64) float64 { return math.Exp(-x) * math.Sin(10*x) }
+	df := func(x float64) float64 { return math.Exp(-x) * (10*math.Cos(10*x) - math.Sin(10*x)) }
+	const x = 1.2
+	want := df(x)
+	ridders, _ := RiddersDerivative(f, x, nil)
+	fixed := Derivative(f, x, &Settings{Formula: Central})
+	if math.Abs(ridders-want) >= math.Abs(fixed-want) {
+		t.Errorf("extrapolation not more accurate than fixed step: got error:%v fixed step error:%v",
+			math.Abs(ridders-want), math.Abs(fixed-want))
+	}
+}
+
+func TestRiddersDerivativePanics(t *testing.T) {
+	t.Parallel()
+	for _, settings := range []*RiddersSettings{
+		{Step: -1},
+		{Shrink: 0.5},
+		{Shrink: 1},
+		{MaxIter: -1},
+	} {
+		if !panics(func() { RiddersDerivative(math.Sin, 0, settings) }) {
+			t.Errorf("expected panic for settings %+v", *settings)
+		}
+	}
+}
+
+func TestSymmetricStencil(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name    string
+		formula Formula
+		want    bool
+	}{
+		{name: "Forward", formula: Forward, want: false},
+		{name: "Backward", formula: Backward, want: false},
+		{name: "Central", formula: Central, want: true},
+		{name: "Central2nd", formula: Central2nd, want: true},
+	} {
+		if got := symmetricStencil(test.formula.Stencil); got != test.want {
+			t.Errorf("unexpected symmetry for %s: got:%t want:%t", test.name, got, test.want)
+		}
+	}
+}
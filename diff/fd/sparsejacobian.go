@@ -0,0 +1,200 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import (
+	"sort"
+
+	"gonum.org/v1/gonum/graph/coloring"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/mat"
+)
+
+// SparseJacobianSettings holds the options for SparseJacobian.
+type SparseJacobianSettings struct {
+	Formula     Formula
+	OriginValue []float64
+	Step        float64
+
+	// Groups is a partition of the columns of the
+	// Jacobian into structurally orthogonal groups
+	// as returned by ColumnGroups. If Groups is nil,
+	// it is computed from the sparsity pattern.
+	Groups [][]int
+}
+
+// ColumnGroups returns a partition of the columns of the sparsity pattern
+// into groups of structurally orthogonal columns, that is, columns that do
+// not have a stored element in a common row. The columns in each group are
+// sorted in increasing order.
+//
+// The partition is obtained from a coloring of the column intersection
+// graph of the pattern, in which two columns are adjacent if they share a
+// row, so the number of groups is the number of colors found by
+// coloring.Dsatur. For a pattern where each row has at most k stored
+// elements at least k groups are required.
+//
+// See T. F. Coleman and J. J. Moré, "Estimation of sparse Jacobian matrices
+// and graph coloring problems", SIAM J. Numer. Anal. 20(1), 1983, for details.
+func ColumnGroups(pattern *mat.CSR) [][]int {
+	r, c := pattern.Dims()
+	indptr, ind, _ := pattern.RawCSR()
+
+	g := simple.NewUndirectedGraph()
+	for j := 0; j < c; j++ {
+		g.AddNode(simple.Node(j))
+	}
+	for i := 0; i < r; i++ {
+		row := ind[indptr[i]:indptr[i+1]]
+		for a, u := range row {
+			for _, v := range row[a+1:] {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+	}
+
+	k, colors, err := coloring.Dsatur(g, nil)
+	if err != nil {
+		// Dsatur only fails for invalid partial colorings.
+		panic(err)
+	}
+	groups := make([][]int, k)
+	for id, col := range colors {
+		groups[col] = append(groups[col], int(id))
+	}
+	for _, grp := range groups {
+		sort.Ints(grp)
+	}
+	return groups
+}
+
+// SparseJacobian approximates the Jacobian matrix of a vector-valued function
+// f at the location x and stores the result in-place into dst. The stored
+// elements of dst define the sparsity pattern of the Jacobian; elements that
+// are not stored are assumed to be zero and the values of the stored elements
+// are overwritten.
+//
+// Columns of the Jacobian that do not share a row are estimated together
+// by perturbing x in all their directions at once, so the number of function
+// evaluations is proportional to the number of column groups rather than the
+// length of x. For a banded Jacobian with bandwidth w, only w+1 groups are
+// needed. If the Jacobian of f has non-zero elements outside the pattern,
+// the estimates of the stored elements will be wrong.
+//
+// Finite difference formula and other options are specified by settings. If
+// settings is nil, the Jacobian will be estimated using the Forward formula,
+// a default step size and a column grouping computed by ColumnGroups.
+//
+// dst must be non-nil, the number of its columns must equal the length of x, and
+// the derivative order of the formula must be 1, otherwise SparseJacobian will
+// panic. SparseJacobian will also panic if Groups in settings is not a
+// partition of the columns of dst.
+func SparseJacobian(dst *mat.CSR, f func(y, x []float64), x []float64, settings *SparseJacobianSettings) {
+	n := len(x)
+	if n == 0 {
+		panic("jacobian: x has zero length")
+	}
+	m, c := dst.Dims()
+	if c != n {
+		panic("jacobian: mismatched matrix size")
+	}
+
+	// Default settings.
+	formula := Forward
+	step := formula.Step
+	var (
+		originValue []float64
+		groups      [][]int
+	)
+
+	// Use user settings if provided.
+	if settings != nil {
+		if !settings.Formula.isZero() {
+			formula = settings.Formula
+			step = formula.Step
+			checkFormula(formula)
+			if formula.Derivative != 1 {
+				panic(badDerivOrder)
+			}
+		}
+		if settings.Step != 0 {
+			step = settings.Step
+		}
+		originValue = settings.OriginValue
+		if originValue != nil && len(originValue) != m {
+			panic("jacobian: mismatched OriginValue slice length")
+		}
+		groups = settings.Groups
+	}
+	if groups == nil {
+		groups = ColumnGroups(dst)
+	} else {
+		checkGroups(groups, n)
+	}
+
+	// Collect the rows and storage positions of the elements of each column.
+	indptr, ind, data := dst.RawCSR()
+	type element struct{ row, pos int }
+	cols := make([][]element, n)
+	for i := 0; i < m; i++ {
+		for k := indptr[i]; k < indptr[i+1]; k++ {
+			cols[ind[k]] = append(cols[ind[k]], element{row: i, pos: k})
+		}
+	}
+	for k := range data {
+		data[k] = 0
+	}
+
+	xcopy := make([]float64, n)
+	y := make([]float64, m)
+	for _, pt := range formula.Stencil {
+		if pt.Loc == 0 {
+			if originValue == nil {
+				originValue = make([]float64, m)
+				copy(xcopy, x)
+				f(originValue, xcopy)
+			}
+			for i := 0; i < m; i++ {
+				for k := indptr[i]; k < indptr[i+1]; k++ {
+					data[k] += pt.Coeff * originValue[i]
+				}
+			}
+			continue
+		}
+		for _, grp := range groups {
+			copy(xcopy, x)
+			for _, j := range grp {
+				xcopy[j] += pt.Loc * step
+			}
+			f(y, xcopy)
+			for _, j := range grp {
+				for _, e := range cols[j] {
+					data[e.pos] += pt.Coeff * y[e.row]
+				}
+			}
+		}
+	}
+	for k := range data {
+		data[k] /= step
+	}
+}
+
+// checkGroups panics if groups is not a partition of the n columns.
+func checkGroups(groups [][]int, n int) {
+	seen := make([]bool, n)
+	var count int
+	for _, grp := range groups {
+		for _, j := range grp {
+			if j < 0 || n <= j || seen[j] {
+				panic("jacobian: invalid column groups")
+			}
+			seen[j] = true
+			count++
+		}
+	}
+	if count != n {
+		panic("jacobian: invalid column groups")
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// broydenTridiagonal is the Broyden tridiagonal function which has a
+// tridiagonal Jacobian.
+func broydenTridiagonal(y, x []float64) {
+	n := len(x)
+	for i := range y {
+		y[i] = (3-2*x[i])*x[i] + 1
+		if i > 0 {
+			y[i] -= x[i-1]
+		}
+		if i < n-1 {
+			y[i] -= 2 * x[i+1]
+		}
+	}
+}
+
+func broydenTridiagonalJac(jac *mat.Dense, x []float64) {
+	n := len(x)
+	for i := 0; i < n; i++ {
+		jac.Set(i, i, 3-4*x[i])
+		if i > 0 {
+			jac.Set(i, i-1, -1)
+		}
+		if i < n-1 {
+			jac.Set(i, i+1, -2)
+		}
+	}
+}
+
+// tridiagonalPattern returns an n×n CSR with a tridiagonal structure.
+func tridiagonalPattern(n int) *mat.CSR {
+	var ii, jj []int
+	var v []float64
+	for i := 0; i < n; i++ {
+		for j := max(0, i-1); j <= min(n-1, i+1); j++ {
+			ii = append(ii, i)
+			jj = append(jj, j)
+			v = append(v, 1)
+		}
+	}
+	return mat.NewCSRFromTriplets(n, n, ii, jj, v)
+}
+
+func TestColumnGroups(t *testing.T) {
+	t.Parallel()
+	for _, n := range []int{1, 2, 3, 10, 50} {
+		pattern := tridiagonalPattern(n)
+		groups := ColumnGroups(pattern)
+		want := min(n, 3)
+		if len(groups) != want {
+			t.Errorf("unexpected number of groups for n=%d: got:%d want:%d", n, len(groups), want)
+		}
+		checkGroups(groups, n)
+
+		// Columns within a group must not share a row.
+		for _, grp := range groups {
+			for i := 0; i < n; i++ {
+				var count int
+				for _, j := range grp {
+					if pattern.At(i, j) != 0 {
+						count++
+					}
+				}
+				if count > 1 {
+					t.Errorf("group %v is not structurally orthogonal in row %d for n=%d", grp, i, n)
+				}
+			}
+		}
+	}
+}
+
+func TestSparseJacobian(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+
+	for _, n := range []int{1, 2, 5, 20} {
+		for _, test := range []struct {
+			name    string
+			formula Formula
+			tol     float64
+		}{
+			{name: "Forward", formula: Forward, tol: 1e-6},
+			{name: "Backward", formula: Backward, tol: 1e-6},
+			{name: "Central", formula: Central, tol: 1e-9},
+		} {
+			x := make([]float64, n)
+			for i := range x {
+				x[i] = rnd.NormFloat64()
+			}
+			want := mat.NewDense(n, n, nil)
+			broydenTridiagonalJac(want, x)
+
+			var evals int
+			f := func(y, x []float64) {
+				evals++
+				broydenTridiagonal(y, x)
+			}
+
+			got := tridiagonalPattern(n)
+			xcopy := make([]float64, n)
+			copy(xcopy, x)
+			SparseJacobian(got, f, x, &SparseJacobianSettings{Formula: test.formula})
+			if !mat.EqualApprox(got, want, test.tol) {
+				t.Errorf("unexpected Jacobian for n=%d %s:\ngot: %v\nwant: %v",
+					n, test.name, mat.Formatted(got, mat.Prefix("     ")), mat.Formatted(want, mat.Prefix("      ")))
+			}
+			for i := range x {
+				if x[i] != xcopy[i] {
+					t.Errorf("x modified for n=%d %s", n, test.name)
+					break
+				}
+			}
+
+			groups := min(n, 3)
+			wantEvals := groups * len(test.formula.Stencil)
+			for _, pt := range test.formula.Stencil {
+				if pt.Loc == 0 {
+					wantEvals -= groups - 1
+				}
+			}
+			if evals != wantEvals {
+				t.Errorf("unexpected number of evaluations for n=%d %s: got:%d want:%d", n, test.name, evals, wantEvals)
+			}
+
+			// Check that the origin value and precomputed groups are used.
+			origin := make([]float64, n)
+			broydenTridiagonal(origin, x)
+			evals = 0
+			got = tridiagonalPattern(n)
+			SparseJacobian(got, f, x, &SparseJacobianSettings{
+				Formula:     test.formula,
+				OriginValue: origin,
+				Groups:      ColumnGroups(got),
+			})
+			if !mat.EqualApprox(got, want, test.tol) {
+				t.Errorf("unexpected Jacobian with origin for n=%d %s:\ngot: %v\nwant: %v",
+					n, test.name, mat.Formatted(got, mat.Prefix("     ")), mat.Formatted(want, mat.Prefix("      ")))
+			}
+			if test.formula.Stencil[0].Loc == 0 && evals != wantEvals-1 {
+				t.Errorf("unexpected number of evaluations with origin for n=%d %s: got:%d want:%d", n, test.name, evals, wantEvals-1)
+			}
+		}
+	}
+
+	// A dense pattern requires one group per column and agrees with Jacobian.
+	x := []float64{0.3, -1.2, 2.1}
+	want := mat.NewDense(4, 3, nil)
+	Jacobian(want, vecFunc43, x, &JacobianSettings{Formula: Central})
+	var ii, jj []int
+	var v []float64
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 3; j++ {
+			ii = append(ii, i)
+			jj = append(jj, j)
+			v = append(v, 1)
+		}
+	}
+	got := mat.NewCSRFromTriplets(4, 3, ii, jj, v)
+	SparseJacobian(got, vecFunc43, x, &SparseJacobianSettings{Formula: Central})
+	if !mat.EqualApprox(got, want, 1e-12) {
+		t.Errorf("unexpected Jacobian for dense pattern:\ngot: %v\nwant: %v",
+			mat.Formatted(got, mat.Prefix("     ")), mat.Formatted(want, mat.Prefix("      ")))
+	}
+}
+
+func TestSparseJacobianPanics(t *testing.T) {
+	t.Parallel()
+	x := make([]float64, 3)
+	for _, test := range []struct {
+		name   string
+		groups [][]int
+	}{
+		{name: "missing column", groups: [][]int{{0}, {1}}},
+		{name: "repeated column", groups: [][]int{{0, 1}, {1, 2}}},
+		{name: "out of range", groups: [][]int{{0, 1}, {2, 3}}},
+	} {
+		if !panics(func() {
+			SparseJacobian(tridiagonalPattern(3), broydenTridiagonal, x, &SparseJacobianSettings{Groups: test.groups})
+		}) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+	if !panics(func() { SparseJacobian(tridiagonalPattern(4), broydenTridiagonal, x, nil) }) {
+		t.Errorf("expected panic for mismatched dimensions")
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		r := recover()
+		panicked = r != nil
+	}()
+	fn()
+	return
+}
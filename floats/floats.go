@@ -269,6 +269,21 @@ func EqualApprox(s1, s2 []float64, tol float64) bool {
 	return true
 }
 
+// EqualWithinULP returns true when the slices have equal lengths and
+// all element pairs are equal to within the specified number of floating
+// point units in the last place. See scalar.EqualWithinULP for details.
+func EqualWithinULP(s1, s2 []float64, ulp uint) bool {
+	if len(s1) != len(s2) {
+		return false
+	}
+	for i, a := range s1 {
+		if !scalar.EqualWithinULP(a, s2[i], ulp) {
+			return false
+		}
+	}
+	return true
+}
+
 // EqualFunc returns true when the slices have the same lengths
 // and the function returns true for all element pairs.
 func EqualFunc(s1, s2 []float64, f func(float64, float64) bool) bool {
@@ -413,6 +413,29 @@ func TestEqualApprox(t *testing.T) {
 	}
 }
 
+func TestEqualWithinULP(t *testing.T) {
+	t.Parallel()
+	s1 := []float64{1, -2, 0, 1e300}
+	s2 := []float64{
+		scalar.NextAfterN(1, 2, 3),
+		scalar.NextAfterN(-2, -3, 1),
+		math.Copysign(0, -1),
+		scalar.NextAfterN(1e300, 0, 4),
+	}
+	if !EqualWithinULP(s1, s2, 4) {
+		t.Errorf("Equal slices returned as unequal")
+	}
+	if EqualWithinULP(s1, s2, 3) {
+		t.Errorf("Unequal slices returned as equal")
+	}
+	if EqualWithinULP([]float64{math.NaN()}, []float64{math.NaN()}, 10) {
+		t.Errorf("NaN slices returned as equal")
+	}
+	if EqualWithinULP(s1, []float64{}, 4) {
+		t.Errorf("Unequal slice lengths returned as equal")
+	}
+}
+
 func TestEqualFunc(t *testing.T) {
 	t.Parallel()
 	s1 := []float64{1, 2, 3, 4}
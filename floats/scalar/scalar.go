@@ -54,10 +54,7 @@ func EqualWithinULP(a, b float64, ulp uint) bool {
 	if math.IsNaN(a) || math.IsNaN(b) {
 		return false
 	}
-	if math.Signbit(a) != math.Signbit(b) {
-		return math.Float64bits(math.Abs(a))+math.Float64bits(math.Abs(b)) <= uint64(ulp)
-	}
-	return ulpDiff(math.Float64bits(a), math.Float64bits(b)) <= uint64(ulp)
+	return ULPDistance(a, b) <= uint64(ulp)
 }
 
 func ulpDiff(a, b uint64) uint64 {
@@ -67,6 +64,97 @@ func ulpDiff(a, b uint64) uint64 {
 	return b - a
 }
 
+// ULPDistance returns the number of floating point units in the last
+// place between a and b, the number of steps of math.Nextafter needed
+// to reach b from a. Positive and negative zero are at a distance of
+// zero from each other. If a or b is NaN, ULPDistance returns
+// math.MaxUint64.
+func ULPDistance(a, b float64) uint64 {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return math.MaxUint64
+	}
+	if math.Signbit(a) != math.Signbit(b) {
+		return math.Float64bits(math.Abs(a)) + math.Float64bits(math.Abs(b))
+	}
+	return ulpDiff(math.Float64bits(a), math.Float64bits(b))
+}
+
+// NextAfterN returns the float64 value n representable values after x
+// in the direction of y. If y is fewer than n values from x, y is
+// returned. If x or y is NaN, NextAfterN returns NaN.
+func NextAfterN(x, y float64, n uint64) float64 {
+	if math.IsNaN(x) || math.IsNaN(y) {
+		return math.NaN()
+	}
+	if n >= ULPDistance(x, y) {
+		return y
+	}
+	if ordered(x) < ordered(y) {
+		return fromOrdered(ordered(x) + int64(n))
+	}
+	return fromOrdered(ordered(x) - int64(n))
+}
+
+// Midpoint returns the midpoint (a+b)/2 of a and b, computed so that it
+// does not overflow when a and b are large. The result lies in the
+// closed interval between a and b. If a or b is NaN, or a and b are
+// infinities of opposite sign, Midpoint returns NaN.
+func Midpoint(a, b float64) float64 {
+	if a == b {
+		return a
+	}
+	if math.IsInf(a, 0) || math.IsInf(b, 0) {
+		// The midpoint is the infinite end point, or NaN if
+		// the end points are infinities of opposite sign.
+		return a + b
+	}
+	if math.Signbit(a) != math.Signbit(b) {
+		// The sum cannot overflow.
+		return (a + b) / 2
+	}
+	// The difference cannot overflow.
+	m := a + (b-a)/2
+	// Guard against rounding outside the interval.
+	lo, hi := math.Min(a, b), math.Max(a, b)
+	return math.Max(lo, math.Min(m, hi))
+}
+
+// MidpointULP returns the value halfway between a and b in the ordering
+// of the float64 values, so that the number of representable values
+// between a and the result and between the result and b differ by at
+// most one. Repeatedly bisecting an interval with MidpointULP reduces it
+// to adjacent values in at most 64 steps regardless of the magnitudes of
+// its end points, unlike bisection with Midpoint, which may require more
+// than a thousand steps for an interval containing zero. If a or b is
+// NaN, MidpointULP returns NaN.
+func MidpointULP(a, b float64) float64 {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return math.NaN()
+	}
+	oa, ob := ordered(a), ordered(b)
+	// Compute the floor of the mean without overflow.
+	return fromOrdered(oa>>1 + ob>>1 + oa&ob&1)
+}
+
+// ordered returns an integer with the same ordering as the float64 x,
+// with positive and negative zero both mapped to zero and adjacent
+// float64 values mapped to adjacent integers. x must not be NaN.
+func ordered(x float64) int64 {
+	b := int64(math.Float64bits(x))
+	if b < 0 {
+		return -(b & math.MaxInt64)
+	}
+	return b
+}
+
+// fromOrdered is the inverse of ordered, returning positive zero for zero.
+func fromOrdered(o int64) float64 {
+	if o < 0 {
+		return math.Float64frombits(uint64(-o) | 1<<63)
+	}
+	return math.Float64frombits(uint64(o))
+}
+
 const (
 	nanBits = 0x7ff8000000000000
 	nanMask = 0xfff8000000000000
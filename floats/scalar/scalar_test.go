@@ -107,16 +107,16 @@ func TestEqualsRelative(t *testing.T) {
 
 func TestEqualsULP(t *testing.T) {
 	t.Parallel()
-	if f := 67329.242; !EqualWithinULP(f, nextAfterN(f, math.Inf(1), 10), 10) {
+	if f := 67329.242; !EqualWithinULP(f, NextAfterN(f, math.Inf(1), 10), 10) {
 		t.Errorf("Equal values returned as unequal")
 	}
-	if f := 67329.242; EqualWithinULP(f, nextAfterN(f, math.Inf(1), 5), 1) {
+	if f := 67329.242; EqualWithinULP(f, NextAfterN(f, math.Inf(1), 5), 1) {
 		t.Errorf("Unequal values returned as equal")
 	}
-	if f := 67329.242; EqualWithinULP(nextAfterN(f, math.Inf(1), 5), f, 1) {
+	if f := 67329.242; EqualWithinULP(NextAfterN(f, math.Inf(1), 5), f, 1) {
 		t.Errorf("Unequal values returned as equal")
 	}
-	if f := nextAfterN(0, math.Inf(1), 2); !EqualWithinULP(f, nextAfterN(f, math.Inf(-1), 5), 10) {
+	if f := NextAfterN(0, math.Inf(1), 2); !EqualWithinULP(f, NextAfterN(f, math.Inf(-1), 5), 10) {
 		t.Errorf("Equal values returned as unequal")
 	}
 	if !EqualWithinULP(67329.242, 67329.242, 10) {
@@ -127,11 +127,142 @@ func TestEqualsULP(t *testing.T) {
 	}
 }
 
-func nextAfterN(x, y float64, n int) float64 {
-	for i := 0; i < n; i++ {
-		x = math.Nextafter(x, y)
+func TestULPDistance(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		a, b float64
+		want uint64
+	}{
+		{a: 1, b: 1, want: 0},
+		{a: 0, b: math.Copysign(0, -1), want: 0},
+		{a: 1, b: math.Nextafter(1, 2), want: 1},
+		{a: math.Nextafter(1, 2), b: 1, want: 1},
+		{a: 1, b: math.Nextafter(1, 0), want: 1},
+		{a: 0, b: math.SmallestNonzeroFloat64, want: 1},
+		{a: -math.SmallestNonzeroFloat64, b: math.SmallestNonzeroFloat64, want: 2},
+		{a: 1, b: 2, want: 1 << 52},
+		{a: 2, b: 4, want: 1 << 52},
+		{a: math.MaxFloat64, b: math.Inf(1), want: 1},
+		{a: math.Inf(-1), b: math.Inf(1), want: 2 * 0x7ff0000000000000},
+		{a: math.NaN(), b: 1, want: math.MaxUint64},
+		{a: 1, b: math.NaN(), want: math.MaxUint64},
+	} {
+		if got := ULPDistance(test.a, test.b); got != test.want {
+			t.Errorf("unexpected ULP distance between %v and %v: got %d, want %d", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestNextAfterN(t *testing.T) {
+	t.Parallel()
+	for _, x := range []float64{0, math.Copysign(0, -1), 1, -1, 67329.242, -1e-310, math.MaxFloat64} {
+		for _, y := range []float64{math.Inf(1), math.Inf(-1)} {
+			for _, n := range []uint64{0, 1, 2, 10} {
+				want := x
+				for i := uint64(0); i < n; i++ {
+					want = math.Nextafter(want, y)
+				}
+				got := NextAfterN(x, y, n)
+				if got != want {
+					t.Errorf("unexpected NextAfterN(%v, %v, %d): got %v, want %v", x, y, n, got, want)
+				}
+			}
+		}
+	}
+	if got := NextAfterN(1, 2, 1<<60); got != 2 {
+		t.Errorf("expected NextAfterN to stop at y, got %v", got)
+	}
+	if got := NextAfterN(math.NaN(), 2, 1); !math.IsNaN(got) {
+		t.Errorf("expected NaN for NaN input, got %v", got)
+	}
+}
+
+func TestMidpoint(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		a, b float64
+		want float64
+	}{
+		{a: 1, b: 3, want: 2},
+		{a: 3, b: 1, want: 2},
+		{a: -1, b: 1, want: 0},
+		{a: math.MaxFloat64, b: math.MaxFloat64 / 2, want: 0.75 * math.MaxFloat64},
+		{a: -math.MaxFloat64, b: math.MaxFloat64, want: 0},
+		{a: math.Inf(1), b: math.Inf(1), want: math.Inf(1)},
+		{a: 1, b: math.Inf(1), want: math.Inf(1)},
+		{a: math.Inf(1), b: 1, want: math.Inf(1)},
+		{a: -1, b: math.Inf(1), want: math.Inf(1)},
+		{a: math.Inf(1), b: -1, want: math.Inf(1)},
+		{a: 1, b: math.Inf(-1), want: math.Inf(-1)},
+		{a: math.Inf(-1), b: 1, want: math.Inf(-1)},
+		{a: 1, b: math.Nextafter(1, 2), want: 1},
+		{a: math.SmallestNonzeroFloat64, b: 3 * math.SmallestNonzeroFloat64, want: 2 * math.SmallestNonzeroFloat64},
+	} {
+		if got := Midpoint(test.a, test.b); got != test.want {
+			t.Errorf("unexpected midpoint of %v and %v: got %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+	for _, test := range [][2]float64{
+		{math.Inf(-1), math.Inf(1)},
+		{math.Inf(1), math.Inf(-1)},
+		{math.NaN(), 1},
+		{1, math.NaN()},
+		{math.NaN(), math.Inf(1)},
+	} {
+		if got := Midpoint(test[0], test[1]); !math.IsNaN(got) {
+			t.Errorf("expected NaN for midpoint of %v and %v, got %v", test[0], test[1], got)
+		}
+	}
+}
+
+func TestMidpointULP(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		a, b float64
+	}{
+		{a: 0, b: 1},
+		{a: 1, b: 2},
+		{a: -1, b: 1},
+		{a: 1e-300, b: 1e300},
+		{a: math.Inf(-1), b: math.Inf(1)},
+		{a: 1, b: math.Nextafter(1, 2)},
+	} {
+		for _, ab := range [][2]float64{{test.a, test.b}, {test.b, test.a}} {
+			a, b := ab[0], ab[1]
+			m := MidpointULP(a, b)
+			if m < math.Min(a, b) || math.Max(a, b) < m {
+				t.Errorf("midpoint of %v and %v outside interval: %v", a, b, m)
+				continue
+			}
+			da, db := ULPDistance(a, m), ULPDistance(m, b)
+			if ulpDiff(da, db) > 1 {
+				t.Errorf("unbalanced midpoint of %v and %v: %v with distances %d and %d", a, b, m, da, db)
+			}
+		}
+	}
+
+	// Bisection for the smallest x with x*x >= 2 terminates in at most
+	// 64 steps from any bracket.
+	lo, hi := 0.0, math.MaxFloat64
+	var steps int
+	for ULPDistance(lo, hi) > 1 {
+		m := MidpointULP(lo, hi)
+		if m*m >= 2 {
+			hi = m
+		} else {
+			lo = m
+		}
+		steps++
+	}
+	if steps > 64 {
+		t.Errorf("bisection took too many steps: %d", steps)
+	}
+	if hi != math.Sqrt(2) && lo != math.Sqrt(2) {
+		t.Errorf("unexpected bisection result: [%v, %v], want to contain %v", lo, hi, math.Sqrt(2))
+	}
+	if got := MidpointULP(math.NaN(), 1); !math.IsNaN(got) {
+		t.Errorf("expected NaN for NaN input, got %v", got)
 	}
-	return x
 }
 
 func TestNaNWith(t *testing.T) {
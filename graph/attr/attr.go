@@ -0,0 +1,419 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package attr
+
+import (
+	"fmt"
+	"strconv"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+)
+
+// Definition is an attribute definition in the schema of a Store. It is
+// implemented by Attribute.
+type Definition interface {
+	// Name returns the name of the attribute.
+	Name() string
+
+	format(v any) string
+	parse(s string) (any, error)
+}
+
+// Attribute is a typed attribute key. The name of an attribute is used as
+// the key of its encoded form.
+type Attribute[T any] struct {
+	name    string
+	marshal func(T) string
+	parser  func(string) (T, error)
+}
+
+// New returns an attribute with the given name that is converted to text
+// for encoding with marshal and from text with parse.
+func New[T any](name string, marshal func(T) string, parse func(string) (T, error)) Attribute[T] {
+	return Attribute[T]{name: name, marshal: marshal, parser: parse}
+}
+
+// String returns a string attribute with the given name.
+func String(name string) Attribute[string] {
+	return New(name,
+		func(v string) string { return v },
+		func(s string) (string, error) { return s, nil },
+	)
+}
+
+// Int returns an integer attribute with the given name.
+func Int(name string) Attribute[int64] {
+	return New(name,
+		func(v int64) string { return strconv.FormatInt(v, 10) },
+		func(s string) (int64, error) { return strconv.ParseInt(s, 10, 64) },
+	)
+}
+
+// Float returns a floating point attribute with the given name.
+func Float(name string) Attribute[float64] {
+	return New(name,
+		func(v float64) string { return strconv.FormatFloat(v, 'g', -1, 64) },
+		func(s string) (float64, error) { return strconv.ParseFloat(s, 64) },
+	)
+}
+
+// Bool returns a boolean attribute with the given name.
+func Bool(name string) Attribute[bool] {
+	return New(name, strconv.FormatBool, strconv.ParseBool)
+}
+
+// Name returns the name of the attribute.
+func (a Attribute[T]) Name() string { return a.name }
+
+func (a Attribute[T]) format(v any) string { return a.marshal(v.(T)) }
+
+func (a Attribute[T]) parse(s string) (any, error) { return a.parser(s) }
+
+// values holds the attribute values of a node, edge or line.
+type values map[string]any
+
+// edgeKey is the key of an edge in a Store.
+type edgeKey struct {
+	from, to int64
+}
+
+// Store holds attribute values for the nodes, edges and lines of a graph.
+// The attributes that may be held are given by the schema of the Store.
+//
+// Edges are identified by the IDs of their end points, and lines by their
+// line IDs. For undirected graphs the order of the end points of an edge is
+// not significant.
+type Store struct {
+	directed bool
+	schema   []Definition
+	index    map[string]int
+
+	nodes map[int64]values
+	edges map[edgeKey]values
+	lines map[int64]values
+}
+
+// NewStore returns a new Store with a schema holding the given attribute
+// definitions. If directed is false, edges are undirected. NewStore panics
+// if more than one definition has the same name.
+func NewStore(directed bool, schema ...Definition) *Store {
+	s := &Store{
+		directed: directed,
+		schema:   append([]Definition(nil), schema...),
+		index:    make(map[string]int, len(schema)),
+
+		nodes: make(map[int64]values),
+		edges: make(map[edgeKey]values),
+		lines: make(map[int64]values),
+	}
+	for i, d := range schema {
+		if _, exists := s.index[d.Name()]; exists {
+			panic(fmt.Sprintf("attr: duplicate attribute %q", d.Name()))
+		}
+		s.index[d.Name()] = i
+	}
+	return s
+}
+
+// Schema returns the attribute definitions of the store in the order they
+// were given to NewStore.
+func (s *Store) Schema() []Definition {
+	return append([]Definition(nil), s.schema...)
+}
+
+// edgeKey returns the key for the edge between uid and vid.
+func (s *Store) edgeKey(uid, vid int64) edgeKey {
+	if !s.directed && vid < uid {
+		uid, vid = vid, uid
+	}
+	return edgeKey{from: uid, to: vid}
+}
+
+// check panics if a is not in the schema of the store with type T.
+func check[T any](s *Store, a Attribute[T]) {
+	i, ok := s.index[a.name]
+	if !ok {
+		panic(fmt.Sprintf("attr: attribute %q not in schema", a.name))
+	}
+	if _, ok := s.schema[i].(Attribute[T]); !ok {
+		panic(fmt.Sprintf("attr: attribute %q has type %T in schema", a.name, s.schema[i]))
+	}
+}
+
+// get returns the value of a in m.
+func get[T any](m values, a Attribute[T]) (v T, ok bool) {
+	u, ok := m[a.name]
+	if !ok {
+		return v, false
+	}
+	return u.(T), true
+}
+
+// NodeValue returns the value of the attribute a of the node with the given
+// ID and whether it is set. NodeValue panics if a is not in the schema of s.
+func NodeValue[T any](s *Store, id int64, a Attribute[T]) (v T, ok bool) {
+	check(s, a)
+	return get(s.nodes[id], a)
+}
+
+// SetNodeValue sets the value of the attribute a of the node with the given
+// ID. SetNodeValue panics if a is not in the schema of s.
+func SetNodeValue[T any](s *Store, id int64, a Attribute[T], v T) {
+	check(s, a)
+	setIn(s.nodes, id, a.name, v)
+}
+
+// EdgeValue returns the value of the attribute a of the edge from uid to vid
+// and whether it is set. EdgeValue panics if a is not in the schema of s.
+func EdgeValue[T any](s *Store, uid, vid int64, a Attribute[T]) (v T, ok bool) {
+	check(s, a)
+	return get(s.edges[s.edgeKey(uid, vid)], a)
+}
+
+// SetEdgeValue sets the value of the attribute a of the edge from uid to vid.
+// SetEdgeValue panics if a is not in the schema of s.
+func SetEdgeValue[T any](s *Store, uid, vid int64, a Attribute[T], v T) {
+	check(s, a)
+	setIn(s.edges, s.edgeKey(uid, vid), a.name, v)
+}
+
+// LineValue returns the value of the attribute a of the line with the given
+// ID and whether it is set. LineValue panics if a is not in the schema of s.
+func LineValue[T any](s *Store, id int64, a Attribute[T]) (v T, ok bool) {
+	check(s, a)
+	return get(s.lines[id], a)
+}
+
+// SetLineValue sets the value of the attribute a of the line with the given
+// ID. SetLineValue panics if a is not in the schema of s.
+func SetLineValue[T any](s *Store, id int64, a Attribute[T], v T) {
+	check(s, a)
+	setIn(s.lines, id, a.name, v)
+}
+
+// setIn sets the value of the named attribute of the element with the
+// given key in m.
+func setIn[K comparable](m map[K]values, key K, name string, v any) {
+	vals, ok := m[key]
+	if !ok {
+		vals = make(values)
+		m[key] = vals
+	}
+	vals[name] = v
+}
+
+// UnsetNode removes the value of the named attribute from the node with the
+// given ID.
+func (s *Store) UnsetNode(id int64, name string) {
+	unsetIn(s.nodes, id, name)
+}
+
+// UnsetEdge removes the value of the named attribute from the edge from uid
+// to vid.
+func (s *Store) UnsetEdge(uid, vid int64, name string) {
+	unsetIn(s.edges, s.edgeKey(uid, vid), name)
+}
+
+// UnsetLine removes the value of the named attribute from the line with the
+// given ID.
+func (s *Store) UnsetLine(id int64, name string) {
+	unsetIn(s.lines, id, name)
+}
+
+// unsetIn removes the named attribute of the element with the given key
+// in m.
+func unsetIn[K comparable](m map[K]values, key K, name string) {
+	vals, ok := m[key]
+	if !ok {
+		return
+	}
+	delete(vals, name)
+	if len(vals) == 0 {
+		delete(m, key)
+	}
+}
+
+// RemoveNode removes the attributes of the node with the given ID and of
+// the edges incident to it. Attributes of lines are not removed since the
+// store does not hold their end points; use RemoveLine for each line of
+// the node.
+func (s *Store) RemoveNode(id int64) {
+	delete(s.nodes, id)
+	for k := range s.edges {
+		if k.from == id || k.to == id {
+			delete(s.edges, k)
+		}
+	}
+}
+
+// RemoveEdge removes the attributes of the edge from uid to vid.
+func (s *Store) RemoveEdge(uid, vid int64) {
+	delete(s.edges, s.edgeKey(uid, vid))
+}
+
+// RemoveLine removes the attributes of the line with the given ID.
+func (s *Store) RemoveLine(id int64) {
+	delete(s.lines, id)
+}
+
+// attributes returns the encoded attributes in vals in schema order.
+func (s *Store) attributes(vals values) []encoding.Attribute {
+	if len(vals) == 0 {
+		return nil
+	}
+	attrs := make([]encoding.Attribute, 0, len(vals))
+	for _, d := range s.schema {
+		v, ok := vals[d.Name()]
+		if !ok {
+			continue
+		}
+		attrs = append(attrs, encoding.Attribute{Key: d.Name(), Value: d.format(v)})
+	}
+	return attrs
+}
+
+// setAttribute sets the encoded attribute in the element with the given
+// key in m. An empty value removes the attribute.
+func setAttribute[K comparable](s *Store, m map[K]values, key K, attr encoding.Attribute) error {
+	i, ok := s.index[attr.Key]
+	if !ok {
+		return fmt.Errorf("attr: attribute %q not in schema", attr.Key)
+	}
+	if attr.Value == "" {
+		unsetIn(m, key, attr.Key)
+		return nil
+	}
+	v, err := s.schema[i].parse(attr.Value)
+	if err != nil {
+		return fmt.Errorf("attr: invalid value for attribute %q: %w", attr.Key, err)
+	}
+	setIn(m, key, attr.Key, v)
+	return nil
+}
+
+// Node returns n associated with the store.
+func (s *Store) Node(n graph.Node) Node {
+	if n, ok := n.(Node); ok && n.Store == s {
+		return n
+	}
+	return Node{Node: n, Store: s}
+}
+
+// Edge returns e associated with the store.
+func (s *Store) Edge(e graph.Edge) Edge {
+	if e, ok := e.(Edge); ok && e.Store == s {
+		return e
+	}
+	return Edge{Edge: e, Store: s}
+}
+
+// Line returns l associated with the store.
+func (s *Store) Line(l graph.Line) Line {
+	if l, ok := l.(Line); ok && l.Store == s {
+		return l
+	}
+	return Line{Line: l, Store: s}
+}
+
+// Node is a graph.Node with attributes held in a Store.
+type Node struct {
+	graph.Node
+	Store *Store
+}
+
+// Attributes returns the encoded attributes of the node in schema order.
+func (n Node) Attributes() []encoding.Attribute {
+	return n.Store.attributes(n.Store.nodes[n.ID()])
+}
+
+// SetAttribute sets the encoded attribute of the node. An empty value
+// removes the attribute. SetAttribute returns an error if the attribute
+// is not in the schema of the store or its value cannot be parsed.
+func (n Node) SetAttribute(attr encoding.Attribute) error {
+	return setAttribute(n.Store, n.Store.nodes, n.ID(), attr)
+}
+
+// Edge is a graph.Edge with attributes held in a Store.
+type Edge struct {
+	graph.Edge
+	Store *Store
+}
+
+// ReversedEdge returns the reversal of the edge, sharing its store.
+func (e Edge) ReversedEdge() graph.Edge {
+	return Edge{Edge: e.Edge.ReversedEdge(), Store: e.Store}
+}
+
+// Attributes returns the encoded attributes of the edge in schema order.
+func (e Edge) Attributes() []encoding.Attribute {
+	return e.Store.attributes(e.Store.edges[e.Store.edgeKey(e.From().ID(), e.To().ID())])
+}
+
+// SetAttribute sets the encoded attribute of the edge. An empty value
+// removes the attribute. SetAttribute returns an error if the attribute
+// is not in the schema of the store or its value cannot be parsed.
+func (e Edge) SetAttribute(attr encoding.Attribute) error {
+	return setAttribute(e.Store, e.Store.edges, e.Store.edgeKey(e.From().ID(), e.To().ID()), attr)
+}
+
+// Line is a graph.Line with attributes held in a Store.
+type Line struct {
+	graph.Line
+	Store *Store
+}
+
+// ReversedLine returns the reversal of the line, sharing its store.
+func (l Line) ReversedLine() graph.Line {
+	return Line{Line: l.Line.ReversedLine(), Store: l.Store}
+}
+
+// Attributes returns the encoded attributes of the line in schema order.
+func (l Line) Attributes() []encoding.Attribute {
+	return l.Store.attributes(l.Store.lines[l.ID()])
+}
+
+// SetAttribute sets the encoded attribute of the line. An empty value
+// removes the attribute. SetAttribute returns an error if the attribute
+// is not in the schema of the store or its value cannot be parsed.
+func (l Line) SetAttribute(attr encoding.Attribute) error {
+	return setAttribute(l.Store, l.Store.lines, l.ID(), attr)
+}
+
+// Builder is an encoding.Builder whose new nodes and edges hold their
+// attributes in a Store. It can be used as the destination of a graph
+// decoder.
+type Builder struct {
+	encoding.Builder
+	Store *Store
+}
+
+// NewNode returns a new node associated with the store.
+func (b Builder) NewNode() graph.Node {
+	return b.Store.Node(b.Builder.NewNode())
+}
+
+// NewEdge returns a new edge associated with the store.
+func (b Builder) NewEdge(from, to graph.Node) graph.Edge {
+	return b.Store.Edge(b.Builder.NewEdge(from, to))
+}
+
+// MultiBuilder is an encoding.MultiBuilder whose new nodes and lines hold
+// their attributes in a Store. It can be used as the destination of a graph
+// decoder.
+type MultiBuilder struct {
+	encoding.MultiBuilder
+	Store *Store
+}
+
+// NewNode returns a new node associated with the store.
+func (b MultiBuilder) NewNode() graph.Node {
+	return b.Store.Node(b.MultiBuilder.NewNode())
+}
+
+// NewLine returns a new line associated with the store.
+func (b MultiBuilder) NewLine(from, to graph.Node) graph.Line {
+	return b.Store.Line(b.MultiBuilder.NewLine(from, to))
+}
@@ -0,0 +1,219 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package attr
+
+import (
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/encoding"
+	"gonum.org/v1/gonum/graph/encoding/dot"
+	"gonum.org/v1/gonum/graph/multi"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var (
+	label  = String("label")
+	weight = Float("weight")
+	rank   = Int("rank")
+	seen   = Bool("seen")
+)
+
+func TestStoreValues(t *testing.T) {
+	for _, directed := range []bool{true, false} {
+		s := NewStore(directed, label, weight, rank, seen)
+
+		SetNodeValue(s, 1, label, "one")
+		SetNodeValue(s, 1, rank, 3)
+		if v, ok := NodeValue(s, 1, label); !ok || v != "one" {
+			t.Errorf("directed=%t: unexpected node label: got:%q,%t", directed, v, ok)
+		}
+		if v, ok := NodeValue(s, 1, rank); !ok || v != 3 {
+			t.Errorf("directed=%t: unexpected node rank: got:%d,%t", directed, v, ok)
+		}
+		if _, ok := NodeValue(s, 2, label); ok {
+			t.Errorf("directed=%t: unexpected label for unset node", directed)
+		}
+
+		SetEdgeValue(s, 1, 2, weight, 0.5)
+		if v, ok := EdgeValue(s, 1, 2, weight); !ok || v != 0.5 {
+			t.Errorf("directed=%t: unexpected edge weight: got:%v,%t", directed, v, ok)
+		}
+		if _, ok := EdgeValue(s, 2, 1, weight); ok == directed {
+			t.Errorf("directed=%t: unexpected presence of reversed edge weight: %t", directed, ok)
+		}
+
+		SetLineValue(s, 7, seen, true)
+		if v, ok := LineValue(s, 7, seen); !ok || !v {
+			t.Errorf("directed=%t: unexpected line value: got:%t,%t", directed, v, ok)
+		}
+
+		s.UnsetNode(1, "label")
+		if _, ok := NodeValue(s, 1, label); ok {
+			t.Errorf("directed=%t: unexpected label after unset", directed)
+		}
+		if _, ok := NodeValue(s, 1, rank); !ok {
+			t.Errorf("directed=%t: rank removed by unsetting label", directed)
+		}
+
+		SetEdgeValue(s, 3, 4, weight, 2)
+		s.RemoveNode(2)
+		if _, ok := EdgeValue(s, 1, 2, weight); ok {
+			t.Errorf("directed=%t: unexpected edge weight after node removal", directed)
+		}
+		if _, ok := EdgeValue(s, 3, 4, weight); !ok {
+			t.Errorf("directed=%t: edge weight of unrelated edge removed", directed)
+		}
+		s.RemoveLine(7)
+		if _, ok := LineValue(s, 7, seen); ok {
+			t.Errorf("directed=%t: unexpected line value after removal", directed)
+		}
+	}
+}
+
+func TestStoreSchema(t *testing.T) {
+	s := NewStore(true, label, weight)
+	if got := len(s.Schema()); got != 2 {
+		t.Errorf("unexpected schema length: got:%d want:2", got)
+	}
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "missing", fn: func() { SetNodeValue(s, 0, rank, 1) }},
+		{name: "type mismatch", fn: func() { SetNodeValue(s, 0, Int("label"), 1) }},
+		{name: "duplicate", fn: func() { NewStore(true, label, String("label")) }},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("%s: expected panic", test.name)
+		}
+	}
+
+	n := s.Node(simple.Node(0))
+	if err := n.SetAttribute(encoding.Attribute{Key: "rank", Value: "1"}); err == nil {
+		t.Error("expected error for attribute not in schema")
+	}
+	if err := n.SetAttribute(encoding.Attribute{Key: "weight", Value: "heavy"}); err == nil {
+		t.Error("expected error for invalid attribute value")
+	}
+	if err := n.SetAttribute(encoding.Attribute{Key: "weight", Value: "1.5"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if v, _ := NodeValue(s, 0, weight); v != 1.5 {
+		t.Errorf("unexpected weight: got:%v want:1.5", v)
+	}
+	if err := n.SetAttribute(encoding.Attribute{Key: "weight", Value: ""}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, ok := NodeValue(s, 0, weight); ok {
+		t.Error("unexpected weight after setting empty value")
+	}
+}
+
+func TestSimpleGraphRoundTrip(t *testing.T) {
+	s := NewStore(false, label, weight)
+	g := simple.NewUndirectedGraph()
+	a := s.Node(simple.Node(0))
+	b := s.Node(simple.Node(1))
+	c := s.Node(simple.Node(2))
+	g.AddNode(a)
+	g.AddNode(b)
+	g.AddNode(c)
+	g.SetEdge(s.Edge(simple.Edge{F: a, T: b}))
+	g.SetEdge(s.Edge(simple.Edge{F: c, T: b}))
+	SetNodeValue(s, 0, label, "first node")
+	SetNodeValue(s, 2, weight, 2)
+	SetNodeValue(s, 2, label, "third")
+	SetEdgeValue(s, 1, 2, weight, 0.25)
+
+	// Attributes are accessible from the edges returned by the graph in
+	// either direction.
+	for _, e := range []graph.Edge{g.Edge(1, 2), g.Edge(2, 1)} {
+		want := []encoding.Attribute{{Key: "weight", Value: "0.25"}}
+		if got := e.(encoding.Attributer).Attributes(); !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected edge attributes: got:%v want:%v", got, want)
+		}
+	}
+
+	data, err := dot.Marshal(g, "", "", "\t")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	const want = `strict graph {
+	// Node definitions.
+	0 [label="first node"];
+	1;
+	2 [
+		label=third
+		weight=2
+	];
+
+	// Edge definitions.
+	0 -- 1;
+	1 -- 2 [weight=0.25];
+}`
+	if string(data) != want {
+		t.Errorf("unexpected encoding:\ngot:\n%s\nwant:\n%s", data, want)
+	}
+
+	dst := NewStore(false, label, weight)
+	err = dot.Unmarshal(data, Builder{Builder: simple.NewUndirectedGraph(), Store: dst})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(dst.nodes, s.nodes) {
+		t.Errorf("unexpected decoded node attributes: got:%v want:%v", dst.nodes, s.nodes)
+	}
+	if !reflect.DeepEqual(dst.edges, s.edges) {
+		t.Errorf("unexpected decoded edge attributes: got:%v want:%v", dst.edges, s.edges)
+	}
+}
+
+func TestMultigraphRoundTrip(t *testing.T) {
+	const src = `digraph {
+	// Node definitions.
+	0;
+	1;
+
+	// Edge definitions.
+	0 -> 1 [weight=1];
+	0 -> 1 [weight=2.5];
+}`
+	s := NewStore(true, weight)
+	g := multi.NewDirectedGraph()
+	err := dot.UnmarshalMulti([]byte(src), MultiBuilder{MultiBuilder: g, Store: s})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var sum float64
+	lines := g.Lines(0, 1)
+	for lines.Next() {
+		v, ok := LineValue(s, lines.Line().ID(), weight)
+		if !ok {
+			t.Errorf("missing weight for line %d", lines.Line().ID())
+		}
+		sum += v
+	}
+	if sum != 3.5 {
+		t.Errorf("unexpected sum of line weights: got:%v want:3.5", sum)
+	}
+
+	data, err := dot.MarshalMulti(g, "", "", "\t")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != src {
+		t.Errorf("unexpected encoding:\ngot:\n%s\nwant:\n%s", data, src)
+	}
+}
+
+func panics(fn func()) (ok bool) {
+	defer func() {
+		ok = recover() != nil
+	}()
+	fn()
+	return
+}
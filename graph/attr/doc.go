@@ -0,0 +1,16 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package attr provides typed attribute storage for the nodes and edges of
+// a graph.
+//
+// A Store holds values for a fixed schema of typed Attribute keys, indexed
+// by node ID, by edge end point IDs and by line ID, so that per-node and
+// per-edge data does not need to be kept in parallel maps. The Node, Edge
+// and Line types wrap graph values to associate them with a Store. They can
+// be held by the graph types in gonum.org/v1/gonum/graph/simple and
+// gonum.org/v1/gonum/graph/multi, and implement the encoding.Attributer and
+// encoding.AttributeSetter interfaces used by the graph encoders, with values
+// converted to and from text by the attribute definitions in the schema.
+package attr // import "gonum.org/v1/gonum/graph/attr"
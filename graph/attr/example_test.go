@@ -0,0 +1,51 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package attr_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/graph/attr"
+	"gonum.org/v1/gonum/graph/encoding/dot"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func ExampleStore() {
+	var (
+		name     = attr.String("name")
+		capacity = attr.Float("capacity")
+	)
+	s := attr.NewStore(true, name, capacity)
+
+	g := simple.NewDirectedGraph()
+	src := s.Node(simple.Node(0))
+	dst := s.Node(simple.Node(1))
+	g.SetEdge(s.Edge(simple.Edge{F: src, T: dst}))
+
+	attr.SetNodeValue(s, src.ID(), name, "source")
+	attr.SetNodeValue(s, dst.ID(), name, "sink")
+	attr.SetEdgeValue(s, src.ID(), dst.ID(), capacity, 12.5)
+
+	c, _ := attr.EdgeValue(s, 0, 1, capacity)
+	fmt.Println("capacity:", c)
+
+	b, err := dot.Marshal(g, "", "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%s\n", b)
+
+	// Output:
+	// capacity: 12.5
+	// strict digraph {
+	//   // Node definitions.
+	//   0 [name=source];
+	//   1 [name=sink];
+	//
+	//   // Edge definitions.
+	//   0 -> 1 [capacity=12.5];
+	// }
+}
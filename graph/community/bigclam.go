@@ -0,0 +1,380 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package community
+
+import (
+	"math"
+	"sort"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// Affiliations holds the overlapping community affiliation strengths of
+// the nodes of a graph. Each node has a non-negative affiliation strength
+// with each of the k communities, and a node may be a strong member of
+// more than one community.
+type Affiliations struct {
+	nodes []graph.Node
+	index map[int64]int
+
+	k int
+	// f is the n×k row-major matrix
+	// of affiliation strengths.
+	f []float64
+
+	// threshold is the default
+	// membership threshold.
+	threshold float64
+}
+
+// K returns the number of communities.
+func (a *Affiliations) K() int { return a.k }
+
+// Nodes returns the nodes of the graph that was used to
+// construct the affiliations, sorted by ID.
+func (a *Affiliations) Nodes() []graph.Node {
+	return append([]graph.Node(nil), a.nodes...)
+}
+
+// Membership returns the affiliation strengths of the node with the given ID
+// with each of the k communities. If dst is not nil, it must have length k
+// and the strengths are stored into it. Membership will panic if the node
+// is not in the graph used to construct the affiliations.
+func (a *Affiliations) Membership(id int64, dst []float64) []float64 {
+	i, ok := a.index[id]
+	if !ok {
+		panic("community: node not in affiliations")
+	}
+	if dst == nil {
+		dst = make([]float64, a.k)
+	}
+	if len(dst) != a.k {
+		panic("community: slice length mismatch")
+	}
+	copy(dst, a.f[i*a.k:(i+1)*a.k])
+	return dst
+}
+
+// Threshold returns the default membership threshold used by Communities.
+// It is the affiliation strength at which the probability of an edge
+// between two nodes sharing a single community equals the background edge
+// density of the graph, ε, so
+//
+//	δ = sqrt(-log(1 - ε)).
+func (a *Affiliations) Threshold() float64 { return a.threshold }
+
+// Communities returns the k communities, each containing the nodes with an
+// affiliation strength for the community of at least threshold. If threshold
+// is not positive, the value returned by Threshold is used. Nodes may appear
+// in more than one community or in none, and communities may be empty. The
+// nodes within each community are sorted by ID.
+func (a *Affiliations) Communities(threshold float64) [][]graph.Node {
+	if threshold <= 0 {
+		threshold = a.threshold
+	}
+	communities := make([][]graph.Node, a.k)
+	for i, n := range a.nodes {
+		for c, v := range a.f[i*a.k : (i+1)*a.k] {
+			if v >= threshold {
+				communities[c] = append(communities[c], n)
+			}
+		}
+	}
+	return communities
+}
+
+// BigCLAM returns the overlapping community affiliations of the nodes in the
+// undirected graph g into k communities using the Cluster Affiliation Model
+// for Big Networks. The model assigns each node u a vector of non-negative
+// affiliation strengths F_u and generates an edge between u and v with
+// probability
+//
+//	P(u, v) = 1 - exp(-F_u · F_v).
+//
+// The strengths are fitted by maximizing the log-likelihood of g with block
+// coordinate ascent, starting from the neighborhoods of nodes with locally
+// minimal conductance. Iteration stops when the relative change in the
+// log-likelihood falls below 1e-4.
+//
+// If src is nil, rand.Intn is used as the random generator for seeding
+// communities when fewer than k locally minimal neighborhoods exist.
+// BigCLAM will panic if k is less than 1.
+//
+// BigCLAM is described in Yang and Leskovec doi:10.1145/2433396.2433471.
+func BigCLAM(g graph.Undirected, k int, src rand.Source) *Affiliations {
+	if k < 1 {
+		panic("community: invalid number of communities")
+	}
+	var intn func(int) int
+	if src == nil {
+		intn = rand.Intn
+	} else {
+		intn = rand.New(src).Intn
+	}
+
+	nodes := graph.NodesOf(g.Nodes())
+	order.ByID(nodes)
+	n := len(nodes)
+	index := make(map[int64]int, n)
+	for i, u := range nodes {
+		index[u.ID()] = i
+	}
+	adj := make([][]int, n)
+	var m int
+	for i, u := range nodes {
+		uid := u.ID()
+		to := g.From(uid)
+		for to.Next() {
+			v := index[to.Node().ID()]
+			if v != i {
+				adj[i] = append(adj[i], v)
+			}
+		}
+		sort.Ints(adj[i])
+		m += len(adj[i])
+	}
+	m /= 2
+
+	a := &Affiliations{
+		nodes: nodes,
+		index: index,
+		k:     k,
+		f:     make([]float64, n*k),
+	}
+	if m == 0 {
+		a.threshold = math.Inf(1)
+		return a
+	}
+	eps := 2 * float64(m) / (float64(n) * float64(n-1))
+	a.threshold = math.Sqrt(-math.Log(1 - math.Min(eps, bigClamMaxP)))
+
+	bigClamSeed(a.f, adj, k, m, intn)
+
+	sum := make([]float64, k)
+	for i := 0; i < n; i++ {
+		for c, v := range a.f[i*k : (i+1)*k] {
+			sum[c] += v
+		}
+	}
+
+	var (
+		grad = make([]float64, k)
+		old  = make([]float64, k)
+		rest = make([]float64, k)
+	)
+	prev := bigClamLikelihood(a.f, adj, k, sum)
+	for iter := 0; iter < bigClamMaxIter; iter++ {
+		for u := 0; u < n; u++ {
+			fu := a.f[u*k : (u+1)*k]
+			copy(old, fu)
+
+			// Compute the gradient of the row log-likelihood
+			// and the sum of affiliations of the nodes that
+			// are not adjacent to u.
+			for c := range grad {
+				grad[c] = 0
+				rest[c] = sum[c] - fu[c]
+			}
+			for _, v := range adj[u] {
+				fv := a.f[v*k : (v+1)*k]
+				p := bigClamProb(dot(fu, fv))
+				w := p / (1 - p)
+				for c, x := range fv {
+					grad[c] += w * x
+					rest[c] -= x
+				}
+			}
+			for c := range grad {
+				grad[c] -= rest[c]
+			}
+
+			// Take a projected gradient step with
+			// backtracking line search.
+			lu := bigClamRowLikelihood(a.f, adj[u], k, fu, rest)
+			for step, try := 1.0, 0; try < bigClamMaxStep; step, try = step*bigClamBeta, try+1 {
+				var ascent float64
+				for c := range fu {
+					fu[c] = math.Min(math.Max(old[c]+step*grad[c], 0), bigClamMaxF)
+					ascent += grad[c] * (fu[c] - old[c])
+				}
+				if ascent <= 0 {
+					copy(fu, old)
+					break
+				}
+				if bigClamRowLikelihood(a.f, adj[u], k, fu, rest) >= lu+bigClamAlpha*ascent {
+					break
+				}
+				copy(fu, old)
+			}
+			for c := range sum {
+				sum[c] += fu[c] - old[c]
+			}
+		}
+
+		l := bigClamLikelihood(a.f, adj, k, sum)
+		if math.Abs(l-prev) <= bigClamTol*math.Abs(prev) {
+			break
+		}
+		prev = l
+	}
+
+	return a
+}
+
+const (
+	// bigClamMinP and bigClamMaxP bound the probability of
+	// no edge between two nodes to avoid infinite terms in
+	// the log-likelihood and its gradient.
+	bigClamMinP = 1e-4
+	bigClamMaxP = 1 - 1e-4
+
+	// bigClamMaxF is the upper bound of an
+	// affiliation strength.
+	bigClamMaxF = 1000
+
+	// Line search parameters.
+	bigClamAlpha   = 0.05
+	bigClamBeta    = 0.3
+	bigClamMaxStep = 10
+
+	bigClamMaxIter = 1000
+	bigClamTol     = 1e-4
+)
+
+// bigClamProb returns the probability of no edge between two
+// nodes with the given affiliation dot product, exp(-x),
+// bounded to [bigClamMinP, bigClamMaxP].
+func bigClamProb(x float64) float64 {
+	return math.Min(math.Max(math.Exp(-x), bigClamMinP), bigClamMaxP)
+}
+
+// bigClamRowLikelihood returns the log-likelihood of the edges of a node with
+// the neighbors adj when its affiliation strengths are fu. rest is the sum of
+// the affiliation strengths of the nodes not adjacent to the node.
+func bigClamRowLikelihood(f []float64, adj []int, k int, fu, rest []float64) float64 {
+	var l float64
+	for _, v := range adj {
+		l += math.Log(1 - bigClamProb(dot(fu, f[v*k:(v+1)*k])))
+	}
+	return l - dot(fu, rest)
+}
+
+// bigClamLikelihood returns the log-likelihood of the graph.
+func bigClamLikelihood(f []float64, adj [][]int, k int, sum []float64) float64 {
+	var l float64
+	for u := range adj {
+		fu := f[u*k : (u+1)*k]
+		var nbr float64
+		for _, v := range adj[u] {
+			fv := f[v*k : (v+1)*k]
+			x := dot(fu, fv)
+			l += math.Log(1 - bigClamProb(x))
+			nbr += x
+		}
+		l -= dot(fu, sum) - dot(fu, fu) - nbr
+	}
+	return l
+}
+
+// bigClamSeed initializes the affiliation strengths f using the
+// neighborhoods of nodes with locally minimal conductance. If there
+// are fewer than k such nodes, the remaining communities are seeded
+// from the neighborhoods of randomly chosen nodes.
+func bigClamSeed(f []float64, adj [][]int, k, m int, intn func(int) int) {
+	n := len(adj)
+	inNbr := make([]bool, n)
+	phi := make([]float64, n)
+	for u := range adj {
+		// Conductance of the neighborhood of u
+		// including u itself.
+		inNbr[u] = true
+		vol := len(adj[u])
+		for _, v := range adj[u] {
+			inNbr[v] = true
+			vol += len(adj[v])
+		}
+		cut := bigClamCut(adj, u, inNbr)
+		for _, v := range adj[u] {
+			cut += bigClamCut(adj, v, inNbr)
+		}
+		inNbr[u] = false
+		for _, v := range adj[u] {
+			inNbr[v] = false
+		}
+		den := math.Min(float64(vol), float64(2*m-vol))
+		if den == 0 {
+			phi[u] = 1
+		} else {
+			phi[u] = float64(cut) / den
+		}
+	}
+
+	var seeds []int
+	for u := range adj {
+		if len(adj[u]) == 0 {
+			continue
+		}
+		min := true
+		for _, v := range adj[u] {
+			if phi[v] < phi[u] {
+				min = false
+				break
+			}
+		}
+		if min {
+			seeds = append(seeds, u)
+		}
+	}
+	sort.SliceStable(seeds, func(i, j int) bool { return phi[seeds[i]] < phi[seeds[j]] })
+
+	// Seeds within the neighborhood of an
+	// earlier seed are skipped to avoid
+	// duplicate communities.
+	covered := inNbr
+	for c := 0; c < k; c++ {
+		u := -1
+		for len(seeds) != 0 {
+			s := seeds[0]
+			seeds = seeds[1:]
+			if !covered[s] {
+				u = s
+				break
+			}
+		}
+		if u < 0 {
+			u = intn(n)
+		}
+		covered[u] = true
+		f[u*k+c] = 1
+		for _, v := range adj[u] {
+			covered[v] = true
+			f[v*k+c] = 1
+		}
+	}
+}
+
+// bigClamCut returns the number of edges from u
+// to nodes that are not marked in in.
+func bigClamCut(adj [][]int, u int, in []bool) int {
+	var cut int
+	for _, v := range adj[u] {
+		if !in[v] {
+			cut++
+		}
+	}
+	return cut
+}
+
+// dot returns the dot product of a and b.
+func dot(a, b []float64) float64 {
+	var s float64
+	for i, v := range a {
+		s += v * b[i]
+	}
+	return s
+}
@@ -0,0 +1,165 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package community
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// cliques returns an undirected graph formed from the union
+// of cliques over the given sets of node IDs.
+func cliques(sets ...[]int64) *simple.UndirectedGraph {
+	g := simple.NewUndirectedGraph()
+	for _, s := range sets {
+		for i, u := range s {
+			if g.Node(u) == nil {
+				g.AddNode(simple.Node(u))
+			}
+			for _, v := range s[i+1:] {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+	}
+	return g
+}
+
+func idRange(first, last int64) []int64 {
+	ids := make([]int64, 0, last-first+1)
+	for id := first; id <= last; id++ {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+var bigCLAMTests = []struct {
+	name string
+	sets [][]int64
+	k    int
+}{
+	{
+		name: "disjoint",
+		sets: [][]int64{idRange(0, 7), idRange(8, 15)},
+		k:    2,
+	},
+	{
+		name: "overlapping",
+		sets: [][]int64{idRange(0, 9), idRange(7, 16)},
+		k:    2,
+	},
+	{
+		name: "three overlapping",
+		sets: [][]int64{idRange(0, 9), idRange(7, 16), append(idRange(14, 21), 0, 1)},
+		k:    3,
+	},
+}
+
+func TestBigCLAM(t *testing.T) {
+	t.Parallel()
+	for _, test := range bigCLAMTests {
+		g := cliques(test.sets...)
+		a := BigCLAM(g, test.k, rand.NewSource(1))
+		if a.K() != test.k {
+			t.Errorf("unexpected number of communities for %q: got:%d want:%d", test.name, a.K(), test.k)
+		}
+		if got := a.Nodes(); len(got) != g.Nodes().Len() {
+			t.Errorf("unexpected number of nodes for %q: got:%d want:%d", test.name, len(got), g.Nodes().Len())
+		}
+
+		got := communityIDs(a.Communities(0))
+		want := make([][]int64, len(test.sets))
+		for i, s := range test.sets {
+			want[i] = append([]int64(nil), s...)
+			slices.Sort(want[i])
+		}
+		order.BySliceValues(want)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected communities for %q:\ngot: %v\nwant:%v", test.name, got, want)
+		}
+
+		for id := 0; id < g.Nodes().Len(); id++ {
+			m := a.Membership(int64(id), nil)
+			var n int
+			for _, s := range test.sets {
+				for _, v := range s {
+					if v == int64(id) {
+						n++
+					}
+				}
+			}
+			var strong int
+			for _, v := range m {
+				if v < 0 {
+					t.Errorf("negative affiliation for node %d in %q: %v", id, test.name, m)
+				}
+				if v >= a.Threshold() {
+					strong++
+				}
+			}
+			if strong != n {
+				t.Errorf("unexpected number of memberships for node %d in %q: got:%d want:%d", id, test.name, strong, n)
+			}
+		}
+	}
+}
+
+func TestBigCLAMEdgeCases(t *testing.T) {
+	t.Parallel()
+
+	g := simple.NewUndirectedGraph()
+	for id := int64(0); id < 4; id++ {
+		g.AddNode(simple.Node(id))
+	}
+	a := BigCLAM(g, 2, nil)
+	for _, c := range a.Communities(0) {
+		if len(c) != 0 {
+			t.Errorf("unexpected community in edgeless graph: %v", c)
+		}
+	}
+
+	if !panics(func() { BigCLAM(g, 0, nil) }) {
+		t.Errorf("expected panic for k=0")
+	}
+	if !panics(func() { a.Membership(10, nil) }) {
+		t.Errorf("expected panic for missing node")
+	}
+	if !panics(func() { a.Membership(0, make([]float64, 3)) }) {
+		t.Errorf("expected panic for bad dst length")
+	}
+}
+
+// communityIDs returns the IDs of the nodes in the non-empty
+// communities, sorted within and across communities.
+func communityIDs(communities [][]graph.Node) [][]int64 {
+	var ids [][]int64
+	for _, c := range communities {
+		if len(c) == 0 {
+			continue
+		}
+		s := make([]int64, len(c))
+		for i, n := range c {
+			s[i] = n.ID()
+		}
+		slices.Sort(s)
+		ids = append(ids, s)
+	}
+	order.BySliceValues(ids)
+	return ids
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
@@ -40,10 +40,42 @@ type PortSetter interface {
 	SetToPort(port, compass string) error
 }
 
+// SubgraphAdder is implemented by encoding.Builder values that can record
+// the subgraph statements of a DOT graph.
+type SubgraphAdder interface {
+	// AddDOTSubgraph returns a builder for the subgraph with the given
+	// DOT ID, which may be empty for anonymous subgraphs. Nodes and edges
+	// declared within the subgraph are added to the receiver and are also
+	// added to the returned builder using the same node and edge values.
+	// If the returned builder implements AttributeSetters, the attribute
+	// statements of the subgraph are applied to it rather than to the
+	// receiver. Subgraphs nested within the subgraph are added to the
+	// returned builder if it is a SubgraphAdder.
+	//
+	// A DOT subgraph ID may appear in more than one statement, so
+	// AddDOTSubgraph should return the existing builder for a previously
+	// seen non-empty ID. If AddDOTSubgraph returns nil, the subgraph is
+	// not recorded.
+	AddDOTSubgraph(id string) encoding.Builder
+}
+
+// MultiSubgraphAdder is implemented by encoding.MultiBuilder values that can
+// record the subgraph statements of a DOT graph. See SubgraphAdder for the
+// semantics of AddDOTSubgraph.
+type MultiSubgraphAdder interface {
+	AddDOTSubgraph(id string) encoding.MultiBuilder
+}
+
 // Unmarshal parses the Graphviz DOT-encoded data and stores the result in dst.
 // If the number of graphs encoded in data is not one, an error is returned and
 // dst will hold the first graph in data.
 //
+// Subgraph statements are recorded if dst implements SubgraphAdder.
+//
+// Bare ID=ID statements, such as rankdir=LR, are set as graph attributes if
+// dst implements AttributeSetters; earlier versions of this package ignored
+// them.
+//
 // Attributes and IDs are unquoted during unmarshalling if appropriate.
 func Unmarshal(data []byte, dst encoding.Builder) error {
 	file, err := dot.ParseBytes(data)
@@ -62,6 +94,12 @@ func Unmarshal(data []byte, dst encoding.Builder) error {
 // If the number of graphs encoded in data is not one, an error is returned and
 // dst will hold the first graph in data.
 //
+// Subgraph statements are recorded if dst implements MultiSubgraphAdder.
+//
+// Bare ID=ID statements, such as rankdir=LR, are set as graph attributes if
+// dst implements AttributeSetters; earlier versions of this package ignored
+// them.
+//
 // Attributes and IDs are unquoted during unmarshalling if appropriate.
 func UnmarshalMulti(data []byte, dst encoding.MultiBuilder) error {
 	file, err := dot.ParseBytes(data)
@@ -148,8 +186,68 @@ type generator struct {
 	// Stack of start indices into the subgraph node slice. The top element
 	// corresponds to the start index of the active (or inner-most) subgraph.
 	subStart []int
-	// graphAttr, nodeAttr and edgeAttr are global graph attributes.
+	// graphAttr, nodeAttr and edgeAttr are global graph attributes of the
+	// active graph or subgraph.
 	graphAttr, nodeAttr, edgeAttr encoding.AttributeSetter
+	// inherited indicates that the active subgraph statement has no
+	// attribute setters of its own, so its attribute statements are
+	// applied to the enclosing graph.
+	inherited bool
+	// Stack of attribute scopes of the graphs enclosing the active
+	// subgraph statement.
+	scopes []attrScope
+}
+
+// attrScope holds the global attribute setters of a graph or subgraph.
+type attrScope struct {
+	graphAttr, nodeAttr, edgeAttr encoding.AttributeSetter
+	inherited                     bool
+}
+
+// pushScope enters the attribute scope of a subgraph statement recorded by
+// the builder sub, which may be nil. If sub implements AttributeSetters the
+// attribute statements of the subgraph are applied to sub. Otherwise node,
+// edge and graph attribute statements continue to apply to the enclosing
+// graph and bare ID=ID statements are ignored.
+func (gen *generator) pushScope(sub interface{}) {
+	gen.scopes = append(gen.scopes, attrScope{
+		graphAttr: gen.graphAttr,
+		nodeAttr:  gen.nodeAttr,
+		edgeAttr:  gen.edgeAttr,
+		inherited: gen.inherited,
+	})
+	a, ok := sub.(AttributeSetters)
+	if !ok {
+		gen.inherited = true
+		return
+	}
+	gen.inherited = false
+	gen.graphAttr, gen.nodeAttr, gen.edgeAttr = a.DOTAttributeSetters()
+}
+
+// popScope returns to the attribute scope enclosing the active subgraph
+// statement.
+func (gen *generator) popScope() {
+	s := gen.scopes[len(gen.scopes)-1]
+	gen.graphAttr, gen.nodeAttr, gen.edgeAttr = s.graphAttr, s.nodeAttr, s.edgeAttr
+	gen.inherited = s.inherited
+	gen.scopes = gen.scopes[:len(gen.scopes)-1]
+}
+
+// addGraphAttr sets the graph attribute of a bare attribute statement,
+// such as rankdir=LR, on the active graph or subgraph. Bare attributes of
+// subgraphs without their own attribute setters are ignored.
+func (gen *generator) addGraphAttr(attr *ast.Attr) {
+	if gen.inherited || gen.graphAttr == nil {
+		return
+	}
+	a := encoding.Attribute{
+		Key:   unquoteID(attr.Key),
+		Value: unquoteID(attr.Val),
+	}
+	if err := gen.graphAttr.SetAttribute(a); err != nil {
+		panic(fmt.Errorf("unable to unmarshal global graph DOT attribute (%s=%s): %v", a.Key, a.Value, err))
+	}
 }
 
 // node returns the Gonum node corresponding to the given dot AST node ID,
@@ -174,7 +272,57 @@ func (gen *generator) node(dst graph.NodeAdder, id string) graph.Node {
 	return n
 }
 
-type simpleGraph struct{ generator }
+type simpleGraph struct {
+	generator
+	// Stack of builders of the subgraph statements enclosing the
+	// active statement.
+	subgraphs []encoding.Builder
+}
+
+// node returns the Gonum node corresponding to the given dot AST node ID,
+// generating a new such node if none exist, and adds it to the subgraphs
+// being built.
+func (gen *simpleGraph) node(dst encoding.Builder, id string) graph.Node {
+	n := gen.generator.node(dst, id)
+	for _, s := range gen.subgraphs {
+		if s.Node(n.ID()) == nil {
+			s.AddNode(n)
+		}
+	}
+	return n
+}
+
+// setEdge sets the edge in the graph and in the subgraphs being built.
+func (gen *simpleGraph) setEdge(dst encoding.Builder, e graph.Edge) {
+	dst.SetEdge(e)
+	for _, s := range gen.subgraphs {
+		s.SetEdge(e)
+	}
+}
+
+// addSubgraph adds the statements of the given subgraph statement to the
+// graph, recording them in a subgraph builder if one is provided.
+func (gen *simpleGraph) addSubgraph(dst encoding.Builder, sub *ast.Subgraph) {
+	var parent interface{} = dst
+	if len(gen.subgraphs) != 0 {
+		parent = gen.subgraphs[len(gen.subgraphs)-1]
+	}
+	var b encoding.Builder
+	if s, ok := parent.(SubgraphAdder); ok {
+		b = s.AddDOTSubgraph(unquoteID(sub.ID))
+	}
+	gen.pushScope(b)
+	if b != nil {
+		gen.subgraphs = append(gen.subgraphs, b)
+	}
+	for _, stmt := range sub.Stmts {
+		gen.addStmt(dst, stmt)
+	}
+	if b != nil {
+		gen.subgraphs = gen.subgraphs[:len(gen.subgraphs)-1]
+	}
+	gen.popScope()
+}
 
 // addStmt adds the given statement to the graph.
 func (gen *simpleGraph) addStmt(dst encoding.Builder, stmt ast.Stmt) {
@@ -230,11 +378,9 @@ func (gen *simpleGraph) addStmt(dst encoding.Builder, stmt ast.Stmt) {
 			}
 		}
 	case *ast.Attr:
-		// ignore.
+		gen.addGraphAttr(stmt)
 	case *ast.Subgraph:
-		for _, stmt := range stmt.Stmts {
-			gen.addStmt(dst, stmt)
-		}
+		gen.addSubgraph(dst, stmt)
 	default:
 		panic(fmt.Sprintf("unknown statement type %T", stmt))
 	}
@@ -288,7 +434,7 @@ func (gen *simpleGraph) addEdgeStmt(dst encoding.Builder, stmt *ast.EdgeStmt) {
 	for _, f := range fs {
 		for _, t := range ts {
 			edge := dst.NewEdge(f, t)
-			dst.SetEdge(edge)
+			gen.setEdge(dst, edge)
 			applyPortsToEdge(stmt.From, stmt.To, edge)
 			addEdgeAttrs(edge, stmt.Attrs)
 		}
@@ -323,7 +469,7 @@ func (gen *simpleGraph) addEdge(dst encoding.Builder, to *ast.Edge, attrs []*ast
 		for _, f := range fs {
 			for _, t := range ts {
 				edge := dst.NewEdge(f, t)
-				dst.SetEdge(edge)
+				gen.setEdge(dst, edge)
 				applyPortsToEdge(to.Vertex, to.To, edge)
 				addEdgeAttrs(edge, attrs)
 			}
@@ -383,7 +529,57 @@ func (gen *generator) appendSubgraphNode(n graph.Node) {
 	gen.subNodes = append(gen.subNodes, n)
 }
 
-type multiGraph struct{ generator }
+type multiGraph struct {
+	generator
+	// Stack of builders of the subgraph statements enclosing the
+	// active statement.
+	subgraphs []encoding.MultiBuilder
+}
+
+// node returns the Gonum node corresponding to the given dot AST node ID,
+// generating a new such node if none exist, and adds it to the subgraphs
+// being built.
+func (gen *multiGraph) node(dst encoding.MultiBuilder, id string) graph.Node {
+	n := gen.generator.node(dst, id)
+	for _, s := range gen.subgraphs {
+		if s.Node(n.ID()) == nil {
+			s.AddNode(n)
+		}
+	}
+	return n
+}
+
+// setLine sets the line in the multigraph and in the subgraphs being built.
+func (gen *multiGraph) setLine(dst encoding.MultiBuilder, l graph.Line) {
+	dst.SetLine(l)
+	for _, s := range gen.subgraphs {
+		s.SetLine(l)
+	}
+}
+
+// addSubgraph adds the statements of the given subgraph statement to the
+// multigraph, recording them in a subgraph builder if one is provided.
+func (gen *multiGraph) addSubgraph(dst encoding.MultiBuilder, sub *ast.Subgraph) {
+	var parent interface{} = dst
+	if len(gen.subgraphs) != 0 {
+		parent = gen.subgraphs[len(gen.subgraphs)-1]
+	}
+	var b encoding.MultiBuilder
+	if s, ok := parent.(MultiSubgraphAdder); ok {
+		b = s.AddDOTSubgraph(unquoteID(sub.ID))
+	}
+	gen.pushScope(b)
+	if b != nil {
+		gen.subgraphs = append(gen.subgraphs, b)
+	}
+	for _, stmt := range sub.Stmts {
+		gen.addStmt(dst, stmt)
+	}
+	if b != nil {
+		gen.subgraphs = gen.subgraphs[:len(gen.subgraphs)-1]
+	}
+	gen.popScope()
+}
 
 // addStmt adds the given statement to the multigraph.
 func (gen *multiGraph) addStmt(dst encoding.MultiBuilder, stmt ast.Stmt) {
@@ -439,11 +635,9 @@ func (gen *multiGraph) addStmt(dst encoding.MultiBuilder, stmt ast.Stmt) {
 			}
 		}
 	case *ast.Attr:
-		// ignore.
+		gen.addGraphAttr(stmt)
 	case *ast.Subgraph:
-		for _, stmt := range stmt.Stmts {
-			gen.addStmt(dst, stmt)
-		}
+		gen.addSubgraph(dst, stmt)
 	default:
 		panic(fmt.Sprintf("unknown statement type %T", stmt))
 	}
@@ -456,7 +650,7 @@ func (gen *multiGraph) addEdgeStmt(dst encoding.MultiBuilder, stmt *ast.EdgeStmt
 	for _, f := range fs {
 		for _, t := range ts {
 			edge := dst.NewLine(f, t)
-			dst.SetLine(edge)
+			gen.setLine(dst, edge)
 			applyPortsToEdge(stmt.From, stmt.To, edge)
 			addEdgeAttrs(edge, stmt.Attrs)
 		}
@@ -491,7 +685,7 @@ func (gen *multiGraph) addLine(dst encoding.MultiBuilder, to *ast.Edge, attrs []
 		for _, f := range fs {
 			for _, t := range ts {
 				edge := dst.NewLine(f, t)
-				dst.SetLine(edge)
+				gen.setLine(dst, edge)
 				applyPortsToEdge(to.Vertex, to.To, edge)
 				addEdgeAttrs(edge, attrs)
 			}
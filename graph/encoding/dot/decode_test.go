@@ -6,6 +6,8 @@ package dot
 
 import (
 	"fmt"
+	"reflect"
+	"sort"
 	"testing"
 
 	"gonum.org/v1/gonum/graph"
@@ -51,6 +53,22 @@ func TestRoundTrip(t *testing.T) {
 			want:     undirectedAttrs,
 			directed: false,
 		},
+		{
+			want:     directedSubgraphs,
+			directed: true,
+		},
+		{
+			want:     directedCompassPorts,
+			directed: true,
+		},
+		{
+			want:     undirectedCompassPorts,
+			directed: false,
+		},
+		{
+			want:     directedHTML,
+			directed: true,
+		},
 	}
 	for i, g := range golden {
 		var dst encoding.Builder
@@ -213,6 +231,309 @@ const undirectedAttrs = `strict graph {
 	A -- B [label="hello world"];
 }`
 
+const directedSubgraphs = `strict digraph G {
+	graph [
+		rankdir=LR
+	];
+
+	subgraph cluster_0 {
+		graph [
+			label="Cluster A"
+		];
+		node [
+			shape=box
+		];
+
+		// Node definitions.
+		A [label=a];
+		B;
+
+		// Edge definitions.
+		A -> B;
+	}
+	subgraph cluster_1 {
+		graph [
+			label=<<b>Cluster</b> B>
+		];
+
+		subgraph inner {
+			graph [
+				rank=same
+			];
+
+			// Node definitions.
+			C;
+			D;
+		}
+		// Node definitions.
+		C;
+		D;
+	}
+	// Node definitions.
+	A [label=a];
+	B;
+	C;
+	D;
+
+	// Edge definitions.
+	A -> B;
+	B -> C;
+}`
+
+const directedCompassPorts = `strict digraph {
+	// Node definitions.
+	A;
+	B;
+	C;
+
+	// Edge definitions.
+	A:"n" -> B:s;
+	A:_ -> C:_:ne;
+	B:"port 1":c -> C:sw;
+	C:"e" -> A:"nw";
+}`
+
+const undirectedCompassPorts = `strict graph {
+	// Node definitions.
+	A;
+	B;
+
+	// Edge definitions.
+	A:"w" -- B:w;
+}`
+
+const directedHTML = `strict digraph {
+	graph [
+		label=<<table border="0">
+	<tr><td port="p">"x" &amp; y</td></tr>
+</table>>
+	];
+
+	// Node definitions.
+	<<b>html id</b>>;
+	A [label="<not HTML>"];
+	B [label=<a<br/>b>];
+
+	// Edge definitions.
+	<<b>html id</b>> -> A;
+	A -> B [label=<<i>&lt;i&gt;</i>>];
+}`
+
+func TestSubgraphDecoding(t *testing.T) {
+	const src = `digraph {
+	subgraph cluster_0 {
+		label=outer;
+		node [shape=box];
+		A -> B;
+		subgraph cluster_1 {
+			color=red;
+			B -> C;
+		}
+	}
+	subgraph cluster_0 {
+		D;
+	}
+	{
+		rank=same;
+		E;
+	}
+	F -> {G H};
+}`
+	dst := newDotDirectedGraph()
+	err := Unmarshal([]byte(src), dst)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := dst.Nodes().Len(); got != 8 {
+		t.Errorf("unexpected number of nodes in graph: got:%d want:8", got)
+	}
+	if got := dst.node.Attributes(); len(got) != 0 {
+		t.Errorf("unexpected global node attributes: %v", got)
+	}
+	if len(dst.subgraphs) != 2 {
+		t.Fatalf("unexpected number of subgraphs: got:%d want:2", len(dst.subgraphs))
+	}
+
+	for _, test := range []struct {
+		g     *dotDirectedGraph
+		id    string
+		nodes []string
+		edges int
+		graph []encoding.Attribute
+		node  []encoding.Attribute
+	}{
+		{
+			g:     dst.subgraphs[0],
+			id:    "cluster_0",
+			nodes: []string{"A", "B", "C", "D"},
+			edges: 2,
+			graph: []encoding.Attribute{{Key: "label", Value: "outer"}},
+			node:  []encoding.Attribute{{Key: "shape", Value: "box"}},
+		},
+		{
+			g:     dst.subgraphs[1],
+			id:    "",
+			nodes: []string{"E"},
+			graph: []encoding.Attribute{{Key: "rank", Value: "same"}},
+		},
+		{
+			g:     dst.subgraphs[0].subgraphs[0],
+			id:    "cluster_1",
+			nodes: []string{"B", "C"},
+			edges: 1,
+			graph: []encoding.Attribute{{Key: "color", Value: "red"}},
+		},
+	} {
+		if test.g.DOTID() != test.id {
+			t.Errorf("unexpected subgraph ID: got:%q want:%q", test.g.DOTID(), test.id)
+		}
+		var nodes []string
+		for _, n := range graph.NodesOf(test.g.Nodes()) {
+			nodes = append(nodes, n.(*dotNode).DOTID())
+		}
+		sort.Strings(nodes)
+		if !reflect.DeepEqual(nodes, test.nodes) {
+			t.Errorf("unexpected nodes in subgraph %q: got:%v want:%v", test.id, nodes, test.nodes)
+		}
+		if got := test.g.Edges().Len(); got != test.edges {
+			t.Errorf("unexpected number of edges in subgraph %q: got:%d want:%d", test.id, got, test.edges)
+		}
+		if got := test.g.graph.Attributes(); !reflect.DeepEqual(got, test.graph) {
+			t.Errorf("unexpected graph attributes in subgraph %q: got:%v want:%v", test.id, got, test.graph)
+		}
+		if got := test.g.node.Attributes(); len(got) != 0 || len(test.node) != 0 {
+			if !reflect.DeepEqual(got, test.node) {
+				t.Errorf("unexpected node attributes in subgraph %q: got:%v want:%v", test.id, got, test.node)
+			}
+		}
+	}
+}
+
+func TestMultigraphSubgraphDecoding(t *testing.T) {
+	const src = `digraph {
+	subgraph cluster_0 {
+		label=lines;
+		A -> B;
+		A -> B;
+	}
+	A -> B;
+}`
+	dst := newDotDirectedMultigraph()
+	err := UnmarshalMulti([]byte(src), dst)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := dst.Edges().Len(); got != 1 {
+		t.Errorf("unexpected number of edges in graph: got:%d want:1", got)
+	}
+	if got := graph.LinesOf(dst.Lines(0, 1)); len(got) != 3 {
+		t.Errorf("unexpected number of lines in graph: got:%d want:3", len(got))
+	}
+	if len(dst.subgraphs) != 1 {
+		t.Fatalf("unexpected number of subgraphs: got:%d want:1", len(dst.subgraphs))
+	}
+	sub := dst.subgraphs[0]
+	if sub.id != "cluster_0" {
+		t.Errorf("unexpected subgraph ID: got:%q want:%q", sub.id, "cluster_0")
+	}
+	if got := graph.LinesOf(sub.Lines(0, 1)); len(got) != 2 {
+		t.Errorf("unexpected number of lines in subgraph: got:%d want:2", len(got))
+	}
+	want := []encoding.Attribute{{Key: "label", Value: "lines"}}
+	if got := sub.graph.Attributes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected subgraph attributes: got:%v want:%v", got, want)
+	}
+	if got := dst.graph.Attributes(); len(got) != 0 {
+		t.Errorf("unexpected graph attributes: %v", got)
+	}
+}
+
+func TestSubgraphAttributeScope(t *testing.T) {
+	const src = `digraph {
+	rankdir=LR;
+	subgraph cluster_0 {
+		label=ignored;
+		node [shape=box];
+		edge [color=red];
+		A -> B;
+	}
+}`
+	dst := &plainSubgraphDirectedGraph{dotDirectedGraph: newDotDirectedGraph()}
+	err := Unmarshal([]byte(src), dst)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(dst.plain) != 1 {
+		t.Fatalf("unexpected number of subgraphs: got:%d want:1", len(dst.plain))
+	}
+	if got := dst.plain[0].Nodes().Len(); got != 2 {
+		t.Errorf("unexpected number of nodes in subgraph: got:%d want:2", got)
+	}
+
+	// Bare attributes of the top-level graph are set as graph attributes,
+	// while those of a subgraph without attribute setters are ignored.
+	want := []encoding.Attribute{{Key: "rankdir", Value: "LR"}}
+	if got := dst.graph.Attributes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected graph attributes: got:%v want:%v", got, want)
+	}
+	// Node and edge attribute statements of a subgraph without attribute
+	// setters are applied to the enclosing graph.
+	want = []encoding.Attribute{{Key: "shape", Value: "box"}}
+	if got := dst.node.Attributes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected global node attributes: got:%v want:%v", got, want)
+	}
+	want = []encoding.Attribute{{Key: "color", Value: "red"}}
+	if got := dst.edge.Attributes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected global edge attributes: got:%v want:%v", got, want)
+	}
+}
+
+// plainSubgraphDirectedGraph records DOT subgraphs with builders that do
+// not implement dot.AttributeSetters.
+type plainSubgraphDirectedGraph struct {
+	*dotDirectedGraph
+	plain []*simple.DirectedGraph
+}
+
+// AddDOTSubgraph implements the dot.SubgraphAdder interface.
+func (g *plainSubgraphDirectedGraph) AddDOTSubgraph(id string) encoding.Builder {
+	s := simple.NewDirectedGraph()
+	g.plain = append(g.plain, s)
+	return s
+}
+
+// dotDirectedMultigraph extends multi.DirectedGraph to record DOT
+// subgraphs and graph attributes.
+type dotDirectedMultigraph struct {
+	*multi.DirectedGraph
+	id                string
+	graph, node, edge attributes
+	subgraphs         []*dotDirectedMultigraph
+}
+
+func newDotDirectedMultigraph() *dotDirectedMultigraph {
+	return &dotDirectedMultigraph{
+		DirectedGraph: multi.NewDirectedGraph(),
+
+		graph: &encoding.Attributes{},
+		node:  &encoding.Attributes{},
+		edge:  &encoding.Attributes{},
+	}
+}
+
+// DOTAttributeSetters implements the dot.AttributeSetters interface.
+func (g *dotDirectedMultigraph) DOTAttributeSetters() (graph, node, edge encoding.AttributeSetter) {
+	return g.graph, g.node, g.edge
+}
+
+// AddDOTSubgraph implements the dot.MultiSubgraphAdder interface.
+func (g *dotDirectedMultigraph) AddDOTSubgraph(id string) encoding.MultiBuilder {
+	s := newDotDirectedMultigraph()
+	s.id = id
+	g.subgraphs = append(g.subgraphs, s)
+	return s
+}
+
 func TestChainedEdgeAttributes(t *testing.T) {
 	golden := []struct {
 		in, want string
@@ -527,6 +848,7 @@ type dotDirectedGraph struct {
 	*simple.DirectedGraph
 	id                string
 	graph, node, edge attributes
+	subgraphs         []*dotDirectedGraph
 }
 
 // newDotDirectedGraph returns a new directed capable of creating user-defined
@@ -571,6 +893,30 @@ func (g *dotDirectedGraph) DOTID() string {
 	return g.id
 }
 
+// AddDOTSubgraph implements the dot.SubgraphAdder interface.
+func (g *dotDirectedGraph) AddDOTSubgraph(id string) encoding.Builder {
+	if id != "" {
+		for _, s := range g.subgraphs {
+			if s.id == id {
+				return s
+			}
+		}
+	}
+	s := newDotDirectedGraph()
+	s.id = id
+	g.subgraphs = append(g.subgraphs, s)
+	return s
+}
+
+// Structure implements the dot.Structurer interface.
+func (g *dotDirectedGraph) Structure() []Graph {
+	s := make([]Graph, len(g.subgraphs))
+	for i, sg := range g.subgraphs {
+		s[i] = sg
+	}
+	return s
+}
+
 // dotUndirectedGraph extends simple.UndirectedGraph to add NewNode and NewEdge
 // methods for creating user-defined nodes and edges.
 //
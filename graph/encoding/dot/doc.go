@@ -17,5 +17,14 @@
 // valid DOT syntax. Quoted IDs and attributes are unquoted during unmarshaling,
 // so the data is kept in raw form. As an exception, quoted text with a leading
 // `"<` and a trailing `>"` is not unquoted to ensure preservation of the string
-// during a round-trip.
+// during a round-trip. HTML strings, such as HTML-like labels, are held in
+// their delimited form, <...>, and are written unchanged during marshalling.
+//
+// # Subgraphs and ports
+//
+// Subgraphs are written for graphs implementing Structurer or MultiStructurer
+// and are read into destinations implementing SubgraphAdder or
+// MultiSubgraphAdder, so that cluster subgraphs and their attributes are
+// preserved during a round-trip. Edge ports and compass points are written
+// for edges implementing Porter and read into edges implementing PortSetter.
 package dot // import "gonum.org/v1/gonum/graph/encoding/dot"
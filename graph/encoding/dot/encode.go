@@ -323,7 +323,13 @@ func (p *printer) writeNode(n graph.Node) {
 func (p *printer) writePorts(port, cp string) {
 	if port != "" {
 		p.buf.WriteByte(':')
-		p.buf.WriteString(quoteID(port))
+		if cp == "" && isCompassPoint(port) {
+			// A port without a compass point would otherwise
+			// be read as the compass point of the same name.
+			p.buf.WriteString(strconv.Quote(port))
+		} else {
+			p.buf.WriteString(quoteID(port))
+		}
 	}
 	if cp != "" {
 		p.buf.WriteByte(':')
@@ -624,6 +630,15 @@ func isKeyword(s string) bool {
 	return false
 }
 
+// isCompassPoint reports whether the given string is a DOT compass point.
+func isCompassPoint(s string) bool {
+	switch s {
+	case "n", "ne", "e", "se", "s", "sw", "w", "nw", "c", "_":
+		return true
+	}
+	return false
+}
+
 // FIXME: see if we rewrite this in another way to remove our regexp dependency.
 
 // Regular expression to match identifier and numeral IDs.
@@ -0,0 +1,274 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package flow
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// MaxFlow returns the value of a maximum flow from s to t in g and the nodes
+// on the source side of a minimum s-t cut, sorted by ID. The value of the
+// maximum flow is equal to the total capacity of the edges leaving the
+// returned nodes.
+//
+// If g is a graph.Weighted, the edge weights are used as capacities,
+// otherwise each edge has unit capacity, or for a graph.Multigraph the
+// capacity of an edge is the number of lines it holds. Edges of an undirected
+// graph may carry flow in either direction. Self edges are ignored.
+//
+// MaxFlow will panic if s and t are the same node, if either is not in g or
+// if g has an edge with negative capacity.
+func MaxFlow(g graph.Graph, s, t graph.Node) (value float64, source []graph.Node) {
+	nw, nodes, indexOf := newNetwork(g, s, t, capacityOf(g))
+	value = nw.maxFlow(indexOf[s.ID()], indexOf[t.ID()])
+	reach := nw.reachable(indexOf[s.ID()])
+	for i, n := range nodes {
+		if reach[i] {
+			source = append(source, n)
+		}
+	}
+	order.ByID(source)
+	return value, source
+}
+
+// EdgeConnectivity returns the s-t edge connectivity of g, the minimum number
+// of edges that must be removed from g to disconnect t from s. Edge weights
+// are ignored, and each line of a graph.Multigraph is counted as an edge.
+//
+// EdgeConnectivity will panic if s and t are the same node or if either is
+// not in g.
+func EdgeConnectivity(g graph.Graph, s, t graph.Node) int {
+	nw, _, indexOf := newNetwork(g, s, t, lineCount(g))
+	return int(nw.maxFlow(indexOf[s.ID()], indexOf[t.ID()]))
+}
+
+// VertexConnectivity returns the s-t vertex connectivity of g, the minimum
+// number of nodes other than s and t that must be removed from g to
+// disconnect t from s, and a minimum set of such nodes sorted by ID. If s
+// and t are adjacent, no such set exists and VertexConnectivity returns -1
+// and nil.
+//
+// VertexConnectivity will panic if s and t are the same node or if either is
+// not in g.
+func VertexConnectivity(g graph.Graph, s, t graph.Node) (k int, cut []graph.Node) {
+	sid := s.ID()
+	tid := t.ID()
+	checkTerminals(g, sid, tid)
+	if g.HasEdgeBetween(sid, tid) {
+		if d, ok := g.(graph.Directed); !ok || d.HasEdgeFromTo(sid, tid) {
+			return -1, nil
+		}
+	}
+
+	// Split each node v into an in node, 2*i, and an out node, 2*i+1,
+	// joined by an arc of unit capacity, and replace each edge from u
+	// to v by an arc of infinite capacity from the out node of u to
+	// the in node of v.
+	nodes := graph.NodesOf(g.Nodes())
+	indexOf := make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+	nw := newResidual(2 * len(nodes))
+	for i, u := range nodes {
+		nw.addArc(2*i, 2*i+1, 1)
+		to := g.From(u.ID())
+		for to.Next() {
+			j := indexOf[to.Node().ID()]
+			if j != i {
+				nw.addArc(2*i+1, 2*j, math.Inf(1))
+			}
+		}
+	}
+
+	k = int(nw.maxFlow(2*indexOf[sid]+1, 2*indexOf[tid]))
+	reach := nw.reachable(2*indexOf[sid] + 1)
+	for i, n := range nodes {
+		if reach[2*i] && !reach[2*i+1] {
+			cut = append(cut, n)
+		}
+	}
+	order.ByID(cut)
+	return k, cut
+}
+
+// capacityOf returns a function returning the capacity of the edge from
+// uid to vid in g.
+func capacityOf(g graph.Graph) func(uid, vid int64) float64 {
+	if wg, ok := g.(graph.Weighted); ok {
+		return func(uid, vid int64) float64 {
+			w, _ := wg.Weight(uid, vid)
+			if w < 0 {
+				panic("flow: negative edge capacity")
+			}
+			return w
+		}
+	}
+	return lineCount(g)
+}
+
+// lineCount returns a function returning the number of lines in the
+// edge from uid to vid in g if g is a graph.Multigraph, or 1 otherwise.
+func lineCount(g graph.Graph) func(uid, vid int64) float64 {
+	mg, ok := g.(graph.Multigraph)
+	if !ok {
+		return func(_, _ int64) float64 { return 1 }
+	}
+	return func(uid, vid int64) float64 {
+		return float64(mg.Lines(uid, vid).Len())
+	}
+}
+
+// checkTerminals panics if the source and sink are the same
+// node or either is not in g.
+func checkTerminals(g graph.Graph, sid, tid int64) {
+	if sid == tid {
+		panic("flow: source and sink are the same node")
+	}
+	if g.Node(sid) == nil || g.Node(tid) == nil {
+		panic("flow: source or sink not in graph")
+	}
+}
+
+// newNetwork returns the residual network of g with edge capacities
+// given by capacity, the nodes of g and a map from node IDs to indices
+// into the nodes.
+func newNetwork(g graph.Graph, s, t graph.Node, capacity func(uid, vid int64) float64) (nw *residual, nodes []graph.Node, indexOf map[int64]int) {
+	checkTerminals(g, s.ID(), t.ID())
+	nodes = graph.NodesOf(g.Nodes())
+	indexOf = make(map[int64]int, len(nodes))
+	for i, n := range nodes {
+		indexOf[n.ID()] = i
+	}
+	nw = newResidual(len(nodes))
+	for i, u := range nodes {
+		uid := u.ID()
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if vid != uid {
+				nw.addArc(i, indexOf[vid], capacity(uid, vid))
+			}
+		}
+	}
+	return nw, nodes, indexOf
+}
+
+// residual is a residual flow network. Arcs are stored in pairs so that
+// the reverse of arc e is arc e^1.
+type residual struct {
+	head []int // head[u] is the first arc leaving u, or -1.
+	next []int // next[e] is the next arc leaving the tail of e, or -1.
+	to   []int
+	cap  []float64
+
+	level []int
+	iter  []int
+}
+
+func newResidual(n int) *residual {
+	head := make([]int, n)
+	for i := range head {
+		head[i] = -1
+	}
+	return &residual{
+		head:  head,
+		level: make([]int, n),
+		iter:  make([]int, n),
+	}
+}
+
+// addArc adds an arc from u to v with capacity c and its reverse
+// arc with zero capacity.
+func (r *residual) addArc(u, v int, c float64) {
+	r.to = append(r.to, v, u)
+	r.cap = append(r.cap, c, 0)
+	r.next = append(r.next, r.head[u], r.head[v])
+	r.head[u] = len(r.to) - 2
+	r.head[v] = len(r.to) - 1
+}
+
+// maxFlow returns the value of a maximum flow from s to t, leaving
+// the residual capacities in the network. It uses Dinic's algorithm.
+func (r *residual) maxFlow(s, t int) float64 {
+	var flow float64
+	for r.bfs(s, t) {
+		copy(r.iter, r.head)
+		for {
+			f := r.augment(s, t, math.Inf(1))
+			if f == 0 {
+				break
+			}
+			flow += f
+		}
+	}
+	return flow
+}
+
+// bfs sets the level of each node in the level graph of the residual
+// network from s and returns whether t is reachable.
+func (r *residual) bfs(s, t int) bool {
+	for i := range r.level {
+		r.level[i] = -1
+	}
+	r.level[s] = 0
+	queue := []int{s}
+	for len(queue) != 0 {
+		u := queue[0]
+		queue = queue[1:]
+		for e := r.head[u]; e >= 0; e = r.next[e] {
+			v := r.to[e]
+			if r.cap[e] > 0 && r.level[v] < 0 {
+				r.level[v] = r.level[u] + 1
+				queue = append(queue, v)
+			}
+		}
+	}
+	return r.level[t] >= 0
+}
+
+// augment pushes at most f units of flow along a path from u to t in
+// the level graph and returns the amount of flow pushed.
+func (r *residual) augment(u, t int, f float64) float64 {
+	if u == t {
+		return f
+	}
+	for ; r.iter[u] >= 0; r.iter[u] = r.next[r.iter[u]] {
+		e := r.iter[u]
+		v := r.to[e]
+		if r.cap[e] <= 0 || r.level[v] != r.level[u]+1 {
+			continue
+		}
+		d := r.augment(v, t, math.Min(f, r.cap[e]))
+		if d > 0 {
+			r.cap[e] -= d
+			r.cap[e^1] += d
+			return d
+		}
+	}
+	return 0
+}
+
+// reachable returns which nodes are reachable from s in the
+// residual network.
+func (r *residual) reachable(s int) []bool {
+	seen := make([]bool, len(r.head))
+	seen[s] = true
+	stack := []int{s}
+	for len(stack) != 0 {
+		u := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for e := r.head[u]; e >= 0; e = r.next[e] {
+			if v := r.to[e]; r.cap[e] > 0 && !seen[v] {
+				seen[v] = true
+				stack = append(stack, v)
+			}
+		}
+	}
+	return seen
+}
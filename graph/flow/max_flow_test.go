@@ -0,0 +1,348 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package flow
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/multi"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+var maxFlowTests = []struct {
+	name   string
+	g      func() graph.Graph
+	s, t   int64
+	value  float64
+	source []int64
+}{
+	{
+		// Example from Cormen et al., Introduction to Algorithms, Figure 26.1.
+		name: "clrs",
+		g: func() graph.Graph {
+			g := simple.NewWeightedDirectedGraph(0, math.Inf(1))
+			for _, e := range []simple.WeightedEdge{
+				{F: simple.Node(0), T: simple.Node(1), W: 16},
+				{F: simple.Node(0), T: simple.Node(2), W: 13},
+				{F: simple.Node(2), T: simple.Node(1), W: 4},
+				{F: simple.Node(1), T: simple.Node(3), W: 12},
+				{F: simple.Node(3), T: simple.Node(2), W: 9},
+				{F: simple.Node(2), T: simple.Node(4), W: 14},
+				{F: simple.Node(4), T: simple.Node(3), W: 7},
+				{F: simple.Node(3), T: simple.Node(5), W: 20},
+				{F: simple.Node(4), T: simple.Node(5), W: 4},
+			} {
+				g.SetWeightedEdge(e)
+			}
+			return g
+		},
+		s: 0, t: 5,
+		value:  23,
+		source: []int64{0, 1, 2, 4},
+	},
+	{
+		name: "unweighted undirected",
+		g: func() graph.Graph {
+			g := simple.NewUndirectedGraph()
+			for _, e := range [][2]int64{{0, 1}, {0, 2}, {1, 2}, {1, 3}, {2, 3}, {3, 4}} {
+				g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+			}
+			return g
+		},
+		s: 0, t: 4,
+		value:  1,
+		source: []int64{0, 1, 2, 3},
+	},
+	{
+		name: "multigraph",
+		g: func() graph.Graph {
+			g := multi.NewUndirectedGraph()
+			for _, e := range [][2]int64{{0, 1}, {0, 1}, {0, 1}, {1, 2}, {1, 2}} {
+				g.SetLine(g.NewLine(multi.Node(e[0]), multi.Node(e[1])))
+			}
+			return g
+		},
+		s: 0, t: 2,
+		value:  2,
+		source: []int64{0, 1},
+	},
+	{
+		name: "disconnected",
+		g: func() graph.Graph {
+			g := simple.NewDirectedGraph()
+			g.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(1)})
+			g.SetEdge(simple.Edge{F: simple.Node(2), T: simple.Node(0)})
+			g.AddNode(simple.Node(3))
+			return g
+		},
+		s: 0, t: 3,
+		value:  0,
+		source: []int64{0, 1},
+	},
+}
+
+func TestMaxFlow(t *testing.T) {
+	t.Parallel()
+	for _, test := range maxFlowTests {
+		g := test.g()
+		value, source := MaxFlow(g, g.Node(test.s), g.Node(test.t))
+		if value != test.value {
+			t.Errorf("unexpected flow value for %q: got:%v want:%v", test.name, value, test.value)
+		}
+		if got := ids(source); !reflect.DeepEqual(got, test.source) {
+			t.Errorf("unexpected source side for %q: got:%v want:%v", test.name, got, test.source)
+		}
+	}
+}
+
+func TestMaxFlowRandom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 50; trial++ {
+		n := 2 + rnd.Intn(7)
+		var g graph.Weighted
+		if trial%2 == 0 {
+			g = randWeightedDirected(n, 0.4, rnd)
+		} else {
+			g = randWeightedUndirected(n, 0.4, rnd)
+		}
+		s, t1 := g.Node(0), g.Node(int64(n-1))
+		value, source := MaxFlow(g, s, t1)
+		want := bruteMinCut(g, 0, int64(n-1))
+		if value != want {
+			t.Errorf("unexpected flow value for trial %d: got:%v want:%v", trial, value, want)
+		}
+		if got := cutWeight(g, source); got != value {
+			t.Errorf("source side does not define a minimum cut for trial %d: cut weight %v != %v", trial, got, value)
+		}
+	}
+}
+
+func TestEdgeConnectivity(t *testing.T) {
+	t.Parallel()
+	// Two 4-cliques joined by two disjoint edges.
+	g := simple.NewUndirectedGraph()
+	for _, c := range [][]int64{{0, 1, 2, 3}, {4, 5, 6, 7}} {
+		for i, u := range c {
+			for _, v := range c[i+1:] {
+				g.SetEdge(simple.Edge{F: simple.Node(u), T: simple.Node(v)})
+			}
+		}
+	}
+	g.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(4)})
+	g.SetEdge(simple.Edge{F: simple.Node(1), T: simple.Node(5)})
+
+	for _, test := range []struct {
+		s, t int64
+		want int
+	}{
+		{s: 0, t: 1, want: 4},
+		{s: 2, t: 3, want: 3},
+		{s: 2, t: 6, want: 2},
+		{s: 0, t: 4, want: 2},
+	} {
+		got := EdgeConnectivity(g, simple.Node(test.s), simple.Node(test.t))
+		if got != test.want {
+			t.Errorf("unexpected edge connectivity between %d and %d: got:%d want:%d", test.s, test.t, got, test.want)
+		}
+	}
+
+	// Edge weights are ignored.
+	w := simple.NewWeightedUndirectedGraph(0, 0)
+	w.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(0), T: simple.Node(1), W: 10})
+	w.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(1), T: simple.Node(2), W: 10})
+	if got := EdgeConnectivity(w, simple.Node(0), simple.Node(2)); got != 1 {
+		t.Errorf("unexpected edge connectivity of weighted graph: got:%d want:1", got)
+	}
+}
+
+func TestVertexConnectivity(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name  string
+		edges [][2]int64
+		dir   bool
+		s, t  int64
+		k     int
+		cut   []int64
+	}{
+		{
+			name:  "cycle",
+			edges: [][2]int64{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 5}, {5, 0}},
+			s:     0, t: 3,
+			k:   2,
+			cut: []int64{1, 5},
+		},
+		{
+			name:  "bowtie",
+			edges: [][2]int64{{0, 1}, {0, 2}, {1, 2}, {2, 3}, {2, 4}, {3, 4}},
+			s:     0, t: 4,
+			k:   1,
+			cut: []int64{2},
+		},
+		{
+			name:  "adjacent",
+			edges: [][2]int64{{0, 1}, {1, 2}, {0, 2}},
+			s:     0, t: 1,
+			k:   -1,
+			cut: nil,
+		},
+		{
+			name:  "directed",
+			edges: [][2]int64{{1, 0}, {0, 2}, {2, 1}, {0, 3}, {3, 1}},
+			dir:   true,
+			s:     0, t: 1,
+			k:   2,
+			cut: []int64{2, 3},
+		},
+		{
+			name:  "disconnected",
+			edges: [][2]int64{{0, 1}, {2, 3}},
+			s:     0, t: 3,
+			k:   0,
+			cut: nil,
+		},
+	} {
+		var g graph.Graph
+		if test.dir {
+			d := simple.NewDirectedGraph()
+			for _, e := range test.edges {
+				d.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+			}
+			g = d
+		} else {
+			u := simple.NewUndirectedGraph()
+			for _, e := range test.edges {
+				u.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+			}
+			g = u
+		}
+		k, cut := VertexConnectivity(g, simple.Node(test.s), simple.Node(test.t))
+		if k != test.k {
+			t.Errorf("unexpected vertex connectivity for %q: got:%d want:%d", test.name, k, test.k)
+		}
+		if got := ids(cut); !reflect.DeepEqual(got, test.cut) {
+			t.Errorf("unexpected vertex cut for %q: got:%v want:%v", test.name, got, test.cut)
+		}
+	}
+}
+
+func TestMaxFlowPanics(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedDirectedGraph(0, 0)
+	g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(0), T: simple.Node(1), W: -1})
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "same", fn: func() { MaxFlow(g, simple.Node(0), simple.Node(0)) }},
+		{name: "missing", fn: func() { MaxFlow(g, simple.Node(0), simple.Node(2)) }},
+		{name: "negative", fn: func() { MaxFlow(g, simple.Node(0), simple.Node(1)) }},
+		{name: "vertex same", fn: func() { VertexConnectivity(g, simple.Node(1), simple.Node(1)) }},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("expected panic for %q", test.name)
+		}
+	}
+}
+
+// randWeightedDirected returns a random directed graph with n nodes, edge
+// probability p and integer edge weights.
+func randWeightedDirected(n int, p float64, rnd *rand.Rand) *simple.WeightedDirectedGraph {
+	g := simple.NewWeightedDirectedGraph(0, 0)
+	for i := 0; i < n; i++ {
+		g.AddNode(simple.Node(i))
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i != j && rnd.Float64() < p {
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: float64(1 + rnd.Intn(9))})
+			}
+		}
+	}
+	return g
+}
+
+// randWeightedUndirected returns a random undirected graph with n nodes,
+// edge probability p and integer edge weights.
+func randWeightedUndirected(n int, p float64, rnd *rand.Rand) *simple.WeightedUndirectedGraph {
+	g := simple.NewWeightedUndirectedGraph(0, 0)
+	for i := 0; i < n; i++ {
+		g.AddNode(simple.Node(i))
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if rnd.Float64() < p {
+				g.SetWeightedEdge(simple.WeightedEdge{F: simple.Node(i), T: simple.Node(j), W: float64(1 + rnd.Intn(9))})
+			}
+		}
+	}
+	return g
+}
+
+// cutWeight returns the total weight of the edges leaving the nodes in side.
+func cutWeight(g graph.Weighted, side []graph.Node) float64 {
+	in := make(map[int64]bool)
+	for _, n := range side {
+		in[n.ID()] = true
+	}
+	var w float64
+	for _, u := range side {
+		to := g.From(u.ID())
+		for to.Next() {
+			v := to.Node()
+			if !in[v.ID()] {
+				x, _ := g.Weight(u.ID(), v.ID())
+				w += x
+			}
+		}
+	}
+	return w
+}
+
+// bruteMinCut returns the minimum s-t cut weight of g by enumerating all
+// partitions of its nodes. The nodes of g must have IDs 0 to n-1.
+func bruteMinCut(g graph.Weighted, s, t int64) float64 {
+	n := g.Nodes().Len()
+	best := math.Inf(1)
+	for mask := 0; mask < 1<<n; mask++ {
+		if mask&(1<<s) == 0 || mask&(1<<t) != 0 {
+			continue
+		}
+		var side []graph.Node
+		for i := 0; i < n; i++ {
+			if mask&(1<<i) != 0 {
+				side = append(side, g.Node(int64(i)))
+			}
+		}
+		best = math.Min(best, cutWeight(g, side))
+	}
+	return best
+}
+
+func ids(nodes []graph.Node) []int64 {
+	if nodes == nil {
+		return nil
+	}
+	id := make([]int64, len(nodes))
+	for i, n := range nodes {
+		id[i] = n.ID()
+	}
+	return id
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		r := recover()
+		panicked = r != nil
+	}()
+	fn()
+	return
+}
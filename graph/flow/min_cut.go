@@ -0,0 +1,192 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package flow
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/internal/order"
+)
+
+// StoerWagner returns the weight of a global minimum cut of the undirected
+// graph g and the nodes on one side of the cut, sorted by ID. A global minimum
+// cut is a partition of the nodes of g into two non-empty sets such that the
+// total weight of the edges between the sets is minimal. If g is not
+// connected, the weight of the cut is zero. If g has fewer than two nodes,
+// StoerWagner returns zero and nil.
+//
+// Edge weights are obtained as described for MaxFlow and StoerWagner will
+// panic if g has an edge with negative weight.
+//
+// The algorithm is described in Stoer and Wagner doi:10.1145/263867.263872.
+func StoerWagner(g graph.Undirected) (weight float64, cut []graph.Node) {
+	nodes := graph.NodesOf(g.Nodes())
+	n := len(nodes)
+	if n < 2 {
+		return 0, nil
+	}
+	indexOf := make(map[int64]int, n)
+	for i, u := range nodes {
+		indexOf[u.ID()] = i
+	}
+
+	// w is the dense weight matrix of the
+	// graph with merged nodes.
+	capacity := capacityOf(g)
+	w := make([][]float64, n)
+	for i, u := range nodes {
+		w[i] = make([]float64, n)
+		uid := u.ID()
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if vid != uid {
+				w[i][indexOf[vid]] = capacity(uid, vid)
+			}
+		}
+	}
+
+	// merged[i] holds the original nodes
+	// merged into node i.
+	merged := make([][]int, n)
+	for i := range merged {
+		merged[i] = []int{i}
+	}
+	active := make([]int, n)
+	for i := range active {
+		active[i] = i
+	}
+
+	weight = math.Inf(1)
+	var best []int
+	key := make([]float64, n)
+	added := make([]bool, n)
+	for len(active) > 1 {
+		// Perform a maximum adjacency search over the
+		// active nodes to find the cut of the phase.
+		for _, i := range active {
+			key[i] = 0
+			added[i] = false
+		}
+		prev, last := -1, -1
+		for range active {
+			next := -1
+			for _, i := range active {
+				if !added[i] && (next < 0 || key[i] > key[next]) {
+					next = i
+				}
+			}
+			added[next] = true
+			for _, i := range active {
+				if !added[i] {
+					key[i] += w[next][i]
+				}
+			}
+			prev, last = last, next
+		}
+
+		if key[last] < weight {
+			weight = key[last]
+			best = append(best[:0], merged[last]...)
+		}
+
+		// Merge the last node into the previous node.
+		merged[prev] = append(merged[prev], merged[last]...)
+		for _, i := range active {
+			w[prev][i] += w[last][i]
+			w[i][prev] = w[prev][i]
+		}
+		w[prev][prev] = 0
+		for k, i := range active {
+			if i == last {
+				active = append(active[:k], active[k+1:]...)
+				break
+			}
+		}
+	}
+
+	cut = make([]graph.Node, len(best))
+	for k, i := range best {
+		cut[k] = nodes[i]
+	}
+	order.ByID(cut)
+	return weight, cut
+}
+
+// WeightedBuilder is a type that can add nodes and weighted edges.
+type WeightedBuilder interface {
+	AddNode(graph.Node)
+	SetWeightedEdge(graph.WeightedEdge)
+}
+
+// GomoryHu constructs a Gomory-Hu cut tree of the undirected graph g in dst.
+// The cut tree has the same nodes as g and for every pair of nodes u and v
+// the minimum weight of the edges on the path between u and v in the tree is
+// the value of a maximum flow, and so of a minimum cut, between u and v in g.
+// Further, removing any edge of the tree partitions its nodes into the two
+// sides of a minimum cut in g between the ends of the edge. The destination
+// is not cleared first.
+//
+// Edge weights are obtained as described for MaxFlow and GomoryHu will panic
+// if g has an edge with negative weight. The edges of the tree are
+// simple.WeightedEdge values. If g is not connected, tree edges between
+// components have zero weight.
+//
+// The tree is constructed with n-1 maximum flow computations using the
+// algorithm described in Gusfield doi:10.1137/0219009.
+func GomoryHu(dst WeightedBuilder, g graph.Undirected) {
+	nodes := graph.NodesOf(g.Nodes())
+	for _, u := range nodes {
+		dst.AddNode(u)
+	}
+	n := len(nodes)
+	if n < 2 {
+		return
+	}
+	indexOf := make(map[int64]int, n)
+	for i, u := range nodes {
+		indexOf[u.ID()] = i
+	}
+	capacity := capacityOf(g)
+	nw := newResidual(n)
+	for i, u := range nodes {
+		uid := u.ID()
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if vid != uid {
+				nw.addArc(i, indexOf[vid], capacity(uid, vid))
+			}
+		}
+	}
+	orig := append([]float64(nil), nw.cap...)
+
+	// parent[i] is the neighbor of i in the tree towards
+	// node 0 and fl[i] is the weight of that tree edge.
+	parent := make([]int, n)
+	fl := make([]float64, n)
+	for s := 1; s < n; s++ {
+		t := parent[s]
+		copy(nw.cap, orig)
+		fl[s] = nw.maxFlow(s, t)
+		side := nw.reachable(s)
+		for i := 0; i < n; i++ {
+			if i != s && side[i] && parent[i] == t {
+				parent[i] = s
+			}
+		}
+		if side[parent[t]] {
+			parent[s] = parent[t]
+			parent[t] = s
+			fl[s], fl[t] = fl[t], fl[s]
+		}
+	}
+
+	for i := 1; i < n; i++ {
+		dst.SetWeightedEdge(simple.WeightedEdge{F: nodes[i], T: nodes[parent[i]], W: fl[i]})
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package flow
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/path"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestStoerWagner(t *testing.T) {
+	t.Parallel()
+	// Example graph from Stoer and Wagner.
+	g := simple.NewWeightedUndirectedGraph(0, 0)
+	for _, e := range []simple.WeightedEdge{
+		{F: simple.Node(1), T: simple.Node(2), W: 2},
+		{F: simple.Node(1), T: simple.Node(5), W: 3},
+		{F: simple.Node(2), T: simple.Node(3), W: 3},
+		{F: simple.Node(2), T: simple.Node(5), W: 2},
+		{F: simple.Node(2), T: simple.Node(6), W: 2},
+		{F: simple.Node(3), T: simple.Node(4), W: 4},
+		{F: simple.Node(3), T: simple.Node(7), W: 2},
+		{F: simple.Node(4), T: simple.Node(7), W: 2},
+		{F: simple.Node(4), T: simple.Node(8), W: 2},
+		{F: simple.Node(5), T: simple.Node(6), W: 3},
+		{F: simple.Node(6), T: simple.Node(7), W: 1},
+		{F: simple.Node(7), T: simple.Node(8), W: 3},
+	} {
+		g.SetWeightedEdge(e)
+	}
+	weight, cut := StoerWagner(g)
+	if weight != 4 {
+		t.Errorf("unexpected minimum cut weight: got:%v want:4", weight)
+	}
+	if got := cutWeight(g, cut); got != weight {
+		t.Errorf("returned side does not match cut weight: got:%v want:%v", got, weight)
+	}
+	if len(cut) != 4 {
+		t.Errorf("unexpected cut side size: got:%d want:4", len(cut))
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 50; trial++ {
+		n := 2 + rnd.Intn(7)
+		g := randWeightedUndirected(n, 0.5, rnd)
+		weight, cut := StoerWagner(g)
+		want := math.Inf(1)
+		for s := 0; s < n; s++ {
+			for t := s + 1; t < n; t++ {
+				want = math.Min(want, bruteMinCut(g, int64(s), int64(t)))
+			}
+		}
+		if weight != want {
+			t.Errorf("unexpected minimum cut weight for trial %d: got:%v want:%v", trial, weight, want)
+		}
+		if len(cut) == 0 || len(cut) == n {
+			t.Errorf("cut side is not a proper subset for trial %d: %v", trial, ids(cut))
+		}
+		if got := cutWeight(g, cut); got != weight {
+			t.Errorf("returned side does not match cut weight for trial %d: got:%v want:%v", trial, got, weight)
+		}
+	}
+
+	// Unweighted graphs have unit weights.
+	u := simple.NewUndirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {1, 2}, {2, 0}, {2, 3}} {
+		u.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+	if weight, cut := StoerWagner(u); weight != 1 || len(cut) != 1 && len(cut) != 3 {
+		t.Errorf("unexpected minimum cut of unweighted graph: got:%v %v", weight, ids(cut))
+	}
+
+	// Trivial graphs have no cut.
+	single := simple.NewUndirectedGraph()
+	single.AddNode(simple.Node(0))
+	if weight, cut := StoerWagner(single); weight != 0 || cut != nil {
+		t.Errorf("unexpected minimum cut of single node graph: got:%v %v", weight, ids(cut))
+	}
+}
+
+func TestGomoryHu(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 30; trial++ {
+		n := 2 + rnd.Intn(9)
+		g := randWeightedUndirected(n, 0.4, rnd)
+		tree := simple.NewWeightedUndirectedGraph(0, 0)
+		GomoryHu(tree, g)
+
+		if got := tree.Nodes().Len(); got != n {
+			t.Fatalf("unexpected number of tree nodes for trial %d: got:%d want:%d", trial, got, n)
+		}
+		if got := tree.Edges().Len(); got != n-1 {
+			t.Fatalf("unexpected number of tree edges for trial %d: got:%d want:%d", trial, got, n-1)
+		}
+
+		// Every pair of nodes has a maximum flow equal to the minimum
+		// weight on the tree path between them.
+		for s := 0; s < n; s++ {
+			for u := s + 1; u < n; u++ {
+				want, _ := MaxFlow(g, g.Node(int64(s)), g.Node(int64(u)))
+				got := treePathMin(tree, int64(s), int64(u))
+				if got != want {
+					t.Errorf("unexpected path minimum between %d and %d for trial %d: got:%v want:%v", s, u, trial, got, want)
+				}
+			}
+		}
+
+		// Removing a tree edge partitions the nodes into a
+		// minimum cut between the ends of the edge.
+		edges := graph.WeightedEdgesOf(tree.WeightedEdges())
+		for _, e := range edges {
+			tree.RemoveEdge(e.From().ID(), e.To().ID())
+			side := reachableFrom(tree, e.From())
+			tree.SetWeightedEdge(e)
+			if got := cutWeight(g, side); got != e.Weight() {
+				t.Errorf("tree edge %d-%d does not define a minimum cut for trial %d: got:%v want:%v",
+					e.From().ID(), e.To().ID(), trial, got, e.Weight())
+			}
+		}
+	}
+}
+
+// treePathMin returns the minimum edge weight on the path between u and v in
+// the tree.
+func treePathMin(tree *simple.WeightedUndirectedGraph, u, v int64) float64 {
+	pt, _ := path.BellmanFordFrom(tree.Node(u), tree)
+	p, _ := pt.To(v)
+	min := math.Inf(1)
+	for i := 1; i < len(p); i++ {
+		w, _ := tree.Weight(p[i-1].ID(), p[i].ID())
+		min = math.Min(min, w)
+	}
+	return min
+}
+
+// reachableFrom returns the nodes reachable from u in g.
+func reachableFrom(g graph.Graph, u graph.Node) []graph.Node {
+	seen := map[int64]bool{u.ID(): true}
+	nodes := []graph.Node{u}
+	for i := 0; i < len(nodes); i++ {
+		to := g.From(nodes[i].ID())
+		for to.Next() {
+			v := to.Node()
+			if !seen[v.ID()] {
+				seen[v.ID()] = true
+				nodes = append(nodes, v)
+			}
+		}
+	}
+	return nodes
+}
@@ -0,0 +1,93 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// RMAT constructs a recursive matrix (R-MAT) graph in the destination, dst,
+// of order 2^scale using edgeFactor*2^scale edge samples. Each edge is
+// placed by recursively descending scale levels into one of the four
+// quadrants of the adjacency matrix, choosing the top-left, top-right,
+// bottom-left and bottom-right quadrants with probabilities a, b, c and
+// 1-a-b-c respectively. This is the stochastic Kronecker graph model with
+// a 2×2 initiator matrix. Node labels are randomly permuted after generation
+// so that node IDs carry no information about degree.
+//
+// Edges that would form self-loops are discarded. Repeated edge samples are
+// passed to dst.SetEdge, so the number of distinct edges in a simple graph
+// will be less than the number of samples. If dst is a graph.Directed, edges
+// are directed from the row to the column of the sampled matrix element.
+//
+// If src is not nil it is used as the random source, otherwise rand.Float64
+// and rand.Perm are used. The graph is constructed in O(scale*m) time where
+// m is the number of edge samples.
+//
+// The Graph500 benchmark uses a=0.57, b=0.19 and c=0.19 with an edge factor
+// of 16. See http://www.cs.cmu.edu/~christos/PUBLICATIONS/siam04.pdf and
+// https://graph500.org/ for details.
+func RMAT(dst graph.Builder, scale, edgeFactor int, a, b, c float64, src rand.Source) error {
+	if scale < 0 || scale > 30 {
+		return fmt.Errorf("gen: bad scale: scale=%d", scale)
+	}
+	if edgeFactor < 0 {
+		return fmt.Errorf("gen: bad edge factor: edgeFactor=%d", edgeFactor)
+	}
+	d := 1 - a - b - c
+	if a < 0 || b < 0 || c < 0 || d < -1e-12 {
+		return fmt.Errorf("gen: bad probabilities: a=%v b=%v c=%v", a, b, c)
+	}
+
+	var (
+		rnd  func() float64
+		perm func(int) []int
+	)
+	if src == nil {
+		rnd = rand.Float64
+		perm = rand.Perm
+	} else {
+		r := rand.New(src)
+		rnd = r.Float64
+		perm = r.Perm
+	}
+
+	n := 1 << uint(scale)
+	nodes := make([]graph.Node, n)
+	for i := range nodes {
+		u := dst.NewNode()
+		dst.AddNode(u)
+		nodes[i] = u
+	}
+	label := perm(n)
+
+	ab := a + b
+	abc := ab + c
+	for i := 0; i < edgeFactor*n; i++ {
+		var u, v int
+		for bit := n >> 1; bit > 0; bit >>= 1 {
+			switch r := rnd(); {
+			case r < a:
+			case r < ab:
+				v |= bit
+			case r < abc:
+				u |= bit
+			default:
+				u |= bit
+				v |= bit
+			}
+		}
+		if u == v {
+			continue
+		}
+		dst.SetEdge(dst.NewEdge(nodes[label[u]], nodes[label[v]]))
+	}
+
+	return nil
+}
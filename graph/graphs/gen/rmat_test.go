@@ -0,0 +1,106 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gen
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func TestRMATUndirected(t *testing.T) {
+	t.Parallel()
+	for scale := 0; scale <= 10; scale++ {
+		for _, edgeFactor := range []int{0, 1, 8} {
+			dst := simple.NewUndirectedGraph()
+			g := &gnUndirected{UndirectedBuilder: dst}
+			err := RMAT(g, scale, edgeFactor, 0.57, 0.19, 0.19, rand.NewSource(1))
+			if err != nil {
+				t.Fatalf("unexpected error: scale=%d edgeFactor=%d: %v", scale, edgeFactor, err)
+			}
+			n := 1 << uint(scale)
+			if got := g.Nodes().Len(); got != n {
+				t.Errorf("unexpected number of nodes for scale=%d: got:%d want:%d", scale, got, n)
+			}
+			if got := dst.Edges().Len(); got > edgeFactor*n {
+				t.Errorf("too many edges for scale=%d edgeFactor=%d: got:%d want<=%d", scale, edgeFactor, got, edgeFactor*n)
+			}
+			if g.addSelfLoop {
+				t.Errorf("unexpected self edge for scale=%d edgeFactor=%d", scale, edgeFactor)
+			}
+		}
+	}
+}
+
+func TestRMATDirected(t *testing.T) {
+	t.Parallel()
+	for scale := 0; scale <= 10; scale++ {
+		g := &gnDirected{DirectedBuilder: simple.NewDirectedGraph()}
+		err := RMAT(g, scale, 8, 0.57, 0.19, 0.19, rand.NewSource(1))
+		if err != nil {
+			t.Fatalf("unexpected error: scale=%d: %v", scale, err)
+		}
+		n := 1 << uint(scale)
+		if got := g.Nodes().Len(); got != n {
+			t.Errorf("unexpected number of nodes for scale=%d: got:%d want:%d", scale, got, n)
+		}
+		if g.addSelfLoop {
+			t.Errorf("unexpected self edge for scale=%d", scale)
+		}
+	}
+}
+
+func TestRMATSkew(t *testing.T) {
+	t.Parallel()
+	const scale = 12
+
+	// Uniform quadrant probabilities give an Erdős-Rényi-like
+	// degree distribution while the Graph500 parameters give
+	// a heavy tail.
+	maxDegree := func(a, b, c float64) (maxDeg int, mean float64) {
+		g := simple.NewUndirectedGraph()
+		err := RMAT(g, scale, 16, a, b, c, rand.NewSource(1))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		nodes := g.Nodes()
+		for nodes.Next() {
+			maxDeg = max(maxDeg, g.From(nodes.Node().ID()).Len())
+		}
+		return maxDeg, 2 * float64(g.Edges().Len()) / float64(g.Nodes().Len())
+	}
+	uniMax, uniMean := maxDegree(0.25, 0.25, 0.25)
+	if float64(uniMax) > 3*uniMean {
+		t.Errorf("unexpected heavy tail for uniform probabilities: max=%d mean=%v", uniMax, uniMean)
+	}
+	skewMax, skewMean := maxDegree(0.57, 0.19, 0.19)
+	if float64(skewMax) < 20*skewMean {
+		t.Errorf("unexpected light tail for Graph500 probabilities: max=%d mean=%v", skewMax, skewMean)
+	}
+}
+
+func TestRMATBadParameters(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		scale, edgeFactor int
+		a, b, c           float64
+	}{
+		{scale: -1, edgeFactor: 16, a: 0.57, b: 0.19, c: 0.19},
+		{scale: 31, edgeFactor: 16, a: 0.57, b: 0.19, c: 0.19},
+		{scale: 4, edgeFactor: -1, a: 0.57, b: 0.19, c: 0.19},
+		{scale: 4, edgeFactor: 16, a: -0.1, b: 0.5, c: 0.5},
+		{scale: 4, edgeFactor: 16, a: 0.5, b: 0.5, c: 0.5},
+	} {
+		var g graph.Builder = simple.NewUndirectedGraph()
+		err := RMAT(g, test.scale, test.edgeFactor, test.a, test.b, test.c, nil)
+		if err == nil {
+			t.Errorf("expected error for scale=%d edgeFactor=%d a=%v b=%v c=%v",
+				test.scale, test.edgeFactor, test.a, test.b, test.c)
+		}
+	}
+}
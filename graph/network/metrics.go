@@ -0,0 +1,365 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/graph"
+)
+
+// Strength returns the strength, or weighted degree, of each node in the
+// undirected graph g, the sum of the weights of the edges incident to the
+// node. If g is not a graph.Weighted, each edge has unit weight and the
+// strength of a node is its degree. Self edges are ignored.
+func Strength(g graph.Undirected) map[int64]float64 {
+	a := newAdjacency(g)
+	s := make(map[int64]float64, len(a.nodes))
+	for i, u := range a.nodes {
+		var sum float64
+		for _, w := range a.weights[i] {
+			sum += w
+		}
+		s[u.ID()] = sum
+	}
+	return s
+}
+
+// Clustering returns the local clustering coefficient of each node in the
+// undirected graph g,
+//
+//	C(v) = 2 T(v) / (k(v) (k(v) - 1)),
+//
+// where T(v) is the number of triangles through v and k(v) is the degree of
+// v. Nodes with degree less than two have a clustering coefficient of zero.
+// Edge weights and self edges are ignored.
+func Clustering(g graph.Undirected) map[int64]float64 {
+	a := newAdjacency(g)
+	c := make(map[int64]float64, len(a.nodes))
+	mark := make([]bool, len(a.nodes))
+	for i, u := range a.nodes {
+		c[u.ID()] = localClustering(a.adj, i, mark)
+	}
+	return c
+}
+
+// ClusteringWeighted returns the weighted local clustering coefficient of
+// each node in the undirected graph g as defined by Onnela et al.,
+//
+//	C(v) = 1 / (k(v) (k(v) - 1)) \sum_{u,w} (ŵ_vu ŵ_vw ŵ_uw)^{1/3},
+//
+// where the sum is over ordered pairs of neighbors of v, k(v) is the degree
+// of v and ŵ are the edge weights normalized by the maximum edge weight in g.
+// Nodes with degree less than two have a clustering coefficient of zero. Self
+// edges are ignored. ClusteringWeighted will panic if g has a negative edge
+// weight.
+//
+// The coefficient is described in Onnela et al. doi:10.1103/PhysRevE.71.065103.
+func ClusteringWeighted(g graph.WeightedUndirected) map[int64]float64 {
+	a := newAdjacency(g)
+	var maxWeight float64
+	for _, ws := range a.weights {
+		for _, w := range ws {
+			if w < 0 {
+				panic("network: negative edge weight")
+			}
+			maxWeight = math.Max(maxWeight, w)
+		}
+	}
+
+	c := make(map[int64]float64, len(a.nodes))
+	// cbrt holds the cube roots of the normalized weights
+	// of the edges from the current node to its neighbors,
+	// indexed by neighbor.
+	cbrt := make([]float64, len(a.nodes))
+	for i, u := range a.nodes {
+		k := len(a.adj[i])
+		if k < 2 || maxWeight == 0 {
+			c[u.ID()] = 0
+			continue
+		}
+		for l, j := range a.adj[i] {
+			cbrt[j] = math.Cbrt(a.weights[i][l] / maxWeight)
+		}
+		var sum float64
+		for _, j := range a.adj[i] {
+			for m, h := range a.adj[j] {
+				if h > j && cbrt[h] != 0 {
+					sum += cbrt[j] * cbrt[h] * math.Cbrt(a.weights[j][m]/maxWeight)
+				}
+			}
+		}
+		for _, j := range a.adj[i] {
+			cbrt[j] = 0
+		}
+		c[u.ID()] = 2 * sum / float64(k*(k-1))
+	}
+	return c
+}
+
+// DegreeAssortativity returns the degree assortativity coefficient of the
+// undirected graph g, the Pearson correlation coefficient of the degrees of
+// the nodes at either end of each edge. Positive values indicate that nodes
+// tend to be connected to nodes of similar degree. If all edges join nodes
+// of equal degree, or g has no edges, DegreeAssortativity returns NaN. Edge
+// weights and self edges are ignored.
+//
+// The coefficient is described in Newman doi:10.1103/PhysRevLett.89.208701.
+func DegreeAssortativity(g graph.Undirected) float64 {
+	a := newAdjacency(g)
+	var s assortativity
+	for i, nbrs := range a.adj {
+		for _, j := range nbrs {
+			if j > i {
+				s.add(len(nbrs), len(a.adj[j]))
+			}
+		}
+	}
+	return s.coefficient()
+}
+
+// RichClub returns the rich-club coefficients of the undirected graph g. The
+// element at index k of the returned slice is
+//
+//	φ(k) = 2 E(k) / (N(k) (N(k) - 1)),
+//
+// where N(k) is the number of nodes with degree greater than k and E(k) is
+// the number of edges between them. The returned slice has length equal to
+// the maximum degree of g, and φ(k) is NaN when N(k) is less than two. Edge
+// weights and self edges are ignored.
+//
+// The coefficient is described in Colizza et al. doi:10.1038/nphys209.
+func RichClub(g graph.Undirected) []float64 {
+	a := newAdjacency(g)
+	var maxDeg int
+	for _, nbrs := range a.adj {
+		maxDeg = max(maxDeg, len(nbrs))
+	}
+
+	// nodes[d] and edges[d] are the numbers of nodes with degree d
+	// and of edges whose end with the smaller degree has degree d.
+	nodes := make([]int, maxDeg+1)
+	edges := make([]int, maxDeg+1)
+	for i, nbrs := range a.adj {
+		nodes[len(nbrs)]++
+		for _, j := range nbrs {
+			if j > i {
+				edges[min(len(nbrs), len(a.adj[j]))]++
+			}
+		}
+	}
+
+	rc := make([]float64, maxDeg)
+	var n, e int
+	for k := maxDeg - 1; k >= 0; k-- {
+		n += nodes[k+1]
+		e += edges[k+1]
+		if n < 2 {
+			rc[k] = math.NaN()
+			continue
+		}
+		rc[k] = 2 * float64(e) / float64(n*(n-1))
+	}
+	return rc
+}
+
+// Summary holds graph-level summary statistics of an undirected graph.
+type Summary struct {
+	// Nodes and Edges are the numbers of
+	// nodes and edges in the graph.
+	Nodes, Edges int
+
+	// Density is the fraction of possible
+	// edges that are present in the graph.
+	Density float64
+
+	// MeanDegree and MaxDegree are the mean
+	// and maximum degrees of the nodes.
+	MeanDegree float64
+	MaxDegree  int
+
+	// MeanStrength is the mean of the node
+	// strengths returned by Strength.
+	MeanStrength float64
+
+	// Triangles is the number of
+	// triangles in the graph.
+	Triangles int
+
+	// Transitivity is the global clustering
+	// coefficient, the fraction of connected
+	// triples of nodes that form triangles.
+	Transitivity float64
+
+	// MeanClustering is the mean of the local
+	// clustering coefficients returned by
+	// Clustering.
+	MeanClustering float64
+
+	// Assortativity is the degree assortativity
+	// coefficient returned by DegreeAssortativity.
+	Assortativity float64
+}
+
+// Summarize returns summary statistics of the undirected graph g computed in
+// a single pass over its edges. Weights are used as described for Strength,
+// and self edges are ignored. Ratios that are undefined for g, such as the
+// density of a graph with fewer than two nodes, are NaN.
+func Summarize(g graph.Undirected) Summary {
+	a := newAdjacency(g)
+	n := len(a.nodes)
+	s := Summary{Nodes: n}
+	if n == 0 {
+		s.Density = math.NaN()
+		s.MeanDegree = math.NaN()
+		s.MeanStrength = math.NaN()
+		s.Transitivity = math.NaN()
+		s.MeanClustering = math.NaN()
+		s.Assortativity = math.NaN()
+		return s
+	}
+
+	var (
+		degrees   int
+		strength  float64
+		triangles int
+		triples   int
+		assort    assortativity
+	)
+	mark := make([]bool, n)
+	for i, nbrs := range a.adj {
+		k := len(nbrs)
+		degrees += k
+		s.MaxDegree = max(s.MaxDegree, k)
+		for l, j := range nbrs {
+			strength += a.weights[i][l]
+			if j > i {
+				assort.add(k, len(a.adj[j]))
+			}
+		}
+		t := localTriangles(a.adj, i, mark)
+		triangles += t
+		triples += k * (k - 1) / 2
+		if k >= 2 {
+			s.MeanClustering += 2 * float64(t) / float64(k*(k-1))
+		}
+	}
+
+	s.Edges = degrees / 2
+	s.Density = float64(degrees) / float64(n*(n-1))
+	s.MeanDegree = float64(degrees) / float64(n)
+	s.MeanStrength = strength / float64(n)
+	s.Triangles = triangles / 3
+	s.Transitivity = float64(triangles) / float64(triples)
+	s.MeanClustering /= float64(n)
+	s.Assortativity = assort.coefficient()
+	return s
+}
+
+// adjacency is an index-based adjacency
+// list representation of a graph.
+type adjacency struct {
+	nodes []graph.Node
+	// adj[i] holds the indices of the neighbors
+	// of nodes[i] and weights[i] holds the
+	// weights of the corresponding edges.
+	adj     [][]int
+	weights [][]float64
+}
+
+// newAdjacency returns the adjacency lists of g, excluding self edges.
+// If g is a graph.Weighted, its edge weights are recorded, otherwise
+// each edge has unit weight.
+func newAdjacency(g graph.Graph) adjacency {
+	nodes := graph.NodesOf(g.Nodes())
+	indexOf := make(map[int64]int, len(nodes))
+	for i, u := range nodes {
+		indexOf[u.ID()] = i
+	}
+	weight := func(_, _ int64) float64 { return 1 }
+	if wg, ok := g.(graph.Weighted); ok {
+		weight = func(uid, vid int64) float64 {
+			w, _ := wg.Weight(uid, vid)
+			return w
+		}
+	}
+	a := adjacency{
+		nodes:   nodes,
+		adj:     make([][]int, len(nodes)),
+		weights: make([][]float64, len(nodes)),
+	}
+	for i, u := range nodes {
+		uid := u.ID()
+		to := g.From(uid)
+		for to.Next() {
+			vid := to.Node().ID()
+			if vid == uid {
+				continue
+			}
+			a.adj[i] = append(a.adj[i], indexOf[vid])
+			a.weights[i] = append(a.weights[i], weight(uid, vid))
+		}
+	}
+	return a
+}
+
+// localTriangles returns the number of triangles through node i. mark
+// must be all false on entry and is left all false on return.
+func localTriangles(adj [][]int, i int, mark []bool) int {
+	for _, j := range adj[i] {
+		mark[j] = true
+	}
+	var t int
+	for _, j := range adj[i] {
+		for _, h := range adj[j] {
+			if h > j && mark[h] {
+				t++
+			}
+		}
+	}
+	for _, j := range adj[i] {
+		mark[j] = false
+	}
+	return t
+}
+
+// localClustering returns the clustering coefficient of node i. mark
+// must be all false on entry and is left all false on return.
+func localClustering(adj [][]int, i int, mark []bool) float64 {
+	k := len(adj[i])
+	if k < 2 {
+		return 0
+	}
+	return 2 * float64(localTriangles(adj, i, mark)) / float64(k*(k-1))
+}
+
+// assortativity accumulates the sums required to compute the
+// degree assortativity coefficient over the edges of a graph.
+type assortativity struct {
+	m, jk, j, j2 float64
+}
+
+// add adds an edge joining nodes of degree j and k.
+func (a *assortativity) add(j, k int) {
+	fj := float64(j)
+	fk := float64(k)
+	a.m++
+	a.jk += fj * fk
+	a.j += (fj + fk) / 2
+	a.j2 += (fj*fj + fk*fk) / 2
+}
+
+// coefficient returns the assortativity coefficient.
+func (a *assortativity) coefficient() float64 {
+	if a.m == 0 {
+		return math.NaN()
+	}
+	mean := a.j / a.m
+	den := a.j2/a.m - mean*mean
+	if den == 0 {
+		return math.NaN()
+	}
+	return (a.jk/a.m - mean*mean) / den
+}
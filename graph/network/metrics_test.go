@@ -0,0 +1,220 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package network
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/graphs/gen"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func undirectedFrom(edges [][2]int64) *simple.UndirectedGraph {
+	g := simple.NewUndirectedGraph()
+	for _, e := range edges {
+		g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+	return g
+}
+
+func TestStrength(t *testing.T) {
+	t.Parallel()
+	g := simple.NewWeightedUndirectedGraph(0, 0)
+	for _, e := range []simple.WeightedEdge{
+		{F: simple.Node(0), T: simple.Node(1), W: 2},
+		{F: simple.Node(1), T: simple.Node(2), W: 3},
+		{F: simple.Node(0), T: simple.Node(2), W: 0.5},
+	} {
+		g.SetWeightedEdge(e)
+	}
+	g.AddNode(simple.Node(3))
+	want := map[int64]float64{0: 2.5, 1: 5, 2: 3.5, 3: 0}
+	got := Strength(g)
+	for id, w := range want {
+		if got[id] != w {
+			t.Errorf("unexpected strength for node %d: got:%v want:%v", id, got[id], w)
+		}
+	}
+
+	// Unweighted graphs give the degree.
+	u := undirectedFrom([][2]int64{{0, 1}, {0, 2}, {0, 3}})
+	for id, w := range map[int64]float64{0: 3, 1: 1, 2: 1, 3: 1} {
+		if got := Strength(u)[id]; got != w {
+			t.Errorf("unexpected unweighted strength for node %d: got:%v want:%v", id, got, w)
+		}
+	}
+}
+
+func TestClustering(t *testing.T) {
+	t.Parallel()
+	// A triangle with a pendant node.
+	g := undirectedFrom([][2]int64{{0, 1}, {1, 2}, {0, 2}, {0, 3}})
+	want := map[int64]float64{0: 1.0 / 3, 1: 1, 2: 1, 3: 0}
+	got := Clustering(g)
+	for id, c := range want {
+		if !scalar.EqualWithinAbsOrRel(got[id], c, 1e-14, 1e-14) {
+			t.Errorf("unexpected clustering for node %d: got:%v want:%v", id, got[id], c)
+		}
+	}
+
+	// Uniform weights give the unweighted coefficients.
+	w := simple.NewWeightedUndirectedGraph(0, 0)
+	for _, e := range graph.EdgesOf(g.Edges()) {
+		w.SetWeightedEdge(simple.WeightedEdge{F: e.From(), T: e.To(), W: 3})
+	}
+	got = ClusteringWeighted(w)
+	for id, c := range want {
+		if !scalar.EqualWithinAbsOrRel(got[id], c, 1e-14, 1e-14) {
+			t.Errorf("unexpected weighted clustering with uniform weights for node %d: got:%v want:%v", id, got[id], c)
+		}
+	}
+
+	// A triangle with normalized weights 1/8, 1 and 1/8 has a
+	// geometric mean weight of 1/4.
+	w = simple.NewWeightedUndirectedGraph(0, 0)
+	for _, e := range []simple.WeightedEdge{
+		{F: simple.Node(0), T: simple.Node(1), W: 1},
+		{F: simple.Node(1), T: simple.Node(2), W: 8},
+		{F: simple.Node(0), T: simple.Node(2), W: 1},
+	} {
+		w.SetWeightedEdge(e)
+	}
+	for id, c := range ClusteringWeighted(w) {
+		if !scalar.EqualWithinAbsOrRel(c, 0.25, 1e-14, 1e-14) {
+			t.Errorf("unexpected weighted clustering for node %d: got:%v want:0.25", id, c)
+		}
+	}
+}
+
+func TestDegreeAssortativity(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name  string
+		edges [][2]int64
+		want  float64
+	}{
+		{name: "star", edges: [][2]int64{{0, 1}, {0, 2}, {0, 3}, {0, 4}}, want: -1},
+		{name: "path", edges: [][2]int64{{0, 1}, {1, 2}, {2, 3}}, want: -0.5},
+		{name: "cycle", edges: [][2]int64{{0, 1}, {1, 2}, {2, 3}, {3, 0}}, want: math.NaN()},
+		{name: "two stars", edges: [][2]int64{{0, 1}, {0, 2}, {0, 3}, {4, 5}, {4, 6}, {4, 7}, {0, 4}}, want: -0.75},
+	} {
+		got := DegreeAssortativity(undirectedFrom(test.edges))
+		if !scalar.Same(got, test.want) && !scalar.EqualWithinAbsOrRel(got, test.want, 1e-14, 1e-14) {
+			t.Errorf("unexpected assortativity for %s: got:%v want:%v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestRichClub(t *testing.T) {
+	t.Parallel()
+	nan := math.NaN()
+	for _, test := range []struct {
+		name  string
+		edges [][2]int64
+		want  []float64
+	}{
+		{name: "complete", edges: [][2]int64{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}}, want: []float64{1, 1, 1}},
+		{name: "star", edges: [][2]int64{{0, 1}, {0, 2}, {0, 3}}, want: []float64{0.5, nan, nan}},
+		{name: "two stars", edges: [][2]int64{{0, 1}, {0, 2}, {0, 3}, {4, 5}, {4, 6}, {4, 7}, {0, 4}}, want: []float64{0.25, 1, 1, 1}},
+	} {
+		got := RichClub(undirectedFrom(test.edges))
+		if len(got) != len(test.want) {
+			t.Errorf("unexpected rich-club length for %s: got:%d want:%d", test.name, len(got), len(test.want))
+			continue
+		}
+		for k := range got {
+			if !scalar.Same(got[k], test.want[k]) && !scalar.EqualWithinAbsOrRel(got[k], test.want[k], 1e-14, 1e-14) {
+				t.Errorf("unexpected rich-club coefficient for %s at k=%d: got:%v want:%v", test.name, k, got[k], test.want[k])
+			}
+		}
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	t.Parallel()
+	src := rand.NewSource(1)
+	for _, test := range []struct {
+		n int
+		p float64
+	}{
+		{n: 10, p: 0.3},
+		{n: 30, p: 0.2},
+		{n: 50, p: 0.5},
+	} {
+		g := simple.NewUndirectedGraph()
+		err := gen.Gnp(g, test.n, test.p, src)
+		if err != nil {
+			t.Fatalf("unexpected error generating graph: %v", err)
+		}
+		s := Summarize(g)
+
+		nodes := graph.NodesOf(g.Nodes())
+		var (
+			edges, maxDeg       int
+			triangles, triples  int
+			meanClustering, deg float64
+		)
+		clustering := Clustering(g)
+		for _, u := range nodes {
+			k := g.From(u.ID()).Len()
+			deg += float64(k)
+			maxDeg = max(maxDeg, k)
+			triples += k * (k - 1) / 2
+			meanClustering += clustering[u.ID()]
+		}
+		edges = g.Edges().Len()
+		for i, u := range nodes {
+			for j := i + 1; j < len(nodes); j++ {
+				for h := j + 1; h < len(nodes); h++ {
+					v, w := nodes[j], nodes[h]
+					if g.HasEdgeBetween(u.ID(), v.ID()) && g.HasEdgeBetween(v.ID(), w.ID()) && g.HasEdgeBetween(u.ID(), w.ID()) {
+						triangles++
+					}
+				}
+			}
+		}
+		n := float64(test.n)
+		want := Summary{
+			Nodes:          test.n,
+			Edges:          edges,
+			Density:        2 * float64(edges) / (n * (n - 1)),
+			MeanDegree:     deg / n,
+			MaxDegree:      maxDeg,
+			MeanStrength:   deg / n,
+			Triangles:      triangles,
+			Transitivity:   3 * float64(triangles) / float64(triples),
+			MeanClustering: meanClustering / n,
+			Assortativity:  DegreeAssortativity(g),
+		}
+		if s.Nodes != want.Nodes || s.Edges != want.Edges || s.MaxDegree != want.MaxDegree || s.Triangles != want.Triangles {
+			t.Errorf("unexpected summary counts for n=%d p=%v: got:%+v want:%+v", test.n, test.p, s, want)
+		}
+		for _, f := range []struct {
+			name      string
+			got, want float64
+		}{
+			{"density", s.Density, want.Density},
+			{"mean degree", s.MeanDegree, want.MeanDegree},
+			{"mean strength", s.MeanStrength, want.MeanStrength},
+			{"transitivity", s.Transitivity, want.Transitivity},
+			{"mean clustering", s.MeanClustering, want.MeanClustering},
+			{"assortativity", s.Assortativity, want.Assortativity},
+		} {
+			if !scalar.EqualWithinAbsOrRel(f.got, f.want, 1e-12, 1e-12) {
+				t.Errorf("unexpected %s for n=%d p=%v: got:%v want:%v", f.name, test.n, test.p, f.got, f.want)
+			}
+		}
+	}
+
+	empty := Summarize(simple.NewUndirectedGraph())
+	if empty.Nodes != 0 || !math.IsNaN(empty.Density) || !math.IsNaN(empty.Assortativity) {
+		t.Errorf("unexpected summary of empty graph: %+v", empty)
+	}
+}
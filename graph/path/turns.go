@@ -0,0 +1,229 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"container/heap"
+	"math"
+	"slices"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/traverse"
+)
+
+// TurnCost returns the cost of the turn made at the node with ID vid when a
+// path arrives from the node with ID uid and leaves toward the node with ID
+// wid, and whether the turn is permitted. The cost is added to the weight of
+// the path. A U-turn has uid == wid.
+type TurnCost func(uid, vid, wid int64) (cost float64, ok bool)
+
+// ShortestTurns is a shortest-path tree created by the DijkstraTurnsFrom
+// single-source shortest path function. Unlike a Shortest, the tree is held
+// over the edges of the graph rather than its nodes, so a shortest path to a
+// node may pass through the same node more than once, and a prefix of a
+// shortest path is not necessarily a shortest path.
+type ShortestTurns struct {
+	// from holds the source node given to
+	// the function that returned the
+	// ShortestTurns value.
+	from graph.Node
+
+	// nodes hold the nodes of the analysed
+	// graph reached by the search.
+	nodes []graph.Node
+	// indexOf contains a mapping between
+	// the id-dense representation of the
+	// graph and the potentially id-sparse
+	// nodes held in nodes.
+	indexOf map[int64]int
+
+	// states hold the edges of the graph
+	// traversed by the search as pairs of
+	// indices into nodes. The first state
+	// is the source with no incoming edge.
+	states  []turnState
+	stateOf map[turnState]int
+
+	// dist and next represent the shortest
+	// paths between states.
+	//
+	// Indices into dist and next are
+	// indices into states.
+	dist []float64
+	next []int
+
+	// best holds the index of the state
+	// with the shortest path to each node,
+	// or -1 if the node is unreachable.
+	best []int
+}
+
+// turnState is an edge from the node indexed
+// by from to the node indexed by to.
+type turnState struct {
+	from, to int
+}
+
+// node returns the index of u in p, adding it if it is not present.
+func (p *ShortestTurns) node(u graph.Node) int {
+	uid := u.ID()
+	if i, ok := p.indexOf[uid]; ok {
+		return i
+	}
+	i := len(p.nodes)
+	p.indexOf[uid] = i
+	p.nodes = append(p.nodes, u)
+	p.best = append(p.best, -1)
+	return i
+}
+
+// state returns the index of the state for the edge from the node indexed
+// by from to the node indexed by to, adding it if it is not present.
+func (p *ShortestTurns) state(from, to int) int {
+	s := turnState{from: from, to: to}
+	if i, ok := p.stateOf[s]; ok {
+		return i
+	}
+	i := len(p.states)
+	p.stateOf[s] = i
+	p.states = append(p.states, s)
+	p.dist = append(p.dist, math.Inf(1))
+	p.next = append(p.next, -1)
+	return i
+}
+
+// From returns the starting node of the paths held by the ShortestTurns.
+func (p ShortestTurns) From() graph.Node { return p.from }
+
+// WeightTo returns the weight of the minimum path to v.
+func (p ShortestTurns) WeightTo(vid int64) float64 {
+	to, ok := p.indexOf[vid]
+	if !ok || p.best[to] < 0 {
+		return math.Inf(1)
+	}
+	return p.dist[p.best[to]]
+}
+
+// To returns a shortest path to v and the weight of the path. The path may
+// include a node more than once.
+func (p ShortestTurns) To(vid int64) (path []graph.Node, weight float64) {
+	to, ok := p.indexOf[vid]
+	if !ok || p.best[to] < 0 {
+		return nil, math.Inf(1)
+	}
+	s := p.best[to]
+	weight = p.dist[s]
+	for ; s >= 0; s = p.next[s] {
+		path = append(path, p.nodes[p.states[s].to])
+	}
+	slices.Reverse(path)
+	return path, weight
+}
+
+// DijkstraTurnsFrom returns a shortest-path tree for shortest paths from u to
+// all nodes reachable from u in the graph g, subject to the turn costs and
+// restrictions given by turn. If the graph does not implement Weighted,
+// UniformCost is used. If turn is nil, all turns are permitted at no cost and
+// the path weights are the same as those found by DijkstraFrom.
+//
+// The search is performed over the edge-based expansion of g, in which each
+// edge of g is a node and each permitted turn between a pair of consecutive
+// edges is an edge, so no expanded graph needs to be constructed by the
+// caller. DijkstraTurnsFrom will panic if g has a u-reachable negative edge
+// weight or a negative turn cost.
+//
+// The time complexity of DijkstraTurnsFrom is O(T.log|E|), where T is the
+// number of permitted turns in g.
+func DijkstraTurnsFrom(u graph.Node, g traverse.Graph, turn TurnCost) ShortestTurns {
+	if h, ok := g.(graph.Graph); ok {
+		if h.Node(u.ID()) == nil {
+			return ShortestTurns{from: u}
+		}
+	} else if g.From(u.ID()) == graph.Empty {
+		return ShortestTurns{from: u}
+	}
+
+	var weight Weighting
+	if wg, ok := g.(Weighted); ok {
+		weight = wg.Weight
+	} else {
+		weight = UniformCost(g)
+	}
+
+	p := ShortestTurns{
+		from:    u,
+		indexOf: make(map[int64]int),
+		stateOf: make(map[turnState]int),
+	}
+	start := p.state(-1, p.node(u))
+	p.dist[start] = 0
+
+	Q := turnQueue{{state: start, dist: 0}}
+	for Q.Len() != 0 {
+		mid := heap.Pop(&Q).(turnDistance)
+		if mid.dist > p.dist[mid.state] {
+			continue
+		}
+		cur := p.states[mid.state]
+		if p.best[cur.to] < 0 {
+			// States are popped in order of increasing distance
+			// so the first state reaching a node is the best.
+			p.best[cur.to] = mid.state
+		}
+		vid := p.nodes[cur.to].ID()
+		to := g.From(vid)
+		for to.Next() {
+			w := to.Node()
+			wid := w.ID()
+			c, ok := weight(vid, wid)
+			if !ok {
+				panic("dijkstra: unexpected invalid weight")
+			}
+			if c < 0 {
+				panic("dijkstra: negative edge weight")
+			}
+			if cur.from >= 0 && turn != nil {
+				tc, ok := turn(p.nodes[cur.from].ID(), vid, wid)
+				if !ok {
+					continue
+				}
+				if tc < 0 {
+					panic("dijkstra: negative turn cost")
+				}
+				c += tc
+			}
+			k := p.state(cur.to, p.node(w))
+			joint := mid.dist + c
+			if joint < p.dist[k] {
+				p.dist[k] = joint
+				p.next[k] = mid.state
+				heap.Push(&Q, turnDistance{state: k, dist: joint})
+			}
+		}
+	}
+
+	return p
+}
+
+// turnDistance is a state of an edge-based
+// search and its distance from the source.
+type turnDistance struct {
+	state int
+	dist  float64
+}
+
+// turnQueue implements a no-dec priority queue.
+type turnQueue []turnDistance
+
+func (q turnQueue) Len() int            { return len(q) }
+func (q turnQueue) Less(i, j int) bool  { return q[i].dist < q[j].dist }
+func (q turnQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *turnQueue) Push(n interface{}) { *q = append(*q, n.(turnDistance)) }
+func (q *turnQueue) Pop() interface{} {
+	t := *q
+	var n interface{}
+	n, *q = t[len(t)-1], t[:len(t)-1]
+	return n
+}
@@ -0,0 +1,184 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package path
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/path/internal/testgraphs"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/traverse"
+)
+
+func TestDijkstraTurnsFromUnrestricted(t *testing.T) {
+	t.Parallel()
+	for _, test := range testgraphs.ShortestPathTests {
+		if test.HasNegativeWeight {
+			continue
+		}
+		g := test.Graph()
+		for _, e := range test.Edges {
+			g.SetWeightedEdge(e)
+		}
+
+		for _, tg := range []struct {
+			typ string
+			g   traverse.Graph
+		}{
+			{"complete", g.(graph.Graph)},
+			{"incremental", incremental{g.(graph.Weighted)}},
+		} {
+			pt := DijkstraTurnsFrom(test.Query.From(), tg.g, nil)
+
+			if pt.From().ID() != test.Query.From().ID() {
+				t.Fatalf("%q %s: unexpected from node ID: got:%d want:%d", test.Name, tg.typ, pt.From().ID(), test.Query.From().ID())
+			}
+
+			p, weight := pt.To(test.Query.To().ID())
+			if weight != test.Weight {
+				t.Errorf("%q %s: unexpected weight from To: got:%f want:%f",
+					test.Name, tg.typ, weight, test.Weight)
+			}
+			if weight := pt.WeightTo(test.Query.To().ID()); weight != test.Weight {
+				t.Errorf("%q %s: unexpected weight from Weight: got:%f want:%f",
+					test.Name, tg.typ, weight, test.Weight)
+			}
+
+			var got []int64
+			for _, n := range p {
+				got = append(got, n.ID())
+			}
+			ok := len(got) == 0 && len(test.WantPaths) == 0
+			for _, sp := range test.WantPaths {
+				if reflect.DeepEqual(got, sp) {
+					ok = true
+					break
+				}
+			}
+			if !ok {
+				t.Errorf("%q %s: unexpected shortest path:\ngot: %v\nwant from:%v",
+					test.Name, tg.typ, p, test.WantPaths)
+			}
+
+			np, weight := pt.To(test.NoPathFor.To().ID())
+			if pt.From().ID() == test.NoPathFor.From().ID() && (np != nil || !math.IsInf(weight, 1)) {
+				t.Errorf("%q %s: unexpected path:\ngot: path=%v weight=%f\nwant:path=<nil> weight=+Inf",
+					test.Name, tg.typ, np, weight)
+			}
+		}
+	}
+}
+
+func TestDijkstraTurnsFrom(t *testing.T) {
+	t.Parallel()
+	// The direct route from 0 to 3 turns at 1. Avoiding
+	// the turn requires going around the loop 1→2→4→1.
+	g := simple.NewDirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {1, 3}, {1, 2}, {2, 4}, {4, 1}} {
+		g.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+	for _, test := range []struct {
+		name   string
+		turn   TurnCost
+		path   []int64
+		weight float64
+	}{
+		{
+			name:   "unrestricted",
+			turn:   nil,
+			path:   []int64{0, 1, 3},
+			weight: 2,
+		},
+		{
+			name: "forbidden",
+			turn: func(uid, vid, wid int64) (float64, bool) {
+				return 0, !(uid == 0 && vid == 1 && wid == 3)
+			},
+			path:   []int64{0, 1, 2, 4, 1, 3},
+			weight: 5,
+		},
+		{
+			name: "high penalty",
+			turn: func(uid, vid, wid int64) (float64, bool) {
+				if uid == 0 && vid == 1 && wid == 3 {
+					return 10, true
+				}
+				return 0, true
+			},
+			path:   []int64{0, 1, 2, 4, 1, 3},
+			weight: 5,
+		},
+		{
+			name: "low penalty",
+			turn: func(uid, vid, wid int64) (float64, bool) {
+				if uid == 0 && vid == 1 && wid == 3 {
+					return 2, true
+				}
+				return 0, true
+			},
+			path:   []int64{0, 1, 3},
+			weight: 4,
+		},
+		{
+			name: "unreachable",
+			turn: func(uid, vid, wid int64) (float64, bool) {
+				return 0, wid != 3
+			},
+			path:   nil,
+			weight: math.Inf(1),
+		},
+	} {
+		pt := DijkstraTurnsFrom(simple.Node(0), g, test.turn)
+		p, weight := pt.To(3)
+		var got []int64
+		for _, n := range p {
+			got = append(got, n.ID())
+		}
+		if !reflect.DeepEqual(got, test.path) {
+			t.Errorf("%q: unexpected path: got:%v want:%v", test.name, got, test.path)
+		}
+		if weight != test.weight {
+			t.Errorf("%q: unexpected weight: got:%v want:%v", test.name, weight, test.weight)
+		}
+		if w := pt.WeightTo(3); w != test.weight {
+			t.Errorf("%q: unexpected weight from WeightTo: got:%v want:%v", test.name, w, test.weight)
+		}
+	}
+
+	// Forbidding U-turns does not prevent leaving
+	// the source in either direction.
+	u := simple.NewUndirectedGraph()
+	for _, e := range [][2]int64{{0, 1}, {1, 2}} {
+		u.SetEdge(simple.Edge{F: simple.Node(e[0]), T: simple.Node(e[1])})
+	}
+	noUTurn := func(uid, _, wid int64) (float64, bool) { return 0, uid != wid }
+	pt := DijkstraTurnsFrom(simple.Node(1), u, noUTurn)
+	if w := pt.WeightTo(2); w != 1 {
+		t.Errorf("unexpected weight to neighbor: got:%v want:1", w)
+	}
+	if p, _ := pt.To(0); len(p) != 2 {
+		t.Errorf("unexpected path to neighbor: got:%v", p)
+	}
+	if w := pt.WeightTo(1); w != 0 {
+		t.Errorf("unexpected weight to source: got:%v want:0", w)
+	}
+
+	neg := func(_, _, _ int64) (float64, bool) { return -1, true }
+	if !panics(func() { DijkstraTurnsFrom(simple.Node(0), g, neg) }) {
+		t.Error("expected panic for negative turn cost")
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		r := recover()
+		panicked = r != nil
+	}()
+	fn()
+	return
+}
@@ -0,0 +1,127 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package traverse
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/graph"
+	"gonum.org/v1/gonum/graph/graphs/gen"
+	"gonum.org/v1/gonum/graph/multi"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+// graph500Roots is the number of search roots used for
+// each graph in the Graph500 benchmark.
+const graph500Roots = 64
+
+// graph500 returns a Graph500 R-MAT graph of the given scale.
+func graph500(scale int) *simple.UndirectedGraph {
+	g := simple.NewUndirectedGraph()
+	err := gen.RMAT(g, scale, 16, 0.57, 0.19, 0.19, rand.NewSource(1))
+	if err != nil {
+		panic(fmt.Sprintf("traverse: bad benchmark: %v", err))
+	}
+	return g
+}
+
+// asWeighted returns a copy of g as a simple.WeightedUndirectedGraph.
+func asWeighted(g *simple.UndirectedGraph) graph.Undirected {
+	dst := simple.NewWeightedUndirectedGraph(0, 0)
+	nodes := g.Nodes()
+	for nodes.Next() {
+		dst.AddNode(nodes.Node())
+	}
+	edges := g.Edges()
+	for edges.Next() {
+		e := edges.Edge()
+		dst.SetWeightedEdge(dst.NewWeightedEdge(e.From(), e.To(), 1))
+	}
+	return dst
+}
+
+// asMulti returns a copy of g as a multi.UndirectedGraph.
+func asMulti(g *simple.UndirectedGraph) graph.Undirected {
+	dst := multi.NewUndirectedGraph()
+	nodes := g.Nodes()
+	for nodes.Next() {
+		dst.AddNode(nodes.Node())
+	}
+	edges := g.Edges()
+	for edges.Next() {
+		e := edges.Edge()
+		dst.SetLine(dst.NewLine(e.From(), e.To()))
+	}
+	return dst
+}
+
+// graph500Search returns the search roots for g and the number of edges in
+// the connected components of the roots, summed over all roots.
+func graph500Search(g graph.Undirected) (roots []graph.Node, edges int) {
+	// Roots are chosen at random from the nodes
+	// that have at least one neighbor.
+	var candidates []graph.Node
+	nodes := g.Nodes()
+	for nodes.Next() {
+		n := nodes.Node()
+		if g.From(n.ID()).Len() != 0 {
+			candidates = append(candidates, n)
+		}
+	}
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < graph500Roots && len(candidates) != 0; i++ {
+		roots = append(roots, candidates[rnd.Intn(len(candidates))])
+	}
+
+	// The number of traversed edges for each search is
+	// the number of edges in the component of the root.
+	for _, r := range roots {
+		var degrees int
+		bft := BreadthFirst{Visit: func(n graph.Node) {
+			degrees += g.From(n.ID()).Len()
+		}}
+		bft.Walk(g, r, nil)
+		edges += degrees / 2
+	}
+	return roots, edges
+}
+
+// benchmarkGraph500BreadthFirst measures breadth-first search throughput
+// over g, reporting the number of traversed edges per second as the TEPS
+// metric.
+func benchmarkGraph500BreadthFirst(b *testing.B, g graph.Undirected) {
+	roots, edges := graph500Search(g)
+	b.ResetTimer()
+	var bft BreadthFirst
+	for i := 0; i < b.N; i++ {
+		for _, r := range roots {
+			bft.Reset()
+			bft.Walk(g, r, nil)
+		}
+	}
+	b.ReportMetric(float64(b.N)*float64(edges)/b.Elapsed().Seconds(), "TEPS")
+}
+
+func BenchmarkGraph500BreadthFirstSimple_10(b *testing.B) {
+	benchmarkGraph500BreadthFirst(b, graph500(10))
+}
+func BenchmarkGraph500BreadthFirstSimple_12(b *testing.B) {
+	benchmarkGraph500BreadthFirst(b, graph500(12))
+}
+func BenchmarkGraph500BreadthFirstWeighted_10(b *testing.B) {
+	benchmarkGraph500BreadthFirst(b, asWeighted(graph500(10)))
+}
+func BenchmarkGraph500BreadthFirstWeighted_12(b *testing.B) {
+	benchmarkGraph500BreadthFirst(b, asWeighted(graph500(12)))
+}
+func BenchmarkGraph500BreadthFirstMulti_10(b *testing.B) {
+	benchmarkGraph500BreadthFirst(b, asMulti(graph500(10)))
+}
+func BenchmarkGraph500BreadthFirstMulti_12(b *testing.B) {
+	benchmarkGraph500BreadthFirst(b, asMulti(graph500(12)))
+}
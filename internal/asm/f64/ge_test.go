@@ -149,7 +149,7 @@ func TestGer(t *testing.T) {
 			}
 		}
 
-		for _, inc := range newIncSet(1, 2) {
+		for _, inc := range newIncSet(-3, -1, 1, 2) {
 			prefix := fmt.Sprintf("Test %v (%vx%v) inc(x:%v,y:%v)", i, m, n, inc.x, inc.y)
 			xg := guardIncVector(test.x, xGdVal, inc.x, gdLn)
 			yg := guardIncVector(test.y, yGdVal, inc.y, gdLn)
@@ -160,7 +160,15 @@ func TestGer(t *testing.T) {
 			alpha := 3.5
 			Ger(uintptr(m), uintptr(n), alpha, x, uintptr(inc.x), y, uintptr(inc.y), a, uintptr(n))
 			for i := range test.want {
-				want := alpha*(test.want[i]-test.a[i]) + test.a[i]
+				// Negative increments reverse the order of the elements.
+				r, c := i/n, i%n
+				if inc.x < 0 {
+					r = m - 1 - r
+				}
+				if inc.y < 0 {
+					c = n - 1 - c
+				}
+				want := test.a[i] + alpha*test.x[r]*test.y[c]
 				if !sameApprox(a[i], want, tol) {
 					t.Errorf(msgVal, prefix, i, a[i], want)
 				}
@@ -0,0 +1,184 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dbdsvdx computes selected singular values and, optionally, the
+// corresponding singular vectors of an n×n bidiagonal matrix B. The singular
+// value decomposition of B is
+//
+//	B = U * S * Vᵀ
+//
+// where S is a diagonal matrix of singular values and U and V are orthogonal
+// matrices of left and right singular vectors.
+//
+// The singular values are computed as the non-negative eigenvalues of the
+// 2n×2n symmetric tridiagonal Golub–Kahan matrix
+//
+//	TGK = tridiag(0; d[0], e[0], d[1], e[1], ..., d[n-1]; 0)
+//
+// by bisection with Dstebz, and the singular vectors are extracted from its
+// eigenvectors computed by inverse iteration with Dstein. Computing a subset
+// of the singular values costs O(n) per singular value.
+//
+// If uplo == blas.Upper, B is upper bidiagonal with diagonal d and
+// super-diagonal e, otherwise B is lower bidiagonal with diagonal d and
+// sub-diagonal e. d must have length at least n and e must have length at
+// least n-1. Neither is modified.
+//
+// rng specifies which singular values are computed:
+//
+//	rng == lapack.EVRangeAll:   all singular values are computed,
+//	rng == lapack.EVRangeValue: the singular values in the half-open
+//	                            interval (vl, vu] are computed,
+//	rng == lapack.EVRangeIndex: the singular values with indices il through
+//	                            iu inclusive, counted from zero in descending
+//	                            order, are computed.
+//
+// vl and vu are only referenced if rng == lapack.EVRangeValue, in which case
+// they must satisfy 0 <= vl < vu. il and iu are only referenced if rng ==
+// lapack.EVRangeIndex, in which case they must satisfy 0 <= il <= iu < n if
+// n > 0.
+//
+// On return, the first ns elements of s contain the computed singular values
+// in descending order, where ns is the returned number of singular values. s
+// must have length at least n.
+//
+// If jobz == lapack.EVCompute, the first ns columns of the 2n×ncols matrix Z
+// contain the singular vectors on return, with the left singular vector u_i
+// in the first n rows of column i and the right singular vector v_i in the
+// last n rows. ncols is iu-il+1 if rng == lapack.EVRangeIndex and n
+// otherwise, and ldz must be at least max(1, ncols). z is not referenced if
+// jobz == lapack.EVNone.
+//
+// Dbdsvdx returns whether all singular vectors converged. It always returns
+// true if jobz == lapack.EVNone.
+//
+// Dbdsvdx is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dbdsvdx(uplo blas.Uplo, jobz lapack.EVJob, rng lapack.EVRange, n int, d, e []float64, vl, vu float64, il, iu int, s, z []float64, ldz int) (ns int, ok bool) {
+	wantz := jobz == lapack.EVCompute
+	ncols := n
+	if rng == lapack.EVRangeIndex {
+		ncols = iu - il + 1
+	}
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case jobz != lapack.EVCompute && jobz != lapack.EVNone:
+		panic(badEVJob)
+	case rng != lapack.EVRangeAll && rng != lapack.EVRangeValue && rng != lapack.EVRangeIndex:
+		panic(badEVRange)
+	case n < 0:
+		panic(nLT0)
+	case rng == lapack.EVRangeValue && vl < 0:
+		panic(vlLT0)
+	case rng == lapack.EVRangeValue && !(vl < vu):
+		panic(badVlVu)
+	case rng == lapack.EVRangeIndex && n > 0 && (il < 0 || n <= il):
+		panic(badIl)
+	case rng == lapack.EVRangeIndex && n > 0 && (iu < il || n <= iu):
+		panic(badIu)
+	case wantz && ldz < max(1, ncols):
+		panic(badLdZ)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0, true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case len(s) < n:
+		panic(shortS)
+	case wantz && len(z) < (2*n-1)*ldz+ncols:
+		panic(shortZ)
+	}
+
+	// Form the Golub–Kahan matrix.
+	n2 := 2 * n
+	td := make([]float64, n2)
+	te := make([]float64, n2-1)
+	for i := 0; i < n; i++ {
+		te[2*i] = d[i]
+		if i < n-1 {
+			te[2*i+1] = e[i]
+		}
+	}
+
+	// The eigenvalues of TGK are ±s_i, so the wanted singular
+	// values are the corresponding non-negative eigenvalues.
+	w := make([]float64, n2)
+	switch rng {
+	case lapack.EVRangeAll:
+		ns = impl.Dstebz(lapack.EVRangeIndex, n2, 0, 0, n, n2-1, 0, td, te, w)
+	case lapack.EVRangeValue:
+		ns = impl.Dstebz(lapack.EVRangeValue, n2, vl, vu, 0, 0, 0, td, te, w)
+	case lapack.EVRangeIndex:
+		ns = impl.Dstebz(lapack.EVRangeIndex, n2, 0, 0, n2-1-iu, n2-1-il, 0, td, te, w)
+	}
+	for i := 0; i < ns; i++ {
+		// Eigenvalues of TGK that are zero may be
+		// computed with a tiny negative value.
+		s[i] = max(0, w[ns-1-i])
+	}
+	if !wantz || ns == 0 {
+		return ns, true
+	}
+
+	// Compute the eigenvectors of TGK for both s_i and -s_i so
+	// that inverse iteration reorthogonalizes each eigenvector
+	// against the eigenvectors of nearby negative eigenvalues.
+	// Otherwise the eigenvectors of small singular values would
+	// be contaminated by those of their negatives.
+	wpm := make([]float64, 2*ns)
+	for j := 0; j < ns; j++ {
+		wpm[ns+j] = s[ns-1-j]
+		wpm[ns-1-j] = -s[ns-1-j]
+	}
+	ldt := 2 * ns
+	ztgk := make([]float64, n2*ldt)
+	ok = impl.Dstein(n2, td, te, 2*ns, wpm, ztgk, ldt)
+
+	// The eigenvector of TGK for s_i interleaves the right and
+	// left singular vectors of an upper bidiagonal B, each
+	// scaled by 1/√2, as [v_0, u_0, v_1, u_1, ...]. The roles are
+	// reversed for a lower bidiagonal B. Normalizing u and v
+	// separately removes any remaining component of the
+	// eigenvector of -s_i. When s_i is zero, the eigenvectors of
+	// s_i and -s_i span the null space of TGK and either may
+	// lack one of the parts, which is then taken from the other.
+	uOff, vOff := 1, 0
+	if uplo == blas.Lower {
+		uOff, vOff = 0, 1
+	}
+	bi := blas64.Implementation()
+	for i := 0; i < ns; i++ {
+		pos := ns + (ns - 1 - i)
+		neg := ns - 1 - (ns - 1 - i)
+		for _, part := range []struct{ off, row int }{{uOff, 0}, {vOff, n}} {
+			col := pos
+			if bi.Dnrm2(n, ztgk[part.off*ldt+pos:], 2*ldt) < 0.25 &&
+				bi.Dnrm2(n, ztgk[part.off*ldt+neg:], 2*ldt) > bi.Dnrm2(n, ztgk[part.off*ldt+pos:], 2*ldt) {
+				col = neg
+			}
+			for k := 0; k < n; k++ {
+				z[(part.row+k)*ldz+i] = ztgk[(2*k+part.off)*ldt+col]
+			}
+			if nrm := bi.Dnrm2(n, z[part.row*ldz+i:], ldz); nrm != 0 {
+				bi.Dscal(n, 1/nrm, z[part.row*ldz+i:], ldz)
+			}
+		}
+	}
+	return ns, ok
+}
@@ -0,0 +1,139 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dgbtrf computes an LU factorization of an m×n band matrix A with kl
+// subdiagonals and ku superdiagonals using partial pivoting with row
+// interchanges. The factorization has the form
+//
+//	A = P * L * U,
+//
+// where P is a permutation matrix, L is lower triangular with unit diagonal
+// elements and at most kl non-zero elements below the diagonal in each column,
+// and U is upper triangular with at most kl+ku superdiagonals.
+//
+// On entry, ab contains A in band storage with kl+(kl+ku) bands: the element
+// A[i,j] for max(0,i-kl) <= j <= min(n-1,i+ku) is stored in
+//
+//	ab[i*ldab+kl+j-i],
+//
+// so ldab must be at least 2*kl+ku+1. The elements in the last kl columns of
+// each row of ab are used for fill-in and need not be set on entry. For
+// example, when m = n = 6, kl = 1 and ku = 2, the band storage of A is
+//
+//	On entry:
+//	  *   a00  a01  a02   +
+//	 a10  a11  a12  a13   +
+//	 a21  a22  a23  a24   +
+//	 a32  a33  a34  a35   *
+//	 a43  a44  a45   *    *
+//	 a54  a55   *    *    *
+//
+// where the elements marked * are not used and the elements marked + are
+// used for fill-in.
+//
+// On return, the upper triangular factor U is stored in ab with U[i,j] at
+// ab[i*ldab+kl+j-i] for i <= j <= min(n-1,i+kl+ku), and the multipliers used
+// during the factorization are stored in the first kl columns of ab.
+//
+// ipiv contains the pivot indices and must have length min(m,n). Row i of the
+// matrix was interchanged with row ipiv[i]. ipiv is zero-indexed.
+//
+// Dgbtrf returns whether U is nonsingular. The factorization is completed
+// regardless of the singularity of U, but it must not be used to solve a
+// system of equations if U is singular.
+func (Implementation) Dgbtrf(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) (ok bool) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case kl < 0:
+		panic(klLT0)
+	case ku < 0:
+		panic(kuLT0)
+	case ldab < 2*kl+ku+1:
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	mn := min(m, n)
+	if mn == 0 {
+		return true
+	}
+
+	switch {
+	case len(ab) < (min(m, n+kl)-1)*ldab+2*kl+ku+1:
+		panic(shortAB)
+	case len(ipiv) != mn:
+		panic(badLenIpiv)
+	}
+
+	// Zero the fill-in elements.
+	for i := 0; i < min(m, n+kl); i++ {
+		for j := kl + ku + 1; j < 2*kl+ku+1; j++ {
+			ab[i*ldab+j] = 0
+		}
+	}
+
+	// at returns the index of A[i,j] in ab.
+	at := func(i, j int) int { return i*ldab + kl + j - i }
+
+	bi := blas64.Implementation()
+	ok = true
+	// ju is the index of the last column affected
+	// by the current stage of the factorization.
+	var ju int
+	for j := 0; j < mn; j++ {
+		// Find the pivot in column j.
+		km := min(kl, m-1-j)
+		p := j
+		pmax := math.Abs(ab[at(j, j)])
+		for i := j + 1; i <= j+km; i++ {
+			if v := math.Abs(ab[at(i, j)]); v > pmax {
+				p = i
+				pmax = v
+			}
+		}
+		ipiv[j] = p
+		if ab[at(p, j)] == 0 {
+			// U[j,j] is exactly zero so the column
+			// is already eliminated.
+			ok = false
+			continue
+		}
+		ju = max(ju, min(p+ku, n-1))
+
+		// Apply the interchange to columns j:ju.
+		if p != j {
+			bi.Dswap(ju-j+1, ab[at(p, j):], 1, ab[at(j, j):], 1)
+		}
+		if km == 0 {
+			continue
+		}
+
+		// Compute the multipliers and update the
+		// trailing submatrix within the band.
+		r := 1 / ab[at(j, j)]
+		for i := j + 1; i <= j+km; i++ {
+			ab[at(i, j)] *= r
+		}
+		if ju > j {
+			for i := j + 1; i <= j+km; i++ {
+				l := ab[at(i, j)]
+				if l != 0 {
+					bi.Daxpy(ju-j, -l, ab[at(j, j+1):], 1, ab[at(i, j+1):], 1)
+				}
+			}
+		}
+	}
+	return ok
+}
@@ -0,0 +1,100 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dgbtrs solves a system of linear equations
+//
+//	A * X = B   if trans == blas.NoTrans
+//	Aᵀ * X = B  if trans == blas.Trans or blas.ConjTrans
+//
+// with an n×n band matrix A with kl subdiagonals and ku superdiagonals using
+// the LU factorization computed by Dgbtrf. See the documentation for Dgbtrf
+// for a description of the band storage format of the factorization in ab.
+// ipiv contains the pivot indices returned by Dgbtrf and must have length n.
+//
+// On entry, b contains the n×nrhs right hand side matrix B. On return, it is
+// overwritten with the solution matrix X.
+func (Implementation) Dgbtrs(trans blas.Transpose, n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int, b []float64, ldb int) {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case n < 0:
+		panic(nLT0)
+	case kl < 0:
+		panic(klLT0)
+	case ku < 0:
+		panic(kuLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case ldab < 2*kl+ku+1:
+		panic(badLdA)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+2*kl+ku+1:
+		panic(shortAB)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	}
+
+	bi := blas64.Implementation()
+	if trans == blas.NoTrans {
+		// Solve L*Y = B, overwriting B with Y, by applying the
+		// interchanges and multipliers in the order they were
+		// computed.
+		if kl > 0 {
+			for j := 0; j < n-1; j++ {
+				if p := ipiv[j]; p != j {
+					bi.Dswap(nrhs, b[p*ldb:], 1, b[j*ldb:], 1)
+				}
+				for i := j + 1; i <= min(j+kl, n-1); i++ {
+					l := ab[i*ldab+kl+j-i]
+					if l != 0 {
+						bi.Daxpy(nrhs, -l, b[j*ldb:], 1, b[i*ldb:], 1)
+					}
+				}
+			}
+		}
+		// Solve U*X = Y, overwriting Y with X.
+		for j := 0; j < nrhs; j++ {
+			bi.Dtbsv(blas.Upper, blas.NoTrans, blas.NonUnit, n, kl+ku, ab[kl:], ldab, b[j:], ldb)
+		}
+		return
+	}
+
+	// Solve Uᵀ*Y = B, overwriting B with Y.
+	for j := 0; j < nrhs; j++ {
+		bi.Dtbsv(blas.Upper, blas.Trans, blas.NonUnit, n, kl+ku, ab[kl:], ldab, b[j:], ldb)
+	}
+	// Solve Lᵀ*X = Y, overwriting Y with X, by applying the
+	// multipliers and interchanges in reverse order.
+	if kl > 0 {
+		for j := n - 2; j >= 0; j-- {
+			for i := j + 1; i <= min(j+kl, n-1); i++ {
+				l := ab[i*ldab+kl+j-i]
+				if l != 0 {
+					bi.Daxpy(nrhs, -l, b[i*ldb:], 1, b[j*ldb:], 1)
+				}
+			}
+			if p := ipiv[j]; p != j {
+				bi.Dswap(nrhs, b[p*ldb:], 1, b[j*ldb:], 1)
+			}
+		}
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dggev computes the generalized eigenvalues and, optionally, the left and/or
+// right generalized eigenvectors for a pair of n×n real nonsymmetric matrices
+// (A,B).
+//
+// A generalized eigenvalue for a pair of matrices (A,B) is a scalar λ or a
+// ratio α/β = λ, such that A - λ*B is singular. It is usually represented as
+// the pair (α,β), as there is a reasonable interpretation for β = 0, and even
+// for both being zero.
+//
+// The right generalized eigenvector v_j corresponding to the generalized
+// eigenvalue λ_j of (A,B) satisfies
+//
+//	A * v_j = λ_j * B * v_j,
+//
+// and the left generalized eigenvector u_j corresponding to λ_j satisfies
+//
+//	u_jᴴ * A = λ_j * u_jᴴ * B,
+//
+// where u_jᴴ is the conjugate transpose of u_j.
+//
+// On return, (alphar[j] + alphai[j]*i)/beta[j], j = 0, ..., n-1, will be the
+// generalized eigenvalues. If alphai[j] is zero, the j-th eigenvalue is real.
+// If alphai[j] is positive, the j-th and (j+1)-th eigenvalues are a complex
+// conjugate pair and alphai[j+1] is negative. beta is non-negative.
+//
+// The quotients alphar[j]/beta[j] and alphai[j]/beta[j] may easily over- or
+// underflow, and beta[j] may even be zero. Thus, the user should avoid naively
+// computing the ratio α/β. However, alphar and alphai will be always less than
+// and usually comparable with norm(A) in magnitude, and beta always less than
+// and usually comparable with norm(B).
+//
+// alphar, alphai and beta must have length n, otherwise Dggev will panic.
+//
+// On return, A and B will be overwritten. The left and right eigenvectors will
+// be stored, respectively, in the columns of the n×n matrices VL and VR in the
+// same order as their eigenvalues. If the j-th eigenvalue is real, then
+//
+//	u_j = VL[:,j],
+//	v_j = VR[:,j],
+//
+// and if it is not real, then j and j+1 form a complex conjugate pair and the
+// eigenvectors can be recovered as
+//
+//	u_j     = VL[:,j] + i*VL[:,j+1],
+//	u_{j+1} = VL[:,j] - i*VL[:,j+1],
+//	v_j     = VR[:,j] + i*VR[:,j+1],
+//	v_{j+1} = VR[:,j] - i*VR[:,j+1],
+//
+// where i is the imaginary unit. Each eigenvector is normalized so that the
+// largest component has |real part| + |imaginary part| = 1.
+//
+// Left eigenvectors will be computed only if jobvl == lapack.LeftEVCompute,
+// otherwise jobvl must be lapack.LeftEVNone.
+// Right eigenvectors will be computed only if jobvr == lapack.RightEVCompute,
+// otherwise jobvr must be lapack.RightEVNone.
+// For other values of jobvl and jobvr Dggev will panic.
+//
+// work must have length at least lwork and lwork must be at least max(1,8*n).
+// For good performance, lwork must generally be larger. On return, the optimal
+// value of lwork will be stored in work[0].
+//
+// If lwork == -1, instead of performing Dggev, the function only calculates
+// the optimal value of lwork and stores it into work[0].
+//
+// Dggev returns whether the QZ iteration converged. If it did not converge, no
+// eigenvectors have been computed and the contents of alphar, alphai and beta
+// are unspecified.
+func (impl Implementation) Dggev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vl []float64, ldvl int, vr []float64, ldvr int, work []float64, lwork int) (ok bool) {
+	wantvl := jobvl == lapack.LeftEVCompute
+	wantvr := jobvr == lapack.RightEVCompute
+	minwrk := max(1, 8*n)
+	switch {
+	case jobvl != lapack.LeftEVCompute && jobvl != lapack.LeftEVNone:
+		panic(badLeftEVJob)
+	case jobvr != lapack.RightEVCompute && jobvr != lapack.RightEVNone:
+		panic(badRightEVJob)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case ldvl < 1 || (ldvl < n && wantvl):
+		panic(badLdVL)
+	case ldvr < 1 || (ldvr < n && wantvr):
+		panic(badLdVR)
+	case lwork < minwrk && lwork != -1:
+		panic(badLWork)
+	case len(work) < lwork:
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return true
+	}
+
+	maxwrk := max(minwrk, n+n*impl.Ilaenv(1, "DGEQRF", " ", n, 1, n, 0))
+	maxwrk = max(maxwrk, n+n*impl.Ilaenv(1, "DORMQR", " ", n, 1, n, 0))
+	if wantvl {
+		maxwrk = max(maxwrk, n+n*impl.Ilaenv(1, "DORGQR", " ", n, 1, n, -1))
+	}
+	if lwork == -1 {
+		work[0] = float64(maxwrk)
+		return true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+n:
+		panic(shortB)
+	case len(alphar) != n:
+		panic(badLenAlphar)
+	case len(alphai) != n:
+		panic(badLenAlphai)
+	case len(beta) != n:
+		panic(badLenBeta)
+	case len(vl) < (n-1)*ldvl+n && wantvl:
+		panic(shortVL)
+	case len(vr) < (n-1)*ldvr+n && wantvr:
+		panic(shortVR)
+	}
+
+	// Get machine constants.
+	smlnum := math.Sqrt(dlamchS) / dlamchP
+	bignum := 1 / smlnum
+
+	// Scale A if max element outside range [smlnum,bignum].
+	anrm := impl.Dlange(lapack.MaxAbs, n, n, a, lda, nil)
+	var scalea bool
+	var anrmto float64
+	if anrm > 0 && anrm < smlnum {
+		scalea = true
+		anrmto = smlnum
+	} else if anrm > bignum {
+		scalea = true
+		anrmto = bignum
+	}
+	if scalea {
+		impl.Dlascl(lapack.General, 0, 0, anrm, anrmto, n, n, a, lda)
+	}
+
+	// Scale B if max element outside range [smlnum,bignum].
+	bnrm := impl.Dlange(lapack.MaxAbs, n, n, b, ldb, nil)
+	var scaleb bool
+	var bnrmto float64
+	if bnrm > 0 && bnrm < smlnum {
+		scaleb = true
+		bnrmto = smlnum
+	} else if bnrm > bignum {
+		scaleb = true
+		bnrmto = bignum
+	}
+	if scaleb {
+		impl.Dlascl(lapack.General, 0, 0, bnrm, bnrmto, n, n, b, ldb)
+	}
+
+	// Reduce B to triangular form (QR decomposition of B) and apply the
+	// orthogonal transformation to A.
+	tau := work[:n]
+	iwrk := n
+	impl.Dgeqrf(n, n, b, ldb, tau, work[iwrk:], lwork-iwrk)
+	impl.Dormqr(blas.Left, blas.Trans, n, n, n, b, ldb, tau, a, lda, work[iwrk:], lwork-iwrk)
+
+	// Initialize VL to the orthogonal factor of the QR decomposition.
+	if wantvl {
+		impl.Dlaset(blas.All, n, n, 0, 1, vl, ldvl)
+		if n > 1 {
+			impl.Dlacpy(blas.Lower, n-1, n-1, b[ldb:], ldb, vl[ldvl:], ldvl)
+		}
+		impl.Dorgqr(n, n, n, vl, ldvl, tau, work[iwrk:], lwork-iwrk)
+	}
+	if n > 1 {
+		impl.Dlaset(blas.Lower, n-1, n-1, 0, 0, b[ldb:], ldb)
+	}
+
+	// Reduce to generalized Hessenberg form.
+	compq := lapack.OrthoNone
+	if wantvl {
+		compq = lapack.OrthoPostmul
+	}
+	compz := lapack.OrthoNone
+	if wantvr {
+		compz = lapack.OrthoExplicit
+	}
+	impl.Dgghrd(compq, compz, n, 0, n-1, a, lda, b, ldb, vl, ldvl, vr, ldvr)
+
+	// Perform the QZ algorithm, computing the Schur form if eigenvectors
+	// are desired.
+	job := lapack.EigenvaluesOnly
+	if wantvl || wantvr {
+		job = lapack.EigenvaluesAndSchur
+	}
+	if wantvr {
+		compz = lapack.OrthoPostmul
+	}
+	ok = impl.Dhgeqz(job, compq, compz, n, 0, n-1, a, lda, b, ldb, alphar, alphai, beta, vl, ldvl, vr, ldvr)
+	if !ok {
+		work[0] = float64(maxwrk)
+		return false
+	}
+
+	// Compute the eigenvectors.
+	if wantvl || wantvr {
+		var side lapack.EVSide
+		switch {
+		case wantvl && wantvr:
+			side = lapack.EVBoth
+		case wantvl:
+			side = lapack.EVLeft
+		default:
+			side = lapack.EVRight
+		}
+		impl.Dtgevc(side, lapack.EVAllMulQ, nil, n, a, lda, b, ldb, vl, ldvl, vr, ldvr, n, work)
+	}
+
+	// Undo scaling.
+	if scalea {
+		impl.Dlascl(lapack.General, 0, 0, anrmto, anrm, n, 1, alphar, 1)
+		impl.Dlascl(lapack.General, 0, 0, anrmto, anrm, n, 1, alphai, 1)
+	}
+	if scaleb {
+		impl.Dlascl(lapack.General, 0, 0, bnrmto, bnrm, n, 1, beta, 1)
+	}
+
+	work[0] = float64(maxwrk)
+	return true
+}
@@ -0,0 +1,555 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dhgeqz computes the eigenvalues of a real matrix pair (H,T), where H is an
+// upper Hessenberg matrix and T is upper triangular, using the double-shift
+// QZ method. Matrix pairs of this type are produced by the reduction to
+// generalized upper Hessenberg form of a real matrix pair (A,B)
+//
+//	A = Q1 * H * Z1ᵀ,
+//	B = Q1 * T * Z1ᵀ,
+//
+// as computed by Dgghrd.
+//
+// If job == lapack.EigenvaluesAndSchur, then H is also reduced to generalized
+// Schur form,
+//
+//	H = Q * S * Zᵀ,
+//	T = Q * P * Zᵀ,
+//
+// where Q and Z are orthogonal matrices, P is an upper triangular matrix, and
+// S is a quasi-triangular matrix with 1×1 and 2×2 diagonal blocks. The 1×1
+// blocks correspond to real eigenvalues of the matrix pair (H,T) and the 2×2
+// blocks correspond to complex conjugate pairs of eigenvalues. The 2×2 blocks
+// of P corresponding to 2×2 blocks of S are reduced to positive diagonal
+// form, that is, the off-diagonal element is zero and the diagonal elements
+// are positive. The diagonal elements of P corresponding to 1×1 blocks of S
+// are non-negative. On return, H and T will contain S and P respectively.
+// If job == lapack.EigenvaluesOnly, only the eigenvalues are computed and on
+// return the contents of H and T are unspecified.
+//
+// Optionally, the orthogonal matrix Q from the generalized Schur factorization
+// may be postmultiplied into an input matrix Q1, and Z may be postmultiplied
+// into an input matrix Z1. If Q1 and Z1 are the orthogonal matrices from
+// Dgghrd that reduced the matrix pair (A,B) to generalized upper Hessenberg
+// form, then the output matrices Q1*Q and Z1*Z are the orthogonal factors
+// from the generalized Schur factorization of (A,B):
+//
+//	A = (Q1*Q) * S * (Z1*Z)ᵀ,
+//	B = (Q1*Q) * P * (Z1*Z)ᵀ.
+//
+// If compq == lapack.OrthoNone, Q is not referenced. If compq ==
+// lapack.OrthoExplicit, Q is initialized to the identity matrix and the
+// orthogonal matrix of left Schur vectors of (H,T) is returned. If compq ==
+// lapack.OrthoPostmul, Q must contain an orthogonal matrix Q1 on entry and
+// the product Q1*Q is returned. compz has the same meaning for Z. The
+// matrices Q and Z are only meaningful if job == lapack.EigenvaluesAndSchur.
+//
+// ilo and ihi determine the block of the matrix pair that is reduced. It is
+// assumed that H is already upper triangular in rows and columns [0:ilo] and
+// [ihi+1:n], as returned by a balancing routine. It must hold that
+//
+//	0 <= ilo <= ihi < n if n > 0,
+//	ilo == 0 and ihi == -1 if n == 0,
+//
+// otherwise Dhgeqz will panic.
+//
+// On return, the generalized eigenvalues of the matrix pair are
+// (alphar[j] + alphai[j]*i)/beta[j] for j = 0, ..., n-1. beta is non-negative.
+// If alphai[j] is zero, the j-th eigenvalue is real. If alphai[j] is positive,
+// the j-th and (j+1)-th eigenvalues are a complex conjugate pair and
+// alphai[j+1] is negative. If job == lapack.EigenvaluesAndSchur, alphar, alphai
+// and beta are computed from the diagonal blocks of S and P so that for real
+// eigenvalues alphar[j] = S[j,j] and beta[j] = P[j,j].
+//
+// alphar, alphai and beta must have length n, otherwise Dhgeqz will panic.
+//
+// Dhgeqz returns whether the QZ iteration converged. If it did not converge,
+// the eigenvalues in alphar, alphai and beta are correct only for indices
+// outside of the unconverged part of the [ilo:ihi+1] range.
+//
+// Dhgeqz is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dhgeqz(job lapack.SchurJob, compq, compz lapack.OrthoComp, n, ilo, ihi int, h []float64, ldh int, t []float64, ldt int, alphar, alphai, beta, q []float64, ldq int, z []float64, ldz int) (ok bool) {
+	switch {
+	case job != lapack.EigenvaluesOnly && job != lapack.EigenvaluesAndSchur:
+		panic(badSchurJob)
+	case compq != lapack.OrthoNone && compq != lapack.OrthoExplicit && compq != lapack.OrthoPostmul:
+		panic(badOrthoComp)
+	case compz != lapack.OrthoNone && compz != lapack.OrthoExplicit && compz != lapack.OrthoPostmul:
+		panic(badOrthoComp)
+	case n < 0:
+		panic(nLT0)
+	case ilo < 0 || max(0, n-1) < ilo:
+		panic(badIlo)
+	case ihi < min(ilo, n-1) || n <= ihi:
+		panic(badIhi)
+	case ldh < max(1, n):
+		panic(badLdH)
+	case ldt < max(1, n):
+		panic(badLdT)
+	case ldq < 1 || (compq != lapack.OrthoNone && ldq < n):
+		panic(badLdQ)
+	case ldz < 1 || (compz != lapack.OrthoNone && ldz < n):
+		panic(badLdZ)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(h) < (n-1)*ldh+n:
+		panic(shortH)
+	case len(t) < (n-1)*ldt+n:
+		panic(shortT)
+	case len(alphar) != n:
+		panic(badLenAlphar)
+	case len(alphai) != n:
+		panic(badLenAlphai)
+	case len(beta) != n:
+		panic(badLenBeta)
+	case compq != lapack.OrthoNone && len(q) < (n-1)*ldq+n:
+		panic(shortQ)
+	case compz != lapack.OrthoNone && len(z) < (n-1)*ldz+n:
+		panic(shortZ)
+	}
+
+	ilschr := job == lapack.EigenvaluesAndSchur
+	ilq := compq != lapack.OrthoNone
+	ilz := compz != lapack.OrthoNone
+
+	if compq == lapack.OrthoExplicit {
+		impl.Dlaset(blas.All, n, n, 0, 1, q, ldq)
+	}
+	if compz == lapack.OrthoExplicit {
+		impl.Dlaset(blas.All, n, n, 0, 1, z, ldz)
+	}
+
+	const (
+		safmin = dlamchS
+		ulp    = dlamchP
+	)
+	bi := blas64.Implementation()
+
+	// ifrstm and ilastm delimit the rows and columns of H and T that are
+	// updated by the transformations. When the Schur form is computed,
+	// the whole matrices are updated.
+	ifrstm := ilo
+	ilastm := ihi
+	if ilschr {
+		ifrstm = 0
+		ilastm = n - 1
+	}
+
+	// setEigen stores the real eigenvalue from the 1×1 block at j, making
+	// the diagonal element of T non-negative.
+	setEigen := func(j, first int) {
+		if t[j*ldt+j] < 0 {
+			if ilschr {
+				for i := first; i <= j; i++ {
+					h[i*ldh+j] *= -1
+					t[i*ldt+j] *= -1
+				}
+			} else {
+				h[j*ldh+j] *= -1
+				t[j*ldt+j] *= -1
+			}
+			if ilz {
+				bi.Dscal(n, -1, z[j:], ldz)
+			}
+		}
+		alphar[j] = h[j*ldh+j]
+		alphai[j] = 0
+		beta[j] = t[j*ldt+j]
+	}
+
+	// Eigenvalues outside of the [ilo:ihi+1] block are already in
+	// triangular form.
+	for j := 0; j < ilo; j++ {
+		setEigen(j, 0)
+	}
+	for j := ihi + 1; j < n; j++ {
+		setEigen(j, 0)
+	}
+
+	nh := ihi - ilo + 1
+	anorm := impl.Dlanhs(lapack.Frobenius, nh, h[ilo*ldh+ilo:], ldh, nil)
+	bnorm := impl.Dlanhs(lapack.Frobenius, nh, t[ilo*ldt+ilo:], ldt, nil)
+	atol := math.Max(safmin, ulp*anorm)
+	btol := math.Max(safmin, ulp*bnorm)
+	ascale := 1 / math.Max(safmin, anorm)
+	bscale := 1 / math.Max(safmin, bnorm)
+
+	// negligible returns whether the subdiagonal element H[j,j-1] can be
+	// set to zero.
+	negligible := func(j int) bool {
+		tol := ulp * (math.Abs(h[j*ldh+j]) + math.Abs(h[(j-1)*ldh+j-1]))
+		if tol == 0 {
+			tol = atol
+		}
+		return math.Abs(h[j*ldh+j-1]) <= math.Max(safmin, tol)
+	}
+
+	var (
+		ar, ai, bt [2]float64
+		v          [2]float64
+	)
+	ilast := ihi
+	iiter := 0
+	maxit := 30 * nh
+	for jiter := 0; jiter < maxit; jiter++ {
+		if ilast < ilo {
+			return true
+		}
+
+		// Check for a 1×1 block that can be deflated at ilast, a zero
+		// at T[ilast,ilast], or find the first row ifirst of the active
+		// unreduced block.
+		var (
+			deflate bool // Deflate a 1×1 block at ilast.
+			zeroSub bool // Annihilate H[ilast,ilast-1] using T[ilast,ilast] == 0.
+			ifirst  int
+		)
+		switch {
+		case ilast == ilo:
+			deflate = true
+		case negligible(ilast):
+			h[ilast*ldh+ilast-1] = 0
+			deflate = true
+		case math.Abs(t[ilast*ldt+ilast]) <= btol:
+			t[ilast*ldt+ilast] = 0
+			zeroSub = true
+		default:
+		search:
+			for j := ilast - 1; j >= ilo; j-- {
+				ilazro := j == ilo
+				if !ilazro && negligible(j) {
+					h[j*ldh+j-1] = 0
+					ilazro = true
+				}
+				if math.Abs(t[j*ldt+j]) >= btol {
+					if ilazro {
+						ifirst = j
+						break search
+					}
+					continue
+				}
+
+				// T[j,j] is negligible.
+				t[j*ldt+j] = 0
+
+				// Test for two consecutive small subdiagonal
+				// elements of H.
+				var ilazr2 bool
+				if !ilazro {
+					temp := math.Abs(h[j*ldh+j-1])
+					temp2 := math.Abs(h[j*ldh+j])
+					tempr := math.Max(temp, temp2)
+					if tempr < 1 && tempr != 0 {
+						temp /= tempr
+						temp2 /= tempr
+					}
+					ilazr2 = temp*(ascale*math.Abs(h[(j+1)*ldh+j])) <= temp2*(ascale*atol)
+				}
+
+				if ilazro || ilazr2 {
+					// The block starting at j is split off or nearly
+					// so. Use rotations from the left to push the
+					// zero at T[j,j] out of the block, or until a
+					// nonzero diagonal element of T is created.
+					for jch := j; jch < ilast; jch++ {
+						c, s, r := impl.Dlartg(h[jch*ldh+jch], h[(jch+1)*ldh+jch])
+						h[jch*ldh+jch] = r
+						h[(jch+1)*ldh+jch] = 0
+						bi.Drot(ilastm-jch, h[jch*ldh+jch+1:], 1, h[(jch+1)*ldh+jch+1:], 1, c, s)
+						bi.Drot(ilastm-jch, t[jch*ldt+jch+1:], 1, t[(jch+1)*ldt+jch+1:], 1, c, s)
+						if ilq {
+							bi.Drot(n, q[jch:], ldq, q[jch+1:], ldq, c, s)
+						}
+						if ilazr2 {
+							h[jch*ldh+jch-1] *= c
+						}
+						ilazr2 = false
+						if math.Abs(t[(jch+1)*ldt+jch+1]) >= btol {
+							if jch+1 >= ilast {
+								deflate = true
+							} else {
+								ifirst = jch + 1
+							}
+							break search
+						}
+						t[(jch+1)*ldt+jch+1] = 0
+					}
+					zeroSub = true
+					break search
+				}
+
+				// Chase the zero at T[j,j] down to T[ilast,ilast].
+				for jch := j; jch < ilast; jch++ {
+					c, s, r := impl.Dlartg(t[jch*ldt+jch+1], t[(jch+1)*ldt+jch+1])
+					t[jch*ldt+jch+1] = r
+					t[(jch+1)*ldt+jch+1] = 0
+					if jch < ilastm-1 {
+						bi.Drot(ilastm-jch-1, t[jch*ldt+jch+2:], 1, t[(jch+1)*ldt+jch+2:], 1, c, s)
+					}
+					bi.Drot(ilastm-jch+2, h[jch*ldh+jch-1:], 1, h[(jch+1)*ldh+jch-1:], 1, c, s)
+					if ilq {
+						bi.Drot(n, q[jch:], ldq, q[jch+1:], ldq, c, s)
+					}
+					c, s, r = impl.Dlartg(h[(jch+1)*ldh+jch], h[(jch+1)*ldh+jch-1])
+					h[(jch+1)*ldh+jch] = r
+					h[(jch+1)*ldh+jch-1] = 0
+					bi.Drot(jch+1-ifrstm, h[ifrstm*ldh+jch:], ldh, h[ifrstm*ldh+jch-1:], ldh, c, s)
+					bi.Drot(jch-ifrstm, t[ifrstm*ldt+jch:], ldt, t[ifrstm*ldt+jch-1:], ldt, c, s)
+					if ilz {
+						bi.Drot(n, z[jch:], ldz, z[jch-1:], ldz, c, s)
+					}
+				}
+				zeroSub = true
+				break search
+			}
+		}
+
+		if zeroSub {
+			// T[ilast,ilast] is zero, so H[ilast,ilast-1] can be
+			// annihilated by a rotation from the right, giving an
+			// infinite eigenvalue.
+			c, s, r := impl.Dlartg(h[ilast*ldh+ilast], h[ilast*ldh+ilast-1])
+			h[ilast*ldh+ilast] = r
+			h[ilast*ldh+ilast-1] = 0
+			bi.Drot(ilast-ifrstm, h[ifrstm*ldh+ilast:], ldh, h[ifrstm*ldh+ilast-1:], ldh, c, s)
+			bi.Drot(ilast-ifrstm, t[ifrstm*ldt+ilast:], ldt, t[ifrstm*ldt+ilast-1:], ldt, c, s)
+			if ilz {
+				bi.Drot(n, z[ilast:], ldz, z[ilast-1:], ldz, c, s)
+			}
+			deflate = true
+		}
+
+		if deflate {
+			setEigen(ilast, ifrstm)
+			ilast--
+			iiter = 0
+			if !ilschr {
+				ilastm = ilast
+				if ifrstm > ilast {
+					ifrstm = ilo
+				}
+			}
+			continue
+		}
+
+		// QZ step on the active unreduced block [ifirst:ilast+1].
+		iiter++
+		if !ilschr {
+			ifrstm = ifirst
+		}
+
+		if ifirst == ilast-1 {
+			// Standardize the 2×2 block.
+			f := ifirst
+			csl, snl, csr, snr := impl.Dlagv2(h[f*ldh+f:], ldh, t[f*ldt+f:], ldt, ar[:], ai[:], bt[:])
+			if ilast < ilastm {
+				bi.Drot(ilastm-ilast, h[f*ldh+ilast+1:], 1, h[ilast*ldh+ilast+1:], 1, csl, snl)
+				bi.Drot(ilastm-ilast, t[f*ldt+ilast+1:], 1, t[ilast*ldt+ilast+1:], 1, csl, snl)
+			}
+			if f > ifrstm {
+				bi.Drot(f-ifrstm, h[ifrstm*ldh+f:], ldh, h[ifrstm*ldh+ilast:], ldh, csr, snr)
+				bi.Drot(f-ifrstm, t[ifrstm*ldt+f:], ldt, t[ifrstm*ldt+ilast:], ldt, csr, snr)
+			}
+			if ilq {
+				bi.Drot(n, q[f:], ldq, q[ilast:], ldq, csl, snl)
+			}
+			if ilz {
+				bi.Drot(n, z[f:], ldz, z[ilast:], ldz, csr, snr)
+			}
+			if ai[0] == 0 {
+				// The eigenvalues are real and H[ilast,ilast-1] is
+				// now zero, so the block will be deflated on the
+				// next iteration.
+				continue
+			}
+
+			// Make the diagonal elements of T positive.
+			for j := f; j <= ilast; j++ {
+				if t[j*ldt+j] >= 0 {
+					continue
+				}
+				for i := ifrstm; i <= ilast; i++ {
+					h[i*ldh+j] *= -1
+				}
+				for i := ifrstm; i <= j; i++ {
+					t[i*ldt+j] *= -1
+				}
+				if ilz {
+					bi.Dscal(n, -1, z[j:], ldz)
+				}
+			}
+			for k, j := range []int{f, ilast} {
+				tjj := t[j*ldt+j]
+				alphar[j] = ar[k] * tjj
+				alphai[j] = ai[k] * tjj
+				beta[j] = tjj
+			}
+			ilast -= 2
+			iiter = 0
+			if !ilschr {
+				ilastm = ilast
+				if ifrstm > ilast {
+					ifrstm = ilo
+				}
+			}
+			continue
+		}
+
+		// Double-shift QZ sweep on a block of order at least 3.
+		impl.dhgeqzSweep(ifirst, ilast, ifrstm, ilastm, iiter%10 == 0, ascale, bscale, n, h, ldh, t, ldt, ilq, q, ldq, ilz, z, ldz, v[:])
+	}
+	return ilast < ilo
+}
+
+// dhgeqzSweep performs a double-shift QZ sweep on the unreduced block
+// [f:l+1] of the matrix pair (H,T). The shifts are the eigenvalues of the
+// trailing 2×2 block of H*T⁻¹, or ad hoc shifts if exceptional is true.
+// The shifts are computed from H and T scaled by ascale and bscale to avoid
+// over- and underflow. Rows and columns in [first:last+1] of H and T are
+// updated.
+func (impl Implementation) dhgeqzSweep(f, l, first, last int, exceptional bool, ascale, bscale float64, n int, h []float64, ldh int, t []float64, ldt int, ilq bool, q []float64, ldq int, ilz bool, z []float64, ldz int, x []float64) {
+	bi := blas64.Implementation()
+
+	// Compute the entries of M = H*T⁻¹ that are needed to form the first
+	// column of (M - σ₁I)(M - σ₂I).
+	t00 := bscale * t[f*ldt+f]
+	t01 := bscale * t[f*ldt+f+1]
+	t11 := bscale * t[(f+1)*ldt+f+1]
+	h00 := ascale * h[f*ldh+f]
+	h01 := ascale * h[f*ldh+f+1]
+	h10 := ascale * h[(f+1)*ldh+f]
+	h11 := ascale * h[(f+1)*ldh+f+1]
+	h21 := ascale * h[(f+2)*ldh+f+1]
+	tinv01 := -t01 / (t00 * t11)
+	m00 := h00 / t00
+	m10 := h10 / t00
+	m01 := h00*tinv01 + h01/t11
+	m11 := h10*tinv01 + h11/t11
+	m21 := h21 / t11
+
+	// Trailing 2×2 block of M.
+	a, b, c := l-2, l-1, l
+	taa := bscale * t[a*ldt+a]
+	tab := bscale * t[a*ldt+b]
+	tac := bscale * t[a*ldt+c]
+	tbb := bscale * t[b*ldt+b]
+	tbc := bscale * t[b*ldt+c]
+	tcc := bscale * t[c*ldt+c]
+	iab := -tab / (taa * tbb)
+	ibc := -tbc / (tbb * tcc)
+	iac := (tab*tbc - tac*tbb) / (taa * tbb * tcc)
+	hba := ascale * h[b*ldh+a]
+	hbb := ascale * h[b*ldh+b]
+	mbb := hba*iab + hbb/tbb
+	mbc := hba*iac + hbb*ibc + ascale*h[b*ldh+c]/tcc
+	mcb := ascale * h[c*ldh+b] / tbb
+	mcc := ascale*h[c*ldh+b]*ibc + ascale*h[c*ldh+c]/tcc
+
+	var tr, det float64
+	if exceptional {
+		w := math.Abs(mcb) + math.Abs(hba/taa)
+		mu := mcc + 0.75*w
+		tr = 2 * mu
+		det = mu*mu + 0.4375*w*w
+	} else {
+		tr = mbb + mcc
+		det = mbb*mcc - mbc*mcb
+	}
+	v0 := m00*m00 + m01*m10 - tr*m00 + det
+	v1 := m10 * (m00 + m11 - tr)
+	v2 := m10 * m21
+	if scale := math.Abs(v0) + math.Abs(v1) + math.Abs(v2); scale != 0 {
+		v0 /= scale
+		v1 /= scale
+		v2 /= scale
+	}
+
+	for k := f; k <= l-2; k++ {
+		// Reflector from the left to annihilate entries below the
+		// diagonal in column k-1 of H, or to introduce the bulge.
+		var alpha float64
+		if k == f {
+			alpha, x[0], x[1] = v0, v1, v2
+		} else {
+			alpha, x[0], x[1] = h[k*ldh+k-1], h[(k+1)*ldh+k-1], h[(k+2)*ldh+k-1]
+		}
+		beta, tau := impl.Dlarfg(3, alpha, x[:2], 1)
+		u1, u2 := x[0], x[1]
+		if k > f {
+			h[k*ldh+k-1] = beta
+			h[(k+1)*ldh+k-1] = 0
+			h[(k+2)*ldh+k-1] = 0
+		}
+		for j := k; j <= last; j++ {
+			sum := h[k*ldh+j] + u1*h[(k+1)*ldh+j] + u2*h[(k+2)*ldh+j]
+			h[k*ldh+j] -= tau * sum
+			h[(k+1)*ldh+j] -= tau * u1 * sum
+			h[(k+2)*ldh+j] -= tau * u2 * sum
+
+			sum = t[k*ldt+j] + u1*t[(k+1)*ldt+j] + u2*t[(k+2)*ldt+j]
+			t[k*ldt+j] -= tau * sum
+			t[(k+1)*ldt+j] -= tau * u1 * sum
+			t[(k+2)*ldt+j] -= tau * u2 * sum
+		}
+		if ilq {
+			for i := 0; i < n; i++ {
+				sum := q[i*ldq+k] + u1*q[i*ldq+k+1] + u2*q[i*ldq+k+2]
+				q[i*ldq+k] -= tau * sum
+				q[i*ldq+k+1] -= tau * u1 * sum
+				q[i*ldq+k+2] -= tau * u2 * sum
+			}
+		}
+
+		// Restore T to upper triangular form with rotations from the
+		// right, annihilating T[k+2,k+1], T[k+2,k] and T[k+1,k].
+		jr := min(k+3, l)
+		for _, pq := range [3][2]int{{k + 2, k + 1}, {k + 2, k}, {k + 1, k}} {
+			p, r := pq[0], pq[1]
+			cs, sn, rr := impl.Dlartg(t[p*ldt+p], t[p*ldt+r])
+			t[p*ldt+p] = rr
+			t[p*ldt+r] = 0
+			bi.Drot(jr-first+1, h[first*ldh+p:], ldh, h[first*ldh+r:], ldh, cs, sn)
+			bi.Drot(p-first, t[first*ldt+p:], ldt, t[first*ldt+r:], ldt, cs, sn)
+			if ilz {
+				bi.Drot(n, z[p:], ldz, z[r:], ldz, cs, sn)
+			}
+		}
+	}
+
+	// Final rotations to annihilate H[l,l-2] and the resulting T[l,l-1].
+	k := l - 1
+	cs, sn, r := impl.Dlartg(h[k*ldh+k-1], h[l*ldh+k-1])
+	h[k*ldh+k-1] = r
+	h[l*ldh+k-1] = 0
+	bi.Drot(last-k+1, h[k*ldh+k:], 1, h[l*ldh+k:], 1, cs, sn)
+	bi.Drot(last-k+1, t[k*ldt+k:], 1, t[l*ldt+k:], 1, cs, sn)
+	if ilq {
+		bi.Drot(n, q[k:], ldq, q[l:], ldq, cs, sn)
+	}
+	cs, sn, r = impl.Dlartg(t[l*ldt+l], t[l*ldt+k])
+	t[l*ldt+l] = r
+	t[l*ldt+k] = 0
+	bi.Drot(l+1-first, h[first*ldh+l:], ldh, h[first*ldh+k:], ldh, cs, sn)
+	bi.Drot(l-first, t[first*ldt+l:], ldt, t[first*ldt+k:], ldt, cs, sn)
+	if ilz {
+		bi.Drot(n, z[l:], ldz, z[k:], ldz, cs, sn)
+	}
+}
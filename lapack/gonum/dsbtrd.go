@@ -0,0 +1,165 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dsbtrd reduces an n×n symmetric band matrix A with kd super- or
+// sub-diagonals to symmetric tridiagonal form T by an orthogonal similarity
+// transformation
+//
+//	Qᵀ * A * Q = T.
+//
+// The band storage scheme of A is described in the documentation for Dpbtrf.
+// On return, the diagonal and first super- or sub-diagonal of ab are
+// overwritten by the corresponding elements of T, and the remaining
+// off-diagonals are set to zero.
+//
+// The reduction annihilates the elements outside the tridiagonal band one
+// diagonal at a time using Givens rotations, chasing the resulting bulge down
+// the band, so only O(n) additional storage is needed.
+//
+// d must have length n and on return it will contain the diagonal elements
+// of T. e must have length n-1 and on return it will contain the off-diagonal
+// elements of T.
+//
+// vect specifies whether the orthogonal matrix Q is computed:
+//
+//	vect == lapack.OrthoNone:     Q is not referenced.
+//	vect == lapack.OrthoExplicit: q will contain the orthogonal matrix Q.
+//	vect == lapack.OrthoPostmul:  q must contain an n×n matrix X on entry and
+//	                              will contain X * Q on return.
+//
+// work must have length at least n, and Dsbtrd will panic otherwise.
+//
+// Dsbtrd is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dsbtrd(vect lapack.OrthoComp, uplo blas.Uplo, n, kd int, ab []float64, ldab int, d, e, q []float64, ldq int, work []float64) {
+	wantQ := vect != lapack.OrthoNone
+	switch {
+	case vect != lapack.OrthoNone && vect != lapack.OrthoExplicit && vect != lapack.OrthoPostmul:
+		panic(badOrthoComp)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case kd < 0:
+		panic(kdLT0)
+	case ldab < kd+1:
+		panic(badLdA)
+	case ldq < 1, wantQ && ldq < n:
+		panic(badLdQ)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+kd+1:
+		panic(shortAB)
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case wantQ && len(q) < (n-1)*ldq+n:
+		panic(shortQ)
+	case len(work) < n:
+		panic(shortWork)
+	}
+
+	if vect == lapack.OrthoExplicit {
+		impl.Dlaset(blas.All, n, n, 0, 1, q, ldq)
+	}
+
+	// The element (i, j) with i >= j of the lower triangle of A is
+	// stored in ab, unless i-j == kd+1 in which case it is a bulge
+	// element stored in work[j].
+	idx := func(i, j int) int {
+		if uplo == blas.Upper {
+			return j*ldab + i - j
+		}
+		return i*ldab + kd + j - i
+	}
+	get := func(i, j int) float64 {
+		if i < j {
+			i, j = j, i
+		}
+		switch {
+		case i-j <= kd:
+			return ab[idx(i, j)]
+		case i-j == kd+1:
+			return work[j]
+		}
+		return 0
+	}
+	set := func(i, j int, v float64) {
+		if i < j {
+			i, j = j, i
+		}
+		switch {
+		case i-j <= kd:
+			ab[idx(i, j)] = v
+		case i-j == kd+1:
+			work[j] = v
+		}
+	}
+	for i := range work[:n] {
+		work[i] = 0
+	}
+
+	bi := blas64.Implementation()
+	// Reduce the bandwidth by one in each sweep.
+	for b := kd; b >= 2; b-- {
+		for j := 0; j+b < n; j++ {
+			// Annihilate A[j+b, j] and chase the bulge
+			// created at distance b+1 from the diagonal
+			// off the end of the band.
+			col, t := j, j+b
+			for t < n {
+				y := get(t, col)
+				if y == 0 {
+					break
+				}
+				p := t - 1
+				c, s, r := impl.Dlartg(get(p, col), y)
+				set(p, col, r)
+				set(t, col, 0)
+
+				// Apply the rotation to rows and columns
+				// p and t of A.
+				for l := col + 1; l <= min(n-1, t+b); l++ {
+					if l == p || l == t {
+						continue
+					}
+					x, y := get(p, l), get(t, l)
+					set(p, l, c*x+s*y)
+					set(t, l, c*y-s*x)
+				}
+				app, apt, att := get(p, p), get(p, t), get(t, t)
+				set(p, p, c*c*app+2*c*s*apt+s*s*att)
+				set(t, t, s*s*app-2*c*s*apt+c*c*att)
+				set(p, t, c*s*(att-app)+(c*c-s*s)*apt)
+
+				if wantQ {
+					bi.Drot(n, q[p:], ldq, q[t:], ldq, c, s)
+				}
+
+				col, t = p, t+b
+			}
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		d[i] = get(i, i)
+	}
+	for i := 0; i < n-1; i++ {
+		e[i] = get(i+1, i)
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dstebz computes selected eigenvalues of an n×n symmetric tridiagonal matrix
+// T by bisection using Sturm sequence counts.
+//
+// d contains the n diagonal elements of T and e contains the n-1 off-diagonal
+// elements. Neither is modified.
+//
+// rng specifies which eigenvalues are computed:
+//
+//	rng == lapack.EVRangeAll:   all eigenvalues are computed,
+//	rng == lapack.EVRangeValue: the eigenvalues in the half-open interval
+//	                            (vl, vu] are computed,
+//	rng == lapack.EVRangeIndex: the eigenvalues with indices il through iu
+//	                            inclusive, counted from zero in ascending
+//	                            order, are computed.
+//
+// vl and vu are only referenced if rng == lapack.EVRangeValue, in which case
+// vl must be less than vu. il and iu are only referenced if rng ==
+// lapack.EVRangeIndex, in which case they must satisfy 0 <= il <= iu < n if
+// n > 0.
+//
+// abstol is the absolute tolerance to which each eigenvalue is computed. If
+// abstol is not positive, a tolerance of eps*|T| is used, where eps is the
+// machine precision and |T| is the 1-norm of T. Eigenvalues are always
+// computed to a relative accuracy of at least 2*eps.
+//
+// On return, the first m elements of w contain the computed eigenvalues in
+// ascending order, where m is the returned number of eigenvalues. w must have
+// length at least n.
+//
+// The cost of computing each eigenvalue is O(n) per bisection step, so
+// computing a few eigenvalues is much cheaper than computing all of them with
+// Dsterf.
+//
+// Dstebz is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dstebz(rng lapack.EVRange, n int, vl, vu float64, il, iu int, abstol float64, d, e, w []float64) (m int) {
+	switch {
+	case rng != lapack.EVRangeAll && rng != lapack.EVRangeValue && rng != lapack.EVRangeIndex:
+		panic(badEVRange)
+	case n < 0:
+		panic(nLT0)
+	case rng == lapack.EVRangeValue && !(vl < vu):
+		panic(badVlVu)
+	case rng == lapack.EVRangeIndex && n > 0 && (il < 0 || n <= il):
+		panic(badIl)
+	case rng == lapack.EVRangeIndex && n > 0 && (iu < il || n <= iu):
+		panic(badIu)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case len(w) < n:
+		panic(shortW)
+	}
+
+	const (
+		eps    = dlamchE
+		safmin = dlamchS
+		rtoli  = 2 * dlamchP
+
+		// maxIter bounds the number of bisection steps
+		// for each eigenvalue. Each step halves the
+		// interval so this is sufficient to reduce any
+		// finite interval to adjacent floating point
+		// numbers.
+		maxIter = 2100
+	)
+
+	// Compute the Gershgorin interval containing
+	// all eigenvalues and the minimum pivot.
+	var emax2 float64
+	gl, gu := d[0], d[0]
+	for i := 0; i < n; i++ {
+		var r float64
+		if i > 0 {
+			r += math.Abs(e[i-1])
+		}
+		if i < n-1 {
+			r += math.Abs(e[i])
+			emax2 = math.Max(emax2, e[i]*e[i])
+		}
+		gl = math.Min(gl, d[i]-r)
+		gu = math.Max(gu, d[i]+r)
+	}
+	pivmin := safmin * math.Max(1, emax2)
+	tnorm := math.Max(math.Abs(gl), math.Abs(gu))
+	fudge := 2.1*eps*tnorm*float64(n) + 4.2*pivmin
+	gl -= fudge
+	gu += fudge
+
+	atoli := abstol
+	if atoli <= 0 {
+		atoli = eps * tnorm
+	}
+
+	// Determine the indices of the wanted eigenvalues.
+	var lo, hi int
+	switch rng {
+	case lapack.EVRangeAll:
+		lo, hi = 0, n-1
+	case lapack.EVRangeValue:
+		lo = sturmCount(n, d, e, vl, pivmin)
+		hi = sturmCount(n, d, e, vu, pivmin) - 1
+	case lapack.EVRangeIndex:
+		lo, hi = il, iu
+	}
+
+	// Find each eigenvalue by bisection. The lower bound
+	// of the interval for eigenvalue k is also a lower
+	// bound for eigenvalue k+1.
+	left := gl
+	for k := lo; k <= hi; k++ {
+		a, b := left, gu
+		for iter := 0; iter < maxIter; iter++ {
+			tol := math.Max(math.Max(atoli, pivmin), rtoli*math.Max(math.Abs(a), math.Abs(b)))
+			if b-a <= tol {
+				break
+			}
+			mid := a + (b-a)/2
+			if sturmCount(n, d, e, mid, pivmin) > k {
+				b = mid
+			} else {
+				a = mid
+			}
+		}
+		left = a
+		w[m] = a + (b-a)/2
+		m++
+	}
+	return m
+}
+
+// sturmCount returns the number of eigenvalues of the n×n symmetric
+// tridiagonal matrix with diagonal d and off-diagonal e that are less
+// than x. Pivots smaller in magnitude than pivmin are replaced by
+// -pivmin.
+func sturmCount(n int, d, e []float64, x, pivmin float64) int {
+	var count int
+	q := d[0] - x
+	if math.Abs(q) <= pivmin {
+		q = -pivmin
+	}
+	if q < 0 {
+		count++
+	}
+	for i := 1; i < n; i++ {
+		q = d[i] - x - e[i-1]*e[i-1]/q
+		if math.Abs(q) <= pivmin {
+			q = -pivmin
+		}
+		if q < 0 {
+			count++
+		}
+	}
+	return count
+}
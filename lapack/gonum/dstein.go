@@ -0,0 +1,263 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dstein computes the eigenvectors of an n×n symmetric tridiagonal matrix T
+// corresponding to the m eigenvalues in w using inverse iteration.
+//
+// d contains the n diagonal elements of T and e contains the n-1 off-diagonal
+// elements. Neither is modified.
+//
+// w contains the eigenvalues of T in ascending order, such as those computed
+// by Dstebz, and must have length at least m. Eigenvectors of eigenvalues that
+// are close together are reorthogonalized against each other.
+//
+// On return, the first m columns of the n×m matrix Z contain the orthonormal
+// eigenvectors corresponding to the eigenvalues in w. The largest element of
+// each eigenvector in magnitude is positive.
+//
+// Dstein returns whether all eigenvectors converged within the maximum number
+// of iterations. If Dstein returns false, the eigenvectors that failed to
+// converge contain the last iterate.
+//
+// Dstein is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dstein(n int, d, e []float64, m int, w, z []float64, ldz int) (ok bool) {
+	switch {
+	case n < 0:
+		panic(nLT0)
+	case m < 0:
+		panic(mLT0)
+	case n < m:
+		panic(mGTN)
+	case ldz < max(1, m):
+		panic(badLdZ)
+	}
+
+	// Quick return if possible.
+	if n == 0 || m == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(shortD)
+	case len(e) < n-1:
+		panic(shortE)
+	case len(w) < m:
+		panic(shortW)
+	case len(z) < (n-1)*ldz+m:
+		panic(shortZ)
+	}
+
+	if n == 1 {
+		z[0] = 1
+		return true
+	}
+
+	const (
+		eps = dlamchE
+
+		// maxIter is the maximum number of inverse
+		// iterations for each eigenvector and extra is
+		// the number of iterations performed after the
+		// convergence criterion is first met.
+		maxIter = 5
+		extra   = 2
+	)
+
+	// Compute the 1-norm of T.
+	var onenrm float64
+	for i := 0; i < n; i++ {
+		r := math.Abs(d[i])
+		if i > 0 {
+			r += math.Abs(e[i-1])
+		}
+		if i < n-1 {
+			r += math.Abs(e[i])
+		}
+		onenrm = math.Max(onenrm, r)
+	}
+	if onenrm == 0 {
+		// T is zero so any orthonormal set of vectors is an
+		// eigenbasis. Use the columns of the identity.
+		for j := 0; j < m; j++ {
+			for i := 0; i < n; i++ {
+				z[i*ldz+j] = 0
+			}
+			z[j*ldz+j] = 1
+		}
+		return true
+	}
+	ortol := 1e-3 * onenrm
+	dtpcrt := math.Sqrt(0.1 / float64(n))
+
+	var lu tridiagLU
+	lu.init(n)
+	x := make([]float64, n)
+	bi := blas64.Implementation()
+
+	// seed holds the state of the generator
+	// for the initial vectors.
+	seed := uint64(1)
+	ok = true
+	var (
+		xjm   float64
+		first int // The index of the first eigenvector in the current cluster.
+	)
+	for j := 0; j < m; j++ {
+		xj := w[j]
+		if j > 0 {
+			if xj-w[j-1] > ortol {
+				first = j
+			}
+			// Perturb eigenvalues that are too close together
+			// to make the inverse iterations independent.
+			pertol := 10 * math.Abs(eps*xj)
+			if xj-xjm < pertol {
+				xj = xjm + pertol
+			}
+		}
+		xjm = xj
+
+		// Initialize x with pseudo-random values in [-1, 1).
+		for i := range x {
+			seed = seed*6364136223846793005 + 1442695040888963407
+			x[i] = 2*float64(seed>>11)/(1<<53) - 1
+		}
+
+		lu.factorize(d, e, xj, eps*onenrm)
+
+		converged := false
+		var nrmchk int
+		for iter := 0; iter < maxIter; iter++ {
+			// Scale x so that the growth of the solution
+			// measures the accuracy of the eigenvalue.
+			scl := float64(n) * onenrm * math.Max(eps, math.Abs(lu.u0[n-1])) / bi.Dasum(n, x, 1)
+			bi.Dscal(n, scl, x, 1)
+			lu.solve(x)
+
+			// Reorthogonalize against the previously
+			// computed eigenvectors in the cluster.
+			for i := first; i < j; i++ {
+				dot := bi.Ddot(n, x, 1, z[i:], ldz)
+				bi.Daxpy(n, -dot, z[i:], ldz, x, 1)
+			}
+
+			jmax := bi.Idamax(n, x, 1)
+			if math.Abs(x[jmax]) < dtpcrt {
+				continue
+			}
+			nrmchk++
+			if nrmchk > extra {
+				converged = true
+				break
+			}
+		}
+		if !converged {
+			ok = false
+		}
+
+		// Normalize x and make its largest element positive.
+		jmax := bi.Idamax(n, x, 1)
+		scl := 1 / bi.Dnrm2(n, x, 1)
+		if x[jmax] < 0 {
+			scl = -scl
+		}
+		bi.Dscal(n, scl, x, 1)
+		bi.Dcopy(n, x, 1, z[j:], ldz)
+	}
+	return ok
+}
+
+// tridiagLU holds an LU factorization with partial pivoting of a shifted
+// symmetric tridiagonal matrix T - λI.
+type tridiagLU struct {
+	// u0, u1 and u2 hold the diagonal and the
+	// first and second superdiagonals of U.
+	u0, u1, u2 []float64
+	// mult holds the multipliers of L and
+	// piv records the row interchanges.
+	mult []float64
+	piv  []bool
+}
+
+func (lu *tridiagLU) init(n int) {
+	lu.u0 = make([]float64, n)
+	lu.u1 = make([]float64, n)
+	lu.u2 = make([]float64, n)
+	lu.mult = make([]float64, n)
+	lu.piv = make([]bool, n)
+}
+
+// factorize computes the factorization of T - λI where T has diagonal d
+// and off-diagonal e. Diagonal elements of U smaller in magnitude than tol
+// are replaced by ±tol so that the factorization may be used for inverse
+// iteration.
+func (lu *tridiagLU) factorize(d, e []float64, lambda, tol float64) {
+	n := len(lu.u0)
+	a := d[0] - lambda // The current diagonal element.
+	b := e[0]          // The current superdiagonal element.
+	for k := 0; k < n-1; k++ {
+		c := e[k] // The subdiagonal element below a.
+		next := d[k+1] - lambda
+		var nextB float64
+		if k < n-2 {
+			nextB = e[k+1]
+		}
+		if math.Abs(a) >= math.Abs(c) {
+			lu.piv[k] = false
+			lu.u0[k] = a
+			lu.u1[k] = b
+			lu.u2[k] = 0
+			var mult float64
+			if a != 0 {
+				mult = c / a
+			}
+			lu.mult[k] = mult
+			a = next - mult*b
+			b = nextB
+		} else {
+			lu.piv[k] = true
+			mult := a / c
+			lu.u0[k] = c
+			lu.u1[k] = next
+			lu.u2[k] = nextB
+			lu.mult[k] = mult
+			a = b - mult*next
+			b = -mult * nextB
+		}
+		if math.Abs(lu.u0[k]) < tol {
+			lu.u0[k] = math.Copysign(tol, lu.u0[k])
+		}
+	}
+	lu.u0[n-1] = a
+	if math.Abs(a) < tol {
+		lu.u0[n-1] = math.Copysign(tol, a)
+	}
+}
+
+// solve overwrites x with the solution of (T - λI) * y = x.
+func (lu *tridiagLU) solve(x []float64) {
+	n := len(x)
+	for k := 0; k < n-1; k++ {
+		if lu.piv[k] {
+			x[k], x[k+1] = x[k+1], x[k]
+		}
+		x[k+1] -= lu.mult[k] * x[k]
+	}
+	x[n-1] /= lu.u0[n-1]
+	if n > 1 {
+		x[n-2] = (x[n-2] - lu.u1[n-2]*x[n-1]) / lu.u0[n-2]
+	}
+	for k := n - 3; k >= 0; k-- {
+		x[k] = (x[k] - lu.u1[k]*x[k+1] - lu.u2[k]*x[k+2]) / lu.u0[k]
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dsyevr computes selected eigenvalues and, optionally, the eigenvectors of a
+// real symmetric matrix A.
+//
+// A is first reduced to tridiagonal form T = Qᵀ * A * Q. If all eigenvalues
+// are requested they are computed with the implicit QL/QR method as in Dsyev.
+// Otherwise the selected eigenvalues of T are computed by bisection with
+// Dstebz, the corresponding eigenvectors of T by inverse iteration with
+// Dstein, and the eigenvectors of A are obtained by applying the elementary
+// reflectors that define Q without forming Q. Computing m of the n
+// eigenvectors therefore costs O(n³) for the reduction and O(n²m) for the
+// vectors.
+//
+// rng specifies which eigenvalues are computed:
+//
+//	rng == lapack.EVRangeAll:   all eigenvalues are computed,
+//	rng == lapack.EVRangeValue: the eigenvalues in the half-open interval
+//	                            (vl, vu] are computed,
+//	rng == lapack.EVRangeIndex: the eigenvalues with indices il through iu
+//	                            inclusive, counted from zero in ascending
+//	                            order, are computed.
+//
+// vl and vu are only referenced if rng == lapack.EVRangeValue, in which case
+// vl must be less than vu. il and iu are only referenced if rng ==
+// lapack.EVRangeIndex, in which case they must satisfy 0 <= il <= iu < n if
+// n > 0.
+//
+// abstol is the absolute tolerance to which each eigenvalue is computed by
+// bisection. If abstol is not positive, a tolerance of eps*|T| is used.
+//
+// On entry, a contains the elements of the symmetric matrix A in the triangular
+// portion specified by uplo. On return, the contents of a are destroyed.
+//
+// On return, the first m elements of w contain the computed eigenvalues in
+// ascending order, where m is the returned number of eigenvalues. w must have
+// length at least n. If jobz == lapack.EVCompute, the first m columns of the
+// n×ncols matrix Z contain the orthonormal eigenvectors corresponding to the
+// eigenvalues in w, where ncols is iu-il+1 if rng == lapack.EVRangeIndex and n
+// otherwise. z is not referenced if jobz == lapack.EVNone.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At
+// minimum, lwork >= max(1, 5*n), and Dsyevr will panic otherwise. The amount
+// of blocking in the reduction is limited by the usable length. If lwork ==
+// -1, instead of computing Dsyevr the optimal work length is stored into
+// work[0].
+//
+// Dsyevr returns whether the computation succeeded. If the eigenvalues or
+// eigenvectors failed to converge, ok is false.
+//
+// The reference LAPACK implementation of Dsyevr uses the MRRR algorithm for
+// subsets. This implementation uses bisection and inverse iteration instead
+// and does not compute the support of the eigenvectors.
+func (impl Implementation) Dsyevr(jobz lapack.EVJob, rng lapack.EVRange, uplo blas.Uplo, n int, a []float64, lda int, vl, vu float64, il, iu int, abstol float64, w, z []float64, ldz int, work []float64, lwork int) (m int, ok bool) {
+	wantz := jobz == lapack.EVCompute
+	ncols := n
+	if rng == lapack.EVRangeIndex {
+		ncols = iu - il + 1
+	}
+	switch {
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(badEVJob)
+	case rng != lapack.EVRangeAll && rng != lapack.EVRangeValue && rng != lapack.EVRangeIndex:
+		panic(badEVRange)
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case rng == lapack.EVRangeValue && !(vl < vu):
+		panic(badVlVu)
+	case rng == lapack.EVRangeIndex && n > 0 && (il < 0 || n <= il):
+		panic(badIl)
+	case rng == lapack.EVRangeIndex && n > 0 && (iu < il || n <= iu):
+		panic(badIu)
+	case wantz && ldz < max(1, ncols):
+		panic(badLdZ)
+	case lwork < max(1, 5*n) && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	var opts string
+	if uplo == blas.Upper {
+		opts = "U"
+	} else {
+		opts = "L"
+	}
+	nb := impl.Ilaenv(1, "DSYTRD", opts, n, -1, -1, -1)
+	lworkopt := max(1, (max(nb, 2)+3)*n)
+	if lwork == -1 {
+		work[0] = float64(lworkopt)
+		return 0, true
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return 0, true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(w) < n:
+		panic(shortW)
+	case wantz && len(z) < (n-1)*ldz+ncols:
+		panic(shortZ)
+	}
+
+	if n == 1 {
+		work[0] = 1
+		if rng == lapack.EVRangeValue && (a[0] <= vl || vu < a[0]) {
+			return 0, true
+		}
+		w[0] = a[0]
+		if wantz {
+			z[0] = 1
+		}
+		return 1, true
+	}
+
+	safmin := dlamchS
+	eps := dlamchP
+	smlnum := safmin / eps
+	bignum := 1 / smlnum
+	rmin := math.Sqrt(smlnum)
+	rmax := math.Min(math.Sqrt(bignum), 1/math.Sqrt(math.Sqrt(safmin)))
+
+	// Scale matrix to allowable range, if necessary.
+	anrm := impl.Dlansy(lapack.MaxAbs, uplo, n, a, lda, work)
+	scaled := false
+	var sigma float64
+	if anrm > 0 && anrm < rmin {
+		scaled = true
+		sigma = rmin / anrm
+	} else if anrm > rmax {
+		scaled = true
+		sigma = rmax / anrm
+	}
+	if scaled {
+		kind := lapack.LowerTri
+		if uplo == blas.Upper {
+			kind = lapack.UpperTri
+		}
+		impl.Dlascl(kind, 0, 0, 1, sigma, n, n, a, lda)
+		if abstol > 0 {
+			abstol *= sigma
+		}
+		if rng == lapack.EVRangeValue {
+			vl *= sigma
+			vu *= sigma
+		}
+	}
+
+	// Reduce A to tridiagonal form.
+	d := work[:n]
+	e := work[n : 2*n]
+	tau := work[2*n : 3*n]
+	indwork := 3 * n
+	llwork := lwork - indwork
+	impl.Dsytrd(uplo, n, a, lda, d, e, tau, work[indwork:], llwork)
+
+	if rng == lapack.EVRangeAll {
+		if !wantz {
+			ok = impl.Dsterf(n, d, e)
+		} else {
+			impl.Dorgtr(uplo, n, a, lda, tau, work[indwork:], llwork)
+			ok = impl.Dsteqr(lapack.EVOrig, n, d, e, a, lda, work[indwork:])
+		}
+		if !ok {
+			return 0, false
+		}
+		copy(w, d)
+		if wantz {
+			impl.Dlacpy(blas.All, n, n, a, lda, z, ldz)
+		}
+		m = n
+	} else {
+		m = impl.Dstebz(rng, n, vl, vu, il, iu, abstol, d, e, w)
+		ok = true
+		if wantz && m > 0 {
+			ok = impl.Dstein(n, d, e, m, w, z, ldz)
+			impl.applyTridiagQ(uplo, n, m, a, lda, tau, z, ldz, work[indwork:])
+		}
+	}
+
+	// If the matrix was scaled, then rescale eigenvalues appropriately.
+	if scaled {
+		bi := blas64.Implementation()
+		bi.Dscal(m, 1/sigma, w, 1)
+	}
+	work[0] = float64(lworkopt)
+	return m, ok
+}
+
+// applyTridiagQ overwrites the n×m matrix Z with Q * Z, where Q is the
+// orthogonal matrix defined by the elementary reflectors returned by Dsytrd in
+// a and tau. work must have length at least m.
+func (impl Implementation) applyTridiagQ(uplo blas.Uplo, n, m int, a []float64, lda int, tau, z []float64, ldz int, work []float64) {
+	if uplo == blas.Upper {
+		// Q = H_{n-2} * ... * H_1 * H_0 where the vector defining H_i
+		// has a unit element in row i, is stored above it in column
+		// i+1 of a, and is zero below it.
+		for i := 0; i < n-1; i++ {
+			aii := a[i*lda+i+1]
+			a[i*lda+i+1] = 1
+			impl.Dlarf(blas.Left, i+1, m, a[i+1:], lda, tau[i], z, ldz, work)
+			a[i*lda+i+1] = aii
+		}
+		return
+	}
+	// Q = H_0 * H_1 * ... * H_{n-2} where the vector defining H_i has
+	// a unit element in row i+1, is stored below it in column i of a,
+	// and is zero above it.
+	for i := n - 2; i >= 0; i-- {
+		aii := a[(i+1)*lda+i]
+		a[(i+1)*lda+i] = 1
+		impl.Dlarf(blas.Left, n-i-1, m, a[(i+1)*lda+i:], lda, tau[i], z[(i+1)*ldz:], ldz, work)
+		a[(i+1)*lda+i] = aii
+	}
+}
@@ -0,0 +1,283 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dsytf2 computes the factorization of a real symmetric matrix A using the
+// Bunch-Kaufman diagonal pivoting method. The form of the factorization is
+//
+//	A = U * D * Uᵀ  if uplo == blas.Upper, or
+//	A = L * D * Lᵀ  if uplo == blas.Lower,
+//
+// where U (or L) is a product of permutation and unit upper (lower) triangular
+// matrices, and D is symmetric and block diagonal with 1×1 and 2×2 diagonal
+// blocks. This is the unblocked version of the algorithm.
+//
+// On entry, a contains the upper or lower triangle of A as specified by uplo.
+// On return, a contains the block diagonal matrix D and the multipliers used
+// to obtain the factor U or L.
+//
+// ipiv contains details of the interchanges and the block structure of D and
+// must have length n. If ipiv[k] >= 0, then rows and columns k and ipiv[k]
+// were interchanged and D[k,k] is a 1×1 diagonal block. If uplo == blas.Upper
+// and ipiv[k] = ipiv[k-1] < 0, then rows and columns k-1 and ^ipiv[k] were
+// interchanged and D[k-1:k+1,k-1:k+1] is a 2×2 diagonal block. If uplo ==
+// blas.Lower and ipiv[k] = ipiv[k+1] < 0, then rows and columns k+1 and
+// ^ipiv[k] were interchanged and D[k:k+2,k:k+2] is a 2×2 diagonal block.
+// ipiv is zero-indexed.
+//
+// Dsytf2 returns whether D is nonsingular. The factorization is completed
+// regardless of the singularity of D, but it should not be used to solve a
+// system of equations if D is singular.
+//
+// Dsytf2 is an internal routine. It is exported for testing purposes.
+func (Implementation) Dsytf2(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	}
+
+	bi := blas64.Implementation()
+
+	// alpha is used for pivot selection.
+	alpha := (1 + math.Sqrt(17)) / 8
+
+	ok = true
+	if uplo == blas.Upper {
+		// Factorize A as U*D*Uᵀ using the upper triangle of A.
+		// k decreases from n-1 to 0 in steps of 1 or 2.
+		for k := n - 1; k >= 0; {
+			kstep := 1
+
+			// Determine the rows and columns to be interchanged
+			// and whether a 1×1 or 2×2 pivot block will be used.
+			absakk := math.Abs(a[k*lda+k])
+			var (
+				imax   int
+				colmax float64
+			)
+			if k > 0 {
+				imax = bi.Idamax(k, a[k:], lda)
+				colmax = math.Abs(a[imax*lda+k])
+			}
+
+			var kp int
+			if math.Max(absakk, colmax) == 0 || math.IsNaN(absakk) {
+				// Column k is zero or contains a NaN.
+				ok = false
+				kp = k
+			} else {
+				if absakk >= alpha*colmax {
+					// No interchange, use 1×1 pivot block.
+					kp = k
+				} else {
+					// rowmax is the largest off-diagonal element
+					// in row imax.
+					jmax := imax + 1 + bi.Idamax(k-imax, a[imax*lda+imax+1:], 1)
+					rowmax := math.Abs(a[imax*lda+jmax])
+					if imax > 0 {
+						jmax = bi.Idamax(imax, a[imax:], lda)
+						rowmax = math.Max(rowmax, math.Abs(a[jmax*lda+imax]))
+					}
+					switch {
+					case absakk >= alpha*colmax*(colmax/rowmax):
+						// No interchange, use 1×1 pivot block.
+						kp = k
+					case math.Abs(a[imax*lda+imax]) >= alpha*rowmax:
+						// Interchange rows and columns k and imax,
+						// use 1×1 pivot block.
+						kp = imax
+					default:
+						// Interchange rows and columns k-1 and imax,
+						// use 2×2 pivot block.
+						kp = imax
+						kstep = 2
+					}
+				}
+
+				kk := k - kstep + 1
+				if kp != kk {
+					// Interchange rows and columns kk and kp in the
+					// leading submatrix A[0:k+1,0:k+1].
+					bi.Dswap(kp, a[kk:], lda, a[kp:], lda)
+					bi.Dswap(kk-kp-1, a[(kp+1)*lda+kk:], lda, a[kp*lda+kp+1:], 1)
+					a[kk*lda+kk], a[kp*lda+kp] = a[kp*lda+kp], a[kk*lda+kk]
+					if kstep == 2 {
+						a[(k-1)*lda+k], a[kp*lda+k] = a[kp*lda+k], a[(k-1)*lda+k]
+					}
+				}
+
+				// Update the leading submatrix.
+				if kstep == 1 {
+					// Perform a rank-1 update of A[0:k,0:k] as
+					//  A := A - U[k] * D[k] * U[k]ᵀ = A - W[k] * 1/D[k] * W[k]ᵀ
+					// and store U[k] in column k.
+					r1 := 1 / a[k*lda+k]
+					bi.Dsyr(uplo, k, -r1, a[k:], lda, a, lda)
+					bi.Dscal(k, r1, a[k:], lda)
+				} else if k > 1 {
+					// Perform a rank-2 update of A[0:k-1,0:k-1] as
+					//  A := A - (U[k-1] U[k]) * D[k] * (U[k-1] U[k])ᵀ
+					//     = A - (W[k-1] W[k]) * inv(D[k]) * (W[k-1] W[k])ᵀ
+					// and store U[k-1] and U[k] in columns k-1 and k.
+					d12 := a[(k-1)*lda+k]
+					d22 := a[(k-1)*lda+k-1] / d12
+					d11 := a[k*lda+k] / d12
+					t := 1 / (d11*d22 - 1)
+					d12 = t / d12
+					for j := k - 2; j >= 0; j-- {
+						wkm1 := d12 * (d11*a[j*lda+k-1] - a[j*lda+k])
+						wk := d12 * (d22*a[j*lda+k] - a[j*lda+k-1])
+						for i := j; i >= 0; i-- {
+							a[i*lda+j] -= a[i*lda+k]*wk + a[i*lda+k-1]*wkm1
+						}
+						a[j*lda+k] = wk
+						a[j*lda+k-1] = wkm1
+					}
+				}
+			}
+
+			// Store details of the interchanges in ipiv.
+			if kstep == 1 {
+				ipiv[k] = kp
+			} else {
+				ipiv[k] = ^kp
+				ipiv[k-1] = ^kp
+			}
+			k -= kstep
+		}
+		return ok
+	}
+
+	// Factorize A as L*D*Lᵀ using the lower triangle of A.
+	// k increases from 0 to n-1 in steps of 1 or 2.
+	for k := 0; k < n; {
+		kstep := 1
+
+		// Determine the rows and columns to be interchanged
+		// and whether a 1×1 or 2×2 pivot block will be used.
+		absakk := math.Abs(a[k*lda+k])
+		var (
+			imax   int
+			colmax float64
+		)
+		if k < n-1 {
+			imax = k + 1 + bi.Idamax(n-k-1, a[(k+1)*lda+k:], lda)
+			colmax = math.Abs(a[imax*lda+k])
+		}
+
+		var kp int
+		if math.Max(absakk, colmax) == 0 || math.IsNaN(absakk) {
+			// Column k is zero or contains a NaN.
+			ok = false
+			kp = k
+		} else {
+			if absakk >= alpha*colmax {
+				// No interchange, use 1×1 pivot block.
+				kp = k
+			} else {
+				// rowmax is the largest off-diagonal element
+				// in row imax.
+				jmax := k + bi.Idamax(imax-k, a[imax*lda+k:], 1)
+				rowmax := math.Abs(a[imax*lda+jmax])
+				if imax < n-1 {
+					jmax = imax + 1 + bi.Idamax(n-imax-1, a[(imax+1)*lda+imax:], lda)
+					rowmax = math.Max(rowmax, math.Abs(a[jmax*lda+imax]))
+				}
+				switch {
+				case absakk >= alpha*colmax*(colmax/rowmax):
+					// No interchange, use 1×1 pivot block.
+					kp = k
+				case math.Abs(a[imax*lda+imax]) >= alpha*rowmax:
+					// Interchange rows and columns k and imax,
+					// use 1×1 pivot block.
+					kp = imax
+				default:
+					// Interchange rows and columns k+1 and imax,
+					// use 2×2 pivot block.
+					kp = imax
+					kstep = 2
+				}
+			}
+
+			kk := k + kstep - 1
+			if kp != kk {
+				// Interchange rows and columns kk and kp in the
+				// trailing submatrix A[k:n,k:n].
+				if kp < n-1 {
+					bi.Dswap(n-kp-1, a[(kp+1)*lda+kk:], lda, a[(kp+1)*lda+kp:], lda)
+				}
+				bi.Dswap(kp-kk-1, a[(kk+1)*lda+kk:], lda, a[kp*lda+kk+1:], 1)
+				a[kk*lda+kk], a[kp*lda+kp] = a[kp*lda+kp], a[kk*lda+kk]
+				if kstep == 2 {
+					a[(k+1)*lda+k], a[kp*lda+k] = a[kp*lda+k], a[(k+1)*lda+k]
+				}
+			}
+
+			// Update the trailing submatrix.
+			if kstep == 1 {
+				if k < n-1 {
+					// Perform a rank-1 update of A[k+1:n,k+1:n] as
+					//  A := A - L[k] * D[k] * L[k]ᵀ = A - W[k] * (1/D[k]) * W[k]ᵀ
+					// and store L[k] in column k.
+					d11 := 1 / a[k*lda+k]
+					bi.Dsyr(uplo, n-k-1, -d11, a[(k+1)*lda+k:], lda, a[(k+1)*lda+k+1:], lda)
+					bi.Dscal(n-k-1, d11, a[(k+1)*lda+k:], lda)
+				}
+			} else if k < n-2 {
+				// Perform a rank-2 update of A[k+2:n,k+2:n] as
+				//  A := A - (L[k] L[k+1]) * D[k] * (L[k] L[k+1])ᵀ
+				//     = A - (W[k] W[k+1]) * inv(D[k]) * (W[k] W[k+1])ᵀ
+				// and store L[k] and L[k+1] in columns k and k+1.
+				d21 := a[(k+1)*lda+k]
+				d11 := a[(k+1)*lda+k+1] / d21
+				d22 := a[k*lda+k] / d21
+				t := 1 / (d11*d22 - 1)
+				d21 = t / d21
+				for j := k + 2; j < n; j++ {
+					wk := d21 * (d11*a[j*lda+k] - a[j*lda+k+1])
+					wkp1 := d21 * (d22*a[j*lda+k+1] - a[j*lda+k])
+					for i := j; i < n; i++ {
+						a[i*lda+j] -= a[i*lda+k]*wk + a[i*lda+k+1]*wkp1
+					}
+					a[j*lda+k] = wk
+					a[j*lda+k+1] = wkp1
+				}
+			}
+		}
+
+		// Store details of the interchanges in ipiv.
+		if kstep == 1 {
+			ipiv[k] = kp
+		} else {
+			ipiv[k] = ^kp
+			ipiv[k+1] = ^kp
+		}
+		k += kstep
+	}
+	return ok
+}
@@ -0,0 +1,67 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import "gonum.org/v1/gonum/blas"
+
+// Dsytrf computes the factorization of a real symmetric matrix A using the
+// Bunch-Kaufman diagonal pivoting method. The form of the factorization is
+//
+//	A = U * D * Uᵀ  if uplo == blas.Upper, or
+//	A = L * D * Lᵀ  if uplo == blas.Lower,
+//
+// where U (or L) is a product of permutation and unit upper (lower) triangular
+// matrices, and D is symmetric and block diagonal with 1×1 and 2×2 diagonal
+// blocks.
+//
+// On entry, a contains the upper or lower triangle of A as specified by uplo.
+// On return, a contains the block diagonal matrix D and the multipliers used
+// to obtain the factor U or L. The details of the interchanges and the block
+// structure of D are stored in ipiv, which must have length n, as described
+// in the documentation for Dsytf2.
+//
+// work is temporary storage, and lwork specifies the usable memory length.
+// lwork must be at least 1 and Dsytrf will panic otherwise. If lwork == -1,
+// instead of performing the factorization, the optimal work length will be
+// stored into work[0].
+//
+// Dsytrf returns whether D is nonsingular. The factorization is completed
+// regardless of the singularity of D, but it should not be used to solve a
+// system of equations if D is singular.
+func (impl Implementation) Dsytrf(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, work []float64, lwork int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case lwork < 1 && lwork != -1:
+		panic(badLWork)
+	case len(work) < max(1, lwork):
+		panic(shortWork)
+	}
+
+	// The factorization is currently computed by the unblocked
+	// algorithm so no workspace is required.
+	if lwork == -1 {
+		work[0] = 1
+		return true
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	}
+
+	return impl.Dsytf2(uplo, n, a, lda, ipiv)
+}
@@ -0,0 +1,195 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Dsytrs solves a system of linear equations A*X = B with a real symmetric
+// matrix A using the factorization
+//
+//	A = U * D * Uᵀ  if uplo == blas.Upper, or
+//	A = L * D * Lᵀ  if uplo == blas.Lower,
+//
+// computed by Dsytrf. a and ipiv contain the factorization and the details
+// of the interchanges as returned by Dsytrf.
+//
+// On entry, b contains the n×nrhs right-hand side matrix B. On return, it
+// contains the solution matrix X.
+func (Implementation) Dsytrs(uplo blas.Uplo, n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0:
+		panic(nLT0)
+	case nrhs < 0:
+		panic(nrhsLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case ldb < max(1, nrhs):
+		panic(badLdB)
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(shortA)
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(shortB)
+	case len(ipiv) != n:
+		panic(badLenIpiv)
+	}
+
+	bi := blas64.Implementation()
+
+	// solve2 solves the 2×2 system with the diagonal block
+	// of D in rows and columns k0 and k1 for the rows k0
+	// and k1 of B.
+	solve2 := func(k0, k1 int) {
+		// Use the off-diagonal element of the stored triangle.
+		offDiag := a[k0*lda+k1]
+		if uplo == blas.Lower {
+			offDiag = a[k1*lda+k0]
+		}
+		d0 := a[k0*lda+k0] / offDiag
+		d1 := a[k1*lda+k1] / offDiag
+		denom := d0*d1 - 1
+		for j := 0; j < nrhs; j++ {
+			b0 := b[k0*ldb+j] / offDiag
+			b1 := b[k1*ldb+j] / offDiag
+			b[k0*ldb+j] = (d1*b0 - b1) / denom
+			b[k1*ldb+j] = (d0*b1 - b0) / denom
+		}
+	}
+
+	if uplo == blas.Upper {
+		// Solve A*X = B, where A = U*D*Uᵀ.
+
+		// First solve U*D*X = B, overwriting B with X.
+		for k := n - 1; k >= 0; {
+			if ipiv[k] >= 0 {
+				// 1×1 diagonal block.
+				// Interchange rows k and ipiv[k].
+				if kp := ipiv[k]; kp != k {
+					bi.Dswap(nrhs, b[k*ldb:], 1, b[kp*ldb:], 1)
+				}
+				// Multiply by inv(U[k]), where U[k] is the
+				// transformation stored in column k of A.
+				bi.Dger(k, nrhs, -1, a[k:], lda, b[k*ldb:], 1, b, ldb)
+				// Multiply by the inverse of the diagonal block.
+				bi.Dscal(nrhs, 1/a[k*lda+k], b[k*ldb:], 1)
+				k--
+				continue
+			}
+			// 2×2 diagonal block.
+			// Interchange rows k-1 and ^ipiv[k].
+			if kp := ^ipiv[k]; kp != k-1 {
+				bi.Dswap(nrhs, b[(k-1)*ldb:], 1, b[kp*ldb:], 1)
+			}
+			// Multiply by inv(U[k]), where U[k] is the
+			// transformation stored in columns k-1 and k of A.
+			bi.Dger(k-1, nrhs, -1, a[k:], lda, b[k*ldb:], 1, b, ldb)
+			bi.Dger(k-1, nrhs, -1, a[k-1:], lda, b[(k-1)*ldb:], 1, b, ldb)
+			// Multiply by the inverse of the diagonal block.
+			solve2(k-1, k)
+			k -= 2
+		}
+
+		// Next solve Uᵀ*X = B, overwriting B with X.
+		for k := 0; k < n; {
+			if ipiv[k] >= 0 {
+				// 1×1 diagonal block.
+				// Multiply by inv(U[k]ᵀ).
+				bi.Dgemv(blas.Trans, k, nrhs, -1, b, ldb, a[k:], lda, 1, b[k*ldb:], 1)
+				// Interchange rows k and ipiv[k].
+				if kp := ipiv[k]; kp != k {
+					bi.Dswap(nrhs, b[k*ldb:], 1, b[kp*ldb:], 1)
+				}
+				k++
+				continue
+			}
+			// 2×2 diagonal block.
+			// Multiply by inv(U[k+1]ᵀ).
+			bi.Dgemv(blas.Trans, k, nrhs, -1, b, ldb, a[k:], lda, 1, b[k*ldb:], 1)
+			bi.Dgemv(blas.Trans, k, nrhs, -1, b, ldb, a[k+1:], lda, 1, b[(k+1)*ldb:], 1)
+			// Interchange rows k and ^ipiv[k].
+			if kp := ^ipiv[k]; kp != k {
+				bi.Dswap(nrhs, b[k*ldb:], 1, b[kp*ldb:], 1)
+			}
+			k += 2
+		}
+		return
+	}
+
+	// Solve A*X = B, where A = L*D*Lᵀ.
+
+	// First solve L*D*X = B, overwriting B with X.
+	for k := 0; k < n; {
+		if ipiv[k] >= 0 {
+			// 1×1 diagonal block.
+			// Interchange rows k and ipiv[k].
+			if kp := ipiv[k]; kp != k {
+				bi.Dswap(nrhs, b[k*ldb:], 1, b[kp*ldb:], 1)
+			}
+			// Multiply by inv(L[k]), where L[k] is the
+			// transformation stored in column k of A.
+			if k < n-1 {
+				bi.Dger(n-k-1, nrhs, -1, a[(k+1)*lda+k:], lda, b[k*ldb:], 1, b[(k+1)*ldb:], ldb)
+			}
+			// Multiply by the inverse of the diagonal block.
+			bi.Dscal(nrhs, 1/a[k*lda+k], b[k*ldb:], 1)
+			k++
+			continue
+		}
+		// 2×2 diagonal block.
+		// Interchange rows k+1 and ^ipiv[k].
+		if kp := ^ipiv[k]; kp != k+1 {
+			bi.Dswap(nrhs, b[(k+1)*ldb:], 1, b[kp*ldb:], 1)
+		}
+		// Multiply by inv(L[k]), where L[k] is the
+		// transformation stored in columns k and k+1 of A.
+		if k < n-2 {
+			bi.Dger(n-k-2, nrhs, -1, a[(k+2)*lda+k:], lda, b[k*ldb:], 1, b[(k+2)*ldb:], ldb)
+			bi.Dger(n-k-2, nrhs, -1, a[(k+2)*lda+k+1:], lda, b[(k+1)*ldb:], 1, b[(k+2)*ldb:], ldb)
+		}
+		// Multiply by the inverse of the diagonal block.
+		solve2(k, k+1)
+		k += 2
+	}
+
+	// Next solve Lᵀ*X = B, overwriting B with X.
+	for k := n - 1; k >= 0; {
+		if ipiv[k] >= 0 {
+			// 1×1 diagonal block.
+			// Multiply by inv(L[k]ᵀ).
+			if k < n-1 {
+				bi.Dgemv(blas.Trans, n-k-1, nrhs, -1, b[(k+1)*ldb:], ldb, a[(k+1)*lda+k:], lda, 1, b[k*ldb:], 1)
+			}
+			// Interchange rows k and ipiv[k].
+			if kp := ipiv[k]; kp != k {
+				bi.Dswap(nrhs, b[k*ldb:], 1, b[kp*ldb:], 1)
+			}
+			k--
+			continue
+		}
+		// 2×2 diagonal block.
+		// Multiply by inv(L[k-1]ᵀ).
+		if k < n-1 {
+			bi.Dgemv(blas.Trans, n-k-1, nrhs, -1, b[(k+1)*ldb:], ldb, a[(k+1)*lda+k:], lda, 1, b[k*ldb:], 1)
+			bi.Dgemv(blas.Trans, n-k-1, nrhs, -1, b[(k+1)*ldb:], ldb, a[(k+1)*lda+k-1:], lda, 1, b[(k-1)*ldb:], 1)
+		}
+		// Interchange rows k and ^ipiv[k].
+		if kp := ^ipiv[k]; kp != k {
+			bi.Dswap(nrhs, b[k*ldb:], 1, b[kp*ldb:], 1)
+		}
+		k -= 2
+	}
+}
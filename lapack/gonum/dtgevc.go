@@ -0,0 +1,487 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gonum
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Dtgevc computes some or all of the right and/or left eigenvectors of a pair
+// of real matrices (S,P), where S is upper quasi-triangular and P is upper
+// triangular. Matrix pairs of this type are produced by the generalized Schur
+// factorization of a matrix pair (A,B)
+//
+//	A = Q * S * Zᵀ,
+//	B = Q * P * Zᵀ,
+//
+// as computed by Dhgeqz. S must be in the standardized form returned by
+// Dhgeqz, that is, its 2×2 diagonal blocks correspond to complex conjugate
+// pairs of eigenvalues and the corresponding 2×2 diagonal blocks of P are
+// diagonal.
+//
+// The right eigenvector x and the left eigenvector y of (S,P) corresponding
+// to an eigenvalue w are defined by
+//
+//	S * x = w * P * x,
+//	yᴴ * S = w * yᴴ * P.
+//
+// The eigenvalues are computed from the diagonal blocks of S and P.
+//
+// If side == lapack.EVRight, only right eigenvectors will be computed.
+// If side == lapack.EVLeft, only left eigenvectors will be computed.
+// If side == lapack.EVBoth, both right and left eigenvectors will be computed.
+// For other values of side, Dtgevc will panic.
+//
+// If howmny == lapack.EVAll, all right and/or left eigenvectors of (S,P) will
+// be computed.
+// If howmny == lapack.EVAllMulQ, all right and/or left eigenvectors will be
+// computed and multiplied from the left by the matrices in VR and/or VL. If
+// VL contains Q and VR contains Z from the generalized Schur factorization,
+// the results are the eigenvectors of (A,B).
+// If howmny == lapack.EVSelected, right and/or left eigenvectors of (S,P)
+// will be computed as indicated by selected.
+// For other values of howmny, Dtgevc will panic.
+//
+// selected specifies which eigenvectors will be computed. It must have length n
+// if howmny == lapack.EVSelected, and it is not referenced otherwise. If w_j is
+// a real eigenvalue, the corresponding real eigenvector will be computed if
+// selected[j] is true. If w_j and w_{j+1} are a complex conjugate pair of
+// eigenvalues, the corresponding complex eigenvector is computed if either
+// selected[j] or selected[j+1] is true.
+//
+// VL and VR are n×mm matrices. If howmny is lapack.EVAll or lapack.EVAllMulQ,
+// mm must be at least n. If howmny is lapack.EVSelected, mm must be large
+// enough to store the selected eigenvectors. Each selected real eigenvector
+// occupies one column and each selected complex eigenvector occupies two
+// columns. If mm is not sufficiently large, Dtgevc will panic. VL is not
+// referenced if side == lapack.EVRight and VR is not referenced if
+// side == lapack.EVLeft.
+//
+// Complex eigenvectors corresponding to a complex conjugate pair of eigenvalues
+// w_j and w_{j+1} are stored in two consecutive columns, the first holding the
+// real part and the second the imaginary part of the eigenvector corresponding
+// to the eigenvalue w_j with positive imaginary part.
+//
+// Each eigenvector will be normalized so that the element of largest magnitude
+// has magnitude 1. Here the magnitude of a complex number (x,y) is taken to be
+// |x| + |y|.
+//
+// work must have length at least 4*n, otherwise Dtgevc will panic.
+//
+// Dtgevc returns the number of columns in VL and/or VR actually used to store
+// the eigenvectors.
+//
+// Dtgevc is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dtgevc(side lapack.EVSide, howmny lapack.EVHowMany, selected []bool, n int, s []float64, lds int, p []float64, ldp int, vl []float64, ldvl int, vr []float64, ldvr int, mm int, work []float64) (m int) {
+	bothv := side == lapack.EVBoth
+	rightv := side == lapack.EVRight || bothv
+	leftv := side == lapack.EVLeft || bothv
+	switch {
+	case !rightv && !leftv:
+		panic(badEVSide)
+	case howmny != lapack.EVAll && howmny != lapack.EVAllMulQ && howmny != lapack.EVSelected:
+		panic(badEVHowMany)
+	case n < 0:
+		panic(nLT0)
+	case lds < max(1, n):
+		panic(badLdS)
+	case ldp < max(1, n):
+		panic(badLdP)
+	case mm < 0:
+		panic(mmLT0)
+	case ldvl < 1 || (leftv && ldvl < n):
+		panic(badLdVL)
+	case ldvr < 1 || (rightv && ldvr < n):
+		panic(badLdVR)
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0
+	}
+
+	switch {
+	case len(s) < (n-1)*lds+n:
+		panic(shortS)
+	case len(p) < (n-1)*ldp+n:
+		panic(shortP)
+	case howmny == lapack.EVSelected && len(selected) != n:
+		panic(badLenSelected)
+	case len(work) < 4*n:
+		panic(shortWork)
+	}
+
+	// Determine the number of columns needed to store the eigenvectors.
+	if howmny == lapack.EVSelected {
+		for j := 0; j < n; j++ {
+			if j < n-1 && s[(j+1)*lds+j] != 0 {
+				if selected[j] || selected[j+1] {
+					m += 2
+				}
+				j++
+				continue
+			}
+			if selected[j] {
+				m++
+			}
+		}
+	} else {
+		m = n
+	}
+	if mm < m {
+		panic(badMm)
+	}
+	switch {
+	case leftv && len(vl) < (n-1)*ldvl+m:
+		panic(shortVL)
+	case rightv && len(vr) < (n-1)*ldvr+m:
+		panic(shortVR)
+	}
+
+	const (
+		safmin = dlamchS
+		ulp    = dlamchP
+		bignum = 1 / (safmin * float64(1<<20))
+	)
+
+	snorm := impl.Dlange(lapack.MaxAbs, n, n, s, lds, nil)
+	pnorm := impl.Dlange(lapack.MaxAbs, n, n, p, ldp, nil)
+	snorm = math.Max(snorm, safmin)
+	pnorm = math.Max(pnorm, safmin)
+
+	// The eigenvector being computed is held in work[:n] (real part) and
+	// work[n:2*n] (imaginary part). The back-transformed eigenvector is
+	// held in work[2*n:3*n] and work[3*n:4*n].
+	xr := work[:n]
+	xi := work[n : 2*n]
+	yr := work[2*n : 3*n]
+	yi := work[3*n : 4*n]
+	x := func(i int) complex128 { return complex(xr[i], xi[i]) }
+	setX := func(i int, v complex128) { xr[i], xi[i] = real(v), imag(v) }
+
+	// coefs returns the scaled coefficients (β, α) of the eigenvalue
+	// α/β from the diagonal block of (S,P) starting at j.
+	coefs := func(j int, cplx bool) (bcoef float64, acoef complex128) {
+		if cplx {
+			scale1, _, wr1, _, wi := impl.Dlag2(s[j*lds+j:], lds, p[j*ldp+j:], ldp)
+			bcoef, acoef = scale1, complex(wr1, wi)
+		} else {
+			bcoef, acoef = p[j*ldp+j], complex(s[j*lds+j], 0)
+		}
+		scale := math.Max(math.Max(math.Abs(bcoef)*snorm, abs1(acoef)*pnorm), safmin)
+		return bcoef / scale, acoef / complex(scale, 0)
+	}
+
+	// rescale scales down the eigenvector in [lo:hi+1] if an element
+	// became too large.
+	rescale := func(lo, hi int, v complex128) {
+		if abs1(v) <= bignum {
+			return
+		}
+		f := 1 / abs1(v)
+		bi := blas64.Implementation()
+		bi.Dscal(hi-lo+1, f, xr[lo:], 1)
+		bi.Dscal(hi-lo+1, f, xi[lo:], 1)
+	}
+
+	bi := blas64.Implementation()
+
+	if rightv {
+		// Compute right eigenvectors from the last eigenvalue to the
+		// first, so that the back-transformation can be done in place.
+		ie := m - 1
+		for je := n - 1; je >= 0; je-- {
+			ilcplx := je > 0 && s[je*lds+je-1] != 0
+			if howmny == lapack.EVSelected {
+				sel := selected[je]
+				if ilcplx {
+					sel = sel || selected[je-1]
+				}
+				if !sel {
+					if ilcplx {
+						je--
+					}
+					continue
+				}
+			}
+			j0 := je
+			if ilcplx {
+				j0 = je - 1
+			}
+			for i := 0; i < n; i++ {
+				xr[i] = 0
+				xi[i] = 0
+			}
+
+			bcoef, acoef := coefs(j0, ilcplx)
+			c := func(i, k int) complex128 {
+				return complex(bcoef*s[i*lds+k], 0) - acoef*complex(p[i*ldp+k], 0)
+			}
+			smin := math.Max(ulp*(math.Abs(bcoef)*snorm+abs1(acoef)*pnorm), safmin)
+
+			switch {
+			case !ilcplx && bcoef == 0 && acoef == 0:
+				// Singular matrix pencil, use a unit eigenvector.
+				xr[je] = 1
+			case !ilcplx:
+				xr[je] = 1
+			default:
+				// Compute a null vector of the 2×2 diagonal block.
+				c11, c12 := c(j0, j0), c(j0, je)
+				c21, c22 := c(je, j0), c(je, je)
+				if abs1(c11)+abs1(c12) >= abs1(c21)+abs1(c22) {
+					setX(j0, -c12)
+					setX(je, c11)
+				} else {
+					setX(j0, -c22)
+					setX(je, c21)
+				}
+			}
+
+			// Back substitution.
+			if !(bcoef == 0 && acoef == 0) {
+				for i := j0 - 1; i >= 0; {
+					if i > 0 && s[i*lds+i-1] != 0 {
+						// 2×2 diagonal block.
+						var r1, r2 complex128
+						for k := i + 1; k <= je; k++ {
+							r1 -= c(i-1, k) * x(k)
+							r2 -= c(i, k) * x(k)
+						}
+						y1, y2 := dtgevcSolve2(c(i-1, i-1), c(i-1, i), c(i, i-1), c(i, i), r1, r2, smin)
+						setX(i-1, y1)
+						setX(i, y2)
+						rescale(0, je, complex(math.Max(abs1(y1), abs1(y2)), 0))
+						i -= 2
+						continue
+					}
+					var r complex128
+					for k := i + 1; k <= je; k++ {
+						r -= c(i, k) * x(k)
+					}
+					d := c(i, i)
+					if abs1(d) < smin {
+						d = complex(smin, 0)
+					}
+					y := r / d
+					setX(i, y)
+					rescale(0, je, y)
+					i--
+				}
+			}
+
+			// Back-transform and store the eigenvector.
+			nr := je + 1
+			if howmny == lapack.EVAllMulQ {
+				bi.Dgemv(blas.NoTrans, n, nr, 1, vr, ldvr, xr, 1, 0, yr, 1)
+				if ilcplx {
+					bi.Dgemv(blas.NoTrans, n, nr, 1, vr, ldvr, xi, 1, 0, yi, 1)
+				}
+				nr = n
+			} else {
+				copy(yr[:nr], xr[:nr])
+				copy(yi[:nr], xi[:nr])
+			}
+			var xmax float64
+			for i := 0; i < nr; i++ {
+				if ilcplx {
+					xmax = math.Max(xmax, math.Abs(yr[i])+math.Abs(yi[i]))
+				} else {
+					xmax = math.Max(xmax, math.Abs(yr[i]))
+				}
+			}
+			f := 1 / math.Max(xmax, safmin)
+			col := je
+			if howmny == lapack.EVSelected {
+				col = ie
+			}
+			if ilcplx {
+				col--
+			}
+			for i := 0; i < n; i++ {
+				var re, im float64
+				if i < nr {
+					re, im = f*yr[i], f*yi[i]
+				}
+				vr[i*ldvr+col] = re
+				if ilcplx {
+					vr[i*ldvr+col+1] = im
+				}
+			}
+			if ilcplx {
+				ie -= 2
+				je--
+			} else {
+				ie--
+			}
+		}
+	}
+
+	if leftv {
+		// Compute left eigenvectors from the first eigenvalue to the
+		// last, so that the back-transformation can be done in place.
+		ie := 0
+		for je := 0; je < n; je++ {
+			ilcplx := je < n-1 && s[(je+1)*lds+je] != 0
+			if howmny == lapack.EVSelected {
+				sel := selected[je]
+				if ilcplx {
+					sel = sel || selected[je+1]
+				}
+				if !sel {
+					if ilcplx {
+						je++
+					}
+					continue
+				}
+			}
+			j1 := je
+			if ilcplx {
+				j1 = je + 1
+			}
+			for i := 0; i < n; i++ {
+				xr[i] = 0
+				xi[i] = 0
+			}
+
+			// Left eigenvectors of (S,P) for w = α/β are the null
+			// vectors of (β*S - conj(α)*P)ᵀ.
+			bcoef, acoef := coefs(je, ilcplx)
+			acoef = complex(real(acoef), -imag(acoef))
+			c := func(i, k int) complex128 {
+				return complex(bcoef*s[i*lds+k], 0) - acoef*complex(p[i*ldp+k], 0)
+			}
+			smin := math.Max(ulp*(math.Abs(bcoef)*snorm+abs1(acoef)*pnorm), safmin)
+
+			switch {
+			case !ilcplx:
+				xr[je] = 1
+			default:
+				// Compute a null vector of the transpose of the
+				// 2×2 diagonal block.
+				c11, c12 := c(je, je), c(je, j1)
+				c21, c22 := c(j1, je), c(j1, j1)
+				if abs1(c11)+abs1(c21) >= abs1(c12)+abs1(c22) {
+					setX(je, -c21)
+					setX(j1, c11)
+				} else {
+					setX(je, -c22)
+					setX(j1, c12)
+				}
+			}
+
+			// Forward substitution.
+			if !(bcoef == 0 && acoef == 0) {
+				for i := j1 + 1; i < n; {
+					if i < n-1 && s[(i+1)*lds+i] != 0 {
+						// 2×2 diagonal block.
+						var r1, r2 complex128
+						for k := je; k < i; k++ {
+							r1 -= c(k, i) * x(k)
+							r2 -= c(k, i+1) * x(k)
+						}
+						y1, y2 := dtgevcSolve2(c(i, i), c(i+1, i), c(i, i+1), c(i+1, i+1), r1, r2, smin)
+						setX(i, y1)
+						setX(i+1, y2)
+						rescale(je, n-1, complex(math.Max(abs1(y1), abs1(y2)), 0))
+						i += 2
+						continue
+					}
+					var r complex128
+					for k := je; k < i; k++ {
+						r -= c(k, i) * x(k)
+					}
+					d := c(i, i)
+					if abs1(d) < smin {
+						d = complex(smin, 0)
+					}
+					y := r / d
+					setX(i, y)
+					rescale(je, n-1, y)
+					i++
+				}
+			}
+
+			// Back-transform and store the eigenvector.
+			nr := n - je
+			lo := je
+			if howmny == lapack.EVAllMulQ {
+				bi.Dgemv(blas.NoTrans, n, nr, 1, vl[je:], ldvl, xr[je:], 1, 0, yr, 1)
+				if ilcplx {
+					bi.Dgemv(blas.NoTrans, n, nr, 1, vl[je:], ldvl, xi[je:], 1, 0, yi, 1)
+				}
+				lo = 0
+			} else {
+				copy(yr[je:], xr[je:])
+				copy(yi[je:], xi[je:])
+			}
+			var xmax float64
+			for i := lo; i < n; i++ {
+				if ilcplx {
+					xmax = math.Max(xmax, math.Abs(yr[i])+math.Abs(yi[i]))
+				} else {
+					xmax = math.Max(xmax, math.Abs(yr[i]))
+				}
+			}
+			f := 1 / math.Max(xmax, safmin)
+			col := je
+			if howmny == lapack.EVSelected {
+				col = ie
+			}
+			for i := 0; i < n; i++ {
+				var re, im float64
+				if i >= lo {
+					re, im = f*yr[i], f*yi[i]
+				}
+				vl[i*ldvl+col] = re
+				if ilcplx {
+					vl[i*ldvl+col+1] = im
+				}
+			}
+			if ilcplx {
+				ie += 2
+				je++
+			} else {
+				ie++
+			}
+		}
+	}
+	return m
+}
+
+// dtgevcSolve2 solves the complex 2×2 system
+//
+//	[ c11 c12 ] [ y1 ] = [ r1 ]
+//	[ c21 c22 ] [ y2 ]   [ r2 ]
+//
+// using Gaussian elimination with partial pivoting. Pivots smaller than smin
+// are perturbed to smin.
+func dtgevcSolve2(c11, c12, c21, c22, r1, r2 complex128, smin float64) (y1, y2 complex128) {
+	if abs1(c21) > abs1(c11) {
+		c11, c12, c21, c22 = c21, c22, c11, c12
+		r1, r2 = r2, r1
+	}
+	if abs1(c11) < smin {
+		c11 = complex(smin, 0)
+	}
+	l := c21 / c11
+	u22 := c22 - l*c12
+	r2 -= l * r1
+	if abs1(u22) < smin {
+		u22 = complex(smin, 0)
+	}
+	y2 = r2 / u22
+	y1 = (r1 - c12*y2) / c11
+	return y1, y2
+}
+
+// abs1 returns |re(z)| + |im(z)|.
+func abs1(z complex128) float64 {
+	return math.Abs(real(z)) + math.Abs(imag(z))
+}
@@ -14,6 +14,7 @@ const (
 	badEVComp           = "lapack: bad EVComp"
 	badEVHowMany        = "lapack: bad EVHowMany"
 	badEVJob            = "lapack: bad EVJob"
+	badEVRange          = "lapack: bad EVRange"
 	badEVSide           = "lapack: bad EVSide"
 	badGSVDJob          = "lapack: bad GSVDJob"
 	badGenOrtho         = "lapack: bad GenOrtho"
@@ -39,11 +40,13 @@ const (
 	badIfst     = "lapack: ifst out of range"
 	badIhi      = "lapack: ihi out of range"
 	badIhiz     = "lapack: ihiz out of range"
+	badIl       = "lapack: il out of range"
 	badIlo      = "lapack: ilo out of range"
 	badIloz     = "lapack: iloz out of range"
 	badIlst     = "lapack: ilst out of range"
 	badIsave    = "lapack: bad isave value"
 	badIspec    = "lapack: bad ispec value"
+	badIu       = "lapack: iu out of range"
 	badJ1       = "lapack: j1 out of range"
 	badJpvt     = "lapack: bad element of jpvt"
 	badK1       = "lapack: k1 out of range"
@@ -61,6 +64,7 @@ const (
 	badNw       = "lapack: bad value of nw"
 	badPp       = "lapack: bad value of pp"
 	badShifts   = "lapack: bad shifts"
+	badVlVu     = "lapack: vl >= vu"
 	i0LT0       = "lapack: i0 < 0"
 	kGTM        = "lapack: k > m"
 	kGTN        = "lapack: k > n"
@@ -97,10 +101,13 @@ const (
 	offsetLT0   = "lapack: offset < 0"
 	pLT0        = "lapack: p < 0"
 	recurLT0    = "lapack: recur < 0"
+	vlLT0       = "lapack: vl < 0"
 	zeroCFrom   = "lapack: zero cfrom"
 
 	// Panic strings for bad slice lengths.
 	badLenAlpha    = "lapack: bad length of alpha"
+	badLenAlphai   = "lapack: bad length of alphai"
+	badLenAlphar   = "lapack: bad length of alphar"
 	badLenBeta     = "lapack: bad length of beta"
 	badLenIpiv     = "lapack: bad length of ipiv"
 	badLenJpiv     = "lapack: bad length of jpiv"
@@ -129,6 +136,7 @@ const (
 	shortH     = "lapack: insufficient length of h"
 	shortIWork = "lapack: insufficient length of iwork"
 	shortIsgn  = "lapack: insufficient length of isgn"
+	shortP     = "lapack: insufficient length of p"
 	shortQ     = "lapack: insufficient length of q"
 	shortRHS   = "lapack: insufficient length of rhs"
 	shortS     = "lapack: insufficient length of s"
@@ -160,7 +168,9 @@ const (
 	badLdC    = "lapack: bad leading dimension of C"
 	badLdF    = "lapack: bad leading dimension of F"
 	badLdH    = "lapack: bad leading dimension of H"
+	badLdP    = "lapack: bad leading dimension of P"
 	badLdQ    = "lapack: bad leading dimension of Q"
+	badLdS    = "lapack: bad leading dimension of S"
 	badLdT    = "lapack: bad leading dimension of T"
 	badLdU    = "lapack: bad leading dimension of U"
 	badLdV    = "lapack: bad leading dimension of V"
@@ -22,6 +22,21 @@ func TestDhseqr(t *testing.T) {
 	testlapack.DhseqrTest(t, impl)
 }
 
+func TestDhgeqz(t *testing.T) {
+	t.Parallel()
+	testlapack.DhgeqzTest(t, impl)
+}
+
+func TestDgbtrf(t *testing.T) {
+	t.Parallel()
+	testlapack.DgbtrfTest(t, impl)
+}
+
+func TestDgbtrs(t *testing.T) {
+	t.Parallel()
+	testlapack.DgbtrsTest(t, impl)
+}
+
 func TestDgebak(t *testing.T) {
 	t.Parallel()
 	testlapack.DgebakTest(t, impl)
@@ -153,6 +168,11 @@ func TestDgghrd(t *testing.T) {
 	testlapack.DgghrdTest(t, impl)
 }
 
+func TestDggev(t *testing.T) {
+	t.Parallel()
+	testlapack.DggevTest(t, impl)
+}
+
 func TestDggsvd3(t *testing.T) {
 	t.Parallel()
 	testlapack.Dggsvd3Test(t, impl)
@@ -213,6 +233,11 @@ func TestDlagtm(t *testing.T) {
 	testlapack.DlagtmTest(t, impl)
 }
 
+func TestDlagv2(t *testing.T) {
+	t.Parallel()
+	testlapack.Dlagv2Test(t, impl)
+}
+
 func TestDlahqr(t *testing.T) {
 	t.Parallel()
 	testlapack.DlahqrTest(t, impl)
@@ -603,21 +628,46 @@ func TestDrscl(t *testing.T) {
 	testlapack.DrsclTest(t, impl)
 }
 
+func TestDsbtrd(t *testing.T) {
+	t.Parallel()
+	testlapack.DsbtrdTest(t, impl)
+}
+
 func TestDsteqr(t *testing.T) {
 	t.Parallel()
 	testlapack.DsteqrTest(t, impl)
 }
 
+func TestDstebz(t *testing.T) {
+	t.Parallel()
+	testlapack.DstebzTest(t, impl)
+}
+
+func TestDstein(t *testing.T) {
+	t.Parallel()
+	testlapack.DsteinTest(t, impl)
+}
+
 func TestDsterf(t *testing.T) {
 	t.Parallel()
 	testlapack.DsterfTest(t, impl)
 }
 
+func TestDsycon(t *testing.T) {
+	t.Parallel()
+	testlapack.DsyconTest(t, impl)
+}
+
 func TestDsyev(t *testing.T) {
 	t.Parallel()
 	testlapack.DsyevTest(t, impl)
 }
 
+func TestDsyevr(t *testing.T) {
+	t.Parallel()
+	testlapack.DsyevrTest(t, impl)
+}
+
 func TestDsytd2(t *testing.T) {
 	t.Parallel()
 	testlapack.Dsytd2Test(t, impl)
@@ -633,6 +683,21 @@ func TestDtgsja(t *testing.T) {
 	testlapack.DtgsjaTest(t, impl)
 }
 
+func TestDsytf2(t *testing.T) {
+	t.Parallel()
+	testlapack.Dsytf2Test(t, impl)
+}
+
+func TestDsytrf(t *testing.T) {
+	t.Parallel()
+	testlapack.DsytrfTest(t, impl)
+}
+
+func TestDsytrs(t *testing.T) {
+	t.Parallel()
+	testlapack.DsytrsTest(t, impl)
+}
+
 func TestDtbtrs(t *testing.T) {
 	t.Parallel()
 	testlapack.DtbtrsTest(t, impl)
@@ -648,6 +713,11 @@ func TestDtrevc3(t *testing.T) {
 	testlapack.Dtrevc3Test(t, impl)
 }
 
+func TestDtgevc(t *testing.T) {
+	t.Parallel()
+	testlapack.DtgevcTest(t, impl)
+}
+
 func TestDtrexc(t *testing.T) {
 	t.Parallel()
 	testlapack.DtrexcTest(t, impl)
@@ -677,3 +747,8 @@ func TestIladlr(t *testing.T) {
 	t.Parallel()
 	testlapack.IladlrTest(t, impl)
 }
+
+func TestDbdsvdx(t *testing.T) {
+	t.Parallel()
+	testlapack.DbdsvdxTest(t, impl)
+}
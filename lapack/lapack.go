@@ -144,6 +144,15 @@ const (
 	EVCompNone EVComp = 'N' // Do not compute eigenvectors.
 )
 
+// EVRange specifies which eigenvalues are computed in Dstebz.
+type EVRange byte
+
+const (
+	EVRangeAll   EVRange = 'A' // Compute all eigenvalues.
+	EVRangeValue EVRange = 'V' // Compute the eigenvalues in the half-open interval (vl, vu].
+	EVRangeIndex EVRange = 'I' // Compute the eigenvalues with indices il through iu.
+)
+
 // EVJob specifies whether eigenvectors are computed in Dsyev.
 type EVJob byte
 
@@ -230,7 +239,8 @@ const (
 	NormalizedNullVector MaximizeNormXJob = 2 // Compute an approximate null-vector e of Z, normalize e and solve Z*x=±e-f.
 )
 
-// OrthoComp specifies whether and how the orthogonal matrix is computed in Dgghrd.
+// OrthoComp specifies whether and how the orthogonal matrix is computed in Dgghrd
+// and Dsbtrd.
 type OrthoComp byte
 
 const (
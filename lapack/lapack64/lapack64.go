@@ -166,6 +166,46 @@ func Pstrf(a blas64.Symmetric, piv []int, tol float64, work []float64) (t blas64
 	return t, rank, ok
 }
 
+// Gbtrf computes an LU factorization of the m×n band matrix A using partial
+// pivoting with row interchanges. The factorization has the form
+//
+//	A = P * L * U,
+//
+// where P is a permutation matrix, L is unit lower triangular and U is upper
+// triangular. The band of U is wider than the band of A, so a must have room
+// for the fill-in: A has a.KL subdiagonals and a.KU-a.KL superdiagonals, and
+// the remaining a.KL superdiagonals of a are overwritten. On return, a contains
+// U and the multipliers used to compute L.
+//
+// ipiv contains the pivot indices and must have length min(m,n). Row i of the
+// matrix was interchanged with row ipiv[i]. Gbtrf returns whether U is
+// nonsingular. Gbtrf will panic if a.KU < a.KL.
+//
+// Dgbtrf is not part of the lapack.Float64 interface and so calls to Gbtrf are
+// always executed by the Gonum implementation.
+func Gbtrf(a blas64.Band, ipiv []int) (ok bool) {
+	if a.KU < a.KL {
+		panic("lapack64: band too narrow for fill-in")
+	}
+	return gonum.Implementation{}.Dgbtrf(a.Rows, a.Cols, a.KL, a.KU-a.KL, a.Data, max(1, a.Stride), ipiv)
+}
+
+// Gbtrs solves a system of linear equations
+//
+//	A * X = B   if trans == blas.NoTrans
+//	Aᵀ * X = B  if trans == blas.Trans or blas.ConjTrans
+//
+// with an n×n band matrix A using the LU factorization computed by Gbtrf. a
+// and ipiv contain the factorization as returned by Gbtrf. On entry, b
+// contains the right-hand side matrix B, on return it contains the solution
+// matrix X.
+//
+// Dgbtrs is not part of the lapack.Float64 interface and so calls to Gbtrs are
+// always executed by the Gonum implementation.
+func Gbtrs(trans blas.Transpose, a blas64.Band, ipiv []int, b blas64.General) {
+	gonum.Implementation{}.Dgbtrs(trans, a.Cols, a.KL, a.KU-a.KL, b.Cols, a.Data, max(1, a.Stride), ipiv, b.Data, max(1, b.Stride))
+}
+
 // Gecon estimates the reciprocal of the condition number of the n×n matrix A
 // given the LU decomposition of the matrix. The condition number computed may
 // be based on the 1-norm or the ∞-norm.
@@ -412,6 +452,68 @@ func Getrs(trans blas.Transpose, a blas64.General, b blas64.General, ipiv []int)
 	lapack64.Dgetrs(trans, a.Cols, b.Cols, a.Data, max(1, a.Stride), ipiv, b.Data, max(1, b.Stride))
 }
 
+// Ggev computes the generalized eigenvalues and, optionally, the left and/or
+// right generalized eigenvectors for a pair of n×n real nonsymmetric matrices
+// (A,B).
+//
+// The right generalized eigenvector v_j corresponding to the generalized
+// eigenvalue λ_j of (A,B) satisfies
+//
+//	A * v_j = λ_j * B * v_j,
+//
+// and the left generalized eigenvector u_j corresponding to λ_j satisfies
+//
+//	u_jᴴ * A = λ_j * u_jᴴ * B,
+//
+// where u_jᴴ is the conjugate transpose of u_j.
+//
+// On return, (alphar[j] + alphai[j]*i)/beta[j], j = 0, ..., n-1, will be the
+// generalized eigenvalues. If alphai[j] is zero, the j-th eigenvalue is real.
+// If alphai[j] is positive, the j-th and (j+1)-th eigenvalues are a complex
+// conjugate pair. beta is non-negative and may be zero for infinite
+// eigenvalues. alphar, alphai and beta must have length n, and Ggev will panic
+// otherwise.
+//
+// On return, A and B will be overwritten and the left and right eigenvectors
+// will be stored in the columns of VL and VR in the same order as their
+// eigenvalues, using the same convention for complex eigenvectors as Geev.
+// Each eigenvector is normalized so that the largest component has
+// |real part| + |imaginary part| = 1.
+//
+// Left eigenvectors will be computed only if jobvl == lapack.LeftEVCompute,
+// otherwise jobvl must be lapack.LeftEVNone.
+// Right eigenvectors will be computed only if jobvr == lapack.RightEVCompute,
+// otherwise jobvr must be lapack.RightEVNone.
+// For other values of jobvl and jobvr Ggev will panic.
+//
+// work must have length at least lwork and lwork must be at least max(1,8*n).
+// For good performance, lwork must generally be larger. On return, optimal
+// value of lwork will be stored in work[0].
+//
+// If lwork == -1, instead of performing Ggev, the function only calculates the
+// optimal value of lwork and stores it into work[0].
+//
+// Ggev returns whether the QZ iteration converged.
+//
+// Dggev is not part of the lapack.Float64 interface and so calls to Ggev are
+// always executed by the Gonum implementation.
+func Ggev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, a, b blas64.General, alphar, alphai, beta []float64, vl, vr blas64.General, work []float64, lwork int) (ok bool) {
+	n := a.Rows
+	if a.Cols != n {
+		panic("lapack64: matrix not square")
+	}
+	if b.Rows != n || b.Cols != n {
+		panic("lapack64: bad size of B")
+	}
+	if jobvl == lapack.LeftEVCompute && (vl.Rows != n || vl.Cols != n) {
+		panic("lapack64: bad size of VL")
+	}
+	if jobvr == lapack.RightEVCompute && (vr.Rows != n || vr.Cols != n) {
+		panic("lapack64: bad size of VR")
+	}
+	return gonum.Implementation{}.Dggev(jobvl, jobvr, n, a.Data, max(1, a.Stride), b.Data, max(1, b.Stride), alphar, alphai, beta, vl.Data, max(1, vl.Stride), vr.Data, max(1, vr.Stride), work, lwork)
+}
+
 // Ggsvd3 computes the generalized singular value decomposition (GSVD)
 // of an m×n matrix A and p×n matrix B:
 //
@@ -663,6 +765,86 @@ func Lapmt(forward bool, x blas64.General, k []int) {
 	lapack64.Dlapmt(forward, x.Rows, x.Cols, x.Data, max(1, x.Stride), k)
 }
 
+// Larft forms the k×k triangular factor T of the compact WY representation of
+// the block reflector H defined by k elementary reflectors, so that
+//
+//	H = I - V * T * Vᵀ  if store == lapack.ColumnWise
+//	H = I - Vᵀ * T * V  if store == lapack.RowWise
+//
+// where
+//
+//	H = H_0 * H_1 * ... * H_{k-1}  if direct == lapack.Forward
+//	H = H_{k-1} * ... * H_1 * H_0  if direct == lapack.Backward
+//
+// k is determined by the length of tau, which contains the scalar factors of
+// the elementary reflectors, and must be at least one. If store ==
+// lapack.ColumnWise the reflectors are stored in the columns of the n×k matrix
+// v, otherwise they are stored in the rows of the k×n matrix v. The layout of v
+// is described in the documentation of gonum.Implementation.Dlarfb; in
+// particular the elements of v that would be on the unit diagonal of the
+// reflectors are not referenced. Geqrf and Gelqf return v in the required form
+// with direct == lapack.Forward.
+//
+// T is stored in t, which must be k×k and have t.Uplo == blas.Upper if direct
+// == lapack.Forward and t.Uplo == blas.Lower otherwise. Together v and t can be
+// passed to Larfb to apply H to blocks of a matrix without forming H.
+//
+// Dlarft is not part of the lapack.Float64 interface and so calls to Larft are
+// always executed by the Gonum implementation.
+func Larft(direct lapack.Direct, store lapack.StoreV, v blas64.General, tau []float64, t blas64.Triangular) {
+	k := len(tau)
+	n := v.Rows
+	if store == lapack.RowWise {
+		n = v.Cols
+	}
+	switch {
+	case t.N != k:
+		panic("lapack64: bad triangle size")
+	case direct == lapack.Forward && t.Uplo != blas.Upper:
+		panic("lapack64: bad triangle orientation")
+	case direct == lapack.Backward && t.Uplo != blas.Lower:
+		panic("lapack64: bad triangle orientation")
+	}
+	gonum.Implementation{}.Dlarft(direct, store, n, k, v.Data, max(1, v.Stride), tau, t.Data, max(1, t.Stride))
+}
+
+// Larfb applies the block reflector H, given in the compact WY representation
+// computed by Larft, to the m×n matrix C as
+//
+//	C = H * C   if side == blas.Left  and trans == blas.NoTrans,
+//	C = Hᵀ * C  if side == blas.Left  and trans == blas.Trans,
+//	C = C * H   if side == blas.Right and trans == blas.NoTrans,
+//	C = C * Hᵀ  if side == blas.Right and trans == blas.Trans.
+//
+// direct, store and v must be as passed to Larft and t must be the triangular
+// factor it returned. The order of the reflectors, that is the number of rows of
+// v if store == lapack.ColumnWise and the number of columns otherwise, must be
+// m if side == blas.Left and n if side == blas.Right.
+//
+// work is temporary storage and must be n×k if side == blas.Left and m×k if
+// side == blas.Right, where k is t.N, otherwise Larfb will panic.
+//
+// Dlarfb is not part of the lapack.Float64 interface and so calls to Larfb are
+// always executed by the Gonum implementation.
+func Larfb(side blas.Side, trans blas.Transpose, direct lapack.Direct, store lapack.StoreV, v blas64.General, t blas64.Triangular, c, work blas64.General) {
+	k := t.N
+	nv := v.Rows
+	if store == lapack.RowWise {
+		nv = v.Cols
+	}
+	nw := c.Cols
+	if side == blas.Right {
+		nw = c.Rows
+	}
+	switch {
+	case side == blas.Left && nv != c.Rows, side == blas.Right && nv != c.Cols:
+		panic("lapack64: bad reflector order")
+	case work.Rows < nw || work.Cols < k:
+		panic("lapack64: insufficient work")
+	}
+	gonum.Implementation{}.Dlarfb(side, trans, direct, store, c.Rows, c.Cols, k, v.Data, max(1, v.Stride), t.Data, max(1, t.Stride), c.Data, max(1, c.Stride), work.Data, max(1, work.Stride))
+}
+
 // Orglq generates an m×n matrix Q with orthonormal rows defined as the first m
 // rows of a product of k elementary reflectors of order n
 //
@@ -780,6 +962,108 @@ func Pocon(a blas64.Symmetric, anorm float64, work []float64, iwork []int) float
 	return lapack64.Dpocon(a.Uplo, a.N, a.Data, max(1, a.Stride), anorm, work, iwork)
 }
 
+// Sbtrd reduces the n×n symmetric band matrix A to symmetric tridiagonal form
+// T by an orthogonal similarity transformation
+//
+//	Qᵀ * A * Q = T.
+//
+// On return, d contains the diagonal and e the off-diagonal elements of T, and
+// the band of a is overwritten. d must have length n and e must have length
+// n-1. If vect == lapack.OrthoExplicit, q will contain Q on return, and if
+// vect == lapack.OrthoPostmul, q must contain an n×n matrix X on entry and will
+// contain X * Q on return. q is not used if vect == lapack.OrthoNone. work
+// must have length at least n.
+//
+// Dsbtrd is not part of the lapack.Float64 interface and so calls to Sbtrd are
+// always executed by the Gonum implementation.
+func Sbtrd(vect lapack.OrthoComp, a blas64.SymmetricBand, d, e []float64, q blas64.General, work []float64) {
+	gonum.Implementation{}.Dsbtrd(vect, a.Uplo, a.N, a.K, a.Data, max(1, a.Stride), d, e, q.Data, max(1, q.Stride), work)
+}
+
+// Stebz computes selected eigenvalues of the symmetric tridiagonal matrix with
+// diagonal d and off-diagonal e by bisection. rng selects all eigenvalues, the
+// eigenvalues in the half-open interval (vl,vu], or the eigenvalues with
+// zero-based indices il through iu inclusive. The m computed eigenvalues are
+// stored in ascending order in w[:m], and w must have length at least len(d).
+// abstol is the absolute tolerance for the eigenvalues; if abstol <= 0, a
+// default based on the norm of the matrix is used.
+//
+// Dstebz is not part of the lapack.Float64 interface and so calls to Stebz are
+// always executed by the Gonum implementation.
+func Stebz(rng lapack.EVRange, vl, vu float64, il, iu int, abstol float64, d, e, w []float64) (m int) {
+	return gonum.Implementation{}.Dstebz(rng, len(d), vl, vu, il, iu, abstol, d, e, w)
+}
+
+// Stein computes the eigenvectors of the symmetric tridiagonal matrix with
+// diagonal d and off-diagonal e corresponding to the eigenvalues in w, which
+// must be in ascending order as returned by Stebz. The eigenvectors are stored
+// in the columns of z, which must be len(d)×len(w).
+//
+// Stein returns whether all eigenvectors converged.
+//
+// Dstein is not part of the lapack.Float64 interface and so calls to Stein are
+// always executed by the Gonum implementation.
+func Stein(d, e, w []float64, z blas64.General) (ok bool) {
+	return gonum.Implementation{}.Dstein(len(d), d, e, len(w), w, z.Data, max(1, z.Stride))
+}
+
+// Bdsvdx computes selected singular values and optionally the singular
+// vectors of the bidiagonal matrix with diagonal d and off-diagonal e, which
+// is upper bidiagonal if uplo == blas.Upper and lower bidiagonal otherwise.
+// rng selects all singular values, the singular values in the half-open
+// interval (vl,vu] with 0 <= vl, or the singular values with zero-based
+// indices il through iu inclusive counted in descending order. The ns
+// computed singular values are stored in descending order in s[:ns], and s
+// must have length at least len(d).
+//
+// If jobz == lapack.EVCompute, the first ns columns of z contain the singular
+// vectors on return, with the left singular vectors in the first len(d) rows
+// and the right singular vectors in the last len(d) rows. z must be
+// 2*len(d)×(iu-il+1) if rng == lapack.EVRangeIndex and 2*len(d)×len(d)
+// otherwise.
+//
+// Bdsvdx returns whether all singular vectors converged.
+//
+// Dbdsvdx is not part of the lapack.Float64 interface and so calls to Bdsvdx
+// are always executed by the Gonum implementation.
+func Bdsvdx(uplo blas.Uplo, jobz lapack.EVJob, rng lapack.EVRange, d, e []float64, vl, vu float64, il, iu int, s []float64, z blas64.General) (ns int, ok bool) {
+	return gonum.Implementation{}.Dbdsvdx(uplo, jobz, rng, len(d), d, e, vl, vu, il, iu, s, z.Data, max(1, z.Stride))
+}
+
+// Steqr computes the eigenvalues and optionally the eigenvectors of the
+// symmetric tridiagonal matrix with diagonal d and off-diagonal e. On return,
+// d contains the eigenvalues in ascending order and e is overwritten.
+//
+// If compz == lapack.EVOrig, z must contain the orthogonal matrix used to
+// reduce a matrix to tridiagonal form on entry and will contain the
+// eigenvectors of the original matrix on return. If compz ==
+// lapack.EVTridiag, z will contain the eigenvectors of the tridiagonal
+// matrix. z is not used if compz == lapack.EVCompNone. work must have length
+// at least max(1, 2*n-2) if the eigenvectors are computed.
+//
+// Steqr returns whether all eigenvalues were found.
+//
+// Dsteqr is not part of the lapack.Float64 interface and so calls to Steqr are
+// always executed by the Gonum implementation.
+func Steqr(compz lapack.EVComp, d, e []float64, z blas64.General, work []float64) (ok bool) {
+	return gonum.Implementation{}.Dsteqr(compz, len(d), d, e, z.Data, max(1, z.Stride), work)
+}
+
+// Sycon estimates the reciprocal of the condition number of a real symmetric
+// matrix A in the 1-norm using the Bunch-Kaufman factorization computed by
+// Sytrf. a and ipiv contain the factorization as returned by Sytrf, and anorm
+// is the 1-norm of the original matrix A.
+//
+// work is a temporary data slice of length at least 2*n and Sycon will panic otherwise.
+//
+// iwork is a temporary data slice of length at least n and Sycon will panic otherwise.
+//
+// Dsycon is not part of the lapack.Float64 interface and so calls to Sycon are
+// always executed by the Gonum implementation.
+func Sycon(a blas64.Symmetric, ipiv []int, anorm float64, work []float64, iwork []int) float64 {
+	return gonum.Implementation{}.Dsycon(a.Uplo, a.N, a.Data, max(1, a.Stride), ipiv, anorm, work, iwork)
+}
+
 // Syev computes all eigenvalues and, optionally, the eigenvectors of a real
 // symmetric matrix A.
 //
@@ -799,6 +1083,67 @@ func Syev(jobz lapack.EVJob, a blas64.Symmetric, w, work []float64, lwork int) (
 	return lapack64.Dsyev(jobz, a.Uplo, a.N, a.Data, max(1, a.Stride), w, work, lwork)
 }
 
+// Syevr computes selected eigenvalues and, optionally, the eigenvectors of a
+// real symmetric matrix A. rng selects all eigenvalues, the eigenvalues in the
+// half-open interval (vl,vu], or the eigenvalues with zero-based indices il
+// through iu inclusive. The m computed eigenvalues are stored in ascending
+// order in w[:m], and w must have length at least n. abstol is the absolute
+// tolerance for the eigenvalues; if abstol <= 0, a default based on the norm
+// of the matrix is used.
+//
+// If jobz == lapack.EVCompute, the first m columns of z contain the
+// orthonormal eigenvectors on return. z must be n×(iu-il+1) if rng ==
+// lapack.EVRangeIndex and n×n otherwise. On return, the contents of a are
+// destroyed.
+//
+// Work is temporary storage, and lwork specifies the usable memory length. At
+// minimum, lwork >= 5*n, and Syevr will panic otherwise. If lwork == -1,
+// instead of computing Syevr the optimal work length is stored into work[0].
+//
+// Syevr returns whether the eigenvalues and eigenvectors converged.
+//
+// Dsyevr is not part of the lapack.Float64 interface and so calls to Syevr are
+// always executed by the Gonum implementation.
+func Syevr(jobz lapack.EVJob, rng lapack.EVRange, a blas64.Symmetric, vl, vu float64, il, iu int, abstol float64, w []float64, z blas64.General, work []float64, lwork int) (m int, ok bool) {
+	return gonum.Implementation{}.Dsyevr(jobz, rng, a.Uplo, a.N, a.Data, max(1, a.Stride), vl, vu, il, iu, abstol, w, z.Data, max(1, z.Stride), work, lwork)
+}
+
+// Sytrf computes the Bunch-Kaufman factorization of a real symmetric matrix A
+//
+//	A = U * D * Uᵀ  if a.Uplo == blas.Upper, or
+//	A = L * D * Lᵀ  if a.Uplo == blas.Lower,
+//
+// where U (or L) is a product of permutation and unit upper (lower) triangular
+// matrices, and D is symmetric and block diagonal with 1×1 and 2×2 diagonal
+// blocks. The factors are stored in place into a, and the interchanges and
+// block structure of D are stored in ipiv, which must have length n.
+// If ipiv[k] >= 0, D[k,k] is a 1×1 block and rows and columns k and ipiv[k]
+// were interchanged. Negative entries of ipiv mark 2×2 blocks, with the
+// interchanged row given by ^ipiv[k]. ipiv is zero-indexed.
+//
+// Work is temporary storage, and lwork specifies the usable memory length.
+// At minimum, lwork >= 1, and Sytrf will panic otherwise. If lwork == -1,
+// instead of computing Sytrf the optimal work length is stored into work[0].
+//
+// Sytrf returns whether D is nonsingular.
+//
+// Dsytrf is not part of the lapack.Float64 interface and so calls to Sytrf are
+// always executed by the Gonum implementation.
+func Sytrf(a blas64.Symmetric, ipiv []int, work []float64, lwork int) (ok bool) {
+	return gonum.Implementation{}.Dsytrf(a.Uplo, a.N, a.Data, max(1, a.Stride), ipiv, work, lwork)
+}
+
+// Sytrs solves a system of linear equations A*X = B with a real symmetric
+// matrix A using the Bunch-Kaufman factorization computed by Sytrf. a and ipiv
+// contain the factorization as returned by Sytrf. On entry, b contains the
+// right-hand side matrix B, on return it contains the solution matrix X.
+//
+// Dsytrs is not part of the lapack.Float64 interface and so calls to Sytrs are
+// always executed by the Gonum implementation.
+func Sytrs(a blas64.Symmetric, ipiv []int, b blas64.General) {
+	gonum.Implementation{}.Dsytrs(a.Uplo, a.N, b.Cols, a.Data, max(1, a.Stride), ipiv, b.Data, max(1, b.Stride))
+}
+
 // Tbtrs solves a triangular system of the form
 //
 //	A * X = B   if trans == blas.NoTrans
@@ -0,0 +1,242 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dbdsvdxer interface {
+	Dbdsvdx(uplo blas.Uplo, jobz lapack.EVJob, rng lapack.EVRange, n int, d, e []float64, vl, vu float64, il, iu int, s, z []float64, ldz int) (ns int, ok bool)
+	Dbdsqrer
+}
+
+// DbdsvdxTest tests Dbdsvdx by comparing the selected singular values with
+// all singular values computed by Dbdsqr and by checking the singular
+// vectors.
+func DbdsvdxTest(t *testing.T, impl Dbdsvdxer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 40} {
+			for _, typ := range []string{"random", "graded", "singular"} {
+				d, e := bidiagTestMatrix(typ, n, rnd)
+				dbdsvdxTest(t, impl, rnd, uplo, typ, d, e)
+			}
+		}
+	}
+}
+
+// bidiagTestMatrix returns the diagonal and off-diagonal of an n×n
+// bidiagonal test matrix of the given type.
+func bidiagTestMatrix(typ string, n int, rnd *rand.Rand) (d, e []float64) {
+	d = make([]float64, n)
+	e = make([]float64, max(0, n-1))
+	for i := range d {
+		d[i] = rnd.NormFloat64()
+	}
+	for i := range e {
+		e[i] = rnd.NormFloat64()
+	}
+	switch typ {
+	case "random":
+	case "graded":
+		// Singular values spanning several orders of magnitude.
+		for i := range d {
+			d[i] = math.Ldexp(d[i], -i)
+		}
+		for i := range e {
+			e[i] = math.Ldexp(e[i], -i-1)
+		}
+	case "singular":
+		if n > 0 {
+			d[n/2] = 0
+		}
+	default:
+		panic("bad test matrix type")
+	}
+	return d, e
+}
+
+func dbdsvdxTest(t *testing.T, impl Dbdsvdxer, rnd *rand.Rand, uplo blas.Uplo, typ string, d, e []float64) {
+	const tol = 1e-13
+
+	n := len(d)
+	name := fmt.Sprintf("uplo=%v,n=%v,type=%v", string(uplo), n, typ)
+
+	// Compute all singular values with Dbdsqr.
+	want := make([]float64, n)
+	copy(want, d)
+	eCopy := make([]float64, len(e))
+	copy(eCopy, e)
+	ok := impl.Dbdsqr(uplo, n, 0, 0, 0, want, eCopy, nil, 1, nil, 1, nil, 1, make([]float64, 4*n))
+	if !ok {
+		t.Fatalf("%v: Dbdsqr failed", name)
+	}
+	var bnorm float64
+	if n > 0 {
+		bnorm = want[0]
+	}
+	// Singular vectors are computed to an absolute accuracy, so
+	// their orthogonality degrades with the relative gap between
+	// the singular values.
+	orthTol := tol
+	for i := 0; i < n-1; i++ {
+		if gap := want[i] - want[i+1]; gap > 0 {
+			orthTol = math.Max(orthTol, tol*bnorm/gap)
+		}
+	}
+
+	dCopy := make([]float64, n)
+	copy(dCopy, d)
+	copy(eCopy, e)
+
+	type rangeTest struct {
+		rng    lapack.EVRange
+		vl, vu float64
+		il, iu int
+		want   []float64
+	}
+	tests := []rangeTest{{rng: lapack.EVRangeAll, want: want}}
+	if n > 0 {
+		il := rnd.Intn(n)
+		iu := il + rnd.Intn(n-il)
+		tests = append(tests,
+			rangeTest{rng: lapack.EVRangeIndex, il: 0, iu: 0, want: want[:1]},
+			rangeTest{rng: lapack.EVRangeIndex, il: n - 1, iu: n - 1, want: want[n-1:]},
+			rangeTest{rng: lapack.EVRangeIndex, il: il, iu: iu, want: want[il : iu+1]},
+		)
+
+		// Choose interval ends away from the singular values
+		// so the expected count is not affected by rounding.
+		var ends []float64
+		for i := 0; i < n-1; i++ {
+			if want[i]-want[i+1] > 1e-8*math.Max(1, bnorm) {
+				ends = append(ends, (want[i]+want[i+1])/2)
+			}
+		}
+		ends = append(ends, 2*bnorm+1)
+		vu := ends[rnd.Intn(len(ends))]
+		var vl float64
+		for _, v := range ends {
+			if v < vu && rnd.Float64() < 0.5 {
+				vl = v
+				break
+			}
+		}
+		var wantValue []float64
+		for _, v := range want {
+			if vl < v && v <= vu {
+				wantValue = append(wantValue, v)
+			}
+		}
+		tests = append(tests, rangeTest{rng: lapack.EVRangeValue, vl: vl, vu: vu, want: wantValue})
+	}
+
+	for _, test := range tests {
+		for _, jobz := range []lapack.EVJob{lapack.EVNone, lapack.EVCompute} {
+			ncols := n
+			if test.rng == lapack.EVRangeIndex {
+				ncols = test.iu - test.il + 1
+			}
+			ldz := max(1, ncols) + rnd.Intn(3)
+			rname := fmt.Sprintf("%v,rng=%v,vl=%v,vu=%v,il=%v,iu=%v,jobz=%v,ldz=%v",
+				name, string(test.rng), test.vl, test.vu, test.il, test.iu, string(jobz), ldz)
+
+			s := make([]float64, n)
+			var z []float64
+			if jobz == lapack.EVCompute && n > 0 {
+				z = make([]float64, (2*n-1)*ldz+ncols)
+			}
+			ns, ok := impl.Dbdsvdx(uplo, jobz, test.rng, n, d, e, test.vl, test.vu, test.il, test.iu, s, z, ldz)
+			if !ok {
+				t.Errorf("%v: Dbdsvdx failed to converge", rname)
+			}
+			if !equalFloat64s(d, dCopy) || !equalFloat64s(e, eCopy) {
+				t.Errorf("%v: unexpected modification of d or e", rname)
+			}
+			if ns != len(test.want) {
+				t.Errorf("%v: unexpected number of singular values: got %v, want %v", rname, ns, len(test.want))
+				continue
+			}
+			for i := 0; i < ns; i++ {
+				if math.Abs(s[i]-test.want[i]) > tol*math.Max(1, bnorm) {
+					t.Errorf("%v: unexpected singular value %v: got %v, want %v", rname, i, s[i], test.want[i])
+				}
+				if i > 0 && s[i] > s[i-1] {
+					t.Errorf("%v: singular values not in descending order", rname)
+				}
+			}
+			if jobz == lapack.EVCompute && ns > 0 {
+				checkBidiagSingularVectors(t, rname, uplo, d, e, s[:ns], z, ldz, tol, orthTol)
+			}
+		}
+	}
+}
+
+// checkBidiagSingularVectors checks that the columns of Z hold left and right
+// singular vectors of the bidiagonal matrix B for the singular values in s
+// with residuals within tol and orthonormal to within orthTol.
+func checkBidiagSingularVectors(t *testing.T, name string, uplo blas.Uplo, d, e, s, z []float64, ldz int, tol, orthTol float64) {
+	t.Helper()
+
+	n := len(d)
+	ns := len(s)
+	u := blas64.General{Rows: n, Cols: ns, Stride: ldz, Data: z}
+	v := blas64.General{Rows: n, Cols: ns, Stride: ldz, Data: z[n*ldz:]}
+
+	var bnorm float64
+	for i := 0; i < n; i++ {
+		r := math.Abs(d[i])
+		if i < n-1 {
+			r += math.Abs(e[i])
+		}
+		bnorm = math.Max(bnorm, r)
+	}
+	bnorm = math.Max(1, bnorm)
+
+	// Check that B * v_j = s_j * u_j and Bᵀ * u_j = s_j * v_j.
+	var resid float64
+	for j := 0; j < ns; j++ {
+		for i := 0; i < n; i++ {
+			bv := d[i] * v.Data[i*ldz+j]
+			btu := d[i] * u.Data[i*ldz+j]
+			if uplo == blas.Upper {
+				if i < n-1 {
+					bv += e[i] * v.Data[(i+1)*ldz+j]
+				}
+				if i > 0 {
+					btu += e[i-1] * u.Data[(i-1)*ldz+j]
+				}
+			} else {
+				if i > 0 {
+					bv += e[i-1] * v.Data[(i-1)*ldz+j]
+				}
+				if i < n-1 {
+					btu += e[i] * u.Data[(i+1)*ldz+j]
+				}
+			}
+			resid = math.Max(resid, math.Abs(bv-s[j]*u.Data[i*ldz+j]))
+			resid = math.Max(resid, math.Abs(btu-s[j]*v.Data[i*ldz+j]))
+		}
+	}
+	if resid > tol*bnorm*float64(n) {
+		t.Errorf("%v: large singular vector residual %v", name, resid)
+	}
+
+	if resid := residualOrthogonal(u, false); resid > orthTol*float64(n) {
+		t.Errorf("%v: left singular vectors not orthonormal; |I - Uᵀ*U|=%v", name, resid)
+	}
+	if resid := residualOrthogonal(v, false); resid > orthTol*float64(n) {
+		t.Errorf("%v: right singular vectors not orthonormal; |I - Vᵀ*V|=%v", name, resid)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+type Dgbtrfer interface {
+	Dgbtrf(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) (ok bool)
+}
+
+// DgbtrfTest tests Dgbtrf by reconstructing a random band matrix from its
+// computed LU factorization.
+func DgbtrfTest(t *testing.T, impl Dgbtrfer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 2, 3, 4, 5, 10, 33} {
+		for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 33} {
+			for _, kl := range []int{0, 1, 2, (m + 1) / 4, (3*m - 1) / 4, m + 1} {
+				for _, ku := range []int{0, 1, 2, (n + 1) / 4, (3*n - 1) / 4, n + 1} {
+					for _, ldab := range []int{2*kl + ku + 1, 2*kl + ku + 4} {
+						dgbtrfTest(t, impl, rnd, m, n, kl, ku, ldab)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgbtrfTest(t *testing.T, impl Dgbtrfer, rnd *rand.Rand, m, n, kl, ku, ldab int) {
+	const tol = 1e-13
+
+	name := fmt.Sprintf("m=%v,n=%v,kl=%v,ku=%v,ldab=%v", m, n, kl, ku, ldab)
+
+	// Generate a random band matrix and its dense representation.
+	ab, a := randGeneralBand(m, n, kl, ku, ldab, rnd)
+
+	ipiv := make([]int, min(m, n))
+	ok := impl.Dgbtrf(m, n, kl, ku, ab, ldab, ipiv)
+	if !ok {
+		t.Fatalf("%v: unexpected singular factor", name)
+	}
+
+	// Extract U.
+	lu := make([]float64, m*n)
+	for i := 0; i < min(m, n); i++ {
+		for j := i; j <= min(n-1, i+kl+ku); j++ {
+			lu[i*n+j] = ab[i*ldab+kl+j-i]
+		}
+	}
+	// Apply the multipliers and interchanges in reverse
+	// order to reconstruct A.
+	for j := min(m, n) - 1; j >= 0; j-- {
+		if p := ipiv[j]; p < j || j+kl < p || m <= p {
+			t.Fatalf("%v: pivot index %d out of range: %d", name, j, p)
+		}
+		for i := j + 1; i <= min(j+kl, m-1); i++ {
+			l := ab[i*ldab+kl+j-i]
+			for k := 0; k < n; k++ {
+				lu[i*n+k] += l * lu[j*n+k]
+			}
+		}
+		if p := ipiv[j]; p != j {
+			for k := 0; k < n; k++ {
+				lu[p*n+k], lu[j*n+k] = lu[j*n+k], lu[p*n+k]
+			}
+		}
+	}
+
+	var diff float64
+	for i := range a {
+		diff = math.Max(diff, math.Abs(a[i]-lu[i]))
+	}
+	if diff > tol {
+		t.Errorf("%v: unexpected reconstruction of A, diff=%v", name, diff)
+	}
+}
+
+// randGeneralBand returns a random m×n band matrix with kl subdiagonals and
+// ku superdiagonals in the band storage used by Dgbtrf with leading dimension
+// ldab, and the same matrix in dense storage with leading dimension n. The
+// elements of ab that are outside A are set to NaN.
+func randGeneralBand(m, n, kl, ku, ldab int, rnd *rand.Rand) (ab, a []float64) {
+	ab = make([]float64, max(0, m*ldab))
+	for i := range ab {
+		ab[i] = math.NaN()
+	}
+	a = make([]float64, m*n)
+	for i := 0; i < m; i++ {
+		for j := max(0, i-kl); j <= min(n-1, i+ku); j++ {
+			v := rnd.NormFloat64()
+			ab[i*ldab+kl+j-i] = v
+			a[i*n+j] = v
+		}
+	}
+	return ab, a
+}
@@ -0,0 +1,99 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+type Dgbtrser interface {
+	Dgbtrs(trans blas.Transpose, n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int, b []float64, ldb int)
+
+	Dgbtrfer
+}
+
+// DgbtrsTest tests Dgbtrs by comparing the computed and known, generated
+// solutions of a linear system with a random band matrix.
+func DgbtrsTest(t *testing.T, impl Dgbtrser) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 65} {
+		for _, kl := range []int{0, 1, (n + 1) / 4, n + 1} {
+			for _, ku := range []int{0, 1, (3*n - 1) / 4, n + 1} {
+				for _, nrhs := range []int{0, 1, 2, 5} {
+					for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+						for _, ldab := range []int{2*kl + ku + 1, 2*kl + ku + 4} {
+							for _, ldb := range []int{max(1, nrhs), nrhs + 3} {
+								dgbtrsTest(t, impl, rnd, trans, n, kl, ku, nrhs, ldab, ldb)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func dgbtrsTest(t *testing.T, impl Dgbtrser, rnd *rand.Rand, trans blas.Transpose, n, kl, ku, nrhs, ldab, ldb int) {
+	const tol = 1e-10
+
+	name := fmt.Sprintf("trans=%v,n=%v,kl=%v,ku=%v,nrhs=%v,ldab=%v,ldb=%v", string(trans), n, kl, ku, nrhs, ldab, ldb)
+
+	// Generate a random band matrix with a dominant
+	// diagonal so that it is well conditioned.
+	ab, a := randGeneralBand(n, n, kl, ku, ldab, rnd)
+	for i := 0; i < n; i++ {
+		ab[i*ldab+kl] += float64(kl+ku) + 1
+		a[i*n+i] = ab[i*ldab+kl]
+	}
+
+	// Compute the LU factorization of A.
+	ipiv := make([]int, n)
+	ok := impl.Dgbtrf(n, n, kl, ku, ab, ldab, ipiv)
+	if !ok {
+		t.Fatalf("%v: bad test matrix, Dgbtrf failed", name)
+	}
+	abCopy := make([]float64, len(ab))
+	copy(abCopy, ab)
+
+	// Generate a random solution.
+	xWant := make([]float64, n*ldb)
+	for i := range xWant {
+		xWant[i] = rnd.NormFloat64()
+	}
+
+	// Compute the corresponding right-hand side.
+	b := make([]float64, len(xWant))
+	if n > 0 && nrhs > 0 {
+		blas64.Gemm(trans, blas.NoTrans,
+			1, blas64.General{Rows: n, Cols: n, Stride: max(1, n), Data: a},
+			blas64.General{Rows: n, Cols: nrhs, Stride: ldb, Data: xWant},
+			0, blas64.General{Rows: n, Cols: nrhs, Stride: ldb, Data: b})
+	}
+
+	impl.Dgbtrs(trans, n, kl, ku, nrhs, ab, ldab, ipiv, b, ldb)
+	xGot := b
+
+	if !floats.Same(ab, abCopy) {
+		t.Errorf("%v: unexpected modification of ab", name)
+	}
+
+	var diff float64
+	for i := 0; i < n; i++ {
+		for j := 0; j < nrhs; j++ {
+			diff = math.Max(diff, math.Abs(xWant[i*ldb+j]-xGot[i*ldb+j]))
+		}
+	}
+	if diff > tol {
+		t.Errorf("%v: unexpected result, diff=%v", name, diff)
+	}
+}
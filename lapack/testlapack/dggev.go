@@ -0,0 +1,130 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dggever interface {
+	Dggev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vl []float64, ldvl int, vr []float64, ldvr int, work []float64, lwork int) bool
+}
+
+func DggevTest(t *testing.T, impl Dggever) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 21} {
+		for _, extra := range []int{0, 4} {
+			for kind := 0; kind < 3; kind++ {
+				for _, wl := range []worklen{minimumWork, mediumWork, optimumWork} {
+					dggevTest(t, impl, rnd, n, extra, kind, wl)
+				}
+			}
+		}
+	}
+}
+
+func dggevTest(t *testing.T, impl Dggever, rnd *rand.Rand, n, extra, kind int, wl worklen) {
+	const tol = 1e-12
+
+	ld := max(1, n+extra)
+	a := randomGeneral(n, n, ld, rnd)
+	b := randomGeneral(n, n, ld, rnd)
+	switch kind {
+	case 0:
+		// Random pair.
+	case 1:
+		// Singular B giving infinite eigenvalues.
+		if n > 0 {
+			for j := 0; j < n; j++ {
+				b.Data[j] = 0
+			}
+		}
+	case 2:
+		// Scaled pair.
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				a.Data[i*a.Stride+j] *= 1e-160
+				b.Data[i*b.Stride+j] *= 1e160
+			}
+		}
+	}
+
+	name := fmt.Sprintf("n=%d,extra=%d,kind=%d,work=%v", n, extra, kind, wl)
+
+	work := make([]float64, 1)
+	impl.Dggev(lapack.LeftEVCompute, lapack.RightEVCompute, n, nil, ld, nil, ld, nil, nil, nil, nil, ld, nil, ld, work, -1)
+	var lwork int
+	switch wl {
+	case minimumWork:
+		lwork = max(1, 8*n)
+	case mediumWork:
+		lwork = (max(1, 8*n) + int(work[0])) / 2
+	case optimumWork:
+		lwork = int(work[0])
+	}
+	work = make([]float64, lwork)
+
+	aCopy := cloneGeneral(a)
+	bCopy := cloneGeneral(b)
+	alphar := make([]float64, n)
+	alphai := make([]float64, n)
+	beta := make([]float64, n)
+	vl := nanGeneral(n, n, ld)
+	vr := nanGeneral(n, n, ld)
+	ok := impl.Dggev(lapack.LeftEVCompute, lapack.RightEVCompute, n, a.Data, a.Stride, b.Data, b.Stride,
+		alphar, alphai, beta, vl.Data, vl.Stride, vr.Data, vr.Stride, work, lwork)
+	if !ok {
+		t.Errorf("%s: unexpected convergence failure", name)
+		return
+	}
+	for j := 0; j < n; j++ {
+		if beta[j] < 0 {
+			t.Errorf("%s: unexpected negative beta[%d]=%v", name, j, beta[j])
+		}
+		if alphai[j] > 0 && (j == n-1 || alphai[j+1] >= 0) {
+			t.Errorf("%s: complex eigenvalue %d not followed by its conjugate", name, j)
+		}
+	}
+	if kind == 1 && n > 0 {
+		// Rounding errors in the reduction of B mean that the
+		// infinite eigenvalue may have a tiny non-zero beta.
+		bnorm := dlange(lapack.MaxAbs, n, n, bCopy.Data, bCopy.Stride)
+		var ninf int
+		for _, v := range beta {
+			if v <= tol*bnorm {
+				ninf++
+			}
+		}
+		if ninf == 0 {
+			t.Errorf("%s: no infinite eigenvalue for singular B", name)
+		}
+	}
+	checkGeneralizedEigenvectors(t, name, aCopy, bCopy, alphar, alphai, beta, vl, vr, tol)
+
+	// The eigenvalues must not depend on whether eigenvectors are computed.
+	a = cloneGeneral(aCopy)
+	b = cloneGeneral(bCopy)
+	alpharN := make([]float64, n)
+	alphaiN := make([]float64, n)
+	betaN := make([]float64, n)
+	ok = impl.Dggev(lapack.LeftEVNone, lapack.RightEVNone, n, a.Data, a.Stride, b.Data, b.Stride,
+		alpharN, alphaiN, betaN, nil, 1, nil, 1, work, lwork)
+	if !ok {
+		t.Errorf("%s: unexpected convergence failure without eigenvectors", name)
+		return
+	}
+	want := generalizedEigenvalues(alphar, alphai, beta)
+	got := generalizedEigenvalues(alpharN, alphaiN, betaN)
+	for i := range want {
+		if !sameGeneralizedEigenvalue(got[i], want[i], 1e-8) {
+			t.Errorf("%s: eigenvalue %d mismatch: got:%v want:%v", name, i, got[i], want[i])
+		}
+	}
+}
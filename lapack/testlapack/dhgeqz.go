@@ -0,0 +1,241 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dhgeqzer interface {
+	Dhgeqz(job lapack.SchurJob, compq, compz lapack.OrthoComp, n, ilo, ihi int, h []float64, ldh int, t []float64, ldt int, alphar, alphai, beta, q []float64, ldq int, z []float64, ldz int) bool
+}
+
+func DhgeqzTest(t *testing.T, impl Dhgeqzer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 6, 10, 18, 31} {
+		for _, extra := range []int{0, 3} {
+			for kind := 0; kind < 4; kind++ {
+				for cas := 0; cas < 5; cas++ {
+					ilo, ihi := 0, n-1
+					if cas%2 == 1 && n > 2 {
+						ilo = rnd.Intn(n / 2)
+						ihi = n - 1 - rnd.Intn(n/2)
+					}
+					dhgeqzTest(t, impl, rnd, n, ilo, ihi, extra, kind)
+				}
+			}
+		}
+	}
+}
+
+func dhgeqzTest(t *testing.T, impl Dhgeqzer, rnd *rand.Rand, n, ilo, ihi, extra, kind int) {
+	const tol = 1e-13
+
+	ld := n + extra
+	h := randomHessenberg(n, max(1, ld), rnd)
+	// Make H upper triangular outside of the [ilo:ihi+1] block.
+	for i := 0; i < n-1; i++ {
+		if i < ilo || ihi <= i {
+			h.Data[(i+1)*h.Stride+i] = 0
+		}
+	}
+	tt := randomGeneral(n, n, max(1, ld), rnd)
+	for i := 1; i < n; i++ {
+		for j := 0; j < i; j++ {
+			tt.Data[i*tt.Stride+j] = 0
+		}
+	}
+	switch kind {
+	case 0:
+		// Random upper triangular T.
+	case 1:
+		// T with zero diagonal elements giving infinite eigenvalues.
+		for i := 0; i < n; i += 3 {
+			tt.Data[i*tt.Stride+i] = 0
+		}
+	case 2:
+		// T equal to the identity reduces the problem to the
+		// standard eigenvalue problem.
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				tt.Data[i*tt.Stride+j] = 0
+			}
+			tt.Data[i*tt.Stride+i] = 1
+		}
+	case 3:
+		// T with the last diagonal element zero.
+		if n > 0 {
+			tt.Data[(n-1)*tt.Stride+n-1] = 0
+		}
+	}
+
+	name := fmt.Sprintf("n=%d,ilo=%d,ihi=%d,extra=%d,kind=%d", n, ilo, ihi, extra, kind)
+
+	// Compute the eigenvalues only.
+	hEig := cloneGeneral(h)
+	tEig := cloneGeneral(tt)
+	alpharE := make([]float64, n)
+	alphaiE := make([]float64, n)
+	betaE := make([]float64, n)
+	ok := impl.Dhgeqz(lapack.EigenvaluesOnly, lapack.OrthoNone, lapack.OrthoNone, n, ilo, ihi,
+		hEig.Data, hEig.Stride, tEig.Data, tEig.Stride, alpharE, alphaiE, betaE, nil, 1, nil, 1)
+	if !ok {
+		t.Errorf("%s: unexpected convergence failure computing eigenvalues only", name)
+		return
+	}
+
+	// Compute the generalized Schur form.
+	s := cloneGeneral(h)
+	p := cloneGeneral(tt)
+	q := nanGeneral(n, n, max(1, ld))
+	z := nanGeneral(n, n, max(1, ld))
+	alphar := make([]float64, n)
+	alphai := make([]float64, n)
+	beta := make([]float64, n)
+	ok = impl.Dhgeqz(lapack.EigenvaluesAndSchur, lapack.OrthoExplicit, lapack.OrthoExplicit, n, ilo, ihi,
+		s.Data, s.Stride, p.Data, p.Stride, alphar, alphai, beta, q.Data, q.Stride, z.Data, z.Stride)
+	if !ok {
+		t.Errorf("%s: unexpected convergence failure", name)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	if resid := residualOrthogonal(q, false); resid > tol*float64(n) {
+		t.Errorf("%s: Q not orthogonal; resid=%v", name, resid)
+	}
+	if resid := residualOrthogonal(z, false); resid > tol*float64(n) {
+		t.Errorf("%s: Z not orthogonal; resid=%v", name, resid)
+	}
+
+	// Check H = Q*S*Zᵀ and T = Q*P*Zᵀ.
+	for _, m := range []struct {
+		name string
+		orig blas64.General
+		got  blas64.General
+	}{
+		{name: "H", orig: h, got: s},
+		{name: "T", orig: tt, got: p},
+	} {
+		aux := zeros(n, n, n)
+		rec := zeros(n, n, n)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, q, m.got, 0, aux)
+		blas64.Gemm(blas.NoTrans, blas.Trans, 1, aux, z, 0, rec)
+		var diff, norm float64
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				diff = math.Max(diff, math.Abs(rec.Data[i*n+j]-m.orig.Data[i*m.orig.Stride+j]))
+				norm = math.Max(norm, math.Abs(m.orig.Data[i*m.orig.Stride+j]))
+			}
+		}
+		if resid := diff / math.Max(1, norm) / float64(n); resid > tol {
+			t.Errorf("%s: %s != Q*%s*Zᵀ; resid=%v", name, m.name, m.name, resid)
+		}
+	}
+
+	// Check the structure of S and P and the eigenvalues.
+	if !isUpperTriangular(p) {
+		t.Errorf("%s: P not upper triangular", name)
+	}
+	for j := 0; j < n; {
+		if beta[j] < 0 {
+			t.Errorf("%s: unexpected negative beta[%d]=%v", name, j, beta[j])
+		}
+		for i := j + 2; i < n; i++ {
+			if s.Data[i*s.Stride+j] != 0 {
+				t.Errorf("%s: S not quasi-triangular at [%d,%d]", name, i, j)
+			}
+		}
+		if j == n-1 || s.Data[(j+1)*s.Stride+j] == 0 {
+			// 1×1 block.
+			if alphai[j] != 0 {
+				t.Errorf("%s: unexpected non-zero alphai[%d]=%v for 1×1 block", name, j, alphai[j])
+			}
+			if alphar[j] != s.Data[j*s.Stride+j] || beta[j] != p.Data[j*p.Stride+j] {
+				t.Errorf("%s: eigenvalue %d does not match 1×1 block", name, j)
+			}
+			j++
+			continue
+		}
+		// 2×2 block.
+		if j+2 < n && s.Data[(j+2)*s.Stride+j+1] != 0 {
+			t.Errorf("%s: overlapping 2×2 blocks at %d", name, j)
+		}
+		if p.Data[j*p.Stride+j+1] != 0 {
+			t.Errorf("%s: P not diagonal in 2×2 block at %d", name, j)
+		}
+		if p.Data[j*p.Stride+j] <= 0 || p.Data[(j+1)*p.Stride+j+1] <= 0 {
+			t.Errorf("%s: P diagonal not positive in 2×2 block at %d", name, j)
+		}
+		if alphai[j] <= 0 || alphai[j+1] >= 0 {
+			t.Errorf("%s: unexpected alphai for complex pair at %d: %v, %v", name, j, alphai[j], alphai[j+1])
+		}
+		for k := j; k < j+2; k++ {
+			alpha := complex(alphar[k], alphai[k])
+			b := complex(beta[k], 0)
+			c := func(r, c int) complex128 {
+				return b*complex(s.Data[r*s.Stride+c], 0) - alpha*complex(p.Data[r*p.Stride+c], 0)
+			}
+			det := c(j, j)*c(j+1, j+1) - c(j, j+1)*c(j+1, j)
+			scale := cmplx.Abs(b)*dlange(lapack.MaxAbs, 2, 2, s.Data[j*s.Stride+j:], s.Stride) +
+				cmplx.Abs(alpha)*dlange(lapack.MaxAbs, 2, 2, p.Data[j*p.Stride+j:], p.Stride)
+			if cmplx.Abs(det) > tol*scale*scale {
+				t.Errorf("%s: eigenvalue %d does not match 2×2 block; det=%v", name, k, det)
+			}
+		}
+		j += 2
+	}
+
+	// Check that the eigenvalues computed without the Schur form agree.
+	want := generalizedEigenvalues(alphar, alphai, beta)
+	got := generalizedEigenvalues(alpharE, alphaiE, betaE)
+	for i := range want {
+		if !sameGeneralizedEigenvalue(got[i], want[i], 1e-8) {
+			t.Errorf("%s: eigenvalue %d mismatch between job types: got:%v want:%v", name, i, got[i], want[i])
+		}
+	}
+}
+
+// generalizedEigenvalues returns the generalized eigenvalues (alphar+alphai*i)/beta
+// sorted by real and then imaginary part. Infinite eigenvalues are placed last.
+func generalizedEigenvalues(alphar, alphai, beta []float64) []complex128 {
+	ev := make([]complex128, len(alphar))
+	for i := range ev {
+		if beta[i] == 0 {
+			ev[i] = cmplx.Inf()
+			continue
+		}
+		ev[i] = complex(alphar[i]/beta[i], alphai[i]/beta[i])
+	}
+	sort.Slice(ev, func(i, j int) bool {
+		a, b := ev[i], ev[j]
+		if cmplx.IsInf(a) || cmplx.IsInf(b) {
+			return !cmplx.IsInf(a) && cmplx.IsInf(b)
+		}
+		if math.Abs(real(a)-real(b)) > 1e-8*math.Max(1, math.Abs(real(a))) {
+			return real(a) < real(b)
+		}
+		return imag(a) < imag(b)
+	})
+	return ev
+}
+
+func sameGeneralizedEigenvalue(a, b complex128, tol float64) bool {
+	if cmplx.IsInf(a) || cmplx.IsInf(b) {
+		return cmplx.IsInf(a) && cmplx.IsInf(b)
+	}
+	return cmplx.Abs(a-b) <= tol*math.Max(1, cmplx.Abs(b))
+}
@@ -0,0 +1,137 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+type Dlagv2er interface {
+	Dlagv2(a []float64, lda int, b []float64, ldb int, alphar, alphai, beta []float64) (csl, snl, csr, snr float64)
+}
+
+func Dlagv2Test(t *testing.T, impl Dlagv2er) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, lda := range []int{2, 5} {
+		for _, ldb := range []int{2, 5} {
+			for kind := 0; kind <= 4; kind++ {
+				for cas := 0; cas < 100; cas++ {
+					dlagv2Test(t, impl, rnd, lda, ldb, kind)
+				}
+			}
+		}
+	}
+}
+
+func dlagv2Test(t *testing.T, impl Dlagv2er, rnd *rand.Rand, lda, ldb, kind int) {
+	const tol = 1e-14
+
+	a := randomGeneral(2, 2, lda, rnd)
+	b := randomGeneral(2, 2, ldb, rnd)
+	b.Data[ldb] = 0
+	switch kind {
+	case 0:
+		// Random pencil.
+	case 1:
+		// A already upper triangular.
+		a.Data[lda] = 0
+	case 2:
+		// B singular with zero leading diagonal element.
+		b.Data[0] = 0
+	case 3:
+		// B singular with zero trailing diagonal element.
+		b.Data[ldb+1] = 0
+	case 4:
+		// B identity.
+		b.Data[0] = 1
+		b.Data[1] = 0
+		b.Data[ldb+1] = 1
+	}
+	aCopy := cloneGeneral(a)
+	bCopy := cloneGeneral(b)
+
+	alphar := make([]float64, 2)
+	alphai := make([]float64, 2)
+	beta := make([]float64, 2)
+	csl, snl, csr, snr := impl.Dlagv2(a.Data, a.Stride, b.Data, b.Stride, alphar, alphai, beta)
+
+	name := fmt.Sprintf("lda=%d,ldb=%d,kind=%d", lda, ldb, kind)
+
+	if math.Abs(csl*csl+snl*snl-1) > tol {
+		t.Errorf("%s: left rotation not orthogonal: csl=%v, snl=%v", name, csl, snl)
+	}
+	if math.Abs(csr*csr+snr*snr-1) > tol {
+		t.Errorf("%s: right rotation not orthogonal: csr=%v, snr=%v", name, csr, snr)
+	}
+	if b.Data[ldb] != 0 {
+		t.Errorf("%s: B not upper triangular", name)
+	}
+
+	complexEV := alphai[0] != 0
+	if complexEV {
+		if alphai[0] < 0 || alphai[1] != -alphai[0] || alphar[0] != alphar[1] {
+			t.Errorf("%s: unexpected complex eigenvalues: alphar=%v, alphai=%v", name, alphar, alphai)
+		}
+		if beta[0] != 1 || beta[1] != 1 {
+			t.Errorf("%s: unexpected beta for complex eigenvalues: got:%v want:[1 1]", name, beta)
+		}
+		if b.Data[1] != 0 {
+			t.Errorf("%s: B not diagonal for complex eigenvalues", name)
+		}
+	} else {
+		if alphai[1] != 0 {
+			t.Errorf("%s: unexpected non-zero alphai[1]=%v", name, alphai[1])
+		}
+		if a.Data[lda] != 0 {
+			t.Errorf("%s: A not upper triangular for real eigenvalues", name)
+		}
+		if alphar[0] != a.Data[0] || alphar[1] != a.Data[lda+1] || beta[0] != b.Data[0] || beta[1] != b.Data[ldb+1] {
+			t.Errorf("%s: eigenvalues do not match diagonal of the result", name)
+		}
+	}
+
+	// Check that the result is Q*A*Zᵀ and Q*B*Zᵀ.
+	q := blas64.General{Rows: 2, Cols: 2, Stride: 2, Data: []float64{csl, snl, -snl, csl}}
+	z := blas64.General{Rows: 2, Cols: 2, Stride: 2, Data: []float64{csr, snr, -snr, csr}}
+	for _, m := range []struct {
+		name      string
+		orig, got blas64.General
+	}{
+		{name: "A", orig: aCopy, got: a},
+		{name: "B", orig: bCopy, got: b},
+	} {
+		aux := zeros(2, 2, 2)
+		want := zeros(2, 2, 2)
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, q, m.orig, 0, aux)
+		blas64.Gemm(blas.NoTrans, blas.Trans, 1, aux, z, 0, want)
+		got := zeros(2, 2, 2)
+		copyGeneral(got, m.got)
+		if !equalApproxGeneral(got, want, tol) {
+			t.Errorf("%s: unexpected %s: got:%v want:%v", name, m.name, got.Data, want.Data)
+		}
+	}
+
+	// Check that beta*A - alpha*B is singular for each eigenvalue.
+	for k := 0; k < 2; k++ {
+		alpha := complex(alphar[k], alphai[k])
+		bk := complex(beta[k], 0)
+		c := func(i, j int) complex128 {
+			return bk*complex(aCopy.Data[i*lda+j], 0) - alpha*complex(bCopy.Data[i*ldb+j], 0)
+		}
+		det := c(0, 0)*c(1, 1) - c(0, 1)*c(1, 0)
+		scale := (cmplx.Abs(bk)*2 + cmplx.Abs(alpha)*2) * 4
+		if cmplx.Abs(det) > tol*scale*scale {
+			t.Errorf("%s: eigenvalue %d does not satisfy det(beta*A - alpha*B)=0; det=%v", name, k, det)
+		}
+	}
+}
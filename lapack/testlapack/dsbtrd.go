@@ -0,0 +1,156 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsbtrder interface {
+	Dsbtrd(vect lapack.OrthoComp, uplo blas.Uplo, n, kd int, ab []float64, ldab int, d, e, q []float64, ldq int, work []float64)
+}
+
+// DsbtrdTest tests the reduction of a symmetric band matrix to tridiagonal
+// form by checking that Q is orthogonal and that Qᵀ * A * Q = T.
+func DsbtrdTest(t *testing.T, impl Dsbtrder) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 31, 50} {
+		for _, kd := range []int{0, 1, 2, 3, (n + 1) / 2, n - 1, n + 2} {
+			if kd < 0 {
+				continue
+			}
+			for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+				for _, ldab := range []int{kd + 1, kd + 1 + 3} {
+					for _, ldq := range []int{max(1, n), n + 5} {
+						dsbtrdTest(t, impl, rnd, uplo, n, kd, ldab, ldq)
+					}
+				}
+			}
+		}
+	}
+}
+
+func dsbtrdTest(t *testing.T, impl Dsbtrder, rnd *rand.Rand, uplo blas.Uplo, n, kd, ldab, ldq int) {
+	const tol = 1e-13
+
+	name := fmt.Sprintf("uplo=%v,n=%v,kd=%v,ldab=%v,ldq=%v", string(uplo), n, kd, ldab, ldq)
+
+	// Generate a random symmetric band matrix and its dense
+	// representation.
+	var ab []float64
+	if n > 0 {
+		ab = make([]float64, (n-1)*ldab+kd+1)
+	}
+	for i := range ab {
+		ab[i] = rnd.NormFloat64()
+	}
+	a := zeros(n, n, max(1, n))
+	for i := 0; i < n; i++ {
+		for j := max(0, i-kd); j <= i; j++ {
+			var v float64
+			if uplo == blas.Upper {
+				v = ab[j*ldab+i-j]
+			} else {
+				v = ab[i*ldab+kd+j-i]
+			}
+			a.Data[i*a.Stride+j] = v
+			a.Data[j*a.Stride+i] = v
+		}
+	}
+
+	d := nanSlice(n)
+	e := nanSlice(max(0, n-1))
+	q := nanGeneral(n, n, ldq)
+	work := nanSlice(n)
+	abCopy := make([]float64, len(ab))
+	copy(abCopy, ab)
+	impl.Dsbtrd(lapack.OrthoExplicit, uplo, n, kd, ab, ldab, d, e, q.Data, max(1, ldq), work)
+	if n == 0 {
+		return
+	}
+
+	// Check that ab holds the tridiagonal matrix.
+	for i := 0; i < n; i++ {
+		for j := max(0, i-kd); j <= i; j++ {
+			var got float64
+			if uplo == blas.Upper {
+				got = ab[j*ldab+i-j]
+			} else {
+				got = ab[i*ldab+kd+j-i]
+			}
+			var want float64
+			switch i - j {
+			case 0:
+				want = d[i]
+			case 1:
+				want = e[j]
+			}
+			if got != want {
+				t.Errorf("%v: unexpected ab element (%d,%d): got %v, want %v", name, i, j, got, want)
+			}
+		}
+	}
+
+	// Check that Q is orthogonal.
+	if resid := residualOrthogonal(q, false); resid > tol*float64(n) {
+		t.Errorf("%v: Q is not orthogonal; resid=%v", name, resid)
+	}
+
+	// Check that Qᵀ * A * Q = T.
+	tri := zeros(n, n, n)
+	for i := 0; i < n; i++ {
+		tri.Data[i*n+i] = d[i]
+		if i < n-1 {
+			tri.Data[i*n+i+1] = e[i]
+			tri.Data[(i+1)*n+i] = e[i]
+		}
+	}
+	aq := zeros(n, n, n)
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, a, q, 0, aq)
+	blas64.Gemm(blas.Trans, blas.NoTrans, 1, q, aq, -1, tri)
+	anorm := dlange(lapack.MaxColumnSum, n, n, a.Data, a.Stride)
+	resid := dlange(lapack.MaxColumnSum, n, n, tri.Data, tri.Stride)
+	if anorm > 0 {
+		resid /= anorm
+	}
+	if resid > tol*float64(n) {
+		t.Errorf("%v: Qᵀ*A*Q != T; resid=%v", name, resid)
+	}
+
+	// Check that the tridiagonal matrix does not depend on
+	// whether Q is computed.
+	copy(ab, abCopy)
+	dNone := nanSlice(n)
+	eNone := nanSlice(n - 1)
+	impl.Dsbtrd(lapack.OrthoNone, uplo, n, kd, ab, ldab, dNone, eNone, nil, 1, work)
+	if !floats.Same(d, dNone) || !floats.Same(e, eNone) {
+		t.Errorf("%v: tridiagonal matrix depends on vect", name)
+	}
+
+	// Check that X * Q is computed with lapack.OrthoPostmul.
+	copy(ab, abCopy)
+	x := randomGeneral(n, n, ldq, rnd)
+	want := zeros(n, n, n)
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, x, q, 0, want)
+	impl.Dsbtrd(lapack.OrthoPostmul, uplo, n, kd, ab, ldab, dNone, eNone, x.Data, ldq, work)
+	var diff float64
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			diff = math.Max(diff, math.Abs(x.Data[i*x.Stride+j]-want.Data[i*want.Stride+j]))
+		}
+	}
+	if diff > tol*float64(n) {
+		t.Errorf("%v: unexpected X*Q; diff=%v", name, diff)
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dstebzer interface {
+	Dstebz(rng lapack.EVRange, n int, vl, vu float64, il, iu int, abstol float64, d, e, w []float64) (m int)
+	Dsterf(n int, d, e []float64) (ok bool)
+}
+
+// DstebzTest tests Dstebz by comparing the selected eigenvalues with all
+// eigenvalues computed by Dsterf.
+func DstebzTest(t *testing.T, impl Dstebzer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 50} {
+		for _, typ := range []string{"random", "clustered", "diagonal", "wilkinson"} {
+			d, e := symTridiagTestMatrix(typ, n, rnd)
+			dstebzTest(t, impl, rnd, typ, d, e)
+		}
+	}
+}
+
+func dstebzTest(t *testing.T, impl Dstebzer, rnd *rand.Rand, typ string, d, e []float64) {
+	const tol = 1e-13
+
+	n := len(d)
+	name := fmt.Sprintf("n=%v,type=%v", n, typ)
+
+	// Compute the reference eigenvalues.
+	want := make([]float64, n)
+	copy(want, d)
+	eCopy := make([]float64, len(e))
+	copy(eCopy, e)
+	ok := impl.Dsterf(n, want, eCopy)
+	if !ok {
+		t.Fatalf("%v: Dsterf failed", name)
+	}
+	var tnorm float64
+	for _, v := range want {
+		tnorm = math.Max(tnorm, math.Abs(v))
+	}
+
+	dCopy := make([]float64, n)
+	copy(dCopy, d)
+	copy(eCopy, e)
+	check := func(rname string, m int, w, want []float64) {
+		t.Helper()
+		if !equalFloat64s(d, dCopy) || !equalFloat64s(e, eCopy) {
+			t.Errorf("%v,%v: unexpected modification of d or e", name, rname)
+		}
+		if m != len(want) {
+			t.Errorf("%v,%v: unexpected number of eigenvalues: got %v, want %v", name, rname, m, len(want))
+			return
+		}
+		for i, v := range want {
+			if math.Abs(w[i]-v) > tol*math.Max(1, tnorm) {
+				t.Errorf("%v,%v: unexpected eigenvalue %d: got %v, want %v", name, rname, i, w[i], v)
+			}
+		}
+	}
+
+	w := make([]float64, n)
+	m := impl.Dstebz(lapack.EVRangeAll, n, 0, 0, 0, 0, 0, d, e, w)
+	check("all", m, w, want)
+
+	if n == 0 {
+		return
+	}
+	for trial := 0; trial < 5; trial++ {
+		il := rnd.Intn(n)
+		iu := il + rnd.Intn(n-il)
+		for i := range w {
+			w[i] = math.NaN()
+		}
+		m = impl.Dstebz(lapack.EVRangeIndex, n, 0, 0, il, iu, 0, d, e, w)
+		check(fmt.Sprintf("il=%v,iu=%v", il, iu), m, w, want[il:iu+1])
+
+		// Choose an interval with ends away from the eigenvalues.
+		vl := want[il] - 0.5
+		if il > 0 {
+			vl = (want[il-1] + want[il]) / 2
+		}
+		vu := want[iu] + 0.5
+		if iu < n-1 {
+			vu = (want[iu] + want[iu+1]) / 2
+		}
+		if want[il]-vl < 1e-8*math.Max(1, tnorm) || vu-want[iu] < 1e-8*math.Max(1, tnorm) {
+			// The ends are too close to an eigenvalue for the
+			// count of eigenvalues in (vl,vu] to be well defined.
+			continue
+		}
+		for i := range w {
+			w[i] = math.NaN()
+		}
+		m = impl.Dstebz(lapack.EVRangeValue, n, vl, vu, 0, 0, 0, d, e, w)
+		check(fmt.Sprintf("vl=%v,vu=%v", vl, vu), m, w, want[il:iu+1])
+	}
+}
+
+// symTridiagTestMatrix returns the diagonal and off-diagonal elements of an
+// n×n symmetric tridiagonal test matrix of the given type.
+func symTridiagTestMatrix(typ string, n int, rnd *rand.Rand) (d, e []float64) {
+	d = make([]float64, n)
+	e = make([]float64, max(0, n-1))
+	switch typ {
+	case "random":
+		for i := range d {
+			d[i] = rnd.NormFloat64()
+		}
+		for i := range e {
+			e[i] = rnd.NormFloat64()
+		}
+	case "clustered":
+		// Nearly decoupled blocks with equal diagonals have
+		// tightly clustered eigenvalues.
+		for i := range d {
+			d[i] = float64(i % 3)
+		}
+		for i := range e {
+			e[i] = 1e-10 * rnd.NormFloat64()
+		}
+	case "diagonal":
+		// A diagonal matrix with repeated eigenvalues.
+		for i := range d {
+			d[i] = float64(i % 2)
+		}
+	case "wilkinson":
+		// The Wilkinson matrix W⁺ has pairs of very close
+		// eigenvalues.
+		for i := range d {
+			d[i] = math.Abs(float64(i) - float64(n-1)/2)
+		}
+		for i := range e {
+			e[i] = 1
+		}
+	default:
+		panic("bad test matrix type")
+	}
+	return d, e
+}
+
+func equalFloat64s(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, v := range a {
+		if v != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,113 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsteiner interface {
+	Dstein(n int, d, e []float64, m int, w, z []float64, ldz int) (ok bool)
+	Dstebzer
+}
+
+// DsteinTest tests Dstein by checking the residual and orthogonality of
+// eigenvectors computed for eigenvalues selected by Dstebz.
+func DsteinTest(t *testing.T, impl Dsteiner) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 50} {
+		for _, typ := range []string{"random", "clustered", "diagonal", "wilkinson"} {
+			d, e := symTridiagTestMatrix(typ, n, rnd)
+			for _, all := range []bool{true, false} {
+				il, iu := 0, n-1
+				if !all && n > 0 {
+					il = rnd.Intn(n)
+					iu = il + rnd.Intn(n-il)
+				}
+				m := iu - il + 1
+				for _, ldz := range []int{max(1, m), m + 3} {
+					dsteinTest(t, impl, typ, d, e, il, iu, ldz)
+				}
+			}
+		}
+	}
+}
+
+func dsteinTest(t *testing.T, impl Dsteiner, typ string, d, e []float64, il, iu, ldz int) {
+	const tol = 1e-12
+
+	n := len(d)
+	name := fmt.Sprintf("n=%v,type=%v,il=%v,iu=%v,ldz=%v", n, typ, il, iu, ldz)
+
+	w := make([]float64, n)
+	var m int
+	if n > 0 {
+		m = impl.Dstebz(lapack.EVRangeIndex, n, 0, 0, il, iu, 0, d, e, w)
+	}
+	z := make([]float64, max(0, (n-1)*ldz+m))
+	ok := impl.Dstein(n, d, e, m, w, z, ldz)
+	if !ok {
+		t.Errorf("%v: Dstein failed to converge", name)
+	}
+	if n == 0 || m == 0 {
+		return
+	}
+
+	var tnorm float64
+	for i := 0; i < n; i++ {
+		r := math.Abs(d[i])
+		if i > 0 {
+			r += math.Abs(e[i-1])
+		}
+		if i < n-1 {
+			r += math.Abs(e[i])
+		}
+		tnorm = math.Max(tnorm, r)
+	}
+
+	// Check that T * z_j = w_j * z_j.
+	var resid float64
+	for j := 0; j < m; j++ {
+		for i := 0; i < n; i++ {
+			r := (d[i] - w[j]) * z[i*ldz+j]
+			if i > 0 {
+				r += e[i-1] * z[(i-1)*ldz+j]
+			}
+			if i < n-1 {
+				r += e[i] * z[(i+1)*ldz+j]
+			}
+			resid = math.Max(resid, math.Abs(r))
+		}
+	}
+	if resid > tol*math.Max(1, tnorm) {
+		t.Errorf("%v: unexpected residual |T*Z - Z*W| = %v", name, resid)
+	}
+
+	// Check that Z has orthonormal columns.
+	zg := blas64.General{Rows: n, Cols: m, Stride: ldz, Data: z}
+	if resid := residualOrthogonal(zg, false); resid > tol*float64(n) {
+		t.Errorf("%v: Z not orthonormal, |I - Zᵀ*Z| = %v", name, resid)
+	}
+
+	// Check the sign convention.
+	for j := 0; j < m; j++ {
+		var big float64
+		for i := 0; i < n; i++ {
+			if math.Abs(z[i*ldz+j]) > math.Abs(big) {
+				big = z[i*ldz+j]
+			}
+		}
+		if big < 0 {
+			t.Errorf("%v: largest element of eigenvector %d is negative", name, j)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsyconer interface {
+	Dsycon(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, anorm float64, work []float64, iwork []int) float64
+
+	Dsytrf(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, work []float64, lwork int) bool
+	Dsytrs(uplo blas.Uplo, n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int)
+	Dlansy(norm lapack.MatrixNorm, uplo blas.Uplo, n int, a []float64, lda int, work []float64) float64
+}
+
+func DsyconTest(t *testing.T, impl Dsyconer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, kind := range []string{"random", "zerodiag", "kkt"} {
+			for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 50} {
+				if kind == "zerodiag" && n == 1 {
+					// The zero matrix is singular.
+					continue
+				}
+				for _, lda := range []int{max(1, n), n + 5} {
+					name := fmt.Sprintf("uplo=%v,kind=%v,n=%v,lda=%v", uploToString(uplo), kind, n, lda)
+					a := randSymIndefinite(kind, n, lda, rnd)
+					anorm := impl.Dlansy(lapack.MaxColumnSum, uplo, n, a, lda, make([]float64, n))
+
+					ipiv := make([]int, n)
+					if !impl.Dsytrf(uplo, n, a, lda, ipiv, make([]float64, 1), 1) {
+						panic("bad test")
+					}
+
+					// Compute the exact reciprocal condition
+					// number from the explicit inverse.
+					want := 1.0
+					if n > 0 {
+						inv := eye(n, n)
+						impl.Dsytrs(uplo, n, n, a, lda, ipiv, inv.Data, inv.Stride)
+						ainvnm := dlange(lapack.MaxColumnSum, n, n, inv.Data, inv.Stride)
+						want = 1 / ainvnm / anorm
+					}
+
+					got := impl.Dsycon(uplo, n, a, lda, ipiv, anorm, make([]float64, 2*n), make([]int, n))
+
+					// The norm estimate is a lower bound of the
+					// norm of the inverse.
+					if got < want*(1-1e-12) || got > 10*want {
+						t.Errorf("%v: unexpected rcond; got %v, want %v", name, got, want)
+					}
+				}
+			}
+		}
+	}
+}
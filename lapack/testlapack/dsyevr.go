@@ -0,0 +1,192 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsyevrer interface {
+	Dsyevr(jobz lapack.EVJob, rng lapack.EVRange, uplo blas.Uplo, n int, a []float64, lda int, vl, vu float64, il, iu int, abstol float64, w, z []float64, ldz int, work []float64, lwork int) (m int, ok bool)
+	Dsyever
+}
+
+// DsyevrTest tests Dsyevr by comparing the selected eigenvalues with all
+// eigenvalues computed by Dsyev and checking the residual and orthogonality
+// of the computed eigenvectors.
+func DsyevrTest(t *testing.T, impl Dsyevrer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, n := range []int{0, 1, 2, 3, 5, 10, 40} {
+			for _, lda := range []int{n, n + 3} {
+				for _, typ := range []string{"random", "clustered", "scaled"} {
+					dsyevrTest(t, impl, rnd, uplo, n, max(1, lda), typ)
+				}
+			}
+		}
+	}
+}
+
+func dsyevrTest(t *testing.T, impl Dsyevrer, rnd *rand.Rand, uplo blas.Uplo, n, lda int, typ string) {
+	const tol = 1e-13
+
+	// Generate a symmetric matrix A = Q * Λ * Qᵀ.
+	lambda := make([]float64, n)
+	scale := 1.0
+	for i := range lambda {
+		switch typ {
+		case "random":
+			lambda[i] = rnd.NormFloat64()
+		case "clustered":
+			lambda[i] = float64(i%3) + 1e-10*rnd.NormFloat64()
+		case "scaled":
+			scale = 1e200
+			lambda[i] = scale * rnd.NormFloat64()
+		}
+	}
+	a := make([]float64, max(0, (n-1)*lda+n))
+	if n > 0 {
+		q := randomOrthogonal(n, rnd)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				var v float64
+				for k := 0; k < n; k++ {
+					v += q.Data[i*q.Stride+k] * lambda[k] * q.Data[j*q.Stride+k]
+				}
+				a[i*lda+j] = v
+			}
+		}
+	}
+	sym := blas64.General{Rows: n, Cols: n, Stride: lda, Data: a}
+	anorm := math.Max(dlange(lapack.MaxAbs, n, n, a, max(1, lda)), scale*1e-300)
+
+	// Compute the reference eigenvalues.
+	want := make([]float64, n)
+	work := make([]float64, max(1, 3*n))
+	aCopy := make([]float64, len(a))
+	copy(aCopy, a)
+	if !impl.Dsyev(lapack.EVNone, uplo, n, aCopy, max(1, lda), want, work, len(work)) {
+		t.Fatalf("uplo=%c,n=%d,typ=%v: Dsyev failed", uplo, n, typ)
+	}
+
+	type rangeTest struct {
+		rng    lapack.EVRange
+		vl, vu float64
+		il, iu int
+	}
+	ranges := []rangeTest{{rng: lapack.EVRangeAll}}
+	if n > 0 {
+		ranges = append(ranges,
+			rangeTest{rng: lapack.EVRangeIndex, il: 0, iu: 0},
+			rangeTest{rng: lapack.EVRangeIndex, il: n - 1, iu: n - 1},
+			rangeTest{rng: lapack.EVRangeIndex, il: n / 3, iu: (2 * n) / 3},
+			rangeTest{rng: lapack.EVRangeValue, vl: want[0] - scale, vu: want[n-1] + scale},
+			rangeTest{rng: lapack.EVRangeValue, vl: want[n-1] + scale, vu: want[n-1] + 2*scale},
+		)
+		if n > 2 {
+			// Choose an interval with endpoints between
+			// well-separated eigenvalues.
+			lo, hi := -1, -1
+			for i := 0; i < n-1; i++ {
+				if want[i+1]-want[i] > 1e-3*anorm {
+					if lo < 0 {
+						lo = i
+					}
+					hi = i
+				}
+			}
+			if lo >= 0 {
+				ranges = append(ranges, rangeTest{
+					rng: lapack.EVRangeValue,
+					vl:  (want[lo] + want[lo+1]) / 2,
+					vu:  (want[hi] + want[hi+1]) / 2,
+				})
+			}
+		}
+	}
+
+	for _, r := range ranges {
+		name := fmt.Sprintf("uplo=%c,n=%d,lda=%d,typ=%v,rng=%c", uplo, n, lda, typ, r.rng)
+
+		// Determine the expected eigenvalues.
+		var wantW []float64
+		switch r.rng {
+		case lapack.EVRangeAll:
+			wantW = want
+		case lapack.EVRangeIndex:
+			wantW = want[r.il : r.iu+1]
+		case lapack.EVRangeValue:
+			for _, v := range want {
+				if r.vl < v && v <= r.vu {
+					wantW = append(wantW, v)
+				}
+			}
+		}
+		ncols := n
+		if r.rng == lapack.EVRangeIndex {
+			ncols = r.iu - r.il + 1
+		}
+		ldz := max(1, ncols+2)
+
+		for _, jobz := range []lapack.EVJob{lapack.EVNone, lapack.EVCompute} {
+			aCopy := make([]float64, len(a))
+			copy(aCopy, a)
+			w := nanSlice(n)
+			z := nanSlice(max(0, (n-1)*ldz+ncols))
+
+			work := make([]float64, 1)
+			impl.Dsyevr(jobz, r.rng, uplo, n, aCopy, max(1, lda), r.vl, r.vu, r.il, r.iu, 0, w, z, ldz, work, -1)
+			lwork := int(work[0])
+			if rnd.Intn(2) == 0 {
+				lwork = max(1, 5*n)
+			}
+			work = nanSlice(lwork)
+			m, ok := impl.Dsyevr(jobz, r.rng, uplo, n, aCopy, max(1, lda), r.vl, r.vu, r.il, r.iu, 0, w, z, ldz, work, lwork)
+			if !ok {
+				t.Errorf("%v,jobz=%c: Dsyevr failed", name, jobz)
+				continue
+			}
+			if m != len(wantW) {
+				t.Errorf("%v,jobz=%c: unexpected number of eigenvalues: got %d, want %d", name, jobz, m, len(wantW))
+				continue
+			}
+			for i := 0; i < m; i++ {
+				if math.Abs(w[i]-wantW[i]) > tol*anorm*float64(max(1, n)) {
+					t.Errorf("%v,jobz=%c: unexpected eigenvalue %d: got %v, want %v", name, jobz, i, w[i], wantW[i])
+				}
+			}
+			if jobz == lapack.EVNone || m == 0 {
+				continue
+			}
+
+			// Check that the eigenvectors are orthonormal.
+			zm := blas64.General{Rows: n, Cols: m, Stride: ldz, Data: z}
+			if resid := residualOrthogonal(zm, false); resid > tol*float64(n) {
+				t.Errorf("%v: eigenvectors not orthonormal: |I - Zᵀ*Z|=%v", name, resid)
+			}
+
+			// Check the residual |A*Z - Z*W| / (|A| * n).
+			az := zeros(n, m, m)
+			blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, sym, zm, 0, az)
+			var resid float64
+			for i := 0; i < n; i++ {
+				for j := 0; j < m; j++ {
+					resid = math.Max(resid, math.Abs(az.Data[i*az.Stride+j]-w[j]*z[i*ldz+j]))
+				}
+			}
+			if resid/anorm > tol*float64(n) {
+				t.Errorf("%v: unexpected residual |A*Z - Z*W|/|A|=%v", name, resid/anorm)
+			}
+		}
+	}
+}
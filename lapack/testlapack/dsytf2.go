@@ -0,0 +1,54 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+type Dsytf2er interface {
+	Dsytf2(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int) (ok bool)
+}
+
+func Dsytf2Test(t *testing.T, impl Dsytf2er) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, kind := range []string{"random", "zerodiag", "kkt"} {
+			for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 20} {
+				if kind == "zerodiag" && n == 1 {
+					// The zero matrix is singular.
+					continue
+				}
+				for _, lda := range []int{max(1, n), n + 5} {
+					a := randSymIndefinite(kind, n, lda, rnd)
+					aFac := make([]float64, len(a))
+					copy(aFac, a)
+					ipiv := make([]int, n)
+					ok := impl.Dsytf2(uplo, n, aFac, lda, ipiv)
+
+					name := fmt.Sprintf("uplo=%v,kind=%v,n=%v,lda=%v", uploToString(uplo), kind, n, lda)
+					checkDsytrf(t, name, uplo, n, a, aFac, lda, ipiv, ok)
+				}
+			}
+		}
+	}
+
+	// A singular matrix is detected.
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		a := []float64{
+			1, 2, 3,
+			2, 4, 6,
+			3, 6, 9,
+		}
+		if impl.Dsytf2(uplo, 3, a, 3, make([]int, 3)) {
+			t.Errorf("uplo=%v: singular matrix not detected", uploToString(uplo))
+		}
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dsytrfer interface {
+	Dsytrf(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, work []float64, lwork int) (ok bool)
+}
+
+func DsytrfTest(t *testing.T, impl Dsytrfer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, kind := range []string{"random", "zerodiag", "kkt"} {
+			for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 31, 64, 65} {
+				if kind == "zerodiag" && n == 1 {
+					// The zero matrix is singular.
+					continue
+				}
+				for _, lda := range []int{max(1, n), n + 5} {
+					for _, wl := range []worklen{minimumWork, optimumWork} {
+						a := randSymIndefinite(kind, n, lda, rnd)
+						aFac := make([]float64, len(a))
+						copy(aFac, a)
+						ipiv := make([]int, n)
+
+						var lwork int
+						switch wl {
+						case minimumWork:
+							lwork = 1
+						case optimumWork:
+							work := make([]float64, 1)
+							impl.Dsytrf(uplo, n, aFac, lda, ipiv, work, -1)
+							lwork = int(work[0])
+						}
+						work := make([]float64, lwork)
+						ok := impl.Dsytrf(uplo, n, aFac, lda, ipiv, work, lwork)
+
+						name := fmt.Sprintf("uplo=%v,kind=%v,n=%v,lda=%v,work=%v", uploToString(uplo), kind, n, lda, wl)
+						checkDsytrf(t, name, uplo, n, a, aFac, lda, ipiv, ok)
+					}
+				}
+			}
+		}
+	}
+}
+
+// checkDsytrf checks that the Bunch-Kaufman factorization in aFac and ipiv
+// reconstructs the symmetric matrix A in a.
+func checkDsytrf(t *testing.T, name string, uplo blas.Uplo, n int, a, aFac []float64, lda int, ipiv []int, ok bool) {
+	t.Helper()
+
+	const tol = 1e-13
+
+	if !ok {
+		t.Errorf("%v: unexpected singular factorization", name)
+		return
+	}
+	if n == 0 {
+		return
+	}
+	for k := 0; k < n; k++ {
+		kp := ipiv[k]
+		if kp < 0 {
+			kp = ^kp
+			// 2×2 pivots are marked in consecutive
+			// elements of ipiv.
+			var pair bool
+			if uplo == blas.Upper {
+				pair = (k > 0 && ipiv[k-1] == ipiv[k]) || (k < n-1 && ipiv[k+1] == ipiv[k])
+			} else {
+				pair = (k < n-1 && ipiv[k+1] == ipiv[k]) || (k > 0 && ipiv[k-1] == ipiv[k])
+			}
+			if !pair {
+				t.Errorf("%v: unpaired 2×2 pivot at %d: %v", name, k, ipiv)
+			}
+		}
+		if kp >= n {
+			t.Errorf("%v: pivot out of range at %d: %v", name, k, ipiv)
+			return
+		}
+	}
+
+	resid := residualDsytrf(uplo, n, a, aFac, lda, ipiv)
+	if resid > tol || math.IsNaN(resid) {
+		t.Errorf("%v: residual too large; got %v, want<=%v", name, resid, tol)
+	}
+}
+
+// residualDsytrf returns
+//
+//	|A - U*D*Uᵀ| / (n * |A|)  or  |A - L*D*Lᵀ| / (n * |A|)
+//
+// in the Frobenius norm, where the factorization is given in aFac and ipiv
+// as computed by Dsytrf.
+func residualDsytrf(uplo blas.Uplo, n int, a, aFac []float64, lda int, ipiv []int) float64 {
+	bi := blas64.Implementation()
+
+	// Form the product of the elementary transformations
+	// M = P(0)*L(0)*P(1)*L(1)*... or M = P(n-1)*U(n-1)*P(n-2)*U(n-2)*...
+	// and the block diagonal matrix D.
+	m := eye(n, n)
+	d := zeros(n, n, n)
+	swapCols := func(i, j int) {
+		if i != j {
+			bi.Dswap(n, m.Data[i:], n, m.Data[j:], n)
+		}
+	}
+	// addCol adds the linear combination of the columns of m with
+	// indices in [from,to) and coefficients in column c of aFac to
+	// column c of m.
+	addCol := func(c, from, to int) {
+		for i := from; i < to; i++ {
+			bi.Daxpy(n, aFac[i*lda+c], m.Data[i:], n, m.Data[c:], n)
+		}
+	}
+	if uplo == blas.Upper {
+		for k := n - 1; k >= 0; {
+			if ipiv[k] >= 0 {
+				swapCols(k, ipiv[k])
+				addCol(k, 0, k)
+				d.Data[k*n+k] = aFac[k*lda+k]
+				k--
+				continue
+			}
+			swapCols(k-1, ^ipiv[k])
+			addCol(k, 0, k-1)
+			addCol(k-1, 0, k-1)
+			d.Data[(k-1)*n+k-1] = aFac[(k-1)*lda+k-1]
+			d.Data[(k-1)*n+k] = aFac[(k-1)*lda+k]
+			d.Data[k*n+k-1] = aFac[(k-1)*lda+k]
+			d.Data[k*n+k] = aFac[k*lda+k]
+			k -= 2
+		}
+	} else {
+		for k := 0; k < n; {
+			if ipiv[k] >= 0 {
+				swapCols(k, ipiv[k])
+				addCol(k, k+1, n)
+				d.Data[k*n+k] = aFac[k*lda+k]
+				k++
+				continue
+			}
+			swapCols(k+1, ^ipiv[k])
+			addCol(k, k+2, n)
+			addCol(k+1, k+2, n)
+			d.Data[k*n+k] = aFac[k*lda+k]
+			d.Data[k*n+k+1] = aFac[(k+1)*lda+k]
+			d.Data[(k+1)*n+k] = aFac[(k+1)*lda+k]
+			d.Data[(k+1)*n+k+1] = aFac[(k+1)*lda+k+1]
+			k += 2
+		}
+	}
+
+	// Compute M*D*Mᵀ - A.
+	md := zeros(n, n, n)
+	bi.Dgemm(blas.NoTrans, blas.NoTrans, n, n, n, 1, m.Data, n, d.Data, n, 0, md.Data, n)
+	full := zeros(n, n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if (uplo == blas.Upper) == (i <= j) {
+				full.Data[i*n+j] = a[i*lda+j]
+			} else {
+				full.Data[i*n+j] = a[j*lda+i]
+			}
+		}
+	}
+	anorm := dlange(lapack.Frobenius, n, n, full.Data, n)
+	bi.Dgemm(blas.NoTrans, blas.Trans, n, n, n, 1, md.Data, n, m.Data, n, -1, full.Data, n)
+	resid := dlange(lapack.Frobenius, n, n, full.Data, n)
+	if anorm == 0 {
+		return resid
+	}
+	return resid / anorm / float64(n)
+}
+
+// randSymIndefinite returns a random n×n symmetric indefinite matrix with
+// leading dimension lda. kind specifies the structure of the matrix:
+//
+//	"random":   A = Q * D * Qᵀ with D having entries of both signs,
+//	"zerodiag": a random symmetric matrix with zero diagonal,
+//	"kkt":      a saddle-point matrix [H Bᵀ; B 0].
+func randSymIndefinite(kind string, n, lda int, rnd *rand.Rand) []float64 {
+	a := make([]float64, n*lda)
+	switch kind {
+	case "random":
+		d := make([]float64, n)
+		Dlatm1(d, 4, 100, true, 1, rnd)
+		if n > 0 {
+			Dlagsy(n, 0, d, a, lda, rnd, make([]float64, 2*n))
+		}
+	case "zerodiag":
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				v := rnd.NormFloat64()
+				a[i*lda+j] = v
+				a[j*lda+i] = v
+			}
+		}
+	case "kkt":
+		h := n - n/3
+		for i := 0; i < h; i++ {
+			a[i*lda+i] = float64(h) + rnd.Float64()
+			for j := i + 1; j < h; j++ {
+				v := rnd.Float64()
+				a[i*lda+j] = v
+				a[j*lda+i] = v
+			}
+		}
+		for i := h; i < n; i++ {
+			for j := 0; j < h; j++ {
+				v := rnd.NormFloat64()
+				a[i*lda+j] = v
+				a[j*lda+i] = v
+			}
+		}
+	default:
+		panic("bad kind")
+	}
+	return a
+}
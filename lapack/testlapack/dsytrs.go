@@ -0,0 +1,86 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+type Dsytrser interface {
+	Dsytrs(uplo blas.Uplo, n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int)
+
+	Dsytrf(uplo blas.Uplo, n int, a []float64, lda int, ipiv []int, work []float64, lwork int) bool
+}
+
+func DsytrsTest(t *testing.T, impl Dsytrser) {
+	const tol = 1e-11
+
+	rnd := rand.New(rand.NewSource(1))
+	bi := blas64.Implementation()
+
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, kind := range []string{"random", "zerodiag", "kkt"} {
+			for _, n := range []int{1, 2, 3, 5, 10} {
+				if kind == "zerodiag" && n == 1 {
+					// The zero matrix is singular.
+					continue
+				}
+				for _, nrhs := range []int{1, 2, 5} {
+					for _, ld := range []struct{ a, b int }{
+						{n, nrhs},
+						{n + 7, nrhs},
+						{n, nrhs + 3},
+						{n + 7, nrhs + 3},
+					} {
+						aData := randSymIndefinite(kind, n, ld.a, rnd)
+						a := blas64.General{Rows: n, Cols: n, Stride: ld.a, Data: aData}
+
+						// Generate a random solution X.
+						want := nanGeneral(n, nrhs, ld.b)
+						for i := 0; i < n; i++ {
+							for j := 0; j < nrhs; j++ {
+								want.Data[i*want.Stride+j] = rnd.NormFloat64()
+							}
+						}
+
+						// Compute the right-hand side matrix as A * X.
+						b := nanGeneral(n, nrhs, ld.b)
+						bi.Dgemm(blas.NoTrans, blas.NoTrans, n, nrhs, n, 1, a.Data, a.Stride, want.Data, want.Stride, 0, b.Data, b.Stride)
+
+						// Compute the Bunch-Kaufman factorization of A.
+						ipiv := make([]int, n)
+						ok := impl.Dsytrf(uplo, n, a.Data, a.Stride, ipiv, make([]float64, 1), 1)
+						if !ok {
+							panic("bad test")
+						}
+
+						aCopy := cloneGeneral(a)
+
+						// Solve A * X = B.
+						impl.Dsytrs(uplo, n, nrhs, a.Data, a.Stride, ipiv, b.Data, b.Stride)
+
+						name := fmt.Sprintf("uplo=%v,kind=%v,n=%v,nrhs=%v,lda=%v,ldb=%v", uploToString(uplo), kind, n, nrhs, a.Stride, b.Stride)
+
+						if !equalApproxGeneral(a, aCopy, 0) {
+							t.Errorf("%v: unexpected modification of A", name)
+						}
+						if !generalOutsideAllNaN(b) {
+							t.Errorf("%v: out-of-range modification of B", name)
+						}
+						if !equalApproxGeneral(b, want, tol) {
+							t.Errorf("%v: unexpected result\ngot  %v\nwant %v", name, b, want)
+						}
+					}
+				}
+			}
+		}
+	}
+}
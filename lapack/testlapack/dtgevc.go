@@ -0,0 +1,193 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlapack
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+type Dtgevcer interface {
+	Dhgeqzer
+	Dtgevc(side lapack.EVSide, howmny lapack.EVHowMany, selected []bool, n int, s []float64, lds int, p []float64, ldp int, vl []float64, ldvl int, vr []float64, ldvr int, mm int, work []float64) int
+}
+
+func DtgevcTest(t *testing.T, impl Dtgevcer) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10, 17} {
+		for _, extra := range []int{0, 3} {
+			for _, singular := range []bool{false, true} {
+				for cas := 0; cas < 5; cas++ {
+					dtgevcTest(t, impl, rnd, n, extra, singular)
+				}
+			}
+		}
+	}
+}
+
+func dtgevcTest(t *testing.T, impl Dtgevcer, rnd *rand.Rand, n, extra int, singular bool) {
+	const tol = 1e-12
+
+	ld := max(1, n+extra)
+	h := randomHessenberg(n, ld, rnd)
+	tt := randomGeneral(n, n, ld, rnd)
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			tt.Data[i*tt.Stride+j] = 0
+		}
+	}
+	if singular && n > 0 {
+		tt.Data[(n/2)*tt.Stride+n/2] = 0
+	}
+
+	name := fmt.Sprintf("n=%d,extra=%d,singular=%t", n, extra, singular)
+
+	s := cloneGeneral(h)
+	p := cloneGeneral(tt)
+	q := nanGeneral(n, n, ld)
+	z := nanGeneral(n, n, ld)
+	alphar := make([]float64, n)
+	alphai := make([]float64, n)
+	beta := make([]float64, n)
+	ok := impl.Dhgeqz(lapack.EigenvaluesAndSchur, lapack.OrthoExplicit, lapack.OrthoExplicit, n, 0, n-1,
+		s.Data, s.Stride, p.Data, p.Stride, alphar, alphai, beta, q.Data, q.Stride, z.Data, z.Stride)
+	if !ok {
+		t.Fatalf("%s: bad test: Dhgeqz did not converge", name)
+	}
+
+	work := make([]float64, 4*n)
+
+	// Eigenvectors of (S,P).
+	vl := nanGeneral(n, n, ld)
+	vr := nanGeneral(n, n, ld)
+	m := impl.Dtgevc(lapack.EVBoth, lapack.EVAll, nil, n, s.Data, s.Stride, p.Data, p.Stride, vl.Data, vl.Stride, vr.Data, vr.Stride, n, work)
+	if m != n {
+		t.Errorf("%s: unexpected number of columns: got:%d want:%d", name, m, n)
+	}
+	checkGeneralizedEigenvectors(t, name+",S", s, p, alphar, alphai, beta, vl, vr, tol)
+
+	// Eigenvectors of (H,T) by back-transformation.
+	vlq := cloneGeneral(q)
+	vrz := cloneGeneral(z)
+	impl.Dtgevc(lapack.EVBoth, lapack.EVAllMulQ, nil, n, s.Data, s.Stride, p.Data, p.Stride, vlq.Data, vlq.Stride, vrz.Data, vrz.Stride, n, work)
+	checkGeneralizedEigenvectors(t, name+",H", h, tt, alphar, alphai, beta, vlq, vrz, tol)
+
+	if n == 0 {
+		return
+	}
+
+	// Selected eigenvectors must match the corresponding columns of the
+	// full result.
+	selected := make([]bool, n)
+	var cols []int
+	for j := 0; j < n; j++ {
+		sel := rnd.Intn(2) == 0
+		if alphai[j] > 0 {
+			selected[j] = sel
+			selected[j+1] = false
+			if sel {
+				cols = append(cols, j, j+1)
+			}
+			j++
+			continue
+		}
+		selected[j] = sel
+		if sel {
+			cols = append(cols, j)
+		}
+	}
+	vls := nanGeneral(n, n, ld)
+	vrs := nanGeneral(n, n, ld)
+	m = impl.Dtgevc(lapack.EVBoth, lapack.EVSelected, selected, n, s.Data, s.Stride, p.Data, p.Stride, vls.Data, vls.Stride, vrs.Data, vrs.Stride, n, work)
+	if m != len(cols) {
+		t.Errorf("%s: unexpected number of selected columns: got:%d want:%d", name, m, len(cols))
+	}
+	for k, j := range cols {
+		for i := 0; i < n; i++ {
+			if vls.Data[i*vls.Stride+k] != vl.Data[i*vl.Stride+j] {
+				t.Errorf("%s: selected left eigenvector %d mismatch", name, j)
+				break
+			}
+		}
+		for i := 0; i < n; i++ {
+			if vrs.Data[i*vrs.Stride+k] != vr.Data[i*vr.Stride+j] {
+				t.Errorf("%s: selected right eigenvector %d mismatch", name, j)
+				break
+			}
+		}
+	}
+}
+
+// checkGeneralizedEigenvectors checks that the columns of vl and vr are left
+// and right eigenvectors of the pair (a,b) corresponding to the eigenvalues
+// given by alphar, alphai and beta, and that they are normalized so that the
+// largest component has |re|+|im| equal to 1.
+func checkGeneralizedEigenvectors(t *testing.T, name string, a, b blas64.General, alphar, alphai, beta []float64, vl, vr blas64.General, tol float64) {
+	t.Helper()
+	n := a.Rows
+	anorm := math.Max(dlange(lapack.MaxAbs, n, n, a.Data, a.Stride), 1)
+	bnorm := math.Max(dlange(lapack.MaxAbs, n, n, b.Data, b.Stride), 1)
+	for j := 0; j < n; j++ {
+		alpha := complex(alphar[j], alphai[j])
+		bt := complex(beta[j], 0)
+		for _, left := range []bool{false, true} {
+			vmat := vr
+			if left {
+				vmat = vl
+			}
+			v := columnOf(vmat, alphai, j)
+			var vmax float64
+			for _, vi := range v {
+				vmax = math.Max(vmax, math.Abs(real(vi))+math.Abs(imag(vi)))
+			}
+			if math.Abs(vmax-1) > tol {
+				t.Errorf("%s: eigenvector %d (left=%t) not normalized: max=%v", name, j, left, vmax)
+			}
+			var resid float64
+			for i := 0; i < n; i++ {
+				var r complex128
+				for k := 0; k < n; k++ {
+					if left {
+						// (β*aᵀ - conj(α)*bᵀ) * u.
+						r += (bt*complex(a.Data[k*a.Stride+i], 0) - cmplx.Conj(alpha)*complex(b.Data[k*b.Stride+i], 0)) * v[k]
+					} else {
+						// (β*a - α*b) * v.
+						r += (bt*complex(a.Data[i*a.Stride+k], 0) - alpha*complex(b.Data[i*b.Stride+k], 0)) * v[k]
+					}
+				}
+				resid = math.Max(resid, cmplx.Abs(r))
+			}
+			resid /= cmplx.Abs(bt)*anorm + cmplx.Abs(alpha)*bnorm
+			if resid > tol*float64(n) {
+				t.Errorf("%s: eigenvector %d (left=%t) residual too large: %v", name, j, left, resid)
+			}
+		}
+	}
+}
+
+// columnOf returns the j-th eigenvector from the columns of v stored in the
+// LAPACK convention for real matrices.
+func columnOf(v blas64.General, alphai []float64, j int) []complex128 {
+	n := v.Rows
+	col := make([]complex128, n)
+	for i := 0; i < n; i++ {
+		switch {
+		case alphai[j] == 0:
+			col[i] = complex(v.Data[i*v.Stride+j], 0)
+		case alphai[j] > 0:
+			col[i] = complex(v.Data[i*v.Stride+j], v.Data[i*v.Stride+j+1])
+		default:
+			col[i] = complex(v.Data[i*v.Stride+j-1], -v.Data[i*v.Stride+j])
+		}
+	}
+	return col
+}
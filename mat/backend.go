@@ -0,0 +1,306 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+// DeviceMatrix is a handle to a matrix held in the memory of a Backend. The
+// elements of a DeviceMatrix are only accessible through its Backend.
+type DeviceMatrix interface {
+	// Dims returns the dimensions of the matrix.
+	Dims() (r, c int)
+}
+
+// Backend is a compute device, such as a GPU or other accelerator, that can
+// perform dense matrix operations on data held in its own memory. A Backend
+// registered with UseBackend is used by Dense.Mul and Dense.Solve for large
+// operands, which allows backends implemented with cgo, for example using
+// CUDA or Metal, to be used without changes to this package.
+//
+// Matrices are transferred between host and device memory in the row-major
+// layout of blas64.General. Implementations are responsible for any change
+// of layout required by the device.
+type Backend interface {
+	// Upload copies a into device memory and returns a handle to the
+	// copy.
+	Upload(a blas64.General) (DeviceMatrix, error)
+
+	// Alloc allocates an r×c matrix in device memory. The elements
+	// of the matrix are undefined.
+	Alloc(r, c int) (DeviceMatrix, error)
+
+	// Download copies the device matrix src into dst, which must have
+	// the same dimensions as src.
+	Download(dst blas64.General, src DeviceMatrix) error
+
+	// Free releases the device memory held by d. d must not be used
+	// after it has been freed.
+	Free(d DeviceMatrix)
+
+	// Gemm computes
+	//
+	//	c = alpha * op(a) * op(b) + beta * c
+	//
+	// where op(x) is x or xᵀ depending on tA and tB, as in the BLAS
+	// routine Dgemm.
+	Gemm(tA, tB blas.Transpose, alpha float64, a, b DeviceMatrix, beta float64, c DeviceMatrix) error
+
+	// Gesv solves the system of linear equations a * x = b for the
+	// square matrix a using an LU factorization with partial pivoting,
+	// as in the LAPACK routine Dgesv. On return, b is overwritten by the
+	// solution x and a by the factors L and U in the layout of the
+	// LAPACK routine Dgetrf, with the unit diagonal of L not stored.
+	// Gesv returns false if a is exactly singular, in which case the
+	// contents of b are undefined.
+	Gesv(a, b DeviceMatrix) (ok bool, err error)
+}
+
+var (
+	backend       Backend
+	backendMinDim int
+)
+
+var errForeignDeviceMatrix = errors.New("mat: device matrix not allocated by backend")
+
+// UseBackend sets the Backend used by subsequent calls to Dense.Mul with
+// *Dense operands and to Dense.Solve with a square *Dense system matrix, for
+// operations in which every dimension of the operands is at least minDim.
+// Smaller operations, for which the cost of transferring the data to the
+// device outweighs the gain, are performed on the host. If b is nil, all
+// operations are performed on the host, which is the default.
+//
+// If an operation on the backend returns an error, the operation is
+// performed on the host instead. UseBackend is not safe to call
+// concurrently with matrix operations.
+func UseBackend(b Backend, minDim int) {
+	if minDim < 0 {
+		panic(ErrNegativeDimension)
+	}
+	backend = b
+	backendMinDim = minDim
+}
+
+// CurrentBackend returns the Backend set by UseBackend and the minimum
+// dimension for its use. CurrentBackend returns a nil Backend if operations
+// are performed on the host.
+func CurrentBackend() (b Backend, minDim int) {
+	return backend, backendMinDim
+}
+
+// useBackend returns the current backend if it should be used for an
+// operation with the given dimensions, and nil otherwise.
+func useBackend(dims ...int) Backend {
+	if backend == nil {
+		return nil
+	}
+	for _, d := range dims {
+		if d < backendMinDim {
+			return nil
+		}
+	}
+	return backend
+}
+
+// mulBackend computes m = op(a) * op(b) using be and returns whether the
+// computation was successful. The receiver must have the dimensions of the
+// result.
+func (m *Dense) mulBackend(be Backend, tA, tB blas.Transpose, a, b blas64.General) (ok bool) {
+	da, err := be.Upload(a)
+	if err != nil {
+		return false
+	}
+	defer be.Free(da)
+	db, err := be.Upload(b)
+	if err != nil {
+		return false
+	}
+	defer be.Free(db)
+	dc, err := be.Alloc(m.mat.Rows, m.mat.Cols)
+	if err != nil {
+		return false
+	}
+	defer be.Free(dc)
+	if be.Gemm(tA, tB, 1, da, db, 0, dc) != nil {
+		return false
+	}
+	return be.Download(m.mat, dc) == nil
+}
+
+// solveBackend solves a * x = b for the n×n matrix a using be, storing the
+// solution into the receiver, which must have the dimensions of b. It
+// returns whether the backend computation was successful, and if it was, the
+// error that Dense.Solve should return.
+func (m *Dense) solveBackend(be Backend, a, b Matrix) (ok bool, err error) {
+	n, _ := a.Dims()
+	lu := getDenseWorkspace(n, n, false)
+	defer putDenseWorkspace(lu)
+	lu.Copy(a)
+	br, bc := b.Dims()
+	x := getDenseWorkspace(br, bc, false)
+	defer putDenseWorkspace(x)
+	x.Copy(b)
+
+	work := getFloat64s(4*n, false)
+	defer putFloat64s(work)
+	anorm := lapack64.Lange(CondNorm, lu.mat, work)
+	da, derr := be.Upload(lu.mat)
+	if derr != nil {
+		return false, nil
+	}
+	defer be.Free(da)
+	db, derr := be.Upload(x.mat)
+	if derr != nil {
+		return false, nil
+	}
+	defer be.Free(db)
+	nonsingular, derr := be.Gesv(da, db)
+	if derr != nil {
+		return false, nil
+	}
+	if !nonsingular {
+		return true, Condition(math.Inf(1))
+	}
+	if be.Download(x.mat, db) != nil || be.Download(lu.mat, da) != nil {
+		return false, nil
+	}
+	m.Copy(x)
+
+	// Estimate the condition number from the factors computed on the
+	// device, as LU.SolveTo does for factors computed on the host.
+	iwork := getInts(n, false)
+	defer putInts(iwork)
+	rcond := lapack64.Gecon(CondNorm, lu.mat, anorm, work, iwork)
+	if cond := 1 / rcond; cond > ConditionTolerance {
+		return true, Condition(cond)
+	}
+	return true, nil
+}
+
+// CPU is a reference Backend that performs operations on the host using the
+// current blas64 and lapack64 implementations. It is intended for testing
+// and as a model for device backends.
+type CPU struct{}
+
+var _ Backend = CPU{}
+
+// cpuMatrix is the DeviceMatrix of the CPU backend.
+type cpuMatrix struct {
+	mat blas64.General
+}
+
+func (m *cpuMatrix) Dims() (r, c int) { return m.mat.Rows, m.mat.Cols }
+
+// Upload returns a copy of a.
+func (CPU) Upload(a blas64.General) (DeviceMatrix, error) {
+	m := newCPUMatrix(a.Rows, a.Cols)
+	for i := 0; i < a.Rows; i++ {
+		copy(m.mat.Data[i*m.mat.Stride:i*m.mat.Stride+a.Cols], a.Data[i*a.Stride:i*a.Stride+a.Cols])
+	}
+	return m, nil
+}
+
+// Alloc returns a new r×c matrix.
+func (CPU) Alloc(r, c int) (DeviceMatrix, error) {
+	if r < 0 || c < 0 {
+		return nil, ErrNegativeDimension
+	}
+	return newCPUMatrix(r, c), nil
+}
+
+// Download copies src into dst.
+func (CPU) Download(dst blas64.General, src DeviceMatrix) error {
+	s, err := cpuMatrixOf(src)
+	if err != nil {
+		return err
+	}
+	if dst.Rows != s.mat.Rows || dst.Cols != s.mat.Cols {
+		return ErrShape
+	}
+	for i := 0; i < dst.Rows; i++ {
+		copy(dst.Data[i*dst.Stride:i*dst.Stride+dst.Cols], s.mat.Data[i*s.mat.Stride:i*s.mat.Stride+dst.Cols])
+	}
+	return nil
+}
+
+// Free is a no-op for the CPU backend.
+func (CPU) Free(DeviceMatrix) {}
+
+// Gemm computes c = alpha * op(a) * op(b) + beta * c using blas64.Gemm.
+func (CPU) Gemm(tA, tB blas.Transpose, alpha float64, a, b DeviceMatrix, beta float64, c DeviceMatrix) error {
+	am, err := cpuMatrixOf(a)
+	if err != nil {
+		return err
+	}
+	bm, err := cpuMatrixOf(b)
+	if err != nil {
+		return err
+	}
+	cm, err := cpuMatrixOf(c)
+	if err != nil {
+		return err
+	}
+	m, k := am.mat.Rows, am.mat.Cols
+	if tA != blas.NoTrans {
+		m, k = k, m
+	}
+	bk, n := bm.mat.Rows, bm.mat.Cols
+	if tB != blas.NoTrans {
+		bk, n = n, bk
+	}
+	if k != bk || cm.mat.Rows != m || cm.mat.Cols != n {
+		return ErrShape
+	}
+	blas64.Gemm(tA, tB, alpha, am.mat, bm.mat, beta, cm.mat)
+	return nil
+}
+
+// Gesv solves a * x = b using lapack64.Getrf and lapack64.Getrs.
+func (CPU) Gesv(a, b DeviceMatrix) (ok bool, err error) {
+	am, err := cpuMatrixOf(a)
+	if err != nil {
+		return false, err
+	}
+	bm, err := cpuMatrixOf(b)
+	if err != nil {
+		return false, err
+	}
+	if am.mat.Rows != am.mat.Cols {
+		return false, ErrSquare
+	}
+	if bm.mat.Rows != am.mat.Rows {
+		return false, ErrShape
+	}
+	ipiv := getInts(am.mat.Rows, false)
+	defer putInts(ipiv)
+	if !lapack64.Getrf(am.mat, ipiv) {
+		return false, nil
+	}
+	lapack64.Getrs(blas.NoTrans, am.mat, bm.mat, ipiv)
+	return true, nil
+}
+
+func newCPUMatrix(r, c int) *cpuMatrix {
+	return &cpuMatrix{mat: blas64.General{
+		Rows:   r,
+		Cols:   c,
+		Stride: max(c, 1),
+		Data:   make([]float64, r*max(c, 1)),
+	}}
+}
+
+func cpuMatrixOf(d DeviceMatrix) (*cpuMatrix, error) {
+	m, ok := d.(*cpuMatrix)
+	if !ok {
+		return nil, errForeignDeviceMatrix
+	}
+	return m, nil
+}
@@ -0,0 +1,272 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"errors"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// countingBackend is a Backend that counts the operations performed by the
+// CPU backend and optionally fails them.
+type countingBackend struct {
+	CPU
+	fail bool
+
+	gemm, gesv int
+	live       int
+}
+
+var errBackendFailure = errors.New("backend failure")
+
+func (b *countingBackend) Upload(a blas64.General) (DeviceMatrix, error) {
+	b.live++
+	return b.CPU.Upload(a)
+}
+
+func (b *countingBackend) Alloc(r, c int) (DeviceMatrix, error) {
+	b.live++
+	return b.CPU.Alloc(r, c)
+}
+
+func (b *countingBackend) Free(d DeviceMatrix) {
+	b.live--
+	b.CPU.Free(d)
+}
+
+func (b *countingBackend) Gemm(tA, tB blas.Transpose, alpha float64, x, y DeviceMatrix, beta float64, c DeviceMatrix) error {
+	b.gemm++
+	if b.fail {
+		return errBackendFailure
+	}
+	return b.CPU.Gemm(tA, tB, alpha, x, y, beta, c)
+}
+
+func (b *countingBackend) Gesv(a, x DeviceMatrix) (bool, error) {
+	b.gesv++
+	if b.fail {
+		return false, errBackendFailure
+	}
+	return b.CPU.Gesv(a, x)
+}
+
+// The tests below set the package backend and so must not be run in
+// parallel with each other, but are safe to run with the parallel tests
+// of the package since those are started after all sequential tests.
+
+func TestBackendMul(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	defer UseBackend(nil, 0)
+	for _, fail := range []bool{false, true} {
+		be := &countingBackend{fail: fail}
+		UseBackend(be, 4)
+		for _, test := range []struct {
+			m, k, n int
+			aTrans  bool
+			bTrans  bool
+			device  bool
+		}{
+			{m: 3, k: 5, n: 6, device: false},
+			{m: 5, k: 4, n: 6, device: true},
+			{m: 6, k: 7, n: 5, aTrans: true, device: true},
+			{m: 6, k: 7, n: 5, bTrans: true, device: true},
+			{m: 8, k: 4, n: 9, aTrans: true, bTrans: true, device: true},
+		} {
+			a := randOperand(test.m, test.k, test.aTrans, rnd)
+			b := randOperand(test.k, test.n, test.bTrans, rnd)
+
+			var want Dense
+			UseBackend(nil, 0)
+			want.Mul(a, b)
+			UseBackend(be, 4)
+
+			gemm := be.gemm
+			var got Dense
+			got.Mul(a, b)
+			if !EqualApprox(&got, &want, 1e-12) {
+				t.Errorf("unexpected product for m=%d k=%d n=%d fail=%t", test.m, test.k, test.n, fail)
+			}
+			if used := be.gemm > gemm; used != test.device {
+				t.Errorf("unexpected backend use for m=%d k=%d n=%d: got %t, want %t", test.m, test.k, test.n, used, test.device)
+			}
+			if be.live != 0 {
+				t.Errorf("device memory leaked for m=%d k=%d n=%d: %d live matrices", test.m, test.k, test.n, be.live)
+			}
+		}
+	}
+}
+
+// randOperand returns a random r×c matrix, or the transpose of a random
+// c×r *Dense if trans is true.
+func randOperand(r, c int, trans bool, rnd *rand.Rand) Matrix {
+	if trans {
+		r, c = c, r
+	}
+	m := NewDense(r, c, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			m.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	if trans {
+		return m.T()
+	}
+	return m
+}
+
+func TestBackendSolve(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	defer UseBackend(nil, 0)
+	for _, fail := range []bool{false, true} {
+		be := &countingBackend{fail: fail}
+		UseBackend(be, 4)
+		for _, test := range []struct {
+			n, k   int
+			aTrans bool
+			device bool
+		}{
+			{n: 3, k: 5, device: false},
+			{n: 5, k: 2, device: false},
+			{n: 6, k: 4, device: true},
+			{n: 10, k: 5, aTrans: true, device: true},
+		} {
+			a := randOperand(test.n, test.n, test.aTrans, rnd)
+			b := randOperand(test.n, test.k, false, rnd)
+
+			var want Dense
+			UseBackend(nil, 0)
+			err := want.Solve(a, b)
+			UseBackend(be, 4)
+			if err != nil {
+				t.Fatalf("unexpected error from host solve: %v", err)
+			}
+
+			gesv := be.gesv
+			var got Dense
+			err = got.Solve(a, b)
+			if err != nil {
+				t.Errorf("unexpected error for n=%d k=%d fail=%t: %v", test.n, test.k, fail, err)
+			}
+			if !EqualApprox(&got, &want, 1e-10) {
+				t.Errorf("unexpected solution for n=%d k=%d fail=%t", test.n, test.k, fail)
+			}
+			if used := be.gesv > gesv; used != test.device {
+				t.Errorf("unexpected backend use for n=%d k=%d: got %t, want %t", test.n, test.k, used, test.device)
+			}
+			if be.live != 0 {
+				t.Errorf("device memory leaked for n=%d k=%d: %d live matrices", test.n, test.k, be.live)
+			}
+		}
+	}
+
+	// Singular and ill-conditioned systems report a Condition error
+	// when solved on the backend.
+	be := &countingBackend{}
+	UseBackend(be, 1)
+	singular := NewDense(3, 3, []float64{
+		1, 2, 3,
+		2, 4, 6,
+		1, 0, 1,
+	})
+	var x Dense
+	err := x.Solve(singular, eye(3))
+	if _, ok := err.(Condition); !ok || be.gesv != 1 {
+		t.Errorf("expected Condition error from backend for singular matrix, got %v", err)
+	}
+	hilbert := NewDense(14, 14, nil)
+	for i := 0; i < 14; i++ {
+		for j := 0; j < 14; j++ {
+			hilbert.Set(i, j, 1/float64(i+j+1))
+		}
+	}
+	var y Dense
+	err = y.Solve(hilbert, eye(14))
+	if _, ok := err.(Condition); !ok || be.gesv != 2 {
+		t.Errorf("expected Condition error from backend for ill-conditioned matrix, got %v", err)
+	}
+}
+
+func TestCPUBackend(t *testing.T) {
+	t.Parallel()
+	var cpu CPU
+	a := NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6})
+	// Upload a strided view to check that the stride is respected.
+	big := NewDense(3, 4, []float64{
+		1, 2, 3, 0,
+		4, 5, 6, 0,
+		7, 8, 9, 0,
+	})
+	b := big.Slice(0, 3, 0, 3).(*Dense)
+
+	da, err := cpu.Upload(a.mat)
+	if err != nil {
+		t.Fatalf("unexpected upload error: %v", err)
+	}
+	db, err := cpu.Upload(b.mat)
+	if err != nil {
+		t.Fatalf("unexpected upload error: %v", err)
+	}
+	dc, err := cpu.Alloc(2, 3)
+	if err != nil {
+		t.Fatalf("unexpected alloc error: %v", err)
+	}
+	if r, c := dc.Dims(); r != 2 || c != 3 {
+		t.Errorf("unexpected dimensions: got %d×%d, want 2×3", r, c)
+	}
+	err = cpu.Gemm(blas.NoTrans, blas.NoTrans, 1, da, db, 0, dc)
+	if err != nil {
+		t.Fatalf("unexpected Gemm error: %v", err)
+	}
+	got := NewDense(2, 3, nil)
+	err = cpu.Download(got.mat, dc)
+	if err != nil {
+		t.Fatalf("unexpected download error: %v", err)
+	}
+	var want Dense
+	want.Mul(a, b)
+	if !Equal(got, &want) {
+		t.Errorf("unexpected product:\ngot  %v\nwant %v", Formatted(got), Formatted(&want))
+	}
+
+	if err := cpu.Gemm(blas.NoTrans, blas.NoTrans, 1, db, da, 0, dc); err != ErrShape {
+		t.Errorf("expected ErrShape for mismatched Gemm, got %v", err)
+	}
+	if err := cpu.Download(NewDense(3, 2, nil).mat, dc); err != ErrShape {
+		t.Errorf("expected ErrShape for mismatched Download, got %v", err)
+	}
+	if _, err := cpu.Gesv(da, dc); err != ErrSquare {
+		t.Errorf("expected ErrSquare for non-square Gesv, got %v", err)
+	}
+	if _, err := cpu.Gesv(foreignMatrix{}, dc); err == nil {
+		t.Error("expected error for foreign device matrix")
+	}
+}
+
+type foreignMatrix struct{}
+
+func (foreignMatrix) Dims() (r, c int) { return 1, 1 }
+
+func TestUseBackend(t *testing.T) {
+	defer UseBackend(nil, 0)
+	be := &countingBackend{}
+	UseBackend(be, 16)
+	got, minDim := CurrentBackend()
+	if got != be || minDim != 16 {
+		t.Errorf("unexpected current backend: got %v %d", got, minDim)
+	}
+	UseBackend(nil, 0)
+	if got, _ := CurrentBackend(); got != nil {
+		t.Errorf("unexpected current backend after reset: got %v", got)
+	}
+	panicked, message := panics(func() { UseBackend(be, -1) })
+	if !panicked || message != ErrNegativeDimension.Error() {
+		t.Errorf("expected panic for negative minimum dimension, got %q", message)
+	}
+}
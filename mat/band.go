@@ -5,6 +5,8 @@
 package mat
 
 import (
+	"math"
+
 	"gonum.org/v1/gonum/blas"
 	"gonum.org/v1/gonum/blas/blas64"
 	"gonum.org/v1/gonum/lapack"
@@ -298,6 +300,7 @@ func (b *BandDense) Zero() {
 //	1 - The maximum absolute column sum
 //	2 - The Frobenius norm, the square root of the sum of the squares of the elements
 //	Inf - The maximum absolute row sum
+//	MaxAbsNorm - The maximum absolute value of the elements
 //
 // Norm will panic with ErrNormOrder if an illegal norm is specified and with
 // ErrZeroLength if the matrix has zero size.
@@ -366,3 +369,132 @@ func (b *BandDense) MulVecTo(dst *VecDense, trans bool, x Vector) {
 		putVecDenseWorkspace(xCopy)
 	}
 }
+
+// SolveTo solves a band system A⋅X = B  or  Aᵀ⋅X = B where A is an n×n band
+// matrix represented by the receiver and B is a given n×nrhs matrix. The system
+// is solved using an LU factorization with partial pivoting that preserves the
+// band structure of A, so the cost is O(n⋅kl⋅(kl+ku)) rather than O(n³). If A
+// is non-singular, the result will be stored into dst and nil will be returned.
+// If A is singular, the contents of dst will be undefined and a Condition error
+// will be returned.
+//
+// If A is not square, SolveTo finds the least squares or minimum norm solution
+// using a dense factorization of A as described for Dense.Solve.
+func (b *BandDense) SolveTo(dst *Dense, trans bool, rhs Matrix) error {
+	r, c := b.Dims()
+	if r != c {
+		var a Dense
+		a.CloneFrom(b)
+		if trans {
+			return dst.Solve(a.T(), rhs)
+		}
+		return dst.Solve(&a, rhs)
+	}
+	n, nrhs := rhs.Dims()
+	if n != r {
+		panic(ErrShape)
+	}
+
+	dst.reuseAsNonZeroed(n, nrhs)
+	bU, bTrans := untranspose(rhs)
+	if dst == bU {
+		if bTrans {
+			work := getDenseWorkspace(n, nrhs, false)
+			defer putDenseWorkspace(work)
+			work.Copy(rhs)
+			dst.Copy(work)
+		}
+	} else {
+		if rm, ok := bU.(RawMatrixer); ok {
+			dst.checkOverlap(rm.RawMatrix())
+		}
+		dst.Copy(rhs)
+	}
+
+	if !b.solveLU(trans, dst.mat) {
+		return Condition(math.Inf(1))
+	}
+	return nil
+}
+
+// SolveVecTo solves a band system A⋅x = b  or  Aᵀ⋅x = b where A is an n×n band
+// matrix represented by the receiver and b is a given n-vector. The system is
+// solved as described for SolveTo. If A is non-singular, the result will be
+// stored into dst and nil will be returned. If A is singular, the contents of
+// dst will be undefined and a Condition error will be returned.
+//
+// If A is not square, SolveVecTo finds the least squares or minimum norm
+// solution using a dense factorization of A as described for Dense.Solve.
+func (b *BandDense) SolveVecTo(dst *VecDense, trans bool, rhs Vector) error {
+	r, c := b.Dims()
+	if r != c {
+		var a Dense
+		a.CloneFrom(b)
+		if trans {
+			return dst.SolveVec(a.T(), rhs)
+		}
+		return dst.SolveVec(&a, rhs)
+	}
+	n, nrhs := rhs.Dims()
+	if n != r || nrhs != 1 {
+		panic(ErrShape)
+	}
+	if rv, ok := rhs.(RawVectorer); ok && dst != rhs {
+		dst.checkOverlap(rv.RawVector())
+	}
+	dst.reuseAsNonZeroed(n)
+	if dst != rhs {
+		dst.CopyVec(rhs)
+	}
+	if !b.solveLU(trans, dst.asGeneral()) {
+		return Condition(math.Inf(1))
+	}
+	return nil
+}
+
+// solveLU overwrites x with the solution of A⋅X = B or Aᵀ⋅X = B, where A is
+// the square receiver, using a temporary LU factorization of A. It returns
+// whether A is non-singular.
+func (b *BandDense) solveLU(trans bool, x blas64.General) (ok bool) {
+	n := b.mat.Rows
+	kl, ku := b.mat.KL, b.mat.KU
+	lu := newBandLUWorkspace(n, kl, ku)
+	defer putFloat64s(lu.Data)
+	for i := 0; i < n; i++ {
+		copy(lu.Data[i*lu.Stride:i*lu.Stride+kl+ku+1], b.mat.Data[i*b.mat.Stride:])
+	}
+	return solveBandLU(trans, lu, x)
+}
+
+// newBandLUWorkspace returns a blas64.Band with pooled storage for the LU
+// factorization of an n×n band matrix with kl subdiagonals and ku
+// superdiagonals, including room for the fill-in. The Data field must be
+// returned to the pool with putFloat64s.
+func newBandLUWorkspace(n, kl, ku int) blas64.Band {
+	stride := 2*kl + ku + 1
+	return blas64.Band{
+		Rows:   n,
+		Cols:   n,
+		KL:     kl,
+		KU:     kl + ku,
+		Stride: stride,
+		Data:   getFloat64s(n*stride, false),
+	}
+}
+
+// solveBandLU factorizes the band matrix held in lu, as returned by
+// newBandLUWorkspace, and overwrites x with the solution of A⋅X = B or
+// Aᵀ⋅X = B. It returns whether A is non-singular.
+func solveBandLU(trans bool, lu blas64.Band, x blas64.General) (ok bool) {
+	ipiv := getInts(lu.Rows, false)
+	defer putInts(ipiv)
+	if !lapack64.Gbtrf(lu, ipiv) {
+		return false
+	}
+	t := blas.NoTrans
+	if trans {
+		t = blas.Trans
+	}
+	lapack64.Gbtrs(t, lu, ipiv, x)
+	return true
+}
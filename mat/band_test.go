@@ -5,9 +5,12 @@
 package mat
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
+	"golang.org/x/exp/rand"
+
 	"gonum.org/v1/gonum/blas/blas64"
 )
 
@@ -457,3 +460,124 @@ func (b bandImplicit) At(i, j int) float64 {
 	}
 	return b.val(i, j)
 }
+
+func TestBandDenseSolveTo(t *testing.T) {
+	t.Parallel()
+
+	const tol = 1e-14
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 31} {
+		for _, kl := range []int{0, 1, 2, n + 1} {
+			for _, ku := range []int{0, 1, 3, n + 1} {
+				kl, ku := min(kl, n-1), min(ku, n-1)
+				a := NewBandDense(n, n, kl, ku, nil)
+				for i := 0; i < n; i++ {
+					for j := max(0, i-kl); j <= min(n-1, i+ku); j++ {
+						a.SetBand(i, j, rnd.NormFloat64())
+					}
+				}
+				var op Matrix = a
+				for _, trans := range []bool{false, true} {
+					if trans {
+						op = a.T()
+					}
+					for _, nrhs := range []int{1, 3} {
+						for _, bIsDst := range []bool{false, true} {
+							name := fmt.Sprintf("n=%d,kl=%d,ku=%d,nrhs=%d,trans=%t,bIsDst=%t", n, kl, ku, nrhs, trans, bIsDst)
+
+							b := NewDense(n, nrhs, nil)
+							for i := 0; i < n; i++ {
+								for j := 0; j < nrhs; j++ {
+									b.Set(i, j, rnd.NormFloat64())
+								}
+							}
+							var bCopy Dense
+							bCopy.CloneFrom(b)
+
+							dst := new(Dense)
+							if bIsDst {
+								dst = b
+							}
+							err := a.SolveTo(dst, trans, b)
+							if err != nil {
+								t.Fatalf("%v: unexpected failure from BandDense.SolveTo: %v", name, err)
+							}
+							if resid := bandResidual(op, dst, &bCopy); resid > tol*float64(n) {
+								t.Errorf("%v: unexpected residual of SolveTo: %v", name, resid)
+							}
+
+							var x VecDense
+							err = a.SolveVecTo(&x, trans, bCopy.ColView(0))
+							if err != nil {
+								t.Fatalf("%v: unexpected failure from BandDense.SolveVecTo: %v", name, err)
+							}
+							if resid := bandResidual(op, &x, bCopy.ColView(0)); resid > tol*float64(n) {
+								t.Errorf("%v: unexpected residual of SolveVecTo: %v", name, resid)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	// A singular band matrix.
+	a := NewBandDense(3, 3, 1, 1, []float64{
+		0, 1, 2,
+		2, 4, 0,
+		1, 1, 0,
+	})
+	var x Dense
+	err := a.SolveTo(&x, false, NewDense(3, 1, []float64{1, 2, 3}))
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("expected Condition error for singular matrix, got:%v", err)
+	}
+
+	// Non-square band matrices give the least squares solution.
+	for _, trans := range []bool{false, true} {
+		a := NewBandDense(5, 3, 2, 1, nil)
+		for i := 0; i < 5; i++ {
+			for j := max(0, i-2); j <= min(2, i+1); j++ {
+				a.SetBand(i, j, rnd.NormFloat64())
+			}
+		}
+		var aDense Dense
+		aDense.CloneFrom(a)
+		var op Matrix = &aDense
+		n := 5
+		if trans {
+			op = aDense.T()
+			n = 3
+		}
+		b := NewDense(n, 2, nil)
+		for i := 0; i < n; i++ {
+			b.Set(i, 0, rnd.NormFloat64())
+			b.Set(i, 1, rnd.NormFloat64())
+		}
+		var got, want Dense
+		err := a.SolveTo(&got, trans, b)
+		if err != nil {
+			t.Fatalf("unexpected failure from non-square BandDense.SolveTo with trans=%t: %v", trans, err)
+		}
+		err = want.Solve(op, b)
+		if err != nil {
+			t.Fatalf("unexpected failure from Dense.Solve with trans=%t: %v", trans, err)
+		}
+		if !EqualApprox(&got, &want, 1e-14) {
+			t.Errorf("unexpected non-square solution with trans=%t:\ngot: %v\nwant:%v", trans, Formatted(&got), Formatted(&want))
+		}
+	}
+}
+
+// bandResidual returns the relative residual
+//
+//	|A⋅X - B|_1 / (|A|_1 |X|_1)
+//
+// of the solution X of a linear system A⋅X = B.
+func bandResidual(a, x, b Matrix) float64 {
+	var r Dense
+	r.Mul(a, x)
+	r.Sub(&r, b)
+	return Norm(&r, 1) / (Norm(a, 1) * Norm(x, 1))
+}
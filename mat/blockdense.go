@@ -0,0 +1,397 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import "sort"
+
+var (
+	blockDense *BlockDense
+	_          Matrix = blockDense
+)
+
+// BlockDense represents a matrix composed of a grid of blocks, each of which
+// is a Matrix. A nil block represents a zero block. The blocks in each block
+// row have the same number of rows and the blocks in each block column have
+// the same number of columns.
+//
+// The blocks are held by reference, so changes to the elements of a block
+// are reflected in the BlockDense. BlockDense is useful for assembling
+// structured systems such as the saddle point system
+//
+//	[A  Bᵀ]
+//	[B  0 ]
+//
+// without copying. Products with a BlockDense are computed block by block,
+// skipping zero blocks.
+type BlockDense struct {
+	blocks [][]Matrix
+
+	// rowOff and colOff hold the offsets of the block rows and block
+	// columns. They have one more element than the grid dimensions,
+	// the last holding the dimensions of the matrix.
+	rowOff, colOff []int
+}
+
+// NewBlockDense returns a new BlockDense with the given grid of blocks, where
+// blocks[i][j] is the block in block row i and block column j. The sizes of
+// the block rows and block columns are determined by the non-nil blocks; each
+// block row and block column must contain at least one non-nil block. The
+// grid is copied but the blocks are not.
+//
+// NewBlockDense will panic if the grid is empty or not rectangular, if the
+// dimensions of the blocks are inconsistent or if the size of a block row or
+// block column cannot be determined.
+func NewBlockDense(blocks [][]Matrix) *BlockDense {
+	if len(blocks) == 0 || len(blocks[0]) == 0 {
+		panic(ErrZeroLength)
+	}
+	br, bc := len(blocks), len(blocks[0])
+	rows := make([]int, br)
+	cols := make([]int, bc)
+	for i := range rows {
+		rows[i] = -1
+	}
+	for j := range cols {
+		cols[j] = -1
+	}
+	grid := make([][]Matrix, br)
+	for i, row := range blocks {
+		if len(row) != bc {
+			panic(ErrRowLength)
+		}
+		grid[i] = make([]Matrix, bc)
+		copy(grid[i], row)
+		for j, b := range row {
+			if b == nil {
+				continue
+			}
+			r, c := b.Dims()
+			if rows[i] < 0 {
+				rows[i] = r
+			} else if rows[i] != r {
+				panic(ErrShape)
+			}
+			if cols[j] < 0 {
+				cols[j] = c
+			} else if cols[j] != c {
+				panic(ErrShape)
+			}
+		}
+	}
+	for _, r := range rows {
+		if r < 0 {
+			panic(badBlockSize)
+		}
+	}
+	for _, c := range cols {
+		if c < 0 {
+			panic(badBlockSize)
+		}
+	}
+	return &BlockDense{
+		blocks: grid,
+		rowOff: offsets(rows),
+		colOff: offsets(cols),
+	}
+}
+
+const badBlockSize = "mat: block row or column has no non-nil block"
+
+// offsets returns the cumulative sums of sizes starting from zero.
+func offsets(sizes []int) []int {
+	off := make([]int, len(sizes)+1)
+	for i, s := range sizes {
+		off[i+1] = off[i] + s
+	}
+	return off
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (b *BlockDense) Dims() (r, c int) {
+	return b.rowOff[len(b.rowOff)-1], b.colOff[len(b.colOff)-1]
+}
+
+// Grid returns the number of block rows and block columns.
+func (b *BlockDense) Grid() (r, c int) {
+	return len(b.rowOff) - 1, len(b.colOff) - 1
+}
+
+// Partition returns the number of rows in each block row and the number of
+// columns in each block column.
+func (b *BlockDense) Partition() (rows, cols []int) {
+	rows = make([]int, len(b.rowOff)-1)
+	for i := range rows {
+		rows[i] = b.rowOff[i+1] - b.rowOff[i]
+	}
+	cols = make([]int, len(b.colOff)-1)
+	for j := range cols {
+		cols[j] = b.colOff[j+1] - b.colOff[j]
+	}
+	return rows, cols
+}
+
+// Block returns the block in block row i and block column j. Block returns
+// nil for a zero block.
+func (b *BlockDense) Block(i, j int) Matrix {
+	br, bc := b.Grid()
+	if uint(i) >= uint(br) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(bc) {
+		panic(ErrColAccess)
+	}
+	return b.blocks[i][j]
+}
+
+// SetBlock replaces the block in block row i and block column j with a, which
+// may be nil to indicate a zero block. SetBlock will panic if a does not
+// have the dimensions of the block it replaces.
+func (b *BlockDense) SetBlock(i, j int, a Matrix) {
+	br, bc := b.Grid()
+	if uint(i) >= uint(br) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(bc) {
+		panic(ErrColAccess)
+	}
+	if a != nil {
+		r, c := a.Dims()
+		if r != b.rowOff[i+1]-b.rowOff[i] || c != b.colOff[j+1]-b.colOff[j] {
+			panic(ErrShape)
+		}
+	}
+	b.blocks[i][j] = a
+}
+
+// At returns the element at row i, column j.
+func (b *BlockDense) At(i, j int) float64 {
+	r, c := b.Dims()
+	if uint(i) >= uint(r) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(c) {
+		panic(ErrColAccess)
+	}
+	bi := sort.SearchInts(b.rowOff, i+1) - 1
+	bj := sort.SearchInts(b.colOff, j+1) - 1
+	blk := b.blocks[bi][bj]
+	if blk == nil {
+		return 0
+	}
+	return blk.At(i-b.rowOff[bi], j-b.colOff[bj])
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (b *BlockDense) T() Matrix {
+	return Transpose{b}
+}
+
+// MulVecTo computes B⋅x or Bᵀ⋅x storing the result into dst. The products
+// are computed block by block, skipping zero blocks.
+func (b *BlockDense) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	v := blockView{b: b, trans: trans}
+	br, bc := v.grid()
+	rowOff, colOff := v.offsets()
+	if x.Len() != colOff[bc] {
+		panic(ErrShape)
+	}
+	xv, ok := x.(*VecDense)
+	if !ok || xv == dst {
+		xv = NewVecDense(x.Len(), nil)
+		xv.CloneFromVec(x)
+	}
+	dst.reuseAsNonZeroed(rowOff[br])
+	dst.Zero()
+	var tmp VecDense
+	for i := 0; i < br; i++ {
+		di := dst.sliceVec(rowOff[i], rowOff[i+1])
+		for j := 0; j < bc; j++ {
+			blk := v.block(i, j)
+			if blk == nil {
+				continue
+			}
+			tmp.Reset()
+			tmp.MulVec(blk, xv.sliceVec(colOff[j], colOff[j+1]))
+			di.AddVec(di, &tmp)
+		}
+	}
+}
+
+// copyTo copies the r×c top-left part of op(B) into m, where op(B) is B if
+// trans is false and Bᵀ otherwise.
+func (b *BlockDense) copyTo(m *Dense, trans bool, r, c int) {
+	v := blockView{b: b, trans: trans}
+	br, bc := v.grid()
+	rowOff, colOff := v.offsets()
+	for i := 0; i < br && rowOff[i] < r; i++ {
+		for j := 0; j < bc && colOff[j] < c; j++ {
+			sub := m.slice(rowOff[i], min(rowOff[i+1], r), colOff[j], min(colOff[j+1], c))
+			blk := v.block(i, j)
+			if blk == nil {
+				sub.Zero()
+				continue
+			}
+			sub.Copy(blk)
+		}
+	}
+}
+
+// mulBlock computes op(A)⋅op(B) into the receiver, where at least one of the
+// underlying matrices of a and b is a BlockDense. The receiver must have the
+// correct dimensions and must not overlap with a or b.
+func (m *Dense) mulBlock(a, b Matrix) {
+	m.Zero()
+	aU, aTrans := untransposeExtract(a)
+	bU, bTrans := untransposeExtract(b)
+	aB, aIsBlock := aU.(*BlockDense)
+	bB, bIsBlock := bU.(*BlockDense)
+	var tmp Dense
+	switch {
+	case aIsBlock && bIsBlock && equalInts(blockView{b: aB, trans: aTrans}.colOffsets(), blockView{b: bB, trans: bTrans}.rowOffsets()):
+		// Conforming partitions, C_ik = Σ_j A_ij⋅B_jk.
+		av := blockView{b: aB, trans: aTrans}
+		bv := blockView{b: bB, trans: bTrans}
+		ar, ac := av.grid()
+		_, bc := bv.grid()
+		rowOff := av.rowOffsets()
+		colOff := bv.colOffsets()
+		for i := 0; i < ar; i++ {
+			for k := 0; k < bc; k++ {
+				sub := m.slice(rowOff[i], rowOff[i+1], colOff[k], colOff[k+1])
+				for j := 0; j < ac; j++ {
+					x, y := av.block(i, j), bv.block(j, k)
+					if x == nil || y == nil {
+						continue
+					}
+					tmp.Reset()
+					tmp.Mul(x, y)
+					sub.Add(sub, &tmp)
+				}
+			}
+		}
+	case aIsBlock:
+		// C_i = Σ_j A_ij⋅B[rows j].
+		av := blockView{b: aB, trans: aTrans}
+		ar, ac := av.grid()
+		rowOff, colOff := av.offsets()
+		bd := sliceable(b)
+		_, bc := b.Dims()
+		for i := 0; i < ar; i++ {
+			sub := m.slice(rowOff[i], rowOff[i+1], 0, bc)
+			for j := 0; j < ac; j++ {
+				x := av.block(i, j)
+				if x == nil {
+					continue
+				}
+				tmp.Reset()
+				tmp.Mul(x, bd.Slice(colOff[j], colOff[j+1], 0, bc))
+				sub.Add(sub, &tmp)
+			}
+		}
+	default:
+		// C[cols k] = Σ_j A[cols j]⋅B_jk.
+		bv := blockView{b: bB, trans: bTrans}
+		br, bc := bv.grid()
+		rowOff, colOff := bv.offsets()
+		ad := sliceable(a)
+		ar, _ := a.Dims()
+		for k := 0; k < bc; k++ {
+			sub := m.slice(0, ar, colOff[k], colOff[k+1])
+			for j := 0; j < br; j++ {
+				y := bv.block(j, k)
+				if y == nil {
+					continue
+				}
+				tmp.Reset()
+				tmp.Mul(ad.Slice(0, ar, rowOff[j], rowOff[j+1]), y)
+				sub.Add(sub, &tmp)
+			}
+		}
+	}
+}
+
+// sliceable returns a representation of a that can be sliced, copying a into
+// a new Dense if necessary.
+func sliceable(a Matrix) slicer {
+	switch a := a.(type) {
+	case *Dense:
+		return a
+	case Transpose:
+		if d, ok := a.Matrix.(*Dense); ok {
+			return transposedSlicer{d}
+		}
+	}
+	return DenseCopyOf(a)
+}
+
+// slicer is a matrix that can be sliced.
+type slicer interface {
+	Slice(i, k, j, l int) Matrix
+}
+
+// transposedSlicer is a transposed Dense that can be sliced.
+type transposedSlicer struct {
+	d *Dense
+}
+
+func (t transposedSlicer) Slice(i, k, j, l int) Matrix {
+	return t.d.Slice(j, l, i, k).T()
+}
+
+// blockView is a possibly transposed BlockDense.
+type blockView struct {
+	b     *BlockDense
+	trans bool
+}
+
+func (v blockView) grid() (r, c int) {
+	r, c = v.b.Grid()
+	if v.trans {
+		return c, r
+	}
+	return r, c
+}
+
+func (v blockView) offsets() (rowOff, colOff []int) {
+	return v.rowOffsets(), v.colOffsets()
+}
+
+func (v blockView) rowOffsets() []int {
+	if v.trans {
+		return v.b.colOff
+	}
+	return v.b.rowOff
+}
+
+func (v blockView) colOffsets() []int {
+	if v.trans {
+		return v.b.rowOff
+	}
+	return v.b.colOff
+}
+
+func (v blockView) block(i, j int) Matrix {
+	if v.trans {
+		blk := v.b.blocks[j][i]
+		if blk == nil {
+			return nil
+		}
+		return blk.T()
+	}
+	return v.b.blocks[i][j]
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, v := range a {
+		if v != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,181 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func randBlock(r, c int, rnd *rand.Rand) *Dense {
+	m := NewDense(r, c, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			m.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	return m
+}
+
+// newTestBlockDense returns a BlockDense with the given partition whose blocks
+// are of various types, with some zero blocks.
+func newTestBlockDense(rows, cols []int, rnd *rand.Rand) *BlockDense {
+	grid := make([][]Matrix, len(rows))
+	for i, r := range rows {
+		grid[i] = make([]Matrix, len(cols))
+		for j, c := range cols {
+			switch {
+			case (i+j)%3 == 1 && i != 0 && j != 0:
+				// Zero block.
+			case r == c && (i+j)%2 == 0:
+				s := NewSymDense(r, nil)
+				for k := 0; k < r; k++ {
+					for l := k; l < r; l++ {
+						s.SetSym(k, l, rnd.NormFloat64())
+					}
+				}
+				grid[i][j] = s
+			case (i+j)%4 == 3:
+				grid[i][j] = randBlock(c, r, rnd).T()
+			default:
+				grid[i][j] = randBlock(r, c, rnd)
+			}
+		}
+	}
+	return NewBlockDense(grid)
+}
+
+func TestBlockDense(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	rows := []int{2, 3, 1}
+	cols := []int{3, 2, 2, 1}
+	b := newTestBlockDense(rows, cols, rnd)
+	if r, c := b.Dims(); r != 6 || c != 8 {
+		t.Fatalf("unexpected dimensions: got %d×%d, want 6×8", r, c)
+	}
+	if r, c := b.Grid(); r != 3 || c != 4 {
+		t.Fatalf("unexpected grid: got %d×%d, want 3×4", r, c)
+	}
+	gotRows, gotCols := b.Partition()
+	if !equalInts(gotRows, rows) || !equalInts(gotCols, cols) {
+		t.Errorf("unexpected partition: got %v %v, want %v %v", gotRows, gotCols, rows, cols)
+	}
+
+	// Build the expected dense matrix by hand.
+	want := NewDense(6, 8, nil)
+	for i, r0 := range offsets(rows)[:len(rows)] {
+		for j, c0 := range offsets(cols)[:len(cols)] {
+			blk := b.Block(i, j)
+			if blk == nil {
+				continue
+			}
+			want.Slice(r0, r0+rows[i], c0, c0+cols[j]).(*Dense).Copy(blk)
+		}
+	}
+	for i := 0; i < 6; i++ {
+		for j := 0; j < 8; j++ {
+			if b.At(i, j) != want.At(i, j) {
+				t.Errorf("unexpected element (%d,%d): got %v, want %v", i, j, b.At(i, j), want.At(i, j))
+			}
+		}
+	}
+	if !Equal(DenseCopyOf(b), want) {
+		t.Errorf("unexpected result of Copy")
+	}
+	if !Equal(DenseCopyOf(b.T()), want.T()) {
+		t.Errorf("unexpected result of transposed Copy")
+	}
+	// Partial copies into a smaller receiver.
+	small := NewDense(4, 3, nil)
+	small.Copy(b)
+	if !Equal(small, want.Slice(0, 4, 0, 3)) {
+		t.Errorf("unexpected result of partial Copy")
+	}
+
+	// Blocks are held by reference.
+	blk := b.Block(0, 0).(*Dense)
+	blk.Set(1, 0, 42)
+	if b.At(1, 0) != 42 {
+		t.Errorf("block change not reflected")
+	}
+	b.SetBlock(0, 0, nil)
+	if b.At(1, 0) != 0 {
+		t.Errorf("zero block not reflected")
+	}
+
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "empty", fn: func() { NewBlockDense(nil) }},
+		{name: "ragged", fn: func() { NewBlockDense([][]Matrix{{NewDense(1, 1, nil)}, {NewDense(1, 1, nil), nil}}) }},
+		{name: "row mismatch", fn: func() { NewBlockDense([][]Matrix{{NewDense(1, 1, nil), NewDense(2, 1, nil)}}) }},
+		{name: "col mismatch", fn: func() { NewBlockDense([][]Matrix{{NewDense(1, 1, nil)}, {NewDense(1, 2, nil)}}) }},
+		{name: "unsized", fn: func() { NewBlockDense([][]Matrix{{NewDense(1, 1, nil), nil}}) }},
+		{name: "set shape", fn: func() { b.SetBlock(0, 0, NewDense(3, 3, nil)) }},
+	} {
+		if ok, _ := panics(test.fn); !ok {
+			t.Errorf("%s: expected panic", test.name)
+		}
+	}
+}
+
+func TestBlockDenseMul(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	a := newTestBlockDense([]int{2, 3, 1}, []int{3, 2, 2}, rnd)
+	conf := newTestBlockDense([]int{3, 2, 2}, []int{1, 4}, rnd)
+	nonconf := newTestBlockDense([]int{4, 3}, []int{2, 3}, rnd)
+	nested := NewBlockDense([][]Matrix{{a, nil}, {randBlock(3, 7, rnd), NewDiagDense(3, []float64{1, 2, 3})}})
+	d := randBlock(7, 5, rnd)
+	left := randBlock(4, 6, rnd)
+	for _, test := range []struct {
+		name string
+		a, b Matrix
+	}{
+		{name: "block×dense", a: a, b: d},
+		{name: "dense×block", a: left, b: a},
+		{name: "blockᵀ×dense", a: a.T(), b: randBlock(6, 3, rnd)},
+		{name: "dense×blockᵀ", a: randBlock(3, 7, rnd), b: a.T()},
+		{name: "block×denseᵀ", a: a, b: randBlock(5, 7, rnd).T()},
+		{name: "block×sym", a: a, b: NewSymDense(7, nil)},
+		{name: "block×block conforming", a: a, b: conf},
+		{name: "block×block nonconforming", a: a, b: nonconf},
+		{name: "blockᵀ×block", a: a.T(), b: newTestBlockDense([]int{2, 3, 1}, []int{2, 2}, rnd)},
+		{name: "block×blockᵀ", a: a, b: a.T()},
+		{name: "nested", a: nested, b: randBlock(10, 2, rnd)},
+	} {
+		var got, want Dense
+		got.Mul(test.a, test.b)
+		want.Mul(DenseCopyOf(test.a), DenseCopyOf(test.b))
+		if !EqualApprox(&got, &want, tol) {
+			t.Errorf("%s: unexpected Mul result:\ngot: %v\nwant:%v", test.name, Formatted(&got), Formatted(&want))
+		}
+	}
+
+	for _, trans := range []bool{false, true} {
+		name := fmt.Sprintf("trans=%t", trans)
+		var aMat, wantMat Matrix = nested, DenseCopyOf(nested)
+		n := 10
+		if trans {
+			aMat, wantMat = nested.T(), wantMat.T()
+			n = 9
+		}
+		x := NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			x.SetVec(i, rnd.NormFloat64())
+		}
+		var got, want VecDense
+		got.MulVec(aMat, x)
+		want.MulVec(wantMat, x)
+		if !EqualApprox(&got, &want, tol) {
+			t.Errorf("%s: unexpected MulVec result", name)
+		}
+	}
+}
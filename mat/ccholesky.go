@@ -0,0 +1,254 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+var _ CMatrix = (*CCholesky)(nil)
+
+// CCholesky is a Hermitian positive definite matrix represented by its
+// Cholesky decomposition
+//
+//	A = Uᴴ * U
+//
+// where U is an upper triangular matrix with a real positive diagonal.
+//
+// The decomposition can be constructed using the Factorize method. The
+// factorization itself can be extracted using the UTo method.
+type CCholesky struct {
+	// The chol pointer must never be retained as a pointer outside the
+	// CCholesky struct. Only the upper triangle of chol is referenced.
+	chol *CDense
+	cond float64
+}
+
+// Dims returns the dimensions of the matrix.
+func (c *CCholesky) Dims() (r, cols int) {
+	if !c.valid() {
+		return 0, 0
+	}
+	n := c.chol.mat.Rows
+	return n, n
+}
+
+// At returns the element at row i, column j.
+func (c *CCholesky) At(i, j int) complex128 {
+	n, _ := c.Dims()
+	if uint(i) >= uint(n) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(n) {
+		panic(ErrColAccess)
+	}
+
+	var val complex128
+	for k := 0; k <= min(i, j); k++ {
+		val += cmplx.Conj(c.chol.at(k, i)) * c.chol.at(k, j)
+	}
+	return val
+}
+
+// H returns the receiver, the conjugate transpose of a Hermitian matrix.
+func (c *CCholesky) H() CMatrix {
+	return c
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// CTranspose.
+func (c *CCholesky) T() CMatrix {
+	return CTranspose{c}
+}
+
+// Factorize calculates the Cholesky decomposition of the Hermitian matrix A
+// and returns whether the matrix is positive definite. Only the upper triangle
+// of A is referenced and the imaginary parts of its diagonal are assumed to be
+// zero. If Factorize returns false, the factorization must not be used.
+//
+// Factorize will panic if A is not square.
+func (c *CCholesky) Factorize(a CMatrix) (ok bool) {
+	n, ac := a.Dims()
+	if n != ac {
+		panic(ErrSquare)
+	}
+	if c.chol == nil {
+		c.chol = &CDense{}
+	}
+	c.chol.Reset()
+	c.chol.reuseAsZeroed(n, n)
+	u := c.chol.mat
+	for i := 0; i < n; i++ {
+		u.Data[i*u.Stride+i] = complex(real(a.At(i, i)), 0)
+		for j := i + 1; j < n; j++ {
+			u.Data[i*u.Stride+j] = a.At(i, j)
+		}
+	}
+	anorm := cnorm1(n, n, c.hermAt)
+
+	for j := 0; j < n; j++ {
+		// Compute U[j,j] and test for positive definiteness.
+		col := cblas128.Vector{N: j, Inc: u.Stride, Data: u.Data[j:]}
+		ujj := real(u.Data[j*u.Stride+j])
+		if j > 0 {
+			ujj -= real(cblas128.Dotc(col, col))
+		}
+		if ujj <= 0 || math.IsNaN(ujj) {
+			c.Reset()
+			return false
+		}
+		ujj = math.Sqrt(ujj)
+		u.Data[j*u.Stride+j] = complex(ujj, 0)
+
+		// Compute the remainder of row j of U.
+		if j == n-1 {
+			break
+		}
+		row := cblas128.Vector{N: n - j - 1, Inc: 1, Data: u.Data[j*u.Stride+j+1:]}
+		for k := 0; k < j; k++ {
+			ukj := cmplx.Conj(u.Data[k*u.Stride+j])
+			cblas128.Axpy(-ukj, cblas128.Vector{N: n - j - 1, Inc: 1, Data: u.Data[k*u.Stride+j+1:]}, row)
+		}
+		cblas128.Dscal(1/ujj, row)
+	}
+
+	ainvnm := cnormEst1(n, func(x []complex128, _ bool) {
+		// A is Hermitian, so A⁻ᴴ = A⁻¹.
+		c.solveVec(x)
+	})
+	c.cond = anorm * ainvnm
+	return true
+}
+
+// hermAt returns the element at row i, column j of the Hermitian matrix
+// stored in the upper triangle of c.chol.
+func (c *CCholesky) hermAt(i, j int) complex128 {
+	if i > j {
+		return cmplx.Conj(c.chol.at(j, i))
+	}
+	return c.chol.at(i, j)
+}
+
+// triU returns the upper triangular factor U.
+func (c *CCholesky) triU() cblas128.Triangular {
+	return cblas128.Triangular{
+		N:      c.chol.mat.Rows,
+		Stride: c.chol.mat.Stride,
+		Data:   c.chol.mat.Data,
+		Uplo:   blas.Upper,
+		Diag:   blas.NonUnit,
+	}
+}
+
+// solveVec overwrites x with A⁻¹ * x.
+func (c *CCholesky) solveVec(x []complex128) {
+	u := c.triU()
+	v := cblas128.Vector{N: u.N, Inc: 1, Data: x}
+	cblas128.Trsv(blas.ConjTrans, u, v)
+	cblas128.Trsv(blas.NoTrans, u, v)
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+func (c *CCholesky) Reset() {
+	if c.chol != nil {
+		c.chol.Reset()
+	}
+	c.cond = math.Inf(1)
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for size-restricted operations. The receiver can be emptied using
+// Reset.
+func (c *CCholesky) IsEmpty() bool {
+	return c.chol == nil || c.chol.IsEmpty()
+}
+
+// valid returns whether the receiver contains a successful factorization.
+func (c *CCholesky) valid() bool {
+	return c.chol != nil && !c.chol.IsEmpty()
+}
+
+// Cond returns the condition number of the factorized matrix.
+func (c *CCholesky) Cond() float64 {
+	if !c.valid() {
+		panic(badCholesky)
+	}
+	return c.cond
+}
+
+// LogDet returns the log of the determinant of the matrix that has been
+// factorized. The determinant of a Hermitian positive definite matrix is real
+// and positive.
+func (c *CCholesky) LogDet() float64 {
+	if !c.valid() {
+		panic(badCholesky)
+	}
+	var det float64
+	for i := 0; i < c.chol.mat.Rows; i++ {
+		det += 2 * math.Log(real(c.chol.mat.Data[i*c.chol.mat.Stride+i]))
+	}
+	return det
+}
+
+// UTo stores into dst the n×n upper triangular matrix U from a Cholesky
+// decomposition
+//
+//	A = Uᴴ * U.
+//
+// If dst is empty, it is resized to be n×n. When dst is non-empty, UTo panics
+// if dst is not n×n. The elements of dst below the diagonal are set to zero.
+// UTo will also panic if the receiver does not contain a successful
+// factorization.
+func (c *CCholesky) UTo(dst *CDense) {
+	if !c.valid() {
+		panic(badCholesky)
+	}
+	n := c.chol.mat.Rows
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, n)
+	} else {
+		r, cols := dst.Dims()
+		if r != n || cols != n {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(c.chol)
+	for i := 1; i < n; i++ {
+		zeroC(dst.mat.Data[i*dst.mat.Stride : i*dst.mat.Stride+i])
+	}
+}
+
+// SolveTo finds the matrix X that solves A * X = B where A is represented
+// by the Cholesky decomposition. The result is stored in-place into dst.
+// If the Cholesky decomposition is singular or near-singular a Condition error
+// is returned. See the documentation for Condition for more information.
+func (c *CCholesky) SolveTo(dst *CDense, b CMatrix) error {
+	if !c.valid() {
+		panic(badCholesky)
+	}
+	n := c.chol.mat.Rows
+	bm, bn := b.Dims()
+	if n != bm {
+		panic(ErrShape)
+	}
+
+	w := getCDenseWorkspace(bm, bn, false)
+	w.Copy(b)
+	u := c.triU()
+	cblas128.Trsm(blas.Left, blas.ConjTrans, 1, u, w.mat)
+	cblas128.Trsm(blas.Left, blas.NoTrans, 1, u, w.mat)
+	dst.reuseAsNonZeroed(bm, bn)
+	dst.Copy(w)
+	putCDenseWorkspace(w)
+	if c.cond > ConditionTolerance {
+		return Condition(c.cond)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestCCholesky(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 30} {
+		// A = Bᴴ*B + n*I is Hermitian positive definite.
+		b := randCDense(n, n, rnd)
+		a := cmul(b.H(), b)
+		for i := 0; i < n; i++ {
+			a.set(i, i, complex(real(a.At(i, i))+float64(n), 0))
+		}
+
+		var chol CCholesky
+		if !chol.Factorize(a) {
+			t.Errorf("n=%d: unexpected factorization failure", n)
+			continue
+		}
+		if !CEqualApprox(&chol, a, tol*float64(n)) {
+			t.Errorf("n=%d: factorization does not reconstruct A", n)
+		}
+
+		var u CDense
+		chol.UTo(&u)
+		for i := 0; i < n; i++ {
+			if d := u.At(i, i); imag(d) != 0 || real(d) <= 0 {
+				t.Errorf("n=%d: diagonal of U not real positive: %v", n, d)
+			}
+			for j := 0; j < i; j++ {
+				if u.At(i, j) != 0 {
+					t.Errorf("n=%d: U not upper triangular at (%d,%d)", n, i, j)
+				}
+			}
+		}
+		if !CEqualApprox(cmul(u.H(), &u), a, tol*float64(n)) {
+			t.Errorf("n=%d: Uᴴ*U != A", n)
+		}
+
+		// The determinant of A is the squared product of the diagonal of U.
+		var logDet float64
+		for i := 0; i < n; i++ {
+			logDet += 2 * math.Log(real(u.At(i, i)))
+		}
+		if math.Abs(chol.LogDet()-logDet) > tol {
+			t.Errorf("n=%d: unexpected LogDet: got %v, want %v", n, chol.LogDet(), logDet)
+		}
+
+		want := randCDense(n, 3, rnd)
+		rhs := cmul(a, want)
+		var x CDense
+		if err := chol.SolveTo(&x, rhs); err != nil {
+			t.Errorf("n=%d: unexpected error: %v", n, err)
+		}
+		if !CEqualApprox(&x, want, tol*float64(n)) {
+			t.Errorf("n=%d: unexpected solution", n)
+		}
+		if c := chol.Cond(); c < 1 || math.IsInf(c, 0) || math.IsNaN(c) {
+			t.Errorf("n=%d: unexpected condition number %v", n, c)
+		}
+	}
+
+	// Only the upper triangle is referenced.
+	a := NewCDense(2, 2, []complex128{4, 2 + 1i, cmplx.NaN(), 3})
+	var chol CCholesky
+	if !chol.Factorize(a) {
+		t.Errorf("unexpected failure with NaN in lower triangle")
+	} else if got := chol.At(1, 0); cmplx.Abs(got-(2-1i)) > tol {
+		t.Errorf("unexpected element at (1,0): got %v, want %v", got, 2-1i)
+	}
+
+	// Indefinite matrices are rejected.
+	if chol.Factorize(NewCDense(2, 2, []complex128{1, 2i, -2i, 1})) {
+		t.Errorf("expected failure for indefinite matrix")
+	}
+	if !chol.IsEmpty() {
+		t.Errorf("expected empty receiver after failed factorization")
+	}
+	if ok, _ := panics(func() { chol.Factorize(NewCDense(2, 3, nil)) }); !ok {
+		t.Errorf("expected panic for non-square matrix")
+	}
+}
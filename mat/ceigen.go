@@ -0,0 +1,629 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+	"sort"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// CEigen is a type for creating and using the eigenvalue decomposition of a
+// complex dense matrix.
+type CEigen struct {
+	n int // The size of the factorized matrix.
+
+	kind EigenKind
+
+	values   []complex128
+	rVectors *CDense
+	lVectors *CDense
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (e *CEigen) succFact() bool {
+	return e.n != 0
+}
+
+// Factorize computes the eigenvalues of the complex square matrix a, and
+// optionally the eigenvectors.
+//
+// A right eigenvalue/eigenvector combination is defined by
+//
+//	A * x_r = λ * x_r
+//
+// where x_r is the column vector called an eigenvector, and λ is the corresponding
+// eigenvalue.
+//
+// Similarly, a left eigenvalue/eigenvector combination is defined by
+//
+//	x_lᴴ * A = λ * x_lᴴ
+//
+// The eigenvalues, but not the eigenvectors, are the same for both decompositions.
+//
+// The matrix is reduced to upper Hessenberg form and then to the complex Schur
+// form A = Z * T * Zᴴ by the shifted QR algorithm. The eigenvalues are the
+// diagonal elements of T and the eigenvectors are computed from the
+// eigenvectors of T.
+//
+// In all cases, Factorize computes the eigenvalues of the matrix. kind
+// specifies which of the eigenvectors, if any, to compute. See the EigenKind
+// documentation for more information.
+// CEigen panics if the input matrix is not square.
+//
+// Factorize returns whether the decomposition succeeded. If the decomposition
+// failed, methods that require a successful factorization will panic.
+func (e *CEigen) Factorize(a CMatrix, kind EigenKind) (ok bool) {
+	// kill previous factorization.
+	e.n = 0
+	e.kind = 0
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrShape)
+	}
+	n := r
+	t := NewCDense(n, n, nil)
+	t.Copy(a)
+
+	var z *CDense
+	if kind != EigenNone {
+		z = NewCDense(n, n, nil)
+		for i := 0; i < n; i++ {
+			z.set(i, i, 1)
+		}
+	}
+	chessenberg(t, z)
+	if !cschur(t, z) {
+		e.values = nil
+		return false
+	}
+	e.n = n
+	e.kind = kind
+	e.values = make([]complex128, n)
+	for i := range e.values {
+		e.values[i] = t.at(i, i)
+	}
+	e.rVectors = nil
+	e.lVectors = nil
+	if kind&EigenRight != 0 {
+		e.rVectors = ctrevc(t, z, false)
+	}
+	if kind&EigenLeft != 0 {
+		e.lVectors = ctrevc(t, z, true)
+	}
+	return true
+}
+
+// chessenberg reduces the n×n matrix h to upper Hessenberg form by a unitary
+// similarity transformation h = Qᴴ * h * Q using elementary reflectors. If z
+// is not nil, it is overwritten with z * Q.
+func chessenberg(h, z *CDense) {
+	n := h.mat.Rows
+	a := h.mat
+	work := make([]complex128, n)
+	for j := 0; j < n-2; j++ {
+		tau := clarfg(&a.Data[(j+1)*a.Stride+j], cblas128.Vector{N: n - j - 2, Inc: a.Stride, Data: a.Data[(j+2)*a.Stride+j:]})
+		beta := a.Data[(j+1)*a.Stride+j]
+		a.Data[(j+1)*a.Stride+j] = 1
+		v := cblas128.Vector{N: n - j - 1, Inc: a.Stride, Data: a.Data[(j+1)*a.Stride+j:]}
+
+		// Apply H_jᴴ from the left and H_j from the right.
+		clarf(cmplx.Conj(tau), v,
+			cblas128.General{Rows: n - j - 1, Cols: n - j - 1, Stride: a.Stride, Data: a.Data[(j+1)*a.Stride+j+1:]},
+			work)
+		clarfRight(tau, v,
+			cblas128.General{Rows: n, Cols: n - j - 1, Stride: a.Stride, Data: a.Data[j+1:]},
+			work)
+		if z != nil {
+			clarfRight(tau, v,
+				cblas128.General{Rows: n, Cols: n - j - 1, Stride: z.mat.Stride, Data: z.mat.Data[j+1:]},
+				work)
+		}
+
+		a.Data[(j+1)*a.Stride+j] = beta
+		for i := j + 2; i < n; i++ {
+			a.Data[i*a.Stride+j] = 0
+		}
+	}
+}
+
+// clarfRight applies the elementary reflector H = I - tau * v * vᴴ to the
+// matrix c from the right. work must have length at least c.Rows.
+func clarfRight(tau complex128, v cblas128.Vector, c cblas128.General, work []complex128) {
+	if tau == 0 || c.Rows == 0 {
+		return
+	}
+	w := cblas128.Vector{N: c.Rows, Inc: 1, Data: work}
+	cblas128.Gemv(blas.NoTrans, 1, c, v, 0, w)
+	cblas128.Gerc(-tau, w, v, c)
+}
+
+// cschur reduces the n×n upper Hessenberg matrix h to the upper triangular
+// complex Schur form T by the single-shift QR algorithm with Wilkinson
+// shifts, overwriting h with T. If z is not nil, it is overwritten with
+// z * Q where h = Qᴴ * T * Q is the transformation applied. cschur returns
+// whether the iteration converged.
+func cschur(h, z *CDense) bool {
+	const (
+		// smlnum is the safe minimum used to decide deflation
+		// of subdiagonal elements of a zero matrix.
+		smlnum = 0x1p-1022
+		eps    = dlamchE
+	)
+	n := h.mat.Rows
+	a := h.mat
+	at := func(i, j int) complex128 { return a.Data[i*a.Stride+j] }
+
+	itmax := 30 * max(10, n)
+	its := 0
+	hi := n - 1
+	for hi > 0 {
+		// Look for a negligible subdiagonal element to split the
+		// active block.
+		l := hi
+		for ; l > 0; l-- {
+			sub := cabs1(at(l, l-1))
+			if sub <= smlnum || sub <= eps*(cabs1(at(l-1, l-1))+cabs1(at(l, l))) {
+				a.Data[l*a.Stride+l-1] = 0
+				break
+			}
+		}
+		if l == hi {
+			// A 1×1 block has converged.
+			hi--
+			its = 0
+			continue
+		}
+		its++
+		if its > itmax {
+			return false
+		}
+
+		var shift complex128
+		if its%10 == 0 {
+			// Use an exceptional shift to break cycles.
+			shift = at(hi, hi) + complex(0.75*cabs1(at(hi, hi-1)), 0)
+		} else {
+			// Use the eigenvalue of the trailing 2×2 block that is
+			// closest to the trailing diagonal element.
+			p, q := at(hi-1, hi-1), at(hi, hi)
+			half := (p - q) / 2
+			disc := cmplx.Sqrt(half*half + at(hi-1, hi)*at(hi, hi-1))
+			shift = q + half - disc
+			if cmplx.Abs(half+disc) < cmplx.Abs(half-disc) {
+				shift = q + half + disc
+			}
+		}
+
+		// Chase the bulge introduced by the shift down the active block.
+		x := at(l, l) - shift
+		y := at(l+1, l)
+		for k := l; k < hi; k++ {
+			if k > l {
+				x = at(k, k-1)
+				y = at(k+1, k-1)
+			}
+			c, s, r := crotg(x, y)
+			if k > l {
+				a.Data[k*a.Stride+k-1] = r
+				a.Data[(k+1)*a.Stride+k-1] = 0
+			}
+			cc := complex(c, 0)
+			for j := k; j < n; j++ {
+				u, v := at(k, j), at(k+1, j)
+				a.Data[k*a.Stride+j] = cc*u + s*v
+				a.Data[(k+1)*a.Stride+j] = -cmplx.Conj(s)*u + cc*v
+			}
+			for i := 0; i <= min(k+2, hi); i++ {
+				u, v := at(i, k), at(i, k+1)
+				a.Data[i*a.Stride+k] = cc*u + cmplx.Conj(s)*v
+				a.Data[i*a.Stride+k+1] = -s*u + cc*v
+			}
+			if z != nil {
+				for i := 0; i < n; i++ {
+					row := z.mat.Data[i*z.mat.Stride:]
+					u, v := row[k], row[k+1]
+					row[k] = cc*u + cmplx.Conj(s)*v
+					row[k+1] = -s*u + cc*v
+				}
+			}
+		}
+	}
+	return true
+}
+
+// crotg computes the plane rotation
+//
+//	[    c     s ] [ f ]   [ r ]
+//	[ -conj(s) c ] [ g ] = [ 0 ]
+//
+// with real c and complex s such that c² + |s|² = 1.
+func crotg(f, g complex128) (c float64, s, r complex128) {
+	if g == 0 {
+		return 1, 0, f
+	}
+	if f == 0 {
+		ga := cmplx.Abs(g)
+		return 0, cmplx.Conj(g) / complex(ga, 0), complex(ga, 0)
+	}
+	fa := cmplx.Abs(f)
+	norm := math.Hypot(fa, cmplx.Abs(g))
+	alpha := f / complex(fa, 0)
+	c = fa / norm
+	s = alpha * cmplx.Conj(g) / complex(norm, 0)
+	r = alpha * complex(norm, 0)
+	return c, s, r
+}
+
+// cabs1 returns |re(z)|+|im(z)|.
+func cabs1(z complex128) float64 {
+	return math.Abs(real(z)) + math.Abs(imag(z))
+}
+
+// ctrevc returns the right eigenvectors, or the left eigenvectors if left is
+// true, of the matrix A = Z * T * Zᴴ where T is the upper triangular n×n matrix
+// t and Z is the unitary n×n matrix z. The eigenvectors are normalized to
+// have Euclidean norm equal to 1 and largest component real.
+func ctrevc(t, z *CDense, left bool) *CDense {
+	const smlnum = 0x1p-1022
+	n := t.mat.Rows
+	vecs := NewCDense(n, n, nil)
+	x := make([]complex128, n)
+	for k := 0; k < n; k++ {
+		lambda := t.at(k, k)
+		smin := math.Max(dlamchE*cabs1(lambda), smlnum)
+		zeroC(x)
+		x[k] = 1
+		if !left {
+			// Solve (T[:k,:k] - λ*I) * x = -T[:k,k] by back substitution.
+			for i := k - 1; i >= 0; i-- {
+				var sum complex128
+				for j := i + 1; j <= k; j++ {
+					sum += t.at(i, j) * x[j]
+				}
+				x[i] = -sum / cshiftedPivot(t.at(i, i)-lambda, smin)
+			}
+			// The eigenvector of A is Z * x.
+			for i := 0; i < n; i++ {
+				var v complex128
+				for j := 0; j <= k; j++ {
+					v += z.at(i, j) * x[j]
+				}
+				vecs.set(i, k, v)
+			}
+		} else {
+			// Solve w * (T[k+1:,k+1:] - λ*I) = -w[k] * T[k,k+1:] for the
+			// row vector w by forward substitution.
+			for j := k + 1; j < n; j++ {
+				var sum complex128
+				for i := k; i < j; i++ {
+					sum += x[i] * t.at(i, j)
+				}
+				x[j] = -sum / cshiftedPivot(t.at(j, j)-lambda, smin)
+			}
+			// The left eigenvector y of A satisfies yᴴ = w * Zᴴ.
+			for i := 0; i < n; i++ {
+				var v complex128
+				for j := k; j < n; j++ {
+					v += z.at(i, j) * cmplx.Conj(x[j])
+				}
+				vecs.set(i, k, v)
+			}
+		}
+		cnormalizeCol(vecs, k)
+	}
+	return vecs
+}
+
+// cshiftedPivot returns d, or a value of magnitude smin if d is smaller, so
+// that it can be used as a divisor when solving a nearly singular triangular
+// system.
+func cshiftedPivot(d complex128, smin float64) complex128 {
+	if cabs1(d) < smin {
+		return complex(smin, 0)
+	}
+	return d
+}
+
+// cnormalizeCol scales column j of m to have Euclidean norm equal to 1 and
+// largest component real.
+func cnormalizeCol(m *CDense, j int) {
+	col := cblas128.Vector{N: m.mat.Rows, Inc: m.mat.Stride, Data: m.mat.Data[j:]}
+	nrm := cblas128.Nrm2(col)
+	if nrm == 0 {
+		return
+	}
+	var big complex128
+	for i := 0; i < m.mat.Rows; i++ {
+		if v := m.at(i, j); cmplx.Abs(v) > cmplx.Abs(big) {
+			big = v
+		}
+	}
+	phase := cmplx.Conj(big) / complex(cmplx.Abs(big), 0)
+	cblas128.Scal(phase/complex(nrm, 0), col)
+	// Remove the rounding error in the imaginary part.
+	for i := 0; i < m.mat.Rows; i++ {
+		if m.at(i, j) == big*phase/complex(nrm, 0) {
+			m.set(i, j, complex(real(m.at(i, j)), 0))
+		}
+	}
+}
+
+// Kind returns the EigenKind of the decomposition. If no decomposition has been
+// computed, Kind returns -1.
+func (e *CEigen) Kind() EigenKind {
+	if !e.succFact() {
+		return -1
+	}
+	return e.kind
+}
+
+// Values extracts the eigenvalues of the factorized matrix. If dst is
+// non-nil, the values are stored in-place into dst. In this case
+// dst must have length n, otherwise Values will panic. If dst is
+// nil, then a new slice will be allocated of the proper length and
+// filled with the eigenvalues.
+//
+// Values panics if the CEigen decomposition was not successful.
+func (e *CEigen) Values(dst []complex128) []complex128 {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]complex128, e.n)
+	}
+	if len(dst) != e.n {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, e.values)
+	return dst
+}
+
+// VectorsTo stores the right eigenvectors of the decomposition into the columns
+// of dst. The computed eigenvectors are normalized to have Euclidean norm equal
+// to 1 and largest component real.
+//
+// If dst is empty, VectorsTo will resize dst to be n×n. When dst is
+// non-empty, VectorsTo will panic if dst is not n×n. VectorsTo will also
+// panic if the eigenvectors were not computed during the factorization,
+// or if the receiver does not contain a successful factorization.
+func (e *CEigen) VectorsTo(dst *CDense) {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if e.kind&EigenRight == 0 {
+		panic(noVectors)
+	}
+	if dst.IsEmpty() {
+		dst.ReuseAs(e.n, e.n)
+	} else {
+		r, c := dst.Dims()
+		if r != e.n || c != e.n {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(e.rVectors)
+}
+
+// LeftVectorsTo stores the left eigenvectors of the decomposition into the
+// columns of dst. The computed eigenvectors are normalized to have Euclidean
+// norm equal to 1 and largest component real.
+//
+// If dst is empty, LeftVectorsTo will resize dst to be n×n. When dst is
+// non-empty, LeftVectorsTo will panic if dst is not n×n. LeftVectorsTo will also
+// panic if the left eigenvectors were not computed during the factorization,
+// or if the receiver does not contain a successful factorization
+func (e *CEigen) LeftVectorsTo(dst *CDense) {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if e.kind&EigenLeft == 0 {
+		panic(noVectors)
+	}
+	if dst.IsEmpty() {
+		dst.ReuseAs(e.n, e.n)
+	} else {
+		r, c := dst.Dims()
+		if r != e.n || c != e.n {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(e.lVectors)
+}
+
+// CEigenHerm is a type for computing all eigenvalues and, optionally,
+// eigenvectors of a complex Hermitian matrix A. The eigenvalues of a
+// Hermitian matrix are real and its eigenvectors can be chosen to be
+// orthonormal.
+type CEigenHerm struct {
+	vectorsComputed bool
+
+	values  []float64
+	vectors *CDense
+}
+
+// Factorize computes the spectral factorization of the Hermitian matrix A
+//
+//	A = Q * Λ * Qᴴ
+//
+// where Λ is a real diagonal matrix whose entries are the eigenvalues, and Q
+// is a unitary matrix whose columns are the eigenvectors. Only the upper
+// triangle of A is referenced and the imaginary parts of its diagonal are
+// assumed to be zero. Factorize panics if A is not square.
+//
+// The decomposition is computed using the cyclic Jacobi method, which
+// computes small eigenvalues to high relative accuracy when A is well
+// conditioned with respect to scaling.
+//
+// If vectors is false, the eigenvectors are not computed and later calls to
+// VectorsTo will panic.
+//
+// Factorize returns whether the factorization succeeded. If it returns false,
+// methods that require a successful factorization will panic.
+func (e *CEigenHerm) Factorize(a CMatrix, vectors bool) (ok bool) {
+	// kill previous decomposition
+	e.vectorsComputed = false
+	e.values = nil
+	e.vectors = nil
+
+	n, c := a.Dims()
+	if n != c {
+		panic(ErrSquare)
+	}
+	h := NewCDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		h.set(i, i, complex(real(a.At(i, i)), 0))
+		for j := i + 1; j < n; j++ {
+			v := a.At(i, j)
+			h.set(i, j, v)
+			h.set(j, i, cmplx.Conj(v))
+		}
+	}
+	var q *CDense
+	if vectors {
+		q = NewCDense(n, n, nil)
+		for i := 0; i < n; i++ {
+			q.set(i, i, 1)
+		}
+	}
+
+	converged := false
+	for sweep := 0; sweep < maxJacobiSweeps && !converged; sweep++ {
+		converged = true
+		for p := 0; p < n-1; p++ {
+			for r := p + 1; r < n; r++ {
+				apr := h.at(p, r)
+				abs := cmplx.Abs(apr)
+				app, arr := real(h.at(p, p)), real(h.at(r, r))
+				if abs == 0 || abs <= dlamchE*math.Sqrt(math.Abs(app))*math.Sqrt(math.Abs(arr)) {
+					h.set(p, r, 0)
+					h.set(r, p, 0)
+					continue
+				}
+				converged = false
+
+				// Scale row and column r so that A[p,r] is real
+				// and positive, then annihilate it with a real
+				// rotation.
+				phase := cmplx.Conj(apr) / complex(abs, 0)
+				tau := (arr - app) / (2 * abs)
+				t := 1 / (math.Abs(tau) + math.Hypot(1, tau))
+				if tau < 0 {
+					t = -t
+				}
+				cs := 1 / math.Hypot(1, t)
+				sn := cs * t
+				crotateCols(h, p, r, cs, sn, phase)
+				crotateRows(h, p, r, cs, sn, cmplx.Conj(phase))
+				if q != nil {
+					crotateCols(q, p, r, cs, sn, phase)
+				}
+				h.set(p, p, complex(app-t*abs, 0))
+				h.set(r, r, complex(arr+t*abs, 0))
+				h.set(p, r, 0)
+				h.set(r, p, 0)
+			}
+		}
+	}
+	if !converged {
+		return false
+	}
+
+	// Sort the eigenvalues in ascending order.
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+	sort.SliceStable(perm, func(i, j int) bool { return real(h.at(perm[i], perm[i])) < real(h.at(perm[j], perm[j])) })
+	e.values = make([]float64, n)
+	for i, pi := range perm {
+		e.values[i] = real(h.at(pi, pi))
+	}
+	if vectors {
+		e.vectors = NewCDense(n, n, nil)
+		for j, pj := range perm {
+			for i := 0; i < n; i++ {
+				e.vectors.set(i, j, q.at(i, pj))
+			}
+		}
+	}
+	e.vectorsComputed = vectors
+	return true
+}
+
+// crotateRows replaces rows j and k of m with
+//
+//	c*m[j,:] - s*phase*m[k,:]  and  s*m[j,:] + c*phase*m[k,:]
+//
+// which is a unitary transformation for real c and s with c²+s² = 1 and
+// |phase| = 1.
+func crotateRows(m *CDense, j, k int, c, s float64, phase complex128) {
+	cc := complex(c, 0)
+	sc := complex(s, 0)
+	rj := m.mat.Data[j*m.mat.Stride : j*m.mat.Stride+m.mat.Cols]
+	rk := m.mat.Data[k*m.mat.Stride : k*m.mat.Stride+m.mat.Cols]
+	for i, x := range rj {
+		y := phase * rk[i]
+		rj[i] = cc*x - sc*y
+		rk[i] = sc*x + cc*y
+	}
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (e *CEigenHerm) succFact() bool {
+	return len(e.values) != 0
+}
+
+// Values extracts the eigenvalues of the factorized n×n matrix A in ascending
+// order.
+//
+// If dst is not nil, the values are stored in-place into dst and returned,
+// otherwise a new slice is allocated first. If dst is not nil, it must have
+// length equal to n.
+//
+// If the receiver does not contain a successful factorization, Values will
+// panic.
+func (e *CEigenHerm) Values(dst []float64) []float64 {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]float64, len(e.values))
+	}
+	if len(dst) != len(e.values) {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, e.values)
+	return dst
+}
+
+// VectorsTo stores the orthonormal eigenvectors of the factorized n×n matrix A
+// into the columns of dst.
+//
+// If dst is empty, VectorsTo will resize dst to be n×n. When dst is non-empty,
+// VectorsTo will panic if dst is not n×n. VectorsTo will also panic if the
+// eigenvectors were not computed during the factorization, or if the receiver
+// does not contain a successful factorization.
+func (e *CEigenHerm) VectorsTo(dst *CDense) {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if !e.vectorsComputed {
+		panic(noVectors)
+	}
+	n := len(e.values)
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, n)
+	} else {
+		r, c := dst.Dims()
+		if r != n || c != n {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(e.vectors)
+}
@@ -0,0 +1,169 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math/cmplx"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestCEigen(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		name string
+		a    *CDense
+	}{
+		{name: "1×1", a: NewCDense(1, 1, []complex128{2 - 3i})},
+		{name: "zero", a: NewCDense(3, 3, nil)},
+		{name: "diagonal", a: NewCDense(3, 3, []complex128{1, 0, 0, 0, 2i, 0, 0, 0, -3})},
+		{name: "jordan", a: NewCDense(2, 2, []complex128{1, 1, 0, 1})},
+		{name: "rotation", a: NewCDense(2, 2, []complex128{0, -1, 1, 0})},
+		{name: "random 5", a: randCDense(5, 5, rnd)},
+		{name: "random 20", a: randCDense(20, 20, rnd)},
+		{name: "random 40", a: randCDense(40, 40, rnd)},
+	} {
+		a := test.a
+		n, _ := a.Dims()
+		var e CEigen
+		if !e.Factorize(a, EigenBoth) {
+			t.Errorf("%s: factorization failed", test.name)
+			continue
+		}
+		if e.Kind() != EigenBoth {
+			t.Errorf("%s: unexpected kind", test.name)
+		}
+		values := e.Values(nil)
+
+		// The trace is the sum of the eigenvalues.
+		var trace, sum complex128
+		for i := 0; i < n; i++ {
+			trace += a.At(i, i)
+			sum += values[i]
+		}
+		if cmplx.Abs(trace-sum) > tol*float64(n) {
+			t.Errorf("%s: sum of eigenvalues %v does not match trace %v", test.name, sum, trace)
+		}
+
+		isJordan := test.name == "jordan"
+		var vr, vl CDense
+		e.VectorsTo(&vr)
+		e.LeftVectorsTo(&vl)
+		for k, lambda := range values {
+			var rnorm, lnorm complex128
+			for i := 0; i < n; i++ {
+				rnorm += cmplx.Conj(vr.At(i, k)) * vr.At(i, k)
+				lnorm += cmplx.Conj(vl.At(i, k)) * vl.At(i, k)
+			}
+			if cmplx.Abs(rnorm-1) > tol || cmplx.Abs(lnorm-1) > tol {
+				t.Errorf("%s: eigenvectors %d not normalized", test.name, k)
+			}
+			if isJordan {
+				// The computed eigenvectors of a defective matrix are
+				// only accurate to about the square root of machine
+				// precision.
+				continue
+			}
+			for i := 0; i < n; i++ {
+				var av, ya complex128
+				for j := 0; j < n; j++ {
+					av += a.At(i, j) * vr.At(j, k)
+					ya += cmplx.Conj(vl.At(j, k)) * a.At(j, i)
+				}
+				if cmplx.Abs(av-lambda*vr.At(i, k)) > tol {
+					t.Errorf("%s: A*v != λ*v for eigenvalue %d", test.name, k)
+					break
+				}
+				if cmplx.Abs(ya-lambda*cmplx.Conj(vl.At(i, k))) > tol {
+					t.Errorf("%s: yᴴ*A != λ*yᴴ for eigenvalue %d", test.name, k)
+					break
+				}
+			}
+		}
+
+		var right CEigen
+		right.Factorize(a, EigenRight)
+		if ok, _ := panics(func() { right.LeftVectorsTo(&CDense{}) }); !ok {
+			t.Errorf("%s: expected panic for missing left vectors", test.name)
+		}
+	}
+
+	if ok, _ := panics(func() { new(CEigen).Factorize(NewCDense(2, 3, nil), EigenNone) }); !ok {
+		t.Errorf("expected panic for non-square matrix")
+	}
+}
+
+func TestCEigenHerm(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 10, 30} {
+		name := fmt.Sprintf("n=%d", n)
+		b := randCDense(n, n, rnd)
+		a := cmul(b, b.H())
+		for i := 0; i < n; i++ {
+			a.set(i, i, a.at(i, i)+complex(float64(i)-float64(n)/2, 0))
+		}
+
+		var e CEigenHerm
+		if !e.Factorize(a, true) {
+			t.Errorf("%s: factorization failed", name)
+			continue
+		}
+		values := e.Values(nil)
+		if !sort.Float64sAreSorted(values) {
+			t.Errorf("%s: eigenvalues not sorted", name)
+		}
+		var q CDense
+		e.VectorsTo(&q)
+		if !CEqualApprox(cmul(q.H(), &q), ceye(n), tol*float64(n)) {
+			t.Errorf("%s: eigenvectors are not orthonormal", name)
+		}
+		lambda := NewCDense(n, n, nil)
+		for i, v := range values {
+			lambda.set(i, i, complex(v, 0))
+		}
+		scale := floats.Max(append([]float64{1}, values...))
+		if !CEqualApprox(cmul(cmul(&q, lambda), q.H()), a, tol*float64(n)*scale) {
+			t.Errorf("%s: Q*Λ*Qᴴ != A", name)
+		}
+
+		// The eigenvalues agree with those computed by the general
+		// complex eigendecomposition.
+		var g CEigen
+		if !g.Factorize(a, EigenNone) {
+			t.Errorf("%s: general factorization failed", name)
+			continue
+		}
+		gv := g.Values(nil)
+		re := make([]float64, n)
+		for i, v := range gv {
+			re[i] = real(v)
+			if abs := imag(v); abs > 1e-10*scale || abs < -1e-10*scale {
+				t.Errorf("%s: eigenvalue %d of Hermitian matrix not real: %v", name, i, v)
+			}
+		}
+		sort.Float64s(re)
+		if !floats.EqualApprox(re, values, 1e-10*scale) {
+			t.Errorf("%s: eigenvalues differ from general decomposition", name)
+		}
+
+		var novec CEigenHerm
+		novec.Factorize(a, false)
+		if !floats.Equal(novec.Values(nil), values) {
+			t.Errorf("%s: eigenvalues depend on vectors", name)
+		}
+		if ok, _ := panics(func() { novec.VectorsTo(&CDense{}) }); !ok {
+			t.Errorf("%s: expected panic for missing vectors", name)
+		}
+	}
+}
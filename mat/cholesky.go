@@ -627,30 +627,7 @@ func (c *Cholesky) SymRankOne(orig *Cholesky, alpha float64, x Vector) (ok bool)
 		if alpha != 1 {
 			blas64.Scal(math.Sqrt(alpha), blas64.Vector{N: n, Data: work, Inc: 1})
 		}
-		umat := c.chol.mat
-		stride := umat.Stride
-		for i := 0; i < n; i++ {
-			// Compute parameters of the Givens matrix that zeroes
-			// the i-th element of x.
-			c, s, r, _ := blas64.Rotg(umat.Data[i*stride+i], work[i])
-			if r < 0 {
-				// Multiply by -1 to have positive diagonal
-				// elements.
-				r *= -1
-				c *= -1
-				s *= -1
-			}
-			umat.Data[i*stride+i] = r
-			if i < n-1 {
-				// Multiply the extended factorization matrix by
-				// the Givens matrix from the left. Only
-				// the i-th row and x are modified.
-				blas64.Rot(
-					blas64.Vector{N: n - i - 1, Data: umat.Data[i*stride+i+1 : i*stride+n], Inc: 1},
-					blas64.Vector{N: n - i - 1, Data: work[i+1 : n], Inc: 1},
-					c, s)
-			}
-		}
+		choleskyUpdate(c.chol.mat, work)
 		c.updateCond(-1)
 		return true
 	}
@@ -660,19 +637,144 @@ func (c *Cholesky) SymRankOne(orig *Cholesky, alpha float64, x Vector) (ok bool)
 	if alpha != 1 {
 		blas64.Scal(alpha, blas64.Vector{N: n, Data: work, Inc: 1})
 	}
-	// Solve Uᵀ * p = x storing the result into work.
-	ok = lapack64.Trtrs(blas.Trans, c.chol.RawTriangular(), blas64.General{
+	workMat := getTriDenseWorkspace(c.chol.mat.N, c.chol.triKind(), false)
+	defer putTriWorkspace(workMat)
+	workMat.Copy(c.chol)
+	ok = choleskyDowndate(workMat.mat, work)
+	if ok {
+		c.chol.Copy(workMat)
+		c.updateCond(-1)
+	}
+	return ok
+}
+
+// SymRankK performs a rank-k update of the original matrix A and refactorizes
+// its Cholesky factorization, storing the result into the receiver. That is, if
+// in the original Cholesky factorization
+//
+//	Uᵀ * U = A,
+//
+// in the updated factorization
+//
+//	U'ᵀ * U' = A + alpha * X * Xᵀ = A',
+//
+// where X is an n×k matrix. SymRankK gives the same result as k successive
+// calls to SymRankOne with the columns of X, but avoids the intermediate
+// copies and condition number estimates. It can be used to add or, with
+// negative alpha, remove a block of observations from a factorized Gram or
+// covariance matrix.
+//
+// Note that when alpha is negative, the updating problem may be ill-conditioned
+// and the results may be inaccurate, or the updated matrix A' may not be
+// positive definite and not have a Cholesky factorization. SymRankK returns
+// whether the updated matrix A' is positive definite. If the update fails
+// the receiver is left unchanged.
+//
+// SymRankK updates a Cholesky factorization in O(n²k) time. The Cholesky
+// factorization computation from scratch is O(n³).
+func (c *Cholesky) SymRankK(orig *Cholesky, alpha float64, x Matrix) (ok bool) {
+	if !orig.valid() {
+		panic(badCholesky)
+	}
+	n := orig.SymmetricDim()
+	r, k := x.Dims()
+	if r != n {
+		panic(ErrShape)
+	}
+	if orig != c {
+		if c.chol == nil {
+			c.chol = NewTriDense(n, Upper, nil)
+		} else if c.chol.mat.N != n {
+			panic(ErrShape)
+		}
+		c.chol.Copy(orig.chol)
+	}
+
+	if alpha == 0 {
+		return true
+	}
+
+	// Store the scaled columns of X as the rows of w
+	// so that each can be passed to the rank-1 kernels.
+	w := getDenseWorkspace(k, n, false)
+	defer putDenseWorkspace(w)
+	w.Copy(x.T())
+	w.Scale(math.Sqrt(math.Abs(alpha)), w)
+	wmat := w.mat
+
+	if alpha > 0 {
+		// Compute rank-k update.
+		for j := 0; j < k; j++ {
+			choleskyUpdate(c.chol.mat, wmat.Data[j*wmat.Stride:j*wmat.Stride+n])
+		}
+		c.updateCond(-1)
+		return true
+	}
+
+	// Compute rank-k downdate as a sequence of rank-1 downdates
+	// of a copy of U, so that the receiver is unchanged if any
+	// of the intermediate matrices is not positive definite.
+	workMat := getTriDenseWorkspace(n, c.chol.triKind(), false)
+	defer putTriWorkspace(workMat)
+	workMat.Copy(c.chol)
+	for j := 0; j < k; j++ {
+		if !choleskyDowndate(workMat.mat, wmat.Data[j*wmat.Stride:j*wmat.Stride+n]) {
+			return false
+		}
+	}
+	c.chol.Copy(workMat)
+	c.updateCond(-1)
+	return true
+}
+
+// choleskyUpdate updates the upper triangular Cholesky factor U in place so
+// that U'ᵀ * U' = Uᵀ * U + x * xᵀ. The contents of x are destroyed.
+func choleskyUpdate(umat blas64.Triangular, x []float64) {
+	n := umat.N
+	stride := umat.Stride
+	for i := 0; i < n; i++ {
+		// Compute parameters of the Givens matrix that zeroes
+		// the i-th element of x.
+		c, s, r, _ := blas64.Rotg(umat.Data[i*stride+i], x[i])
+		if r < 0 {
+			// Multiply by -1 to have positive diagonal
+			// elements.
+			r *= -1
+			c *= -1
+			s *= -1
+		}
+		umat.Data[i*stride+i] = r
+		if i < n-1 {
+			// Multiply the extended factorization matrix by
+			// the Givens matrix from the left. Only
+			// the i-th row and x are modified.
+			blas64.Rot(
+				blas64.Vector{N: n - i - 1, Data: umat.Data[i*stride+i+1 : i*stride+n], Inc: 1},
+				blas64.Vector{N: n - i - 1, Data: x[i+1 : n], Inc: 1},
+				c, s)
+		}
+	}
+}
+
+// choleskyDowndate downdates the upper triangular Cholesky factor U in place
+// so that U'ᵀ * U' = Uᵀ * U - x * xᵀ, and returns whether the downdated
+// matrix is positive definite. If choleskyDowndate returns false, the
+// contents of U are undefined. The contents of x are destroyed.
+func choleskyDowndate(umat blas64.Triangular, x []float64) (ok bool) {
+	n := umat.N
+	// Solve Uᵀ * p = x storing the result into x.
+	ok = lapack64.Trtrs(blas.Trans, umat, blas64.General{
 		Rows:   n,
 		Cols:   1,
 		Stride: 1,
-		Data:   work,
+		Data:   x,
 	})
 	if !ok {
 		// The original matrix is singular. Should not happen, because
 		// the factorization is valid.
 		panic(badCholesky)
 	}
-	norm := blas64.Nrm2(blas64.Vector{N: n, Data: work, Inc: 1})
+	norm := blas64.Nrm2(blas64.Vector{N: n, Data: x, Inc: 1})
 	if norm >= 1 {
 		// The updated matrix is not positive definite.
 		return false
@@ -685,23 +787,19 @@ func (c *Cholesky) SymRankOne(orig *Cholesky, alpha float64, x Vector) (ok bool)
 	for i := n - 1; i >= 0; i-- {
 		// Compute parameters of Givens matrices that zero elements of p
 		// backwards.
-		cos[i], sin[i], norm, _ = blas64.Rotg(norm, work[i])
+		cos[i], sin[i], norm, _ = blas64.Rotg(norm, x[i])
 		if norm < 0 {
 			norm *= -1
 			cos[i] *= -1
 			sin[i] *= -1
 		}
 	}
-	workMat := getTriDenseWorkspace(c.chol.mat.N, c.chol.triKind(), false)
-	defer putTriWorkspace(workMat)
-	workMat.Copy(c.chol)
-	umat := workMat.mat
-	stride := workMat.mat.Stride
+	stride := umat.Stride
 	for i := n - 1; i >= 0; i-- {
-		work[i] = 0
+		x[i] = 0
 		// Apply Givens matrices to U.
 		blas64.Rot(
-			blas64.Vector{N: n - i, Data: work[i:n], Inc: 1},
+			blas64.Vector{N: n - i, Data: x[i:n], Inc: 1},
 			blas64.Vector{N: n - i, Data: umat.Data[i*stride+i : i*stride+n], Inc: 1},
 			cos[i], sin[i])
 		if umat.Data[i*stride+i] == 0 {
@@ -716,10 +814,6 @@ func (c *Cholesky) SymRankOne(orig *Cholesky, alpha float64, x Vector) (ok bool)
 			blas64.Scal(-1, blas64.Vector{N: n - i, Data: umat.Data[i*stride+i : i*stride+n], Inc: 1})
 		}
 	}
-	if ok {
-		c.chol.Copy(workMat)
-		c.updateCond(-1)
-	}
 	return ok
 }
 
@@ -1111,6 +1205,37 @@ func (c *PivotedCholesky) UTo(dst *TriDense) {
 	dst.Copy(c.chol)
 }
 
+// ThinUTo stores the r×n upper trapezoidal matrix U₁ formed by the first r rows
+// of U from the Cholesky factorization
+//
+//	Pᵀ * A * P = Uᵀ * U
+//
+// into dst, where r is the computed rank of A, so that
+//
+//	Pᵀ * A * P ≈ U₁ᵀ * U₁.
+//
+// If dst is empty, it is resized to be r×n. When dst is non-empty, ThinUTo
+// panics if dst is not r×n. ThinUTo panics if the computed rank is zero.
+func (c *PivotedCholesky) ThinUTo(dst *Dense) {
+	if c.chol == nil {
+		panic(badCholesky)
+	}
+	n := c.chol.mat.N
+	r := c.rank
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, n)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || n != c2 {
+			panic(ErrShape)
+		}
+	}
+	for i := 0; i < r; i++ {
+		zero(dst.mat.Data[i*dst.mat.Stride : i*dst.mat.Stride+i])
+		copy(dst.mat.Data[i*dst.mat.Stride+i:i*dst.mat.Stride+n], c.chol.mat.Data[i*c.chol.mat.Stride+i:i*c.chol.mat.Stride+n])
+	}
+}
+
 // ColumnPivots returns the column permutation p that represents the permutation
 // matrix P from the Cholesky factorization
 //
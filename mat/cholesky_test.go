@@ -503,6 +503,114 @@ func TestCholeskySymRankOne(t *testing.T) {
 	}
 }
 
+func TestCholeskySymRankK(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 4, 5, 7, 10, 20, 50} {
+		for _, k := range []int{1, 2, 3, 5, 10} {
+			for trial := 0; trial < 10; trial++ {
+				// Construct a random positive definite matrix.
+				data := make([]float64, n*n)
+				for i := range data {
+					data[i] = rnd.NormFloat64()
+				}
+				var a SymDense
+				a.SymOuterK(1, NewDense(n, n, data))
+
+				// Construct random data for updating.
+				xdata := make([]float64, n*k)
+				for i := range xdata {
+					xdata[i] = rnd.NormFloat64()
+				}
+				x := NewDense(n, k, xdata)
+				alpha := rnd.NormFloat64()
+
+				// Compute the updated matrix directly. If alpha < 0, the
+				// final matrix may not be positive definite, so switch the
+				// two matrices as in TestCholeskySymRankOne.
+				aUpdate := NewSymDense(n, nil)
+				if alpha > 0 {
+					aUpdate.SymRankK(&a, alpha, x)
+				} else {
+					aUpdate.CopySym(&a)
+					a.Reset()
+					a.SymRankK(aUpdate, -alpha, x)
+				}
+
+				var chol Cholesky
+				ok := chol.Factorize(&a)
+				if !ok {
+					t.Errorf("Bad random test, Cholesky factorization failed")
+					continue
+				}
+
+				var cholUpdate Cholesky
+				ok = cholUpdate.SymRankK(&chol, alpha, x)
+				if !ok {
+					t.Errorf("n=%v, k=%v, alpha=%v: unexpected failure", n, k, alpha)
+					continue
+				}
+
+				var aCompare SymDense
+				cholUpdate.ToSym(&aCompare)
+				if !EqualApprox(&aCompare, aUpdate, 1e-12) {
+					t.Errorf("n=%v, k=%v, alpha=%v: mismatch between updated matrix and from Cholesky:\nupdated:\n%v\nfrom Cholesky:\n%v",
+						n, k, alpha, Formatted(aUpdate), Formatted(&aCompare))
+				}
+
+				// Check that an update followed by a downdate in place
+				// recovers the original factorization.
+				cholUpdate.SymRankK(&cholUpdate, -alpha, x)
+				var uOrig, uRound TriDense
+				chol.UTo(&uOrig)
+				cholUpdate.UTo(&uRound)
+				if !EqualApprox(&uOrig, &uRound, 1e-8) {
+					t.Errorf("n=%v, k=%v, alpha=%v: update and downdate do not round trip", n, k, alpha)
+				}
+			}
+		}
+	}
+
+	// Downdate to an indefinite matrix leaves the receiver unchanged.
+	a := NewSymDense(4, []float64{
+		1, 1, 1, 1,
+		0, 2, 3, 4,
+		0, 0, 6, 10,
+		0, 0, 0, 20,
+	})
+	var chol Cholesky
+	if !chol.Factorize(a) {
+		t.Fatal("bad test, Cholesky factorization failed")
+	}
+	var want TriDense
+	chol.UTo(&want)
+	x := NewDense(4, 2, []float64{
+		0, 0,
+		0, 0,
+		0.1, 0,
+		0, 1,
+	})
+	if chol.SymRankK(&chol, -1, x) {
+		t.Error("expected failure from SymRankK downdate to singular matrix")
+	}
+	var got TriDense
+	chol.UTo(&got)
+	if !Equal(&got, &want) {
+		t.Error("receiver modified by failed SymRankK downdate")
+	}
+	if !chol.SymRankK(&chol, -0.5, x) {
+		t.Error("unexpected failure from SymRankK downdate to positive definite matrix")
+	}
+	a.SymRankK(a, -0.5, x)
+	var achol SymDense
+	chol.ToSym(&achol)
+	if !EqualApprox(&achol, a, 1e-13) {
+		t.Errorf("mismatch between updated matrix and from Cholesky:\nupdated:\n%v\nfrom Cholesky:\n%v",
+			Formatted(a), Formatted(&achol))
+	}
+
+}
+
 func TestCholeskyExtendVecSym(t *testing.T) {
 	t.Parallel()
 	for cas, test := range []struct {
@@ -1001,6 +1109,25 @@ func TestPivotedCholesky(t *testing.T) {
 			if res > tol {
 				t.Errorf("%s: unexpected result using ColumnPivots and RawU (|P*Uᵀ*U*Pᵀ - A|=%v)\ndiff=%.4g", name, res, Formatted(diff, Prefix("     ")))
 			}
+
+			if rank == 0 {
+				continue
+			}
+			// Compute the norm of the difference |P*U₁ᵀ*U₁*Pᵀ - A| using ColumnPivots and ThinUTo.
+			var u1 Dense
+			chol.ThinUTo(&u1)
+			if r, c := u1.Dims(); r != rank || c != n {
+				t.Errorf("%s: unexpected dims of thin U: r=%d, c=%d", name, r, c)
+				continue
+			}
+			diff.Mul(u1.T(), &u1)
+			diff.PermuteCols(chol.ColumnPivots(nil), true)
+			diff.PermuteRows(chol.ColumnPivots(nil), true)
+			diff.Sub(diff, a)
+			res = Norm(diff, 1)
+			if res > tol {
+				t.Errorf("%s: unexpected result using ColumnPivots and ThinUTo (|P*U₁ᵀ*U₁*Pᵀ - A|=%v)\ndiff=%.4g", name, res, Formatted(diff, Prefix("     ")))
+			}
 		}
 	}
 }
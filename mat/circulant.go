@@ -0,0 +1,279 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/dsp/fourier"
+)
+
+var (
+	circulant *Circulant
+	_         Matrix      = circulant
+	_         SolveToer   = circulant
+	_         NonZeroDoer = circulant
+)
+
+// Circulant represents an n×n circulant matrix by its first column c, so
+// that
+//
+//	A[i,j] = c[(i-j) mod n]
+//
+// A circulant matrix is diagonalized by the discrete Fourier transform, so
+// products and solutions of linear systems are computed with FFTs in
+// O(n log n) time.
+type Circulant struct {
+	n int
+	c []float64
+}
+
+// NewCirculant creates a new n×n circulant matrix with first column c. If c
+// is nil, a new backing slice is allocated, otherwise c must have length n
+// and is used as the backing slice so that changes to the elements of the
+// returned Circulant will be reflected in c. NewCirculant will panic if n is
+// not positive or if c has the wrong length.
+func NewCirculant(n int, c []float64) *Circulant {
+	if n <= 0 {
+		if n == 0 {
+			panic(ErrZeroLength)
+		}
+		panic(ErrNegativeDimension)
+	}
+	if c == nil {
+		c = make([]float64, n)
+	}
+	if len(c) != n {
+		panic(ErrShape)
+	}
+	return &Circulant{n: n, c: c}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (a *Circulant) Dims() (r, c int) {
+	return a.n, a.n
+}
+
+// At returns the element at row i, column j.
+func (a *Circulant) At(i, j int) float64 {
+	if uint(i) >= uint(a.n) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(a.n) {
+		panic(ErrColAccess)
+	}
+	k := i - j
+	if k < 0 {
+		k += a.n
+	}
+	return a.c[k]
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (a *Circulant) T() Matrix {
+	return Transpose{a}
+}
+
+// RawColumn returns the first column of the matrix. Changes to the elements
+// of the returned slice will be reflected in the receiver.
+func (a *Circulant) RawColumn() []float64 {
+	return a.c
+}
+
+// Eigenvalues returns the eigenvalues of the matrix, the discrete Fourier
+// transform of the first column,
+//
+//	λ_k = Σ_j c[j] exp(-2πi jk/n)
+//
+// If dst is not nil, the values are stored in-place into dst and returned,
+// otherwise a new slice is allocated first. If dst is not nil, it must have
+// length n.
+func (a *Circulant) Eigenvalues(dst []complex128) []complex128 {
+	if dst == nil {
+		dst = make([]complex128, a.n)
+	}
+	if len(dst) != a.n {
+		panic(ErrSliceLengthMismatch)
+	}
+	half := fourier.NewFFT(a.n).Coefficients(nil, a.c)
+	copy(dst, half)
+	// The spectrum of a real sequence is conjugate symmetric.
+	for k := len(half); k < a.n; k++ {
+		dst[k] = cmplx.Conj(half[a.n-k])
+	}
+	return dst
+}
+
+// DoNonZero calls the function fn for each of the non-zero elements of A. The
+// function fn takes a row/column index and the element value of A at (i,j).
+func (a *Circulant) DoNonZero(fn func(i, j int, v float64)) {
+	for i := 0; i < a.n; i++ {
+		for j := 0; j < a.n; j++ {
+			if v := a.At(i, j); v != 0 {
+				fn(i, j, v)
+			}
+		}
+	}
+}
+
+// MulVecTo computes A⋅x or Aᵀ⋅x storing the result into dst.
+func (a *Circulant) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	n := a.n
+	if x.Len() != n {
+		panic(ErrShape)
+	}
+	if xv, ok := x.(RawVectorer); ok && dst != x {
+		dst.checkOverlap(xv.RawVector())
+	}
+	dst.reuseAsNonZeroed(n)
+
+	var s circulantSpectrum
+	s.init(a.c)
+	seq := make([]float64, n)
+	for i := range seq {
+		seq[i] = x.AtVec(i)
+	}
+	s.apply(seq, trans, false)
+	for i, v := range seq {
+		dst.setVec(i, v)
+	}
+}
+
+// SolveTo solves a circulant system A⋅X = B or Aᵀ⋅X = B where A is an n×n
+// circulant matrix represented by the receiver and B is a given n×nrhs matrix.
+// The result is stored into dst.
+//
+// If A is singular, the contents of dst will be undefined and a Condition
+// error will be returned. If the condition number of A is larger than
+// ConditionTolerance, the result is stored into dst and a Condition error is
+// returned. The condition number is computed exactly from the eigenvalues.
+func (a *Circulant) SolveTo(dst *Dense, trans bool, b Matrix) error {
+	n, nrhs := b.Dims()
+	if n != a.n {
+		panic(ErrShape)
+	}
+	if bU, _ := untranspose(b); dst != bU {
+		if rm, ok := bU.(RawMatrixer); ok {
+			dst.checkOverlap(rm.RawMatrix())
+		}
+	}
+
+	var s circulantSpectrum
+	s.init(a.c)
+	cond := s.cond()
+	if math.IsInf(cond, 1) {
+		return Condition(cond)
+	}
+	cols := make([][]float64, nrhs)
+	for j := range cols {
+		col := make([]float64, n)
+		for i := range col {
+			col[i] = b.At(i, j)
+		}
+		s.apply(col, trans, true)
+		cols[j] = col
+	}
+	dst.reuseAsNonZeroed(n, nrhs)
+	for j, col := range cols {
+		for i, v := range col {
+			dst.set(i, j, v)
+		}
+	}
+	if cond > ConditionTolerance {
+		return Condition(cond)
+	}
+	return nil
+}
+
+// SolveVecTo solves a circulant system A⋅x = b or Aᵀ⋅x = b where A is an n×n
+// circulant matrix represented by the receiver and b is a given n-vector. The
+// result is stored into dst.
+//
+// If A is singular, the contents of dst will be undefined and a Condition
+// error will be returned. If the condition number of A is larger than
+// ConditionTolerance, the result is stored into dst and a Condition error is
+// returned.
+func (a *Circulant) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
+	n := b.Len()
+	if n != a.n {
+		panic(ErrShape)
+	}
+	if bv, ok := b.(RawVectorer); ok && dst != b {
+		dst.checkOverlap(bv.RawVector())
+	}
+
+	var s circulantSpectrum
+	s.init(a.c)
+	cond := s.cond()
+	if math.IsInf(cond, 1) {
+		return Condition(cond)
+	}
+	seq := make([]float64, n)
+	for i := range seq {
+		seq[i] = b.AtVec(i)
+	}
+	s.apply(seq, trans, true)
+	dst.reuseAsNonZeroed(n)
+	for i, v := range seq {
+		dst.setVec(i, v)
+	}
+	if cond > ConditionTolerance {
+		return Condition(cond)
+	}
+	return nil
+}
+
+// circulantSpectrum holds the FFT of the first column of a circulant matrix.
+type circulantSpectrum struct {
+	fft    *fourier.FFT
+	lambda []complex128
+	coeff  []complex128
+}
+
+func (s *circulantSpectrum) init(c []float64) {
+	s.fft = fourier.NewFFT(len(c))
+	s.lambda = s.fft.Coefficients(nil, c)
+	s.coeff = make([]complex128, len(s.lambda))
+}
+
+// cond returns the 2-norm condition number of the circulant matrix.
+func (s *circulantSpectrum) cond() float64 {
+	lo := math.Inf(1)
+	var hi float64
+	for _, l := range s.lambda {
+		abs := cmplx.Abs(l)
+		lo = math.Min(lo, abs)
+		hi = math.Max(hi, abs)
+	}
+	if lo == 0 {
+		return math.Inf(1)
+	}
+	return hi / lo
+}
+
+// apply overwrites x with A⋅x, or with A⁻¹⋅x if inv is true, where A is
+// transposed if trans is true.
+func (s *circulantSpectrum) apply(x []float64, trans, inv bool) {
+	s.fft.Coefficients(s.coeff, x)
+	for k, l := range s.lambda {
+		if trans {
+			// The transpose of a real circulant matrix has
+			// the conjugate eigenvalues.
+			l = cmplx.Conj(l)
+		}
+		if inv {
+			s.coeff[k] /= l
+		} else {
+			s.coeff[k] *= l
+		}
+	}
+	s.fft.Sequence(x, s.coeff)
+	scale := 1 / float64(len(x))
+	for i := range x {
+		x[i] *= scale
+	}
+}
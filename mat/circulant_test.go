@@ -0,0 +1,108 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestCirculant(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 4, 7, 16, 31} {
+		c := make([]float64, n)
+		for i := range c {
+			c[i] = rnd.NormFloat64()
+		}
+		// Make the matrix diagonally dominant.
+		c[0] += float64(n)
+		a := NewCirculant(n, c)
+		want := DenseCopyOf(a)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				if v := c[(i-j+n)%n]; want.At(i, j) != v {
+					t.Fatalf("n=%d: unexpected element (%d,%d): got %v, want %v", n, i, j, want.At(i, j), v)
+				}
+			}
+		}
+
+		// Check the eigenvalues against the definition.
+		lambda := a.Eigenvalues(nil)
+		for k, l := range lambda {
+			var v complex128
+			for j, cj := range c {
+				v += complex(cj, 0) * cmplx.Rect(1, -2*math.Pi*float64(j*k)/float64(n))
+			}
+			if cmplx.Abs(l-v) > tol*float64(n) {
+				t.Errorf("n=%d: unexpected eigenvalue %d: got %v, want %v", n, k, l, v)
+			}
+		}
+
+		for _, trans := range []bool{false, true} {
+			name := fmt.Sprintf("n=%d,trans=%t", n, trans)
+			var aMat, wantMat Matrix = a, want
+			if trans {
+				aMat, wantMat = a.T(), want.T()
+			}
+
+			x := NewVecDense(n, nil)
+			for i := 0; i < n; i++ {
+				x.SetVec(i, rnd.NormFloat64())
+			}
+			var got, ref VecDense
+			got.MulVec(aMat, x)
+			ref.MulVec(wantMat, x)
+			if !EqualApprox(&got, &ref, tol*float64(n)) {
+				t.Errorf("%s: unexpected MulVec result", name)
+			}
+
+			bm := NewDense(n, 3, nil)
+			for i := 0; i < n; i++ {
+				for j := 0; j < 3; j++ {
+					bm.Set(i, j, rnd.NormFloat64())
+				}
+			}
+			var xm, xref Dense
+			if err := a.SolveTo(&xm, trans, bm); err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+			}
+			if err := xref.Solve(wantMat, bm); err != nil {
+				t.Fatalf("%s: bad test: %v", name, err)
+			}
+			if !EqualApprox(&xm, &xref, tol*float64(n)) {
+				t.Errorf("%s: unexpected SolveTo result", name)
+			}
+			var viaSolve Dense
+			if err := viaSolve.Solve(aMat, bm); err != nil {
+				t.Errorf("%s: unexpected error from Dense.Solve: %v", name, err)
+			}
+			if !EqualApprox(&viaSolve, &xref, tol*float64(n)) {
+				t.Errorf("%s: unexpected Dense.Solve result", name)
+			}
+
+			var xv, xvref VecDense
+			if err := a.SolveVecTo(&xv, trans, x); err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+			}
+			xvref.SolveVec(wantMat, x)
+			if !EqualApprox(&xv, &xvref, tol*float64(n)) {
+				t.Errorf("%s: unexpected SolveVecTo result", name)
+			}
+		}
+	}
+
+	// The all-ones circulant matrix is singular.
+	a := NewCirculant(4, []float64{1, 1, 1, 1})
+	var x Dense
+	if _, ok := a.SolveTo(&x, false, NewDense(4, 1, nil)).(Condition); !ok {
+		t.Errorf("expected Condition error for singular circulant matrix")
+	}
+}
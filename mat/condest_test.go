@@ -0,0 +1,126 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestConditionEst(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+
+	hilbert := func(n int) *SymDense {
+		h := NewSymDense(n, nil)
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				h.SetSym(i, j, 1/float64(i+j+1))
+			}
+		}
+		return h
+	}
+	randGeneral := func(n int) *Dense {
+		a := NewDense(n, n, nil)
+		for i := range a.mat.Data {
+			a.mat.Data[i] = rnd.NormFloat64()
+		}
+		return a
+	}
+	randSPD := func(n int) *SymDense {
+		a := randGeneral(n)
+		s := NewSymDense(n, nil)
+		s.SymOuterK(1, a)
+		for i := 0; i < n; i++ {
+			s.SetSym(i, i, s.At(i, i)+float64(n))
+		}
+		return s
+	}
+
+	for _, test := range []struct {
+		name string
+		a    Matrix
+	}{
+		{name: "1×1", a: NewDense(1, 1, []float64{-4})},
+		{name: "identity", a: eye(5)},
+		{name: "random 10", a: randGeneral(10)},
+		{name: "random 50", a: randGeneral(50)},
+		{name: "hilbert 6", a: hilbert(6)},
+		{name: "spd 20", a: randSPD(20)},
+	} {
+		n, _ := test.a.Dims()
+		var inv Dense
+		err := inv.Inverse(test.a)
+		if err != nil {
+			t.Fatalf("%s: unexpected error computing inverse: %v", test.name, err)
+		}
+		want := Norm(test.a, 1) * Norm(&inv, 1)
+
+		var lu LU
+		lu.Factorize(test.a)
+		got := ConditionEst(test.a, &lu)
+		if got > want*(1+1e-8) || got < want/3 {
+			t.Errorf("%s: unexpected LU condition estimate: got %v, want %v", test.name, got, want)
+		}
+
+		if s, ok := test.a.(Symmetric); ok {
+			var chol Cholesky
+			if !chol.Factorize(s) {
+				t.Fatalf("%s: unexpected Cholesky failure", test.name)
+			}
+			got := ConditionEst(test.a, &chol)
+			if got > want*(1+1e-8) || got < want/3 {
+				t.Errorf("%s: unexpected Cholesky condition estimate: got %v, want %v", test.name, got, want)
+			}
+		}
+
+		if ok, _ := panics(func() { ConditionEst(eye(n+1), &lu) }); !ok {
+			t.Errorf("%s: expected panic for mismatched sizes", test.name)
+		}
+	}
+
+	// The estimate reflects the matrix rather than a bound derived from an
+	// updated factorization.
+	for _, n := range []int{3, 10, 25} {
+		name := fmt.Sprintf("rank one n=%d", n)
+		a := randGeneral(n)
+		x := NewVecDense(n, nil)
+		y := NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			x.SetVec(i, rnd.NormFloat64())
+			y.SetVec(i, rnd.NormFloat64())
+		}
+		var lu, updated LU
+		lu.Factorize(a)
+		updated.RankOne(&lu, 0.5, x, y)
+		var b, inv Dense
+		b.RankOne(a, 0.5, x, y)
+		if err := inv.Inverse(&b); err != nil {
+			t.Fatalf("%s: unexpected error computing inverse: %v", name, err)
+		}
+		want := Norm(&b, 1) * Norm(&inv, 1)
+		got := ConditionEst(&b, &updated)
+		if got > want*(1+1e-8) || got < want/3 {
+			t.Errorf("%s: unexpected condition estimate: got %v, want %v", name, got, want)
+		}
+	}
+
+	singular := NewDense(3, 3, []float64{1, 2, 3, 2, 4, 6, 1, 0, 1})
+	var lu LU
+	lu.Factorize(singular)
+	if got := ConditionEst(singular, &lu); !math.IsInf(got, 1) {
+		t.Errorf("unexpected condition estimate for singular matrix: got %v, want +Inf", got)
+	}
+
+	if ok, _ := panics(func() { ConditionEst(eye(2), eye(2)) }); !ok {
+		t.Errorf("expected panic for unsupported factorization")
+	}
+	if ok, _ := panics(func() { ConditionEst(NewDense(2, 3, nil), &lu) }); !ok {
+		t.Errorf("expected panic for non-square matrix")
+	}
+}
@@ -0,0 +1,435 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+const badConvertType = "mat: unsupported Convert destination type"
+
+// Convert copies the elements of src into dst, converting between the storage
+// structures of the two matrices. The destination must be one of *Dense,
+// *VecDense, *SymDense, *TriDense, *BandDense, *SymBandDense, *TriBandDense,
+// *DiagDense or *Tridiag, or implement Mutable.
+//
+// If dst is empty, it is resized to the dimensions of src and its structure is
+// chosen to fit the non-zero elements of src. The bandwidths of a *BandDense,
+// *SymBandDense or *TriBandDense are the smallest that hold the non-zero
+// elements, and the kind of a *TriDense or *TriBandDense is taken from src if
+// it is Triangular, and otherwise is Upper unless src has non-zero elements
+// below the diagonal. If dst is not empty, it must have the dimensions of src
+// and its structure must be able to hold the non-zero elements of src.
+//
+// Convert will panic if the elements of src can not be represented by dst: with
+// ErrShape if the dimensions do not match, with ErrSquare if dst is square
+// and src is not, with ErrNotSymmetric if dst is symmetric and src is not, with
+// ErrTriangleSet if src does not fit the triangle of dst, with ErrBandSet if src
+// does not fit the band of dst, and with ErrDiagSet if src does not fit the
+// diagonal of dst.
+func Convert(dst, src Matrix) {
+	r, c := src.Dims()
+
+	if dst, ok := dst.(*Dense); ok {
+		if !dst.IsEmpty() {
+			if dr, dc := dst.Dims(); dr != r || dc != c {
+				panic(ErrShape)
+			}
+		}
+		dst.reuseAsNonZeroed(r, c)
+		dst.Copy(src)
+		return
+	}
+
+	// The structured types below are written after being zeroed,
+	// so an aliased source must be copied first.
+	if aU, _ := untranspose(src); aU == dst {
+		src = DenseCopyOf(src)
+	}
+
+	switch dst := dst.(type) {
+	case *VecDense:
+		if c != 1 {
+			panic(ErrShape)
+		}
+		if dst.IsEmpty() {
+			dst.reuseAsNonZeroed(r)
+		} else if dst.Len() != r {
+			panic(ErrShape)
+		}
+		if v, ok := src.(Vector); ok {
+			dst.CopyVec(v)
+			return
+		}
+		for i := 0; i < r; i++ {
+			dst.setVec(i, src.At(i, 0))
+		}
+
+	case *SymDense:
+		checkConvertSquare(dst, r, c)
+		if !isSymmetric(src) {
+			panic(ErrNotSymmetric)
+		}
+		dst.reuseAsNonZeroed(r)
+		if s, ok := src.(Symmetric); ok {
+			dst.CopySym(s)
+			return
+		}
+		for i := 0; i < r; i++ {
+			for j := i; j < r; j++ {
+				dst.set(i, j, src.At(i, j))
+			}
+		}
+
+	case *TriDense:
+		checkConvertSquare(dst, r, c)
+		kl, ku := nonZeroBandwidth(src)
+		var kind TriKind
+		if dst.IsEmpty() {
+			kind = convertTriKind(src, kl, ku)
+		} else {
+			_, kind = dst.Triangle()
+		}
+		if (kind == Upper && kl > 0) || (kind == Lower && ku > 0) {
+			panic(ErrTriangleSet)
+		}
+		dst.reuseAsNonZeroed(r, kind)
+		dst.Copy(src)
+
+	case *BandDense:
+		kl, ku := nonZeroBandwidth(src)
+		if dst.IsEmpty() {
+			dst.SetRawBand(NewBandDense(r, c, kl, ku, nil).mat)
+			doNonZero(src, dst.set)
+			return
+		}
+		if dr, dc := dst.Dims(); dr != r || dc != c {
+			panic(ErrShape)
+		}
+		if dkl, dku := dst.Bandwidth(); kl > dkl || ku > dku {
+			panic(ErrBandSet)
+		}
+		dst.Zero()
+		doNonZero(src, dst.set)
+
+	case *SymBandDense:
+		checkConvertSquare(dst, r, c)
+		if !isSymmetric(src) {
+			panic(ErrNotSymmetric)
+		}
+		_, k := nonZeroBandwidth(src)
+		if dst.IsEmpty() {
+			dst.SetRawSymBand(NewSymBandDense(r, k, nil).mat)
+		} else {
+			if _, dk := dst.SymBand(); k > dk {
+				panic(ErrBandSet)
+			}
+			dst.Zero()
+		}
+		doNonZero(src, func(i, j int, v float64) {
+			if i <= j {
+				dst.set(i, j, v)
+			}
+		})
+
+	case *TriBandDense:
+		checkConvertSquare(dst, r, c)
+		kl, ku := nonZeroBandwidth(src)
+		if dst.IsEmpty() {
+			kind := convertTriKind(src, kl, ku)
+			if (kind == Upper && kl > 0) || (kind == Lower && ku > 0) {
+				panic(ErrTriangleSet)
+			}
+			dst.ReuseAsTriBand(r, max(kl, ku), kind)
+		} else {
+			_, dk, kind := dst.TriBand()
+			if (kind == Upper && kl > 0) || (kind == Lower && ku > 0) {
+				panic(ErrTriangleSet)
+			}
+			if max(kl, ku) > dk {
+				panic(ErrBandSet)
+			}
+			dst.Zero()
+		}
+		doNonZero(src, dst.SetTriBand)
+
+	case *DiagDense:
+		checkConvertSquare(dst, r, c)
+		if kl, ku := nonZeroBandwidth(src); kl > 0 || ku > 0 {
+			panic(ErrDiagSet)
+		}
+		dst.reuseAsNonZeroed(r)
+		for i := 0; i < r; i++ {
+			dst.setDiag(i, src.At(i, i))
+		}
+
+	case *Tridiag:
+		checkConvertSquare(dst, r, c)
+		if kl, ku := nonZeroBandwidth(src); kl > 1 || ku > 1 {
+			panic(ErrBandSet)
+		}
+		if dst.IsEmpty() {
+			dst.mat = NewTridiag(r, nil, nil, nil).mat
+		} else {
+			dst.Zero()
+		}
+		doNonZero(src, dst.set)
+
+	case Mutable:
+		if dr, dc := dst.Dims(); dr != r || dc != c {
+			panic(ErrShape)
+		}
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				dst.Set(i, j, src.At(i, j))
+			}
+		}
+
+	default:
+		panic(badConvertType)
+	}
+}
+
+// checkConvertSquare panics if the r×c source of a conversion is not square
+// or does not match the dimensions of the non-empty destination dst.
+func checkConvertSquare(dst Matrix, r, c int) {
+	if r != c {
+		panic(ErrSquare)
+	}
+	if e, ok := dst.(interface{ IsEmpty() bool }); ok && e.IsEmpty() {
+		return
+	}
+	if n, _ := dst.Dims(); n != r {
+		panic(ErrShape)
+	}
+}
+
+// convertTriKind returns the triangle kind to use when converting a to
+// a triangular matrix, given the lower and upper bandwidths of its non-zero
+// elements.
+func convertTriKind(a Matrix, kl, ku int) TriKind {
+	if t, ok := a.(Triangular); ok {
+		_, kind := t.Triangle()
+		return kind
+	}
+	if kl > 0 && ku == 0 {
+		return Lower
+	}
+	return Upper
+}
+
+// isSymmetric returns whether the square matrix a is exactly symmetric.
+func isSymmetric(a Matrix) bool {
+	if _, ok := a.(Symmetric); ok {
+		return true
+	}
+	n, _ := a.Dims()
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if a.At(i, j) != a.At(j, i) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// nonZeroBandwidth returns the smallest lower and upper bandwidths that
+// hold the non-zero elements of a.
+func nonZeroBandwidth(a Matrix) (kl, ku int) {
+	doNonZero(a, func(i, j int, _ float64) {
+		kl = max(kl, i-j)
+		ku = max(ku, j-i)
+	})
+	return kl, ku
+}
+
+// doNonZero calls fn for each of the non-zero elements of a, using the
+// structure of a to avoid visiting elements that are known to be zero.
+func doNonZero(a Matrix, fn func(i, j int, v float64)) {
+	aU, trans := untranspose(a)
+	if trans {
+		f := fn
+		fn = func(i, j int, v float64) { f(j, i, v) }
+	}
+	switch a := aU.(type) {
+	case NonZeroDoer:
+		a.DoNonZero(fn)
+	case RawMatrixer:
+		m := a.RawMatrix()
+		for i := 0; i < m.Rows; i++ {
+			for j, v := range m.Data[i*m.Stride : i*m.Stride+m.Cols] {
+				if v != 0 {
+					fn(i, j, v)
+				}
+			}
+		}
+	case Banded:
+		r, c := a.Dims()
+		kl, ku := a.Bandwidth()
+		for i := 0; i < r; i++ {
+			for j := max(0, i-kl); j < min(c, i+ku+1); j++ {
+				if v := a.At(i, j); v != 0 {
+					fn(i, j, v)
+				}
+			}
+		}
+	case Triangular:
+		n, kind := a.Triangle()
+		for i := 0; i < n; i++ {
+			lo, hi := i, n
+			if kind == Lower {
+				lo, hi = 0, i+1
+			}
+			for j := lo; j < hi; j++ {
+				if v := a.At(i, j); v != 0 {
+					fn(i, j, v)
+				}
+			}
+		}
+	default:
+		r, c := a.Dims()
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				if v := a.At(i, j); v != 0 {
+					fn(i, j, v)
+				}
+			}
+		}
+	}
+}
+
+// PackTri copies the triangle of a into dst in packed storage and returns the
+// packed representation. The rows of the triangle are stored consecutively. If
+// dst is nil, a new slice is allocated, otherwise dst must have length
+// n×(n+1)/2 where n is the size of a, or PackTri will panic.
+func PackTri(dst []float64, a Triangular) blas64.TriangularPacked {
+	n, kind := a.Triangle()
+	if dst == nil {
+		dst = make([]float64, n*(n+1)/2)
+	} else if len(dst) != n*(n+1)/2 {
+		panic(ErrSliceLengthMismatch)
+	}
+	uplo := blas.Upper
+	if kind == Lower {
+		uplo = blas.Lower
+	}
+	var k int
+	for i := 0; i < n; i++ {
+		lo, hi := i, n
+		if kind == Lower {
+			lo, hi = 0, i+1
+		}
+		if rt, ok := a.(RawTriangular); ok {
+			t := rt.RawTriangular()
+			k += copy(dst[k:], t.Data[i*t.Stride+lo:i*t.Stride+hi])
+			continue
+		}
+		for j := lo; j < hi; j++ {
+			dst[k] = a.At(i, j)
+			k++
+		}
+	}
+	return blas64.TriangularPacked{Uplo: uplo, Diag: blas.NonUnit, N: n, Data: dst}
+}
+
+// PackSym copies the upper triangle of a into dst in packed storage and returns
+// the packed representation. The rows of the triangle are stored consecutively.
+// If dst is nil, a new slice is allocated, otherwise dst must have length
+// n×(n+1)/2 where n is the size of a, or PackSym will panic.
+func PackSym(dst []float64, a Symmetric) blas64.SymmetricPacked {
+	n := a.SymmetricDim()
+	if dst == nil {
+		dst = make([]float64, n*(n+1)/2)
+	} else if len(dst) != n*(n+1)/2 {
+		panic(ErrSliceLengthMismatch)
+	}
+	var k int
+	for i := 0; i < n; i++ {
+		if rs, ok := a.(RawSymmetricer); ok {
+			s := rs.RawSymmetric()
+			k += copy(dst[k:], s.Data[i*s.Stride+i:i*s.Stride+n])
+			continue
+		}
+		for j := i; j < n; j++ {
+			dst[k] = a.At(i, j)
+			k++
+		}
+	}
+	return blas64.SymmetricPacked{Uplo: blas.Upper, N: n, Data: dst}
+}
+
+// NewTriDenseFromPacked returns a new TriDense holding the triangular matrix
+// in packed storage p. The elements are copied, so changes to the returned
+// matrix are not reflected in p. If p.Diag is blas.Unit, the diagonal of the
+// returned matrix is set to one.
+func NewTriDenseFromPacked(p blas64.TriangularPacked) *TriDense {
+	if p.N <= 0 {
+		if p.N == 0 {
+			panic(ErrZeroLength)
+		}
+		panic(ErrNegativeDimension)
+	}
+	n := p.N
+	if len(p.Data) < n*(n+1)/2 {
+		panic(ErrShape)
+	}
+	kind := Upper
+	if p.Uplo == blas.Lower {
+		kind = Lower
+	} else if p.Uplo != blas.Upper {
+		panic(ErrTriangle)
+	}
+	t := NewTriDense(n, kind, nil)
+	var k int
+	for i := 0; i < n; i++ {
+		lo, hi := i, n
+		if kind == Lower {
+			lo, hi = 0, i+1
+		}
+		k += copy(t.mat.Data[i*t.mat.Stride+lo:i*t.mat.Stride+hi], p.Data[k:])
+		if p.Diag == blas.Unit {
+			t.mat.Data[i*t.mat.Stride+i] = 1
+		}
+	}
+	return t
+}
+
+// NewSymDenseFromPacked returns a new SymDense holding the symmetric matrix in
+// packed storage p. The elements are copied, so changes to the returned matrix
+// are not reflected in p.
+func NewSymDenseFromPacked(p blas64.SymmetricPacked) *SymDense {
+	if p.N <= 0 {
+		if p.N == 0 {
+			panic(ErrZeroLength)
+		}
+		panic(ErrNegativeDimension)
+	}
+	n := p.N
+	if len(p.Data) < n*(n+1)/2 {
+		panic(ErrShape)
+	}
+	s := NewSymDense(n, nil)
+	var k int
+	switch p.Uplo {
+	case blas.Upper:
+		for i := 0; i < n; i++ {
+			k += copy(s.mat.Data[i*s.mat.Stride+i:i*s.mat.Stride+n], p.Data[k:])
+		}
+	case blas.Lower:
+		// The lower triangle in packed row order is the
+		// transpose of the upper triangle.
+		for i := 0; i < n; i++ {
+			for j := 0; j <= i; j++ {
+				s.mat.Data[j*s.mat.Stride+i] = p.Data[k]
+				k++
+			}
+		}
+	default:
+		panic(ErrTriangle)
+	}
+	return s
+}
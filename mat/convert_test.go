@@ -0,0 +1,350 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestConvert(t *testing.T) {
+	t.Parallel()
+	// The 1-D Laplacian in several representations.
+	lapDense := NewDense(4, 4, []float64{
+		2, -1, 0, 0,
+		-1, 2, -1, 0,
+		0, -1, 2, -1,
+		0, 0, -1, 2,
+	})
+	lapSym := NewSymDense(4, nil)
+	lapSym.CopySym(NewSymDense(4, lapDense.RawMatrix().Data))
+	lapBand := NewBandDense(4, 4, 1, 1, []float64{
+		0, 2, -1,
+		-1, 2, -1,
+		-1, 2, -1,
+		-1, 2, 0,
+	})
+	lapTridiag := NewTridiag(4, []float64{-1, -1, -1}, []float64{2, 2, 2, 2}, []float64{-1, -1, -1})
+	lapSymBand := NewSymBandDense(4, 1, []float64{
+		2, -1,
+		2, -1,
+		2, -1,
+		2, 0,
+	})
+	upper := NewDense(3, 3, []float64{
+		1, 2, 0,
+		0, 3, 4,
+		0, 0, 5,
+	})
+	lower := DenseCopyOf(upper.T())
+	diag := NewDense(3, 3, []float64{
+		1, 0, 0,
+		0, 2, 0,
+		0, 0, 3,
+	})
+	wide := NewDense(2, 4, []float64{
+		1, 2, 0, 0,
+		0, 3, 0, 4,
+	})
+
+	for _, test := range []struct {
+		name string
+		dst  Matrix
+		src  Matrix
+		// check reports whether the structure
+		// of the converted dst is as expected.
+		check func(dst Matrix) bool
+	}{
+		{name: "Sym to Dense", dst: &Dense{}, src: lapSym},
+		{name: "Band to Dense", dst: &Dense{}, src: lapBand.T()},
+		{name: "Dense to Sym", dst: &SymDense{}, src: lapDense},
+		{name: "Tridiag to Sym", dst: &SymDense{}, src: lapTridiag},
+		{
+			name: "Dense to Band",
+			dst:  &BandDense{},
+			src:  lapDense,
+			check: func(dst Matrix) bool {
+				kl, ku := dst.(*BandDense).Bandwidth()
+				return kl == 1 && ku == 1
+			},
+		},
+		{
+			name: "wide Dense to Band",
+			dst:  &BandDense{},
+			src:  wide,
+			check: func(dst Matrix) bool {
+				kl, ku := dst.(*BandDense).Bandwidth()
+				return kl == 0 && ku == 2
+			},
+		},
+		{
+			name: "transposed wide Dense to Band",
+			dst:  &BandDense{},
+			src:  wide.T(),
+			check: func(dst Matrix) bool {
+				kl, ku := dst.(*BandDense).Bandwidth()
+				return kl == 2 && ku == 0
+			},
+		},
+		{
+			name: "Dense to wider Band",
+			dst:  NewBandDense(4, 4, 2, 3, nil),
+			src:  lapDense,
+			check: func(dst Matrix) bool {
+				kl, ku := dst.(*BandDense).Bandwidth()
+				return kl == 2 && ku == 3
+			},
+		},
+		{name: "Band to Tridiag", dst: &Tridiag{}, src: lapBand},
+		{name: "SymBand to Tridiag", dst: NewTridiag(4, nil, nil, nil), src: lapSymBand},
+		{name: "Dense to Tridiag", dst: &Tridiag{}, src: lapDense},
+		{name: "Tridiag to Band", dst: &BandDense{}, src: lapTridiag},
+		{
+			name: "Dense to SymBand",
+			dst:  &SymBandDense{},
+			src:  lapDense,
+			check: func(dst Matrix) bool {
+				_, k := dst.(*SymBandDense).SymBand()
+				return k == 1
+			},
+		},
+		{
+			name: "Dense to Tri",
+			dst:  &TriDense{},
+			src:  upper,
+			check: func(dst Matrix) bool {
+				_, kind := dst.(*TriDense).Triangle()
+				return kind == Upper
+			},
+		},
+		{
+			name: "lower Dense to Tri",
+			dst:  &TriDense{},
+			src:  lower,
+			check: func(dst Matrix) bool {
+				_, kind := dst.(*TriDense).Triangle()
+				return kind == Lower
+			},
+		},
+		{
+			name: "diagonal Dense to lower Tri",
+			dst:  NewTriDense(3, Lower, nil),
+			src:  diag,
+		},
+		{
+			name: "Dense to TriBand",
+			dst:  &TriBandDense{},
+			src:  lower,
+			check: func(dst Matrix) bool {
+				_, k, kind := dst.(*TriBandDense).TriBand()
+				return k == 1 && kind == Lower
+			},
+		},
+		{
+			name: "Tri to TriBand",
+			dst:  &TriBandDense{},
+			src:  NewTriDense(3, Upper, []float64{1, 0, 0, 0, 2, 0, 0, 0, 3}),
+			check: func(dst Matrix) bool {
+				_, k, kind := dst.(*TriBandDense).TriBand()
+				return k == 0 && kind == Upper
+			},
+		},
+		{name: "Dense to Diag", dst: &DiagDense{}, src: diag},
+		{name: "Diag to Band", dst: &BandDense{}, src: NewDiagDense(3, []float64{1, 2, 3})},
+		{name: "Dense to VecDense", dst: &VecDense{}, src: NewDense(3, 1, []float64{1, 0, -2})},
+		{name: "Dense to non-empty VecDense", dst: NewVecDense(3, []float64{7, 7, 7}), src: NewDense(3, 1, []float64{1, 0, -2})},
+		{name: "CSR to Band", dst: &BandDense{}, src: NewCSR(2, 4, []int{0, 2, 4}, []int{0, 1, 1, 3}, []float64{1, 2, 3, 4})},
+		{name: "Sym to Mutable", dst: &basicMutable{m: NewDense(4, 4, nil)}, src: lapSym},
+	} {
+		Convert(test.dst, test.src)
+		if !Equal(test.dst, test.src) {
+			t.Errorf("%s: unexpected result:\ngot:\n%v\nwant:\n%v",
+				test.name, Formatted(test.dst), Formatted(test.src))
+		}
+		if test.check != nil && !test.check(test.dst) {
+			t.Errorf("%s: unexpected structure", test.name)
+		}
+	}
+}
+
+// basicMutable is a Mutable that is not a built-in type.
+type basicMutable struct{ m *Dense }
+
+func (b *basicMutable) Dims() (r, c int)        { return b.m.Dims() }
+func (b *basicMutable) At(i, j int) float64     { return b.m.At(i, j) }
+func (b *basicMutable) Set(i, j int, v float64) { b.m.Set(i, j, v) }
+func (b *basicMutable) T() Matrix               { return Transpose{b} }
+
+func TestConvertOverwrite(t *testing.T) {
+	t.Parallel()
+	// Non-empty destinations have elements outside the
+	// source's structure cleared.
+	b := NewBandDense(3, 3, 1, 1, []float64{
+		0, 9, 9,
+		9, 9, 9,
+		9, 9, 0,
+	})
+	src := NewDiagDense(3, []float64{1, 2, 3})
+	Convert(b, src)
+	if !Equal(b, src) {
+		t.Errorf("unexpected band result:\n%v", Formatted(b))
+	}
+	tri := NewTridiag(3, []float64{9, 9}, []float64{9, 9, 9}, []float64{9, 9})
+	Convert(tri, src)
+	if !Equal(tri, src) {
+		t.Errorf("unexpected tridiagonal result:\n%v", Formatted(tri))
+	}
+
+	// Converting from the transpose of the receiver.
+	b = NewBandDense(3, 3, 1, 1, []float64{
+		0, 1, 2,
+		3, 4, 5,
+		6, 7, 0,
+	})
+	want := DenseCopyOf(b.T())
+	Convert(b, b.T())
+	if !Equal(b, want) {
+		t.Errorf("unexpected result for aliased conversion:\ngot:\n%v\nwant:\n%v", Formatted(b), Formatted(want))
+	}
+}
+
+func TestConvertPanics(t *testing.T) {
+	t.Parallel()
+	full := NewDense(3, 3, []float64{
+		1, 2, 3,
+		4, 5, 6,
+		7, 8, 9,
+	})
+	tridiag := NewDense(3, 3, []float64{
+		1, 2, 0,
+		4, 5, 6,
+		0, 8, 9,
+	})
+	for _, test := range []struct {
+		name string
+		dst  Matrix
+		src  Matrix
+		want Error
+	}{
+		{name: "Dense shape", dst: NewDense(2, 3, nil), src: full, want: ErrShape},
+		{name: "VecDense columns", dst: &VecDense{}, src: full, want: ErrShape},
+		{name: "VecDense length", dst: NewVecDense(2, nil), src: NewVecDense(3, nil), want: ErrShape},
+		{name: "Sym square", dst: &SymDense{}, src: NewDense(2, 3, nil), want: ErrSquare},
+		{name: "Sym shape", dst: NewSymDense(2, nil), src: NewSymDense(3, nil), want: ErrShape},
+		{name: "Sym not symmetric", dst: &SymDense{}, src: full, want: ErrNotSymmetric},
+		{name: "SymBand not symmetric", dst: &SymBandDense{}, src: tridiag, want: ErrNotSymmetric},
+		{name: "Tri full", dst: &TriDense{}, src: full, want: ErrTriangleSet},
+		{name: "Tri kind", dst: NewTriDense(3, Upper, nil), src: NewTriDense(3, Lower, []float64{1, 0, 0, 1, 1, 0, 1, 1, 1}), want: ErrTriangleSet},
+		{name: "TriBand full", dst: &TriBandDense{}, src: full, want: ErrTriangleSet},
+		{name: "TriBand bandwidth", dst: NewTriBandDense(3, 0, Upper, nil), src: NewTriDense(3, Upper, []float64{1, 1, 0, 0, 1, 0, 0, 0, 1}), want: ErrBandSet},
+		{name: "Band bandwidth", dst: NewBandDense(3, 3, 1, 1, nil), src: full, want: ErrBandSet},
+		{name: "Tridiag bandwidth", dst: &Tridiag{}, src: full, want: ErrBandSet},
+		{name: "Diag", dst: &DiagDense{}, src: tridiag, want: ErrDiagSet},
+	} {
+		panicked, message := panics(func() { Convert(test.dst, test.src) })
+		if !panicked || message != test.want.Error() {
+			t.Errorf("%s: unexpected panic: got:%q want:%q", test.name, message, test.want.Error())
+		}
+	}
+
+	panicked, message := panics(func() { Convert(Transpose{NewDense(3, 3, nil)}, full) })
+	if !panicked || message != badConvertType {
+		t.Errorf("unexpected panic for unsupported type: got:%q want:%q", message, badConvertType)
+	}
+}
+
+func TestPacked(t *testing.T) {
+	t.Parallel()
+	upper := NewTriDense(3, Upper, []float64{
+		1, 2, 3,
+		0, 4, 5,
+		0, 0, 6,
+	})
+	lower := NewTriDense(3, Lower, []float64{
+		1, 0, 0,
+		2, 3, 0,
+		4, 5, 6,
+	})
+	for _, test := range []struct {
+		a    Triangular
+		uplo blas.Uplo
+	}{
+		{a: upper, uplo: blas.Upper},
+		{a: lower, uplo: blas.Lower},
+		{a: upper.TTri(), uplo: blas.Lower},
+		{a: lower.TTri(), uplo: blas.Upper},
+	} {
+		p := PackTri(nil, test.a)
+		if p.Uplo != test.uplo || p.Diag != blas.NonUnit || p.N != 3 {
+			t.Errorf("unexpected packed header: %+v", p)
+		}
+		if test.a == upper || test.a == lower {
+			want := []float64{1, 2, 3, 4, 5, 6}
+			if !floats.Equal(p.Data, want) {
+				t.Errorf("unexpected packed data: got:%v want:%v", p.Data, want)
+			}
+		}
+		got := NewTriDenseFromPacked(p)
+		if !Equal(got, test.a) {
+			t.Errorf("unexpected unpacked triangle:\ngot:\n%v\nwant:\n%v", Formatted(got), Formatted(test.a))
+		}
+
+		// The packed triangle gives the same product.
+		x := []float64{1, -2, 0.5}
+		want := NewVecDense(3, nil)
+		want.MulVec(test.a, NewVecDense(3, x))
+		y := append([]float64(nil), x...)
+		blas64.Tpmv(blas.NoTrans, p, blas64.Vector{N: 3, Inc: 1, Data: y})
+		if !floats.EqualApprox(y, want.RawVector().Data, 1e-14) {
+			t.Errorf("unexpected packed product: got:%v want:%v", y, want.RawVector().Data)
+		}
+	}
+
+	p := PackTri(nil, upper)
+	p.Diag = blas.Unit
+	unit := NewTriDenseFromPacked(p)
+	for i := 0; i < 3; i++ {
+		if unit.At(i, i) != 1 {
+			t.Errorf("unexpected diagonal for unit triangle: got:%v want:1", unit.At(i, i))
+		}
+	}
+
+	sym := NewSymDense(3, []float64{
+		1, 2, 3,
+		2, 4, 5,
+		3, 5, 6,
+	})
+	ps := PackSym(make([]float64, 6), sym)
+	if !floats.Equal(ps.Data, []float64{1, 2, 3, 4, 5, 6}) || ps.Uplo != blas.Upper {
+		t.Errorf("unexpected packed symmetric: %+v", ps)
+	}
+	if got := NewSymDenseFromPacked(ps); !Equal(got, sym) {
+		t.Errorf("unexpected unpacked symmetric:\n%v", Formatted(got))
+	}
+	ps = PackSym(nil, NewDiagDense(3, []float64{1, 2, 3}))
+	if !floats.Equal(ps.Data, []float64{1, 0, 0, 2, 0, 3}) {
+		t.Errorf("unexpected packed diagonal: %v", ps.Data)
+	}
+	lowerPacked := blas64.SymmetricPacked{Uplo: blas.Lower, N: 3, Data: []float64{1, 2, 4, 3, 5, 6}}
+	if got := NewSymDenseFromPacked(lowerPacked); !Equal(got, sym) {
+		t.Errorf("unexpected unpacked lower symmetric:\n%v", Formatted(got))
+	}
+
+	for _, fn := range []func(){
+		func() { PackTri(make([]float64, 5), upper) },
+		func() { PackSym(make([]float64, 7), sym) },
+		func() {
+			NewTriDenseFromPacked(blas64.TriangularPacked{Uplo: blas.Upper, N: 3, Data: make([]float64, 5)})
+		},
+		func() { NewSymDenseFromPacked(blas64.SymmetricPacked{Uplo: blas.All, N: 1, Data: []float64{1}}) },
+	} {
+		if ok, _ := panics(fn); !ok {
+			t.Errorf("expected panic")
+		}
+	}
+}
@@ -0,0 +1,307 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+const badCQR = "mat: invalid complex QR factorization"
+
+// CQR is a type for creating and using the QR factorization of a complex
+// matrix.
+type CQR struct {
+	qr   *CDense
+	tau  []complex128
+	cond float64
+}
+
+// Dims returns the dimensions of the factorized matrix.
+func (qr *CQR) Dims() (r, c int) {
+	if qr.qr == nil {
+		return 0, 0
+	}
+	return qr.qr.Dims()
+}
+
+// Factorize computes the QR factorization of a complex m×n matrix a where
+// m >= n. The QR factorization always exists even if A is singular.
+//
+// The QR decomposition is a factorization of the matrix A such that A = Q * R.
+// The matrix Q is a unitary m×m matrix, and R is an m×n upper triangular
+// matrix. Q and R can be extracted using the QTo and RTo methods.
+func (qr *CQR) Factorize(a CMatrix) {
+	m, n := a.Dims()
+	if m < n {
+		panic(ErrShape)
+	}
+	if qr.qr == nil {
+		qr.qr = &CDense{}
+	}
+	qr.qr.Reset()
+	qr.qr.reuseAsNonZeroed(m, n)
+	qr.qr.Copy(a)
+	qr.tau = make([]complex128, n)
+
+	q := qr.qr.mat
+	work := make([]complex128, n)
+	for j := 0; j < n; j++ {
+		// Generate the reflector H_j that annihilates A[j+1:m, j].
+		qr.tau[j] = clarfg(&q.Data[j*q.Stride+j], cblas128.Vector{N: m - j - 1, Inc: q.Stride, Data: q.Data[min(j+1, m-1)*q.Stride+j:]})
+		if j < n-1 {
+			// Apply H_jᴴ to A[j:m, j+1:n] from the left.
+			ajj := q.Data[j*q.Stride+j]
+			q.Data[j*q.Stride+j] = 1
+			clarf(cmplx.Conj(qr.tau[j]),
+				cblas128.Vector{N: m - j, Inc: q.Stride, Data: q.Data[j*q.Stride+j:]},
+				cblas128.General{Rows: m - j, Cols: n - j - 1, Stride: q.Stride, Data: q.Data[j*q.Stride+j+1:]},
+				work)
+			q.Data[j*q.Stride+j] = ajj
+		}
+	}
+	qr.updateCond()
+}
+
+// updateCond estimates the condition number of A in the 1-norm from the
+// condition number of R, which is equal in the 2-norm since Q is unitary.
+func (qr *CQR) updateCond() {
+	n := qr.qr.mat.Cols
+	r := qr.triR()
+	for i := 0; i < n; i++ {
+		if r.Data[i*r.Stride+i] == 0 {
+			qr.cond = math.Inf(1)
+			return
+		}
+	}
+	rnorm := cnorm1(n, n, func(i, j int) complex128 {
+		if i > j {
+			return 0
+		}
+		return r.Data[i*r.Stride+j]
+	})
+	rinvnm := cnormEst1(n, func(x []complex128, conj bool) {
+		t := blas.NoTrans
+		if conj {
+			t = blas.ConjTrans
+		}
+		cblas128.Trsv(t, r, cblas128.Vector{N: n, Inc: 1, Data: x})
+	})
+	qr.cond = rnorm * rinvnm
+}
+
+// triR returns the n×n upper triangular matrix R₁ of the first n rows of R.
+func (qr *CQR) triR() cblas128.Triangular {
+	return cblas128.Triangular{
+		N:      qr.qr.mat.Cols,
+		Stride: qr.qr.mat.Stride,
+		Data:   qr.qr.mat.Data,
+		Uplo:   blas.Upper,
+		Diag:   blas.NonUnit,
+	}
+}
+
+// isValid returns whether the receiver contains a factorization.
+func (qr *CQR) isValid() bool {
+	return qr.qr != nil && !qr.qr.IsEmpty()
+}
+
+// Cond returns the condition number for the factorized matrix.
+// Cond will panic if the receiver does not contain a factorization.
+func (qr *CQR) Cond() float64 {
+	if !qr.isValid() {
+		panic(badCQR)
+	}
+	return qr.cond
+}
+
+// RTo extracts the m×n upper trapezoidal matrix R from the factorization.
+//
+// If dst is empty, RTo will resize dst to be m×n. When dst is non-empty,
+// RTo will panic if dst is not m×n. RTo will also panic if the receiver
+// does not contain a factorization.
+func (qr *CQR) RTo(dst *CDense) {
+	if !qr.isValid() {
+		panic(badCQR)
+	}
+
+	r, c := qr.qr.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || c != c2 {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(qr.qr)
+
+	// Zero below the diagonal.
+	for i := 1; i < r; i++ {
+		zeroC(dst.mat.Data[i*dst.mat.Stride : i*dst.mat.Stride+min(i, c)])
+	}
+}
+
+// QTo extracts the m×m unitary matrix Q from the factorization.
+//
+// If dst is empty, QTo will resize dst to be m×m. When dst is non-empty,
+// QTo will panic if dst is not m×m. QTo will also panic if the receiver
+// does not contain a factorization.
+func (qr *CQR) QTo(dst *CDense) {
+	if !qr.isValid() {
+		panic(badCQR)
+	}
+
+	r, _ := qr.qr.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, r)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || r != c2 {
+			panic(ErrShape)
+		}
+		dst.Zero()
+	}
+	for i := 0; i < r; i++ {
+		dst.mat.Data[i*dst.mat.Stride+i] = 1
+	}
+	qr.applyQ(false, dst)
+}
+
+// SolveTo finds a minimum-norm solution to a system of linear equations defined
+// by the matrices A and b, where A is an m×n matrix represented in its QR
+// factorized form. If A is singular or near-singular a Condition error is
+// returned. See the documentation for Condition for more information.
+//
+// The minimization problem solved depends on the input parameters.
+//
+//	If trans == false, find X such that ||A*X - B||_2 is minimized.
+//	If trans == true, find the minimum norm solution of Aᴴ * X = B.
+//
+// The solution matrix, X, is stored in place into dst.
+// SolveTo will panic if the receiver does not contain a factorization.
+func (qr *CQR) SolveTo(dst *CDense, trans bool, b CMatrix) error {
+	if !qr.isValid() {
+		panic(badCQR)
+	}
+
+	r, c := qr.qr.Dims()
+	br, bc := b.Dims()
+
+	// The QR solve algorithm stores the result in-place into the right hand side.
+	// The storage for the answer must be large enough to hold both b and x.
+	// However, this method's receiver must be the size of x. Copy b, and then
+	// copy the result into dst at the end.
+	if trans {
+		if c != br {
+			panic(ErrShape)
+		}
+	} else {
+		if r != br {
+			panic(ErrShape)
+		}
+	}
+	w := getCDenseWorkspace(max(r, c), bc, false)
+	w.Copy(b)
+	if trans {
+		dst.reuseAsNonZeroed(r, bc)
+	} else {
+		dst.reuseAsNonZeroed(c, bc)
+	}
+	if math.IsInf(qr.cond, 1) {
+		putCDenseWorkspace(w)
+		return Condition(qr.cond)
+	}
+	t := qr.triR()
+	if trans {
+		cblas128.Trsm(blas.Left, blas.ConjTrans, 1, t, w.slice(0, c, 0, bc).mat)
+		for i := c; i < r; i++ {
+			zeroC(w.mat.Data[i*w.mat.Stride : i*w.mat.Stride+bc])
+		}
+		qr.applyQ(false, w)
+	} else {
+		qr.applyQ(true, w)
+		cblas128.Trsm(blas.Left, blas.NoTrans, 1, t, w.slice(0, c, 0, bc).mat)
+	}
+	// X was set above to be the correct size for the result.
+	dst.Copy(w)
+	putCDenseWorkspace(w)
+	if qr.cond > ConditionTolerance {
+		return Condition(qr.cond)
+	}
+	return nil
+}
+
+// applyQ overwrites the m×k matrix w with Q*w, or with Qᴴ*w if conj is true.
+func (qr *CQR) applyQ(conj bool, w *CDense) {
+	m, n := qr.qr.Dims()
+	q := qr.qr.mat
+	k := w.mat.Cols
+	work := getCDenseWorkspace(1, k, false)
+	apply := func(j int) {
+		tau := qr.tau[j]
+		if conj {
+			tau = cmplx.Conj(tau)
+		}
+		ajj := q.Data[j*q.Stride+j]
+		q.Data[j*q.Stride+j] = 1
+		clarf(tau,
+			cblas128.Vector{N: m - j, Inc: q.Stride, Data: q.Data[j*q.Stride+j:]},
+			cblas128.General{Rows: m - j, Cols: k, Stride: w.mat.Stride, Data: w.mat.Data[j*w.mat.Stride:]},
+			work.mat.Data)
+		q.Data[j*q.Stride+j] = ajj
+	}
+	// Q = H_0 * H_1 * ... * H_{n-1}.
+	if conj {
+		for j := 0; j < n; j++ {
+			apply(j)
+		}
+	} else {
+		for j := n - 1; j >= 0; j-- {
+			apply(j)
+		}
+	}
+	putCDenseWorkspace(work)
+}
+
+// clarf applies the elementary reflector H = I - tau * v * vᴴ to the matrix c
+// from the left. work must have length at least c.Cols.
+func clarf(tau complex128, v cblas128.Vector, c cblas128.General, work []complex128) {
+	if tau == 0 || c.Cols == 0 {
+		return
+	}
+	w := cblas128.Vector{N: c.Cols, Inc: 1, Data: work}
+	cblas128.Gemv(blas.ConjTrans, 1, c, v, 0, w)
+	cblas128.Gerc(-tau, v, w, c)
+}
+
+// clarfg generates an elementary reflector H of order n+1 such that
+//
+//	Hᴴ * [alpha] = [beta]
+//	     [  x  ]   [  0 ]
+//
+// where H = I - tau * [1; v] * [1; v]ᴴ and beta is real. On return alpha is
+// overwritten by beta and x by v. clarfg returns tau, which is zero if H is
+// the identity.
+func clarfg(alpha *complex128, x cblas128.Vector) (tau complex128) {
+	var xnorm float64
+	if x.N > 0 {
+		xnorm = cblas128.Nrm2(x)
+	}
+	alphr, alphi := real(*alpha), imag(*alpha)
+	if xnorm == 0 && alphi == 0 {
+		return 0
+	}
+	beta := -math.Copysign(math.Hypot(math.Hypot(alphr, alphi), xnorm), alphr)
+	tau = complex((beta-alphr)/beta, -alphi/beta)
+	if x.N > 0 {
+		cblas128.Scal(1/(*alpha-complex(beta, 0)), x)
+	}
+	*alpha = complex(beta, 0)
+	return tau
+}
@@ -0,0 +1,111 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestCQR(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{1, 1},
+		{3, 3},
+		{5, 5},
+		{3, 1},
+		{6, 3},
+		{20, 10},
+		{30, 30},
+	} {
+		m, n := test.m, test.n
+		name := fmt.Sprintf("m=%d,n=%d", m, n)
+		a := randCDense(m, n, rnd)
+		var qr CQR
+		qr.Factorize(a)
+		if r, c := qr.Dims(); r != m || c != n {
+			t.Errorf("%s: unexpected dimensions: got %d×%d", name, r, c)
+		}
+
+		var q, r CDense
+		qr.QTo(&q)
+		qr.RTo(&r)
+		if !CEqualApprox(cmul(q.H(), &q), ceye(m), tol*float64(m)) {
+			t.Errorf("%s: Q is not unitary", name)
+		}
+		for i := 0; i < m; i++ {
+			for j := 0; j < min(i, n); j++ {
+				if r.At(i, j) != 0 {
+					t.Errorf("%s: R not upper triangular at (%d,%d)", name, i, j)
+				}
+			}
+		}
+		if !CEqualApprox(cmul(&q, &r), a, tol*float64(m)) {
+			t.Errorf("%s: Q*R != A", name)
+		}
+
+		// Extraction into non-empty destinations.
+		q2 := randCDense(m, m, rnd)
+		qr.QTo(q2)
+		if !CEqual(q2, &q) {
+			t.Errorf("%s: QTo into non-empty destination mismatch", name)
+		}
+		if ok, _ := panics(func() { qr.RTo(NewCDense(m+1, n, nil)) }); !ok {
+			t.Errorf("%s: expected panic for bad RTo shape", name)
+		}
+
+		// Least squares solution of the normal equations Aᴴ*A*x = Aᴴ*b.
+		b := randCDense(m, 2, rnd)
+		var x CDense
+		if err := qr.SolveTo(&x, false, b); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		resid := cmul(a, &x)
+		for i := range resid.mat.Data {
+			resid.mat.Data[i] -= b.mat.Data[i]
+		}
+		if !CEqualApprox(cmul(a.H(), resid), NewCDense(n, 2, nil), tol*float64(m)*10) {
+			t.Errorf("%s: residual not orthogonal to range of A", name)
+		}
+
+		// Minimum-norm solution of Aᴴ*x = b.
+		bt := randCDense(n, 2, rnd)
+		var xt CDense
+		if err := qr.SolveTo(&xt, true, bt); err != nil {
+			t.Errorf("%s: unexpected error for trans: %v", name, err)
+			continue
+		}
+		if !CEqualApprox(cmul(a.H(), &xt), bt, tol*float64(m)*10) {
+			t.Errorf("%s: Aᴴ*X != B", name)
+		}
+	}
+
+	// Rank deficient matrices report a Condition error.
+	var qr CQR
+	qr.Factorize(NewCDense(3, 2, []complex128{1, 1i, 2, 2i, 3, 3i}))
+	var x CDense
+	if err := qr.SolveTo(&x, false, NewCDense(3, 1, []complex128{1, 2, 3})); err == nil {
+		t.Errorf("expected error for rank deficient matrix")
+	}
+	if ok, _ := panics(func() { qr.Factorize(NewCDense(2, 3, nil)) }); !ok {
+		t.Errorf("expected panic for wide matrix")
+	}
+}
+
+// ceye returns the n×n complex identity matrix.
+func ceye(n int) *CDense {
+	m := NewCDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		m.set(i, i, 1)
+	}
+	return m
+}
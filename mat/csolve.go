@@ -0,0 +1,251 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// Solve solves the linear least squares problem
+//
+//	minimize over x |b - A*x|_2
+//
+// where A is a complex m×n matrix, b is a given m element vector and x is n
+// element solution vector. Solve assumes that A has full rank, that is
+//
+//	rank(A) = min(m,n)
+//
+// If m == n, Solve computes the LU factorization of A with partial pivoting
+// and solves the square system A*x = b.
+//
+// If m > n, Solve finds the unique least squares solution of an overdetermined
+// system using the QR factorization of A.
+//
+// If m < n, there is an infinite number of solutions that satisfy b-A*x=0. In
+// this case Solve finds the unique solution of an underdetermined system that
+// minimizes |x|_2 using the QR factorization of Aᴴ.
+//
+// Several right-hand side vectors b and solution vectors x can be handled in a
+// single call. Vectors b are stored in the columns of the m×k matrix B. Vectors
+// x will be stored in-place into the n×k receiver.
+//
+// If A is singular or near-singular a Condition error is returned. See the
+// documentation for Condition for more information.
+func (m *CDense) Solve(a, b CMatrix) error {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br {
+		panic(ErrShape)
+	}
+
+	switch {
+	case ar == ac:
+		anorm := cnorm1(ar, ac, a.At)
+		lu := NewCDense(ar, ac, nil)
+		lu.Copy(a)
+		ipiv := make([]int, ar)
+		ok := cgetrf(lu.mat, ipiv)
+
+		// Copy b before the receiver is written in case they alias.
+		w := getCDenseWorkspace(br, bc, false)
+		w.Copy(b)
+		m.reuseAsNonZeroed(ac, bc)
+		if !ok {
+			putCDenseWorkspace(w)
+			return Condition(math.Inf(1))
+		}
+		cgetrs(false, lu.mat, ipiv, w.mat)
+		m.Copy(w)
+		putCDenseWorkspace(w)
+
+		ainvnm := cnormEst1(ar, func(x []complex128, conj bool) {
+			cgetrs(conj, lu.mat, ipiv, cblas128.General{Rows: ar, Cols: 1, Stride: 1, Data: x})
+		})
+		if cond := anorm * ainvnm; cond > ConditionTolerance {
+			return Condition(cond)
+		}
+		return nil
+	case ar > ac:
+		var qr CQR
+		qr.Factorize(a)
+		return qr.SolveTo(m, false, b)
+	default:
+		var qr CQR
+		qr.Factorize(a.H())
+		return qr.SolveTo(m, true, b)
+	}
+}
+
+// cgetrf computes the LU factorization of the n×n matrix a using partial
+// pivoting with row interchanges, overwriting a with the unit lower triangular
+// factor L and the upper triangular factor U. The row interchanges are stored
+// in ipiv so that row i was interchanged with row ipiv[i]. cgetrf returns
+// whether U is non-singular. If it is not, the factorization is still
+// completed, but U must not be used to solve a system of equations.
+func cgetrf(a cblas128.General, ipiv []int) (ok bool) {
+	n := a.Rows
+	ok = true
+	for j := 0; j < n; j++ {
+		col := cblas128.Vector{N: n - j, Inc: a.Stride, Data: a.Data[j*a.Stride+j:]}
+		p := j + cblas128.Iamax(col)
+		ipiv[j] = p
+		if a.Data[p*a.Stride+j] == 0 {
+			ok = false
+			continue
+		}
+		if p != j {
+			cblas128.Swap(
+				cblas128.Vector{N: n, Inc: 1, Data: a.Data[j*a.Stride:]},
+				cblas128.Vector{N: n, Inc: 1, Data: a.Data[p*a.Stride:]},
+			)
+		}
+		if j == n-1 {
+			break
+		}
+		below := cblas128.Vector{N: n - j - 1, Inc: a.Stride, Data: a.Data[(j+1)*a.Stride+j:]}
+		cblas128.Scal(1/a.Data[j*a.Stride+j], below)
+		cblas128.Geru(-1,
+			below,
+			cblas128.Vector{N: n - j - 1, Inc: 1, Data: a.Data[j*a.Stride+j+1:]},
+			cblas128.General{
+				Rows:   n - j - 1,
+				Cols:   n - j - 1,
+				Stride: a.Stride,
+				Data:   a.Data[(j+1)*a.Stride+j+1:],
+			},
+		)
+	}
+	return ok
+}
+
+// cgetrs solves the system A*X = B, or Aᴴ*X = B if conj is true, using the LU
+// factorization of A computed by cgetrf. B is overwritten by the solution X.
+func cgetrs(conj bool, lu cblas128.General, ipiv []int, b cblas128.General) {
+	n := lu.Rows
+	if n == 0 || b.Cols == 0 {
+		return
+	}
+	l := cblas128.Triangular{N: n, Stride: lu.Stride, Data: lu.Data, Uplo: blas.Lower, Diag: blas.Unit}
+	u := cblas128.Triangular{N: n, Stride: lu.Stride, Data: lu.Data, Uplo: blas.Upper, Diag: blas.NonUnit}
+	swap := func(i, p int) {
+		if i == p {
+			return
+		}
+		cblas128.Swap(
+			cblas128.Vector{N: b.Cols, Inc: 1, Data: b.Data[i*b.Stride:]},
+			cblas128.Vector{N: b.Cols, Inc: 1, Data: b.Data[p*b.Stride:]},
+		)
+	}
+	if !conj {
+		for i, p := range ipiv {
+			swap(i, p)
+		}
+		cblas128.Trsm(blas.Left, blas.NoTrans, 1, l, b)
+		cblas128.Trsm(blas.Left, blas.NoTrans, 1, u, b)
+		return
+	}
+	cblas128.Trsm(blas.Left, blas.ConjTrans, 1, u, b)
+	cblas128.Trsm(blas.Left, blas.ConjTrans, 1, l, b)
+	for i := n - 1; i >= 0; i-- {
+		swap(i, ipiv[i])
+	}
+}
+
+// cnorm1 returns the 1-norm, the maximum absolute column sum, of the r×c
+// matrix whose elements are returned by at.
+func cnorm1(r, c int, at func(i, j int) complex128) float64 {
+	var norm float64
+	for j := 0; j < c; j++ {
+		var sum float64
+		for i := 0; i < r; i++ {
+			sum += cmplx.Abs(at(i, j))
+		}
+		if sum > norm || math.IsNaN(sum) {
+			norm = sum
+		}
+	}
+	return norm
+}
+
+// cnormEst1 estimates the 1-norm of an n×n complex matrix B that is only
+// available through solve, which must overwrite x with B*x, or with Bᴴ*x if
+// conj is true. It is typically used with B = A⁻¹ to estimate the condition
+// number of A from a factorization without forming the inverse.
+//
+// cnormEst1 uses Hager's method with Higham's modifications, the algorithm
+// of the LAPACK routine Zlacn2.
+func cnormEst1(n int, solve func(x []complex128, conj bool)) float64 {
+	const itmax = 5
+	if n == 0 {
+		return 0
+	}
+	x := make([]complex128, n)
+	for i := range x {
+		x[i] = complex(1/float64(n), 0)
+	}
+	solve(x, false)
+	if n == 1 {
+		return cmplx.Abs(x[0])
+	}
+	est := asum1(x)
+
+	jlast := -1
+	for iter := 0; iter < itmax; iter++ {
+		for i, v := range x {
+			if a := cmplx.Abs(v); a > 0 {
+				x[i] = v / complex(a, 0)
+			} else {
+				x[i] = 1
+			}
+		}
+		solve(x, true)
+		j := 0
+		for i, v := range x {
+			if cmplx.Abs(v) > cmplx.Abs(x[j]) {
+				j = i
+			}
+		}
+		if j == jlast {
+			break
+		}
+		jlast = j
+		zeroC(x)
+		x[j] = 1
+		solve(x, false)
+		newEst := asum1(x)
+		if newEst <= est {
+			break
+		}
+		est = newEst
+	}
+
+	// Compare with the alternating sign vector to guard against the
+	// rare matrices for which the iteration underestimates the norm.
+	for i := range x {
+		sign := 1.0
+		if i%2 == 1 {
+			sign = -1
+		}
+		x[i] = complex(sign*(1+float64(i)/float64(n-1)), 0)
+	}
+	solve(x, false)
+	if alt := 2 * asum1(x) / float64(3*n); alt > est {
+		est = alt
+	}
+	return est
+}
+
+// asum1 returns the sum of the absolute values of the elements of x.
+func asum1(x []complex128) float64 {
+	var sum float64
+	for _, v := range x {
+		sum += cmplx.Abs(v)
+	}
+	return sum
+}
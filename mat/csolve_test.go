@@ -0,0 +1,150 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestCDenseSolve(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, k int
+	}{
+		{1, 1, 1},
+		{3, 3, 1},
+		{5, 5, 3},
+		{20, 20, 2},
+		{6, 4, 2},
+		{20, 7, 3},
+		{4, 6, 2},
+		{7, 20, 1},
+	} {
+		m, n, k := test.m, test.n, test.k
+		name := fmt.Sprintf("m=%d,n=%d,k=%d", m, n, k)
+		a := randCDense(m, n, rnd)
+		x := randCDense(n, k, rnd)
+		var b *CDense
+		switch {
+		case m >= n:
+			// Consistent systems have an exact solution.
+			b = cmul(a, x)
+		default:
+			// Underdetermined systems have a minimum-norm solution
+			// in the row space of A.
+			b = randCDense(m, k, rnd)
+		}
+
+		var got CDense
+		err := got.Solve(a, b)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if r, c := got.Dims(); r != n || c != k {
+			t.Errorf("%s: unexpected solution shape: got %d×%d, want %d×%d", name, r, c, n, k)
+			continue
+		}
+		if m >= n {
+			if !CEqualApprox(&got, x, tol*float64(n)) {
+				t.Errorf("%s: unexpected solution", name)
+			}
+			continue
+		}
+		if !CEqualApprox(cmul(a, &got), b, tol*float64(n)) {
+			t.Errorf("%s: A*X != B", name)
+		}
+		// The minimum-norm solution is X = Aᴴ*Y for some Y.
+		var y CDense
+		if err := y.Solve(cmul(a, a.H()), b); err != nil {
+			t.Errorf("%s: unexpected error solving normal equations: %v", name, err)
+			continue
+		}
+		if !CEqualApprox(&got, cmul(a.H(), &y), tol*float64(n)) {
+			t.Errorf("%s: solution is not minimum norm", name)
+		}
+	}
+
+	// A singular matrix is reported as a Condition error.
+	var x CDense
+	a := NewCDense(2, 2, []complex128{1, 1i, 2, 2i})
+	err := x.Solve(a, NewCDense(2, 1, []complex128{1, 2}))
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("expected Condition error for singular matrix, got %v", err)
+	}
+
+	// The receiver may alias the right-hand side.
+	a = randCDense(4, 4, rnd)
+	want := randCDense(4, 2, rnd)
+	b := cmul(a, want)
+	if err := b.Solve(a, b); err != nil {
+		t.Errorf("unexpected error for aliased right-hand side: %v", err)
+	}
+	if !CEqualApprox(b, want, tol) {
+		t.Errorf("unexpected solution for aliased right-hand side")
+	}
+}
+
+func TestCNormEst1(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 10, 30} {
+		b := randCDense(n, n, rnd)
+		want := cnorm1(n, n, b.At)
+		got := cnormEst1(n, func(x []complex128, conj bool) {
+			y := make([]complex128, n)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					if conj {
+						y[i] += cmplx.Conj(b.At(j, i)) * x[j]
+					} else {
+						y[i] += b.At(i, j) * x[j]
+					}
+				}
+			}
+			copy(x, y)
+		})
+		// The estimate is a lower bound that is usually within a
+		// small factor of the true norm.
+		if got > want*(1+1e-12) || got < want/3 {
+			t.Errorf("n=%d: unexpected norm estimate: got %v, want %v", n, got, want)
+		}
+	}
+}
+
+// randCDense returns an r×c matrix with random complex elements.
+func randCDense(r, c int, rnd *rand.Rand) *CDense {
+	m := NewCDense(r, c, nil)
+	for i := range m.mat.Data {
+		m.mat.Data[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+	}
+	return m
+}
+
+// cmul returns the matrix product a*b.
+func cmul(a, b CMatrix) *CDense {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ac != br {
+		panic(ErrShape)
+	}
+	m := NewCDense(ar, bc, nil)
+	for i := 0; i < ar; i++ {
+		for j := 0; j < bc; j++ {
+			var v complex128
+			for k := 0; k < ac; k++ {
+				v += a.At(i, k) * b.At(k, j)
+			}
+			m.set(i, j, v)
+		}
+	}
+	return m
+}
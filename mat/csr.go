@@ -0,0 +1,399 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import "sort"
+
+var (
+	csr *CSR
+	_   Matrix         = csr
+	_   Mutable        = csr
+	_   Reseter        = csr
+	_   NonZeroDoer    = csr
+	_   RowNonZeroDoer = csr
+	_   ColNonZeroDoer = csr
+)
+
+// CSR represents a sparse matrix in compressed sparse row format. The column
+// indices and values of the stored elements of row i are held in
+// ind[indptr[i]:indptr[i+1]] and data[indptr[i]:indptr[i+1]], with the column
+// indices of each row strictly increasing. Elements that are not stored are
+// zero.
+//
+// Setting an element that is not stored inserts it into the structure of
+// the matrix, which takes time proportional to the number of stored
+// elements. Matrices should be assembled with NewCSRFromTriplets where
+// possible.
+type CSR struct {
+	rows, cols int
+
+	indptr []int
+	ind    []int
+	data   []float64
+}
+
+// NewCSR creates a new r×c sparse matrix in compressed sparse row format.
+// The slice indptr must have length r+1 with indptr[0] == 0 and
+// non-decreasing elements, ind and data must have length indptr[r], and the
+// column indices in ind must be strictly increasing within each row and
+// less than c. If all of indptr, ind and data are nil, a zero matrix with
+// no stored elements is returned. The slices are used as backing data, so
+// changes to the stored elements of the returned CSR will be reflected in
+// data. If the inputs do not describe a valid matrix, NewCSR will panic.
+func NewCSR(r, c int, indptr, ind []int, data []float64) *CSR {
+	if r <= 0 || c <= 0 {
+		if r == 0 || c == 0 {
+			panic(ErrZeroLength)
+		}
+		panic(ErrNegativeDimension)
+	}
+	if indptr == nil && ind == nil && data == nil {
+		return &CSR{rows: r, cols: c, indptr: make([]int, r+1)}
+	}
+	if len(indptr) != r+1 {
+		panic(ErrShape)
+	}
+	nnz := indptr[r]
+	if len(ind) != nnz || len(data) != nnz {
+		panic(ErrShape)
+	}
+	if indptr[0] != 0 {
+		panic(ErrSparseStructure)
+	}
+	for i := 0; i < r; i++ {
+		if indptr[i+1] < indptr[i] {
+			panic(ErrSparseStructure)
+		}
+	}
+	for i := 0; i < r; i++ {
+		start, end := indptr[i], indptr[i+1]
+		for k := start; k < end; k++ {
+			j := ind[k]
+			if uint(j) >= uint(c) {
+				panic(ErrColAccess)
+			}
+			if k > start && j <= ind[k-1] {
+				panic(ErrSparseStructure)
+			}
+		}
+	}
+	return &CSR{rows: r, cols: c, indptr: indptr, ind: ind, data: data}
+}
+
+// NewCSRFromTriplets creates a new r×c sparse matrix in compressed sparse
+// row format from the coordinate triplets (i[k], j[k], v[k]). Values with
+// the same row and column indices are summed. The input slices are not
+// retained. NewCSRFromTriplets will panic if the lengths of i, j and v
+// differ or if any index is out of range.
+func NewCSRFromTriplets(r, c int, i, j []int, v []float64) *CSR {
+	if r <= 0 || c <= 0 {
+		if r == 0 || c == 0 {
+			panic(ErrZeroLength)
+		}
+		panic(ErrNegativeDimension)
+	}
+	if len(i) != len(j) || len(i) != len(v) {
+		panic(ErrSliceLengthMismatch)
+	}
+
+	// Count the elements in each row and bucket them.
+	indptr := make([]int, r+1)
+	for k, ik := range i {
+		if uint(ik) >= uint(r) {
+			panic(ErrRowAccess)
+		}
+		if uint(j[k]) >= uint(c) {
+			panic(ErrColAccess)
+		}
+		indptr[ik+1]++
+	}
+	for k := 0; k < r; k++ {
+		indptr[k+1] += indptr[k]
+	}
+	next := make([]int, r)
+	copy(next, indptr)
+	ind := make([]int, len(i))
+	data := make([]float64, len(i))
+	for k, ik := range i {
+		p := next[ik]
+		ind[p] = j[k]
+		data[p] = v[k]
+		next[ik]++
+	}
+
+	// Sort each row by column and sum duplicates, compacting in place.
+	var nnz int
+	for row := 0; row < r; row++ {
+		start, end := indptr[row], indptr[row+1]
+		sort.Sort(csrRow{ind: ind[start:end], data: data[start:end]})
+		indptr[row] = nnz
+		for k := start; k < end; k++ {
+			if nnz > indptr[row] && ind[nnz-1] == ind[k] {
+				data[nnz-1] += data[k]
+				continue
+			}
+			ind[nnz] = ind[k]
+			data[nnz] = data[k]
+			nnz++
+		}
+	}
+	indptr[r] = nnz
+	return &CSR{rows: r, cols: c, indptr: indptr, ind: ind[:nnz:nnz], data: data[:nnz:nnz]}
+}
+
+// csrRow sorts the elements of a row by column index.
+type csrRow struct {
+	ind  []int
+	data []float64
+}
+
+func (r csrRow) Len() int           { return len(r.ind) }
+func (r csrRow) Less(i, j int) bool { return r.ind[i] < r.ind[j] }
+func (r csrRow) Swap(i, j int) {
+	r.ind[i], r.ind[j] = r.ind[j], r.ind[i]
+	r.data[i], r.data[j] = r.data[j], r.data[i]
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (m *CSR) Dims() (r, c int) {
+	return m.rows, m.cols
+}
+
+// At returns the element at row i, column j.
+func (m *CSR) At(i, j int) float64 {
+	if uint(i) >= uint(m.rows) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.cols) {
+		panic(ErrColAccess)
+	}
+	k, ok := m.find(i, j)
+	if !ok {
+		return 0
+	}
+	return m.data[k]
+}
+
+// find returns the index into ind and data of the element at row i,
+// column j, and whether the element is stored. If it is not stored, k is
+// the position at which it would be inserted.
+func (m *CSR) find(i, j int) (k int, ok bool) {
+	start, end := m.indptr[i], m.indptr[i+1]
+	k = start + sort.SearchInts(m.ind[start:end], j)
+	return k, k < end && m.ind[k] == j
+}
+
+// Set sets the element at row i, column j to the value v. If the element
+// is not stored and v is non-zero, it is inserted into the structure of
+// the matrix. Setting a stored element to zero does not remove it.
+func (m *CSR) Set(i, j int, v float64) {
+	if uint(i) >= uint(m.rows) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.cols) {
+		panic(ErrColAccess)
+	}
+	k, ok := m.find(i, j)
+	if ok {
+		m.data[k] = v
+		return
+	}
+	if v == 0 {
+		return
+	}
+	m.ind = append(m.ind, 0)
+	copy(m.ind[k+1:], m.ind[k:])
+	m.ind[k] = j
+	m.data = append(m.data, 0)
+	copy(m.data[k+1:], m.data[k:])
+	m.data[k] = v
+	for r := i + 1; r <= m.rows; r++ {
+		m.indptr[r]++
+	}
+}
+
+// T performs an implicit transpose by returning the receiver inside a Transpose.
+func (m *CSR) T() Matrix {
+	return Transpose{m}
+}
+
+// NNZ returns the number of stored elements in the matrix. Stored elements
+// may have a zero value.
+func (m *CSR) NNZ() int {
+	if m.IsEmpty() {
+		return 0
+	}
+	return m.indptr[m.rows]
+}
+
+// RawCSR returns the row pointers, column indices and values used by the
+// receiver. Changes to the values in data will be reflected in the
+// receiver, but the structure described by indptr and ind must not be
+// modified.
+func (m *CSR) RawCSR() (indptr, ind []int, data []float64) {
+	return m.indptr, m.ind, m.data
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for size-restricted operations. The receiver can be zeroed using
+// Reset.
+func (m *CSR) IsEmpty() bool {
+	return m.rows == 0 || m.cols == 0
+}
+
+// Reset empties the matrix so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+//
+// Reset should not be used when the matrix shares backing data. See the Reseter
+// interface for more information.
+func (m *CSR) Reset() {
+	m.rows, m.cols = 0, 0
+	m.indptr = m.indptr[:0]
+	m.ind = m.ind[:0]
+	m.data = m.data[:0]
+}
+
+// CloneFrom makes a copy of a into the receiver, overwriting the previous
+// value of the receiver. Only the non-zero elements of a are stored.
+// CloneFrom does not place any restrictions on receiver shape.
+func (m *CSR) CloneFrom(a Matrix) {
+	r, c := a.Dims()
+	if r == 0 || c == 0 {
+		panic(ErrZeroLength)
+	}
+	indptr := make([]int, r+1)
+	var (
+		ind  []int
+		data []float64
+	)
+	switch a := a.(type) {
+	case *CSR:
+		ind = make([]int, 0, a.NNZ())
+		data = make([]float64, 0, a.NNZ())
+		for i := 0; i < r; i++ {
+			for k := a.indptr[i]; k < a.indptr[i+1]; k++ {
+				if a.data[k] != 0 {
+					ind = append(ind, a.ind[k])
+					data = append(data, a.data[k])
+				}
+			}
+			indptr[i+1] = len(ind)
+		}
+	case RowNonZeroDoer:
+		for i := 0; i < r; i++ {
+			start := len(ind)
+			a.DoRowNonZero(i, func(_, j int, v float64) {
+				ind = append(ind, j)
+				data = append(data, v)
+			})
+			sort.Sort(csrRow{ind: ind[start:], data: data[start:]})
+			indptr[i+1] = len(ind)
+		}
+	default:
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				v := a.At(i, j)
+				if v != 0 {
+					ind = append(ind, j)
+					data = append(data, v)
+				}
+			}
+			indptr[i+1] = len(ind)
+		}
+	}
+	*m = CSR{rows: r, cols: c, indptr: indptr, ind: ind, data: data}
+}
+
+// MulVecTo computes A⋅x or Aᵀ⋅x storing the result into dst.
+func (m *CSR) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	r, c := m.rows, m.cols
+	if trans {
+		r, c = c, r
+	}
+	if x.Len() != c {
+		panic(ErrShape)
+	}
+	dst.reuseAsNonZeroed(r)
+
+	xMat, _ := untransposeExtract(x)
+	xVec, ok := xMat.(*VecDense)
+	if ok && dst != xVec {
+		dst.checkOverlap(xVec.mat)
+	} else {
+		xCopy := getVecDenseWorkspace(c, false)
+		defer putVecDenseWorkspace(xCopy)
+		xCopy.CloneFromVec(x)
+		xVec = xCopy
+	}
+	xd, incX := xVec.mat.Data, xVec.mat.Inc
+	yd, incY := dst.mat.Data, dst.mat.Inc
+
+	if !trans {
+		for i := 0; i < m.rows; i++ {
+			var sum float64
+			for k := m.indptr[i]; k < m.indptr[i+1]; k++ {
+				sum += m.data[k] * xd[m.ind[k]*incX]
+			}
+			yd[i*incY] = sum
+		}
+		return
+	}
+	for j := 0; j < m.cols; j++ {
+		yd[j*incY] = 0
+	}
+	for i := 0; i < m.rows; i++ {
+		xi := xd[i*incX]
+		if xi == 0 {
+			continue
+		}
+		for k := m.indptr[i]; k < m.indptr[i+1]; k++ {
+			yd[m.ind[k]*incY] += m.data[k] * xi
+		}
+	}
+}
+
+// DoNonZero calls the function fn for each of the non-zero elements of A
+// in row-major order. The function fn takes a row/column index and the
+// element value of A at (i,j). Stored elements with a zero value are skipped.
+func (m *CSR) DoNonZero(fn func(i, j int, v float64)) {
+	for i := 0; i < m.rows; i++ {
+		for k := m.indptr[i]; k < m.indptr[i+1]; k++ {
+			if v := m.data[k]; v != 0 {
+				fn(i, m.ind[k], v)
+			}
+		}
+	}
+}
+
+// DoRowNonZero calls the function fn for each of the non-zero elements of row i
+// of A in column order. The function fn takes a row/column index and the element
+// value of A at (i,j).
+func (m *CSR) DoRowNonZero(i int, fn func(i, j int, v float64)) {
+	if uint(i) >= uint(m.rows) {
+		panic(ErrRowAccess)
+	}
+	for k := m.indptr[i]; k < m.indptr[i+1]; k++ {
+		if v := m.data[k]; v != 0 {
+			fn(i, m.ind[k], v)
+		}
+	}
+}
+
+// DoColNonZero calls the function fn for each of the non-zero elements of
+// column j of A in row order. The function fn takes a row/column index and the
+// element value of A at (i, j). Column access requires a search of each row,
+// so DoColNonZero is less efficient than DoRowNonZero.
+func (m *CSR) DoColNonZero(j int, fn func(i, j int, v float64)) {
+	if uint(j) >= uint(m.cols) {
+		panic(ErrColAccess)
+	}
+	for i := 0; i < m.rows; i++ {
+		if k, ok := m.find(i, j); ok {
+			if v := m.data[k]; v != 0 {
+				fn(i, j, v)
+			}
+		}
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"sort"
+
+	"gonum.org/v1/gonum/internal/asm/f64"
+)
+
+// Mul takes the matrix product of a and b, placing the result in the
+// receiver. If the number of columns in a does not equal the number of rows
+// in b, Mul will panic. The receiver must either be empty or have the
+// dimensions of the product, otherwise Mul will panic.
+//
+// The product is computed by Gustavson's row-wise algorithm in time
+// proportional to the number of multiplications of stored elements. Operands
+// that are not *CSR or the transpose of a *CSR are first converted to
+// compressed sparse row format. Elements of the product that are
+// structurally non-zero are stored even if their value cancels to zero.
+//
+// See F. G. Gustavson, "Two fast algorithms for sparse matrices:
+// multiplication and permuted transposition", ACM Trans. Math. Softw. 4(3),
+// 1978, for details.
+func (m *CSR) Mul(a, b Matrix) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ac != br {
+		panic(ErrShape)
+	}
+	if !m.IsEmpty() && (m.rows != ar || m.cols != bc) {
+		panic(ErrShape)
+	}
+	as := csrOperand(a)
+	bs := csrOperand(b)
+
+	indptr := make([]int, ar+1)
+	var (
+		ind  []int
+		data []float64
+	)
+	work := make([]float64, bc)
+	mark := make([]int, bc)
+	for j := range mark {
+		mark[j] = -1
+	}
+	for i := 0; i < ar; i++ {
+		start := len(ind)
+		for p := as.indptr[i]; p < as.indptr[i+1]; p++ {
+			k := as.ind[p]
+			aik := as.data[p]
+			for q := bs.indptr[k]; q < bs.indptr[k+1]; q++ {
+				j := bs.ind[q]
+				if mark[j] != i {
+					mark[j] = i
+					ind = append(ind, j)
+					work[j] = aik * bs.data[q]
+				} else {
+					work[j] += aik * bs.data[q]
+				}
+			}
+		}
+		row := ind[start:]
+		sort.Ints(row)
+		for _, j := range row {
+			data = append(data, work[j])
+		}
+		indptr[i+1] = len(ind)
+	}
+	*m = CSR{rows: ar, cols: bc, indptr: indptr, ind: ind, data: data}
+}
+
+// csrOperand returns a in compressed sparse row format. If a is a *CSR it
+// is returned directly, and the transpose of a *CSR is formed without
+// conversion through the generic path.
+func csrOperand(a Matrix) *CSR {
+	if a, ok := a.(*CSR); ok {
+		return a
+	}
+	aU, trans := untransposeExtract(a)
+	if aU, ok := aU.(*CSR); ok && trans {
+		indptr, ind, data := transposeCSR(aU)
+		return &CSR{rows: aU.cols, cols: aU.rows, indptr: indptr, ind: ind, data: data}
+	}
+	var c CSR
+	c.CloneFrom(a)
+	return &c
+}
+
+// denseOperand returns the elements of a as a *Dense that is not
+// transposed, and a function to release any workspace that was allocated.
+func denseOperand(a Matrix) (d *Dense, release func()) {
+	aU, trans := untransposeExtract(a)
+	if aU, ok := aU.(*Dense); ok && !trans {
+		return aU, func() {}
+	}
+	r, c := a.Dims()
+	w := getDenseWorkspace(r, c, false)
+	w.Copy(a)
+	return w, func() { putDenseWorkspace(w) }
+}
+
+// mulCSRLeft places the product of the sparse matrix a, or its transpose if
+// trans is true, and b into the receiver, which must have the dimensions of
+// the product.
+func (m *Dense) mulCSRLeft(a *CSR, trans bool, b Matrix) {
+	bU, _ := untransposeExtract(b)
+	m.checkOverlapMatrix(bU)
+	bd, release := denseOperand(b)
+	defer release()
+
+	m.Zero()
+	bs := bd.mat.Stride
+	ms := m.mat.Stride
+	n := m.mat.Cols
+	for k := 0; k < a.rows; k++ {
+		for p := a.indptr[k]; p < a.indptr[k+1]; p++ {
+			j := a.ind[p]
+			v := a.data[p]
+			if v == 0 {
+				continue
+			}
+			if trans {
+				// C[j,:] += Aᵀ[j,k] * B[k,:].
+				f64.AxpyUnitary(v, bd.mat.Data[k*bs:k*bs+n], m.mat.Data[j*ms:j*ms+n])
+			} else {
+				// C[k,:] += A[k,j] * B[j,:].
+				f64.AxpyUnitary(v, bd.mat.Data[j*bs:j*bs+n], m.mat.Data[k*ms:k*ms+n])
+			}
+		}
+	}
+}
+
+// mulCSRRight places the product of a and the sparse matrix b, or its
+// transpose if trans is true, into the receiver, which must have the
+// dimensions of the product.
+func (m *Dense) mulCSRRight(a Matrix, b *CSR, trans bool) {
+	aU, _ := untransposeExtract(a)
+	m.checkOverlapMatrix(aU)
+	ad, release := denseOperand(a)
+	defer release()
+
+	r := m.mat.Rows
+	as := ad.mat.Stride
+	ms := m.mat.Stride
+	if trans {
+		// C[i,j] = A[i,:] · B[j,:].
+		for i := 0; i < r; i++ {
+			arow := ad.mat.Data[i*as : i*as+ad.mat.Cols]
+			crow := m.mat.Data[i*ms : i*ms+m.mat.Cols]
+			for j := range crow {
+				var v float64
+				for p := b.indptr[j]; p < b.indptr[j+1]; p++ {
+					v += arow[b.ind[p]] * b.data[p]
+				}
+				crow[j] = v
+			}
+		}
+		return
+	}
+
+	// C[i,:] = \sum_k A[i,k] * B[k,:].
+	m.Zero()
+	for i := 0; i < r; i++ {
+		arow := ad.mat.Data[i*as : i*as+ad.mat.Cols]
+		crow := m.mat.Data[i*ms : i*ms+m.mat.Cols]
+		for k, aik := range arow {
+			if aik == 0 {
+				continue
+			}
+			for p := b.indptr[k]; p < b.indptr[k+1]; p++ {
+				crow[b.ind[p]] += aik * b.data[p]
+			}
+		}
+	}
+}
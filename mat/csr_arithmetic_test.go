@@ -0,0 +1,136 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestCSRMul(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	rnd := rand.New(rand.NewSource(1))
+	for _, dims := range [][3]int{{1, 1, 1}, {3, 4, 5}, {7, 2, 6}, {10, 10, 10}, {20, 15, 8}} {
+		r, k, c := dims[0], dims[1], dims[2]
+		a, aDense := newTestCSR(rnd, r, k, 0.3)
+		b, bDense := newTestCSR(rnd, k, c, 0.3)
+		at, atDense := newTestCSR(rnd, k, r, 0.3)
+		bt, btDense := newTestCSR(rnd, c, k, 0.3)
+
+		for _, test := range []struct {
+			name string
+			a, b Matrix
+			want func(*Dense)
+		}{
+			{name: "A*B", a: a, b: b, want: func(d *Dense) { d.Mul(aDense, bDense) }},
+			{name: "Aᵀ*B", a: at.T(), b: b, want: func(d *Dense) { d.Mul(atDense.T(), bDense) }},
+			{name: "A*Bᵀ", a: a, b: bt.T(), want: func(d *Dense) { d.Mul(aDense, btDense.T()) }},
+			{name: "Aᵀ*Bᵀ", a: at.T(), b: bt.T(), want: func(d *Dense) { d.Mul(atDense.T(), btDense.T()) }},
+			{name: "A*dense", a: a, b: bDense, want: func(d *Dense) { d.Mul(aDense, bDense) }},
+			{name: "dense*B", a: aDense, b: b, want: func(d *Dense) { d.Mul(aDense, bDense) }},
+		} {
+			name := fmt.Sprintf("%d×%d×%d %s", r, k, c, test.name)
+			var want Dense
+			test.want(&want)
+
+			var got CSR
+			got.Mul(test.a, test.b)
+			if !EqualApprox(&got, &want, tol) {
+				t.Errorf("%s: unexpected CSR product:\ngot: % v\nwant:% v",
+					name, Formatted(&got, Prefix("     ")), Formatted(&want, Prefix("     ")))
+			}
+			indptr, ind, _ := got.RawCSR()
+			for i := 0; i < r; i++ {
+				for p := indptr[i] + 1; p < indptr[i+1]; p++ {
+					if ind[p] <= ind[p-1] {
+						t.Errorf("%s: column indices not increasing in row %d", name, i)
+					}
+				}
+			}
+
+			var gotDense Dense
+			gotDense.Mul(test.a, test.b)
+			if !EqualApprox(&gotDense, &want, tol) {
+				t.Errorf("%s: unexpected Dense product:\ngot: % v\nwant:% v",
+					name, Formatted(&gotDense, Prefix("     ")), Formatted(&want, Prefix("     ")))
+			}
+		}
+
+		// Products with transposed dense operands.
+		var want, got Dense
+		bDenseT := DenseCopyOf(bDense.T())
+		want.Mul(aDense, bDense)
+		got.Mul(a, bDenseT.T())
+		if !EqualApprox(&got, &want, tol) {
+			t.Errorf("%d×%d×%d: unexpected product with transposed dense operand", r, k, c)
+		}
+
+		// Matrix-vector products.
+		x := NewVecDense(k, nil)
+		for i := 0; i < k; i++ {
+			x.SetVec(i, rnd.NormFloat64())
+		}
+		var gotVec, wantVec VecDense
+		gotVec.MulVec(a, x)
+		wantVec.MulVec(aDense, x)
+		if !EqualApprox(&gotVec, &wantVec, tol) {
+			t.Errorf("%d×%d×%d: unexpected MulVec result", r, k, c)
+		}
+		y := NewVecDense(r, nil)
+		for i := 0; i < r; i++ {
+			y.SetVec(i, rnd.NormFloat64())
+		}
+		gotVec.Reset()
+		wantVec.Reset()
+		gotVec.MulVec(a.T(), y)
+		wantVec.MulVec(aDense.T(), y)
+		if !EqualApprox(&gotVec, &wantVec, tol) {
+			t.Errorf("%d×%d×%d: unexpected transposed MulVec result", r, k, c)
+		}
+	}
+
+	// Aliased receiver and operand.
+	a, aDense := newTestCSR(rnd, 6, 6, 0.4)
+	var want Dense
+	want.Mul(aDense, aDense)
+	a.Mul(a, a)
+	if !EqualApprox(a, &want, tol) {
+		t.Errorf("unexpected product with aliased receiver")
+	}
+
+	panicked, message := panics(func() {
+		var m CSR
+		m.Mul(NewCSR(2, 3, nil, nil, nil), NewCSR(2, 3, nil, nil, nil))
+	})
+	if !panicked || message != ErrShape.Error() {
+		t.Errorf("expected panic for shape mismatch")
+	}
+	panicked, message = panics(func() {
+		m := NewCSR(3, 3, nil, nil, nil)
+		m.Mul(NewCSR(2, 3, nil, nil, nil), NewCSR(3, 2, nil, nil, nil))
+	})
+	if !panicked || message != ErrShape.Error() {
+		t.Errorf("expected panic for receiver shape mismatch")
+	}
+}
+
+func BenchmarkCSRMulDense(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	a, _ := newTestCSR(rnd, 500, 500, 0.01)
+	x := NewDense(500, 50, nil)
+	for i := 0; i < 500; i++ {
+		for j := 0; j < 50; j++ {
+			x.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	var dst Dense
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst.Mul(a, x)
+	}
+}
@@ -0,0 +1,380 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+// newTestCSR returns a random r×c sparse matrix with approximately the
+// given density of stored elements, and a dense matrix with the same values.
+func newTestCSR(rnd *rand.Rand, r, c int, density float64) (*CSR, *Dense) {
+	var (
+		i, j []int
+		v    []float64
+	)
+	ref := NewDense(r, c, nil)
+	for row := 0; row < r; row++ {
+		for col := 0; col < c; col++ {
+			if rnd.Float64() >= density {
+				continue
+			}
+			val := rnd.NormFloat64()
+			i = append(i, row)
+			j = append(j, col)
+			v = append(v, val)
+			ref.Set(row, col, val)
+		}
+	}
+	// Shuffle the triplets to exercise sorting.
+	rnd.Shuffle(len(i), func(a, b int) {
+		i[a], i[b] = i[b], i[a]
+		j[a], j[b] = j[b], j[a]
+		v[a], v[b] = v[b], v[a]
+	})
+	return NewCSRFromTriplets(r, c, i, j, v), ref
+}
+
+func TestNewCSR(t *testing.T) {
+	t.Parallel()
+	m := NewCSR(3, 4,
+		[]int{0, 2, 2, 4},
+		[]int{0, 3, 1, 2},
+		[]float64{1, 2, 3, 4},
+	)
+	want := NewDense(3, 4, []float64{
+		1, 0, 0, 2,
+		0, 0, 0, 0,
+		0, 3, 4, 0,
+	})
+	if !Equal(m, want) {
+		t.Errorf("unexpected matrix:\ngot: % v\nwant:% v",
+			Formatted(m, Prefix("     ")), Formatted(want, Prefix("     ")))
+	}
+	if m.NNZ() != 4 {
+		t.Errorf("unexpected number of stored elements: got:%d want:4", m.NNZ())
+	}
+
+	z := NewCSR(2, 3, nil, nil, nil)
+	if !Equal(z, NewDense(2, 3, nil)) || z.NNZ() != 0 {
+		t.Errorf("unexpected non-zero matrix")
+	}
+
+	for _, test := range []struct {
+		r, c   int
+		indptr []int
+		ind    []int
+		data   []float64
+		want   error
+	}{
+		{r: 0, c: 1, want: ErrZeroLength},
+		{r: -1, c: 1, want: ErrNegativeDimension},
+		{r: 2, c: 2, indptr: []int{0, 1}, ind: []int{0}, data: []float64{1}, want: ErrShape},
+		{r: 2, c: 2, indptr: []int{0, 1, 2}, ind: []int{0}, data: []float64{1}, want: ErrShape},
+		{r: 2, c: 2, indptr: []int{1, 1, 2}, ind: []int{0, 1}, data: []float64{1, 2}, want: ErrSparseStructure},
+		{r: 2, c: 2, indptr: []int{0, 2, 1}, ind: []int{0}, data: []float64{1}, want: ErrSparseStructure},
+		{r: 2, c: 2, indptr: []int{0, 2, 2}, ind: []int{1, 0}, data: []float64{1, 2}, want: ErrSparseStructure},
+		{r: 2, c: 2, indptr: []int{0, 2, 2}, ind: []int{1, 1}, data: []float64{1, 2}, want: ErrSparseStructure},
+		{r: 2, c: 2, indptr: []int{0, 1, 1}, ind: []int{2}, data: []float64{1}, want: ErrColAccess},
+	} {
+		panicked, message := panics(func() { NewCSR(test.r, test.c, test.indptr, test.ind, test.data) })
+		if !panicked || message != test.want.Error() {
+			t.Errorf("unexpected panic for r=%d c=%d indptr=%v ind=%v: got:%q want:%q",
+				test.r, test.c, test.indptr, test.ind, message, test.want)
+		}
+	}
+}
+
+func TestNewCSRFromTriplets(t *testing.T) {
+	t.Parallel()
+	m := NewCSRFromTriplets(2, 3,
+		[]int{1, 0, 1, 0, 1},
+		[]int{2, 1, 0, 1, 2},
+		[]float64{1, 2, 3, 4, 5},
+	)
+	want := NewDense(2, 3, []float64{
+		0, 6, 0,
+		3, 0, 6,
+	})
+	if !Equal(m, want) {
+		t.Errorf("unexpected matrix:\ngot: % v\nwant:% v",
+			Formatted(m, Prefix("     ")), Formatted(want, Prefix("     ")))
+	}
+	if m.NNZ() != 3 {
+		t.Errorf("unexpected number of stored elements: got:%d want:3", m.NNZ())
+	}
+	indptr, ind, _ := m.RawCSR()
+	if !equalInts(indptr, []int{0, 1, 3}) || !equalInts(ind, []int{1, 0, 2}) {
+		t.Errorf("unexpected structure: got indptr=%v ind=%v", indptr, ind)
+	}
+
+	for _, test := range []struct {
+		i, j []int
+		v    []float64
+		want error
+	}{
+		{i: []int{0}, j: []int{0, 1}, v: []float64{1}, want: ErrSliceLengthMismatch},
+		{i: []int{2}, j: []int{0}, v: []float64{1}, want: ErrRowAccess},
+		{i: []int{0}, j: []int{-1}, v: []float64{1}, want: ErrColAccess},
+	} {
+		panicked, message := panics(func() { NewCSRFromTriplets(2, 2, test.i, test.j, test.v) })
+		if !panicked || message != test.want.Error() {
+			t.Errorf("unexpected panic for i=%v j=%v: got:%q want:%q", test.i, test.j, message, test.want)
+		}
+	}
+}
+
+func TestCSRAtSet(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, dims := range [][2]int{{1, 1}, {1, 5}, {5, 1}, {4, 7}, {10, 10}} {
+		r, c := dims[0], dims[1]
+		name := fmt.Sprintf("Case r=%d c=%d", r, c)
+		m, ref := newTestCSR(rnd, r, c, 0.3)
+		if !Equal(m, ref) {
+			t.Errorf("%v: unexpected value:\ngot: % v\nwant:% v",
+				name, Formatted(m, Prefix("     ")), Formatted(ref, Prefix("     ")))
+		}
+
+		// Set every element in a random order, inserting absent elements.
+		perm := rnd.Perm(r * c)
+		for _, p := range perm {
+			i, j := p/c, p%c
+			v := float64(p + 1)
+			m.Set(i, j, v)
+			ref.Set(i, j, v)
+		}
+		if !Equal(m, ref) {
+			t.Errorf("%v: unexpected value after Set:\ngot: % v\nwant:% v",
+				name, Formatted(m, Prefix("     ")), Formatted(ref, Prefix("     ")))
+		}
+		if m.NNZ() != r*c {
+			t.Errorf("%v: unexpected number of stored elements: got:%d want:%d", name, m.NNZ(), r*c)
+		}
+		indptr, ind, _ := m.RawCSR()
+		for i := 0; i < r; i++ {
+			for k := indptr[i] + 1; k < indptr[i+1]; k++ {
+				if ind[k] <= ind[k-1] {
+					t.Errorf("%v: column indices not increasing in row %d: %v", name, i, ind[indptr[i]:indptr[i+1]])
+					break
+				}
+			}
+		}
+
+		// Setting an absent element to zero must not store it.
+		z := NewCSR(r, c, nil, nil, nil)
+		z.Set(r-1, c-1, 0)
+		if z.NNZ() != 0 {
+			t.Errorf("%v: unexpected stored zero", name)
+		}
+
+		for _, i := range []int{-1, r} {
+			panicked, message := panics(func() { m.At(i, 0) })
+			if !panicked || message != ErrRowAccess.Error() {
+				t.Errorf("%v: expected panic for invalid row access at (%d,0)", name, i)
+			}
+			panicked, message = panics(func() { m.Set(i, 0, 1) })
+			if !panicked || message != ErrRowAccess.Error() {
+				t.Errorf("%v: expected panic for invalid row set at (%d,0)", name, i)
+			}
+		}
+		for _, j := range []int{-1, c} {
+			panicked, message := panics(func() { m.At(0, j) })
+			if !panicked || message != ErrColAccess.Error() {
+				t.Errorf("%v: expected panic for invalid column access at (0,%d)", name, j)
+			}
+			panicked, message = panics(func() { m.Set(0, j, 1) })
+			if !panicked || message != ErrColAccess.Error() {
+				t.Errorf("%v: expected panic for invalid column set at (0,%d)", name, j)
+			}
+		}
+	}
+}
+
+func TestCSRReset(t *testing.T) {
+	t.Parallel()
+	a := NewCSR(3, 4, nil, nil, nil)
+	if a.IsEmpty() {
+		t.Errorf("matrix is empty")
+	}
+	a.Reset()
+	if !a.IsEmpty() {
+		t.Errorf("matrix is not empty after Reset")
+	}
+	if a.NNZ() != 0 {
+		t.Errorf("unexpected number of stored elements after Reset: got:%d", a.NNZ())
+	}
+}
+
+func TestCSRCloneFrom(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, dims := range [][2]int{{1, 1}, {3, 5}, {6, 2}, {8, 8}} {
+		r, c := dims[0], dims[1]
+		name := fmt.Sprintf("Case r=%d c=%d", r, c)
+		src, ref := newTestCSR(rnd, r, c, 0.4)
+		tri, _ := newTestTridiag(r)
+
+		for _, test := range []struct {
+			name string
+			a    Matrix
+		}{
+			{name: "CSR", a: src},
+			{name: "CSR.T", a: src.T()},
+			{name: "Dense", a: ref},
+			{name: "Tridiag", a: tri},
+		} {
+			var m CSR
+			m.CloneFrom(test.a)
+			if !Equal(&m, test.a) {
+				t.Errorf("%v %s: unexpected value:\ngot: % v\nwant:% v",
+					name, test.name, Formatted(&m, Prefix("     ")), Formatted(test.a, Prefix("     ")))
+			}
+			if nnz := countNonZero(test.a); m.NNZ() != nnz {
+				t.Errorf("%v %s: unexpected number of stored elements: got:%d want:%d", name, test.name, m.NNZ(), nnz)
+			}
+		}
+	}
+}
+
+// countNonZero returns the number of non-zero elements of a.
+func countNonZero(a Matrix) int {
+	r, c := a.Dims()
+	var n int
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			if a.At(i, j) != 0 {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+func TestCSRDoNonZero(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, dims := range [][2]int{{1, 1}, {3, 5}, {6, 2}, {8, 8}} {
+		r, c := dims[0], dims[1]
+		name := fmt.Sprintf("Case r=%d c=%d", r, c)
+		m, ref := newTestCSR(rnd, r, c, 0.4)
+		// Store an explicit zero which must not be visited.
+		m.Set(0, 0, 1)
+		m.Set(0, 0, 0)
+		ref.Set(0, 0, 0)
+
+		got := NewDense(r, c, nil)
+		var n, lastI, lastJ int = 0, -1, -1
+		m.DoNonZero(func(i, j int, v float64) {
+			if v == 0 {
+				t.Errorf("%v: unexpected zero visited by DoNonZero at (%d,%d)", name, i, j)
+			}
+			if i < lastI || (i == lastI && j <= lastJ) {
+				t.Errorf("%v: DoNonZero not in row-major order at (%d,%d)", name, i, j)
+			}
+			lastI, lastJ = i, j
+			got.Set(i, j, v)
+			n++
+		})
+		if !Equal(got, ref) || n != countNonZero(ref) {
+			t.Errorf("%v: unexpected DoNonZero result", name)
+		}
+
+		got.Zero()
+		for i := 0; i < r; i++ {
+			m.DoRowNonZero(i, func(i2, j int, v float64) {
+				if i2 != i || v == 0 {
+					t.Errorf("%v: unexpected element visited by DoRowNonZero(%d) at (%d,%d)", name, i, i2, j)
+				}
+				got.Set(i2, j, v)
+			})
+		}
+		if !Equal(got, ref) {
+			t.Errorf("%v: unexpected DoRowNonZero result", name)
+		}
+
+		got.Zero()
+		for j := 0; j < c; j++ {
+			m.DoColNonZero(j, func(i, j2 int, v float64) {
+				if j2 != j || v == 0 {
+					t.Errorf("%v: unexpected element visited by DoColNonZero(%d) at (%d,%d)", name, j, i, j2)
+				}
+				got.Set(i, j2, v)
+			})
+		}
+		if !Equal(got, ref) {
+			t.Errorf("%v: unexpected DoColNonZero result", name)
+		}
+
+		panicked, message := panics(func() { m.DoRowNonZero(r, func(_, _ int, _ float64) {}) })
+		if !panicked || message != ErrRowAccess.Error() {
+			t.Errorf("%v: expected panic for invalid row", name)
+		}
+		panicked, message = panics(func() { m.DoColNonZero(-1, func(_, _ int, _ float64) {}) })
+		if !panicked || message != ErrColAccess.Error() {
+			t.Errorf("%v: expected panic for invalid column", name)
+		}
+	}
+}
+
+func TestCSRMulVecTo(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	rnd := rand.New(rand.NewSource(1))
+	for _, dims := range [][2]int{{1, 1}, {3, 5}, {6, 2}, {8, 8}} {
+		r, c := dims[0], dims[1]
+		m, ref := newTestCSR(rnd, r, c, 0.4)
+		for _, trans := range []bool{false, true} {
+			name := fmt.Sprintf("Case r=%d c=%d trans=%t", r, c, trans)
+			n, k := r, c
+			var a Matrix = ref
+			if trans {
+				n, k = c, r
+				a = ref.T()
+			}
+			for _, inc := range []int{1, 3} {
+				x := NewVecDense(k*inc, nil)
+				for i := range x.mat.Data {
+					x.mat.Data[i] = rnd.NormFloat64()
+				}
+				xv := x.SliceVec(0, k*inc).(*VecDense)
+				xv = &VecDense{mat: xv.mat}
+				xv.mat.N = k
+				xv.mat.Inc = inc
+
+				var want VecDense
+				want.MulVec(a, xv)
+
+				var got VecDense
+				m.MulVecTo(&got, trans, xv)
+				if !EqualApprox(&got, &want, tol) {
+					t.Errorf("%v inc=%d: unexpected result:\ngot: %v\nwant:%v", name, inc, Formatted(&got), Formatted(&want))
+				}
+			}
+
+			if n == k {
+				// Aliased input and output.
+				x := NewVecDense(k, nil)
+				for i := 0; i < k; i++ {
+					x.SetVec(i, rnd.NormFloat64())
+				}
+				var want VecDense
+				want.MulVec(a, x)
+				m.MulVecTo(x, trans, x)
+				if !EqualApprox(x, &want, tol) {
+					t.Errorf("%v: unexpected result with aliased vectors:\ngot: %v\nwant:%v", name, Formatted(x), Formatted(&want))
+				}
+			}
+
+			panicked, message := panics(func() { m.MulVecTo(&VecDense{}, trans, NewVecDense(k+1, nil)) })
+			if !panicked || message != ErrShape.Error() {
+				t.Errorf("%v: expected panic for shape mismatch", name)
+			}
+		}
+	}
+}
@@ -0,0 +1,345 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+	"sort"
+
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// maxJacobiSweeps is the maximum number of sweeps performed by the Jacobi
+// methods used for complex decompositions before they report failure.
+const maxJacobiSweeps = 60
+
+// CSVD is a type for creating and using the Singular Value Decomposition
+// of a complex matrix.
+type CSVD struct {
+	kind SVDKind
+
+	s []float64
+	u *CDense
+	v *CDense
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (svd *CSVD) succFact() bool {
+	return len(svd.s) != 0
+}
+
+// Factorize computes the singular value decomposition (SVD) of the complex
+// input matrix A. The singular values of A are computed in all cases, while
+// the singular vectors are optionally computed depending on the input kind.
+//
+// The full singular value decomposition (kind == SVDFull) is a factorization
+// of an m×n matrix A of the form
+//
+//	A = U * Σ * Vᴴ
+//
+// where Σ is an m×n real diagonal matrix, U is an m×m unitary matrix, and V is
+// an n×n unitary matrix. The diagonal elements of Σ are the singular values of
+// A. The first min(m,n) columns of U and V are, respectively, the left and
+// right singular vectors of A.
+//
+// The thin SVD (kind == SVDThin) finds
+//
+//	A = U~ * Σ * V~ᴴ
+//
+// where U~ is of size m×min(m,n), Σ is a diagonal matrix of size
+// min(m,n)×min(m,n) and V~ is of size n×min(m,n).
+//
+// The decomposition is computed using the one-sided Jacobi method, which
+// computes small singular values to high relative accuracy.
+//
+// Factorize returns whether the decomposition succeeded. If the decomposition
+// failed, routines that require a successful factorization will panic.
+func (svd *CSVD) Factorize(a CMatrix, kind SVDKind) (ok bool) {
+	// kill previous factorization
+	svd.s = svd.s[:0]
+	svd.kind = kind
+	svd.u = nil
+	svd.v = nil
+
+	m, n := a.Dims()
+	wantU := kind&(SVDThinU|SVDFullU) != 0
+	wantV := kind&(SVDThinV|SVDFullV) != 0
+
+	// The Jacobi iteration orthogonalizes the columns of a tall matrix, so
+	// a wide matrix is factorized through its conjugate transpose
+	//  Aᴴ = U' * Σ * V'ᴴ  ⟹  A = V' * Σ * U'ᴴ.
+	g := &CDense{}
+	var s []float64
+	var u, v *CDense
+	if m >= n {
+		g.reuseAsNonZeroed(m, n)
+		g.Copy(a)
+		s, u, v, ok = cjacobiSVD(g, wantU, kind&SVDFullU != 0, wantV)
+	} else {
+		g.reuseAsNonZeroed(n, m)
+		g.Copy(a.H())
+		s, v, u, ok = cjacobiSVD(g, wantV, kind&SVDFullV != 0, wantU)
+	}
+	if !ok {
+		svd.kind = 0
+		return false
+	}
+	svd.s = s
+	svd.u = u
+	svd.v = v
+	return true
+}
+
+// cjacobiSVD computes the singular value decomposition of the p×q matrix g,
+// p >= q, using one-sided Jacobi rotations. g is overwritten. The singular
+// values are returned in descending order in s. If wantU is true, u holds the
+// p×q left singular vectors, or the p×p unitary matrix U if fullU is true.
+// If wantV is true, v holds the q×q right singular vectors.
+func cjacobiSVD(g *CDense, wantU, fullU, wantV bool) (s []float64, u, v *CDense, ok bool) {
+	p, q := g.Dims()
+	var vw *CDense
+	if wantV {
+		vw = NewCDense(q, q, nil)
+		for i := 0; i < q; i++ {
+			vw.set(i, i, 1)
+		}
+	}
+
+	tol := float64(p) * dlamchE
+	col := func(m *CDense, j int) cblas128.Vector {
+		return cblas128.Vector{N: m.mat.Rows, Inc: m.mat.Stride, Data: m.mat.Data[j:]}
+	}
+	converged := false
+	for sweep := 0; sweep < maxJacobiSweeps && !converged; sweep++ {
+		converged = true
+		for j := 0; j < q-1; j++ {
+			for k := j + 1; k < q; k++ {
+				gj, gk := col(g, j), col(g, k)
+				alpha := real(cblas128.Dotc(gj, gj))
+				beta := real(cblas128.Dotc(gk, gk))
+				gamma := cblas128.Dotc(gj, gk)
+				absGamma := cmplx.Abs(gamma)
+				if absGamma == 0 || absGamma <= tol*math.Sqrt(alpha)*math.Sqrt(beta) {
+					continue
+				}
+				converged = false
+
+				// Rotate the phase of column k so that the inner
+				// product is real, and then apply a real rotation
+				// that orthogonalizes the two columns.
+				phase := cmplx.Conj(gamma) / complex(absGamma, 0)
+				zeta := (beta - alpha) / (2 * absGamma)
+				t := 1 / (math.Abs(zeta) + math.Hypot(1, zeta))
+				if zeta < 0 {
+					t = -t
+				}
+				c := 1 / math.Hypot(1, t)
+				sn := c * t
+				crotateCols(g, j, k, c, sn, phase)
+				if vw != nil {
+					crotateCols(vw, j, k, c, sn, phase)
+				}
+			}
+		}
+	}
+	if !converged {
+		return nil, nil, nil, false
+	}
+
+	// Extract the singular values and sort them in descending order.
+	s = make([]float64, q)
+	perm := make([]int, q)
+	for j := range s {
+		s[j] = cblas128.Nrm2(col(g, j))
+		perm[j] = j
+	}
+	sort.SliceStable(perm, func(i, j int) bool { return s[perm[i]] > s[perm[j]] })
+	sorted := make([]float64, q)
+	for j, pj := range perm {
+		sorted[j] = s[pj]
+	}
+	s = sorted
+
+	if wantV {
+		v = NewCDense(q, q, nil)
+		for j, pj := range perm {
+			for i := 0; i < q; i++ {
+				v.set(i, j, vw.at(i, pj))
+			}
+		}
+	}
+	if !wantU {
+		return s, nil, v, true
+	}
+
+	ucols := q
+	if fullU {
+		ucols = p
+	}
+	u = NewCDense(p, ucols, nil)
+	rank := 0
+	for j, pj := range perm {
+		if s[j] == 0 {
+			break
+		}
+		for i := 0; i < p; i++ {
+			u.set(i, j, g.at(i, pj)/complex(s[j], 0))
+		}
+		rank++
+	}
+	if rank < ucols {
+		// Complete the left singular vectors to an orthonormal set using
+		// the trailing columns of the unitary factor of their QR
+		// factorization, which are orthogonal to the leading rank columns.
+		var qm CDense
+		if rank == 0 {
+			qm.ReuseAs(p, p)
+			for i := 0; i < p; i++ {
+				qm.set(i, i, 1)
+			}
+		} else {
+			var qr CQR
+			qr.Factorize(u.slice(0, p, 0, rank))
+			qr.QTo(&qm)
+		}
+		for j := rank; j < ucols; j++ {
+			for i := 0; i < p; i++ {
+				u.set(i, j, qm.at(i, j))
+			}
+		}
+	}
+	return s, u, v, true
+}
+
+// crotateCols replaces columns j and k of m with
+//
+//	c*m[:,j] - s*phase*m[:,k]  and  s*m[:,j] + c*phase*m[:,k]
+//
+// which is a unitary transformation for real c and s with c²+s² = 1 and
+// |phase| = 1.
+func crotateCols(m *CDense, j, k int, c, s float64, phase complex128) {
+	cc := complex(c, 0)
+	sc := complex(s, 0)
+	for i := 0; i < m.mat.Rows; i++ {
+		row := m.mat.Data[i*m.mat.Stride:]
+		x := row[j]
+		y := phase * row[k]
+		row[j] = cc*x - sc*y
+		row[k] = sc*x + cc*y
+	}
+}
+
+// Kind returns the SVDKind of the decomposition. If no decomposition has been
+// computed, Kind returns -1.
+func (svd *CSVD) Kind() SVDKind {
+	if !svd.succFact() {
+		return -1
+	}
+	return svd.kind
+}
+
+// Rank returns the rank of A based on the count of singular values greater than
+// rcond scaled by the largest singular value.
+// Rank will panic if the receiver does not contain a successful factorization or
+// rcond is negative.
+func (svd *CSVD) Rank(rcond float64) int {
+	if rcond < 0 {
+		panic(badRcond)
+	}
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	s0 := svd.s[0]
+	for i, v := range svd.s {
+		if v <= rcond*s0 {
+			return i
+		}
+	}
+	return len(svd.s)
+}
+
+// Cond returns the 2-norm condition number for the factorized matrix. Cond will
+// panic if the receiver does not contain a successful factorization.
+func (svd *CSVD) Cond() float64 {
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	return svd.s[0] / svd.s[len(svd.s)-1]
+}
+
+// Values returns the singular values of the factorized matrix in descending order.
+//
+// If the input slice is non-nil, the values will be stored in-place into
+// the slice. In this case, the slice must have length min(m,n), and Values will
+// panic with ErrSliceLengthMismatch otherwise. If the input slice is nil, a new
+// slice of the appropriate length will be allocated and returned.
+//
+// Values will panic if the receiver does not contain a successful factorization.
+func (svd *CSVD) Values(s []float64) []float64 {
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	if s == nil {
+		s = make([]float64, len(svd.s))
+	}
+	if len(s) != len(svd.s) {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(s, svd.s)
+	return s
+}
+
+// UTo extracts the matrix U from the singular value decomposition. The first
+// min(m,n) columns are the left singular vectors and correspond to the singular
+// values as returned from CSVD.Values.
+//
+// If dst is empty, UTo will resize dst to be m×m if the full U was computed
+// and size m×min(m,n) if the thin U was computed. When dst is non-empty, then
+// UTo will panic if dst is not the appropriate size. UTo will also panic if
+// the receiver does not contain a successful factorization, or if U was
+// not computed during factorization.
+func (svd *CSVD) UTo(dst *CDense) {
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	if svd.kind&(SVDThinU|SVDFullU) == 0 {
+		panic("svd: u not computed during factorization")
+	}
+	svd.vectorsTo(dst, svd.u)
+}
+
+// VTo extracts the matrix V from the singular value decomposition. The first
+// min(m,n) columns are the right singular vectors and correspond to the singular
+// values as returned from CSVD.Values.
+//
+// If dst is empty, VTo will resize dst to be n×n if the full V was computed
+// and size n×min(m,n) if the thin V was computed. When dst is non-empty, then
+// VTo will panic if dst is not the appropriate size. VTo will also panic if
+// the receiver does not contain a successful factorization, or if V was
+// not computed during factorization.
+func (svd *CSVD) VTo(dst *CDense) {
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	if svd.kind&(SVDThinV|SVDFullV) == 0 {
+		panic("svd: v not computed during factorization")
+	}
+	svd.vectorsTo(dst, svd.v)
+}
+
+// vectorsTo copies the singular vectors in src into dst.
+func (svd *CSVD) vectorsTo(dst, src *CDense) {
+	r, c := src.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || c != c2 {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(src)
+}
@@ -0,0 +1,131 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestCSVD(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, rank int
+	}{
+		{1, 1, 1},
+		{3, 3, 3},
+		{5, 3, 3},
+		{3, 5, 3},
+		{10, 10, 10},
+		{10, 10, 4},
+		{12, 7, 3},
+		{7, 12, 3},
+		{4, 4, 0},
+	} {
+		m, n := test.m, test.n
+		name := fmt.Sprintf("m=%d,n=%d,rank=%d", m, n, test.rank)
+		a := NewCDense(m, n, nil)
+		if test.rank > 0 {
+			a = cmul(randCDense(m, test.rank, rnd), randCDense(test.rank, n, rnd))
+		}
+		k := min(m, n)
+		for _, kind := range []SVDKind{SVDThin, SVDFull, SVDNone} {
+			var svd CSVD
+			if !svd.Factorize(a, kind) {
+				t.Errorf("%s,kind=%d: factorization failed", name, kind)
+				continue
+			}
+			if svd.Kind() != kind {
+				t.Errorf("%s,kind=%d: unexpected kind %d", name, kind, svd.Kind())
+			}
+			s := svd.Values(nil)
+			if len(s) != k {
+				t.Errorf("%s,kind=%d: unexpected number of singular values", name, kind)
+				continue
+			}
+			for i := 1; i < k; i++ {
+				if s[i] > s[i-1] {
+					t.Errorf("%s,kind=%d: singular values not sorted", name, kind)
+				}
+			}
+			if got := svd.Rank(1e-10); got != test.rank {
+				t.Errorf("%s,kind=%d: unexpected rank: got %d, want %d", name, kind, got, test.rank)
+			}
+			if kind == SVDNone {
+				if ok, _ := panics(func() { svd.UTo(&CDense{}) }); !ok {
+					t.Errorf("%s: expected panic for UTo without vectors", name)
+				}
+				continue
+			}
+
+			var u, v CDense
+			svd.UTo(&u)
+			svd.VTo(&v)
+			ur, uc := u.Dims()
+			vr, vc := v.Dims()
+			wantUC, wantVC := k, k
+			if kind == SVDFull {
+				wantUC, wantVC = m, n
+			}
+			if ur != m || uc != wantUC || vr != n || vc != wantVC {
+				t.Errorf("%s,kind=%d: unexpected shapes U %d×%d, V %d×%d", name, kind, ur, uc, vr, vc)
+				continue
+			}
+			if !CEqualApprox(cmul(u.H(), &u), ceye(uc), tol) {
+				t.Errorf("%s,kind=%d: U does not have orthonormal columns", name, kind)
+			}
+			if !CEqualApprox(cmul(v.H(), &v), ceye(vc), tol) {
+				t.Errorf("%s,kind=%d: V does not have orthonormal columns", name, kind)
+			}
+
+			sigma := NewCDense(uc, vc, nil)
+			for i, sv := range s {
+				sigma.set(i, i, complex(sv, 0))
+			}
+			if !CEqualApprox(cmul(cmul(&u, sigma), v.H()), a, tol*floats.Max(append([]float64{1}, s...))) {
+				t.Errorf("%s,kind=%d: U*Σ*Vᴴ != A", name, kind)
+			}
+		}
+	}
+}
+
+func TestCSVDRealAgreement(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 4, 9} {
+		a := NewDense(n+2, n, nil)
+		for i := range a.mat.Data {
+			a.mat.Data[i] = rnd.NormFloat64()
+		}
+		ca := NewCDense(n+2, n, nil)
+		for i := 0; i < n+2; i++ {
+			for j := 0; j < n; j++ {
+				ca.set(i, j, complex(a.At(i, j), 0))
+			}
+		}
+		var svd SVD
+		if !svd.Factorize(a, SVDNone) {
+			t.Fatalf("n=%d: real factorization failed", n)
+		}
+		var csvd CSVD
+		if !csvd.Factorize(ca, SVDNone) {
+			t.Fatalf("n=%d: complex factorization failed", n)
+		}
+		if !floats.EqualApprox(svd.Values(nil), csvd.Values(nil), tol) {
+			t.Errorf("n=%d: singular values differ from real SVD", n)
+		}
+		if !scalar.EqualWithinAbsOrRel(svd.Cond(), csvd.Cond(), tol, 1e-8) {
+			t.Errorf("n=%d: condition numbers differ", n)
+		}
+	}
+}
@@ -525,6 +525,8 @@ func (m *Dense) Copy(a Matrix) (r, c int) {
 		default:
 			// Nothing to do.
 		}
+	case *BlockDense:
+		aU.copyTo(m, trans, r, c)
 	default:
 		m.checkOverlapMatrix(aU)
 		for i := 0; i < r; i++ {
@@ -598,6 +600,7 @@ func (m *Dense) Trace() float64 {
 //	1 - The maximum absolute column sum
 //	2 - The Frobenius norm, the square root of the sum of the squares of the elements
 //	Inf - The maximum absolute row sum
+//	MaxAbsNorm - The maximum absolute value of the elements
 //
 // Norm will panic with ErrNormOrder if an illegal norm is specified and with
 // ErrShape if the matrix has zero size.
@@ -0,0 +1,298 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"gonum.org/v1/gonum/blas/blas32"
+)
+
+var (
+	dense32 *Dense32
+
+	_ Matrix    = dense32
+	_ allMatrix = dense32
+)
+
+// Dense32 is a dense matrix representation with float32 data. It halves the
+// memory and bandwidth requirements of Dense at the cost of precision.
+//
+// Dense32 satisfies the Matrix interface, so it can be used as an operand of
+// the float64 matrix types, in which case its elements are converted exactly.
+// The arithmetic methods of Dense32 are computed in float32 and convert
+// operands that are not Dense32 to float32.
+type Dense32 struct {
+	mat blas32.General
+
+	capRows, capCols int
+}
+
+// NewDense32 creates a new float32 Dense matrix with r rows and c columns.
+// If data == nil, a new slice is allocated for the backing slice.
+// If len(data) == r*c, data is used as the backing slice, and changes to the
+// elements of the returned Dense32 will be reflected in data.
+// If neither of these is true, NewDense32 will panic.
+// NewDense32 will panic if either r or c is zero.
+//
+// The data must be arranged in row-major order, i.e. the (i*c + j)-th
+// element in the data slice is the {i, j}-th element in the matrix.
+func NewDense32(r, c int, data []float32) *Dense32 {
+	if r <= 0 || c <= 0 {
+		if r == 0 || c == 0 {
+			panic(ErrZeroLength)
+		}
+		panic(ErrNegativeDimension)
+	}
+	if data != nil && r*c != len(data) {
+		panic(ErrShape)
+	}
+	if data == nil {
+		data = make([]float32, r*c)
+	}
+	return &Dense32{
+		mat: blas32.General{
+			Rows:   r,
+			Cols:   c,
+			Stride: c,
+			Data:   data,
+		},
+		capRows: r,
+		capCols: c,
+	}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (m *Dense32) Dims() (r, c int) {
+	return m.mat.Rows, m.mat.Cols
+}
+
+// Caps returns the number of rows and columns in the backing matrix.
+func (m *Dense32) Caps() (r, c int) { return m.capRows, m.capCols }
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (m *Dense32) T() Matrix {
+	return Transpose{m}
+}
+
+// ReuseAs changes the receiver if it IsEmpty() to be of size r×c.
+//
+// ReuseAs re-uses the backing data slice if it has sufficient capacity,
+// otherwise a new slice is allocated. The backing data is zero on return.
+//
+// ReuseAs panics if the receiver is not empty, and panics if
+// the input sizes are less than one. To empty the receiver for re-use,
+// Reset should be used.
+func (m *Dense32) ReuseAs(r, c int) {
+	if r <= 0 || c <= 0 {
+		if r == 0 || c == 0 {
+			panic(ErrZeroLength)
+		}
+		panic(ErrNegativeDimension)
+	}
+	if !m.IsEmpty() {
+		panic(ErrReuseNonEmpty)
+	}
+	m.reuseAsZeroed(r, c)
+}
+
+// reuseAsNonZeroed resizes an empty matrix to a r×c matrix,
+// or checks that a non-empty matrix is r×c.
+//
+// reuseAsNonZeroed must be kept in sync with reuseAsZeroed.
+func (m *Dense32) reuseAsNonZeroed(r, c int) {
+	if m.mat.Rows > m.capRows || m.mat.Cols > m.capCols {
+		// Panic as a string, not a mat.Error.
+		panic(badCap)
+	}
+	if r == 0 || c == 0 {
+		panic(ErrZeroLength)
+	}
+	if m.IsEmpty() {
+		m.mat = blas32.General{
+			Rows:   r,
+			Cols:   c,
+			Stride: c,
+			Data:   useFloat32(m.mat.Data, r*c),
+		}
+		m.capRows = r
+		m.capCols = c
+		return
+	}
+	if r != m.mat.Rows || c != m.mat.Cols {
+		panic(ErrShape)
+	}
+}
+
+func (m *Dense32) reuseAsZeroed(r, c int) {
+	// This must be kept in-sync with reuseAsNonZeroed.
+	if m.mat.Rows > m.capRows || m.mat.Cols > m.capCols {
+		// Panic as a string, not a mat.Error.
+		panic(badCap)
+	}
+	if r == 0 || c == 0 {
+		panic(ErrZeroLength)
+	}
+	if m.IsEmpty() {
+		m.mat = blas32.General{
+			Rows:   r,
+			Cols:   c,
+			Stride: c,
+			Data:   useZeroedFloat32(m.mat.Data, r*c),
+		}
+		m.capRows = r
+		m.capCols = c
+		return
+	}
+	if r != m.mat.Rows || c != m.mat.Cols {
+		panic(ErrShape)
+	}
+	m.Zero()
+}
+
+// Reset zeros the dimensions of the matrix so that it can be reused as the
+// receiver of a dimensionally restricted operation.
+//
+// Reset should not be used when the matrix shares backing data.
+// See the Reseter interface for more information.
+func (m *Dense32) Reset() {
+	// Row, Cols and Stride must be zeroed in unison.
+	m.mat.Rows, m.mat.Cols, m.mat.Stride = 0, 0, 0
+	m.capRows, m.capCols = 0, 0
+	m.mat.Data = m.mat.Data[:0]
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for size-restricted operations. The receiver can be zeroed using Reset.
+func (m *Dense32) IsEmpty() bool {
+	// It must be the case that m.Dims() returns
+	// zeros in this case. See comment in Reset().
+	return m.mat.Stride == 0
+}
+
+// Zero sets all of the matrix elements to zero.
+func (m *Dense32) Zero() {
+	r := m.mat.Rows
+	c := m.mat.Cols
+	for i := 0; i < r; i++ {
+		zeroFloat32(m.mat.Data[i*m.mat.Stride : i*m.mat.Stride+c])
+	}
+}
+
+// Copy makes a copy of elements of a into the receiver, converting them to
+// float32. It is similar to the built-in copy; it copies as much as the
+// overlap between the two matrices and returns the number of rows and columns
+// it copied.
+//
+// See the Copier interface for more information.
+func (m *Dense32) Copy(a Matrix) (r, c int) {
+	r, c = a.Dims()
+	if a == m {
+		return r, c
+	}
+	r = min(r, m.mat.Rows)
+	c = min(c, m.mat.Cols)
+	if r == 0 || c == 0 {
+		return 0, 0
+	}
+
+	aU, trans := untranspose(a)
+	if aU, ok := aU.(*Dense32); ok {
+		if !trans {
+			if aU != m {
+				m.checkOverlap(aU.mat)
+			}
+			for i := 0; i < r; i++ {
+				copy(m.mat.Data[i*m.mat.Stride:i*m.mat.Stride+c], aU.mat.Data[i*aU.mat.Stride:i*aU.mat.Stride+c])
+			}
+			return r, c
+		}
+		if aU == m {
+			// Transposing in place requires a copy of the
+			// source.
+			var tmp Dense32
+			tmp.reuseAsNonZeroed(c, r)
+			tmp.Copy(aU)
+			aU = &tmp
+		} else {
+			m.checkOverlap(aU.mat)
+		}
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				m.mat.Data[i*m.mat.Stride+j] = aU.mat.Data[j*aU.mat.Stride+i]
+			}
+		}
+		return r, c
+	}
+
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			m.set(i, j, float32(a.At(i, j)))
+		}
+	}
+	return r, c
+}
+
+// CloneFrom makes a copy of a into the receiver, overwriting the previous
+// value of the receiver. The elements of a are converted to float32.
+// The clone operation does not make any restriction on shape and will not
+// cause shadowing.
+//
+// See the ClonerFrom interface for more information.
+func (m *Dense32) CloneFrom(a Matrix) {
+	r, c := a.Dims()
+	mat := blas32.General{
+		Rows:   r,
+		Cols:   c,
+		Stride: c,
+		Data:   make([]float32, r*c),
+	}
+	var tmp Dense32
+	tmp.mat = mat
+	tmp.capRows, tmp.capCols = r, c
+	tmp.Copy(a)
+	m.mat = mat
+	m.capRows, m.capCols = r, c
+}
+
+// SetRawMatrix sets the underlying blas32.General used by the receiver.
+// Changes to elements in the receiver following the call will be reflected
+// in b.
+func (m *Dense32) SetRawMatrix(b blas32.General) {
+	m.capRows, m.capCols = b.Rows, b.Cols
+	m.mat = b
+}
+
+// RawMatrix returns the underlying blas32.General used by the receiver.
+// Changes to elements in the receiver following the call will be reflected
+// in returned blas32.General.
+func (m *Dense32) RawMatrix() blas32.General { return m.mat }
+
+// useFloat32 returns a float32 slice with l elements, using f if it
+// has the necessary capacity, otherwise creating a new slice.
+func useFloat32(f []float32, l int) []float32 {
+	if l <= cap(f) {
+		return f[:l]
+	}
+	return make([]float32, l)
+}
+
+// useZeroedFloat32 returns a float32 slice with l elements, using f if it
+// has the necessary capacity, otherwise creating a new slice. The
+// elements of the returned slice are guaranteed to be zero.
+func useZeroedFloat32(f []float32, l int) []float32 {
+	if l <= cap(f) {
+		f = f[:l]
+		zeroFloat32(f)
+		return f
+	}
+	return make([]float32, l)
+}
+
+// zeroFloat32 zeros the given slice's elements.
+func zeroFloat32(f []float32) {
+	for i := range f {
+		f[i] = 0
+	}
+}
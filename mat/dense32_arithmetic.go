@@ -0,0 +1,294 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+)
+
+// conditionTolerance32 is the tolerance limit of the condition number of a
+// Dense32 system. It is the float32 analogue of ConditionTolerance.
+const conditionTolerance32 = 1 << 24
+
+// Add adds a and b element-wise, placing the result in the receiver. Add
+// will panic if the two matrices do not have the same shape.
+func (m *Dense32) Add(a, b Matrix) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		panic(ErrShape)
+	}
+	m.reuseAsNonZeroed(ar, ac)
+	amat := m.operand(a).mat
+	bmat := m.operand(b).mat
+	for i := 0; i < ar; i++ {
+		row := m.mat.Data[i*m.mat.Stride : i*m.mat.Stride+ac]
+		arow := amat.Data[i*amat.Stride : i*amat.Stride+ac]
+		brow := bmat.Data[i*bmat.Stride : i*bmat.Stride+ac]
+		for j, v := range arow {
+			row[j] = v + brow[j]
+		}
+	}
+}
+
+// Sub subtracts the matrix b from a, placing the result in the receiver. Sub
+// will panic if the two matrices do not have the same shape.
+func (m *Dense32) Sub(a, b Matrix) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br || ac != bc {
+		panic(ErrShape)
+	}
+	m.reuseAsNonZeroed(ar, ac)
+	amat := m.operand(a).mat
+	bmat := m.operand(b).mat
+	for i := 0; i < ar; i++ {
+		row := m.mat.Data[i*m.mat.Stride : i*m.mat.Stride+ac]
+		arow := amat.Data[i*amat.Stride : i*amat.Stride+ac]
+		brow := bmat.Data[i*bmat.Stride : i*bmat.Stride+ac]
+		for j, v := range arow {
+			row[j] = v - brow[j]
+		}
+	}
+}
+
+// Scale multiplies the elements of a by f, placing the result in the receiver.
+//
+// See the Scaler interface for more information.
+func (m *Dense32) Scale(f float32, a Matrix) {
+	ar, ac := a.Dims()
+	m.reuseAsNonZeroed(ar, ac)
+	amat := m.operand(a).mat
+	for i := 0; i < ar; i++ {
+		row := m.mat.Data[i*m.mat.Stride : i*m.mat.Stride+ac]
+		for j, v := range amat.Data[i*amat.Stride : i*amat.Stride+ac] {
+			row[j] = f * v
+		}
+	}
+}
+
+// Mul takes the matrix product of a and b, placing the result in the receiver.
+// If the number of columns in a does not equal the number of rows in b, Mul will panic.
+func (m *Dense32) Mul(a, b Matrix) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ac != br {
+		panic(ErrShape)
+	}
+	m.reuseAsNonZeroed(ar, bc)
+
+	amat, aT, aAlias := m.mulOperand(a)
+	bmat, bT, bAlias := m.mulOperand(b)
+	if aAlias || bAlias {
+		var w Dense32
+		w.reuseAsNonZeroed(ar, bc)
+		blas32.Gemm(aT, bT, 1, amat, bmat, 0, w.mat)
+		m.Copy(&w)
+		return
+	}
+	blas32.Gemm(aT, bT, 1, amat, bmat, 0, m.mat)
+}
+
+// operand returns a Dense32 holding the elements of a that may be read
+// while the receiver is written element-wise.
+func (m *Dense32) operand(a Matrix) *Dense32 {
+	aU, trans := untranspose(a)
+	if aU, ok := aU.(*Dense32); ok && !trans {
+		if aU != m {
+			m.checkOverlap(aU.mat)
+		}
+		return aU
+	}
+	var tmp Dense32
+	tmp.CloneFrom(a)
+	return &tmp
+}
+
+// mulOperand returns the blas32 representation of a for use in a matrix
+// product and whether it shares its data with the receiver.
+func (m *Dense32) mulOperand(a Matrix) (amat blas32.General, t blas.Transpose, alias bool) {
+	aU, trans := untranspose(a)
+	t = blas.NoTrans
+	if aU, ok := aU.(*Dense32); ok {
+		if trans {
+			t = blas.Trans
+		}
+		if aU == m {
+			return aU.mat, t, true
+		}
+		m.checkOverlap(aU.mat)
+		return aU.mat, t, false
+	}
+	var tmp Dense32
+	tmp.CloneFrom(a)
+	return tmp.mat, t, false
+}
+
+// Solve solves the linear system A⋅X = B for the square matrix A using an
+// LU factorization with partial pivoting computed in float32, and places the
+// result in the receiver.
+//
+// If A is singular, Solve returns Condition(+Inf). If A is close to
+// singular in float32 precision, the solution is computed and Solve returns
+// a Condition error holding an estimate of the 1-norm condition number of A.
+// Solve panics if A is not square or if the number of rows in A and B differ.
+func (m *Dense32) Solve(a, b Matrix) error {
+	ar, ac := a.Dims()
+	if ar != ac {
+		panic(ErrSquare)
+	}
+	br, bc := b.Dims()
+	if ar != br {
+		panic(ErrShape)
+	}
+	m.reuseAsNonZeroed(ac, bc)
+
+	var lu lu32
+	lu.factorize(a)
+	if bU, _ := untranspose(b); bU == m {
+		var tmp Dense32
+		tmp.CloneFrom(b)
+		b = &tmp
+	}
+	m.Copy(b)
+	if lu.singular {
+		return Condition(math.Inf(1))
+	}
+	lu.solve(false, m.mat)
+	if cond := lu.cond(); cond > conditionTolerance32 {
+		return Condition(cond)
+	}
+	return nil
+}
+
+// lu32 is an LU factorization with partial pivoting of a square float32
+// matrix, P⋅A = L⋅U.
+type lu32 struct {
+	mat      blas32.General
+	piv      []int
+	anorm    float64
+	singular bool
+}
+
+// factorize computes the LU factorization of the square matrix a.
+func (lu *lu32) factorize(a Matrix) {
+	var tmp Dense32
+	tmp.CloneFrom(a)
+	lu.mat = tmp.mat
+	n := lu.mat.Rows
+	data, stride := lu.mat.Data, lu.mat.Stride
+
+	// The 1-norm of A is needed for the condition estimate.
+	lu.anorm = 0
+	for j := 0; j < n; j++ {
+		var s float64
+		for i := 0; i < n; i++ {
+			s += math.Abs(float64(data[i*stride+j]))
+		}
+		lu.anorm = math.Max(lu.anorm, s)
+	}
+
+	lu.piv = make([]int, n)
+	lu.singular = false
+	for j := 0; j < n; j++ {
+		col := blas32.Vector{N: n - j, Inc: stride, Data: data[j*stride+j:]}
+		p := j + blas32.Iamax(col)
+		lu.piv[j] = p
+		if data[p*stride+j] == 0 {
+			lu.singular = true
+			continue
+		}
+		if p != j {
+			blas32.Swap(
+				blas32.Vector{N: n, Inc: 1, Data: data[j*stride:]},
+				blas32.Vector{N: n, Inc: 1, Data: data[p*stride:]},
+			)
+		}
+		if j == n-1 {
+			break
+		}
+		below := blas32.Vector{N: n - j - 1, Inc: stride, Data: data[(j+1)*stride+j:]}
+		blas32.Scal(1/data[j*stride+j], below)
+		blas32.Ger(-1,
+			below,
+			blas32.Vector{N: n - j - 1, Inc: 1, Data: data[j*stride+j+1:]},
+			blas32.General{Rows: n - j - 1, Cols: n - j - 1, Stride: stride, Data: data[(j+1)*stride+j+1:]},
+		)
+	}
+}
+
+// solve solves A⋅X = B, or Aᵀ⋅X = B if trans is true, in place in b.
+func (lu *lu32) solve(trans bool, b blas32.General) {
+	n := lu.mat.Rows
+	lower := blas32.Triangular{N: n, Stride: lu.mat.Stride, Data: lu.mat.Data, Uplo: blas.Lower, Diag: blas.Unit}
+	upper := blas32.Triangular{N: n, Stride: lu.mat.Stride, Data: lu.mat.Data, Uplo: blas.Upper, Diag: blas.NonUnit}
+	if !trans {
+		for i, p := range lu.piv {
+			lu.swapRows(b, i, p)
+		}
+		blas32.Trsm(blas.Left, blas.NoTrans, 1, lower, b)
+		blas32.Trsm(blas.Left, blas.NoTrans, 1, upper, b)
+		return
+	}
+	blas32.Trsm(blas.Left, blas.Trans, 1, upper, b)
+	blas32.Trsm(blas.Left, blas.Trans, 1, lower, b)
+	for i := n - 1; i >= 0; i-- {
+		lu.swapRows(b, i, lu.piv[i])
+	}
+}
+
+func (lu *lu32) swapRows(b blas32.General, i, j int) {
+	if i == j {
+		return
+	}
+	blas32.Swap(
+		blas32.Vector{N: b.Cols, Inc: 1, Data: b.Data[i*b.Stride:]},
+		blas32.Vector{N: b.Cols, Inc: 1, Data: b.Data[j*b.Stride:]},
+	)
+}
+
+// cond returns an estimate of the 1-norm condition number of the factorized
+// matrix using Hager's method.
+func (lu *lu32) cond() float64 {
+	n := lu.mat.Rows
+	x := make([]float32, n)
+	for i := range x {
+		x[i] = 1 / float32(n)
+	}
+	prev := make([]float32, n)
+	v := blas32.General{Rows: n, Cols: 1, Stride: 1, Data: x}
+	var est float64
+	for iter := 0; iter < 5; iter++ {
+		copy(prev, x)
+		lu.solve(false, v)
+		norm := float64(blas32.Asum(blas32.Vector{N: n, Inc: 1, Data: x}))
+		if iter > 0 && norm <= est {
+			break
+		}
+		est = norm
+		for i, xi := range x {
+			if xi >= 0 {
+				x[i] = 1
+			} else {
+				x[i] = -1
+			}
+		}
+		lu.solve(true, v)
+		j := blas32.Iamax(blas32.Vector{N: n, Inc: 1, Data: x})
+		var ztx float64
+		for i, zi := range x {
+			ztx += float64(zi) * float64(prev[i])
+		}
+		if math.Abs(float64(x[j])) <= ztx {
+			break
+		}
+		zeroFloat32(x)
+		x[j] = 1
+	}
+	return lu.anorm * est
+}
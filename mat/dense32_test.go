@@ -0,0 +1,255 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+// randDense32 returns a random r×c Dense32 and its float64 equivalent.
+func randDense32(rnd *rand.Rand, r, c int) (*Dense32, *Dense) {
+	m := NewDense32(r, c, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			m.Set(i, j, float32(rnd.NormFloat64()))
+		}
+	}
+	return m, DenseCopyOf(m)
+}
+
+func TestNewDense32(t *testing.T) {
+	t.Parallel()
+	data := []float32{1, 2, 3, 4, 5, 6}
+	m := NewDense32(2, 3, data)
+	if r, c := m.Dims(); r != 2 || c != 3 {
+		t.Errorf("unexpected dimensions: got:%d×%d want:2×3", r, c)
+	}
+	if m.At(1, 0) != 4 {
+		t.Errorf("unexpected value: got:%v want:4", m.At(1, 0))
+	}
+	m.Set(0, 1, 7)
+	if data[1] != 7 {
+		t.Errorf("data not shared with matrix")
+	}
+	if !Equal(m.T(), NewDense(3, 2, []float64{1, 4, 7, 5, 3, 6})) {
+		t.Errorf("unexpected transpose: got:%v", Formatted(m.T()))
+	}
+
+	for _, fn := range []func(){
+		func() { NewDense32(0, 1, nil) },
+		func() { NewDense32(-1, 1, nil) },
+		func() { NewDense32(2, 2, make([]float32, 3)) },
+		func() { m.At(2, 0) },
+		func() { m.Set(0, 3, 1) },
+	} {
+		if ok, _ := panics(fn); !ok {
+			t.Errorf("expected panic")
+		}
+	}
+
+	var e Dense32
+	if !e.IsEmpty() {
+		t.Errorf("zero value not empty")
+	}
+	e.ReuseAs(2, 2)
+	if e.IsEmpty() || !Equal(&e, NewDense(2, 2, nil)) {
+		t.Errorf("unexpected matrix after ReuseAs")
+	}
+	if ok, _ := panics(func() { e.ReuseAs(2, 2) }); !ok {
+		t.Errorf("expected panic for ReuseAs of non-empty matrix")
+	}
+	e.Reset()
+	if !e.IsEmpty() {
+		t.Errorf("matrix not empty after Reset")
+	}
+}
+
+func TestDense32Copy(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	a, a64 := randDense32(rnd, 3, 4)
+
+	var m Dense32
+	m.CloneFrom(a)
+	if !Equal(&m, a64) {
+		t.Errorf("unexpected clone of Dense32")
+	}
+	m.CloneFrom(a64.T())
+	if !Equal(&m, a64.T()) {
+		t.Errorf("unexpected clone of transposed Dense")
+	}
+
+	sq, sq64 := randDense32(rnd, 4, 4)
+	sq.Copy(sq.T())
+	if !Equal(sq, sq64.T()) {
+		t.Errorf("unexpected in-place transpose copy")
+	}
+
+	// Values are rounded to the nearest float32.
+	m.CloneFrom(NewDense(1, 1, []float64{1.0 / 3}))
+	if got := m.At(0, 0); got != float64(float32(1.0/3)) {
+		t.Errorf("unexpected converted value: got:%v want:%v", got, float32(1.0/3))
+	}
+}
+
+func TestDense32Arithmetic(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	const tol = 1e-5
+	for _, test := range []struct{ r, c, k int }{
+		{1, 1, 1},
+		{3, 4, 5},
+		{10, 7, 12},
+	} {
+		a, a64 := randDense32(rnd, test.r, test.c)
+		b, b64 := randDense32(rnd, test.r, test.c)
+
+		var got Dense32
+		var want Dense
+		got.Add(a, b)
+		want.Add(a64, b64)
+		if !EqualApprox(&got, &want, tol) {
+			t.Errorf("%d×%d: unexpected Add result", test.r, test.c)
+		}
+		got.Sub(a, b64)
+		want.Sub(a64, b64)
+		if !EqualApprox(&got, &want, tol) {
+			t.Errorf("%d×%d: unexpected Sub result with Dense operand", test.r, test.c)
+		}
+		got.Scale(2.5, a)
+		want.Scale(2.5, a64)
+		if !EqualApprox(&got, &want, tol) {
+			t.Errorf("%d×%d: unexpected Scale result", test.r, test.c)
+		}
+
+		c, c64 := randDense32(rnd, test.c, test.k)
+		var mul Dense32
+		var mul64 Dense
+		mul.Mul(a, c)
+		mul64.Mul(a64, c64)
+		if !EqualApprox(&mul, &mul64, tol*float64(test.c)) {
+			t.Errorf("%d×%d×%d: unexpected Mul result", test.r, test.c, test.k)
+		}
+		mul.Reset()
+		mul.Mul(c.T(), a.T())
+		if !EqualApprox(&mul, mul64.T(), tol*float64(test.c)) {
+			t.Errorf("%d×%d×%d: unexpected Mul result with transposed operands", test.r, test.c, test.k)
+		}
+
+		// Aliased receiver.
+		sq, sq64 := randDense32(rnd, test.r, test.r)
+		want.Reset()
+		want.Mul(sq64, sq64.T())
+		sq.Mul(sq, sq.T())
+		if !EqualApprox(sq, &want, tol*float64(test.r)) {
+			t.Errorf("%d×%d: unexpected Mul result with aliased receiver", test.r, test.r)
+		}
+		a.Add(a, a.T().T())
+		if !EqualApprox(a, scaled(2, a64), tol) {
+			t.Errorf("%d×%d: unexpected Add result with aliased receiver", test.r, test.c)
+		}
+	}
+
+	a := NewDense32(2, 3, nil)
+	for _, fn := range []func(){
+		func() { a.Add(a, NewDense32(3, 2, nil)) },
+		func() { a.Sub(NewDense32(2, 2, nil), a) },
+		func() { a.Mul(a, a) },
+		func() { NewDense32(2, 2, nil).Add(a, a) },
+	} {
+		if ok, _ := panics(fn); !ok {
+			t.Errorf("expected panic for shape mismatch")
+		}
+	}
+}
+
+func scaled(f float64, a Matrix) *Dense {
+	var m Dense
+	m.Scale(f, a)
+	return &m
+}
+
+func TestDense32Solve(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 20, 50} {
+		for _, bc := range []int{1, 3} {
+			a, a64 := randDense32(rnd, n, n)
+			for i := 0; i < n; i++ {
+				// Make a reasonably conditioned.
+				a.Set(i, i, a.at(i, i)+float32(n))
+			}
+			a64.Copy(a)
+			_, b64 := randDense32(rnd, n, bc)
+
+			var x Dense32
+			err := x.Solve(a, b64)
+			if err != nil {
+				t.Errorf("n=%d: unexpected error: %v", n, err)
+			}
+			var want Dense
+			want.Solve(a64, b64)
+			if !EqualApprox(&x, &want, 1e-4) {
+				t.Errorf("n=%d: unexpected solution:\ngot: %v\nwant:%v", n, Formatted(&x), Formatted(&want))
+			}
+
+			x.Reset()
+			err = x.Solve(a.T(), b64)
+			if err != nil {
+				t.Errorf("n=%d: unexpected error for transpose: %v", n, err)
+			}
+			want.Solve(a64.T(), b64)
+			if !EqualApprox(&x, &want, 1e-4) {
+				t.Errorf("n=%d: unexpected solution for transpose", n)
+			}
+
+			// Aliased right-hand side.
+			want.Solve(a64, b64)
+			b := NewDense32(n, bc, nil)
+			b.Copy(b64)
+			err = b.Solve(a, b)
+			if err != nil {
+				t.Errorf("n=%d: unexpected error for aliased right-hand side: %v", n, err)
+			}
+			if !EqualApprox(b, &want, 1e-4) {
+				t.Errorf("n=%d: unexpected solution for aliased right-hand side", n)
+			}
+		}
+	}
+
+	var x Dense32
+	err := x.Solve(NewDense32(2, 2, []float32{1, 2, 2, 4}), NewDense32(2, 1, nil))
+	if c, ok := err.(Condition); !ok || !math.IsInf(float64(c), 1) {
+		t.Errorf("unexpected error for singular matrix: got:%v want:%v", err, Condition(math.Inf(1)))
+	}
+
+	// A matrix that is nearly singular in float32 precision.
+	x.Reset()
+	err = x.Solve(NewDense32(2, 2, []float32{1, 1, 1, math.Nextafter32(1, 2)}), NewDense32(2, 1, []float32{1, 1}))
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("expected Condition error for ill-conditioned matrix: got:%v", err)
+	}
+
+	if ok, _ := panics(func() { x.Solve(NewDense32(2, 3, nil), NewDense32(2, 1, nil)) }); !ok {
+		t.Errorf("expected panic for non-square matrix")
+	}
+}
+
+func TestDense32Overlap(t *testing.T) {
+	t.Parallel()
+	m := NewDense32(4, 4, nil)
+	var a, b Dense32
+	a.SetRawMatrix(m.RawMatrix())
+	a.mat.Rows, a.mat.Cols = 3, 3
+	b.SetRawMatrix(m.RawMatrix())
+	b.mat.Data = b.mat.Data[1:]
+	b.mat.Rows, b.mat.Cols = 3, 3
+	if ok, _ := panics(func() { a.Add(&b, &b) }); !ok {
+		t.Errorf("expected panic for overlapping operands")
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"gonum.org/v1/gonum/blas"
 	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/internal/asm/f64"
 	"gonum.org/v1/gonum/lapack/lapack64"
 )
 
@@ -210,6 +211,30 @@ func (m *Dense) DivElem(a, b Matrix) {
 	}
 }
 
+// Pinv computes the Moore–Penrose pseudoinverse of the m×n matrix a using its
+// singular value decomposition, storing the n×m result into the receiver.
+// Singular values of a at or below rcond times the largest singular value are
+// treated as zero. If rcond is zero, the default cutoff max(m,n)·ε is used,
+// where ε = 2⁻⁵² is the spacing of float64 values at one. See SVD.PinvTo for
+// more information.
+//
+// Unlike Inverse, Pinv is defined for rectangular and rank-deficient
+// matrices. Pinv returns ErrFailedSVD if the singular value decomposition of
+// a could not be computed, and panics if rcond is negative.
+func (m *Dense) Pinv(a Matrix, rcond float64) error {
+	if rcond < 0 {
+		panic(badRcond)
+	}
+	var svd SVD
+	if !svd.Factorize(a, SVDThin) {
+		return ErrFailedSVD
+	}
+	r, c := a.Dims()
+	m.reuseAsNonZeroed(c, r)
+	svd.PinvTo(m, rcond)
+	return nil
+}
+
 // Inverse computes the inverse of the matrix a, storing the result into the
 // receiver. If a is ill-conditioned, a Condition error will be returned.
 // Note that matrix inversion is numerically unstable, and should generally
@@ -275,6 +300,8 @@ func (m *Dense) Inverse(a Matrix) error {
 
 // Mul takes the matrix product of a and b, placing the result in the receiver.
 // If the number of columns in a does not equal the number of rows in b, Mul will panic.
+// The product of two *Dense matrices is computed using the Backend set by
+// UseBackend if the matrices are large enough.
 func (m *Dense) Mul(a, b Matrix) {
 	ar, ac := a.Dims()
 	br, bc := b.Dims()
@@ -303,6 +330,13 @@ func (m *Dense) Mul(a, b Matrix) {
 		bT = blas.Trans
 	}
 
+	_, aBlock := aU.(*BlockDense)
+	_, bBlock := bU.(*BlockDense)
+	if aBlock || bBlock {
+		m.mulBlock(a, b)
+		return
+	}
+
 	// Some of the cases do not have a transpose option, so create
 	// temporary memory.
 	// C = Aᵀ * B = (Bᵀ * A)ᵀ
@@ -316,6 +350,9 @@ func (m *Dense) Mul(a, b Matrix) {
 			if restore == nil {
 				m.checkOverlap(bU.mat)
 			}
+			if be := useBackend(ar, ac, bc); be != nil && m.mulBackend(be, aT, bT, aU.mat, bU.mat) {
+				return
+			}
 			blas64.Gemm(aT, bT, 1, aU.mat, bU.mat, 0, m.mat)
 			return
 
@@ -439,6 +476,15 @@ func (m *Dense) Mul(a, b Matrix) {
 		}
 	}
 
+	if aU, ok := aU.(*CSR); ok {
+		m.mulCSRLeft(aU, aTrans, b)
+		return
+	}
+	if bU, ok := bU.(*CSR); ok {
+		m.mulCSRRight(a, bU, bTrans)
+		return
+	}
+
 	m.checkOverlapMatrix(aU)
 	m.checkOverlapMatrix(bU)
 	row := getFloat64s(ac, false)
@@ -875,3 +921,102 @@ func (m *Dense) Outer(alpha float64, x, y Vector) {
 		}
 	}
 }
+
+// AddRowVec adds the vector v to each row of a, placing the result in the
+// receiver. AddRowVec will panic if the length of v is not the number of
+// columns of a.
+//
+//	m = a + 1 * vᵀ
+//
+// where 1 is the column vector of ones. Subtracting the column means of a
+// data matrix is an example of its use.
+func (m *Dense) AddRowVec(a Matrix, v Vector) {
+	ar, ac := a.Dims()
+	if v.Len() != ac {
+		panic(ErrShape)
+	}
+	vals := m.broadcastPrepare(a, v)
+	for i := 0; i < ar; i++ {
+		f64.AxpyUnitary(1, vals, m.mat.Data[i*m.mat.Stride:i*m.mat.Stride+ac])
+	}
+}
+
+// AddColVec adds the vector v to each column of a, placing the result in the
+// receiver. AddColVec will panic if the length of v is not the number of rows
+// of a.
+//
+//	m = a + v * 1ᵀ
+//
+// where 1 is the column vector of ones.
+func (m *Dense) AddColVec(a Matrix, v Vector) {
+	ar, ac := a.Dims()
+	if v.Len() != ar {
+		panic(ErrShape)
+	}
+	vals := m.broadcastPrepare(a, v)
+	for i, f := range vals {
+		f64.AddConst(f, m.mat.Data[i*m.mat.Stride:i*m.mat.Stride+ac])
+	}
+}
+
+// ScaleRows multiplies the i-th row of a by v[i], placing the result in the
+// receiver. ScaleRows will panic if the length of v is not the number of rows
+// of a.
+//
+//	m = diag(v) * a
+func (m *Dense) ScaleRows(v Vector, a Matrix) {
+	ar, ac := a.Dims()
+	if v.Len() != ar {
+		panic(ErrShape)
+	}
+	vals := m.broadcastPrepare(a, v)
+	for i, f := range vals {
+		f64.ScalUnitary(f, m.mat.Data[i*m.mat.Stride:i*m.mat.Stride+ac])
+	}
+}
+
+// ScaleCols multiplies the j-th column of a by v[j], placing the result in
+// the receiver. ScaleCols will panic if the length of v is not the number of
+// columns of a.
+//
+//	m = a * diag(v)
+func (m *Dense) ScaleCols(v Vector, a Matrix) {
+	ar, ac := a.Dims()
+	if v.Len() != ac {
+		panic(ErrShape)
+	}
+	vals := m.broadcastPrepare(a, v)
+	for i := 0; i < ar; i++ {
+		row := m.mat.Data[i*m.mat.Stride : i*m.mat.Stride+ac]
+		for j, f := range vals {
+			row[j] *= f
+		}
+	}
+}
+
+// broadcastPrepare checks that v does not overlap the receiver, copies a into
+// the receiver and returns the elements of v as a contiguous slice.
+func (m *Dense) broadcastPrepare(a Matrix, v Vector) []float64 {
+	n := v.Len()
+	var vals []float64
+	vU, _ := untransposeExtract(v)
+	if rv, ok := vU.(*VecDense); ok {
+		r, c := vU.Dims()
+		m.checkOverlap(generalFromVector(rv.mat, r, c))
+		if rv.mat.Inc == 1 {
+			vals = rv.mat.Data[:n]
+		}
+	}
+	if vals == nil {
+		vals = make([]float64, n)
+		for i := range vals {
+			vals[i] = v.AtVec(i)
+		}
+	}
+	if a != m {
+		ar, ac := a.Dims()
+		m.reuseAsNonZeroed(ar, ac)
+		m.Copy(a)
+	}
+	return vals
+}
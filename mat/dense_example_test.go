@@ -267,3 +267,63 @@ func ExampleDense_Scale() {
 	// m = ⎡1.000  1.000⎤
 	//     ⎣1.000  1.000⎦
 }
+
+func ExampleDense_Select() {
+	// Initialize a data matrix with one observation per row.
+	data := mat.NewDense(5, 3, []float64{
+		1, 10, 100,
+		2, 20, 200,
+		3, 30, 300,
+		4, 40, 400,
+		5, 50, 500,
+	})
+
+	// Split the observations into training and test
+	// sets, keeping only the first and last features.
+	features := []int{0, 2}
+	var train, test mat.Dense
+	train.Select(data, []int{0, 2, 4}, features)
+	test.Select(data, []int{1, 3}, features)
+
+	fmt.Printf("train = %v\n\n", mat.Formatted(&train, mat.Prefix("        ")))
+	fmt.Printf("test = %v\n", mat.Formatted(&test, mat.Prefix("       ")))
+
+	// Output:
+	//
+	// train = ⎡  1  100⎤
+	//         ⎢  3  300⎥
+	//         ⎣  5  500⎦
+	//
+	// test = ⎡  2  200⎤
+	//        ⎣  4  400⎦
+}
+
+func ExampleDense_Einsum() {
+	a := mat.NewDense(2, 3, []float64{
+		1, 2, 3,
+		4, 5, 6,
+	})
+	b := mat.NewDense(3, 2, []float64{
+		1, 0,
+		0, 1,
+		1, 1,
+	})
+	x := mat.NewVecDense(2, []float64{1, 2})
+
+	// Compute the bilinear form xᵀ A B x.
+	var bilinear mat.Dense
+	bilinear.Einsum("i,ij,jk,k->", x, a, b, x)
+
+	// Compute the transpose of the product A B.
+	var c mat.Dense
+	c.Einsum("ij,jk->ki", a, b)
+
+	fmt.Printf("xᵀ A B x = %v\n", bilinear.At(0, 0))
+	fmt.Printf("(A B)ᵀ = %v\n", mat.Formatted(&c, mat.Prefix("         ")))
+
+	// Output:
+	//
+	// xᵀ A B x = 78
+	// (A B)ᵀ = ⎡ 4  10⎤
+	//          ⎣ 5  11⎦
+}
@@ -1757,6 +1757,104 @@ func TestDenseRankOne(t *testing.T) {
 	}
 }
 
+func TestDenseBroadcast(t *testing.T) {
+	t.Parallel()
+	src := rand.NewSource(1)
+	rnd := rand.New(src)
+	for _, test := range []struct {
+		r, c  int
+		trans bool
+	}{
+		{r: 1, c: 1},
+		{r: 3, c: 5},
+		{r: 5, c: 3, trans: true},
+		{r: 7, c: 7},
+	} {
+		var a Matrix
+		base, err := randDense(max(test.r, test.c), 1, src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if test.trans {
+			a = base.Slice(0, test.c, 0, test.r).T()
+		} else {
+			a = base.Slice(0, test.r, 0, test.c)
+		}
+		rowVec := make([]float64, test.c)
+		for i := range rowVec {
+			rowVec[i] = rnd.NormFloat64()
+		}
+		colVec := make([]float64, test.r)
+		for i := range colVec {
+			colVec[i] = rnd.NormFloat64()
+		}
+		// Use a strided vector for the row vector.
+		strided := NewDense(test.c, 2, nil)
+		strided.SetCol(1, rowVec)
+		rv := strided.ColView(1)
+		cv := NewVecDense(test.r, colVec)
+
+		for _, method := range []struct {
+			name string
+			fn   func(m *Dense, a Matrix)
+			want func(i, j int, v float64) float64
+		}{
+			{
+				name: "AddRowVec",
+				fn:   func(m *Dense, a Matrix) { m.AddRowVec(a, rv) },
+				want: func(i, j int, v float64) float64 { return v + rowVec[j] },
+			},
+			{
+				name: "AddColVec",
+				fn:   func(m *Dense, a Matrix) { m.AddColVec(a, cv) },
+				want: func(i, j int, v float64) float64 { return v + colVec[i] },
+			},
+			{
+				name: "ScaleRows",
+				fn:   func(m *Dense, a Matrix) { m.ScaleRows(cv, a) },
+				want: func(i, j int, v float64) float64 { return colVec[i] * v },
+			},
+			{
+				name: "ScaleCols",
+				fn:   func(m *Dense, a Matrix) { m.ScaleCols(rv, a) },
+				want: func(i, j int, v float64) float64 { return rowVec[j] * v },
+			},
+		} {
+			var want Dense
+			want.Apply(method.want, a)
+
+			var got Dense
+			method.fn(&got, a)
+			if !EqualApprox(&got, &want, 1e-14) {
+				t.Errorf("%s r=%d c=%d trans=%t: unexpected result:\ngot:\n%v\nwant:\n%v",
+					method.name, test.r, test.c, test.trans, Formatted(&got), Formatted(&want))
+			}
+
+			// Check in-place operation.
+			inPlace := DenseCopyOf(a)
+			method.fn(inPlace, inPlace)
+			if !EqualApprox(inPlace, &want, 1e-14) {
+				t.Errorf("%s r=%d c=%d trans=%t: unexpected in-place result", method.name, test.r, test.c, test.trans)
+			}
+		}
+	}
+
+	m := NewDense(3, 4, nil)
+	for _, fn := range []func(){
+		func() { m.AddRowVec(m, NewVecDense(3, nil)) },
+		func() { m.AddColVec(m, NewVecDense(4, nil)) },
+		func() { m.ScaleRows(NewVecDense(4, nil), m) },
+		func() { m.ScaleCols(NewVecDense(3, nil), m) },
+	} {
+		if panicked, message := panics(fn); !panicked || message != ErrShape.Error() {
+			t.Errorf("expected shape panic, got %q", message)
+		}
+	}
+	if panicked, _ := panics(func() { m.AddRowVec(m, m.RowView(0)) }); !panicked {
+		t.Errorf("expected panic for overlapping vector")
+	}
+}
+
 func TestDenseOuter(t *testing.T) {
 	t.Parallel()
 	for i, test := range []struct {
@@ -320,7 +320,7 @@ func (d *DiagDense) Trace() float64 {
 
 // Norm returns the specified norm of the receiver. Valid norms are:
 //
-//	1 or Inf - The maximum diagonal element magnitude
+//	1, Inf or MaxAbsNorm - The maximum diagonal element magnitude
 //	2 - The Frobenius norm, the square root of the sum of the squares of
 //	    the diagonal elements
 //
@@ -333,7 +333,7 @@ func (d *DiagDense) Norm(norm float64) float64 {
 	switch norm {
 	default:
 		panic(ErrNormOrder)
-	case 1, math.Inf(1):
+	case 1, math.Inf(1), MaxAbsNorm:
 		imax := blas64.Iamax(d.mat)
 		return math.Abs(d.at(imax, imax))
 	case 2:
@@ -5,6 +5,8 @@
 package mat
 
 import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
 	"gonum.org/v1/gonum/lapack"
 	"gonum.org/v1/gonum/lapack/lapack64"
 )
@@ -12,15 +14,19 @@ import (
 const (
 	badFact   = "mat: use without successful factorization"
 	noVectors = "mat: eigenvectors not computed"
+
+	partialFact   = "mat: use of partial eigendecomposition"
+	badEigenRange = "mat: invalid eigenvalue range"
 )
 
-// EigenSym is a type for computing all eigenvalues and, optionally,
-// eigenvectors of a symmetric matrix A.
+// EigenSym is a type for computing all or a subset of the eigenvalues and,
+// optionally, eigenvectors of a symmetric matrix A.
 //
 // It is a Symmetric matrix represented by its spectral factorization. Once
 // computed, this representation is useful for extracting eigenvalues and
 // eigenvector, but At is slow.
 type EigenSym struct {
+	n               int
 	vectorsComputed bool
 
 	values  []float64
@@ -35,27 +41,30 @@ func (e *EigenSym) Dims() (r, c int) {
 
 // SymmetricDim implements the Symmetric interface.
 func (e *EigenSym) SymmetricDim() int {
-	return len(e.values)
+	return e.n
 }
 
 // At returns the element at row i, column j of the matrix A.
 //
-// At will panic if the eigenvectors have not been computed.
+// At will panic if the eigenvectors have not been computed or if only a
+// subset of the eigenvalues was computed.
 func (e *EigenSym) At(i, j int) float64 {
 	if !e.vectorsComputed {
 		panic(noVectors)
 	}
-	n, _ := e.Dims()
-	if uint(i) >= uint(n) {
+	if len(e.values) < e.n {
+		panic(partialFact)
+	}
+	if uint(i) >= uint(e.n) {
 		panic(ErrRowAccess)
 	}
-	if uint(j) >= uint(n) {
+	if uint(j) >= uint(e.n) {
 		panic(ErrColAccess)
 	}
 
 	var val float64
-	for k := 0; k < n; k++ {
-		val += e.values[k] * e.vectors.at(i, k) * e.vectors.at(j, k)
+	for k, v := range e.values {
+		val += v * e.vectors.at(i, k) * e.vectors.at(j, k)
 	}
 	return val
 }
@@ -81,25 +90,61 @@ func (e *EigenSym) T() Matrix {
 // Factorize returns whether the factorization succeeded. If it returns false,
 // methods that require a successful factorization will panic.
 func (e *EigenSym) Factorize(a Symmetric, vectors bool) (ok bool) {
+	return e.FactorizeWith(a, vectors, nil)
+}
+
+// FactorizeWith computes the spectral factorization of the symmetric matrix A
+// as Factorize does, taking temporary storage from ws. If ws is not nil, the
+// storage of the previous factorization held by the receiver is reused for
+// the result, so slices and matrices returned by earlier calls to RawValues
+// and RawQ are overwritten. If ws is nil, FactorizeWith is equivalent to
+// Factorize.
+func (e *EigenSym) FactorizeWith(a Symmetric, vectors bool, ws *Workspace) (ok bool) {
+	// Keep the previous decomposition's storage for reuse.
+	prevValues, prevVectors := e.values, e.vectors
+
 	// kill previous decomposition
 	e.vectorsComputed = false
 	e.values = e.values[:]
 
 	n := a.SymmetricDim()
-	sd := NewSymDense(n, nil)
+	e.n = n
+	ws.reset()
+	var (
+		sd *SymDense
+		w  []float64
+	)
+	if ws == nil {
+		sd = NewSymDense(n, nil)
+		w = make([]float64, n)
+	} else {
+		var data []float64
+		if prevVectors != nil {
+			data = prevVectors.mat.Data
+		}
+		sd = &SymDense{
+			mat: blas64.Symmetric{
+				N:      n,
+				Stride: n,
+				Uplo:   blas.Upper,
+				Data:   use(data, n*n),
+			},
+			cap: n,
+		}
+		w = use(prevValues, n)
+	}
 	sd.CopySym(a)
 
 	jobz := lapack.EVNone
 	if vectors {
 		jobz = lapack.EVCompute
 	}
-	w := make([]float64, n)
 	work := []float64{0}
 	lapack64.Syev(jobz, sd.mat, w, work, -1)
 
-	work = getFloat64s(int(work[0]), false)
+	work = ws.float64s(int(work[0]))
 	ok = lapack64.Syev(jobz, sd.mat, w, work, len(work))
-	putFloat64s(work)
+	ws.putFloat64s(work)
 	if !ok {
 		e.vectorsComputed = false
 		e.values = nil
@@ -108,21 +153,91 @@ func (e *EigenSym) Factorize(a Symmetric, vectors bool) (ok bool) {
 	}
 	e.vectorsComputed = vectors
 	e.values = w
-	e.vectors = NewDense(n, n, sd.mat.Data)
+	if ws == nil || prevVectors == nil {
+		e.vectors = NewDense(n, n, sd.mat.Data)
+	} else {
+		prevVectors.mat = blas64.General{Rows: n, Cols: n, Stride: n, Data: sd.mat.Data}
+		prevVectors.capRows, prevVectors.capCols = n, n
+		e.vectors = prevVectors
+	}
+	return true
+}
+
+// FactorizeRange computes the eigenvalues of the symmetric matrix A selected
+// by r and, optionally, the corresponding eigenvectors. Computing only a
+// subset of the spectrum of a large matrix is considerably cheaper than
+// computing the full factorization.
+//
+// If r specifies all eigenvalues, FactorizeRange is equivalent to Factorize.
+// If r was created by EigenValueRange(vl, vu), the eigenvalues in the
+// half-open interval (vl, vu] are computed. If r was created by
+// EigenIndexRange(il, iu), the eigenvalues with indices il through iu
+// inclusive, counted from zero in ascending order, are computed.
+// FactorizeRange will panic if r is not a valid range for A.
+//
+// If vectors is false, the eigenvectors are not computed and later calls to
+// VectorsTo and At will panic. If only a subset of the eigenvalues is
+// computed, At will panic.
+//
+// FactorizeRange returns whether the factorization succeeded. If it returns
+// false, methods that require a successful factorization will panic.
+func (e *EigenSym) FactorizeRange(a Symmetric, r EigenRange, vectors bool) (ok bool) {
+	n := a.SymmetricDim()
+	checkEigenRange(n, r)
+	if r.all() {
+		return e.Factorize(a, vectors)
+	}
+
+	// kill previous decomposition
+	e.n = n
+	e.vectorsComputed = false
+	e.values = nil
+	e.vectors = nil
+
+	sd := NewSymDense(n, nil)
+	sd.CopySym(a)
+
+	jobz := lapack.EVNone
+	ncols := n
+	if r.rng == lapack.EVRangeIndex {
+		ncols = r.iu - r.il + 1
+	}
+	var z blas64.General
+	if vectors {
+		jobz = lapack.EVCompute
+		z = blas64.General{Rows: n, Cols: ncols, Stride: max(1, ncols), Data: make([]float64, n*ncols)}
+	}
+	w := make([]float64, n)
+	work := []float64{0}
+	lapack64.Syevr(jobz, r.rng, sd.mat, r.vl, r.vu, r.il, r.iu, 0, w, z, work, -1)
+
+	work = getFloat64s(int(work[0]), false)
+	m, ok := lapack64.Syevr(jobz, r.rng, sd.mat, r.vl, r.vu, r.il, r.iu, 0, w, z, work, len(work))
+	putFloat64s(work)
+	if !ok {
+		return false
+	}
+	e.vectorsComputed = vectors
+	e.values = w[:m:m]
+	if vectors && m > 0 {
+		q := NewDense(n, m, nil)
+		q.Copy(&Dense{mat: z, capRows: n, capCols: ncols})
+		e.vectors = q
+	}
 	return true
 }
 
 // succFact returns whether the receiver contains a successful factorization.
 func (e *EigenSym) succFact() bool {
-	return len(e.values) != 0
+	return e.values != nil
 }
 
-// Values extracts the eigenvalues of the factorized n×n matrix A in ascending
-// order.
+// Values extracts the m computed eigenvalues of the factorized n×n matrix A in
+// ascending order. If all eigenvalues were computed, m is equal to n.
 //
 // If dst is not nil, the values are stored in-place into dst and returned,
 // otherwise a new slice is allocated first. If dst is not nil, it must have
-// length equal to n.
+// length equal to m.
 //
 // If the receiver does not contain a successful factorization, Values will
 // panic.
@@ -154,13 +269,15 @@ func (e *EigenSym) RawValues() []float64 {
 	return e.values
 }
 
-// VectorsTo stores the orthonormal eigenvectors of the factorized n×n matrix A
-// into the columns of dst.
+// VectorsTo stores the orthonormal eigenvectors corresponding to the m
+// computed eigenvalues of the factorized n×n matrix A into the columns of
+// dst.
 //
-// If dst is empty, VectorsTo will resize dst to be n×n. When dst is non-empty,
-// VectorsTo will panic if dst is not n×n. VectorsTo will also panic if the
-// eigenvectors were not computed during the factorization, or if the receiver
-// does not contain a successful factorization.
+// If dst is empty, VectorsTo will resize dst to be n×m. When dst is non-empty,
+// VectorsTo will panic if dst is not n×m. VectorsTo will also panic if the
+// eigenvectors were not computed during the factorization, if no eigenvalues
+// were found in the requested range, or if the receiver does not contain a
+// successful factorization.
 func (e *EigenSym) VectorsTo(dst *Dense) {
 	if !e.succFact() {
 		panic(badFact)
@@ -168,6 +285,9 @@ func (e *EigenSym) VectorsTo(dst *Dense) {
 	if !e.vectorsComputed {
 		panic(noVectors)
 	}
+	if e.vectors == nil {
+		panic(ErrZeroLength)
+	}
 	r, c := e.vectors.Dims()
 	if dst.IsEmpty() {
 		dst.ReuseAs(r, c)
@@ -180,20 +300,20 @@ func (e *EigenSym) VectorsTo(dst *Dense) {
 	dst.Copy(e.vectors)
 }
 
-// RawQ returns the orthogonal matrix Q from the spectral factorization of the
+// RawQ returns the n×m matrix Q whose columns are the eigenvectors
+// corresponding to the m computed eigenvalues. If all eigenvalues were
+// computed, Q is the orthogonal matrix from the spectral factorization of the
 // original matrix A
 //
 //	A = Q * Λ * Qᵀ
 //
-// The columns of Q contain the eigenvectors of A.
-//
 // If the returned matrix is modified, the factorization is invalid and should
 // not be used.
 //
-// If the receiver does not contain a successful factorization or eigenvectors
-// not computed, RawU will return nil.
+// If the receiver does not contain a successful factorization, eigenvectors
+// were not computed or no eigenvalues were found, RawQ will return nil.
 func (e *EigenSym) RawQ() Matrix {
-	if !e.succFact() || !e.vectorsComputed {
+	if !e.succFact() || !e.vectorsComputed || e.vectors == nil {
 		return nil
 	}
 	return e.vectors
@@ -255,6 +375,15 @@ func (e *Eigen) succFact() bool {
 // Factorize returns whether the decomposition succeeded. If the decomposition
 // failed, methods that require a successful factorization will panic.
 func (e *Eigen) Factorize(a Matrix, kind EigenKind) (ok bool) {
+	return e.FactorizeWith(a, kind, nil)
+}
+
+// FactorizeWith computes the eigenvalues and, optionally, the eigenvectors
+// of the square matrix A as Factorize does, taking temporary storage from
+// ws. If ws is not nil, the storage of the previous factorization held by
+// the receiver is also reused for the result. If ws is nil, FactorizeWith is
+// equivalent to Factorize.
+func (e *Eigen) FactorizeWith(a Matrix, kind EigenKind, ws *Workspace) (ok bool) {
 	// kill previous factorization.
 	e.n = 0
 	e.kind = 0
@@ -263,8 +392,15 @@ func (e *Eigen) Factorize(a Matrix, kind EigenKind) (ok bool) {
 	if r != c {
 		panic(ErrShape)
 	}
+	ws.reset()
 	var sd Dense
-	sd.CloneFrom(a)
+	if ws == nil {
+		sd.CloneFrom(a)
+	} else {
+		sd.mat = ws.general(r, c)
+		sd.capRows, sd.capCols = r, c
+		sd.Copy(a)
+	}
 
 	left := kind&EigenLeft != 0
 	right := kind&EigenRight != 0
@@ -273,24 +409,34 @@ func (e *Eigen) Factorize(a Matrix, kind EigenKind) (ok bool) {
 	jobvl := lapack.LeftEVNone
 	jobvr := lapack.RightEVNone
 	if left {
-		vl = *NewDense(r, r, nil)
+		if ws == nil {
+			vl = *NewDense(r, r, nil)
+		} else {
+			vl.mat = ws.general(r, r)
+			vl.capRows, vl.capCols = r, r
+		}
 		jobvl = lapack.LeftEVCompute
 	}
 	if right {
-		vr = *NewDense(c, c, nil)
+		if ws == nil {
+			vr = *NewDense(c, c, nil)
+		} else {
+			vr.mat = ws.general(c, c)
+			vr.capRows, vr.capCols = c, c
+		}
 		jobvr = lapack.RightEVCompute
 	}
 
-	wr := getFloat64s(c, false)
-	defer putFloat64s(wr)
-	wi := getFloat64s(c, false)
-	defer putFloat64s(wi)
+	wr := ws.float64s(c)
+	defer ws.putFloat64s(wr)
+	wi := ws.float64s(c)
+	defer ws.putFloat64s(wi)
 
 	work := []float64{0}
 	lapack64.Geev(jobvl, jobvr, sd.mat, wr, wi, vl.mat, vr.mat, work, -1)
-	work = getFloat64s(int(work[0]), false)
+	work = ws.float64s(int(work[0]))
 	first := lapack64.Geev(jobvl, jobvr, sd.mat, wr, wi, vl.mat, vr.mat, work, len(work))
-	putFloat64s(work)
+	ws.putFloat64s(work)
 
 	if first != 0 {
 		e.values = nil
@@ -300,31 +446,44 @@ func (e *Eigen) Factorize(a Matrix, kind EigenKind) (ok bool) {
 	e.kind = kind
 
 	// Construct complex eigenvalues from float64 data.
-	values := make([]complex128, r)
+	var values []complex128
+	if ws == nil {
+		values = make([]complex128, r)
+	} else {
+		values = useC(e.values, r)
+	}
 	for i, v := range wr {
 		values[i] = complex(v, wi[i])
 	}
 	e.values = values
 
 	// Construct complex eigenvectors from float64 data.
-	var cvl, cvr CDense
 	if left {
-		cvl = *NewCDense(r, r, nil)
-		e.complexEigenTo(&cvl, &vl)
-		e.lVectors = &cvl
+		e.lVectors = reuseEigenVectors(e.lVectors, r, ws)
+		e.complexEigenTo(e.lVectors, &vl)
 	} else {
 		e.lVectors = nil
 	}
 	if right {
-		cvr = *NewCDense(c, c, nil)
-		e.complexEigenTo(&cvr, &vr)
-		e.rVectors = &cvr
+		e.rVectors = reuseEigenVectors(e.rVectors, c, ws)
+		e.complexEigenTo(e.rVectors, &vr)
 	} else {
 		e.rVectors = nil
 	}
 	return true
 }
 
+// reuseEigenVectors returns an n×n matrix to hold complex eigenvectors,
+// reusing the storage of prev if ws is not nil.
+func reuseEigenVectors(prev *CDense, n int, ws *Workspace) *CDense {
+	if ws == nil || prev == nil {
+		return NewCDense(n, n, nil)
+	}
+	prev.Reset()
+	prev.reuseAsNonZeroed(n, n)
+	return prev
+}
+
 // Kind returns the EigenKind of the decomposition. If no decomposition has been
 // computed, Kind returns -1.
 func (e *Eigen) Kind() EigenKind {
@@ -63,3 +63,33 @@ func ExampleEigen() {
 	// Eigenvalues of A:
 	// [(1+1i) (1-1i)]
 }
+
+func ExampleGeneralizedEigen() {
+	a := mat.NewDense(2, 2, []float64{
+		1, -1,
+		1, 1,
+	})
+	b := mat.NewDense(2, 2, []float64{
+		2, 0,
+		0, 2,
+	})
+	fmt.Printf("A = %v\n\n", mat.Formatted(a, mat.Prefix("    ")))
+	fmt.Printf("B = %v\n\n", mat.Formatted(b, mat.Prefix("    ")))
+
+	var eig mat.GeneralizedEigen
+	ok := eig.Factorize(a, b, mat.EigenNone)
+	if !ok {
+		log.Fatal("Generalized eigendecomposition failed")
+	}
+	fmt.Printf("Generalized eigenvalues of (A, B):\n%.3f\n", eig.Values(nil))
+
+	// Output:
+	// A = ⎡ 1  -1⎤
+	//     ⎣ 1   1⎦
+	//
+	// B = ⎡2  0⎤
+	//     ⎣0  2⎦
+	//
+	// Generalized eigenvalues of (A, B):
+	// [(0.500+0.500i) (0.500-0.500i)]
+}
@@ -0,0 +1,326 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+// GeneralizedEigen is a type for creating and using the generalized eigenvalue
+// decomposition of a pair of dense matrices (A, B).
+type GeneralizedEigen struct {
+	n int // The size of the factorized matrices.
+
+	kind EigenKind
+
+	alpha    []complex128
+	beta     []float64
+	rVectors *CDense
+	lVectors *CDense
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (e *GeneralizedEigen) succFact() bool {
+	return e.n != 0
+}
+
+// Factorize computes the generalized eigenvalues of the pair of square
+// matrices a and b, and optionally the generalized eigenvectors, using the
+// QZ algorithm.
+//
+// A generalized eigenvalue is a scalar λ such that A - λ*B is singular. It is
+// represented as a ratio λ = α/β, where β is zero for infinite eigenvalues
+// that arise when B is singular. A right eigenvalue/eigenvector combination is
+// defined by
+//
+//	A * x_r = λ * B * x_r
+//
+// and a left eigenvalue/eigenvector combination is defined by
+//
+//	x_lᴴ * A = λ * x_lᴴ * B
+//
+// where x_lᴴ is the conjugate transpose of x_l. The eigenvalues are the same
+// for both decompositions.
+//
+// In all cases, Factorize computes the eigenvalues of the pair. kind
+// specifies which of the eigenvectors, if any, to compute. See the EigenKind
+// documentation for more information.
+// Factorize panics if the input matrices are not square or do not have the
+// same size.
+//
+// Factorize returns whether the decomposition succeeded. If the decomposition
+// failed, methods that require a successful factorization will panic.
+func (e *GeneralizedEigen) Factorize(a, b Matrix, kind EigenKind) (ok bool) {
+	// kill previous factorization.
+	e.n = 0
+	e.kind = 0
+	r, c := a.Dims()
+	if r != c {
+		panic(ErrShape)
+	}
+	br, bc := b.Dims()
+	if br != r || bc != c {
+		panic(ErrShape)
+	}
+	// Copy a and b because they are modified during the Lapack call.
+	var sa, sb Dense
+	sa.CloneFrom(a)
+	sb.CloneFrom(b)
+
+	left := kind&EigenLeft != 0
+	right := kind&EigenRight != 0
+
+	var vl, vr Dense
+	jobvl := lapack.LeftEVNone
+	jobvr := lapack.RightEVNone
+	if left {
+		vl = *NewDense(r, r, nil)
+		jobvl = lapack.LeftEVCompute
+	}
+	if right {
+		vr = *NewDense(r, r, nil)
+		jobvr = lapack.RightEVCompute
+	}
+
+	alphar := getFloat64s(r, false)
+	defer putFloat64s(alphar)
+	alphai := getFloat64s(r, false)
+	defer putFloat64s(alphai)
+	beta := make([]float64, r)
+
+	work := []float64{0}
+	lapack64.Ggev(jobvl, jobvr, sa.mat, sb.mat, alphar, alphai, beta, vl.mat, vr.mat, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	ok = lapack64.Ggev(jobvl, jobvr, sa.mat, sb.mat, alphar, alphai, beta, vl.mat, vr.mat, work, len(work))
+	putFloat64s(work)
+
+	if !ok {
+		e.alpha = nil
+		e.beta = nil
+		return false
+	}
+	e.n = r
+	e.kind = kind
+
+	alpha := make([]complex128, r)
+	for i, v := range alphar {
+		alpha[i] = complex(v, alphai[i])
+	}
+	e.alpha = alpha
+	e.beta = beta
+
+	// Construct complex eigenvectors from float64 data.
+	if left {
+		cvl := NewCDense(r, r, nil)
+		e.complexEigenTo(cvl, &vl)
+		e.lVectors = cvl
+	} else {
+		e.lVectors = nil
+	}
+	if right {
+		cvr := NewCDense(r, r, nil)
+		e.complexEigenTo(cvr, &vr)
+		e.rVectors = cvr
+	} else {
+		e.rVectors = nil
+	}
+	return true
+}
+
+// Kind returns the EigenKind of the decomposition. If no decomposition has been
+// computed, Kind returns -1.
+func (e *GeneralizedEigen) Kind() EigenKind {
+	if !e.succFact() {
+		return -1
+	}
+	return e.kind
+}
+
+// Values extracts the generalized eigenvalues α/β of the factorized pair. If
+// dst is non-nil, the values are stored in-place into dst. In this case dst
+// must have length n, otherwise Values will panic. If dst is nil, then a new
+// slice will be allocated of the proper length and filled with the eigenvalues.
+//
+// Eigenvalues with β equal to zero are infinite and are returned as
+// cmplx.Inf(). If both α and β are zero, the pair is singular and the
+// corresponding eigenvalue is returned as cmplx.NaN(). Since the ratio α/β may
+// over- or underflow even when the eigenvalue is well determined, Alphas and
+// Betas should be used when this matters.
+//
+// Values panics if the decomposition was not successful.
+func (e *GeneralizedEigen) Values(dst []complex128) []complex128 {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]complex128, e.n)
+	}
+	if len(dst) != e.n {
+		panic(ErrSliceLengthMismatch)
+	}
+	for i, a := range e.alpha {
+		b := e.beta[i]
+		switch {
+		case b != 0:
+			dst[i] = complex(real(a)/b, imag(a)/b)
+		case a == 0:
+			dst[i] = cmplx.NaN()
+		default:
+			dst[i] = cmplx.Inf()
+		}
+	}
+	return dst
+}
+
+// Alphas extracts the numerators α of the generalized eigenvalues α/β of the
+// factorized pair. If dst is non-nil, the values are stored in-place into dst.
+// In this case dst must have length n, otherwise Alphas will panic. If dst is
+// nil, then a new slice will be allocated of the proper length.
+//
+// Alphas panics if the decomposition was not successful.
+func (e *GeneralizedEigen) Alphas(dst []complex128) []complex128 {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]complex128, e.n)
+	}
+	if len(dst) != e.n {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, e.alpha)
+	return dst
+}
+
+// Betas extracts the denominators β of the generalized eigenvalues α/β of the
+// factorized pair. The values of β are real and non-negative. If dst is
+// non-nil, the values are stored in-place into dst. In this case dst must have
+// length n, otherwise Betas will panic. If dst is nil, then a new slice will be
+// allocated of the proper length.
+//
+// Betas panics if the decomposition was not successful.
+func (e *GeneralizedEigen) Betas(dst []float64) []float64 {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]float64, e.n)
+	}
+	if len(dst) != e.n {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, e.beta)
+	return dst
+}
+
+// complexEigenTo extracts the complex eigenvectors from the real matrix d
+// and stores them into the complex matrix dst, normalizing each eigenvector
+// to have Euclidean norm equal to 1 and largest component real. See
+// Eigen.complexEigenTo for the storage convention of d.
+func (e *GeneralizedEigen) complexEigenTo(dst *CDense, d *Dense) {
+	r, c := d.Dims()
+	cr, cc := dst.Dims()
+	if r != cr {
+		panic("size mismatch")
+	}
+	if c != cc {
+		panic("size mismatch")
+	}
+	for j := 0; j < c; j++ {
+		if imag(e.alpha[j]) == 0 {
+			var norm float64
+			for i := 0; i < r; i++ {
+				norm = math.Hypot(norm, d.at(i, j))
+			}
+			if norm == 0 {
+				norm = 1
+			}
+			for i := 0; i < r; i++ {
+				dst.set(i, j, complex(d.at(i, j)/norm, 0))
+			}
+			continue
+		}
+		// Find the largest component and scale the eigenvector so
+		// that the component is real and the norm is 1.
+		var norm, vmax float64
+		var big complex128
+		for i := 0; i < r; i++ {
+			v := complex(d.at(i, j), d.at(i, j+1))
+			abs := cmplx.Abs(v)
+			norm = math.Hypot(norm, abs)
+			if abs > vmax {
+				vmax = abs
+				big = v
+			}
+		}
+		scale := complex(1, 0)
+		if vmax != 0 {
+			scale = cmplx.Conj(big) / complex(vmax*norm, 0)
+		}
+		for i := 0; i < r; i++ {
+			v := complex(d.at(i, j), d.at(i, j+1)) * scale
+			dst.set(i, j, v)
+			dst.set(i, j+1, cmplx.Conj(v))
+		}
+		j++
+	}
+}
+
+// VectorsTo stores the right eigenvectors of the decomposition into the columns
+// of dst. The computed eigenvectors are normalized to have Euclidean norm equal
+// to 1 and largest component real.
+//
+// If dst is empty, VectorsTo will resize dst to be n×n. When dst is
+// non-empty, VectorsTo will panic if dst is not n×n. VectorsTo will also
+// panic if the eigenvectors were not computed during the factorization,
+// or if the receiver does not contain a successful factorization.
+func (e *GeneralizedEigen) VectorsTo(dst *CDense) {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if e.kind&EigenRight == 0 {
+		panic(noVectors)
+	}
+	if dst.IsEmpty() {
+		dst.ReuseAs(e.n, e.n)
+	} else {
+		r, c := dst.Dims()
+		if r != e.n || c != e.n {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(e.rVectors)
+}
+
+// LeftVectorsTo stores the left eigenvectors of the decomposition into the
+// columns of dst. The computed eigenvectors are normalized to have Euclidean
+// norm equal to 1 and largest component real.
+//
+// If dst is empty, LeftVectorsTo will resize dst to be n×n. When dst is
+// non-empty, LeftVectorsTo will panic if dst is not n×n. LeftVectorsTo will
+// also panic if the left eigenvectors were not computed during the
+// factorization, or if the receiver does not contain a successful
+// factorization.
+func (e *GeneralizedEigen) LeftVectorsTo(dst *CDense) {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if e.kind&EigenLeft == 0 {
+		panic(noVectors)
+	}
+	if dst.IsEmpty() {
+		dst.ReuseAs(e.n, e.n)
+	} else {
+		r, c := dst.Dims()
+		if r != e.n || c != e.n {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(e.lVectors)
+}
@@ -0,0 +1,193 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestGeneralizedEigen(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 17} {
+		for kind := 0; kind < 3; kind++ {
+			a := NewDense(n, n, nil)
+			b := NewDense(n, n, nil)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					a.Set(i, j, rnd.NormFloat64())
+					b.Set(i, j, rnd.NormFloat64())
+				}
+			}
+			switch kind {
+			case 1:
+				// Singular B.
+				for j := 0; j < n; j++ {
+					b.Set(n-1, j, 0)
+				}
+			case 2:
+				// B equal to the identity.
+				b = NewDense(n, n, nil)
+				for i := 0; i < n; i++ {
+					b.Set(i, i, 1)
+				}
+			}
+			aCopy := DenseCopyOf(a)
+			bCopy := DenseCopyOf(b)
+
+			var ge GeneralizedEigen
+			ok := ge.Factorize(a, b, EigenBoth)
+			if !ok {
+				t.Errorf("n=%d kind=%d: unexpected factorization failure", n, kind)
+				continue
+			}
+			if !Equal(a, aCopy) || !Equal(b, bCopy) {
+				t.Errorf("n=%d kind=%d: input matrices modified", n, kind)
+			}
+			if ge.Kind() != EigenBoth {
+				t.Errorf("n=%d kind=%d: unexpected kind: got:%v want:%v", n, kind, ge.Kind(), EigenBoth)
+			}
+
+			alpha := ge.Alphas(nil)
+			beta := ge.Betas(nil)
+			values := ge.Values(nil)
+			var right, left CDense
+			ge.VectorsTo(&right)
+			ge.LeftVectorsTo(&left)
+
+			anorm := Norm(a, math.Inf(1))
+			bnorm := Norm(b, math.Inf(1))
+			var ninf int
+			for j := 0; j < n; j++ {
+				if beta[j] < 0 {
+					t.Errorf("n=%d kind=%d: unexpected negative beta[%d]=%v", n, kind, j, beta[j])
+				}
+				if beta[j] <= tol*bnorm {
+					ninf++
+				} else if want := alpha[j] / complex(beta[j], 0); cmplx.Abs(values[j]-want) > tol*cmplx.Abs(want) {
+					t.Errorf("n=%d kind=%d: unexpected value %d: got:%v want:%v", n, kind, j, values[j], want)
+				}
+
+				// Check β*A*x = α*B*x and β*yᴴ*A = α*yᴴ*B.
+				bt := complex(beta[j], 0)
+				scale := beta[j]*anorm + cmplx.Abs(alpha[j])*bnorm
+				var rres, lres, rnorm, lnorm float64
+				for i := 0; i < n; i++ {
+					var rr, lr complex128
+					for k := 0; k < n; k++ {
+						rr += (bt*complex(a.At(i, k), 0) - alpha[j]*complex(b.At(i, k), 0)) * right.At(k, j)
+						lr += cmplx.Conj(left.At(k, j)) * (bt*complex(a.At(k, i), 0) - alpha[j]*complex(b.At(k, i), 0))
+					}
+					rres = math.Max(rres, cmplx.Abs(rr))
+					lres = math.Max(lres, cmplx.Abs(lr))
+					rnorm = math.Hypot(rnorm, cmplx.Abs(right.At(i, j)))
+					lnorm = math.Hypot(lnorm, cmplx.Abs(left.At(i, j)))
+				}
+				if rres > tol*scale*float64(n) {
+					t.Errorf("n=%d kind=%d: right eigenvector %d residual too large: %v", n, kind, j, rres/scale)
+				}
+				if lres > tol*scale*float64(n) {
+					t.Errorf("n=%d kind=%d: left eigenvector %d residual too large: %v", n, kind, j, lres/scale)
+				}
+				if math.Abs(rnorm-1) > tol || math.Abs(lnorm-1) > tol {
+					t.Errorf("n=%d kind=%d: eigenvector %d not normalized: right=%v left=%v", n, kind, j, rnorm, lnorm)
+				}
+			}
+			if kind == 1 && ninf == 0 {
+				t.Errorf("n=%d kind=%d: no infinite eigenvalue for singular B", n, kind)
+			}
+
+			if kind == 2 {
+				// The generalized eigenvalues of (A,I) are the
+				// eigenvalues of A.
+				var e Eigen
+				if !e.Factorize(a, EigenNone) {
+					t.Fatalf("n=%d: bad test: eigen factorization failed", n)
+				}
+				want := e.Values(nil)
+				for _, v := range values {
+					found := false
+					for _, w := range want {
+						if cmplx.Abs(v-w) <= 1e-10*math.Max(1, cmplx.Abs(w)) {
+							found = true
+							break
+						}
+					}
+					if !found {
+						t.Errorf("n=%d: eigenvalue %v not an eigenvalue of A", n, v)
+					}
+				}
+			}
+
+			// The eigenvalues do not depend on the eigenvectors
+			// computed.
+			for _, k := range []EigenKind{EigenNone, EigenLeft, EigenRight} {
+				var ge2 GeneralizedEigen
+				if !ge2.Factorize(a, b, k) {
+					t.Errorf("n=%d kind=%d: unexpected factorization failure for %v", n, kind, k)
+					continue
+				}
+				got := ge2.Values(nil)
+				for j := range got {
+					if beta[j] > tol*bnorm && cmplx.Abs(got[j]-values[j]) > 1e-10*math.Max(1, cmplx.Abs(values[j])) {
+						t.Errorf("n=%d kind=%d: eigenvalue %d mismatch for %v: got:%v want:%v", n, kind, j, k, got[j], values[j])
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestGeneralizedEigenValues(t *testing.T) {
+	t.Parallel()
+	// The pair has eigenvalues 2, ∞ and an undetermined eigenvalue from
+	// the common null space.
+	a := NewDiagDense(3, []float64{2, 1, 0})
+	b := NewDiagDense(3, []float64{1, 0, 0})
+	var ge GeneralizedEigen
+	if !ge.Factorize(a, b, EigenNone) {
+		t.Fatal("unexpected factorization failure")
+	}
+	values := ge.Values(nil)
+	var finite, inf, nan int
+	for _, v := range values {
+		switch {
+		case cmplx.IsNaN(v):
+			nan++
+		case cmplx.IsInf(v):
+			inf++
+		case v == 2:
+			finite++
+		default:
+			t.Errorf("unexpected eigenvalue: %v", v)
+		}
+	}
+	if finite != 1 || inf != 1 || nan != 1 {
+		t.Errorf("unexpected eigenvalues: got:%v want:[2 +Inf NaN]", values)
+	}
+
+	var empty GeneralizedEigen
+	if empty.Kind() != -1 {
+		t.Errorf("unexpected kind for empty decomposition: got:%v want:-1", empty.Kind())
+	}
+	var dst CDense
+	for _, fn := range []func(){
+		func() { empty.Values(nil) },
+		func() { ge.VectorsTo(&dst) },
+		func() { ge.LeftVectorsTo(&dst) },
+		func() { ge.Values(make([]complex128, 2)) },
+		func() { ge.Factorize(NewDense(2, 3, nil), NewDense(2, 3, nil), EigenNone) },
+		func() { ge.Factorize(NewDense(2, 2, nil), NewDense(3, 3, nil), EigenNone) },
+	} {
+		if ok, _ := panics(fn); !ok {
+			t.Errorf("expected panic")
+		}
+	}
+}
@@ -0,0 +1,605 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+	"sort"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+const badPartialEigen = "mat: invalid partial eigendecomposition"
+
+// EigenTarget specifies which eigenvalues are computed by a partial
+// eigendecomposition.
+type EigenTarget int
+
+const (
+	// EigenLargestMagnitude specifies the eigenvalues with the largest
+	// absolute value.
+	EigenLargestMagnitude EigenTarget = iota
+	// EigenLargestReal specifies the eigenvalues with the largest real
+	// part.
+	EigenLargestReal
+	// EigenSmallestReal specifies the eigenvalues with the smallest real
+	// part.
+	EigenSmallestReal
+)
+
+// before returns whether the eigenvalue a is wanted before b.
+func (t EigenTarget) before(a, b complex128) bool {
+	switch t {
+	case EigenLargestMagnitude:
+		return cmplx.Abs(a) > cmplx.Abs(b)
+	case EigenLargestReal:
+		return real(a) > real(b)
+	case EigenSmallestReal:
+		return real(a) < real(b)
+	default:
+		panic("mat: invalid eigen target")
+	}
+}
+
+// ShiftInvert specifies the shift-invert spectral transformation for a
+// partial eigendecomposition. Eigenvalues λ of A closest to Sigma are the
+// eigenvalues 1/(λ-Sigma) of largest magnitude of (A - Sigma*I)⁻¹, which
+// converge rapidly in a Krylov method.
+type ShiftInvert struct {
+	// Sigma is the shift.
+	Sigma float64
+
+	// Solve stores the solution x of (A - Sigma*I)*x = b into dst,
+	// typically using a factorization of A - Sigma*I computed once.
+	// Solve must not retain dst or b.
+	Solve func(dst *VecDense, b Vector) error
+}
+
+// KrylovSettings holds the settings of the Krylov eigensolvers. The zero
+// value gives the default settings.
+type KrylovSettings struct {
+	// Tolerance is the relative tolerance for the residual of the
+	// computed eigenpairs. If Tolerance is zero, 1e-10 is used.
+	Tolerance float64
+
+	// Dim is the maximum dimension of the Krylov subspace. It must be
+	// greater than the number of requested eigenvalues. If Dim is zero,
+	// max(2k+1, k+20) is used, limited by the size of the operator.
+	Dim int
+
+	// MaxRestarts is the maximum number of restarts. If MaxRestarts is
+	// zero, 300 is used.
+	MaxRestarts int
+}
+
+// PartialEigenSym is a type for computing a few eigenvalues and eigenvectors
+// of a large symmetric linear operator using the Lanczos method with
+// Krylov–Schur restarting. The operator is only accessed through products
+// with vectors.
+type PartialEigenSym struct {
+	values  []float64
+	vectors *Dense
+}
+
+// Factorize computes the k eigenvalues of the symmetric n×n operator a
+// selected by target and the corresponding eigenvectors, and returns whether
+// the computation converged. settings may be nil, in which case the default
+// settings are used.
+//
+// Only MulVecTo with trans false is used, and a must be symmetric.
+// Factorize will panic if a is not square or k is not in [1, n].
+func (e *PartialEigenSym) Factorize(a LinearOperator, k int, target EigenTarget, settings *KrylovSettings) (ok bool) {
+	n, c := a.Dims()
+	if n != c {
+		panic(ErrSquare)
+	}
+	return e.factorize(n, k, target, 0, false, operatorMul(a), settings)
+}
+
+// FactorizeShiftInvert computes the k eigenvalues closest to si.Sigma of a
+// symmetric n×n operator A, and the corresponding eigenvectors, using the
+// shift-invert transformation with the solver in si. It returns whether the
+// computation converged and the solves succeeded.
+//
+// FactorizeShiftInvert will panic if k is not in [1, n].
+func (e *PartialEigenSym) FactorizeShiftInvert(n, k int, si ShiftInvert, settings *KrylovSettings) (ok bool) {
+	mul, failed := shiftInvertMul(n, si)
+	ok = e.factorize(n, k, EigenLargestMagnitude, si.Sigma, true, mul, settings)
+	if *failed {
+		e.values = nil
+		e.vectors = nil
+		return false
+	}
+	return ok
+}
+
+func (e *PartialEigenSym) factorize(n, k int, target EigenTarget, sigma float64, shiftInvert bool, mul func(dst, x []float64), settings *KrylovSettings) bool {
+	e.values = nil
+	e.vectors = nil
+	if k < 1 || n < k {
+		panic(ErrIndexOutOfRange)
+	}
+	res, ok := krylovEigen(n, k, mul, true, target, settings)
+	if res.vals == nil {
+		return false
+	}
+	values := make([]float64, k)
+	vectors := NewDense(n, k, nil)
+	for i := 0; i < k; i++ {
+		values[i] = real(res.vals[i])
+		if shiftInvert {
+			values[i] = sigma + 1/values[i]
+		}
+		for j, q := range res.basis {
+			y := real(res.y.At(j, i))
+			for r, v := range q {
+				vectors.mat.Data[r*vectors.mat.Stride+i] += y * v
+			}
+		}
+	}
+	e.values = values
+	e.vectors = vectors
+	return ok
+}
+
+// Values returns the k computed eigenvalues, ordered by the target with the
+// most wanted first. For shift-invert factorizations, the eigenvalues are
+// ordered by increasing distance from the shift.
+//
+// If dst is not nil, the values are stored in-place into dst and returned,
+// otherwise a new slice is allocated first. If dst is not nil, it must have
+// length k. Values will panic if the receiver does not contain a
+// factorization.
+func (e *PartialEigenSym) Values(dst []float64) []float64 {
+	if e.values == nil {
+		panic(badPartialEigen)
+	}
+	if dst == nil {
+		dst = make([]float64, len(e.values))
+	}
+	if len(dst) != len(e.values) {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, e.values)
+	return dst
+}
+
+// VectorsTo stores the n×k matrix of orthonormal eigenvectors corresponding
+// to the eigenvalues returned by Values into dst.
+//
+// If dst is empty, VectorsTo will resize dst to be n×k. When dst is
+// non-empty, VectorsTo will panic if dst is not n×k. VectorsTo will also
+// panic if the receiver does not contain a factorization.
+func (e *PartialEigenSym) VectorsTo(dst *Dense) {
+	if e.values == nil {
+		panic(badPartialEigen)
+	}
+	r, c := e.vectors.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || c != c2 {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(e.vectors)
+}
+
+// PartialEigen is a type for computing a few eigenvalues and eigenvectors of
+// a large general linear operator using the Arnoldi method with Krylov–Schur
+// restarting, which is mathematically equivalent to the implicitly restarted
+// Arnoldi method. The operator is only accessed through products with
+// vectors.
+type PartialEigen struct {
+	values  []complex128
+	vectors *CDense
+}
+
+// Factorize computes the k eigenvalues of the n×n operator a selected by
+// target and the corresponding right eigenvectors, and returns whether the
+// computation converged. settings may be nil, in which case the default
+// settings are used.
+//
+// Only MulVecTo with trans false is used. Factorize will panic if a is not
+// square or k is not in [1, n].
+func (e *PartialEigen) Factorize(a LinearOperator, k int, target EigenTarget, settings *KrylovSettings) (ok bool) {
+	n, c := a.Dims()
+	if n != c {
+		panic(ErrSquare)
+	}
+	return e.factorize(n, k, target, 0, false, operatorMul(a), settings)
+}
+
+// FactorizeShiftInvert computes the k eigenvalues closest to si.Sigma of an
+// n×n operator A, and the corresponding right eigenvectors, using the
+// shift-invert transformation with the solver in si. It returns whether the
+// computation converged and the solves succeeded.
+//
+// FactorizeShiftInvert will panic if k is not in [1, n].
+func (e *PartialEigen) FactorizeShiftInvert(n, k int, si ShiftInvert, settings *KrylovSettings) (ok bool) {
+	mul, failed := shiftInvertMul(n, si)
+	ok = e.factorize(n, k, EigenLargestMagnitude, si.Sigma, true, mul, settings)
+	if *failed {
+		e.values = nil
+		e.vectors = nil
+		return false
+	}
+	return ok
+}
+
+func (e *PartialEigen) factorize(n, k int, target EigenTarget, sigma float64, shiftInvert bool, mul func(dst, x []float64), settings *KrylovSettings) bool {
+	e.values = nil
+	e.vectors = nil
+	if k < 1 || n < k {
+		panic(ErrIndexOutOfRange)
+	}
+	res, ok := krylovEigen(n, k, mul, false, target, settings)
+	if res.vals == nil {
+		return false
+	}
+	values := make([]complex128, k)
+	vectors := NewCDense(n, k, nil)
+	x := make([]complex128, n)
+	for i := 0; i < k; i++ {
+		values[i] = res.vals[i]
+		if shiftInvert {
+			values[i] = complex(sigma, 0) + 1/values[i]
+		}
+		for r := range x {
+			x[r] = 0
+		}
+		for j, q := range res.basis {
+			y := res.y.At(j, i)
+			for r, v := range q {
+				x[r] += y * complex(v, 0)
+			}
+		}
+		// Normalize to unit norm with the largest
+		// element real, as Eigen does.
+		var norm float64
+		big := complex(1, 0)
+		for _, v := range x {
+			norm = math.Hypot(norm, cmplx.Abs(v))
+			if cmplx.Abs(v) > cmplx.Abs(big) || big == 1 {
+				big = v
+			}
+		}
+		scale := cmplx.Conj(big) / complex(cmplx.Abs(big)*norm, 0)
+		for r, v := range x {
+			vectors.Set(r, i, v*scale)
+		}
+	}
+	e.values = values
+	e.vectors = vectors
+	return ok
+}
+
+// Values returns the k computed eigenvalues, ordered by the target with the
+// most wanted first. For shift-invert factorizations, the eigenvalues are
+// ordered by increasing distance from the shift.
+//
+// If dst is not nil, the values are stored in-place into dst and returned,
+// otherwise a new slice is allocated first. If dst is not nil, it must have
+// length k. Values will panic if the receiver does not contain a
+// factorization.
+func (e *PartialEigen) Values(dst []complex128) []complex128 {
+	if e.values == nil {
+		panic(badPartialEigen)
+	}
+	if dst == nil {
+		dst = make([]complex128, len(e.values))
+	}
+	if len(dst) != len(e.values) {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, e.values)
+	return dst
+}
+
+// VectorsTo stores the n×k matrix of unit-norm right eigenvectors
+// corresponding to the eigenvalues returned by Values into dst.
+//
+// If dst is empty, VectorsTo will resize dst to be n×k. When dst is
+// non-empty, VectorsTo will panic if dst is not n×k. VectorsTo will also
+// panic if the receiver does not contain a factorization.
+func (e *PartialEigen) VectorsTo(dst *CDense) {
+	if e.values == nil {
+		panic(badPartialEigen)
+	}
+	r, c := e.vectors.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || c != c2 {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(e.vectors)
+}
+
+// operatorMul returns a function computing the product of a with a vector.
+func operatorMul(a LinearOperator) func(dst, x []float64) {
+	return func(dst, x []float64) {
+		a.MulVecTo(NewVecDense(len(dst), dst), false, NewVecDense(len(x), x))
+	}
+}
+
+// shiftInvertMul returns a function applying the shift-invert operator in si,
+// and a flag that is set if any of the solves fails.
+func shiftInvertMul(n int, si ShiftInvert) (mul func(dst, x []float64), failed *bool) {
+	if si.Solve == nil {
+		panic("mat: nil shift-invert solver")
+	}
+	failed = new(bool)
+	return func(dst, x []float64) {
+		if *failed {
+			zero(dst)
+			return
+		}
+		if err := si.Solve(NewVecDense(n, dst), NewVecDense(n, x)); err != nil {
+			if _, ok := err.(Condition); !ok {
+				*failed = true
+				zero(dst)
+			}
+		}
+	}, failed
+}
+
+// krylovResult holds the wanted Ritz values of a Krylov eigensolver in order
+// and their coefficients in the orthonormal basis.
+type krylovResult struct {
+	vals  []complex128
+	y     *CDense
+	basis [][]float64
+}
+
+// krylovEigen computes the k eigenvalues of the n×n operator mul selected by
+// target using the Arnoldi method with Krylov–Schur restarting, as described
+// in
+//
+//	Stewart, G. W. "A Krylov–Schur algorithm for large eigenproblems."
+//	SIAM Journal on Matrix Analysis and Applications 23.3 (2002)
+//
+// If sym is true, the operator is assumed to be symmetric and the projected
+// matrix is symmetrized, giving the Lanczos method with full
+// reorthogonalization.
+func krylovEigen(n, k int, mul func(dst, x []float64), sym bool, target EigenTarget, settings *KrylovSettings) (res krylovResult, ok bool) {
+	var s KrylovSettings
+	if settings != nil {
+		s = *settings
+	}
+	if s.Tolerance == 0 {
+		s.Tolerance = 1e-10
+	}
+	if s.Dim == 0 {
+		s.Dim = max(2*k+1, k+20)
+	}
+	if s.MaxRestarts == 0 {
+		s.MaxRestarts = 300
+	}
+	m := min(n, s.Dim)
+	if m <= k && m < n {
+		panic("mat: Krylov subspace dimension too small")
+	}
+
+	// A deterministic source is used so that
+	// factorizations are reproducible.
+	rnd := rand.New(rand.NewSource(1))
+
+	// The Krylov decomposition
+	//  A * V_m = V_m * H_m + v_m * h_mᵀ
+	// is held in basis, which has m+1 orthonormal vectors, and h,
+	// whose first m rows hold H_m and whose last row holds h_m.
+	basis := [][]float64{randomOrthogonal(n, nil, rnd)}
+	h := NewDense(m+1, m, nil)
+	var p int
+	eps23 := math.Pow(dlamchE, 2.0/3)
+	for restart := 0; ; restart++ {
+		// Expand the decomposition to dimension m by Arnoldi
+		// steps with full reorthogonalization.
+		for j := p; j < m; j++ {
+			w := make([]float64, n)
+			mul(w, basis[j])
+			for pass := 0; pass < 2; pass++ {
+				for i, q := range basis {
+					c := floats.Dot(w, q)
+					floats.AddScaled(w, -c, q)
+					h.set(i, j, h.at(i, j)+c)
+				}
+			}
+			beta := floats.Norm(w, 2)
+			if beta <= math.Sqrt(float64(j+1))*dlamchE*Norm(h.slice(0, j+1, 0, j+1), 1) || len(basis) == n {
+				// The subspace is invariant. Continue with a
+				// random vector so that the decomposition
+				// remains valid with a zero coupling.
+				beta = 0
+				if len(basis) < n {
+					w = randomOrthogonal(n, basis, rnd)
+				}
+			} else {
+				floats.Scale(1/beta, w)
+			}
+			h.set(j+1, j, beta)
+			basis = append(basis, w)
+		}
+
+		// Compute the Ritz pairs of the projected matrix.
+		hm := h.slice(0, m, 0, m)
+		vals, y, ok := projectedEigen(hm, sym)
+		if !ok {
+			return krylovResult{}, false
+		}
+		order := make([]int, m)
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(i, j int) bool {
+			return target.before(vals[order[i]], vals[order[j]])
+		})
+
+		// Check the residuals |h_mᵀ * y| of the wanted Ritz pairs.
+		var hnorm float64
+		for _, v := range vals {
+			hnorm = math.Max(hnorm, cmplx.Abs(v))
+		}
+		converged := true
+		for _, i := range order[:k] {
+			var r complex128
+			for l := 0; l < m; l++ {
+				r += complex(h.at(m, l), 0) * y.At(l, i)
+			}
+			if cmplx.Abs(r) > math.Max(eps23*hnorm, s.Tolerance*cmplx.Abs(vals[i])) {
+				converged = false
+				break
+			}
+		}
+		if converged || restart == s.MaxRestarts || m == n {
+			res.vals = make([]complex128, k)
+			res.y = NewCDense(m, k, nil)
+			for c, i := range order[:k] {
+				res.vals[c] = vals[i]
+				for l := 0; l < m; l++ {
+					res.y.Set(l, c, y.At(l, i))
+				}
+			}
+			res.basis = basis[:m]
+			return res, converged || m == n
+		}
+
+		// Restart with the orthonormal basis Q of the invariant
+		// subspace of H_m spanned by the wanted Ritz vectors,
+		// giving the smaller decomposition
+		//  A * (V_m*Q) = (V_m*Q) * (Qᵀ*H_m*Q) + v_m * (Qᵀ*h_m)ᵀ
+		keep := k + (m-k)/2
+		q := restartBasis(vals, y, order, keep, sym)
+		p = q.mat.Cols
+		var s, tmp Dense
+		tmp.Mul(hm, q)
+		s.Mul(q.T(), &tmp)
+		var b VecDense
+		b.MulVec(q.T(), h.RowView(m))
+
+		next := make([][]float64, p+1)
+		for i := 0; i < p; i++ {
+			v := make([]float64, n)
+			for l := 0; l < m; l++ {
+				floats.AddScaled(v, q.at(l, i), basis[l])
+			}
+			next[i] = v
+		}
+		next[p] = basis[m]
+		basis = next
+		h.Zero()
+		h.slice(0, p, 0, p).Copy(&s)
+		for i := 0; i < p; i++ {
+			h.set(p, i, b.AtVec(i))
+		}
+	}
+}
+
+// projectedEigen returns the eigenvalues and unit-norm eigenvectors of the
+// small projected matrix h.
+func projectedEigen(h *Dense, sym bool) (vals []complex128, y *CDense, ok bool) {
+	m, _ := h.Dims()
+	if sym {
+		hs := NewSymDense(m, nil)
+		for i := 0; i < m; i++ {
+			for j := i; j < m; j++ {
+				hs.SetSym(i, j, (h.at(i, j)+h.at(j, i))/2)
+			}
+		}
+		var es EigenSym
+		if !es.Factorize(hs, true) {
+			return nil, nil, false
+		}
+		var z Dense
+		es.VectorsTo(&z)
+		vals = make([]complex128, m)
+		y = NewCDense(m, m, nil)
+		for i, v := range es.values {
+			vals[i] = complex(v, 0)
+			for j := 0; j < m; j++ {
+				y.Set(j, i, complex(z.at(j, i), 0))
+			}
+		}
+		return vals, y, true
+	}
+	var eig Eigen
+	if !eig.Factorize(h, EigenRight) {
+		return nil, nil, false
+	}
+	y = NewCDense(m, m, nil)
+	eig.VectorsTo(y)
+	return eig.Values(nil), y, true
+}
+
+// restartBasis returns an m×p matrix with orthonormal columns spanning the
+// real invariant subspace of the projected matrix associated with the first
+// keep Ritz values in order. Complex conjugate pairs are kept together.
+func restartBasis(vals []complex128, y *CDense, order []int, keep int, sym bool) *Dense {
+	m := len(vals)
+	if sym {
+		q := NewDense(m, keep, nil)
+		for c, i := range order[:keep] {
+			for l := 0; l < m; l++ {
+				q.set(l, c, real(y.At(l, i)))
+			}
+		}
+		return q
+	}
+
+	var cols [][]float64
+	used := make([]bool, m)
+	for _, i := range order {
+		if len(cols) >= keep {
+			break
+		}
+		if used[i] {
+			continue
+		}
+		used[i] = true
+		re := make([]float64, m)
+		im := make([]float64, m)
+		for l := 0; l < m; l++ {
+			v := y.At(l, i)
+			re[l] = real(v)
+			im[l] = imag(v)
+		}
+		cols = append(cols, re)
+		if imag(vals[i]) != 0 {
+			// Mark the conjugate as used and keep the
+			// imaginary part of the pair.
+			for _, j := range order {
+				if !used[j] && vals[j] == cmplx.Conj(vals[i]) {
+					used[j] = true
+					break
+				}
+			}
+			cols = append(cols, im)
+		}
+	}
+	p := len(cols)
+	if p >= m {
+		// A pair at the boundary filled the subspace.
+		cols = cols[:m-1]
+		p = m - 1
+	}
+	z := NewDense(m, p, nil)
+	for c, col := range cols {
+		for l, v := range col {
+			z.set(l, c, v)
+		}
+	}
+	var qr QR
+	qr.Factorize(z)
+	var q Dense
+	qr.QTo(&q)
+	return DenseCopyOf(q.Slice(0, m, 0, p))
+}
@@ -0,0 +1,330 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"math/cmplx"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+// denseOperator is a LinearOperator backed by a Dense.
+type denseOperator struct {
+	*Dense
+}
+
+func (a denseOperator) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	if trans {
+		dst.MulVec(a.Dense.T(), x)
+		return
+	}
+	dst.MulVec(a.Dense, x)
+}
+
+func TestPartialEigenSym(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		n, k   int
+		target EigenTarget
+	}{
+		{n: 1, k: 1, target: EigenLargestMagnitude},
+		{n: 5, k: 2, target: EigenLargestReal},
+		{n: 10, k: 10, target: EigenSmallestReal},
+		{n: 50, k: 3, target: EigenLargestMagnitude},
+		{n: 100, k: 5, target: EigenLargestReal},
+		{n: 100, k: 4, target: EigenSmallestReal},
+		{n: 200, k: 6, target: EigenLargestMagnitude},
+	} {
+		n, k := test.n, test.k
+		a := NewSymBandDense(n, min(2, n-1), nil)
+		for i := 0; i < n; i++ {
+			for j := i; j < min(n, i+a.mat.K+1); j++ {
+				a.SetSymBand(i, j, rnd.NormFloat64())
+			}
+		}
+		want := wantSymEigen(t, a, k, test.target)
+
+		var e PartialEigenSym
+		if !e.Factorize(a, k, test.target, nil) {
+			t.Errorf("n=%d k=%d target=%d: factorization failed", n, k, test.target)
+			continue
+		}
+		checkPartialEigenSym(t, &e, a, want, n, k, 1e-8)
+	}
+}
+
+func TestPartialEigenSymShiftInvert(t *testing.T) {
+	t.Parallel()
+	const n = 80
+	// The 1D Laplacian has eigenvalues 2-2cos(jπ/(n+1)), which cluster
+	// at the ends of the spectrum.
+	a := NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		a.SetSym(i, i, 2)
+		if i > 0 {
+			a.SetSym(i, i-1, -1)
+		}
+	}
+	for _, test := range []struct {
+		sigma float64
+		k     int
+	}{
+		{sigma: 0, k: 3},
+		{sigma: 1.3, k: 4},
+		{sigma: 3.99, k: 2},
+	} {
+		shifted := NewSymDense(n, nil)
+		shifted.CopySym(a)
+		for i := 0; i < n; i++ {
+			shifted.SetSym(i, i, a.At(i, i)-test.sigma)
+		}
+		var lu LU
+		lu.Factorize(shifted)
+		si := ShiftInvert{
+			Sigma: test.sigma,
+			Solve: func(dst *VecDense, b Vector) error {
+				return lu.SolveVecTo(dst, false, b)
+			},
+		}
+
+		want := make([]float64, n)
+		for j := range want {
+			want[j] = 2 - 2*math.Cos(float64(j+1)*math.Pi/(n+1))
+		}
+		sort.Slice(want, func(i, j int) bool {
+			return math.Abs(want[i]-test.sigma) < math.Abs(want[j]-test.sigma)
+		})
+
+		var e PartialEigenSym
+		if !e.FactorizeShiftInvert(n, test.k, si, nil) {
+			t.Errorf("sigma=%v: factorization failed", test.sigma)
+			continue
+		}
+		checkPartialEigenSym(t, &e, a, want[:test.k], n, test.k, 1e-8)
+	}
+
+	var e PartialEigenSym
+	failing := ShiftInvert{Solve: func(dst *VecDense, b Vector) error { return ErrSingular }}
+	if e.FactorizeShiftInvert(n, 2, failing, nil) {
+		t.Errorf("unexpected success with failing solver")
+	}
+	if panicked, _ := panics(func() { e.Values(nil) }); !panicked {
+		t.Errorf("expected panic for failed factorization")
+	}
+}
+
+func wantSymEigen(t *testing.T, a Symmetric, k int, target EigenTarget) []float64 {
+	var es EigenSym
+	if !es.Factorize(a, false) {
+		t.Fatal("unexpected EigenSym failure")
+	}
+	vals := es.Values(nil)
+	sort.Slice(vals, func(i, j int) bool {
+		return target.before(complex(vals[i], 0), complex(vals[j], 0))
+	})
+	return vals[:k]
+}
+
+func checkPartialEigenSym(t *testing.T, e *PartialEigenSym, a Matrix, want []float64, n, k int, tol float64) {
+	t.Helper()
+	got := e.Values(nil)
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > tol*math.Max(1, math.Abs(want[i])) {
+			t.Errorf("n=%d k=%d: unexpected eigenvalue %d: got %v, want %v", n, k, i, got[i], want[i])
+		}
+	}
+	var vecs Dense
+	e.VectorsTo(&vecs)
+	if r, c := vecs.Dims(); r != n || c != k {
+		t.Fatalf("n=%d k=%d: unexpected vectors shape %d×%d", n, k, r, c)
+	}
+	var vtv Dense
+	vtv.Mul(vecs.T(), &vecs)
+	if !EqualApprox(&vtv, eye(k), 1e-8) {
+		t.Errorf("n=%d k=%d: eigenvectors not orthonormal", n, k)
+	}
+	var av, lv Dense
+	av.Mul(a, &vecs)
+	lv.Mul(&vecs, NewDiagDense(k, got))
+	if !EqualApprox(&av, &lv, 1e-7) {
+		t.Errorf("n=%d k=%d: A*V != V*Λ", n, k)
+	}
+}
+
+func TestPartialEigen(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		n, k   int
+		target EigenTarget
+	}{
+		{n: 1, k: 1, target: EigenLargestMagnitude},
+		{n: 6, k: 3, target: EigenLargestReal},
+		{n: 8, k: 8, target: EigenLargestMagnitude},
+		{n: 60, k: 4, target: EigenLargestMagnitude},
+		{n: 100, k: 3, target: EigenLargestReal},
+		{n: 100, k: 5, target: EigenSmallestReal},
+	} {
+		n, k := test.n, test.k
+		// A sparse matrix with a dominant diagonal so that the
+		// wanted eigenvalues are reasonably separated.
+		var ri, ci []int
+		var v []float64
+		for i := 0; i < n; i++ {
+			ri = append(ri, i)
+			ci = append(ci, i)
+			v = append(v, float64(i)+rnd.Float64())
+			for l := 0; l < 3; l++ {
+				ri = append(ri, i)
+				ci = append(ci, rnd.Intn(n))
+				v = append(v, rnd.NormFloat64())
+			}
+		}
+		a := NewCSRFromTriplets(n, n, ri, ci, v)
+		dense := DenseCopyOf(a)
+
+		var eig Eigen
+		if !eig.Factorize(dense, EigenRight) {
+			t.Fatal("unexpected Eigen failure")
+		}
+		all := eig.Values(nil)
+		sort.SliceStable(all, func(i, j int) bool { return test.target.before(all[i], all[j]) })
+
+		var e PartialEigen
+		if !e.Factorize(a, k, test.target, nil) {
+			t.Errorf("n=%d k=%d target=%d: factorization failed", n, k, test.target)
+			continue
+		}
+		checkPartialEigen(t, &e, dense, all, n, k, 1e-7)
+	}
+}
+
+func TestPartialEigenComplex(t *testing.T) {
+	t.Parallel()
+	// A rotation block scaled to dominate gives a complex conjugate
+	// pair of largest magnitude.
+	const n = 40
+	a := NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		a.Set(i, i, 1+float64(i)/n)
+		if i+1 < n {
+			a.Set(i, i+1, 0.1)
+		}
+	}
+	a.Set(0, 0, 3)
+	a.Set(0, 1, -4)
+	a.Set(1, 0, 4)
+	a.Set(1, 1, 3)
+	var e PartialEigen
+	if !e.Factorize(denseOperator{a}, 2, EigenLargestMagnitude, nil) {
+		t.Fatal("factorization failed")
+	}
+	got := e.Values(nil)
+	if cmplx.Abs(got[0]-cmplx.Conj(got[1])) > 1e-10 || math.Abs(cmplx.Abs(got[0])-5) > 1e-8 || imag(got[0]) == 0 {
+		t.Errorf("unexpected eigenvalues: got %v, want 3±4i", got)
+	}
+
+	var eig Eigen
+	eig.Factorize(a, EigenRight)
+	all := eig.Values(nil)
+	sort.SliceStable(all, func(i, j int) bool { return EigenLargestMagnitude.before(all[i], all[j]) })
+	checkPartialEigen(t, &e, a, all, n, 2, 1e-8)
+}
+
+func TestPartialEigenShiftInvert(t *testing.T) {
+	t.Parallel()
+	const n = 50
+	rnd := rand.New(rand.NewSource(1))
+	a := NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		a.Set(i, i, float64(i))
+		for l := 0; l < 2; l++ {
+			a.Set(i, rnd.Intn(n), 0.1*rnd.NormFloat64())
+		}
+	}
+	const sigma = 20.3
+	shifted := DenseCopyOf(a)
+	for i := 0; i < n; i++ {
+		shifted.Set(i, i, a.At(i, i)-sigma)
+	}
+	var lu LU
+	lu.Factorize(shifted)
+	si := ShiftInvert{
+		Sigma: sigma,
+		Solve: func(dst *VecDense, b Vector) error {
+			return lu.SolveVecTo(dst, false, b)
+		},
+	}
+
+	var eig Eigen
+	eig.Factorize(a, EigenRight)
+	all := eig.Values(nil)
+	sort.SliceStable(all, func(i, j int) bool {
+		return cmplx.Abs(all[i]-sigma) < cmplx.Abs(all[j]-sigma)
+	})
+
+	var e PartialEigen
+	if !e.FactorizeShiftInvert(n, 3, si, nil) {
+		t.Fatal("factorization failed")
+	}
+	checkPartialEigen(t, &e, a, all, n, 3, 1e-8)
+}
+
+func checkPartialEigen(t *testing.T, e *PartialEigen, a *Dense, want []complex128, n, k int, tol float64) {
+	t.Helper()
+	got := e.Values(nil)
+	for i := 0; i < k; i++ {
+		// Members of a conjugate pair may be returned in
+		// either order.
+		if cmplx.Abs(got[i]-want[i]) > tol*math.Max(1, cmplx.Abs(want[i])) &&
+			cmplx.Abs(got[i]-cmplx.Conj(want[i])) > tol*math.Max(1, cmplx.Abs(want[i])) {
+			t.Errorf("n=%d k=%d: unexpected eigenvalue %d: got %v, want %v", n, k, i, got[i], want[i])
+		}
+	}
+	var vecs CDense
+	e.VectorsTo(&vecs)
+	if r, c := vecs.Dims(); r != n || c != k {
+		t.Fatalf("n=%d k=%d: unexpected vectors shape %d×%d", n, k, r, c)
+	}
+	for j := 0; j < k; j++ {
+		var norm, res float64
+		for i := 0; i < n; i++ {
+			var av complex128
+			for l := 0; l < n; l++ {
+				av += complex(a.At(i, l), 0) * vecs.At(l, j)
+			}
+			res = math.Hypot(res, cmplx.Abs(av-got[j]*vecs.At(i, j)))
+			norm = math.Hypot(norm, cmplx.Abs(vecs.At(i, j)))
+		}
+		if math.Abs(norm-1) > 1e-10 {
+			t.Errorf("n=%d k=%d: eigenvector %d not unit norm: %v", n, k, j, norm)
+		}
+		if res > 1e-7*math.Max(1, cmplx.Abs(got[j])) {
+			t.Errorf("n=%d k=%d: large residual for eigenpair %d: %v", n, k, j, res)
+		}
+	}
+}
+
+func TestPartialEigenPanics(t *testing.T) {
+	t.Parallel()
+	a := NewSymBandDense(4, 1, nil)
+	var e PartialEigenSym
+	for _, k := range []int{0, 5} {
+		if panicked, _ := panics(func() { e.Factorize(a, k, EigenLargestMagnitude, nil) }); !panicked {
+			t.Errorf("expected panic for k=%d", k)
+		}
+	}
+	if panicked, _ := panics(func() { e.Values(nil) }); !panicked {
+		t.Errorf("expected panic for empty factorization")
+	}
+	var g PartialEigen
+	if panicked, _ := panics(func() { g.Factorize(denseOperator{NewDense(3, 4, nil)}, 1, EigenLargestMagnitude, nil) }); !panicked {
+		t.Errorf("expected panic for non-square operator")
+	}
+}
@@ -0,0 +1,360 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+// EigenRange specifies the subset of eigenvalues of a symmetric matrix to
+// compute. The zero value specifies all eigenvalues.
+type EigenRange struct {
+	rng    lapack.EVRange
+	vl, vu float64
+	il, iu int
+}
+
+// EigenValueRange returns an EigenRange selecting the eigenvalues in the
+// half-open interval (vl, vu]. EigenValueRange will panic if vl >= vu.
+func EigenValueRange(vl, vu float64) EigenRange {
+	if !(vl < vu) {
+		panic(badEigenRange)
+	}
+	return EigenRange{rng: lapack.EVRangeValue, vl: vl, vu: vu}
+}
+
+// EigenIndexRange returns an EigenRange selecting the eigenvalues with
+// zero-based indices il through iu inclusive when the eigenvalues are sorted
+// in ascending order. EigenIndexRange will panic if il < 0 or iu < il.
+func EigenIndexRange(il, iu int) EigenRange {
+	if il < 0 || iu < il {
+		panic(ErrIndexOutOfRange)
+	}
+	return EigenRange{rng: lapack.EVRangeIndex, il: il, iu: iu}
+}
+
+// all returns whether r selects all eigenvalues.
+func (r EigenRange) all() bool {
+	return r.rng == 0 || r.rng == lapack.EVRangeAll
+}
+
+// EigenSymBand is a type for computing all or a subset of the eigenvalues and,
+// optionally, eigenvectors of a symmetric band or symmetric tridiagonal matrix
+// A.
+//
+// The band matrix is reduced to tridiagonal form without forming a dense
+// copy of A, so computing the eigenvalues takes O(n²k) time and O(nk)
+// memory for an n×n matrix with bandwidth k. If the eigenvectors are
+// computed, the orthogonal transformation of the reduction is accumulated
+// into an n×n matrix. Subsets of the eigenvalues are computed by bisection
+// and the corresponding eigenvectors by inverse iteration.
+//
+// It is a Symmetric matrix represented by its spectral factorization. Once
+// computed, this representation is useful for extracting eigenvalues and
+// eigenvector, but At is slow.
+type EigenSymBand struct {
+	n               int
+	vectorsComputed bool
+
+	values  []float64
+	vectors *Dense
+}
+
+// Dims returns the dimensions of the matrix.
+func (e *EigenSymBand) Dims() (r, c int) {
+	return e.n, e.n
+}
+
+// SymmetricDim implements the Symmetric interface.
+func (e *EigenSymBand) SymmetricDim() int {
+	return e.n
+}
+
+// At returns the element at row i, column j of the matrix A.
+//
+// At will panic if the eigenvectors have not been computed or if only a
+// subset of the eigenvalues was computed.
+func (e *EigenSymBand) At(i, j int) float64 {
+	if !e.vectorsComputed {
+		panic(noVectors)
+	}
+	if len(e.values) < e.n {
+		panic(partialFact)
+	}
+	if uint(i) >= uint(e.n) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(e.n) {
+		panic(ErrColAccess)
+	}
+
+	var val float64
+	for k, v := range e.values {
+		val += v * e.vectors.at(i, k) * e.vectors.at(j, k)
+	}
+	return val
+}
+
+// T returns the receiver, the transpose of a symmetric matrix.
+func (e *EigenSymBand) T() Matrix {
+	return e
+}
+
+// Factorize computes the spectral factorization (eigendecomposition) of the
+// symmetric band matrix A.
+//
+// The spectral factorization of A can be written as
+//
+//	A = Q * Λ * Qᵀ
+//
+// where Λ is a diagonal matrix whose entries are the eigenvalues, and Q is an
+// orthogonal matrix whose columns are the eigenvectors.
+//
+// If vectors is false, the eigenvectors are not computed and later calls to
+// VectorsTo and At will panic.
+//
+// Factorize returns whether the factorization succeeded. If it returns false,
+// methods that require a successful factorization will panic.
+func (e *EigenSymBand) Factorize(a SymBanded, vectors bool) (ok bool) {
+	return e.FactorizeRange(a, EigenRange{}, vectors)
+}
+
+// FactorizeRange computes the eigenvalues of the symmetric band matrix A
+// selected by r and, if vectors is true, the corresponding eigenvectors.
+//
+// If r selects a subset of the eigenvalues, only m ≤ n eigenvalues and
+// eigenvectors are computed and At will panic. FactorizeRange will panic if r
+// is an index range and its upper index is not less than n.
+//
+// FactorizeRange returns whether the factorization succeeded. If it returns
+// false, methods that require a successful factorization will panic.
+func (e *EigenSymBand) FactorizeRange(a SymBanded, r EigenRange, vectors bool) (ok bool) {
+	n, k := a.SymBand()
+	checkEigenRange(n, r)
+	ab := blas64.SymmetricBand{
+		Uplo:   blas.Upper,
+		N:      n,
+		K:      k,
+		Stride: k + 1,
+		Data:   make([]float64, n*(k+1)),
+	}
+	if rb, isRaw := a.(RawSymBander); isRaw && rb.RawSymBand().Uplo == blas.Upper {
+		src := rb.RawSymBand()
+		for i := 0; i < n; i++ {
+			copy(ab.Data[i*ab.Stride:i*ab.Stride+min(k+1, n-i)], src.Data[i*src.Stride:])
+		}
+	} else {
+		for i := 0; i < n; i++ {
+			for j := i; j < min(n, i+k+1); j++ {
+				ab.Data[i*ab.Stride+j-i] = a.At(i, j)
+			}
+		}
+	}
+
+	vect := lapack.OrthoNone
+	var q blas64.General
+	if vectors {
+		vect = lapack.OrthoExplicit
+		q = blas64.General{Rows: n, Cols: n, Stride: n, Data: make([]float64, n*n)}
+	}
+	d := make([]float64, n)
+	sub := make([]float64, max(0, n-1))
+	work := getFloat64s(max(1, 2*n-2), false)
+	lapack64.Sbtrd(vect, ab, d, sub, q, work)
+	putFloat64s(work)
+	return e.factorizeTridiag(d, sub, q, r, vectors)
+}
+
+// FactorizeTridiag computes the eigenvalues of the symmetric tridiagonal
+// matrix A selected by r and, if vectors is true, the corresponding
+// eigenvectors. A is not modified.
+//
+// If r selects a subset of the eigenvalues, only m ≤ n eigenvalues and
+// eigenvectors are computed and At will panic. FactorizeTridiag will panic if
+// A is not symmetric or if r is an index range and its upper index is not
+// less than n.
+//
+// FactorizeTridiag returns whether the factorization succeeded. If it returns
+// false, methods that require a successful factorization will panic.
+func (e *EigenSymBand) FactorizeTridiag(a *Tridiag, r EigenRange, vectors bool) (ok bool) {
+	n, _ := a.Dims()
+	checkEigenRange(n, r)
+	for i, v := range a.mat.DL {
+		if v != a.mat.DU[i] {
+			panic(ErrNotSymmetric)
+		}
+	}
+	d := make([]float64, n)
+	copy(d, a.mat.D)
+	sub := make([]float64, max(0, n-1))
+	copy(sub, a.mat.DU)
+	return e.factorizeTridiag(d, sub, blas64.General{}, r, vectors)
+}
+
+// checkEigenRange panics if r is not a valid range for an n×n matrix.
+func checkEigenRange(n int, r EigenRange) {
+	if r.rng == lapack.EVRangeIndex && r.iu >= n {
+		panic(ErrIndexOutOfRange)
+	}
+}
+
+// factorizeTridiag computes the eigenvalues selected by r of the symmetric
+// tridiagonal matrix with diagonal d and off-diagonal sub, and optionally
+// the eigenvectors. If q is not empty, it contains the orthogonal matrix that
+// reduced the original matrix to tridiagonal form and the eigenvectors of
+// the original matrix are computed. d and sub may be overwritten.
+func (e *EigenSymBand) factorizeTridiag(d, sub []float64, q blas64.General, r EigenRange, vectors bool) (ok bool) {
+	n := len(d)
+
+	// Kill the previous decomposition.
+	e.n = 0
+	e.vectorsComputed = false
+	e.values = nil
+	e.vectors = nil
+
+	var (
+		w []float64
+		z *Dense
+	)
+	if r.all() {
+		compz := lapack.EVCompNone
+		if vectors {
+			if q.Data == nil {
+				compz = lapack.EVTridiag
+				q = blas64.General{Rows: n, Cols: n, Stride: n, Data: make([]float64, n*n)}
+			} else {
+				compz = lapack.EVOrig
+			}
+		}
+		work := getFloat64s(max(1, 2*n-2), false)
+		ok = lapack64.Steqr(compz, d, sub, q, work)
+		putFloat64s(work)
+		if !ok {
+			return false
+		}
+		w = d
+		if vectors {
+			z = NewDense(n, n, q.Data)
+		}
+	} else {
+		w = make([]float64, n)
+		m := lapack64.Stebz(r.rng, r.vl, r.vu, r.il, r.iu, 0, d, sub, w)
+		w = w[:m]
+		if vectors && m > 0 {
+			zt := blas64.General{Rows: n, Cols: m, Stride: m, Data: make([]float64, n*m)}
+			if !lapack64.Stein(d, sub, w, zt) {
+				return false
+			}
+			z = NewDense(n, m, zt.Data)
+			if q.Data != nil {
+				var qz Dense
+				qz.Mul(NewDense(n, n, q.Data), z)
+				z = &qz
+			}
+		}
+	}
+
+	e.n = n
+	e.vectorsComputed = vectors
+	e.values = w
+	e.vectors = z
+	return true
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (e *EigenSymBand) succFact() bool {
+	return e.values != nil
+}
+
+// Values extracts the m computed eigenvalues of the factorized n×n matrix A
+// in ascending order. If all eigenvalues were computed, m is equal to n.
+//
+// If dst is not nil, the values are stored in-place into dst and returned,
+// otherwise a new slice is allocated first. If dst is not nil, it must have
+// length equal to m.
+//
+// If the receiver does not contain a successful factorization, Values will
+// panic.
+func (e *EigenSymBand) Values(dst []float64) []float64 {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if dst == nil {
+		dst = make([]float64, len(e.values))
+	}
+	if len(dst) != len(e.values) {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(dst, e.values)
+	return dst
+}
+
+// RawValues returns the slice storing the computed eigenvalues of A in
+// ascending order.
+//
+// If the returned slice is modified, the factorization is invalid and should
+// not be used.
+//
+// If the receiver does not contain a successful factorization, RawValues will
+// return nil.
+func (e *EigenSymBand) RawValues() []float64 {
+	if !e.succFact() {
+		return nil
+	}
+	return e.values
+}
+
+// VectorsTo stores the orthonormal eigenvectors corresponding to the m
+// computed eigenvalues of the factorized n×n matrix A into the columns of
+// dst.
+//
+// If dst is empty, VectorsTo will resize dst to be n×m. When dst is non-empty,
+// VectorsTo will panic if dst is not n×m. VectorsTo will also panic if the
+// eigenvectors were not computed during the factorization, if no eigenvalues
+// were found in the requested range, or if the receiver does not contain a
+// successful factorization.
+func (e *EigenSymBand) VectorsTo(dst *Dense) {
+	if !e.succFact() {
+		panic(badFact)
+	}
+	if !e.vectorsComputed {
+		panic(noVectors)
+	}
+	if e.vectors == nil {
+		panic(ErrZeroLength)
+	}
+	r, c := e.vectors.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || c != c2 {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(e.vectors)
+}
+
+// RawQ returns the n×m matrix Q whose columns are the eigenvectors
+// corresponding to the m computed eigenvalues. If all eigenvalues were
+// computed, Q is the orthogonal matrix from the spectral factorization of the
+// original matrix A
+//
+//	A = Q * Λ * Qᵀ
+//
+// If the returned matrix is modified, the factorization is invalid and should
+// not be used.
+//
+// If the receiver does not contain a successful factorization, eigenvectors
+// were not computed or no eigenvalues were found, RawQ will return nil.
+func (e *EigenSymBand) RawQ() Matrix {
+	if !e.succFact() || !e.vectorsComputed || e.vectors == nil {
+		return nil
+	}
+	return e.vectors
+}
@@ -0,0 +1,207 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestEigenSymBand(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 40} {
+		for _, k := range []int{0, 1, 2, 4, n - 1} {
+			if k < 0 || k >= n {
+				continue
+			}
+			name := fmt.Sprintf("n=%d,k=%d", n, k)
+			a := NewSymBandDense(n, k, nil)
+			for i := 0; i < n; i++ {
+				for j := i; j < min(n, i+k+1); j++ {
+					a.SetSymBand(i, j, rnd.NormFloat64())
+				}
+			}
+
+			var want EigenSym
+			if !want.Factorize(NewSymDense(n, DenseCopyOf(a).RawMatrix().Data), false) {
+				t.Fatalf("%s: bad test: dense factorization failed", name)
+			}
+
+			for _, vectors := range []bool{false, true} {
+				var es EigenSymBand
+				ok := es.Factorize(a, vectors)
+				if !ok {
+					t.Errorf("%s vectors=%t: factorization failed", name, vectors)
+					continue
+				}
+				if r, c := es.Dims(); r != n || c != n {
+					t.Errorf("%s: unexpected dimensions: got:%d×%d want:%d×%d", name, r, c, n, n)
+				}
+				values := es.Values(nil)
+				if !floats.EqualApprox(values, want.RawValues(), tol) {
+					t.Errorf("%s vectors=%t: unexpected eigenvalues:\ngot: %v\nwant:%v", name, vectors, values, want.RawValues())
+				}
+				if !vectors {
+					if es.RawQ() != nil {
+						t.Errorf("%s: unexpected eigenvectors", name)
+					}
+					if ok, _ := panics(func() { es.VectorsTo(&Dense{}) }); !ok {
+						t.Errorf("%s: expected panic for VectorsTo without vectors", name)
+					}
+					continue
+				}
+
+				var q Dense
+				es.VectorsTo(&q)
+				if !isOrthonormal(&q, tol) {
+					t.Errorf("%s: eigenvectors are not orthonormal", name)
+				}
+				var aq, ql Dense
+				aq.Mul(a, &q)
+				ql.Mul(&q, NewDiagDense(n, values))
+				if !EqualApprox(&aq, &ql, tol*float64(n)) {
+					t.Errorf("%s: A⋅Q != Q⋅Λ", name)
+				}
+				if !EqualApprox(&es, a, tol*float64(n)) {
+					t.Errorf("%s: factorization does not reconstruct A", name)
+				}
+			}
+		}
+	}
+}
+
+func TestEigenSymBandRange(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 40} {
+		for _, k := range []int{1, 2, n - 1} {
+			if k < 1 || k >= n {
+				continue
+			}
+			a := NewSymBandDense(n, k, nil)
+			for i := 0; i < n; i++ {
+				for j := i; j < min(n, i+k+1); j++ {
+					a.SetSymBand(i, j, rnd.NormFloat64())
+				}
+			}
+			testEigenSymBandRange(t, fmt.Sprintf("band,n=%d,k=%d", n, k), a, func(es *EigenSymBand, r EigenRange, vectors bool) bool {
+				return es.FactorizeRange(a, r, vectors)
+			}, rnd, tol)
+
+			if k != 1 {
+				continue
+			}
+			d := make([]float64, n)
+			e := make([]float64, n-1)
+			for i := range d {
+				d[i] = a.At(i, i)
+			}
+			for i := range e {
+				e[i] = a.At(i, i+1)
+			}
+			tri := NewTridiag(n, e, d, append([]float64(nil), e...))
+			testEigenSymBandRange(t, fmt.Sprintf("tridiag,n=%d", n), a, func(es *EigenSymBand, r EigenRange, vectors bool) bool {
+				return es.FactorizeTridiag(tri, r, vectors)
+			}, rnd, tol)
+		}
+	}
+
+	// An asymmetric tridiagonal matrix is rejected.
+	var es EigenSymBand
+	if ok, _ := panics(func() {
+		es.FactorizeTridiag(NewTridiag(2, []float64{1}, []float64{1, 1}, []float64{2}), EigenRange{}, false)
+	}); !ok {
+		t.Errorf("expected panic for asymmetric tridiagonal matrix")
+	}
+	if ok, _ := panics(func() { EigenValueRange(1, 1) }); !ok {
+		t.Errorf("expected panic for empty value range")
+	}
+	if ok, _ := panics(func() { EigenIndexRange(2, 1) }); !ok {
+		t.Errorf("expected panic for empty index range")
+	}
+	if ok, _ := panics(func() { es.FactorizeRange(NewSymBandDense(3, 1, nil), EigenIndexRange(0, 3), false) }); !ok {
+		t.Errorf("expected panic for index out of range")
+	}
+}
+
+func testEigenSymBandRange(t *testing.T, name string, a *SymBandDense, factorize func(*EigenSymBand, EigenRange, bool) bool, rnd *rand.Rand, tol float64) {
+	n := a.SymmetricDim()
+	var full EigenSym
+	if !full.Factorize(NewSymDense(n, DenseCopyOf(a).RawMatrix().Data), false) {
+		t.Fatalf("%s: bad test: dense factorization failed", name)
+	}
+	want := full.RawValues()
+
+	for trial := 0; trial < 4; trial++ {
+		il := rnd.Intn(n)
+		iu := il + rnd.Intn(n-il)
+		vl := want[il] - 1
+		if il > 0 {
+			vl = (want[il-1] + want[il]) / 2
+		}
+		vu := want[iu] + 1
+		if iu < n-1 {
+			vu = (want[iu] + want[iu+1]) / 2
+		}
+		for _, r := range []EigenRange{{}, EigenIndexRange(il, iu), EigenValueRange(vl, vu)} {
+			wantValues := want
+			if !r.all() {
+				wantValues = want[il : iu+1]
+			}
+			m := len(wantValues)
+			for _, vectors := range []bool{false, true} {
+				name := fmt.Sprintf("%s,il=%d,iu=%d,range=%c,vectors=%t", name, il, iu, r.rng, vectors)
+				var es EigenSymBand
+				if !factorize(&es, r, vectors) {
+					t.Errorf("%s: factorization failed", name)
+					continue
+				}
+				if r, c := es.Dims(); r != n || c != n {
+					t.Errorf("%s: unexpected dimensions: got:%d×%d want:%d×%d", name, r, c, n, n)
+				}
+				values := es.Values(nil)
+				if !floats.EqualApprox(values, wantValues, tol) {
+					t.Errorf("%s: unexpected eigenvalues:\ngot: %v\nwant:%v", name, values, wantValues)
+					continue
+				}
+				if !vectors {
+					continue
+				}
+
+				var q Dense
+				es.VectorsTo(&q)
+				if r, c := q.Dims(); r != n || c != m {
+					t.Errorf("%s: unexpected eigenvector dimensions: got:%d×%d want:%d×%d", name, r, c, n, m)
+					continue
+				}
+				var qtq Dense
+				qtq.Mul(q.T(), &q)
+				if !EqualApprox(&qtq, eye(m), tol*float64(n)) {
+					t.Errorf("%s: eigenvectors are not orthonormal", name)
+				}
+				var aq, ql Dense
+				aq.Mul(a, &q)
+				ql.Mul(&q, NewDiagDense(m, values))
+				if !EqualApprox(&aq, &ql, tol*float64(n)) {
+					t.Errorf("%s: A⋅Q != Q⋅Λ", name)
+				}
+				if m < n {
+					if ok, _ := panics(func() { es.At(0, 0) }); !ok {
+						t.Errorf("%s: expected panic for At on partial factorization", name)
+					}
+				} else if !EqualApprox(&es, a, tol*float64(n)) {
+					t.Errorf("%s: factorization does not reconstruct A", name)
+				}
+			}
+		}
+	}
+}
@@ -233,3 +233,112 @@ func TestEigenSym(t *testing.T) {
 		}
 	}
 }
+
+func TestEigenSymRange(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 40} {
+		a := make([]float64, n*n)
+		for i := range a {
+			a[i] = rnd.NormFloat64()
+		}
+		s := NewSymDense(n, a)
+
+		var full EigenSym
+		if !full.Factorize(s, false) {
+			t.Fatalf("n=%d: bad test: full factorization failed", n)
+		}
+		want := full.RawValues()
+
+		for trial := 0; trial < 4; trial++ {
+			il := rnd.Intn(n)
+			iu := il + rnd.Intn(n-il)
+			vl := want[il] - 1
+			if il > 0 {
+				vl = (want[il-1] + want[il]) / 2
+			}
+			vu := want[iu] + 1
+			if iu < n-1 {
+				vu = (want[iu] + want[iu+1]) / 2
+			}
+			for _, r := range []EigenRange{{}, EigenIndexRange(il, iu), EigenValueRange(vl, vu)} {
+				wantValues := want
+				if !r.all() {
+					wantValues = want[il : iu+1]
+				}
+				m := len(wantValues)
+
+				var es EigenSym
+				if !es.FactorizeRange(s, r, false) {
+					t.Errorf("n=%d,il=%d,iu=%d,r=%+v: factorization failed", n, il, iu, r)
+					continue
+				}
+				if es.SymmetricDim() != n {
+					t.Errorf("n=%d,r=%+v: unexpected dimension: got %d, want %d", n, r, es.SymmetricDim(), n)
+				}
+				if !floats.EqualApprox(es.Values(nil), wantValues, tol) {
+					t.Errorf("n=%d,r=%+v: eigenvalue mismatch without vectors:\ngot  %v\nwant %v", n, r, es.Values(nil), wantValues)
+				}
+				if es.RawQ() != nil {
+					t.Errorf("n=%d,r=%+v: unexpected non-nil RawQ without vectors", n, r)
+				}
+
+				if !es.FactorizeRange(s, r, true) {
+					t.Errorf("n=%d,r=%+v: factorization with vectors failed", n, r)
+					continue
+				}
+				values := es.Values(nil)
+				if !floats.EqualApprox(values, wantValues, tol) {
+					t.Errorf("n=%d,r=%+v: eigenvalue mismatch with vectors", n, r)
+				}
+				var q Dense
+				es.VectorsTo(&q)
+				if r, c := q.Dims(); r != n || c != m {
+					t.Errorf("n=%d: unexpected eigenvector shape: got %d×%d, want %d×%d", n, r, c, n, m)
+					continue
+				}
+				var qtq Dense
+				qtq.Mul(q.T(), &q)
+				if !EqualApprox(&qtq, eye(m), tol*float64(n)) {
+					t.Errorf("n=%d,r=%+v: eigenvectors not orthonormal", n, r)
+				}
+				var aq, ql Dense
+				aq.Mul(s, &q)
+				ql.Mul(&q, NewDiagDense(m, values))
+				if !EqualApprox(&aq, &ql, tol*float64(n)) {
+					t.Errorf("n=%d,r=%+v: A⋅Q != Q⋅Λ", n, r)
+				}
+				if m < n {
+					if ok, _ := panics(func() { es.At(0, 0) }); !ok {
+						t.Errorf("n=%d,r=%+v: expected panic for At on partial factorization", n, r)
+					}
+				} else if !EqualApprox(&es, s, tol*float64(n)) {
+					t.Errorf("n=%d,r=%+v: factorization does not reconstruct A", n, r)
+				}
+			}
+		}
+
+		// A value range containing no eigenvalues is a successful
+		// factorization with no values and no vectors.
+		var es EigenSym
+		if !es.FactorizeRange(s, EigenValueRange(want[n-1]+1, want[n-1]+2), true) {
+			t.Errorf("n=%d: empty range factorization failed", n)
+			continue
+		}
+		if got := es.Values(nil); len(got) != 0 {
+			t.Errorf("n=%d: unexpected eigenvalues in empty range: %v", n, got)
+		}
+		if es.RawQ() != nil {
+			t.Errorf("n=%d: unexpected non-nil RawQ for empty range", n)
+		}
+		if ok, _ := panics(func() { es.VectorsTo(&Dense{}) }); !ok {
+			t.Errorf("n=%d: expected panic for VectorsTo with empty range", n)
+		}
+	}
+
+	var es EigenSym
+	if ok, _ := panics(func() { es.FactorizeRange(NewSymDense(3, nil), EigenIndexRange(0, 3), false) }); !ok {
+		t.Errorf("expected panic for index out of range")
+	}
+}
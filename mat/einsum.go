@@ -0,0 +1,396 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"sort"
+	"strings"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+const badEinsum = "mat: invalid einsum subscripts"
+
+// Einsum evaluates the Einstein summation described by subscripts over the
+// operands and stores the result in the receiver.
+//
+// subscripts is a comma separated list of index labels, one term per operand,
+// optionally followed by "->" and the labels of the result, for example
+//
+//	"ij,jk->ik"    matrix product
+//	"ij,jk,kl->il" chained matrix product
+//	"ij,ij->ij"    element-wise product
+//	"ji->ij"       transpose
+//	"ii->"         trace
+//	"ii->i"        diagonal
+//	"i,j->ij"      outer product of vectors
+//	"ij,j->i"      matrix-vector product
+//
+// Labels are single ASCII letters and spaces are ignored. Each label is summed
+// over unless it appears in the result. If "->" is omitted the result holds,
+// in alphabetical order, the labels that appear exactly once in subscripts.
+//
+// An operand with two labels is used as a matrix. An operand with a single
+// label must have a single row or column and is used as a vector, and an
+// operand with no labels must be 1×1. The result may have at most two labels;
+// a result with two labels is stored as a matrix, a result with one label as a
+// column vector and a result with no labels as a 1×1 matrix. All dimensions
+// sharing a label must be equal.
+//
+// The contraction is planned pairwise, choosing at each step the pair of
+// operands with the smallest intermediate result, and each pairwise
+// contraction is computed with matrix multiplication.
+//
+// Einsum panics if subscripts is malformed, does not match the number of
+// operands or if the operand dimensions are not compatible.
+func (m *Dense) Einsum(subscripts string, operands ...Matrix) {
+	inputs, output := parseEinsum(subscripts, len(operands))
+
+	dims := make(map[byte]int)
+	tensors := make([]*einTensor, len(operands))
+	for i, a := range operands {
+		tensors[i] = newEinTensor(a, inputs[i], dims)
+	}
+
+	// Remove labels that are used by only one operand and
+	// are not part of the result.
+	for i, t := range tensors {
+		tensors[i] = t.sumOut(einKeep(output, tensors, i, -1))
+	}
+
+	for len(tensors) > 1 {
+		bi, bj := 0, 1
+		best := -1
+		for i := 0; i < len(tensors); i++ {
+			for j := i + 1; j < len(tensors); j++ {
+				size := 1
+				for _, l := range einContractedLabels(tensors[i], tensors[j], output, tensors, i, j) {
+					size *= dims[l]
+				}
+				if best < 0 || size < best {
+					bi, bj, best = i, j, size
+				}
+			}
+		}
+		keep := einContractedLabels(tensors[bi], tensors[bj], output, tensors, bi, bj)
+		c := einContract(tensors[bi], tensors[bj], keep)
+		tensors[bi] = c
+		tensors = append(tensors[:bj], tensors[bj+1:]...)
+	}
+
+	t := tensors[0].sumOut(func(l byte) bool { return strings.IndexByte(string(output), l) >= 0 })
+	t = t.permute(output)
+
+	r, c := 1, 1
+	switch len(output) {
+	case 1:
+		r = dims[output[0]]
+	case 2:
+		r, c = dims[output[0]], dims[output[1]]
+	}
+	m.reuseAsNonZeroed(r, c)
+	for i := 0; i < r; i++ {
+		copy(m.mat.Data[i*m.mat.Stride:i*m.mat.Stride+c], t.data[i*c:(i+1)*c])
+	}
+}
+
+// parseEinsum returns the labels of each of the n operands and the labels of
+// the result described by subscripts.
+func parseEinsum(subscripts string, n int) (inputs [][]byte, output []byte) {
+	s := strings.ReplaceAll(subscripts, " ", "")
+	lhs, rhs, explicit := strings.Cut(s, "->")
+	terms := strings.Split(lhs, ",")
+	if len(terms) != n {
+		panic(badEinsum)
+	}
+	count := make(map[byte]int)
+	inputs = make([][]byte, n)
+	for i, term := range terms {
+		if len(term) > 2 {
+			panic(badEinsum)
+		}
+		for j := 0; j < len(term); j++ {
+			if !isEinLabel(term[j]) {
+				panic(badEinsum)
+			}
+			count[term[j]]++
+		}
+		inputs[i] = []byte(term)
+	}
+	if !explicit {
+		for l, c := range count {
+			if c == 1 {
+				output = append(output, l)
+			}
+		}
+		sort.Slice(output, func(i, j int) bool { return output[i] < output[j] })
+	} else {
+		output = []byte(rhs)
+		for i, l := range output {
+			if !isEinLabel(l) || count[l] == 0 || strings.IndexByte(rhs[:i], l) >= 0 {
+				panic(badEinsum)
+			}
+		}
+	}
+	if len(output) > 2 {
+		panic(badEinsum)
+	}
+	return inputs, output
+}
+
+func isEinLabel(b byte) bool {
+	return ('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z')
+}
+
+// einTensor is a dense row-major tensor with labelled axes used to hold
+// operands and intermediate results of Einsum.
+type einTensor struct {
+	labels []byte
+	dims   []int
+	data   []float64
+}
+
+// newEinTensor returns the tensor for the operand a with the given labels,
+// recording the label dimensions in dims and checking them against
+// previously recorded dimensions. A repeated label selects the diagonal of a.
+func newEinTensor(a Matrix, labels []byte, dims map[byte]int) *einTensor {
+	r, c := a.Dims()
+	var t einTensor
+	switch len(labels) {
+	case 0:
+		if r != 1 || c != 1 {
+			panic(ErrShape)
+		}
+		t.data = []float64{a.At(0, 0)}
+	case 1:
+		if r != 1 && c != 1 {
+			panic(ErrShape)
+		}
+		n := r * c
+		t.labels = labels
+		t.dims = []int{n}
+		t.data = make([]float64, n)
+		for i := range t.data {
+			if c == 1 {
+				t.data[i] = a.At(i, 0)
+			} else {
+				t.data[i] = a.At(0, i)
+			}
+		}
+	case 2:
+		if labels[0] == labels[1] {
+			if r != c {
+				panic(ErrSquare)
+			}
+			t.labels = labels[:1]
+			t.dims = []int{r}
+			t.data = make([]float64, r)
+			for i := range t.data {
+				t.data[i] = a.At(i, i)
+			}
+			break
+		}
+		t.labels = labels
+		t.dims = []int{r, c}
+		t.data = make([]float64, r*c)
+		if rm, ok := a.(RawMatrixer); ok {
+			amat := rm.RawMatrix()
+			for i := 0; i < r; i++ {
+				copy(t.data[i*c:(i+1)*c], amat.Data[i*amat.Stride:i*amat.Stride+c])
+			}
+			break
+		}
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				t.data[i*c+j] = a.At(i, j)
+			}
+		}
+	}
+	for i, l := range t.labels {
+		if d, ok := dims[l]; ok && d != t.dims[i] {
+			panic(ErrShape)
+		}
+		dims[l] = t.dims[i]
+	}
+	return &t
+}
+
+// einKeep returns a function reporting whether a label must be retained
+// because it is part of the output or used by a tensor other than those at
+// indices i and j.
+func einKeep(output []byte, tensors []*einTensor, i, j int) func(byte) bool {
+	return func(l byte) bool {
+		if strings.IndexByte(string(output), l) >= 0 {
+			return true
+		}
+		for k, u := range tensors {
+			if k != i && k != j && u.index(l) >= 0 {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// einContractedLabels returns the labels of the result of contracting a and b,
+// the tensors at indices i and j, ordered with the labels shared by a and b
+// first, followed by those only in a and then those only in b.
+func einContractedLabels(a, b *einTensor, output []byte, tensors []*einTensor, i, j int) []byte {
+	keep := einKeep(output, tensors, i, j)
+	var batch, fa, fb []byte
+	for _, l := range a.labels {
+		if !keep(l) {
+			continue
+		}
+		if b.index(l) >= 0 {
+			batch = append(batch, l)
+		} else {
+			fa = append(fa, l)
+		}
+	}
+	for _, l := range b.labels {
+		if keep(l) && a.index(l) < 0 {
+			fb = append(fb, l)
+		}
+	}
+	return append(append(batch, fa...), fb...)
+}
+
+// einContract returns the contraction of a and b over all shared labels that
+// are not in keep. keep must be ordered as returned by einContractedLabels.
+func einContract(a, b *einTensor, keep []byte) *einTensor {
+	kept := func(l byte) bool { return strings.IndexByte(string(keep), l) >= 0 }
+	// Labels only in one of a or b and not kept have already been
+	// summed out, so the remaining labels of a and b are either kept
+	// or contracted.
+	var batch, fa, fb, contr []byte
+	for _, l := range a.labels {
+		switch {
+		case b.index(l) < 0:
+			fa = append(fa, l)
+		case kept(l):
+			batch = append(batch, l)
+		default:
+			contr = append(contr, l)
+		}
+	}
+	for _, l := range b.labels {
+		if a.index(l) < 0 {
+			fb = append(fb, l)
+		}
+	}
+
+	ap := a.permute(append(append(append([]byte(nil), batch...), fa...), contr...))
+	bp := b.permute(append(append(append([]byte(nil), batch...), contr...), fb...))
+	nb, na, nk, nf := ap.size(0, len(batch)), ap.size(len(batch), len(batch)+len(fa)),
+		ap.size(len(batch)+len(fa), len(ap.dims)), bp.size(len(batch)+len(contr), len(bp.dims))
+
+	c := &einTensor{
+		labels: append(append(append([]byte(nil), batch...), fa...), fb...),
+		data:   make([]float64, nb*na*nf),
+	}
+	c.dims = append(append([]int(nil), ap.dims[:len(batch)+len(fa)]...), bp.dims[len(batch)+len(contr):]...)
+	for p := 0; p < nb; p++ {
+		blas64.Gemm(blas.NoTrans, blas.NoTrans, 1,
+			blas64.General{Rows: na, Cols: nk, Stride: nk, Data: ap.data[p*na*nk : (p+1)*na*nk]},
+			blas64.General{Rows: nk, Cols: nf, Stride: nf, Data: bp.data[p*nk*nf : (p+1)*nk*nf]},
+			0,
+			blas64.General{Rows: na, Cols: nf, Stride: nf, Data: c.data[p*na*nf : (p+1)*na*nf]})
+	}
+	return c
+}
+
+// index returns the axis of t with label l, or -1 if t has no such axis.
+func (t *einTensor) index(l byte) int {
+	return strings.IndexByte(string(t.labels), l)
+}
+
+// size returns the number of elements spanned by the axes [from, to) of t.
+func (t *einTensor) size(from, to int) int {
+	n := 1
+	for _, d := range t.dims[from:to] {
+		n *= d
+	}
+	return n
+}
+
+// sumOut returns t summed over all axes with labels for which keep returns
+// false.
+func (t *einTensor) sumOut(keep func(byte) bool) *einTensor {
+	var kept, summed []byte
+	for _, l := range t.labels {
+		if keep(l) {
+			kept = append(kept, l)
+		} else {
+			summed = append(summed, l)
+		}
+	}
+	if len(summed) == 0 {
+		return t
+	}
+	p := t.permute(append(append([]byte(nil), kept...), summed...))
+	n := p.size(len(kept), len(p.dims))
+	s := &einTensor{
+		labels: kept,
+		dims:   p.dims[:len(kept)],
+		data:   make([]float64, len(p.data)/n),
+	}
+	for i := range s.data {
+		var sum float64
+		for _, v := range p.data[i*n : (i+1)*n] {
+			sum += v
+		}
+		s.data[i] = sum
+	}
+	return s
+}
+
+// permute returns t with its axes reordered to match labels, which must be
+// a permutation of the labels of t. The returned tensor shares data with t
+// if no reordering is necessary.
+func (t *einTensor) permute(labels []byte) *einTensor {
+	if string(labels) == string(t.labels) {
+		return t
+	}
+	n := len(t.dims)
+	perm := make([]int, n)
+	dims := make([]int, n)
+	for i, l := range labels {
+		perm[i] = t.index(l)
+		dims[i] = t.dims[perm[i]]
+	}
+	// Strides of the source tensor in the order of the destination axes.
+	strides := make([]int, n)
+	stride := 1
+	for i := n - 1; i >= 0; i-- {
+		for j, p := range perm {
+			if p == i {
+				strides[j] = stride
+			}
+		}
+		stride *= t.dims[i]
+	}
+	p := &einTensor{
+		labels: labels,
+		dims:   dims,
+		data:   make([]float64, len(t.data)),
+	}
+	idx := make([]int, n)
+	var off int
+	for i := range p.data {
+		p.data[i] = t.data[off]
+		for k := n - 1; k >= 0; k-- {
+			idx[k]++
+			off += strides[k]
+			if idx[k] < dims[k] {
+				break
+			}
+			off -= idx[k] * strides[k]
+			idx[k] = 0
+		}
+	}
+	return p
+}
@@ -0,0 +1,213 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestEinsum(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	randMat := func(r, c int) *Dense {
+		m := NewDense(r, c, nil)
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				m.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		return m
+	}
+	randVec := func(n int) *VecDense {
+		v := NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			v.SetVec(i, rnd.NormFloat64())
+		}
+		return v
+	}
+
+	a := randMat(3, 4)
+	b := randMat(4, 5)
+	c := randMat(5, 2)
+	d := randMat(3, 4)
+	s := randMat(4, 4)
+	x := randVec(3)
+	y := randVec(4)
+
+	var ab, abc, want Dense
+	ab.Mul(a, b)
+	abc.Mul(&ab, c)
+
+	for _, test := range []struct {
+		subscripts string
+		operands   []Matrix
+		want       func() Matrix
+	}{
+		{
+			subscripts: "ij,jk->ik",
+			operands:   []Matrix{a, b},
+			want:       func() Matrix { return &ab },
+		},
+		{
+			subscripts: "ij,jk",
+			operands:   []Matrix{a, b},
+			want:       func() Matrix { return &ab },
+		},
+		{
+			subscripts: "ij, jk, kl -> il",
+			operands:   []Matrix{a, b, c},
+			want:       func() Matrix { return &abc },
+		},
+		{
+			subscripts: "kl,ij,jk->li",
+			operands:   []Matrix{c, a, b},
+			want:       func() Matrix { return abc.T() },
+		},
+		{
+			subscripts: "ij,kj->ik",
+			operands:   []Matrix{a, d},
+			want: func() Matrix {
+				want.Mul(a, d.T())
+				return &want
+			},
+		},
+		{
+			subscripts: "ij,ij->ij",
+			operands:   []Matrix{a, d},
+			want: func() Matrix {
+				want.MulElem(a, d)
+				return &want
+			},
+		},
+		{
+			subscripts: "ij,ij->",
+			operands:   []Matrix{a, d},
+			want: func() Matrix {
+				var e Dense
+				e.MulElem(a, d)
+				return NewDense(1, 1, []float64{Sum(&e)})
+			},
+		},
+		{
+			subscripts: "ji->ij",
+			operands:   []Matrix{a},
+			want:       func() Matrix { return a.T() },
+		},
+		{
+			subscripts: "ij->j",
+			operands:   []Matrix{a},
+			want: func() Matrix {
+				var v VecDense
+				v.MulVec(a.T(), NewVecDense(3, []float64{1, 1, 1}))
+				return &v
+			},
+		},
+		{
+			subscripts: "ii->",
+			operands:   []Matrix{s},
+			want:       func() Matrix { return NewDense(1, 1, []float64{Trace(s)}) },
+		},
+		{
+			subscripts: "ii",
+			operands:   []Matrix{s},
+			want:       func() Matrix { return NewDense(1, 1, []float64{Trace(s)}) },
+		},
+		{
+			subscripts: "ii->i",
+			operands:   []Matrix{s},
+			want: func() Matrix {
+				return NewVecDense(4, []float64{s.At(0, 0), s.At(1, 1), s.At(2, 2), s.At(3, 3)})
+			},
+		},
+		{
+			subscripts: "i,j->ij",
+			operands:   []Matrix{x, y},
+			want: func() Matrix {
+				want.Outer(1, x, y)
+				return &want
+			},
+		},
+		{
+			subscripts: "ij,j->i",
+			operands:   []Matrix{a, y},
+			want: func() Matrix {
+				var v VecDense
+				v.MulVec(a, y)
+				return &v
+			},
+		},
+		{
+			subscripts: "i,ij,j->",
+			operands:   []Matrix{x, a, y.T()},
+			want:       func() Matrix { return NewDense(1, 1, []float64{Inner(x, a, y)}) },
+		},
+		{
+			subscripts: ",ij->ij",
+			operands:   []Matrix{NewDense(1, 1, []float64{2}), a},
+			want: func() Matrix {
+				want.Scale(2, a)
+				return &want
+			},
+		},
+		{
+			subscripts: "ij,ik,il->jk",
+			operands:   []Matrix{a, d, x},
+			want: func() Matrix {
+				var xa Dense
+				xa.Apply(func(i, j int, v float64) float64 { return v * x.AtVec(i) }, a)
+				want.Mul(xa.T(), d)
+				return &want
+			},
+		},
+	} {
+		var got Dense
+		got.Einsum(test.subscripts, test.operands...)
+		want.Reset()
+		w := test.want()
+		if !EqualApprox(&got, w, 1e-12) {
+			t.Errorf("unexpected result for %q:\ngot:\n%v\nwant:\n%v",
+				test.subscripts, Formatted(&got), Formatted(w))
+		}
+	}
+
+	// The receiver may be an operand.
+	m := DenseCopyOf(a)
+	m.Einsum("ij,ij->ij", m, m)
+	want.Reset()
+	want.MulElem(a, a)
+	if !EqualApprox(m, &want, 1e-14) {
+		t.Errorf("unexpected result for aliased receiver")
+	}
+}
+
+func TestEinsumPanics(t *testing.T) {
+	t.Parallel()
+	a := NewDense(2, 3, nil)
+	b := NewDense(3, 3, nil)
+	for _, test := range []struct {
+		subscripts string
+		operands   []Matrix
+		want       string
+	}{
+		{subscripts: "ij,jk->ik", operands: []Matrix{a}, want: badEinsum},
+		{subscripts: "ijk->ij", operands: []Matrix{a}, want: badEinsum},
+		{subscripts: "ij,jk->ijk", operands: []Matrix{a, b}, want: badEinsum},
+		{subscripts: "ij->il", operands: []Matrix{a}, want: badEinsum},
+		{subscripts: "ij->ii", operands: []Matrix{a}, want: badEinsum},
+		{subscripts: "i1->i", operands: []Matrix{a}, want: badEinsum},
+		{subscripts: "ij,ij->ij", operands: []Matrix{a, b}, want: ErrShape.Error()},
+		{subscripts: "i->i", operands: []Matrix{a}, want: ErrShape.Error()},
+		{subscripts: "->", operands: []Matrix{a}, want: ErrShape.Error()},
+		{subscripts: "ii->i", operands: []Matrix{a}, want: ErrSquare.Error()},
+	} {
+		var m Dense
+		panicked, message := panics(func() { m.Einsum(test.subscripts, test.operands...) })
+		if !panicked || message != test.want {
+			t.Errorf("unexpected panic for %q: got %q, want %q", test.subscripts, message, test.want)
+		}
+	}
+}
@@ -137,6 +137,9 @@ var (
 	ErrSliceLengthMismatch = Error{"mat: input slice length mismatch"}
 	ErrNotPSD              = Error{"mat: input not positive symmetric definite"}
 	ErrFailedEigen         = Error{"mat: eigendecomposition not successful"}
+	ErrFailedSVD           = Error{"mat: singular value decomposition not successful"}
+	ErrNotSymmetric        = Error{"mat: matrix not symmetric"}
+	ErrSparseStructure     = Error{"mat: malformed sparse structure"}
 )
 
 // ErrorStack represents matrix handling errors that have been recovered by Maybe wrappers.
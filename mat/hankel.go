@@ -0,0 +1,124 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+var (
+	hankel *Hankel
+	_      Matrix      = hankel
+	_      NonZeroDoer = hankel
+)
+
+// Hankel represents an r×c Hankel matrix, a matrix that is constant along
+// each anti-diagonal, by the sequence h of length r+c-1, so that
+//
+//	A[i,j] = h[i+j]
+//
+// The first column of A is h[:r] and the last row is h[r-1:]. Hankel matrices
+// arise as the trajectory matrices of time series in singular spectrum
+// analysis and as the matrices of Markov parameters in system
+// identification.
+//
+// Products with a Hankel matrix are computed by reversing the order of the
+// columns, which gives a Toeplitz matrix, and embedding the result in a
+// circulant matrix, in O((r+c) log(r+c)) time.
+type Hankel struct {
+	rows, cols int
+	h          []float64
+}
+
+// NewHankel creates a new r×c Hankel matrix with the anti-diagonals in h. If h
+// is nil, a new backing slice is allocated, otherwise h must have length
+// r+c-1 and is used as the backing slice so that changes to the elements of
+// the returned Hankel will be reflected in h. NewHankel will panic if r or c
+// is not positive or if h has the wrong length.
+func NewHankel(r, c int, h []float64) *Hankel {
+	if r <= 0 || c <= 0 {
+		if r == 0 || c == 0 {
+			panic(ErrZeroLength)
+		}
+		panic(ErrNegativeDimension)
+	}
+	if h == nil {
+		h = make([]float64, r+c-1)
+	}
+	if len(h) != r+c-1 {
+		panic(ErrShape)
+	}
+	return &Hankel{rows: r, cols: c, h: h}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (a *Hankel) Dims() (r, c int) {
+	return a.rows, a.cols
+}
+
+// At returns the element at row i, column j.
+func (a *Hankel) At(i, j int) float64 {
+	if uint(i) >= uint(a.rows) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(a.cols) {
+		panic(ErrColAccess)
+	}
+	return a.h[i+j]
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (a *Hankel) T() Matrix {
+	return Transpose{a}
+}
+
+// RawHankel returns the anti-diagonals of the matrix. Changes to the elements
+// of the returned slice will be reflected in the receiver.
+func (a *Hankel) RawHankel() []float64 {
+	return a.h
+}
+
+// DoNonZero calls the function fn for each of the non-zero elements of A. The
+// function fn takes a row/column index and the element value of A at (i,j).
+func (a *Hankel) DoNonZero(fn func(i, j int, v float64)) {
+	for i := 0; i < a.rows; i++ {
+		for j := 0; j < a.cols; j++ {
+			if v := a.h[i+j]; v != 0 {
+				fn(i, j, v)
+			}
+		}
+	}
+}
+
+// MulVecTo computes A⋅x or Aᵀ⋅x storing the result into dst.
+func (a *Hankel) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	// The transpose of a Hankel matrix is the Hankel matrix with
+	// the same anti-diagonals.
+	m, n := a.rows, a.cols
+	if trans {
+		m, n = n, m
+	}
+	if x.Len() != n {
+		panic(ErrShape)
+	}
+	if xv, ok := x.(RawVectorer); ok && dst != x {
+		dst.checkOverlap(xv.RawVector())
+	}
+
+	// Reversing the order of the columns of A gives the m×n Toeplitz
+	// matrix with first column h[n-1:] and first row h[n-1], ..., h[0].
+	xRev := make([]float64, n)
+	for j := range xRev {
+		xRev[n-1-j] = x.AtVec(j)
+	}
+	r := make([]float64, n)
+	for k := range r {
+		r[k] = a.h[n-1-k]
+	}
+	y := make([]float64, m)
+	toeplitzMulVec(y, a.h[n-1:], r, xRev)
+
+	dst.reuseAsNonZeroed(m)
+	for i, v := range y {
+		dst.setVec(i, v)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestHankel(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, r := range []int{1, 2, 3, 8, 17} {
+		for _, c := range []int{1, 2, 5, 8, 30} {
+			h := make([]float64, r+c-1)
+			for i := range h {
+				h[i] = rnd.NormFloat64()
+			}
+			a := NewHankel(r, c, h)
+			want := DenseCopyOf(a)
+			for i := 0; i < r; i++ {
+				for j := 0; j < c; j++ {
+					if want.At(i, j) != h[i+j] {
+						t.Fatalf("r=%d,c=%d: unexpected element (%d,%d): got %v, want %v", r, c, i, j, want.At(i, j), h[i+j])
+					}
+				}
+			}
+
+			for _, trans := range []bool{false, true} {
+				name := fmt.Sprintf("r=%d,c=%d,trans=%t", r, c, trans)
+				var aMat, wantMat Matrix = a, want
+				n := c
+				if trans {
+					aMat, wantMat = a.T(), want.T()
+					n = r
+				}
+				x := NewVecDense(n, nil)
+				for i := 0; i < n; i++ {
+					x.SetVec(i, rnd.NormFloat64())
+				}
+				var got, ref VecDense
+				got.MulVec(aMat, x)
+				ref.MulVec(wantMat, x)
+				if !EqualApprox(&got, &ref, tol*float64(r+c)) {
+					t.Errorf("%s: unexpected MulVec result:\ngot: %v\nwant:%v", name, got.RawVector().Data, ref.RawVector().Data)
+				}
+			}
+		}
+	}
+}
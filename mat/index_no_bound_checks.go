@@ -69,6 +69,37 @@ func (m *CDense) set(i, j int, v complex128) {
 	m.mat.Data[i*m.mat.Stride+j] = v
 }
 
+// At returns the element at row i, column j converted to float64, so that
+// Dense32 satisfies the Matrix interface. The conversion is exact.
+func (m *Dense32) At(i, j int) float64 {
+	if uint(i) >= uint(m.mat.Rows) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.mat.Cols) {
+		panic(ErrColAccess)
+	}
+	return float64(m.at(i, j))
+}
+
+func (m *Dense32) at(i, j int) float32 {
+	return m.mat.Data[i*m.mat.Stride+j]
+}
+
+// Set sets the element at row i, column j to the value v.
+func (m *Dense32) Set(i, j int, v float32) {
+	if uint(i) >= uint(m.mat.Rows) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.mat.Cols) {
+		panic(ErrColAccess)
+	}
+	m.set(i, j, v)
+}
+
+func (m *Dense32) set(i, j int, v float32) {
+	m.mat.Data[i*m.mat.Stride+j] = v
+}
+
 // At returns the element at row i.
 // It panics if i is out of bounds or if j is not zero.
 func (v *VecDense) At(i, j int) float64 {
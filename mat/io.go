@@ -9,6 +9,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"hash/crc32"
 	"io"
 	"math"
 )
@@ -428,6 +429,302 @@ func (v *VecDense) UnmarshalBinaryFrom(r io.Reader) (int, error) {
 	return n, nil
 }
 
+// streamVersion is the codec version of the chunked streaming encoding
+// written by WriteTo.
+const streamVersion uint32 = 0x2
+
+// streamChunk is the maximum number of elements in each frame written by
+// WriteTo.
+const streamChunk = 1 << 16
+
+var errChecksum = errors.New("mat: frame checksum mismatch")
+
+// WriteTo encodes the receiver into a chunked binary form and writes it
+// into w, implementing the io.WriterTo interface. WriteTo returns the
+// number of bytes written into w and an error, if any.
+//
+// Unlike MarshalBinary, WriteTo never holds more than a fixed-size chunk
+// of the encoding in memory, so it is suitable for serializing very large
+// matrices. The encoding is little-endian and begins with the same header
+// as MarshalBinary, but with the version set to 2. The header is followed
+// by a sequence of frames holding the matrix elements in row-major order,
+// each encoded as follows:
+//
+//	0 - 3   number of elements in the frame, k  (uint32)
+//	4 - ..  k data elements                     (float64)
+//	..      CRC-32 (IEEE) of the data elements  (uint32)
+//
+// The sequence is terminated by a frame with k = 0 and no checksum.
+func (m Dense) WriteTo(w io.Writer) (int64, error) {
+	header := storage{
+		Form: 'G', Packing: 'F', Uplo: 'A',
+		Rows: int64(m.mat.Rows), Cols: int64(m.mat.Cols),
+		Version: streamVersion,
+	}
+	n, err := header.marshalBinaryTo(w)
+	if err != nil {
+		return int64(n), err
+	}
+
+	fw := frameWriter{w: w, n: int64(n)}
+	r, c := m.Dims()
+	for i := 0; i < r; i++ {
+		fw.write(m.mat.Data[i*m.mat.Stride : i*m.mat.Stride+c])
+	}
+	return fw.close()
+}
+
+// ReadFrom decodes the binary form written by WriteTo or by
+// MarshalBinaryTo from r into the receiver, implementing the
+// io.ReaderFrom interface. ReadFrom returns the number of bytes read
+// and an error, if any. It panics if the receiver is a non-empty Dense
+// matrix.
+//
+// The checksum of each frame is verified as it is read, so at most one
+// chunk of the input is decoded before a corrupted frame is detected.
+// The same checks are performed as for UnmarshalBinaryFrom, and ReadFrom
+// similarly should not be used on untrusted data.
+func (m *Dense) ReadFrom(r io.Reader) (int64, error) {
+	if !m.IsEmpty() {
+		panic("mat: unmarshal into non-empty matrix")
+	}
+
+	var header storage
+	n, err := header.unmarshalStreamFrom(r)
+	if err != nil {
+		return int64(n), err
+	}
+	vers := header.Version
+	rows := header.Rows
+	cols := header.Cols
+	header.Version = 0
+	header.Rows = 0
+	header.Cols = 0
+	if (header != storage{Form: 'G', Packing: 'F', Uplo: 'A'}) {
+		return int64(n), errWrongType
+	}
+	if rows < 0 || cols < 0 {
+		return int64(n), errBadSize
+	}
+	size := rows * cols
+	if size == 0 {
+		return int64(n), ErrZeroLength
+	}
+	if int(size) < 0 || size > maxLen {
+		return int64(n), errTooBig
+	}
+
+	m.reuseAsNonZeroed(int(rows), int(cols))
+	nn, err := readElements(r, m.mat.Data, vers)
+	return int64(n) + nn, err
+}
+
+// WriteTo encodes the receiver into a chunked binary form and writes it
+// into w, implementing the io.WriterTo interface. WriteTo returns the
+// number of bytes written into w and an error, if any.
+//
+// See Dense.WriteTo for the encoding. The vector is encoded as a single
+// column matrix.
+func (v VecDense) WriteTo(w io.Writer) (int64, error) {
+	header := storage{
+		Form: 'G', Packing: 'F', Uplo: 'A',
+		Rows: int64(v.mat.N), Cols: 1,
+		Version: streamVersion,
+	}
+	n, err := header.marshalBinaryTo(w)
+	if err != nil {
+		return int64(n), err
+	}
+
+	fw := frameWriter{w: w, n: int64(n)}
+	for i := 0; i < v.mat.N; i++ {
+		fw.put(v.at(i))
+	}
+	return fw.close()
+}
+
+// ReadFrom decodes the binary form written by WriteTo or by
+// MarshalBinaryTo from r into the receiver, implementing the
+// io.ReaderFrom interface. ReadFrom returns the number of bytes read
+// and an error, if any. It panics if the receiver is a non-empty VecDense.
+//
+// See Dense.ReadFrom for the checks performed on the input.
+func (v *VecDense) ReadFrom(r io.Reader) (int64, error) {
+	if !v.IsEmpty() {
+		panic("mat: unmarshal into non-empty vector")
+	}
+
+	var header storage
+	n, err := header.unmarshalStreamFrom(r)
+	if err != nil {
+		return int64(n), err
+	}
+	if header.Cols != 1 {
+		return int64(n), ErrShape
+	}
+	vers := header.Version
+	l := header.Rows
+	header.Version = 0
+	header.Rows = 0
+	header.Cols = 0
+	if (header != storage{Form: 'G', Packing: 'F', Uplo: 'A'}) {
+		return int64(n), errWrongType
+	}
+	if l == 0 {
+		return int64(n), ErrZeroLength
+	}
+	if l < 0 {
+		return int64(n), errBadSize
+	}
+	if int64(maxLen) < l {
+		return int64(n), errTooBig
+	}
+
+	v.reuseAsNonZeroed(int(l))
+	nn, err := readElements(r, v.mat.Data, vers)
+	return int64(n) + nn, err
+}
+
+// frameWriter buffers float64 values and writes them to w in checksummed
+// frames of at most streamChunk elements.
+type frameWriter struct {
+	w   io.Writer
+	buf []byte
+	k   int
+	n   int64
+	err error
+}
+
+// write adds the elements of data to the stream.
+func (fw *frameWriter) write(data []float64) {
+	for _, v := range data {
+		fw.put(v)
+	}
+}
+
+// put adds v to the stream.
+func (fw *frameWriter) put(v float64) {
+	if fw.err != nil {
+		return
+	}
+	if fw.buf == nil {
+		fw.buf = make([]byte, 4+streamChunk*sizeFloat64+4)
+	}
+	binary.LittleEndian.PutUint64(fw.buf[4+fw.k*sizeFloat64:], math.Float64bits(v))
+	fw.k++
+	if fw.k == streamChunk {
+		fw.flush()
+	}
+}
+
+// flush writes the buffered elements as a frame.
+func (fw *frameWriter) flush() {
+	if fw.k == 0 || fw.err != nil {
+		return
+	}
+	end := 4 + fw.k*sizeFloat64
+	binary.LittleEndian.PutUint32(fw.buf, uint32(fw.k))
+	binary.LittleEndian.PutUint32(fw.buf[end:], crc32.ChecksumIEEE(fw.buf[4:end]))
+	var nn int
+	nn, fw.err = fw.w.Write(fw.buf[:end+4])
+	fw.n += int64(nn)
+	fw.k = 0
+}
+
+// close flushes any buffered elements and writes the terminating frame.
+// It returns the total number of bytes written and the first error
+// encountered.
+func (fw *frameWriter) close() (int64, error) {
+	fw.flush()
+	if fw.err != nil {
+		return fw.n, fw.err
+	}
+	var b [4]byte
+	nn, err := fw.w.Write(b[:])
+	fw.n += int64(nn)
+	return fw.n, err
+}
+
+// readElements reads len(dst) elements encoded with the given codec
+// version from r into dst. It returns the number of bytes read.
+func readElements(r io.Reader, dst []float64, vers uint32) (int64, error) {
+	var (
+		n   int64
+		buf = make([]byte, min(len(dst), streamChunk)*sizeFloat64)
+		hdr [4]byte
+	)
+	readChunk := func(dst []float64) error {
+		b := buf[:len(dst)*sizeFloat64]
+		nn, err := readFull(r, b)
+		n += int64(nn)
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return err
+		}
+		for i := range dst {
+			dst[i] = math.Float64frombits(binary.LittleEndian.Uint64(b[i*sizeFloat64:]))
+		}
+		return nil
+	}
+
+	if vers == version {
+		for len(dst) != 0 {
+			k := min(len(dst), streamChunk)
+			err := readChunk(dst[:k])
+			if err != nil {
+				return n, err
+			}
+			dst = dst[k:]
+		}
+		return n, nil
+	}
+
+	for {
+		nn, err := readFull(r, hdr[:])
+		n += int64(nn)
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return n, err
+		}
+		k := int64(binary.LittleEndian.Uint32(hdr[:]))
+		if k == 0 {
+			if len(dst) != 0 {
+				return n, errBadBuffer
+			}
+			return n, nil
+		}
+		if k > int64(len(dst)) {
+			return n, errBadBuffer
+		}
+		sum := crc32.NewIEEE()
+		for k > 0 {
+			c := min(k, streamChunk)
+			err := readChunk(dst[:c])
+			if err != nil {
+				return n, err
+			}
+			sum.Write(buf[:c*int64(sizeFloat64)])
+			dst = dst[c:]
+			k -= c
+		}
+		nn, err = readFull(r, hdr[:])
+		n += int64(nn)
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return n, err
+		}
+		if binary.LittleEndian.Uint32(hdr[:]) != sum.Sum32() {
+			return n, errChecksum
+		}
+	}
+}
+
 // storage is the internal representation of the storage format of a
 // serialised matrix.
 type storage struct {
@@ -474,6 +771,24 @@ func (s *storage) unmarshalBinaryFrom(r io.Reader) (int, error) {
 	return n, s.unmarshalBinary(buf[:n])
 }
 
+// unmarshalStreamFrom reads a header with either the binary or the
+// streaming codec version from r.
+func (s *storage) unmarshalStreamFrom(r io.Reader) (int, error) {
+	buf := make([]byte, headerSize)
+	n, err := readFull(r, buf)
+	if err != nil {
+		return n, err
+	}
+	err = binary.Read(bytes.NewReader(buf), binary.LittleEndian, s)
+	if err != nil {
+		return n, err
+	}
+	if s.Version != version && s.Version != streamVersion {
+		return n, fmt.Errorf("mat: incorrect version: %d", s.Version)
+	}
+	return n, nil
+}
+
 // readFull reads from r into buf until it has read len(buf).
 // It returns the number of bytes copied and an error if fewer bytes were read.
 // If an EOF happens after reading fewer than len(buf) bytes, io.ErrUnexpectedEOF is returned.
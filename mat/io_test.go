@@ -12,6 +12,8 @@ import (
 	"math"
 	"testing"
 
+	"golang.org/x/exp/rand"
+
 	"gonum.org/v1/gonum/blas/blas64"
 )
 
@@ -20,6 +22,11 @@ var (
 	_ encoding.BinaryUnmarshaler = (*Dense)(nil)
 	_ encoding.BinaryMarshaler   = (*VecDense)(nil)
 	_ encoding.BinaryUnmarshaler = (*VecDense)(nil)
+
+	_ io.WriterTo   = Dense{}
+	_ io.ReaderFrom = (*Dense)(nil)
+	_ io.WriterTo   = VecDense{}
+	_ io.ReaderFrom = (*VecDense)(nil)
 )
 
 var sizeInt64 = binary.Size(int64(0))
@@ -581,6 +588,179 @@ func TestVecDenseIORoundTrip(t *testing.T) {
 	}
 }
 
+func TestDenseStreamRoundTrip(t *testing.T) {
+	t.Parallel()
+	for i, test := range denseData {
+		var buf bytes.Buffer
+		n, err := test.want.WriteTo(&buf)
+		if err != nil {
+			t.Errorf("error encoding test #%d: %v\n", i, err)
+		}
+		if n != int64(buf.Len()) {
+			t.Errorf("unexpected number of bytes written for test #%d: got:%d want:%d", i, n, buf.Len())
+		}
+		size := buf.Len()
+
+		var got Dense
+		n, err = got.ReadFrom(&buf)
+		if err != nil {
+			if err != test.err {
+				t.Errorf("error decoding test #%d: %v\n", i, err)
+			}
+			continue
+		}
+		if n != int64(size) {
+			t.Errorf("unexpected number of bytes read for test #%d: got:%d want:%d", i, n, size)
+		}
+		if !test.eq(&got, test.want) {
+			t.Errorf("r/w test #%d failed\n got=%#v\nwant=%#v\n", i, &got, test.want)
+		}
+
+		// ReadFrom also accepts the unchunked encoding.
+		var v1 Dense
+		_, err = v1.ReadFrom(bytes.NewReader(test.raw))
+		if err != nil {
+			t.Errorf("error decoding unchunked test #%d: %v\n", i, err)
+		}
+		if !test.eq(&v1, test.want) {
+			t.Errorf("unchunked test #%d failed\n got=%#v\nwant=%#v\n", i, &v1, test.want)
+		}
+	}
+
+	// Check a matrix spanning several frames.
+	rnd := rand.New(rand.NewSource(1))
+	const r, c = 301, 517
+	want := NewDense(r, c, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			want.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	for _, m := range []*Dense{want, want.Slice(3, r, 5, c-7).(*Dense)} {
+		var buf bytes.Buffer
+		_, err := m.WriteTo(&buf)
+		if err != nil {
+			t.Fatalf("error encoding large matrix: %v", err)
+		}
+		var got Dense
+		_, err = got.ReadFrom(&buf)
+		if err != nil {
+			t.Fatalf("error decoding large matrix: %v", err)
+		}
+		if !Equal(&got, m) {
+			t.Errorf("large matrix round trip failed")
+		}
+	}
+}
+
+func TestDenseReadFromError(t *testing.T) {
+	t.Parallel()
+	m := NewDense(3, 4, []float64{
+		1, 2, 3, 4,
+		5, 6, 7, 8,
+		9, 10, 11, 12,
+	})
+	var buf bytes.Buffer
+	_, err := m.WriteTo(&buf)
+	if err != nil {
+		t.Fatalf("error encoding: %v", err)
+	}
+	enc := buf.Bytes()
+
+	corrupt := append([]byte(nil), enc...)
+	corrupt[headerSize+4+3] ^= 0xff
+
+	short := append([]byte(nil), enc...)
+	binary.LittleEndian.PutUint32(short[headerSize:], 2)
+
+	long := append([]byte(nil), enc...)
+	binary.LittleEndian.PutUint32(long[headerSize:], 13)
+
+	badVersion := append([]byte(nil), enc...)
+	binary.LittleEndian.PutUint32(badVersion, 3)
+
+	for i, test := range []struct {
+		data []byte
+		want error
+	}{
+		{data: enc[:len(enc)-4], want: io.ErrUnexpectedEOF},
+		{data: enc[:headerSize+10], want: io.ErrUnexpectedEOF},
+		{data: enc[:headerSize], want: io.ErrUnexpectedEOF},
+		{data: corrupt, want: errChecksum},
+		{data: short, want: errChecksum},
+		{data: long, want: errBadBuffer},
+	} {
+		var got Dense
+		_, err := got.ReadFrom(bytes.NewReader(test.data))
+		if err != test.want {
+			t.Errorf("unexpected error for test %d: got:%v want:%v", i, err, test.want)
+		}
+	}
+
+	var got Dense
+	_, err = got.ReadFrom(bytes.NewReader(badVersion))
+	if err == nil {
+		t.Errorf("expected error for incorrect version")
+	}
+}
+
+func TestVecDenseStreamRoundTrip(t *testing.T) {
+	t.Parallel()
+	for i, test := range vectorData {
+		var buf bytes.Buffer
+		n, err := test.want.WriteTo(&buf)
+		if err != nil {
+			t.Errorf("error encoding test #%d: %v\n", i, err)
+		}
+		if n != int64(buf.Len()) {
+			t.Errorf("unexpected number of bytes written for test #%d: got:%d want:%d", i, n, buf.Len())
+		}
+
+		var got VecDense
+		_, err = got.ReadFrom(&buf)
+		if err != nil {
+			if err != test.err {
+				t.Errorf("error decoding test #%d: %v\n", i, err)
+			}
+			continue
+		}
+		if !test.eq(&got, test.want) {
+			t.Errorf("r/w test #%d failed\n got=%#v\nwant=%#v\n", i, &got, test.want)
+		}
+
+		var v1 VecDense
+		_, err = v1.ReadFrom(bytes.NewReader(test.raw))
+		if err != nil {
+			t.Errorf("error decoding unchunked test #%d: %v\n", i, err)
+		}
+		if !test.eq(&v1, test.want) {
+			t.Errorf("unchunked test #%d failed\n got=%#v\nwant=%#v\n", i, &v1, test.want)
+		}
+	}
+
+	// Check a strided vector spanning several frames.
+	rnd := rand.New(rand.NewSource(1))
+	const n = 2*streamChunk + 17
+	data := make([]float64, 2*n)
+	for i := range data {
+		data[i] = rnd.NormFloat64()
+	}
+	want := NewDense(n, 2, data).ColView(1)
+	var buf bytes.Buffer
+	_, err := want.(*VecDense).WriteTo(&buf)
+	if err != nil {
+		t.Fatalf("error encoding large vector: %v", err)
+	}
+	var got VecDense
+	_, err = got.ReadFrom(&buf)
+	if err != nil {
+		t.Fatalf("error decoding large vector: %v", err)
+	}
+	if !Equal(&got, want) {
+		t.Errorf("large vector round trip failed")
+	}
+}
+
 func BenchmarkMarshalDense10(b *testing.B)    { marshalBinaryBenchDense(b, 10) }
 func BenchmarkMarshalDense100(b *testing.B)   { marshalBinaryBenchDense(b, 100) }
 func BenchmarkMarshalDense1000(b *testing.B)  { marshalBinaryBenchDense(b, 1000) }
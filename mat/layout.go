@@ -0,0 +1,263 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Storage is the scheme used to store the elements of a matrix.
+type Storage int
+
+const (
+	// UnknownStorage is used for matrices whose elements
+	// are only available through their At method.
+	UnknownStorage Storage = iota
+	// GeneralStorage is row-major storage of all elements.
+	GeneralStorage
+	// SymmetricStorage is row-major storage of the upper
+	// or lower triangle of a symmetric matrix.
+	SymmetricStorage
+	// TriangularStorage is row-major storage of a triangular matrix.
+	TriangularStorage
+	// BandStorage is row-major band storage.
+	BandStorage
+	// SymmetricBandStorage is row-major band storage of the upper
+	// or lower triangle of a symmetric band matrix.
+	SymmetricBandStorage
+	// TriangularBandStorage is row-major band storage of a
+	// triangular band matrix.
+	TriangularBandStorage
+	// DiagonalStorage is storage of the diagonal elements.
+	DiagonalStorage
+	// TridiagonalStorage is storage of the three diagonals
+	// of a tridiagonal matrix in separate slices.
+	TridiagonalStorage
+	// VectorStorage is strided storage of the elements of a vector.
+	VectorStorage
+	// CompressedSparseRowStorage is compressed sparse row storage.
+	CompressedSparseRowStorage
+)
+
+var storageNames = [...]string{
+	UnknownStorage:             "unknown",
+	GeneralStorage:             "general",
+	SymmetricStorage:           "symmetric",
+	TriangularStorage:          "triangular",
+	BandStorage:                "band",
+	SymmetricBandStorage:       "symmetric band",
+	TriangularBandStorage:      "triangular band",
+	DiagonalStorage:            "diagonal",
+	TridiagonalStorage:         "tridiagonal",
+	VectorStorage:              "vector",
+	CompressedSparseRowStorage: "compressed sparse row",
+}
+
+func (s Storage) String() string {
+	if s < 0 || int(s) >= len(storageNames) {
+		return "Storage(" + strconv.Itoa(int(s)) + ")"
+	}
+	return storageNames[s]
+}
+
+// Layout describes the storage of the elements of a matrix.
+type Layout struct {
+	// Type is the Go type of the matrix.
+	Type string
+
+	// Rows and Cols are the dimensions of the matrix.
+	Rows, Cols int
+
+	// Storage is the storage scheme of the stored matrix.
+	Storage Storage
+
+	// Transposed is whether the matrix is an implicit
+	// transpose of the stored matrix.
+	Transposed bool
+
+	// Stride is the number of elements between the starts of
+	// consecutive rows of the stored matrix, or the increment
+	// between elements of a vector. Stride is zero for storage
+	// schemes without a stride.
+	Stride int
+
+	// Len is the number of elements in the storage slices
+	// of the matrix and Cap is the number of elements in the
+	// backing arrays from the start of those slices.
+	Len, Cap int
+
+	// Bytes is the size of the backing storage of the matrix
+	// as returned by SizeOf.
+	Bytes int64
+}
+
+// String returns a one line description of the layout.
+func (l Layout) String() string {
+	s := fmt.Sprintf("%s %d×%d %v storage", l.Type, l.Rows, l.Cols, l.Storage)
+	if l.Transposed {
+		s += " transposed"
+	}
+	if l.Stride != 0 {
+		s += fmt.Sprintf(" stride=%d", l.Stride)
+	}
+	return s + fmt.Sprintf(" len=%d cap=%d bytes=%d", l.Len, l.Cap, l.Bytes)
+}
+
+// Describe returns a description of the storage of a. The description is
+// a snapshot; it is not updated when a changes.
+func Describe(a Matrix) Layout {
+	r, c := a.Dims()
+	s := storageOf(a)
+	l := Layout{
+		Type:       fmt.Sprintf("%T", a),
+		Rows:       r,
+		Cols:       c,
+		Storage:    s.kind,
+		Transposed: s.trans,
+		Stride:     s.stride,
+		Bytes:      s.bytes(),
+	}
+	for _, d := range s.f64 {
+		l.Len += len(d)
+		l.Cap += cap(d)
+	}
+	for _, d := range s.f32 {
+		l.Len += len(d)
+		l.Cap += cap(d)
+	}
+	for _, d := range s.ints {
+		l.Len += len(d)
+		l.Cap += cap(d)
+	}
+	return l
+}
+
+// SizeOf returns the size in bytes of the backing storage held by a. The
+// size counts the capacity of the backing arrays from the start of each
+// storage slice of a, so the size of a view includes the elements of the
+// matrix it was sliced from that follow it in memory and that are kept
+// alive by the view. SizeOf returns zero for matrices whose storage is not
+// known.
+func SizeOf(a Matrix) int64 {
+	s := storageOf(a)
+	return s.bytes()
+}
+
+// SharesStorage returns whether the backing storage of a and b overlaps, so
+// that changes to the elements of one may be visible through the other, for
+// example when one is a view of the other. Matrices whose storage is not
+// known are reported as not sharing storage.
+func SharesStorage(a, b Matrix) bool {
+	sa := storageOf(a)
+	sb := storageOf(b)
+	for _, x := range sa.f64 {
+		for _, y := range sb.f64 {
+			if cap(x) != 0 && cap(y) != 0 && overlaps(offset(x[:1], y[:1]), cap(x), cap(y)) {
+				return true
+			}
+		}
+	}
+	for _, x := range sa.f32 {
+		for _, y := range sb.f32 {
+			if cap(x) != 0 && cap(y) != 0 && overlaps(offsetFloat32(x[:1], y[:1]), cap(x), cap(y)) {
+				return true
+			}
+		}
+	}
+	for _, x := range sa.ints {
+		for _, y := range sb.ints {
+			if cap(x) != 0 && cap(y) != 0 && overlaps(offsetInt(x[:1], y[:1]), cap(x), cap(y)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// overlaps returns whether the ranges [0, capA) and [off, off+capB) overlap.
+func overlaps(off, capA, capB int) bool {
+	if off >= 0 {
+		return off < capA
+	}
+	return -off < capB
+}
+
+// storageSlices holds the storage slices of a matrix.
+type storageSlices struct {
+	kind   Storage
+	trans  bool
+	stride int
+
+	f64  [][]float64
+	f32  [][]float32
+	ints [][]int
+}
+
+func (s storageSlices) bytes() int64 {
+	const (
+		sizeFloat64 = 8
+		sizeFloat32 = 4
+		sizeInt     = 4 << (^uint(0) >> 63)
+	)
+	var n int64
+	for _, d := range s.f64 {
+		n += int64(cap(d)) * sizeFloat64
+	}
+	for _, d := range s.f32 {
+		n += int64(cap(d)) * sizeFloat32
+	}
+	for _, d := range s.ints {
+		n += int64(cap(d)) * sizeInt
+	}
+	return n
+}
+
+// storageOf returns the storage of a, following any implicit transposes.
+func storageOf(a Matrix) storageSlices {
+	var trans bool
+	for {
+		u, ok := a.(Untransposer)
+		if !ok {
+			break
+		}
+		a = u.Untranspose()
+		trans = !trans
+	}
+	s := storageSlices{trans: trans}
+	switch a := a.(type) {
+	case *Dense32:
+		raw := a.RawMatrix()
+		s.kind, s.stride, s.f32 = GeneralStorage, raw.Stride, [][]float32{raw.Data}
+	case *DiagDense:
+		s.kind, s.stride, s.f64 = DiagonalStorage, a.mat.Inc, [][]float64{a.mat.Data}
+	case *Tridiag:
+		s.kind, s.f64 = TridiagonalStorage, [][]float64{a.mat.DL, a.mat.D, a.mat.DU}
+	case *CSR:
+		s.kind, s.f64, s.ints = CompressedSparseRowStorage, [][]float64{a.data}, [][]int{a.indptr, a.ind}
+	case RawMatrixer:
+		raw := a.RawMatrix()
+		s.kind, s.stride, s.f64 = GeneralStorage, raw.Stride, [][]float64{raw.Data}
+	case RawSymmetricer:
+		raw := a.RawSymmetric()
+		s.kind, s.stride, s.f64 = SymmetricStorage, raw.Stride, [][]float64{raw.Data}
+	case RawTriangular:
+		raw := a.RawTriangular()
+		s.kind, s.stride, s.f64 = TriangularStorage, raw.Stride, [][]float64{raw.Data}
+	case RawBander:
+		raw := a.RawBand()
+		s.kind, s.stride, s.f64 = BandStorage, raw.Stride, [][]float64{raw.Data}
+	case RawSymBander:
+		raw := a.RawSymBand()
+		s.kind, s.stride, s.f64 = SymmetricBandStorage, raw.Stride, [][]float64{raw.Data}
+	case RawTriBander:
+		raw := a.RawTriBand()
+		s.kind, s.stride, s.f64 = TriangularBandStorage, raw.Stride, [][]float64{raw.Data}
+	case RawVectorer:
+		raw := a.RawVector()
+		s.kind, s.stride, s.f64 = VectorStorage, raw.Inc, [][]float64{raw.Data}
+	}
+	return s
+}
@@ -0,0 +1,148 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestDescribe(t *testing.T) {
+	t.Parallel()
+	const intSize = int64(unsafe.Sizeof(int(0)))
+
+	dense := NewDense(4, 5, nil)
+	for _, test := range []struct {
+		a    Matrix
+		want Layout
+	}{
+		{
+			a:    dense,
+			want: Layout{Type: "*mat.Dense", Rows: 4, Cols: 5, Storage: GeneralStorage, Stride: 5, Len: 20, Cap: 20, Bytes: 160},
+		},
+		{
+			a:    dense.T(),
+			want: Layout{Type: "mat.Transpose", Rows: 5, Cols: 4, Storage: GeneralStorage, Transposed: true, Stride: 5, Len: 20, Cap: 20, Bytes: 160},
+		},
+		{
+			a:    Transpose{Transpose{dense}},
+			want: Layout{Type: "mat.Transpose", Rows: 4, Cols: 5, Storage: GeneralStorage, Stride: 5, Len: 20, Cap: 20, Bytes: 160},
+		},
+		{
+			// The view retains the rest of the backing array.
+			a:    dense.Slice(1, 3, 1, 3),
+			want: Layout{Type: "*mat.Dense", Rows: 2, Cols: 2, Storage: GeneralStorage, Stride: 5, Len: 7, Cap: 14, Bytes: 112},
+		},
+		{
+			a:    dense.ColView(2),
+			want: Layout{Type: "*mat.VecDense", Rows: 4, Cols: 1, Storage: VectorStorage, Stride: 5, Len: 16, Cap: 18, Bytes: 144},
+		},
+		{
+			a:    NewDense32(2, 3, nil),
+			want: Layout{Type: "*mat.Dense32", Rows: 2, Cols: 3, Storage: GeneralStorage, Stride: 3, Len: 6, Cap: 6, Bytes: 24},
+		},
+		{
+			a:    NewSymDense(3, nil),
+			want: Layout{Type: "*mat.SymDense", Rows: 3, Cols: 3, Storage: SymmetricStorage, Stride: 3, Len: 9, Cap: 9, Bytes: 72},
+		},
+		{
+			a:    NewTriDense(3, Lower, nil),
+			want: Layout{Type: "*mat.TriDense", Rows: 3, Cols: 3, Storage: TriangularStorage, Stride: 3, Len: 9, Cap: 9, Bytes: 72},
+		},
+		{
+			a:    NewBandDense(4, 4, 1, 0, nil),
+			want: Layout{Type: "*mat.BandDense", Rows: 4, Cols: 4, Storage: BandStorage, Stride: 2, Len: 8, Cap: 8, Bytes: 64},
+		},
+		{
+			a:    NewSymBandDense(4, 1, nil),
+			want: Layout{Type: "*mat.SymBandDense", Rows: 4, Cols: 4, Storage: SymmetricBandStorage, Stride: 2, Len: 8, Cap: 8, Bytes: 64},
+		},
+		{
+			a:    NewTriBandDense(4, 1, Upper, nil),
+			want: Layout{Type: "*mat.TriBandDense", Rows: 4, Cols: 4, Storage: TriangularBandStorage, Stride: 2, Len: 8, Cap: 8, Bytes: 64},
+		},
+		{
+			a:    NewDiagDense(3, nil),
+			want: Layout{Type: "*mat.DiagDense", Rows: 3, Cols: 3, Storage: DiagonalStorage, Stride: 1, Len: 3, Cap: 3, Bytes: 24},
+		},
+		{
+			a:    NewTridiag(3, nil, nil, nil),
+			want: Layout{Type: "*mat.Tridiag", Rows: 3, Cols: 3, Storage: TridiagonalStorage, Len: 7, Cap: 7, Bytes: 56},
+		},
+		{
+			a:    NewCSR(2, 3, []int{0, 1, 2}, []int{0, 2}, []float64{1, 2}),
+			want: Layout{Type: "*mat.CSR", Rows: 2, Cols: 3, Storage: CompressedSparseRowStorage, Len: 7, Cap: 7, Bytes: 16 + 5*intSize},
+		},
+		{
+			a:    &Dense{},
+			want: Layout{Type: "*mat.Dense", Storage: GeneralStorage},
+		},
+		{
+			a:    &basicMatrix{},
+			want: Layout{Type: "*mat.basicMatrix", Storage: UnknownStorage},
+		},
+	} {
+		got := Describe(test.a)
+		if got != test.want {
+			t.Errorf("unexpected layout:\ngot: %+v\nwant:%+v", got, test.want)
+		}
+		if size := SizeOf(test.a); size != test.want.Bytes {
+			t.Errorf("unexpected size for %s: got:%d want:%d", test.want.Type, size, test.want.Bytes)
+		}
+	}
+
+	got := Describe(dense.T()).String()
+	want := "mat.Transpose 5×4 general storage transposed stride=5 len=20 cap=20 bytes=160"
+	if got != want {
+		t.Errorf("unexpected string:\ngot: %q\nwant:%q", got, want)
+	}
+}
+
+func TestSharesStorage(t *testing.T) {
+	t.Parallel()
+	a := NewDense(4, 5, nil)
+	b := NewDense(4, 5, nil)
+	topLeft := a.Slice(0, 2, 0, 2)
+	bottomRight := a.Slice(2, 4, 3, 5)
+	sym := NewSymDense(4, nil)
+
+	for _, test := range []struct {
+		name string
+		a, b Matrix
+		want bool
+	}{
+		{name: "self", a: a, b: a, want: true},
+		{name: "transpose", a: a, b: a.T(), want: true},
+		{name: "view", a: a, b: topLeft, want: true},
+		{name: "view of view", a: topLeft, b: a.Slice(1, 2, 1, 2), want: true},
+		{name: "disjoint views of same array", a: bottomRight, b: topLeft, want: true},
+		{name: "row view", a: a.RowView(3), b: a, want: true},
+		{name: "copy", a: a, b: DenseCopyOf(a), want: false},
+		{name: "distinct", a: a, b: b, want: false},
+		{name: "symmetric view of dense", a: NewSymDense(4, b.RawMatrix().Data[:16]), b: b, want: true},
+		{name: "symmetric copy", a: sym, b: DenseCopyOf(sym), want: false},
+		{name: "unknown", a: &basicMatrix{}, b: a, want: false},
+		{name: "empty", a: &Dense{}, b: a, want: false},
+	} {
+		if got := SharesStorage(test.a, test.b); got != test.want {
+			t.Errorf("%s: unexpected result: got:%t want:%t", test.name, got, test.want)
+		}
+		if got := SharesStorage(test.b, test.a); got != test.want {
+			t.Errorf("%s: unexpected result for reversed arguments: got:%t want:%t", test.name, got, test.want)
+		}
+	}
+
+	// The slices of a CSR are distinct from each other
+	// and from the slices of a copy.
+	c := NewCSR(2, 2, []int{0, 1, 2}, []int{0, 1}, []float64{1, 2})
+	if !SharesStorage(c, c) {
+		t.Errorf("CSR does not share storage with itself")
+	}
+	var d CSR
+	d.CloneFrom(c)
+	if SharesStorage(c, &d) {
+		t.Errorf("CSR shares storage with its clone")
+	}
+}
@@ -0,0 +1,310 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+const badLDL = "mat: invalid LDLᵀ factorization"
+
+// LDL is a symmetric n×n matrix represented by its LDLᵀ factorization with
+// Bunch-Kaufman diagonal pivoting.
+//
+// The factorization has the form
+//
+//	A = U * D * Uᵀ
+//
+// where U is a product of permutation and unit upper triangular matrices, and
+// D is symmetric and block diagonal with 1×1 and 2×2 diagonal blocks.
+//
+// Unlike the Cholesky factorization, the LDLᵀ factorization exists for
+// symmetric indefinite matrices such as the saddle-point matrices arising in
+// constrained optimization, and unlike the LU factorization it preserves
+// symmetry, requiring half the storage and work.
+type LDL struct {
+	ldl  *SymDense
+	ipiv []int
+	cond float64
+	ok   bool // Whether A is nonsingular
+}
+
+// updateCond updates the stored condition number of the matrix. anorm is the
+// 1-norm of the original matrix.
+func (ldl *LDL) updateCond(anorm float64) {
+	n := ldl.ldl.mat.N
+	work := getFloat64s(2*n, false)
+	defer putFloat64s(work)
+	iwork := getInts(n, false)
+	defer putInts(iwork)
+	v := lapack64.Sycon(ldl.ldl.mat, ldl.ipiv, anorm, work, iwork)
+	ldl.cond = 1 / v
+}
+
+// Factorize computes the LDLᵀ factorization of the symmetric matrix A and
+// stores the result in the receiver. The factorization will complete
+// regardless of the singularity of a, and Factorize returns whether A is
+// nonsingular. If A is singular, the factorization may still be used to
+// compute the determinant and inertia of A, but not to solve systems of
+// equations.
+func (ldl *LDL) Factorize(a Symmetric) (ok bool) {
+	n := a.SymmetricDim()
+	if ldl.ldl == nil {
+		ldl.ldl = NewSymDense(n, nil)
+	} else {
+		ldl.ldl.Reset()
+		ldl.ldl.reuseAsNonZeroed(n)
+	}
+	ldl.ldl.CopySym(a)
+	ldl.ipiv = useInt(ldl.ipiv, n)
+
+	work := getFloat64s(n, false)
+	anorm := lapack64.Lansy(lapack.MaxColumnSum, ldl.ldl.mat, work)
+	putFloat64s(work)
+
+	work = getFloat64s(1, false)
+	lapack64.Sytrf(ldl.ldl.mat, ldl.ipiv, work, -1)
+	lwork := int(work[0])
+	putFloat64s(work)
+	work = getFloat64s(lwork, false)
+	ldl.ok = lapack64.Sytrf(ldl.ldl.mat, ldl.ipiv, work, lwork)
+	putFloat64s(work)
+
+	if ldl.ok {
+		ldl.updateCond(anorm)
+	} else {
+		ldl.cond = math.Inf(1)
+	}
+	return ldl.ok
+}
+
+// isValid returns whether the receiver contains a factorization.
+func (ldl *LDL) isValid() bool {
+	return ldl.ldl != nil && !ldl.ldl.IsEmpty()
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+func (ldl *LDL) Reset() {
+	if ldl.ldl != nil {
+		ldl.ldl.Reset()
+	}
+	ldl.ipiv = ldl.ipiv[:0]
+	ldl.ok = false
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for size-restricted operations. The receiver can be emptied using
+// Reset.
+func (ldl *LDL) IsEmpty() bool {
+	return ldl.ldl == nil || ldl.ldl.IsEmpty()
+}
+
+// SymmetricDim returns the number of rows and columns of the factorized matrix.
+func (ldl *LDL) SymmetricDim() int {
+	if ldl.ldl == nil {
+		return 0
+	}
+	return ldl.ldl.SymmetricDim()
+}
+
+// Cond returns the condition number for the factorized matrix.
+// Cond will panic if the receiver does not contain a factorization.
+func (ldl *LDL) Cond() float64 {
+	if !ldl.isValid() {
+		panic(badLDL)
+	}
+	return ldl.cond
+}
+
+// blocks calls fn for each diagonal block of D with the block's first index,
+// its size and its elements d00, d01 and d11. For 1×1 blocks d01 and d11 are
+// zero.
+func (ldl *LDL) blocks(fn func(k, size int, d00, d01, d11 float64)) {
+	n := ldl.ldl.mat.N
+	for k := n - 1; k >= 0; {
+		if ldl.ipiv[k] >= 0 {
+			fn(k, 1, ldl.ldl.at(k, k), 0, 0)
+			k--
+			continue
+		}
+		fn(k-1, 2, ldl.ldl.at(k-1, k-1), ldl.ldl.at(k-1, k), ldl.ldl.at(k, k))
+		k -= 2
+	}
+}
+
+// Det returns the determinant of the matrix that has been factorized. In many
+// expressions, using LogDet will be more numerically stable.
+// Det will panic if the receiver does not contain a factorization.
+func (ldl *LDL) Det() float64 {
+	det, sign := ldl.LogDet()
+	return math.Exp(det) * sign
+}
+
+// LogDet returns the log of the determinant and the sign of the determinant
+// for the matrix that has been factorized. Numerical stability in product and
+// division expressions is generally improved by working in log space.
+// LogDet will panic if the receiver does not contain a factorization.
+func (ldl *LDL) LogDet() (det float64, sign float64) {
+	if !ldl.isValid() {
+		panic(badLDL)
+	}
+	sign = 1
+	ldl.blocks(func(_, size int, d00, d01, d11 float64) {
+		v := d00
+		if size == 2 {
+			v = d00*d11 - d01*d01
+		}
+		if v < 0 {
+			sign = -sign
+		}
+		det += math.Log(math.Abs(v))
+	})
+	if math.IsInf(det, -1) {
+		sign = 0
+	}
+	return det, sign
+}
+
+// Inertia returns the inertia of the factorized matrix, the numbers of its
+// positive, negative and zero eigenvalues. By Sylvester's law of inertia
+// these are the same as those of the block diagonal matrix D.
+// Inertia will panic if the receiver does not contain a factorization.
+func (ldl *LDL) Inertia() (pos, neg, zero int) {
+	if !ldl.isValid() {
+		panic(badLDL)
+	}
+	count := func(v float64) {
+		switch {
+		case v > 0:
+			pos++
+		case v < 0:
+			neg++
+		default:
+			zero++
+		}
+	}
+	ldl.blocks(func(_, size int, d00, d01, d11 float64) {
+		if size == 1 {
+			count(d00)
+			return
+		}
+		// The signs of the eigenvalues of a symmetric 2×2 block
+		// are determined by its determinant and trace.
+		switch det := d00*d11 - d01*d01; {
+		case det < 0:
+			pos++
+			neg++
+		case det > 0:
+			count(d00 + d11)
+			count(d00 + d11)
+		default:
+			zero++
+			count(d00 + d11)
+		}
+	})
+	return pos, neg, zero
+}
+
+// SolveTo solves a system of linear equations
+//
+//	A * X = B
+//
+// using the LDLᵀ factorization of A stored in the receiver. The solution
+// matrix X is stored into dst.
+//
+// If A is singular or near-singular a Condition error is returned. See the
+// documentation for Condition for more information. SolveTo will panic if the
+// receiver does not contain a factorization.
+func (ldl *LDL) SolveTo(dst *Dense, b Matrix) error {
+	if !ldl.isValid() {
+		panic(badLDL)
+	}
+
+	n := ldl.ldl.mat.N
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+
+	if !ldl.ok {
+		return Condition(math.Inf(1))
+	}
+
+	dst.reuseAsNonZeroed(n, bc)
+	bU, _ := untranspose(b)
+	if dst == bU {
+		var restore func()
+		dst, restore = dst.isolatedWorkspace(bU)
+		defer restore()
+	} else if rm, ok := bU.(RawMatrixer); ok {
+		dst.checkOverlap(rm.RawMatrix())
+	}
+
+	dst.Copy(b)
+	lapack64.Sytrs(ldl.ldl.mat, ldl.ipiv, dst.mat)
+	if ldl.cond > ConditionTolerance {
+		return Condition(ldl.cond)
+	}
+	return nil
+}
+
+// SolveVecTo solves a system of linear equations
+//
+//	A * x = b
+//
+// using the LDLᵀ factorization of A stored in the receiver. The solution
+// vector x is stored into dst.
+//
+// If A is singular or near-singular a Condition error is returned. See the
+// documentation for Condition for more information. SolveVecTo will panic if
+// the receiver does not contain a factorization.
+func (ldl *LDL) SolveVecTo(dst *VecDense, b Vector) error {
+	if !ldl.isValid() {
+		panic(badLDL)
+	}
+
+	n := ldl.ldl.mat.N
+	if br, bc := b.Dims(); br != n || bc != 1 {
+		panic(ErrShape)
+	}
+
+	switch rv := b.(type) {
+	default:
+		dst.reuseAsNonZeroed(n)
+		return ldl.SolveTo(dst.asDense(), b)
+	case RawVectorer:
+		if dst != b {
+			dst.checkOverlap(rv.RawVector())
+		}
+
+		if !ldl.ok {
+			return Condition(math.Inf(1))
+		}
+
+		dst.reuseAsNonZeroed(n)
+		var restore func()
+		if dst == b {
+			dst, restore = dst.isolatedWorkspace(b)
+			defer restore()
+		}
+		dst.CopyVec(b)
+		vMat := blas64.General{
+			Rows:   n,
+			Cols:   1,
+			Stride: dst.mat.Inc,
+			Data:   dst.mat.Data,
+		}
+		lapack64.Sytrs(ldl.ldl.mat, ldl.ipiv, vMat)
+		if ldl.cond > ConditionTolerance {
+			return Condition(ldl.cond)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// randSymIndefinite returns a random n×n symmetric matrix with
+// eigenvalues of both signs.
+func randSymIndefinite(n int, rnd *rand.Rand) *SymDense {
+	a := NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			a.SetSym(i, j, rnd.NormFloat64())
+		}
+	}
+	return a
+}
+
+// kkt returns the saddle-point matrix [H Bᵀ; B 0] with a random
+// positive definite h×h block H and a random m×h block B.
+func kkt(h, m int, rnd *rand.Rand) *SymDense {
+	n := h + m
+	a := NewSymDense(n, nil)
+	for i := 0; i < h; i++ {
+		a.SetSym(i, i, float64(h)+rnd.Float64())
+		for j := i + 1; j < h; j++ {
+			a.SetSym(i, j, rnd.Float64())
+		}
+	}
+	for i := h; i < n; i++ {
+		for j := 0; j < h; j++ {
+			a.SetSym(i, j, rnd.NormFloat64())
+		}
+	}
+	return a
+}
+
+func TestLDL(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		name string
+		a    *SymDense
+	}{
+		{name: "random 1", a: randSymIndefinite(1, rnd)},
+		{name: "random 2", a: randSymIndefinite(2, rnd)},
+		{name: "random 5", a: randSymIndefinite(5, rnd)},
+		{name: "random 20", a: randSymIndefinite(20, rnd)},
+		{name: "kkt 3 1", a: kkt(3, 1, rnd)},
+		{name: "kkt 10 4", a: kkt(10, 4, rnd)},
+		{name: "kkt 30 10", a: kkt(30, 10, rnd)},
+		{name: "zero diagonal", a: NewSymDense(4, []float64{
+			0, 1, 2, 3,
+			1, 0, 4, 5,
+			2, 4, 0, 6,
+			3, 5, 6, 0,
+		})},
+	} {
+		n := test.a.SymmetricDim()
+
+		var ldl LDL
+		if !ldl.Factorize(test.a) {
+			t.Errorf("%s: unexpected singular factorization", test.name)
+			continue
+		}
+		if ldl.SymmetricDim() != n {
+			t.Errorf("%s: unexpected dimension: got:%d want:%d", test.name, ldl.SymmetricDim(), n)
+		}
+
+		// Compare the solution with A*X = B.
+		b := NewDense(n, 3, nil)
+		for i := 0; i < n; i++ {
+			for j := 0; j < 3; j++ {
+				b.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		var x Dense
+		err := ldl.SolveTo(&x, b)
+		if err != nil {
+			t.Errorf("%s: unexpected error from SolveTo: %v", test.name, err)
+		}
+		var ax Dense
+		ax.Mul(test.a, &x)
+		if !EqualApprox(&ax, b, tol) {
+			t.Errorf("%s: A*X != B", test.name)
+		}
+
+		var xv VecDense
+		bv := b.ColView(1)
+		err = ldl.SolveVecTo(&xv, bv)
+		if err != nil {
+			t.Errorf("%s: unexpected error from SolveVecTo: %v", test.name, err)
+		}
+		if !EqualApprox(&xv, x.ColView(1), tol) {
+			t.Errorf("%s: mismatch between SolveVecTo and SolveTo", test.name)
+		}
+
+		// Compare the determinant with the LU factorization.
+		var lu LU
+		lu.Factorize(test.a)
+		if got, want := ldl.Det(), lu.Det(); !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+			t.Errorf("%s: unexpected determinant: got:%v want:%v", test.name, got, want)
+		}
+		logDet, sign := ldl.LogDet()
+		wantLogDet, wantSign := lu.LogDet()
+		if !scalar.EqualWithinAbsOrRel(logDet, wantLogDet, tol, tol) || sign != wantSign {
+			t.Errorf("%s: unexpected log determinant: got:(%v,%v) want:(%v,%v)", test.name, logDet, sign, wantLogDet, wantSign)
+		}
+
+		// Compare the inertia with the eigenvalues.
+		var eig EigenSym
+		if !eig.Factorize(test.a, false) {
+			t.Fatalf("%s: bad test", test.name)
+		}
+		var wantPos, wantNeg int
+		for _, v := range eig.Values(nil) {
+			if v > 0 {
+				wantPos++
+			} else {
+				wantNeg++
+			}
+		}
+		pos, neg, zero := ldl.Inertia()
+		if pos != wantPos || neg != wantNeg || zero != 0 {
+			t.Errorf("%s: unexpected inertia: got:(%d,%d,%d) want:(%d,%d,0)", test.name, pos, neg, zero, wantPos, wantNeg)
+		}
+
+		// Compare the condition number with the LU factorization.
+		// Both are 1-norm estimates.
+		if got, want := ldl.Cond(), lu.Cond(); got < want/10 || got > want*10 {
+			t.Errorf("%s: unexpected condition number: got:%v want:%v", test.name, got, want)
+		}
+	}
+}
+
+func TestLDLSingular(t *testing.T) {
+	t.Parallel()
+	a := NewSymDense(3, []float64{
+		1, 2, 3,
+		2, 4, 6,
+		3, 6, 9,
+	})
+	var ldl LDL
+	if ldl.Factorize(a) {
+		t.Fatal("singular matrix not detected")
+	}
+	if det := ldl.Det(); det != 0 {
+		t.Errorf("unexpected determinant of singular matrix: got:%v want:0", det)
+	}
+	pos, neg, zero := ldl.Inertia()
+	if pos != 1 || neg != 0 || zero != 2 {
+		t.Errorf("unexpected inertia: got:(%d,%d,%d) want:(1,0,2)", pos, neg, zero)
+	}
+	if !math.IsInf(ldl.Cond(), 1) {
+		t.Errorf("unexpected condition number: got:%v want:+Inf", ldl.Cond())
+	}
+	var x Dense
+	err := ldl.SolveTo(&x, NewDense(3, 1, nil))
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("unexpected error for singular solve: %v", err)
+	}
+
+	ldl.Reset()
+	if !ldl.IsEmpty() {
+		t.Errorf("LDL not empty after Reset")
+	}
+	panicked, _ := panics(func() { ldl.Det() })
+	if !panicked {
+		t.Errorf("expected panic for empty factorization")
+	}
+}
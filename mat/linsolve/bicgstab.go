@@ -0,0 +1,139 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// BiCGSTAB implements the biconjugate gradient stabilized method for solving
+// general systems. The preconditioner is applied from the right so the
+// residual norm reported by BiCGSTAB is the norm of the recursively updated
+// residual of the original system.
+//
+// Each iteration of BiCGSTAB requires two products with the operator and two
+// preconditioner solves, and the storage does not grow with the number of
+// iterations.
+//
+// See H. A. van der Vorst, "Bi-CGSTAB: A fast and smoothly converging variant
+// of Bi-CG for the solution of nonsymmetric linear systems", SIAM J. Sci.
+// Stat. Comput. 13(2), 1992, for details.
+type BiCGSTAB struct {
+	x, r, rt, p, v, phat, s, shat mat.VecDense
+
+	rho, rhoPrev, alpha, omega float64
+	first                      bool
+
+	resume int
+}
+
+// Init initializes the method for solving the system starting from the
+// initial estimate x with the residual b - A⋅x.
+func (b *BiCGSTAB) Init(x, residual mat.Vector) {
+	n := x.Len()
+	if residual.Len() != n {
+		panic("linsolve: mismatched vector length")
+	}
+	for _, v := range []*mat.VecDense{&b.x, &b.r, &b.rt, &b.p, &b.v, &b.phat, &b.s, &b.shat} {
+		reuse(v, n)
+	}
+	b.x.CopyVec(x)
+	b.r.CopyVec(residual)
+	b.rt.CopyVec(residual)
+	b.first = true
+	b.resume = 1
+}
+
+// Iterate performs a step of the method and returns the operation to be
+// performed by the caller.
+func (b *BiCGSTAB) Iterate(ctx *Context) (Operation, error) {
+	switch b.resume {
+	case 1:
+		b.rho = mat.Dot(&b.rt, &b.r)
+		if b.rho == 0 {
+			b.resume = 0
+			return NoOperation, &BreakdownError{Value: b.rho, Tolerance: 0}
+		}
+		if b.first {
+			b.p.CopyVec(&b.r)
+			b.first = false
+		} else {
+			beta := (b.rho / b.rhoPrev) * (b.alpha / b.omega)
+			b.p.AddScaledVec(&b.p, -b.omega, &b.v)
+			b.p.AddScaledVec(&b.r, beta, &b.p)
+		}
+		// Solve M⋅p̂ = p.
+		ctx.Src.CopyVec(&b.p)
+		b.resume = 2
+		return PreconSolve, nil
+	case 2:
+		b.phat.CopyVec(ctx.Dst)
+		// Compute v = A⋅p̂.
+		ctx.Src.CopyVec(&b.phat)
+		b.resume = 3
+		return MulVec, nil
+	case 3:
+		b.v.CopyVec(ctx.Dst)
+		rtv := mat.Dot(&b.rt, &b.v)
+		if rtv == 0 {
+			b.resume = 0
+			return NoOperation, &BreakdownError{Value: rtv, Tolerance: 0}
+		}
+		b.alpha = b.rho / rtv
+		b.s.AddScaledVec(&b.r, -b.alpha, &b.v)
+		ctx.ResidualNorm = mat.Norm(&b.s, 2)
+		b.resume = 4
+		return CheckResidualNorm, nil
+	case 4:
+		if ctx.Converged {
+			// The half step has converged.
+			b.x.AddScaledVec(&b.x, b.alpha, &b.phat)
+			ctx.X.CopyVec(&b.x)
+			b.resume = 0
+			return MajorIteration, nil
+		}
+		// Solve M⋅ŝ = s.
+		ctx.Src.CopyVec(&b.s)
+		b.resume = 5
+		return PreconSolve, nil
+	case 5:
+		b.shat.CopyVec(ctx.Dst)
+		// Compute t = A⋅ŝ.
+		ctx.Src.CopyVec(&b.shat)
+		b.resume = 6
+		return MulVec, nil
+	case 6:
+		t := ctx.Dst
+		tt := mat.Dot(t, t)
+		if tt == 0 {
+			b.resume = 0
+			return NoOperation, &BreakdownError{Value: tt, Tolerance: 0}
+		}
+		b.omega = mat.Dot(t, &b.s) / tt
+		b.x.AddScaledVec(&b.x, b.alpha, &b.phat)
+		b.x.AddScaledVec(&b.x, b.omega, &b.shat)
+		b.r.AddScaledVec(&b.s, -b.omega, t)
+		ctx.ResidualNorm = mat.Norm(&b.r, 2)
+		b.resume = 7
+		return CheckResidualNorm, nil
+	case 7:
+		ctx.X.CopyVec(&b.x)
+		if b.omega == 0 && !ctx.Converged {
+			b.resume = 0
+			return NoOperation, &BreakdownError{Value: b.omega, Tolerance: 0}
+		}
+		if math.IsNaN(ctx.ResidualNorm) {
+			b.resume = 0
+			return NoOperation, &BreakdownError{Value: ctx.ResidualNorm, Tolerance: 0}
+		}
+		b.rhoPrev = b.rho
+		b.resume = 1
+		return MajorIteration, nil
+	default:
+		panic("linsolve: BiCGSTAB.Init not called")
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package linsolve provides iterative methods for solving linear systems.
+//
+// The methods are Krylov subspace methods that access the matrix of the
+// system only through matrix-vector products, so they are suited to large
+// sparse or structured systems, and to operators that are not stored as a
+// matrix at all. The system matrix is supplied as a MulVecToer, which is
+// implemented by the sparse and banded matrix types of package mat, and any
+// mat.Matrix can be adapted with Operator.
+//
+// The convergence of the methods depends on the conditioning of the system
+// and is usually improved by a Preconditioner. The package provides the
+// Jacobi, SSOR and zero fill-in incomplete LU and Cholesky preconditioners,
+// and other preconditioners can be supplied by the user.
+//
+// The methods are implemented using reverse communication; a Method requests
+// the operations it needs from the driver Iterative, which performs them and
+// tracks convergence. This allows new methods to be added without changing
+// the driver and the driver to be reused for new operators.
+package linsolve // import "gonum.org/v1/gonum/mat/linsolve"
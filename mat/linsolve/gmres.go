@@ -0,0 +1,217 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/mat"
+)
+
+// GMRES implements the restarted generalized minimum residual method for
+// solving general systems, GMRES(m). The preconditioner is applied from the
+// right so the residual norm reported by GMRES is the Euclidean norm of the
+// residual of the original system.
+//
+// Each iteration of GMRES requires one product with the operator and one
+// preconditioner solve, and the work and storage grow linearly with the
+// number of iterations since the last restart.
+//
+// See Y. Saad and M. H. Schultz, "GMRES: A generalized minimal residual
+// algorithm for solving nonsymmetric linear systems", SIAM J. Sci. Stat.
+// Comput. 7(3), 1986, for details.
+type GMRES struct {
+	// Restart is the number of iterations between restarts.
+	// If Restart is zero, min(n, 30) is used, where n is the
+	// dimension of the system.
+	Restart int
+
+	m int
+
+	x mat.VecDense
+	// v holds the orthonormal basis of the Krylov subspace.
+	v []mat.VecDense
+	// h holds the upper Hessenberg matrix of the Arnoldi
+	// relation, reduced to upper triangular form by the
+	// rotations in cs and sn.
+	h      blas64.General
+	cs, sn []float64
+	// g holds the rotated right-hand side of the least
+	// squares problem.
+	g []float64
+	// y holds the solution of the least squares problem.
+	y mat.VecDense
+
+	k int
+
+	resume int
+}
+
+// Init initializes the method for solving the system starting from the
+// initial estimate x with the residual b - A⋅x.
+func (g *GMRES) Init(x, residual mat.Vector) {
+	n := x.Len()
+	if residual.Len() != n {
+		panic("linsolve: mismatched vector length")
+	}
+	if g.Restart < 0 {
+		panic("linsolve: negative restart length")
+	}
+	g.m = g.Restart
+	if g.m == 0 {
+		g.m = min(n, 30)
+	}
+	g.m = min(g.m, n)
+
+	reuse(&g.x, n)
+	g.x.CopyVec(x)
+	if cap(g.v) < g.m+1 {
+		g.v = make([]mat.VecDense, g.m+1)
+	}
+	g.v = g.v[:g.m+1]
+	for i := range g.v {
+		reuse(&g.v[i], n)
+	}
+	g.h = blas64.General{
+		Rows:   g.m + 1,
+		Cols:   g.m,
+		Stride: g.m,
+		Data:   use(g.h.Data, (g.m+1)*g.m),
+	}
+	g.cs = use(g.cs, g.m)
+	g.sn = use(g.sn, g.m)
+	g.g = use(g.g, g.m+1)
+
+	g.v[0].CopyVec(residual)
+	g.resume = 1
+}
+
+// Iterate performs a step of the method and returns the operation to be
+// performed by the caller.
+func (g *GMRES) Iterate(ctx *Context) (Operation, error) {
+	switch g.resume {
+	case 1:
+		// Start a cycle from the residual held in v[0].
+		beta := mat.Norm(&g.v[0], 2)
+		g.v[0].ScaleVec(1/beta, &g.v[0])
+		for i := range g.g {
+			g.g[i] = 0
+		}
+		g.g[0] = beta
+		g.k = 0
+		fallthrough
+	case 2:
+		// Compute A⋅M⁻¹⋅v[k].
+		ctx.Src.CopyVec(&g.v[g.k])
+		g.resume = 3
+		return PreconSolve, nil
+	case 3:
+		ctx.Src.CopyVec(ctx.Dst)
+		g.resume = 4
+		return MulVec, nil
+	case 4:
+		k := g.k
+		w := &g.v[k+1]
+		w.CopyVec(ctx.Dst)
+
+		// Orthogonalize by modified Gram-Schmidt.
+		for i := 0; i <= k; i++ {
+			hik := mat.Dot(w, &g.v[i])
+			g.h.Data[i*g.h.Stride+k] = hik
+			w.AddScaledVec(w, -hik, &g.v[i])
+		}
+		hk1 := mat.Norm(w, 2)
+		if hk1 != 0 {
+			w.ScaleVec(1/hk1, w)
+		}
+
+		// Apply the previous rotations to the new column
+		// and eliminate the subdiagonal.
+		for i := 0; i < k; i++ {
+			a := g.h.Data[i*g.h.Stride+k]
+			b := g.h.Data[(i+1)*g.h.Stride+k]
+			g.h.Data[i*g.h.Stride+k] = g.cs[i]*a + g.sn[i]*b
+			g.h.Data[(i+1)*g.h.Stride+k] = -g.sn[i]*a + g.cs[i]*b
+		}
+		hkk := g.h.Data[k*g.h.Stride+k]
+		r := math.Hypot(hkk, hk1)
+		if r == 0 {
+			g.resume = 0
+			return NoOperation, &BreakdownError{Value: 0, Tolerance: 0}
+		}
+		g.cs[k] = hkk / r
+		g.sn[k] = hk1 / r
+		g.h.Data[k*g.h.Stride+k] = r
+		g.h.Data[(k+1)*g.h.Stride+k] = 0
+		g.g[k+1] = -g.sn[k] * g.g[k]
+		g.g[k] *= g.cs[k]
+		g.k++
+
+		ctx.ResidualNorm = math.Abs(g.g[k+1])
+		g.resume = 5
+		if hk1 == 0 {
+			// The Krylov subspace is invariant so the least
+			// squares solution is exact.
+			ctx.ResidualNorm = 0
+		}
+		return CheckResidualNorm, nil
+	case 5:
+		if !ctx.Converged && g.k < g.m {
+			g.resume = 2
+			return MajorIteration, nil
+		}
+
+		// Solve the triangular least squares system and form
+		// the update V⋅y.
+		k := g.k
+		g.y.Reset()
+		g.y.ReuseAsVec(k)
+		copy(g.y.RawVector().Data, g.g[:k])
+		blas64.Trsv(blas.NoTrans, blas64.Triangular{
+			Uplo:   blas.Upper,
+			Diag:   blas.NonUnit,
+			N:      k,
+			Stride: g.h.Stride,
+			Data:   g.h.Data,
+		}, g.y.RawVector())
+		ctx.Src.Zero()
+		for i := 0; i < k; i++ {
+			ctx.Src.AddScaledVec(ctx.Src, g.y.AtVec(i), &g.v[i])
+		}
+		g.resume = 6
+		return PreconSolve, nil
+	case 6:
+		g.x.AddVec(&g.x, ctx.Dst)
+		ctx.X.CopyVec(&g.x)
+		if ctx.Converged {
+			g.resume = 0
+			return MajorIteration, nil
+		}
+		// Restart from the true residual.
+		g.resume = 7
+		return ComputeResidual, nil
+	case 7:
+		g.v[0].CopyVec(ctx.Dst)
+		ctx.ResidualNorm = mat.Norm(&g.v[0], 2)
+		g.resume = 8
+		return CheckResidualNorm, nil
+	case 8:
+		g.resume = 1
+		return MajorIteration, nil
+	default:
+		panic("linsolve: GMRES.Init not called")
+	}
+}
+
+// use returns a float64 slice with l elements, using f if it has the
+// necessary capacity, otherwise creating a new slice.
+func use(f []float64, l int) []float64 {
+	if l <= cap(f) {
+		return f[:l]
+	}
+	return make([]float64, l)
+}
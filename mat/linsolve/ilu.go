@@ -0,0 +1,185 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// ILU0 is the incomplete LU factorization preconditioner with zero fill-in,
+// M = L⋅U, where L is unit lower triangular and U is upper triangular and
+// together they have the sparsity pattern of A.
+//
+// See Y. Saad, "Iterative Methods for Sparse Linear Systems", 2nd ed., SIAM,
+// 2003, section 10.3, for details.
+type ILU0 struct {
+	// lu holds the strictly lower part of L and U in the
+	// pattern of A, with the diagonal of U in its diagonal.
+	lu   csrTri
+	work []float64
+}
+
+// NewILU0 returns the ILU(0) preconditioner for the square sparse matrix a.
+// If a has a missing diagonal element or a zero pivot is encountered,
+// NewILU0 returns ErrZeroPivot.
+func NewILU0(a *mat.CSR) (*ILU0, error) {
+	lu, err := newCSRTri(a)
+	if err != nil {
+		return nil, err
+	}
+	n := len(lu.diag)
+
+	// diagPos holds the position of the diagonal element
+	// of each row.
+	diagPos := make([]int, n)
+	for i := range diagPos {
+		diagPos[i], _ = lu.find(i, i)
+	}
+	for i := 0; i < n; i++ {
+		for k := lu.indptr[i]; k < diagPos[i]; k++ {
+			j := lu.ind[k]
+			piv := lu.data[diagPos[j]]
+			if piv == 0 {
+				return nil, ErrZeroPivot
+			}
+			lu.data[k] /= piv
+			l := lu.data[k]
+
+			// Subtract l times the upper part of row j from
+			// row i, restricted to the pattern of row i.
+			p := k + 1
+			for q := diagPos[j] + 1; q < lu.indptr[j+1]; q++ {
+				col := lu.ind[q]
+				for p < lu.indptr[i+1] && lu.ind[p] < col {
+					p++
+				}
+				if p == lu.indptr[i+1] {
+					break
+				}
+				if lu.ind[p] == col {
+					lu.data[p] -= l * lu.data[q]
+				}
+			}
+		}
+		if lu.data[diagPos[i]] == 0 {
+			return nil, ErrZeroPivot
+		}
+	}
+	for i, k := range diagPos {
+		lu.diag[i] = lu.data[k]
+	}
+	return &ILU0{lu: lu, work: make([]float64, n)}, nil
+}
+
+// PreconSolve solves M⋅dst = rhs, or Mᵀ⋅dst = rhs if trans is true.
+func (p *ILU0) PreconSolve(dst *mat.VecDense, rhs mat.Vector, trans bool) error {
+	x := p.work
+	reuseAs(dst, rhs, len(x))
+	for i := range x {
+		x[i] = rhs.AtVec(i)
+	}
+	l := p.lu
+	l.diag = nil
+	if trans {
+		p.lu.upperTransSolve(x)
+		l.lowerTransSolve(x)
+	} else {
+		l.lowerSolve(x)
+		p.lu.upperSolve(x)
+	}
+	for i, v := range x {
+		dst.SetVec(i, v)
+	}
+	return nil
+}
+
+// IC0 is the incomplete Cholesky factorization preconditioner with zero
+// fill-in, M = L⋅Lᵀ, where L is lower triangular with the sparsity pattern of
+// the lower triangle of A.
+//
+// See Y. Saad, "Iterative Methods for Sparse Linear Systems", 2nd ed., SIAM,
+// 2003, section 10.3, for details.
+type IC0 struct {
+	l    csrTri
+	work []float64
+}
+
+// NewIC0 returns the IC(0) preconditioner for the square symmetric sparse
+// matrix a. Only the lower triangle of a is used. If a non-positive pivot is
+// encountered, which may happen even if a is positive definite, NewIC0
+// returns ErrNotPositiveDefinite.
+func NewIC0(a *mat.CSR) (*IC0, error) {
+	r, c := a.Dims()
+	if r != c {
+		panic(mat.ErrSquare)
+	}
+	n := r
+
+	// Extract the lower triangle of a.
+	indptr, ind, data := a.RawCSR()
+	l := csrTri{
+		indptr: make([]int, n+1),
+		diag:   make([]float64, n),
+	}
+	for i := 0; i < n; i++ {
+		for k := indptr[i]; k < indptr[i+1] && ind[k] <= i; k++ {
+			l.ind = append(l.ind, ind[k])
+			l.data = append(l.data, data[k])
+		}
+		l.indptr[i+1] = len(l.ind)
+		if l.indptr[i+1] == l.indptr[i] || l.ind[l.indptr[i+1]-1] != i {
+			return nil, ErrNotPositiveDefinite
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		for k := l.indptr[i]; k < l.indptr[i+1]; k++ {
+			j := l.ind[k]
+			// Compute the dot product of row i and row j of L
+			// over the columns less than j.
+			s := l.data[k]
+			p := l.indptr[i]
+			for q := l.indptr[j]; q < l.indptr[j+1]-1; q++ {
+				col := l.ind[q]
+				for p < k && l.ind[p] < col {
+					p++
+				}
+				if p == k {
+					break
+				}
+				if l.ind[p] == col {
+					s -= l.data[p] * l.data[q]
+				}
+			}
+			if j < i {
+				l.data[k] = s / l.diag[j]
+				continue
+			}
+			if !(s > 0) {
+				return nil, ErrNotPositiveDefinite
+			}
+			l.diag[i] = math.Sqrt(s)
+			l.data[k] = l.diag[i]
+		}
+	}
+	return &IC0{l: l, work: make([]float64, n)}, nil
+}
+
+// PreconSolve solves M⋅dst = rhs. Since M is symmetric trans is ignored.
+func (p *IC0) PreconSolve(dst *mat.VecDense, rhs mat.Vector, _ bool) error {
+	x := p.work
+	reuseAs(dst, rhs, len(x))
+	for i := range x {
+		x[i] = rhs.AtVec(i)
+	}
+	p.l.lowerSolve(x)
+	p.l.lowerTransSolve(x)
+	for i, v := range x {
+		dst.SetVec(i, v)
+	}
+	return nil
+}
@@ -0,0 +1,350 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// ErrIterationLimit is returned when the maximum number of iterations is
+// reached before convergence.
+var ErrIterationLimit = errors.New("linsolve: iteration limit reached")
+
+// BreakdownError is returned when a method cannot continue because a
+// quantity it divides by has become too small.
+type BreakdownError struct {
+	Value     float64
+	Tolerance float64
+}
+
+func (e *BreakdownError) Error() string {
+	return fmt.Sprintf("linsolve: breakdown, value=%v tolerance=%v", e.Value, e.Tolerance)
+}
+
+// MulVecToer represents a linear operator A that can compute the product of
+// A or its transpose with a vector.
+type MulVecToer interface {
+	// MulVecTo computes A⋅x or Aᵀ⋅x and stores the result into dst.
+	MulVecTo(dst *mat.VecDense, trans bool, x mat.Vector)
+}
+
+// Operator returns a MulVecToer that computes products with the matrix a.
+// If a implements MulVecToer, it is returned directly.
+func Operator(a mat.Matrix) MulVecToer {
+	if m, ok := a.(MulVecToer); ok {
+		return m
+	}
+	return matrixOperator{a}
+}
+
+type matrixOperator struct {
+	mat.Matrix
+}
+
+func (a matrixOperator) MulVecTo(dst *mat.VecDense, trans bool, x mat.Vector) {
+	if trans {
+		dst.MulVec(a.Matrix.T(), x)
+		return
+	}
+	dst.MulVec(a.Matrix, x)
+}
+
+// Operation specifies the type of operation a Method requests from the
+// driver.
+type Operation uint64
+
+// Operations a Method can request from Iterative.
+const (
+	NoOperation Operation = 0
+
+	// MulVec requests the computation of A⋅Src, or Aᵀ⋅Src when
+	// combined with Trans, to be stored into Dst.
+	MulVec Operation = 1 << (iota - 1)
+
+	// PreconSolve requests the solution of M⋅Dst = Src, or
+	// Mᵀ⋅Dst = Src when combined with Trans, where M is the
+	// preconditioner.
+	PreconSolve
+
+	// Trans indicates that MulVec or PreconSolve should use the
+	// transpose.
+	Trans
+
+	// ComputeResidual requests the computation of the residual
+	// b - A⋅X to be stored into Dst.
+	ComputeResidual
+
+	// CheckResidualNorm requests the driver to check whether
+	// ResidualNorm satisfies the convergence criterion and to
+	// record the result in Converged.
+	CheckResidualNorm
+
+	// MajorIteration indicates that the method has completed an
+	// iteration and that X holds the current approximate solution.
+	MajorIteration
+)
+
+// Context holds the state shared between Iterative and a Method.
+type Context struct {
+	// X is the current approximate solution. It is updated by
+	// the method before it returns MajorIteration.
+	X *mat.VecDense
+
+	// ResidualNorm is the norm of the residual b - A⋅X, or an
+	// estimate of it. It is set by the method before it
+	// returns CheckResidualNorm.
+	ResidualNorm float64
+
+	// Converged is set by Iterative in response to
+	// CheckResidualNorm.
+	Converged bool
+
+	// Src and Dst are the operand and the result of the
+	// operations requested by the method.
+	Src, Dst *mat.VecDense
+}
+
+// Method is an iterative method for solving a linear system.
+//
+// Iterate is called repeatedly by Iterative and returns the next operation
+// to perform. Iterative performs the operation using the fields of ctx and
+// calls Iterate again until the method returns MajorIteration with
+// ctx.Converged set, the iteration limit is reached, or Iterate returns a
+// non-nil error.
+type Method interface {
+	// Init initializes the method for solving the system
+	// starting from the initial estimate x with the residual
+	// b - A⋅x.
+	Init(x, residual mat.Vector)
+
+	// Iterate performs a step of the method and returns the
+	// operation to be performed by the caller.
+	Iterate(ctx *Context) (Operation, error)
+}
+
+// Settings holds settings for solving a linear system.
+type Settings struct {
+	// InitX is the initial estimate of the solution. If
+	// InitX is nil, the zero vector is used.
+	InitX mat.Vector
+
+	// Dst, if not nil, is used to store the solution. Dst
+	// must have the length of b or be empty.
+	Dst *mat.VecDense
+
+	// Tolerance is the relative tolerance for the residual.
+	// The iteration is considered converged when
+	//  |b - A⋅x| < Tolerance * |b|.
+	// If Tolerance is zero, 1e-8 is used. Tolerance must be
+	// less than one.
+	Tolerance float64
+
+	// MaxIterations is the maximum number of iterations. If
+	// MaxIterations is zero, twice the dimension of the
+	// system is used.
+	MaxIterations int
+
+	// PreconSolve solves the system with the preconditioner
+	// M, or its transpose if trans is true, storing the result
+	// into dst. If PreconSolve and Preconditioner are nil, the
+	// identity is used.
+	PreconSolve func(dst *mat.VecDense, rhs mat.Vector, trans bool) error
+
+	// Preconditioner is the preconditioner M. At most one of
+	// PreconSolve and Preconditioner may be non-nil.
+	Preconditioner Preconditioner
+}
+
+// Stats holds statistics about a solve.
+type Stats struct {
+	// Iterations is the number of iterations performed.
+	Iterations int
+	// MulVec is the number of products with the operator.
+	MulVec int
+	// PreconSolve is the number of preconditioner solves.
+	PreconSolve int
+	// Runtime is the duration of the solve.
+	Runtime time.Duration
+}
+
+// Result holds the result of solving a linear system.
+type Result struct {
+	// X is the approximate solution.
+	X *mat.VecDense
+
+	// ResidualNorm is the norm of the residual at X as
+	// reported by the method.
+	ResidualNorm float64
+
+	// History holds the residual norm of the initial estimate
+	// followed by the residual norm after each iteration.
+	History []float64
+
+	// Stats holds statistics about the solve.
+	Stats Stats
+}
+
+// Iterative solves the system A⋅x = b using the iterative method m starting
+// from settings.InitX, and returns the result. If settings is nil, the
+// default settings described in Settings are used. If m is nil, GMRES with
+// default restart length is used.
+//
+// If the method does not converge within the iteration limit, the returned
+// result holds the last approximate solution and the error is
+// ErrIterationLimit. If the method breaks down or the preconditioner returns
+// an error, the error is returned with the last approximate solution.
+//
+// Iterative panics if the dimensions of b, settings.InitX and settings.Dst do
+// not match, or if the settings are invalid.
+func Iterative(a MulVecToer, b mat.Vector, m Method, settings *Settings) (*Result, error) {
+	start := time.Now()
+	n := b.Len()
+	if n == 0 {
+		panic("linsolve: zero length b")
+	}
+	var s Settings
+	if settings != nil {
+		s = *settings
+	}
+	if s.Tolerance == 0 {
+		s.Tolerance = 1e-8
+	}
+	if !(0 < s.Tolerance && s.Tolerance < 1) {
+		panic("linsolve: invalid tolerance")
+	}
+	if s.MaxIterations < 0 {
+		panic("linsolve: negative maximum iterations")
+	}
+	if s.MaxIterations == 0 {
+		s.MaxIterations = 2 * n
+	}
+	if s.InitX != nil && s.InitX.Len() != n {
+		panic("linsolve: mismatched length of initial estimate")
+	}
+	if s.Preconditioner != nil {
+		if s.PreconSolve != nil {
+			panic("linsolve: both PreconSolve and Preconditioner set")
+		}
+		s.PreconSolve = s.Preconditioner.PreconSolve
+	}
+	if m == nil {
+		m = &GMRES{}
+	}
+
+	x := s.Dst
+	if x == nil {
+		x = mat.NewVecDense(n, nil)
+	} else {
+		if x.IsEmpty() {
+			x.ReuseAsVec(n)
+		} else if x.Len() != n {
+			panic("linsolve: mismatched length of destination")
+		}
+	}
+	ctx := &Context{
+		X:   x,
+		Src: mat.NewVecDense(n, nil),
+		Dst: mat.NewVecDense(n, nil),
+	}
+	var (
+		stats   Stats
+		history []float64
+	)
+	result := func(err error) (*Result, error) {
+		stats.Runtime = time.Since(start)
+		return &Result{
+			X:            x,
+			ResidualNorm: ctx.ResidualNorm,
+			History:      history,
+			Stats:        stats,
+		}, err
+	}
+
+	// Compute the initial residual.
+	r := mat.NewVecDense(n, nil)
+	if s.InitX == nil {
+		x.Zero()
+		r.CopyVec(b)
+	} else {
+		x.CopyVec(s.InitX)
+		a.MulVecTo(r, false, x)
+		stats.MulVec++
+		r.SubVec(b, r)
+	}
+	bNorm := mat.Norm(b, 2)
+	if bNorm == 0 {
+		// The solution of A⋅x = 0 is the zero vector.
+		x.Zero()
+		ctx.ResidualNorm = 0
+		history = append(history, 0)
+		return result(nil)
+	}
+	ctx.ResidualNorm = mat.Norm(r, 2)
+	history = append(history, ctx.ResidualNorm)
+	tol := s.Tolerance * bNorm
+	if ctx.ResidualNorm < tol {
+		return result(nil)
+	}
+
+	m.Init(x, r)
+	var err error
+	for {
+		var op Operation
+		op, err = m.Iterate(ctx)
+		if err != nil {
+			break
+		}
+		trans := op&Trans != 0
+		switch op &^ Trans {
+		case NoOperation:
+		case MulVec:
+			a.MulVecTo(ctx.Dst, trans, ctx.Src)
+			stats.MulVec++
+		case PreconSolve:
+			if s.PreconSolve == nil {
+				ctx.Dst.CopyVec(ctx.Src)
+			} else {
+				err = s.PreconSolve(ctx.Dst, ctx.Src, trans)
+			}
+			stats.PreconSolve++
+		case ComputeResidual:
+			a.MulVecTo(ctx.Dst, false, ctx.X)
+			stats.MulVec++
+			ctx.Dst.SubVec(b, ctx.Dst)
+		case CheckResidualNorm:
+			ctx.Converged = ctx.ResidualNorm < tol
+		case MajorIteration:
+			stats.Iterations++
+			history = append(history, ctx.ResidualNorm)
+			if ctx.Converged {
+				return result(nil)
+			}
+			if stats.Iterations >= s.MaxIterations {
+				err = ErrIterationLimit
+			}
+		default:
+			panic(fmt.Sprintf("linsolve: invalid operation %d", op))
+		}
+		if err != nil {
+			break
+		}
+	}
+	return result(err)
+}
+
+// reuse resizes v to length n, retaining its storage if possible.
+func reuse(v *mat.VecDense, n int) {
+	if !v.IsEmpty() {
+		if v.Len() == n {
+			return
+		}
+		v.Reset()
+	}
+	v.ReuseAsVec(n)
+}
@@ -0,0 +1,352 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// laplacian2D returns the n²×n² five-point Laplacian on an n×n grid.
+func laplacian2D(n int) *mat.CSR {
+	var ii, jj []int
+	var vv []float64
+	add := func(i, j int, v float64) {
+		ii = append(ii, i)
+		jj = append(jj, j)
+		vv = append(vv, v)
+	}
+	for r := 0; r < n; r++ {
+		for c := 0; c < n; c++ {
+			k := r*n + c
+			add(k, k, 4)
+			if r > 0 {
+				add(k, k-n, -1)
+			}
+			if r < n-1 {
+				add(k, k+n, -1)
+			}
+			if c > 0 {
+				add(k, k-1, -1)
+			}
+			if c < n-1 {
+				add(k, k+1, -1)
+			}
+		}
+	}
+	return mat.NewCSRFromTriplets(n*n, n*n, ii, jj, vv)
+}
+
+// convectionDiffusion returns the n×n nonsymmetric tridiagonal matrix of the
+// upwind discretization of -u″ + c⋅u′ in one dimension.
+func convectionDiffusion(n int, c float64) *mat.Tridiag {
+	dl := make([]float64, n-1)
+	d := make([]float64, n)
+	du := make([]float64, n-1)
+	for i := range d {
+		d[i] = 2 + c
+	}
+	for i := range dl {
+		dl[i] = -1 - c
+		du[i] = -1
+	}
+	return mat.NewTridiag(n, dl, d, du)
+}
+
+// randomSymmetric returns a random n×n dense symmetric matrix with
+// eigenvalues of both signs bounded away from zero.
+func randomSymmetric(rnd *rand.Rand, n int) *mat.SymDense {
+	var q mat.QR
+	g := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			g.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	q.Factorize(g)
+	var qm mat.Dense
+	q.QTo(&qm)
+	d := mat.NewDiagDense(n, nil)
+	for i := 0; i < n; i++ {
+		v := 1 + 9*rnd.Float64()
+		if i%2 == 1 {
+			v = -v
+		}
+		d.SetDiag(i, v)
+	}
+	var a mat.Dense
+	a.Product(&qm, d, qm.T())
+	s := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			s.SetSym(i, j, (a.At(i, j)+a.At(j, i))/2)
+		}
+	}
+	return s
+}
+
+// jacobi returns a Jacobi preconditioner for a.
+func jacobi(a mat.Matrix) func(dst *mat.VecDense, rhs mat.Vector, trans bool) error {
+	n, _ := a.Dims()
+	diag := make([]float64, n)
+	for i := range diag {
+		diag[i] = a.At(i, i)
+	}
+	return func(dst *mat.VecDense, rhs mat.Vector, trans bool) error {
+		for i, d := range diag {
+			dst.SetVec(i, rhs.AtVec(i)/d)
+		}
+		return nil
+	}
+}
+
+type testCase struct {
+	name      string
+	a         mat.Matrix
+	symmetric bool
+	spd       bool
+	// precon indicates whether the Jacobi preconditioner
+	// is positive definite.
+	precon bool
+}
+
+func testCases(rnd *rand.Rand) []testCase {
+	return []testCase{
+		{name: "laplacian", a: laplacian2D(12), symmetric: true, spd: true, precon: true},
+		{name: "tridiag", a: convectionDiffusion(40, 0), symmetric: true, spd: true, precon: true},
+		{name: "convection", a: convectionDiffusion(100, 1), precon: true},
+		{name: "indefinite", a: randomSymmetric(rnd, 40), symmetric: true},
+	}
+}
+
+func methods() []struct {
+	name      string
+	method    func() Method
+	symmetric bool
+	spd       bool
+} {
+	return []struct {
+		name      string
+		method    func() Method
+		symmetric bool
+		spd       bool
+	}{
+		{name: "CG", method: func() Method { return &CG{} }, symmetric: true, spd: true},
+		{name: "MINRES", method: func() Method { return &MINRES{} }, symmetric: true},
+		{name: "GMRES", method: func() Method { return &GMRES{} }},
+		{name: "GMRES(5)", method: func() Method { return &GMRES{Restart: 5} }},
+		{name: "BiCGSTAB", method: func() Method { return &BiCGSTAB{} }},
+	}
+}
+
+func TestIterative(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range testCases(rnd) {
+		n, _ := test.a.Dims()
+		want := mat.NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			want.SetVec(i, rnd.NormFloat64())
+		}
+		var b mat.VecDense
+		b.MulVec(test.a, want)
+		bNorm := mat.Norm(&b, 2)
+
+		for _, m := range methods() {
+			if (m.symmetric && !test.symmetric) || (m.spd && !test.spd) {
+				continue
+			}
+			for _, precon := range []bool{false, true} {
+				if precon && !test.precon {
+					continue
+				}
+				name := fmt.Sprintf("%s/%s/precon=%t", test.name, m.name, precon)
+				settings := &Settings{
+					Tolerance:     1e-10,
+					MaxIterations: 20 * n,
+				}
+				if precon {
+					settings.PreconSolve = jacobi(test.a)
+				}
+				res, err := Iterative(Operator(test.a), &b, m.method(), settings)
+				if err != nil {
+					t.Errorf("%s: unexpected error: %v", name, err)
+					continue
+				}
+
+				var r mat.VecDense
+				r.MulVec(test.a, res.X)
+				r.SubVec(&b, &r)
+				if got := mat.Norm(&r, 2); got > 1e-8*bNorm {
+					t.Errorf("%s: unexpected residual norm: got:%v want:<%v", name, got, 1e-8*bNorm)
+				}
+				if res.ResidualNorm >= settings.Tolerance*bNorm {
+					t.Errorf("%s: reported residual norm not below tolerance: %v", name, res.ResidualNorm)
+				}
+				if len(res.History) != res.Stats.Iterations+1 {
+					t.Errorf("%s: unexpected history length: got:%d want:%d", name, len(res.History), res.Stats.Iterations+1)
+				}
+				if res.History[0] != bNorm {
+					t.Errorf("%s: unexpected initial residual: got:%v want:%v", name, res.History[0], bNorm)
+				}
+				if m.name == "GMRES" || m.name == "MINRES" {
+					// Minimum residual methods without restarts
+					// have monotonically decreasing residuals.
+					for i := 1; i < len(res.History); i++ {
+						if res.History[i] > res.History[i-1]*(1+1e-12) {
+							t.Errorf("%s: residual increased at iteration %d: %v > %v", name, i, res.History[i], res.History[i-1])
+							break
+						}
+					}
+				}
+				if res.Stats.MulVec == 0 {
+					t.Errorf("%s: no products recorded", name)
+				}
+			}
+		}
+	}
+}
+
+func TestIterativeInitX(t *testing.T) {
+	t.Parallel()
+	a := laplacian2D(5)
+	n, _ := a.Dims()
+	x := mat.NewVecDense(n, nil)
+	for i := 0; i < n; i++ {
+		x.SetVec(i, float64(i))
+	}
+	var b mat.VecDense
+	a.MulVecTo(&b, false, x)
+
+	// Starting from the solution requires no iterations.
+	dst := mat.NewVecDense(n, nil)
+	res, err := Iterative(a, &b, &CG{}, &Settings{InitX: x, Dst: dst})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Stats.Iterations != 0 {
+		t.Errorf("unexpected number of iterations: got:%d want:0", res.Stats.Iterations)
+	}
+	if res.X != dst {
+		t.Errorf("solution not stored in Dst")
+	}
+	if !mat.EqualApprox(res.X, x, 1e-14) {
+		t.Errorf("unexpected solution")
+	}
+
+	// A zero right-hand side has the zero solution.
+	res, err = Iterative(a, mat.NewVecDense(n, nil), &CG{}, &Settings{InitX: x})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mat.Norm(res.X, 2) != 0 {
+		t.Errorf("unexpected non-zero solution for zero right-hand side")
+	}
+}
+
+func TestIterativeIterationLimit(t *testing.T) {
+	t.Parallel()
+	a := laplacian2D(20)
+	n, _ := a.Dims()
+	b := mat.NewVecDense(n, nil)
+	for i := 0; i < n; i++ {
+		b.SetVec(i, 1)
+	}
+	for _, m := range []Method{&CG{}, &MINRES{}, &GMRES{}, &BiCGSTAB{}} {
+		res, err := Iterative(a, b, m, &Settings{MaxIterations: 3})
+		if err != ErrIterationLimit {
+			t.Errorf("%T: unexpected error: got:%v want:%v", m, err, ErrIterationLimit)
+		}
+		if res.Stats.Iterations != 3 {
+			t.Errorf("%T: unexpected number of iterations: got:%d want:3", m, res.Stats.Iterations)
+		}
+		if len(res.History) != 4 {
+			t.Errorf("%T: unexpected history length: got:%d want:4", m, len(res.History))
+		}
+	}
+}
+
+func TestIterativePreconError(t *testing.T) {
+	t.Parallel()
+	a := laplacian2D(4)
+	n, _ := a.Dims()
+	b := mat.NewVecDense(n, nil)
+	b.SetVec(0, 1)
+	errPrecon := errors.New("precon failure")
+	_, err := Iterative(a, b, &CG{}, &Settings{
+		PreconSolve: func(dst *mat.VecDense, rhs mat.Vector, trans bool) error {
+			return errPrecon
+		},
+	})
+	if err != errPrecon {
+		t.Errorf("unexpected error: got:%v want:%v", err, errPrecon)
+	}
+}
+
+func TestCGBreakdown(t *testing.T) {
+	t.Parallel()
+	// pᵀ⋅A⋅p is zero on the first iteration.
+	a := mat.NewDense(2, 2, []float64{1, 0, 0, -1})
+	b := mat.NewVecDense(2, []float64{1, 1})
+	_, err := Iterative(Operator(a), b, &CG{}, nil)
+	var breakdown *BreakdownError
+	if !errors.As(err, &breakdown) {
+		t.Errorf("unexpected error: got:%v want breakdown", err)
+	}
+}
+
+func TestOperator(t *testing.T) {
+	t.Parallel()
+	a := mat.NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6})
+	op := Operator(a)
+	var got mat.VecDense
+	op.MulVecTo(&got, false, mat.NewVecDense(3, []float64{1, 1, 1}))
+	if want := mat.NewVecDense(2, []float64{6, 15}); !mat.Equal(&got, want) {
+		t.Errorf("unexpected product: got:%v want:%v", got.RawVector().Data, want.RawVector().Data)
+	}
+	got.Reset()
+	op.MulVecTo(&got, true, mat.NewVecDense(2, []float64{1, 1}))
+	if want := mat.NewVecDense(3, []float64{5, 7, 9}); !mat.Equal(&got, want) {
+		t.Errorf("unexpected transposed product: got:%v want:%v", got.RawVector().Data, want.RawVector().Data)
+	}
+
+	csr := laplacian2D(2)
+	if Operator(csr) != MulVecToer(csr) {
+		t.Errorf("MulVecToer not returned directly")
+	}
+}
+
+func BenchmarkIterativeLaplacian(b *testing.B) {
+	a := laplacian2D(64)
+	n, _ := a.Dims()
+	rhs := mat.NewVecDense(n, nil)
+	for i := 0; i < n; i++ {
+		rhs.SetVec(i, math.Sin(float64(i)))
+	}
+	for _, m := range []struct {
+		name   string
+		method Method
+	}{
+		{"CG", &CG{}},
+		{"MINRES", &MINRES{}},
+		{"GMRES", &GMRES{}},
+		{"BiCGSTAB", &BiCGSTAB{}},
+	} {
+		b.Run(m.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, err := Iterative(a, rhs, m.method, &Settings{MaxIterations: 10 * n})
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
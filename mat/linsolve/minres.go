@@ -0,0 +1,143 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// MINRES implements the minimum residual method for solving systems with a
+// symmetric, possibly indefinite, matrix. The preconditioner must be
+// symmetric positive definite.
+//
+// The residual norm reported by MINRES is the estimate obtained from the
+// Lanczos recurrence. With a preconditioner M it is the norm of the residual
+// in the norm induced by M⁻¹, rather than the Euclidean norm.
+//
+// See C. C. Paige and M. A. Saunders, "Solution of sparse indefinite systems
+// of linear equations", SIAM J. Numer. Anal. 12(4), 1975, for details.
+type MINRES struct {
+	x, r1, r2, y, v mat.VecDense
+	w, w1, w2       mat.VecDense
+
+	beta, oldb     float64
+	alfa           float64
+	dbar, epsln    float64
+	phibar, cs, sn float64
+	first          bool
+
+	resume int
+}
+
+// Init initializes the method for solving the system starting from the
+// initial estimate x with the residual b - A⋅x.
+func (m *MINRES) Init(x, residual mat.Vector) {
+	n := x.Len()
+	if residual.Len() != n {
+		panic("linsolve: mismatched vector length")
+	}
+	for _, v := range []*mat.VecDense{&m.x, &m.r1, &m.r2, &m.y, &m.v, &m.w, &m.w1, &m.w2} {
+		reuse(v, n)
+	}
+	m.x.CopyVec(x)
+	m.r1.CopyVec(residual)
+	m.r2.CopyVec(residual)
+	m.w.Zero()
+	m.w2.Zero()
+	m.oldb = 0
+	m.dbar = 0
+	m.epsln = 0
+	m.cs = -1
+	m.sn = 0
+	m.first = true
+	m.resume = 1
+}
+
+// Iterate performs a step of the method and returns the operation to be
+// performed by the caller.
+func (m *MINRES) Iterate(ctx *Context) (Operation, error) {
+	switch m.resume {
+	case 1:
+		// Solve M⋅y = r₁ for the first Lanczos vector.
+		ctx.Src.CopyVec(&m.r1)
+		m.resume = 2
+		return PreconSolve, nil
+	case 2:
+		m.y.CopyVec(ctx.Dst)
+		beta2 := mat.Dot(&m.r1, &m.y)
+		if !(beta2 > 0) {
+			m.resume = 0
+			return NoOperation, &BreakdownError{Value: beta2, Tolerance: 0}
+		}
+		m.beta = math.Sqrt(beta2)
+		m.phibar = m.beta
+		fallthrough
+	case 3:
+		// Compute the next Lanczos vector and A⋅v.
+		m.v.ScaleVec(1/m.beta, &m.y)
+		ctx.Src.CopyVec(&m.v)
+		m.resume = 4
+		return MulVec, nil
+	case 4:
+		m.y.CopyVec(ctx.Dst)
+		if !m.first {
+			m.y.AddScaledVec(&m.y, -m.beta/m.oldb, &m.r1)
+		}
+		m.first = false
+		m.alfa = mat.Dot(&m.v, &m.y)
+		m.y.AddScaledVec(&m.y, -m.alfa/m.beta, &m.r2)
+		m.r1.CopyVec(&m.r2)
+		m.r2.CopyVec(&m.y)
+		ctx.Src.CopyVec(&m.r2)
+		m.resume = 5
+		return PreconSolve, nil
+	case 5:
+		m.y.CopyVec(ctx.Dst)
+		m.oldb = m.beta
+		beta2 := mat.Dot(&m.r2, &m.y)
+		if beta2 < 0 {
+			m.resume = 0
+			return NoOperation, &BreakdownError{Value: beta2, Tolerance: 0}
+		}
+		m.beta = math.Sqrt(beta2)
+
+		// Apply the previous rotation and compute the next one.
+		oldeps := m.epsln
+		delta := m.cs*m.dbar + m.sn*m.alfa
+		gbar := m.sn*m.dbar - m.cs*m.alfa
+		m.epsln = m.sn * m.beta
+		m.dbar = -m.cs * m.beta
+		gamma := math.Max(math.Hypot(gbar, m.beta), dlamchE)
+		m.cs = gbar / gamma
+		m.sn = m.beta / gamma
+		phi := m.cs * m.phibar
+		m.phibar *= m.sn
+
+		// Update the search direction and the solution.
+		m.w1.CopyVec(&m.w2)
+		m.w2.CopyVec(&m.w)
+		m.w.AddScaledVec(&m.v, -oldeps, &m.w1)
+		m.w.AddScaledVec(&m.w, -delta, &m.w2)
+		m.w.ScaleVec(1/gamma, &m.w)
+		m.x.AddScaledVec(&m.x, phi, &m.w)
+
+		ctx.ResidualNorm = math.Abs(m.phibar)
+		m.resume = 6
+		return CheckResidualNorm, nil
+	case 6:
+		// If beta is zero the Krylov subspace is invariant
+		// and phibar is zero, so the iteration has converged.
+		ctx.X.CopyVec(&m.x)
+		m.resume = 3
+		return MajorIteration, nil
+	default:
+		panic("linsolve: MINRES.Init not called")
+	}
+}
+
+// dlamchE is the machine epsilon.
+const dlamchE = 1.0 / (1 << 53)
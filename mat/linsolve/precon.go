@@ -0,0 +1,249 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"errors"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	// ErrZeroPivot is returned when a preconditioner cannot be
+	// constructed because of a zero or missing diagonal element.
+	ErrZeroPivot = errors.New("linsolve: zero pivot")
+
+	// ErrNotPositiveDefinite is returned when an incomplete Cholesky
+	// factorization encounters a non-positive pivot.
+	ErrNotPositiveDefinite = errors.New("linsolve: matrix not positive definite")
+)
+
+// Preconditioner is a linear operator M that approximates the system matrix
+// A and for which systems can be solved cheaply. The PreconSolve method of a
+// Preconditioner can be used as Settings.PreconSolve, or the Preconditioner
+// can be set directly as Settings.Preconditioner.
+type Preconditioner interface {
+	// PreconSolve solves M⋅dst = rhs, or Mᵀ⋅dst = rhs if trans is
+	// true. If dst is empty, it is resized to the length of rhs.
+	PreconSolve(dst *mat.VecDense, rhs mat.Vector, trans bool) error
+}
+
+var (
+	_ Preconditioner = (*Jacobi)(nil)
+	_ Preconditioner = (*SSOR)(nil)
+	_ Preconditioner = (*ILU0)(nil)
+	_ Preconditioner = (*IC0)(nil)
+)
+
+// Jacobi is the diagonal preconditioner M = diag(A).
+type Jacobi struct {
+	inv []float64
+}
+
+// NewJacobi returns the Jacobi preconditioner for the square matrix a. If a
+// has a zero diagonal element, NewJacobi returns ErrZeroPivot.
+func NewJacobi(a mat.Matrix) (*Jacobi, error) {
+	r, c := a.Dims()
+	if r != c {
+		panic(mat.ErrSquare)
+	}
+	inv := make([]float64, r)
+	for i := range inv {
+		d := a.At(i, i)
+		if d == 0 {
+			return nil, ErrZeroPivot
+		}
+		inv[i] = 1 / d
+	}
+	return &Jacobi{inv: inv}, nil
+}
+
+// PreconSolve solves M⋅dst = rhs. Since M is diagonal trans is ignored.
+func (p *Jacobi) PreconSolve(dst *mat.VecDense, rhs mat.Vector, _ bool) error {
+	reuseAs(dst, rhs, len(p.inv))
+	for i, d := range p.inv {
+		dst.SetVec(i, d*rhs.AtVec(i))
+	}
+	return nil
+}
+
+// SSOR is the symmetric successive over-relaxation preconditioner
+//
+//	M = ω/(2-ω) (D/ω + L) (D/ω)⁻¹ (D/ω + U),
+//
+// where D, L and U are the diagonal and the strictly lower and upper
+// triangular parts of A. For ω = 1 this is the symmetric Gauss-Seidel
+// preconditioner. If A is symmetric positive definite, so is M.
+type SSOR struct {
+	tri   csrTri
+	scale float64
+	work  []float64
+}
+
+// NewSSOR returns the SSOR preconditioner for the square sparse matrix a with
+// the relaxation parameter omega. The preconditioner holds a copy of a.
+// NewSSOR panics if omega is not in (0, 2). If a has a zero or missing
+// diagonal element, NewSSOR returns ErrZeroPivot.
+func NewSSOR(a *mat.CSR, omega float64) (*SSOR, error) {
+	if !(0 < omega && omega < 2) {
+		panic("linsolve: relaxation parameter out of range")
+	}
+	tri, err := newCSRTri(a)
+	if err != nil {
+		return nil, err
+	}
+	for i, d := range tri.diag {
+		tri.diag[i] = d / omega
+	}
+	return &SSOR{
+		tri:   tri,
+		scale: (2 - omega) / omega,
+		work:  make([]float64, len(tri.diag)),
+	}, nil
+}
+
+// PreconSolve solves M⋅dst = rhs, or Mᵀ⋅dst = rhs if trans is true.
+func (p *SSOR) PreconSolve(dst *mat.VecDense, rhs mat.Vector, trans bool) error {
+	x := p.work
+	reuseAs(dst, rhs, len(x))
+	for i := range x {
+		x[i] = rhs.AtVec(i)
+	}
+	if trans {
+		p.tri.upperTransSolve(x)
+		scaleByDiag(x, p.tri.diag)
+		p.tri.lowerTransSolve(x)
+	} else {
+		p.tri.lowerSolve(x)
+		scaleByDiag(x, p.tri.diag)
+		p.tri.upperSolve(x)
+	}
+	for i, v := range x {
+		dst.SetVec(i, p.scale*v)
+	}
+	return nil
+}
+
+// scaleByDiag multiplies the elements of x by the elements of d.
+func scaleByDiag(x, d []float64) {
+	for i, v := range d {
+		x[i] *= v
+	}
+}
+
+// reuseAs prepares dst to hold the solution of an n-dimensional
+// preconditioner system with right-hand side rhs.
+func reuseAs(dst *mat.VecDense, rhs mat.Vector, n int) {
+	if rhs.Len() != n {
+		panic(mat.ErrShape)
+	}
+	if dst.IsEmpty() {
+		dst.ReuseAsVec(n)
+	} else if dst.Len() != n {
+		panic(mat.ErrShape)
+	}
+}
+
+// csrTri holds a square sparse matrix in compressed sparse row form with
+// sorted column indices, and a diagonal that replaces the diagonal of the
+// matrix in triangular solves. A nil diagonal indicates a unit diagonal.
+type csrTri struct {
+	indptr, ind []int
+	data        []float64
+	diag        []float64
+}
+
+// newCSRTri returns a csrTri holding a copy of a and its diagonal.
+func newCSRTri(a *mat.CSR) (csrTri, error) {
+	r, c := a.Dims()
+	if r != c {
+		panic(mat.ErrSquare)
+	}
+	indptr, ind, data := a.RawCSR()
+	nnz := indptr[r]
+	t := csrTri{
+		indptr: append([]int(nil), indptr...),
+		ind:    append([]int(nil), ind[:nnz]...),
+		data:   append([]float64(nil), data[:nnz]...),
+		diag:   make([]float64, r),
+	}
+	for i := 0; i < r; i++ {
+		k, ok := t.find(i, i)
+		if !ok || t.data[k] == 0 {
+			return csrTri{}, ErrZeroPivot
+		}
+		t.diag[i] = t.data[k]
+	}
+	return t, nil
+}
+
+// find returns the position of the element (i, j) in data and whether it is
+// stored.
+func (t *csrTri) find(i, j int) (int, bool) {
+	for k := t.indptr[i]; k < t.indptr[i+1]; k++ {
+		switch {
+		case t.ind[k] == j:
+			return k, true
+		case t.ind[k] > j:
+			return -1, false
+		}
+	}
+	return -1, false
+}
+
+func (t *csrTri) d(i int) float64 {
+	if t.diag == nil {
+		return 1
+	}
+	return t.diag[i]
+}
+
+// lowerSolve solves (D + L)⋅x = b in place, where L is the strictly lower
+// triangular part of the matrix.
+func (t *csrTri) lowerSolve(x []float64) {
+	for i := range x {
+		s := x[i]
+		for k := t.indptr[i]; k < t.indptr[i+1] && t.ind[k] < i; k++ {
+			s -= t.data[k] * x[t.ind[k]]
+		}
+		x[i] = s / t.d(i)
+	}
+}
+
+// upperSolve solves (D + U)⋅x = b in place, where U is the strictly upper
+// triangular part of the matrix.
+func (t *csrTri) upperSolve(x []float64) {
+	for i := len(x) - 1; i >= 0; i-- {
+		s := x[i]
+		for k := t.indptr[i+1] - 1; k >= t.indptr[i] && t.ind[k] > i; k-- {
+			s -= t.data[k] * x[t.ind[k]]
+		}
+		x[i] = s / t.d(i)
+	}
+}
+
+// lowerTransSolve solves (D + L)ᵀ⋅x = b in place, where L is the strictly
+// lower triangular part of the matrix.
+func (t *csrTri) lowerTransSolve(x []float64) {
+	for i := len(x) - 1; i >= 0; i-- {
+		x[i] /= t.d(i)
+		xi := x[i]
+		for k := t.indptr[i]; k < t.indptr[i+1] && t.ind[k] < i; k++ {
+			x[t.ind[k]] -= t.data[k] * xi
+		}
+	}
+}
+
+// upperTransSolve solves (D + U)ᵀ⋅x = b in place, where U is the strictly
+// upper triangular part of the matrix.
+func (t *csrTri) upperTransSolve(x []float64) {
+	for i := range x {
+		x[i] /= t.d(i)
+		xi := x[i]
+		for k := t.indptr[i+1] - 1; k >= t.indptr[i] && t.ind[k] > i; k-- {
+			x[t.ind[k]] -= t.data[k] * xi
+		}
+	}
+}
@@ -0,0 +1,321 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package linsolve
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// randomDominant returns a random n×n sparse diagonally dominant matrix with
+// approximately the given density of off-diagonal elements. If symmetric is
+// true the matrix is symmetric.
+func randomDominant(rnd *rand.Rand, n int, density float64, symmetric bool) *mat.CSR {
+	var ii, jj []int
+	var vv []float64
+	diag := make([]float64, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i == j || (symmetric && j > i) || rnd.Float64() >= density {
+				continue
+			}
+			v := rnd.NormFloat64()
+			ii = append(ii, i)
+			jj = append(jj, j)
+			vv = append(vv, v)
+			diag[i] += math.Abs(v)
+			if symmetric {
+				ii = append(ii, j)
+				jj = append(jj, i)
+				vv = append(vv, v)
+				diag[j] += math.Abs(v)
+			}
+		}
+	}
+	for i, d := range diag {
+		ii = append(ii, i)
+		jj = append(jj, i)
+		vv = append(vv, d+1)
+	}
+	return mat.NewCSRFromTriplets(n, n, ii, jj, vv)
+}
+
+// denseOf returns a dense copy of a.
+func denseOf(a mat.Matrix) *mat.Dense {
+	r, c := a.Dims()
+	d := mat.NewDense(r, c, nil)
+	d.Copy(a)
+	return d
+}
+
+// checkPreconSolve checks that p solves systems with the dense matrix m and
+// its transpose.
+func checkPreconSolve(t *testing.T, name string, rnd *rand.Rand, p Preconditioner, m *mat.Dense) {
+	t.Helper()
+	n, _ := m.Dims()
+	rhs := mat.NewVecDense(n, nil)
+	for i := 0; i < n; i++ {
+		rhs.SetVec(i, rnd.NormFloat64())
+	}
+	for _, trans := range []bool{false, true} {
+		var want mat.VecDense
+		var err error
+		if trans {
+			err = want.SolveVec(m.T(), rhs)
+		} else {
+			err = want.SolveVec(m, rhs)
+		}
+		if err != nil {
+			t.Fatalf("%s: bad test: %v", name, err)
+		}
+		var got mat.VecDense
+		err = p.PreconSolve(&got, rhs, trans)
+		if err != nil {
+			t.Errorf("%s trans=%t: unexpected error: %v", name, trans, err)
+		}
+		if !mat.EqualApprox(&got, &want, 1e-10) {
+			t.Errorf("%s trans=%t: unexpected solution:\ngot: %v\nwant:%v", name, trans, got.RawVector().Data, want.RawVector().Data)
+		}
+
+		// Aliased destination and right-hand side.
+		x := mat.VecDenseCopyOf(rhs)
+		p.PreconSolve(x, x, trans)
+		if !mat.EqualApprox(x, &want, 1e-10) {
+			t.Errorf("%s trans=%t: unexpected solution with aliased vectors", name, trans)
+		}
+	}
+}
+
+func TestJacobi(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	a := randomDominant(rnd, 20, 0.2, false)
+	p, err := NewJacobi(a)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	m := mat.NewDense(20, 20, nil)
+	for i := 0; i < 20; i++ {
+		m.Set(i, i, a.At(i, i))
+	}
+	checkPreconSolve(t, "Jacobi", rnd, p, m)
+
+	_, err = NewJacobi(mat.NewDense(2, 2, []float64{1, 1, 1, 0}))
+	if err != ErrZeroPivot {
+		t.Errorf("unexpected error for zero diagonal: got:%v want:%v", err, ErrZeroPivot)
+	}
+}
+
+func TestSSOR(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, symmetric := range []bool{false, true} {
+		for _, omega := range []float64{0.5, 1, 1.5} {
+			name := fmt.Sprintf("SSOR symmetric=%t omega=%v", symmetric, omega)
+			const n = 20
+			a := randomDominant(rnd, n, 0.2, symmetric)
+			p, err := NewSSOR(a, omega)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", name, err)
+			}
+
+			// Form M = ω/(2-ω) (D/ω + L) (D/ω)⁻¹ (D/ω + U).
+			lower := mat.NewDense(n, n, nil)
+			upper := mat.NewDense(n, n, nil)
+			dinv := mat.NewDiagDense(n, nil)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					v := a.At(i, j)
+					switch {
+					case i == j:
+						lower.Set(i, i, v/omega)
+						upper.Set(i, i, v/omega)
+						dinv.SetDiag(i, omega/v)
+					case j < i:
+						lower.Set(i, j, v)
+					default:
+						upper.Set(i, j, v)
+					}
+				}
+			}
+			var m mat.Dense
+			m.Product(lower, dinv, upper)
+			m.Scale(omega/(2-omega), &m)
+			checkPreconSolve(t, name, rnd, p, &m)
+		}
+	}
+
+	for _, omega := range []float64{0, 2, -1, math.NaN()} {
+		if !panics(func() { NewSSOR(laplacian2D(2), omega) }) {
+			t.Errorf("expected panic for omega=%v", omega)
+		}
+	}
+	_, err := NewSSOR(mat.NewCSR(2, 2, []int{0, 1, 2}, []int{1, 1}, []float64{1, 1}), 1)
+	if err != ErrZeroPivot {
+		t.Errorf("unexpected error for missing diagonal: got:%v want:%v", err, ErrZeroPivot)
+	}
+}
+
+func TestILU0(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		name string
+		a    *mat.CSR
+	}{
+		{name: "laplacian", a: laplacian2D(5)},
+		{name: "random sparse", a: randomDominant(rnd, 30, 0.1, false)},
+		{name: "random dense", a: randomDominant(rnd, 10, 1, false)},
+	} {
+		p, err := NewILU0(test.a)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		n, _ := test.a.Dims()
+
+		// Form L and U and check that L⋅U agrees with A on the
+		// sparsity pattern of A.
+		l := mat.NewDense(n, n, nil)
+		u := mat.NewDense(n, n, nil)
+		for i := 0; i < n; i++ {
+			l.Set(i, i, 1)
+			for k := p.lu.indptr[i]; k < p.lu.indptr[i+1]; k++ {
+				j := p.lu.ind[k]
+				if j < i {
+					l.Set(i, j, p.lu.data[k])
+				} else {
+					u.Set(i, j, p.lu.data[k])
+				}
+			}
+		}
+		var m mat.Dense
+		m.Mul(l, u)
+		test.a.DoNonZero(func(i, j int, v float64) {
+			if got := m.At(i, j); math.Abs(got-v) > 1e-12 {
+				t.Errorf("%s: (L⋅U)[%d,%d] does not match A: got:%v want:%v", test.name, i, j, got, v)
+			}
+		})
+		if test.name == "random dense" && !mat.EqualApprox(&m, denseOf(test.a), 1e-12) {
+			t.Errorf("%s: ILU(0) of dense matrix is not the LU factorization", test.name)
+		}
+		checkPreconSolve(t, "ILU0 "+test.name, rnd, p, &m)
+	}
+
+	_, err := NewILU0(mat.NewCSR(2, 2, []int{0, 2, 4}, []int{0, 1, 0, 1}, []float64{1, 1, 1, 1}))
+	if err != ErrZeroPivot {
+		t.Errorf("unexpected error for zero pivot: got:%v want:%v", err, ErrZeroPivot)
+	}
+}
+
+func TestIC0(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		name string
+		a    *mat.CSR
+	}{
+		{name: "laplacian", a: laplacian2D(5)},
+		{name: "random sparse", a: randomDominant(rnd, 30, 0.1, true)},
+		{name: "random dense", a: randomDominant(rnd, 10, 1, true)},
+	} {
+		p, err := NewIC0(test.a)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		n, _ := test.a.Dims()
+
+		l := mat.NewDense(n, n, nil)
+		for i := 0; i < n; i++ {
+			for k := p.l.indptr[i]; k < p.l.indptr[i+1]; k++ {
+				l.Set(i, p.l.ind[k], p.l.data[k])
+			}
+		}
+		var m mat.Dense
+		m.Mul(l, l.T())
+		test.a.DoNonZero(func(i, j int, v float64) {
+			if got := m.At(i, j); math.Abs(got-v) > 1e-12 {
+				t.Errorf("%s: (L⋅Lᵀ)[%d,%d] does not match A: got:%v want:%v", test.name, i, j, got, v)
+			}
+		})
+		if test.name == "random dense" && !mat.EqualApprox(&m, denseOf(test.a), 1e-12) {
+			t.Errorf("%s: IC(0) of dense matrix is not the Cholesky factorization", test.name)
+		}
+		checkPreconSolve(t, "IC0 "+test.name, rnd, p, &m)
+	}
+
+	for i, a := range []*mat.CSR{
+		mat.NewCSR(2, 2, []int{0, 2, 4}, []int{0, 1, 0, 1}, []float64{1, 2, 2, 1}),
+		mat.NewCSR(2, 2, []int{0, 1, 1}, []int{0}, []float64{1}),
+	} {
+		_, err := NewIC0(a)
+		if err != ErrNotPositiveDefinite {
+			t.Errorf("case %d: unexpected error: got:%v want:%v", i, err, ErrNotPositiveDefinite)
+		}
+	}
+}
+
+func TestPreconditionedIterative(t *testing.T) {
+	t.Parallel()
+	a := laplacian2D(30)
+	n, _ := a.Dims()
+	b := mat.NewVecDense(n, nil)
+	for i := 0; i < n; i++ {
+		b.SetVec(i, math.Sin(float64(i)))
+	}
+	jacobi, _ := NewJacobi(a)
+	ssor, _ := NewSSOR(a, 1.5)
+	ilu, _ := NewILU0(a)
+	ic, _ := NewIC0(a)
+	for _, test := range []struct {
+		name   string
+		method func() Method
+		precon []Preconditioner
+	}{
+		{name: "CG", method: func() Method { return &CG{} }, precon: []Preconditioner{ssor, ic}},
+		{name: "GMRES", method: func() Method { return &GMRES{Restart: 50} }, precon: []Preconditioner{ssor, ilu}},
+		{name: "BiCGSTAB", method: func() Method { return &BiCGSTAB{} }, precon: []Preconditioner{ssor, ilu}},
+	} {
+		plain, err := Iterative(a, b, test.method(), &Settings{Preconditioner: jacobi, MaxIterations: 10 * n})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		for _, p := range test.precon {
+			res, err := Iterative(a, b, test.method(), &Settings{Preconditioner: p, MaxIterations: 10 * n})
+			if err != nil {
+				t.Errorf("%s %T: unexpected error: %v", test.name, p, err)
+				continue
+			}
+			var r mat.VecDense
+			a.MulVecTo(&r, false, res.X)
+			r.SubVec(b, &r)
+			if mat.Norm(&r, 2) > 1e-7*mat.Norm(b, 2) {
+				t.Errorf("%s %T: residual too large: %v", test.name, p, mat.Norm(&r, 2))
+			}
+			if res.Stats.Iterations >= plain.Stats.Iterations {
+				t.Errorf("%s %T: preconditioner did not reduce iterations: got:%d Jacobi:%d",
+					test.name, p, res.Stats.Iterations, plain.Stats.Iterations)
+			}
+		}
+	}
+
+	if !panics(func() {
+		Iterative(a, b, &CG{}, &Settings{Preconditioner: ic, PreconSolve: ic.PreconSolve})
+	}) {
+		t.Errorf("expected panic for both PreconSolve and Preconditioner set")
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
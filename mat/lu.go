@@ -79,12 +79,12 @@ func (lu *LU) T() Matrix {
 
 // updateCond updates the stored condition number of the matrix. anorm is the
 // norm of the original matrix. If anorm is negative it will be estimated.
-func (lu *LU) updateCond(anorm float64, norm lapack.MatrixNorm) {
+func (lu *LU) updateCond(anorm float64, norm lapack.MatrixNorm, ws *Workspace) {
 	n := lu.lu.mat.Cols
-	work := getFloat64s(4*n, false)
-	defer putFloat64s(work)
-	iwork := getInts(n, false)
-	defer putInts(iwork)
+	work := ws.float64s(4 * n)
+	defer ws.putFloat64s(work)
+	iwork := ws.ints(n)
+	defer ws.putInts(iwork)
 	if anorm < 0 {
 		// This is an approximation. By the definition of a norm,
 		//  |AB| <= |A| |B|.
@@ -111,14 +111,22 @@ func (lu *LU) updateCond(anorm float64, norm lapack.MatrixNorm) {
 // LTo and UTo methods. The matrix P can be extracted as a row permutation using
 // the RowPivots method and applied using Dense.PermuteRows.
 func (lu *LU) Factorize(a Matrix) {
-	lu.factorize(a, CondNorm)
+	lu.factorize(a, CondNorm, nil)
 }
 
-func (lu *LU) factorize(a Matrix, norm lapack.MatrixNorm) {
+// FactorizeWith computes the LU factorization of the square matrix A as
+// Factorize does, taking temporary storage from ws. If ws is nil,
+// FactorizeWith is equivalent to Factorize.
+func (lu *LU) FactorizeWith(a Matrix, ws *Workspace) {
+	lu.factorize(a, CondNorm, ws)
+}
+
+func (lu *LU) factorize(a Matrix, norm lapack.MatrixNorm, ws *Workspace) {
 	m, n := a.Dims()
 	if m != n {
 		panic(ErrSquare)
 	}
+	ws.reset()
 	if lu.lu == nil {
 		lu.lu = NewDense(n, n, nil)
 	} else {
@@ -128,12 +136,12 @@ func (lu *LU) factorize(a Matrix, norm lapack.MatrixNorm) {
 	lu.lu.Copy(a)
 	lu.swaps = useInt(lu.swaps, n)
 	lu.piv = useInt(lu.piv, n)
-	work := getFloat64s(n, false)
+	work := ws.float64s(n)
 	anorm := lapack64.Lange(norm, lu.lu.mat, work)
-	putFloat64s(work)
+	ws.putFloat64s(work)
 	lu.ok = lapack64.Getrf(lu.lu.mat, lu.swaps)
 	lu.updatePivots(lu.swaps)
-	lu.updateCond(anorm, norm)
+	lu.updateCond(anorm, norm, ws)
 }
 
 func (lu *LU) updatePivots(swaps []int) {
@@ -313,7 +321,7 @@ func (lu *LU) RankOne(orig *LU, alpha float64, x, y Vector) {
 			lum.Data[j*lum.Stride+i] += gamma * tmp
 		}
 	}
-	lu.updateCond(-1, CondNorm)
+	lu.updateCond(-1, CondNorm, nil)
 }
 
 // LTo extracts the lower triangular matrix from an LU factorization.
@@ -0,0 +1,99 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import "math"
+
+var (
+	masked *Masked
+
+	_ Matrix = masked
+)
+
+// Masked is a view of a matrix in which selected elements are hidden. Hidden
+// elements are reported as NaN, so the NaN-ignoring reductions NaNSum, NaNMax,
+// NaNMin, NaNCount and NaNNorm treat them as missing values. Changes to the
+// elements of the underlying matrix are reflected in the Masked view.
+type Masked struct {
+	mat  Matrix
+	mask []bool
+	r, c int
+}
+
+// NewMasked returns a view of a with the elements hidden that are true in
+// mask, which holds a boolean for each element of a in row-major order. If
+// mask is nil, no elements are hidden. The mask is copied.
+//
+// NewMasked will panic with ErrShape if mask is not nil and its length is
+// not the number of elements of a.
+func NewMasked(a Matrix, mask []bool) *Masked {
+	r, c := a.Dims()
+	if mask != nil && len(mask) != r*c {
+		panic(ErrShape)
+	}
+	m := make([]bool, r*c)
+	copy(m, mask)
+	return &Masked{mat: a, mask: m, r: r, c: c}
+}
+
+// Dims returns the dimensions of the matrix.
+func (m *Masked) Dims() (r, c int) {
+	return m.r, m.c
+}
+
+// At returns the element at row i and column j of the underlying matrix, or
+// NaN if the element is hidden.
+func (m *Masked) At(i, j int) float64 {
+	if m.IsMasked(i, j) {
+		return math.NaN()
+	}
+	return m.mat.At(i, j)
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (m *Masked) T() Matrix {
+	return Transpose{m}
+}
+
+// IsMasked returns whether the element at row i and column j is hidden.
+func (m *Masked) IsMasked(i, j int) bool {
+	if uint(i) >= uint(m.r) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.c) {
+		panic(ErrColAccess)
+	}
+	return m.mask[i*m.c+j]
+}
+
+// SetMask sets whether the element at row i and column j is hidden.
+func (m *Masked) SetMask(i, j int, hidden bool) {
+	if uint(i) >= uint(m.r) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(m.c) {
+		panic(ErrColAccess)
+	}
+	m.mask[i*m.c+j] = hidden
+}
+
+// MaskFunc hides each element of the underlying matrix for which fn returns
+// true. fn is called with the row and column indices and the value of each
+// element. Elements that are already hidden remain hidden.
+func (m *Masked) MaskFunc(fn func(i, j int, v float64) bool) {
+	for i := 0; i < m.r; i++ {
+		for j := 0; j < m.c; j++ {
+			if fn(i, j, m.mat.At(i, j)) {
+				m.mask[i*m.c+j] = true
+			}
+		}
+	}
+}
+
+// Unmasked returns the underlying matrix.
+func (m *Masked) Unmasked() Matrix {
+	return m.mat
+}
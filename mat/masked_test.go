@@ -0,0 +1,82 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMasked(t *testing.T) {
+	t.Parallel()
+	a := NewDense(2, 3, []float64{
+		1, 2, 3,
+		4, 5, 6,
+	})
+	m := NewMasked(a, []bool{
+		false, true, false,
+		false, false, true,
+	})
+	if r, c := m.Dims(); r != 2 || c != 3 {
+		t.Fatalf("unexpected dimensions: %d×%d", r, c)
+	}
+	if !math.IsNaN(m.At(0, 1)) || !math.IsNaN(m.At(1, 2)) {
+		t.Errorf("hidden elements not reported as NaN")
+	}
+	if m.At(1, 0) != 4 || m.T().At(2, 0) != 3 {
+		t.Errorf("unexpected visible element")
+	}
+	if got := NaNSum(m); got != 13 {
+		t.Errorf("unexpected NaNSum: got %v, want 13", got)
+	}
+	if got := NaNMax(m); got != 5 {
+		t.Errorf("unexpected NaNMax: got %v, want 5", got)
+	}
+	if got := NaNCount(m); got != 2 {
+		t.Errorf("unexpected NaNCount: got %v, want 2", got)
+	}
+
+	m.SetMask(0, 1, false)
+	if m.IsMasked(0, 1) || m.At(0, 1) != 2 {
+		t.Errorf("element not revealed by SetMask")
+	}
+	m.MaskFunc(func(_, _ int, v float64) bool { return v > 4 })
+	want := []bool{
+		false, false, false,
+		false, true, true,
+	}
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 3; j++ {
+			if m.IsMasked(i, j) != want[i*3+j] {
+				t.Errorf("unexpected mask at (%d,%d)", i, j)
+			}
+		}
+	}
+
+	// Changes to the underlying matrix are visible.
+	a.Set(0, 0, -1)
+	if got := NaNMin(m); got != -1 {
+		t.Errorf("unexpected NaNMin after modification: got %v, want -1", got)
+	}
+	if m.Unmasked() != a {
+		t.Errorf("unexpected underlying matrix")
+	}
+
+	// Copying materializes hidden elements as NaN.
+	d := DenseCopyOf(m)
+	if NaNCount(d) != 2 {
+		t.Errorf("unexpected NaN count in copy: %v", NaNCount(d))
+	}
+
+	if panicked, message := panics(func() { NewMasked(a, make([]bool, 5)) }); !panicked || message != ErrShape.Error() {
+		t.Errorf("unexpected panic for bad mask length: %q", message)
+	}
+	if panicked, message := panics(func() { m.SetMask(2, 0, true) }); !panicked || message != ErrRowAccess.Error() {
+		t.Errorf("unexpected panic for bad row: %q", message)
+	}
+	if panicked, message := panics(func() { m.At(0, 3) }); !panicked || message != ErrColAccess.Error() {
+		t.Errorf("unexpected panic for bad column: %q", message)
+	}
+}
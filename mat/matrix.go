@@ -230,6 +230,15 @@ type SolveToer interface {
 	SolveTo(dst *Dense, trans bool, b Matrix) error
 }
 
+// A LinearOperator is a linear operator that is accessed only through its
+// dimensions and its products with vectors. Large sparse or implicitly
+// represented matrices can be used through a LinearOperator without forming
+// their elements.
+type LinearOperator interface {
+	Dims() (r, c int)
+	MulVecTo(dst *VecDense, trans bool, x Vector)
+}
+
 // untranspose untransposes a matrix if applicable. If a is an Untransposer, then
 // untranspose returns the underlying matrix and true. If it is not, then it returns
 // the input matrix and false.
@@ -409,13 +418,13 @@ func Cond(a Matrix, norm float64) float64 {
 	if m == n {
 		// Use the LU decomposition to compute the condition number.
 		var lu LU
-		lu.factorize(a, lnorm)
+		lu.factorize(a, lnorm, nil)
 		return lu.Cond()
 	}
 	if m > n {
 		// Use the QR factorization to compute the condition number.
 		var qr QR
-		qr.factorize(a, lnorm)
+		qr.factorize(a, lnorm, nil)
 		return qr.Cond()
 	}
 	// Use the LQ factorization to compute the condition number.
@@ -767,6 +776,18 @@ func Min(a Matrix) float64 {
 	}
 }
 
+// Matrix norm orders, in addition to 1, 2 and Inf, that are accepted by Norm.
+// They are negative so that they cannot be confused with the orders of
+// p-norms.
+const (
+	// NuclearNorm specifies the nuclear norm, also known as the trace
+	// norm, the sum of the singular values of the matrix.
+	NuclearNorm = -1
+	// MaxAbsNorm specifies the entrywise maximum norm, the largest
+	// absolute value of the elements of the matrix.
+	MaxAbsNorm = -2
+)
+
 // A Normer can compute a norm of the matrix. Valid norms are:
 //
 //	1 - The maximum absolute column sum
@@ -781,8 +802,13 @@ type Normer interface {
 //	1 - The maximum absolute column sum
 //	2 - The Frobenius norm, the square root of the sum of the squares of the elements
 //	Inf - The maximum absolute row sum
+//	NuclearNorm - The sum of the singular values
+//	MaxAbsNorm - The maximum absolute value of the elements
 //
-// If a is a Normer, its Norm method will be used to calculate the norm.
+// If a is a Normer, its Norm method will be used to calculate the 1, 2, Inf
+// and MaxAbsNorm norms. The nuclear norm is computed from the singular values of A, or
+// from the eigenvalues if A is a *SymDense, without computing the singular
+// vectors.
 //
 // Norm will panic with ErrNormOrder if an illegal norm is specified and with
 // ErrShape if the matrix has zero size.
@@ -792,6 +818,9 @@ func Norm(a Matrix, norm float64) float64 {
 		panic(ErrZeroLength)
 	}
 	m, trans := untransposeExtract(a)
+	if norm == NuclearNorm {
+		return nuclearNorm(m)
+	}
 	if m, ok := m.(Normer); ok {
 		if trans {
 			switch norm {
@@ -839,6 +868,14 @@ func Norm(a Matrix, norm float64) float64 {
 			}
 		}
 		return max
+	case MaxAbsNorm:
+		var max float64
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				max = math.Max(max, math.Abs(a.At(i, j)))
+			}
+		}
+		return max
 	}
 }
 
@@ -859,11 +896,44 @@ func normLapack(norm float64, aTrans bool) lapack.MatrixNorm {
 			n = lapack.MaxColumnSum
 		}
 		return n
+	case MaxAbsNorm:
+		return lapack.MaxAbs
 	default:
 		panic(ErrNormOrder)
 	}
 }
 
+// nuclearNorm returns the sum of the singular values of a.
+func nuclearNorm(a Matrix) float64 {
+	var sum float64
+	switch a := a.(type) {
+	case *DiagDense:
+		for i := 0; i < a.mat.N; i++ {
+			sum += math.Abs(a.mat.Data[i*a.mat.Inc])
+		}
+		return sum
+	case *SymDense:
+		// The singular values of a symmetric matrix are the absolute
+		// values of its eigenvalues.
+		var eig EigenSym
+		if !eig.Factorize(a, false) {
+			panic(ErrFailedEigen)
+		}
+		for _, v := range eig.Values(nil) {
+			sum += math.Abs(v)
+		}
+		return sum
+	}
+	var svd SVD
+	if !svd.Factorize(a, SVDNone) {
+		panic(ErrFailedSVD)
+	}
+	for _, v := range svd.Values(nil) {
+		sum += v
+	}
+	return sum
+}
+
 // Sum returns the sum of the elements of the matrix.
 //
 // Sum will panic with ErrZeroLength if the matrix has zero size.
@@ -461,6 +461,23 @@ func TestNorm(t *testing.T) {
 			ord:  math.Inf(1),
 			norm: 15,
 		},
+		{
+			a:    [][]float64{{1, -2, -2}, {-4, 5, 6}},
+			ord:  MaxAbsNorm,
+			norm: 6,
+		},
+		{
+			a:    [][]float64{{3, 0}, {0, -4}},
+			ord:  NuclearNorm,
+			norm: 7,
+		},
+		{
+			// A rank one matrix has a single non-zero singular value,
+			// so its nuclear norm is its Frobenius norm.
+			a:    [][]float64{{1, 2}, {2, 4}, {-3, -6}},
+			ord:  NuclearNorm,
+			norm: math.Sqrt(70),
+		},
 	} {
 		a := NewDense(flatten(test.a))
 		if math.Abs(Norm(a, test.ord)-test.norm) > 1e-14 {
@@ -475,6 +492,8 @@ func TestNorm(t *testing.T) {
 		{"NormOne", 1},
 		{"NormTwo", 2},
 		{"NormInf", math.Inf(1)},
+		{"NormNuclear", NuclearNorm},
+		{"NormMaxAbs", MaxAbsNorm},
 	} {
 		f := func(a Matrix) interface{} {
 			return Norm(a, test.norm)
@@ -486,6 +505,28 @@ func TestNorm(t *testing.T) {
 	}
 }
 
+func TestNormerMaxAbs(t *testing.T) {
+	t.Parallel()
+	for _, a := range []Normer{
+		NewDense(2, 3, []float64{1, -7, 3, 4, 5, 6}),
+		NewSymDense(2, []float64{1, -7, -7, 5}),
+		NewTriDense(2, Upper, []float64{1, -7, 0, 5}),
+		NewBandDense(2, 3, 0, 1, []float64{1, -7, 5, 3}),
+		NewSymBandDense(2, 1, []float64{1, -7, 5, 0}),
+		NewDiagDense(3, []float64{1, -7, 5}),
+		NewTriBandDense(2, 1, Upper, []float64{1, -7, 5, 0}),
+		NewTridiag(3, []float64{2, -7}, []float64{1, 5, 3}, []float64{0, 4}),
+		NewVecDense(3, []float64{1, -7, 5}),
+	} {
+		if got := a.Norm(MaxAbsNorm); got != 7 {
+			t.Errorf("unexpected max abs norm for %T: got %v, want 7", a, got)
+		}
+		if got := Norm(a.(Matrix), MaxAbsNorm); got != 7 {
+			t.Errorf("unexpected max abs norm from Norm for %T: got %v, want 7", a, got)
+		}
+	}
+}
+
 func TestNormZero(t *testing.T) {
 	t.Parallel()
 	for _, a := range []Matrix{
@@ -496,7 +537,7 @@ func TestNormZero(t *testing.T) {
 		&TriDense{mat: blas64.Triangular{Uplo: blas.Upper, Diag: blas.NonUnit}},
 		&VecDense{},
 	} {
-		for _, norm := range []float64{1, 2, math.Inf(1)} {
+		for _, norm := range []float64{1, 2, math.Inf(1), NuclearNorm, MaxAbsNorm} {
 			panicked, message := panics(func() { Norm(a, norm) })
 			if !panicked {
 				t.Errorf("expected panic for Norm(&%T{}, %v)", a, norm)
@@ -0,0 +1,16 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package matrixmarket implements reading and writing of real matrices in
+// the Matrix Market exchange format.
+//
+// The Matrix Market format is a text format with an array variant for dense
+// matrices, holding all elements in column-major order, and a coordinate
+// variant for sparse matrices, holding the indices and values of the stored
+// elements. Symmetric and skew-symmetric matrices store only their lower
+// triangle. Most published collections of sparse test matrices, including
+// the SuiteSparse Matrix Collection, are distributed in this format.
+//
+// The format is described at https://math.nist.gov/MatrixMarket/formats.html.
+package matrixmarket // import "gonum.org/v1/gonum/mat/matrixmarket"
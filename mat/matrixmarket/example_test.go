@@ -0,0 +1,54 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrixmarket_test
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/mat/matrixmarket"
+)
+
+func ExampleRead() {
+	const data = `%%MatrixMarket matrix coordinate real symmetric
+% The 1-D Laplacian.
+3 3 5
+1 1 2
+2 1 -1
+2 2 2
+3 2 -1
+3 3 2
+`
+	m, err := matrixmarket.Read(strings.NewReader(data))
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%T\n", m)
+	fmt.Printf("%v\n", mat.Formatted(m))
+
+	// Output:
+	// *mat.CSR
+	// ⎡ 2  -1   0⎤
+	// ⎢-1   2  -1⎥
+	// ⎣ 0  -1   2⎦
+}
+
+func ExampleWrite() {
+	a := mat.NewCSR(2, 3, []int{0, 2, 3}, []int{0, 2, 1}, []float64{1, 0.5, -3})
+	err := matrixmarket.Write(os.Stdout, a)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Output:
+	// %%MatrixMarket matrix coordinate real general
+	// 2 3 3
+	// 1 1 1
+	// 1 3 0.5
+	// 2 2 -3
+}
@@ -0,0 +1,281 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrixmarket
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+var readTests = []struct {
+	name string
+	in   string
+	want mat.Matrix
+}{
+	{
+		name: "array general",
+		in: `%%MatrixMarket matrix array real general
+% A comment.
+2 3
+1
+4
+2
+5
+
+3
+-6e-1
+`,
+		want: mat.NewDense(2, 3, []float64{1, 2, 3, 4, 5, -0.6}),
+	},
+	{
+		name: "array symmetric",
+		in: `%%MatrixMarket matrix array real symmetric
+3 3
+1
+2
+3
+4
+5
+6
+`,
+		want: mat.NewDense(3, 3, []float64{
+			1, 2, 3,
+			2, 4, 5,
+			3, 5, 6,
+		}),
+	},
+	{
+		name: "array skew-symmetric",
+		in: `%%MatrixMarket matrix array integer skew-symmetric
+3 3
+1
+2
+3
+`,
+		want: mat.NewDense(3, 3, []float64{
+			0, -1, -2,
+			1, 0, -3,
+			2, 3, 0,
+		}),
+	},
+	{
+		name: "coordinate general",
+		in: `%%MatrixMarket matrix coordinate real general
+%
+3 4 5
+1 1 1.5
+3 4 -2
+2 2 3
+1 1 0.5
+3 1 7
+`,
+		want: mat.NewDense(3, 4, []float64{
+			2, 0, 0, 0,
+			0, 3, 0, 0,
+			7, 0, 0, -2,
+		}),
+	},
+	{
+		name: "coordinate symmetric",
+		in: `%%MatrixMarket MATRIX Coordinate Real Symmetric
+3 3 4
+1 1 1
+2 1 2
+3 2 3
+3 3 4
+`,
+		want: mat.NewDense(3, 3, []float64{
+			1, 2, 0,
+			2, 0, 3,
+			0, 3, 4,
+		}),
+	},
+	{
+		name: "coordinate skew-symmetric",
+		in: `%%MatrixMarket matrix coordinate real skew-symmetric
+2 2 1
+2 1 5
+`,
+		want: mat.NewDense(2, 2, []float64{
+			0, -5,
+			5, 0,
+		}),
+	},
+	{
+		name: "coordinate pattern",
+		in: `%%MatrixMarket matrix coordinate pattern general
+2 3 2
+1 3
+2 1
+`,
+		want: mat.NewDense(2, 3, []float64{
+			0, 0, 1,
+			1, 0, 0,
+		}),
+	},
+}
+
+func TestRead(t *testing.T) {
+	t.Parallel()
+	for _, test := range readTests {
+		got, err := Read(strings.NewReader(test.in))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if strings.Contains(test.name, "coordinate") {
+			if _, ok := got.(*mat.CSR); !ok {
+				t.Errorf("%s: unexpected type: got:%T want:*mat.CSR", test.name, got)
+			}
+		} else if _, ok := got.(*mat.Dense); !ok {
+			t.Errorf("%s: unexpected type: got:%T want:*mat.Dense", test.name, got)
+		}
+		if !mat.Equal(got, test.want) {
+			t.Errorf("%s: unexpected matrix:\ngot:\n%v\nwant:\n%v",
+				test.name, mat.Formatted(got), mat.Formatted(test.want))
+		}
+	}
+}
+
+func TestReadHeader(t *testing.T) {
+	t.Parallel()
+	_, h, err := ReadWithHeader(strings.NewReader(readTests[4].in))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := Header{Format: "coordinate", Field: "real", Symmetry: "symmetric", Rows: 3, Cols: 3, Entries: 4}
+	if *h != want {
+		t.Errorf("unexpected header: got:%+v want:%+v", *h, want)
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		in   string
+		want error
+	}{
+		{name: "empty", in: "", want: io.ErrUnexpectedEOF},
+		{name: "complex", in: "%%MatrixMarket matrix coordinate complex general\n1 1 1\n1 1 1 0\n", want: ErrUnsupported},
+		{name: "hermitian", in: "%%MatrixMarket matrix coordinate real hermitian\n1 1 0\n", want: ErrUnsupported},
+		{name: "vector", in: "%%MatrixMarket vector coordinate real general\n1 0\n", want: ErrUnsupported},
+		{name: "truncated", in: "%%MatrixMarket matrix array real general\n2 2\n1\n2\n3\n", want: io.ErrUnexpectedEOF},
+		{name: "bad banner", in: "%MatrixMarket matrix array real general\n1 1\n1\n"},
+		{name: "bad format", in: "%%MatrixMarket matrix dense real general\n1 1\n1\n"},
+		{name: "bad field", in: "%%MatrixMarket matrix array float general\n1 1\n1\n"},
+		{name: "array pattern", in: "%%MatrixMarket matrix array pattern general\n1 1\n"},
+		{name: "bad size", in: "%%MatrixMarket matrix coordinate real general\n2 2\n"},
+		{name: "zero size", in: "%%MatrixMarket matrix array real general\n0 2\n"},
+		{name: "non-square symmetric", in: "%%MatrixMarket matrix coordinate real symmetric\n2 3 0\n"},
+		{name: "index out of range", in: "%%MatrixMarket matrix coordinate real general\n2 2 1\n3 1 1\n"},
+		{name: "zero index", in: "%%MatrixMarket matrix coordinate real general\n2 2 1\n0 1 1\n"},
+		{name: "bad value", in: "%%MatrixMarket matrix coordinate real general\n2 2 1\n1 1 x\n"},
+		{name: "missing value", in: "%%MatrixMarket matrix coordinate real general\n2 2 1\n1 1\n"},
+		{name: "upper symmetric", in: "%%MatrixMarket matrix coordinate real symmetric\n2 2 1\n1 2 1\n"},
+		{name: "skew diagonal", in: "%%MatrixMarket matrix coordinate real skew-symmetric\n2 2 1\n1 1 1\n"},
+		{name: "trailing data", in: "%%MatrixMarket matrix array real general\n1 1\n1\n2\n"},
+	} {
+		m, err := Read(strings.NewReader(test.in))
+		if err == nil {
+			t.Errorf("%s: expected error", test.name)
+			continue
+		}
+		if m != nil {
+			t.Errorf("%s: unexpected non-nil matrix with error", test.name)
+		}
+		if test.want != nil && err != test.want {
+			t.Errorf("%s: unexpected error: got:%v want:%v", test.name, err, test.want)
+		}
+	}
+}
+
+func TestWrite(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		m    mat.Matrix
+		want string
+	}{
+		{
+			name: "dense",
+			m:    mat.NewDense(2, 2, []float64{1, 2, 3, 0.1}),
+			want: "%%MatrixMarket matrix array real general\n2 2\n1\n3\n2\n0.1\n",
+		},
+		{
+			name: "symmetric",
+			m:    mat.NewSymDense(2, []float64{1, 2, 2, 3}),
+			want: "%%MatrixMarket matrix array real symmetric\n2 2\n1\n2\n3\n",
+		},
+		{
+			name: "sparse",
+			m:    mat.NewCSR(2, 3, []int{0, 1, 2}, []int{2, 0}, []float64{-1.5, 4}),
+			want: "%%MatrixMarket matrix coordinate real general\n2 3 2\n1 3 -1.5\n2 1 4\n",
+		},
+		{
+			name: "symmetric band",
+			m:    mat.NewSymBandDense(3, 1, []float64{1, 2, 3, 4, 5, 0}),
+			want: "%%MatrixMarket matrix coordinate real symmetric\n3 3 5\n1 1 1\n2 1 2\n2 2 3\n3 2 4\n3 3 5\n",
+		},
+	} {
+		var buf bytes.Buffer
+		err := Write(&buf, test.m)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if buf.String() != test.want {
+			t.Errorf("%s: unexpected output:\ngot:\n%s\nwant:\n%s", test.name, buf.String(), test.want)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	const r, c = 20, 15
+	dense := mat.NewDense(r, c, nil)
+	var ii, jj []int
+	var vv []float64
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			v := rnd.NormFloat64()
+			dense.Set(i, j, v)
+			if rnd.Float64() < 0.2 {
+				ii = append(ii, i)
+				jj = append(jj, j)
+				vv = append(vv, v)
+			}
+		}
+	}
+	sym := mat.NewSymDense(c, nil)
+	for i := 0; i < c; i++ {
+		for j := i; j < c; j++ {
+			sym.SetSym(i, j, rnd.NormFloat64())
+		}
+	}
+	for _, m := range []mat.Matrix{
+		dense,
+		sym,
+		mat.NewCSRFromTriplets(r, c, ii, jj, vv),
+	} {
+		var buf bytes.Buffer
+		if err := Write(&buf, m); err != nil {
+			t.Fatalf("unexpected error writing %T: %v", m, err)
+		}
+		got, err := Read(&buf)
+		if err != nil {
+			t.Fatalf("unexpected error reading %T: %v", m, err)
+		}
+		if !mat.Equal(got, m) {
+			t.Errorf("round trip of %T does not preserve matrix", m)
+		}
+	}
+}
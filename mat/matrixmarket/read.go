@@ -0,0 +1,321 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrixmarket
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// banner is the first token of a Matrix Market file.
+const banner = "%%MatrixMarket"
+
+// Header holds the description of a matrix from the banner and size lines
+// of a Matrix Market file.
+type Header struct {
+	// Format is "array" or "coordinate".
+	Format string
+	// Field is "real", "double", "integer" or "pattern".
+	Field string
+	// Symmetry is "general", "symmetric" or "skew-symmetric".
+	Symmetry string
+
+	// Rows and Cols are the dimensions of the matrix.
+	Rows, Cols int
+	// Entries is the number of elements held in the file.
+	Entries int
+}
+
+// ErrUnsupported is returned when a Matrix Market file holds an object
+// that is not a real matrix.
+var ErrUnsupported = errors.New("matrixmarket: unsupported matrix type")
+
+// Read reads a matrix in Matrix Market format from r.
+//
+// Matrices in array format are returned as a *mat.Dense and matrices in
+// coordinate format are returned as a *mat.CSR. The missing triangle of
+// symmetric and skew-symmetric matrices is filled in, and the elements of
+// pattern matrices are set to one. Duplicate coordinate entries are summed.
+// Read returns ErrUnsupported for complex and Hermitian matrices and for
+// objects other than matrices.
+func Read(r io.Reader) (mat.Matrix, error) {
+	m, _, err := ReadWithHeader(r)
+	return m, err
+}
+
+// ReadWithHeader reads a matrix in Matrix Market format from r and returns
+// it with the header of the file. See Read for details.
+func ReadWithHeader(r io.Reader) (mat.Matrix, *Header, error) {
+	p := parser{sc: bufio.NewScanner(r)}
+	p.sc.Buffer(nil, 1<<20)
+	h, err := p.header()
+	if err != nil {
+		return nil, nil, err
+	}
+	var m mat.Matrix
+	if h.Format == "array" {
+		m, err = p.array(h)
+	} else {
+		m, err = p.coordinate(h)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return m, h, nil
+}
+
+// parser reads the lines of a Matrix Market file.
+type parser struct {
+	sc   *bufio.Scanner
+	line int
+}
+
+// next returns the fields of the next line that is not blank and not a
+// comment.
+func (p *parser) next() ([]string, error) {
+	for p.sc.Scan() {
+		p.line++
+		text := p.sc.Text()
+		if strings.HasPrefix(text, "%") {
+			continue
+		}
+		f := strings.Fields(text)
+		if len(f) != 0 {
+			return f, nil
+		}
+	}
+	if err := p.sc.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.ErrUnexpectedEOF
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("matrixmarket: line %d: %s", p.line, fmt.Sprintf(format, args...))
+}
+
+// header parses the banner and size lines.
+func (p *parser) header() (*Header, error) {
+	if !p.sc.Scan() {
+		if err := p.sc.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.ErrUnexpectedEOF
+	}
+	p.line++
+	f := strings.Fields(p.sc.Text())
+	if len(f) != 5 || f[0] != banner {
+		return nil, p.errorf("invalid banner")
+	}
+	for i := range f[1:] {
+		f[i+1] = strings.ToLower(f[i+1])
+	}
+	if f[1] != "matrix" {
+		return nil, ErrUnsupported
+	}
+	h := &Header{Format: f[2], Field: f[3], Symmetry: f[4]}
+	switch h.Format {
+	case "array", "coordinate":
+	default:
+		return nil, p.errorf("invalid format %q", h.Format)
+	}
+	switch h.Field {
+	case "real", "double", "integer":
+	case "pattern":
+		if h.Format == "array" {
+			return nil, p.errorf("pattern field in array format")
+		}
+	case "complex":
+		return nil, ErrUnsupported
+	default:
+		return nil, p.errorf("invalid field %q", h.Field)
+	}
+	switch h.Symmetry {
+	case "general", "symmetric", "skew-symmetric":
+	case "hermitian":
+		return nil, ErrUnsupported
+	default:
+		return nil, p.errorf("invalid symmetry %q", h.Symmetry)
+	}
+
+	f, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+	want := 3
+	if h.Format == "array" {
+		want = 2
+	}
+	if len(f) != want {
+		return nil, p.errorf("invalid size line")
+	}
+	size := make([]int, len(f))
+	for i, s := range f {
+		size[i], err = strconv.Atoi(s)
+		if err != nil || size[i] < 0 {
+			return nil, p.errorf("invalid size %q", s)
+		}
+	}
+	h.Rows, h.Cols = size[0], size[1]
+	if h.Rows == 0 || h.Cols == 0 {
+		return nil, p.errorf("zero matrix dimension")
+	}
+	if h.Symmetry != "general" && h.Rows != h.Cols {
+		return nil, p.errorf("non-square %s matrix", h.Symmetry)
+	}
+	if h.Format == "array" {
+		h.Entries = h.Rows * h.Cols
+		switch h.Symmetry {
+		case "symmetric":
+			h.Entries = h.Rows * (h.Rows + 1) / 2
+		case "skew-symmetric":
+			h.Entries = h.Rows * (h.Rows - 1) / 2
+		}
+	} else {
+		h.Entries = size[2]
+	}
+	return h, nil
+}
+
+// value parses a matrix element.
+func (p *parser) value(s string) (float64, error) {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, p.errorf("invalid value %q", s)
+	}
+	return v, nil
+}
+
+// array reads the elements of a matrix in array format.
+func (p *parser) array(h *Header) (*mat.Dense, error) {
+	m := mat.NewDense(h.Rows, h.Cols, nil)
+	i, j := 0, 0
+	if h.Symmetry == "skew-symmetric" {
+		i = 1
+	}
+	for k := 0; k < h.Entries; k++ {
+		f, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		if len(f) != 1 {
+			return nil, p.errorf("invalid array entry")
+		}
+		v, err := p.value(f[0])
+		if err != nil {
+			return nil, err
+		}
+		m.Set(i, j, v)
+		switch h.Symmetry {
+		case "symmetric":
+			m.Set(j, i, v)
+		case "skew-symmetric":
+			m.Set(j, i, -v)
+		}
+
+		// Advance in column-major order over the stored
+		// part of the matrix.
+		i++
+		if i == h.Rows {
+			j++
+			switch h.Symmetry {
+			case "general":
+				i = 0
+			case "symmetric":
+				i = j
+			case "skew-symmetric":
+				i = j + 1
+			}
+		}
+	}
+	if err := p.end(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// coordinate reads the elements of a matrix in coordinate format.
+func (p *parser) coordinate(h *Header) (*mat.CSR, error) {
+	want := 3
+	if h.Field == "pattern" {
+		want = 2
+	}
+	// Do not trust the size line for the allocation.
+	n := min(h.Entries, 1<<16)
+	if h.Symmetry != "general" {
+		n *= 2
+	}
+	ii := make([]int, 0, n)
+	jj := make([]int, 0, n)
+	vv := make([]float64, 0, n)
+	for k := 0; k < h.Entries; k++ {
+		f, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		if len(f) != want {
+			return nil, p.errorf("invalid coordinate entry")
+		}
+		i, erri := strconv.Atoi(f[0])
+		j, errj := strconv.Atoi(f[1])
+		if erri != nil || errj != nil || i < 1 || h.Rows < i || j < 1 || h.Cols < j {
+			return nil, p.errorf("invalid index (%s, %s)", f[0], f[1])
+		}
+		i--
+		j--
+		v := 1.0
+		if h.Field != "pattern" {
+			v, err = p.value(f[2])
+			if err != nil {
+				return nil, err
+			}
+		}
+		if h.Symmetry != "general" && j > i {
+			return nil, p.errorf("entry (%d, %d) above the diagonal of %s matrix", i+1, j+1, h.Symmetry)
+		}
+		ii = append(ii, i)
+		jj = append(jj, j)
+		vv = append(vv, v)
+		if i == j {
+			if h.Symmetry == "skew-symmetric" {
+				return nil, p.errorf("diagonal entry in skew-symmetric matrix")
+			}
+			continue
+		}
+		switch h.Symmetry {
+		case "symmetric":
+			ii = append(ii, j)
+			jj = append(jj, i)
+			vv = append(vv, v)
+		case "skew-symmetric":
+			ii = append(ii, j)
+			jj = append(jj, i)
+			vv = append(vv, -v)
+		}
+	}
+	if err := p.end(); err != nil {
+		return nil, err
+	}
+	return mat.NewCSRFromTriplets(h.Rows, h.Cols, ii, jj, vv), nil
+}
+
+// end checks that there is no data following the matrix elements.
+func (p *parser) end() error {
+	_, err := p.next()
+	switch err {
+	case io.ErrUnexpectedEOF:
+		return nil
+	case nil:
+		return p.errorf("unexpected data after matrix elements")
+	default:
+		return err
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package matrixmarket
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// Write writes the matrix m to w in Matrix Market format.
+//
+// Matrices that implement mat.NonZeroDoer, such as *mat.CSR, are written in
+// coordinate format holding the elements visited by DoNonZero, and all
+// other matrices are written in array format. Matrices that implement
+// mat.Symmetric are written as symmetric matrices holding their lower
+// triangle. Elements are written with the minimal number of digits that
+// allows them to be read back exactly.
+func Write(w io.Writer, m mat.Matrix) error {
+	bw := bufio.NewWriter(w)
+	r, c := m.Dims()
+	_, isSym := m.(mat.Symmetric)
+	symmetry := "general"
+	if isSym {
+		symmetry = "symmetric"
+	}
+
+	var buf []byte
+	if nz, ok := m.(mat.NonZeroDoer); ok {
+		var n int
+		nz.DoNonZero(func(i, j int, _ float64) {
+			if !isSym || j <= i {
+				n++
+			}
+		})
+		fmt.Fprintf(bw, "%s matrix coordinate real %s\n%d %d %d\n", banner, symmetry, r, c, n)
+		nz.DoNonZero(func(i, j int, v float64) {
+			if isSym && j > i {
+				return
+			}
+			buf = strconv.AppendInt(buf[:0], int64(i+1), 10)
+			buf = append(buf, ' ')
+			buf = strconv.AppendInt(buf, int64(j+1), 10)
+			buf = append(buf, ' ')
+			buf = strconv.AppendFloat(buf, v, 'g', -1, 64)
+			buf = append(buf, '\n')
+			bw.Write(buf)
+		})
+		return bw.Flush()
+	}
+
+	fmt.Fprintf(bw, "%s matrix array real %s\n%d %d\n", banner, symmetry, r, c)
+	for j := 0; j < c; j++ {
+		i := 0
+		if isSym {
+			i = j
+		}
+		for ; i < r; i++ {
+			buf = strconv.AppendFloat(buf[:0], m.At(i, j), 'g', -1, 64)
+			buf = append(buf, '\n')
+			bw.Write(buf)
+		}
+	}
+	return bw.Flush()
+}
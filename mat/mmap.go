@@ -0,0 +1,151 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+)
+
+// MapMode specifies how the pages of a memory-mapped matrix are protected.
+type MapMode int
+
+const (
+	// MapReadOnly maps the file read-only. Any attempt to write to
+	// the elements of the mapped matrix will cause the program to
+	// fault.
+	MapReadOnly MapMode = iota
+
+	// MapCopyOnWrite maps the file privately. Writes to the mapped
+	// matrix are permitted but are visible only to the process and
+	// are never written back to the file.
+	MapCopyOnWrite
+)
+
+var (
+	errMapUnsupported = errors.New("mat: memory mapping not supported on this platform")
+	errMapEndian      = errors.New("mat: memory mapping requires a little-endian host")
+	errMapMode        = errors.New("mat: invalid map mode")
+	errMapAlign       = errors.New("mat: data offset not aligned to float64")
+)
+
+// MappedDense is a Dense matrix whose element storage is backed by a
+// memory-mapped file. Pages of the file are loaded on demand by the
+// operating system, so a MappedDense may be larger than the available
+// memory while still being usable as a *Dense, including slicing and
+// passing to BLAS routines.
+//
+// The *Dense returned by the Dense method, and any views of it, must not
+// be used after Close has been called.
+type MappedDense struct {
+	dense *Dense
+	data  []byte
+}
+
+// MapDense maps the file with the given name into memory and returns a
+// MappedDense that uses the file contents as its backing data. The file
+// must hold a Dense matrix in the layout written by Dense.MarshalBinary
+// and Dense.MarshalBinaryTo.
+//
+// MapDense is only supported on little-endian unix platforms when the
+// safe build tag is not used.
+func MapDense(name string, mode MapMode) (*MappedDense, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var header storage
+	_, err = header.unmarshalBinaryFrom(f)
+	if err != nil {
+		return nil, err
+	}
+	rows := header.Rows
+	cols := header.Cols
+	header.Version = 0
+	header.Rows = 0
+	header.Cols = 0
+	if (header != storage{Form: 'G', Packing: 'F', Uplo: 'A'}) {
+		return nil, errWrongType
+	}
+	return mapDense(f, int64(headerSize), rows, cols, mode)
+}
+
+// MapDenseData maps the file with the given name into memory and returns
+// a MappedDense with r rows and c columns that uses the file contents as
+// its backing data. The matrix elements are read as little-endian float64
+// values in row-major order starting offset bytes into the file, and the
+// file must end with the last element. The offset must be a multiple of
+// eight.
+//
+// MapDenseData is only supported on little-endian unix platforms when the
+// safe build tag is not used.
+func MapDenseData(name string, offset int64, r, c int, mode MapMode) (*MappedDense, error) {
+	if offset < 0 || offset%int64(sizeFloat64) != 0 {
+		return nil, errMapAlign
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return mapDense(f, offset, int64(r), int64(c), mode)
+}
+
+// mapDense checks the requested dimensions against the size of f and
+// maps the matrix data starting at offset.
+func mapDense(f *os.File, offset, rows, cols int64, mode MapMode) (*MappedDense, error) {
+	if mode != MapReadOnly && mode != MapCopyOnWrite {
+		return nil, errMapMode
+	}
+	if binary.NativeEndian.Uint16([]byte{1, 0}) != 1 {
+		return nil, errMapEndian
+	}
+	if rows < 0 || cols < 0 {
+		return nil, errBadSize
+	}
+	size := rows * cols
+	if size == 0 {
+		return nil, ErrZeroLength
+	}
+	if int(size) < 0 || size > maxLen || size > (maxLen-offset)/int64(sizeFloat64) {
+		return nil, errTooBig
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if fi.Size() != offset+size*int64(sizeFloat64) {
+		return nil, errBadBuffer
+	}
+	data, elems, err := mmapFloat64s(f, offset, int(size), mode)
+	if err != nil {
+		return nil, err
+	}
+	return &MappedDense{
+		dense: NewDense(int(rows), int(cols), elems),
+		data:  data,
+	}, nil
+}
+
+// Dense returns the memory-mapped matrix. The returned value must not be
+// used after m has been closed.
+func (m *MappedDense) Dense() *Dense {
+	return m.dense
+}
+
+// Close unmaps the file backing m. Calling Close more than once has no
+// further effect.
+func (m *MappedDense) Close() error {
+	if m.data == nil {
+		return nil
+	}
+	err := munmap(m.data)
+	m.data = nil
+	m.dense = nil
+	return err
+}
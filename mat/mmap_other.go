@@ -0,0 +1,17 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !unix || safe
+
+package mat
+
+import "os"
+
+func mmapFloat64s(f *os.File, offset int64, n int, mode MapMode) ([]byte, []float64, error) {
+	return nil, nil, errMapUnsupported
+}
+
+func munmap(data []byte) error {
+	return nil
+}
@@ -0,0 +1,143 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build unix && !safe
+
+package mat
+
+import (
+	"encoding/binary"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestMapDense(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	dir := t.TempDir()
+	for _, test := range []struct{ r, c int }{
+		{1, 1},
+		{3, 5},
+		{17, 4},
+		{100, 93},
+	} {
+		want := NewDense(test.r, test.c, nil)
+		for i := 0; i < test.r; i++ {
+			for j := 0; j < test.c; j++ {
+				want.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		buf, err := want.MarshalBinary()
+		if err != nil {
+			t.Fatalf("unexpected marshal error: %v", err)
+		}
+		name := filepath.Join(dir, "dense.bin")
+		err = os.WriteFile(name, buf, 0o644)
+		if err != nil {
+			t.Fatalf("unexpected write error: %v", err)
+		}
+
+		m, err := MapDense(name, MapReadOnly)
+		if err != nil {
+			t.Fatalf("unexpected error mapping %d×%d: %v", test.r, test.c, err)
+		}
+		if !Equal(m.Dense(), want) {
+			t.Errorf("unexpected mapped matrix for %d×%d:\ngot: %v\nwant:%v", test.r, test.c, Formatted(m.Dense()), Formatted(want))
+		}
+		var got Dense
+		got.Mul(m.Dense(), want.T())
+		var wantMul Dense
+		wantMul.Mul(want, want.T())
+		if !EqualApprox(&got, &wantMul, 1e-12) {
+			t.Errorf("unexpected product of mapped matrix for %d×%d", test.r, test.c)
+		}
+		err = m.Close()
+		if err != nil {
+			t.Errorf("unexpected error closing %d×%d: %v", test.r, test.c, err)
+		}
+		err = m.Close()
+		if err != nil {
+			t.Errorf("unexpected error on second close: %v", err)
+		}
+
+		m, err = MapDense(name, MapCopyOnWrite)
+		if err != nil {
+			t.Fatalf("unexpected error mapping %d×%d copy-on-write: %v", test.r, test.c, err)
+		}
+		m.Dense().Set(0, 0, math.Pi)
+		if m.Dense().At(0, 0) != math.Pi {
+			t.Errorf("copy-on-write write not visible in mapping")
+		}
+		m.Close()
+		onDisk, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatalf("unexpected read error: %v", err)
+		}
+		var back Dense
+		err = back.UnmarshalBinary(onDisk)
+		if err != nil {
+			t.Fatalf("unexpected unmarshal error: %v", err)
+		}
+		if !Equal(&back, want) {
+			t.Errorf("copy-on-write mapping modified file for %d×%d", test.r, test.c)
+		}
+	}
+}
+
+func TestMapDenseData(t *testing.T) {
+	t.Parallel()
+	const (
+		offset = 24
+		r, c   = 7, 3
+	)
+	buf := make([]byte, offset+r*c*8)
+	for i := 0; i < r*c; i++ {
+		binary.LittleEndian.PutUint64(buf[offset+8*i:], math.Float64bits(float64(i)))
+	}
+	name := filepath.Join(t.TempDir(), "raw.bin")
+	err := os.WriteFile(name, buf, 0o644)
+	if err != nil {
+		t.Fatalf("unexpected write error: %v", err)
+	}
+
+	m, err := MapDenseData(name, offset, r, c, MapReadOnly)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer m.Close()
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			if got := m.Dense().At(i, j); got != float64(i*c+j) {
+				t.Errorf("unexpected value at (%d,%d): got:%v want:%v", i, j, got, float64(i*c+j))
+			}
+		}
+	}
+	s := m.Dense().Slice(2, 5, 1, 3)
+	if got := s.At(1, 1); got != float64(3*c+2) {
+		t.Errorf("unexpected value in slice: got:%v want:%v", got, float64(3*c+2))
+	}
+
+	for _, test := range []struct {
+		offset int64
+		r, c   int
+		mode   MapMode
+		want   error
+	}{
+		{offset: 4, r: r, c: c, want: errMapAlign},
+		{offset: offset, r: r, c: c + 1, want: errBadBuffer},
+		{offset: offset, r: 0, c: c, want: ErrZeroLength},
+		{offset: offset, r: -1, c: c, want: errBadSize},
+		{offset: offset, r: r, c: c, mode: -1, want: errMapMode},
+	} {
+		_, err := MapDenseData(name, test.offset, test.r, test.c, test.mode)
+		if err != test.want {
+			t.Errorf("unexpected error for offset=%d r=%d c=%d mode=%d: got:%v want:%v",
+				test.offset, test.r, test.c, test.mode, err, test.want)
+		}
+	}
+}
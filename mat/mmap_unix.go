@@ -0,0 +1,41 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build unix && !safe
+
+package mat
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// mmapFloat64s maps n float64 values starting offset bytes into f. It
+// returns the complete mapping, which must be passed to munmap, and the
+// float64 view of the requested data.
+func mmapFloat64s(f *os.File, offset int64, n int, mode MapMode) ([]byte, []float64, error) {
+	// The mapping offset must be page-aligned, so map from the start
+	// of the page holding offset and slice off the leading bytes.
+	page := int64(os.Getpagesize())
+	start := offset - offset%page
+	length := int(offset-start) + n*sizeFloat64
+
+	prot := syscall.PROT_READ
+	flags := syscall.MAP_SHARED
+	if mode == MapCopyOnWrite {
+		prot |= syscall.PROT_WRITE
+		flags = syscall.MAP_PRIVATE
+	}
+	data, err := syscall.Mmap(int(f.Fd()), start, length, prot, flags)
+	if err != nil {
+		return nil, nil, &os.PathError{Op: "mmap", Path: f.Name(), Err: err}
+	}
+	b := data[offset-start:]
+	return data, unsafe.Slice((*float64)(unsafe.Pointer(&b[0])), n), nil
+}
+
+func munmap(data []byte) error {
+	return syscall.Munmap(data)
+}
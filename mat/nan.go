@@ -0,0 +1,158 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import "math"
+
+// The functions in this file are variants of the reductions Sum, Max, Min
+// and Norm that ignore NaN elements, which are commonly used to represent
+// missing values. The Masked type can be used to hide further elements from
+// them.
+
+// NaNSum returns the sum of the elements of the matrix A that are not NaN.
+// NaNSum returns zero if all elements are NaN.
+//
+// NaNSum will panic with ErrZeroLength if the matrix has zero size.
+func NaNSum(a Matrix) float64 {
+	var sum float64
+	doNonNaN(a, func(_, _ int, v float64) {
+		sum += v
+	})
+	return sum
+}
+
+// NaNMax returns the largest element value of the matrix A that is not NaN.
+// NaNMax returns NaN if all elements are NaN.
+//
+// NaNMax will panic with ErrZeroLength if the matrix has zero size.
+func NaNMax(a Matrix) float64 {
+	max := math.NaN()
+	doNonNaN(a, func(_, _ int, v float64) {
+		if !(v <= max) {
+			max = v
+		}
+	})
+	return max
+}
+
+// NaNMin returns the smallest element value of the matrix A that is not NaN.
+// NaNMin returns NaN if all elements are NaN.
+//
+// NaNMin will panic with ErrZeroLength if the matrix has zero size.
+func NaNMin(a Matrix) float64 {
+	min := math.NaN()
+	doNonNaN(a, func(_, _ int, v float64) {
+		if !(v >= min) {
+			min = v
+		}
+	})
+	return min
+}
+
+// NaNCount returns the number of NaN elements of the matrix A.
+//
+// NaNCount will panic with ErrZeroLength if the matrix has zero size.
+func NaNCount(a Matrix) int {
+	r, c := a.Dims()
+	n := r * c
+	doNonNaN(a, func(_, _ int, _ float64) {
+		n--
+	})
+	return n
+}
+
+// NaNNorm returns the specified norm of the matrix A with NaN elements
+// treated as zero. Valid norms are:
+//
+//	1 - The maximum absolute column sum
+//	2 - The Frobenius norm, the square root of the sum of the squares of the elements
+//	Inf - The maximum absolute row sum
+//
+// NaNNorm will panic with ErrNormOrder if an illegal norm is specified and
+// with ErrZeroLength if the matrix has zero size.
+func NaNNorm(a Matrix, norm float64) float64 {
+	r, c := a.Dims()
+	switch norm {
+	default:
+		panic(ErrNormOrder)
+	case 1:
+		sums := make([]float64, c)
+		doNonNaN(a, func(_, j int, v float64) {
+			sums[j] += math.Abs(v)
+		})
+		return maxNonNeg(sums)
+	case 2:
+		// Accumulate the scaled sum of squares to
+		// avoid overflow and underflow.
+		scale, ssq := 0.0, 1.0
+		doNonNaN(a, func(_, _ int, v float64) {
+			if v == 0 {
+				return
+			}
+			absv := math.Abs(v)
+			if scale < absv {
+				ssq = 1 + ssq*(scale/absv)*(scale/absv)
+				scale = absv
+			} else {
+				ssq += (absv / scale) * (absv / scale)
+			}
+		})
+		if math.IsInf(scale, 1) {
+			return scale
+		}
+		return scale * math.Sqrt(ssq)
+	case math.Inf(1):
+		sums := make([]float64, r)
+		doNonNaN(a, func(i, _ int, v float64) {
+			sums[i] += math.Abs(v)
+		})
+		return maxNonNeg(sums)
+	}
+}
+
+// maxNonNeg returns the maximum of the non-negative values in s.
+func maxNonNeg(s []float64) float64 {
+	var max float64
+	for _, v := range s {
+		if v > max {
+			max = v
+		}
+	}
+	return max
+}
+
+// doNonNaN calls fn for each element of a that is not NaN, with its row and
+// column indices. It panics with ErrZeroLength if a has zero size.
+func doNonNaN(a Matrix, fn func(i, j int, v float64)) {
+	r, c := a.Dims()
+	if r == 0 || c == 0 {
+		panic(ErrZeroLength)
+	}
+	aU, trans := untransposeExtract(a)
+	if rm, ok := aU.(RawMatrixer); ok {
+		raw := rm.RawMatrix()
+		for i := 0; i < raw.Rows; i++ {
+			for j, v := range raw.Data[i*raw.Stride : i*raw.Stride+raw.Cols] {
+				if math.IsNaN(v) {
+					continue
+				}
+				if trans {
+					fn(j, i, v)
+				} else {
+					fn(i, j, v)
+				}
+			}
+		}
+		return
+	}
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			v := a.At(i, j)
+			if !math.IsNaN(v) {
+				fn(i, j, v)
+			}
+		}
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestNaNReductions(t *testing.T) {
+	t.Parallel()
+	nan := math.NaN()
+	for _, test := range []struct {
+		name  string
+		a     Matrix
+		sum   float64
+		max   float64
+		min   float64
+		count int
+		norm1 float64
+		norm2 float64
+		normI float64
+	}{
+		{
+			name:  "no NaN",
+			a:     NewDense(2, 2, []float64{1, -2, 3, 4}),
+			sum:   6,
+			max:   4,
+			min:   -2,
+			norm1: 6,
+			norm2: math.Sqrt(30),
+			normI: 7,
+		},
+		{
+			name: "some NaN",
+			a: NewDense(2, 3, []float64{
+				1, nan, -3,
+				nan, 5, 2,
+			}),
+			sum:   5,
+			max:   5,
+			min:   -3,
+			count: 2,
+			norm1: 5,
+			norm2: math.Sqrt(39),
+			normI: 7,
+		},
+		{
+			name: "transposed",
+			a: NewDense(2, 3, []float64{
+				1, nan, -3,
+				nan, 5, 2,
+			}).T(),
+			sum:   5,
+			max:   5,
+			min:   -3,
+			count: 2,
+			norm1: 7,
+			norm2: math.Sqrt(39),
+			normI: 5,
+		},
+		{
+			name:  "all NaN",
+			a:     NewDense(1, 2, []float64{nan, nan}),
+			sum:   0,
+			max:   nan,
+			min:   nan,
+			count: 2,
+		},
+		{
+			name:  "symmetric",
+			a:     NewSymDense(2, []float64{nan, -1, -1, 3}),
+			sum:   1,
+			max:   3,
+			min:   -1,
+			count: 1,
+			norm1: 4,
+			norm2: math.Sqrt(11),
+			normI: 4,
+		},
+		{
+			name:  "large",
+			a:     NewDense(1, 3, []float64{1e300, nan, 1e300}),
+			sum:   2e300,
+			max:   1e300,
+			min:   1e300,
+			count: 1,
+			norm1: 1e300,
+			norm2: math.Sqrt2 * 1e300,
+			normI: 2e300,
+		},
+	} {
+		same := func(got, want float64) bool {
+			return (math.IsNaN(got) && math.IsNaN(want)) || math.Abs(got-want) <= 1e-14*math.Abs(want)
+		}
+		if got := NaNSum(test.a); !same(got, test.sum) {
+			t.Errorf("%s: unexpected NaNSum: got %v, want %v", test.name, got, test.sum)
+		}
+		if got := NaNMax(test.a); !same(got, test.max) {
+			t.Errorf("%s: unexpected NaNMax: got %v, want %v", test.name, got, test.max)
+		}
+		if got := NaNMin(test.a); !same(got, test.min) {
+			t.Errorf("%s: unexpected NaNMin: got %v, want %v", test.name, got, test.min)
+		}
+		if got := NaNCount(test.a); got != test.count {
+			t.Errorf("%s: unexpected NaNCount: got %v, want %v", test.name, got, test.count)
+		}
+		for _, norm := range []struct {
+			typ  float64
+			want float64
+		}{
+			{1, test.norm1},
+			{2, test.norm2},
+			{math.Inf(1), test.normI},
+		} {
+			if got := NaNNorm(test.a, norm.typ); !same(got, norm.want) {
+				t.Errorf("%s: unexpected NaNNorm(%v): got %v, want %v", test.name, norm.typ, got, norm.want)
+			}
+		}
+	}
+}
+
+func TestNaNReductionsAgree(t *testing.T) {
+	t.Parallel()
+	// Without NaN elements the NaN-ignoring reductions
+	// agree with the standard reductions.
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ r, c int }{{1, 1}, {3, 5}, {10, 4}} {
+		a := NewDense(test.r, test.c, nil)
+		for i := 0; i < test.r; i++ {
+			for j := 0; j < test.c; j++ {
+				a.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		for _, m := range []Matrix{a, a.T()} {
+			if got, want := NaNSum(m), Sum(m); math.Abs(got-want) > 1e-14 {
+				t.Errorf("unexpected NaNSum: got %v, want %v", got, want)
+			}
+			if got, want := NaNMax(m), Max(m); got != want {
+				t.Errorf("unexpected NaNMax: got %v, want %v", got, want)
+			}
+			if got, want := NaNMin(m), Min(m); got != want {
+				t.Errorf("unexpected NaNMin: got %v, want %v", got, want)
+			}
+			for _, norm := range []float64{1, 2, math.Inf(1)} {
+				if got, want := NaNNorm(m, norm), Norm(m, norm); math.Abs(got-want) > 1e-14*want {
+					t.Errorf("unexpected NaNNorm(%v): got %v, want %v", norm, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestNaNReductionsPanic(t *testing.T) {
+	t.Parallel()
+	if panicked, message := panics(func() { NaNSum(&Dense{}) }); !panicked || message != ErrZeroLength.Error() {
+		t.Errorf("unexpected panic for empty matrix: %q", message)
+	}
+	if panicked, message := panics(func() { NaNNorm(NewDense(1, 1, nil), 3) }); !panicked || message != ErrNormOrder.Error() {
+		t.Errorf("unexpected panic for bad norm: %q", message)
+	}
+}
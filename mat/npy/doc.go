@@ -0,0 +1,17 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package npy implements reading and writing of matrices and vectors in the
+// NumPy .npy and .npz formats.
+//
+// An .npy file holds a single array in a binary format that preserves the
+// exact values of its elements, and an .npz file is a zip archive of named
+// .npy files. One-dimensional arrays correspond to *mat.VecDense and
+// two-dimensional arrays correspond to *mat.Dense. Arrays of float32 and
+// float64 elements in either byte order and in C (row-major) or Fortran
+// (column-major) order can be read.
+//
+// The format is described at
+// https://numpy.org/doc/stable/reference/generated/numpy.lib.format.html.
+package npy // import "gonum.org/v1/gonum/mat/npy"
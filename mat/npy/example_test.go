@@ -0,0 +1,41 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package npy_test
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/mat/npy"
+)
+
+func Example() {
+	a := mat.NewDense(2, 3, []float64{
+		1, 2, 3,
+		4, 5, 6,
+	})
+
+	// Write the matrix in .npy format. The result can
+	// be loaded in Python with numpy.load.
+	var buf bytes.Buffer
+	err := npy.Write(&buf, a)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	m, err := npy.Read(&buf)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%T\n", m)
+	fmt.Printf("%v\n", mat.Formatted(m))
+
+	// Output:
+	// *mat.Dense
+	// ⎡1  2  3⎤
+	// ⎣4  5  6⎦
+}
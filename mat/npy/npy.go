@@ -0,0 +1,355 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package npy
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// magic is the prefix of an .npy file.
+const magic = "\x93NUMPY"
+
+var (
+	// ErrUnsupported is returned when an array has an element type or
+	// a number of dimensions that can not be represented by a matrix or
+	// vector.
+	ErrUnsupported = errors.New("npy: unsupported array")
+
+	errBadHeader = errors.New("npy: malformed header")
+)
+
+// Header describes the array held in an .npy file.
+type Header struct {
+	// Descr is the NumPy type descriptor of the elements,
+	// for example "<f8".
+	Descr string
+	// FortranOrder is whether the elements are stored in
+	// column-major order.
+	FortranOrder bool
+	// Shape is the shape of the array.
+	Shape []int
+}
+
+// Read reads an array in .npy format from r. One-dimensional arrays are
+// returned as a *mat.VecDense and two-dimensional arrays are returned as a
+// *mat.Dense. Elements of float32 arrays are converted to float64 exactly.
+// Read returns ErrUnsupported for arrays that are not one- or
+// two-dimensional, have a zero dimension, or do not hold float32 or float64
+// elements.
+func Read(r io.Reader) (mat.Matrix, error) {
+	h, err := ReadHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		order binary.ByteOrder
+		size  int
+	)
+	if len(h.Descr) != 3 {
+		return nil, ErrUnsupported
+	}
+	switch h.Descr[0] {
+	case '<':
+		order = binary.LittleEndian
+	case '>':
+		order = binary.BigEndian
+	case '=':
+		order = binary.NativeEndian
+	default:
+		return nil, ErrUnsupported
+	}
+	switch h.Descr[1:] {
+	case "f4":
+		size = 4
+	case "f8":
+		size = 8
+	default:
+		return nil, ErrUnsupported
+	}
+
+	var rows, cols int
+	switch len(h.Shape) {
+	case 1:
+		rows, cols = h.Shape[0], 1
+	case 2:
+		rows, cols = h.Shape[0], h.Shape[1]
+	default:
+		return nil, ErrUnsupported
+	}
+	if rows == 0 || cols == 0 {
+		return nil, ErrUnsupported
+	}
+
+	buf := make([]byte, rows*cols*size)
+	_, err = io.ReadFull(r, buf)
+	if err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	data := make([]float64, rows*cols)
+	for i := range data {
+		if size == 4 {
+			data[i] = float64(math.Float32frombits(order.Uint32(buf[4*i:])))
+		} else {
+			data[i] = math.Float64frombits(order.Uint64(buf[8*i:]))
+		}
+	}
+
+	if len(h.Shape) == 1 {
+		return mat.NewVecDense(rows, data), nil
+	}
+	if h.FortranOrder {
+		// The data holds the transpose in row-major order.
+		var m mat.Dense
+		m.CloneFrom(mat.NewDense(cols, rows, data).T())
+		return &m, nil
+	}
+	return mat.NewDense(rows, cols, data), nil
+}
+
+// ReadHeader reads the magic string and the header of an .npy file from r.
+// On return, r is positioned at the start of the array data.
+func ReadHeader(r io.Reader) (*Header, error) {
+	var pre [len(magic) + 2]byte
+	_, err := io.ReadFull(r, pre[:])
+	if err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	if string(pre[:len(magic)]) != magic {
+		return nil, errors.New("npy: not an npy file")
+	}
+
+	var n int
+	switch major := pre[len(magic)]; major {
+	case 1:
+		var l [2]byte
+		_, err = io.ReadFull(r, l[:])
+		n = int(binary.LittleEndian.Uint16(l[:]))
+	case 2, 3:
+		var l [4]byte
+		_, err = io.ReadFull(r, l[:])
+		n = int(binary.LittleEndian.Uint32(l[:]))
+	default:
+		return nil, fmt.Errorf("npy: unsupported format version %d.%d", major, pre[len(magic)+1])
+	}
+	if err != nil {
+		return nil, err
+	}
+	text := make([]byte, n)
+	_, err = io.ReadFull(r, text)
+	if err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return parseHeader(string(text))
+}
+
+// parseHeader parses the Python dictionary literal of an .npy header.
+func parseHeader(s string) (*Header, error) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "{") || !strings.HasSuffix(s, "}") {
+		return nil, errBadHeader
+	}
+	p := headerParser{s: s[1 : len(s)-1]}
+	var (
+		h                               Header
+		haveDescr, haveOrder, haveShape bool
+	)
+	for {
+		p.skipSpace()
+		if p.done() {
+			break
+		}
+		key, ok := p.str()
+		if !ok || !p.consume(':') {
+			return nil, errBadHeader
+		}
+		switch key {
+		case "descr":
+			h.Descr, ok = p.str()
+			haveDescr = true
+		case "fortran_order":
+			h.FortranOrder, ok = p.boolean()
+			haveOrder = true
+		case "shape":
+			h.Shape, ok = p.tuple()
+			haveShape = true
+		default:
+			ok = false
+		}
+		if !ok {
+			return nil, errBadHeader
+		}
+		if !p.consume(',') {
+			p.skipSpace()
+			if !p.done() {
+				return nil, errBadHeader
+			}
+		}
+	}
+	if !haveDescr || !haveOrder || !haveShape {
+		return nil, errBadHeader
+	}
+	return &h, nil
+}
+
+// headerParser is a parser for the subset of Python literals used in
+// .npy headers.
+type headerParser struct {
+	s   string
+	pos int
+}
+
+func (p *headerParser) done() bool { return p.pos >= len(p.s) }
+
+func (p *headerParser) skipSpace() {
+	for !p.done() && strings.IndexByte(" \t\r\n", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+// consume skips spaces and consumes c if it is the next character.
+func (p *headerParser) consume(c byte) bool {
+	p.skipSpace()
+	if p.done() || p.s[p.pos] != c {
+		return false
+	}
+	p.pos++
+	return true
+}
+
+// str parses a quoted string without escapes.
+func (p *headerParser) str() (string, bool) {
+	p.skipSpace()
+	if p.done() || (p.s[p.pos] != '\'' && p.s[p.pos] != '"') {
+		return "", false
+	}
+	q := p.s[p.pos]
+	end := strings.IndexByte(p.s[p.pos+1:], q)
+	if end < 0 {
+		return "", false
+	}
+	v := p.s[p.pos+1 : p.pos+1+end]
+	p.pos += end + 2
+	return v, true
+}
+
+// boolean parses a Python boolean.
+func (p *headerParser) boolean() (bool, bool) {
+	p.skipSpace()
+	switch {
+	case strings.HasPrefix(p.s[p.pos:], "True"):
+		p.pos += len("True")
+		return true, true
+	case strings.HasPrefix(p.s[p.pos:], "False"):
+		p.pos += len("False")
+		return false, true
+	}
+	return false, false
+}
+
+// tuple parses a tuple of non-negative integers.
+func (p *headerParser) tuple() ([]int, bool) {
+	if !p.consume('(') {
+		return nil, false
+	}
+	shape := []int{}
+	for {
+		if p.consume(')') {
+			return shape, true
+		}
+		p.skipSpace()
+		start := p.pos
+		for !p.done() && '0' <= p.s[p.pos] && p.s[p.pos] <= '9' {
+			p.pos++
+		}
+		// Python 2 wrote long integers with an L suffix.
+		end := p.pos
+		if !p.done() && p.s[p.pos] == 'L' {
+			p.pos++
+		}
+		v, err := strconv.Atoi(p.s[start:end])
+		if err != nil {
+			return nil, false
+		}
+		shape = append(shape, v)
+		if !p.consume(',') {
+			if !p.consume(')') {
+				return nil, false
+			}
+			return shape, true
+		}
+	}
+}
+
+// Write writes m to w in .npy format. Vectors that are a *mat.VecDense are
+// written as one-dimensional arrays and all other matrices are written as
+// two-dimensional arrays in C order. The elements of a *mat.Dense32 are
+// written as float32 and the elements of all other matrices are written as
+// float64.
+func Write(w io.Writer, m mat.Matrix) error {
+	r, c := m.Dims()
+	descr := "<f8"
+	if _, ok := m.(*mat.Dense32); ok {
+		descr = "<f4"
+	}
+	shape := fmt.Sprintf("(%d, %d)", r, c)
+	if _, ok := m.(*mat.VecDense); ok {
+		shape = fmt.Sprintf("(%d,)", r)
+	}
+	header := fmt.Sprintf("{'descr': '%s', 'fortran_order': False, 'shape': %s, }", descr, shape)
+
+	// Pad the header with spaces and a terminating newline so that
+	// the data is 64-byte aligned.
+	const prefix = len(magic) + 2 + 2
+	pad := 64 - (prefix+len(header)+1)%64
+	if pad == 64 {
+		pad = 0
+	}
+	header += strings.Repeat(" ", pad) + "\n"
+
+	bw := bufio.NewWriter(w)
+	bw.WriteString(magic)
+	bw.Write([]byte{1, 0})
+	var b [8]byte
+	binary.LittleEndian.PutUint16(b[:2], uint16(len(header)))
+	bw.Write(b[:2])
+	bw.WriteString(header)
+
+	if m32, ok := m.(*mat.Dense32); ok {
+		raw := m32.RawMatrix()
+		for i := 0; i < r; i++ {
+			for _, v := range raw.Data[i*raw.Stride : i*raw.Stride+c] {
+				binary.LittleEndian.PutUint32(b[:4], math.Float32bits(v))
+				bw.Write(b[:4])
+			}
+		}
+		return bw.Flush()
+	}
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			binary.LittleEndian.PutUint64(b[:], math.Float64bits(m.At(i, j)))
+			bw.Write(b[:])
+		}
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,363 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package npy
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// npyFile returns an .npy file of the given format version holding
+// the header and data. The data is encoded in the given byte order
+// as float32 if f32 is true and as float64 otherwise.
+func npyFile(major byte, header string, order binary.ByteOrder, f32 bool, data []float64) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(magic)
+	buf.Write([]byte{major, 0})
+	header += "\n"
+	if major == 1 {
+		binary.Write(&buf, binary.LittleEndian, uint16(len(header)))
+	} else {
+		binary.Write(&buf, binary.LittleEndian, uint32(len(header)))
+	}
+	buf.WriteString(header)
+	for _, v := range data {
+		if f32 {
+			binary.Write(&buf, order, float32(v))
+		} else {
+			binary.Write(&buf, order, v)
+		}
+	}
+	return buf.Bytes()
+}
+
+var readTests = []struct {
+	name string
+	in   []byte
+	want mat.Matrix
+}{
+	{
+		name: "vector",
+		in:   npyFile(1, "{'descr': '<f8', 'fortran_order': False, 'shape': (3,), }", binary.LittleEndian, false, []float64{1, -2, 0.1}),
+		want: mat.NewVecDense(3, []float64{1, -2, 0.1}),
+	},
+	{
+		name: "C order",
+		in:   npyFile(1, "{'descr': '<f8', 'fortran_order': False, 'shape': (2, 3), }", binary.LittleEndian, false, []float64{1, 2, 3, 4, 5, 6}),
+		want: mat.NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6}),
+	},
+	{
+		name: "Fortran order",
+		in:   npyFile(1, "{'descr': '<f8', 'fortran_order': True, 'shape': (2, 3), }", binary.LittleEndian, false, []float64{1, 4, 2, 5, 3, 6}),
+		want: mat.NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6}),
+	},
+	{
+		name: "big endian",
+		in:   npyFile(1, "{'descr': '>f8', 'fortran_order': False, 'shape': (2, 2), }", binary.BigEndian, false, []float64{1, 2, 3, math.Pi}),
+		want: mat.NewDense(2, 2, []float64{1, 2, 3, math.Pi}),
+	},
+	{
+		name: "float32",
+		in:   npyFile(1, "{'descr': '<f4', 'fortran_order': False, 'shape': (1, 2), }", binary.LittleEndian, true, []float64{0.5, -0.25}),
+		want: mat.NewDense(1, 2, []float64{0.5, -0.25}),
+	},
+	{
+		name: "big endian float32 Fortran order",
+		in:   npyFile(1, "{'descr': '>f4', 'fortran_order': True, 'shape': (2, 2), }", binary.BigEndian, true, []float64{1, 3, 2, 4}),
+		want: mat.NewDense(2, 2, []float64{1, 2, 3, 4}),
+	},
+	{
+		name: "version 2 reordered keys",
+		in:   npyFile(2, `{"shape": (2L, 1L), "fortran_order": False, "descr": "<f8"}`, binary.LittleEndian, false, []float64{7, 8}),
+		want: mat.NewDense(2, 1, []float64{7, 8}),
+	},
+	{
+		name: "special values",
+		in:   npyFile(1, "{'descr': '<f8', 'fortran_order': False, 'shape': (3,), }", binary.LittleEndian, false, []float64{math.Inf(1), math.Inf(-1), math.SmallestNonzeroFloat64}),
+		want: mat.NewVecDense(3, []float64{math.Inf(1), math.Inf(-1), math.SmallestNonzeroFloat64}),
+	},
+}
+
+func TestRead(t *testing.T) {
+	t.Parallel()
+	for _, test := range readTests {
+		got, err := Read(bytes.NewReader(test.in))
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", test.name, err)
+			continue
+		}
+		if reflect.TypeOf(got) != reflect.TypeOf(test.want) {
+			t.Errorf("unexpected type for %s: got:%T want:%T", test.name, got, test.want)
+			continue
+		}
+		if !mat.Equal(got, test.want) {
+			t.Errorf("unexpected result for %s:\ngot:\n%v\nwant:\n%v",
+				test.name, mat.Formatted(got), mat.Formatted(test.want))
+		}
+	}
+}
+
+var readErrorTests = []struct {
+	name    string
+	in      []byte
+	wantErr error
+}{
+	{
+		name:    "empty",
+		in:      nil,
+		wantErr: io.ErrUnexpectedEOF,
+	},
+	{
+		name: "bad magic",
+		in:   []byte("\x93NUMPZ\x01\x00\x00\x00"),
+	},
+	{
+		name: "bad version",
+		in:   npyFile(4, "{'descr': '<f8', 'fortran_order': False, 'shape': (1,), }", binary.LittleEndian, false, []float64{1}),
+	},
+	{
+		name: "not a dict",
+		in:   npyFile(1, "('descr', '<f8')", binary.LittleEndian, false, []float64{1}),
+	},
+	{
+		name: "missing key",
+		in:   npyFile(1, "{'descr': '<f8', 'shape': (1,), }", binary.LittleEndian, false, []float64{1}),
+	},
+	{
+		name: "unknown key",
+		in:   npyFile(1, "{'descr': '<f8', 'fortran_order': False, 'shape': (1,), 'other': 1, }", binary.LittleEndian, false, []float64{1}),
+	},
+	{
+		name: "bad shape",
+		in:   npyFile(1, "{'descr': '<f8', 'fortran_order': False, 'shape': (1, x), }", binary.LittleEndian, false, []float64{1}),
+	},
+	{
+		name:    "integer elements",
+		in:      npyFile(1, "{'descr': '<i8', 'fortran_order': False, 'shape': (1,), }", binary.LittleEndian, false, []float64{1}),
+		wantErr: ErrUnsupported,
+	},
+	{
+		name:    "complex elements",
+		in:      npyFile(1, "{'descr': '<c16', 'fortran_order': False, 'shape': (1,), }", binary.LittleEndian, false, []float64{1, 0}),
+		wantErr: ErrUnsupported,
+	},
+	{
+		name:    "scalar",
+		in:      npyFile(1, "{'descr': '<f8', 'fortran_order': False, 'shape': (), }", binary.LittleEndian, false, []float64{1}),
+		wantErr: ErrUnsupported,
+	},
+	{
+		name:    "three dimensions",
+		in:      npyFile(1, "{'descr': '<f8', 'fortran_order': False, 'shape': (1, 1, 1), }", binary.LittleEndian, false, []float64{1}),
+		wantErr: ErrUnsupported,
+	},
+	{
+		name:    "zero dimension",
+		in:      npyFile(1, "{'descr': '<f8', 'fortran_order': False, 'shape': (0, 2), }", binary.LittleEndian, false, nil),
+		wantErr: ErrUnsupported,
+	},
+	{
+		name:    "truncated data",
+		in:      npyFile(1, "{'descr': '<f8', 'fortran_order': False, 'shape': (2, 2), }", binary.LittleEndian, false, []float64{1, 2, 3}),
+		wantErr: io.ErrUnexpectedEOF,
+	},
+	{
+		name:    "missing data",
+		in:      npyFile(1, "{'descr': '<f8', 'fortran_order': False, 'shape': (2,), }", binary.LittleEndian, false, nil),
+		wantErr: io.ErrUnexpectedEOF,
+	},
+}
+
+func TestReadErrors(t *testing.T) {
+	t.Parallel()
+	for _, test := range readErrorTests {
+		_, err := Read(bytes.NewReader(test.in))
+		if err == nil {
+			t.Errorf("expected error for %s", test.name)
+			continue
+		}
+		if test.wantErr != nil && !errors.Is(err, test.wantErr) {
+			t.Errorf("unexpected error for %s: got:%v want:%v", test.name, err, test.wantErr)
+		}
+	}
+}
+
+func TestWriteHeader(t *testing.T) {
+	t.Parallel()
+	// The header written by numpy.save for a float64 array of shape (2,).
+	want := "\x93NUMPY\x01\x00\x76\x00{'descr': '<f8', 'fortran_order': False, 'shape': (2,), }" +
+		"                                                            \n"
+
+	var buf bytes.Buffer
+	err := Write(&buf, mat.NewVecDense(2, []float64{1, 2}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := buf.String()
+	if len(got) != len(want)+16 {
+		t.Fatalf("unexpected length: got:%d want:%d", len(got), len(want)+16)
+	}
+	if got[:len(want)] != want {
+		t.Errorf("unexpected header:\ngot: %q\nwant:%q", got[:len(want)], want)
+	}
+	for _, test := range []struct {
+		m     mat.Matrix
+		shape []int
+		descr string
+	}{
+		{m: mat.NewVecDense(5, nil), shape: []int{5}, descr: "<f8"},
+		{m: mat.NewDense(3, 4, nil), shape: []int{3, 4}, descr: "<f8"},
+		{m: mat.NewDense(3, 4, nil).T(), shape: []int{4, 3}, descr: "<f8"},
+		{m: mat.NewDense32(2, 7, nil), shape: []int{2, 7}, descr: "<f4"},
+		{m: mat.NewDense(1000, 1000, nil).ColView(0), shape: []int{1000}, descr: "<f8"},
+	} {
+		buf.Reset()
+		err := Write(&buf, test.m)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		n := int(binary.LittleEndian.Uint16(buf.Bytes()[8:10]))
+		if (10+n)%64 != 0 {
+			t.Errorf("data not aligned for %T: offset=%d", test.m, 10+n)
+		}
+		h, err := ReadHeader(&buf)
+		if err != nil {
+			t.Fatalf("unexpected error reading header: %v", err)
+		}
+		want := &Header{Descr: test.descr, Shape: test.shape}
+		if !reflect.DeepEqual(h, want) {
+			t.Errorf("unexpected header for %T: got:%+v want:%+v", test.m, h, want)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		r, c int
+	}{
+		{1, 1},
+		{1, 5},
+		{5, 1},
+		{4, 7},
+		{10, 3},
+	} {
+		a := mat.NewDense(test.r, test.c, nil)
+		a32 := mat.NewDense32(test.r, test.c, nil)
+		for i := 0; i < test.r; i++ {
+			for j := 0; j < test.c; j++ {
+				v := rnd.NormFloat64() * math.Pow(10, float64(rnd.Intn(40)-20))
+				a.Set(i, j, v)
+				a32.Set(i, j, float32(v))
+			}
+		}
+		v := mat.NewVecDense(test.r, mat.Col(nil, 0, a))
+
+		for _, m := range []mat.Matrix{a, a.T(), a32, v} {
+			var buf bytes.Buffer
+			err := Write(&buf, m)
+			if err != nil {
+				t.Fatalf("unexpected error writing %T: %v", m, err)
+			}
+			got, err := Read(&buf)
+			if err != nil {
+				t.Fatalf("unexpected error reading %T: %v", m, err)
+			}
+			if !mat.Equal(got, m) {
+				t.Errorf("round trip mismatch for %d×%d %T", test.r, test.c, m)
+			}
+			if _, ok := m.(*mat.VecDense); ok {
+				if _, ok := got.(*mat.VecDense); !ok {
+					t.Errorf("unexpected type for vector: got:%T want:*mat.VecDense", got)
+				}
+			}
+			if buf.Len() != 0 {
+				t.Errorf("unexpected unread data for %T: %d bytes", m, buf.Len())
+			}
+		}
+	}
+}
+
+func TestArchive(t *testing.T) {
+	t.Parallel()
+	arrays := map[string]mat.Matrix{
+		"a": mat.NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6}),
+		"b": mat.NewVecDense(2, []float64{-1, 0.5}),
+		"c": mat.NewDense32(1, 2, []float32{0.25, 8}),
+	}
+	var buf bytes.Buffer
+	err := WriteArchive(&buf, arrays)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("unexpected error opening archive: %v", err)
+	}
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	wantNames := []string{"a.npy", "b.npy", "c.npy"}
+	if !reflect.DeepEqual(names, wantNames) {
+		t.Errorf("unexpected archive entries: got:%v want:%v", names, wantNames)
+	}
+
+	got, err := ReadArchive(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != len(arrays) {
+		t.Errorf("unexpected number of arrays: got:%d want:%d", len(got), len(arrays))
+	}
+	for name, want := range arrays {
+		if !mat.Equal(got[name], want) {
+			t.Errorf("unexpected array %q", name)
+		}
+	}
+
+	// Archives written by numpy.savez_compressed are deflated.
+	buf.Reset()
+	zw := zip.NewWriter(&buf)
+	f, err := zw.CreateHeader(&zip.FileHeader{Name: "x.npy", Method: zip.Deflate})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	f.Write(readTests[2].in)
+	zw.Close()
+	got, err = ReadArchive(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("unexpected error reading compressed archive: %v", err)
+	}
+	if !mat.Equal(got["x"], readTests[2].want) {
+		t.Errorf("unexpected array from compressed archive")
+	}
+
+	// Errors identify the failing entry.
+	buf.Reset()
+	zw = zip.NewWriter(&buf)
+	f, _ = zw.Create("bad.npy")
+	f.Write([]byte("not npy"))
+	zw.Close()
+	_, err = ReadArchive(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err == nil {
+		t.Errorf("expected error for bad entry")
+	} else if !strings.HasPrefix(err.Error(), "npy: bad.npy: ") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package npy
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// ReadArchive reads the arrays held in an .npz archive from r, which holds
+// size bytes. The arrays are returned keyed by their name in the archive
+// without the .npy extension. Each array is returned as described by Read.
+func ReadArchive(r io.ReaderAt, size int64) (map[string]mat.Matrix, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+	arrays := make(map[string]mat.Matrix, len(zr.File))
+	for _, f := range zr.File {
+		m, err := readFile(f)
+		if err != nil {
+			return nil, fmt.Errorf("npy: %s: %w", f.Name, err)
+		}
+		arrays[strings.TrimSuffix(f.Name, ".npy")] = m
+	}
+	return arrays, nil
+}
+
+func readFile(f *zip.File) (mat.Matrix, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return Read(rc)
+}
+
+// WriteArchive writes the arrays to w as an uncompressed .npz archive, in
+// the form written by numpy.savez. Each array is stored under its name
+// with an .npy extension in lexical order of the names, and is written as
+// described by Write.
+func WriteArchive(w io.Writer, arrays map[string]mat.Matrix) error {
+	names := make([]string, 0, len(arrays))
+	for name := range arrays {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	zw := zip.NewWriter(w)
+	for _, name := range names {
+		f, err := zw.CreateHeader(&zip.FileHeader{
+			Name:   name + ".npy",
+			Method: zip.Store,
+		})
+		if err != nil {
+			return err
+		}
+		err = Write(f, arrays[name])
+		if err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
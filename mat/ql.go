@@ -0,0 +1,146 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+const badQL = "mat: invalid QL factorization"
+
+// QL is a type for creating and using the QL factorization of a matrix.
+//
+// The factorization is computed from the QR factorization of the matrix
+// with its rows and columns in reverse order. If J is the exchange matrix
+// and J⋅A⋅J = Q̃⋅R, then A = (J⋅Q̃⋅J)⋅(J⋅R⋅J) where J⋅Q̃⋅J is orthonormal
+// and J⋅R⋅J is lower trapezoidal.
+type QL struct {
+	qr QR
+}
+
+// Dims returns the dimensions of the matrix.
+func (ql *QL) Dims() (r, c int) {
+	return ql.qr.Dims()
+}
+
+// At returns the element at row i, column j.
+func (ql *QL) At(i, j int) float64 {
+	m, n := ql.Dims()
+	if uint(i) >= uint(m) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(n) {
+		panic(ErrColAccess)
+	}
+	return ql.qr.At(m-1-i, n-1-j)
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (ql *QL) T() Matrix {
+	return Transpose{ql}
+}
+
+// Factorize computes the QL factorization of an m×n matrix a where m >= n. The QL
+// factorization always exists even if A is singular.
+//
+// The QL decomposition is a factorization of the matrix A such that A = Q * L.
+// The matrix Q is an orthonormal m×m matrix, and L is an m×n lower trapezoidal
+// matrix whose non-zero elements are in its last n rows.
+// Q and L can be extracted using the QTo and LTo methods.
+func (ql *QL) Factorize(a Matrix) {
+	m, n := a.Dims()
+	if m < n {
+		panic(ErrShape)
+	}
+	w := getDenseWorkspace(m, n, false)
+	w.Copy(a)
+	reverseDense(w)
+	ql.qr.Factorize(w)
+	putDenseWorkspace(w)
+}
+
+// isValid returns whether the receiver contains a factorization.
+func (ql *QL) isValid() bool {
+	return ql.qr.isValid()
+}
+
+// Cond returns the condition number for the factorized matrix.
+// Cond will panic if the receiver does not contain a factorization.
+func (ql *QL) Cond() float64 {
+	if !ql.isValid() {
+		panic(badQL)
+	}
+	return ql.qr.cond
+}
+
+// LTo extracts the m×n lower trapezoidal matrix from a QL decomposition.
+//
+// If dst is empty, LTo will resize dst to be m×n. When dst is
+// non-empty, LTo will panic if dst is not m×n. LTo will also panic
+// if the receiver does not contain a successful factorization.
+func (ql *QL) LTo(dst *Dense) {
+	if !ql.isValid() {
+		panic(badQL)
+	}
+	ql.qr.RTo(dst)
+	reverseDense(dst)
+}
+
+// QTo extracts the m×m orthonormal matrix Q from a QL decomposition.
+//
+// If dst is empty, QTo will resize dst to be m×m. When dst is
+// non-empty, QTo will panic if dst is not m×m. QTo will also panic
+// if the receiver does not contain a successful factorization.
+func (ql *QL) QTo(dst *Dense) {
+	if !ql.isValid() {
+		panic(badQL)
+	}
+	ql.qr.QTo(dst)
+	reverseDense(dst)
+}
+
+// SolveTo finds a minimum-norm solution to a system of linear equations defined
+// by the matrices A and b, where A is an m×n matrix represented in its QL factorized
+// form. If A is singular or near-singular a Condition error is returned.
+// See the documentation for Condition for more information.
+//
+// The minimization problem solved depends on the input parameters.
+//
+//	If trans == false, find X such that ||A*X - B||_2 is minimized.
+//	If trans == true, find the minimum norm solution of Aᵀ * X = B.
+//
+// The solution matrix, X, is stored in place into dst.
+// SolveTo will panic if the receiver does not contain a factorization.
+func (ql *QL) SolveTo(dst *Dense, trans bool, b Matrix) error {
+	if !ql.isValid() {
+		panic(badQL)
+	}
+	// With J⋅A⋅J = Q̃⋅R, the system A⋅X = B is equivalent to
+	// (Q̃⋅R)⋅(J⋅X) = J⋅B, and similarly for the transpose.
+	br, bc := b.Dims()
+	w := getDenseWorkspace(br, bc, false)
+	w.Copy(b)
+	reverseRows(w)
+	err := ql.qr.SolveTo(dst, trans, w)
+	putDenseWorkspace(w)
+	reverseRows(dst)
+	return err
+}
+
+// SolveVecTo finds a minimum-norm solution to a system of linear equations.
+// See QL.SolveTo for the full documentation.
+// SolveVecTo will panic if the receiver does not contain a factorization.
+func (ql *QL) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
+	if !ql.isValid() {
+		panic(badQL)
+	}
+	r, c := ql.Dims()
+	if _, bc := b.Dims(); bc != 1 {
+		panic(ErrShape)
+	}
+	if trans {
+		dst.reuseAsNonZeroed(r)
+	} else {
+		dst.reuseAsNonZeroed(c)
+	}
+	return ql.SolveTo(dst.asDense(), trans, b)
+}
@@ -0,0 +1,141 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestQL(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	rnd := rand.New(rand.NewSource(1))
+	for cas, test := range []struct {
+		m, n int
+	}{
+		{1, 1},
+		{5, 5},
+		{10, 5},
+		{4, 1},
+	} {
+		m := test.m
+		n := test.n
+		a := NewDense(m, n, nil)
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		var want Dense
+		want.CloneFrom(a)
+
+		var ql QL
+		ql.Factorize(a)
+
+		if !Equal(a, &want) {
+			t.Errorf("case %d: input matrix modified", cas)
+		}
+		if !EqualApprox(a, &ql, tol) {
+			t.Errorf("case %d: A and QL are not equal", cas)
+		}
+
+		var l, q Dense
+		ql.QTo(&q)
+		if !isOrthonormal(&q, tol) {
+			t.Errorf("case %d: Q is not orthonormal", cas)
+		}
+
+		ql.LTo(&l)
+		for i := 0; i < m; i++ {
+			for j := max(0, i-(m-n)+1); j < n; j++ {
+				if l.At(i, j) != 0 {
+					t.Errorf("case %d: L is not lower trapezoidal: L[%d,%d]=%v", cas, i, j, l.At(i, j))
+				}
+			}
+		}
+
+		var got Dense
+		got.Mul(&q, &l)
+		if !EqualApprox(&got, &want, tol) {
+			t.Errorf("case %d: QL does not equal original matrix.\nWant: %v\nGot: %v", cas, want, got)
+		}
+	}
+
+	if ok, _ := panics(func() { (&QL{}).Factorize(NewDense(2, 3, nil)) }); !ok {
+		t.Errorf("expected panic for wide matrix")
+	}
+}
+
+func TestQLSolveTo(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, trans := range []bool{false, true} {
+		for _, test := range []struct {
+			m, n, bc int
+		}{
+			{5, 5, 1},
+			{10, 5, 1},
+			{5, 5, 3},
+			{10, 5, 3},
+		} {
+			m := test.m
+			n := test.n
+			bc := test.bc
+			a := NewDense(m, n, nil)
+			for i := 0; i < m; i++ {
+				for j := 0; j < n; j++ {
+					a.Set(i, j, rnd.Float64())
+				}
+			}
+			br := m
+			if trans {
+				br = n
+			}
+			b := NewDense(br, bc, nil)
+			for i := 0; i < br; i++ {
+				for j := 0; j < bc; j++ {
+					b.Set(i, j, rnd.Float64())
+				}
+			}
+
+			var ql QL
+			ql.Factorize(a)
+			var x Dense
+			err := ql.SolveTo(&x, trans, b)
+			if err != nil {
+				t.Errorf("unexpected error from QL solve: %v", err)
+			}
+
+			// The solution must agree with the QR solution.
+			var qr QR
+			qr.Factorize(a)
+			var want Dense
+			qr.SolveTo(&want, trans, b)
+			if !EqualApprox(&x, &want, 1e-10) {
+				t.Errorf("m=%d n=%d bc=%d trans=%t: QL solution does not match QR solution", m, n, bc, trans)
+			}
+
+			if bc == 1 {
+				var xvec VecDense
+				err := ql.SolveVecTo(&xvec, trans, b.ColView(0))
+				if err != nil {
+					t.Errorf("unexpected error from QL vector solve: %v", err)
+				}
+				if !EqualApprox(&xvec, &want, 1e-10) {
+					t.Errorf("m=%d n=%d trans=%t: QL vector solution does not match QR solution", m, n, trans)
+				}
+			}
+		}
+	}
+
+	var ql QL
+	ql.Factorize(NewDense(3, 2, []float64{1, 0, 0, 1e-20, 0, 0}))
+	var x Dense
+	if err := ql.SolveTo(&x, false, NewDense(3, 1, nil)); err == nil {
+		t.Error("no error for near-singular matrix in matrix solve")
+	}
+}
@@ -6,6 +6,7 @@ package mat
 
 import (
 	"math"
+	"sync"
 
 	"gonum.org/v1/gonum/blas"
 	"gonum.org/v1/gonum/blas/blas64"
@@ -17,10 +18,13 @@ const badQR = "mat: invalid QR factorization"
 
 // QR is a type for creating and using the QR factorization of a matrix.
 type QR struct {
-	qr   *Dense
-	q    *Dense
-	tau  []float64
-	cond float64
+	qr *Dense
+	q  *Dense
+	// qOnce guards the formation of q from the
+	// elementary reflectors held in qr and tau.
+	qOnce sync.Once
+	tau   []float64
+	cond  float64
 }
 
 // Dims returns the dimensions of the matrix.
@@ -41,6 +45,7 @@ func (qr *QR) At(i, j int) float64 {
 		panic(ErrColAccess)
 	}
 
+	qr.formQ()
 	var val float64
 	for k := 0; k <= j; k++ {
 		val += qr.q.at(i, k) * qr.qr.at(k, j)
@@ -54,7 +59,7 @@ func (qr *QR) T() Matrix {
 	return Transpose{qr}
 }
 
-func (qr *QR) updateCond(norm lapack.MatrixNorm) {
+func (qr *QR) updateCond(norm lapack.MatrixNorm, ws *Workspace) {
 	// Since A = Q*R, and Q is orthogonal, we get for the condition number κ
 	//  κ(A) := |A| |A^-1| = |Q*R| |(Q*R)^-1| = |R| |R^-1 * Qᵀ|
 	//        = |R| |R^-1| = κ(R),
@@ -63,12 +68,12 @@ func (qr *QR) updateCond(norm lapack.MatrixNorm) {
 	// is not the case for CondNorm. Hopefully the error is negligible: κ
 	// is only a qualitative measure anyway.
 	n := qr.qr.mat.Cols
-	work := getFloat64s(3*n, false)
-	iwork := getInts(n, false)
+	work := ws.float64s(3 * n)
+	iwork := ws.ints(n)
 	r := qr.qr.asTriDense(n, blas.NonUnit, blas.Upper)
 	v := lapack64.Trcon(norm, r.mat, work, iwork)
-	putFloat64s(work)
-	putInts(iwork)
+	ws.putFloat64s(work)
+	ws.putInts(iwork)
 	qr.cond = 1 / v
 }
 
@@ -77,35 +82,65 @@ func (qr *QR) updateCond(norm lapack.MatrixNorm) {
 //
 // The QR decomposition is a factorization of the matrix A such that A = Q * R.
 // The matrix Q is an orthonormal m×m matrix, and R is an m×n upper triangular matrix.
-// Q and R can be extracted using the QTo and RTo methods.
+// Q and R can be extracted using the QTo and RTo methods, and the thin
+// factorization A = Q₁ * R₁, where Q₁ is the m×n matrix of the first n columns
+// of Q and R₁ is the n×n upper triangular matrix of the first n rows of R, can
+// be extracted using the ThinQTo and ThinRTo methods. Q is held implicitly and
+// is only formed when it is extracted or an element of A is requested with At,
+// so it can be applied using MulQTo without the cost of forming the m×m matrix.
+// Forming Q is safe for concurrent use, so At, QTo and the other methods that
+// do not modify the factorization may be called concurrently.
 func (qr *QR) Factorize(a Matrix) {
-	qr.factorize(a, CondNorm)
+	qr.factorize(a, CondNorm, nil)
 }
 
-func (qr *QR) factorize(a Matrix, norm lapack.MatrixNorm) {
+// FactorizeWith computes the QR factorization of the m×n matrix A as
+// Factorize does, taking temporary storage from ws. If ws is nil,
+// FactorizeWith is equivalent to Factorize.
+func (qr *QR) FactorizeWith(a Matrix, ws *Workspace) {
+	qr.factorize(a, CondNorm, ws)
+}
+
+func (qr *QR) factorize(a Matrix, norm lapack.MatrixNorm, ws *Workspace) {
 	m, n := a.Dims()
 	if m < n {
 		panic(ErrShape)
 	}
+	ws.reset()
 	if qr.qr == nil {
-		qr.qr = &Dense{}
+		qr.qr = NewDense(m, n, nil)
+	} else {
+		qr.qr.Reset()
+		qr.qr.reuseAsNonZeroed(m, n)
 	}
-	qr.qr.CloneFrom(a)
+	qr.qr.Copy(a)
+	qr.tau = use(qr.tau, n)
 	work := []float64{0}
-	qr.tau = make([]float64, n)
 	lapack64.Geqrf(qr.qr.mat, qr.tau, work, -1)
-	work = getFloat64s(int(work[0]), false)
+	work = ws.float64s(int(work[0]))
 	lapack64.Geqrf(qr.qr.mat, qr.tau, work, len(work))
-	putFloat64s(work)
-	qr.updateCond(norm)
-	qr.updateQ()
+	ws.putFloat64s(work)
+	qr.updateCond(norm, ws)
+	// Mark Q as not yet formed.
+	qr.qOnce = sync.Once{}
 }
 
+// formQ forms the explicit m×m matrix Q from the elementary reflectors if it
+// has not already been formed since the last factorization.
+func (qr *QR) formQ() {
+	qr.qOnce.Do(qr.updateQ)
+}
+
+// updateQ forms the explicit m×m matrix Q from the elementary reflectors.
+// updateQ must only be called through formQ.
 func (qr *QR) updateQ() {
 	m, _ := qr.Dims()
 	if qr.q == nil {
 		qr.q = NewDense(m, m, nil)
 	} else {
+		// The receiver may have been used to factorize
+		// a matrix with a different number of rows.
+		qr.q.Reset()
 		qr.q.reuseAsNonZeroed(m, m)
 	}
 	// Construct Q from the elementary reflectors.
@@ -131,9 +166,6 @@ func (qr *QR) Cond() float64 {
 	return qr.cond
 }
 
-// TODO(btracey): Add in the "Reduced" forms for extracting the n×n orthogonal
-// and upper triangular matrices.
-
 // RTo extracts the m×n upper trapezoidal matrix from a QR decomposition.
 //
 // If dst is empty, RTo will resize dst to be r×c. When dst is non-empty,
@@ -149,7 +181,7 @@ func (qr *QR) RTo(dst *Dense) {
 		dst.ReuseAs(r, c)
 	} else {
 		r2, c2 := dst.Dims()
-		if c != r2 || c != c2 {
+		if r != r2 || c != c2 {
 			panic(ErrShape)
 		}
 	}
@@ -192,9 +224,104 @@ func (qr *QR) QTo(dst *Dense) {
 			panic(ErrShape)
 		}
 	}
+	qr.formQ()
 	dst.Copy(qr.q)
 }
 
+// ThinRTo extracts the n×n upper triangular matrix R₁ of the thin QR
+// decomposition A = Q₁ * R₁, that is the first n rows of R.
+//
+// If dst is empty, ThinRTo will resize dst to be an n×n upper triangular
+// matrix. When dst is non-empty, ThinRTo will panic if dst is not n×n or not
+// Upper. ThinRTo will also panic if the receiver does not contain a successful
+// factorization.
+func (qr *QR) ThinRTo(dst *TriDense) {
+	if !qr.isValid() {
+		panic(badQR)
+	}
+
+	_, c := qr.qr.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAsTri(c, Upper)
+	} else {
+		n, kind := dst.Triangle()
+		if n != c {
+			panic(ErrShape)
+		}
+		if kind != Upper {
+			panic(ErrTriangle)
+		}
+	}
+	dst.Copy(qr.qr)
+}
+
+// ThinQTo extracts the m×n matrix Q₁ with orthonormal columns of the thin QR
+// decomposition A = Q₁ * R₁, that is the first n columns of Q. ThinQTo does
+// not form the m×m matrix Q.
+//
+// If dst is empty, ThinQTo will resize dst to be m×n. When dst is non-empty,
+// ThinQTo will panic if dst is not m×n. ThinQTo will also panic if the
+// receiver does not contain a successful factorization.
+func (qr *QR) ThinQTo(dst *Dense) {
+	if !qr.isValid() {
+		panic(badQR)
+	}
+
+	r, c := qr.qr.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || c != c2 {
+			panic(ErrShape)
+		}
+	}
+	if qr.tau == nil {
+		// The factorization has been updated, so Q is
+		// only held explicitly.
+		dst.Copy(qr.q)
+		return
+	}
+	dst.Copy(qr.qr)
+	work := []float64{0}
+	lapack64.Orgqr(dst.mat, qr.tau, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Orgqr(dst.mat, qr.tau, work, len(work))
+	putFloat64s(work)
+}
+
+// MulQTo computes Q * B if trans is false and Qᵀ * B if trans is true,
+// placing the result in dst, where Q is the m×m orthonormal matrix of the QR
+// decomposition and B is an m×k matrix. The product is computed by applying
+// the elementary reflectors that define Q, so Q is never formed.
+//
+// MulQTo will panic if the receiver does not contain a successful
+// factorization or if b does not have m rows.
+func (qr *QR) MulQTo(dst *Dense, trans bool, b Matrix) {
+	if !qr.isValid() {
+		panic(badQR)
+	}
+
+	r, _ := qr.qr.Dims()
+	br, bc := b.Dims()
+	if br != r {
+		panic(ErrShape)
+	}
+	dst.reuseAsNonZeroed(r, bc)
+
+	// Do not need to worry about overlap between dst and b
+	// because the product is formed in a separate workspace.
+	w := getDenseWorkspace(r, bc, false)
+	w.Copy(b)
+	t := blas.NoTrans
+	if trans {
+		t = blas.Trans
+	}
+	qr.applyQ(t, w)
+	dst.Copy(w)
+	putDenseWorkspace(w)
+}
+
 // SolveTo finds a minimum-norm solution to a system of linear equations defined
 // by the matrices A and b, where A is an m×n matrix represented in its QR factorized
 // form. If A is singular or near-singular a Condition error is returned.
@@ -243,17 +370,9 @@ func (qr *QR) SolveTo(dst *Dense, trans bool, b Matrix) error {
 		for i := c; i < r; i++ {
 			zero(w.mat.Data[i*w.mat.Stride : i*w.mat.Stride+bc])
 		}
-		work := []float64{0}
-		lapack64.Ormqr(blas.Left, blas.NoTrans, qr.qr.mat, qr.tau, w.mat, work, -1)
-		work = getFloat64s(int(work[0]), false)
-		lapack64.Ormqr(blas.Left, blas.NoTrans, qr.qr.mat, qr.tau, w.mat, work, len(work))
-		putFloat64s(work)
+		qr.applyQ(blas.NoTrans, w)
 	} else {
-		work := []float64{0}
-		lapack64.Ormqr(blas.Left, blas.Trans, qr.qr.mat, qr.tau, w.mat, work, -1)
-		work = getFloat64s(int(work[0]), false)
-		lapack64.Ormqr(blas.Left, blas.Trans, qr.qr.mat, qr.tau, w.mat, work, len(work))
-		putFloat64s(work)
+		qr.applyQ(blas.Trans, w)
 
 		ok := lapack64.Trtrs(blas.NoTrans, t, w.mat)
 		if !ok {
@@ -303,3 +422,544 @@ func (qr *QR) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
 	}
 	return qr.SolveTo(dst.asDense(), trans, bm)
 }
+
+// applyQ overwrites the m×k matrix w with Q * w or Qᵀ * w depending on
+// trans.
+func (qr *QR) applyQ(trans blas.Transpose, w *Dense) {
+	if qr.tau == nil {
+		// The factorization has been updated, so Q is
+		// only held explicitly.
+		tmp := getDenseWorkspace(w.mat.Rows, w.mat.Cols, false)
+		tmp.Copy(w)
+		blas64.Gemm(trans, blas.NoTrans, 1, qr.q.mat, tmp.mat, 0, w.mat)
+		putDenseWorkspace(tmp)
+		return
+	}
+	work := []float64{0}
+	lapack64.Ormqr(blas.Left, trans, qr.qr.mat, qr.tau, w.mat, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Ormqr(blas.Left, trans, qr.qr.mat, qr.tau, w.mat, work, len(work))
+	putFloat64s(work)
+}
+
+// explicitR discards the elementary reflectors held below the diagonal of
+// qr.qr so that it holds R explicitly. After a call to explicitR, Q is only
+// available in its explicit form.
+func (qr *QR) explicitR() {
+	if qr.tau == nil {
+		return
+	}
+	qr.formQ()
+	m, n := qr.qr.Dims()
+	for i := 1; i < m; i++ {
+		zero(qr.qr.mat.Data[i*qr.qr.mat.Stride : i*qr.qr.mat.Stride+min(i, n)])
+	}
+	qr.tau = nil
+}
+
+// UpdateRows updates the QR factorization of the m×n matrix A held by the
+// receiver to the factorization of the (m+k)×n matrix
+//
+//	A' = ⎡A⎤
+//	     ⎣X⎦
+//
+// obtained by appending the k rows of the k×n matrix x to A. The factorization
+// is updated using Givens rotations in O(m²k) time rather than recomputed, so
+// UpdateRows can be used to add observations to a least-squares problem.
+//
+// UpdateRows will panic if the receiver does not contain a factorization or if
+// x does not have n columns.
+func (qr *QR) UpdateRows(x Matrix) {
+	if !qr.isValid() {
+		panic(badQR)
+	}
+	m, n := qr.Dims()
+	k, c := x.Dims()
+	if c != n {
+		panic(ErrShape)
+	}
+	qr.explicitR()
+
+	// Extend R with the rows of x and Q
+	// with a trailing identity block.
+	r := NewDense(m+k, n, nil)
+	r.Copy(qr.qr)
+	r.Slice(m, m+k, 0, n).(*Dense).Copy(x)
+	q := NewDense(m+k, m+k, nil)
+	q.Copy(qr.q)
+	for i := m; i < m+k; i++ {
+		q.set(i, i, 1)
+	}
+
+	// Annihilate the new rows with Givens rotations against
+	// the rows of R. The rotations are applied to the rows of
+	// R and accumulated into the columns of Q.
+	rd, rs := r.mat.Data, r.mat.Stride
+	qd, qs := q.mat.Data, q.mat.Stride
+	for i := m; i < m+k; i++ {
+		for j := 0; j < n; j++ {
+			if rd[i*rs+j] == 0 {
+				continue
+			}
+			cs, sn, v, _ := blas64.Rotg(rd[j*rs+j], rd[i*rs+j])
+			rd[j*rs+j] = v
+			rd[i*rs+j] = 0
+			if j < n-1 {
+				blas64.Rot(
+					blas64.Vector{N: n - j - 1, Data: rd[j*rs+j+1:], Inc: 1},
+					blas64.Vector{N: n - j - 1, Data: rd[i*rs+j+1:], Inc: 1},
+					cs, sn)
+			}
+			blas64.Rot(
+				blas64.Vector{N: m + k, Data: qd[j:], Inc: qs},
+				blas64.Vector{N: m + k, Data: qd[i:], Inc: qs},
+				cs, sn)
+		}
+	}
+
+	qr.qr = r
+	qr.q = q
+	qr.updateCond(CondNorm, nil)
+}
+
+// RemoveRows updates the QR factorization of the m×n matrix A held by the
+// receiver to the factorization of the (m-k)×n matrix obtained by deleting
+// the k rows of A starting at row i. The factorization is updated using Givens
+// rotations in O(m²k) time rather than recomputed, so RemoveRows can be used
+// to remove observations from a least-squares problem.
+//
+// RemoveRows will panic if the receiver does not contain a factorization, if
+// the rows to remove are not within A, or if m-k is less than n.
+func (qr *QR) RemoveRows(i, k int) {
+	if !qr.isValid() {
+		panic(badQR)
+	}
+	m, n := qr.Dims()
+	if i < 0 || k < 0 || i+k > m {
+		panic(ErrIndexOutOfRange)
+	}
+	if m-k < n {
+		panic(ErrShape)
+	}
+	if k == 0 {
+		return
+	}
+	qr.explicitR()
+
+	rd, rs := qr.qr.mat.Data, qr.qr.mat.Stride
+	qd, qs := qr.q.mat.Data, qr.q.mat.Stride
+	for ; k > 0; k, m = k-1, m-1 {
+		// Rotate row i of Q to a multiple of the first unit
+		// vector, making R upper Hessenberg. Row i of Q is
+		// then ±1 in its first column and, since Q is
+		// orthogonal, the first column of Q is zero except
+		// in row i.
+		for l := m - 1; l > 0; l-- {
+			if qd[i*qs+l] == 0 {
+				continue
+			}
+			cs, sn, v, _ := blas64.Rotg(qd[i*qs+l-1], qd[i*qs+l])
+			blas64.Rot(
+				blas64.Vector{N: m, Data: qd[l-1:], Inc: qs},
+				blas64.Vector{N: m, Data: qd[l:], Inc: qs},
+				cs, sn)
+			qd[i*qs+l-1] = v
+			qd[i*qs+l] = 0
+			if l-1 < n {
+				blas64.Rot(
+					blas64.Vector{N: n - l + 1, Data: rd[(l-1)*rs+l-1:], Inc: 1},
+					blas64.Vector{N: n - l + 1, Data: rd[l*rs+l-1:], Inc: 1},
+					cs, sn)
+			}
+		}
+
+		// Remove row i and the first column of Q, and
+		// the first row of R, leaving R upper triangular.
+		for r := 0; r < m-1; r++ {
+			src := r
+			if r >= i {
+				src++
+			}
+			copy(qd[r*qs:r*qs+m-1], qd[src*qs+1:src*qs+m])
+		}
+		for r := 0; r < m-1; r++ {
+			copy(rd[r*rs:r*rs+n], rd[(r+1)*rs:(r+1)*rs+n])
+		}
+	}
+
+	qr.qr = qr.qr.Slice(0, m, 0, n).(*Dense)
+	qr.q = qr.q.Slice(0, m, 0, m).(*Dense)
+	qr.updateCond(CondNorm, nil)
+}
+
+const (
+	badPivotedQR = "mat: invalid pivoted QR factorization"
+	badTol       = "mat: negative tolerance"
+)
+
+// PivotedQR is a type for creating and using the rank-revealing QR
+// factorization with column pivoting of a matrix.
+//
+// The factorization has the form
+//
+//	A * P = Q * R
+//
+// where P is a permutation matrix, Q is an orthonormal m×m matrix and R is an
+// m×n upper trapezoidal matrix. The columns are chosen so that the magnitudes
+// of the diagonal elements of R are non-increasing which allows the numerical
+// rank of A to be estimated.
+type PivotedQR struct {
+	qr            *Dense
+	q             *Dense
+	tau           []float64
+	piv, pivTrans []int
+	rank          int
+	cond          float64
+
+	// z and tauZ hold the LQ factorization of the
+	// leading rank rows of R used for minimum-norm
+	// solutions of rank-deficient systems.
+	z    *Dense
+	tauZ []float64
+}
+
+// Factorize computes the QR factorization with column pivoting of the m×n
+// matrix a. The factorization always exists even if A is rank-deficient.
+//
+// tol is a relative tolerance used to determine the numerical rank of A. The
+// rank is the number of diagonal elements of R whose magnitude is greater than
+// tol times the magnitude of the first diagonal element. If tol is zero, the
+// default tolerance max(m,n)·ε is used, where ε = 2⁻⁵² is the spacing of
+// float64 values at one. Factorize will panic if tol is negative.
+func (qr *PivotedQR) Factorize(a Matrix, tol float64) {
+	if tol < 0 {
+		panic(badTol)
+	}
+	m, n := a.Dims()
+	if qr.qr == nil {
+		qr.qr = &Dense{}
+	}
+	qr.qr.CloneFrom(a)
+	k := min(m, n)
+	qr.tau = make([]float64, k)
+	qr.piv = useInt(qr.piv, n)
+	for i := range qr.piv {
+		qr.piv[i] = -1
+	}
+	work := []float64{0}
+	lapack64.Geqp3(qr.qr.mat, qr.piv, qr.tau, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Geqp3(qr.qr.mat, qr.piv, qr.tau, work, len(work))
+	putFloat64s(work)
+	qr.pivTrans = useInt(qr.pivTrans, n)
+	for i, p := range qr.piv {
+		qr.pivTrans[p] = i
+	}
+
+	if tol == 0 {
+		tol = defaultRankTol(m, n)
+	}
+	qr.rank = 0
+	if k > 0 {
+		thresh := tol * math.Abs(qr.qr.at(0, 0))
+		for qr.rank < k && math.Abs(qr.qr.at(qr.rank, qr.rank)) > thresh {
+			qr.rank++
+		}
+	}
+
+	qr.updateCond()
+	qr.updateZ()
+	qr.updateQ()
+}
+
+// updateCond computes the condition number of the leading rank×rank
+// block of R.
+func (qr *PivotedQR) updateCond() {
+	r := qr.rank
+	if r == 0 {
+		qr.cond = math.Inf(1)
+		return
+	}
+	work := getFloat64s(3*r, false)
+	iwork := getInts(r, false)
+	t := qr.qr.slice(0, r, 0, r).asTriDense(r, blas.NonUnit, blas.Upper)
+	v := lapack64.Trcon(CondNorm, t.mat, work, iwork)
+	putFloat64s(work)
+	putInts(iwork)
+	qr.cond = 1 / v
+}
+
+// updateZ computes the LQ factorization of the leading rank rows of R.
+func (qr *PivotedQR) updateZ() {
+	r := qr.rank
+	_, n := qr.qr.Dims()
+	if r == 0 {
+		qr.z = nil
+		qr.tauZ = nil
+		return
+	}
+	if qr.z == nil {
+		qr.z = NewDense(r, n, nil)
+	} else {
+		qr.z.Reset()
+		qr.z.ReuseAs(r, n)
+	}
+	qr.z.Copy(qr.qr.slice(0, r, 0, n))
+	for i := 1; i < r; i++ {
+		zero(qr.z.mat.Data[i*qr.z.mat.Stride : i*qr.z.mat.Stride+i])
+	}
+	qr.tauZ = make([]float64, r)
+	work := []float64{0}
+	lapack64.Gelqf(qr.z.mat, qr.tauZ, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Gelqf(qr.z.mat, qr.tauZ, work, len(work))
+	putFloat64s(work)
+}
+
+func (qr *PivotedQR) updateQ() {
+	m, _ := qr.qr.Dims()
+	if qr.q == nil {
+		qr.q = NewDense(m, m, nil)
+	} else {
+		// The receiver may have been used to factorize
+		// a matrix with a different number of rows.
+		qr.q.Reset()
+		qr.q.reuseAsNonZeroed(m, m)
+	}
+	// Construct Q from the elementary reflectors.
+	k := min(m, qr.qr.mat.Cols)
+	qr.q.slice(0, m, 0, k).Copy(qr.qr.slice(0, m, 0, k))
+	work := []float64{0}
+	lapack64.Orgqr(qr.q.mat, qr.tau, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Orgqr(qr.q.mat, qr.tau, work, len(work))
+	putFloat64s(work)
+}
+
+// isValid returns whether the receiver contains a factorization.
+func (qr *PivotedQR) isValid() bool {
+	return qr.qr != nil && !qr.qr.IsEmpty()
+}
+
+// Dims returns the dimensions of the matrix.
+func (qr *PivotedQR) Dims() (r, c int) {
+	if qr.qr == nil {
+		return 0, 0
+	}
+	return qr.qr.Dims()
+}
+
+// At returns the element at row i, column j.
+func (qr *PivotedQR) At(i, j int) float64 {
+	m, n := qr.Dims()
+	if uint(i) >= uint(m) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(n) {
+		panic(ErrColAccess)
+	}
+
+	j = qr.pivTrans[j]
+	var val float64
+	for k := 0; k <= min(j, m-1); k++ {
+		val += qr.q.at(i, k) * qr.qr.at(k, j)
+	}
+	return val
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (qr *PivotedQR) T() Matrix {
+	return Transpose{qr}
+}
+
+// Rank returns the numerical rank of the factorized matrix.
+// Rank will panic if the receiver does not contain a factorization.
+func (qr *PivotedQR) Rank() int {
+	if !qr.isValid() {
+		panic(badPivotedQR)
+	}
+	return qr.rank
+}
+
+// Cond returns the condition number of the leading Rank×Rank block of R.
+// If the factorized matrix has full column rank, this is an estimate of the
+// condition number of the factorized matrix. If the rank is zero, Cond
+// returns +Inf.
+// Cond will panic if the receiver does not contain a factorization.
+func (qr *PivotedQR) Cond() float64 {
+	if !qr.isValid() {
+		panic(badPivotedQR)
+	}
+	return qr.cond
+}
+
+// ColumnPivots returns the column permutation p that represents the permutation
+// matrix P from the factorization
+//
+//	A * P = Q * R
+//
+// such that the nonzero entries are P[p[k],k] = 1.
+//
+// If dst is nil, a new slice is allocated and returned. If dst is not nil and
+// the length of dst does not equal the number of columns of the factorized
+// matrix, ColumnPivots will panic.
+// ColumnPivots will panic if the receiver does not contain a factorization.
+func (qr *PivotedQR) ColumnPivots(dst []int) []int {
+	if !qr.isValid() {
+		panic(badPivotedQR)
+	}
+	_, n := qr.qr.Dims()
+	if dst == nil {
+		dst = make([]int, n)
+	}
+	if len(dst) != n {
+		panic(badSliceLength)
+	}
+	copy(dst, qr.piv)
+	return dst
+}
+
+// RTo extracts the m×n upper trapezoidal matrix R from the factorization.
+//
+// If dst is empty, RTo will resize dst to be m×n. When dst is non-empty,
+// RTo will panic if dst is not m×n. RTo will also panic if the receiver
+// does not contain a factorization.
+func (qr *PivotedQR) RTo(dst *Dense) {
+	if !qr.isValid() {
+		panic(badPivotedQR)
+	}
+
+	r, c := qr.qr.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || c != c2 {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(qr.qr)
+
+	// Zero below the diagonal.
+	for i := 1; i < r; i++ {
+		zero(dst.mat.Data[i*dst.mat.Stride : i*dst.mat.Stride+min(i, c)])
+	}
+}
+
+// QTo extracts the m×m orthonormal matrix Q from the factorization.
+//
+// If dst is empty, QTo will resize dst to be m×m. When dst is non-empty,
+// QTo will panic if dst is not m×m. QTo will also panic if the receiver
+// does not contain a factorization.
+func (qr *PivotedQR) QTo(dst *Dense) {
+	if !qr.isValid() {
+		panic(badPivotedQR)
+	}
+
+	r, _ := qr.qr.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, r)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || r != c2 {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(qr.q)
+}
+
+// SolveTo finds the minimum-norm solution X of the least-squares problem
+//
+//	minimize ||A*X - B||_2
+//
+// where A is an m×n matrix represented in its pivoted QR factorized form and
+// B is an m×k matrix. A may be rank-deficient, in which case the rows of R
+// beyond the numerical rank are treated as zero and the complete orthogonal
+// decomposition of A is used to compute the solution of minimum norm.
+//
+// The solution matrix, X, is stored in place into dst. If the leading
+// Rank×Rank block of R is near-singular a Condition error is returned. See
+// the documentation for Condition for more information.
+// SolveTo will panic if the receiver does not contain a factorization.
+func (qr *PivotedQR) SolveTo(dst *Dense, b Matrix) error {
+	if !qr.isValid() {
+		panic(badPivotedQR)
+	}
+
+	m, n := qr.qr.Dims()
+	br, bc := b.Dims()
+	if m != br {
+		panic(ErrShape)
+	}
+	dst.reuseAsNonZeroed(n, bc)
+
+	w := getDenseWorkspace(max(m, n), bc, false)
+	defer putDenseWorkspace(w)
+	w.slice(0, m, 0, bc).Copy(b)
+
+	// Compute C = Qᵀ * B.
+	work := []float64{0}
+	lapack64.Ormqr(blas.Left, blas.Trans, qr.qr.mat, qr.tau, w.slice(0, m, 0, bc).mat, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Ormqr(blas.Left, blas.Trans, qr.qr.mat, qr.tau, w.slice(0, m, 0, bc).mat, work, len(work))
+	putFloat64s(work)
+
+	r := qr.rank
+	if r > 0 {
+		// With the leading rows of R written as L * Z, solve
+		// L * Y = C[0:r] and form X = P * Zᵀ * [Y; 0].
+		l := qr.z.slice(0, r, 0, r).asTriDense(r, blas.NonUnit, blas.Lower)
+		ok := lapack64.Trtrs(blas.NoTrans, l.mat, w.slice(0, r, 0, bc).mat)
+		if !ok {
+			return Condition(math.Inf(1))
+		}
+	}
+	for i := r; i < n; i++ {
+		zero(w.mat.Data[i*w.mat.Stride : i*w.mat.Stride+bc])
+	}
+	y := w.slice(0, n, 0, bc)
+	if r > 0 {
+		work = []float64{0}
+		lapack64.Ormlq(blas.Left, blas.Trans, qr.z.mat, qr.tauZ, y.mat, work, -1)
+		work = getFloat64s(int(work[0]), false)
+		lapack64.Ormlq(blas.Left, blas.Trans, qr.z.mat, qr.tauZ, y.mat, work, len(work))
+		putFloat64s(work)
+	}
+	lapack64.Lapmr(false, y.mat, qr.piv)
+
+	dst.Copy(y)
+	if qr.cond > ConditionTolerance {
+		return Condition(qr.cond)
+	}
+	return nil
+}
+
+// SolveVecTo finds the minimum-norm solution x of the least-squares problem
+//
+//	minimize ||A*x - b||_2.
+//
+// See PivotedQR.SolveTo for the full documentation.
+// SolveVecTo will panic if the receiver does not contain a factorization.
+func (qr *PivotedQR) SolveVecTo(dst *VecDense, b Vector) error {
+	if !qr.isValid() {
+		panic(badPivotedQR)
+	}
+
+	_, c := qr.qr.Dims()
+	if _, bc := b.Dims(); bc != 1 {
+		panic(ErrShape)
+	}
+
+	bm := Matrix(b)
+	if rv, ok := b.(RawVectorer); ok {
+		bmat := rv.RawVector()
+		if dst != b {
+			dst.checkOverlap(bmat)
+		}
+		b := VecDense{mat: bmat}
+		bm = b.asDense()
+	}
+	dst.reuseAsNonZeroed(c)
+	return qr.SolveTo(dst.asDense(), bm)
+}
@@ -38,3 +38,38 @@ func ExampleQR_solveTo() {
 	// ⎡0.000⎤
 	// ⎣1.000⎦
 }
+
+func ExamplePivotedQR_solveTo() {
+	// QR factorization with column pivoting reveals the numerical
+	// rank of a matrix and can be used to find the minimum-norm
+	// least-squares solution of a rank-deficient system.
+	//
+	// Here, the third column of A is the sum of the first two.
+
+	var (
+		a = mat.NewDense(4, 3, []float64{
+			1, 0, 1,
+			0, 1, 1,
+			1, 1, 2,
+			1, 0, 1,
+		})
+		b = mat.NewDense(4, 1, []float64{2, 2, 4, 2})
+		x = mat.NewDense(3, 1, nil)
+	)
+
+	var qr mat.PivotedQR
+	qr.Factorize(a, 0)
+	fmt.Println("rank:", qr.Rank())
+
+	err := qr.SolveTo(x, b)
+	if err != nil {
+		log.Fatalf("could not solve QR: %+v", err)
+	}
+	fmt.Printf("%.3f\n", mat.Formatted(x))
+
+	// Output:
+	// rank: 2
+	// ⎡0.667⎤
+	// ⎢0.667⎥
+	// ⎣1.333⎦
+}
@@ -5,7 +5,9 @@
 package mat
 
 import (
+	"fmt"
 	"math"
+	"sync"
 	"testing"
 
 	"golang.org/x/exp/rand"
@@ -59,6 +61,111 @@ func TestQR(t *testing.T) {
 	}
 }
 
+func TestQRThin(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{1, 1},
+		{5, 5},
+		{10, 5},
+		{50, 3},
+	} {
+		m := test.m
+		n := test.n
+		a := NewDense(m, n, nil)
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a.Set(i, j, rnd.NormFloat64())
+			}
+		}
+
+		var qr QR
+		qr.Factorize(a)
+
+		var q1 Dense
+		var r1 TriDense
+		qr.ThinQTo(&q1)
+		qr.ThinRTo(&r1)
+		if r, c := q1.Dims(); r != m || c != n {
+			t.Errorf("m=%d,n=%d: unexpected dims of thin Q: %d×%d", m, n, r, c)
+			continue
+		}
+		var qtq Dense
+		qtq.Mul(q1.T(), &q1)
+		if !EqualApprox(&qtq, eye(n), 1e-14) {
+			t.Errorf("m=%d,n=%d: thin Q does not have orthonormal columns", m, n)
+		}
+		var got Dense
+		got.Mul(&q1, &r1)
+		if !EqualApprox(&got, a, 1e-13) {
+			t.Errorf("m=%d,n=%d: Q₁ * R₁ does not equal A", m, n)
+		}
+
+		// The thin factors must be the leading blocks of the full factors.
+		var q, r Dense
+		qr.QTo(&q)
+		qr.RTo(&r)
+		if !EqualApprox(&q1, q.Slice(0, m, 0, n), 1e-14) {
+			t.Errorf("m=%d,n=%d: thin Q does not match leading columns of Q", m, n)
+		}
+		if !EqualApprox(&r1, r.Slice(0, n, 0, n), 1e-14) {
+			t.Errorf("m=%d,n=%d: thin R does not match leading rows of R", m, n)
+		}
+		var q2 Dense
+		qr.ThinQTo(&q2)
+		if !EqualApprox(&q1, &q2, 1e-14) {
+			t.Errorf("m=%d,n=%d: thin Q differs after forming Q", m, n)
+		}
+
+		// RTo must accept a non-empty m×n destination.
+		r.Zero()
+		qr.RTo(&r)
+
+		for _, trans := range []bool{false, true} {
+			for _, bc := range []int{1, 4} {
+				b := NewDense(m, bc, nil)
+				for i := range b.mat.Data {
+					b.mat.Data[i] = rnd.NormFloat64()
+				}
+				var want Dense
+				if trans {
+					want.Mul(q.T(), b)
+				} else {
+					want.Mul(&q, b)
+				}
+
+				var fresh QR
+				fresh.Factorize(a)
+				var got Dense
+				fresh.MulQTo(&got, trans, b)
+				if !EqualApprox(&got, &want, 1e-13) {
+					t.Errorf("m=%d,n=%d,trans=%t: unexpected result from MulQTo", m, n, trans)
+				}
+
+				// The receiver may be the right-hand side.
+				fresh.MulQTo(b, trans, b)
+				if !EqualApprox(b, &want, 1e-13) {
+					t.Errorf("m=%d,n=%d,trans=%t: unexpected result from MulQTo in place", m, n, trans)
+				}
+			}
+		}
+	}
+
+	var qr QR
+	qr.Factorize(NewDense(3, 2, []float64{1, 2, 3, 4, 5, 6}))
+	if panicked, message := panics(func() { qr.MulQTo(&Dense{}, false, NewDense(2, 2, nil)) }); !panicked || message != ErrShape.Error() {
+		t.Errorf("unexpected panic for MulQTo with bad shape: %q", message)
+	}
+	if panicked, message := panics(func() { qr.ThinQTo(NewDense(3, 3, nil)) }); !panicked || message != ErrShape.Error() {
+		t.Errorf("unexpected panic for ThinQTo with bad shape: %q", message)
+	}
+	if panicked, message := panics(func() { qr.ThinRTo(NewTriDense(2, Lower, nil)) }); !panicked || message != ErrTriangle.Error() {
+		t.Errorf("unexpected panic for ThinRTo with lower triangle: %q", message)
+	}
+}
+
 func isOrthonormal(q *Dense, tol float64) bool {
 	m, n := q.Dims()
 	if m != n {
@@ -80,6 +187,51 @@ func isOrthonormal(q *Dense, tol float64) bool {
 	return true
 }
 
+func TestQRConcurrentAccess(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	const m, n = 12, 4
+	a := NewDense(m, n, nil)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			a.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	var qr QR
+	qr.Factorize(a)
+
+	// Q is formed by the first accessor that needs it, so
+	// concurrent accessors must not race to form it.
+	const workers = 8
+	var wg sync.WaitGroup
+	got := make([]Dense, workers)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			if w%2 == 0 {
+				got[w].ReuseAs(m, n)
+				for i := 0; i < m; i++ {
+					for j := 0; j < n; j++ {
+						got[w].Set(i, j, qr.At(i, j))
+					}
+				}
+				return
+			}
+			var q, r Dense
+			qr.QTo(&q)
+			qr.RTo(&r)
+			got[w].Mul(&q, &r)
+		}(w)
+	}
+	wg.Wait()
+	for w := range got {
+		if !EqualApprox(&got[w], a, 1e-12) {
+			t.Errorf("unexpected reconstruction by worker %d", w)
+		}
+	}
+}
+
 func TestQRSolveTo(t *testing.T) {
 	t.Parallel()
 	rnd := rand.New(rand.NewSource(1))
@@ -223,3 +375,293 @@ func TestQRSolveCondTo(t *testing.T) {
 		}
 	}
 }
+
+func TestQRUpdateRemoveRows(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	randDense := func(m, n int) *Dense {
+		d := NewDense(m, n, nil)
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				d.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		return d
+	}
+	// checkQR checks that the receiver is a valid QR factorization
+	// of want and that it gives the same least-squares solution as
+	// a factorization computed from scratch.
+	checkQR := func(name string, qr *QR, want *Dense) {
+		t.Helper()
+		m, n := want.Dims()
+		if r, c := qr.Dims(); r != m || c != n {
+			t.Errorf("%s: unexpected dimensions: got:%d×%d want:%d×%d", name, r, c, m, n)
+			return
+		}
+		var q, r Dense
+		qr.QTo(&q)
+		qr.RTo(&r)
+		if !isOrthonormal(&q, 1e-12) {
+			t.Errorf("%s: Q is not orthonormal", name)
+		}
+		for i := 0; i < m; i++ {
+			for j := 0; j < min(i, n); j++ {
+				if r.At(i, j) != 0 {
+					t.Errorf("%s: R is not upper triangular", name)
+					i = m
+					break
+				}
+			}
+		}
+		var got Dense
+		got.Mul(&q, &r)
+		if !EqualApprox(&got, want, 1e-12) {
+			t.Errorf("%s: QR does not equal original matrix.\nwant:\n%v\ngot:\n%v", name, Formatted(want), Formatted(&got))
+		}
+		if !EqualApprox(qr, want, 1e-12) {
+			t.Errorf("%s: At does not match original matrix", name)
+		}
+
+		b := randDense(m, 2)
+		var fresh QR
+		fresh.Factorize(want)
+		var x, xWant Dense
+		if err := qr.SolveTo(&x, false, b); err != nil {
+			t.Errorf("%s: unexpected error from SolveTo: %v", name, err)
+		}
+		fresh.SolveTo(&xWant, false, b)
+		if !EqualApprox(&x, &xWant, 1e-10) {
+			t.Errorf("%s: unexpected least-squares solution.\nwant:\n%v\ngot:\n%v", name, Formatted(&xWant), Formatted(&x))
+		}
+		bt := randDense(n, 2)
+		x.Reset()
+		xWant.Reset()
+		if err := qr.SolveTo(&x, true, bt); err != nil {
+			t.Errorf("%s: unexpected error from transposed SolveTo: %v", name, err)
+		}
+		fresh.SolveTo(&xWant, true, bt)
+		if !EqualApprox(&x, &xWant, 1e-10) {
+			t.Errorf("%s: unexpected minimum-norm solution.\nwant:\n%v\ngot:\n%v", name, Formatted(&xWant), Formatted(&x))
+		}
+		if math.Abs(qr.Cond()-fresh.Cond()) > 1e-8*fresh.Cond() {
+			t.Errorf("%s: unexpected condition number: got:%v want:%v", name, qr.Cond(), fresh.Cond())
+		}
+	}
+
+	for _, test := range []struct {
+		m, n, k int
+	}{
+		{1, 1, 1},
+		{3, 3, 1},
+		{5, 3, 2},
+		{10, 4, 3},
+		{20, 7, 10},
+	} {
+		a := randDense(test.m, test.n)
+		x := randDense(test.k, test.n)
+
+		var qr QR
+		qr.Factorize(a)
+		qr.UpdateRows(x)
+		var want Dense
+		want.Stack(a, x)
+		name := fmt.Sprintf("m=%d,n=%d,k=%d update", test.m, test.n, test.k)
+		checkQR(name, &qr, &want)
+
+		// Removing the appended rows recovers A.
+		qr.RemoveRows(test.m, test.k)
+		checkQR(fmt.Sprintf("m=%d,n=%d,k=%d remove appended", test.m, test.n, test.k), &qr, a)
+
+		// Remove rows from each position.
+		m := test.m + test.k
+		for i := 0; i+test.k <= m; i++ {
+			if m-test.k < test.n {
+				break
+			}
+			qr.Factorize(&want)
+			qr.RemoveRows(i, test.k)
+			var rest Dense
+			switch {
+			case i == 0:
+				rest.CloneFrom(want.Slice(test.k, m, 0, test.n))
+			case i+test.k == m:
+				rest.CloneFrom(want.Slice(0, i, 0, test.n))
+			default:
+				rest.Stack(want.Slice(0, i, 0, test.n), want.Slice(i+test.k, m, 0, test.n))
+			}
+			checkQR(fmt.Sprintf("m=%d,n=%d,k=%d remove at %d", test.m, test.n, test.k, i), &qr, &rest)
+		}
+	}
+
+	// A sliding window of observations.
+	const n, window = 4, 8
+	data := randDense(40, n)
+	var qr QR
+	qr.Factorize(data.Slice(0, window, 0, n))
+	for i := window; i < 40; i++ {
+		qr.UpdateRows(data.Slice(i, i+1, 0, n))
+		qr.RemoveRows(0, 1)
+		var want Dense
+		want.CloneFrom(data.Slice(i-window+1, i+1, 0, n))
+		checkQR(fmt.Sprintf("window at %d", i), &qr, &want)
+	}
+
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "update shape", fn: func() { qr.UpdateRows(NewDense(1, n+1, nil)) }},
+		{name: "remove range", fn: func() { qr.RemoveRows(window-1, 2) }},
+		{name: "remove negative", fn: func() { qr.RemoveRows(-1, 1) }},
+		{name: "remove too many", fn: func() { qr.RemoveRows(0, window-n+1) }},
+		{name: "empty", fn: func() { var qr QR; qr.UpdateRows(NewDense(1, 1, nil)) }},
+	} {
+		if p, _ := panics(test.fn); !p {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
+
+func TestPivotedQR(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, rank int
+	}{
+		{1, 1, 1},
+		{5, 5, 5},
+		{10, 5, 5},
+		{5, 10, 5},
+		{8, 6, 3},
+		{6, 8, 2},
+		{7, 7, 1},
+		{4, 3, 0},
+	} {
+		m, n, rank := test.m, test.n, test.rank
+		a := randLowRank(m, n, rank, rnd)
+
+		var qr PivotedQR
+		qr.Factorize(a, 0)
+
+		if got := qr.Rank(); got != rank {
+			t.Errorf("m=%d,n=%d: unexpected rank: got:%d want:%d", m, n, got, rank)
+		}
+		if !EqualApprox(a, &qr, 1e-12) {
+			t.Errorf("m=%d,n=%d: A and QRPᵀ are not equal", m, n)
+		}
+		if !EqualApprox(a.T(), qr.T(), 1e-12) {
+			t.Errorf("m=%d,n=%d: Aᵀ and (QRPᵀ)ᵀ are not equal", m, n)
+		}
+
+		var q, r Dense
+		qr.QTo(&q)
+		if !isOrthonormal(&q, 1e-12) {
+			t.Errorf("m=%d,n=%d: Q is not orthonormal", m, n)
+		}
+		qr.RTo(&r)
+		for i := 0; i < m; i++ {
+			for j := 0; j < min(i, n); j++ {
+				if r.At(i, j) != 0 {
+					t.Errorf("m=%d,n=%d: R is not upper trapezoidal", m, n)
+				}
+			}
+		}
+		for i := 1; i < min(m, n); i++ {
+			if math.Abs(r.At(i, i)) > math.Abs(r.At(i-1, i-1))*(1+1e-12) {
+				t.Errorf("m=%d,n=%d: diagonal of R is not non-increasing in magnitude", m, n)
+			}
+		}
+
+		// Check that A * P = Q * R.
+		piv := qr.ColumnPivots(nil)
+		ap := NewDense(m, n, nil)
+		for j, p := range piv {
+			for i := 0; i < m; i++ {
+				ap.Set(i, j, a.At(i, p))
+			}
+		}
+		var got Dense
+		got.Mul(&q, &r)
+		if !EqualApprox(&got, ap, 1e-12) {
+			t.Errorf("m=%d,n=%d: Q*R does not equal A*P", m, n)
+		}
+	}
+}
+
+// randLowRank returns a random m×n matrix with rank r.
+func randLowRank(m, n, r int, rnd *rand.Rand) *Dense {
+	a := NewDense(m, n, nil)
+	if r == 0 {
+		return a
+	}
+	x := NewDense(m, r, nil)
+	for i := range x.mat.Data {
+		x.mat.Data[i] = rnd.NormFloat64()
+	}
+	y := NewDense(r, n, nil)
+	for i := range y.mat.Data {
+		y.mat.Data[i] = rnd.NormFloat64()
+	}
+	a.Mul(x, y)
+	return a
+}
+
+func TestPivotedQRSolveTo(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, rank, bc int
+	}{
+		{5, 5, 5, 1},
+		{10, 5, 5, 3},
+		{5, 10, 5, 2},
+		{8, 6, 3, 1},
+		{6, 8, 2, 4},
+		{7, 7, 1, 2},
+		{4, 3, 0, 1},
+	} {
+		m, n, rank, bc := test.m, test.n, test.rank, test.bc
+		a := randLowRank(m, n, rank, rnd)
+		b := NewDense(m, bc, nil)
+		for i := range b.mat.Data {
+			b.mat.Data[i] = rnd.NormFloat64()
+		}
+
+		var qr PivotedQR
+		qr.Factorize(a, 0)
+		var x Dense
+		err := qr.SolveTo(&x, b)
+		if rank == 0 {
+			if err == nil {
+				t.Errorf("m=%d,n=%d: expected Condition error for zero matrix", m, n)
+			}
+		} else if err != nil {
+			t.Errorf("m=%d,n=%d: unexpected error: %v", m, n, err)
+		}
+
+		// The minimum-norm least-squares solution is
+		// unique and given by the pseudo-inverse.
+		want := NewDense(n, bc, nil)
+		if rank > 0 {
+			var svd SVD
+			if !svd.Factorize(a, SVDFull) {
+				t.Fatalf("m=%d,n=%d: SVD factorization failed", m, n)
+			}
+			svd.SolveTo(want, b, rank)
+		}
+		if !EqualApprox(&x, want, 1e-10) {
+			t.Errorf("m=%d,n=%d: unexpected solution:\ngot: %v\nwant:%v", m, n, Formatted(&x), Formatted(want))
+		}
+
+		for j := 0; j < bc; j++ {
+			var xv VecDense
+			err := qr.SolveVecTo(&xv, b.ColView(j))
+			if rank > 0 && err != nil {
+				t.Errorf("m=%d,n=%d: unexpected error from SolveVecTo: %v", m, n, err)
+			}
+			if !EqualApprox(&xv, want.ColView(j), 1e-10) {
+				t.Errorf("m=%d,n=%d: SolveVecTo does not match SolveTo for column %d", m, n, j)
+			}
+		}
+	}
+}
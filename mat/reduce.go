@@ -0,0 +1,261 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"sync"
+	"sync/atomic"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+// Reduction specifies how a reduction over the elements of a matrix or
+// vector is evaluated by its Sum, Dot and Norm methods. The zero value
+// specifies sequential evaluation, giving the same results as the package
+// functions Sum, Dot and Norm.
+//
+// Floating point addition is not associative, so the result of a reduction
+// depends on the order in which its terms are combined. When Deterministic is
+// false, a parallel reduction splits its terms among Workers goroutines and
+// its result may change with the number of workers. When Deterministic is
+// true, the terms are summed in blocks of a fixed size and the block results
+// are combined by pairwise reduction in a fixed order, so the result is
+// bit-for-bit reproducible across runs and for any number of workers.
+// Reductions of fewer terms than the block size give the same result as
+// sequential evaluation.
+//
+// Reductions of matrix types that do not provide access to their raw data
+// are always evaluated sequentially.
+type Reduction struct {
+	// Workers is the maximum number of goroutines used to evaluate
+	// a reduction. Values less than 2 specify sequential evaluation.
+	Workers int
+
+	// Deterministic specifies that reductions are evaluated in a
+	// fixed order that does not depend on Workers.
+	Deterministic bool
+}
+
+// reductionBlockSize is the number of terms in the blocks of a reduction.
+// It is part of the definition of the result of a deterministic reduction
+// and must not depend on the number of workers.
+const reductionBlockSize = 1 << 12
+
+// Sum returns the sum of the elements of a, evaluated as specified by r.
+//
+// Sum will panic with ErrZeroLength if the matrix has zero size.
+func (r Reduction) Sum(a Matrix) float64 {
+	switch a := a.(type) {
+	case RawMatrixer:
+		if rm := a.RawMatrix(); rm.Rows != 0 && rm.Cols != 0 && r.use(rm.Rows, rowBlockSize(rm.Cols)) {
+			return r.sumGeneral(rm)
+		}
+	case *VecDense:
+		if rv := a.RawVector(); rv.N != 0 && r.use(rv.N, reductionBlockSize) {
+			return r.sumVector(rv)
+		}
+	}
+	return Sum(a)
+}
+
+// Dot returns the sum of the element-wise product of a and b, evaluated as
+// specified by r.
+//
+// Dot panics with ErrShape if the vector sizes are unequal and with
+// ErrZeroLength if the sizes are zero.
+func (r Reduction) Dot(a, b Vector) float64 {
+	la := a.Len()
+	if la != b.Len() || la == 0 {
+		return Dot(a, b)
+	}
+	if arv, ok := a.(RawVectorer); ok {
+		if brv, ok := b.(RawVectorer); ok {
+			x, y := arv.RawVector(), brv.RawVector()
+			if r.use(la, reductionBlockSize) && x.Inc > 0 && y.Inc > 0 {
+				return r.dot(x, y)
+			}
+		}
+	}
+	return Dot(a, b)
+}
+
+// Norm returns the specified norm of a as Norm does. The 1-norm and 2-norm
+// of a *VecDense and the Frobenius norm of a *Dense are evaluated as
+// specified by r.
+//
+// Norm will panic with ErrNormOrder if an illegal norm is specified and with
+// ErrZeroLength if the matrix has zero size.
+func (r Reduction) Norm(a Matrix, norm float64) float64 {
+	switch a := a.(type) {
+	case *VecDense:
+		if v := a.RawVector(); v.N != 0 && r.use(v.N, reductionBlockSize) {
+			switch norm {
+			case 1:
+				return r.asum(v)
+			case 2:
+				return r.nrm2(v)
+			}
+		}
+	case *Dense:
+		if m := a.RawMatrix(); norm == 2 && !a.IsEmpty() && r.use(m.Rows, rowBlockSize(m.Cols)) {
+			return r.frobenius(m)
+		}
+	}
+	return Norm(a, norm)
+}
+
+// use returns whether a reduction over n items grouped into blocks of bs
+// items should be evaluated by reduce rather than by the sequential code path.
+func (r Reduction) use(n, bs int) bool {
+	return r.Deterministic || (r.Workers > 1 && n > bs)
+}
+
+// reduce evaluates a reduction over the half-open range [0, n) whose terms
+// are grouped into blocks of bs elements. The partial result for [lo, hi) is
+// computed by partial, and partial results are combined with combine.
+func (r Reduction) reduce(n, bs int, partial func(lo, hi int) float64, combine func(x, y float64) float64) float64 {
+	nb := (n + bs - 1) / bs
+	if nb == 1 {
+		return partial(0, n)
+	}
+	if r.Deterministic {
+		results := make([]float64, nb)
+		parallelFor(nb, r.Workers, func(b int) {
+			results[b] = partial(b*bs, min(n, (b+1)*bs))
+		})
+		return pairwise(results, combine)
+	}
+
+	workers := min(r.Workers, nb)
+	chunk := (n + workers - 1) / workers
+	results := make([]float64, workers)
+	parallelFor(workers, workers, func(w int) {
+		lo := w * chunk
+		results[w] = partial(lo, min(n, lo+chunk))
+	})
+	acc := results[0]
+	for _, v := range results[1:] {
+		acc = combine(acc, v)
+	}
+	return acc
+}
+
+// pairwise combines the values in x by recursively combining the
+// reductions of the two halves of x.
+func pairwise(x []float64, combine func(x, y float64) float64) float64 {
+	if len(x) == 1 {
+		return x[0]
+	}
+	h := len(x) / 2
+	return combine(pairwise(x[:h], combine), pairwise(x[h:], combine))
+}
+
+// parallelFor calls fn for each i in [0, n) using up to workers goroutines.
+func parallelFor(n, workers int, fn func(i int)) {
+	if workers < 2 || n < 2 {
+		for i := 0; i < n; i++ {
+			fn(i)
+		}
+		return
+	}
+	workers = min(workers, n)
+	var (
+		next int64 = -1
+		wg   sync.WaitGroup
+	)
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= n {
+					return
+				}
+				fn(i)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func add(x, y float64) float64 { return x + y }
+
+// rowBlockSize returns the number of rows of a matrix with c columns
+// in a reduction block.
+func rowBlockSize(c int) int {
+	return max(1, reductionBlockSize/c)
+}
+
+// sumGeneral returns the sum of the elements of m using the reduction
+// policy r.
+func (r Reduction) sumGeneral(m blas64.General) float64 {
+	return r.reduce(m.Rows, rowBlockSize(m.Cols), func(lo, hi int) float64 {
+		var sum float64
+		for i := lo; i < hi; i++ {
+			for _, v := range m.Data[i*m.Stride : i*m.Stride+m.Cols] {
+				sum += v
+			}
+		}
+		return sum
+	}, add)
+}
+
+// sumVector returns the sum of the elements of v using the reduction
+// policy r.
+func (r Reduction) sumVector(v blas64.Vector) float64 {
+	return r.reduce(v.N, reductionBlockSize, func(lo, hi int) float64 {
+		var sum float64
+		for i := lo; i < hi; i++ {
+			sum += v.Data[i*v.Inc]
+		}
+		return sum
+	}, add)
+}
+
+// dot returns the dot product of x and y using the reduction policy r.
+// The increments of x and y must be positive.
+func (r Reduction) dot(x, y blas64.Vector) float64 {
+	return r.reduce(x.N, reductionBlockSize, func(lo, hi int) float64 {
+		return blas64.Dot(subVector(x, lo, hi), subVector(y, lo, hi))
+	}, add)
+}
+
+// asum returns the sum of the absolute values of the elements of x using
+// the reduction policy r.
+func (r Reduction) asum(x blas64.Vector) float64 {
+	return r.reduce(x.N, reductionBlockSize, func(lo, hi int) float64 {
+		return blas64.Asum(subVector(x, lo, hi))
+	}, add)
+}
+
+// nrm2 returns the Euclidean norm of x using the reduction policy r.
+func (r Reduction) nrm2(x blas64.Vector) float64 {
+	// The norms of the blocks are combined with math.Hypot
+	// to avoid overflow and underflow.
+	return r.reduce(x.N, reductionBlockSize, func(lo, hi int) float64 {
+		return blas64.Nrm2(subVector(x, lo, hi))
+	}, math.Hypot)
+}
+
+// frobenius returns the Frobenius norm of m using the reduction policy r.
+func (r Reduction) frobenius(m blas64.General) float64 {
+	return r.reduce(m.Rows, rowBlockSize(m.Cols), func(lo, hi int) float64 {
+		return lapack64.Lange(lapack.Frobenius, subRows(m, lo, hi), nil)
+	}, math.Hypot)
+}
+
+// subVector returns the elements [lo, hi) of v.
+func subVector(v blas64.Vector, lo, hi int) blas64.Vector {
+	return blas64.Vector{N: hi - lo, Inc: v.Inc, Data: v.Data[lo*v.Inc:]}
+}
+
+// subRows returns the rows [lo, hi) of m.
+func subRows(m blas64.General, lo, hi int) blas64.General {
+	return blas64.General{Rows: hi - lo, Cols: m.Cols, Stride: m.Stride, Data: m.Data[lo*m.Stride:]}
+}
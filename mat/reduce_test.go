@@ -0,0 +1,128 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// reductions returns the results of all the reductions
+// evaluated according to r.
+func reductions(r Reduction, a *Dense, x, y *VecDense) [6]float64 {
+	return [6]float64{
+		r.Sum(a),
+		r.Sum(x),
+		r.Dot(x, y),
+		r.Norm(a, 2),
+		r.Norm(x, 1),
+		r.Norm(x, 2),
+	}
+}
+
+func TestReduction(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		r, c, n int
+		inc     int
+	}{
+		{r: 3, c: 4, n: 10, inc: 1},
+		{r: 1000, c: 37, n: 50001, inc: 1},
+		{r: 5, c: 20000, n: reductionBlockSize, inc: 3},
+		{r: 20000, c: 1, n: 3*reductionBlockSize + 1, inc: 2},
+	} {
+		// Use values with a wide dynamic range so that the
+		// results depend strongly on the order of evaluation.
+		a := NewDense(test.r, test.c+1, nil)
+		for i := 0; i < test.r; i++ {
+			for j := 0; j < test.c+1; j++ {
+				a.Set(i, j, rnd.NormFloat64()*math.Pow(10, float64(rnd.Intn(16))))
+			}
+		}
+		// Use a non-contiguous view of a.
+		as := a.Slice(0, test.r, 0, test.c).(*Dense)
+
+		xd := make([]float64, test.n*test.inc)
+		yd := make([]float64, test.n*test.inc)
+		for i := range xd {
+			xd[i] = rnd.NormFloat64() * math.Pow(10, float64(rnd.Intn(16)))
+			yd[i] = rnd.NormFloat64()
+		}
+		x := NewVecDense(len(xd), xd)
+		y := NewVecDense(len(yd), yd)
+		if test.inc != 1 {
+			x = NewDense(test.n, test.inc, xd).ColView(0).(*VecDense)
+			y = NewDense(test.n, test.inc, yd).ColView(test.inc - 1).(*VecDense)
+		}
+
+		want := reductions(Reduction{}, as, x, y)
+		seq := [6]float64{Sum(as), Sum(x), Dot(x, y), Norm(as, 2), Norm(x, 1), Norm(x, 2)}
+		if want != seq {
+			t.Errorf("%d×%d n=%d: zero Reduction differs from package functions: got:%v want:%v",
+				test.r, test.c, test.n, want, seq)
+		}
+
+		var deterministic [6]float64
+		for i, workers := range []int{0, 1, 2, 3, 8} {
+			got := reductions(Reduction{Workers: workers, Deterministic: true}, as, x, y)
+			if i == 0 {
+				deterministic = got
+			} else if got != deterministic {
+				t.Errorf("%d×%d n=%d: deterministic result depends on workers=%d: got:%v want:%v",
+					test.r, test.c, test.n, workers, got, deterministic)
+			}
+			for k := range got {
+				if !scalar.EqualWithinRel(got[k], want[k], 1e-10) {
+					t.Errorf("%d×%d n=%d: unexpected deterministic result %d with workers=%d: got:%v want:%v",
+						test.r, test.c, test.n, k, workers, got[k], want[k])
+				}
+			}
+
+			got = reductions(Reduction{Workers: workers}, as, x, y)
+			for k := range got {
+				if !scalar.EqualWithinRel(got[k], want[k], 1e-10) {
+					t.Errorf("%d×%d n=%d: unexpected parallel result %d with workers=%d: got:%v want:%v",
+						test.r, test.c, test.n, k, workers, got[k], want[k])
+				}
+			}
+		}
+
+		// Reductions within a single block are evaluated
+		// sequentially.
+		if test.r*test.c <= reductionBlockSize && test.n <= reductionBlockSize && deterministic != want {
+			t.Errorf("%d×%d n=%d: small deterministic reduction differs from sequential: got:%v want:%v",
+				test.r, test.c, test.n, deterministic, want)
+		}
+	}
+
+	// Deterministic reductions are repeatable across runs.
+	r := Reduction{Workers: 4, Deterministic: true}
+	x := NewVecDense(100000, nil)
+	for i := 0; i < x.Len(); i++ {
+		x.SetVec(i, rnd.NormFloat64()*math.Pow(10, float64(rnd.Intn(16))))
+	}
+	want := r.Sum(x)
+	for i := 0; i < 20; i++ {
+		if got := r.Sum(x); got != want {
+			t.Fatalf("deterministic reduction not repeatable: got:%v want:%v", got, want)
+		}
+	}
+
+	// The 2-norm does not overflow for large elements.
+	x = NewVecDense(3*reductionBlockSize, nil)
+	for i := 0; i < x.Len(); i++ {
+		x.SetVec(i, 1e300)
+	}
+	got := r.Norm(x, 2)
+	wantNorm := 1e300 * math.Sqrt(float64(x.Len()))
+	if !scalar.EqualWithinRel(got, wantNorm, 1e-12) {
+		t.Errorf("unexpected norm for large elements: got:%v want:%v", got, wantNorm)
+	}
+}
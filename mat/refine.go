@@ -0,0 +1,406 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+const (
+	// dlamchS is the smallest normal float64 value, the safe minimum
+	// used to avoid division by zero in the backward error computation.
+	dlamchS = 0x1p-1022
+
+	// maxRefineIter is the maximum number of refinement steps taken
+	// with a double precision factorization, as in LAPACK Dgerfs.
+	maxRefineIter = 5
+	// maxMixedRefineIter is the maximum number of refinement steps
+	// taken with a single precision factorization, as in LAPACK Dsgesv.
+	maxMixedRefineIter = 30
+)
+
+// RefineInfo holds the error estimates of a solution computed by
+// Dense.SolveRefined.
+type RefineInfo struct {
+	// Cond is an estimate of the condition number of A in the 1-norm.
+	Cond float64
+
+	// BackwardErr holds the componentwise relative backward error of
+	// each column x of the solution, the smallest ω such that
+	//
+	//	(A + E) * x = b + f
+	//
+	// with |E| <= ω |A| and |f| <= ω |b| elementwise.
+	BackwardErr []float64
+
+	// ForwardErr holds an estimated bound on the relative forward
+	// error ‖x - x̂‖_∞ / ‖x̂‖_∞ of each column x̂ of the solution.
+	ForwardErr []float64
+
+	// Iterations is the largest number of refinement steps taken for
+	// any column of the solution.
+	Iterations int
+
+	// Mixed is whether the solution was computed using a single
+	// precision factorization of A. It is false if mixed precision was
+	// not requested, or if the refinement did not converge and the
+	// solution was recomputed using a double precision factorization.
+	Mixed bool
+}
+
+// SolveRefined solves the system of linear equations A * X = B for the
+// n×n matrix A using an LU factorization of A followed by iterative
+// refinement of the solution, storing the result into the receiver, and
+// returns error estimates for the solution in the manner of the LAPACK
+// expert drivers Dgesvx and Dsgesv.
+//
+// If mixed is false, A is factorized in double precision and each column of
+// the solution is refined until its componentwise backward error stops
+// decreasing or is at the level of the machine precision. Refinement
+// improves the componentwise accuracy of the solution of badly scaled
+// systems.
+//
+// If mixed is true, A is factorized in single precision, which is about
+// twice as fast for large matrices, and the solution is refined using
+// residuals computed in double precision until it has the accuracy of a
+// double precision solution. If A is too ill-conditioned for the refinement
+// to converge, the solution is recomputed using a double precision
+// factorization and RefineInfo.Mixed is false.
+//
+// SolveRefined panics with ErrSquare if A is not square and with ErrShape
+// if the number of rows of B does not match the order of A. If A is
+// singular or near-singular a Condition error is returned. See the
+// documentation for Condition for more information.
+func (m *Dense) SolveRefined(a, b Matrix, mixed bool) (RefineInfo, error) {
+	n, c := a.Dims()
+	if n != c {
+		panic(ErrSquare)
+	}
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+	if n == 0 || bc == 0 {
+		panic(ErrZeroLength)
+	}
+
+	am := getDenseWorkspace(n, n, false)
+	defer putDenseWorkspace(am)
+	am.Copy(a)
+	bm := getDenseWorkspace(n, bc, false)
+	defer putDenseWorkspace(bm)
+	bm.Copy(b)
+	xm := getDenseWorkspace(n, bc, false)
+	defer putDenseWorkspace(xm)
+
+	var info RefineInfo
+	if mixed {
+		info.Mixed = refineMixed(xm, am, bm, &info)
+	}
+	if !info.Mixed {
+		info.Iterations = 0
+		if !refineFixed(xm, am, bm, &info) {
+			m.reuseAsNonZeroed(n, bc)
+			return info, Condition(math.Inf(1))
+		}
+	}
+	m.reuseAsNonZeroed(n, bc)
+	m.Copy(xm)
+	if info.Cond > ConditionTolerance {
+		return info, Condition(info.Cond)
+	}
+	return info, nil
+}
+
+// refineFixed stores into x the solution of A * X = B computed with a double
+// precision LU factorization of a and refined in double precision, and fills
+// the error estimates in info. It returns false if a is exactly singular.
+func refineFixed(x, a, b *Dense, info *RefineInfo) (ok bool) {
+	n := a.mat.Rows
+	lu := getDenseWorkspace(n, n, false)
+	defer putDenseWorkspace(lu)
+	lu.Copy(a)
+	ipiv := getInts(n, false)
+	defer putInts(ipiv)
+	if !lapack64.Getrf(lu.mat, ipiv) {
+		info.Cond = math.Inf(1)
+		return false
+	}
+	solve := func(v []float64, trans bool) {
+		t := blas.NoTrans
+		if trans {
+			t = blas.Trans
+		}
+		lapack64.Getrs(t, lu.mat, blas64.General{Rows: n, Cols: 1, Stride: 1, Data: v}, ipiv)
+	}
+
+	xj := make([]float64, n)
+	bj := make([]float64, n)
+	r := make([]float64, n)
+	for j := 0; j < b.mat.Cols; j++ {
+		Col(bj, j, b)
+		copy(xj, bj)
+		solve(xj, false)
+		lstres := 3.0
+		for iter := 0; ; iter++ {
+			residual(r, a, xj, bj)
+			berr := backwardErr(a, xj, bj, r)
+			if berr <= dlamchE || 2*berr > lstres || iter >= maxRefineIter {
+				info.Iterations = max(info.Iterations, iter)
+				break
+			}
+			solve(r, false)
+			for i, v := range r {
+				xj[i] += v
+			}
+			lstres = berr
+		}
+		x.SetCol(j, xj)
+	}
+	finishRefine(info, x, a, b, solve)
+	return true
+}
+
+// refineMixed stores into x the solution of A * X = B computed with a single
+// precision LU factorization of a and refined with residuals computed in
+// double precision, and fills the error estimates in info. It returns false
+// if the factorization failed or the refinement did not converge.
+func refineMixed(x, a, b *Dense, info *RefineInfo) (ok bool) {
+	n := a.mat.Rows
+	lu := blas32.General{Rows: n, Cols: n, Stride: n, Data: make([]float32, n*n)}
+	for i := 0; i < n; i++ {
+		for j, v := range a.mat.Data[i*a.mat.Stride : i*a.mat.Stride+n] {
+			if math.Abs(v) > math.MaxFloat32 {
+				return false
+			}
+			lu.Data[i*n+j] = float32(v)
+		}
+	}
+	ipiv := make([]int, n)
+	if !getrf32(lu, ipiv) {
+		return false
+	}
+	work := make([]float32, n)
+	solve := func(v []float64, trans bool) {
+		// Scale v so that small residuals do not underflow when
+		// converted to single precision.
+		scale := normInf(v)
+		if scale == 0 {
+			return
+		}
+		for i, f := range v {
+			work[i] = float32(f / scale)
+		}
+		getrs32(trans, lu, ipiv, work)
+		for i, f := range work {
+			v[i] = float64(f) * scale
+		}
+	}
+
+	// The convergence criterion of Dsgesv.
+	anrm := lapack64.Lange(lapack.MaxRowSum, a.mat, nil)
+	cte := anrm * dlamchE * math.Sqrt(float64(n))
+
+	xj := make([]float64, n)
+	bj := make([]float64, n)
+	r := make([]float64, n)
+	var iterations int
+	for j := 0; j < b.mat.Cols; j++ {
+		Col(bj, j, b)
+		copy(xj, bj)
+		solve(xj, false)
+		converged := false
+		for iter := 0; iter <= maxMixedRefineIter; iter++ {
+			residual(r, a, xj, bj)
+			if normInf(r) <= normInf(xj)*cte {
+				iterations = max(iterations, iter)
+				converged = true
+				break
+			}
+			solve(r, false)
+			for i, v := range r {
+				xj[i] += v
+			}
+		}
+		if !converged {
+			return false
+		}
+		x.SetCol(j, xj)
+	}
+	info.Iterations = iterations
+	finishRefine(info, x, a, b, solve)
+	return true
+}
+
+// finishRefine fills the condition number estimate and the backward and
+// forward error estimates of the solution x of A * X = B in info, using
+// solve to apply A⁻¹ or A⁻ᵀ.
+func finishRefine(info *RefineInfo, x, a, b *Dense, solve func(v []float64, trans bool)) {
+	n := a.mat.Rows
+	info.Cond = math.Inf(1)
+	if anorm := lapack64.Lange(lapack.MaxColumnSum, a.mat, make([]float64, n)); anorm != 0 {
+		info.Cond = anorm * normEst1(n, solve)
+		if math.IsNaN(info.Cond) {
+			info.Cond = math.Inf(1)
+		}
+	}
+
+	k := b.mat.Cols
+	info.BackwardErr = make([]float64, k)
+	info.ForwardErr = make([]float64, k)
+	xj := make([]float64, n)
+	bj := make([]float64, n)
+	r := make([]float64, n)
+	w := make([]float64, n)
+	for j := 0; j < k; j++ {
+		Col(xj, j, x)
+		Col(bj, j, b)
+		residual(r, a, xj, bj)
+		info.BackwardErr[j] = backwardErr(a, xj, bj, r)
+
+		// Bound the error by ‖|A⁻¹| * w‖_∞ where w accounts for the
+		// residual and the rounding errors in computing it, as in
+		// LAPACK Dgerfs. The norm is that of A⁻¹ * diag(w) and is
+		// estimated as the 1-norm of its transpose.
+		absResidual(w, a, xj, bj)
+		nz := float64(n + 1)
+		for i, v := range r {
+			w[i] = math.Abs(v) + nz*dlamchE*w[i]
+			if w[i] <= nz*dlamchS/dlamchE {
+				w[i] += nz * dlamchS
+			}
+		}
+		ferr := normEst1(n, func(v []float64, trans bool) {
+			if trans {
+				for i := range v {
+					v[i] *= w[i]
+				}
+				solve(v, false)
+				return
+			}
+			solve(v, true)
+			for i := range v {
+				v[i] *= w[i]
+			}
+		})
+		if xnorm := normInf(xj); xnorm != 0 {
+			ferr /= xnorm
+		}
+		info.ForwardErr[j] = ferr
+	}
+}
+
+// residual computes r = b - A*x.
+func residual(r []float64, a *Dense, x, b []float64) {
+	copy(r, b)
+	n := len(x)
+	blas64.Gemv(blas.NoTrans, -1, a.mat, blas64.Vector{N: n, Inc: 1, Data: x}, 1, blas64.Vector{N: n, Inc: 1, Data: r})
+}
+
+// absResidual computes s = |A|*|x| + |b|, the scale of the rounding errors
+// in the computation of the residual b - A*x.
+func absResidual(s []float64, a *Dense, x, b []float64) {
+	for i := range s {
+		v := math.Abs(b[i])
+		for j, aij := range a.mat.Data[i*a.mat.Stride : i*a.mat.Stride+len(x)] {
+			v += math.Abs(aij) * math.Abs(x[j])
+		}
+		s[i] = v
+	}
+}
+
+// backwardErr returns the componentwise relative backward error
+//
+//	max_i |r_i| / (|A|*|x| + |b|)_i
+//
+// of the solution x of A*x = b with residual r = b - A*x, guarding against
+// division by zero in the manner of LAPACK Dgerfs.
+func backwardErr(a *Dense, x, b, r []float64) float64 {
+	n := len(x)
+	s := make([]float64, n)
+	absResidual(s, a, x, b)
+	safe1 := float64(n+1) * dlamchS
+	safe2 := safe1 / dlamchE
+	var berr float64
+	for i, v := range r {
+		if s[i] > safe2 {
+			berr = math.Max(berr, math.Abs(v)/s[i])
+		} else {
+			berr = math.Max(berr, (math.Abs(v)+safe1)/(s[i]+safe1))
+		}
+	}
+	return berr
+}
+
+// normInf returns the maximum absolute value of the elements of x.
+func normInf(x []float64) float64 {
+	var norm float64
+	for _, v := range x {
+		norm = math.Max(norm, math.Abs(v))
+	}
+	return norm
+}
+
+// getrf32 computes the LU factorization with partial pivoting of the n×n
+// single precision matrix a in place, storing the pivot indices in ipiv as
+// in LAPACK Sgetf2. It returns false if a is exactly singular.
+func getrf32(a blas32.General, ipiv []int) (ok bool) {
+	n := a.Rows
+	ok = true
+	for j := 0; j < n; j++ {
+		col := blas32.Vector{N: n - j, Inc: a.Stride, Data: a.Data[j*a.Stride+j:]}
+		p := j + blas32.Iamax(col)
+		ipiv[j] = p
+		if a.Data[p*a.Stride+j] == 0 {
+			ok = false
+			continue
+		}
+		if p != j {
+			blas32.Swap(
+				blas32.Vector{N: n, Inc: 1, Data: a.Data[j*a.Stride:]},
+				blas32.Vector{N: n, Inc: 1, Data: a.Data[p*a.Stride:]},
+			)
+		}
+		if j < n-1 {
+			blas32.Scal(1/a.Data[j*a.Stride+j], blas32.Vector{N: n - j - 1, Inc: a.Stride, Data: a.Data[(j+1)*a.Stride+j:]})
+			blas32.Ger(-1,
+				blas32.Vector{N: n - j - 1, Inc: a.Stride, Data: a.Data[(j+1)*a.Stride+j:]},
+				blas32.Vector{N: n - j - 1, Inc: 1, Data: a.Data[j*a.Stride+j+1:]},
+				blas32.General{Rows: n - j - 1, Cols: n - j - 1, Stride: a.Stride, Data: a.Data[(j+1)*a.Stride+j+1:]},
+			)
+		}
+	}
+	return ok
+}
+
+// getrs32 overwrites x with A⁻¹*x, or with A⁻ᵀ*x if trans is true, using the
+// LU factorization of the single precision matrix A computed by getrf32.
+func getrs32(trans bool, lu blas32.General, ipiv []int, x []float32) {
+	n := lu.Rows
+	v := blas32.Vector{N: n, Inc: 1, Data: x}
+	l := blas32.Triangular{N: n, Stride: lu.Stride, Data: lu.Data, Uplo: blas.Lower, Diag: blas.Unit}
+	u := blas32.Triangular{N: n, Stride: lu.Stride, Data: lu.Data, Uplo: blas.Upper, Diag: blas.NonUnit}
+	if !trans {
+		// A = P * L * U, so x = U⁻¹ * L⁻¹ * Pᵀ * b.
+		for i, p := range ipiv {
+			x[i], x[p] = x[p], x[i]
+		}
+		blas32.Trsv(blas.NoTrans, l, v)
+		blas32.Trsv(blas.NoTrans, u, v)
+		return
+	}
+	blas32.Trsv(blas.Trans, u, v)
+	blas32.Trsv(blas.Trans, l, v)
+	for i := n - 1; i >= 0; i-- {
+		p := ipiv[i]
+		x[i], x[p] = x[p], x[i]
+	}
+}
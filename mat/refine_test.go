@@ -0,0 +1,192 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestSolveRefined(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, mixed := range []bool{false, true} {
+		for _, n := range []int{1, 2, 3, 5, 10, 30} {
+			for _, k := range []int{1, 3} {
+				a := NewDense(n, n, nil)
+				for i := 0; i < n; i++ {
+					for j := 0; j < n; j++ {
+						a.Set(i, j, rnd.NormFloat64())
+					}
+				}
+				want := NewDense(n, k, nil)
+				for i := 0; i < n; i++ {
+					for j := 0; j < k; j++ {
+						want.Set(i, j, rnd.NormFloat64())
+					}
+				}
+				var b Dense
+				b.Mul(a, want)
+
+				var x Dense
+				info, err := x.SolveRefined(a, &b, mixed)
+				if err != nil {
+					t.Errorf("unexpected error for n=%d k=%d mixed=%t: %v", n, k, mixed, err)
+					continue
+				}
+				if info.Mixed != mixed {
+					t.Errorf("unexpected precision for n=%d k=%d mixed=%t: got Mixed=%t", n, k, mixed, info.Mixed)
+				}
+				if len(info.BackwardErr) != k || len(info.ForwardErr) != k {
+					t.Errorf("unexpected number of error estimates for n=%d k=%d mixed=%t", n, k, mixed)
+					continue
+				}
+
+				var lu LU
+				lu.Factorize(a)
+				cond := ConditionEst(a, &lu)
+				// The estimate from a single precision factorization
+				// agrees with ConditionEst only to single precision.
+				tol := 1e-8
+				if mixed {
+					tol = 1e-3
+				}
+				if math.Abs(info.Cond-cond) > tol*cond {
+					t.Errorf("unexpected condition estimate for n=%d k=%d mixed=%t: got %v, want %v", n, k, mixed, info.Cond, cond)
+				}
+
+				for j := 0; j < k; j++ {
+					if info.BackwardErr[j] > 4*dlamchE {
+						t.Errorf("backward error too large for n=%d k=%d mixed=%t col=%d: %v", n, k, mixed, j, info.BackwardErr[j])
+					}
+					var diff, xnorm float64
+					for i := 0; i < n; i++ {
+						diff = math.Max(diff, math.Abs(x.At(i, j)-want.At(i, j)))
+						xnorm = math.Max(xnorm, math.Abs(x.At(i, j)))
+					}
+					if diff/xnorm > info.ForwardErr[j] {
+						t.Errorf("forward error bound violated for n=%d k=%d mixed=%t col=%d: error %v, bound %v",
+							n, k, mixed, j, diff/xnorm, info.ForwardErr[j])
+					}
+				}
+
+				var xs Dense
+				err = xs.Solve(a, &b)
+				if err != nil {
+					t.Fatalf("unexpected error from Solve: %v", err)
+				}
+				if !EqualApprox(&x, &xs, 1e-10*cond) {
+					t.Errorf("solution mismatch with Solve for n=%d k=%d mixed=%t", n, k, mixed)
+				}
+			}
+		}
+	}
+}
+
+func TestSolveRefinedBadlyScaled(t *testing.T) {
+	t.Parallel()
+	// The rows of a have widely different scales so the componentwise
+	// backward error of the unrefined solution is large.
+	const n = 8
+	rnd := rand.New(rand.NewSource(1))
+	a := NewDense(n, n, nil)
+	want := NewVecDense(n, nil)
+	for i := 0; i < n; i++ {
+		s := math.Pow(10, float64(3*i-12))
+		for j := 0; j < n; j++ {
+			a.Set(i, j, s*rnd.NormFloat64())
+		}
+		want.SetVec(i, rnd.NormFloat64())
+	}
+	var b VecDense
+	b.MulVec(a, want)
+
+	var x Dense
+	info, err := x.SolveRefined(a, &b, false)
+	if err != nil {
+		t.Logf("condition warning: %v", err)
+	}
+	if info.BackwardErr[0] > 4*dlamchE {
+		t.Errorf("backward error too large after refinement: %v", info.BackwardErr[0])
+	}
+}
+
+func TestSolveRefinedMixedFallback(t *testing.T) {
+	t.Parallel()
+	// The Hilbert matrix of order 10 has a condition number of about 1e13,
+	// too large for refinement with a single precision factorization.
+	const n = 10
+	a := NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a.Set(i, j, 1/float64(i+j+1))
+		}
+	}
+	b := NewDense(n, 1, nil)
+	for i := 0; i < n; i++ {
+		b.Set(i, 0, 1)
+	}
+	var x Dense
+	info, err := x.SolveRefined(a, b, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.Mixed {
+		t.Errorf("expected fallback to double precision factorization")
+	}
+	if info.Cond < 1e12 {
+		t.Errorf("unexpected condition estimate: got %v, want > 1e12", info.Cond)
+	}
+	if info.BackwardErr[0] > 4*dlamchE {
+		t.Errorf("backward error too large: %v", info.BackwardErr[0])
+	}
+}
+
+func TestSolveRefinedSingular(t *testing.T) {
+	t.Parallel()
+	a := NewDense(3, 3, []float64{
+		1, 2, 3,
+		4, 5, 6,
+		0, 0, 0,
+	})
+	b := NewDense(3, 1, []float64{1, 2, 3})
+	for _, mixed := range []bool{false, true} {
+		var x Dense
+		_, err := x.SolveRefined(a, b, mixed)
+		if _, ok := err.(Condition); !ok {
+			t.Errorf("expected Condition error for singular matrix with mixed=%t, got %v", mixed, err)
+		}
+	}
+}
+
+func TestSolveRefinedPanics(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		a, b Matrix
+		want string
+	}{
+		{
+			name: "non-square",
+			a:    NewDense(2, 3, nil),
+			b:    NewDense(2, 1, nil),
+			want: ErrSquare.Error(),
+		},
+		{
+			name: "shape mismatch",
+			a:    eye(3),
+			b:    NewDense(2, 1, nil),
+			want: ErrShape.Error(),
+		},
+	} {
+		var x Dense
+		panicked, msg := panics(func() { x.SolveRefined(test.a, test.b, false) })
+		if !panicked || msg != test.want {
+			t.Errorf("unexpected panic for %s: got %q, want %q", test.name, msg, test.want)
+		}
+	}
+}
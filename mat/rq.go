@@ -0,0 +1,173 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+const badRQ = "mat: invalid RQ factorization"
+
+// RQ is a type for creating and using the RQ factorization of a matrix.
+//
+// The factorization is computed from the LQ factorization of the matrix
+// with its rows and columns in reverse order. If J is the exchange matrix
+// and J⋅A⋅J = L⋅Q̃, then A = (J⋅L⋅J)⋅(J⋅Q̃⋅J) where J⋅L⋅J is upper trapezoidal
+// and J⋅Q̃⋅J is orthonormal.
+type RQ struct {
+	lq LQ
+}
+
+// Dims returns the dimensions of the matrix.
+func (rq *RQ) Dims() (r, c int) {
+	return rq.lq.Dims()
+}
+
+// At returns the element at row i, column j.
+func (rq *RQ) At(i, j int) float64 {
+	m, n := rq.Dims()
+	if uint(i) >= uint(m) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(n) {
+		panic(ErrColAccess)
+	}
+	return rq.lq.At(m-1-i, n-1-j)
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (rq *RQ) T() Matrix {
+	return Transpose{rq}
+}
+
+// Factorize computes the RQ factorization of an m×n matrix a where m <= n. The RQ
+// factorization always exists even if A is singular.
+//
+// The RQ decomposition is a factorization of the matrix A such that A = R * Q.
+// The matrix Q is an orthonormal n×n matrix, and R is an m×n upper trapezoidal
+// matrix whose non-zero elements are in its last m columns.
+// R and Q can be extracted using the RTo and QTo methods.
+func (rq *RQ) Factorize(a Matrix) {
+	m, n := a.Dims()
+	if m > n {
+		panic(ErrShape)
+	}
+	w := getDenseWorkspace(m, n, false)
+	w.Copy(a)
+	reverseDense(w)
+	rq.lq.Factorize(w)
+	putDenseWorkspace(w)
+}
+
+// isValid returns whether the receiver contains a factorization.
+func (rq *RQ) isValid() bool {
+	return rq.lq.isValid()
+}
+
+// Cond returns the condition number for the factorized matrix.
+// Cond will panic if the receiver does not contain a factorization.
+func (rq *RQ) Cond() float64 {
+	if !rq.isValid() {
+		panic(badRQ)
+	}
+	return rq.lq.cond
+}
+
+// RTo extracts the m×n upper trapezoidal matrix from an RQ decomposition.
+//
+// If dst is empty, RTo will resize dst to be m×n. When dst is
+// non-empty, RTo will panic if dst is not m×n. RTo will also panic
+// if the receiver does not contain a successful factorization.
+func (rq *RQ) RTo(dst *Dense) {
+	if !rq.isValid() {
+		panic(badRQ)
+	}
+	rq.lq.LTo(dst)
+	reverseDense(dst)
+}
+
+// QTo extracts the n×n orthonormal matrix Q from an RQ decomposition.
+//
+// If dst is empty, QTo will resize dst to be n×n. When dst is
+// non-empty, QTo will panic if dst is not n×n. QTo will also panic
+// if the receiver does not contain a successful factorization.
+func (rq *RQ) QTo(dst *Dense) {
+	if !rq.isValid() {
+		panic(badRQ)
+	}
+	rq.lq.QTo(dst)
+	reverseDense(dst)
+}
+
+// SolveTo finds a minimum-norm solution to a system of linear equations defined
+// by the matrices A and b, where A is an m×n matrix represented in its RQ factorized
+// form. If A is singular or near-singular a Condition error is returned.
+// See the documentation for Condition for more information.
+//
+// The minimization problem solved depends on the input parameters.
+//
+//	If trans == false, find the minimum norm solution of A * X = B.
+//	If trans == true, find X such that ||A*X - B||_2 is minimized.
+//
+// The solution matrix, X, is stored in place into dst.
+// SolveTo will panic if the receiver does not contain a factorization.
+func (rq *RQ) SolveTo(dst *Dense, trans bool, b Matrix) error {
+	if !rq.isValid() {
+		panic(badRQ)
+	}
+	// With J⋅A⋅J = L⋅Q̃, the system A⋅X = B is equivalent to
+	// (L⋅Q̃)⋅(J⋅X) = J⋅B, and similarly for the transpose.
+	br, bc := b.Dims()
+	w := getDenseWorkspace(br, bc, false)
+	w.Copy(b)
+	reverseRows(w)
+	err := rq.lq.SolveTo(dst, trans, w)
+	putDenseWorkspace(w)
+	reverseRows(dst)
+	return err
+}
+
+// SolveVecTo finds a minimum-norm solution to a system of linear equations.
+// See RQ.SolveTo for the full documentation.
+// SolveVecTo will panic if the receiver does not contain a factorization.
+func (rq *RQ) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
+	if !rq.isValid() {
+		panic(badRQ)
+	}
+	r, c := rq.Dims()
+	if _, bc := b.Dims(); bc != 1 {
+		panic(ErrShape)
+	}
+	if trans {
+		dst.reuseAsNonZeroed(r)
+	} else {
+		dst.reuseAsNonZeroed(c)
+	}
+	return rq.SolveTo(dst.asDense(), trans, b)
+}
+
+// reverseDense reverses the order of the rows and the columns of m in place.
+func reverseDense(m *Dense) {
+	reverseRows(m)
+	r, c := m.Dims()
+	for i := 0; i < r; i++ {
+		row := m.mat.Data[i*m.mat.Stride : i*m.mat.Stride+c]
+		for j, k := 0, c-1; j < k; j, k = j+1, k-1 {
+			row[j], row[k] = row[k], row[j]
+		}
+	}
+}
+
+// reverseRows reverses the order of the rows of m in place.
+func reverseRows(m *Dense) {
+	r, c := m.Dims()
+	for i, k := 0, r-1; i < k; i, k = i+1, k-1 {
+		blas64.Swap(
+			blas64.Vector{N: c, Inc: 1, Data: m.mat.Data[i*m.mat.Stride:]},
+			blas64.Vector{N: c, Inc: 1, Data: m.mat.Data[k*m.mat.Stride:]},
+		)
+	}
+}
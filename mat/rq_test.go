@@ -0,0 +1,141 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestRQ(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	rnd := rand.New(rand.NewSource(1))
+	for cas, test := range []struct {
+		m, n int
+	}{
+		{1, 1},
+		{5, 5},
+		{5, 10},
+		{1, 4},
+	} {
+		m := test.m
+		n := test.n
+		a := NewDense(m, n, nil)
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				a.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		var want Dense
+		want.CloneFrom(a)
+
+		var rq RQ
+		rq.Factorize(a)
+
+		if !Equal(a, &want) {
+			t.Errorf("case %d: input matrix modified", cas)
+		}
+		if !EqualApprox(a, &rq, tol) {
+			t.Errorf("case %d: A and RQ are not equal", cas)
+		}
+
+		var r, q Dense
+		rq.QTo(&q)
+		if !isOrthonormal(&q, tol) {
+			t.Errorf("case %d: Q is not orthonormal", cas)
+		}
+
+		rq.RTo(&r)
+		for i := 0; i < m; i++ {
+			for j := 0; j < n-m+i; j++ {
+				if r.At(i, j) != 0 {
+					t.Errorf("case %d: R is not upper trapezoidal: R[%d,%d]=%v", cas, i, j, r.At(i, j))
+				}
+			}
+		}
+
+		var got Dense
+		got.Mul(&r, &q)
+		if !EqualApprox(&got, &want, tol) {
+			t.Errorf("case %d: RQ does not equal original matrix.\nWant: %v\nGot: %v", cas, want, got)
+		}
+	}
+
+	if ok, _ := panics(func() { (&RQ{}).Factorize(NewDense(3, 2, nil)) }); !ok {
+		t.Errorf("expected panic for tall matrix")
+	}
+}
+
+func TestRQSolveTo(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, trans := range []bool{false, true} {
+		for _, test := range []struct {
+			m, n, bc int
+		}{
+			{5, 5, 1},
+			{5, 10, 1},
+			{5, 5, 3},
+			{5, 10, 3},
+		} {
+			m := test.m
+			n := test.n
+			bc := test.bc
+			a := NewDense(m, n, nil)
+			for i := 0; i < m; i++ {
+				for j := 0; j < n; j++ {
+					a.Set(i, j, rnd.Float64())
+				}
+			}
+			br := m
+			if trans {
+				br = n
+			}
+			b := NewDense(br, bc, nil)
+			for i := 0; i < br; i++ {
+				for j := 0; j < bc; j++ {
+					b.Set(i, j, rnd.Float64())
+				}
+			}
+
+			var rq RQ
+			rq.Factorize(a)
+			var x Dense
+			err := rq.SolveTo(&x, trans, b)
+			if err != nil {
+				t.Errorf("unexpected error from RQ solve: %v", err)
+			}
+
+			// The solution must agree with the LQ solution.
+			var lq LQ
+			lq.Factorize(a)
+			var want Dense
+			lq.SolveTo(&want, trans, b)
+			if !EqualApprox(&x, &want, 1e-10) {
+				t.Errorf("m=%d n=%d bc=%d trans=%t: RQ solution does not match LQ solution", m, n, bc, trans)
+			}
+
+			if bc == 1 {
+				var xvec VecDense
+				err := rq.SolveVecTo(&xvec, trans, b.ColView(0))
+				if err != nil {
+					t.Errorf("unexpected error from RQ vector solve: %v", err)
+				}
+				if !EqualApprox(&xvec, &want, 1e-10) {
+					t.Errorf("m=%d n=%d trans=%t: RQ vector solution does not match LQ solution", m, n, trans)
+				}
+			}
+		}
+	}
+
+	var rq RQ
+	rq.Factorize(NewDense(2, 3, []float64{1, 0, 0, 0, 1e-20, 0}))
+	var x Dense
+	if err := rq.SolveTo(&x, false, NewDense(2, 1, nil)); err == nil {
+		t.Error("no error for near-singular matrix in matrix solve")
+	}
+}
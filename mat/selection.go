@@ -0,0 +1,148 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+var (
+	selection *Selection
+
+	_ Matrix = selection
+)
+
+// Selection is a view of the elements of a matrix in a selection of its rows
+// and columns. The selected indices need not be contiguous, ordered or
+// distinct, so a Selection can represent a permutation or a resampling of
+// the rows or columns of a matrix. Changes to the elements of the underlying
+// matrix are reflected in the Selection.
+type Selection struct {
+	mat        Matrix
+	rows, cols []int
+}
+
+// NewSelection returns a view of the elements of a in the rows and columns
+// given by the indices in rows and cols, so that element (i, j) of the view
+// is element (rows[i], cols[j]) of a. If rows is nil, all rows of a are
+// selected in order, and similarly for cols. The index slices are copied.
+//
+// NewSelection will panic if rows or cols is not nil and has zero length, or
+// if any index is out of range for a.
+func NewSelection(a Matrix, rows, cols []int) *Selection {
+	r, c := a.Dims()
+	rows = selectIndices(rows, r, ErrRowAccess)
+	cols = selectIndices(cols, c, ErrColAccess)
+	if s, ok := a.(*Selection); ok {
+		// Select from the underlying matrix directly
+		// so that At does not chain through views.
+		for i, v := range rows {
+			rows[i] = s.rows[v]
+		}
+		for j, v := range cols {
+			cols[j] = s.cols[v]
+		}
+		a = s.mat
+	}
+	return &Selection{mat: a, rows: rows, cols: cols}
+}
+
+// selectIndices returns a copy of idx after checking that its elements are
+// in [0, n), or the indices 0 to n-1 if idx is nil.
+func selectIndices(idx []int, n int, access Error) []int {
+	if idx == nil {
+		idx = make([]int, n)
+		for i := range idx {
+			idx[i] = i
+		}
+		return idx
+	}
+	if len(idx) == 0 {
+		panic(ErrZeroLength)
+	}
+	for _, v := range idx {
+		if v < 0 || n <= v {
+			panic(access)
+		}
+	}
+	return append([]int(nil), idx...)
+}
+
+// Dims returns the number of selected rows and columns.
+func (s *Selection) Dims() (r, c int) {
+	return len(s.rows), len(s.cols)
+}
+
+// At returns the element at row i and column j of the selection.
+func (s *Selection) At(i, j int) float64 {
+	if uint(i) >= uint(len(s.rows)) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(len(s.cols)) {
+		panic(ErrColAccess)
+	}
+	return s.mat.At(s.rows[i], s.cols[j])
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (s *Selection) T() Matrix {
+	return Transpose{s}
+}
+
+// Indices returns copies of the row and column indices of the underlying
+// matrix that are selected by the receiver.
+func (s *Selection) Indices() (rows, cols []int) {
+	return append([]int(nil), s.rows...), append([]int(nil), s.cols...)
+}
+
+// Select copies the elements of a in the rows and columns given by the
+// indices in rows and cols into the receiver, so that element (i, j) of the
+// receiver is element (rows[i], cols[j]) of a. If rows is nil, all rows of a
+// are selected in order, and similarly for cols.
+//
+// Select will panic if rows or cols is not nil and has zero length, if any
+// index is out of range for a, or if the receiver is not empty and does not
+// have len(rows) rows and len(cols) columns.
+func (m *Dense) Select(a Matrix, rows, cols []int) {
+	s := NewSelection(a, rows, cols)
+	r, c := s.Dims()
+
+	m.reuseAsNonZeroed(r, c)
+
+	aU, aTrans := untransposeExtract(s.mat)
+	if rm, ok := aU.(*Dense); ok {
+		amat := rm.mat
+		if m == aU || m.checkOverlap(amat) {
+			var restore func()
+			m, restore = m.isolatedWorkspace(s)
+			defer restore()
+		}
+		if !aTrans {
+			for i, ri := range s.rows {
+				src := amat.Data[ri*amat.Stride:]
+				dst := m.mat.Data[i*m.mat.Stride : i*m.mat.Stride+c]
+				for j, cj := range s.cols {
+					dst[j] = src[cj]
+				}
+			}
+		} else {
+			for i, ri := range s.rows {
+				dst := m.mat.Data[i*m.mat.Stride : i*m.mat.Stride+c]
+				for j, cj := range s.cols {
+					dst[j] = amat.Data[cj*amat.Stride+ri]
+				}
+			}
+		}
+		return
+	}
+
+	if m.checkOverlapMatrix(aU) {
+		var restore func()
+		m, restore = m.isolatedWorkspace(s)
+		defer restore()
+	}
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			m.set(i, j, s.At(i, j))
+		}
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestSelection(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	a := NewDense(5, 6, nil)
+	for i := 0; i < 5; i++ {
+		for j := 0; j < 6; j++ {
+			a.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	sym := NewSymDense(5, nil)
+	for i := 0; i < 5; i++ {
+		for j := i; j < 5; j++ {
+			sym.SetSym(i, j, rnd.NormFloat64())
+		}
+	}
+	for _, test := range []struct {
+		a          Matrix
+		rows, cols []int
+	}{
+		{a: a, rows: []int{0, 2, 4}, cols: []int{1, 5}},
+		{a: a, rows: nil, cols: []int{3}},
+		{a: a, rows: []int{4, 4, 0, 1}, cols: nil},
+		{a: a, rows: []int{3, 1}, cols: []int{5, 0, 5, 2}},
+		{a: a.T(), rows: []int{5, 0}, cols: []int{1, 1, 4}},
+		{a: a.Slice(1, 4, 2, 6), rows: []int{2, 0}, cols: []int{3, 1, 0}},
+		{a: sym, rows: []int{1, 3}, cols: []int{4, 0, 2}},
+		{a: NewSelection(a, []int{4, 2, 0}, []int{5, 3, 1, 0}), rows: []int{2, 0}, cols: []int{1, 3}},
+	} {
+		r, c := test.a.Dims()
+		rows, cols := test.rows, test.cols
+		if rows == nil {
+			rows = make([]int, r)
+			for i := range rows {
+				rows[i] = i
+			}
+		}
+		if cols == nil {
+			cols = make([]int, c)
+			for j := range cols {
+				cols[j] = j
+			}
+		}
+		want := NewDense(len(rows), len(cols), nil)
+		for i, ri := range rows {
+			for j, cj := range cols {
+				want.Set(i, j, test.a.At(ri, cj))
+			}
+		}
+
+		s := NewSelection(test.a, test.rows, test.cols)
+		if !Equal(s, want) {
+			t.Errorf("rows=%v cols=%v: unexpected selection view:\ngot:\n%v\nwant:\n%v",
+				test.rows, test.cols, Formatted(s), Formatted(want))
+		}
+		if !Equal(s.T(), want.T()) {
+			t.Errorf("rows=%v cols=%v: unexpected transposed selection view", test.rows, test.cols)
+		}
+
+		var got Dense
+		got.Select(test.a, test.rows, test.cols)
+		if !Equal(&got, want) {
+			t.Errorf("rows=%v cols=%v: unexpected selection copy:\ngot:\n%v\nwant:\n%v",
+				test.rows, test.cols, Formatted(&got), Formatted(want))
+		}
+	}
+
+	// Indices are copied on construction.
+	rows := []int{0, 1}
+	s := NewSelection(a, rows, nil)
+	rows[0] = 4
+	if s.At(0, 0) != a.At(0, 0) {
+		t.Errorf("selection retained index slice")
+	}
+	// Changes to the underlying matrix are visible in the view.
+	a.Set(1, 2, 100)
+	if s.At(1, 2) != 100 {
+		t.Errorf("selection did not reflect modification of underlying matrix")
+	}
+	// Nested selections refer to the underlying matrix.
+	nested := NewSelection(NewSelection(a, []int{3, 1}, []int{2, 4}), []int{1}, []int{0})
+	if gotRows, gotCols := nested.Indices(); !equalInts(gotRows, []int{1}) || !equalInts(gotCols, []int{2}) {
+		t.Errorf("unexpected nested indices: rows=%v cols=%v", gotRows, gotCols)
+	}
+}
+
+func TestDenseSelectInPlace(t *testing.T) {
+	t.Parallel()
+	a := NewDense(3, 3, []float64{
+		1, 2, 3,
+		4, 5, 6,
+		7, 8, 9,
+	})
+	// Permute the rows and columns of a in place.
+	a.Select(a, []int{2, 0, 1}, []int{1, 2, 0})
+	want := NewDense(3, 3, []float64{
+		8, 9, 7,
+		2, 3, 1,
+		5, 6, 4,
+	})
+	if !Equal(a, want) {
+		t.Errorf("unexpected in-place selection:\ngot:\n%v\nwant:\n%v", Formatted(a), Formatted(want))
+	}
+}
+
+func TestSelectionPanics(t *testing.T) {
+	t.Parallel()
+	a := NewDense(3, 4, nil)
+	for _, test := range []struct {
+		name       string
+		rows, cols []int
+		want       error
+	}{
+		{name: "empty rows", rows: []int{}, want: ErrZeroLength},
+		{name: "empty cols", cols: []int{}, want: ErrZeroLength},
+		{name: "negative row", rows: []int{-1}, want: ErrRowAccess},
+		{name: "large row", rows: []int{3}, want: ErrRowAccess},
+		{name: "large col", cols: []int{0, 4}, want: ErrColAccess},
+	} {
+		panicked, message := panics(func() { NewSelection(a, test.rows, test.cols) })
+		if !panicked || message != test.want.Error() {
+			t.Errorf("%s: unexpected panic: got %q, want %q", test.name, message, test.want)
+		}
+	}
+	s := NewSelection(a, []int{0, 2}, nil)
+	if panicked, message := panics(func() { s.At(2, 0) }); !panicked || message != ErrRowAccess.Error() {
+		t.Errorf("unexpected panic for row access: %q", message)
+	}
+	if panicked, message := panics(func() { s.At(0, 4) }); !panicked || message != ErrColAccess.Error() {
+		t.Errorf("unexpected panic for column access: %q", message)
+	}
+	if panicked, message := panics(func() { a.Select(a, []int{0}, nil) }); !panicked || message != ErrShape.Error() {
+		t.Errorf("unexpected panic for mismatched receiver: %q", message)
+	}
+}
@@ -25,7 +25,8 @@ package mat
 // x will be stored in-place into the n×k receiver.
 //
 // If the underlying matrix of a is a SolveToer, its SolveTo method is used,
-// otherwise a Dense copy of a will be used for the solution.
+// otherwise a Dense copy of a will be used for the solution. If a is a square
+// *Dense, the solution may be computed using the Backend set by UseBackend.
 //
 // If A does not have full rank, a Condition error is returned. See the
 // documentation for Condition for more information.
@@ -57,6 +58,13 @@ func (m *Dense) Solve(a, b Matrix) error {
 			}
 			return nil
 		}
+		if _, ok := aU.(*Dense); ok {
+			if be := useBackend(ar, bc); be != nil {
+				if ok, err := m.solveBackend(be, a, b); ok {
+					return err
+				}
+			}
+		}
 		var lu LU
 		lu.Factorize(a)
 		return lu.SolveTo(m, false, b)
@@ -122,3 +130,85 @@ func (v *VecDense) SolveVec(a Matrix, b Vector) error {
 	m := v.asDense()
 	return m.Solve(a, b)
 }
+
+// dlamchE is the machine epsilon. For IEEE this is 2^{-53}.
+const dlamchE = 0x1p-53
+
+// defaultRankTol returns the default relative tolerance max(m,n)·ε used to
+// determine the numerical rank of an m×n matrix, where ε = 2⁻⁵² is the
+// spacing of float64 values at one.
+func defaultRankTol(m, n int) float64 {
+	return float64(max(m, n)) * 0x1p-52
+}
+
+// SolveMinNorm finds the minimum-norm solution of the linear least squares
+// problem
+//
+//	minimize over x |b - A*x|_2
+//
+// where A is an m×n matrix of any shape and rank. Of all x that minimize the
+// residual, SolveMinNorm finds the one that minimizes |x|_2. For full rank A
+// this is the solution found by Solve, but unlike Solve, SolveMinNorm does not
+// require A to have full rank, so it also gives the minimum-norm solution of
+// underdetermined systems whose rows are linearly dependent and of
+// overdetermined systems whose columns are linearly dependent.
+//
+// The solution is computed using the singular value decomposition of A.
+// Singular values of A less than or equal to rcond times the largest singular
+// value are treated as zero when determining the rank of A. If rcond is zero,
+// the default cutoff max(m,n)·ε is used, where ε = 2⁻⁵² is the spacing of
+// float64 values at one. SolveMinNorm will panic if rcond is negative.
+//
+// Several right-hand side vectors b and solution vectors x can be handled in a
+// single call. Vectors b are stored in the columns of the m×k matrix B. Vectors
+// x will be stored in-place into the n×k receiver.
+//
+// If the singular value decomposition of A can not be computed, SolveMinNorm
+// returns ErrFailedSVD.
+func (m *Dense) SolveMinNorm(a, b Matrix, rcond float64) error {
+	if rcond < 0 {
+		panic(badRcond)
+	}
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ar != br {
+		panic(ErrShape)
+	}
+	m.reuseAsNonZeroed(ac, bc)
+
+	var svd SVD
+	if !svd.Factorize(a, SVDThin) {
+		return ErrFailedSVD
+	}
+	if rcond == 0 {
+		rcond = defaultRankTol(ar, ac)
+	}
+	rank := 0
+	if svd.s[0] > 0 {
+		rank = svd.Rank(rcond)
+	}
+	if rank == 0 {
+		// A is numerically zero, so every x minimizes the
+		// residual and the minimum-norm solution is zero.
+		m.Zero()
+		return nil
+	}
+	svd.SolveTo(m, b, rank)
+	return nil
+}
+
+// SolveMinNormVec finds the minimum-norm solution of the linear least squares
+// problem
+//
+//	minimize over x |b - A*x|_2
+//
+// where A is an m×n matrix of any shape and rank, and stores it in-place into
+// the receiver. See Dense.SolveMinNorm for the full documentation.
+func (v *VecDense) SolveMinNormVec(a Matrix, b Vector, rcond float64) error {
+	if _, bc := b.Dims(); bc != 1 {
+		panic(ErrShape)
+	}
+	_, c := a.Dims()
+	v.reuseAsNonZeroed(c)
+	return v.asDense().SolveMinNorm(a, b, rcond)
+}
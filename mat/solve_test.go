@@ -305,3 +305,143 @@ func TestSolveVec(t *testing.T) {
 	}
 	testTwoInput(t, "SolveVec", &VecDense{}, method, denseComparison, legalTypesMatrixVector, legalSizeSolve, 1e-12)
 }
+
+func TestSolveMinNorm(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	randDense := func(r, c int) *Dense {
+		d := NewDense(r, c, nil)
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				d.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		return d
+	}
+	for _, test := range []struct {
+		m, n, rank, bc int
+	}{
+		// Full rank.
+		{5, 5, 5, 1},
+		{8, 5, 5, 2},
+		{5, 8, 5, 2},
+		{1, 4, 1, 1},
+		{4, 1, 1, 1},
+		// Rank deficient.
+		{5, 5, 3, 1},
+		{8, 5, 2, 2},
+		{5, 8, 4, 3},
+		{6, 6, 1, 1},
+	} {
+		// Construct A = B⋅C with B m×rank and C rank×n, so
+		// that A⁺ = C⁺⋅B⁺ with full rank factors.
+		bf := randDense(test.m, test.rank)
+		cf := randDense(test.rank, test.n)
+		var a Dense
+		a.Mul(bf, cf)
+		b := randDense(test.m, test.bc)
+
+		var y, want Dense
+		if err := y.Solve(bf, b); err != nil {
+			t.Fatalf("bad test: %v", err)
+		}
+		if err := want.Solve(cf, &y); err != nil {
+			t.Fatalf("bad test: %v", err)
+		}
+
+		var x Dense
+		err := x.SolveMinNorm(&a, b, 0)
+		if err != nil {
+			t.Errorf("m=%d n=%d rank=%d: unexpected error: %v", test.m, test.n, test.rank, err)
+		}
+		if !EqualApprox(&x, &want, 1e-10) {
+			t.Errorf("m=%d n=%d rank=%d: unexpected solution:\ngot: %v\nwant:%v",
+				test.m, test.n, test.rank, Formatted(&x), Formatted(&want))
+		}
+
+		if test.bc == 1 {
+			var xvec VecDense
+			err := xvec.SolveMinNormVec(&a, b.ColView(0), 0)
+			if err != nil {
+				t.Errorf("m=%d n=%d rank=%d: unexpected error for vector: %v", test.m, test.n, test.rank, err)
+			}
+			if !EqualApprox(&xvec, &want, 1e-10) {
+				t.Errorf("m=%d n=%d rank=%d: unexpected vector solution", test.m, test.n, test.rank)
+			}
+		}
+
+		if test.rank == min(test.m, test.n) {
+			// For full rank A the solution agrees with Solve.
+			var got Dense
+			if err := got.Solve(&a, b); err != nil {
+				t.Errorf("m=%d n=%d: unexpected error from Solve: %v", test.m, test.n, err)
+			}
+			if !EqualApprox(&x, &got, 1e-10) {
+				t.Errorf("m=%d n=%d: solution does not match Solve", test.m, test.n)
+			}
+		}
+	}
+
+	// The minimum-norm solution for a zero matrix is zero.
+	var x Dense
+	err := x.SolveMinNorm(NewDense(3, 2, nil), NewDense(3, 1, []float64{1, 2, 3}), 0)
+	if err != nil {
+		t.Errorf("unexpected error for zero matrix: %v", err)
+	}
+	if !Equal(&x, NewDense(2, 1, nil)) {
+		t.Errorf("unexpected solution for zero matrix: got:%v want:0", Formatted(&x))
+	}
+
+	// A large rcond truncates small singular values.
+	a := NewDense(2, 2, []float64{1, 0, 0, 1e-3})
+	x.Reset()
+	x.SolveMinNorm(a, NewDense(2, 1, []float64{1, 1}), 1e-2)
+	if !EqualApprox(&x, NewDense(2, 1, []float64{1, 0}), 1e-14) {
+		t.Errorf("unexpected solution for truncated rank: got:%v want:[1 0]", Formatted(&x))
+	}
+
+	for _, fn := range []func(){
+		func() { x.SolveMinNorm(a, NewDense(3, 1, nil), 0) },
+		func() { x.SolveMinNorm(a, NewDense(2, 1, nil), -1) },
+	} {
+		if ok, _ := panics(fn); !ok {
+			t.Errorf("expected panic")
+		}
+	}
+}
+
+func TestDefaultRankTol(t *testing.T) {
+	t.Parallel()
+	// The second singular value lies between max(m,n)·2⁻⁵³ and
+	// max(m,n)·2⁻⁵² times the first, so it is only treated as zero
+	// if the default cutoff uses ε = 2⁻⁵².
+	a := NewDense(2, 2, []float64{1, 0, 0, 1.5 * 0x1p-52})
+	b := NewDense(2, 1, []float64{1, 1})
+
+	var x Dense
+	err := x.SolveMinNorm(a, b, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !Equal(&x, NewDense(2, 1, []float64{1, 0})) {
+		t.Errorf("unexpected SolveMinNorm solution with default cutoff: got:%v want:[1 0]", Formatted(&x))
+	}
+
+	var pinv Dense
+	err = pinv.Pinv(a, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !Equal(&pinv, NewDense(2, 2, []float64{1, 0, 0, 0})) {
+		t.Errorf("unexpected Pinv with default cutoff: got:%v want:diag(1, 0)", Formatted(&pinv))
+	}
+
+	var qr PivotedQR
+	qr.Factorize(a, 0)
+	if qr.Rank() != 1 {
+		t.Errorf("unexpected PivotedQR rank with default tolerance: got:%d want:1", qr.Rank())
+	}
+	if ok, _ := panics(func() { qr.Factorize(a, -1) }); !ok {
+		t.Errorf("expected panic for negative PivotedQR tolerance")
+	}
+}
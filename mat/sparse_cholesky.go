@@ -0,0 +1,334 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import "math"
+
+const badSparseCholesky = "mat: invalid sparse Cholesky factorization"
+
+// SparseCholesky is a symmetric positive definite sparse matrix represented
+// by its Cholesky decomposition
+//
+//	P * A * Pᵀ = L * Lᵀ
+//
+// where P is a fill-reducing permutation and L is a sparse lower triangular
+// matrix.
+//
+// The permutation is computed by the minimum degree ordering of the pattern
+// of A, which keeps the number of non-zero elements in L, and so the memory
+// and time required for the factorization, far smaller than for a dense
+// factorization of the same matrix for most matrices arising from graphs
+// and finite element discretizations.
+//
+// SparseCholesky methods may only be called on a value that has been
+// successfully initialized by a call to Factorize that has returned true.
+// Calls to methods of an unsuccessful factorization will panic.
+type SparseCholesky struct {
+	n int
+
+	// perm and pinv hold the permutation P and its inverse,
+	// row i of P*A*Pᵀ is row perm[i] of A.
+	perm, pinv []int
+
+	// L is stored in compressed sparse column format with
+	// the diagonal element first in each column.
+	colptr []int
+	rowind []int
+	values []float64
+
+	cond float64
+}
+
+// Factorize calculates the Cholesky decomposition of the symmetric matrix
+// a, returning whether the matrix is positive definite. Only the lower
+// triangle of a, including the diagonal, is referenced. If Factorize returns
+// false, the factorization must not be used.
+//
+// Factorize panics if a is not square.
+func (c *SparseCholesky) Factorize(a *CSR) (ok bool) {
+	n, m := a.Dims()
+	if n != m {
+		panic(ErrSquare)
+	}
+	c.reset()
+	c.n = n
+
+	c.perm = minimumDegree(symmetricPattern(a))
+	c.pinv = make([]int, n)
+	for k, i := range c.perm {
+		c.pinv[i] = k
+	}
+
+	// Form the rows of the lower triangle of C = P*A*Pᵀ. By symmetry these
+	// are the columns of the upper triangle, so row k holds C[i,k] for i ≤ k.
+	lower := c.permutedLower(a)
+
+	// Compute the elimination tree and the number of elements in each
+	// column of L.
+	parent := make([]int, n)
+	ancestor := make([]int, n)
+	for k := 0; k < n; k++ {
+		parent[k] = -1
+		ancestor[k] = -1
+		for _, i := range lower.ind[lower.indptr[k]:lower.indptr[k+1]] {
+			// Follow the path from i to the root of its subtree,
+			// compressing the path to k.
+			for i != -1 && i < k {
+				next := ancestor[i]
+				ancestor[i] = k
+				if next == -1 {
+					parent[i] = k
+				}
+				i = next
+			}
+		}
+	}
+
+	counts := make([]int, n)
+	mark := make([]int, n)
+	stack := make([]int, n)
+	for k := 0; k < n; k++ {
+		top := ereach(lower, k, parent, mark, stack)
+		counts[k]++
+		for _, j := range stack[top:] {
+			counts[j]++
+		}
+	}
+	c.colptr = make([]int, n+1)
+	for j := 0; j < n; j++ {
+		c.colptr[j+1] = c.colptr[j] + counts[j]
+	}
+	nnz := c.colptr[n]
+	c.rowind = make([]int, nnz)
+	c.values = make([]float64, nnz)
+
+	// Numeric up-looking factorization computing one row of L at a time.
+	next := make([]int, n)
+	copy(next, c.colptr[:n])
+	x := make([]float64, n)
+	for k := range mark {
+		mark[k] = -1
+	}
+	for k := 0; k < n; k++ {
+		top := ereach(lower, k, parent, mark, stack)
+
+		// Scatter row k of the lower triangle of C.
+		var d float64
+		for p := lower.indptr[k]; p < lower.indptr[k+1]; p++ {
+			i := lower.ind[p]
+			if i == k {
+				d = lower.data[p]
+			} else {
+				x[i] = lower.data[p]
+			}
+		}
+
+		// Solve L[:k,:k] * l = C[:k,k] for row k of L.
+		for _, j := range stack[top:] {
+			lkj := x[j] / c.values[c.colptr[j]]
+			x[j] = 0
+			for p := c.colptr[j] + 1; p < next[j]; p++ {
+				x[c.rowind[p]] -= c.values[p] * lkj
+			}
+			d -= lkj * lkj
+			c.rowind[next[j]] = k
+			c.values[next[j]] = lkj
+			next[j]++
+		}
+		if d <= 0 || math.IsNaN(d) {
+			c.reset()
+			return false
+		}
+		c.rowind[next[k]] = k
+		c.values[next[k]] = math.Sqrt(d)
+		next[k]++
+	}
+
+	c.cond = sparseCond(a, c.solve)
+	return true
+}
+
+// permutedLower returns the lower triangle of P*A*Pᵀ in compressed sparse
+// row format, taking each element from the lower triangle of a.
+func (c *SparseCholesky) permutedLower(a *CSR) *CSR {
+	n := c.n
+	var ii, jj []int
+	var vv []float64
+	for i := 0; i < n; i++ {
+		for p := a.indptr[i]; p < a.indptr[i+1]; p++ {
+			j := a.ind[p]
+			if j > i {
+				break
+			}
+			pi, pj := c.pinv[i], c.pinv[j]
+			if pj > pi {
+				pi, pj = pj, pi
+			}
+			ii = append(ii, pi)
+			jj = append(jj, pj)
+			vv = append(vv, a.data[p])
+		}
+	}
+	return NewCSRFromTriplets(n, n, ii, jj, vv)
+}
+
+// ereach computes the non-zero pattern of row k of L from the rows of the
+// lower triangle of the matrix being factorized and its elimination tree.
+// The column indices are returned in stack[top:] in topological order.
+// Nodes are marked as visited by setting mark to k.
+func ereach(lower *CSR, k int, parent, mark, stack []int) (top int) {
+	n := len(parent)
+	top = n
+	mark[k] = k
+	for _, i := range lower.ind[lower.indptr[k]:lower.indptr[k+1]] {
+		if i > k {
+			continue
+		}
+		// Walk up the elimination tree from i until a marked node.
+		var length int
+		for ; mark[i] != k; i = parent[i] {
+			stack[length] = i
+			length++
+			mark[i] = k
+		}
+		// Push the path onto the output stack.
+		for length > 0 {
+			length--
+			top--
+			stack[top] = stack[length]
+		}
+	}
+	return top
+}
+
+// solve solves A * x = b in place where b is held in x, using work as
+// temporary storage of length n. The trans parameter is ignored since A
+// is symmetric.
+func (c *SparseCholesky) solve(x, work []float64, _ bool) {
+	n := c.n
+	for k, i := range c.perm {
+		work[k] = x[i]
+	}
+	// Solve L * y = P*b.
+	for j := 0; j < n; j++ {
+		work[j] /= c.values[c.colptr[j]]
+		for p := c.colptr[j] + 1; p < c.colptr[j+1]; p++ {
+			work[c.rowind[p]] -= c.values[p] * work[j]
+		}
+	}
+	// Solve Lᵀ * z = y.
+	for j := n - 1; j >= 0; j-- {
+		for p := c.colptr[j] + 1; p < c.colptr[j+1]; p++ {
+			work[j] -= c.values[p] * work[c.rowind[p]]
+		}
+		work[j] /= c.values[c.colptr[j]]
+	}
+	for k, i := range c.perm {
+		x[i] = work[k]
+	}
+}
+
+// Dims returns the dimensions of the factorized matrix.
+func (c *SparseCholesky) Dims() (r, cols int) {
+	return c.n, c.n
+}
+
+// NNZ returns the number of stored elements in the factor L.
+func (c *SparseCholesky) NNZ() int {
+	if !c.valid() {
+		panic(badSparseCholesky)
+	}
+	return c.colptr[c.n]
+}
+
+// Cond returns the condition number of the factorized matrix in the 1-norm.
+// The condition number is estimated from the factorization.
+func (c *SparseCholesky) Cond() float64 {
+	if !c.valid() {
+		panic(badSparseCholesky)
+	}
+	return c.cond
+}
+
+// Perm returns the fill-reducing permutation used by the factorization. Row
+// i of P*A*Pᵀ is row perm[i] of A. If dst is not nil it is used to store
+// the permutation and must have length equal to the dimension of the
+// factorized matrix.
+func (c *SparseCholesky) Perm(dst []int) []int {
+	if !c.valid() {
+		panic(badSparseCholesky)
+	}
+	if dst == nil {
+		dst = make([]int, c.n)
+	}
+	if len(dst) != c.n {
+		panic(badSliceLength)
+	}
+	copy(dst, c.perm)
+	return dst
+}
+
+// LogDet returns the log of the determinant of the factorized matrix.
+func (c *SparseCholesky) LogDet() float64 {
+	if !c.valid() {
+		panic(badSparseCholesky)
+	}
+	var det float64
+	for j := 0; j < c.n; j++ {
+		det += 2 * math.Log(c.values[c.colptr[j]])
+	}
+	return det
+}
+
+// SolveTo finds the matrix X that solves A * X = B where A is represented
+// by the Cholesky decomposition. The result is stored in-place into dst.
+// If the matrix is near-singular a Condition error is returned. See the
+// documentation for Condition for more information.
+func (c *SparseCholesky) SolveTo(dst *Dense, b Matrix) error {
+	if !c.valid() {
+		panic(badSparseCholesky)
+	}
+	sparseSolveTo(dst, b, c.n, false, c.solve)
+	if c.cond > ConditionTolerance {
+		return Condition(c.cond)
+	}
+	return nil
+}
+
+// SolveVecTo finds the vector x that solves A * x = b where A is represented
+// by the Cholesky decomposition. The result is stored in-place into dst.
+// If the matrix is near-singular a Condition error is returned. See the
+// documentation for Condition for more information.
+func (c *SparseCholesky) SolveVecTo(dst *VecDense, b Vector) error {
+	if !c.valid() {
+		panic(badSparseCholesky)
+	}
+	sparseSolveVecTo(dst, b, c.n, false, c.solve)
+	if c.cond > ConditionTolerance {
+		return Condition(c.cond)
+	}
+	return nil
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of
+// a dimensionally restricted operation.
+func (c *SparseCholesky) Reset() {
+	c.reset()
+}
+
+func (c *SparseCholesky) reset() {
+	*c = SparseCholesky{}
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for dimensionally restricted operations. The receiver can be
+// emptied using Reset.
+func (c *SparseCholesky) IsEmpty() bool {
+	return c.n == 0
+}
+
+func (c *SparseCholesky) valid() bool {
+	return c.n != 0 && c.colptr != nil
+}
@@ -0,0 +1,228 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// gridLaplacian returns the n²×n² matrix of the five-point Laplacian on an
+// n×n grid with Dirichlet boundary conditions, shifted by the given value
+// on the diagonal.
+func gridLaplacian(n int, shift float64) *CSR {
+	var ii, jj []int
+	var vv []float64
+	add := func(i, j int, v float64) {
+		ii = append(ii, i)
+		jj = append(jj, j)
+		vv = append(vv, v)
+	}
+	for r := 0; r < n; r++ {
+		for c := 0; c < n; c++ {
+			k := r*n + c
+			add(k, k, 4+shift)
+			if r > 0 {
+				add(k, k-n, -1)
+			}
+			if r < n-1 {
+				add(k, k+n, -1)
+			}
+			if c > 0 {
+				add(k, k-1, -1)
+			}
+			if c < n-1 {
+				add(k, k+1, -1)
+			}
+		}
+	}
+	return NewCSRFromTriplets(n*n, n*n, ii, jj, vv)
+}
+
+// randomSparseSPD returns a random n×n sparse symmetric positive definite
+// matrix with approximately the given density of off-diagonal elements.
+func randomSparseSPD(rnd *rand.Rand, n int, density float64) *CSR {
+	var ii, jj []int
+	var vv []float64
+	diag := make([]float64, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < i; j++ {
+			if rnd.Float64() >= density {
+				continue
+			}
+			v := rnd.NormFloat64()
+			ii = append(ii, i, j)
+			jj = append(jj, j, i)
+			vv = append(vv, v, v)
+			diag[i] += math.Abs(v)
+			diag[j] += math.Abs(v)
+		}
+	}
+	for i, d := range diag {
+		ii = append(ii, i)
+		jj = append(jj, i)
+		vv = append(vv, d+rnd.Float64()+0.1)
+	}
+	return NewCSRFromTriplets(n, n, ii, jj, vv)
+}
+
+func TestSparseCholesky(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		name string
+		a    *CSR
+	}{
+		{name: "1×1", a: NewCSR(1, 1, []int{0, 1}, []int{0}, []float64{4})},
+		{name: "diagonal", a: NewCSR(3, 3, []int{0, 1, 2, 3}, []int{0, 1, 2}, []float64{1, 2, 3})},
+		{name: "laplacian 3", a: gridLaplacian(3, 0)},
+		{name: "laplacian 10", a: gridLaplacian(10, 0.5)},
+		{name: "random 20", a: randomSparseSPD(rnd, 20, 0.2)},
+		{name: "random 60", a: randomSparseSPD(rnd, 60, 0.05)},
+	} {
+		n, _ := test.a.Dims()
+		dense := NewDense(n, n, nil)
+		dense.Copy(test.a)
+		sym := NewSymDense(n, dense.RawMatrix().Data)
+
+		var chol SparseCholesky
+		if !chol.Factorize(test.a) {
+			t.Errorf("%s: unexpected factorization failure", test.name)
+			continue
+		}
+		var dchol Cholesky
+		if !dchol.Factorize(sym) {
+			t.Fatalf("%s: bad test: matrix not positive definite", test.name)
+		}
+
+		if got, want := chol.LogDet(), dchol.LogDet(); !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+			t.Errorf("%s: unexpected LogDet: got:%v want:%v", test.name, got, want)
+		}
+		if got, want := chol.Cond(), dchol.Cond(); got < want/10 || got > 10*want {
+			t.Errorf("%s: unexpected condition estimate: got:%v want:%v", test.name, got, want)
+		}
+		perm := chol.Perm(nil)
+		seen := make([]bool, n)
+		for _, p := range perm {
+			seen[p] = true
+		}
+		for i, ok := range seen {
+			if !ok {
+				t.Errorf("%s: permutation missing %d: %v", test.name, i, perm)
+			}
+		}
+
+		for _, nrhs := range []int{1, 3} {
+			b := NewDense(n, nrhs, nil)
+			for i := 0; i < n; i++ {
+				for j := 0; j < nrhs; j++ {
+					b.Set(i, j, rnd.NormFloat64())
+				}
+			}
+			var got, want Dense
+			err := chol.SolveTo(&got, b)
+			if err != nil {
+				t.Errorf("%s: unexpected error from SolveTo: %v", test.name, err)
+			}
+			dchol.SolveTo(&want, b)
+			if !EqualApprox(&got, &want, tol) {
+				t.Errorf("%s nrhs=%d: unexpected solution:\ngot: %v\nwant:%v", test.name, nrhs, Formatted(&got), Formatted(&want))
+			}
+
+			// Aliased input and output.
+			bCopy := DenseCopyOf(b)
+			chol.SolveTo(bCopy, bCopy)
+			if !EqualApprox(bCopy, &want, tol) {
+				t.Errorf("%s nrhs=%d: unexpected solution with aliased matrices", test.name, nrhs)
+			}
+		}
+
+		b := NewVecDense(n, nil)
+		for i := 0; i < n; i++ {
+			b.SetVec(i, rnd.NormFloat64())
+		}
+		var got, want VecDense
+		chol.SolveVecTo(&got, b)
+		dchol.SolveVecTo(&want, b)
+		if !EqualApprox(&got, &want, tol) {
+			t.Errorf("%s: unexpected vector solution:\ngot: %v\nwant:%v", test.name, Formatted(&got), Formatted(&want))
+		}
+		chol.SolveVecTo(b, b)
+		if !EqualApprox(b, &want, tol) {
+			t.Errorf("%s: unexpected vector solution with aliased vectors", test.name)
+		}
+	}
+}
+
+func TestSparseCholeskyFill(t *testing.T) {
+	t.Parallel()
+	// An arrow matrix with a dense first row and column has a dense
+	// Cholesky factor in the natural order but no fill when the dense
+	// row is ordered last.
+	const n = 100
+	var ii, jj []int
+	var vv []float64
+	for i := 0; i < n; i++ {
+		ii = append(ii, i)
+		jj = append(jj, i)
+		vv = append(vv, n)
+		if i > 0 {
+			ii = append(ii, i, 0)
+			jj = append(jj, 0, i)
+			vv = append(vv, 1, 1)
+		}
+	}
+	a := NewCSRFromTriplets(n, n, ii, jj, vv)
+	var chol SparseCholesky
+	if !chol.Factorize(a) {
+		t.Fatal("unexpected factorization failure")
+	}
+	if got, want := chol.NNZ(), 2*n-1; got != want {
+		t.Errorf("unexpected number of elements in factor: got:%d want:%d", got, want)
+	}
+
+	// The factor of the grid Laplacian must be much sparser
+	// than its dense triangle.
+	const m = 20
+	if !chol.Factorize(gridLaplacian(m, 0)) {
+		t.Fatal("unexpected factorization failure")
+	}
+	if nnz, dense := chol.NNZ(), m*m*(m*m+1)/2; nnz > dense/5 {
+		t.Errorf("unexpected fill for grid Laplacian: got:%d dense:%d", nnz, dense)
+	}
+}
+
+func TestSparseCholeskyNotPD(t *testing.T) {
+	t.Parallel()
+	for i, a := range []*CSR{
+		NewCSR(2, 2, []int{0, 2, 4}, []int{0, 1, 0, 1}, []float64{1, 2, 2, 1}),
+		NewCSR(2, 2, []int{0, 1, 2}, []int{0, 1}, []float64{1, -1}),
+		gridLaplacian(4, -6),
+	} {
+		var chol SparseCholesky
+		if chol.Factorize(a) {
+			t.Errorf("case %d: expected factorization failure", i)
+		}
+		if !chol.IsEmpty() {
+			t.Errorf("case %d: expected empty factorization after failure", i)
+		}
+		panicked, message := panics(func() { chol.LogDet() })
+		if !panicked || message != badSparseCholesky {
+			t.Errorf("case %d: expected panic for invalid factorization", i)
+		}
+	}
+	panicked, message := panics(func() {
+		var chol SparseCholesky
+		chol.Factorize(NewCSR(2, 3, nil, nil, nil))
+	})
+	if !panicked || message != ErrSquare.Error() {
+		t.Errorf("expected panic for non-square matrix")
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat_test
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+func ExampleSparseCholesky() {
+	// Construct a sparse symmetric positive definite
+	// tridiagonal matrix.
+	var i, j []int
+	var v []float64
+	for k := 0; k < 5; k++ {
+		i = append(i, k)
+		j = append(j, k)
+		v = append(v, 3)
+		if k > 0 {
+			i = append(i, k, k-1)
+			j = append(j, k-1, k)
+			v = append(v, -1, -1)
+		}
+	}
+	a := mat.NewCSRFromTriplets(5, 5, i, j, v)
+	b := mat.NewVecDense(5, []float64{1, 1, 1, 1, 1})
+
+	var chol mat.SparseCholesky
+	if ok := chol.Factorize(a); !ok {
+		fmt.Println("matrix is not positive definite")
+		return
+	}
+	var x mat.VecDense
+	err := chol.SolveVecTo(&x, b)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("x = %.4f\n", mat.Formatted(&x, mat.Prefix("    ")))
+
+	// Output:
+	// x = ⎡0.6111⎤
+	//     ⎢0.8333⎥
+	//     ⎢0.8889⎥
+	//     ⎢0.8333⎥
+	//     ⎣0.6111⎦
+}
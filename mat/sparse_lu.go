@@ -0,0 +1,519 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import "math"
+
+const badSparseLU = "mat: invalid sparse LU factorization"
+
+// sparsePivotTol is the threshold of the partial pivoting in the sparse LU
+// factorization. The diagonal element of the fill-reducing ordering is
+// chosen as the pivot if its magnitude is at least sparsePivotTol times the
+// largest magnitude in its column, which preserves the ordering while
+// bounding the growth of the factors.
+const sparsePivotTol = 0.1
+
+// SparseLU is a square sparse matrix represented by its LU factorization
+//
+//	P * A * Q = L * U
+//
+// where Q is a fill-reducing column permutation, P is a row permutation
+// chosen by threshold partial pivoting, L is a sparse unit lower triangular
+// matrix and U is a sparse upper triangular matrix.
+//
+// The column permutation is computed by the minimum degree ordering of the
+// pattern of A+Aᵀ, which is effective for matrices with a nearly symmetric
+// pattern such as those arising from graphs and finite element
+// discretizations. The factorization is computed by the left-looking
+// algorithm of Gilbert and Peierls, in time proportional to the number of
+// floating point operations.
+//
+// See J. R. Gilbert and T. Peierls, "Sparse partial pivoting in time
+// proportional to arithmetic operations", SIAM J. Sci. Statist. Comput.
+// 9(5), 1988, and T. A. Davis, "Direct Methods for Sparse Linear Systems",
+// SIAM, 2006, for details.
+//
+// SparseLU methods may only be called on a value that has been successfully
+// initialized by a call to Factorize that has returned true. Calls to
+// methods of an unsuccessful factorization will panic.
+type SparseLU struct {
+	n int
+
+	// q holds the column permutation, column k of A*Q is
+	// column q[k] of A. pinv holds the inverse of the row
+	// permutation, row i of A is row pinv[i] of P*A.
+	q, pinv []int
+
+	// L and U are stored in compressed sparse column format.
+	// The unit diagonal of L is stored first in each column
+	// and the diagonal of U is stored last.
+	lp, li []int
+	lx     []float64
+	up, ui []int
+	ux     []float64
+
+	cond float64
+}
+
+// Factorize computes the LU factorization of the square matrix a, returning
+// whether the matrix is non-singular. If Factorize returns false, a zero
+// pivot was encountered and the factorization must not be used.
+//
+// Factorize panics if a is not square.
+func (lu *SparseLU) Factorize(a *CSR) (ok bool) {
+	n, m := a.Dims()
+	if n != m {
+		panic(ErrSquare)
+	}
+	lu.reset()
+	lu.n = n
+	lu.q = minimumDegree(symmetricPattern(a))
+
+	// Form A in compressed sparse column format, which is
+	// the compressed sparse row format of Aᵀ.
+	ap, ai, ax := transposeCSR(a)
+
+	lu.pinv = make([]int, n)
+	for i := range lu.pinv {
+		lu.pinv[i] = -1
+	}
+	nnz := 4*len(ax) + n
+	lu.lp = make([]int, n+1)
+	lu.li = make([]int, 0, nnz)
+	lu.lx = make([]float64, 0, nnz)
+	lu.up = make([]int, n+1)
+	lu.ui = make([]int, 0, nnz)
+	lu.ux = make([]float64, 0, nnz)
+
+	x := make([]float64, n)
+	xi := make([]int, 2*n)
+	mark := make([]bool, n)
+	for k := 0; k < n; k++ {
+		lu.lp[k] = len(lu.li)
+		lu.up[k] = len(lu.ui)
+
+		// Solve L[:,:k] * x = A[:,q[k]].
+		col := lu.q[k]
+		top := lu.reach(ap, ai, col, xi, mark)
+		for _, i := range xi[top:n] {
+			x[i] = 0
+		}
+		for p := ap[col]; p < ap[col+1]; p++ {
+			x[ai[p]] = ax[p]
+		}
+		for _, j := range xi[top:n] {
+			jj := lu.pinv[j]
+			if jj < 0 {
+				continue
+			}
+			// The unit diagonal is stored first.
+			for p := lu.lp[jj] + 1; p < lu.lp[jj+1]; p++ {
+				x[lu.li[p]] -= lu.lx[p] * x[j]
+			}
+		}
+
+		// Find the pivot, storing the upper part into U.
+		ipiv := -1
+		amax := -1.0
+		for _, i := range xi[top:n] {
+			if lu.pinv[i] < 0 {
+				if v := math.Abs(x[i]); v > amax {
+					amax = v
+					ipiv = i
+				}
+			} else {
+				lu.ui = append(lu.ui, lu.pinv[i])
+				lu.ux = append(lu.ux, x[i])
+			}
+		}
+		if ipiv == -1 || amax <= 0 || math.IsNaN(amax) {
+			lu.reset()
+			return false
+		}
+		if lu.pinv[col] < 0 && math.Abs(x[col]) >= sparsePivotTol*amax {
+			ipiv = col
+		}
+		pivot := x[ipiv]
+		lu.ui = append(lu.ui, k)
+		lu.ux = append(lu.ux, pivot)
+		lu.pinv[ipiv] = k
+
+		// Store the lower part into L.
+		lu.li = append(lu.li, ipiv)
+		lu.lx = append(lu.lx, 1)
+		for _, i := range xi[top:n] {
+			if lu.pinv[i] < 0 {
+				lu.li = append(lu.li, i)
+				lu.lx = append(lu.lx, x[i]/pivot)
+			}
+			x[i] = 0
+		}
+	}
+	lu.lp[n] = len(lu.li)
+	lu.up[n] = len(lu.ui)
+
+	// Renumber the rows of L to the pivot order.
+	for p, i := range lu.li {
+		lu.li[p] = lu.pinv[i]
+	}
+
+	lu.cond = sparseCond(a, lu.solve)
+	return true
+}
+
+// reach computes the non-zero pattern of the solution of L*x = A[:,col]
+// by depth first search in the graph of L, returning it in xi[top:n] in
+// topological order. The lower part of xi is used as the search stack and
+// xi[n:] holds the search positions. The marks of the visited nodes are
+// cleared before returning.
+func (lu *SparseLU) reach(ap, ai []int, col int, xi []int, mark []bool) (top int) {
+	n := lu.n
+	top = n
+	for p := ap[col]; p < ap[col+1]; p++ {
+		if !mark[ai[p]] {
+			top = lu.dfs(ai[p], top, xi[:n], xi[n:], mark)
+		}
+	}
+	for _, i := range xi[top:n] {
+		mark[i] = false
+	}
+	return top
+}
+
+// dfs performs a non-recursive depth first search from node j in the graph
+// of L, pushing the nodes onto xi[top:] in post order. Column k of L holds
+// the edges from the node pivoted at step k, skipping the unit diagonal.
+func (lu *SparseLU) dfs(j, top int, xi, pstack []int, mark []bool) int {
+	head := 0
+	xi[0] = j
+	for head >= 0 {
+		j = xi[head]
+		jj := lu.pinv[j]
+		if !mark[j] {
+			mark[j] = true
+			if jj < 0 {
+				pstack[head] = 0
+			} else {
+				pstack[head] = lu.lp[jj] + 1
+			}
+		}
+		var end int
+		if jj >= 0 {
+			end = lu.lp[jj+1]
+		}
+		done := true
+		for p := pstack[head]; p < end; p++ {
+			i := lu.li[p]
+			if mark[i] {
+				continue
+			}
+			pstack[head] = p
+			head++
+			xi[head] = i
+			done = false
+			break
+		}
+		if done {
+			head--
+			top--
+			xi[top] = j
+		}
+	}
+	return top
+}
+
+// solve solves A * x = b or Aᵀ * x = b in place where b is held in x, using
+// work as temporary storage of length n.
+func (lu *SparseLU) solve(x, work []float64, trans bool) {
+	n := lu.n
+	if !trans {
+		// P*A*Q = L*U, so x = Q * U⁻¹ * L⁻¹ * P * b.
+		for i, k := range lu.pinv {
+			work[k] = x[i]
+		}
+		for j := 0; j < n; j++ {
+			for p := lu.lp[j] + 1; p < lu.lp[j+1]; p++ {
+				work[lu.li[p]] -= lu.lx[p] * work[j]
+			}
+		}
+		for j := n - 1; j >= 0; j-- {
+			work[j] /= lu.ux[lu.up[j+1]-1]
+			for p := lu.up[j]; p < lu.up[j+1]-1; p++ {
+				work[lu.ui[p]] -= lu.ux[p] * work[j]
+			}
+		}
+		for k, j := range lu.q {
+			x[j] = work[k]
+		}
+		return
+	}
+
+	// Aᵀ = Q * Uᵀ * Lᵀ * P, so x = Pᵀ * L⁻ᵀ * U⁻ᵀ * Qᵀ * b.
+	for k, j := range lu.q {
+		work[k] = x[j]
+	}
+	for j := 0; j < n; j++ {
+		for p := lu.up[j]; p < lu.up[j+1]-1; p++ {
+			work[j] -= lu.ux[p] * work[lu.ui[p]]
+		}
+		work[j] /= lu.ux[lu.up[j+1]-1]
+	}
+	for j := n - 1; j >= 0; j-- {
+		for p := lu.lp[j] + 1; p < lu.lp[j+1]; p++ {
+			work[j] -= lu.lx[p] * work[lu.li[p]]
+		}
+	}
+	for i, k := range lu.pinv {
+		x[i] = work[k]
+	}
+}
+
+// Dims returns the dimensions of the factorized matrix.
+func (lu *SparseLU) Dims() (r, c int) {
+	return lu.n, lu.n
+}
+
+// NNZ returns the number of stored elements in the factors L and U,
+// including the unit diagonal of L.
+func (lu *SparseLU) NNZ() int {
+	if !lu.valid() {
+		panic(badSparseLU)
+	}
+	return len(lu.li) + len(lu.ui)
+}
+
+// Cond returns the condition number of the factorized matrix in the 1-norm.
+// The condition number is estimated from the factorization.
+func (lu *SparseLU) Cond() float64 {
+	if !lu.valid() {
+		panic(badSparseLU)
+	}
+	return lu.cond
+}
+
+// LogDet returns the log of the determinant and the sign of the determinant
+// of the factorized matrix.
+func (lu *SparseLU) LogDet() (det float64, sign float64) {
+	if !lu.valid() {
+		panic(badSparseLU)
+	}
+	sign = 1
+	for j := 0; j < lu.n; j++ {
+		v := lu.ux[lu.up[j+1]-1]
+		if v < 0 {
+			sign *= -1
+		}
+		det += math.Log(math.Abs(v))
+	}
+	// The determinants of P and Q are the signs of the permutations.
+	perm := make([]int, lu.n)
+	for i, k := range lu.pinv {
+		perm[k] = i
+	}
+	return det, sign * permSign(perm) * permSign(lu.q)
+}
+
+// permSign returns the sign of the permutation perm.
+func permSign(perm []int) float64 {
+	visited := make([]bool, len(perm))
+	sign := 1.0
+	for i := range perm {
+		if visited[i] {
+			continue
+		}
+		var length int
+		for j := i; !visited[j]; j = perm[j] {
+			visited[j] = true
+			length++
+		}
+		if length%2 == 0 {
+			sign = -sign
+		}
+	}
+	return sign
+}
+
+// SolveTo solves a system of linear equations
+//
+//	A * X = B   if trans == false
+//	Aᵀ * X = B  if trans == true
+//
+// using the LU factorization of A stored in the receiver. The solution matrix
+// X is stored into dst.
+//
+// If A is near-singular a Condition error is returned. See the documentation
+// for Condition for more information. SolveTo will panic if the receiver does
+// not contain a factorization.
+func (lu *SparseLU) SolveTo(dst *Dense, trans bool, b Matrix) error {
+	if !lu.valid() {
+		panic(badSparseLU)
+	}
+	sparseSolveTo(dst, b, lu.n, trans, lu.solve)
+	if lu.cond > ConditionTolerance {
+		return Condition(lu.cond)
+	}
+	return nil
+}
+
+// SolveVecTo solves a system of linear equations
+//
+//	A * x = b   if trans == false
+//	Aᵀ * x = b  if trans == true
+//
+// using the LU factorization of A stored in the receiver. The solution vector
+// x is stored into dst.
+//
+// If A is near-singular a Condition error is returned. See the documentation
+// for Condition for more information. SolveVecTo will panic if the receiver
+// does not contain a factorization.
+func (lu *SparseLU) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
+	if !lu.valid() {
+		panic(badSparseLU)
+	}
+	sparseSolveVecTo(dst, b, lu.n, trans, lu.solve)
+	if lu.cond > ConditionTolerance {
+		return Condition(lu.cond)
+	}
+	return nil
+}
+
+// Reset resets the factorization so that it can be reused as the receiver of
+// a dimensionally restricted operation.
+func (lu *SparseLU) Reset() {
+	lu.reset()
+}
+
+func (lu *SparseLU) reset() {
+	*lu = SparseLU{}
+}
+
+// IsEmpty returns whether the receiver is empty. Empty matrices can be the
+// receiver for dimensionally restricted operations. The receiver can be
+// emptied using Reset.
+func (lu *SparseLU) IsEmpty() bool {
+	return lu.n == 0
+}
+
+func (lu *SparseLU) valid() bool {
+	return lu.n != 0 && lu.up != nil
+}
+
+// transposeCSR returns the compressed sparse row representation of the
+// transpose of a, which is the compressed sparse column representation of a.
+func transposeCSR(a *CSR) (indptr, ind []int, data []float64) {
+	r, c := a.Dims()
+	nnz := a.NNZ()
+	indptr = make([]int, c+1)
+	ind = make([]int, nnz)
+	data = make([]float64, nnz)
+	for _, j := range a.ind[:nnz] {
+		indptr[j+1]++
+	}
+	for j := 0; j < c; j++ {
+		indptr[j+1] += indptr[j]
+	}
+	next := make([]int, c)
+	copy(next, indptr)
+	for i := 0; i < r; i++ {
+		for p := a.indptr[i]; p < a.indptr[i+1]; p++ {
+			j := a.ind[p]
+			ind[next[j]] = i
+			data[next[j]] = a.data[p]
+			next[j]++
+		}
+	}
+	return indptr, ind, data
+}
+
+// sparseSolveTo solves the n×n system represented by solve for each column
+// of b, storing the result into dst.
+func sparseSolveTo(dst *Dense, b Matrix, n int, trans bool, solve func(x, work []float64, trans bool)) {
+	br, bc := b.Dims()
+	if br != n {
+		panic(ErrShape)
+	}
+	dst.reuseAsNonZeroed(n, bc)
+	bU, _ := untranspose(b)
+	if dst == bU {
+		var restore func()
+		dst, restore = dst.isolatedWorkspace(bU)
+		defer restore()
+	} else if rm, ok := bU.(RawMatrixer); ok {
+		dst.checkOverlap(rm.RawMatrix())
+	}
+	dst.Copy(b)
+
+	x := getFloat64s(n, false)
+	defer putFloat64s(x)
+	work := getFloat64s(n, false)
+	defer putFloat64s(work)
+	for j := 0; j < bc; j++ {
+		for i := range x {
+			x[i] = dst.mat.Data[i*dst.mat.Stride+j]
+		}
+		solve(x, work, trans)
+		for i, v := range x {
+			dst.mat.Data[i*dst.mat.Stride+j] = v
+		}
+	}
+}
+
+// sparseSolveVecTo solves the n×n system represented by solve for b,
+// storing the result into dst.
+func sparseSolveVecTo(dst *VecDense, b Vector, n int, trans bool, solve func(x, work []float64, trans bool)) {
+	if br, bc := b.Dims(); br != n || bc != 1 {
+		panic(ErrShape)
+	}
+	if rv, ok := b.(RawVectorer); ok && dst != b {
+		dst.checkOverlap(rv.RawVector())
+	}
+	dst.reuseAsNonZeroed(n)
+	if dst != b {
+		dst.CopyVec(b)
+	}
+
+	x := getFloat64s(n, false)
+	defer putFloat64s(x)
+	work := getFloat64s(n, false)
+	defer putFloat64s(work)
+	for i := range x {
+		x[i] = dst.mat.Data[i*dst.mat.Inc]
+	}
+	solve(x, work, trans)
+	for i, v := range x {
+		dst.mat.Data[i*dst.mat.Inc] = v
+	}
+}
+
+// sparseCond returns an estimate of the condition number in the 1-norm of
+// the n×n sparse matrix a using the solver of its factorization. The norm
+// of the inverse is estimated by normEst1.
+func sparseCond(a *CSR, solve func(x, work []float64, trans bool)) float64 {
+	n, _ := a.Dims()
+
+	// Compute the 1-norm of a as the maximum absolute column sum.
+	sums := make([]float64, n)
+	for p, j := range a.ind[:a.NNZ()] {
+		sums[j] += math.Abs(a.data[p])
+	}
+	var norm float64
+	for _, v := range sums {
+		norm = math.Max(norm, v)
+	}
+	if norm == 0 {
+		return math.Inf(1)
+	}
+
+	work := make([]float64, n)
+	est := normEst1(n, func(x []float64, trans bool) {
+		solve(x, work, trans)
+	})
+
+	cond := norm * est
+	if math.IsNaN(cond) {
+		return math.Inf(1)
+	}
+	return cond
+}
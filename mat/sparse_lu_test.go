@@ -0,0 +1,163 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// randomSparse returns a random n×n sparse matrix with approximately the
+// given density of off-diagonal elements and a diagonal scaled by diag.
+func randomSparse(rnd *rand.Rand, n int, density, diag float64) *CSR {
+	var ii, jj []int
+	var vv []float64
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i == j {
+				ii = append(ii, i)
+				jj = append(jj, j)
+				vv = append(vv, diag*(1+rnd.Float64()))
+				continue
+			}
+			if rnd.Float64() >= density {
+				continue
+			}
+			ii = append(ii, i)
+			jj = append(jj, j)
+			vv = append(vv, rnd.NormFloat64())
+		}
+	}
+	return NewCSRFromTriplets(n, n, ii, jj, vv)
+}
+
+func TestSparseLU(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		name string
+		a    *CSR
+	}{
+		{name: "1×1", a: NewCSR(1, 1, []int{0, 1}, []int{0}, []float64{-3})},
+		{name: "permutation", a: NewCSR(3, 3, []int{0, 1, 2, 3}, []int{2, 0, 1}, []float64{1, 2, 3})},
+		{name: "zero diagonal", a: NewCSR(3, 3, []int{0, 2, 4, 6}, []int{1, 2, 0, 2, 0, 1}, []float64{1, 2, 3, 4, 5, 6})},
+		{name: "laplacian 8", a: gridLaplacian(8, 0)},
+		{name: "random 30 dominant", a: randomSparse(rnd, 30, 0.1, 5)},
+		{name: "random 50 weak diagonal", a: randomSparse(rnd, 50, 0.1, 0.01)},
+		{name: "random 80", a: randomSparse(rnd, 80, 0.05, 1)},
+	} {
+		n, _ := test.a.Dims()
+		dense := DenseCopyOf(test.a)
+
+		var lu SparseLU
+		if !lu.Factorize(test.a) {
+			t.Errorf("%s: unexpected factorization failure", test.name)
+			continue
+		}
+		var dlu LU
+		dlu.Factorize(dense)
+
+		gotDet, gotSign := lu.LogDet()
+		wantDet, wantSign := dlu.LogDet()
+		if !scalar.EqualWithinAbsOrRel(gotDet, wantDet, tol, tol) || gotSign != wantSign {
+			t.Errorf("%s: unexpected LogDet: got:(%v, %v) want:(%v, %v)", test.name, gotDet, gotSign, wantDet, wantSign)
+		}
+		if got, want := lu.Cond(), dlu.Cond(); got < want/10 || got > 10*want {
+			t.Errorf("%s: unexpected condition estimate: got:%v want:%v", test.name, got, want)
+		}
+
+		for _, trans := range []bool{false, true} {
+			for _, nrhs := range []int{1, 3} {
+				b := NewDense(n, nrhs, nil)
+				for i := 0; i < n; i++ {
+					for j := 0; j < nrhs; j++ {
+						b.Set(i, j, rnd.NormFloat64())
+					}
+				}
+				var got, want Dense
+				err := lu.SolveTo(&got, trans, b)
+				if err != nil {
+					t.Errorf("%s: unexpected error from SolveTo: %v", test.name, err)
+				}
+				dlu.SolveTo(&want, trans, b)
+				if !EqualApprox(&got, &want, tol) {
+					t.Errorf("%s trans=%t nrhs=%d: unexpected solution:\ngot: %v\nwant:%v",
+						test.name, trans, nrhs, Formatted(&got), Formatted(&want))
+				}
+
+				// Check the residual.
+				var r Dense
+				if trans {
+					r.Mul(dense.T(), &got)
+				} else {
+					r.Mul(dense, &got)
+				}
+				if !EqualApprox(&r, b, tol) {
+					t.Errorf("%s trans=%t nrhs=%d: unexpected residual", test.name, trans, nrhs)
+				}
+			}
+
+			b := NewVecDense(n, nil)
+			for i := 0; i < n; i++ {
+				b.SetVec(i, rnd.NormFloat64())
+			}
+			var got, want VecDense
+			lu.SolveVecTo(&got, trans, b)
+			dlu.SolveVecTo(&want, trans, b)
+			if !EqualApprox(&got, &want, tol) {
+				t.Errorf("%s trans=%t: unexpected vector solution:\ngot: %v\nwant:%v",
+					test.name, trans, Formatted(&got), Formatted(&want))
+			}
+			lu.SolveVecTo(b, trans, b)
+			if !EqualApprox(b, &want, tol) {
+				t.Errorf("%s trans=%t: unexpected vector solution with aliased vectors", test.name, trans)
+			}
+		}
+	}
+}
+
+func TestSparseLUSingular(t *testing.T) {
+	t.Parallel()
+	for i, a := range []*CSR{
+		NewCSR(2, 2, nil, nil, nil),
+		NewCSR(2, 2, []int{0, 2, 4}, []int{0, 1, 0, 1}, []float64{1, 2, 2, 4}),
+		NewCSR(3, 3, []int{0, 2, 3, 4}, []int{0, 1, 0, 1}, []float64{1, 2, 3, 4}),
+	} {
+		var lu SparseLU
+		if lu.Factorize(a) {
+			t.Errorf("case %d: expected factorization failure", i)
+		}
+		panicked, message := panics(func() { lu.SolveVecTo(&VecDense{}, false, NewVecDense(2, nil)) })
+		if !panicked || message != badSparseLU {
+			t.Errorf("case %d: expected panic for invalid factorization", i)
+		}
+	}
+}
+
+func TestPermSign(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for n := 1; n <= 6; n++ {
+		perm := rnd.Perm(n)
+		p := NewDense(n, n, nil)
+		for i, j := range perm {
+			p.Set(i, j, 1)
+		}
+		if got, want := permSign(perm), Det(p); got != want {
+			t.Errorf("unexpected sign of %v: got:%v want:%v", perm, got, want)
+		}
+	}
+	if got := permSign([]int{1, 0}); got != -1 {
+		t.Errorf("unexpected sign of transposition: got:%v", got)
+	}
+	if got := permSign([]int{1, 2, 0}); math.Abs(got-1) != 0 {
+		t.Errorf("unexpected sign of 3-cycle: got:%v", got)
+	}
+}
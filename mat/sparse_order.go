@@ -0,0 +1,106 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import "container/heap"
+
+// symmetricPattern returns the adjacency lists of the graph of the pattern
+// of A+Aᵀ for the n×n sparse matrix a, excluding the diagonal.
+func symmetricPattern(a *CSR) []map[int]struct{} {
+	n, _ := a.Dims()
+	adj := make([]map[int]struct{}, n)
+	for i := range adj {
+		adj[i] = make(map[int]struct{})
+	}
+	for i := 0; i < n; i++ {
+		for k := a.indptr[i]; k < a.indptr[i+1]; k++ {
+			j := a.ind[k]
+			if i == j {
+				continue
+			}
+			adj[i][j] = struct{}{}
+			adj[j][i] = struct{}{}
+		}
+	}
+	return adj
+}
+
+// minimumDegree returns a fill-reducing ordering of the symmetric sparsity
+// pattern described by the adjacency lists adj, which are destroyed. The
+// returned perm holds the node eliminated at each step. Ties between nodes
+// of equal degree are broken by the lower index so the ordering is
+// deterministic.
+//
+// The ordering is computed by the minimum degree algorithm on the explicit
+// elimination graph, which requires work proportional to the number of
+// operations of the symbolic factorization.
+//
+// See A. George and J. W. H. Liu, "The evolution of the minimum degree
+// ordering algorithm", SIAM Review 31(1), 1989, for details.
+func minimumDegree(adj []map[int]struct{}) []int {
+	n := len(adj)
+	h := make(degreeHeap, n)
+	for i := range h {
+		h[i] = degreeNode{node: i, degree: len(adj[i])}
+	}
+	heap.Init(&h)
+
+	eliminated := make([]bool, n)
+	perm := make([]int, 0, n)
+	nbrs := make([]int, 0, n)
+	for h.Len() != 0 {
+		top := heap.Pop(&h).(degreeNode)
+		p := top.node
+		if eliminated[p] || top.degree != len(adj[p]) {
+			// Stale entry.
+			continue
+		}
+		eliminated[p] = true
+		perm = append(perm, p)
+
+		// Form the clique of the neighbours of p.
+		nbrs = nbrs[:0]
+		for u := range adj[p] {
+			nbrs = append(nbrs, u)
+		}
+		for _, u := range nbrs {
+			delete(adj[u], p)
+			for _, v := range nbrs {
+				if u != v {
+					adj[u][v] = struct{}{}
+				}
+			}
+			heap.Push(&h, degreeNode{node: u, degree: len(adj[u])})
+		}
+		adj[p] = nil
+	}
+	return perm
+}
+
+// degreeNode is a node and its degree in the elimination graph.
+type degreeNode struct {
+	node   int
+	degree int
+}
+
+// degreeHeap is a min-heap of nodes ordered by degree and then index.
+type degreeHeap []degreeNode
+
+func (h degreeHeap) Len() int { return len(h) }
+func (h degreeHeap) Less(i, j int) bool {
+	if h[i].degree == h[j].degree {
+		return h[i].node < h[j].node
+	}
+	return h[i].degree < h[j].degree
+}
+func (h degreeHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *degreeHeap) Push(x interface{}) { *h = append(*h, x.(degreeNode)) }
+func (h *degreeHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
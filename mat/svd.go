@@ -77,11 +77,20 @@ func (svd *SVD) succFact() bool {
 // Factorize returns whether the decomposition succeeded. If the decomposition
 // failed, routines that require a successful factorization will panic.
 func (svd *SVD) Factorize(a Matrix, kind SVDKind) (ok bool) {
+	return svd.FactorizeWith(a, kind, nil)
+}
+
+// FactorizeWith computes the singular value decomposition of A as Factorize
+// does, taking temporary storage, including the copy of A that is destroyed
+// during the decomposition, from ws. If ws is nil, FactorizeWith is
+// equivalent to Factorize.
+func (svd *SVD) FactorizeWith(a Matrix, kind SVDKind, ws *Workspace) (ok bool) {
 	// kill previous factorization
 	svd.s = svd.s[:0]
 	svd.kind = kind
 
 	m, n := a.Dims()
+	ws.reset()
 	var jobU, jobVT lapack.SVDJob
 
 	// TODO(btracey): This code should be modified to have the smaller
@@ -129,15 +138,21 @@ func (svd *SVD) Factorize(a Matrix, kind SVDKind) (ok bool) {
 	}
 
 	// A is destroyed on call, so copy the matrix.
-	aCopy := DenseCopyOf(a)
+	var aCopy *Dense
+	if ws == nil {
+		aCopy = DenseCopyOf(a)
+	} else {
+		aCopy = &Dense{mat: ws.general(m, n), capRows: m, capCols: n}
+		aCopy.Copy(a)
+	}
 	svd.kind = kind
 	svd.s = use(svd.s, min(m, n))
 
 	work := []float64{0}
 	lapack64.Gesvd(jobU, jobVT, aCopy.mat, svd.u, svd.vt, svd.s, work, -1)
-	work = getFloat64s(int(work[0]), false)
+	work = ws.float64s(int(work[0]))
 	ok = lapack64.Gesvd(jobU, jobVT, aCopy.mat, svd.u, svd.vt, svd.s, work, len(work))
-	putFloat64s(work)
+	ws.putFloat64s(work)
 	if !ok {
 		svd.kind = 0
 	}
@@ -423,3 +438,77 @@ func (svd *SVD) SolveVecTo(dst *VecDense, b Vector, rank int) float64 {
 	}
 	return res
 }
+
+// PinvTo computes the Moore–Penrose pseudoinverse of the m×n matrix A from
+// the singular value decomposition stored in the receiver and stores the n×m
+// result into dst,
+//
+//	A⁺ = V * Σ⁺ * Uᵀ
+//
+// where Σ⁺ holds the reciprocals of the singular values of A that are greater
+// than rcond scaled by the largest singular value, and zero in place of the
+// others. Singular values at or below the cutoff are treated as exactly zero,
+// so that the pseudoinverse of a numerically rank-deficient matrix is not
+// dominated by the reciprocals of small singular values arising from rounding
+// errors. The effective rank used is the value returned by SVD.Rank(rcond).
+// If rcond is zero, the default cutoff max(m,n)·ε is used, where ε = 2⁻⁵² is
+// the spacing of float64 values at one, as for Dense.SolveMinNorm.
+//
+// If dst is empty, PinvTo will resize dst to be n×m. When dst is non-empty,
+// PinvTo will panic if dst is not n×m. PinvTo will also panic if rcond is
+// negative, if the receiver does not contain a successful factorization, or
+// if U and V were not computed during factorization.
+func (svd *SVD) PinvTo(dst *Dense, rcond float64) {
+	if rcond < 0 {
+		panic(badRcond)
+	}
+	if !svd.succFact() {
+		panic(badFact)
+	}
+	kind := svd.kind
+	if kind&SVDThinU == 0 && kind&SVDFullU == 0 {
+		panic("svd: u not computed during factorization")
+	}
+	if kind&SVDThinV == 0 && kind&SVDFullV == 0 {
+		panic("svd: v not computed during factorization")
+	}
+	m := svd.u.Rows
+	n := svd.vt.Cols
+	if dst.IsEmpty() {
+		dst.ReuseAs(n, m)
+	} else {
+		r, c := dst.Dims()
+		if r != n || c != m {
+			panic(ErrShape)
+		}
+	}
+	if rcond == 0 {
+		rcond = defaultRankTol(m, n)
+	}
+	rank := svd.Rank(rcond)
+	if rank == 0 {
+		dst.Zero()
+		return
+	}
+
+	u := Dense{
+		mat:     svd.u,
+		capRows: svd.u.Rows,
+		capCols: svd.u.Cols,
+	}
+	vt := Dense{
+		mat:     svd.vt,
+		capRows: svd.vt.Rows,
+		capCols: svd.vt.Cols,
+	}
+
+	// Scale the leading rank rows of Vᵀ by the reciprocal singular values
+	// to form Σ⁺ * Vᵀ.
+	svt := getDenseWorkspace(rank, n, false)
+	defer putDenseWorkspace(svt)
+	svt.Copy(vt.slice(0, rank, 0, n))
+	for i, s := range svd.s[:rank] {
+		blas64.Scal(1/s, blas64.Vector{N: n, Inc: 1, Data: svt.mat.Data[i*svt.mat.Stride:]})
+	}
+	dst.Mul(svt.T(), u.slice(0, m, 0, rank).T())
+}
@@ -0,0 +1,333 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+const badPartialSVD = "mat: invalid partial SVD factorization"
+
+// mulVecToer is a matrix that can compute its product with a vector
+// more efficiently than through its At method.
+type mulVecToer interface {
+	MulVecTo(dst *VecDense, trans bool, x Vector)
+}
+
+// PartialSVD is a type for computing the k largest singular values and the
+// corresponding singular vectors of a matrix without forming its full
+// singular value decomposition.
+//
+// The partial SVD of an m×n matrix A is the set of singular triplets
+//
+//	A * v_i = σ_i * u_i,  Aᵀ * u_i = σ_i * v_i,  i = 0, ..., k-1
+//
+// for the k largest singular values σ_0 ≥ σ_1 ≥ ... ≥ σ_{k-1}.
+//
+// The triplets are computed by Golub–Kahan–Lanczos bidiagonalization with
+// full reorthogonalization. A is only accessed through products with A and
+// Aᵀ. If A implements
+//
+//	MulVecTo(dst *VecDense, trans bool, x Vector)
+//
+// that method is used to compute the products, otherwise VecDense.MulVec is
+// used. This makes PartialSVD suitable for large sparse or implicitly
+// represented matrices.
+type PartialSVD struct {
+	s []float64
+	u *Dense
+	v *Dense
+}
+
+// Factorize computes the k largest singular triplets of the m×n matrix a and
+// returns whether the computation converged.
+//
+// A triplet is considered converged when the residual ‖Aᵀ*u_i - σ_i*v_i‖ is
+// at most tol times the largest singular value. If tol is not positive,
+// a default tolerance of 1e-10 is used. The Krylov subspace is extended until
+// all k triplets have converged or it spans min(m,n) dimensions.
+//
+// Factorize will panic if k is not positive or is greater than min(m,n).
+func (svd *PartialSVD) Factorize(a Matrix, k int, tol float64) (ok bool) {
+	svd.s = svd.s[:0]
+
+	m, n := a.Dims()
+	minmn := min(m, n)
+	if k <= 0 || minmn < k {
+		panic(ErrIndexOutOfRange)
+	}
+	if tol <= 0 {
+		tol = 1e-10
+	}
+
+	// Bidiagonalize the operator with the smaller column
+	// dimension so that the right Krylov basis can span
+	// the full space and the process terminates.
+	swap := m < n
+	if swap {
+		m, n = n, m
+	}
+	op, isOp := a.(mulVecToer)
+	mul := func(dst []float64, trans bool, x []float64) {
+		if swap {
+			trans = !trans
+		}
+		d := NewVecDense(len(dst), dst)
+		xv := NewVecDense(len(x), x)
+		if isOp {
+			op.MulVecTo(d, trans, xv)
+			return
+		}
+		if trans {
+			d.MulVec(a.T(), xv)
+		} else {
+			d.MulVec(a, xv)
+		}
+	}
+
+	// A deterministic source is used so that
+	// factorizations are reproducible.
+	rnd := rand.New(rand.NewSource(1))
+
+	// The bidiagonalization satisfies
+	//  A * V_p = U_p * B_p
+	//  Aᵀ * U_p = V_p * B_pᵀ + β_p * v_{p+1} * e_pᵀ
+	// where B_p is upper bidiagonal with diagonal alpha
+	// and superdiagonal beta.
+	var (
+		us, vs      [][]float64
+		alpha, beta []float64
+	)
+	v := randomOrthogonal(n, vs, rnd)
+	vs = append(vs, v)
+
+	var (
+		small  SVD
+		x, y   Dense
+		target = min(minmn, max(2*k, k+10))
+		step   = max(k, 10)
+	)
+	for {
+		for len(us) < target {
+			j := len(us)
+			u := make([]float64, m)
+			mul(u, false, vs[j])
+			if j > 0 {
+				floats.AddScaled(u, -beta[j-1], us[j-1])
+			}
+			reorthogonalize(u, us)
+			aj := floats.Norm(u, 2)
+			if aj <= breakdownTol(alpha, beta) {
+				aj = 0
+				u = randomOrthogonal(m, us, rnd)
+			} else {
+				floats.Scale(1/aj, u)
+			}
+			us = append(us, u)
+			alpha = append(alpha, aj)
+
+			r := make([]float64, n)
+			mul(r, true, u)
+			floats.AddScaled(r, -aj, vs[j])
+			reorthogonalize(r, vs)
+			bj := floats.Norm(r, 2)
+			if bj <= breakdownTol(alpha, beta) || len(vs) == n {
+				bj = 0
+				if len(vs) < n {
+					r = randomOrthogonal(n, vs, rnd)
+				}
+			} else {
+				floats.Scale(1/bj, r)
+			}
+			beta = append(beta, bj)
+			if len(vs) < n {
+				vs = append(vs, r)
+			}
+		}
+
+		// Compute the SVD of the small bidiagonal matrix.
+		p := len(us)
+		bd := NewDense(p, p, nil)
+		for i := 0; i < p; i++ {
+			bd.set(i, i, alpha[i])
+			if i < p-1 {
+				bd.set(i, i+1, beta[i])
+			}
+		}
+		if !small.Factorize(bd, SVDThin) {
+			return false
+		}
+		x.Reset()
+		y.Reset()
+		small.UTo(&x)
+		small.VTo(&y)
+
+		// Check convergence of the leading k triplets using the
+		// residual β_p * |e_pᵀ * x_i|.
+		converged := true
+		thresh := tol * small.s[0]
+		for i := 0; i < k; i++ {
+			if beta[p-1]*math.Abs(x.at(p-1, i)) > thresh {
+				converged = false
+				break
+			}
+		}
+		if converged || p == minmn {
+			ok = converged || beta[p-1] == 0
+			break
+		}
+		target = min(minmn, p+step)
+	}
+
+	// Form the Ritz vectors.
+	p := len(us)
+	left := NewDense(m, k, nil)
+	right := NewDense(n, k, nil)
+	for i := 0; i < k; i++ {
+		for j := 0; j < p; j++ {
+			xj := x.at(j, i)
+			for r, uv := range us[j] {
+				left.mat.Data[r*left.mat.Stride+i] += xj * uv
+			}
+			yj := y.at(j, i)
+			for r, vv := range vs[j] {
+				right.mat.Data[r*right.mat.Stride+i] += yj * vv
+			}
+		}
+	}
+	if swap {
+		left, right = right, left
+	}
+	svd.u = left
+	svd.v = right
+	svd.s = use(svd.s, k)
+	copy(svd.s, small.s[:k])
+	return ok
+}
+
+// reorthogonalize orthogonalizes x against the orthonormal vectors in basis
+// using two passes of classical Gram-Schmidt.
+func reorthogonalize(x []float64, basis [][]float64) {
+	for pass := 0; pass < 2; pass++ {
+		for _, q := range basis {
+			floats.AddScaled(x, -floats.Dot(x, q), q)
+		}
+	}
+}
+
+// randomOrthogonal returns a random unit vector of length n orthogonal to the
+// orthonormal vectors in basis, which must span less than n dimensions.
+func randomOrthogonal(n int, basis [][]float64, rnd *rand.Rand) []float64 {
+	x := make([]float64, n)
+	for {
+		for i := range x {
+			x[i] = rnd.NormFloat64()
+		}
+		reorthogonalize(x, basis)
+		norm := floats.Norm(x, 2)
+		if norm > 1e-8 {
+			floats.Scale(1/norm, x)
+			return x
+		}
+	}
+}
+
+// breakdownTol returns the magnitude below which a Lanczos coefficient is
+// treated as zero relative to the coefficients computed so far.
+func breakdownTol(alpha, beta []float64) float64 {
+	var scale float64
+	for _, v := range alpha {
+		scale = math.Max(scale, v)
+	}
+	for _, v := range beta {
+		scale = math.Max(scale, v)
+	}
+	return math.Sqrt(float64(len(alpha)+1)) * dlamchE * scale
+}
+
+// succFact returns whether the receiver contains a factorization.
+func (svd *PartialSVD) succFact() bool {
+	return len(svd.s) != 0
+}
+
+// Rank returns the number k of computed singular triplets.
+// Rank will panic if the receiver does not contain a factorization.
+func (svd *PartialSVD) Rank() int {
+	if !svd.succFact() {
+		panic(badPartialSVD)
+	}
+	return len(svd.s)
+}
+
+// Values returns the k largest singular values of the factorized matrix in
+// descending order.
+//
+// If the input slice is non-nil, the values will be stored in-place into
+// the slice. In this case, the slice must have length k, and Values will
+// panic with ErrSliceLengthMismatch otherwise. If the input slice is nil, a new
+// slice of the appropriate length will be allocated and returned.
+//
+// Values will panic if the receiver does not contain a factorization.
+func (svd *PartialSVD) Values(s []float64) []float64 {
+	if !svd.succFact() {
+		panic(badPartialSVD)
+	}
+	if s == nil {
+		s = make([]float64, len(svd.s))
+	}
+	if len(s) != len(svd.s) {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(s, svd.s)
+	return s
+}
+
+// UTo extracts the m×k matrix U of left singular vectors corresponding to the
+// singular values returned from PartialSVD.Values.
+//
+// If dst is empty, UTo will resize dst to be m×k. When dst is non-empty, UTo
+// will panic if dst is not m×k. UTo will also panic if the receiver does not
+// contain a factorization.
+func (svd *PartialSVD) UTo(dst *Dense) {
+	if !svd.succFact() {
+		panic(badPartialSVD)
+	}
+	r, c := svd.u.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || c != c2 {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(svd.u)
+}
+
+// VTo extracts the n×k matrix V of right singular vectors corresponding to the
+// singular values returned from PartialSVD.Values.
+//
+// If dst is empty, VTo will resize dst to be n×k. When dst is non-empty, VTo
+// will panic if dst is not n×k. VTo will also panic if the receiver does not
+// contain a factorization.
+func (svd *PartialSVD) VTo(dst *Dense) {
+	if !svd.succFact() {
+		panic(badPartialSVD)
+	}
+	r, c := svd.v.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || c != c2 {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(svd.v)
+}
@@ -0,0 +1,124 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestPartialSVD(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, k int
+		rank    int
+		sparse  bool
+	}{
+		{m: 1, n: 1, k: 1},
+		{m: 5, n: 5, k: 5},
+		{m: 50, n: 30, k: 5},
+		{m: 30, n: 50, k: 5},
+		{m: 100, n: 80, k: 10},
+		{m: 40, n: 40, k: 40},
+		{m: 60, n: 40, k: 8, rank: 3},
+		{m: 200, n: 150, k: 6, sparse: true},
+		{m: 150, n: 200, k: 6, sparse: true},
+	} {
+		m, n, k := test.m, test.n, test.k
+		var a Matrix
+		if test.sparse {
+			d := NewDense(m, n, nil)
+			for i := 0; i < m; i++ {
+				for j := 0; j < n; j++ {
+					if rnd.Float64() < 0.05 {
+						d.Set(i, j, rnd.NormFloat64())
+					}
+				}
+			}
+			var csr CSR
+			csr.CloneFrom(d)
+			a = &csr
+		} else if test.rank > 0 {
+			a = randLowRank(m, n, test.rank, rnd)
+		} else {
+			d := NewDense(m, n, nil)
+			for i := range d.mat.Data {
+				d.mat.Data[i] = rnd.NormFloat64()
+			}
+			a = d
+		}
+
+		var full SVD
+		if !full.Factorize(a, SVDNone) {
+			t.Fatalf("m=%d,n=%d: full SVD failed", m, n)
+		}
+		want := full.Values(nil)[:k]
+
+		var svd PartialSVD
+		if !svd.Factorize(a, k, 0) {
+			t.Errorf("m=%d,n=%d,k=%d: factorization did not converge", m, n, k)
+			continue
+		}
+		got := svd.Values(nil)
+		if !floats.EqualApprox(got, want, 1e-10*want[0]) {
+			t.Errorf("m=%d,n=%d,k=%d: unexpected singular values:\ngot: %v\nwant:%v", m, n, k, got, want)
+		}
+
+		var u, v Dense
+		svd.UTo(&u)
+		svd.VTo(&v)
+		if r, c := u.Dims(); r != m || c != k {
+			t.Fatalf("m=%d,n=%d,k=%d: unexpected U shape: %d×%d", m, n, k, r, c)
+		}
+		if r, c := v.Dims(); r != n || c != k {
+			t.Fatalf("m=%d,n=%d,k=%d: unexpected V shape: %d×%d", m, n, k, r, c)
+		}
+
+		// Check the singular triplets for the non-zero
+		// singular values, the vectors of zero singular
+		// values are not unique.
+		for i := 0; i < k; i++ {
+			if got[i] <= 1e-8*got[0] {
+				continue
+			}
+			var av, atu VecDense
+			av.MulVec(a, v.ColView(i))
+			atu.MulVec(a.T(), u.ColView(i))
+			av.AddScaledVec(&av, -got[i], u.ColView(i))
+			atu.AddScaledVec(&atu, -got[i], v.ColView(i))
+			if res := math.Max(av.Norm(2), atu.Norm(2)); res > 1e-8*got[0] {
+				t.Errorf("m=%d,n=%d,k=%d: large residual for triplet %d: %v", m, n, k, i, res)
+			}
+		}
+		var utu, vtv Dense
+		utu.Mul(u.T(), &u)
+		vtv.Mul(v.T(), &v)
+		eye := NewDiagDense(k, nil)
+		for i := 0; i < k; i++ {
+			eye.SetDiag(i, 1)
+		}
+		if !EqualApprox(&utu, eye, 1e-10) {
+			t.Errorf("m=%d,n=%d,k=%d: U does not have orthonormal columns", m, n, k)
+		}
+		if !EqualApprox(&vtv, eye, 1e-10) {
+			t.Errorf("m=%d,n=%d,k=%d: V does not have orthonormal columns", m, n, k)
+		}
+	}
+
+	for _, k := range []int{0, 11} {
+		panicked, _ := panics(func() {
+			var svd PartialSVD
+			svd.Factorize(NewDense(10, 20, nil), k, 0)
+		})
+		if !panicked {
+			t.Errorf("expected panic for k=%d", k)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/lapack/lapack64"
+)
+
+const badRandomizedSVD = "mat: invalid randomized SVD factorization"
+
+// RandomizedSVD is a type for computing an approximate truncated singular value
+// decomposition of a matrix using randomized range finding.
+//
+// The randomized SVD of rank k of an m×n matrix A is the factorization
+//
+//	A ≈ U * Σ * Vᵀ
+//
+// where U is an m×k matrix with orthonormal columns, Σ is a k×k diagonal matrix
+// of approximations to the k largest singular values of A and V is an n×k matrix
+// with orthonormal columns. The approximation is accurate when the singular
+// values of A beyond the kth decay rapidly.
+//
+// See https://doi.org/10.1137/090771806 for details of the algorithm.
+type RandomizedSVD struct {
+	s []float64
+	u *Dense
+	v *Dense
+}
+
+// Factorize computes an approximate rank k singular value decomposition of the
+// m×n matrix a and returns whether the decomposition succeeded.
+//
+// The range of A is sampled by multiplying A with an n×(k+oversample) random
+// Gaussian matrix, and the estimate of the range is refined by power
+// iterations each of which multiplies the sample by A*Aᵀ. If oversample is
+// negative, a value of 10 is used and if power is negative, a value of 2 is
+// used. Larger values improve the accuracy of the approximation at an increased
+// computational cost. The number of samples is limited to min(m,n).
+//
+// A is only accessed through matrix products with A and Aᵀ, so the cost of the
+// factorization is dominated by O((power+1)*(k+oversample)) matrix-vector
+// products rather than the O(m*n*min(m,n)) cost of a full SVD.
+//
+// If src is nil, the global random source is used to generate the random
+// samples.
+//
+// Factorize will panic if k is not positive or is greater than min(m,n).
+func (svd *RandomizedSVD) Factorize(a Matrix, k, oversample, power int, src rand.Source) (ok bool) {
+	svd.s = svd.s[:0]
+
+	m, n := a.Dims()
+	if k <= 0 || min(m, n) < k {
+		panic(ErrIndexOutOfRange)
+	}
+	if oversample < 0 {
+		oversample = 10
+	}
+	if power < 0 {
+		power = 2
+	}
+	l := min(k+oversample, m, n)
+
+	norm := rand.NormFloat64
+	if src != nil {
+		norm = rand.New(src).NormFloat64
+	}
+	omega := NewDense(n, l, nil)
+	for i := range omega.mat.Data {
+		omega.mat.Data[i] = norm()
+	}
+
+	// Find an orthonormal basis Q for the range of A
+	// using subspace iteration.
+	q := NewDense(m, l, nil)
+	q.Mul(a, omega)
+	orthonormalize(q)
+	z := omega
+	for i := 0; i < power; i++ {
+		z.Mul(a.T(), q)
+		orthonormalize(z)
+		q.Mul(a, z)
+		orthonormalize(q)
+	}
+
+	// Compute the SVD of the small matrix B = Qᵀ * A
+	// and project the left singular vectors back.
+	var b Dense
+	b.Mul(q.T(), a)
+	var small SVD
+	if !small.Factorize(&b, SVDThin) {
+		return false
+	}
+	var ub, vb Dense
+	small.UTo(&ub)
+	small.VTo(&vb)
+
+	svd.s = use(svd.s, k)
+	copy(svd.s, small.s[:k])
+	if svd.u == nil {
+		svd.u = &Dense{}
+	} else {
+		svd.u.Reset()
+	}
+	svd.u.Mul(q, ub.slice(0, l, 0, k))
+	if svd.v == nil {
+		svd.v = &Dense{}
+	} else {
+		svd.v.Reset()
+	}
+	svd.v.CloneFrom(vb.slice(0, n, 0, k))
+	return true
+}
+
+// orthonormalize replaces the columns of the m×n matrix a, m ≥ n, with an
+// orthonormal basis for their span computed by a QR factorization.
+func orthonormalize(a *Dense) {
+	_, n := a.Dims()
+	tau := getFloat64s(n, false)
+	work := []float64{0}
+	lapack64.Geqrf(a.mat, tau, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Geqrf(a.mat, tau, work, len(work))
+	putFloat64s(work)
+	work = []float64{0}
+	lapack64.Orgqr(a.mat, tau, work, -1)
+	work = getFloat64s(int(work[0]), false)
+	lapack64.Orgqr(a.mat, tau, work, len(work))
+	putFloat64s(work)
+	putFloat64s(tau)
+}
+
+// succFact returns whether the receiver contains a successful factorization.
+func (svd *RandomizedSVD) succFact() bool {
+	return len(svd.s) != 0
+}
+
+// Rank returns the rank k of the approximate decomposition.
+// Rank will panic if the receiver does not contain a successful factorization.
+func (svd *RandomizedSVD) Rank() int {
+	if !svd.succFact() {
+		panic(badRandomizedSVD)
+	}
+	return len(svd.s)
+}
+
+// Values returns the approximate k largest singular values of the factorized
+// matrix in descending order.
+//
+// If the input slice is non-nil, the values will be stored in-place into
+// the slice. In this case, the slice must have length k, and Values will
+// panic with ErrSliceLengthMismatch otherwise. If the input slice is nil, a new
+// slice of the appropriate length will be allocated and returned.
+//
+// Values will panic if the receiver does not contain a successful factorization.
+func (svd *RandomizedSVD) Values(s []float64) []float64 {
+	if !svd.succFact() {
+		panic(badRandomizedSVD)
+	}
+	if s == nil {
+		s = make([]float64, len(svd.s))
+	}
+	if len(s) != len(svd.s) {
+		panic(ErrSliceLengthMismatch)
+	}
+	copy(s, svd.s)
+	return s
+}
+
+// UTo extracts the m×k matrix U of approximate left singular vectors
+// corresponding to the singular values returned from RandomizedSVD.Values.
+//
+// If dst is empty, UTo will resize dst to be m×k. When dst is non-empty, UTo
+// will panic if dst is not m×k. UTo will also panic if the receiver does not
+// contain a successful factorization.
+func (svd *RandomizedSVD) UTo(dst *Dense) {
+	if !svd.succFact() {
+		panic(badRandomizedSVD)
+	}
+	r, c := svd.u.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || c != c2 {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(svd.u)
+}
+
+// VTo extracts the n×k matrix V of approximate right singular vectors
+// corresponding to the singular values returned from RandomizedSVD.Values.
+//
+// If dst is empty, VTo will resize dst to be n×k. When dst is non-empty, VTo
+// will panic if dst is not n×k. VTo will also panic if the receiver does not
+// contain a successful factorization.
+func (svd *RandomizedSVD) VTo(dst *Dense) {
+	if !svd.succFact() {
+		panic(badRandomizedSVD)
+	}
+	r, c := svd.v.Dims()
+	if dst.IsEmpty() {
+		dst.ReuseAs(r, c)
+	} else {
+		r2, c2 := dst.Dims()
+		if r != r2 || c != c2 {
+			panic(ErrShape)
+		}
+	}
+	dst.Copy(svd.v)
+}
@@ -0,0 +1,141 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+// randSpectrum returns a random m×n matrix with the given singular values.
+func randSpectrum(m, n int, s []float64, rnd *rand.Rand) *Dense {
+	k := len(s)
+	u := NewDense(m, k, nil)
+	for i := range u.mat.Data {
+		u.mat.Data[i] = rnd.NormFloat64()
+	}
+	orthonormalize(u)
+	v := NewDense(n, k, nil)
+	for i := range v.mat.Data {
+		v.mat.Data[i] = rnd.NormFloat64()
+	}
+	orthonormalize(v)
+	for j, sv := range s {
+		for i := 0; i < m; i++ {
+			u.Set(i, j, u.At(i, j)*sv)
+		}
+	}
+	var a Dense
+	a.Mul(u, v.T())
+	return &a
+}
+
+func TestRandomizedSVD(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, k    int
+		oversample int
+		power      int
+		decay      float64
+		tol        float64
+	}{
+		// Exactly low rank matrices are recovered exactly.
+		{m: 50, n: 30, k: 5, oversample: 0, power: 0, decay: 0, tol: 1e-10},
+		{m: 30, n: 50, k: 5, oversample: 0, power: 0, decay: 0, tol: 1e-10},
+
+		// Matrices with rapidly decaying spectra are well approximated.
+		{m: 100, n: 60, k: 10, oversample: -1, power: -1, decay: 0.5, tol: 1e-8},
+		{m: 60, n: 100, k: 10, oversample: 5, power: 4, decay: 0.5, tol: 1e-8},
+		{m: 40, n: 40, k: 40, oversample: -1, power: 0, decay: 0.9, tol: 1e-10},
+	} {
+		m, n, k := test.m, test.n, test.k
+		// Construct singular values that are exactly
+		// rank k when decay is zero, and otherwise
+		// decay geometrically after the first k.
+		s := make([]float64, min(m, n))
+		for i := range s {
+			switch {
+			case i < k:
+				s[i] = float64(k - i)
+			case test.decay != 0:
+				s[i] = s[i-1] * test.decay
+			}
+		}
+		a := randSpectrum(m, n, s, rnd)
+
+		var svd RandomizedSVD
+		ok := svd.Factorize(a, k, test.oversample, test.power, rand.NewSource(1))
+		if !ok {
+			t.Errorf("m=%d,n=%d,k=%d: factorization failed", m, n, k)
+			continue
+		}
+		if svd.Rank() != k {
+			t.Errorf("m=%d,n=%d,k=%d: unexpected rank: got:%d", m, n, k, svd.Rank())
+		}
+
+		values := svd.Values(nil)
+		if !floats.EqualApprox(values, s[:k], test.tol*s[0]) {
+			t.Errorf("m=%d,n=%d,k=%d: unexpected singular values:\ngot: %v\nwant:%v", m, n, k, values, s[:k])
+		}
+
+		var u, v Dense
+		svd.UTo(&u)
+		svd.VTo(&v)
+		if r, c := u.Dims(); r != m || c != k {
+			t.Errorf("m=%d,n=%d,k=%d: unexpected U shape: %d×%d", m, n, k, r, c)
+		}
+		if r, c := v.Dims(); r != n || c != k {
+			t.Errorf("m=%d,n=%d,k=%d: unexpected V shape: %d×%d", m, n, k, r, c)
+		}
+		var utu, vtv Dense
+		utu.Mul(u.T(), &u)
+		vtv.Mul(v.T(), &v)
+		eye := NewDiagDense(k, nil)
+		for i := 0; i < k; i++ {
+			eye.SetDiag(i, 1)
+		}
+		if !EqualApprox(&utu, eye, 1e-12) {
+			t.Errorf("m=%d,n=%d,k=%d: U does not have orthonormal columns", m, n, k)
+		}
+		if !EqualApprox(&vtv, eye, 1e-12) {
+			t.Errorf("m=%d,n=%d,k=%d: V does not have orthonormal columns", m, n, k)
+		}
+
+		// The approximation error is bounded below by the
+		// first discarded singular value, and is close to
+		// it for a good approximation.
+		var us, approx Dense
+		us.Mul(&u, NewDiagDense(k, values))
+		approx.Mul(&us, v.T())
+		approx.Sub(a, &approx)
+		var opt float64
+		if k < len(s) {
+			opt = s[k]
+		}
+		var svdErr SVD
+		if !svdErr.Factorize(&approx, SVDNone) {
+			t.Fatalf("m=%d,n=%d,k=%d: SVD of residual failed", m, n, k)
+		}
+		got := svdErr.Values(nil)[0]
+		if math.Abs(got-opt) > test.tol*s[0] {
+			t.Errorf("m=%d,n=%d,k=%d: unexpected approximation error: got:%v want:%v", m, n, k, got, opt)
+		}
+	}
+
+	for _, k := range []int{0, 11} {
+		panicked, _ := panics(func() {
+			var svd RandomizedSVD
+			svd.Factorize(NewDense(10, 20, nil), k, -1, -1, nil)
+		})
+		if !panicked {
+			t.Errorf("expected panic for k=%d", k)
+		}
+	}
+}
@@ -661,3 +661,137 @@ func TestSVDSolveVecTo(t *testing.T) {
 		}
 	}
 }
+
+func TestPinv(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	randGeneral := func(r, c int) *Dense {
+		a := NewDense(r, c, nil)
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				a.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		return a
+	}
+	for _, test := range []struct {
+		m, n, rank int
+	}{
+		{m: 1, n: 1, rank: 1},
+		{m: 4, n: 4, rank: 4},
+		{m: 7, n: 3, rank: 3},
+		{m: 3, n: 7, rank: 3},
+		{m: 6, n: 6, rank: 3},
+		{m: 8, n: 5, rank: 2},
+		{m: 5, n: 8, rank: 1},
+		{m: 4, n: 6, rank: 0},
+	} {
+		// Construct a matrix of the given rank, so that the
+		// rank-deficient cases have singular values at the level
+		// of rounding error.
+		a := NewDense(test.m, test.n, nil)
+		if test.rank > 0 {
+			a.Mul(randGeneral(test.m, test.rank), randGeneral(test.rank, test.n))
+		}
+
+		var pinv Dense
+		err := pinv.Pinv(a, 0)
+		if err != nil {
+			t.Errorf("unexpected error for m=%d n=%d rank=%d: %v", test.m, test.n, test.rank, err)
+			continue
+		}
+		if r, c := pinv.Dims(); r != test.n || c != test.m {
+			t.Errorf("unexpected dimensions for m=%d n=%d rank=%d: got %d×%d", test.m, test.n, test.rank, r, c)
+			continue
+		}
+
+		// Check the four Penrose conditions.
+		const tol = 1e-10
+		var apa, pap, ap, pa Dense
+		ap.Mul(a, &pinv)
+		pa.Mul(&pinv, a)
+		apa.Mul(&ap, a)
+		pap.Mul(&pa, &pinv)
+		if !EqualApprox(&apa, a, tol) {
+			t.Errorf("A*A⁺*A != A for m=%d n=%d rank=%d", test.m, test.n, test.rank)
+		}
+		if !EqualApprox(&pap, &pinv, tol) {
+			t.Errorf("A⁺*A*A⁺ != A⁺ for m=%d n=%d rank=%d", test.m, test.n, test.rank)
+		}
+		if !EqualApprox(&ap, ap.T(), tol) {
+			t.Errorf("A*A⁺ not symmetric for m=%d n=%d rank=%d", test.m, test.n, test.rank)
+		}
+		if !EqualApprox(&pa, pa.T(), tol) {
+			t.Errorf("A⁺*A not symmetric for m=%d n=%d rank=%d", test.m, test.n, test.rank)
+		}
+
+		if test.rank == test.m && test.m == test.n {
+			var inv Dense
+			err := inv.Inverse(a)
+			if err != nil {
+				t.Fatalf("unexpected error from Inverse: %v", err)
+			}
+			if !EqualApprox(&pinv, &inv, tol) {
+				t.Errorf("pseudoinverse does not match inverse for m=%d n=%d", test.m, test.n)
+			}
+		}
+
+		// The pseudoinverse from a full factorization must agree.
+		var svd SVD
+		if !svd.Factorize(a, SVDFull) {
+			t.Fatal("SVD factorization failed")
+		}
+		var full Dense
+		svd.PinvTo(&full, 0)
+		if !EqualApprox(&full, &pinv, tol) {
+			t.Errorf("PinvTo with full SVD mismatch for m=%d n=%d rank=%d", test.m, test.n, test.rank)
+		}
+	}
+}
+
+func TestPinvCutoff(t *testing.T) {
+	t.Parallel()
+	a := NewDiagDense(3, []float64{1, 1e-3, 1e-9})
+	for _, test := range []struct {
+		rcond float64
+		want  []float64
+	}{
+		{rcond: 0, want: []float64{1, 1e3, 1e9}},
+		{rcond: 1e-6, want: []float64{1, 1e3, 0}},
+		{rcond: 1e-2, want: []float64{1, 0, 0}},
+	} {
+		var pinv Dense
+		err := pinv.Pinv(a, test.rcond)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := NewDiagDense(3, test.want)
+		if !EqualApprox(&pinv, want, 1e-12*test.want[len(test.want)-1]+1e-12) {
+			t.Errorf("unexpected pseudoinverse for rcond=%v:\ngot  %v\nwant %v",
+				test.rcond, Formatted(&pinv), Formatted(want))
+		}
+	}
+}
+
+func TestPinvPanics(t *testing.T) {
+	t.Parallel()
+	a := NewDense(3, 2, []float64{1, 2, 3, 4, 5, 6})
+	var svd SVD
+	if !svd.Factorize(a, SVDThin) {
+		t.Fatal("SVD factorization failed")
+	}
+	panicked, message := panics(func() { svd.PinvTo(NewDense(3, 2, nil), 0) })
+	if !panicked || message != ErrShape.Error() {
+		t.Errorf("expected panic %q for bad shape, got %q", ErrShape.Error(), message)
+	}
+	panicked, message = panics(func() { svd.PinvTo(&Dense{}, -1) })
+	if !panicked || message != badRcond {
+		t.Errorf("expected panic %q for negative rcond, got %q", badRcond, message)
+	}
+	var none SVD
+	none.Factorize(a, SVDNone)
+	panicked, _ = panics(func() { none.PinvTo(&Dense{}, 0) })
+	if !panicked {
+		t.Error("expected panic for missing singular vectors")
+	}
+}
@@ -5,6 +5,8 @@
 package mat
 
 import (
+	"math"
+
 	"gonum.org/v1/gonum/blas"
 	"gonum.org/v1/gonum/blas/blas64"
 	"gonum.org/v1/gonum/lapack"
@@ -253,6 +255,7 @@ func (s *SymBandDense) DoColNonZero(j int, fn func(i, j int, v float64)) {
 //	1 - The maximum absolute column sum
 //	2 - The Frobenius norm, the square root of the sum of the squares of the elements
 //	Inf - The maximum absolute row sum
+//	MaxAbsNorm - The maximum absolute value of the elements
 //
 // Norm will panic with ErrNormOrder if an illegal norm is specified and with
 // ErrZeroLength if the matrix has zero size.
@@ -310,3 +313,89 @@ func (s *SymBandDense) MulVecTo(dst *VecDense, _ bool, x Vector) {
 		putVecDenseWorkspace(xCopy)
 	}
 }
+
+// SolveTo solves the system A⋅X = B where A is an n×n symmetric band matrix
+// represented by the receiver and B is a given n×nrhs matrix. If A is positive
+// definite, the system is solved using a band Cholesky factorization, otherwise
+// it is solved using a band LU factorization with partial pivoting. In either
+// case the band structure of A is preserved, so the cost is O(n⋅k²) rather than
+// O(n³).
+//
+// If A is non-singular, the result will be stored into dst and nil will be
+// returned. If A is singular, the contents of dst will be undefined and a
+// Condition error will be returned. If A is positive definite but
+// near-singular, a Condition error is returned with the solution stored into
+// dst. See the documentation for Condition for more information.
+func (s *SymBandDense) SolveTo(dst *Dense, b Matrix) error {
+	n, nrhs := b.Dims()
+	if n != s.mat.N {
+		panic(ErrShape)
+	}
+
+	dst.reuseAsNonZeroed(n, nrhs)
+	bU, bTrans := untranspose(b)
+	if dst == bU {
+		if bTrans {
+			work := getDenseWorkspace(n, nrhs, false)
+			defer putDenseWorkspace(work)
+			work.Copy(b)
+			dst.Copy(work)
+		}
+	} else {
+		if rm, ok := bU.(RawMatrixer); ok {
+			dst.checkOverlap(rm.RawMatrix())
+		}
+		dst.Copy(b)
+	}
+
+	return s.solve(dst.mat)
+}
+
+// SolveVecTo solves the system A⋅x = b where A is an n×n symmetric band matrix
+// represented by the receiver and b is a given n-vector. The system is solved
+// as described for SolveTo. If A is non-singular, the result will be stored
+// into dst and nil will be returned. If A is singular, the contents of dst will
+// be undefined and a Condition error will be returned. If A is positive
+// definite but near-singular, a Condition error is returned with the solution
+// stored into dst.
+func (s *SymBandDense) SolveVecTo(dst *VecDense, b Vector) error {
+	n, nrhs := b.Dims()
+	if n != s.mat.N || nrhs != 1 {
+		panic(ErrShape)
+	}
+	if b, ok := b.(RawVectorer); ok && dst != b {
+		dst.checkOverlap(b.RawVector())
+	}
+	dst.reuseAsNonZeroed(n)
+	if dst != b {
+		dst.CopyVec(b)
+	}
+	return s.solve(dst.asGeneral())
+}
+
+// solve overwrites x with the solution of A⋅X = B where A is the receiver.
+func (s *SymBandDense) solve(x blas64.General) error {
+	var ch BandCholesky
+	if ch.Factorize(s) {
+		lapack64.Pbtrs(ch.chol.mat, x)
+		if ch.cond > ConditionTolerance {
+			return Condition(ch.cond)
+		}
+		return nil
+	}
+
+	// A is not positive definite, so fall back to
+	// an LU factorization of the full band.
+	n, k := s.mat.N, s.mat.K
+	lu := newBandLUWorkspace(n, k, k)
+	defer putFloat64s(lu.Data)
+	for i := 0; i < n; i++ {
+		for j := max(0, i-k); j <= min(n-1, i+k); j++ {
+			lu.Data[i*lu.Stride+k+j-i] = s.at(i, j)
+		}
+	}
+	if !solveBandLU(false, lu, x) {
+		return Condition(math.Inf(1))
+	}
+	return nil
+}
@@ -5,9 +5,12 @@
 package mat
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
+	"golang.org/x/exp/rand"
+
 	"gonum.org/v1/gonum/blas"
 	"gonum.org/v1/gonum/blas/blas64"
 )
@@ -234,3 +237,69 @@ func TestSymBandDenseZero(t *testing.T) {
 		}
 	}
 }
+
+func TestSymBandDenseSolveTo(t *testing.T) {
+	t.Parallel()
+
+	const tol = 1e-14
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 5, 10, 31} {
+		for _, k := range []int{0, 1, 2, n + 1} {
+			k := min(k, n-1)
+			for _, pd := range []bool{false, true} {
+				a := NewSymBandDense(n, k, nil)
+				for i := 0; i < n; i++ {
+					for j := i; j <= min(n-1, i+k); j++ {
+						a.SetSymBand(i, j, rnd.NormFloat64())
+					}
+					if pd {
+						// Make A strictly diagonally dominant
+						// with a positive diagonal.
+						a.SetSymBand(i, i, 2*float64(k)+2+rnd.Float64())
+					}
+				}
+				for _, nrhs := range []int{1, 3} {
+					name := fmt.Sprintf("n=%d,k=%d,pd=%t,nrhs=%d", n, k, pd, nrhs)
+
+					b := NewDense(n, nrhs, nil)
+					for i := 0; i < n; i++ {
+						for j := 0; j < nrhs; j++ {
+							b.Set(i, j, rnd.NormFloat64())
+						}
+					}
+
+					var dst Dense
+					err := a.SolveTo(&dst, b)
+					if err != nil {
+						t.Fatalf("%v: unexpected failure from SymBandDense.SolveTo: %v", name, err)
+					}
+					if resid := bandResidual(a, &dst, b); resid > tol*float64(n) {
+						t.Errorf("%v: unexpected residual of SolveTo: %v", name, resid)
+					}
+
+					var x VecDense
+					err = a.SolveVecTo(&x, b.ColView(0))
+					if err != nil {
+						t.Fatalf("%v: unexpected failure from SymBandDense.SolveVecTo: %v", name, err)
+					}
+					if resid := bandResidual(a, &x, b.ColView(0)); resid > tol*float64(n) {
+						t.Errorf("%v: unexpected residual of SolveVecTo: %v", name, resid)
+					}
+				}
+			}
+		}
+	}
+
+	// A singular symmetric band matrix.
+	a := NewSymBandDense(3, 1, []float64{
+		1, 1,
+		1, 0,
+		1, 0,
+	})
+	var x VecDense
+	err := a.SolveVecTo(&x, NewVecDense(3, []float64{1, 2, 3}))
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("expected Condition error for singular matrix, got:%v", err)
+	}
+}
@@ -589,6 +589,7 @@ func (s *SymDense) sliceSym(i, k int) *SymDense {
 //	1 - The maximum absolute column sum
 //	2 - The Frobenius norm, the square root of the sum of the squares of the elements
 //	Inf - The maximum absolute row sum
+//	MaxAbsNorm - The maximum absolute value of the elements
 //
 // Norm will panic with ErrNormOrder if an illegal norm is specified and with
 // ErrZeroLength if the matrix has zero size.
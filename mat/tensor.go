@@ -0,0 +1,407 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import "gonum.org/v1/gonum/blas/blas64"
+
+const badTensorAxes = "mat: invalid tensor axes"
+
+// Tensor is a dense N-dimensional array of float64 values stored with
+// explicit strides. The element at index (i_0, i_1, ..., i_{n-1}) is stored
+// at offset Σ i_k * stride_k of the backing data.
+//
+// Tensors returned by Reshape, Transpose, Slice and Index are views sharing
+// data with the tensor they were created from, so changes to the elements of
+// a view are reflected in the original tensor and vice versa.
+type Tensor struct {
+	shape   []int
+	strides []int
+	data    []float64
+}
+
+// NewTensor creates a new Tensor with the given shape. If data == nil, a new
+// slice is allocated for the backing slice. If len(data) is equal to the
+// product of the elements of shape, data is used as the backing slice in
+// row-major order, that is with the last index varying fastest, and changes
+// to the elements of the returned Tensor will be reflected in data. If
+// neither of these is true, NewTensor will panic. NewTensor will also panic
+// if shape is empty or if any element of shape is not positive.
+func NewTensor(shape []int, data []float64) *Tensor {
+	n := tensorSize(shape)
+	if data != nil && len(data) != n {
+		panic(ErrShape)
+	}
+	if data == nil {
+		data = make([]float64, n)
+	}
+	return &Tensor{
+		shape:   append([]int(nil), shape...),
+		strides: compactStrides(shape),
+		data:    data,
+	}
+}
+
+// tensorSize returns the number of elements of a tensor with the given
+// shape, panicking if the shape is not valid.
+func tensorSize(shape []int) int {
+	if len(shape) == 0 {
+		panic(ErrZeroLength)
+	}
+	n := 1
+	for _, d := range shape {
+		if d <= 0 {
+			if d == 0 {
+				panic(ErrZeroLength)
+			}
+			panic(ErrNegativeDimension)
+		}
+		n *= d
+	}
+	return n
+}
+
+// compactStrides returns the row-major strides of a contiguous tensor with
+// the given shape.
+func compactStrides(shape []int) []int {
+	strides := make([]int, len(shape))
+	s := 1
+	for i := len(shape) - 1; i >= 0; i-- {
+		strides[i] = s
+		s *= shape[i]
+	}
+	return strides
+}
+
+// Shape returns a copy of the shape of the tensor.
+func (t *Tensor) Shape() []int {
+	return append([]int(nil), t.shape...)
+}
+
+// Strides returns a copy of the strides of the tensor.
+func (t *Tensor) Strides() []int {
+	return append([]int(nil), t.strides...)
+}
+
+// Len returns the number of elements in the tensor.
+func (t *Tensor) Len() int {
+	if t.IsEmpty() {
+		return 0
+	}
+	n := 1
+	for _, d := range t.shape {
+		n *= d
+	}
+	return n
+}
+
+// IsEmpty returns whether the receiver is empty. Empty tensors can be the
+// receiver for size-restricted operations. The receiver can be emptied using
+// Reset.
+func (t *Tensor) IsEmpty() bool {
+	return len(t.shape) == 0
+}
+
+// Reset empties the tensor so that it can be reused as the receiver of a
+// dimensionally restricted operation.
+//
+// Reset should not be used when the tensor shares backing data.
+// See the Reseter interface for more information.
+func (t *Tensor) Reset() {
+	t.shape = t.shape[:0]
+	t.strides = t.strides[:0]
+	t.data = t.data[:0]
+}
+
+// offset returns the offset into the backing data of the element at idx.
+func (t *Tensor) offset(idx []int) int {
+	if len(idx) != len(t.shape) {
+		panic(ErrShape)
+	}
+	var off int
+	for i, v := range idx {
+		if uint(v) >= uint(t.shape[i]) {
+			panic(ErrIndexOutOfRange)
+		}
+		off += v * t.strides[i]
+	}
+	return off
+}
+
+// At returns the element at the given index. At panics if the number of
+// indices does not match the number of dimensions of the tensor or if any
+// index is out of range.
+func (t *Tensor) At(idx ...int) float64 {
+	return t.data[t.offset(idx)]
+}
+
+// Set sets the element at the given index to v. Set panics if the number of
+// indices does not match the number of dimensions of the tensor or if any
+// index is out of range.
+func (t *Tensor) Set(v float64, idx ...int) {
+	t.data[t.offset(idx)] = v
+}
+
+// isContiguous returns whether the elements of t are stored in row-major order
+// without gaps.
+func (t *Tensor) isContiguous() bool {
+	s := 1
+	for i := len(t.shape) - 1; i >= 0; i-- {
+		if t.shape[i] != 1 && t.strides[i] != s {
+			return false
+		}
+		s *= t.shape[i]
+	}
+	return true
+}
+
+// do calls fn for each element of t in row-major order with the offset of
+// the element in the backing data.
+func (t *Tensor) do(fn func(off int)) {
+	n := len(t.shape)
+	idx := make([]int, n)
+	var off int
+	for i := t.Len(); i > 0; i-- {
+		fn(off)
+		for k := n - 1; k >= 0; k-- {
+			idx[k]++
+			off += t.strides[k]
+			if idx[k] < t.shape[k] {
+				break
+			}
+			off -= idx[k] * t.strides[k]
+			idx[k] = 0
+		}
+	}
+}
+
+// values returns the elements of t in row-major order. The returned slice
+// shares data with t if t is contiguous.
+func (t *Tensor) values() []float64 {
+	if t.isContiguous() {
+		return t.data[:t.Len()]
+	}
+	v := make([]float64, 0, t.Len())
+	t.do(func(off int) { v = append(v, t.data[off]) })
+	return v
+}
+
+// setValues sets the elements of t in row-major order from v.
+func (t *Tensor) setValues(v []float64) {
+	if t.isContiguous() {
+		copy(t.data, v[:t.Len()])
+		return
+	}
+	var i int
+	t.do(func(off int) {
+		t.data[off] = v[i]
+		i++
+	})
+}
+
+// CloneFrom makes a contiguous copy of a into the receiver, overwriting the
+// previous value of the receiver. CloneFrom does not place any restrictions on
+// the receiver shape.
+func (t *Tensor) CloneFrom(a *Tensor) {
+	if a.IsEmpty() {
+		panic(ErrZeroLength)
+	}
+	v := a.values()
+	t.shape = append(t.shape[:0], a.shape...)
+	t.strides = compactStrides(t.shape)
+	t.data = append(use(t.data, 0), v...)
+}
+
+// Reshape returns a tensor with the given shape holding the elements of the
+// receiver in row-major order. If the receiver is contiguous, the returned
+// tensor is a view sharing data with the receiver, otherwise it holds a copy
+// of the elements. Reshape panics if the number of elements of the new shape
+// differs from the number of elements in the receiver.
+func (t *Tensor) Reshape(shape ...int) *Tensor {
+	if tensorSize(shape) != t.Len() {
+		panic(ErrShape)
+	}
+	return &Tensor{
+		shape:   append([]int(nil), shape...),
+		strides: compactStrides(shape),
+		data:    t.values(),
+	}
+}
+
+// Transpose returns a view of the receiver with its axes permuted so that
+// axis i of the returned tensor is axis perm[i] of the receiver. Transpose
+// panics if perm is not a permutation of the axes of the receiver.
+func (t *Tensor) Transpose(perm ...int) *Tensor {
+	n := len(t.shape)
+	if len(perm) != n {
+		panic(badTensorAxes)
+	}
+	seen := make([]bool, n)
+	v := &Tensor{
+		shape:   make([]int, n),
+		strides: make([]int, n),
+		data:    t.data,
+	}
+	for i, p := range perm {
+		if uint(p) >= uint(n) || seen[p] {
+			panic(badTensorAxes)
+		}
+		seen[p] = true
+		v.shape[i] = t.shape[p]
+		v.strides[i] = t.strides[p]
+	}
+	return v
+}
+
+// Slice returns a view of the elements of the receiver in the half-open ranges
+// from[i] to to[i] along each axis i. Slice panics if from or to do not have
+// one element per axis or if any range is empty or out of bounds.
+func (t *Tensor) Slice(from, to []int) *Tensor {
+	n := len(t.shape)
+	if len(from) != n || len(to) != n {
+		panic(ErrShape)
+	}
+	v := &Tensor{
+		shape:   make([]int, n),
+		strides: append([]int(nil), t.strides...),
+	}
+	var off int
+	for i := range t.shape {
+		if from[i] < 0 || to[i] > t.shape[i] || from[i] >= to[i] {
+			panic(ErrIndexOutOfRange)
+		}
+		v.shape[i] = to[i] - from[i]
+		off += from[i] * t.strides[i]
+	}
+	v.data = t.data[off:]
+	return v
+}
+
+// Index returns a view of the receiver with the given axis fixed at index i,
+// so the returned tensor has one fewer dimension than the receiver. Index
+// panics if the axis or index is out of range or if the receiver has only
+// one dimension.
+func (t *Tensor) Index(axis, i int) *Tensor {
+	n := len(t.shape)
+	if uint(axis) >= uint(n) || n == 1 {
+		panic(badTensorAxes)
+	}
+	if uint(i) >= uint(t.shape[axis]) {
+		panic(ErrIndexOutOfRange)
+	}
+	v := &Tensor{
+		shape:   make([]int, 0, n-1),
+		strides: make([]int, 0, n-1),
+		data:    t.data[i*t.strides[axis]:],
+	}
+	v.shape = append(append(v.shape, t.shape[:axis]...), t.shape[axis+1:]...)
+	v.strides = append(append(v.strides, t.strides[:axis]...), t.strides[axis+1:]...)
+	return v
+}
+
+// MatrixView returns a Dense view of the last two axes of the receiver with
+// the leading axes fixed at the indices given in idx. The returned matrix
+// shares data with the receiver, so MatrixView can be used to apply matrix
+// operations to each matrix in a batch held by a tensor.
+//
+// MatrixView panics if the receiver has fewer than two dimensions, if len(idx)
+// is not two less than the number of dimensions or if any index is out of
+// range. MatrixView panics with ErrIllegalStride if the elements of the rows
+// of the view are not adjacent in the backing data, as happens after a
+// Transpose that moves the last axis.
+func (t *Tensor) MatrixView(idx ...int) *Dense {
+	n := len(t.shape)
+	if n < 2 {
+		panic(badTensorAxes)
+	}
+	if len(idx) != n-2 {
+		panic(ErrShape)
+	}
+	var off int
+	for i, v := range idx {
+		if uint(v) >= uint(t.shape[i]) {
+			panic(ErrIndexOutOfRange)
+		}
+		off += v * t.strides[i]
+	}
+	r, c := t.shape[n-2], t.shape[n-1]
+	stride := t.strides[n-2]
+	if r == 1 {
+		stride = c
+	}
+	if (c != 1 && t.strides[n-1] != 1) || stride < c {
+		panic(ErrIllegalStride)
+	}
+	return &Dense{
+		mat: blas64.General{
+			Rows:   r,
+			Cols:   c,
+			Stride: stride,
+			Data:   t.data[off : off+(r-1)*stride+c],
+		},
+		capRows: r,
+		capCols: c,
+	}
+}
+
+// Contract computes the contraction of axis of the tensor a with the rows of
+// the matrix b, placing the result in the receiver. The result has the shape
+// of a with the length of axis replaced by the number of columns of b, and
+//
+//	t[..., j, ...] = Σ_k a[..., k, ...] * b[k, j]
+//
+// where the indices j and k are at position axis. The contraction is computed
+// with a single matrix multiplication.
+//
+// If the receiver is empty, it is resized to the shape of the result. When
+// the receiver is non-empty, Contract panics if its shape does not match the
+// shape of the result. Contract also panics if axis is out of range for a or
+// if the length of axis does not match the number of rows of b.
+func (t *Tensor) Contract(a *Tensor, axis int, b Matrix) {
+	n := len(a.shape)
+	if uint(axis) >= uint(n) {
+		panic(badTensorAxes)
+	}
+	br, bc := b.Dims()
+	if a.shape[axis] != br {
+		panic(ErrShape)
+	}
+	shape := append([]int(nil), a.shape...)
+	shape[axis] = bc
+	if t.IsEmpty() {
+		t.shape = append(t.shape[:0], shape...)
+		t.strides = compactStrides(shape)
+		t.data = use(t.data, tensorSize(shape))
+	} else if !equalInts(t.shape, shape) {
+		panic(ErrShape)
+	}
+
+	// Move axis to the end and view the elements as
+	// a matrix with one row per element of the other axes.
+	perm := make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		if i != axis {
+			perm = append(perm, i)
+		}
+	}
+	perm = append(perm, axis)
+	rows := a.Len() / br
+	// The elements of a are copied so that the receiver may
+	// share data with a.
+	av := append([]float64(nil), a.Transpose(perm...).values()...)
+	var w Dense
+	w.Mul(NewDense(rows, br, av), b)
+
+	// Scatter the product back with axis in its original position.
+	inv := make([]int, n)
+	for i, p := range perm {
+		inv[p] = i
+	}
+	wshape := make([]int, n)
+	for i, p := range perm {
+		wshape[i] = shape[p]
+	}
+	wt := NewTensor(wshape, w.mat.Data).Transpose(inv...)
+	t.setValues(wt.values())
+}
@@ -0,0 +1,223 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestTensor(t *testing.T) {
+	t.Parallel()
+	data := make([]float64, 24)
+	for i := range data {
+		data[i] = float64(i)
+	}
+	a := NewTensor([]int{2, 3, 4}, data)
+	if !equalInts(a.Shape(), []int{2, 3, 4}) || !equalInts(a.Strides(), []int{12, 4, 1}) {
+		t.Fatalf("unexpected shape %v or strides %v", a.Shape(), a.Strides())
+	}
+	if a.Len() != 24 {
+		t.Errorf("unexpected length: %d", a.Len())
+	}
+	if got := a.At(1, 2, 3); got != 23 {
+		t.Errorf("unexpected At: got %v, want 23", got)
+	}
+	a.Set(-1, 0, 1, 2)
+	if data[6] != -1 {
+		t.Errorf("Set not reflected in backing data")
+	}
+	data[6] = 6
+
+	// Transpose is a view with permuted axes.
+	tr := a.Transpose(2, 0, 1)
+	if !equalInts(tr.Shape(), []int{4, 2, 3}) {
+		t.Errorf("unexpected transposed shape: %v", tr.Shape())
+	}
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 3; j++ {
+			for k := 0; k < 4; k++ {
+				if tr.At(k, i, j) != a.At(i, j, k) {
+					t.Errorf("unexpected transposed element at (%d,%d,%d)", k, i, j)
+				}
+			}
+		}
+	}
+
+	// Reshape of a contiguous tensor shares data.
+	r := a.Reshape(6, 4)
+	r.Set(100, 5, 3)
+	if a.At(1, 2, 3) != 100 {
+		t.Errorf("Reshape of contiguous tensor does not share data")
+	}
+	a.Set(23, 1, 2, 3)
+
+	// Reshape of a non-contiguous tensor copies in row-major order.
+	rt := tr.Reshape(24)
+	var n int
+	for k := 0; k < 4; k++ {
+		for i := 0; i < 2; i++ {
+			for j := 0; j < 3; j++ {
+				if rt.At(n) != a.At(i, j, k) {
+					t.Errorf("unexpected reshaped element %d", n)
+				}
+				n++
+			}
+		}
+	}
+
+	// Slice and Index are views.
+	s := a.Slice([]int{1, 1, 0}, []int{2, 3, 4})
+	if !equalInts(s.Shape(), []int{1, 2, 4}) {
+		t.Errorf("unexpected slice shape: %v", s.Shape())
+	}
+	if s.At(0, 1, 2) != a.At(1, 2, 2) {
+		t.Errorf("unexpected sliced element")
+	}
+	ix := a.Index(1, 2)
+	if !equalInts(ix.Shape(), []int{2, 4}) || ix.At(1, 3) != a.At(1, 2, 3) {
+		t.Errorf("unexpected indexed tensor")
+	}
+	ix.Set(-5, 0, 0)
+	if a.At(0, 2, 0) != -5 {
+		t.Errorf("Index does not share data")
+	}
+	a.Set(8, 0, 2, 0)
+
+	// MatrixView provides Dense views of the trailing axes.
+	m := a.MatrixView(1)
+	want := NewDense(3, 4, data[12:])
+	if !Equal(m, want) {
+		t.Errorf("unexpected matrix view:\n%v", Formatted(m))
+	}
+	sm := s.MatrixView(0)
+	if !Equal(sm, want.Slice(1, 3, 0, 4)) {
+		t.Errorf("unexpected matrix view of slice:\n%v", Formatted(sm))
+	}
+	m.Set(0, 0, -2)
+	if a.At(1, 0, 0) != -2 {
+		t.Errorf("MatrixView does not share data")
+	}
+	a.Set(12, 1, 0, 0)
+	if panicked, message := panics(func() { tr.MatrixView(0) }); !panicked || message != ErrIllegalStride.Error() {
+		t.Errorf("unexpected panic for non-unit stride: %q", message)
+	}
+
+	// CloneFrom makes a contiguous copy.
+	var c Tensor
+	c.CloneFrom(tr)
+	if !equalInts(c.Strides(), []int{6, 3, 1}) || c.At(3, 1, 2) != a.At(1, 2, 3) {
+		t.Errorf("unexpected clone")
+	}
+	c.Set(-3, 3, 1, 2)
+	if a.At(1, 2, 3) == -3 {
+		t.Errorf("CloneFrom shares data")
+	}
+
+	for _, test := range []struct {
+		name string
+		fn   func()
+		want string
+	}{
+		{"zero shape", func() { NewTensor([]int{2, 0}, nil) }, ErrZeroLength.Error()},
+		{"negative shape", func() { NewTensor([]int{2, -1}, nil) }, ErrNegativeDimension.Error()},
+		{"data length", func() { NewTensor([]int{2, 2}, make([]float64, 3)) }, ErrShape.Error()},
+		{"index count", func() { a.At(1, 2) }, ErrShape.Error()},
+		{"index range", func() { a.At(1, 3, 0) }, ErrIndexOutOfRange.Error()},
+		{"reshape size", func() { a.Reshape(5, 5) }, ErrShape.Error()},
+		{"repeated axis", func() { a.Transpose(0, 0, 1) }, badTensorAxes},
+		{"slice range", func() { a.Slice([]int{0, 2, 0}, []int{2, 2, 4}) }, ErrIndexOutOfRange.Error()},
+		{"index vector", func() { NewTensor([]int{3}, nil).Index(0, 0) }, badTensorAxes},
+	} {
+		if panicked, message := panics(test.fn); !panicked || message != test.want {
+			t.Errorf("%s: unexpected panic: got %q, want %q", test.name, message, test.want)
+		}
+	}
+}
+
+func TestTensorContract(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	shape := []int{3, 4, 2, 5}
+	a := NewTensor(shape, nil)
+	for i := range a.data {
+		a.data[i] = rnd.NormFloat64()
+	}
+	for axis := range shape {
+		b := NewDense(shape[axis], 3, nil)
+		for i := range b.mat.Data {
+			b.mat.Data[i] = rnd.NormFloat64()
+		}
+		for _, src := range []*Tensor{a, a.Transpose(3, 1, 0, 2).Transpose(2, 1, 3, 0)} {
+			var got Tensor
+			got.Contract(src, axis, b)
+			wantShape := append([]int(nil), shape...)
+			wantShape[axis] = 3
+			if !equalInts(got.Shape(), wantShape) {
+				t.Errorf("axis=%d: unexpected shape: got %v, want %v", axis, got.Shape(), wantShape)
+				continue
+			}
+			idx := make([]int, len(shape))
+			got.do(func(off int) {
+				var want float64
+				j := idx[axis]
+				for k := 0; k < shape[axis]; k++ {
+					idx[axis] = k
+					want += a.At(idx...) * b.At(k, j)
+				}
+				idx[axis] = j
+				if !scalar.EqualWithinAbsOrRel(got.data[off], want, 1e-14, 1e-14) {
+					t.Errorf("axis=%d: unexpected element at %v: got %v, want %v", axis, idx, got.data[off], want)
+				}
+				for k := len(idx) - 1; k >= 0; k-- {
+					idx[k]++
+					if idx[k] < wantShape[k] {
+						break
+					}
+					idx[k] = 0
+				}
+			})
+		}
+	}
+
+	// Batched matrix products through matrix views agree with Contract.
+	batch := NewTensor([]int{4, 3, 5}, nil)
+	for i := range batch.data {
+		batch.data[i] = rnd.NormFloat64()
+	}
+	b := NewDense(5, 2, nil)
+	for i := range b.mat.Data {
+		b.mat.Data[i] = rnd.NormFloat64()
+	}
+	dst := NewTensor([]int{4, 3, 2}, nil)
+	dst.Contract(batch, 2, b)
+	for i := 0; i < 4; i++ {
+		var want Dense
+		want.Mul(batch.MatrixView(i), b)
+		if !EqualApprox(dst.MatrixView(i), &want, 1e-14) {
+			t.Errorf("unexpected batch product %d", i)
+		}
+	}
+
+	// The receiver may be the contracted tensor.
+	sq := NewDense(5, 5, nil)
+	for i := range sq.mat.Data {
+		sq.mat.Data[i] = rnd.NormFloat64()
+	}
+	var want Tensor
+	want.Contract(batch, 2, sq)
+	batch.Contract(batch, 2, sq)
+	if !floats.EqualApprox(batch.data, want.data, 1e-14) {
+		t.Errorf("unexpected result for aliased receiver")
+	}
+
+	if panicked, message := panics(func() { dst.Contract(batch, 1, b) }); !panicked || message != ErrShape.Error() {
+		t.Errorf("unexpected panic for bad shape: %q", message)
+	}
+}
@@ -0,0 +1,273 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import "math"
+
+var (
+	toeplitz *Toeplitz
+	_        Matrix      = toeplitz
+	_        SolveToer   = toeplitz
+	_        NonZeroDoer = toeplitz
+)
+
+const badToeplitzDiag = "mat: first row and column of Toeplitz matrix differ"
+
+// levinsonTol is the threshold below which the Levinson recursion is
+// considered to have broken down.
+const levinsonTol = 1e-10
+
+// Toeplitz represents an n×n Toeplitz matrix, a matrix that is constant along
+// each diagonal, by its first column c and first row r, so that
+//
+//	A[i,j] = c[i-j]  if i >= j,
+//	A[i,j] = r[j-i]  if i < j.
+//
+// Products with a Toeplitz matrix are computed by embedding it in a circulant
+// matrix in O(n log n) time, and linear systems are solved by the Levinson
+// recursion in O(n²) time.
+type Toeplitz struct {
+	n    int
+	c, r []float64
+}
+
+// NewToeplitz creates a new n×n Toeplitz matrix with first column c and first
+// row r. If c and r are both nil, new backing slices are allocated, otherwise
+// c and r must have length n and are used as the backing slices so that
+// changes to the elements of the returned Toeplitz will be reflected in c and
+// r. If c and r are the same slice, the matrix is symmetric.
+//
+// NewToeplitz will panic if n is not positive, if c or r have the wrong length
+// or if c[0] != r[0].
+func NewToeplitz(n int, c, r []float64) *Toeplitz {
+	if n <= 0 {
+		if n == 0 {
+			panic(ErrZeroLength)
+		}
+		panic(ErrNegativeDimension)
+	}
+	if c == nil && r == nil {
+		c = make([]float64, n)
+		r = make([]float64, n)
+	}
+	if len(c) != n || len(r) != n {
+		panic(ErrShape)
+	}
+	if c[0] != r[0] {
+		panic(badToeplitzDiag)
+	}
+	return &Toeplitz{n: n, c: c, r: r}
+}
+
+// Dims returns the number of rows and columns in the matrix.
+func (a *Toeplitz) Dims() (r, c int) {
+	return a.n, a.n
+}
+
+// At returns the element at row i, column j.
+func (a *Toeplitz) At(i, j int) float64 {
+	if uint(i) >= uint(a.n) {
+		panic(ErrRowAccess)
+	}
+	if uint(j) >= uint(a.n) {
+		panic(ErrColAccess)
+	}
+	if i >= j {
+		return a.c[i-j]
+	}
+	return a.r[j-i]
+}
+
+// T performs an implicit transpose by returning the receiver inside a
+// Transpose.
+func (a *Toeplitz) T() Matrix {
+	return Transpose{a}
+}
+
+// RawToeplitz returns the first column and the first row of the matrix.
+// Changes to the elements of the returned slices will be reflected in the
+// receiver. The first elements of c and r must be kept equal.
+func (a *Toeplitz) RawToeplitz() (c, r []float64) {
+	return a.c, a.r
+}
+
+// DoNonZero calls the function fn for each of the non-zero elements of A. The
+// function fn takes a row/column index and the element value of A at (i,j).
+func (a *Toeplitz) DoNonZero(fn func(i, j int, v float64)) {
+	for i := 0; i < a.n; i++ {
+		for j := 0; j < a.n; j++ {
+			if v := a.At(i, j); v != 0 {
+				fn(i, j, v)
+			}
+		}
+	}
+}
+
+// MulVecTo computes A⋅x or Aᵀ⋅x storing the result into dst.
+func (a *Toeplitz) MulVecTo(dst *VecDense, trans bool, x Vector) {
+	n := a.n
+	if x.Len() != n {
+		panic(ErrShape)
+	}
+	if xv, ok := x.(RawVectorer); ok && dst != x {
+		dst.checkOverlap(xv.RawVector())
+	}
+	dst.reuseAsNonZeroed(n)
+
+	c, r := a.c, a.r
+	if trans {
+		c, r = r, c
+	}
+	seq := make([]float64, n)
+	for i := range seq {
+		seq[i] = x.AtVec(i)
+	}
+	toeplitzMulVec(seq, c, r, seq)
+	for i, v := range seq {
+		dst.setVec(i, v)
+	}
+}
+
+// SolveTo solves a Toeplitz system A⋅X = B or Aᵀ⋅X = B where A is an n×n
+// Toeplitz matrix represented by the receiver and B is a given n×nrhs matrix.
+// The result is stored into dst.
+//
+// SolveTo uses the Levinson recursion, which requires all leading principal
+// submatrices of A to be well-conditioned, as is the case for symmetric
+// positive definite matrices such as covariance matrices of stationary
+// processes. If the recursion breaks down, SolveTo falls back to an LU
+// factorization of a dense copy of A. If A is singular or near singular, a
+// Condition error is returned. See the documentation for Condition for more
+// information.
+func (a *Toeplitz) SolveTo(dst *Dense, trans bool, b Matrix) error {
+	n, nrhs := b.Dims()
+	if n != a.n {
+		panic(ErrShape)
+	}
+	if bU, _ := untranspose(b); dst != bU {
+		if rm, ok := bU.(RawMatrixer); ok {
+			dst.checkOverlap(rm.RawMatrix())
+		}
+	}
+
+	x := NewDense(n, nrhs, nil)
+	if !a.levinson(x, trans, b) {
+		var lu LU
+		if trans {
+			lu.Factorize(a.T())
+		} else {
+			lu.Factorize(a)
+		}
+		return lu.SolveTo(dst, false, b)
+	}
+	dst.reuseAsNonZeroed(n, nrhs)
+	dst.Copy(x)
+	return nil
+}
+
+// SolveVecTo solves a Toeplitz system A⋅x = b or Aᵀ⋅x = b where A is an n×n
+// Toeplitz matrix represented by the receiver and b is a given n-vector. The
+// result is stored into dst. See SolveTo for the details of the algorithm.
+func (a *Toeplitz) SolveVecTo(dst *VecDense, trans bool, b Vector) error {
+	n := b.Len()
+	if n != a.n {
+		panic(ErrShape)
+	}
+	if bv, ok := b.(RawVectorer); ok && dst != b {
+		dst.checkOverlap(bv.RawVector())
+	}
+	dst.reuseAsNonZeroed(n)
+	return a.SolveTo(dst.asDense(), trans, b)
+}
+
+// levinson solves A⋅X = B or Aᵀ⋅X = B by the Levinson recursion, storing the
+// result into the n×nrhs matrix x. It returns false if the recursion breaks
+// down.
+func (a *Toeplitz) levinson(x *Dense, trans bool, b Matrix) bool {
+	n, nrhs := x.Dims()
+	c, r := a.c, a.r
+	if trans {
+		c, r = r, c
+	}
+	// t returns the element on the d-th subdiagonal of A.
+	t := func(d int) float64 {
+		if d >= 0 {
+			return c[d]
+		}
+		return r[-d]
+	}
+	if t(0) == 0 {
+		return false
+	}
+
+	// f and g are the forward and backward vectors of the leading k×k
+	// submatrix T_k, satisfying T_k⋅f = e_1 and T_k⋅g = e_k.
+	f := make([]float64, n)
+	g := make([]float64, n)
+	f[0] = 1 / t(0)
+	g[0] = f[0]
+	for j := 0; j < nrhs; j++ {
+		x.set(0, j, b.At(0, j)*f[0])
+	}
+	for k := 1; k < n; k++ {
+		var ef, eg float64
+		for j := 0; j < k; j++ {
+			ef += t(k-j) * f[j]
+			eg += t(-(j + 1)) * g[j]
+		}
+		denom := 1 - ef*eg
+		if math.Abs(denom) < levinsonTol || math.IsNaN(denom) {
+			return false
+		}
+		// f ← ([f; 0] - ef⋅[0; g]) / denom
+		// g ← ([0; g] - eg⋅[f; 0]) / denom
+		for j := k; j > 0; j-- {
+			g[j] = g[j-1]
+		}
+		g[0] = 0
+		f[k] = 0
+		for j := 0; j <= k; j++ {
+			fj, gj := f[j], g[j]
+			f[j] = (fj - ef*gj) / denom
+			g[j] = (gj - eg*fj) / denom
+		}
+		for col := 0; col < nrhs; col++ {
+			var ex float64
+			for j := 0; j < k; j++ {
+				ex += t(k-j) * x.at(j, col)
+			}
+			scale := b.At(k, col) - ex
+			x.set(k, col, 0)
+			for j := 0; j <= k; j++ {
+				x.set(j, col, x.at(j, col)+scale*g[j])
+			}
+		}
+	}
+	for _, v := range x.mat.Data {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// toeplitzMulVec computes T⋅x for the m×n Toeplitz matrix T with first column
+// c and first row r, storing the result into dst of length m. The length of x
+// is n. dst and x may be the same slice if m equals n.
+func toeplitzMulVec(dst, c, r, x []float64) {
+	m, n := len(c), len(r)
+	// Embed T in an (m+n)×(m+n) circulant matrix and multiply by [x; 0].
+	col := make([]float64, m+n)
+	copy(col, c)
+	for k := 1; k < n; k++ {
+		col[m+n-k] = r[k]
+	}
+	var s circulantSpectrum
+	s.init(col)
+	seq := make([]float64, m+n)
+	copy(seq, x)
+	s.apply(seq, false, false)
+	copy(dst, seq[:m])
+}
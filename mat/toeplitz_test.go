@@ -0,0 +1,132 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestToeplitz(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 4, 7, 16, 31} {
+		for _, typ := range []string{"symmetric", "general", "zero diagonal"} {
+			c := make([]float64, n)
+			r := make([]float64, n)
+			switch typ {
+			case "symmetric":
+				// An autocovariance sequence of an AR(1)
+				// process.
+				for i := range c {
+					c[i] = math.Pow(0.8, float64(i))
+				}
+				r = c
+			case "general":
+				for i := range c {
+					c[i] = rnd.NormFloat64()
+					r[i] = rnd.NormFloat64()
+				}
+				c[0] = float64(n)
+				r[0] = c[0]
+			case "zero diagonal":
+				// The Levinson recursion breaks down
+				// immediately and the dense fallback is
+				// used.
+				if n < 2 {
+					continue
+				}
+				for i := 1; i < n; i++ {
+					c[i] = rnd.NormFloat64()
+					r[i] = rnd.NormFloat64()
+				}
+				c[1] += float64(n)
+			}
+			a := NewToeplitz(n, c, r)
+			want := DenseCopyOf(a)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					var v float64
+					if i >= j {
+						v = c[i-j]
+					} else {
+						v = r[j-i]
+					}
+					if want.At(i, j) != v {
+						t.Fatalf("n=%d,%s: unexpected element (%d,%d): got %v, want %v", n, typ, i, j, want.At(i, j), v)
+					}
+				}
+			}
+
+			for _, trans := range []bool{false, true} {
+				name := fmt.Sprintf("n=%d,%s,trans=%t", n, typ, trans)
+				var aMat, wantMat Matrix = a, want
+				if trans {
+					aMat, wantMat = a.T(), want.T()
+				}
+
+				x := NewVecDense(n, nil)
+				for i := 0; i < n; i++ {
+					x.SetVec(i, rnd.NormFloat64())
+				}
+				var got, ref VecDense
+				got.MulVec(aMat, x)
+				ref.MulVec(wantMat, x)
+				if !EqualApprox(&got, &ref, tol*float64(n)) {
+					t.Errorf("%s: unexpected MulVec result", name)
+				}
+
+				bm := NewDense(n, 3, nil)
+				for i := 0; i < n; i++ {
+					for j := 0; j < 3; j++ {
+						bm.Set(i, j, rnd.NormFloat64())
+					}
+				}
+				var xref Dense
+				if err := xref.Solve(wantMat, bm); err != nil {
+					t.Fatalf("%s: bad test: %v", name, err)
+				}
+				var xm Dense
+				if err := a.SolveTo(&xm, trans, bm); err != nil {
+					t.Errorf("%s: unexpected error: %v", name, err)
+				}
+				if !EqualApprox(&xm, &xref, tol*float64(n)) {
+					t.Errorf("%s: unexpected SolveTo result", name)
+				}
+				var viaSolve Dense
+				if err := viaSolve.Solve(aMat, bm); err != nil {
+					t.Errorf("%s: unexpected error from Dense.Solve: %v", name, err)
+				}
+				if !EqualApprox(&viaSolve, &xref, tol*float64(n)) {
+					t.Errorf("%s: unexpected Dense.Solve result", name)
+				}
+
+				var xv, xvref VecDense
+				if err := a.SolveVecTo(&xv, trans, x); err != nil {
+					t.Errorf("%s: unexpected error: %v", name, err)
+				}
+				xvref.SolveVec(wantMat, x)
+				if !EqualApprox(&xv, &xvref, tol*float64(n)) {
+					t.Errorf("%s: unexpected SolveVecTo result", name)
+				}
+			}
+		}
+	}
+
+	if ok, _ := panics(func() { NewToeplitz(2, []float64{1, 2}, []float64{3, 4}) }); !ok {
+		t.Errorf("expected panic for mismatched diagonal")
+	}
+
+	// A singular Toeplitz matrix.
+	a := NewToeplitz(3, []float64{1, 1, 1}, []float64{1, 1, 1})
+	var x Dense
+	if _, ok := a.SolveTo(&x, false, NewDense(3, 1, []float64{1, 2, 3})).(Condition); !ok {
+		t.Errorf("expected Condition error for singular Toeplitz matrix")
+	}
+}
@@ -268,7 +268,7 @@ func (v *VecDense) CopyVec(a Vector) int {
 //
 //	1 - The sum of the element magnitudes
 //	2 - The Euclidean norm, the square root of the sum of the squares of the elements
-//	Inf - The maximum element magnitude
+//	Inf or MaxAbsNorm - The maximum element magnitude
 //
 // Norm will panic with ErrNormOrder if an illegal norm is specified and with
 // ErrZeroLength if the vector has zero size.
@@ -283,7 +283,7 @@ func (v *VecDense) Norm(norm float64) float64 {
 		return blas64.Asum(v.mat)
 	case 2:
 		return blas64.Nrm2(v.mat)
-	case math.Inf(1):
+	case math.Inf(1), MaxAbsNorm:
 		imax := blas64.Iamax(v.mat)
 		return math.Abs(v.at(imax))
 	}
@@ -666,6 +666,21 @@ func (v *VecDense) MulVec(a Matrix, b Vector) {
 			blas64.Trmv(ta, aU.mat, v.mat)
 			return
 		}
+	case *CSR:
+		aU.MulVecTo(v, trans, b)
+		return
+	case *Circulant:
+		aU.MulVecTo(v, trans, b)
+		return
+	case *Toeplitz:
+		aU.MulVecTo(v, trans, b)
+		return
+	case *Hankel:
+		aU.MulVecTo(v, trans, b)
+		return
+	case *BlockDense:
+		aU.MulVecTo(v, trans, b)
+		return
 	case *Dense:
 		if fast {
 			aU.checkOverlap(v.asGeneral())
@@ -0,0 +1,110 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import "gonum.org/v1/gonum/blas/blas64"
+
+// Workspace holds temporary storage that is reused by the FactorizeWith
+// methods of LU, QR, SVD, EigenSym and Eigen. Factorizing with a Workspace
+// also reuses the storage held by the receiver for the result, so that
+// repeatedly factorizing matrices of the same size into the same receiver
+// makes no more allocations than the corresponding Factorize method, and
+// usually fewer, once the Workspace has grown to the required size. This
+// reduces garbage collector pressure in tight loops that factorize many
+// small matrices. The underlying LAPACK routines may still allocate, and the
+// number of allocations that remain depends on the build configuration.
+//
+// The zero value of a Workspace is ready to use. A Workspace may be shared
+// by factorizations of different types and sizes, but must not be used by
+// more than one factorization concurrently.
+type Workspace struct {
+	fdata []float64
+	idata []int
+	usedF int
+	usedI int
+	needF int
+	needI int
+}
+
+// reset makes all storage held by the workspace available for reuse, growing
+// it to the size required by previous uses. Storage previously returned by
+// the workspace must no longer be in use. reset must be called at the start
+// of each use of the workspace.
+func (ws *Workspace) reset() {
+	if ws == nil {
+		return
+	}
+	if len(ws.fdata) < ws.needF {
+		ws.fdata = make([]float64, ws.needF)
+	}
+	if len(ws.idata) < ws.needI {
+		ws.idata = make([]int, ws.needI)
+	}
+	ws.usedF = 0
+	ws.usedI = 0
+}
+
+// float64s returns a float64 slice of length n whose elements are not
+// zeroed. If ws is nil, the slice is taken from the package workspace pool
+// and must be returned with ws.putFloat64s.
+func (ws *Workspace) float64s(n int) []float64 {
+	if ws == nil {
+		return getFloat64s(n, false)
+	}
+	var s []float64
+	if ws.usedF+n <= len(ws.fdata) {
+		s = ws.fdata[ws.usedF : ws.usedF+n : ws.usedF+n]
+	} else {
+		// The workspace is too small, so allocate now and grow the
+		// workspace at the next reset.
+		s = make([]float64, n)
+	}
+	ws.usedF += n
+	ws.needF = max(ws.needF, ws.usedF)
+	return s
+}
+
+// putFloat64s returns s to the package workspace pool if ws is nil.
+func (ws *Workspace) putFloat64s(s []float64) {
+	if ws == nil {
+		putFloat64s(s)
+	}
+}
+
+// ints returns an int slice of length n whose elements are not zeroed. If
+// ws is nil, the slice is taken from the package workspace pool and must be
+// returned with ws.putInts.
+func (ws *Workspace) ints(n int) []int {
+	if ws == nil {
+		return getInts(n, false)
+	}
+	var s []int
+	if ws.usedI+n <= len(ws.idata) {
+		s = ws.idata[ws.usedI : ws.usedI+n : ws.usedI+n]
+	} else {
+		s = make([]int, n)
+	}
+	ws.usedI += n
+	ws.needI = max(ws.needI, ws.usedI)
+	return s
+}
+
+// putInts returns s to the package workspace pool if ws is nil.
+func (ws *Workspace) putInts(s []int) {
+	if ws == nil {
+		putInts(s)
+	}
+}
+
+// general returns an r×c blas64.General whose elements are not zeroed,
+// backed by storage from ws.float64s.
+func (ws *Workspace) general(r, c int) blas64.General {
+	return blas64.General{
+		Rows:   r,
+		Cols:   c,
+		Stride: max(c, 1),
+		Data:   ws.float64s(r * max(c, 1)),
+	}
+}
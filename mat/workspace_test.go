@@ -0,0 +1,167 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mat
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestFactorizeWith(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	var ws Workspace
+	for _, n := range []int{1, 3, 8, 5, 20, 4} {
+		a := NewDense(n, n, nil)
+		tall := NewDense(n+2, n, nil)
+		sym := NewSymDense(n, nil)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				a.Set(i, j, rnd.NormFloat64())
+				tall.Set(i, j, rnd.NormFloat64())
+			}
+			for j := i; j < n; j++ {
+				sym.SetSym(i, j, rnd.NormFloat64())
+			}
+		}
+		for j := 0; j < n; j++ {
+			tall.Set(n, j, rnd.NormFloat64())
+			tall.Set(n+1, j, rnd.NormFloat64())
+		}
+
+		var lu, luWant LU
+		lu.FactorizeWith(a, &ws)
+		luWant.Factorize(a)
+		var l, lWant TriDense
+		lu.LTo(&l)
+		luWant.LTo(&lWant)
+		if !Equal(&l, &lWant) || lu.Cond() != luWant.Cond() {
+			t.Errorf("n=%d: LU factorization with workspace differs from Factorize", n)
+		}
+
+		var qr, qrWant QR
+		qr.FactorizeWith(tall, &ws)
+		qrWant.Factorize(tall)
+		var q, qWant Dense
+		qr.QTo(&q)
+		qrWant.QTo(&qWant)
+		if !Equal(&q, &qWant) || qr.Cond() != qrWant.Cond() {
+			t.Errorf("n=%d: QR factorization with workspace differs from Factorize", n)
+		}
+
+		var svd, svdWant SVD
+		ok := svd.FactorizeWith(tall, SVDThin, &ws)
+		okWant := svdWant.Factorize(tall, SVDThin)
+		if !ok || !okWant {
+			t.Fatalf("n=%d: SVD failed", n)
+		}
+		var u, uWant Dense
+		svd.UTo(&u)
+		svdWant.UTo(&uWant)
+		if !Equal(&u, &uWant) || !Equal(NewVecDense(n, svd.Values(nil)), NewVecDense(n, svdWant.Values(nil))) {
+			t.Errorf("n=%d: SVD with workspace differs from Factorize", n)
+		}
+
+		var es, esWant EigenSym
+		ok = es.FactorizeWith(sym, true, &ws)
+		okWant = esWant.Factorize(sym, true)
+		if !ok || !okWant {
+			t.Fatalf("n=%d: EigenSym failed", n)
+		}
+		var ev, evWant Dense
+		es.VectorsTo(&ev)
+		esWant.VectorsTo(&evWant)
+		if !Equal(&ev, &evWant) || !Equal(NewVecDense(n, es.Values(nil)), NewVecDense(n, esWant.Values(nil))) {
+			t.Errorf("n=%d: EigenSym with workspace differs from Factorize", n)
+		}
+
+		var eig, eigWant Eigen
+		ok = eig.FactorizeWith(a, EigenBoth, &ws)
+		okWant = eigWant.Factorize(a, EigenBoth)
+		if !ok || !okWant {
+			t.Fatalf("n=%d: Eigen failed", n)
+		}
+		var vl, vlWant, vr, vrWant CDense
+		eig.LeftVectorsTo(&vl)
+		eigWant.LeftVectorsTo(&vlWant)
+		eig.VectorsTo(&vr)
+		eigWant.VectorsTo(&vrWant)
+		values, valuesWant := eig.Values(nil), eigWant.Values(nil)
+		same := CEqual(&vl, &vlWant) && CEqual(&vr, &vrWant)
+		for i := range values {
+			same = same && values[i] == valuesWant[i]
+		}
+		if !same {
+			t.Errorf("n=%d: Eigen with workspace differs from Factorize", n)
+		}
+	}
+}
+
+// TestFactorizeWithReuse must not be run in parallel since it counts
+// allocations.
+func TestFactorizeWithReuse(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	const n = 6
+	a := NewDense(n, n, nil)
+	sym := NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a.Set(i, j, rnd.NormFloat64())
+		}
+		for j := i; j < n; j++ {
+			sym.SetSym(i, j, rnd.NormFloat64())
+		}
+	}
+
+	// Refactorizing a receiver into the same workspace must reuse both the
+	// workspace and the storage of the previous factorization.
+	var (
+		ws  Workspace
+		lu  LU
+		qr  QR
+		svd SVD
+		es  EigenSym
+		eig Eigen
+	)
+	for _, test := range []struct {
+		name    string
+		with    func()
+		without func()
+	}{
+		{
+			name:    "LU",
+			with:    func() { lu.FactorizeWith(a, &ws) },
+			without: func() { lu.Factorize(a) },
+		},
+		{
+			name:    "QR",
+			with:    func() { qr.FactorizeWith(a, &ws) },
+			without: func() { qr.Factorize(a) },
+		},
+		{
+			name:    "SVD",
+			with:    func() { svd.FactorizeWith(a, SVDFull, &ws) },
+			without: func() { svd.Factorize(a, SVDFull) },
+		},
+		{
+			name:    "EigenSym",
+			with:    func() { es.FactorizeWith(sym, true, &ws) },
+			without: func() { es.Factorize(sym, true) },
+		},
+		{
+			name:    "Eigen",
+			with:    func() { eig.FactorizeWith(a, EigenBoth, &ws) },
+			without: func() { eig.Factorize(a, EigenBoth) },
+		},
+	} {
+		test.with()
+		got := testing.AllocsPerRun(10, test.with)
+		base := testing.AllocsPerRun(10, test.without)
+		if got > base {
+			t.Errorf("%s: workspace increased allocations: got %v, Factorize made %v", test.name, got, base)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// sameBessel returns whether got and want are equal within tol, with
+// equal NaN and infinite values considered the same.
+func sameBessel(got, want, tol float64) bool {
+	if math.IsNaN(got) || math.IsNaN(want) {
+		return math.IsNaN(got) && math.IsNaN(want)
+	}
+	if math.IsInf(want, 0) {
+		return got == want
+	}
+	return scalar.EqualWithinAbsOrRel(got, want, tol, tol)
+}
+
+func TestBesselValues(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	nan := math.NaN()
+	for _, test := range []struct {
+		nu, x      float64
+		j, y, i, k float64
+	}{
+		// Results computed from the power series with 80 digit precision.
+		// K_ν is omitted for large x where the series suffers from
+		// cancellation.
+		{nu: 0.3, x: 0.5, j: 0.70026048850705447, y: -0.80804750747749088, i: 0.77095173457921928, k: 0.97647412438178804},
+		{nu: 0.3, x: 2, j: 0.42569406198141363, y: 0.36348280782609216, i: 2.1776379895537374, k: 0.11603697434811983},
+		{nu: 0.3, x: 5, j: -0.29682911012576069, y: -0.19705687911614489, i: 26.962093779437936, k: nan},
+		{nu: 0.3, x: 12, j: -0.058942057108976792, y: -0.22259453945785637, i: 18874.745079467688, k: nan},
+		{nu: 1.7, x: 0.5, j: 0.059920175825577938, y: -3.3413067868148354, i: 0.062759535142037901, k: 4.444156320186134},
+		{nu: 1.7, x: 2, j: 0.43781146213067657, y: -0.49036218582288488, i: 0.92195478398536124, k: 0.20424626426274647},
+		{nu: 1.7, x: 5, j: -0.085089767345250394, y: 0.35626412768764787, i: 19.748516040401814, k: nan},
+		{nu: 1.7, x: 12, j: -0.16599459201170788, y: 0.16119969298660031, i: 16710.733664102208, k: nan},
+		{nu: 4.2, x: 0.5, j: 8.9783675554360584e-05, y: -850.54902462582243, i: 9.1968091510687318e-05, k: 1284.8515612520773},
+		{nu: 4.2, x: 2, j: 0.025247160500204167, y: -3.4769268930092845, i: 0.037097218444020641, k: 2.888043974118963},
+		{nu: 4.2, x: 5, j: 0.37140611526947731, y: -0.25146852916418722, i: 4.3515099553083489, k: nan},
+		{nu: 4.2, x: 12, j: 0.14086053084979813, y: -0.19153997062103453, i: 8867.5554399556604, k: nan},
+	} {
+		for _, fn := range []struct {
+			name string
+			fn   func(nu, x float64) float64
+			want float64
+		}{
+			{name: "BesselJ", fn: BesselJ, want: test.j},
+			{name: "BesselY", fn: BesselY, want: test.y},
+			{name: "BesselI", fn: BesselI, want: test.i},
+			{name: "BesselK", fn: BesselK, want: test.k},
+		} {
+			if math.IsNaN(fn.want) {
+				continue
+			}
+			got := fn.fn(test.nu, test.x)
+			if !sameBessel(got, fn.want, tol) {
+				t.Errorf("unexpected %s(%v, %v): got:%v want:%v", fn.name, test.nu, test.x, got, fn.want)
+			}
+		}
+	}
+}
+
+func TestBesselIntegerOrder(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	for _, x := range []float64{1e-5, 0.01, 0.3, 1, 1.99, 2, 2.5, 5, 10, 29, 31, 50, 100, 1000, 1e5} {
+		for n := 0; n < 40; n++ {
+			// Compare absolutely relative to the envelope of the
+			// functions to allow for results close to their zeros.
+			env := math.Max(1, math.Sqrt(x))
+			if got, want := BesselJ(float64(n), x), math.Jn(n, x); !scalar.EqualWithinAbsOrRel(got*env, want*env, tol, tol) {
+				t.Errorf("unexpected BesselJ(%d, %v): got:%v want:%v", n, x, got, want)
+			}
+			if got, want := BesselJ(float64(-n), x), math.Jn(-n, x); !scalar.EqualWithinAbsOrRel(got*env, want*env, tol, tol) {
+				t.Errorf("unexpected BesselJ(%d, %v): got:%v want:%v", -n, x, got, want)
+			}
+			if got, want := BesselY(float64(n), x), math.Yn(n, x); !scalar.EqualWithinAbsOrRel(got*env, want*env, tol, tol) {
+				t.Errorf("unexpected BesselY(%d, %v): got:%v want:%v", n, x, got, want)
+			}
+		}
+	}
+}
+
+func TestBesselHalfOrder(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	for _, x := range []float64{1e-5, 0.01, 0.3, 1, 2, 5, 10, 31, 100, 600, 1e5} {
+		s := math.Sqrt(2 / (math.Pi * x))
+		for _, test := range []struct {
+			name string
+			got  float64
+			want float64
+		}{
+			{name: "J(1/2)", got: BesselJ(0.5, x), want: s * math.Sin(x)},
+			{name: "J(-1/2)", got: BesselJ(-0.5, x), want: s * math.Cos(x)},
+			{name: "Y(1/2)", got: BesselY(0.5, x), want: -s * math.Cos(x)},
+			{name: "Y(-1/2)", got: BesselY(-0.5, x), want: s * math.Sin(x)},
+			{name: "I(1/2)", got: BesselI(0.5, x), want: s * math.Sinh(x)},
+			{name: "I(-1/2)", got: BesselI(-0.5, x), want: s * math.Cosh(x)},
+			{name: "Iscaled(1/2)", got: BesselIScaled(0.5, x), want: s * -math.Expm1(-2*x) / 2},
+			{name: "K(1/2)", got: BesselK(0.5, x), want: math.Sqrt(math.Pi/(2*x)) * math.Exp(-x)},
+			{name: "K(-1/2)", got: BesselK(-0.5, x), want: math.Sqrt(math.Pi/(2*x)) * math.Exp(-x)},
+			{name: "Kscaled(1/2)", got: BesselKScaled(0.5, x), want: math.Sqrt(math.Pi / (2 * x))},
+		} {
+			if !scalar.EqualWithinAbsOrRel(test.got, test.want, tol, tol) {
+				t.Errorf("unexpected %s at %v: got:%v want:%v", test.name, x, test.got, test.want)
+			}
+		}
+	}
+}
+
+func TestBesselWronskian(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	for _, x := range []float64{1e-3, 0.3, 1, 1.99, 2, 5, 10, 29, 31, 50, 100, 1000} {
+		for _, nu := range []float64{0.1, 1.0 / 3, 0.7, 1.2, 2.5, 3.7, 10.3, 25.6} {
+			name := fmt.Sprintf("ν=%v x=%v", nu, x)
+			// https://dlmf.nist.gov/10.5#E3
+			got := BesselJ(nu+1, x)*BesselY(nu, x) - BesselJ(nu, x)*BesselY(nu+1, x)
+			if want := 2 / (math.Pi * x); !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+				t.Errorf("unexpected J-Y Wronskian for %s: got:%v want:%v", name, got, want)
+			}
+			// https://dlmf.nist.gov/10.28#E2
+			got = BesselIScaled(nu, x)*BesselKScaled(nu+1, x) + BesselIScaled(nu+1, x)*BesselKScaled(nu, x)
+			if want := 1 / x; !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+				t.Errorf("unexpected I-K Wronskian for %s: got:%v want:%v", name, got, want)
+			}
+			// Reflection, https://dlmf.nist.gov/10.27#E2.
+			got = BesselI(-nu, x) - BesselI(nu, x)
+			want := 2 / math.Pi * math.Sin(nu*math.Pi) * BesselK(nu, x)
+			if x < 10 && !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+				t.Errorf("unexpected I reflection for %s: got:%v want:%v", name, got, want)
+			}
+		}
+	}
+}
+
+func TestBesselAiry(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	// Ai(x) = √(x/3) K_{1/3}(ζ)/π with ζ = 2/3 x^{3/2}.
+	// https://dlmf.nist.gov/9.6#E1
+	for _, x := range []float64{0.1, 0.5, 1, 2, 4, 8} {
+		zeta := 2.0 / 3 * math.Pow(x, 1.5)
+		got := math.Sqrt(x/3) * BesselK(1.0/3, zeta) / math.Pi
+		want := real(AiryAi(complex(x, 0)))
+		if !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+			t.Errorf("unexpected Airy function relation at %v: got:%v want:%v", x, got, want)
+		}
+	}
+}
+
+func TestBesselSpecialCases(t *testing.T) {
+	t.Parallel()
+	inf := math.Inf(1)
+	nan := math.NaN()
+	for _, test := range []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{name: "J(0, 0)", got: BesselJ(0, 0), want: 1},
+		{name: "J(1.5, 0)", got: BesselJ(1.5, 0), want: 0},
+		{name: "Y(1.5, 0)", got: BesselY(1.5, 0), want: -inf},
+		{name: "I(0, 0)", got: BesselI(0, 0), want: 1},
+		{name: "I(2.5, 0)", got: BesselI(2.5, 0), want: 0},
+		{name: "K(2.5, 0)", got: BesselK(2.5, 0), want: inf},
+		{name: "J(2.5, +Inf)", got: BesselJ(2.5, inf), want: 0},
+		{name: "Y(2.5, +Inf)", got: BesselY(2.5, inf), want: 0},
+		{name: "I(2.5, +Inf)", got: BesselI(2.5, inf), want: inf},
+		{name: "Iscaled(2.5, +Inf)", got: BesselIScaled(2.5, inf), want: 0},
+		{name: "K(2.5, +Inf)", got: BesselK(2.5, inf), want: 0},
+		{name: "J(2.5, -1)", got: BesselJ(2.5, -1), want: nan},
+		{name: "J(3, -1)", got: BesselJ(3, -1), want: -math.Jn(3, 1)},
+		{name: "I(2, -1)", got: BesselI(2, -1), want: BesselI(2, 1)},
+		{name: "Y(1, -1)", got: BesselY(1, -1), want: nan},
+		{name: "K(1, -1)", got: BesselK(1, -1), want: nan},
+		{name: "J(NaN, 1)", got: BesselJ(nan, 1), want: nan},
+		{name: "K(1, NaN)", got: BesselK(1, nan), want: nan},
+		{name: "I(+Inf, 1)", got: BesselI(inf, 1), want: nan},
+		{name: "Iscaled(0, 1e6)", got: BesselIScaled(0, 1e6), want: 1 / math.Sqrt(2*math.Pi*1e6) * (1 + 1/8e6)},
+		{name: "I(0, 800)", got: BesselI(0, 800), want: inf},
+		{name: "K(0, 800)", got: BesselK(0, 800), want: 0},
+	} {
+		if !sameBessel(test.got, test.want, 1e-14) {
+			t.Errorf("unexpected %s: got:%v want:%v", test.name, test.got, test.want)
+		}
+	}
+}
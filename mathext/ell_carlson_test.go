@@ -104,3 +104,159 @@ func TestEllipticE(t *testing.T) {
 		}
 	}
 }
+
+func TestEllipticRCRJ(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+
+	// Test values from B. C. Carlson, "Numerical computation of real or complex
+	// elliptic integrals", Numerical Algorithms 10(1):13-26, 1995.
+	for _, test := range []struct {
+		x, y float64
+		want float64
+	}{
+		{x: 0, y: 0.25, want: math.Pi},
+		{x: 2.25, y: 2, want: math.Ln2},
+		{x: 0.25, y: 0.25, want: 2},
+		{x: 0, y: 1, want: math.Pi / 2},
+	} {
+		got := EllipticRC(test.x, test.y)
+		if math.Abs(got-test.want) > tol*math.Abs(test.want) {
+			t.Errorf("unexpected EllipticRC(%v, %v): got:%v want:%v", test.x, test.y, got, test.want)
+		}
+	}
+	for _, test := range []struct {
+		x, y, z, p float64
+		want       float64
+	}{
+		{x: 0, y: 1, z: 2, p: 3, want: 0.77688623778582},
+		{x: 2, y: 3, z: 4, p: 5, want: 0.14297579667157},
+	} {
+		got := EllipticRJ(test.x, test.y, test.z, test.p)
+		if math.Abs(got-test.want) > 1e-13 {
+			t.Errorf("unexpected EllipticRJ(%v, %v, %v, %v): got:%v want:%v", test.x, test.y, test.z, test.p, got, test.want)
+		}
+	}
+
+	// R_J(x,y,z,z) = R_D(x,y,z), http://dlmf.nist.gov/19.20.E18.
+	rnd := rand.New(rand.NewSource(1))
+	for test := 0; test < 100; test++ {
+		x, y, z := rnd.Float64(), rnd.Float64(), 0.1+rnd.Float64()
+		got := EllipticRJ(x, y, z, z)
+		want := EllipticRD(x, y, z)
+		if math.Abs(got-want) > tol*want {
+			t.Errorf("unexpected EllipticRJ(%v, %v, %[3]v, %[3]v): got:%v want:%v", x, y, z, got, want)
+		}
+	}
+
+	for _, v := range [][]float64{{-1, 1}, {1, 0}, {math.NaN(), 1}} {
+		if got := EllipticRC(v[0], v[1]); !math.IsNaN(got) {
+			t.Errorf("expected NaN for EllipticRC(%v, %v): got:%v", v[0], v[1], got)
+		}
+	}
+	for _, v := range [][]float64{{-1, 1, 1, 1}, {1, 1, 1, 0}, {1, 1, 1, -1}, {0, 0, 1, 1}} {
+		if got := EllipticRJ(v[0], v[1], v[2], v[3]); !math.IsNaN(got) {
+			t.Errorf("expected NaN for EllipticRJ(%v, %v, %v, %v): got:%v", v[0], v[1], v[2], v[3], got)
+		}
+	}
+}
+
+func TestEllipticPi(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+
+	// simpson integrates f over [0, b] using the composite Simpson's rule.
+	simpson := func(f func(float64) float64, b float64) float64 {
+		const n = 2000
+		h := b / n
+		sum := f(0) + f(b)
+		for i := 1; i < n; i++ {
+			w := 2.0
+			if i%2 == 1 {
+				w = 4
+			}
+			sum += w * f(float64(i)*h)
+		}
+		return sum * h / 3
+	}
+
+	for _, n := range []float64{-5, -0.5, 0, 0.3, 0.9} {
+		for _, m := range []float64{0, 0.2, 0.5, 0.9} {
+			for _, phi := range []float64{0.2, 1, math.Pi / 2} {
+				got := EllipticPi(n, phi, m)
+				want := simpson(func(theta float64) float64 {
+					s := math.Sin(theta)
+					return 1 / ((1 - n*s*s) * math.Sqrt(1-m*s*s))
+				}, phi)
+				if math.Abs(got-want) > tol*math.Abs(want) {
+					t.Errorf("unexpected EllipticPi(%v, %v, %v): got:%v want:%v", n, phi, m, got, want)
+				}
+			}
+			if got, want := CompletePi(n, m), EllipticPi(n, math.Pi/2, m); math.Abs(got-want) > tol*want {
+				t.Errorf("unexpected CompletePi(%v, %v): got:%v want:%v", n, m, got, want)
+			}
+		}
+	}
+
+	// Π(0,φ,m) = F(φ,m) and Π(n,φ,0) = atan(sqrt(1-n)tan φ)/sqrt(1-n) for n<1.
+	for _, phi := range []float64{0.1, 0.7, 1.3} {
+		for _, m := range []float64{0.1, 0.6} {
+			if got, want := EllipticPi(0, phi, m), EllipticF(phi, m); math.Abs(got-want) > 1e-15 {
+				t.Errorf("unexpected EllipticPi(0, %v, %v): got:%v want:%v", phi, m, got, want)
+			}
+		}
+		for _, n := range []float64{-2, 0.5} {
+			want := math.Atan(math.Sqrt(1-n)*math.Tan(phi)) / math.Sqrt(1-n)
+			if got := EllipticPi(n, phi, 0); math.Abs(got-want) > 1e-14 {
+				t.Errorf("unexpected EllipticPi(%v, %v, 0): got:%v want:%v", n, phi, got, want)
+			}
+		}
+	}
+
+	// Integrand with a pole in the range of integration.
+	if got := EllipticPi(2, 1, 0.5); !math.IsNaN(got) {
+		t.Errorf("expected NaN for EllipticPi with pole: got:%v", got)
+	}
+	if got := CompletePi(1.5, 0.5); !math.IsNaN(got) {
+		t.Errorf("expected NaN for CompletePi with n>1: got:%v", got)
+	}
+}
+
+func TestEllipticQuasiPeriodic(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+
+	for _, m := range []float64{0, 0.3, 0.8} {
+		k := CompleteK(m)
+		e := CompleteE(m)
+		pi := CompletePi(0.4, m)
+		for _, phi := range []float64{-7.5, -2, 0.4, 1.8, 4, 10.2} {
+			var j float64
+			r := phi
+			for r > math.Pi/2 {
+				r -= math.Pi
+				j++
+			}
+			for r < -math.Pi/2 {
+				r += math.Pi
+				j--
+			}
+			for _, test := range []struct {
+				name      string
+				got, want float64
+			}{
+				{name: "F", got: EllipticF(phi, m), want: EllipticF(r, m) + 2*j*k},
+				{name: "E", got: EllipticE(phi, m), want: EllipticE(r, m) + 2*j*e},
+				{name: "Pi", got: EllipticPi(0.4, phi, m), want: EllipticPi(0.4, r, m) + 2*j*pi},
+			} {
+				if math.Abs(test.got-test.want) > tol*math.Max(1, math.Abs(test.want)) {
+					t.Errorf("unexpected %s(%v, %v): got:%v want:%v", test.name, phi, m, test.got, test.want)
+				}
+			}
+			// The integrals are odd in phi.
+			if got := EllipticF(-phi, m); got != -EllipticF(phi, m) {
+				t.Errorf("EllipticF not odd for phi=%v m=%v", phi, m)
+			}
+		}
+	}
+}
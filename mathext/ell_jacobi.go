@@ -0,0 +1,81 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import "math"
+
+// JacobiElliptic computes the Jacobi elliptic functions sn(u|m), cn(u|m) and
+// dn(u|m) for the parameter 0≤m≤1. The functions are defined through the
+// Jacobi amplitude φ = am(u|m), the inverse of the incomplete elliptic
+// integral of the 1st kind u = F(φ,m), as
+//
+//	sn(u|m) = \sin φ,
+//	cn(u|m) = \cos φ,
+//	dn(u|m) = \sqrt{1-m\sin^2 φ}.
+//
+// JacobiElliptic returns math.NaN() for all three functions if m is outside
+// [0, 1] or u or m is NaN.
+//
+// The functions are computed using the descending Landen transformation
+// and the arithmetic-geometric mean, as described in M. Abramowitz and
+// I. A. Stegun, "Handbook of Mathematical Functions", section 16.4, 1964.
+// See also http://dlmf.nist.gov/22.20.ii.
+func JacobiElliptic(u, m float64) (sn, cn, dn float64) {
+	switch {
+	case !(0 <= m && m <= 1), math.IsNaN(u):
+		return math.NaN(), math.NaN(), math.NaN()
+	case m == 0:
+		sn, cn = math.Sincos(u)
+		return sn, cn, 1
+	case m == 1:
+		sech := 1 / math.Cosh(u)
+		return math.Tanh(u), sech, sech
+	}
+	sn, cn = math.Sincos(amplitude(u, m))
+	// dn² = 1-m sn² = cn²+(1-m)sn² avoids cancellation when sn is close to one.
+	return sn, cn, math.Sqrt(cn*cn + (1-m)*sn*sn)
+}
+
+// JacobiAmplitude computes the Jacobi amplitude φ = am(u|m) for the parameter
+// 0≤m≤1, the inverse of the incomplete elliptic integral of the 1st kind with
+// respect to its amplitude, so that EllipticF(JacobiAmplitude(u, m), m) = u.
+//
+// JacobiAmplitude returns math.NaN() if m is outside [0, 1] or u or m is NaN.
+func JacobiAmplitude(u, m float64) float64 {
+	switch {
+	case !(0 <= m && m <= 1), math.IsNaN(u):
+		return math.NaN()
+	case m == 0:
+		return u
+	case m == 1:
+		// am(u|1) is the Gudermannian function.
+		return math.Atan(math.Sinh(u))
+	}
+	return amplitude(u, m)
+}
+
+// amplitude returns the amplitude am(u|m) for 0<m<1.
+func amplitude(u, m float64) float64 {
+	// maxIter bounds the AGM iteration which converges quadratically,
+	// so it is reached only for m very close to one.
+	const maxIter = 16
+
+	var a, c [maxIter + 1]float64
+	a[0] = 1
+	b := math.Sqrt(1 - m)
+	c[0] = math.Sqrt(m)
+	var n int
+	for n = 0; n < maxIter && math.Abs(c[n]) > machEp*a[n]; n++ {
+		a[n+1] = (a[n] + b) / 2
+		c[n+1] = (a[n] - b) / 2
+		b = math.Sqrt(a[n] * b)
+	}
+
+	phi := math.Ldexp(a[n]*u, n)
+	for ; n > 0; n-- {
+		phi = (phi + math.Asin(c[n]/a[n]*math.Sin(phi))) / 2
+	}
+	return phi
+}
@@ -0,0 +1,85 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestJacobiElliptic(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+
+	for test := 0; test < 1000; test++ {
+		u := 20 * (rnd.Float64() - 0.5)
+		m := rnd.Float64()
+		if test%10 == 0 {
+			m = 1 - math.Pow(10, -15*rnd.Float64())
+		}
+		sn, cn, dn := JacobiElliptic(u, m)
+		if got := sn*sn + cn*cn; !scalar.EqualWithinAbsOrRel(got, 1, tol, tol) {
+			t.Errorf("unexpected sn²+cn² for u=%v m=%v: got:%v want:1", u, m, got)
+		}
+		if got := dn*dn + m*sn*sn; !scalar.EqualWithinAbsOrRel(got, 1, tol, tol) {
+			t.Errorf("unexpected dn²+m sn² for u=%v m=%v: got:%v want:1", u, m, got)
+		}
+		if dn <= 0 {
+			t.Errorf("unexpected non-positive dn for u=%v m=%v: got:%v", u, m, dn)
+		}
+
+		phi := JacobiAmplitude(u, m)
+		if s, c := math.Sincos(phi); !scalar.EqualWithinAbs(s, sn, tol) || !scalar.EqualWithinAbs(c, cn, tol) {
+			t.Errorf("mismatched amplitude for u=%v m=%v: got:(%v,%v) want:(%v,%v)", u, m, s, c, sn, cn)
+		}
+		if m < 0.999 {
+			if got := EllipticF(phi, m); !scalar.EqualWithinAbsOrRel(got, u, tol, tol) {
+				t.Errorf("unexpected F(am(u|m),m) for u=%v m=%v: got:%v want:%v", u, m, got, u)
+			}
+		}
+	}
+
+	// Values at the quarter period, sn(K|m) = 1, cn(K|m) = 0 and dn(K|m) = sqrt(1-m).
+	for _, m := range []float64{0.1, 0.5, 0.9, 0.99} {
+		k := CompleteK(m)
+		sn, cn, dn := JacobiElliptic(k, m)
+		if !scalar.EqualWithinAbs(sn, 1, tol) || !scalar.EqualWithinAbs(cn, 0, 1e-8) || !scalar.EqualWithinAbs(dn, math.Sqrt(1-m), 1e-13) {
+			t.Errorf("unexpected values at quarter period for m=%v: got:(%v,%v,%v) want:(1,0,%v)", m, sn, cn, dn, math.Sqrt(1-m))
+		}
+		// Periodicity, sn(u+2K) = -sn(u) and dn(u+2K) = dn(u).
+		u := 0.3
+		sn0, cn0, dn0 := JacobiElliptic(u, m)
+		sn2, cn2, dn2 := JacobiElliptic(u+2*k, m)
+		if !scalar.EqualWithinAbs(sn2, -sn0, 1e-12) || !scalar.EqualWithinAbs(cn2, -cn0, 1e-12) || !scalar.EqualWithinAbs(dn2, dn0, 1e-12) {
+			t.Errorf("unexpected half period shift for m=%v: got:(%v,%v,%v) want:(%v,%v,%v)", m, sn2, cn2, dn2, -sn0, -cn0, dn0)
+		}
+	}
+
+	// Limiting cases.
+	for _, u := range []float64{-2, 0, 0.5, 3} {
+		sn, cn, dn := JacobiElliptic(u, 0)
+		if sn != math.Sin(u) || cn != math.Cos(u) || dn != 1 {
+			t.Errorf("unexpected values for m=0, u=%v: got:(%v,%v,%v)", u, sn, cn, dn)
+		}
+		sn, cn, dn = JacobiElliptic(u, 1)
+		if sn != math.Tanh(u) || cn != 1/math.Cosh(u) || dn != 1/math.Cosh(u) {
+			t.Errorf("unexpected values for m=1, u=%v: got:(%v,%v,%v)", u, sn, cn, dn)
+		}
+	}
+	for _, m := range []float64{-0.1, 1.1, math.NaN()} {
+		sn, cn, dn := JacobiElliptic(0.5, m)
+		if !math.IsNaN(sn) || !math.IsNaN(cn) || !math.IsNaN(dn) {
+			t.Errorf("expected NaN for m=%v: got:(%v,%v,%v)", m, sn, cn, dn)
+		}
+		if am := JacobiAmplitude(0.5, m); !math.IsNaN(am) {
+			t.Errorf("expected NaN amplitude for m=%v: got:%v", m, am)
+		}
+	}
+}
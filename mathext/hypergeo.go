@@ -0,0 +1,353 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import "math"
+
+const (
+	// hypMaxIter is the maximum number of terms summed in
+	// a hypergeometric series.
+	hypMaxIter = 1 << 17
+
+	// hypNearInt is the distance of c-a-b from an integer below
+	// which the linear transformation of 2F1 to 1-x loses too
+	// much precision to cancellation.
+	hypNearInt = 1e-3
+
+	// hypScale is the threshold above which partial sums of
+	// the 1F1 series are rescaled to avoid overflow.
+	hypScale = 0x1p500
+)
+
+// Hyp2F1 returns the value of the Gauss hypergeometric function
+//
+//	2F1(a,b;c;x) = \sum_{n=0}^{\infty} (a)_n (b)_n / ((c)_n n!) x^n
+//
+// for real parameters and x ≤ 1, where (a)_n is the rising factorial.
+//
+// The series is summed directly for 0 ≤ x ≤ 1/2. Negative x are mapped into
+// [0, 1) by the Pfaff transformation and 1/2 < x < 1 are mapped to 1-x by
+// the linear transformation formulas, including the logarithmic cases where
+// c-a-b is an integer.
+//
+// If a or b is a non-positive integer the series terminates and 2F1 is a
+// polynomial in x that is evaluated for all x. Otherwise Hyp2F1 returns NaN
+// for x > 1, where 2F1 is complex, and for c a non-positive integer, where
+// 2F1 is undefined. At x = 1 the sum is given by Gauss's theorem when
+// c-a-b > 0 and is infinite otherwise.
+//
+// See https://dlmf.nist.gov/15 and M. Abramowitz and I. A. Stegun,
+// "Handbook of Mathematical Functions", chapter 15, 1964, for more details.
+func Hyp2F1(a, b, c, x float64) float64 {
+	switch {
+	case math.IsNaN(a), math.IsNaN(b), math.IsNaN(c), math.IsNaN(x):
+		return math.NaN()
+	case x == 0, a == 0, b == 0:
+		return 1
+	}
+
+	// Terminating series.
+	na, aTerm := nonPosInt(a)
+	nb, bTerm := nonPosInt(b)
+	nc, cPole := nonPosInt(c)
+	if aTerm || bTerm {
+		n := na
+		if !aTerm || (bTerm && nb < na) {
+			n = nb
+		}
+		if cPole && nc < n {
+			return math.NaN()
+		}
+		if math.IsInf(x, 0) {
+			// The polynomial is dominated by its leading term.
+			lead := 1.0
+			for k := 0.0; k < n; k++ {
+				lead *= (a + k) * (b + k) / (c + k) * x
+			}
+			return lead
+		}
+		return hyp2f1Series(a, b, c, x)
+	}
+
+	switch {
+	case cPole, x > 1, math.IsInf(x, 0):
+		return math.NaN()
+	case x == 1:
+		s := c - a - b
+		if s > 0 {
+			return gammaRatio([]float64{c, s}, []float64{c - a, c - b})
+		}
+		// The series diverges with the sign of the leading
+		// coefficient of the singular part.
+		return math.Copysign(math.Inf(1), gammaRatio([]float64{c}, []float64{a, b}))
+	case x < 0:
+		// Pfaff transformation, https://dlmf.nist.gov/15.8.E1.
+		z := x / (x - 1)
+		if _, ok := nonPosInt(c - a); ok {
+			return math.Pow(1-x, -b) * hyp2f1Unit(c-a, b, c, z)
+		}
+		return math.Pow(1-x, -a) * hyp2f1Unit(a, c-b, c, z)
+	}
+	return hyp2f1Unit(a, b, c, x)
+}
+
+// hyp2f1Unit returns 2F1(a,b;c;x) for 0 ≤ x < 1.
+func hyp2f1Unit(a, b, c, x float64) float64 {
+	if x <= 0.5 {
+		return hyp2f1Series(a, b, c, x)
+	}
+	if _, ok := nonPosInt(a); ok {
+		return hyp2f1Series(a, b, c, x)
+	}
+	if _, ok := nonPosInt(b); ok {
+		return hyp2f1Series(a, b, c, x)
+	}
+
+	s := c - a - b
+	m := math.Round(s)
+	switch {
+	case s == m && m < 0:
+		// Euler transformation, https://dlmf.nist.gov/15.8.E1,
+		// to make c-a-b positive.
+		return math.Pow(1-x, s) * hyp2f1Unit(c-a, c-b, c, x)
+	case s == m:
+		return hyp2f1Log(a, b, c, int(m), x)
+	case math.Abs(s-m) < hypNearInt:
+		// The linear transformation suffers from cancellation
+		// for c-a-b close to an integer, so sum the series
+		// which converges for all x < 1.
+		return hyp2f1Series(a, b, c, x)
+	}
+
+	// Linear transformation to 1-x, https://dlmf.nist.gov/15.8.E4.
+	y := 1 - x
+	f := gammaRatio([]float64{c, s}, []float64{c - a, c - b}) * hyp2f1Series(a, b, 1-s, y)
+	g := gammaRatio([]float64{c, -s}, []float64{a, b})
+	if g != 0 {
+		f += g * math.Pow(y, s) * hyp2f1Series(c-a, c-b, 1+s, y)
+	}
+	return f
+}
+
+// hyp2f1Log returns 2F1(a,b;c;x) for 1/2 < x < 1 when c-a-b is the
+// non-negative integer m, and neither a nor b is a non-positive integer.
+// See Abramowitz and Stegun, equations 15.3.10 and 15.3.11.
+func hyp2f1Log(a, b, c float64, m int, x float64) float64 {
+	y := 1 - x
+	mf := float64(m)
+
+	// Finite sum.
+	var f float64
+	if m > 0 {
+		term := 1.0
+		for n := 0; n < m; n++ {
+			f += term
+			nf := float64(n)
+			term *= (a + nf) * (b + nf) / ((nf + 1) * (1 - mf + nf)) * y
+		}
+		f *= gammaRatio([]float64{mf, c}, []float64{a + mf, b + mf})
+	}
+
+	// Logarithmic series.
+	psi1 := digammaShifted(1)
+	psim := digammaShifted(mf + 1)
+	psia := digammaShifted(a + mf)
+	psib := digammaShifted(b + mf)
+	logy := math.Log(y)
+	term := 1 / factorial(m)
+	var sum float64
+	for n := 0; n < hypMaxIter; n++ {
+		sum += term * (logy - psi1 - psim + psia + psib)
+		nf := float64(n)
+		r := (a + mf + nf) * (b + mf + nf) / ((nf + 1) * (nf + mf + 1)) * y
+		// Only stop once the terms are decreasing, bounding the term
+		// without cancellation within the bracket which may vanish.
+		bound := math.Abs(term) * (math.Abs(logy) + math.Abs(psi1) + math.Abs(psim) + math.Abs(psia) + math.Abs(psib))
+		if bound <= machEp*math.Abs(sum) && math.Abs(r) < 1 {
+			break
+		}
+		term *= r
+		psi1 += 1 / (nf + 1)
+		psim += 1 / (nf + mf + 1)
+		psia += 1 / (a + mf + nf)
+		psib += 1 / (b + mf + nf)
+	}
+	if m%2 == 1 {
+		sum = -sum
+	}
+	return f - gammaRatio([]float64{c}, []float64{a, b})*math.Pow(y, mf)*sum
+}
+
+// hyp2f1Series returns the sum of the hypergeometric series 2F1(a,b;c;x).
+func hyp2f1Series(a, b, c, x float64) float64 {
+	sum, term := 1.0, 1.0
+	for k := 0.0; k < hypMaxIter; k++ {
+		term *= (a + k) * (b + k) / ((c + k) * (k + 1)) * x
+		sum += term
+		if term == 0 {
+			break
+		}
+		// Only stop once the terms are decreasing.
+		next := (a + k + 1) * (b + k + 1) / ((c + k + 1) * (k + 2)) * x
+		if math.Abs(term) <= machEp*math.Abs(sum) && math.Abs(next) < 1 {
+			break
+		}
+	}
+	return sum
+}
+
+// Hyp1F1 returns the value of Kummer's confluent hypergeometric function
+//
+//	1F1(a;b;x) = M(a,b,x) = \sum_{n=0}^{\infty} (a)_n / ((b)_n n!) x^n
+//
+// for real parameters and argument, where (a)_n is the rising factorial.
+//
+// Negative x are mapped to positive x by Kummer's transformation
+// 1F1(a;b;x) = e^x 1F1(b-a;b;-x) so that the series is summed without
+// cancellation when a and b are positive.
+//
+// Hyp1F1 returns NaN if b is a non-positive integer, where 1F1 is undefined,
+// unless a is a non-positive integer with a > b, in which case the series
+// terminates before the pole.
+//
+// See https://dlmf.nist.gov/13 and M. Abramowitz and I. A. Stegun,
+// "Handbook of Mathematical Functions", chapter 13, 1964, for more details.
+func Hyp1F1(a, b, x float64) float64 {
+	switch {
+	case math.IsNaN(a), math.IsNaN(b), math.IsNaN(x):
+		return math.NaN()
+	case x == 0, a == 0:
+		return 1
+	case a == b:
+		return math.Exp(x)
+	}
+
+	na, aTerm := nonPosInt(a)
+	nb, bPole := nonPosInt(b)
+	switch {
+	case bPole && !(aTerm && na < nb):
+		return math.NaN()
+	case aTerm && math.IsInf(x, 0):
+		// The polynomial is dominated by its leading term.
+		lead := 1.0
+		for k := 0.0; k < na; k++ {
+			lead *= (a + k) / (b + k) * x
+		}
+		return lead
+	case aTerm:
+		sum, scale := hyp1f1Series(a, b, x)
+		return math.Ldexp(sum, scale)
+	case math.IsInf(x, 1):
+		return math.Copysign(math.Inf(1), gammaRatio([]float64{b}, []float64{a}))
+	case math.IsInf(x, -1):
+		// 1F1(a;b;x) ~ Γ(b)/Γ(b-a) (-x)^-a as x → -∞.
+		g := gammaRatio([]float64{b}, []float64{b - a})
+		if a > 0 || g == 0 {
+			return 0
+		}
+		return math.Copysign(math.Inf(1), g)
+	case x < 0:
+		// Kummer's transformation, https://dlmf.nist.gov/13.2.E39.
+		sum, scale := hyp1f1Series(b-a, b, -x)
+		if scale == 0 {
+			return math.Exp(x) * sum
+		}
+		return math.Exp(x+float64(scale)*math.Ln2) * sum
+	}
+	sum, scale := hyp1f1Series(a, b, x)
+	return math.Ldexp(sum, scale)
+}
+
+// hyp1f1Series returns the sum of the hypergeometric series 1F1(a;b;x) as
+// sum×2^scale.
+func hyp1f1Series(a, b, x float64) (sum float64, scale int) {
+	sum, term := 1.0, 1.0
+	for k := 0.0; k < hypMaxIter; k++ {
+		term *= (a + k) / ((b + k) * (k + 1)) * x
+		sum += term
+		if term == 0 || math.IsInf(term, 0) {
+			break
+		}
+		if math.Abs(sum) > hypScale {
+			sum /= hypScale
+			term /= hypScale
+			scale += 500
+		}
+		// Only stop once the terms are decreasing.
+		next := (a + k + 1) / ((b + k + 1) * (k + 2)) * x
+		if math.Abs(term) <= machEp*math.Abs(sum) && math.Abs(next) < 1 {
+			break
+		}
+	}
+	return sum, scale
+}
+
+// nonPosInt returns -x and true if x is a non-positive integer.
+func nonPosInt(x float64) (n float64, ok bool) {
+	if x <= 0 && x == math.Trunc(x) {
+		return -x, true
+	}
+	return 0, false
+}
+
+// gammaRatio returns the product of Γ(x) for x in num divided by the product
+// of Γ(x) for x in den. The reciprocal gamma function is taken to be zero at
+// the non-positive integers.
+func gammaRatio(num, den []float64) float64 {
+	for _, v := range den {
+		if _, ok := nonPosInt(v); ok {
+			return 0
+		}
+	}
+	r := 1.0
+	for _, v := range num {
+		r *= math.Gamma(v)
+	}
+	for _, v := range den {
+		r /= math.Gamma(v)
+	}
+	if r != 0 && !math.IsInf(r, 0) && !math.IsNaN(r) {
+		return r
+	}
+
+	// Intermediate overflow or underflow, so work with logarithms.
+	var lr float64
+	sign := 1
+	for _, v := range num {
+		lg, s := math.Lgamma(v)
+		lr += lg
+		sign *= s
+	}
+	for _, v := range den {
+		lg, s := math.Lgamma(v)
+		lr -= lg
+		sign *= s
+	}
+	return float64(sign) * math.Exp(lr)
+}
+
+// factorial returns n!.
+func factorial(n int) float64 {
+	f := 1.0
+	for i := 2; i <= n; i++ {
+		f *= float64(i)
+	}
+	return f
+}
+
+// digammaShifted returns ψ(x), using the recurrence ψ(x) = ψ(x+1) - 1/x
+// to move positive x into the range where the asymptotic expansion used
+// by Digamma is accurate to full precision, as needed by the logarithmic
+// series of hyp2f1Log.
+func digammaShifted(x float64) float64 {
+	if x <= 0 {
+		return Digamma(x)
+	}
+	var sum float64
+	for ; x < 20; x++ {
+		sum += 1 / x
+	}
+	return Digamma(x) - sum
+}
@@ -0,0 +1,189 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestHyp2F1(t *testing.T) {
+	t.Parallel()
+
+	// Reference values were computed by summing the series with
+	// 60 digit decimal arithmetic.
+	for _, test := range []struct {
+		a, b, c, x float64
+		want       float64
+		tol        float64
+	}{
+		{a: 0.3, b: 0.7, c: 1.9, x: 0.4, want: 1.0528748855080596, tol: 1e-15},
+		{a: 1.5, b: -2.3, c: 3.1, x: 0.8, want: 0.37438196880089786, tol: 1e-14},
+		{a: 2.2, b: 3.1, c: 4.7, x: 0.95, want: 31.435507527543507, tol: 1e-13},
+		{a: -1.5, b: 2.5, c: 0.7, x: -0.6, want: 5.0809997109812688, tol: 1e-14},
+		{a: 0.5, b: 0.25, c: 2.75, x: 0.75, want: 1.0436663860327575, tol: 1e-14},
+		{a: 3.3, b: 1.2, c: 5.5, x: -0.9, want: 0.60625707426415354, tol: 1e-14},
+		{a: 1, b: 2, c: 3.5, x: 0.99, want: 3.9959919938974782, tol: 1e-13},
+
+		// Terminating series.
+		{a: -2, b: 3, c: 1.5, x: 2, want: 1 - 2*3/1.5*2 + (-2*-1)*(3*4)/(1.5*2.5*2)*4, tol: 1e-15},
+		{a: 2, b: -1, c: -3, x: 5, want: 1 + 2*-1/-3.0*5, tol: 1e-15},
+
+		// Gauss's theorem.
+		{a: 0.5, b: 0.5, c: 2, x: 1, want: 4 / math.Pi, tol: 1e-15},
+		{a: 1, b: 1, c: 1, x: 1, want: math.Inf(1)},
+	} {
+		got := Hyp2F1(test.a, test.b, test.c, test.x)
+		if !scalar.EqualWithinAbsOrRel(got, test.want, test.tol, test.tol) {
+			t.Errorf("unexpected Hyp2F1(%v, %v, %v, %v): got:%v want:%v", test.a, test.b, test.c, test.x, got, test.want)
+		}
+	}
+
+	// Elementary and special function representations.
+	const tol = 1e-13
+	for _, x := range []float64{-20, -3, -0.9, -0.3, 0.01, 0.2, 0.5, 0.6, 0.75, 0.9, 0.999} {
+		for _, test := range []struct {
+			name      string
+			got, want float64
+		}{
+			{name: "2F1(1,1;2;x)", got: Hyp2F1(1, 1, 2, x), want: -math.Log1p(-x) / x},
+			{name: "2F1(a,b;b;x)", got: Hyp2F1(1.3, 2.5, 2.5, x), want: math.Pow(1-x, -1.3)},
+			{name: "2F1(1/2,1;3/2;x)", got: Hyp2F1(0.5, 1, 1.5, -math.Abs(x)), want: math.Atan(math.Sqrt(math.Abs(x))) / math.Sqrt(math.Abs(x))},
+			{name: "incomplete beta", got: Hyp2F1(2.5, 1-3.7, 3.5, math.Abs(x)/20), want: RegIncBeta(2.5, 3.7, math.Abs(x)/20) * 2.5 * Beta(2.5, 3.7) / math.Pow(math.Abs(x)/20, 2.5)},
+		} {
+			if !scalar.EqualWithinAbsOrRel(test.got, test.want, tol, tol) {
+				t.Errorf("unexpected %s for x=%v: got:%v want:%v", test.name, x, test.got, test.want)
+			}
+		}
+		if x <= 0 || x >= 1 {
+			continue
+		}
+		for _, test := range []struct {
+			name      string
+			got, want float64
+		}{
+			// Integer c-a-b, requiring the logarithmic transformations.
+			{name: "2F1(1/2,1/2;1;m)", got: Hyp2F1(0.5, 0.5, 1, x), want: 2 / math.Pi * CompleteK(x)},
+			{name: "2F1(-1/2,1/2;1;m)", got: Hyp2F1(-0.5, 0.5, 1, x), want: 2 / math.Pi * CompleteE(x)},
+			{name: "2F1(1/2,1/2;3/2;x²)", got: Hyp2F1(0.5, 0.5, 1.5, x), want: math.Asin(math.Sqrt(x)) / math.Sqrt(x)},
+			{name: "2F1(1,1;3;x)", got: Hyp2F1(1, 1, 3, x), want: 2 * ((1-x)*math.Log1p(-x) + x) / (x * x)},
+			{name: "2F1(2,1;1;x)", got: Hyp2F1(2, 1, 1, x), want: 1 / ((1 - x) * (1 - x))},
+		} {
+			if !scalar.EqualWithinAbsOrRel(test.got, test.want, tol, tol) {
+				t.Errorf("unexpected %s for x=%v: got:%v want:%v", test.name, x, test.got, test.want)
+			}
+		}
+	}
+
+	// Euler's transformation, https://dlmf.nist.gov/15.8.E1.
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		a := 6*rnd.Float64() - 3
+		b := 6*rnd.Float64() - 3
+		c := 6*rnd.Float64() - 1
+		x := 1.5*rnd.Float64() - 0.5
+		if x > 0.98 {
+			continue
+		}
+		got := Hyp2F1(a, b, c, x)
+		want := math.Pow(1-x, c-a-b) * Hyp2F1(c-a, c-b, c, x)
+		if !scalar.EqualWithinAbsOrRel(got, want, 1e-10, 1e-10) {
+			t.Errorf("Euler transformation mismatch for a=%v b=%v c=%v x=%v: got:%v want:%v", a, b, c, x, got, want)
+		}
+	}
+
+	for _, test := range []struct{ a, b, c, x float64 }{
+		{a: 1, b: 1, c: 2, x: 1.5},
+		{a: 1, b: 1, c: -2, x: 0.5},
+		{a: -3, b: 1, c: -2, x: 0.5},
+		{a: math.NaN(), b: 1, c: 2, x: 0.5},
+	} {
+		if got := Hyp2F1(test.a, test.b, test.c, test.x); !math.IsNaN(got) {
+			t.Errorf("expected NaN for Hyp2F1(%v, %v, %v, %v): got:%v", test.a, test.b, test.c, test.x, got)
+		}
+	}
+}
+
+func TestHyp1F1(t *testing.T) {
+	t.Parallel()
+
+	// Reference values were computed by summing the series with
+	// 80 digit decimal arithmetic.
+	for _, test := range []struct {
+		a, b, x float64
+		want    float64
+		tol     float64
+	}{
+		{a: 0.3, b: 1.7, x: 2.5, want: 1.9378165350288672, tol: 1e-15},
+		{a: -2.5, b: 1.5, x: 10, want: -6.1587671094517695, tol: 1e-13},
+		{a: 1.5, b: 2.5, x: -30, want: 0.0080901079689773246, tol: 1e-14},
+		{a: -0.5, b: 0.3, x: -3, want: 4.2899095467285022, tol: 1e-14},
+		{a: 5.5, b: 0.25, x: 20, want: 610052640547108.25, tol: 1e-14},
+		{a: 2, b: 3.5, x: -50.5, want: 0.0014408756436898023, tol: 1e-14},
+		{a: -10.5, b: 2.5, x: 15, want: -8.474004635397419, tol: 1e-11},
+
+		// Laguerre polynomial L_3(x) = (-x³+9x²-18x+6)/6.
+		{a: -3, b: 1, x: 2.5, want: (-2.5*2.5*2.5 + 9*2.5*2.5 - 18*2.5 + 6) / 6, tol: 1e-15},
+		{a: -3, b: 1, x: -4, want: (64 + 9*16 + 18*4 + 6) / 6.0, tol: 1e-15},
+
+		{a: 1.5, b: 1.5, x: -2, want: math.Exp(-2), tol: 0},
+		{a: 0.5, b: 1.5, x: math.Inf(1), want: math.Inf(1)},
+		{a: 0.5, b: 1.5, x: math.Inf(-1), want: 0},
+		{a: -2, b: 1, x: math.Inf(1), want: math.Inf(1)},
+	} {
+		got := Hyp1F1(test.a, test.b, test.x)
+		if !scalar.EqualWithinAbsOrRel(got, test.want, test.tol, test.tol) {
+			t.Errorf("unexpected Hyp1F1(%v, %v, %v): got:%v want:%v", test.a, test.b, test.x, got, test.want)
+		}
+	}
+
+	const tol = 1e-13
+	for _, x := range []float64{-700, -50, -5, -0.5, 0.001, 0.3, 4, 30, 200} {
+		for _, test := range []struct {
+			name      string
+			got, want float64
+		}{
+			{name: "1F1(1;2;x)", got: Hyp1F1(1, 2, x), want: math.Expm1(x) / x},
+			{name: "1F1(1/2;3/2;-x²)", got: Hyp1F1(0.5, 1.5, -math.Abs(x)/100), want: math.Sqrt(math.Pi) / 2 * math.Erf(math.Sqrt(math.Abs(x)/100)) / math.Sqrt(math.Abs(x)/100)},
+			// https://dlmf.nist.gov/8.5.E1.
+			{name: "incomplete gamma", got: Hyp1F1(1, 3.5, math.Abs(x)), want: GammaIncReg(2.5, math.Abs(x)) * math.Gamma(3.5) * math.Exp(math.Abs(x)) / math.Pow(math.Abs(x), 2.5)},
+		} {
+			if math.IsInf(test.want, 0) || math.IsNaN(test.want) {
+				continue
+			}
+			if !scalar.EqualWithinAbsOrRel(test.got, test.want, tol, tol) {
+				t.Errorf("unexpected %s for x=%v: got:%v want:%v", test.name, x, test.got, test.want)
+			}
+		}
+	}
+
+	// Modified Bessel function relation, https://dlmf.nist.gov/10.39.E5.
+	for _, nu := range []float64{0, 0.3, 2.5} {
+		for _, x := range []float64{0.5, 3, 20} {
+			got := Hyp1F1(nu+0.5, 2*nu+1, 2*x)
+			want := math.Gamma(1+nu) * math.Exp(x) * math.Pow(x/2, -nu) * BesselI(nu, x)
+			if !scalar.EqualWithinAbsOrRel(got, want, 1e-13, 1e-13) {
+				t.Errorf("unexpected 1F1(ν+1/2;2ν+1;2x) for ν=%v x=%v: got:%v want:%v", nu, x, got, want)
+			}
+		}
+	}
+
+	for _, test := range []struct{ a, b, x float64 }{
+		{a: 1, b: -2, x: 0.5},
+		{a: -3, b: -2, x: 0.5},
+		{a: 1, b: math.NaN(), x: 0.5},
+	} {
+		if got := Hyp1F1(test.a, test.b, test.x); !math.IsNaN(got) {
+			t.Errorf("expected NaN for Hyp1F1(%v, %v, %v): got:%v", test.a, test.b, test.x, got)
+		}
+	}
+	if got, want := Hyp1F1(-2, -3, 2), 1+(-2.0/-3)*2+(-2.0*-1)/(-3*-2)*2; !scalar.EqualWithinRel(got, want, 1e-15) {
+		t.Errorf("unexpected Hyp1F1(-2, -3, 2): got:%v want:%v", got, want)
+	}
+}
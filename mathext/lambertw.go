@@ -0,0 +1,163 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import "math"
+
+// LambertW0 returns the principal branch of the Lambert W function at x,
+// the solution w ≥ -1 of
+//
+//	w e^w = x.
+//
+// The principal branch is real for x ≥ -1/e.
+//
+// Special cases are:
+//
+//	LambertW0(x) = NaN for x < -1/e
+//	LambertW0(-1/e) = -1
+//	LambertW0(0) = 0
+//	LambertW0(+Inf) = +Inf
+//	LambertW0(NaN) = NaN
+//
+// See R. M. Corless, G. H. Gonnet, D. E. G. Hare, D. J. Jeffrey and
+// D. E. Knuth, "On the Lambert W function", Advances in Computational
+// Mathematics 5:329–359, 1996, and https://dlmf.nist.gov/4.13 for more
+// details.
+func LambertW0(x float64) float64 {
+	switch {
+	case math.IsNaN(x), math.IsInf(x, 1), x == 0:
+		return x
+	case x < -1/math.E:
+		return math.NaN()
+	}
+	p, ok := branchDist(x)
+	if !ok {
+		return math.NaN()
+	}
+	var w float64
+	switch {
+	case p < 0.5:
+		w = branchSeries(p)
+		if p < 1e-3 {
+			// The series is accurate to working precision.
+			return w
+		}
+	case math.Abs(x) < 0.25:
+		// Taylor series about zero, https://dlmf.nist.gov/4.13#E5.
+		w = x * (1 + x*(-1+x*(1.5+x*(-8.0/3))))
+	case x < 3:
+		w = 0.5 * math.Log1p(x) * (1 + 1/(1+math.Log1p(x)))
+	default:
+		// Asymptotic expansion, https://dlmf.nist.gov/4.13#E10.
+		l1 := math.Log(x)
+		l2 := math.Log(l1)
+		w = l1 - l2 + l2/l1
+	}
+	return halleyW(x, w)
+}
+
+// LambertWm1 returns the lower branch of the Lambert W function at x,
+// the solution w ≤ -1 of
+//
+//	w e^w = x.
+//
+// The lower branch is real for -1/e ≤ x < 0.
+//
+// Special cases are:
+//
+//	LambertWm1(x) = NaN for x < -1/e or x > 0
+//	LambertWm1(-1/e) = -1
+//	LambertWm1(±0) = -Inf
+//	LambertWm1(NaN) = NaN
+//
+// See R. M. Corless, G. H. Gonnet, D. E. G. Hare, D. J. Jeffrey and
+// D. E. Knuth, "On the Lambert W function", Advances in Computational
+// Mathematics 5:329–359, 1996, and https://dlmf.nist.gov/4.13 for more
+// details.
+func LambertWm1(x float64) float64 {
+	switch {
+	case math.IsNaN(x):
+		return x
+	case x == 0:
+		return math.Inf(-1)
+	case x < -1/math.E, x > 0:
+		return math.NaN()
+	}
+	p, ok := branchDist(x)
+	if !ok {
+		return math.NaN()
+	}
+	var w float64
+	if p < 0.5 {
+		w = branchSeries(-p)
+		if p < 1e-3 {
+			return w
+		}
+	} else {
+		// Asymptotic expansion about zero, https://dlmf.nist.gov/4.13#E11.
+		l1 := math.Log(-x)
+		l2 := math.Log(-l1)
+		w = l1 - l2 + l2/l1
+	}
+	return halleyW(x, w)
+}
+
+// branchDist returns p = sqrt(2(e x + 1)), the scaled distance of x from
+// the branch point at -1/e, and whether x is within the domain. The
+// product e x is computed with a two-part representation of e so that p
+// is accurate close to the branch point.
+func branchDist(x float64) (p float64, ok bool) {
+	const (
+		eHi = math.E
+		eLo = 1.4456468917292502e-16 // e - eHi
+	)
+	d := math.FMA(eHi, x, 1) + eLo*x
+	if d < 0 {
+		// x is -1/e to within rounding error, so allow
+		// very small negative values.
+		if d < -4*eLo {
+			return math.NaN(), false
+		}
+		d = 0
+	}
+	return math.Sqrt(2 * d), true
+}
+
+// branchSeries returns the series expansion of W about the branch point
+// at -1/e in terms of p = ±sqrt(2(e x + 1)), with positive p for the
+// principal branch and negative p for the lower branch.
+// See https://dlmf.nist.gov/4.13#E6.
+func branchSeries(p float64) float64 {
+	const (
+		c2 = -1.0 / 3
+		c3 = 11.0 / 72
+		c4 = -43.0 / 540
+		c5 = 769.0 / 17280
+		c6 = -221.0 / 8505
+		c7 = 680863.0 / 43545600
+		c8 = -1963.0 / 204120
+		c9 = 226287557.0 / 37623398400
+	)
+	return -1 + p*(1+p*(c2+p*(c3+p*(c4+p*(c5+p*(c6+p*(c7+p*(c8+p*c9))))))))
+}
+
+// halleyW refines the estimate w of W(x) using Halley's method.
+func halleyW(x, w float64) float64 {
+	const maxIter = 20
+	for i := 0; i < maxIter; i++ {
+		ew := math.Exp(w)
+		f := w*ew - x
+		wp1 := w + 1
+		if wp1 == 0 {
+			return w
+		}
+		delta := f / (ew*wp1 - (w+2)*f/(2*wp1))
+		w -= delta
+		if math.Abs(delta) <= 1e-15*math.Abs(w) || math.IsNaN(delta) {
+			break
+		}
+	}
+	return w
+}
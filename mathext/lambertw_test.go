@@ -0,0 +1,79 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestLambertW(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+
+	for i, test := range []struct {
+		x, w0, wm1 float64
+	}{
+		// Results computed by Newton iteration with 60 digit precision.
+		{x: -1 / math.E, w0: -1, wm1: -1},
+		{x: -0.367879441171, w0: -0.999998449325332, wm1: -1.0000015506762712},
+		{x: -0.3678794, w0: -0.99952696660770057, wm1: -1.0004731826130855},
+		{x: -0.367, w0: -0.93239918474792827, wm1: -1.0707918867680521},
+		{x: -0.36, w0: -0.80608431597081776, wm1: -1.222770133978506},
+		{x: -0.3, w0: -0.48940222718021498, wm1: -1.7813370234216277},
+		{x: -0.1, w0: -0.11183255915896297, wm1: -3.5771520639572971},
+		{x: -1e-5, w0: -1.0000100001500027e-05, wm1: -14.163600815810183},
+		{x: 0, w0: 0, wm1: math.Inf(-1)},
+		{x: 1e-10, w0: 9.9999999989999997e-11, wm1: math.NaN()},
+		{x: 0.1, w0: 0.091276527160862264, wm1: math.NaN()},
+		{x: 0.5, w0: 0.35173371124919584, wm1: math.NaN()},
+		{x: 1, w0: 0.56714329040978384, wm1: math.NaN()},
+		{x: math.E, w0: 1, wm1: math.NaN()},
+		{x: 3, w0: 1.0499088949640401, wm1: math.NaN()},
+		{x: 10, w0: 1.7455280027406994, wm1: math.NaN()},
+		{x: 100, w0: 3.3856301402900502, wm1: math.NaN()},
+		{x: 1e10, w0: 20.028685413304952, wm1: math.NaN()},
+		{x: 1e300, w0: 684.24720862976085, wm1: math.NaN()},
+		{x: math.Inf(1), w0: math.Inf(1), wm1: math.NaN()},
+		{x: -0.5, w0: math.NaN(), wm1: math.NaN()},
+		{x: math.NaN(), w0: math.NaN(), wm1: math.NaN()},
+	} {
+		for _, branch := range []struct {
+			name string
+			fn   func(float64) float64
+			want float64
+		}{
+			{name: "LambertW0", fn: LambertW0, want: test.w0},
+			{name: "LambertWm1", fn: LambertWm1, want: test.wm1},
+		} {
+			got := branch.fn(test.x)
+			if math.IsNaN(got) != math.IsNaN(branch.want) || !math.IsNaN(got) && !scalar.EqualWithinAbsOrRel(got, branch.want, tol, tol) {
+				t.Errorf("test %d %s(%g) failed: got %g want %g", i, branch.name, test.x, got, branch.want)
+			}
+		}
+	}
+}
+
+func TestLambertWInverse(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		// Choose w on each branch and check that W(w e^w) = w.
+		w := -1 + math.Exp(rnd.Float64()*12-6)
+		if got := LambertW0(w * math.Exp(w)); !scalar.EqualWithinAbsOrRel(got, w, 1e-8*math.Abs(w+1), tol) {
+			t.Errorf("unexpected LambertW0 inverse for w=%g: got %g", w, got)
+		}
+		w = -1 - math.Exp(rnd.Float64()*8-4)
+		if got := LambertWm1(w * math.Exp(w)); !scalar.EqualWithinAbsOrRel(got, w, 1e-8*math.Abs(w+1), tol) {
+			t.Errorf("unexpected LambertWm1 inverse for w=%g: got %g", w, got)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestBivariateNormalCDF(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 2000; i++ {
+		h := 8 * (rnd.Float64() - 0.5)
+		k := 8 * (rnd.Float64() - 0.5)
+		rho := 2*rnd.Float64() - 1
+		if i%4 == 0 {
+			// Exercise the branch for strong correlation.
+			rho = math.Copysign(0.925+0.075*rnd.Float64(), rho)
+		}
+		got := BivariateNormalCDF(h, k, rho)
+
+		// Owen's representation of the bivariate normal CDF,
+		// https://en.wikipedia.org/wiki/Owen%27s_T_function.
+		s := math.Sqrt(1 - rho*rho)
+		want := (normalCDF(h)+normalCDF(k))/2 - OwenT(h, (k-rho*h)/(h*s)) - OwenT(k, (h-rho*k)/(k*s))
+		if h*k < 0 {
+			want -= 0.5
+		}
+		if !scalar.EqualWithinAbsOrRel(got, want, tol, 1e-12) {
+			t.Errorf("unexpected BivariateNormalCDF(%v, %v, %v): got:%v want:%v", h, k, rho, got, want)
+		}
+
+		// Symmetry in the arguments.
+		if swap := BivariateNormalCDF(k, h, rho); !scalar.EqualWithinAbsOrRel(swap, got, tol, tol) {
+			t.Errorf("asymmetric BivariateNormalCDF(%v, %v, %v): got:%v want:%v", h, k, rho, swap, got)
+		}
+	}
+
+	for _, test := range []struct {
+		h, k, rho float64
+		want      float64
+	}{
+		{h: 0, k: 0, rho: 0.5, want: 0.25 + math.Asin(0.5)/(2*math.Pi)},
+		{h: 0, k: 0, rho: -0.95, want: 0.25 + math.Asin(-0.95)/(2*math.Pi)},
+		{h: 0.3, k: -1.2, rho: 0, want: normalCDF(0.3) * normalCDF(-1.2)},
+		{h: 0.3, k: -1.2, rho: 1, want: normalCDF(-1.2)},
+		{h: 0.3, k: 1.2, rho: -1, want: normalCDF(0.3) + normalCDF(1.2) - 1},
+		{h: -0.3, k: -1.2, rho: -1, want: 0},
+		{h: math.Inf(1), k: 0.7, rho: 0.4, want: normalCDF(0.7)},
+		{h: math.Inf(-1), k: 0.7, rho: 0.4, want: 0},
+	} {
+		got := BivariateNormalCDF(test.h, test.k, test.rho)
+		if !scalar.EqualWithinAbsOrRel(got, test.want, tol, tol) {
+			t.Errorf("unexpected BivariateNormalCDF(%v, %v, %v): got:%v want:%v", test.h, test.k, test.rho, got, test.want)
+		}
+	}
+
+	if got := BivariateNormalCDF(0, 0, 1.1); !math.IsNaN(got) {
+		t.Errorf("expected NaN for invalid correlation: got:%v", got)
+	}
+}
+
+func TestTrivariateNormalCDF(t *testing.T) {
+	t.Parallel()
+
+	// Reference values for one factor correlation structures, r_ij = λ_i λ_j,
+	// were computed by integrating \int φ(z) \prod_i Φ((h_i-λ_i z)/\sqrt{1-λ_i^2}) dz
+	// with the trapezoidal rule.
+	for _, test := range []struct {
+		h1, h2, h3    float64
+		r12, r13, r23 float64
+		want          float64
+	}{
+		{h1: 0.5, h2: -0.3, h3: 1.2, r12: 0.4, r13: 0.4, r23: 0.4, want: 0.30661207225371107},
+		{h1: 1.1, h2: 0.2, h3: -0.7, r12: 0.9 * 0.6, r13: 0.9 * -0.5, r23: 0.6 * -0.5, want: 0.089012243791986412},
+		{h1: -2, h2: -1.5, h3: -1, r12: 0.95 * 0.9, r13: 0.95 * 0.8, r23: 0.9 * 0.8, want: 0.016662458891921982},
+	} {
+		perms := [][6]float64{
+			{test.h1, test.h2, test.h3, test.r12, test.r13, test.r23},
+			{test.h3, test.h2, test.h1, test.r23, test.r13, test.r12},
+			{test.h2, test.h1, test.h3, test.r12, test.r23, test.r13},
+			{test.h2, test.h3, test.h1, test.r23, test.r12, test.r13},
+		}
+		for _, p := range perms {
+			got := TrivariateNormalCDF(p[0], p[1], p[2], p[3], p[4], p[5])
+			if !scalar.EqualWithinRel(got, test.want, 1e-12) {
+				t.Errorf("unexpected TrivariateNormalCDF(%v): got:%v want:%v", p, got, test.want)
+			}
+		}
+	}
+
+	// Orthant probabilities, Φ_3(0,0,0;R) = 1/8 + (asin r12 + asin r13 + asin r23)/(4π).
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		var l [3]float64
+		for j := range l {
+			l[j] = 2*rnd.Float64() - 1
+		}
+		r12, r13, r23 := l[0]*l[1], l[0]*l[2], l[1]*l[2]
+		got := TrivariateNormalCDF(0, 0, 0, r12, r13, r23)
+		want := 0.125 + (math.Asin(r12)+math.Asin(r13)+math.Asin(r23))/(4*math.Pi)
+		if !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-12) {
+			t.Errorf("unexpected orthant probability for r=(%v,%v,%v): got:%v want:%v", r12, r13, r23, got, want)
+		}
+	}
+
+	for _, test := range []struct {
+		h1, h2, h3    float64
+		r12, r13, r23 float64
+		want          float64
+	}{
+		{h1: 0.3, h2: -0.4, h3: 1, want: normalCDF(0.3) * normalCDF(-0.4) * normalCDF(1)},
+		{h1: 0.3, h2: -0.4, h3: 1, r23: 0.6, want: normalCDF(0.3) * BivariateNormalCDF(-0.4, 1, 0.6)},
+		{h1: 0.3, h2: -0.4, h3: math.Inf(1), r12: 0.2, r13: 0.3, r23: 0.6, want: BivariateNormalCDF(0.3, -0.4, 0.2)},
+		{h1: 0.3, h2: math.Inf(-1), h3: 1, r12: 0.2, r13: 0.3, r23: 0.6, want: 0},
+	} {
+		got := TrivariateNormalCDF(test.h1, test.h2, test.h3, test.r12, test.r13, test.r23)
+		if !scalar.EqualWithinAbsOrRel(got, test.want, 1e-15, 1e-14) {
+			t.Errorf("unexpected TrivariateNormalCDF(%v, %v, %v, %v, %v, %v): got:%v want:%v",
+				test.h1, test.h2, test.h3, test.r12, test.r13, test.r23, got, test.want)
+		}
+	}
+
+	// Not positive semi-definite.
+	if got := TrivariateNormalCDF(0, 0, 0, 0.9, 0.9, -0.9); !math.IsNaN(got) {
+		t.Errorf("expected NaN for invalid correlation: got:%v", got)
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import "math"
+
+// The orthogonal polynomials below are evaluated by their three-term
+// recurrence relations in the degree, which are numerically stable in the
+// direction of increasing degree. See https://dlmf.nist.gov/18.9.
+
+// LegendreP returns the Legendre polynomial of degree n at x. LegendreP
+// panics if n is negative.
+//
+// See https://dlmf.nist.gov/18.3 for more details.
+func LegendreP(n int, x float64) float64 {
+	checkDegree(n)
+	p0, p1 := 1.0, x
+	if n == 0 {
+		return p0
+	}
+	for k := 1; k < n; k++ {
+		kf := float64(k)
+		p0, p1 = p1, ((2*kf+1)*x*p1-kf*p0)/(kf+1)
+	}
+	return p1
+}
+
+// AssocLegendreP returns the associated Legendre function of the first kind
+// of degree l and order m at -1 ≤ x ≤ 1, the Ferrers function
+//
+//	P_l^m(x) = (-1)^m (1-x^2)^{m/2} d^m/dx^m P_l(x)
+//
+// which includes the Condon-Shortley phase (-1)^m. Negative orders are
+// defined by
+//
+//	P_l^{-m}(x) = (-1)^m (l-m)!/(l+m)! P_l^m(x).
+//
+// AssocLegendreP returns zero if |m| > l and NaN if x is outside [-1, 1].
+// AssocLegendreP panics if l is negative.
+//
+// See https://dlmf.nist.gov/14.6 and https://dlmf.nist.gov/14.9 for more details.
+func AssocLegendreP(l, m int, x float64) float64 {
+	checkDegree(l)
+	switch {
+	case !(-1 <= x && x <= 1):
+		return math.NaN()
+	case m > l, -m > l:
+		return 0
+	case m < 0:
+		m = -m
+		// (l-m)!/(l+m)! computed as a product to avoid overflow.
+		r := 1.0
+		for k := l - m + 1; k <= l+m; k++ {
+			r /= float64(k)
+		}
+		return parity(float64(m)) * r * AssocLegendreP(l, m, x)
+	}
+
+	// P_m^m(x) = (-1)^m (2m-1)!! (1-x^2)^{m/2}.
+	s := math.Sqrt((1 - x) * (1 + x))
+	pmm := 1.0
+	for k := 1; k <= m; k++ {
+		pmm *= -float64(2*k-1) * s
+	}
+	if l == m {
+		return pmm
+	}
+	p0, p1 := pmm, x*float64(2*m+1)*pmm
+	for k := m + 1; k < l; k++ {
+		kf, mf := float64(k), float64(m)
+		p0, p1 = p1, ((2*kf+1)*x*p1-(kf+mf)*p0)/(kf-mf+1)
+	}
+	return p1
+}
+
+// ChebyshevT returns the Chebyshev polynomial of the first kind of degree n
+// at x, satisfying T_n(\cos θ) = \cos(nθ). ChebyshevT panics if n is negative.
+//
+// See https://dlmf.nist.gov/18.3 for more details.
+func ChebyshevT(n int, x float64) float64 {
+	checkDegree(n)
+	t0, t1 := 1.0, x
+	if n == 0 {
+		return t0
+	}
+	for k := 1; k < n; k++ {
+		t0, t1 = t1, 2*x*t1-t0
+	}
+	return t1
+}
+
+// ChebyshevU returns the Chebyshev polynomial of the second kind of degree n
+// at x, satisfying U_n(\cos θ) = \sin((n+1)θ)/\sin θ. ChebyshevU panics if n
+// is negative.
+//
+// See https://dlmf.nist.gov/18.3 for more details.
+func ChebyshevU(n int, x float64) float64 {
+	checkDegree(n)
+	u0, u1 := 1.0, 2*x
+	if n == 0 {
+		return u0
+	}
+	for k := 1; k < n; k++ {
+		u0, u1 = u1, 2*x*u1-u0
+	}
+	return u1
+}
+
+// HermiteH returns the physicists' Hermite polynomial of degree n at x,
+// orthogonal with respect to the weight \exp(-x^2). HermiteH panics if n
+// is negative.
+//
+// See https://dlmf.nist.gov/18.3 for more details.
+func HermiteH(n int, x float64) float64 {
+	checkDegree(n)
+	h0, h1 := 1.0, 2*x
+	if n == 0 {
+		return h0
+	}
+	for k := 1; k < n; k++ {
+		h0, h1 = h1, 2*x*h1-2*float64(k)*h0
+	}
+	return h1
+}
+
+// HermiteHe returns the probabilists' Hermite polynomial of degree n at x,
+// orthogonal with respect to the weight \exp(-x^2/2). HermiteHe panics if n
+// is negative.
+//
+// See https://dlmf.nist.gov/18.3 for more details.
+func HermiteHe(n int, x float64) float64 {
+	checkDegree(n)
+	h0, h1 := 1.0, x
+	if n == 0 {
+		return h0
+	}
+	for k := 1; k < n; k++ {
+		h0, h1 = h1, x*h1-float64(k)*h0
+	}
+	return h1
+}
+
+// LaguerreL returns the generalized Laguerre polynomial L_n^{(alpha)} of
+// degree n at x, orthogonal with respect to the weight x^α \exp(-x) on
+// [0, ∞) for α > -1. LaguerreL panics if n is negative.
+//
+// See https://dlmf.nist.gov/18.3 for more details.
+func LaguerreL(n int, alpha, x float64) float64 {
+	checkDegree(n)
+	l0, l1 := 1.0, 1+alpha-x
+	if n == 0 {
+		return l0
+	}
+	for k := 1; k < n; k++ {
+		kf := float64(k)
+		l0, l1 = l1, ((2*kf+1+alpha-x)*l1-(kf+alpha)*l0)/(kf+1)
+	}
+	return l1
+}
+
+// JacobiP returns the Jacobi polynomial P_n^{(alpha,beta)} of degree n at x,
+// orthogonal with respect to the weight (1-x)^α (1+x)^β on [-1, 1] for
+// α, β > -1. JacobiP panics if n is negative.
+//
+// See https://dlmf.nist.gov/18.3 and https://dlmf.nist.gov/18.9.E2 for
+// more details.
+func JacobiP(n int, alpha, beta, x float64) float64 {
+	checkDegree(n)
+	p0, p1 := 1.0, (alpha+1)+(alpha+beta+2)*(x-1)/2
+	if n == 0 {
+		return p0
+	}
+	ab := alpha + beta
+	for k := 1; k < n; k++ {
+		kf := float64(k)
+		c := 2*kf + ab
+		a1 := 2 * (kf + 1) * (kf + ab + 1) * c
+		a2 := (c + 1) * (alpha*alpha - beta*beta)
+		a3 := c * (c + 1) * (c + 2)
+		a4 := 2 * (kf + alpha) * (kf + beta) * (c + 2)
+		p0, p1 = p1, ((a2+a3*x)*p1-a4*p0)/a1
+	}
+	return p1
+}
+
+// RealSphericalHarmonic returns the real spherical harmonic of degree l and
+// order m at the polar angle theta and the azimuthal angle phi. The real
+// spherical harmonics are orthonormal over the unit sphere and are defined
+// without the Condon-Shortley phase by
+//
+//	Y_{lm} = \sqrt{2} N_l^m \bar{P}_l^m(\cos θ) \cos(mφ)      for m > 0,
+//	Y_{l0} = N_l^0 P_l(\cos θ),
+//	Y_{lm} = \sqrt{2} N_l^{|m|} \bar{P}_l^{|m|}(\cos θ) \sin(|m|φ) for m < 0,
+//
+// where \bar{P}_l^m = (-1)^m P_l^m is the associated Legendre function
+// without the Condon-Shortley phase and
+//
+//	N_l^m = \sqrt{(2l+1)/(4π) (l-m)!/(l+m)!}.
+//
+// The normalized associated Legendre functions are computed by a recurrence
+// that does not overflow for large degree. RealSphericalHarmonic returns zero
+// if |m| > l and panics if l is negative.
+//
+// See https://en.wikipedia.org/wiki/Spherical_harmonics#Real_form for more
+// details.
+func RealSphericalHarmonic(l, m int, theta, phi float64) float64 {
+	checkDegree(l)
+	am := m
+	if am < 0 {
+		am = -am
+	}
+	if am > l {
+		return 0
+	}
+	y := normalizedLegendre(l, am, math.Cos(theta), math.Abs(math.Sin(theta)))
+	switch {
+	case m > 0:
+		return math.Sqrt2 * y * math.Cos(float64(m)*phi)
+	case m < 0:
+		return math.Sqrt2 * y * math.Sin(float64(am)*phi)
+	}
+	return y
+}
+
+// normalizedLegendre returns N_l^m \bar{P}_l^m(x) for 0 ≤ m ≤ l where
+// s = \sqrt{1-x^2}.
+func normalizedLegendre(l, m int, x, s float64) float64 {
+	// \bar{P}_m^m scaled by N_m^m.
+	p := 1 / math.Sqrt(4*math.Pi)
+	for k := 1; k <= m; k++ {
+		kf := float64(k)
+		p *= math.Sqrt((2*kf+1)/(2*kf)) * s
+	}
+	if l == m {
+		return p
+	}
+	mf := float64(m)
+	p0, p1 := p, math.Sqrt(2*mf+3)*x*p
+	aPrev := math.Sqrt(2*mf + 3)
+	for k := m + 2; k <= l; k++ {
+		kf := float64(k)
+		a := math.Sqrt((4*kf*kf - 1) / (kf*kf - mf*mf))
+		p0, p1 = p1, a*(x*p1-p0/aPrev)
+		aPrev = a
+	}
+	return p1
+}
+
+// checkDegree panics if the polynomial degree n is negative.
+func checkDegree(n int) {
+	if n < 0 {
+		panic("mathext: negative degree")
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestOrthogonalPolynomials(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+
+	for _, x := range []float64{-1, -0.7, -0.2, 0, 0.35, 0.9, 1, 1.7, -3} {
+		x2 := x * x
+		for _, test := range []struct {
+			name      string
+			got, want float64
+		}{
+			{name: "LegendreP(0)", got: LegendreP(0, x), want: 1},
+			{name: "LegendreP(1)", got: LegendreP(1, x), want: x},
+			{name: "LegendreP(3)", got: LegendreP(3, x), want: (5*x2*x - 3*x) / 2},
+			{name: "LegendreP(4)", got: LegendreP(4, x), want: (35*x2*x2 - 30*x2 + 3) / 8},
+			{name: "ChebyshevT(4)", got: ChebyshevT(4, x), want: 8*x2*x2 - 8*x2 + 1},
+			{name: "ChebyshevU(3)", got: ChebyshevU(3, x), want: 8*x2*x - 4*x},
+			{name: "HermiteH(3)", got: HermiteH(3, x), want: 8*x2*x - 12*x},
+			{name: "HermiteH(4)", got: HermiteH(4, x), want: 16*x2*x2 - 48*x2 + 12},
+			{name: "HermiteHe(4)", got: HermiteHe(4, x), want: x2*x2 - 6*x2 + 3},
+			{name: "LaguerreL(2)", got: LaguerreL(2, 0.5, x), want: (x2 - 2*2.5*x + 1.5*2.5) / 2},
+			{name: "LaguerreL(3)", got: LaguerreL(3, 0, x), want: (-x2*x + 9*x2 - 18*x + 6) / 6},
+			{name: "JacobiP(4) Legendre", got: JacobiP(4, 0, 0, x), want: LegendreP(4, x)},
+			{name: "JacobiP(5) Chebyshev", got: JacobiP(5, -0.5, -0.5, x), want: 63.0 / 256 * ChebyshevT(5, x)},
+			{name: "JacobiP(2)", got: JacobiP(2, 1, 2, x), want: 3 + 9*(x-1) + 21.0/4*(x-1)*(x-1)},
+		} {
+			if !scalar.EqualWithinAbsOrRel(test.got, test.want, tol, tol) {
+				t.Errorf("unexpected %s at x=%v: got:%v want:%v", test.name, x, test.got, test.want)
+			}
+		}
+	}
+
+	for _, theta := range []float64{0, 0.3, 1, 2.5, math.Pi} {
+		c := math.Cos(theta)
+		for n := 0; n < 20; n++ {
+			nf := float64(n)
+			if got, want := ChebyshevT(n, c), math.Cos(nf*theta); !scalar.EqualWithinAbs(got, want, 1e-13) {
+				t.Errorf("unexpected ChebyshevT(%d, cos(%v)): got:%v want:%v", n, theta, got, want)
+			}
+			if math.Sin(theta) < 0.1 {
+				continue
+			}
+			if got, want := ChebyshevU(n, c), math.Sin((nf+1)*theta)/math.Sin(theta); !scalar.EqualWithinAbs(got, want, 1e-13) {
+				t.Errorf("unexpected ChebyshevU(%d, cos(%v)): got:%v want:%v", n, theta, got, want)
+			}
+		}
+	}
+}
+
+func TestLegendreOrthogonality(t *testing.T) {
+	t.Parallel()
+
+	// The 20 point Gauss-Legendre rule is exact for polynomials
+	// of degree up to 39.
+	for m := 0; m < 20; m++ {
+		for n := 0; n < 20; n++ {
+			var got float64
+			for _, p := range gaussLegendre20 {
+				for _, x := range [2]float64{-p.x, p.x} {
+					got += p.w * LegendreP(m, x) * LegendreP(n, x)
+				}
+			}
+			var want float64
+			if m == n {
+				want = 2 / float64(2*n+1)
+			}
+			if !scalar.EqualWithinAbs(got, want, 1e-14) {
+				t.Errorf("unexpected inner product of P_%d and P_%d: got:%v want:%v", m, n, got, want)
+			}
+		}
+	}
+}
+
+func TestAssocLegendreP(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+
+	for _, x := range []float64{-1, -0.6, 0, 0.25, 0.8, 1} {
+		s := math.Sqrt(1 - x*x)
+		for _, test := range []struct {
+			l, m int
+			want float64
+		}{
+			{l: 0, m: 0, want: 1},
+			{l: 3, m: 0, want: LegendreP(3, x)},
+			{l: 1, m: 1, want: -s},
+			{l: 2, m: 1, want: -3 * x * s},
+			{l: 2, m: 2, want: 3 * s * s},
+			{l: 3, m: 2, want: 15 * x * s * s},
+			{l: 3, m: 3, want: -15 * s * s * s},
+			{l: 2, m: -1, want: x * s / 2},
+			{l: 3, m: -2, want: x * s * s / 8},
+			{l: 2, m: 3, want: 0},
+		} {
+			got := AssocLegendreP(test.l, test.m, x)
+			if !scalar.EqualWithinAbsOrRel(got, test.want, tol, tol) {
+				t.Errorf("unexpected AssocLegendreP(%d, %d, %v): got:%v want:%v", test.l, test.m, x, got, test.want)
+			}
+		}
+	}
+	if got := AssocLegendreP(2, 1, 1.5); !math.IsNaN(got) {
+		t.Errorf("expected NaN outside [-1, 1]: got:%v", got)
+	}
+	if !panics(func() { LegendreP(-1, 0.5) }) {
+		t.Errorf("expected panic for negative degree")
+	}
+}
+
+func TestRealSphericalHarmonic(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+
+	for _, theta := range []float64{0, 0.4, 1.3, 2.9} {
+		for _, phi := range []float64{0, 0.7, 4} {
+			st, ct := math.Sincos(theta)
+			sp, cp := math.Sincos(phi)
+			for _, test := range []struct {
+				l, m int
+				want float64
+			}{
+				{l: 0, m: 0, want: 0.5 / math.Sqrt(math.Pi)},
+				{l: 1, m: -1, want: math.Sqrt(3/(4*math.Pi)) * st * sp},
+				{l: 1, m: 0, want: math.Sqrt(3/(4*math.Pi)) * ct},
+				{l: 1, m: 1, want: math.Sqrt(3/(4*math.Pi)) * st * cp},
+				{l: 2, m: -2, want: 0.5 * math.Sqrt(15/math.Pi) * st * st * sp * cp},
+				{l: 2, m: 0, want: 0.25 * math.Sqrt(5/math.Pi) * (3*ct*ct - 1)},
+				{l: 2, m: 1, want: 0.5 * math.Sqrt(15/math.Pi) * st * ct * cp},
+			} {
+				got := RealSphericalHarmonic(test.l, test.m, theta, phi)
+				if !scalar.EqualWithinAbsOrRel(got, test.want, tol, tol) {
+					t.Errorf("unexpected RealSphericalHarmonic(%d, %d, %v, %v): got:%v want:%v", test.l, test.m, theta, phi, got, test.want)
+				}
+			}
+		}
+	}
+
+	// Orthonormality over the sphere using Gauss-Legendre quadrature
+	// in cos θ and the trapezoidal rule in φ, exact for degree below 20.
+	const (
+		maxL = 6
+		nPhi = 2*maxL + 2
+	)
+	type lm struct{ l, m int }
+	var idx []lm
+	for l := 0; l <= maxL; l++ {
+		for m := -l; m <= l; m++ {
+			idx = append(idx, lm{l, m})
+		}
+	}
+	for _, a := range idx {
+		for _, b := range idx {
+			var got float64
+			for _, p := range gaussLegendre20 {
+				for _, x := range [2]float64{-p.x, p.x} {
+					theta := math.Acos(x)
+					for k := 0; k < nPhi; k++ {
+						phi := 2 * math.Pi * float64(k) / nPhi
+						got += p.w * 2 * math.Pi / nPhi * RealSphericalHarmonic(a.l, a.m, theta, phi) * RealSphericalHarmonic(b.l, b.m, theta, phi)
+					}
+				}
+			}
+			var want float64
+			if a == b {
+				want = 1
+			}
+			if !scalar.EqualWithinAbs(got, want, 1e-13) {
+				t.Errorf("unexpected inner product of Y_%v and Y_%v: got:%v want:%v", a, b, got, want)
+			}
+		}
+	}
+
+	// Large degree does not overflow.
+	if got := RealSphericalHarmonic(1000, 500, 1, 0.5); math.IsNaN(got) || math.IsInf(got, 0) || math.Abs(got) > 1 {
+		t.Errorf("unexpected RealSphericalHarmonic for large degree: got:%v", got)
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return false
+}
@@ -0,0 +1,67 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestOwenT(t *testing.T) {
+	t.Parallel()
+
+	// Reference values were computed using Owen's series with 45 digit
+	// decimal arithmetic.
+	for _, test := range []struct {
+		h, a, want float64
+	}{
+		{h: 0.0625, a: 0.25, want: 0.038911930234701367},
+		{h: 6.5, a: 0.4375, want: 2.0005773048508314e-11},
+		{h: 7, a: 0.96875, want: 6.3990627193898686e-13},
+		{h: 4.78125, a: 0.0625, want: 1.0632974804687464e-07},
+		{h: 2, a: 0.5, want: 0.0086250779855215065},
+		{h: 1, a: 1, want: 0.066741882165700969},
+		{h: 0.3, a: 0.99, want: 0.11731718792552008},
+		{h: 10, a: 0.1, want: 2.6189072922490969e-24},
+		{h: 0.5, a: 0.001, want: 0.0001404536916395029},
+		{h: -2, a: -0.5, want: -0.0086250779855215065},
+		{h: 0, a: 3, want: math.Atan(3) / (2 * math.Pi)},
+		{h: 1.5, a: math.Inf(1), want: normalQ(1.5) / 2},
+		{h: math.Inf(1), a: 2, want: 0},
+		{h: 40, a: 0.5, want: 0},
+	} {
+		got := OwenT(test.h, test.a)
+		if !scalar.EqualWithinRel(got, test.want, 1e-14) {
+			t.Errorf("unexpected OwenT(%v, %v): got:%v want:%v", test.h, test.a, got, test.want)
+		}
+	}
+
+	// T(h,a) + T(ah,1/a) = (Φ(h)+Φ(ah))/2 - Φ(h)Φ(ah) for h ≥ 0 and a > 0,
+	// checked across the switch between the quadrature and the identity,
+	// and T(h,1) = Φ(h)Q(h)/2.
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		h := 8 * rnd.Float64()
+		a := math.Exp(4 * (rnd.Float64() - 0.5))
+		got := OwenT(h, a) + OwenT(a*h, 1/a)
+		want := (normalCDF(h)+normalCDF(a*h))/2 - normalCDF(h)*normalCDF(a*h)
+		if !scalar.EqualWithinAbsOrRel(got, want, 1e-15, 1e-13) {
+			t.Errorf("unexpected OwenT identity for h=%v a=%v: got:%v want:%v", h, a, got, want)
+		}
+		got = OwenT(h, 1)
+		want = normalCDF(h) * normalQ(h) / 2
+		if !scalar.EqualWithinRel(got, want, 1e-14) {
+			t.Errorf("unexpected OwenT(%v, 1): got:%v want:%v", h, got, want)
+		}
+	}
+
+	if got := OwenT(math.NaN(), 1); !math.IsNaN(got) {
+		t.Errorf("expected NaN for OwenT(NaN, 1): got:%v", got)
+	}
+}
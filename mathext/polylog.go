@@ -0,0 +1,188 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// Polylog computes the polylogarithm of real order s at real x ≤ 1
+//
+//	Li_s(x) = \sum_{k=1}^{\infty} x^k / k^s,
+//
+// continued analytically outside the unit disc of convergence of the
+// series.
+//
+// For small |x| the series is summed directly. Otherwise Li_s is computed
+// from its expansion in powers of μ = ln(x),
+//
+//	Li_s(e^μ) = Γ(1-s) (-μ)^{s-1} + \sum_{k=0}^{\infty} ζ(s-k) μ^k/k!,
+//
+// or the corresponding logarithmic expansion for positive integer s. For
+// x < -1 the inversion formula relating Li_s(x) to Li_s(1/x) is used. For
+// non-integer s the inversion formula involves the Hurwitz zeta function
+// ζ(1-s, 1/2 + ln(-x)/(2πi)) of complex argument.
+//
+// Special cases are:
+//
+//	Polylog(s, 0) = 0
+//	Polylog(s, 1) = RiemannZeta(s) for s > 1
+//	Polylog(s, 1) = +Inf for s ≤ 1
+//	Polylog(s, -1) = -DirichletEta(s)
+//	Polylog(s, x) = NaN for x > 1
+//	Polylog(0, x) = x/(1-x)
+//	Polylog(1, x) = -ln(1-x)
+//
+// See https://dlmf.nist.gov/25.12 for more details.
+func Polylog(s, x float64) float64 {
+	switch {
+	case math.IsNaN(s), math.IsNaN(x), math.IsInf(s, 0), x > 1:
+		return math.NaN()
+	case x == 0:
+		return 0
+	case x == 1:
+		if s > 1 {
+			return RiemannZeta(s)
+		}
+		return math.Inf(1)
+	case x == -1:
+		return -DirichletEta(s)
+	case s == 0:
+		return x / (1 - x)
+	case s == 1:
+		return -math.Log1p(-x)
+	case x < -1:
+		return polylogInv(s, x)
+	case x > 0.75:
+		return real(polylogLog(s, complex(math.Log(x), 0)))
+	case x < -0.25, s < 0 && x < -0.01:
+		return real(polylogLog(s, complex(math.Log(-x), math.Pi)))
+	}
+	return polylogSeries(s, x)
+}
+
+// polylogSeries returns the sum of the series for Li_s(x) for |x| < 1.
+func polylogSeries(s, x float64) float64 {
+	var sum float64
+	xk := 1.0
+	for k := 1.0; k < hypMaxIter; k++ {
+		xk *= x
+		term := xk * math.Pow(k, -s)
+		sum += term
+		// Only stop once the terms are decreasing.
+		next := math.Abs(x) * math.Pow((k+1)/k, -s)
+		if math.Abs(term) <= machEp*math.Abs(sum) && next < 1 {
+			break
+		}
+	}
+	return sum
+}
+
+// polylogLog returns Li_s(e^μ) for |μ| < 2π using the expansion in powers of μ.
+// See https://dlmf.nist.gov/25.12.E12 and https://dlmf.nist.gov/25.12.E13.
+func polylogLog(s float64, mu complex128) complex128 {
+	const maxIter = 500
+
+	n, frac := math.Modf(s)
+	isInt := frac == 0 && s > 0
+	var sum complex128
+	if isInt {
+		// Li_n(e^μ) = μ^{n-1}/(n-1)! (H_{n-1} - ln(-μ)) + \sum_{k≠n-1} ζ(n-k) μ^k/k!
+		var h float64
+		for k := 1.0; k < n; k++ {
+			h += 1 / k
+		}
+		sum = cmplx.Pow(mu, complex(n-1, 0)) / complex(factorial(int(n)-1), 0) * (complex(h, 0) - cmplx.Log(-mu))
+	} else {
+		sum = complex(math.Gamma(1-s), 0) * cmplx.Pow(-mu, complex(s-1, 0))
+	}
+
+	var small int
+	muk := complex(1, 0)
+	for k := 0; k < maxIter; k++ {
+		if !isInt || float64(k) != n-1 {
+			term := complex(RiemannZeta(s-float64(k)), 0) * muk
+			sum += term
+			// Stop after two consecutive small terms since ζ vanishes
+			// at the negative even integers.
+			if cmplx.Abs(term) <= machEp*cmplx.Abs(sum) && float64(k) > s {
+				small++
+				if small == 2 {
+					break
+				}
+			} else {
+				small = 0
+			}
+		}
+		muk *= mu / complex(float64(k+1), 0)
+	}
+	return sum
+}
+
+// polylogInv returns Li_s(x) for x < -1 using the inversion formula.
+func polylogInv(s, x float64) float64 {
+	li := Polylog(s, 1/x)
+	if s != math.Trunc(s) {
+		// Li_s(z) + e^{iπs} Li_s(1/z) = (2πi)^s/Γ(s) ζ(1-s, 1/2 + ln(-z)/(2πi)),
+		// the real part of which gives Li_s(x) since Li_s(x) and Li_s(1/x)
+		// are real. See https://dlmf.nist.gov/25.12 and
+		// A. Jonquière, "Note sur la série Σ x^n/n^s", Bulletin de la
+		// Société Mathématique de France 17:142-152, 1889.
+		t := math.Log(-x)
+		lg, sign := math.Lgamma(s)
+		c := complex(float64(sign)*math.Exp(s*math.Log(2*math.Pi)-lg), 0)
+		z := hurwitzZeta(1-s, complex(0.5, -t/(2*math.Pi)))
+		return real(c*cmplx.Exp(complex(0, math.Pi*s/2))*z) - cosPi(s)*li
+	}
+	if s < 0 {
+		// Li_{-n}(x) = (-1)^{n+1} Li_{-n}(1/x).
+		return -parity(s) * li
+	}
+
+	// Li_n(-e^t) = -(-1)^n Li_n(-e^{-t}) - t^n/n! - 2 \sum_{k=1}^{⌊n/2⌋} η(2k) t^{n-2k}/(n-2k)!
+	// See https://dlmf.nist.gov/25.12.E4 and https://dlmf.nist.gov/25.12.E15.
+	t := math.Log(-x)
+	n := int(s)
+	v := -parity(s)*li - math.Pow(t, s)/factorial(n)
+	for k := 1; 2*k <= n; k++ {
+		v -= 2 * DirichletEta(float64(2*k)) * math.Pow(t, float64(n-2*k)) / factorial(n-2*k)
+	}
+	return v
+}
+
+// hurwitzZeta returns the Hurwitz zeta function ζ(σ, a) for real σ ≠ 1
+// and complex a with positive real part using Hermite's integral
+//
+//	ζ(σ, a) = a^{-σ}/2 + a^{1-σ}/(σ-1) + i \int_0^\infty ((a+iy)^{-σ} - (a-iy)^{-σ})/(e^{2πy}-1) dy.
+//
+// The integral is evaluated with the exp-sinh double exponential
+// quadrature rule. Unlike the Euler-Maclaurin summation, the integral
+// does not require a to be shifted away from the origin, which would
+// lead to cancellation when σ is negative.
+// See https://dlmf.nist.gov/25.11.
+func hurwitzZeta(sigma float64, a complex128) complex128 {
+	// step is the step size of the quadrature rule in the
+	// transformed variable of integration.
+	const step = 1.0 / 32
+
+	// The integrand decays as y^{-σ} e^{-2πy}, so the upper limit
+	// of integration increases with -σ. The lower limit corresponds
+	// to y ≈ 2e-19.
+	yMax := 8 + math.Abs(sigma)
+	ms := complex(-sigma, 0)
+	var sum complex128
+	for k := -128; ; k++ {
+		tau := step * float64(k)
+		y := math.Exp(math.Pi / 2 * math.Sinh(tau))
+		if y > yMax {
+			break
+		}
+		w := math.Pi / 2 * math.Cosh(tau) * y / math.Expm1(2*math.Pi*y)
+		iy := complex(0, y)
+		sum += complex(0, w) * (cmplx.Pow(a+iy, ms) - cmplx.Pow(a-iy, ms))
+	}
+	return cmplx.Pow(a, ms)/2 + cmplx.Pow(a, complex(1-sigma, 0))/complex(sigma-1, 0) + complex(step, 0)*sum
+}
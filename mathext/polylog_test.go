@@ -0,0 +1,122 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathext
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestPolylog(t *testing.T) {
+	t.Parallel()
+
+	ln2 := math.Ln2
+	zeta3 := 1.2020569031595942853997381615114499907649862923405
+	for _, test := range []struct {
+		s, x float64
+		want float64
+		tol  float64
+	}{
+		// Reference values for non-integer order were computed by
+		// summing the series with 50 digit decimal arithmetic.
+		{s: 0.5, x: 0.9, want: 4.0219504274733611, tol: 1e-14},
+		{s: 2.5, x: 0.95, want: 1.2330274225873386, tol: 1e-14},
+		{s: -1.5, x: 0.8, want: 56.489241971432243, tol: 1e-14},
+		{s: 0.3, x: -0.7, want: -0.45274682961314944, tol: 1e-14},
+		{s: 3.7, x: -0.95, want: -0.89181877891500871, tol: 1e-14},
+		{s: -2.5, x: -0.5, want: 0.0045360934276796085, tol: 1e-13},
+		{s: 1.5, x: 0.3, want: 0.33831109554480626, tol: 1e-15},
+		{s: -0.5, x: -0.9, want: -0.36713237829146633, tol: 1e-14},
+
+		// Reference values for x < -1 and non-integer order were
+		// computed from the Fermi-Dirac integral
+		//	-Li_s(-e^t) = 1/Γ(s) \int_0^\infty u^{s-1}/(e^{u-t}+1) du
+		// using double exponential quadrature with 50 digit decimal
+		// arithmetic.
+		{s: 0.5, x: -5, want: -1.2972654048194185, tol: 1e-14},
+		{s: 3.7, x: -2, want: -1.7771456562324759, tol: 1e-14},
+		{s: 1.5, x: -100, want: -7.8891019147215491, tol: 1e-14},
+		{s: 2.5, x: -1e4, want: -83.079100707379021, tol: 1e-14},
+		{s: 0.3, x: -1e6, want: -2.4449976070390909, tol: 1e-14},
+		{s: 0.5, x: -1e20, want: -7.6558516087591105, tol: 1e-14},
+
+		{s: 2, x: 0.5, want: math.Pi*math.Pi/12 - ln2*ln2/2, tol: 1e-15},
+		{s: 2, x: -1, want: -math.Pi * math.Pi / 12, tol: 1e-15},
+		{s: 3, x: 0.5, want: 7*zeta3/8 - math.Pi*math.Pi/12*ln2 + ln2*ln2*ln2/6, tol: 1e-15},
+		{s: 4, x: 1, want: math.Pow(math.Pi, 4) / 90, tol: 1e-15},
+		{s: 0.5, x: 1, want: math.Inf(1)},
+		{s: 0, x: -3, want: -0.75, tol: 1e-15},
+		{s: 1, x: -3, want: -math.Log(4), tol: 1e-15},
+		{s: 0, x: 0, want: 0},
+	} {
+		got := Polylog(test.s, test.x)
+		if !scalar.EqualWithinAbsOrRel(got, test.want, test.tol, test.tol) {
+			t.Errorf("unexpected Polylog(%v, %v): got:%v want:%v", test.s, test.x, got, test.want)
+		}
+	}
+
+	const tol = 1e-13
+	for _, x := range []float64{-1e6, -50, -3, -1.5, -0.99, -0.6, -0.3, -0.05, 0.05, 0.3, 0.6, 0.8, 0.99, 0.9999} {
+		// Rational functions for negative integer order.
+		if got, want := Polylog(-1, x), x/((1-x)*(1-x)); !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+			t.Errorf("unexpected Polylog(-1, %v): got:%v want:%v", x, got, want)
+		}
+		if got, want := Polylog(-2, x), x*(1+x)/((1-x)*(1-x)*(1-x)); !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+			t.Errorf("unexpected Polylog(-2, %v): got:%v want:%v", x, got, want)
+		}
+
+		if x < 0 {
+			// Inversion formula for the dilogarithm, https://dlmf.nist.gov/25.12.E4.
+			y := -x
+			got := Polylog(2, x) + Polylog(2, -1/y)
+			want := -math.Pi*math.Pi/6 - math.Log(y)*math.Log(y)/2
+			if !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+				t.Errorf("unexpected inversion for Polylog(2, %v): got:%v want:%v", x, got, want)
+			}
+			continue
+		}
+
+		// Reflection formula for the dilogarithm, https://dlmf.nist.gov/25.12.E6.
+		got := Polylog(2, x) + Polylog(2, 1-x)
+		want := math.Pi*math.Pi/6 - math.Log(x)*math.Log1p(-x)
+		if !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+			t.Errorf("unexpected reflection for Polylog(2, %v): got:%v want:%v", x, got, want)
+		}
+	}
+
+	// For x < -1 and non-integer order, the inversion formula must agree
+	// with the logarithmic expansion where both are valid.
+	for _, s := range []float64{-3.7, -1.5, -0.3, 0.2, 0.5, 1.5, 2.5, 3.3, 5.5, 9.7} {
+		for _, lnx := range []float64{0.1, 0.5, 1, 2, 3, 4} {
+			got := polylogInv(s, -math.Exp(lnx))
+			want := real(polylogLog(s, complex(lnx, math.Pi)))
+			if !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+				t.Errorf("unexpected inversion for Polylog(%v, %v): got:%v want:%v", s, -math.Exp(lnx), got, want)
+			}
+		}
+	}
+
+	// Duplication formula, Li_s(x)+Li_s(-x) = 2^{1-s} Li_s(x²).
+	for _, s := range []float64{-3.5, -1.2, 0.5, 1.5, 2, 3.3, 7} {
+		for _, x := range []float64{0.1, 0.4, 0.7, 0.9, 0.97} {
+			got := Polylog(s, x) + Polylog(s, -x)
+			want := math.Pow(2, 1-s) * Polylog(s, x*x)
+			if !scalar.EqualWithinAbsOrRel(got, want, tol, tol) {
+				t.Errorf("unexpected duplication for Polylog(%v, %v): got:%v want:%v", s, x, got, want)
+			}
+		}
+	}
+
+	for _, test := range []struct{ s, x float64 }{
+		{s: 2, x: 1.5},
+		{s: math.NaN(), x: 0.5},
+	} {
+		if got := Polylog(test.s, test.x); !math.IsNaN(got) {
+			t.Errorf("expected NaN for Polylog(%v, %v): got:%v", test.s, test.x, got)
+		}
+	}
+}
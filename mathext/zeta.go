@@ -4,7 +4,11 @@
 
 package mathext
 
-import "gonum.org/v1/gonum/mathext/internal/cephes"
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mathext/internal/cephes"
+)
 
 // Zeta computes the Riemann zeta function of two arguments.
 //
@@ -20,3 +24,121 @@ import "gonum.org/v1/gonum/mathext/internal/cephes"
 func Zeta(x, q float64) float64 {
 	return cephes.Zeta(x, q)
 }
+
+// RiemannZeta computes the Riemann zeta function for real s
+//
+//	ζ(s) = \sum_{k=1}^{\infty} k^{-s}
+//
+// continued analytically to all s ≠ 1.
+//
+// For s ≥ 0 the zeta function is computed from the Dirichlet eta function,
+// ζ(s) = η(s)/(1-2^{1-s}), and for s < 0 the reflection formula
+//
+//	ζ(s) = 2^s π^{s-1} \sin(πs/2) Γ(1-s) ζ(1-s)
+//
+// is used.
+//
+// Special cases are:
+//
+//	RiemannZeta(1) = +Inf
+//	RiemannZeta(0) = -1/2
+//	RiemannZeta(-2n) = 0 for positive integer n
+//	RiemannZeta(+Inf) = 1
+//	RiemannZeta(-Inf) = NaN
+//	RiemannZeta(NaN) = NaN
+//
+// See https://dlmf.nist.gov/25.2 and https://dlmf.nist.gov/25.4 for more details.
+func RiemannZeta(s float64) float64 {
+	switch {
+	case math.IsNaN(s), math.IsInf(s, -1):
+		return math.NaN()
+	case math.IsInf(s, 1):
+		return 1
+	case s == 1:
+		return math.Inf(1)
+	case s == 0:
+		return -0.5
+	case s > 0:
+		// 1-2^{1-s} is computed without cancellation close to s = 1.
+		return DirichletEta(s) / -math.Expm1((1-s)*math.Ln2)
+	}
+
+	// Reflection formula, https://dlmf.nist.gov/25.4.E1.
+	sin := sinPi(s / 2)
+	if sin == 0 {
+		return 0
+	}
+	z := RiemannZeta(1 - s)
+	if 1-s < 170 {
+		return math.Pow(2, s) * math.Pow(math.Pi, s-1) * sin * math.Gamma(1-s) * z
+	}
+	lg, _ := math.Lgamma(1 - s)
+	return math.Exp(s*math.Ln2+(s-1)*math.Log(math.Pi)+lg) * sin * z
+}
+
+// DirichletEta computes the Dirichlet eta function, the alternating zeta
+// function, for real s
+//
+//	η(s) = \sum_{k=1}^{\infty} (-1)^{k-1} k^{-s} = (1-2^{1-s}) ζ(s).
+//
+// The eta function is entire. For s ≥ 0 the series is summed using the
+// convergence acceleration of P. Borwein, "An efficient algorithm for the
+// Riemann zeta function", Constructive, Experimental, and Nonlinear
+// Analysis, CMS Conference Proceedings 27:29-34, 2000, and for s < 0
+// η(s) is obtained from the reflection formula for ζ(s).
+//
+// Special cases are:
+//
+//	DirichletEta(1) = ln(2)
+//	DirichletEta(+Inf) = 1
+//	DirichletEta(-Inf) = NaN
+//	DirichletEta(NaN) = NaN
+//
+// See https://dlmf.nist.gov/25.2.E3 for more details.
+func DirichletEta(s float64) float64 {
+	switch {
+	case math.IsNaN(s), math.IsInf(s, -1):
+		return math.NaN()
+	case math.IsInf(s, 1):
+		return 1
+	case s < 0:
+		return -math.Expm1((1-s)*math.Ln2) * RiemannZeta(s)
+	}
+	var sum float64
+	for k := len(borweinEta) - 1; k >= 0; k-- {
+		v := borweinEta[k] * math.Pow(float64(k+1), -s)
+		if k%2 == 1 {
+			v = -v
+		}
+		sum += v
+	}
+	return sum
+}
+
+// borweinN is the number of terms used in the Borwein acceleration
+// of the eta series. The relative error is bounded by 3/(3+√8)^borweinN.
+const borweinN = 28
+
+// borweinEta holds the weights 1-d_k/d_n of the Borwein acceleration
+// of the eta series, where
+//
+//	d_k = n \sum_{i=0}^{k} (n+i-1)! 4^i / ((n-i)! (2i)!).
+var borweinEta = func() [borweinN]float64 {
+	var e [borweinN + 1]float64
+	e[0] = 1
+	for i := 0; i < borweinN; i++ {
+		fi := float64(i)
+		e[i+1] = e[i] * 4 * (borweinN + fi) * (borweinN - fi) / ((2*fi + 1) * (2*fi + 2))
+	}
+	var dn float64
+	for _, v := range e {
+		dn += v
+	}
+	var w [borweinN]float64
+	var tail float64
+	for k := borweinN - 1; k >= 0; k-- {
+		tail += e[k+1]
+		w[k] = tail / dn
+	}
+	return w
+}()
@@ -7,6 +7,8 @@ package mathext
 import (
 	"math"
 	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
 )
 
 func TestZeta(t *testing.T) {
@@ -41,3 +43,67 @@ func TestZeta(t *testing.T) {
 		}
 	}
 }
+
+func TestRiemannZeta(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		s, want float64
+		tol     float64
+	}{
+		{s: 2, want: math.Pi * math.Pi / 6, tol: 1e-15},
+		{s: 3, want: 1.2020569031595942853997381615114499907649862923405, tol: 1e-15},
+		{s: 1.5, want: 2.6123753486854883433485675679240716305708006524, tol: 1e-15},
+		{s: 1.001, want: 1000.5772884760119, tol: 1e-14},
+		{s: 0.5, want: -1.4603545088095868128894991525152980125672575, tol: 1e-15},
+		{s: 0, want: -0.5, tol: 0},
+		{s: -0.5, want: -0.20788622497735456601730672539704930222626853, tol: 1e-15},
+		{s: -1, want: -1.0 / 12, tol: 1e-15},
+		{s: -3, want: 1.0 / 120, tol: 1e-15},
+		{s: -21, want: -281.46014492753625, tol: 1e-14},
+		{s: -151, want: 8.1952152218313777e+143, tol: 1e-12},
+		{s: -2, want: 0, tol: 0},
+		{s: -100, want: 0, tol: 0},
+		{s: 60, want: 1 + math.Pow(2, -60), tol: 1e-16},
+		{s: 1, want: math.Inf(1)},
+		{s: math.Inf(1), want: 1},
+	} {
+		got := RiemannZeta(test.s)
+		if !scalar.EqualWithinAbsOrRel(got, test.want, test.tol, test.tol) {
+			t.Errorf("unexpected RiemannZeta(%v): got:%v want:%v", test.s, got, test.want)
+		}
+	}
+
+	for s := 1.1; s < 40; s *= 1.3 {
+		got := RiemannZeta(s)
+		want := Zeta(s, 1)
+		if !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+			t.Errorf("mismatch with Hurwitz zeta at s=%v: got:%v want:%v", s, got, want)
+		}
+	}
+	if got := RiemannZeta(math.NaN()); !math.IsNaN(got) {
+		t.Errorf("expected NaN for RiemannZeta(NaN): got:%v", got)
+	}
+}
+
+func TestDirichletEta(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		s, want float64
+		tol     float64
+	}{
+		{s: 1, want: math.Ln2, tol: 1e-15},
+		{s: 2, want: math.Pi * math.Pi / 12, tol: 1e-15},
+		{s: 0.5, want: 0.60489864342163037197, tol: 1e-15},
+		{s: 0, want: 0.5, tol: 1e-15},
+		{s: -1, want: 0.25, tol: 1e-15},
+		{s: -2, want: 0, tol: 0},
+		{s: math.Inf(1), want: 1},
+	} {
+		got := DirichletEta(test.s)
+		if !scalar.EqualWithinAbsOrRel(got, test.want, test.tol, test.tol) {
+			t.Errorf("unexpected DirichletEta(%v): got:%v want:%v", test.s, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,268 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bigfloat
+
+import (
+	"math/big"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+const (
+	badPrec     = "bigfloat: zero precision"
+	badIndex    = "bigfloat: index out of range"
+	badNegative = "bigfloat: negative dimension"
+)
+
+// Matrix is a dense matrix of arbitrary precision floating point values.
+type Matrix struct {
+	rows, cols int
+	prec       uint
+	data       []big.Float
+}
+
+// NewMatrix returns a new r×c matrix of zeros with elements of the given
+// precision in bits. NewMatrix panics if r or c is negative or prec is zero.
+func NewMatrix(r, c int, prec uint) *Matrix {
+	if r < 0 || c < 0 {
+		panic(badNegative)
+	}
+	if prec == 0 {
+		panic(badPrec)
+	}
+	return &Matrix{rows: r, cols: c, prec: prec, data: newFloats(r*c, prec)}
+}
+
+// NewMatrixFrom returns a new matrix holding the values of a with elements
+// of the given precision in bits. The conversion from float64 is exact if
+// prec is at least 53. NewMatrixFrom panics if prec is zero.
+func NewMatrixFrom(a mat.Matrix, prec uint) *Matrix {
+	r, c := a.Dims()
+	m := NewMatrix(r, c, prec)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			m.data[i*c+j].SetFloat64(a.At(i, j))
+		}
+	}
+	return m
+}
+
+// Dims returns the dimensions of the matrix.
+func (m *Matrix) Dims() (r, c int) {
+	return m.rows, m.cols
+}
+
+// Prec returns the precision of the elements of the matrix in bits.
+func (m *Matrix) Prec() uint {
+	return m.prec
+}
+
+// At returns a copy of the element at row i, column j.
+func (m *Matrix) At(i, j int) *big.Float {
+	return new(big.Float).Copy(m.elem(i, j))
+}
+
+// Set sets the element at row i, column j to the value of v, rounded to
+// the precision of the matrix.
+func (m *Matrix) Set(i, j int, v *big.Float) {
+	m.elem(i, j).Set(v)
+}
+
+// SetFloat64 sets the element at row i, column j to the value of v,
+// rounded to the precision of the matrix. SetFloat64 panics if v is NaN.
+func (m *Matrix) SetFloat64(i, j int, v float64) {
+	m.elem(i, j).SetFloat64(v)
+}
+
+// elem returns a pointer to the element at row i, column j.
+func (m *Matrix) elem(i, j int) *big.Float {
+	if uint(i) >= uint(m.rows) || uint(j) >= uint(m.cols) {
+		panic(badIndex)
+	}
+	return &m.data[i*m.cols+j]
+}
+
+// Dense returns the values of m rounded to the nearest float64 values.
+func (m *Matrix) Dense() *mat.Dense {
+	d := mat.NewDense(m.rows, m.cols, nil)
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < m.cols; j++ {
+			v, _ := m.data[i*m.cols+j].Float64()
+			d.Set(i, j, v)
+		}
+	}
+	return d
+}
+
+// Mul takes the matrix product of a and b, placing the result in the
+// receiver. Mul panics if the number of columns of a does not equal the
+// number of rows of b or if the receiver is not the correct shape. Each
+// element is accumulated at the precision of the receiver.
+func (m *Matrix) Mul(a, b *Matrix) {
+	ar, ac := a.Dims()
+	br, bc := b.Dims()
+	if ac != br || m.rows != ar || m.cols != bc {
+		panic(mat.ErrShape)
+	}
+	dst := m
+	if m == a || m == b {
+		dst = NewMatrix(ar, bc, m.prec)
+	}
+	var t big.Float
+	t.SetPrec(m.prec)
+	for i := 0; i < ar; i++ {
+		for j := 0; j < bc; j++ {
+			s := &dst.data[i*bc+j]
+			s.SetInt64(0)
+			for k := 0; k < ac; k++ {
+				t.Mul(&a.data[i*ac+k], &b.data[k*bc+j])
+				s.Add(s, &t)
+			}
+		}
+	}
+	if dst != m {
+		setFloats(m.data, dst.data)
+	}
+}
+
+// Vector is a vector of arbitrary precision floating point values.
+type Vector struct {
+	prec uint
+	data []big.Float
+}
+
+// NewVector returns a new vector of n zeros with elements of the given
+// precision in bits. NewVector panics if n is negative or prec is zero.
+func NewVector(n int, prec uint) *Vector {
+	if n < 0 {
+		panic(badNegative)
+	}
+	if prec == 0 {
+		panic(badPrec)
+	}
+	return &Vector{prec: prec, data: newFloats(n, prec)}
+}
+
+// NewVectorFrom returns a new vector holding the values of x with elements
+// of the given precision in bits. The conversion from float64 is exact if
+// prec is at least 53. NewVectorFrom panics if prec is zero.
+func NewVectorFrom(x []float64, prec uint) *Vector {
+	v := NewVector(len(x), prec)
+	for i, f := range x {
+		v.data[i].SetFloat64(f)
+	}
+	return v
+}
+
+// Len returns the length of the vector.
+func (v *Vector) Len() int {
+	return len(v.data)
+}
+
+// Prec returns the precision of the elements of the vector in bits.
+func (v *Vector) Prec() uint {
+	return v.prec
+}
+
+// AtVec returns a copy of the element at index i.
+func (v *Vector) AtVec(i int) *big.Float {
+	return new(big.Float).Copy(v.elem(i))
+}
+
+// SetVec sets the element at index i to the value of f, rounded to the
+// precision of the vector.
+func (v *Vector) SetVec(i int, f *big.Float) {
+	v.elem(i).Set(f)
+}
+
+// SetVecFloat64 sets the element at index i to the value of f, rounded to
+// the precision of the vector. SetVecFloat64 panics if f is NaN.
+func (v *Vector) SetVecFloat64(i int, f float64) {
+	v.elem(i).SetFloat64(f)
+}
+
+// elem returns a pointer to the element at index i.
+func (v *Vector) elem(i int) *big.Float {
+	if uint(i) >= uint(len(v.data)) {
+		panic(badIndex)
+	}
+	return &v.data[i]
+}
+
+// Float64s stores the values of v rounded to the nearest float64 values
+// in dst. If dst is nil, a new slice is allocated. Float64s panics if
+// the length of a non-nil dst does not equal the length of v.
+func (v *Vector) Float64s(dst []float64) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(v.data))
+	}
+	if len(dst) != len(v.data) {
+		panic(mat.ErrShape)
+	}
+	for i := range v.data {
+		dst[i], _ = v.data[i].Float64()
+	}
+	return dst
+}
+
+// MulVec computes a * x, placing the result in the receiver. MulVec
+// panics if the number of columns of a does not equal the length of x
+// or the number of rows of a does not equal the length of the receiver.
+// Each element is accumulated at the precision of the receiver.
+func (v *Vector) MulVec(a *Matrix, x *Vector) {
+	r, c := a.Dims()
+	if c != x.Len() || r != v.Len() {
+		panic(mat.ErrShape)
+	}
+	dst := v
+	if v == x {
+		dst = NewVector(r, v.prec)
+	}
+	for i := 0; i < r; i++ {
+		dot(&dst.data[i], a.data[i*c:(i+1)*c], x.data)
+	}
+	if dst != v {
+		setFloats(v.data, dst.data)
+	}
+}
+
+// Dot returns the dot product of x and y computed with the precision
+// of x. Dot panics if the lengths of x and y are not equal.
+func Dot(x, y *Vector) *big.Float {
+	if x.Len() != y.Len() {
+		panic(mat.ErrShape)
+	}
+	return dot(new(big.Float).SetPrec(x.prec), x.data, y.data)
+}
+
+// dot sets dst to the dot product of x and y, accumulated at the
+// precision of dst, and returns dst.
+func dot(dst *big.Float, x, y []big.Float) *big.Float {
+	var t big.Float
+	t.SetPrec(dst.Prec())
+	dst.SetInt64(0)
+	for i := range x {
+		t.Mul(&x[i], &y[i])
+		dst.Add(dst, &t)
+	}
+	return dst
+}
+
+// newFloats returns a slice of n zero values with the given precision.
+func newFloats(n int, prec uint) []big.Float {
+	f := make([]big.Float, n)
+	for i := range f {
+		f[i].SetPrec(prec)
+	}
+	return f
+}
+
+// setFloats sets the elements of dst to the values of src. big.Float
+// values must not be shallow copied.
+func setFloats(dst, src []big.Float) {
+	for i := range src {
+		dst[i].Set(&src[i])
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bigfloat_test
+
+import (
+	"fmt"
+	"log"
+	"math/big"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/num/bigfloat"
+)
+
+func ExampleLU_SolveVecTo() {
+	// Solve H x = b where H is the 14×14 Hilbert matrix
+	// and b is chosen so that the solution is all ones.
+	const (
+		n    = 14
+		prec = 256
+	)
+	h := bigfloat.NewMatrix(n, n, prec)
+	hf := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			v := big.NewFloat(1).SetPrec(prec)
+			v.Quo(v, big.NewFloat(float64(i+j+1)))
+			h.Set(i, j, v)
+			hf.Set(i, j, 1/float64(i+j+1))
+		}
+	}
+	ones := bigfloat.NewVector(n, prec)
+	for i := 0; i < n; i++ {
+		ones.SetVecFloat64(i, 1)
+	}
+	b := bigfloat.NewVector(n, prec)
+	b.MulVec(h, ones)
+
+	var lu bigfloat.LU
+	lu.Factorize(h)
+	x := bigfloat.NewVector(n, prec)
+	err := lu.SolveVecTo(x, b)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// The float64 solution of the same system is dominated
+	// by rounding error.
+	var xf mat.VecDense
+	_ = xf.SolveVec(hf, mat.NewVecDense(n, b.Float64s(nil)))
+
+	fmt.Printf("bigfloat x[0]=%.6f x[%d]=%.6f\n", x.Float64s(nil)[0], n-1, x.Float64s(nil)[n-1])
+	fmt.Printf("float64 solution norm exceeds 10: %t\n", mat.Norm(&xf, 2) > 10)
+
+	// Output:
+	// bigfloat x[0]=1.000000 x[13]=1.000000
+	// float64 solution norm exceeds 10: true
+}
@@ -0,0 +1,197 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bigfloat
+
+import (
+	"math/big"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+func randDense(r, c int, rnd *rand.Rand) *mat.Dense {
+	d := mat.NewDense(r, c, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			d.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	return d
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return false
+}
+
+func TestMatrixMul(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, k, n int }{
+		{m: 1, k: 1, n: 1},
+		{m: 3, k: 4, n: 5},
+		{m: 5, k: 2, n: 3},
+	} {
+		a := randDense(test.m, test.k, rnd)
+		b := randDense(test.k, test.n, rnd)
+		var want mat.Dense
+		want.Mul(a, b)
+
+		got := NewMatrix(test.m, test.n, 200)
+		got.Mul(NewMatrixFrom(a, 53), NewMatrixFrom(b, 53))
+		if !mat.EqualApprox(got.Dense(), &want, 1e-14) {
+			t.Errorf("unexpected product for %d×%d×%d:\ngot:\n%v\nwant:\n%v",
+				test.m, test.k, test.n, mat.Formatted(got.Dense()), mat.Formatted(&want))
+		}
+
+		if test.m == test.k && test.k == test.n {
+			am := NewMatrixFrom(a, 200)
+			am.Mul(am, NewMatrixFrom(b, 200))
+			if !mat.EqualApprox(am.Dense(), &want, 1e-14) {
+				t.Errorf("unexpected product for aliased receiver")
+			}
+		}
+	}
+	if !panics(func() { NewMatrix(2, 2, 64).Mul(NewMatrix(2, 3, 64), NewMatrix(2, 2, 64)) }) {
+		t.Error("expected panic for shape mismatch")
+	}
+}
+
+func TestVector(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	a := randDense(4, 3, rnd)
+	x := []float64{1, -2, 0.5}
+	var want mat.VecDense
+	want.MulVec(a, mat.NewVecDense(3, x))
+
+	got := NewVector(4, 200)
+	got.MulVec(NewMatrixFrom(a, 53), NewVectorFrom(x, 53))
+	if !floats.EqualApprox(got.Float64s(nil), want.RawVector().Data, 1e-14) {
+		t.Errorf("unexpected matrix-vector product: got:%v want:%v", got.Float64s(nil), want.RawVector().Data)
+	}
+
+	// The exact dot product of values whose float64 sum
+	// suffers catastrophic cancellation.
+	u := NewVectorFrom([]float64{1e20, 1, -1e20}, 53)
+	v := NewVectorFrom([]float64{1, 1, 1}, 53)
+	if got, _ := Dot(NewVectorFrom([]float64{1e20, 1, -1e20}, 200), v).Float64(); got != 1 {
+		t.Errorf("unexpected dot product: got:%v want:1", got)
+	}
+	if got := Dot(u, v); got.Sign() != 0 {
+		t.Errorf("unexpected low precision dot product: got:%v want:0", got)
+	}
+
+	v.SetVecFloat64(1, 3)
+	v.SetVec(2, big.NewFloat(-2))
+	if got, _ := v.AtVec(1).Float64(); got != 3 {
+		t.Errorf("unexpected element: got:%v want:3", got)
+	}
+	if got, _ := v.AtVec(2).Float64(); got != -2 {
+		t.Errorf("unexpected element: got:%v want:-2", got)
+	}
+	if !panics(func() { v.AtVec(3) }) {
+		t.Error("expected panic for index out of range")
+	}
+	if !panics(func() { Dot(u, NewVector(2, 53)) }) {
+		t.Error("expected panic for length mismatch")
+	}
+}
+
+// hilbert returns the n×n Hilbert matrix with elements of the given
+// precision.
+func hilbert(n int, prec uint) *Matrix {
+	h := NewMatrix(n, n, prec)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			v := new(big.Float).SetPrec(prec).SetInt64(1)
+			v.Quo(v, new(big.Float).SetInt64(int64(i+j+1)))
+			h.Set(i, j, v)
+		}
+	}
+	return h
+}
+
+func TestLUHilbert(t *testing.T) {
+	t.Parallel()
+	const prec = 512
+	for _, n := range []int{1, 2, 5, 10, 20} {
+		h := hilbert(n, prec)
+		ones := NewVector(n, prec)
+		for i := 0; i < n; i++ {
+			ones.SetVecFloat64(i, 1)
+		}
+		b := NewVector(n, prec)
+		b.MulVec(h, ones)
+
+		var lu LU
+		lu.Factorize(h)
+		x := NewVector(n, prec)
+		if err := lu.SolveVecTo(x, b); err != nil {
+			t.Fatalf("unexpected error for n=%d: %v", n, err)
+		}
+		tol := new(big.Float).SetFloat64(1e-60)
+		for i := 0; i < n; i++ {
+			d := new(big.Float).Sub(x.AtVec(i), big.NewFloat(1))
+			if d.Abs(d).Cmp(tol) > 0 {
+				t.Errorf("unexpected solution element %d for n=%d: got:%v", i, n, x.AtVec(i))
+			}
+		}
+
+		bm := NewMatrix(n, 2, prec)
+		for i := 0; i < n; i++ {
+			bm.Set(i, 0, b.AtVec(i))
+			bm.Set(i, 1, new(big.Float).Neg(b.AtVec(i)))
+		}
+		xm := NewMatrix(n, 2, prec)
+		if err := lu.SolveTo(xm, bm); err != nil {
+			t.Fatalf("unexpected error for n=%d: %v", n, err)
+		}
+		for i := 0; i < n; i++ {
+			for j, want := range []float64{1, -1} {
+				if got, _ := xm.At(i, j).Float64(); got != want {
+					t.Errorf("unexpected solution element (%d, %d) for n=%d: got:%v want:%v", i, j, n, got, want)
+				}
+			}
+		}
+	}
+
+	// The determinant of the 4×4 Hilbert matrix is 1/6048000.
+	var lu LU
+	lu.Factorize(hilbert(4, prec))
+	got, _ := lu.Det().Float64()
+	if want := 1.0 / 6048000; got != want {
+		t.Errorf("unexpected determinant: got:%v want:%v", got, want)
+	}
+}
+
+func TestLUSingular(t *testing.T) {
+	t.Parallel()
+	a := NewMatrixFrom(mat.NewDense(3, 3, []float64{
+		2, 4, 6,
+		1, 2, 3,
+		0, 1, 1,
+	}), 53)
+	var lu LU
+	lu.Factorize(a)
+	if err := lu.SolveVecTo(NewVector(3, 53), NewVector(3, 53)); err != ErrSingular {
+		t.Errorf("unexpected error for singular matrix: got:%v want:%v", err, ErrSingular)
+	}
+	if lu.Det().Sign() != 0 {
+		t.Errorf("unexpected determinant for singular matrix: got:%v want:0", lu.Det())
+	}
+	if !panics(func() { lu.Factorize(NewMatrix(2, 3, 53)) }) {
+		t.Error("expected panic for non-square matrix")
+	}
+	if !panics(func() { new(LU).Det() }) {
+		t.Error("expected panic for unfactorized LU")
+	}
+}
@@ -0,0 +1,16 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package bigfloat provides matrix and vector types with arbitrary precision
+// floating point elements, and a small set of linear algebra operations on
+// them. It is intended for problems where the conditioning of the problem
+// makes float64 computation useless, such as solving linear systems involving
+// Hilbert matrices, and for computing reference results to test float64
+// routines against.
+//
+// The elements of the types are math/big.Float values, and all arithmetic is
+// performed with the precision of the receiver or destination, rounding to
+// nearest even. Operations are much slower than their float64 counterparts
+// in the mat package.
+package bigfloat // import "gonum.org/v1/gonum/num/bigfloat"
@@ -0,0 +1,216 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bigfloat
+
+import (
+	"errors"
+	"math/big"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// ErrSingular is returned when a linear system with a singular
+// matrix is solved.
+var ErrSingular = errors.New("bigfloat: matrix is singular")
+
+const badLU = "bigfloat: invalid LU factorization"
+
+// LU is a square n×n matrix represented by its LU factorization with partial
+// pivoting.
+//
+// The factorization has the form
+//
+//	A = P * L * U
+//
+// where P is a permutation matrix, L is lower triangular with unit diagonal
+// elements, and U is upper triangular. The factorization is computed with
+// the precision of the factorized matrix.
+type LU struct {
+	lu   *Matrix
+	piv  []int // Row i of L*U is row piv[i] of A.
+	sign int   // The sign of the permutation.
+	ok   bool  // Whether A is nonsingular.
+}
+
+// Factorize computes the LU factorization of the square matrix a and stores
+// the result. The LU decomposition will complete regardless of the
+// singularity of a. Factorize panics if a is not square.
+func (lu *LU) Factorize(a *Matrix) {
+	n, c := a.Dims()
+	if n != c {
+		panic(mat.ErrSquare)
+	}
+	lu.lu = NewMatrix(n, n, a.prec)
+	setFloats(lu.lu.data, a.data)
+	lu.piv = make([]int, n)
+	for i := range lu.piv {
+		lu.piv[i] = i
+	}
+	lu.sign = 1
+	lu.ok = true
+
+	data := lu.lu.data
+	var l, t big.Float
+	l.SetPrec(a.prec)
+	t.SetPrec(a.prec)
+	for k := 0; k < n; k++ {
+		// Find the pivot with the largest magnitude.
+		p := k
+		for i := k + 1; i < n; i++ {
+			if cmpAbs(&data[i*n+k], &data[p*n+k]) > 0 {
+				p = i
+			}
+		}
+		if p != k {
+			for j := 0; j < n; j++ {
+				t.Set(&data[k*n+j])
+				data[k*n+j].Set(&data[p*n+j])
+				data[p*n+j].Set(&t)
+			}
+			lu.piv[k], lu.piv[p] = lu.piv[p], lu.piv[k]
+			lu.sign = -lu.sign
+		}
+		pivot := &data[k*n+k]
+		if pivot.Sign() == 0 {
+			lu.ok = false
+			continue
+		}
+		for i := k + 1; i < n; i++ {
+			l.Quo(&data[i*n+k], pivot)
+			data[i*n+k].Set(&l)
+			for j := k + 1; j < n; j++ {
+				t.Mul(&l, &data[k*n+j])
+				data[i*n+j].Sub(&data[i*n+j], &t)
+			}
+		}
+	}
+}
+
+// cmpAbs compares the absolute values of x and y and returns -1, 0 or +1
+// as for big.Float.Cmp.
+func cmpAbs(x, y *big.Float) int {
+	var ax, ay big.Float
+	return ax.Abs(x).Cmp(ay.Abs(y))
+}
+
+// Dims returns the dimensions of the factorized matrix.
+func (lu *LU) Dims() (r, c int) {
+	if lu.lu == nil {
+		panic(badLU)
+	}
+	return lu.lu.Dims()
+}
+
+// Det returns the determinant of the factorized matrix.
+func (lu *LU) Det() *big.Float {
+	if lu.lu == nil {
+		panic(badLU)
+	}
+	n := lu.lu.rows
+	det := new(big.Float).SetPrec(lu.lu.prec).SetInt64(int64(lu.sign))
+	for i := 0; i < n; i++ {
+		det.Mul(det, &lu.lu.data[i*n+i])
+	}
+	return det
+}
+
+// Pivot returns the row pivots of the factorization. Row i of L*U is
+// row dst[i] of the factorized matrix. If dst is nil, a new slice is
+// allocated. Pivot panics if the length of a non-nil dst is not n.
+func (lu *LU) Pivot(dst []int) []int {
+	if lu.lu == nil {
+		panic(badLU)
+	}
+	if dst == nil {
+		dst = make([]int, len(lu.piv))
+	}
+	if len(dst) != len(lu.piv) {
+		panic(mat.ErrShape)
+	}
+	copy(dst, lu.piv)
+	return dst
+}
+
+// SolveVecTo solves the system A * x = b for x using the LU factorization
+// of A and stores the result in dst. The solution is computed with the
+// precision of dst. If A is singular, ErrSingular is returned. SolveVecTo
+// panics if the lengths of dst and b do not match the size of A.
+func (lu *LU) SolveVecTo(dst, b *Vector) error {
+	if lu.lu == nil {
+		panic(badLU)
+	}
+	n := lu.lu.rows
+	if dst.Len() != n || b.Len() != n {
+		panic(mat.ErrShape)
+	}
+	if !lu.ok {
+		return ErrSingular
+	}
+	x := newFloats(n, dst.prec)
+	for i, p := range lu.piv {
+		x[i].Set(&b.data[p])
+	}
+	lu.solve(x)
+	setFloats(dst.data, x)
+	return nil
+}
+
+// SolveTo solves the system A * X = B for X using the LU factorization
+// of A and stores the result in dst. The solution is computed with the
+// precision of dst. If A is singular, ErrSingular is returned. SolveTo
+// panics if the dimensions of dst and b do not match.
+func (lu *LU) SolveTo(dst, b *Matrix) error {
+	if lu.lu == nil {
+		panic(badLU)
+	}
+	n := lu.lu.rows
+	br, bc := b.Dims()
+	if br != n || dst.rows != n || dst.cols != bc {
+		panic(mat.ErrShape)
+	}
+	if !lu.ok {
+		return ErrSingular
+	}
+	x := newFloats(n*bc, dst.prec)
+	col := newFloats(n, dst.prec)
+	for j := 0; j < bc; j++ {
+		for i, p := range lu.piv {
+			col[i].Set(&b.data[p*bc+j])
+		}
+		lu.solve(col)
+		for i := range col {
+			x[i*bc+j].Set(&col[i])
+		}
+	}
+	setFloats(dst.data, x)
+	return nil
+}
+
+// solve overwrites x, which must hold the permuted right-hand side,
+// with the solution of L * U * x = x.
+func (lu *LU) solve(x []big.Float) {
+	n := lu.lu.rows
+	if n == 0 {
+		return
+	}
+	data := lu.lu.data
+	var t big.Float
+	t.SetPrec(x[0].Prec())
+	// Forward substitution with the unit lower triangle.
+	for i := 1; i < n; i++ {
+		for j := 0; j < i; j++ {
+			t.Mul(&data[i*n+j], &x[j])
+			x[i].Sub(&x[i], &t)
+		}
+	}
+	// Back substitution with the upper triangle.
+	for i := n - 1; i >= 0; i-- {
+		for j := i + 1; j < n; j++ {
+			t.Mul(&data[i*n+j], &x[j])
+			x[i].Sub(&x[i], &t)
+		}
+		x[i].Quo(&x[i], &data[i*n+i])
+	}
+}
@@ -7,6 +7,12 @@
 //
 // See https://en.wikipedia.org/wiki/Dual_number for details of their properties
 // and uses.
+//
+// Dual numbers provide forward-mode automatic differentiation, where each
+// evaluation of a function gives the derivative in a single direction. The
+// Tape and Var types provide reverse-mode automatic differentiation, where
+// the gradient of a scalar function with respect to all of its inputs is
+// obtained from a single evaluation.
 package dual // imports "gonum.org/v1/gonum/num/dual"
 
 // TODO(kortschak): Handle special cases properly.
@@ -0,0 +1,56 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dual
+
+import "gonum.org/v1/gonum/mat"
+
+// Jacobian computes the Jacobian matrix of the vector-valued function f at x
+// using forward-mode automatic differentiation, storing the result in dst.
+// If dst is nil, a new matrix is allocated, and if dst is empty it is resized
+// to the dimensions of the Jacobian. The matrix is returned.
+//
+// If f maps an n-dimensional vector x to an m-dimensional vector y = f(x),
+// the Jacobian J is the m×n matrix
+//
+//	J_{i,j} = ∂f_i/∂x_j.
+//
+// The function f is evaluated n times, once for each column of J, with
+// the dual part of the input seeded with the corresponding unit vector.
+// The length of the result of f must be the same for every evaluation.
+// The slice passed to f must not be modified or retained.
+//
+// Jacobian panics if x has zero length or if a non-empty dst is not m×n.
+func Jacobian(dst *mat.Dense, f func(x []Number) []Number, x []float64) *mat.Dense {
+	n := len(x)
+	if n == 0 {
+		panic("dual: zero length x")
+	}
+	xd := Seed(nil, x, nil)
+	var col []float64
+	for j := 0; j < n; j++ {
+		xd[j].Emag = 1
+		y := f(xd)
+		xd[j].Emag = 0
+		if j == 0 {
+			m := len(y)
+			switch {
+			case dst == nil:
+				dst = mat.NewDense(m, n, nil)
+			case dst.IsEmpty():
+				dst.ReuseAs(m, n)
+			default:
+				if r, c := dst.Dims(); r != m || c != n {
+					panic(mat.ErrShape)
+				}
+			}
+			col = make([]float64, m)
+		}
+		if len(y) != len(col) {
+			panic("dual: inconsistent function result length")
+		}
+		dst.SetCol(j, Emags(col, y))
+	}
+	return dst
+}
@@ -0,0 +1,84 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dual
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestJacobian(t *testing.T) {
+	t.Parallel()
+	// f maps R³ to R⁴.
+	f := func(x []Number) []Number {
+		return []Number{
+			Mul(x[0], x[1]),
+			Sin(Add(x[1], x[2])),
+			Exp(Scale(0.5, x[0])),
+			Mul(Mul(x[0], x[1]), Inv(x[2])),
+		}
+	}
+	ff := func(y, x []float64) {
+		xd := Seed(nil, x, nil)
+		Reals(y, f(xd))
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 10; trial++ {
+		x := []float64{rnd.NormFloat64(), rnd.NormFloat64(), 1 + rnd.Float64()}
+		want := mat.NewDense(4, 3, nil)
+		fd.Jacobian(want, ff, x, &fd.JacobianSettings{Formula: fd.Central})
+
+		got := Jacobian(nil, f, x)
+		if !mat.EqualApprox(got, want, 1e-8) {
+			t.Errorf("unexpected Jacobian for x=%v:\ngot:\n%v\nwant:\n%v", x, mat.Formatted(got), mat.Formatted(want))
+		}
+
+		var empty mat.Dense
+		Jacobian(&empty, f, x)
+		if !mat.Equal(&empty, got) {
+			t.Errorf("unexpected Jacobian for empty destination")
+		}
+		dst := mat.NewDense(4, 3, nil)
+		if Jacobian(dst, f, x) != dst || !mat.Equal(dst, got) {
+			t.Errorf("unexpected Jacobian for allocated destination")
+		}
+	}
+
+	// The exact Jacobian of a linear function.
+	a := mat.NewDense(2, 3, []float64{
+		1, 2, 3,
+		-4, 5, -6,
+	})
+	lin := func(x []Number) []Number {
+		y := make([]Number, 2)
+		for i := range y {
+			y[i] = Dot([]Number{{Real: a.At(i, 0)}, {Real: a.At(i, 1)}, {Real: a.At(i, 2)}}, x)
+		}
+		return y
+	}
+	if got := Jacobian(nil, lin, []float64{7, 8, 9}); !mat.Equal(got, a) {
+		t.Errorf("unexpected Jacobian of linear function:\ngot:\n%v\nwant:\n%v", mat.Formatted(got), mat.Formatted(a))
+	}
+
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "zero length x", fn: func() { Jacobian(nil, f, nil) }},
+		{name: "bad dst shape", fn: func() { Jacobian(mat.NewDense(3, 3, nil), f, []float64{1, 2, 3}) }},
+		{name: "inconsistent result", fn: func() {
+			Jacobian(nil, func(x []Number) []Number { return make([]Number, int(x[0].Emag)+1) }, []float64{1, 2})
+		}},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
@@ -0,0 +1,337 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dual
+
+// Tape records the operations performed on Vars so that derivatives can be
+// computed by reverse-mode automatic differentiation. Each operation adds a
+// single node to the tape holding the partial derivatives of its result with
+// respect to its operands. The gradient of a scalar result with respect to
+// all of its inputs is then found with a single reverse sweep over the tape,
+// at a cost proportional to the number of recorded operations and independent
+// of the number of inputs. This complements the forward-mode Number type,
+// which requires one evaluation for each input.
+//
+// The zero value of Tape is an empty tape ready to use. A Tape must not be
+// used concurrently.
+type Tape struct {
+	nodes []node
+	adj   []float64
+}
+
+// node is an operation recorded on a Tape. Operands that are
+// constants or absent have a negative index.
+type node struct {
+	i, j   int
+	di, dj float64
+}
+
+// Var is a scalar variable whose operations may be recorded on a Tape.
+// A Var that was not created by a Tape, including the zero value, is a
+// constant, and operations involving only constants are not recorded.
+// Vars from different tapes must not be combined.
+type Var struct {
+	tape  *Tape
+	index int
+	value float64
+}
+
+// Variable returns a new independent variable with the value x recorded
+// on the tape.
+func (t *Tape) Variable(x float64) Var {
+	return Var{tape: t, index: t.push(node{i: -1, j: -1}), value: x}
+}
+
+// Constant returns a constant Var with the value x.
+func Constant(x float64) Var {
+	return Var{value: x}
+}
+
+// Len returns the number of operations recorded on the tape.
+func (t *Tape) Len() int {
+	return len(t.nodes)
+}
+
+// Reset clears the tape so that its storage can be reused. Vars created
+// before the call to Reset must not be used after it.
+func (t *Tape) Reset() {
+	t.nodes = t.nodes[:0]
+}
+
+// Gradient computes the partial derivatives of y with respect to each of the
+// variables in x, storing the result in dst. If dst is nil, a new slice is
+// allocated. Gradient panics if the lengths of dst and x are not equal, or
+// if y or any element of x was recorded on another tape.
+func (t *Tape) Gradient(dst []float64, y Var, x []Var) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(x))
+	}
+	if len(dst) != len(x) {
+		panic("dual: slice length mismatch")
+	}
+	if y.tape != nil && y.tape != t {
+		panic(errTape)
+	}
+	for _, v := range x {
+		if v.tape != nil && v.tape != t {
+			panic(errTape)
+		}
+	}
+	if y.tape == nil {
+		// A constant does not depend on any variable.
+		for i := range dst {
+			dst[i] = 0
+		}
+		return dst
+	}
+
+	n := y.index + 1
+	if cap(t.adj) < n {
+		t.adj = make([]float64, n)
+	}
+	adj := t.adj[:n]
+	for i := range adj {
+		adj[i] = 0
+	}
+	adj[y.index] = 1
+	for k := y.index; k >= 0; k-- {
+		a := adj[k]
+		if a == 0 {
+			continue
+		}
+		nd := t.nodes[k]
+		if nd.i >= 0 {
+			adj[nd.i] += a * nd.di
+		}
+		if nd.j >= 0 {
+			adj[nd.j] += a * nd.dj
+		}
+	}
+	for i, v := range x {
+		if v.tape == nil || v.index >= n {
+			dst[i] = 0
+			continue
+		}
+		dst[i] = adj[v.index]
+	}
+	return dst
+}
+
+// Gradient computes the gradient of f at x using reverse-mode automatic
+// differentiation, storing the result in dst. If dst is nil, a new slice
+// is allocated. Gradient panics if the lengths of dst and x are not equal.
+//
+// The function f is called once with a slice of variables holding the
+// values of x and must return its result computed from those variables.
+func Gradient(dst []float64, f func(x []Var) Var, x []float64) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(x))
+	}
+	if len(dst) != len(x) {
+		panic("dual: slice length mismatch")
+	}
+	var t Tape
+	vars := make([]Var, len(x))
+	for i, v := range x {
+		vars[i] = t.Variable(v)
+	}
+	return t.Gradient(dst, f(vars), vars)
+}
+
+const errTape = "dual: variable from different tape"
+
+// push appends n to the tape and returns its index.
+func (t *Tape) push(n node) int {
+	t.nodes = append(t.nodes, n)
+	return len(t.nodes) - 1
+}
+
+// unary returns the result of an operation on v with value z and
+// derivative dz with respect to v.
+func (v Var) unary(z, dz float64) Var {
+	if v.tape == nil {
+		return Constant(z)
+	}
+	return Var{tape: v.tape, index: v.tape.push(node{i: v.index, j: -1, di: dz}), value: z}
+}
+
+// binary returns the result of an operation on v and u with value z and
+// derivatives dv and du with respect to v and u.
+func (v Var) binary(u Var, z, dv, du float64) Var {
+	t := v.tape
+	switch {
+	case t == nil && u.tape == nil:
+		return Constant(z)
+	case t == nil:
+		return u.unary(z, du)
+	case u.tape == nil:
+		return v.unary(z, dv)
+	case t != u.tape:
+		panic(errTape)
+	}
+	return Var{tape: t, index: t.push(node{i: v.index, j: u.index, di: dv, dj: du}), value: z}
+}
+
+// apply returns the result of the function fn, which operates on dual
+// numbers, applied to v. The derivative is obtained by evaluating fn
+// with a unit dual part.
+func (v Var) apply(fn func(Number) Number) Var {
+	d := fn(Number{Real: v.value, Emag: 1})
+	return v.unary(d.Real, d.Emag)
+}
+
+// Value returns the value of v.
+func (v Var) Value() float64 {
+	return v.value
+}
+
+// Add returns the sum of v and u.
+func (v Var) Add(u Var) Var {
+	return v.binary(u, v.value+u.value, 1, 1)
+}
+
+// Sub returns the difference of v and u, v-u.
+func (v Var) Sub(u Var) Var {
+	return v.binary(u, v.value-u.value, 1, -1)
+}
+
+// Mul returns the product of v and u.
+func (v Var) Mul(u Var) Var {
+	return v.binary(u, v.value*u.value, u.value, v.value)
+}
+
+// Div returns the quotient of v and u, v/u.
+func (v Var) Div(u Var) Var {
+	q := v.value / u.value
+	return v.binary(u, q, 1/u.value, -q/u.value)
+}
+
+// Scale returns v scaled by f.
+func (v Var) Scale(f float64) Var {
+	return v.unary(f*v.value, f)
+}
+
+// Neg returns the negation of v.
+func (v Var) Neg() Var {
+	return v.unary(-v.value, -1)
+}
+
+// Inv returns the inverse of v. Special cases are as for the Inv function.
+func (v Var) Inv() Var {
+	return v.apply(Inv)
+}
+
+// Abs returns the absolute value of v. Special cases are as for the
+// Abs function.
+func (v Var) Abs() Var {
+	return v.apply(Abs)
+}
+
+// PowReal returns v**p. Special cases are as for the PowReal function.
+func (v Var) PowReal(p float64) Var {
+	return v.apply(func(d Number) Number { return PowReal(d, p) })
+}
+
+// Pow returns v**u. Special cases are as for the Pow function.
+func (v Var) Pow(u Var) Var {
+	dv := Pow(Number{Real: v.value, Emag: 1}, Number{Real: u.value})
+	du := Pow(Number{Real: v.value}, Number{Real: u.value, Emag: 1})
+	return v.binary(u, dv.Real, dv.Emag, du.Emag)
+}
+
+// Sqrt returns the square root of v. Special cases are as for the
+// Sqrt function.
+func (v Var) Sqrt() Var {
+	return v.apply(Sqrt)
+}
+
+// Exp returns e**v. Special cases are as for the Exp function.
+func (v Var) Exp() Var {
+	return v.apply(Exp)
+}
+
+// Log returns the natural logarithm of v. Special cases are as for the
+// Log function.
+func (v Var) Log() Var {
+	return v.apply(Log)
+}
+
+// Sin returns the sine of v. Special cases are as for the Sin function.
+func (v Var) Sin() Var {
+	return v.apply(Sin)
+}
+
+// Cos returns the cosine of v. Special cases are as for the Cos function.
+func (v Var) Cos() Var {
+	return v.apply(Cos)
+}
+
+// Tan returns the tangent of v. Special cases are as for the Tan function.
+func (v Var) Tan() Var {
+	return v.apply(Tan)
+}
+
+// Asin returns the inverse sine of v. Special cases are as for the
+// Asin function.
+func (v Var) Asin() Var {
+	return v.apply(Asin)
+}
+
+// Acos returns the inverse cosine of v. Special cases are as for the
+// Acos function.
+func (v Var) Acos() Var {
+	return v.apply(Acos)
+}
+
+// Atan returns the inverse tangent of v. Special cases are as for the
+// Atan function.
+func (v Var) Atan() Var {
+	return v.apply(Atan)
+}
+
+// Sinh returns the hyperbolic sine of v. Special cases are as for the
+// Sinh function.
+func (v Var) Sinh() Var {
+	return v.apply(Sinh)
+}
+
+// Cosh returns the hyperbolic cosine of v. Special cases are as for the
+// Cosh function.
+func (v Var) Cosh() Var {
+	return v.apply(Cosh)
+}
+
+// Tanh returns the hyperbolic tangent of v. Special cases are as for the
+// Tanh function.
+func (v Var) Tanh() Var {
+	return v.apply(Tanh)
+}
+
+// Asinh returns the inverse hyperbolic sine of v. Special cases are as for
+// the Asinh function.
+func (v Var) Asinh() Var {
+	return v.apply(Asinh)
+}
+
+// Acosh returns the inverse hyperbolic cosine of v. Special cases are as
+// for the Acosh function.
+func (v Var) Acosh() Var {
+	return v.apply(Acosh)
+}
+
+// Atanh returns the inverse hyperbolic tangent of v. Special cases are as
+// for the Atanh function.
+func (v Var) Atanh() Var {
+	return v.apply(Atanh)
+}
+
+// Sum returns the sum of the elements of vs, recorded as a sequence of
+// additions.
+func Sum(vs []Var) Var {
+	var s Var
+	for _, v := range vs {
+		s = s.Add(v)
+	}
+	return s
+}
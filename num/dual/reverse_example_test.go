@@ -0,0 +1,28 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dual_test
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/num/dual"
+)
+
+func ExampleGradient() {
+	// Calculate the gradient of the function
+	// f(x, y, z) = x*y*sin(z) + exp(x*z)
+	// with a single reverse sweep.
+	f := func(v []dual.Var) dual.Var {
+		x, y, z := v[0], v[1], v[2]
+		return x.Mul(y).Mul(z.Sin()).Add(x.Mul(z).Exp())
+	}
+
+	grad := dual.Gradient(nil, f, []float64{1, 2, 0.5})
+	fmt.Printf("∇f(1, 2, 0.5)=%.4f\n", grad)
+
+	// Output:
+	//
+	// ∇f(1, 2, 0.5)=[1.7832 0.4794 3.4039]
+}
@@ -0,0 +1,232 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dual
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+var reverseUnaryTests = []struct {
+	name string
+	rev  func(Var) Var
+	fwd  func(Number) Number
+	x    []float64
+}{
+	{name: "Inv", rev: Var.Inv, fwd: Inv, x: []float64{-2, 0.5, 3}},
+	{name: "Abs", rev: Var.Abs, fwd: Abs, x: []float64{-2, 0.5, 3}},
+	{name: "Neg", rev: Var.Neg, fwd: func(d Number) Number { return Scale(-1, d) }, x: []float64{-2, 0.5, 3}},
+	{name: "Sqrt", rev: Var.Sqrt, fwd: Sqrt, x: []float64{0, 0.5, 3}},
+	{name: "Exp", rev: Var.Exp, fwd: Exp, x: []float64{-2, 0.5, 3}},
+	{name: "Log", rev: Var.Log, fwd: Log, x: []float64{0, 0.5, 3}},
+	{name: "Sin", rev: Var.Sin, fwd: Sin, x: []float64{-2, 0.5, 3}},
+	{name: "Cos", rev: Var.Cos, fwd: Cos, x: []float64{-2, 0.5, 3}},
+	{name: "Tan", rev: Var.Tan, fwd: Tan, x: []float64{-2, 0.5, 3}},
+	{name: "Asin", rev: Var.Asin, fwd: Asin, x: []float64{-0.5, 0, 0.9}},
+	{name: "Acos", rev: Var.Acos, fwd: Acos, x: []float64{-0.5, 0, 0.9}},
+	{name: "Atan", rev: Var.Atan, fwd: Atan, x: []float64{-2, 0.5, 3}},
+	{name: "Sinh", rev: Var.Sinh, fwd: Sinh, x: []float64{-2, 0.5, 3}},
+	{name: "Cosh", rev: Var.Cosh, fwd: Cosh, x: []float64{-2, 0.5, 3}},
+	{name: "Tanh", rev: Var.Tanh, fwd: Tanh, x: []float64{-2, 0.5, 3}},
+	{name: "Asinh", rev: Var.Asinh, fwd: Asinh, x: []float64{-2, 0.5, 3}},
+	{name: "Acosh", rev: Var.Acosh, fwd: Acosh, x: []float64{1.5, 3}},
+	{name: "Atanh", rev: Var.Atanh, fwd: Atanh, x: []float64{-0.5, 0, 0.9}},
+	{name: "PowReal", rev: func(v Var) Var { return v.PowReal(2.5) }, fwd: func(d Number) Number { return PowReal(d, 2.5) }, x: []float64{0.5, 3}},
+}
+
+func TestReverseUnary(t *testing.T) {
+	t.Parallel()
+	for _, test := range reverseUnaryTests {
+		for _, x := range test.x {
+			var tape Tape
+			v := tape.Variable(x)
+			y := test.rev(v)
+			want := test.fwd(Number{Real: x, Emag: 1})
+			if !same(y.Value(), want.Real, 0) {
+				t.Errorf("unexpected value for %s(%v): got:%v want:%v", test.name, x, y.Value(), want.Real)
+			}
+			got := tape.Gradient(nil, y, []Var{v})[0]
+			if !same(got, want.Emag, 0) {
+				t.Errorf("unexpected derivative for %s(%v): got:%v want:%v", test.name, x, got, want.Emag)
+			}
+
+			// Operations on constants are not recorded.
+			c := test.rev(Constant(x))
+			if c.tape != nil || !same(c.Value(), want.Real, 0) {
+				t.Errorf("unexpected constant result for %s(%v): got:%v want:%v", test.name, x, c.Value(), want.Real)
+			}
+		}
+	}
+}
+
+func TestReverseBinary(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name   string
+		fn     func(x, y Var) Var
+		x, y   float64
+		dx, dy float64
+	}{
+		{name: "Add", fn: Var.Add, x: 2, y: 3, dx: 1, dy: 1},
+		{name: "Sub", fn: Var.Sub, x: 2, y: 3, dx: 1, dy: -1},
+		{name: "Mul", fn: Var.Mul, x: 2, y: 3, dx: 3, dy: 2},
+		{name: "Div", fn: Var.Div, x: 2, y: 4, dx: 0.25, dy: -0.125},
+		{name: "Pow", fn: Var.Pow, x: 2, y: 3, dx: 12, dy: 8 * math.Ln2},
+		{name: "Scale", fn: func(x, _ Var) Var { return x.Scale(-3) }, x: 2, y: 3, dx: -3, dy: 0},
+		{name: "Square", fn: func(x, _ Var) Var { return x.Mul(x) }, x: 2, y: 3, dx: 4, dy: 0},
+	} {
+		var tape Tape
+		x := tape.Variable(test.x)
+		y := tape.Variable(test.y)
+		got := tape.Gradient(nil, test.fn(x, y), []Var{x, y})
+		if !scalar.EqualWithinAbsOrRel(got[0], test.dx, 1e-14, 1e-14) || !scalar.EqualWithinAbsOrRel(got[1], test.dy, 1e-14, 1e-14) {
+			t.Errorf("unexpected gradient for %s: got:%v want:[%v %v]", test.name, got, test.dx, test.dy)
+		}
+
+		// Mixed constant and variable operands.
+		got = tape.Gradient(nil, test.fn(x, Constant(test.y)), []Var{x})
+		if !scalar.EqualWithinAbsOrRel(got[0], test.dx, 1e-14, 1e-14) {
+			t.Errorf("unexpected derivative for %s with constant y: got:%v want:%v", test.name, got[0], test.dx)
+		}
+		if test.dy != 0 {
+			got = tape.Gradient(nil, test.fn(Constant(test.x), y), []Var{y})
+			if !scalar.EqualWithinAbsOrRel(got[0], test.dy, 1e-14, 1e-14) {
+				t.Errorf("unexpected derivative for %s with constant x: got:%v want:%v", test.name, got[0], test.dy)
+			}
+		}
+	}
+}
+
+func rosenbrock(x []Var) Var {
+	var sum Var
+	for i := 0; i < len(x)-1; i++ {
+		a := Constant(1).Sub(x[i])
+		b := x[i+1].Sub(x[i].Mul(x[i]))
+		sum = sum.Add(a.Mul(a)).Add(b.Mul(b).Scale(100))
+	}
+	return sum
+}
+
+func TestGradient(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{2, 10, 1000} {
+		x := make([]float64, n)
+		for i := range x {
+			x[i] = rnd.NormFloat64()
+		}
+		want := make([]float64, n)
+		functions.ExtendedRosenbrock{}.Grad(want, x)
+		got := Gradient(nil, rosenbrock, x)
+		if !floats.EqualApprox(got, want, 1e-12) {
+			t.Errorf("unexpected gradient for n=%d", n)
+		}
+	}
+
+	// Compare a function of transcendental
+	// operations with finite differences.
+	f := func(x []Var) Var {
+		return x[0].Exp().Mul(x[1].Sin()).Add(x[2].Sqrt().Div(x[0].Atan().Add(x[1].Mul(x[1])))).Sub(x[2].Log().Tanh())
+	}
+	x := []float64{0.3, -1.2, 2.5}
+	got := Gradient(nil, f, x)
+	want := fd.Gradient(nil, func(x []float64) float64 {
+		vars := make([]Var, len(x))
+		for i, v := range x {
+			vars[i] = Constant(v)
+		}
+		return f(vars).Value()
+	}, x, &fd.Settings{Formula: fd.Central})
+	if !floats.EqualApprox(got, want, 1e-7) {
+		t.Errorf("unexpected gradient: got:%v want:%v", got, want)
+	}
+}
+
+func TestTape(t *testing.T) {
+	t.Parallel()
+	var tape Tape
+	x := tape.Variable(2)
+	y := tape.Variable(3)
+	z := x.Mul(y).Add(x)
+	if tape.Len() != 4 {
+		t.Errorf("unexpected tape length: got:%d want:4", tape.Len())
+	}
+
+	// Gradients may be computed repeatedly and for
+	// intermediate results.
+	for i := 0; i < 2; i++ {
+		got := tape.Gradient(nil, z, []Var{x, y})
+		if got[0] != 4 || got[1] != 2 {
+			t.Errorf("unexpected gradient: got:%v want:[4 2]", got)
+		}
+	}
+	got := tape.Gradient(nil, x.Mul(y), []Var{x, y, z, Constant(1)})
+	if got[0] != 3 || got[1] != 2 || got[2] != 0 || got[3] != 0 {
+		t.Errorf("unexpected gradient: got:%v want:[3 2 0 0]", got)
+	}
+	got = tape.Gradient(nil, Constant(1), []Var{x, y})
+	if got[0] != 0 || got[1] != 0 {
+		t.Errorf("unexpected gradient of constant: got:%v want:[0 0]", got)
+	}
+
+	tape.Reset()
+	if tape.Len() != 0 {
+		t.Errorf("unexpected tape length after reset: got:%d want:0", tape.Len())
+	}
+	x = tape.Variable(5)
+	got = tape.Gradient(nil, x.Mul(x), []Var{x})
+	if got[0] != 10 {
+		t.Errorf("unexpected derivative after reset: got:%v want:10", got[0])
+	}
+
+	var other Tape
+	w := other.Variable(1)
+	if !panics(func() { x.Add(w) }) {
+		t.Error("expected panic for variables from different tapes")
+	}
+	if !panics(func() { tape.Gradient(nil, w, []Var{x}) }) {
+		t.Error("expected panic for result from different tape")
+	}
+	if !panics(func() { tape.Gradient(make([]float64, 2), x, []Var{x}) }) {
+		t.Error("expected panic for length mismatch")
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return false
+}
+
+func BenchmarkGradientRosenbrock(b *testing.B) {
+	for _, n := range []int{10, 1000, 100000} {
+		x := make([]float64, n)
+		for i := range x {
+			x[i] = 1.5
+		}
+		dst := make([]float64, n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			var tape Tape
+			vars := make([]Var, n)
+			for i := 0; i < b.N; i++ {
+				tape.Reset()
+				for j, v := range x {
+					vars[j] = tape.Variable(v)
+				}
+				tape.Gradient(dst, rosenbrock(vars), vars)
+			}
+		})
+	}
+}
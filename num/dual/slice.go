@@ -0,0 +1,138 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dual
+
+const (
+	badLength    = "dual: slice lengths do not match"
+	badDstLength = "dual: destination slice length does not match input"
+)
+
+// Seed sets the elements of dst to the dual numbers with real parts x and
+// dual parts dir, so that evaluating a function of dst gives the directional
+// derivative of the function along dir. If dir is nil, the dual parts are
+// zero. If dst is nil, a new slice is allocated. Seed panics if the lengths
+// of dst, x and a non-nil dir are not equal.
+func Seed(dst []Number, x, dir []float64) []Number {
+	if dir != nil && len(dir) != len(x) {
+		panic(badLength)
+	}
+	if dst == nil {
+		dst = make([]Number, len(x))
+	}
+	if len(dst) != len(x) {
+		panic(badDstLength)
+	}
+	for i, v := range x {
+		dst[i].Real = v
+		if dir == nil {
+			dst[i].Emag = 0
+		} else {
+			dst[i].Emag = dir[i]
+		}
+	}
+	return dst
+}
+
+// Reals stores the real parts of the elements of d in dst. If dst is nil,
+// a new slice is allocated. Reals panics if the lengths of dst and d are
+// not equal.
+func Reals(dst []float64, d []Number) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(d))
+	}
+	if len(dst) != len(d) {
+		panic(badDstLength)
+	}
+	for i, v := range d {
+		dst[i] = v.Real
+	}
+	return dst
+}
+
+// Emags stores the dual parts of the elements of d in dst. If dst is nil,
+// a new slice is allocated. Emags panics if the lengths of dst and d are
+// not equal.
+func Emags(dst []float64, d []Number) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(d))
+	}
+	if len(dst) != len(d) {
+		panic(badDstLength)
+	}
+	for i, v := range d {
+		dst[i] = v.Emag
+	}
+	return dst
+}
+
+// AddTo adds, element-wise, the elements of x and y and stores the result
+// in dst. It panics if the argument lengths do not match.
+func AddTo(dst, x, y []Number) []Number {
+	if len(x) != len(y) {
+		panic(badLength)
+	}
+	if len(dst) != len(x) {
+		panic(badDstLength)
+	}
+	for i, v := range x {
+		dst[i] = Add(v, y[i])
+	}
+	return dst
+}
+
+// SubTo subtracts, element-wise, the elements of y from x and stores the
+// result in dst. It panics if the argument lengths do not match.
+func SubTo(dst, x, y []Number) []Number {
+	if len(x) != len(y) {
+		panic(badLength)
+	}
+	if len(dst) != len(x) {
+		panic(badDstLength)
+	}
+	for i, v := range x {
+		dst[i] = Sub(v, y[i])
+	}
+	return dst
+}
+
+// MulTo performs element-wise dual multiplication of x and y and stores
+// the result in dst. It panics if the argument lengths do not match.
+func MulTo(dst, x, y []Number) []Number {
+	if len(x) != len(y) {
+		panic(badLength)
+	}
+	if len(dst) != len(x) {
+		panic(badDstLength)
+	}
+	for i, v := range x {
+		dst[i] = Mul(v, y[i])
+	}
+	return dst
+}
+
+// ScaleTo multiplies the elements in x by f and stores the result in dst.
+// It panics if the slice argument lengths do not match.
+func ScaleTo(dst []Number, f float64, x []Number) []Number {
+	if len(dst) != len(x) {
+		panic(badDstLength)
+	}
+	for i, v := range x {
+		dst[i] = Scale(f, v)
+	}
+	return dst
+}
+
+// Dot computes the dual dot product of x and y, the sum of the dual
+// products of their elements. It panics if the slice lengths do not match.
+func Dot(x, y []Number) Number {
+	if len(x) != len(y) {
+		panic(badLength)
+	}
+	var sum Number
+	for i, v := range x {
+		sum = Add(sum, Mul(v, y[i]))
+	}
+	return sum
+}
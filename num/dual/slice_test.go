@@ -0,0 +1,70 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dual
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSliceOps(t *testing.T) {
+	t.Parallel()
+	x := []Number{{1, 2}, {3, -1}, {-2, 0.5}}
+	y := []Number{{4, 0}, {-1, 1}, {0.5, 2}}
+	dst := make([]Number, 3)
+
+	for _, test := range []struct {
+		name string
+		got  []Number
+		want []Number
+	}{
+		{name: "AddTo", got: AddTo(make([]Number, 3), x, y), want: []Number{{5, 2}, {2, 0}, {-1.5, 2.5}}},
+		{name: "SubTo", got: SubTo(make([]Number, 3), x, y), want: []Number{{-3, 2}, {4, -2}, {-2.5, -1.5}}},
+		{name: "MulTo", got: MulTo(make([]Number, 3), x, y), want: []Number{{4, 8}, {-3, 4}, {-1, -3.75}}},
+		{name: "ScaleTo", got: ScaleTo(make([]Number, 3), -2, x), want: []Number{{-2, -4}, {-6, 2}, {4, -1}}},
+	} {
+		if !reflect.DeepEqual(test.got, test.want) {
+			t.Errorf("unexpected result for %s: got:%v want:%v", test.name, test.got, test.want)
+		}
+	}
+
+	// (1+2ϵ)(4) + (3-ϵ)(-1+ϵ) + (-2+0.5ϵ)(0.5+2ϵ)
+	if got, want := Dot(x, y), (Number{Real: 0, Emag: 8.25}); got != want {
+		t.Errorf("unexpected dot product: got:%v want:%v", got, want)
+	}
+
+	seeded := Seed(nil, []float64{1, 2}, []float64{0, 1})
+	if want := []Number{{1, 0}, {2, 1}}; !reflect.DeepEqual(seeded, want) {
+		t.Errorf("unexpected seeded slice: got:%v want:%v", seeded, want)
+	}
+	Seed(seeded, []float64{3, 4}, nil)
+	if want := []Number{{3, 0}, {4, 0}}; !reflect.DeepEqual(seeded, want) {
+		t.Errorf("unexpected seeded slice without direction: got:%v want:%v", seeded, want)
+	}
+	if got, want := Reals(nil, x), []float64{1, 3, -2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected real parts: got:%v want:%v", got, want)
+	}
+	if got, want := Emags(nil, x), []float64{2, -1, 0.5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected dual parts: got:%v want:%v", got, want)
+	}
+
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "AddTo", fn: func() { AddTo(dst, x, y[:2]) }},
+		{name: "SubTo", fn: func() { SubTo(dst[:2], x, y) }},
+		{name: "MulTo", fn: func() { MulTo(dst, x[:1], y) }},
+		{name: "ScaleTo", fn: func() { ScaleTo(dst[:1], 1, x) }},
+		{name: "Dot", fn: func() { Dot(x, y[:1]) }},
+		{name: "Seed", fn: func() { Seed(nil, []float64{1, 2}, []float64{1}) }},
+		{name: "Reals", fn: func() { Reals(make([]float64, 1), x) }},
+		{name: "Emags", fn: func() { Emags(make([]float64, 1), x) }},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("expected panic for %s with mismatched lengths", test.name)
+		}
+	}
+}
@@ -7,6 +7,11 @@
 // Dual quaternions provide a system for rigid transformation with interpolation
 // and blending in ℝ³. See https://www.cs.utah.edu/~ladislav/kavan06dual/kavan06dual.pdf and
 // https://en.wikipedia.org/wiki/Dual_quaternion for more details.
+//
+// Functions are provided to construct unit dual quaternions from rotations,
+// translations, screw parameters and homogeneous transformation matrices,
+// to transform points and lines in ℝ³, and to interpolate between rigid
+// transformations using screw linear interpolation.
 package dualquat // imports "gonum.org/v1/gonum/num/dualquat"
 
 // TODO(kortschak): Handle special cases properly.
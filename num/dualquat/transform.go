@@ -0,0 +1,210 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dualquat
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/num/quat"
+	"gonum.org/v1/gonum/spatial/r3"
+)
+
+// The functions in this file treat unit dual quaternions as rigid
+// transformations in three dimensions. The unit dual quaternion r+½trϵ,
+// where r is a unit quaternion, is the rotation r followed by the
+// translation t. Transformations compose by multiplication, so Mul(a, b)
+// is the transformation b followed by the transformation a. The dual
+// quaternions d and -d represent the same transformation.
+//
+// Unless otherwise noted, the functions expect unit dual quaternion
+// arguments. Normalize may be used to enforce this.
+
+// FromRotationTranslation returns the dual quaternion representing the
+// rotation r followed by the translation t. The rotation r must be a
+// unit quaternion.
+func FromRotationTranslation(r quat.Number, t r3.Vec) Number {
+	return Number{
+		Real: r,
+		Dual: quat.Scale(0.5, quat.Mul(raise(t), r)),
+	}
+}
+
+// RotationTranslation returns the rotation and translation represented by
+// the unit dual quaternion d, such that d is the rotation r followed by the
+// translation t.
+func RotationTranslation(d Number) (r quat.Number, t r3.Vec) {
+	return d.Real, translation(d)
+}
+
+// translation returns the translation of the unit dual quaternion d.
+func translation(d Number) r3.Vec {
+	return lower(quat.Scale(2, quat.Mul(d.Dual, quat.Conj(d.Real))))
+}
+
+// Normalize returns the unit dual quaternion closest to d. The real part
+// is scaled to unit length and the component of the dual part parallel
+// to the real part is removed so that the real and dual parts are
+// orthogonal. If the real part of d is zero, the returned value has NaN
+// components.
+func Normalize(d Number) Number {
+	n := quat.Abs(d.Real)
+	r := quat.Scale(1/n, d.Real)
+	u := quat.Scale(1/n, d.Dual)
+	return Number{
+		Real: r,
+		Dual: quat.Sub(u, quat.Scale(dot(r, u), r)),
+	}
+}
+
+// TransformPoint returns the point p transformed by the unit dual
+// quaternion d.
+func TransformPoint(d Number, p r3.Vec) r3.Vec {
+	return r3.Add(lower(quat.Mul(quat.Mul(d.Real, raise(p)), quat.Conj(d.Real))), translation(d))
+}
+
+// TransformVector returns the free vector v transformed by the unit dual
+// quaternion d. Free vectors are rotated but not translated.
+func TransformVector(d Number, v r3.Vec) r3.Vec {
+	return lower(quat.Mul(quat.Mul(d.Real, raise(v)), quat.Conj(d.Real)))
+}
+
+// Line is a directed line in three dimensions represented by its Plücker
+// coordinates. Dir is the unit direction of the line and Moment is the
+// cross product of any point on the line with Dir.
+type Line struct {
+	Dir, Moment r3.Vec
+}
+
+// LineThrough returns the line through p with direction dir. LineThrough
+// panics if dir is the zero vector.
+func LineThrough(p, dir r3.Vec) Line {
+	if dir == (r3.Vec{}) {
+		panic("dualquat: zero line direction")
+	}
+	dir = r3.Unit(dir)
+	return Line{Dir: dir, Moment: r3.Cross(p, dir)}
+}
+
+// Point returns the point on l closest to the origin.
+func (l Line) Point() r3.Vec {
+	return r3.Cross(l.Dir, l.Moment)
+}
+
+// TransformLine returns the line l transformed by the unit dual
+// quaternion d.
+//
+// The line is represented as the dual vector Dir+Momentϵ, and is
+// transformed as d l d̅ where d̅ is the quaternion conjugate of d.
+func TransformLine(d Number, l Line) Line {
+	q := Number{Real: raise(l.Dir), Dual: raise(l.Moment)}
+	q = Mul(Mul(d, q), ConjQuat(d))
+	return Line{Dir: lower(q.Real), Moment: lower(q.Dual)}
+}
+
+// Matrix returns the 4×4 homogeneous transformation matrix corresponding
+// to the unit dual quaternion d. The upper left 3×3 block is the rotation
+// matrix and the last column holds the translation.
+func Matrix(d Number) [4][4]float64 {
+	r := quat.Matrix(d.Real)
+	t := translation(d)
+	return [4][4]float64{
+		{r[0][0], r[0][1], r[0][2], t.X},
+		{r[1][0], r[1][1], r[1][2], t.Y},
+		{r[2][0], r[2][1], r[2][2], t.Z},
+		{0, 0, 0, 1},
+	}
+}
+
+// FromMatrix returns the unit dual quaternion corresponding to the 4×4
+// homogeneous transformation matrix m. The upper left 3×3 block of m must
+// be a rotation matrix and the last row must be [0 0 0 1]; the last row is
+// not checked. The real part of the result has a non-negative real
+// component.
+func FromMatrix(m [4][4]float64) Number {
+	r := quat.FromMatrix([3][3]float64{
+		{m[0][0], m[0][1], m[0][2]},
+		{m[1][0], m[1][1], m[1][2]},
+		{m[2][0], m[2][1], m[2][2]},
+	})
+	return FromRotationTranslation(r, r3.Vec{X: m[0][3], Y: m[1][3], Z: m[2][3]})
+}
+
+// Screw returns the screw parameters of the rigid transformation represented
+// by the unit dual quaternion d. By Chasles' theorem, any rigid transformation
+// is a rotation by angle about the line axis followed by a translation of
+// disp along the line. For pure translations, the axis is through the origin
+// in the direction of the translation and angle is zero. For the identity
+// transformation, the axis direction is the zero vector.
+//
+// The angle is in [0, 2π]. Negating d gives the same transformation with an
+// angle of 2π minus the original angle about the reversed axis.
+func Screw(d Number) (axis Line, angle, disp float64) {
+	t := translation(d)
+	s := math.Hypot(d.Real.Imag, math.Hypot(d.Real.Jmag, d.Real.Kmag))
+	angle = 2 * math.Atan2(s, d.Real.Real)
+	if s < 1e-12 {
+		// The rotation is negligible, so the transformation
+		// is a pure translation.
+		disp = r3.Norm(t)
+		if disp == 0 {
+			return Line{}, 0, 0
+		}
+		return Line{Dir: r3.Scale(1/disp, t)}, angle, disp
+	}
+	dir := r3.Scale(1/s, lower(d.Real))
+	disp = r3.Dot(t, dir)
+	// The moment of the axis is ½(t×l + cot(θ/2) (t - (t·l)l)).
+	perp := r3.Sub(t, r3.Scale(disp, dir))
+	moment := r3.Scale(0.5, r3.Add(r3.Cross(t, dir), r3.Scale(d.Real.Real/s, perp)))
+	return Line{Dir: dir, Moment: moment}, angle, disp
+}
+
+// FromScrew returns the unit dual quaternion representing the rotation by
+// angle about the line axis followed by the translation of disp along it.
+func FromScrew(axis Line, angle, disp float64) Number {
+	sin, cos := math.Sincos(angle / 2)
+	l := raise(axis.Dir)
+	m := raise(axis.Moment)
+	return Number{
+		Real: quat.Add(quat.Number{Real: cos}, quat.Scale(sin, l)),
+		Dual: quat.Add(
+			quat.Number{Real: -disp / 2 * sin},
+			quat.Add(quat.Scale(disp/2*cos, l), quat.Scale(sin, m)),
+		),
+	}
+}
+
+// Sclerp returns the screw linear interpolation between the unit dual
+// quaternions a and b at t. Sclerp(a, b, 0) is a and Sclerp(a, b, 1) is
+// the transformation represented by b. The interpolation follows the
+// screw motion from a to b with constant rotational and translational
+// velocity, taking the shortest path.
+//
+// See L. Kavan, S. Collins, C. O'Sullivan and J. Žára, "Dual Quaternions
+// for Rigid Transformation Blending", Technical report TCD-CS-2006-46,
+// Trinity College Dublin, 2006.
+func Sclerp(a, b Number, t float64) Number {
+	d := Mul(ConjQuat(a), b)
+	if d.Real.Real < 0 {
+		d = Scale(-1, d)
+	}
+	axis, angle, disp := Screw(d)
+	return Mul(a, FromScrew(axis, t*angle, t*disp))
+}
+
+// raise returns the pure quaternion with imaginary parts v.
+func raise(v r3.Vec) quat.Number {
+	return quat.Number{Imag: v.X, Jmag: v.Y, Kmag: v.Z}
+}
+
+// lower returns the imaginary parts of q.
+func lower(q quat.Number) r3.Vec {
+	return r3.Vec{X: q.Imag, Y: q.Jmag, Z: q.Kmag}
+}
+
+// dot returns the 4-dimensional dot product of p and q.
+func dot(p, q quat.Number) float64 {
+	return p.Real*q.Real + p.Imag*q.Imag + p.Jmag*q.Jmag + p.Kmag*q.Kmag
+}
@@ -0,0 +1,243 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dualquat
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/num/quat"
+	"gonum.org/v1/gonum/spatial/r3"
+)
+
+const tol = 1e-12
+
+func randVec(rnd *rand.Rand) r3.Vec {
+	return r3.Vec{X: rnd.NormFloat64(), Y: rnd.NormFloat64(), Z: rnd.NormFloat64()}
+}
+
+func randRotation(rnd *rand.Rand) quat.Number {
+	return quat.Normalize(quat.Number{
+		Real: rnd.NormFloat64(), Imag: rnd.NormFloat64(),
+		Jmag: rnd.NormFloat64(), Kmag: rnd.NormFloat64(),
+	})
+}
+
+func randTransform(rnd *rand.Rand) (quat.Number, r3.Vec, Number) {
+	r := randRotation(rnd)
+	t := randVec(rnd)
+	return r, t, FromRotationTranslation(r, t)
+}
+
+func vecEqual(a, b r3.Vec, tol float64) bool {
+	return scalar.EqualWithinAbs(a.X, b.X, tol) &&
+		scalar.EqualWithinAbs(a.Y, b.Y, tol) &&
+		scalar.EqualWithinAbs(a.Z, b.Z, tol)
+}
+
+func quatEqual(a, b quat.Number, tol float64) bool {
+	return scalar.EqualWithinAbs(a.Real, b.Real, tol) &&
+		scalar.EqualWithinAbs(a.Imag, b.Imag, tol) &&
+		scalar.EqualWithinAbs(a.Jmag, b.Jmag, tol) &&
+		scalar.EqualWithinAbs(a.Kmag, b.Kmag, tol)
+}
+
+// sameTransform returns whether a and b represent the same
+// rigid transformation.
+func sameTransform(a, b Number, tol float64) bool {
+	if dot(a.Real, b.Real) < 0 {
+		b = Scale(-1, b)
+	}
+	return quatEqual(a.Real, b.Real, tol) && quatEqual(a.Dual, b.Dual, tol)
+}
+
+// rotate returns v rotated by the unit quaternion r.
+func rotate(r quat.Number, v r3.Vec) r3.Vec {
+	a := quat.Rotate(r, [3]float64{v.X, v.Y, v.Z})
+	return r3.Vec{X: a[0], Y: a[1], Z: a[2]}
+}
+
+func TestTransformPoint(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		r, tr, d := randTransform(rnd)
+		p := randVec(rnd)
+		want := r3.Add(rotate(r, p), tr)
+		got := TransformPoint(d, p)
+		if !vecEqual(got, want, tol) {
+			t.Errorf("unexpected transformed point: got:%v want:%v", got, want)
+		}
+		if got, want := TransformVector(d, p), rotate(r, p); !vecEqual(got, want, tol) {
+			t.Errorf("unexpected transformed vector: got:%v want:%v", got, want)
+		}
+
+		gotR, gotT := RotationTranslation(d)
+		if !quatEqual(gotR, r, tol) || !vecEqual(gotT, tr, tol) {
+			t.Errorf("unexpected rotation and translation: got:(%v, %v) want:(%v, %v)", gotR, gotT, r, tr)
+		}
+
+		// Composition applies the right operand first.
+		_, _, e := randTransform(rnd)
+		got = TransformPoint(Mul(e, d), p)
+		want = TransformPoint(e, TransformPoint(d, p))
+		if !vecEqual(got, want, tol) {
+			t.Errorf("unexpected composed transformation: got:%v want:%v", got, want)
+		}
+	}
+}
+
+func TestTransformLine(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		_, _, d := randTransform(rnd)
+		p := randVec(rnd)
+		dir := randVec(rnd)
+		l := LineThrough(p, dir)
+
+		got := TransformLine(d, l)
+		want := LineThrough(TransformPoint(d, p), TransformVector(d, dir))
+		if !vecEqual(got.Dir, want.Dir, tol) || !vecEqual(got.Moment, want.Moment, tol) {
+			t.Errorf("unexpected transformed line: got:%v want:%v", got, want)
+		}
+
+		// The closest point lies on the line and is
+		// perpendicular to its direction.
+		c := l.Point()
+		if !scalar.EqualWithinAbs(r3.Dot(c, l.Dir), 0, tol) || !vecEqual(r3.Cross(c, l.Dir), l.Moment, tol) {
+			t.Errorf("unexpected closest point: %v", c)
+		}
+	}
+	if !panics(func() { LineThrough(r3.Vec{X: 1}, r3.Vec{}) }) {
+		t.Error("expected panic for zero direction")
+	}
+}
+
+func TestMatrix(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		_, _, d := randTransform(rnd)
+		m := Matrix(d)
+		p := randVec(rnd)
+		want := TransformPoint(d, p)
+		got := r3.Vec{
+			X: m[0][0]*p.X + m[0][1]*p.Y + m[0][2]*p.Z + m[0][3],
+			Y: m[1][0]*p.X + m[1][1]*p.Y + m[1][2]*p.Z + m[1][3],
+			Z: m[2][0]*p.X + m[2][1]*p.Y + m[2][2]*p.Z + m[2][3],
+		}
+		if !vecEqual(got, want, tol) {
+			t.Errorf("unexpected matrix transformation: got:%v want:%v", got, want)
+		}
+		if m[3] != [4]float64{0, 0, 0, 1} {
+			t.Errorf("unexpected last row: %v", m[3])
+		}
+		if back := FromMatrix(m); !sameTransform(back, d, tol) || back.Real.Real < 0 {
+			t.Errorf("unexpected round trip: got:%v want:%v", back, d)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		_, _, d := randTransform(rnd)
+		// Perturb the scale and add a dual part parallel
+		// to the real part.
+		e := Scale(2.5, d)
+		e.Dual = quat.Add(e.Dual, quat.Scale(0.3, e.Real))
+		got := Normalize(e)
+		if !sameTransform(got, d, tol) {
+			t.Errorf("unexpected normalized dual quaternion: got:%v want:%v", got, d)
+		}
+	}
+}
+
+func TestScrew(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		_, _, d := randTransform(rnd)
+		axis, angle, disp := Screw(d)
+		if !scalar.EqualWithinAbs(r3.Norm(axis.Dir), 1, tol) {
+			t.Errorf("axis direction not unit: %v", axis.Dir)
+		}
+		if !scalar.EqualWithinAbs(r3.Dot(axis.Dir, axis.Moment), 0, tol) {
+			t.Errorf("axis moment not perpendicular to direction: %v", axis)
+		}
+		if got := FromScrew(axis, angle, disp); !sameTransform(got, d, 1e-10) {
+			t.Errorf("unexpected screw round trip: got:%v want:%v", got, d)
+		}
+		// Points on the axis are translated along it by disp.
+		p := axis.Point()
+		want := r3.Add(p, r3.Scale(disp, axis.Dir))
+		if got := TransformPoint(d, p); !vecEqual(got, want, 1e-10) {
+			t.Errorf("unexpected transformation of point on axis: got:%v want:%v", got, want)
+		}
+	}
+
+	// A pure translation.
+	d := FromRotationTranslation(quat.Number{Real: 1}, r3.Vec{X: 3, Z: 4})
+	axis, angle, disp := Screw(d)
+	if angle != 0 || disp != 5 || !vecEqual(axis.Dir, r3.Vec{X: 0.6, Z: 0.8}, tol) {
+		t.Errorf("unexpected screw for translation: got:(%v, %v, %v)", axis, angle, disp)
+	}
+	// The identity.
+	axis, angle, disp = Screw(Number{Real: quat.Number{Real: 1}})
+	if axis != (Line{}) || angle != 0 || disp != 0 {
+		t.Errorf("unexpected screw for identity: got:(%v, %v, %v)", axis, angle, disp)
+	}
+}
+
+func TestSclerp(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		_, _, a := randTransform(rnd)
+		_, _, b := randTransform(rnd)
+		if got := Sclerp(a, b, 0); !sameTransform(got, a, 1e-10) {
+			t.Errorf("unexpected start of interpolation: got:%v want:%v", got, a)
+		}
+		if got := Sclerp(a, b, 1); !sameTransform(got, b, 1e-10) {
+			t.Errorf("unexpected end of interpolation: got:%v want:%v", got, b)
+		}
+		if got := Sclerp(a, Scale(-1, b), 1); !sameTransform(got, b, 1e-10) {
+			t.Errorf("unexpected end of interpolation with negated end: got:%v want:%v", got, b)
+		}
+		// Interpolating half way twice is the same as
+		// interpolating to the end.
+		h := Sclerp(a, b, 0.5)
+		if got := Mul(h, Mul(ConjQuat(a), h)); !sameTransform(got, b, 1e-10) {
+			t.Errorf("unexpected half way interpolation: got:%v want:%v", got, b)
+		}
+		if got := quat.Abs(h.Real); !scalar.EqualWithinAbs(got, 1, tol) {
+			t.Errorf("interpolation not unit: |real|=%v", got)
+		}
+	}
+
+	// Interpolation of a screw motion about the z axis
+	// through [1, 0, 0] with a pitch of 1 per radian.
+	axis := LineThrough(r3.Vec{X: 1}, r3.Vec{Z: 1})
+	a := Number{Real: quat.Number{Real: 1}}
+	b := FromScrew(axis, math.Pi/2, math.Pi/2)
+	got := TransformPoint(Sclerp(a, b, 0.5), r3.Vec{})
+	want := r3.Vec{X: 1 - math.Cos(math.Pi/4), Y: -math.Sin(math.Pi / 4), Z: math.Pi / 4}
+	if !vecEqual(got, want, tol) {
+		t.Errorf("unexpected screw interpolation: got:%v want:%v", got, want)
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return false
+}
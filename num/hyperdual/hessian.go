@@ -0,0 +1,120 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hyperdual
+
+import (
+	"runtime"
+	"sync"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// HessianSettings is the settings structure for computing a Hessian.
+type HessianSettings struct {
+	// Concurrent specifies whether the evaluations of the
+	// function may be executed concurrently. If Concurrent
+	// is true, the function must be safe for concurrent use.
+	Concurrent bool
+}
+
+// Hessian computes the Hessian matrix of the multivariate function f at x
+// using hyperdual numbers, storing the result in dst. That is
+//
+//	H_{i,j} = ∂^2 f(x)/∂x_i ∂x_j
+//
+// If dst is nil, a new matrix is allocated, and if dst is empty it is resized
+// to n×n where n is the length of x. The matrix is returned. If settings is
+// nil, the function is evaluated serially.
+//
+// The function f is evaluated n(n+1)/2 times, once for each element of the
+// upper triangle of H, with the ϵ₁ and ϵ₂ parts of the input seeded with the
+// unit vectors for x_i and x_j respectively. The result is exact to within
+// floating point error, without the truncation error of finite difference
+// approximations. The slice passed to f must not be modified or retained.
+//
+// Hessian panics if x has zero length or if a non-empty dst is not n×n.
+func Hessian(dst *mat.SymDense, f func(x []Number) Number, x []float64, settings *HessianSettings) *mat.SymDense {
+	n := len(x)
+	if n == 0 {
+		panic("hyperdual: zero length x")
+	}
+	switch {
+	case dst == nil:
+		dst = mat.NewSymDense(n, nil)
+	case dst.IsEmpty():
+		dst.ReuseAsSym(n)
+	default:
+		if dst.SymmetricDim() != n {
+			panic(mat.ErrShape)
+		}
+	}
+
+	workers := 1
+	if settings != nil && settings.Concurrent {
+		workers = min(runtime.GOMAXPROCS(0), n)
+	}
+	if workers == 1 {
+		hessianRows(dst, f, x, seed(x), 0, 1)
+		return dst
+	}
+
+	// Each worker computes an interleaved set of rows of the
+	// upper triangle so that the work is approximately balanced.
+	// The workers write to disjoint elements of dst.
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			hessianRows(dst, f, x, seed(x), w, workers)
+		}(w)
+	}
+	wg.Wait()
+	return dst
+}
+
+// hessianRows computes the rows start, start+stride, ... of the upper
+// triangle of the Hessian of f at x, storing the results in dst. xh is
+// used as workspace and must hold x with zero non-real parts.
+func hessianRows(dst *mat.SymDense, f func(x []Number) Number, x []float64, xh []Number, start, stride int) {
+	for i := start; i < len(x); i += stride {
+		xh[i].E1mag = 1
+		for j := i; j < len(x); j++ {
+			xh[j].E2mag = 1
+			dst.SetSym(i, j, f(xh).E1E2mag)
+			xh[j].E2mag = 0
+		}
+		xh[i].E1mag = 0
+	}
+}
+
+// MixedPartial returns the second order partial derivative of the
+// multivariate function f at x with respect to x_i and x_j,
+//
+//	∂^2 f(x)/∂x_i ∂x_j
+//
+// computed with a single evaluation of f using hyperdual numbers. The value
+// of f at x and the partial derivatives of f with respect to x_i and x_j are
+// also returned. The slice passed to f must not be modified or retained.
+// MixedPartial panics if i or j is out of range.
+func MixedPartial(f func(x []Number) Number, x []float64, i, j int) (v, di, dj, dij float64) {
+	if i < 0 || len(x) <= i || j < 0 || len(x) <= j {
+		panic("hyperdual: index out of range")
+	}
+	xh := seed(x)
+	xh[i].E1mag = 1
+	xh[j].E2mag = 1
+	r := f(xh)
+	return r.Real, r.E1mag, r.E2mag, r.E1E2mag
+}
+
+// seed returns x as hyperdual numbers with zero non-real parts.
+func seed(x []float64) []Number {
+	dst := make([]Number, len(x))
+	for i, v := range x {
+		dst[i] = Number{Real: v}
+	}
+	return dst
+}
@@ -0,0 +1,137 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hyperdual
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+// rosen is the Rosenbrock function.
+func rosen(x []Number) Number {
+	var s Number
+	for i := 0; i < len(x)-1; i++ {
+		a := Sub(x[i+1], Mul(x[i], x[i]))
+		b := Sub(Number{Real: 1}, x[i])
+		s = Add(s, Add(Scale(100, Mul(a, a)), Mul(b, b)))
+	}
+	return s
+}
+
+// rosenHess returns the analytic Hessian of the Rosenbrock function.
+func rosenHess(x []float64) *mat.SymDense {
+	n := len(x)
+	h := mat.NewSymDense(n, nil)
+	for i := 0; i < n-1; i++ {
+		h.SetSym(i, i, h.At(i, i)+1200*x[i]*x[i]-400*x[i+1]+2)
+		h.SetSym(i+1, i+1, h.At(i+1, i+1)+200)
+		h.SetSym(i, i+1, -400*x[i])
+	}
+	return h
+}
+
+func TestHessian(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 10} {
+		for _, concurrent := range []bool{false, true} {
+			x := make([]float64, n)
+			for i := range x {
+				x[i] = rnd.NormFloat64()
+			}
+			want := rosenHess(x)
+			got := Hessian(nil, rosen, x, &HessianSettings{Concurrent: concurrent})
+			if !mat.EqualApprox(got, want, 1e-12) {
+				t.Errorf("unexpected Hessian for n=%d concurrent=%t:\ngot:\n%v\nwant:\n%v",
+					n, concurrent, mat.Formatted(got), mat.Formatted(want))
+			}
+
+			var empty mat.SymDense
+			Hessian(&empty, rosen, x, nil)
+			if !mat.EqualApprox(&empty, want, 1e-12) {
+				t.Errorf("unexpected Hessian for empty destination with n=%d", n)
+			}
+			dst := mat.NewSymDense(n, nil)
+			if Hessian(dst, rosen, x, nil) != dst {
+				t.Errorf("unexpected destination returned for n=%d", n)
+			}
+		}
+	}
+
+	// A function with dense, non-polynomial mixed partials.
+	f := func(x []Number) Number {
+		return Mul(Exp(Mul(x[0], x[1])), Sin(x[2]))
+	}
+	x := []float64{0.5, -1.5, 2}
+	e := math.Exp(x[0] * x[1])
+	s, c := math.Sincos(x[2])
+	want := mat.NewSymDense(3, []float64{
+		x[1] * x[1] * e * s, (1 + x[0]*x[1]) * e * s, x[1] * e * c,
+		0, x[0] * x[0] * e * s, x[0] * e * c,
+		0, 0, -e * s,
+	})
+	got := Hessian(nil, f, x, &HessianSettings{Concurrent: true})
+	if !mat.EqualApprox(got, want, 1e-14) {
+		t.Errorf("unexpected Hessian:\ngot:\n%v\nwant:\n%v", mat.Formatted(got), mat.Formatted(want))
+	}
+
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{name: "zero length", fn: func() { Hessian(nil, rosen, nil, nil) }},
+		{name: "bad dst", fn: func() { Hessian(mat.NewSymDense(2, nil), rosen, x, nil) }},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
+
+func TestMixedPartial(t *testing.T) {
+	t.Parallel()
+	f := func(x []Number) Number {
+		return Mul(Exp(Mul(x[0], x[1])), Sin(x[2]))
+	}
+	x := []float64{0.5, -1.5, 2}
+	e := math.Exp(x[0] * x[1])
+	s, c := math.Sincos(x[2])
+	for _, test := range []struct {
+		i, j        int
+		di, dj, dij float64
+	}{
+		{i: 0, j: 1, di: x[1] * e * s, dj: x[0] * e * s, dij: (1 + x[0]*x[1]) * e * s},
+		{i: 0, j: 2, di: x[1] * e * s, dj: e * c, dij: x[1] * e * c},
+		{i: 2, j: 2, di: e * c, dj: e * c, dij: -e * s},
+	} {
+		v, di, dj, dij := MixedPartial(f, x, test.i, test.j)
+		const tol = 1e-14
+		if !scalar.EqualWithinAbsOrRel(v, e*s, tol, tol) {
+			t.Errorf("unexpected value for i=%d j=%d: got:%v want:%v", test.i, test.j, v, e*s)
+		}
+		if !scalar.EqualWithinAbsOrRel(di, test.di, tol, tol) ||
+			!scalar.EqualWithinAbsOrRel(dj, test.dj, tol, tol) ||
+			!scalar.EqualWithinAbsOrRel(dij, test.dij, tol, tol) {
+			t.Errorf("unexpected derivatives for i=%d j=%d: got:(%v, %v, %v) want:(%v, %v, %v)",
+				test.i, test.j, di, dj, dij, test.di, test.dj, test.dij)
+		}
+	}
+	if !panics(func() { MixedPartial(f, x, 0, 3) }) {
+		t.Error("expected panic for index out of range")
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return false
+}
@@ -0,0 +1,160 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import "math"
+
+// Add returns the sum of x and y.
+func Add(x, y Number) Number {
+	if x.IsEmpty() || y.IsEmpty() {
+		return Empty()
+	}
+	return Number{Lo: addDown(x.Lo, y.Lo), Hi: addUp(x.Hi, y.Hi)}
+}
+
+// Sub returns the difference of x and y, x-y.
+func Sub(x, y Number) Number {
+	if x.IsEmpty() || y.IsEmpty() {
+		return Empty()
+	}
+	return Number{Lo: subDown(x.Lo, y.Hi), Hi: subUp(x.Hi, y.Lo)}
+}
+
+// Neg returns the negation of x.
+func Neg(x Number) Number {
+	return Number{Lo: -x.Hi, Hi: -x.Lo}
+}
+
+// Mul returns the product of x and y.
+func Mul(x, y Number) Number {
+	if x.IsEmpty() || y.IsEmpty() {
+		return Empty()
+	}
+	lo := math.Min(
+		math.Min(mulDown(x.Lo, y.Lo), mulDown(x.Lo, y.Hi)),
+		math.Min(mulDown(x.Hi, y.Lo), mulDown(x.Hi, y.Hi)),
+	)
+	hi := math.Max(
+		math.Max(mulUp(x.Lo, y.Lo), mulUp(x.Lo, y.Hi)),
+		math.Max(mulUp(x.Hi, y.Lo), mulUp(x.Hi, y.Hi)),
+	)
+	return Number{Lo: lo, Hi: hi}
+}
+
+// Scale returns x scaled by f. Scale panics if f is NaN or infinite.
+func Scale(f float64, x Number) Number {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		panic("interval: invalid scale factor")
+	}
+	return Mul(Point(f), x)
+}
+
+// Inv returns the reciprocal of x. If x contains zero in its interior,
+// the result is Entire, and if x is [0, 0] the result is empty. If zero
+// is a bound of x the result is unbounded on the side of that bound.
+func Inv(x Number) Number {
+	switch {
+	case x.IsEmpty(), x.Lo == 0 && x.Hi == 0:
+		return Empty()
+	case x.Lo < 0 && x.Hi > 0:
+		return Entire()
+	case x.Lo == 0:
+		return Number{Lo: divDown(1, x.Hi), Hi: math.Inf(1)}
+	case x.Hi == 0:
+		return Number{Lo: math.Inf(-1), Hi: divUp(1, x.Lo)}
+	}
+	return Number{Lo: divDown(1, x.Hi), Hi: divUp(1, x.Lo)}
+}
+
+// Div returns the quotient of x and y, x/y. Special cases are as for
+// Inv applied to y, with the exception that the quotient of an interval
+// containing zero by an interval containing zero is Entire.
+func Div(x, y Number) Number {
+	switch {
+	case x.IsEmpty() || y.IsEmpty(), y.Lo == 0 && y.Hi == 0:
+		return Empty()
+	case y.Lo < 0 && y.Hi > 0, x.Contains(0) && y.Contains(0):
+		return Entire()
+	case y.Lo == 0 || y.Hi == 0:
+		// The divisor is unbounded after inversion, so use the
+		// reciprocal with the product convention 0·∞ = 0. The
+		// dividend does not contain zero here.
+		return Mul(x, Inv(y))
+	}
+	lo := math.Min(
+		math.Min(divDown(x.Lo, y.Lo), divDown(x.Lo, y.Hi)),
+		math.Min(divDown(x.Hi, y.Lo), divDown(x.Hi, y.Hi)),
+	)
+	hi := math.Max(
+		math.Max(divUp(x.Lo, y.Lo), divUp(x.Lo, y.Hi)),
+		math.Max(divUp(x.Hi, y.Lo), divUp(x.Hi, y.Hi)),
+	)
+	return Number{Lo: lo, Hi: hi}
+}
+
+// Abs returns the interval of absolute values of the members of x.
+func Abs(x Number) Number {
+	switch {
+	case x.IsEmpty():
+		return Empty()
+	case x.Lo >= 0:
+		return x
+	case x.Hi <= 0:
+		return Neg(x)
+	}
+	return Number{Lo: 0, Hi: math.Max(-x.Lo, x.Hi)}
+}
+
+// Sqr returns the interval of squares of the members of x. The result
+// is tighter than Mul(x, x) when x contains zero.
+func Sqr(x Number) Number {
+	a := Abs(x)
+	if a.IsEmpty() {
+		return a
+	}
+	return Number{Lo: mulDown(a.Lo, a.Lo), Hi: mulUp(a.Hi, a.Hi)}
+}
+
+// PowInt returns the interval of the members of x raised to the
+// integer power n. Negative powers of an interval containing zero
+// are computed as the reciprocal of the positive power.
+func PowInt(x Number, n int) Number {
+	switch {
+	case x.IsEmpty():
+		return Empty()
+	case n == 0:
+		return Point(1)
+	case n < 0:
+		return Inv(PowInt(x, -n))
+	case n%2 == 0:
+		return powPos(Abs(x), n)
+	case x.Lo >= 0:
+		return powPos(x, n)
+	case x.Hi <= 0:
+		return Neg(powPos(Neg(x), n))
+	}
+	// Odd powers are monotonic.
+	return Number{Lo: -powPos(Point(-x.Lo), n).Hi, Hi: powPos(Point(x.Hi), n).Hi}
+}
+
+// powPos returns x**n for n > 0 and a non-negative interval x
+// by repeated squaring with directed rounding.
+func powPos(x Number, n int) Number {
+	lo, hi := 1.0, 1.0
+	blo, bhi := x.Lo, x.Hi
+	for {
+		if n&1 == 1 {
+			lo = mulDown(lo, blo)
+			hi = mulUp(hi, bhi)
+		}
+		n >>= 1
+		if n == 0 {
+			break
+		}
+		blo = mulDown(blo, blo)
+		bhi = mulUp(bhi, bhi)
+	}
+	return Number{Lo: lo, Hi: hi}
+}
@@ -0,0 +1,201 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+// randFloat returns a random float64 with a random exponent in [-e, e].
+func randFloat(rnd *rand.Rand, e int) float64 {
+	return math.Ldexp(rnd.NormFloat64(), rnd.Intn(2*e+1)-e)
+}
+
+// exact returns the result of op applied to a and b with 1000 bits of
+// precision, enough to compare exactly against the float64 bounds.
+func exact(op func(z, x, y *big.Float) *big.Float, a, b float64) *big.Float {
+	z := new(big.Float).SetPrec(1000)
+	return op(z, big.NewFloat(a), big.NewFloat(b))
+}
+
+// encloses returns whether the bounds of x enclose v and are the
+// tightest float64 bounds of v.
+func encloses(x Number, v *big.Float) (ok, tight bool) {
+	lo := big.NewFloat(x.Lo)
+	hi := big.NewFloat(x.Hi)
+	ok = lo.Cmp(v) <= 0 && v.Cmp(hi) <= 0
+	f, acc := v.Float64()
+	switch acc {
+	case big.Exact:
+		tight = x.Lo == f && x.Hi == f
+	case big.Below:
+		tight = x.Lo == f && x.Hi == next(f)
+	case big.Above:
+		tight = x.Lo == prev(f) && x.Hi == f
+	}
+	return ok, tight
+}
+
+func TestArithmeticRounding(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		name  string
+		fn    func(x, y Number) Number
+		exact func(z, x, y *big.Float) *big.Float
+		exp   int
+	}{
+		{name: "Add", fn: Add, exact: (*big.Float).Add, exp: 60},
+		{name: "Sub", fn: Sub, exact: (*big.Float).Sub, exp: 60},
+		{name: "Mul", fn: Mul, exact: (*big.Float).Mul, exp: 400},
+		{name: "Div", fn: Div, exact: (*big.Float).Quo, exp: 400},
+	} {
+		for i := 0; i < 10000; i++ {
+			a := randFloat(rnd, test.exp)
+			b := randFloat(rnd, test.exp)
+			if i%10 == 0 {
+				// Exercise exactly representable results.
+				a = math.Round(a)
+				b = math.Round(b)
+			}
+			if b == 0 {
+				continue
+			}
+			got := test.fn(Point(a), Point(b))
+			ok, tight := encloses(got, exact(test.exact, a, b))
+			if !ok {
+				t.Errorf("%s(%v, %v) does not enclose exact result: got:%v", test.name, a, b, got)
+			}
+			if !tight {
+				t.Errorf("%s(%v, %v) is not tight: got:%v", test.name, a, b, got)
+			}
+		}
+	}
+}
+
+func TestSqrtRounding(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		a := math.Abs(randFloat(rnd, 400))
+		if i%10 == 0 {
+			a = math.Round(a)
+			a *= a
+		}
+		got := Sqrt(Point(a))
+		ok, tight := encloses(got, new(big.Float).SetPrec(1000).Sqrt(big.NewFloat(a)))
+		if !ok {
+			t.Errorf("Sqrt(%v) does not enclose exact result: got:%v", a, got)
+		}
+		if !tight {
+			t.Errorf("Sqrt(%v) is not tight: got:%v", a, got)
+		}
+	}
+}
+
+func TestTinyAndHuge(t *testing.T) {
+	t.Parallel()
+	tiny := math.SmallestNonzeroFloat64
+	for _, test := range []struct {
+		name string
+		got  Number
+		want Number
+	}{
+		{name: "underflow positive", got: Mul(Point(tiny), Point(0.5)), want: Number{Lo: 0, Hi: tiny}},
+		{name: "underflow negative", got: Mul(Point(-tiny), Point(0.5)), want: Number{Lo: -tiny, Hi: 0}},
+		{name: "overflow", got: Mul(Point(math.MaxFloat64), Point(2)), want: Number{Lo: math.MaxFloat64, Hi: math.Inf(1)}},
+		{name: "overflow add", got: Add(Point(-math.MaxFloat64), Point(-math.MaxFloat64)), want: Number{Lo: math.Inf(-1), Hi: -math.MaxFloat64}},
+		{name: "zero times entire", got: Mul(Point(0), Entire()), want: Point(0)},
+	} {
+		if !same(test.got, test.want) {
+			t.Errorf("unexpected result for %s: got:%v want:%v", test.name, test.got, test.want)
+		}
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	t.Parallel()
+	inf := math.Inf(1)
+	for _, test := range []struct {
+		name string
+		got  Number
+		want Number
+	}{
+		{name: "add", got: Add(New(1, 2), New(-3, 4)), want: New(-2, 6)},
+		{name: "sub", got: Sub(New(1, 2), New(-3, 4)), want: New(-3, 5)},
+		{name: "mul", got: Mul(New(-1, 2), New(-3, 4)), want: New(-6, 8)},
+		{name: "mul negative", got: Mul(New(-2, -1), New(3, 4)), want: New(-8, -3)},
+		{name: "mul unbounded", got: Mul(New(1, inf), New(-2, -1)), want: New(-inf, -1)},
+		{name: "div", got: Div(New(1, 2), New(4, 8)), want: New(0.125, 0.5)},
+		{name: "div zero lower bound", got: Div(New(1, 2), New(0, 4)), want: New(0.25, inf)},
+		{name: "div zero upper bound", got: Div(New(1, 2), New(-4, 0)), want: New(-inf, -0.25)},
+		{name: "div zero interior", got: Div(New(1, 2), New(-1, 1)), want: Entire()},
+		{name: "div zero by zero", got: Div(New(-1, 2), New(0, 1)), want: Entire()},
+		{name: "div by zero", got: Div(New(1, 2), Point(0)), want: Empty()},
+		{name: "inv", got: Inv(New(2, 4)), want: New(0.25, 0.5)},
+		{name: "inv zero", got: Inv(Point(0)), want: Empty()},
+		{name: "neg", got: Neg(New(1, 2)), want: New(-2, -1)},
+		{name: "scale", got: Scale(-2, New(1, 2)), want: New(-4, -2)},
+		{name: "abs", got: Abs(New(-3, 2)), want: New(0, 3)},
+		{name: "abs negative", got: Abs(New(-3, -2)), want: New(2, 3)},
+		{name: "sqr", got: Sqr(New(-3, 2)), want: New(0, 9)},
+		{name: "powint even", got: PowInt(New(-3, 2), 2), want: New(0, 9)},
+		{name: "powint odd", got: PowInt(New(-3, 2), 3), want: New(-27, 8)},
+		{name: "powint odd negative", got: PowInt(New(-3, -2), 3), want: New(-27, -8)},
+		{name: "powint zero", got: PowInt(New(-3, 2), 0), want: Point(1)},
+		{name: "powint negative", got: PowInt(New(2, 4), -2), want: New(0.0625, 0.25)},
+		{name: "empty", got: Add(Empty(), New(1, 2)), want: Empty()},
+		{name: "empty mul", got: Mul(New(1, 2), Empty()), want: Empty()},
+	} {
+		if !same(test.got, test.want) {
+			t.Errorf("unexpected result for %s: got:%v want:%v", test.name, test.got, test.want)
+		}
+	}
+
+	// 0.1 and 0.2 are not exactly representable, so their sum
+	// must straddle the rounded result by one unit in the last place.
+	got := Add(Point(0.1), Point(0.2))
+	if got.Hi != next(got.Lo) || !got.Contains(0.30000000000000004) {
+		t.Errorf("unexpected result for 0.1+0.2: got:%v", got)
+	}
+	if !panics(func() { Scale(math.NaN(), New(1, 2)) }) {
+		t.Error("expected panic for NaN scale factor")
+	}
+}
+
+func TestPowIntEnclosure(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		a := rnd.NormFloat64() * 4
+		n := rnd.Intn(21) - 10
+		if a == 0 && n < 0 {
+			continue
+		}
+		got := PowInt(Point(a), n)
+		z := new(big.Float).SetPrec(2000).SetInt64(1)
+		b := big.NewFloat(a)
+		for k := 0; k < abs(n); k++ {
+			z.Mul(z, b)
+		}
+		if n < 0 {
+			z.Quo(new(big.Float).SetPrec(2000).SetInt64(1), z)
+		}
+		if ok, _ := encloses(got, z); !ok {
+			t.Errorf("PowInt(%v, %d) does not enclose exact result: got:%v", a, n, got)
+		}
+	}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,21 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package interval provides the closed interval numeric type and functions
+// for interval arithmetic with outward rounding. The result of each
+// operation on intervals is an interval that is guaranteed to contain the
+// exact result of the operation applied to every combination of real
+// numbers in the operands, so bounds computed with the package are rigorous
+// despite floating point rounding.
+//
+// The basic arithmetic operations and Sqrt are correctly rounded outward
+// using error-free transformations, so the bounds of their results are the
+// tightest floating point bounds. The elementary functions widen their
+// results by two units in the last place to account for the error of the
+// functions in the math package, which are accurate to within one unit in
+// the last place.
+//
+// See R. E. Moore, R. B. Kearfott and M. J. Cloud, "Introduction to Interval
+// Analysis", SIAM, 2009, for an introduction to interval arithmetic.
+package interval // import "gonum.org/v1/gonum/num/interval"
@@ -0,0 +1,120 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import "math"
+
+// Sqrt returns the interval of square roots of the non-negative members
+// of x. If x has no non-negative members, the result is empty.
+func Sqrt(x Number) Number {
+	if x.IsEmpty() || x.Hi < 0 {
+		return Empty()
+	}
+	return Number{Lo: sqrtRound(math.Max(x.Lo, 0), true), Hi: sqrtRound(x.Hi, false)}
+}
+
+// Exp returns the interval of e**v for the members v of x.
+func Exp(x Number) Number {
+	if x.IsEmpty() {
+		return Empty()
+	}
+	r := widen(math.Exp(x.Lo), math.Exp(x.Hi))
+	r.Lo = math.Max(r.Lo, 0)
+	return r
+}
+
+// Log returns the interval of natural logarithms of the positive members
+// of x. If x has no positive members, the result is empty, and if zero is
+// a member of x the result is unbounded below.
+func Log(x Number) Number {
+	if x.IsEmpty() || x.Hi <= 0 {
+		return Empty()
+	}
+	r := widen(math.Log(x.Lo), math.Log(x.Hi))
+	if x.Lo <= 0 {
+		r.Lo = math.Inf(-1)
+	}
+	return r
+}
+
+// Atan returns the interval of inverse tangents of the members of x.
+func Atan(x Number) Number {
+	if x.IsEmpty() {
+		return Empty()
+	}
+	r := widen(math.Atan(x.Lo), math.Atan(x.Hi))
+	return Number{Lo: math.Max(r.Lo, -halfPiUp), Hi: math.Min(r.Hi, halfPiUp)}
+}
+
+// Sin returns the interval of sines of the members of x.
+func Sin(x Number) Number {
+	return trig(x, math.Sin, math.Pi/2)
+}
+
+// Cos returns the interval of cosines of the members of x.
+func Cos(x Number) Number {
+	return trig(x, math.Cos, 0)
+}
+
+// Tan returns the interval of tangents of the members of x. If x may
+// contain a pole of the tangent function, the result is Entire.
+func Tan(x Number) Number {
+	switch {
+	case x.IsEmpty():
+		return Empty()
+	case !(x.Width() < math.Pi) || math.Max(-x.Lo, x.Hi) > maxReduce:
+		return Entire()
+	case hasCritical(x, math.Pi/2, math.Pi):
+		return Entire()
+	}
+	return widen(math.Tan(x.Lo), math.Tan(x.Hi))
+}
+
+const (
+	// halfPiUp is π/2 rounded up.
+	halfPiUp = 0x1.921fb54442d19p0
+
+	// maxReduce is the largest magnitude argument for which the
+	// location of the extrema of the trigonometric functions is
+	// determined. Beyond this, the spacing of floating point
+	// values is too coarse for the location to be meaningful.
+	maxReduce = 1 << 50
+
+	// critTol is the relative tolerance used when deciding whether
+	// a critical point of a trigonometric function lies within an
+	// interval. Critical points that are within the tolerance are
+	// treated as members of the interval.
+	critTol = 1e-9
+)
+
+// trig returns the interval of fn applied to the members of x, where fn is
+// the sine or cosine function with a maximum at max and period 2π.
+func trig(x Number, fn func(float64) float64, max float64) Number {
+	switch {
+	case x.IsEmpty():
+		return Empty()
+	case !(x.Width() < 2*math.Pi) || math.Max(-x.Lo, x.Hi) > maxReduce:
+		return Number{Lo: -1, Hi: 1}
+	}
+	a, b := fn(x.Lo), fn(x.Hi)
+	r := widen(math.Min(a, b), math.Max(a, b))
+	if hasCritical(x, max, 2*math.Pi) {
+		r.Hi = 1
+	}
+	if hasCritical(x, max+math.Pi, 2*math.Pi) {
+		r.Lo = -1
+	}
+	return Number{Lo: math.Max(r.Lo, -1), Hi: math.Min(r.Hi, 1)}
+}
+
+// hasCritical returns whether a point offset+k·period for some integer k
+// may lie within x. The test is conservative, so points close to the
+// bounds of x are reported as lying within it.
+func hasCritical(x Number, offset, period float64) bool {
+	lo := (x.Lo - offset) / period
+	hi := (x.Hi - offset) / period
+	tol := critTol * math.Max(1, math.Max(math.Abs(lo), math.Abs(hi)))
+	return math.Ceil(lo-tol) <= math.Floor(hi+tol)
+}
@@ -0,0 +1,103 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestElementaryEnclosure(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		name  string
+		fn    func(Number) Number
+		point func(float64) float64
+		scale float64
+	}{
+		{name: "Sqrt", fn: Sqrt, point: math.Sqrt, scale: 10},
+		{name: "Exp", fn: Exp, point: math.Exp, scale: 10},
+		{name: "Log", fn: Log, point: math.Log, scale: 10},
+		{name: "Atan", fn: Atan, point: math.Atan, scale: 10},
+		{name: "Sin", fn: Sin, point: math.Sin, scale: 10},
+		{name: "Cos", fn: Cos, point: math.Cos, scale: 10},
+		{name: "Tan", fn: Tan, point: math.Tan, scale: 2},
+	} {
+		for i := 0; i < 1000; i++ {
+			a := rnd.NormFloat64() * test.scale
+			b := a + rnd.ExpFloat64()*test.scale/4
+			x := New(a, b)
+			got := test.fn(x)
+			for j := 0; j <= 20; j++ {
+				v := math.Min(a+(b-a)*float64(j)/20, b)
+				want := test.point(v)
+				if math.IsNaN(want) {
+					continue
+				}
+				if !got.Contains(want) && !math.IsInf(want, 0) {
+					t.Errorf("%s(%v) does not contain %s(%v)=%v: got:%v", test.name, x, test.name, v, want, got)
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestElementary(t *testing.T) {
+	t.Parallel()
+	inf := math.Inf(1)
+	for _, test := range []struct {
+		name string
+		got  Number
+		want Number
+	}{
+		{name: "sqrt", got: Sqrt(New(4, 9)), want: New(2, 3)},
+		{name: "sqrt partly negative", got: Sqrt(New(-4, 9)), want: New(0, 3)},
+		{name: "sqrt negative", got: Sqrt(New(-4, -1)), want: Empty()},
+		{name: "log negative", got: Log(New(-4, 0)), want: Empty()},
+		{name: "sin wide", got: Sin(New(0, 7)), want: New(-1, 1)},
+		{name: "sin unbounded", got: Sin(New(0, inf)), want: New(-1, 1)},
+		{name: "cos huge", got: Cos(Point(1e300)), want: New(-1, 1)},
+		{name: "tan pole", got: Tan(New(1, 2)), want: Entire()},
+		{name: "empty", got: Exp(Empty()), want: Empty()},
+	} {
+		if !same(test.got, test.want) {
+			t.Errorf("unexpected result for %s: got:%v want:%v", test.name, test.got, test.want)
+		}
+	}
+
+	for _, test := range []struct {
+		name   string
+		got    Number
+		lo, hi bool // Whether the bound is expected to be ±1.
+	}{
+		{name: "sin max", got: Sin(New(1, 2)), hi: true},
+		{name: "sin min", got: Sin(New(4, 5)), lo: true},
+		{name: "sin monotonic", got: Sin(New(-1, 1))},
+		{name: "cos max", got: Cos(New(-1, 1)), hi: true},
+		{name: "cos min", got: Cos(New(3, 4)), lo: true},
+		{name: "cos monotonic", got: Cos(New(1, 2))},
+	} {
+		if (test.got.Lo == -1) != test.lo || (test.got.Hi == 1) != test.hi {
+			t.Errorf("unexpected extrema for %s: got:%v", test.name, test.got)
+		}
+	}
+
+	got := Exp(New(math.Inf(-1), 0))
+	if got.Lo != 0 || !got.Contains(1) {
+		t.Errorf("unexpected result for exp of unbounded interval: got:%v", got)
+	}
+	got = Log(New(0, 1))
+	if !math.IsInf(got.Lo, -1) || !got.Contains(0) {
+		t.Errorf("unexpected result for log of interval including zero: got:%v", got)
+	}
+	got = Atan(Entire())
+	if got.Lo < -halfPiUp || got.Hi > halfPiUp || !got.Contains(math.Pi/2) {
+		t.Errorf("unexpected result for atan of entire interval: got:%v", got)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Number is a closed interval of the extended real line, [Lo, Hi]. An
+// interval with NaN bounds is empty; Empty returns the canonical empty
+// interval. Infinite bounds represent unbounded intervals, but ±Inf are
+// not themselves members of an interval.
+type Number struct {
+	Lo, Hi float64
+}
+
+// New returns the interval [lo, hi]. New panics if lo is greater than hi
+// or either bound is NaN.
+func New(lo, hi float64) Number {
+	if !(lo <= hi) {
+		panic("interval: invalid bounds")
+	}
+	return Number{Lo: lo, Hi: hi}
+}
+
+// Point returns the degenerate interval [x, x].
+func Point(x float64) Number {
+	return Number{Lo: x, Hi: x}
+}
+
+// Empty returns the empty interval.
+func Empty() Number {
+	return Number{Lo: math.NaN(), Hi: math.NaN()}
+}
+
+// Entire returns the interval containing all real numbers.
+func Entire() Number {
+	return Number{Lo: math.Inf(-1), Hi: math.Inf(1)}
+}
+
+// IsEmpty returns whether x is the empty interval.
+func (x Number) IsEmpty() bool {
+	return !(x.Lo <= x.Hi)
+}
+
+// Contains returns whether v is a member of x.
+func (x Number) Contains(v float64) bool {
+	return x.Lo <= v && v <= x.Hi && !math.IsInf(v, 0)
+}
+
+// Width returns the width of x, Hi-Lo, rounded up. The width of the
+// empty interval is NaN.
+func (x Number) Width() float64 {
+	if x.IsEmpty() {
+		return math.NaN()
+	}
+	return subUp(x.Hi, x.Lo)
+}
+
+// Mid returns the midpoint of x. The midpoint is a member of x for all
+// bounded non-empty intervals. The midpoint of an interval unbounded on
+// one side is the finite bound, or the largest finite value of the
+// appropriate sign if both bounds are infinite on the same side. The
+// midpoint of Entire is zero and the midpoint of the empty interval is NaN.
+func (x Number) Mid() float64 {
+	switch {
+	case x.IsEmpty():
+		return math.NaN()
+	case math.IsInf(x.Lo, -1) && math.IsInf(x.Hi, 1):
+		return 0
+	case math.IsInf(x.Lo, -1):
+		return math.Max(x.Hi, -math.MaxFloat64)
+	case math.IsInf(x.Hi, 1):
+		return math.Min(x.Lo, math.MaxFloat64)
+	}
+	m := x.Lo/2 + x.Hi/2
+	return math.Max(x.Lo, math.Min(x.Hi, m))
+}
+
+// Subset returns whether x is a subset of y. The empty interval is
+// a subset of every interval.
+func (x Number) Subset(y Number) bool {
+	return x.IsEmpty() || (y.Lo <= x.Lo && x.Hi <= y.Hi)
+}
+
+// Hull returns the smallest interval containing both x and y.
+func Hull(x, y Number) Number {
+	switch {
+	case x.IsEmpty():
+		return y
+	case y.IsEmpty():
+		return x
+	}
+	return Number{Lo: math.Min(x.Lo, y.Lo), Hi: math.Max(x.Hi, y.Hi)}
+}
+
+// Intersect returns the intersection of x and y.
+func Intersect(x, y Number) Number {
+	lo := math.Max(x.Lo, y.Lo)
+	hi := math.Min(x.Hi, y.Hi)
+	if !(lo <= hi) {
+		return Empty()
+	}
+	return Number{Lo: lo, Hi: hi}
+}
+
+// Format implements fmt.Formatter. Intervals are formatted as [Lo, Hi]
+// with the floating point verbs applied to each bound, and the empty
+// interval is formatted as [empty].
+func (x Number) Format(fs fmt.State, c rune) {
+	switch c {
+	case 'v':
+		if fs.Flag('#') {
+			fmt.Fprintf(fs, "%T{Lo:%#v, Hi:%#v}", x, x.Lo, x.Hi)
+			return
+		}
+		if fs.Flag('+') {
+			fmt.Fprintf(fs, "{Lo:%+v, Hi:%+v}", x.Lo, x.Hi)
+			return
+		}
+		c = 'g'
+		fallthrough
+	case 'e', 'E', 'f', 'F', 'g', 'G':
+		if x.IsEmpty() {
+			fmt.Fprint(fs, "[empty]")
+			return
+		}
+		f := fmtString(fs, c)
+		fmt.Fprintf(fs, "["+f+", "+f+"]", x.Lo, x.Hi)
+	default:
+		fmt.Fprintf(fs, "%%!%c(%T=%[2]v)", c, x)
+	}
+}
+
+// fmtString returns the format string for a bound, including
+// the flags, width and precision of fs.
+func fmtString(fs fmt.State, c rune) string {
+	var b strings.Builder
+	b.WriteByte('%')
+	for _, f := range "0+- " {
+		if fs.Flag(int(f)) {
+			b.WriteByte(byte(f))
+		}
+	}
+	if w, ok := fs.Width(); ok {
+		fmt.Fprint(&b, w)
+	}
+	if p, ok := fs.Precision(); ok {
+		fmt.Fprintf(&b, ".%d", p)
+	}
+	b.WriteRune(c)
+	return b.String()
+}
@@ -0,0 +1,32 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval_test
+
+import (
+	"fmt"
+
+	"gonum.org/v1/gonum/num/interval"
+)
+
+func Example() {
+	// Sum 0.1 ten times. The floating point sum is not
+	// equal to 1, but the interval sum is guaranteed to
+	// contain the exact sum of the floating point value
+	// of 0.1 ten times.
+	var f float64
+	var x interval.Number
+	for i := 0; i < 10; i++ {
+		f += 0.1
+		x = interval.Add(x, interval.Point(0.1))
+	}
+	fmt.Printf("float64: %.17g\n", f)
+	fmt.Printf("interval: %.17g\n", x)
+	fmt.Println("contains 1:", x.Contains(1))
+
+	// Output:
+	// float64: 0.99999999999999989
+	// interval: [0.99999999999999978, 1.0000000000000007]
+	// contains 1: true
+}
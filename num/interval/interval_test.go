@@ -0,0 +1,129 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return false
+}
+
+func same(a, b Number) bool {
+	if a.IsEmpty() || b.IsEmpty() {
+		return a.IsEmpty() && b.IsEmpty()
+	}
+	return a == b
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		lo, hi float64
+		panic  bool
+	}{
+		{lo: 0, hi: 1},
+		{lo: 1, hi: 1},
+		{lo: math.Inf(-1), hi: math.Inf(1)},
+		{lo: 1, hi: 0, panic: true},
+		{lo: math.NaN(), hi: 0, panic: true},
+		{lo: 0, hi: math.NaN(), panic: true},
+	} {
+		got := panics(func() { New(test.lo, test.hi) })
+		if got != test.panic {
+			t.Errorf("unexpected panic status for New(%v, %v): got:%t want:%t", test.lo, test.hi, got, test.panic)
+		}
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	t.Parallel()
+	a := New(0, 2)
+	b := New(1, 3)
+	c := New(4, 5)
+
+	if got, want := Hull(a, b), New(0, 3); !same(got, want) {
+		t.Errorf("unexpected hull: got:%v want:%v", got, want)
+	}
+	if got, want := Hull(a, Empty()), a; !same(got, want) {
+		t.Errorf("unexpected hull with empty: got:%v want:%v", got, want)
+	}
+	if got, want := Intersect(a, b), New(1, 2); !same(got, want) {
+		t.Errorf("unexpected intersection: got:%v want:%v", got, want)
+	}
+	if got := Intersect(a, c); !got.IsEmpty() {
+		t.Errorf("unexpected intersection of disjoint intervals: got:%v want:[empty]", got)
+	}
+	if got := Intersect(a, Empty()); !got.IsEmpty() {
+		t.Errorf("unexpected intersection with empty: got:%v want:[empty]", got)
+	}
+	if !New(1, 2).Subset(a) || b.Subset(a) || !Empty().Subset(a) {
+		t.Error("unexpected subset result")
+	}
+	if !a.Contains(0) || !a.Contains(2) || a.Contains(3) || Empty().Contains(0) {
+		t.Error("unexpected contains result")
+	}
+	if Entire().Contains(math.Inf(1)) {
+		t.Error("unexpected infinite member of entire interval")
+	}
+}
+
+func TestWidthMid(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		x     Number
+		width float64
+		mid   float64
+	}{
+		{x: New(1, 3), width: 2, mid: 2},
+		{x: Point(5), width: 0, mid: 5},
+		{x: New(-math.MaxFloat64, math.MaxFloat64), width: math.Inf(1), mid: 0},
+		{x: Entire(), width: math.Inf(1), mid: 0},
+		{x: New(math.Inf(-1), 2), width: math.Inf(1), mid: 2},
+		{x: New(-2, math.Inf(1)), width: math.Inf(1), mid: -2},
+		{x: New(math.Inf(1), math.Inf(1)), width: math.NaN(), mid: math.MaxFloat64},
+		{x: Empty(), width: math.NaN(), mid: math.NaN()},
+	} {
+		w := test.x.Width()
+		if w != test.width && !(math.IsNaN(w) && math.IsNaN(test.width)) {
+			t.Errorf("unexpected width of %v: got:%v want:%v", test.x, w, test.width)
+		}
+		m := test.x.Mid()
+		if m != test.mid && !(math.IsNaN(m) && math.IsNaN(test.mid)) {
+			t.Errorf("unexpected midpoint of %v: got:%v want:%v", test.x, m, test.mid)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+	x := New(-1.5, 2.25)
+	for _, test := range []struct {
+		format string
+		x      Number
+		want   string
+	}{
+		{format: "%v", x: x, want: "[-1.5, 2.25]"},
+		{format: "%.2f", x: x, want: "[-1.50, 2.25]"},
+		{format: "%+.1e", x: x, want: "[-1.5e+00, +2.2e+00]"},
+		{format: "%#v", x: x, want: "interval.Number{Lo:-1.5, Hi:2.25}"},
+		{format: "%+v", x: x, want: "{Lo:-1.5, Hi:2.25}"},
+		{format: "%v", x: Empty(), want: "[empty]"},
+		{format: "%v", x: Entire(), want: "[-Inf, +Inf]"},
+		{format: "%d", x: x, want: "%!d(interval.Number=[-1.5, 2.25])"},
+	} {
+		got := fmt.Sprintf(test.format, test.x)
+		if got != test.want {
+			t.Errorf("unexpected result for %q: got:%q want:%q", test.format, got, test.want)
+		}
+	}
+}
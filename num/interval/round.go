@@ -0,0 +1,174 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import "math"
+
+// The functions in this file perform floating point operations rounded
+// toward negative or positive infinity. Go does not provide control of
+// the rounding mode, so the rounding error of each round-to-nearest
+// operation is found exactly using an error-free transformation and the
+// result is moved one unit in the last place when the exact result lies
+// outside it.
+//
+// See S. M. Rump, T. Ogita and S. Oishi, "Accurate floating-point
+// summation part I: Faithful rounding", SIAM Journal on Scientific
+// Computing 31(1):189–224, 2008, and J.-M. Muller et al., "Handbook of
+// Floating-Point Arithmetic", 2nd ed., Birkhäuser, 2018.
+
+// tiny is the magnitude below which the error terms of products,
+// quotients and square roots may be subject to underflow, and so
+// are not relied upon.
+const tiny = 0x1p-960
+
+var (
+	inf    = math.Inf(1)
+	negInf = math.Inf(-1)
+)
+
+func next(x float64) float64 { return math.Nextafter(x, inf) }
+func prev(x float64) float64 { return math.Nextafter(x, negInf) }
+
+// sumErr returns the exact rounding error of s = a+b, so that a+b
+// is exactly equal to s+err. It uses Knuth's TwoSum algorithm.
+func sumErr(a, b, s float64) float64 {
+	bb := s - a
+	return (a - (s - bb)) + (b - bb)
+}
+
+// round returns the result r of a round-to-nearest operation with
+// operands a and b, rounded down when down is true and up otherwise.
+// sign is the sign of the exact result minus r when it is known, and
+// exact indicates that the result is known to be exact.
+func round(r float64, sign float64, exact, down bool, a, b float64) float64 {
+	switch {
+	case math.IsInf(r, 0):
+		if math.IsInf(a, 0) || math.IsInf(b, 0) {
+			return r
+		}
+		// Overflow of a finite exact result.
+		if down && r > 0 {
+			return math.MaxFloat64
+		}
+		if !down && r < 0 {
+			return -math.MaxFloat64
+		}
+		return r
+	case exact:
+		return r
+	case down:
+		if sign < 0 {
+			return prev(r)
+		}
+		return r
+	default:
+		if sign > 0 {
+			return next(r)
+		}
+		return r
+	}
+}
+
+func addDown(a, b float64) float64 {
+	s := a + b
+	e := sumErr(a, b, s)
+	return round(s, e, e == 0, true, a, b)
+}
+
+func addUp(a, b float64) float64 {
+	s := a + b
+	e := sumErr(a, b, s)
+	return round(s, e, e == 0, false, a, b)
+}
+
+func subDown(a, b float64) float64 { return addDown(a, -b) }
+func subUp(a, b float64) float64   { return addUp(a, -b) }
+
+// mulRound returns a*b rounded down or up. By the convention
+// of interval arithmetic, the product of zero and infinity
+// is zero.
+func mulRound(a, b float64, down bool) float64 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	p := a * b
+	if math.Abs(p) < tiny && !math.IsInf(a, 0) && !math.IsInf(b, 0) {
+		// The error term may underflow.
+		return outward(p, math.Signbit(a) != math.Signbit(b), down)
+	}
+	e := math.FMA(a, b, -p)
+	return round(p, e, e == 0, down, a, b)
+}
+
+func mulDown(a, b float64) float64 { return mulRound(a, b, true) }
+func mulUp(a, b float64) float64   { return mulRound(a, b, false) }
+
+// outward returns r moved one unit in the last place down or up, without
+// crossing zero when the exact result is known to be non-negative or
+// non-positive according to neg.
+func outward(r float64, neg, down bool) float64 {
+	if down {
+		r = prev(r)
+		if !neg {
+			r = math.Max(r, 0)
+		}
+		return r
+	}
+	r = next(r)
+	if neg {
+		r = math.Min(r, 0)
+	}
+	return r
+}
+
+// divRound returns a/b rounded down or up. b must not be zero.
+func divRound(a, b float64, down bool) float64 {
+	q := a / b
+	if math.IsInf(a, 0) || math.IsInf(b, 0) || q == 0 && a == 0 {
+		return q
+	}
+	if math.Abs(q) < tiny || math.Abs(a) < tiny {
+		return outward(q, math.Signbit(a) != math.Signbit(b), down)
+	}
+	// The remainder a - q*b is exactly representable
+	// and a/b = q + r/b.
+	r := math.FMA(-q, b, a)
+	if b < 0 {
+		r = -r
+	}
+	return round(q, r, r == 0, down, a, b)
+}
+
+func divDown(a, b float64) float64 { return divRound(a, b, true) }
+func divUp(a, b float64) float64   { return divRound(a, b, false) }
+
+// sqrtRound returns the square root of x rounded down or up.
+// x must not be negative.
+func sqrtRound(x float64, down bool) float64 {
+	s := math.Sqrt(x)
+	if x == 0 || math.IsInf(x, 1) {
+		return s
+	}
+	if x < tiny {
+		return outward(s, false, down)
+	}
+	// sqrt(x)² = x = s² + r.
+	r := math.FMA(-s, s, x)
+	return round(s, r, r == 0, down, x, 0)
+}
+
+// ulps is the number of units in the last place that the results
+// of the math package's elementary functions are widened by.
+const ulps = 2
+
+// widen returns [lo, hi] widened outward by ulps units in the
+// last place.
+func widen(lo, hi float64) Number {
+	for i := 0; i < ulps; i++ {
+		lo = prev(lo)
+		hi = next(hi)
+	}
+	return Number{Lo: lo, Hi: hi}
+}
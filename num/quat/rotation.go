@@ -0,0 +1,395 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quat
+
+import "math"
+
+// The functions in this file treat unit quaternions as rotations in three
+// dimensions. The unit quaternion q rotates the vector v, represented as
+// the pure quaternion with imaginary parts v, to q v q*, where q* is the
+// conjugate of q. The quaternions q and -q represent the same rotation.
+// Rotations compose by quaternion multiplication, so Mul(q, p) is the
+// rotation p followed by the rotation q.
+//
+// Unless otherwise noted, the functions expect unit quaternion arguments
+// and the results are not meaningful for quaternions that are far from
+// unit length. Normalize may be used to enforce unit length.
+
+// Normalize returns the unit quaternion in the direction of q. If q is
+// zero, the returned value has NaN components.
+func Normalize(q Number) Number {
+	return unit(q)
+}
+
+// IsUnit returns whether the absolute value of q is within tol of one.
+func IsUnit(q Number, tol float64) bool {
+	return math.Abs(Abs(q)-1) <= tol
+}
+
+// Canonical returns the one of q and -q that has a non-negative real
+// part. When the real part is zero, the sign is chosen so that the
+// first non-zero imaginary component is positive. Canonical does not
+// change the rotation represented by a unit quaternion, but gives a
+// unique representation.
+func Canonical(q Number) Number {
+	for _, v := range [...]float64{q.Real, q.Imag, q.Jmag, q.Kmag} {
+		switch {
+		case v > 0:
+			return q
+		case v < 0:
+			return Scale(-1, q)
+		}
+	}
+	return q
+}
+
+// FromAxisAngle returns the unit quaternion representing a rotation by
+// angle radians about axis, following the right-hand rule. The axis does
+// not need to be of unit length. If axis is zero, the identity rotation
+// is returned.
+func FromAxisAngle(axis [3]float64, angle float64) Number {
+	n := math.Sqrt(axis[0]*axis[0] + axis[1]*axis[1] + axis[2]*axis[2])
+	if n == 0 {
+		return Number{Real: 1}
+	}
+	sin, cos := math.Sincos(angle / 2)
+	s := sin / n
+	return Number{Real: cos, Imag: s * axis[0], Jmag: s * axis[1], Kmag: s * axis[2]}
+}
+
+// AxisAngle returns the unit axis and angle of the rotation represented
+// by q. The returned angle is in [0, π]. If q is the identity rotation,
+// the returned axis is (1, 0, 0) and the angle is zero.
+func AxisAngle(q Number) (axis [3]float64, angle float64) {
+	q = Canonical(q)
+	s := math.Sqrt(q.Imag*q.Imag + q.Jmag*q.Jmag + q.Kmag*q.Kmag)
+	if s == 0 {
+		return [3]float64{1, 0, 0}, 0
+	}
+	// Use atan2 rather than acos for accuracy
+	// near zero angles.
+	angle = 2 * math.Atan2(s, q.Real)
+	return [3]float64{q.Imag / s, q.Jmag / s, q.Kmag / s}, angle
+}
+
+// Rotate returns the vector v rotated by the unit quaternion q.
+func Rotate(q Number, v [3]float64) [3]float64 {
+	// Compute v + 2w(u×v) + 2u×(u×v) where
+	// w and u are the real and imaginary
+	// parts of q.
+	u := [3]float64{q.Imag, q.Jmag, q.Kmag}
+	t := cross(u, v)
+	for i := range t {
+		t[i] *= 2
+	}
+	c := cross(u, t)
+	return [3]float64{
+		v[0] + q.Real*t[0] + c[0],
+		v[1] + q.Real*t[1] + c[1],
+		v[2] + q.Real*t[2] + c[2],
+	}
+}
+
+func cross(a, b [3]float64) [3]float64 {
+	return [3]float64{
+		a[1]*b[2] - a[2]*b[1],
+		a[2]*b[0] - a[0]*b[2],
+		a[0]*b[1] - a[1]*b[0],
+	}
+}
+
+// Matrix returns the 3×3 rotation matrix, indexed by row and then column,
+// corresponding to the unit quaternion q. The matrix multiplies column
+// vectors, so Matrix(q) times v is equal to Rotate(q, v).
+func Matrix(q Number) [3][3]float64 {
+	w, x, y, z := q.Real, q.Imag, q.Jmag, q.Kmag
+	return [3][3]float64{
+		{1 - 2*(y*y+z*z), 2 * (x*y - w*z), 2 * (x*z + w*y)},
+		{2 * (x*y + w*z), 1 - 2*(x*x+z*z), 2 * (y*z - w*x)},
+		{2 * (x*z - w*y), 2 * (y*z + w*x), 1 - 2*(x*x+y*y)},
+	}
+}
+
+// FromMatrix returns the unit quaternion with a non-negative real part
+// corresponding to the 3×3 rotation matrix m, indexed by row and then
+// column. If m is not a rotation matrix, the returned quaternion is the
+// normalized result of Shepperd's method.
+//
+// See S. W. Shepperd, "Quaternion from rotation matrix", Journal of
+// Guidance and Control 1(3):223–224, 1978.
+func FromMatrix(m [3][3]float64) Number {
+	// Select the largest of the quaternion
+	// components to avoid cancellation.
+	m00, m11, m22 := m[0][0], m[1][1], m[2][2]
+	tr := m00 + m11 + m22
+	var q Number
+	switch {
+	case tr >= m00 && tr >= m11 && tr >= m22:
+		s := 2 * math.Sqrt(1+tr)
+		q = Number{
+			Real: s / 4,
+			Imag: (m[2][1] - m[1][2]) / s,
+			Jmag: (m[0][2] - m[2][0]) / s,
+			Kmag: (m[1][0] - m[0][1]) / s,
+		}
+	case m00 >= m11 && m00 >= m22:
+		s := 2 * math.Sqrt(1+m00-m11-m22)
+		q = Number{
+			Real: (m[2][1] - m[1][2]) / s,
+			Imag: s / 4,
+			Jmag: (m[0][1] + m[1][0]) / s,
+			Kmag: (m[0][2] + m[2][0]) / s,
+		}
+	case m11 >= m22:
+		s := 2 * math.Sqrt(1+m11-m00-m22)
+		q = Number{
+			Real: (m[0][2] - m[2][0]) / s,
+			Imag: (m[0][1] + m[1][0]) / s,
+			Jmag: s / 4,
+			Kmag: (m[1][2] + m[2][1]) / s,
+		}
+	default:
+		s := 2 * math.Sqrt(1+m22-m00-m11)
+		q = Number{
+			Real: (m[1][0] - m[0][1]) / s,
+			Imag: (m[0][2] + m[2][0]) / s,
+			Jmag: (m[1][2] + m[2][1]) / s,
+			Kmag: s / 4,
+		}
+	}
+	if q.Real < 0 {
+		q = Scale(-1, q)
+	}
+	return unit(q)
+}
+
+// eulerAxes returns the axis indices of the Euler angle sequence seq
+// and whether the rotations are extrinsic.
+func eulerAxes(seq string) (axes [3]int, extrinsic bool) {
+	if len(seq) != 3 {
+		panic(badEuler)
+	}
+	var upper, lower int
+	for i, c := range []byte(seq) {
+		switch {
+		case 'x' <= c && c <= 'z':
+			axes[i] = int(c - 'x')
+			lower++
+		case 'X' <= c && c <= 'Z':
+			axes[i] = int(c - 'X')
+			upper++
+		default:
+			panic(badEuler)
+		}
+	}
+	if (upper != 0 && lower != 0) || axes[0] == axes[1] || axes[1] == axes[2] {
+		panic(badEuler)
+	}
+	return axes, lower != 0
+}
+
+const badEuler = "quat: invalid Euler sequence"
+
+// FromEuler returns the unit quaternion for the rotation described by the
+// Euler angles a, b and c in radians about the axes named in seq. The
+// sequence is three characters naming the axes of the elemental rotations
+// in the order they are applied. Lower case names, for example "xyz", give
+// extrinsic rotations about the axes of the fixed reference frame and upper
+// case names, for example "ZYX", give intrinsic rotations about the axes of
+// the rotating body. Both Tait-Bryan sequences such as "xyz" and proper
+// Euler sequences such as "zxz" are accepted. The intrinsic sequence "ZYX"
+// gives the yaw, pitch and roll angles commonly used in aeronautics.
+//
+// FromEuler panics if seq is not a valid sequence.
+func FromEuler(seq string, a, b, c float64) Number {
+	axes, extrinsic := eulerAxes(seq)
+	q1 := elemental(axes[0], a)
+	q2 := elemental(axes[1], b)
+	q3 := elemental(axes[2], c)
+	if extrinsic {
+		return Mul(q3, Mul(q2, q1))
+	}
+	return Mul(q1, Mul(q2, q3))
+}
+
+// elemental returns the unit quaternion for a rotation
+// by alpha about the axis with index axis.
+func elemental(axis int, alpha float64) Number {
+	sin, cos := math.Sincos(alpha / 2)
+	q := Number{Real: cos}
+	switch axis {
+	case 0:
+		q.Imag = sin
+	case 1:
+		q.Jmag = sin
+	case 2:
+		q.Kmag = sin
+	}
+	return q
+}
+
+// Euler returns the Euler angles in radians describing the rotation q with
+// the Euler sequence seq, interpreted as for FromEuler. The first and third
+// angles are in [-π, π]. The second angle is in [0, π] for proper Euler
+// sequences and in [-π/2, π/2] for Tait-Bryan sequences. When the rotation
+// is in gimbal lock, the first and third rotations are about the same axis
+// and the third angle is returned as zero.
+//
+// Euler panics if seq is not a valid sequence.
+//
+// See E. Bernardes and S. Viollet, "Quaternion to Euler angles conversion:
+// A direct, general and computationally efficient method", PLoS ONE 17(11):
+// e0276302, 2022.
+func Euler(q Number, seq string) (a, b, c float64) {
+	axes, extrinsic := eulerAxes(seq)
+	if !extrinsic {
+		// An intrinsic sequence is the reversed
+		// extrinsic sequence.
+		axes[0], axes[2] = axes[2], axes[0]
+	}
+	i, j, k := axes[0], axes[1], axes[2]
+	proper := i == k
+	if proper {
+		k = 3 - i - j
+	}
+	sign := float64((i - j) * (j - k) * (k - i) / 2)
+
+	v := [3]float64{q.Imag, q.Jmag, q.Kmag}
+	var qa, qb, qc, qd float64
+	if proper {
+		qa, qb, qc, qd = q.Real, v[i], v[j], v[k]*sign
+	} else {
+		qa, qb, qc, qd = q.Real-v[j], v[i]+v[k]*sign, v[j]+q.Real, v[k]*sign-v[i]
+	}
+
+	var angles [3]float64
+	angles[1] = 2 * math.Atan2(math.Hypot(qc, qd), math.Hypot(qa, qb))
+	halfSum := math.Atan2(qb, qa)
+	halfDiff := math.Atan2(qd, qc)
+	const tol = 1e-7
+	switch {
+	case math.Abs(angles[1]) <= tol:
+		if extrinsic {
+			angles[0] = 2 * halfSum
+		} else {
+			angles[2] = 2 * halfSum
+		}
+	case math.Abs(angles[1]-math.Pi) <= tol:
+		if extrinsic {
+			angles[0] = -2 * halfDiff
+		} else {
+			angles[2] = 2 * halfDiff
+		}
+	default:
+		angles[0] = halfSum - halfDiff
+		angles[2] = halfSum + halfDiff
+	}
+	if !proper {
+		angles[2] *= sign
+		angles[1] -= math.Pi / 2
+	}
+	if !extrinsic {
+		angles[0], angles[2] = angles[2], angles[0]
+	}
+	for i, a := range angles {
+		angles[i] = wrapAngle(a)
+	}
+	return angles[0], angles[1], angles[2]
+}
+
+// wrapAngle returns a wrapped into [-π, π].
+func wrapAngle(a float64) float64 {
+	switch {
+	case a < -math.Pi:
+		return a + 2*math.Pi
+	case a > math.Pi:
+		return a - 2*math.Pi
+	}
+	return a
+}
+
+// Slerp returns the spherical linear interpolation between the unit
+// quaternions p and q for t in [0, 1]; zero corresponds to p and one to q.
+// The interpolation follows the shorter of the two great arcs between the
+// rotations represented by p and q, so the result for t=1 may be -q.
+func Slerp(p, q Number, t float64) Number {
+	if dot(p, q) < 0 {
+		q = Scale(-1, q)
+	}
+	return slerp(p, q, t)
+}
+
+// slerp returns the spherical linear interpolation between
+// p and q without selecting the shorter arc.
+func slerp(p, q Number, t float64) Number {
+	d := dot(p, q)
+	if math.Abs(d) > 1-1e-12 {
+		// The quaternions are nearly parallel;
+		// use normalized linear interpolation.
+		return unit(Add(Scale(1-t, p), Scale(t, q)))
+	}
+	theta := math.Acos(d)
+	sin := math.Sin(theta)
+	return Add(
+		Scale(math.Sin((1-t)*theta)/sin, p),
+		Scale(math.Sin(t*theta)/sin, q),
+	)
+}
+
+// dot returns the four-dimensional dot product of p and q.
+func dot(p, q Number) float64 {
+	return p.Real*q.Real + p.Imag*q.Imag + p.Jmag*q.Jmag + p.Kmag*q.Kmag
+}
+
+// SquadControl returns the control point at the key rotation q for
+// spherical quadrangle interpolation between the key rotations prev, q
+// and next. The neighbouring rotations are negated where needed to lie
+// in the same hemisphere as q. At the ends of a sequence of key rotations,
+// the end rotation may be used as its own neighbour.
+//
+// See K. Shoemake, "Animating rotation with quaternion curves",
+// SIGGRAPH Computer Graphics 19(3):245–254, 1985.
+func SquadControl(prev, q, next Number) Number {
+	if dot(prev, q) < 0 {
+		prev = Scale(-1, prev)
+	}
+	if dot(next, q) < 0 {
+		next = Scale(-1, next)
+	}
+	inv := Conj(q)
+	l := Add(Log(Mul(inv, next)), Log(Mul(inv, prev)))
+	return unit(Mul(q, Exp(Scale(-0.25, l))))
+}
+
+// Squad returns the spherical quadrangle interpolation between the key
+// rotations p and q with control points sp and sq, as returned by
+// SquadControl, for t in [0, 1]; zero corresponds to p and one to q.
+// Squad gives a rotation path with continuous angular velocity across
+// key rotations. The key rotations must lie in the same hemisphere, as
+// done by Canonical or by negating q when the dot product of p and q is
+// negative.
+func Squad(p, q, sp, sq Number, t float64) Number {
+	return unit(slerp(slerp(p, q, t), slerp(sp, sq, t), 2*t*(1-t)))
+}
+
+// Integrate returns the orientation obtained by rotating from the unit
+// quaternion q with the constant angular velocity omega, in radians per
+// unit time, for the time interval dt. The angular velocity is expressed
+// in the body frame of the rotating object, as measured by a gyroscope
+// fixed to the body. The integration is exact for constant angular
+// velocity and the result is normalized.
+func Integrate(q Number, omega [3]float64, dt float64) Number {
+	n := math.Sqrt(omega[0]*omega[0] + omega[1]*omega[1] + omega[2]*omega[2])
+	return unit(Mul(q, FromAxisAngle(omega, n*dt)))
+}
+
+// AngularVelocity returns the constant body frame angular velocity that
+// rotates the unit quaternion p to q in the time interval dt, taking the
+// shorter rotation between them. It is the inverse of Integrate.
+func AngularVelocity(p, q Number, dt float64) [3]float64 {
+	axis, angle := AxisAngle(Mul(Conj(p), q))
+	w := angle / dt
+	return [3]float64{w * axis[0], w * axis[1], w * axis[2]}
+}
@@ -0,0 +1,294 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quat
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func randRotation(rnd *rand.Rand) Number {
+	return Normalize(Number{
+		Real: rnd.NormFloat64(),
+		Imag: rnd.NormFloat64(),
+		Jmag: rnd.NormFloat64(),
+		Kmag: rnd.NormFloat64(),
+	})
+}
+
+// sameRotation returns whether p and q represent the same rotation.
+func sameRotation(p, q Number, tol float64) bool {
+	return math.Abs(math.Abs(dot(p, q))-1) <= tol
+}
+
+func sameVec(a, b [3]float64, tol float64) bool {
+	for i := range a {
+		if !scalar.EqualWithinAbsOrRel(a[i], b[i], tol, tol) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestAxisAngle(t *testing.T) {
+	t.Parallel()
+	q := FromAxisAngle([3]float64{0, 0, 2}, math.Pi/2)
+	if got := Rotate(q, [3]float64{1, 0, 0}); !sameVec(got, [3]float64{0, 1, 0}, 1e-15) {
+		t.Errorf("unexpected rotation of x axis about z: got:%v want:[0 1 0]", got)
+	}
+	if got := FromAxisAngle([3]float64{}, 1); got != (Number{Real: 1}) {
+		t.Errorf("unexpected rotation about zero axis: got:%v want:1", got)
+	}
+	axis, angle := AxisAngle(Number{Real: 1})
+	if axis != [3]float64{1, 0, 0} || angle != 0 {
+		t.Errorf("unexpected axis and angle of identity: got:%v %v", axis, angle)
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		q := randRotation(rnd)
+		axis, angle := AxisAngle(q)
+		if angle < 0 || angle > math.Pi {
+			t.Errorf("angle out of range: %v", angle)
+		}
+		if got := FromAxisAngle(axis, angle); !sameRotation(got, q, 1e-14) {
+			t.Errorf("unexpected axis-angle round trip: got:%v want:%v", got, q)
+		}
+		// The axis is invariant under the rotation.
+		if got := Rotate(q, axis); !sameVec(got, axis, 1e-14) {
+			t.Errorf("axis not invariant: got:%v want:%v", got, axis)
+		}
+	}
+}
+
+func TestRotateMatrix(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		q := randRotation(rnd)
+		v := [3]float64{rnd.NormFloat64(), rnd.NormFloat64(), rnd.NormFloat64()}
+
+		p := Mul(Mul(q, Number{Imag: v[0], Jmag: v[1], Kmag: v[2]}), Conj(q))
+		want := [3]float64{p.Imag, p.Jmag, p.Kmag}
+		if got := Rotate(q, v); !sameVec(got, want, 1e-14) {
+			t.Errorf("unexpected rotation: got:%v want:%v", got, want)
+		}
+
+		m := Matrix(q)
+		var got [3]float64
+		for r := range m {
+			for c := range m[r] {
+				got[r] += m[r][c] * v[c]
+			}
+		}
+		if !sameVec(got, want, 1e-14) {
+			t.Errorf("unexpected matrix rotation: got:%v want:%v", got, want)
+		}
+
+		back := FromMatrix(m)
+		if back.Real < 0 || !sameRotation(back, q, 1e-14) || !IsUnit(back, 1e-15) {
+			t.Errorf("unexpected matrix round trip: got:%v want:%v", back, q)
+		}
+	}
+
+	// Rotations by π exercise each branch of Shepperd's method.
+	for _, axis := range [][3]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}, {1, 1, 0}} {
+		q := FromAxisAngle(axis, math.Pi)
+		if got := FromMatrix(Matrix(q)); !sameRotation(got, q, 1e-15) {
+			t.Errorf("unexpected matrix round trip for π rotation about %v: got:%v want:%v", axis, got, q)
+		}
+	}
+}
+
+var eulerSequences = []string{
+	"xyz", "xzy", "yxz", "yzx", "zxy", "zyx",
+	"xyx", "xzx", "yxy", "yzy", "zxz", "zyz",
+	"XYZ", "XZY", "YXZ", "YZX", "ZXY", "ZYX",
+	"XYX", "XZX", "YXY", "YZY", "ZXZ", "ZYZ",
+}
+
+func TestEuler(t *testing.T) {
+	t.Parallel()
+	// Extrinsic xyz is intrinsic ZYX with the angles reversed.
+	p := FromEuler("xyz", 0.1, 0.2, 0.3)
+	q := FromEuler("ZYX", 0.3, 0.2, 0.1)
+	if !sameRotation(p, q, 1e-15) {
+		t.Errorf("unexpected rotation for ZYX: got:%v want:%v", q, p)
+	}
+	// Yaw about z then pitch about the new y axis.
+	q = FromEuler("ZYX", math.Pi/2, math.Pi/2, 0)
+	if got := Rotate(q, [3]float64{1, 0, 0}); !sameVec(got, [3]float64{0, 0, -1}, 1e-15) {
+		t.Errorf("unexpected yaw-pitch rotation of x axis: got:%v want:[0 0 -1]", got)
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, seq := range eulerSequences {
+		proper := seq[0] == seq[2]
+		for i := 0; i < 100; i++ {
+			q := randRotation(rnd)
+			a, b, c := Euler(q, seq)
+			if a < -math.Pi || a > math.Pi || c < -math.Pi || c > math.Pi {
+				t.Errorf("angles out of range for %s: a=%v c=%v", seq, a, c)
+			}
+			if proper && (b < 0 || b > math.Pi) || !proper && (b < -math.Pi/2 || b > math.Pi/2) {
+				t.Errorf("second angle out of range for %s: %v", seq, b)
+			}
+			if got := FromEuler(seq, a, b, c); !sameRotation(got, q, 1e-12) {
+				t.Errorf("unexpected Euler round trip for %s: got:%v want:%v", seq, got, q)
+			}
+		}
+
+		// Gimbal lock.
+		locks := []float64{math.Pi / 2, -math.Pi / 2}
+		if proper {
+			locks = []float64{0, math.Pi}
+		}
+		for _, b := range locks {
+			q := FromEuler(seq, 0.3, b, -0.7)
+			a, gb, c := Euler(q, seq)
+			if got := FromEuler(seq, a, gb, c); !sameRotation(got, q, 1e-12) {
+				t.Errorf("unexpected Euler round trip in gimbal lock for %s: got:%v want:%v", seq, got, q)
+			}
+			if c != 0 {
+				t.Errorf("unexpected third angle in gimbal lock for %s: got:%v want:0", seq, c)
+			}
+		}
+	}
+
+	for _, seq := range []string{"", "xy", "xyzx", "xxy", "xYz", "abc", "xyy"} {
+		if !panics(func() { FromEuler(seq, 0, 0, 0) }) {
+			t.Errorf("expected panic for Euler sequence %q", seq)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+	q := Normalize(Number{Real: 1, Imag: 2, Jmag: 2, Kmag: 4})
+	if !IsUnit(q, 1e-15) || q != (Number{Real: 0.2, Imag: 0.4, Jmag: 0.4, Kmag: 0.8}) {
+		t.Errorf("unexpected normalized quaternion: got:%v", q)
+	}
+	if IsUnit(Number{Real: 1.1}, 0.01) {
+		t.Error("unexpected unit quaternion")
+	}
+	if !IsNaN(Normalize(Number{})) {
+		t.Error("expected NaN for normalized zero")
+	}
+	for _, test := range []struct {
+		q, want Number
+	}{
+		{q: Number{Real: -1, Imag: 2}, want: Number{Real: 1, Imag: -2}},
+		{q: Number{Real: 1, Imag: -2}, want: Number{Real: 1, Imag: -2}},
+		{q: Number{Imag: -1, Jmag: 2}, want: Number{Imag: 1, Jmag: -2}},
+		{q: Number{Kmag: -1}, want: Number{Kmag: 1}},
+	} {
+		if got := Canonical(test.q); got != test.want {
+			t.Errorf("unexpected canonical quaternion for %v: got:%v want:%v", test.q, got, test.want)
+		}
+	}
+}
+
+func TestSlerp(t *testing.T) {
+	t.Parallel()
+	p := FromAxisAngle([3]float64{0, 0, 1}, 0.2)
+	q := FromAxisAngle([3]float64{0, 0, 1}, 1.4)
+	for _, tt := range []float64{0, 0.25, 0.5, 1} {
+		want := FromAxisAngle([3]float64{0, 0, 1}, 0.2+1.2*tt)
+		if got := Slerp(p, q, tt); !sameRotation(got, want, 1e-15) || !IsUnit(got, 1e-15) {
+			t.Errorf("unexpected slerp at %v: got:%v want:%v", tt, got, want)
+		}
+		// Slerp takes the shorter path.
+		if got := Slerp(p, Scale(-1, q), tt); !sameRotation(got, want, 1e-15) {
+			t.Errorf("unexpected slerp with negated end at %v: got:%v want:%v", tt, got, want)
+		}
+	}
+	if got := Slerp(p, p, 0.5); !sameRotation(got, p, 1e-15) {
+		t.Errorf("unexpected slerp between identical rotations: got:%v want:%v", got, p)
+	}
+}
+
+func TestSquad(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	keys := make([]Number, 5)
+	keys[0] = randRotation(rnd)
+	for i := 1; i < len(keys); i++ {
+		step := FromAxisAngle([3]float64{rnd.NormFloat64(), rnd.NormFloat64(), rnd.NormFloat64()}, 0.5)
+		keys[i] = Mul(keys[i-1], step)
+	}
+	ctrl := make([]Number, len(keys))
+	for i := range keys {
+		prev, next := keys[max(i-1, 0)], keys[min(i+1, len(keys)-1)]
+		ctrl[i] = SquadControl(prev, keys[i], next)
+	}
+
+	const h = 1e-5
+	for i := 0; i < len(keys)-1; i++ {
+		if got := Squad(keys[i], keys[i+1], ctrl[i], ctrl[i+1], 0); !sameRotation(got, keys[i], 1e-14) {
+			t.Errorf("unexpected start of segment %d: got:%v want:%v", i, got, keys[i])
+		}
+		if got := Squad(keys[i], keys[i+1], ctrl[i], ctrl[i+1], 1); !sameRotation(got, keys[i+1], 1e-14) {
+			t.Errorf("unexpected end of segment %d: got:%v want:%v", i, got, keys[i+1])
+		}
+		if i == 0 {
+			continue
+		}
+		// The angular velocity is continuous across keys.
+		before := AngularVelocity(Squad(keys[i-1], keys[i], ctrl[i-1], ctrl[i], 1-h), keys[i], h)
+		after := AngularVelocity(keys[i], Squad(keys[i], keys[i+1], ctrl[i], ctrl[i+1], h), h)
+		if !sameVec(before, after, 1e-3) {
+			t.Errorf("discontinuous angular velocity at key %d: before:%v after:%v", i, before, after)
+		}
+	}
+}
+
+func TestIntegrate(t *testing.T) {
+	t.Parallel()
+	// Spinning about the body z axis.
+	q := Integrate(Number{Real: 1}, [3]float64{0, 0, math.Pi}, 0.5)
+	if want := FromAxisAngle([3]float64{0, 0, 1}, math.Pi/2); !sameRotation(q, want, 1e-15) {
+		t.Errorf("unexpected integrated rotation: got:%v want:%v", q, want)
+	}
+	if got := Integrate(q, [3]float64{}, 1); got != q {
+		t.Errorf("unexpected rotation for zero angular velocity: got:%v want:%v", got, q)
+	}
+
+	// Body frame rates are applied about the rotated axes.
+	q = Integrate(FromAxisAngle([3]float64{0, 0, 1}, math.Pi/2), [3]float64{1, 0, 0}, math.Pi/2)
+	if got := Rotate(q, [3]float64{0, 0, 1}); !sameVec(got, [3]float64{1, 0, 0}, 1e-15) {
+		t.Errorf("unexpected body frame rotation: got:%v want:[1 0 0]", got)
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		p := randRotation(rnd)
+		omega := [3]float64{rnd.NormFloat64(), rnd.NormFloat64(), rnd.NormFloat64()}
+		q := Integrate(p, omega, 0.1)
+		if got := AngularVelocity(p, q, 0.1); !sameVec(got, omega, 1e-12) {
+			t.Errorf("unexpected angular velocity: got:%v want:%v", got, omega)
+		}
+
+		// Many small steps give the same result as one large step.
+		r := p
+		for j := 0; j < 100; j++ {
+			r = Integrate(r, omega, 0.001)
+		}
+		if !sameRotation(r, q, 1e-12) {
+			t.Errorf("unexpected result of stepped integration: got:%v want:%v", r, q)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return false
+}
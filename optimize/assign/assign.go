@@ -0,0 +1,367 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package assign
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+var (
+	ErrInfeasible = errors.New("assign: no complete assignment exists")
+	ErrCost       = errors.New("assign: cost is NaN or -Inf")
+)
+
+// Result is the solution of a linear assignment problem with n rows and m
+// columns.
+type Result struct {
+	// Cost is the total cost of the assignment.
+	Cost float64
+
+	// RowCol holds the column assigned to each row, and ColRow holds the
+	// row assigned to each column. Unassigned rows and columns are
+	// indicated by -1.
+	RowCol []int
+	ColRow []int
+
+	// U and V are the dual prices of the rows and columns. They satisfy
+	//  u[i] + v[j] <= c[i][j]
+	// for all allowed pairs, with equality for the assigned pairs, and
+	// the prices of the unassigned rows or columns are zero. The sum of
+	// the prices is equal to Cost.
+	U, V []float64
+}
+
+// Solve solves the linear assignment problem with the n×m cost matrix c.
+// If n ≤ m, each row is assigned to a distinct column such that the total
+// cost
+//
+//	Σ_i c[i, RowCol[i]]
+//
+// is minimized, otherwise each column is assigned to a distinct row. To
+// maximize the total instead, negate the costs.
+//
+// An element of c equal to +Inf forbids the assignment of its row and
+// column. Solve returns ErrInfeasible if no complete assignment with finite
+// cost exists and ErrCost if c contains NaN or -Inf.
+//
+// Solve uses the shortest augmenting path algorithm of Jonker and Volgenant
+// with the rectangular extension described in
+//
+//	Crouse, D. F. "On implementing 2D rectangular assignment algorithms."
+//	IEEE Transactions on Aerospace and Electronic Systems 52.4 (2016)
+//
+// and takes O(n²m) time.
+func Solve(c mat.Matrix) (Result, error) {
+	n, m := c.Dims()
+	trans := n > m
+	if trans {
+		n, m = m, n
+	}
+	cost := make([]float64, n*m)
+	for i := 0; i < n; i++ {
+		for j := 0; j < m; j++ {
+			var v float64
+			if trans {
+				v = c.At(j, i)
+			} else {
+				v = c.At(i, j)
+			}
+			if math.IsNaN(v) || math.IsInf(v, -1) {
+				return Result{}, ErrCost
+			}
+			cost[i*m+j] = v
+		}
+	}
+
+	s := newSolver(n, m, false)
+	remaining := make([]int, m)
+	for cur := 0; cur < n; cur++ {
+		// Find the shortest augmenting path from row cur to an
+		// unassigned column, scanning all columns not yet reached.
+		for j := range remaining {
+			remaining[j] = m - 1 - j
+		}
+		nrem := m
+		s.reset()
+		var minVal float64
+		i := cur
+		sink := -1
+		for sink < 0 {
+			s.visitRow(i)
+			index := -1
+			lowest := math.Inf(1)
+			for k := 0; k < nrem; k++ {
+				j := remaining[k]
+				r := minVal + cost[i*m+j] - s.u[i] - s.v[j]
+				if r < s.shortest[j] {
+					s.path[j] = i
+					s.shortest[j] = r
+				}
+				if s.shortest[j] < lowest || (s.shortest[j] == lowest && s.colRow[j] < 0) {
+					lowest = s.shortest[j]
+					index = k
+				}
+			}
+			if math.IsInf(lowest, 1) {
+				return Result{}, ErrInfeasible
+			}
+			minVal = lowest
+			j := remaining[index]
+			s.visitCol(j)
+			if s.colRow[j] < 0 {
+				sink = j
+			} else {
+				i = s.colRow[j]
+			}
+			nrem--
+			remaining[index] = remaining[nrem]
+		}
+		s.augment(cur, sink, minVal)
+	}
+
+	var total float64
+	for i, j := range s.rowCol {
+		total += cost[i*m+j]
+	}
+	return s.result(total, trans), nil
+}
+
+// SolveSparse solves the linear assignment problem with the sparse n×m cost
+// matrix c. Only the stored elements of c are allowed assignments; elements
+// that are not stored forbid the assignment of their row and column.
+// Otherwise SolveSparse is equivalent to Solve.
+//
+// SolveSparse uses the same algorithm as Solve with the shortest paths found
+// by Dijkstra's algorithm over the stored elements, and takes O(n·e·log(e))
+// time in the worst case, where e is the number of stored elements.
+func SolveSparse(c *mat.CSR) (Result, error) {
+	n, m := c.Dims()
+	trans := n > m
+	indptr, ind, data := c.RawCSR()
+	for _, v := range data[:indptr[len(indptr)-1]] {
+		if math.IsNaN(v) || math.IsInf(v, -1) {
+			return Result{}, ErrCost
+		}
+	}
+	if trans {
+		indptr, ind, data = transposeCSR(n, m, indptr, ind, data)
+		n, m = m, n
+	}
+
+	s := newSolver(n, m, true)
+	var h colHeap
+	for cur := 0; cur < n; cur++ {
+		s.reset()
+		h = h[:0]
+		var minVal float64
+		i := cur
+		sink := -1
+		for sink < 0 {
+			s.visitRow(i)
+			for k := indptr[i]; k < indptr[i+1]; k++ {
+				j := ind[k]
+				if s.scanned[j] {
+					continue
+				}
+				r := minVal + data[k] - s.u[i] - s.v[j]
+				if r < s.shortest[j] {
+					if math.IsInf(s.shortest[j], 1) {
+						s.touched = append(s.touched, j)
+					}
+					s.path[j] = i
+					s.shortest[j] = r
+					heap.Push(&h, colDist{col: j, dist: r})
+				}
+			}
+			j := -1
+			for len(h) != 0 {
+				next := heap.Pop(&h).(colDist)
+				if !s.scanned[next.col] && next.dist == s.shortest[next.col] {
+					j = next.col
+					break
+				}
+			}
+			if j < 0 {
+				return Result{}, ErrInfeasible
+			}
+			minVal = s.shortest[j]
+			s.visitCol(j)
+			if s.colRow[j] < 0 {
+				sink = j
+			} else {
+				i = s.colRow[j]
+			}
+		}
+		s.augment(cur, sink, minVal)
+	}
+
+	var total float64
+	for i, j := range s.rowCol {
+		for k := indptr[i]; k < indptr[i+1]; k++ {
+			if ind[k] == j {
+				total += data[k]
+				break
+			}
+		}
+	}
+	return s.result(total, trans), nil
+}
+
+// solver holds the state of the shortest augmenting path algorithm for an
+// n×m problem with n ≤ m.
+type solver struct {
+	u, v   []float64
+	rowCol []int
+	colRow []int
+
+	// shortest and path hold the shortest path lengths to the
+	// columns and the row preceding each column on the path.
+	shortest []float64
+	path     []int
+
+	// scanned and the visited lists hold the rows and columns whose
+	// shortest path lengths are final. If sparse is true, touched
+	// holds the columns whose shortest path lengths are finite.
+	sparse      bool
+	scanned     []bool
+	visitedRows []int
+	visitedCols []int
+	touched     []int
+}
+
+func newSolver(n, m int, sparse bool) *solver {
+	s := &solver{
+		sparse:   sparse,
+		u:        make([]float64, n),
+		v:        make([]float64, m),
+		rowCol:   make([]int, n),
+		colRow:   make([]int, m),
+		shortest: make([]float64, m),
+		path:     make([]int, m),
+		scanned:  make([]bool, m),
+	}
+	for i := range s.rowCol {
+		s.rowCol[i] = -1
+	}
+	for j := range s.colRow {
+		s.colRow[j] = -1
+	}
+	for j := range s.shortest {
+		s.shortest[j] = math.Inf(1)
+	}
+	return s
+}
+
+// reset prepares the solver for finding a new augmenting path.
+func (s *solver) reset() {
+	for _, j := range s.visitedCols {
+		s.scanned[j] = false
+	}
+	if !s.sparse {
+		// The dense solver reaches all columns.
+		for j := range s.shortest {
+			s.shortest[j] = math.Inf(1)
+		}
+	} else {
+		for _, j := range s.touched {
+			s.shortest[j] = math.Inf(1)
+		}
+		s.touched = s.touched[:0]
+	}
+	s.visitedRows = s.visitedRows[:0]
+	s.visitedCols = s.visitedCols[:0]
+}
+
+func (s *solver) visitRow(i int) {
+	s.visitedRows = append(s.visitedRows, i)
+}
+
+func (s *solver) visitCol(j int) {
+	s.scanned[j] = true
+	s.visitedCols = append(s.visitedCols, j)
+}
+
+// augment updates the dual prices and the assignment along the shortest
+// augmenting path from row cur to the unassigned column sink with length
+// minVal.
+func (s *solver) augment(cur, sink int, minVal float64) {
+	s.u[cur] += minVal
+	for _, i := range s.visitedRows {
+		if i != cur {
+			s.u[i] += minVal - s.shortest[s.rowCol[i]]
+		}
+	}
+	for _, j := range s.visitedCols {
+		s.v[j] -= minVal - s.shortest[j]
+	}
+	j := sink
+	for {
+		i := s.path[j]
+		s.colRow[j] = i
+		j, s.rowCol[i] = s.rowCol[i], j
+		if i == cur {
+			break
+		}
+	}
+}
+
+// result returns the solution held by the solver, swapping the roles of rows
+// and columns if trans is true.
+func (s *solver) result(cost float64, trans bool) Result {
+	if trans {
+		return Result{Cost: cost, RowCol: s.colRow, ColRow: s.rowCol, U: s.v, V: s.u}
+	}
+	return Result{Cost: cost, RowCol: s.rowCol, ColRow: s.colRow, U: s.u, V: s.v}
+}
+
+// transposeCSR returns the compressed sparse row representation of the
+// transpose of the n×m matrix held in indptr, ind and data.
+func transposeCSR(n, m int, indptr, ind []int, data []float64) (tindptr, tind []int, tdata []float64) {
+	nnz := indptr[n]
+	tindptr = make([]int, m+1)
+	for _, j := range ind[:nnz] {
+		tindptr[j+1]++
+	}
+	for j := 0; j < m; j++ {
+		tindptr[j+1] += tindptr[j]
+	}
+	next := make([]int, m)
+	copy(next, tindptr)
+	tind = make([]int, nnz)
+	tdata = make([]float64, nnz)
+	for i := 0; i < n; i++ {
+		for k := indptr[i]; k < indptr[i+1]; k++ {
+			p := next[ind[k]]
+			tind[p] = i
+			tdata[p] = data[k]
+			next[ind[k]]++
+		}
+	}
+	return tindptr, tind, tdata
+}
+
+// colDist is a column and its tentative shortest path length.
+type colDist struct {
+	col  int
+	dist float64
+}
+
+// colHeap is a min-heap of columns ordered by path length.
+type colHeap []colDist
+
+func (h colHeap) Len() int            { return len(h) }
+func (h colHeap) Less(i, j int) bool  { return h[i].dist < h[j].dist }
+func (h colHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *colHeap) Push(x interface{}) { *h = append(*h, x.(colDist)) }
+func (h *colHeap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
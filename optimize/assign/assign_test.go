@@ -0,0 +1,213 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package assign
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestSolve(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 4, 6} {
+		for _, m := range []int{1, 2, 3, 5, 6} {
+			for _, forbid := range []float64{0, 0.3, 0.7} {
+				for trial := 0; trial < 10; trial++ {
+					name := fmt.Sprintf("n=%d,m=%d,forbid=%v,trial=%d", n, m, forbid, trial)
+					c := mat.NewDense(n, m, nil)
+					var ri, ci []int
+					var vals []float64
+					for i := 0; i < n; i++ {
+						for j := 0; j < m; j++ {
+							if rnd.Float64() < forbid {
+								c.Set(i, j, math.Inf(1))
+								continue
+							}
+							// Use integer costs so that ties
+							// are common.
+							v := float64(rnd.Intn(10) - 3)
+							c.Set(i, j, v)
+							ri = append(ri, i)
+							ci = append(ci, j)
+							vals = append(vals, v)
+						}
+					}
+					want, feasible := bruteForce(c)
+
+					got, err := Solve(c)
+					checkResult(t, name+",dense", c, got, err, want, feasible, tol)
+
+					sc := mat.NewCSRFromTriplets(n, m, ri, ci, vals)
+					got, err = SolveSparse(sc)
+					checkResult(t, name+",sparse", c, got, err, want, feasible, tol)
+				}
+			}
+		}
+	}
+}
+
+func TestSolveLarge(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-8
+	rnd := rand.New(rand.NewSource(1))
+	for _, size := range [][2]int{{50, 50}, {40, 70}, {70, 40}} {
+		n, m := size[0], size[1]
+		name := fmt.Sprintf("n=%d,m=%d", n, m)
+		c := mat.NewDense(n, m, nil)
+		for i := 0; i < n; i++ {
+			for j := 0; j < m; j++ {
+				c.Set(i, j, 100*rnd.Float64())
+			}
+		}
+		dense, err := Solve(c)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		checkResult(t, name+",dense", c, dense, err, dense.Cost, true, tol)
+		sparse, err := SolveSparse(csrOf(c))
+		checkResult(t, name+",sparse", c, sparse, err, dense.Cost, true, tol)
+	}
+}
+
+func TestSolveErrors(t *testing.T) {
+	t.Parallel()
+	inf := math.Inf(1)
+	for _, test := range []struct {
+		c    *mat.Dense
+		want error
+	}{
+		{c: mat.NewDense(2, 2, []float64{1, inf, 2, inf}), want: ErrInfeasible},
+		{c: mat.NewDense(3, 2, []float64{1, inf, 2, inf, 3, inf}), want: ErrInfeasible},
+		{c: mat.NewDense(2, 2, []float64{1, math.NaN(), 2, 3}), want: ErrCost},
+		{c: mat.NewDense(2, 2, []float64{1, 2, math.Inf(-1), 3}), want: ErrCost},
+	} {
+		if _, err := Solve(test.c); err != test.want {
+			t.Errorf("unexpected error for dense cost %v: got %v, want %v", mat.Formatted(test.c), err, test.want)
+		}
+		if _, err := SolveSparse(csrOf(test.c)); err != test.want {
+			t.Errorf("unexpected error for sparse cost %v: got %v, want %v", mat.Formatted(test.c), err, test.want)
+		}
+	}
+}
+
+// csrOf returns the sparse representation of the finite elements of c.
+func csrOf(c *mat.Dense) *mat.CSR {
+	n, m := c.Dims()
+	var ri, ci []int
+	var vals []float64
+	for i := 0; i < n; i++ {
+		for j := 0; j < m; j++ {
+			v := c.At(i, j)
+			if math.IsInf(v, 1) {
+				continue
+			}
+			ri = append(ri, i)
+			ci = append(ci, j)
+			vals = append(vals, v)
+		}
+	}
+	return mat.NewCSRFromTriplets(n, m, ri, ci, vals)
+}
+
+func checkResult(t *testing.T, name string, c *mat.Dense, got Result, err error, want float64, feasible bool, tol float64) {
+	t.Helper()
+	if !feasible {
+		if err != ErrInfeasible {
+			t.Errorf("%s: unexpected error: got %v, want %v", name, err, ErrInfeasible)
+		}
+		return
+	}
+	if err != nil {
+		t.Errorf("%s: unexpected error: %v", name, err)
+		return
+	}
+	if math.Abs(got.Cost-want) > tol*math.Max(1, math.Abs(want)) {
+		t.Errorf("%s: unexpected cost: got %v, want %v", name, got.Cost, want)
+	}
+
+	n, m := c.Dims()
+	if len(got.RowCol) != n || len(got.ColRow) != m || len(got.U) != n || len(got.V) != m {
+		t.Errorf("%s: unexpected result lengths", name)
+		return
+	}
+	var assigned int
+	var cost float64
+	for i, j := range got.RowCol {
+		if j < 0 {
+			continue
+		}
+		assigned++
+		if got.ColRow[j] != i {
+			t.Errorf("%s: inconsistent assignment of row %d and column %d", name, i, j)
+		}
+		cost += c.At(i, j)
+		if d := math.Abs(got.U[i] + got.V[j] - c.At(i, j)); d > tol*math.Max(1, math.Abs(c.At(i, j))) {
+			t.Errorf("%s: dual prices not tight for assigned pair (%d,%d)", name, i, j)
+		}
+	}
+	if assigned != min(n, m) {
+		t.Errorf("%s: unexpected number of assignments: got %d, want %d", name, assigned, min(n, m))
+	}
+	if math.Abs(cost-got.Cost) > tol*math.Max(1, math.Abs(cost)) {
+		t.Errorf("%s: cost mismatch: assignment costs %v, reported %v", name, cost, got.Cost)
+	}
+	var dual float64
+	for i, u := range got.U {
+		if got.RowCol[i] < 0 && u != 0 {
+			t.Errorf("%s: non-zero dual price for unassigned row %d", name, i)
+		}
+		dual += u
+		for j, v := range got.V {
+			if u+v > c.At(i, j)+tol*math.Max(1, math.Abs(c.At(i, j))) {
+				t.Errorf("%s: dual prices infeasible for pair (%d,%d)", name, i, j)
+			}
+		}
+	}
+	for j, v := range got.V {
+		if got.ColRow[j] < 0 && v != 0 {
+			t.Errorf("%s: non-zero dual price for unassigned column %d", name, j)
+		}
+		dual += v
+	}
+	if math.Abs(dual-got.Cost) > tol*math.Max(1, math.Abs(got.Cost)) {
+		t.Errorf("%s: dual objective %v not equal to cost %v", name, dual, got.Cost)
+	}
+}
+
+// bruteForce returns the minimum cost of a complete assignment of c by
+// enumerating all assignments, and whether any finite assignment exists.
+func bruteForce(c *mat.Dense) (cost float64, feasible bool) {
+	n, m := c.Dims()
+	if n > m {
+		return bruteForce(mat.DenseCopyOf(c.T()))
+	}
+	used := make([]bool, m)
+	best := math.Inf(1)
+	var rec func(i int, sum float64)
+	rec = func(i int, sum float64) {
+		if i == n {
+			best = math.Min(best, sum)
+			return
+		}
+		for j := 0; j < m; j++ {
+			v := c.At(i, j)
+			if used[j] || math.IsInf(v, 1) {
+				continue
+			}
+			used[j] = true
+			rec(i+1, sum+v)
+			used[j] = false
+		}
+	}
+	rec(0, 0)
+	return best, !math.IsInf(best, 1)
+}
@@ -0,0 +1,37 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package assign_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/assign"
+)
+
+func ExampleSolve() {
+	// The cost of assigning each of three workers (rows)
+	// to each of four jobs (columns).
+	c := mat.NewDense(3, 4, []float64{
+		4, 1, 3, 8,
+		2, 0, 5, 9,
+		3, 2, 2, 1,
+	})
+	res, err := assign.Solve(c)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for i, j := range res.RowCol {
+		fmt.Printf("worker %d: job %d\n", i, j)
+	}
+	fmt.Println("cost:", res.Cost)
+
+	// Output:
+	// worker 0: job 1
+	// worker 1: job 0
+	// worker 2: job 3
+	// cost: 4
+}
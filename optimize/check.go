@@ -0,0 +1,279 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/diff/fd"
+	"gonum.org/v1/gonum/mat"
+)
+
+// defaultCheckTolerance is the default tolerance used by
+// CheckGradient and CheckHessian.
+const defaultCheckTolerance = 1e-6
+
+// CheckSettings holds settings for CheckGradient, CheckHessian and
+// CheckScaling.
+type CheckSettings struct {
+	// Formula is the finite difference formula used to approximate the
+	// derivatives. It must be a formula for the first derivative. If
+	// Formula is the zero value, fd.Central is used.
+	Formula fd.Formula
+
+	// Step is the step size of the finite difference formula. If Step
+	// is zero, the default step size of Formula is used.
+	Step float64
+
+	// Tolerance is the largest error between an element of a supplied
+	// derivative and its approximation that is not reported as bad. The
+	// error is computed as
+	//
+	//	|d - a| / max(1, |d|, |a|),
+	//
+	// where d is the supplied value and a is the approximation. If
+	// Tolerance is zero, a default of 1e-6 is used.
+	Tolerance float64
+}
+
+func (s *CheckSettings) fd() *fd.Settings {
+	settings := &fd.Settings{Formula: fd.Central}
+	if s != nil {
+		if s.Formula.Stencil != nil {
+			settings.Formula = s.Formula
+		}
+		settings.Step = s.Step
+	}
+	return settings
+}
+
+func (s *CheckSettings) tolerance() float64 {
+	if s == nil || s.Tolerance == 0 {
+		return defaultCheckTolerance
+	}
+	return s.Tolerance
+}
+
+// GradientCheck is the result of comparing the gradient returned by
+// Problem.Grad with a finite difference approximation.
+type GradientCheck struct {
+	// Grad is the gradient returned by Problem.Grad
+	// and Approx is its finite difference approximation.
+	Grad, Approx []float64
+
+	// Errors holds the error of each element of Grad
+	// as described for CheckSettings.Tolerance.
+	Errors []float64
+
+	// MaxError is the largest element of Errors
+	// and MaxIndex is its index.
+	MaxError float64
+	MaxIndex int
+
+	// Bad holds the indices of the elements of Grad
+	// with an error larger than the tolerance.
+	Bad []int
+}
+
+// OK returns whether all elements of the gradient agree with the
+// approximation within the tolerance.
+func (c GradientCheck) OK() bool { return len(c.Bad) == 0 }
+
+// CheckGradient compares the gradient returned by p.Grad at x with a finite
+// difference approximation computed from p.Func. Errors in user-supplied
+// gradients are a common cause of optimization failures, and CheckGradient
+// can be used to find them before calling Minimize. If settings is nil, the
+// default settings are used. See the documentation for CheckSettings for the
+// default values.
+//
+// CheckGradient will panic if p.Func or p.Grad is nil.
+func CheckGradient(p Problem, x []float64, settings *CheckSettings) GradientCheck {
+	if p.Func == nil {
+		panic(badProblem)
+	}
+	if p.Grad == nil {
+		panic("optimize: problem does not provide Grad function")
+	}
+	n := len(x)
+	c := GradientCheck{
+		Grad:   make([]float64, n),
+		Errors: make([]float64, n),
+	}
+	xCopy := make([]float64, n)
+	copy(xCopy, x)
+	p.Grad(c.Grad, xCopy)
+	c.Approx = fd.Gradient(nil, p.Func, x, settings.fd())
+
+	tol := settings.tolerance()
+	for i, g := range c.Grad {
+		e := derivativeError(g, c.Approx[i])
+		c.Errors[i] = e
+		if e > c.MaxError || math.IsNaN(e) {
+			c.MaxError = e
+			c.MaxIndex = i
+		}
+		if !(e <= tol) {
+			c.Bad = append(c.Bad, i)
+		}
+	}
+	return c
+}
+
+// HessianCheck is the result of comparing the Hessian returned by
+// Problem.Hess with a finite difference approximation.
+type HessianCheck struct {
+	// Hess is the Hessian returned by Problem.Hess
+	// and Approx is its finite difference approximation.
+	Hess, Approx *mat.SymDense
+
+	// MaxError is the largest error of an element of
+	// Hess as described for CheckSettings.Tolerance,
+	// and MaxRow and MaxCol are its indices.
+	MaxError       float64
+	MaxRow, MaxCol int
+
+	// Bad holds the row and column indices of the
+	// elements in the upper triangle of Hess with an
+	// error larger than the tolerance.
+	Bad [][2]int
+}
+
+// OK returns whether all elements of the Hessian agree with the
+// approximation within the tolerance.
+func (c HessianCheck) OK() bool { return len(c.Bad) == 0 }
+
+// CheckHessian compares the Hessian returned by p.Hess at x with a finite
+// difference approximation. If p.Grad is not nil, the approximation is
+// computed from finite differences of the gradient, otherwise it is computed
+// from second order finite differences of p.Func. If settings is nil, the
+// default settings are used. See the documentation for CheckSettings for the
+// default values.
+//
+// CheckHessian will panic if p.Func or p.Hess is nil.
+func CheckHessian(p Problem, x []float64, settings *CheckSettings) HessianCheck {
+	if p.Func == nil {
+		panic(badProblem)
+	}
+	if p.Hess == nil {
+		panic("optimize: problem does not provide Hess function")
+	}
+	n := len(x)
+	c := HessianCheck{
+		Hess:   mat.NewSymDense(n, nil),
+		Approx: approxHessian(p, x, settings),
+	}
+	xCopy := make([]float64, n)
+	copy(xCopy, x)
+	p.Hess(c.Hess, xCopy)
+
+	tol := settings.tolerance()
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			e := derivativeError(c.Hess.At(i, j), c.Approx.At(i, j))
+			if e > c.MaxError || math.IsNaN(e) {
+				c.MaxError = e
+				c.MaxRow, c.MaxCol = i, j
+			}
+			if !(e <= tol) {
+				c.Bad = append(c.Bad, [2]int{i, j})
+			}
+		}
+	}
+	return c
+}
+
+// ScaleCheck describes the scaling of the variables of a Problem.
+type ScaleCheck struct {
+	// Curvature holds the magnitude of the diagonal
+	// elements of the Hessian.
+	Curvature []float64
+
+	// Scale holds suggested typical magnitudes of the
+	// variables that are suitable for Problem.Scale.
+	// With these scales the diagonal elements of the
+	// Hessian of the scaled problem have unit magnitude.
+	// Variables with zero curvature have unit scale.
+	Scale []float64
+
+	// Ratio is the ratio of the largest to the smallest
+	// element of Curvature. Ratios much larger than one
+	// indicate a poorly scaled problem that may benefit
+	// from setting Problem.Scale. Ratio is +Inf if some
+	// but not all of the curvatures are zero, and NaN if
+	// all are zero.
+	Ratio float64
+}
+
+// CheckScaling returns a description of the scaling of p at x based on the
+// diagonal of the Hessian. If p.Hess is not nil, it is used to compute the
+// Hessian, otherwise the Hessian is approximated as described for
+// CheckHessian. Only the Formula and Step fields of settings are used. If
+// settings is nil, the default settings are used.
+//
+// CheckScaling will panic if p.Func is nil.
+func CheckScaling(p Problem, x []float64, settings *CheckSettings) ScaleCheck {
+	if p.Func == nil {
+		panic(badProblem)
+	}
+	n := len(x)
+	var hess *mat.SymDense
+	if p.Hess != nil {
+		hess = mat.NewSymDense(n, nil)
+		xCopy := make([]float64, n)
+		copy(xCopy, x)
+		p.Hess(hess, xCopy)
+	} else {
+		hess = approxHessian(p, x, settings)
+	}
+
+	c := ScaleCheck{
+		Curvature: make([]float64, n),
+		Scale:     make([]float64, n),
+	}
+	lo, hi := math.Inf(1), 0.0
+	for i := range c.Curvature {
+		d := math.Abs(hess.At(i, i))
+		c.Curvature[i] = d
+		if d == 0 {
+			c.Scale[i] = 1
+		} else {
+			c.Scale[i] = 1 / math.Sqrt(d)
+		}
+		lo = math.Min(lo, d)
+		hi = math.Max(hi, d)
+	}
+	c.Ratio = hi / lo
+	return c
+}
+
+// approxHessian returns a finite difference approximation of the Hessian of
+// p at x. If p.Grad is not nil, the approximation is computed from the
+// gradient and symmetrized.
+func approxHessian(p Problem, x []float64, settings *CheckSettings) *mat.SymDense {
+	n := len(x)
+	s := settings.fd()
+	hess := mat.NewSymDense(n, nil)
+	if p.Grad == nil {
+		fd.Hessian(hess, p.Func, x, s)
+		return hess
+	}
+	jac := mat.NewDense(n, n, nil)
+	fd.Jacobian(jac, func(y, x []float64) { p.Grad(y, x) }, x, &fd.JacobianSettings{
+		Formula: s.Formula,
+		Step:    s.Step,
+	})
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			hess.SetSym(i, j, (jac.At(i, j)+jac.At(j, i))/2)
+		}
+	}
+	return hess
+}
+
+// derivativeError returns the error between a supplied derivative value
+// d and its approximation a.
+func derivativeError(d, a float64) float64 {
+	return math.Abs(d-a) / math.Max(1, math.Max(math.Abs(d), math.Abs(a)))
+}
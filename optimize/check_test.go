@@ -0,0 +1,199 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/functions"
+)
+
+func TestCheckGradient(t *testing.T) {
+	t.Parallel()
+	x := []float64{-1.2, 1, 0.5, 2}
+	good := Problem{
+		Func: functions.ExtendedRosenbrock{}.Func,
+		Grad: functions.ExtendedRosenbrock{}.Grad,
+	}
+	c := CheckGradient(good, x, nil)
+	if !c.OK() {
+		t.Errorf("unexpected bad gradient elements %v: max error %v", c.Bad, c.MaxError)
+	}
+	if c.MaxError > 1e-8 {
+		t.Errorf("unexpected large error for correct gradient: %v", c.MaxError)
+	}
+
+	// Introduce a sign error in the third element.
+	bad := good
+	bad.Grad = func(grad, x []float64) {
+		functions.ExtendedRosenbrock{}.Grad(grad, x)
+		grad[2] = -grad[2]
+	}
+	c = CheckGradient(bad, x, nil)
+	if c.OK() || !reflect.DeepEqual(c.Bad, []int{2}) || c.MaxIndex != 2 {
+		t.Errorf("unexpected check of bad gradient: bad=%v max index=%d", c.Bad, c.MaxIndex)
+	}
+	if !floats.EqualApprox(c.Approx, CheckGradient(good, x, nil).Grad, 1e-6) {
+		t.Errorf("unexpected finite difference gradient: got:%v", c.Approx)
+	}
+
+	// A loose tolerance accepts the bad gradient.
+	c = CheckGradient(bad, x, &CheckSettings{Tolerance: 1e10})
+	if !c.OK() {
+		t.Errorf("unexpected bad gradient elements with loose tolerance: %v", c.Bad)
+	}
+
+	if !panics(func() { CheckGradient(Problem{Func: good.Func}, x, nil) }) {
+		t.Error("expected panic for missing gradient")
+	}
+}
+
+func TestCheckHessian(t *testing.T) {
+	t.Parallel()
+	x := []float64{0.1, -0.3, 0.7}
+	good := Problem{
+		Func: functions.Watson{}.Func,
+		Grad: functions.Watson{}.Grad,
+		Hess: functions.Watson{}.Hess,
+	}
+	for _, withGrad := range []bool{true, false} {
+		p := good
+		settings := &CheckSettings{Tolerance: 1e-5}
+		if !withGrad {
+			p.Grad = nil
+			// Second order differences of the function
+			// are less accurate than differences of the
+			// gradient.
+			settings.Tolerance = 1e-3
+		}
+		c := CheckHessian(p, x, settings)
+		if !c.OK() {
+			t.Errorf("unexpected bad Hessian elements with grad=%t: %v max error %v", withGrad, c.Bad, c.MaxError)
+		}
+
+		p.Hess = func(hess *mat.SymDense, x []float64) {
+			functions.Watson{}.Hess(hess, x)
+			hess.SetSym(0, 2, hess.At(0, 2)+1)
+		}
+		c = CheckHessian(p, x, settings)
+		if !reflect.DeepEqual(c.Bad, [][2]int{{0, 2}}) || c.MaxRow != 0 || c.MaxCol != 2 {
+			t.Errorf("unexpected check of bad Hessian with grad=%t: bad=%v max=(%d,%d)", withGrad, c.Bad, c.MaxRow, c.MaxCol)
+		}
+	}
+}
+
+func TestCheckScaling(t *testing.T) {
+	t.Parallel()
+	// f(x) = 1/2 \sum_i d_i x_i^2 has Hessian diag(d).
+	d := []float64{1e4, 1, 1e-2, 0}
+	p := Problem{
+		Func: func(x []float64) float64 {
+			var f float64
+			for i, v := range x {
+				f += d[i] * v * v / 2
+			}
+			return f
+		},
+		Grad: func(grad, x []float64) {
+			for i, v := range x {
+				grad[i] = d[i] * v
+			}
+		},
+	}
+	x := []float64{1, 2, 3, 4}
+	c := CheckScaling(p, x, nil)
+	if !floats.EqualApprox(c.Curvature, d, 1e-6) {
+		t.Errorf("unexpected curvature: got:%v want:%v", c.Curvature, d)
+	}
+	want := []float64{1e-2, 1, 10, 1}
+	if !floats.EqualApprox(c.Scale, want, 1e-6) {
+		t.Errorf("unexpected scale: got:%v want:%v", c.Scale, want)
+	}
+	if !math.IsInf(c.Ratio, 1) {
+		t.Errorf("unexpected ratio with zero curvature: got:%v want:+Inf", c.Ratio)
+	}
+
+	d[3] = 1e-2
+	c = CheckScaling(p, x, nil)
+	if math.Abs(c.Ratio-1e6) > 1e-3 {
+		t.Errorf("unexpected ratio: got:%v want:1e6", c.Ratio)
+	}
+}
+
+func TestMinimizeScale(t *testing.T) {
+	t.Parallel()
+	// A badly scaled quadratic with minimum at xMin.
+	d := []float64{1e6, 1, 1e-4}
+	xMin := []float64{1e-3, 2, 300}
+	p := Problem{
+		Func: func(x []float64) float64 {
+			var f float64
+			for i, v := range x {
+				f += d[i] * (v - xMin[i]) * (v - xMin[i]) / 2
+			}
+			return f
+		},
+		Grad: func(grad, x []float64) {
+			for i, v := range x {
+				grad[i] = d[i] * (v - xMin[i])
+			}
+		},
+		Hess: func(hess *mat.SymDense, x []float64) {
+			for i := range x {
+				for j := i; j < len(x); j++ {
+					hess.SetSym(i, j, 0)
+				}
+				hess.SetSym(i, i, d[i])
+			}
+		},
+	}
+	initX := []float64{0, 0, 0}
+	p.Scale = CheckScaling(p, initX, nil).Scale
+
+	for _, method := range []Method{&GradientDescent{}, &BFGS{}, &Newton{}} {
+		result, err := Minimize(p, initX, &Settings{GradientThreshold: 1e-10}, method)
+		if err != nil {
+			t.Errorf("unexpected error from %T: %v", method, err)
+			continue
+		}
+		if !floats.EqualApprox(result.X, xMin, 1e-6) {
+			t.Errorf("unexpected minimum location from %T: got:%v want:%v", method, result.X, xMin)
+		}
+		grad := make([]float64, len(xMin))
+		p.Grad(grad, result.X)
+		if !floats.EqualApprox(result.Gradient, grad, 1e-12) {
+			t.Errorf("unexpected gradient from %T: got:%v want:%v", method, result.Gradient, grad)
+		}
+	}
+
+	// Initial values are scaled consistently with the problem.
+	init := &Location{F: p.Func(initX), Gradient: make([]float64, 3)}
+	p.Grad(init.Gradient, initX)
+	result, err := Minimize(p, initX, &Settings{InitValues: init, GradientThreshold: 1e-10}, &BFGS{})
+	if err != nil {
+		t.Fatalf("unexpected error with initial values: %v", err)
+	}
+	if !floats.EqualApprox(result.X, xMin, 1e-6) {
+		t.Errorf("unexpected minimum location with initial values: got:%v want:%v", result.X, xMin)
+	}
+
+	p.Scale = []float64{1, 0, 1}
+	if !panics(func() { Minimize(p, initX, nil, nil) }) {
+		t.Error("expected panic for non-positive scale")
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		r := recover()
+		panicked = r != nil
+	}()
+	fn()
+	return
+}
@@ -0,0 +1,13 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package prox implements proximal operators and proximal gradient methods
+// for minimizing composite convex functions
+//
+//	f(x) + g(x)
+//
+// where f is smooth and g is a possibly non-smooth function with an
+// inexpensive proximal operator, such as a norm penalty or the indicator
+// function of a convex set.
+package prox // import "gonum.org/v1/gonum/optimize/convex/prox"
@@ -0,0 +1,48 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package prox_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/optimize/convex/prox"
+)
+
+func ExampleFISTA() {
+	// Find a sparse solution of an underdetermined system A⋅x = b by
+	// solving the lasso problem
+	//  minimize 1/2 |A⋅x - b|² + λ |x|_1
+	a := mat.NewDense(3, 5, []float64{
+		1, 0, 2, 0, 1,
+		0, 1, 1, 0, 0,
+		1, 1, 0, 1, 2,
+	})
+	b := mat.NewVecDense(3, []float64{2, 1, 3})
+	p := prox.Problem{
+		Func: func(x []float64) float64 {
+			var r mat.VecDense
+			r.MulVec(a, mat.NewVecDense(len(x), x))
+			r.SubVec(&r, b)
+			return mat.Dot(&r, &r) / 2
+		},
+		Grad: func(grad, x []float64) {
+			var r mat.VecDense
+			r.MulVec(a, mat.NewVecDense(len(x), x))
+			r.SubVec(&r, b)
+			mat.NewVecDense(len(grad), grad).MulVec(a.T(), &r)
+		},
+		Prox: prox.L1{Lambda: 0.01},
+	}
+	res, err := prox.FISTA(p, make([]float64, 5), nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("x = %.3f\n", res.X)
+
+	// Output:
+	// x = [0.000 0.595 0.399 0.000 1.200]
+}
@@ -0,0 +1,257 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package prox
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// feasTol is the tolerance used when checking membership of a convex set.
+const feasTol = 1e-10
+
+// Operator is a closed convex function g with its proximal operator
+//
+//	prox_{t·g}(x) = argmin_z g(z) + 1/(2t) |z - x|²
+type Operator interface {
+	// Prox stores prox_{t·g}(x) into dst for the step t > 0. dst and x
+	// may be the same slice.
+	Prox(dst, x []float64, t float64)
+
+	// Value returns g(x). Indicator functions of sets return +Inf
+	// for points outside the set.
+	Value(x []float64) float64
+}
+
+var (
+	_ Operator = Zero{}
+	_ Operator = L1{}
+	_ Operator = Box{}
+	_ Operator = Simplex{}
+	_ Operator = Nuclear{}
+)
+
+// Zero is the zero function, whose proximal operator is the identity. With
+// Zero the proximal gradient methods reduce to gradient descent.
+type Zero struct{}
+
+// Prox copies x into dst.
+func (Zero) Prox(dst, x []float64, _ float64) {
+	copy(dst, x)
+}
+
+// Value returns zero.
+func (Zero) Value([]float64) float64 {
+	return 0
+}
+
+// L1 is the scaled L1 norm
+//
+//	g(x) = Lambda * Σ_i |x_i|
+//
+// whose proximal operator is the soft-thresholding operator.
+type L1 struct {
+	Lambda float64
+}
+
+// Prox stores the soft-thresholded x into dst.
+func (l L1) Prox(dst, x []float64, t float64) {
+	if len(dst) != len(x) {
+		panic(badLength)
+	}
+	thresh := l.Lambda * t
+	for i, v := range x {
+		dst[i] = softThreshold(v, thresh)
+	}
+}
+
+// Value returns the scaled L1 norm of x.
+func (l L1) Value(x []float64) float64 {
+	return l.Lambda * floats.Norm(x, 1)
+}
+
+// Box is the indicator function of the box
+//
+//	Lower[i] <= x_i <= Upper[i]
+//
+// whose proximal operator is the Euclidean projection onto the box. A nil
+// Lower or Upper leaves the corresponding side unbounded.
+type Box struct {
+	Lower, Upper []float64
+}
+
+// Prox stores the projection of x onto the box into dst.
+func (b Box) Prox(dst, x []float64, _ float64) {
+	b.check(x)
+	if len(dst) != len(x) {
+		panic(badLength)
+	}
+	for i, v := range x {
+		if b.Lower != nil {
+			v = math.Max(v, b.Lower[i])
+		}
+		if b.Upper != nil {
+			v = math.Min(v, b.Upper[i])
+		}
+		dst[i] = v
+	}
+}
+
+// Value returns zero if x is in the box and +Inf otherwise.
+func (b Box) Value(x []float64) float64 {
+	b.check(x)
+	for i, v := range x {
+		if (b.Lower != nil && v < b.Lower[i]) || (b.Upper != nil && v > b.Upper[i]) {
+			return math.Inf(1)
+		}
+	}
+	return 0
+}
+
+func (b Box) check(x []float64) {
+	if (b.Lower != nil && len(b.Lower) != len(x)) || (b.Upper != nil && len(b.Upper) != len(x)) {
+		panic(badLength)
+	}
+}
+
+// Simplex is the indicator function of the scaled probability simplex
+//
+//	x_i >= 0, Σ_i x_i = Radius
+//
+// whose proximal operator is the Euclidean projection onto the simplex. If
+// Radius is zero, it is taken to be one.
+type Simplex struct {
+	Radius float64
+}
+
+// Prox stores the projection of x onto the simplex into dst.
+//
+// The projection is computed by the sorting method of
+//
+//	Duchi, J., et al. "Efficient projections onto the l1-ball for learning
+//	in high dimensions." Proceedings of the 25th International Conference on
+//	Machine Learning (2008)
+func (s Simplex) Prox(dst, x []float64, _ float64) {
+	if len(dst) != len(x) {
+		panic(badLength)
+	}
+	if len(x) == 0 {
+		return
+	}
+	r := s.radius()
+	u := make([]float64, len(x))
+	copy(u, x)
+	sort.Sort(sort.Reverse(sort.Float64Slice(u)))
+	var sum, theta float64
+	for j, v := range u {
+		sum += v
+		t := (sum - r) / float64(j+1)
+		if v-t <= 0 {
+			break
+		}
+		theta = t
+	}
+	for i, v := range x {
+		dst[i] = math.Max(v-theta, 0)
+	}
+}
+
+// Value returns zero if x is in the simplex and +Inf otherwise.
+func (s Simplex) Value(x []float64) float64 {
+	r := s.radius()
+	var sum float64
+	for _, v := range x {
+		if v < 0 {
+			return math.Inf(1)
+		}
+		sum += v
+	}
+	if math.Abs(sum-r) > feasTol*math.Max(1, r) {
+		return math.Inf(1)
+	}
+	return 0
+}
+
+func (s Simplex) radius() float64 {
+	if s.Radius == 0 {
+		return 1
+	}
+	if s.Radius < 0 {
+		panic("prox: negative simplex radius")
+	}
+	return s.Radius
+}
+
+// Nuclear is the scaled nuclear norm of a Rows×Cols matrix X stored in
+// row-major order in x
+//
+//	g(x) = Lambda * Σ_i σ_i(X)
+//
+// where σ_i(X) are the singular values of X. Its proximal operator
+// soft-thresholds the singular values and is used to find low-rank
+// solutions.
+type Nuclear struct {
+	Lambda     float64
+	Rows, Cols int
+}
+
+// Prox stores the result of soft-thresholding the singular values of the
+// matrix held in x into dst.
+func (n Nuclear) Prox(dst, x []float64, t float64) {
+	if len(dst) != len(x) {
+		panic(badLength)
+	}
+	var svd mat.SVD
+	if !svd.Factorize(n.matrix(x), mat.SVDThin) {
+		panic("prox: singular value decomposition failed")
+	}
+	var u, v mat.Dense
+	svd.UTo(&u)
+	svd.VTo(&v)
+	s := svd.Values(nil)
+	thresh := n.Lambda * t
+	for i, sv := range s {
+		s[i] = math.Max(sv-thresh, 0)
+	}
+	// X = U * diag(s) * Vᵀ
+	for j, sv := range s {
+		for i := 0; i < n.Rows; i++ {
+			u.Set(i, j, u.At(i, j)*sv)
+		}
+	}
+	res := mat.NewDense(n.Rows, n.Cols, dst)
+	res.Mul(&u, v.T())
+}
+
+// Value returns the scaled nuclear norm of the matrix held in x.
+func (n Nuclear) Value(x []float64) float64 {
+	var svd mat.SVD
+	if !svd.Factorize(n.matrix(x), mat.SVDNone) {
+		panic("prox: singular value decomposition failed")
+	}
+	return n.Lambda * floats.Sum(svd.Values(nil))
+}
+
+func (n Nuclear) matrix(x []float64) *mat.Dense {
+	if n.Rows*n.Cols != len(x) {
+		panic(badLength)
+	}
+	return mat.NewDense(n.Rows, n.Cols, x)
+}
+
+// softThreshold returns sign(v) * max(|v| - thresh, 0).
+func softThreshold(v, thresh float64) float64 {
+	switch {
+	case v > thresh:
+		return v - thresh
+	case v < -thresh:
+		return v + thresh
+	default:
+		return 0
+	}
+}
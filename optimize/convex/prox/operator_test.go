@@ -0,0 +1,132 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package prox
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestOperators(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 12} {
+		lower := make([]float64, n)
+		upper := make([]float64, n)
+		for i := range lower {
+			lower[i] = -rnd.Float64()
+			upper[i] = rnd.Float64()
+		}
+		rows := 3
+		if n%rows != 0 {
+			rows = 1
+		}
+		for _, test := range []struct {
+			name string
+			op   Operator
+		}{
+			{name: "Zero", op: Zero{}},
+			{name: "L1", op: L1{Lambda: 0.7}},
+			{name: "Box", op: Box{Lower: lower, Upper: upper}},
+			{name: "BoxLower", op: Box{Lower: lower}},
+			{name: "Simplex", op: Simplex{}},
+			{name: "Simplex2", op: Simplex{Radius: 2}},
+			{name: "Nuclear", op: Nuclear{Lambda: 0.5, Rows: rows, Cols: n / rows}},
+		} {
+			for trial := 0; trial < 10; trial++ {
+				name := fmt.Sprintf("%s,n=%d,trial=%d", test.name, n, trial)
+				x := make([]float64, n)
+				for i := range x {
+					x[i] = 2 * rnd.NormFloat64()
+				}
+				step := 0.1 + rnd.Float64()
+				p := make([]float64, n)
+				test.op.Prox(p, x, step)
+				if v := test.op.Value(p); math.IsInf(v, 1) {
+					t.Errorf("%s: prox result not in domain", name)
+					continue
+				}
+
+				// The proximal point minimizes
+				//  h(z) = g(z) + |z-x|²/(2t)
+				// so no other point in the domain,
+				// including points on segments towards
+				// the proximal point, may be better.
+				h := func(z []float64) float64 {
+					d := make([]float64, n)
+					floats.SubTo(d, z, x)
+					return test.op.Value(z) + floats.Dot(d, d)/(2*step)
+				}
+				hp := h(p)
+				for k := 0; k < 10; k++ {
+					q := make([]float64, n)
+					for i := range q {
+						q[i] = 2 * rnd.NormFloat64()
+					}
+					test.op.Prox(q, q, 1)
+					for _, alpha := range []float64{1, 0.1, 1e-3} {
+						w := make([]float64, n)
+						for i := range w {
+							w[i] = p[i] + alpha*(q[i]-p[i])
+						}
+						if hw := h(w); hw < hp-tol*math.Max(1, math.Abs(hp)) {
+							t.Errorf("%s: prox result is not optimal: h(prox)=%v, h(w)=%v", name, hp, hw)
+						}
+					}
+				}
+
+				// Prox must work in place.
+				inPlace := make([]float64, n)
+				copy(inPlace, x)
+				test.op.Prox(inPlace, inPlace, step)
+				if !floats.EqualApprox(inPlace, p, tol) {
+					t.Errorf("%s: in-place prox differs", name)
+				}
+			}
+		}
+	}
+}
+
+func TestOperatorKnownValues(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	for _, test := range []struct {
+		name string
+		op   Operator
+		x    []float64
+		step float64
+		want []float64
+	}{
+		{name: "L1", op: L1{Lambda: 1}, x: []float64{3, -0.5, -2, 0.25}, step: 0.5, want: []float64{2.5, 0, -1.5, 0}},
+		{name: "Box", op: Box{Lower: []float64{0, 0, 0}, Upper: []float64{1, 1, 1}}, x: []float64{-1, 0.5, 2}, step: 1, want: []float64{0, 0.5, 1}},
+		{name: "Simplex", op: Simplex{}, x: []float64{0.5, 0.5, 0.5}, step: 1, want: []float64{1.0 / 3, 1.0 / 3, 1.0 / 3}},
+		{name: "Simplex corner", op: Simplex{}, x: []float64{3, 0, -1}, step: 1, want: []float64{1, 0, 0}},
+		{name: "Nuclear diagonal", op: Nuclear{Lambda: 1, Rows: 2, Cols: 2}, x: []float64{3, 0, 0, 0.5}, step: 1, want: []float64{2, 0, 0, 0}},
+	} {
+		got := make([]float64, len(test.x))
+		test.op.Prox(got, test.x, test.step)
+		if !floats.EqualApprox(got, test.want, tol) {
+			t.Errorf("%s: unexpected prox result: got %v, want %v", test.name, got, test.want)
+		}
+	}
+
+	// The nuclear norm of a rank one matrix is the product of the
+	// norms of its factors.
+	u := []float64{1, 2, 2}
+	v := []float64{3, 4}
+	var a mat.Dense
+	a.Outer(1, mat.NewVecDense(3, u), mat.NewVecDense(2, v))
+	got := Nuclear{Lambda: 2, Rows: 3, Cols: 2}.Value(a.RawMatrix().Data)
+	if want := 2 * 3.0 * 5; math.Abs(got-want) > 1e-12 {
+		t.Errorf("unexpected nuclear norm: got %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package prox
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+const badLength = "prox: slice length mismatch"
+
+var (
+	ErrMaxIterations = errors.New("prox: maximum number of iterations reached")
+	ErrFunc          = errors.New("prox: function value is NaN or infinite")
+	ErrStep          = errors.New("prox: step size underflow")
+)
+
+// Problem is a composite minimization problem
+//
+//	minimize f(x) + g(x)
+//
+// where f is convex and differentiable with a Lipschitz continuous gradient
+// and g is a closed convex function with an inexpensive proximal operator.
+type Problem struct {
+	// Func evaluates the smooth function f at x.
+	Func func(x []float64) float64
+
+	// Grad evaluates the gradient of f at x and stores it into grad.
+	Grad func(grad, x []float64)
+
+	// Prox is the non-smooth function g. If Prox is nil, g is zero.
+	Prox Operator
+}
+
+// Settings holds the settings of the proximal gradient methods.
+type Settings struct {
+	// Step is the initial step size, an estimate of 1/L where L is the
+	// Lipschitz constant of the gradient of f. If Step is zero, it is
+	// taken to be one.
+	Step float64
+
+	// Shrink is the factor by which the step size is decreased during
+	// backtracking. If Shrink is zero, it is taken to be 0.5.
+	Shrink float64
+
+	// Tolerance is the convergence tolerance on the infinity norm of
+	// the gradient mapping
+	//
+	//	(y - prox_{t·g}(y - t ∇f(y))) / t
+	//
+	// which is zero exactly at the minimizers of f + g. If Tolerance is
+	// zero, it is taken to be 1e-8.
+	Tolerance float64
+
+	// MaxIterations is the maximum number of iterations. If
+	// MaxIterations is zero, it is taken to be 10000.
+	MaxIterations int
+}
+
+// Result holds the result of a proximal gradient method.
+type Result struct {
+	// X is the final location.
+	X []float64
+
+	// F is the value of f + g at X.
+	F float64
+
+	// Step is the final step size.
+	Step float64
+
+	// Iterations, FuncEvaluations and GradEvaluations are the number
+	// of iterations and of evaluations of f and its gradient.
+	Iterations      int
+	FuncEvaluations int
+	GradEvaluations int
+}
+
+// ProximalGradient minimizes f + g starting from x0 using the proximal
+// gradient method, also known as ISTA, with a backtracking line search. When
+// g is the indicator function of a convex set, such as Box or Simplex, this
+// is the projected gradient method. x0 is not modified and settings may be
+// nil, in which case the default settings are used.
+//
+// The returned Result is non-nil if the starting location is valid. If the
+// maximum number of iterations is reached, the last iterate is returned
+// along with ErrMaxIterations.
+func ProximalGradient(p Problem, x0 []float64, settings *Settings) (*Result, error) {
+	return minimize(p, x0, settings, false)
+}
+
+// FISTA minimizes f + g starting from x0 using the fast iterative
+// shrinkage-thresholding algorithm with a backtracking line search, as
+// described in
+//
+//	Beck, A., and Teboulle, M. "A fast iterative shrinkage-thresholding
+//	algorithm for linear inverse problems." SIAM Journal on Imaging
+//	Sciences 2.1 (2009)
+//
+// FISTA converges at the rate O(1/k²) in the objective value compared to
+// O(1/k) for ProximalGradient. x0 is not modified and settings may be nil,
+// in which case the default settings are used.
+//
+// The returned Result is non-nil if the starting location is valid. If the
+// maximum number of iterations is reached, the last iterate is returned
+// along with ErrMaxIterations.
+func FISTA(p Problem, x0 []float64, settings *Settings) (*Result, error) {
+	return minimize(p, x0, settings, true)
+}
+
+func minimize(p Problem, x0 []float64, settings *Settings, accelerate bool) (*Result, error) {
+	if p.Func == nil || p.Grad == nil {
+		panic("prox: problem must have Func and Grad")
+	}
+	g := p.Prox
+	if g == nil {
+		g = Zero{}
+	}
+	var s Settings
+	if settings != nil {
+		s = *settings
+	}
+	if s.Step == 0 {
+		s.Step = 1
+	}
+	if s.Shrink == 0 {
+		s.Shrink = 0.5
+	}
+	if s.Tolerance == 0 {
+		s.Tolerance = 1e-8
+	}
+	if s.MaxIterations == 0 {
+		s.MaxIterations = 10000
+	}
+	if s.Step < 0 || s.Shrink <= 0 || s.Shrink >= 1 || s.Tolerance < 0 || s.MaxIterations < 0 {
+		panic("prox: invalid settings")
+	}
+
+	n := len(x0)
+	res := &Result{Step: s.Step}
+
+	// x is the current iterate and y is the point at which the proximal
+	// gradient step is taken. Without acceleration y is equal to x.
+	x := make([]float64, n)
+	g.Prox(x, x0, s.Step)
+	y := make([]float64, n)
+	copy(y, x)
+	xPrev := make([]float64, n)
+	z := make([]float64, n)
+	grad := make([]float64, n)
+	diff := make([]float64, n)
+
+	fy := p.Func(y)
+	res.FuncEvaluations++
+	if math.IsNaN(fy) || math.IsInf(fy, 0) {
+		return nil, ErrFunc
+	}
+	theta := 1.0
+	var fz float64
+	for {
+		if res.Iterations == s.MaxIterations {
+			res.X = x
+			res.F = p.Func(x) + g.Value(x)
+			res.FuncEvaluations++
+			return res, ErrMaxIterations
+		}
+		res.Iterations++
+
+		p.Grad(grad, y)
+		res.GradEvaluations++
+
+		// Backtrack until the quadratic model at y majorizes f at
+		// the proximal gradient step z.
+		for {
+			floats.AddScaledTo(z, y, -res.Step, grad)
+			g.Prox(z, z, res.Step)
+			fz = p.Func(z)
+			res.FuncEvaluations++
+			floats.SubTo(diff, z, y)
+			model := fy + floats.Dot(grad, diff) + floats.Dot(diff, diff)/(2*res.Step)
+			if fz <= model+1e-12*math.Abs(fy) {
+				break
+			}
+			res.Step *= s.Shrink
+			if res.Step == 0 {
+				res.X = x
+				res.F = p.Func(x) + g.Value(x)
+				res.FuncEvaluations++
+				return res, ErrStep
+			}
+		}
+		if math.IsNaN(fz) || math.IsInf(fz, 0) {
+			return nil, ErrFunc
+		}
+
+		copy(xPrev, x)
+		copy(x, z)
+		if floats.Norm(diff, math.Inf(1))/res.Step <= s.Tolerance {
+			res.X = x
+			res.F = fz + g.Value(x)
+			return res, nil
+		}
+
+		if !accelerate {
+			copy(y, x)
+			fy = fz
+			continue
+		}
+		// Extrapolate the next point from the last two iterates.
+		thetaNext := (1 + math.Sqrt(1+4*theta*theta)) / 2
+		beta := (theta - 1) / thetaNext
+		theta = thetaNext
+		for i := range y {
+			y[i] = x[i] + beta*(x[i]-xPrev[i])
+		}
+		fy = p.Func(y)
+		res.FuncEvaluations++
+		if math.IsNaN(fy) || math.IsInf(fy, 0) {
+			return nil, ErrFunc
+		}
+	}
+}
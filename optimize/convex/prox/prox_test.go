@@ -0,0 +1,187 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package prox
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+var methods = []struct {
+	name string
+	fn   func(Problem, []float64, *Settings) (*Result, error)
+}{
+	{name: "ProximalGradient", fn: ProximalGradient},
+	{name: "FISTA", fn: FISTA},
+}
+
+// leastSquares returns the problem 1/2 |A⋅x - b|² with the given g.
+func leastSquares(a *mat.Dense, b *mat.VecDense, g Operator) Problem {
+	m, _ := a.Dims()
+	return Problem{
+		Func: func(x []float64) float64 {
+			var r mat.VecDense
+			r.MulVec(a, mat.NewVecDense(len(x), x))
+			r.SubVec(&r, b)
+			return mat.Dot(&r, &r) / 2
+		},
+		Grad: func(grad, x []float64) {
+			r := mat.NewVecDense(m, nil)
+			r.MulVec(a, mat.NewVecDense(len(x), x))
+			r.SubVec(r, b)
+			mat.NewVecDense(len(grad), grad).MulVec(a.T(), r)
+		},
+		Prox: g,
+	}
+}
+
+func randomLeastSquares(m, n int, rnd *rand.Rand) (*mat.Dense, *mat.VecDense) {
+	a := mat.NewDense(m, n, nil)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			a.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	b := mat.NewVecDense(m, nil)
+	for i := 0; i < m; i++ {
+		b.SetVec(i, rnd.NormFloat64())
+	}
+	return a, b
+}
+
+func TestLasso(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-6
+	rnd := rand.New(rand.NewSource(1))
+	for _, size := range [][2]int{{10, 5}, {20, 40}} {
+		m, n := size[0], size[1]
+		a, b := randomLeastSquares(m, n, rnd)
+		const lambda = 1.0
+		p := leastSquares(a, b, L1{Lambda: lambda})
+		for _, method := range methods {
+			name := fmt.Sprintf("%s,m=%d,n=%d", method.name, m, n)
+			res, err := method.fn(p, make([]float64, n), &Settings{Step: 10})
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			// Check the optimality conditions
+			//  |Aᵀ(A⋅x - b)|_i <= λ, with equality and
+			//  opposite sign to x_i if x_i != 0.
+			grad := make([]float64, n)
+			p.Grad(grad, res.X)
+			for i, xi := range res.X {
+				switch {
+				case xi == 0:
+					if math.Abs(grad[i]) > lambda+tol {
+						t.Errorf("%s: optimality violated for zero element %d: |grad|=%v", name, i, math.Abs(grad[i]))
+					}
+				default:
+					if math.Abs(grad[i]+lambda*math.Copysign(1, xi)) > tol {
+						t.Errorf("%s: optimality violated for element %d: grad=%v, x=%v", name, i, grad[i], xi)
+					}
+				}
+			}
+			if want := p.Func(res.X) + p.Prox.Value(res.X); math.Abs(res.F-want) > 1e-12*math.Max(1, want) {
+				t.Errorf("%s: unexpected F: got %v, want %v", name, res.F, want)
+			}
+		}
+	}
+}
+
+func TestProjected(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-6
+	rnd := rand.New(rand.NewSource(1))
+	const m, n = 15, 6
+	a, b := randomLeastSquares(m, n, rnd)
+	lower := make([]float64, n)
+	upper := make([]float64, n)
+	for i := range upper {
+		lower[i] = -0.1
+		upper[i] = 0.1
+	}
+	for _, g := range []Operator{Box{Lower: lower, Upper: upper}, Simplex{}} {
+		p := leastSquares(a, b, g)
+		var want []float64
+		for _, method := range methods {
+			name := fmt.Sprintf("%s,%T", method.name, g)
+			res, err := method.fn(p, make([]float64, n), nil)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			if math.IsInf(g.Value(res.X), 1) {
+				t.Errorf("%s: solution not feasible", name)
+			}
+			// At the solution, a projected gradient step
+			// does not move.
+			grad := make([]float64, n)
+			p.Grad(grad, res.X)
+			step := make([]float64, n)
+			floats.AddScaledTo(step, res.X, -1, grad)
+			g.Prox(step, step, 1)
+			if !floats.EqualApprox(step, res.X, tol) {
+				t.Errorf("%s: solution is not a fixed point of the projected gradient step", name)
+			}
+			if want == nil {
+				want = res.X
+			} else if !floats.EqualApprox(res.X, want, 1e-5) {
+				t.Errorf("%s: solution differs between methods", name)
+			}
+		}
+	}
+}
+
+func TestFISTAFaster(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	// An ill-conditioned problem where acceleration helps.
+	const m, n = 50, 30
+	a, b := randomLeastSquares(m, n, rnd)
+	for j := 0; j < n; j++ {
+		for i := 0; i < m; i++ {
+			a.Set(i, j, a.At(i, j)*math.Pow(10, -2*float64(j)/n))
+		}
+	}
+	p := leastSquares(a, b, L1{Lambda: 0.1})
+	settings := &Settings{Tolerance: 1e-6, MaxIterations: 100000}
+	ista, err := ProximalGradient(p, make([]float64, n), settings)
+	if err != nil {
+		t.Fatalf("unexpected error from ProximalGradient: %v", err)
+	}
+	fista, err := FISTA(p, make([]float64, n), settings)
+	if err != nil {
+		t.Fatalf("unexpected error from FISTA: %v", err)
+	}
+	if fista.Iterations >= ista.Iterations {
+		t.Errorf("FISTA not faster than ProximalGradient: %d >= %d iterations", fista.Iterations, ista.Iterations)
+	}
+	if math.Abs(fista.F-ista.F) > 1e-6*math.Max(1, ista.F) {
+		t.Errorf("objective values differ: FISTA %v, ProximalGradient %v", fista.F, ista.F)
+	}
+}
+
+func TestMaxIterations(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	a, b := randomLeastSquares(10, 5, rnd)
+	p := leastSquares(a, b, nil)
+	for _, method := range methods {
+		res, err := method.fn(p, make([]float64, 5), &Settings{MaxIterations: 2})
+		if err != ErrMaxIterations {
+			t.Errorf("%s: unexpected error: got %v, want %v", method.name, err, ErrMaxIterations)
+		}
+		if res == nil || res.Iterations != 2 {
+			t.Errorf("%s: unexpected result %+v", method.name, res)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package manifold implements methods for the minimization of smooth
+// functions over Riemannian manifolds embedded in a Euclidean space,
+//
+//	minimize f(x) subject to x ∈ M
+//
+// such as the unit sphere, the Stiefel manifold of matrices with orthonormal
+// columns and the groups of rotations and rigid body motions in three
+// dimensions. These constraints arise in eigenvalue problems, rotation
+// averaging and pose-graph optimization.
+//
+// The methods only require the Euclidean gradient of f and the Manifold
+// operations of projection onto a tangent space and retraction from a
+// tangent space back onto the manifold. See
+//
+//	Absil, P.-A., Mahony, R., and Sepulchre, R. "Optimization Algorithms
+//	on Matrix Manifolds." Princeton University Press (2008)
+//
+// for an introduction.
+package manifold // import "gonum.org/v1/gonum/optimize/manifold"
@@ -0,0 +1,62 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package manifold_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"gonum.org/v1/gonum/optimize/manifold"
+)
+
+func ExampleConjugateGradient() {
+	// Find the rotation R that best averages a set of measured rotations
+	// R_k by minimizing the chordal distance
+	//  Σ_k |R - R_k|²_F
+	// over the rotation group SO(3).
+	rotZ := func(deg float64) []float64 {
+		s, c := math.Sincos(deg * math.Pi / 180)
+		return []float64{
+			c, -s, 0,
+			s, c, 0,
+			0, 0, 1,
+		}
+	}
+	measured := [][]float64{rotZ(80), rotZ(85), rotZ(95), rotZ(100)}
+
+	p := manifold.Problem{
+		Func: func(x []float64) float64 {
+			var f float64
+			for _, r := range measured {
+				for i, v := range r {
+					f += (x[i] - v) * (x[i] - v)
+				}
+			}
+			return f
+		},
+		Grad: func(grad, x []float64) {
+			for i := range grad {
+				grad[i] = 0
+			}
+			for _, r := range measured {
+				for i, v := range r {
+					grad[i] += 2 * (x[i] - v)
+				}
+			}
+		},
+		Manifold: manifold.SO3{},
+	}
+	identity := []float64{1, 0, 0, 0, 1, 0, 0, 0, 1}
+	res, err := manifold.ConjugateGradient(p, identity, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	angle := math.Atan2(res.X[3], res.X[0]) * 180 / math.Pi
+	fmt.Printf("rotation about z by %.3f degrees\n", angle)
+
+	// Output:
+	// rotation about z by 90.000 degrees
+}
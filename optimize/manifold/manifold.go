@@ -0,0 +1,289 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package manifold
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+const badLength = "manifold: slice length mismatch"
+
+// Manifold is a Riemannian submanifold of a Euclidean space. Points on the
+// manifold and tangent vectors are represented by slices of the length of
+// the ambient space, and the Riemannian metric is the Euclidean inner
+// product restricted to the tangent spaces.
+type Manifold interface {
+	// Len returns the dimension of the ambient space, the length of
+	// the slices representing points and tangent vectors.
+	Len() int
+
+	// Project stores into dst the orthogonal projection of the ambient
+	// vector v onto the tangent space at x. Projecting the Euclidean
+	// gradient of a function gives its Riemannian gradient, and
+	// projecting a tangent vector at another point transports it to
+	// the tangent space at x. dst may alias x or v.
+	Project(dst, x, v []float64)
+
+	// Retract stores into dst the point on the manifold reached by
+	// moving from x along the tangent vector v at x. The curve
+	// t ↦ Retract(x, t·v) must pass through x at t = 0 with velocity v.
+	// dst may alias x or v.
+	Retract(dst, x, v []float64)
+}
+
+// Sphere is the unit sphere in ℝ^N, the set of vectors with a Euclidean
+// norm of one.
+type Sphere struct {
+	N int
+}
+
+// Len returns N.
+func (s Sphere) Len() int { return s.N }
+
+// Project stores into dst the projection v - (xᵀv) x of v onto the tangent
+// space at x.
+func (s Sphere) Project(dst, x, v []float64) {
+	s.check(dst, x, v)
+	d := floats.Dot(x, v)
+	for i, xi := range x {
+		dst[i] = v[i] - d*xi
+	}
+}
+
+// Retract stores into dst the normalization of x + v.
+func (s Sphere) Retract(dst, x, v []float64) {
+	s.check(dst, x, v)
+	floats.AddTo(dst, x, v)
+	floats.Scale(1/floats.Norm(dst, 2), dst)
+}
+
+func (s Sphere) check(dst, x, v []float64) {
+	if len(dst) != s.N || len(x) != s.N || len(v) != s.N {
+		panic(badLength)
+	}
+}
+
+// Stiefel is the Stiefel manifold of N×P matrices with orthonormal columns,
+// XᵀX = I, with P ≤ N. Points and tangent vectors are stored as N×P
+// matrices in row-major order. The Stiefel manifold with P = 1 is the unit
+// sphere and with P = N is the orthogonal group.
+type Stiefel struct {
+	N, P int
+}
+
+// Len returns N×P.
+func (s Stiefel) Len() int { return s.N * s.P }
+
+// Project stores into dst the projection V - X sym(XᵀV) of V onto the
+// tangent space at X, where sym(A) = (A + Aᵀ)/2.
+func (s Stiefel) Project(dst, x, v []float64) {
+	s.check(dst, x, v)
+	xm := mat.NewDense(s.N, s.P, x)
+	vm := mat.NewDense(s.N, s.P, v)
+	var xtv mat.Dense
+	xtv.Mul(xm.T(), vm)
+	sym := mat.NewDense(s.P, s.P, nil)
+	sym.Add(&xtv, xtv.T())
+	sym.Scale(0.5, sym)
+	var p mat.Dense
+	p.Mul(xm, sym)
+	p.Sub(vm, &p)
+	copy(dst, p.RawMatrix().Data)
+}
+
+// Retract stores into dst the orthonormal factor Q of the QR factorization
+// of X + V, with the signs chosen so that the diagonal of R is positive.
+func (s Stiefel) Retract(dst, x, v []float64) {
+	s.check(dst, x, v)
+	a := mat.NewDense(s.N, s.P, nil)
+	floats.AddTo(a.RawMatrix().Data, x, v)
+	var qr mat.QR
+	qr.Factorize(a)
+	var q, r mat.Dense
+	qr.QTo(&q)
+	qr.RTo(&r)
+	for i := 0; i < s.N; i++ {
+		for j := 0; j < s.P; j++ {
+			dst[i*s.P+j] = math.Copysign(1, r.At(j, j)) * q.At(i, j)
+		}
+	}
+}
+
+func (s Stiefel) check(dst, x, v []float64) {
+	if s.P < 1 || s.P > s.N {
+		panic("manifold: invalid Stiefel dimensions")
+	}
+	n := s.Len()
+	if len(dst) != n || len(x) != n || len(v) != n {
+		panic(badLength)
+	}
+}
+
+// SO3 is the special orthogonal group of 3×3 rotation matrices, RᵀR = I
+// with det(R) = 1. Points and tangent vectors are stored as 3×3 matrices
+// in row-major order.
+type SO3 struct{}
+
+// Len returns 9.
+func (SO3) Len() int { return 9 }
+
+// Project stores into dst the projection R skew(RᵀV) of V onto the tangent
+// space at R, where skew(A) = (A - Aᵀ)/2.
+func (SO3) Project(dst, x, v []float64) {
+	if len(dst) != 9 || len(x) != 9 || len(v) != 9 {
+		panic(badLength)
+	}
+	var omega [9]float64
+	skewRtV(&omega, x, v)
+	var p [9]float64
+	mul3(&p, x, omega[:])
+	copy(dst, p[:])
+}
+
+// Retract stores into dst the rotation R exp(Ω) where Ω is the skew-symmetric
+// part of RᵀV, computed by the Rodrigues formula.
+func (SO3) Retract(dst, x, v []float64) {
+	if len(dst) != 9 || len(x) != 9 || len(v) != 9 {
+		panic(badLength)
+	}
+	var omega, e, r [9]float64
+	skewRtV(&omega, x, v)
+	expSO3(&e, omega[2*3+1], omega[0*3+2], omega[1*3+0])
+	mul3(&r, x, e[:])
+	copy(dst, r[:])
+}
+
+// SE3 is the special Euclidean group of rigid body motions in three
+// dimensions, x ↦ R x + t, with the product metric of SO(3) × ℝ³. Points
+// and tangent vectors are stored as the 3×3 rotation part in row-major
+// order followed by the translation part, giving a length of 12.
+type SE3 struct{}
+
+// Len returns 12.
+func (SE3) Len() int { return 12 }
+
+// Project stores into dst the projection of v onto the tangent space at x.
+// The rotation part is projected as in SO3 and the translation part is
+// unchanged.
+func (SE3) Project(dst, x, v []float64) {
+	if len(dst) != 12 || len(x) != 12 || len(v) != 12 {
+		panic(badLength)
+	}
+	SO3{}.Project(dst[:9], x[:9], v[:9])
+	copy(dst[9:], v[9:])
+}
+
+// Retract stores into dst the point reached from x along v. The rotation
+// part is retracted as in SO3 and v is added to the translation part.
+func (SE3) Retract(dst, x, v []float64) {
+	if len(dst) != 12 || len(x) != 12 || len(v) != 12 {
+		panic(badLength)
+	}
+	SO3{}.Retract(dst[:9], x[:9], v[:9])
+	floats.AddTo(dst[9:], x[9:], v[9:])
+}
+
+// Product is the Cartesian product of manifolds. Points and tangent vectors
+// are stored as the concatenation of the points and tangent vectors of each
+// manifold in order. For example, the poses of a pose graph with n nodes are
+// points on the product of n copies of SE3.
+type Product []Manifold
+
+// Len returns the sum of the lengths of the manifolds.
+func (p Product) Len() int {
+	var n int
+	for _, m := range p {
+		n += m.Len()
+	}
+	return n
+}
+
+// Project projects each part of v onto the tangent space of the
+// corresponding manifold.
+func (p Product) Project(dst, x, v []float64) {
+	p.apply(dst, x, v, Manifold.Project)
+}
+
+// Retract retracts each part of x along the corresponding part of v.
+func (p Product) Retract(dst, x, v []float64) {
+	p.apply(dst, x, v, Manifold.Retract)
+}
+
+func (p Product) apply(dst, x, v []float64, fn func(m Manifold, dst, x, v []float64)) {
+	n := p.Len()
+	if len(dst) != n || len(x) != n || len(v) != n {
+		panic(badLength)
+	}
+	var off int
+	for _, m := range p {
+		end := off + m.Len()
+		fn(m, dst[off:end], x[off:end], v[off:end])
+		off = end
+	}
+}
+
+// skewRtV stores into dst the skew-symmetric part of Rᵀ V for 3×3 matrices.
+func skewRtV(dst *[9]float64, r, v []float64) {
+	var a [9]float64
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			var s float64
+			for k := 0; k < 3; k++ {
+				s += r[k*3+i] * v[k*3+j]
+			}
+			a[i*3+j] = s
+		}
+	}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			dst[i*3+j] = (a[i*3+j] - a[j*3+i]) / 2
+		}
+	}
+}
+
+// mul3 stores into dst the product of the 3×3 matrices a and b.
+func mul3(dst *[9]float64, a, b []float64) {
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			var s float64
+			for k := 0; k < 3; k++ {
+				s += a[i*3+k] * b[k*3+j]
+			}
+			dst[i*3+j] = s
+		}
+	}
+}
+
+// expSO3 stores into dst the rotation matrix exp(K) where K is the
+// skew-symmetric matrix of the axis-angle vector w = (w1, w2, w3).
+func expSO3(dst *[9]float64, w1, w2, w3 float64) {
+	theta2 := w1*w1 + w2*w2 + w3*w3
+	theta := math.Sqrt(theta2)
+	// exp(K) = I + a K + b K² by the Rodrigues formula.
+	var a, b float64
+	if theta < 1e-4 {
+		a = 1 - theta2/6
+		b = 0.5 - theta2/24
+	} else {
+		a = math.Sin(theta) / theta
+		b = (1 - math.Cos(theta)) / theta2
+	}
+	k := [9]float64{
+		0, -w3, w2,
+		w3, 0, -w1,
+		-w2, w1, 0,
+	}
+	var k2 [9]float64
+	mul3(&k2, k[:], k[:])
+	for i := range dst {
+		dst[i] = a*k[i] + b*k2[i]
+	}
+	dst[0] += 1
+	dst[4] += 1
+	dst[8] += 1
+}
@@ -0,0 +1,192 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package manifold
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// randomPoint returns a random point on m.
+func randomPoint(m Manifold, rnd *rand.Rand) []float64 {
+	switch m := m.(type) {
+	case Sphere:
+		x := randomVector(m.N, rnd)
+		floats.Scale(1/floats.Norm(x, 2), x)
+		return x
+	case Stiefel:
+		x := make([]float64, m.Len())
+		for i := 0; i < m.P; i++ {
+			x[i*m.P+i] = 1
+		}
+		m.Retract(x, x, randomTangent(m, x, rnd))
+		return x
+	case SO3:
+		x := []float64{1, 0, 0, 0, 1, 0, 0, 0, 1}
+		m.Retract(x, x, randomTangent(m, x, rnd))
+		return x
+	case SE3:
+		x := []float64{1, 0, 0, 0, 1, 0, 0, 0, 1, 0, 0, 0}
+		m.Retract(x, x, randomTangent(m, x, rnd))
+		return x
+	case Product:
+		var x []float64
+		for _, mi := range m {
+			x = append(x, randomPoint(mi, rnd)...)
+		}
+		return x
+	}
+	panic(fmt.Sprintf("unknown manifold %T", m))
+}
+
+func randomVector(n int, rnd *rand.Rand) []float64 {
+	v := make([]float64, n)
+	for i := range v {
+		v[i] = rnd.NormFloat64()
+	}
+	return v
+}
+
+func randomTangent(m Manifold, x []float64, rnd *rand.Rand) []float64 {
+	v := randomVector(m.Len(), rnd)
+	m.Project(v, x, v)
+	return v
+}
+
+// onManifold returns whether x is within tol of a point on m.
+func onManifold(m Manifold, x []float64, tol float64) bool {
+	switch m := m.(type) {
+	case Sphere:
+		return math.Abs(floats.Norm(x, 2)-1) <= tol
+	case Stiefel:
+		xm := mat.NewDense(m.N, m.P, x)
+		var xtx mat.Dense
+		xtx.Mul(xm.T(), xm)
+		return mat.EqualApprox(&xtx, eye(m.P), tol)
+	case SO3:
+		r := mat.NewDense(3, 3, x)
+		var rtr mat.Dense
+		rtr.Mul(r.T(), r)
+		return mat.EqualApprox(&rtr, eye(3), tol) && math.Abs(mat.Det(r)-1) <= tol
+	case SE3:
+		return onManifold(SO3{}, x[:9], tol)
+	case Product:
+		var off int
+		for _, mi := range m {
+			if !onManifold(mi, x[off:off+mi.Len()], tol) {
+				return false
+			}
+			off += mi.Len()
+		}
+		return true
+	}
+	panic(fmt.Sprintf("unknown manifold %T", m))
+}
+
+func eye(n int) *mat.Dense {
+	d := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		d.Set(i, i, 1)
+	}
+	return d
+}
+
+var manifolds = []Manifold{
+	Sphere{N: 1},
+	Sphere{N: 5},
+	Stiefel{N: 4, P: 1},
+	Stiefel{N: 5, P: 3},
+	Stiefel{N: 3, P: 3},
+	SO3{},
+	SE3{},
+	Product{Sphere{N: 3}, SO3{}, SE3{}},
+}
+
+func TestManifolds(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range manifolds {
+		for trial := 0; trial < 10; trial++ {
+			x := randomPoint(m, rnd)
+			if !onManifold(m, x, 1e-12) {
+				t.Errorf("%#v: random point not on manifold", m)
+				continue
+			}
+
+			// Projection is idempotent and orthogonal.
+			w := randomVector(m.Len(), rnd)
+			v := make([]float64, m.Len())
+			m.Project(v, x, w)
+			pv := make([]float64, m.Len())
+			m.Project(pv, x, v)
+			if !floats.EqualApprox(v, pv, tol) {
+				t.Errorf("%#v: projection not idempotent", m)
+			}
+			normal := make([]float64, m.Len())
+			floats.SubTo(normal, w, v)
+			if d := floats.Dot(normal, v); math.Abs(d) > tol*floats.Norm(w, 2)*floats.Norm(w, 2) {
+				t.Errorf("%#v: projection not orthogonal: %v", m, d)
+			}
+
+			// Aliased projection gives the same result.
+			alias := make([]float64, m.Len())
+			copy(alias, w)
+			m.Project(alias, x, alias)
+			if !floats.Equal(alias, v) {
+				t.Errorf("%#v: aliased projection mismatch", m)
+			}
+
+			// Retraction stays on the manifold and is the identity
+			// for a zero tangent vector.
+			y := make([]float64, m.Len())
+			m.Retract(y, x, v)
+			if !onManifold(m, y, 1e-12) {
+				t.Errorf("%#v: retraction not on manifold", m)
+			}
+			m.Retract(y, x, make([]float64, m.Len()))
+			if !floats.EqualApprox(x, y, tol) {
+				t.Errorf("%#v: retraction of zero vector moved the point", m)
+			}
+
+			// Retraction has velocity v at zero.
+			const h = 1e-6
+			fwd := make([]float64, m.Len())
+			bwd := make([]float64, m.Len())
+			hv := make([]float64, m.Len())
+			floats.ScaleTo(hv, h, v)
+			m.Retract(fwd, x, hv)
+			floats.ScaleTo(hv, -h, v)
+			m.Retract(bwd, x, hv)
+			deriv := make([]float64, m.Len())
+			floats.SubTo(deriv, fwd, bwd)
+			floats.Scale(1/(2*h), deriv)
+			if !floats.EqualApprox(deriv, v, 1e-6) {
+				t.Errorf("%#v: retraction velocity mismatch: got %v, want %v", m, deriv, v)
+			}
+		}
+	}
+}
+
+func TestManifoldPanics(t *testing.T) {
+	for _, m := range manifolds {
+		n := m.Len()
+		for _, fn := range []func(dst, x, v []float64){m.Project, m.Retract} {
+			panicked := func() (panicked bool) {
+				defer func() { panicked = recover() != nil }()
+				fn(make([]float64, n), make([]float64, n+1), make([]float64, n))
+				return false
+			}()
+			if !panicked {
+				t.Errorf("%#v: expected panic for length mismatch", m)
+			}
+		}
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package manifold
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+var (
+	ErrMaxIterations = errors.New("manifold: maximum number of iterations reached")
+	ErrFunc          = errors.New("manifold: function value is NaN or infinite")
+	ErrStep          = errors.New("manifold: step size underflow")
+)
+
+// Problem is the minimization of a smooth function over a manifold.
+type Problem struct {
+	// Func evaluates the function f at the point x on the manifold.
+	Func func(x []float64) float64
+
+	// Grad evaluates the Euclidean gradient at x of a smooth extension
+	// of f to the ambient space and stores it into grad. The gradient
+	// is projected onto the tangent space at x to give the Riemannian
+	// gradient, so its component normal to the manifold is ignored.
+	Grad func(grad, x []float64)
+
+	// Manifold is the manifold over which f is minimized.
+	Manifold Manifold
+}
+
+// Settings holds the settings of the manifold optimization methods.
+type Settings struct {
+	// Step is the initial trial step size of the line search. If Step
+	// is zero, it is taken to be one.
+	Step float64
+
+	// Shrink is the factor by which the step size is decreased during
+	// backtracking. If Shrink is zero, it is taken to be 0.5.
+	Shrink float64
+
+	// Tolerance is the convergence tolerance on the norm of the
+	// Riemannian gradient. If Tolerance is zero, it is taken to be 1e-8.
+	Tolerance float64
+
+	// MaxIterations is the maximum number of iterations. If
+	// MaxIterations is zero, it is taken to be 10000.
+	MaxIterations int
+}
+
+// Result holds the result of a manifold optimization method.
+type Result struct {
+	// X is the final location.
+	X []float64
+
+	// F is the value of f at X.
+	F float64
+
+	// GradNorm is the norm of the Riemannian gradient at X.
+	GradNorm float64
+
+	// Iterations, FuncEvaluations and GradEvaluations are the number
+	// of iterations and of evaluations of f and its gradient.
+	Iterations      int
+	FuncEvaluations int
+	GradEvaluations int
+}
+
+// GradientDescent minimizes f over the manifold starting from x0 using the
+// Riemannian steepest descent method with a backtracking Armijo line search.
+// x0 must be a point on the manifold and is not modified. settings may be
+// nil, in which case the default settings are used.
+//
+// The returned Result is non-nil if the starting location is valid. If the
+// maximum number of iterations is reached, the last iterate is returned
+// along with ErrMaxIterations.
+func GradientDescent(p Problem, x0 []float64, settings *Settings) (*Result, error) {
+	return minimize(p, x0, settings, false)
+}
+
+// ConjugateGradient minimizes f over the manifold starting from x0 using the
+// Riemannian nonlinear conjugate gradient method with the Polak–Ribière+
+// update and a backtracking Armijo line search. Previous search directions
+// are transported to the current tangent space by projection. x0 must be a
+// point on the manifold and is not modified. settings may be nil, in which
+// case the default settings are used.
+//
+// The returned Result is non-nil if the starting location is valid. If the
+// maximum number of iterations is reached, the last iterate is returned
+// along with ErrMaxIterations.
+func ConjugateGradient(p Problem, x0 []float64, settings *Settings) (*Result, error) {
+	return minimize(p, x0, settings, true)
+}
+
+func minimize(p Problem, x0 []float64, settings *Settings, conjugate bool) (*Result, error) {
+	if p.Func == nil || p.Grad == nil || p.Manifold == nil {
+		panic("manifold: problem must have Func, Grad and Manifold")
+	}
+	n := p.Manifold.Len()
+	if len(x0) != n {
+		panic(badLength)
+	}
+	var s Settings
+	if settings != nil {
+		s = *settings
+	}
+	if s.Step == 0 {
+		s.Step = 1
+	}
+	if s.Shrink == 0 {
+		s.Shrink = 0.5
+	}
+	if s.Tolerance == 0 {
+		s.Tolerance = 1e-8
+	}
+	if s.MaxIterations == 0 {
+		s.MaxIterations = 10000
+	}
+	if s.Step < 0 || s.Shrink <= 0 || s.Shrink >= 1 || s.Tolerance < 0 || s.MaxIterations < 0 {
+		panic("manifold: invalid settings")
+	}
+
+	// Armijo sufficient decrease parameter.
+	const c1 = 1e-4
+
+	m := p.Manifold
+	res := &Result{}
+	x := make([]float64, n)
+	copy(x, x0)
+	xNew := make([]float64, n)
+	grad := make([]float64, n)
+	gradNew := make([]float64, n)
+	dir := make([]float64, n)
+	v := make([]float64, n)
+
+	f := p.Func(x)
+	res.FuncEvaluations++
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return nil, ErrFunc
+	}
+	p.Grad(grad, x)
+	res.GradEvaluations++
+	m.Project(grad, x, grad)
+	floats.ScaleTo(dir, -1, grad)
+
+	step := s.Step
+	for {
+		gg := floats.Dot(grad, grad)
+		if math.Sqrt(gg) <= s.Tolerance {
+			res.X = x
+			res.F = f
+			res.GradNorm = math.Sqrt(gg)
+			return res, nil
+		}
+		if res.Iterations == s.MaxIterations {
+			res.X = x
+			res.F = f
+			res.GradNorm = math.Sqrt(gg)
+			return res, ErrMaxIterations
+		}
+		res.Iterations++
+
+		slope := floats.Dot(grad, dir)
+		if slope >= 0 {
+			// The transported direction is not a descent direction,
+			// so restart along the negative gradient.
+			floats.ScaleTo(dir, -1, grad)
+			slope = -gg
+		}
+
+		// Backtrack until the Armijo condition holds along the curve
+		// obtained by retracting the scaled search direction. When the
+		// predicted decrease in f is at the level of its rounding error
+		// the Armijo condition cannot be tested reliably, so the step
+		// is instead accepted if f does not increase significantly and
+		// the norm of the gradient decreases.
+		noise := 1e-12 * math.Abs(f)
+		var fNew float64
+		for {
+			floats.ScaleTo(v, step, dir)
+			m.Retract(xNew, x, v)
+			fNew = p.Func(xNew)
+			res.FuncEvaluations++
+			armijo := -c1*step*slope > noise
+			if (armijo && fNew <= f+c1*step*slope) || (!armijo && fNew <= f+noise) {
+				p.Grad(gradNew, xNew)
+				res.GradEvaluations++
+				m.Project(gradNew, xNew, gradNew)
+				if armijo || floats.Dot(gradNew, gradNew) < gg {
+					break
+				}
+			}
+			step *= s.Shrink
+			if step == 0 {
+				res.X = x
+				res.F = f
+				res.GradNorm = math.Sqrt(gg)
+				return res, ErrStep
+			}
+		}
+		if math.IsNaN(fNew) || math.IsInf(fNew, 0) {
+			return nil, ErrFunc
+		}
+
+		x, xNew = xNew, x
+		f = fNew
+		grad, gradNew = gradNew, grad
+
+		if !conjugate {
+			floats.ScaleTo(dir, -1, grad)
+		} else {
+			// Transport the previous gradient and search direction to
+			// the tangent space at the new point.
+			m.Project(gradNew, x, gradNew)
+			m.Project(dir, x, dir)
+			beta := (floats.Dot(grad, grad) - floats.Dot(grad, gradNew)) / gg
+			beta = math.Max(beta, 0)
+			for i, g := range grad {
+				dir[i] = -g + beta*dir[i]
+			}
+		}
+		// Allow the step to grow after a successful line search.
+		step /= s.Shrink
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package manifold
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+var methods = []struct {
+	name string
+	fn   func(Problem, []float64, *Settings) (*Result, error)
+}{
+	{name: "GradientDescent", fn: GradientDescent},
+	{name: "ConjugateGradient", fn: ConjugateGradient},
+}
+
+func randomSym(n int, rnd *rand.Rand) *mat.SymDense {
+	a := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			a.SetSym(i, j, rnd.NormFloat64())
+		}
+	}
+	return a
+}
+
+// traceProblem returns the problem of minimizing tr(XᵀAX) over the Stiefel
+// manifold, whose minimum is the sum of the p smallest eigenvalues of A.
+func traceProblem(a *mat.SymDense, p int) Problem {
+	n := a.SymmetricDim()
+	return Problem{
+		Func: func(x []float64) float64 {
+			xm := mat.NewDense(n, p, x)
+			var ax mat.Dense
+			ax.Mul(a, xm)
+			return dotDense(xm, &ax)
+		},
+		Grad: func(grad, x []float64) {
+			xm := mat.NewDense(n, p, x)
+			g := mat.NewDense(n, p, grad)
+			g.Mul(a, xm)
+			g.Scale(2, g)
+		},
+		Manifold: Stiefel{N: n, P: p},
+	}
+}
+
+func dotDense(a, b *mat.Dense) float64 {
+	return floats.Dot(a.RawMatrix().Data, b.RawMatrix().Data)
+}
+
+func TestStiefelTrace(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ n, p int }{
+		{n: 5, p: 1},
+		{n: 8, p: 3},
+		{n: 6, p: 6},
+	} {
+		a := randomSym(test.n, rnd)
+		var eig mat.EigenSym
+		if !eig.Factorize(a, false) {
+			t.Fatal("eigendecomposition failed")
+		}
+		want := floats.Sum(eig.Values(nil)[:test.p])
+
+		p := traceProblem(a, test.p)
+		x0 := randomPoint(p.Manifold, rnd)
+		for _, method := range methods {
+			res, err := method.fn(p, x0, nil)
+			if err != nil {
+				t.Errorf("%s n=%d p=%d: unexpected error: %v", method.name, test.n, test.p, err)
+				continue
+			}
+			if math.Abs(res.F-want) > 1e-8 {
+				t.Errorf("%s n=%d p=%d: unexpected minimum: got %v, want %v", method.name, test.n, test.p, res.F, want)
+			}
+			if !onManifold(p.Manifold, res.X, 1e-12) {
+				t.Errorf("%s n=%d p=%d: result not on manifold", method.name, test.n, test.p)
+			}
+			if res.GradNorm > 1e-8 {
+				t.Errorf("%s n=%d p=%d: gradient norm too large: %v", method.name, test.n, test.p, res.GradNorm)
+			}
+		}
+	}
+}
+
+func TestSphereRayleigh(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	const n = 10
+	a := randomSym(n, rnd)
+	var eig mat.EigenSym
+	if !eig.Factorize(a, true) {
+		t.Fatal("eigendecomposition failed")
+	}
+	var vecs mat.Dense
+	eig.VectorsTo(&vecs)
+	want := mat.Col(nil, 0, &vecs)
+
+	av := mat.NewVecDense(n, nil)
+	p := Problem{
+		Func: func(x []float64) float64 {
+			xv := mat.NewVecDense(n, x)
+			return mat.Inner(xv, a, xv)
+		},
+		Grad: func(grad, x []float64) {
+			av.MulVec(a, mat.NewVecDense(n, x))
+			floats.ScaleTo(grad, 2, av.RawVector().Data)
+		},
+		Manifold: Sphere{N: n},
+	}
+	x0 := randomPoint(p.Manifold, rnd)
+	for _, method := range methods {
+		res, err := method.fn(p, x0, nil)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", method.name, err)
+			continue
+		}
+		if math.Abs(res.F-eig.Values(nil)[0]) > 1e-10 {
+			t.Errorf("%s: unexpected minimum: got %v, want %v", method.name, res.F, eig.Values(nil)[0])
+		}
+		// The eigenvector is determined up to sign.
+		if d := math.Abs(floats.Dot(res.X, want)); math.Abs(d-1) > 1e-6 {
+			t.Errorf("%s: minimizer is not the smallest eigenvector: |cos| = %v", method.name, d)
+		}
+	}
+}
+
+// poseAlignment returns the problem of finding the rigid motion (R, t)
+// minimizing Σ |R p_i + t - q_i|².
+func poseAlignment(ps, qs [][3]float64) Problem {
+	residual := func(r, x []float64, p, q [3]float64) {
+		for i := 0; i < 3; i++ {
+			r[i] = x[i*3]*p[0] + x[i*3+1]*p[1] + x[i*3+2]*p[2] + x[9+i] - q[i]
+		}
+	}
+	return Problem{
+		Func: func(x []float64) float64 {
+			var f float64
+			var r [3]float64
+			for k := range ps {
+				residual(r[:], x, ps[k], qs[k])
+				f += r[0]*r[0] + r[1]*r[1] + r[2]*r[2]
+			}
+			return f
+		},
+		Grad: func(grad, x []float64) {
+			for i := range grad {
+				grad[i] = 0
+			}
+			var r [3]float64
+			for k, p := range ps {
+				residual(r[:], x, p, qs[k])
+				for i := 0; i < 3; i++ {
+					for j := 0; j < 3; j++ {
+						grad[i*3+j] += 2 * r[i] * p[j]
+					}
+					grad[9+i] += 2 * r[i]
+				}
+			}
+		},
+		Manifold: SE3{},
+	}
+}
+
+func TestSE3Alignment(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 5; trial++ {
+		want := randomPoint(SE3{}, rnd)
+		for i := 9; i < 12; i++ {
+			want[i] = 5 * rnd.NormFloat64()
+		}
+		var ps, qs [][3]float64
+		for k := 0; k < 10; k++ {
+			p := [3]float64{rnd.NormFloat64(), rnd.NormFloat64(), rnd.NormFloat64()}
+			var q [3]float64
+			for i := 0; i < 3; i++ {
+				q[i] = want[i*3]*p[0] + want[i*3+1]*p[1] + want[i*3+2]*p[2] + want[9+i]
+			}
+			ps = append(ps, p)
+			qs = append(qs, q)
+		}
+		p := poseAlignment(ps, qs)
+		x0 := []float64{1, 0, 0, 0, 1, 0, 0, 0, 1, 0, 0, 0}
+		for _, method := range methods {
+			res, err := method.fn(p, x0, &Settings{Tolerance: 1e-10})
+			if err != nil {
+				t.Errorf("%s trial %d: unexpected error: %v", method.name, trial, err)
+				continue
+			}
+			if !floats.EqualApprox(res.X, want, 1e-8) {
+				t.Errorf("%s trial %d: unexpected pose:\ngot  %v\nwant %v", method.name, trial, res.X, want)
+			}
+			if !onManifold(SE3{}, res.X, 1e-12) {
+				t.Errorf("%s trial %d: result not on manifold", method.name, trial)
+			}
+		}
+	}
+}
+
+func TestMaxIterations(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	a := randomSym(10, rnd)
+	p := traceProblem(a, 3)
+	x0 := randomPoint(p.Manifold, rnd)
+	for _, method := range methods {
+		res, err := method.fn(p, x0, &Settings{MaxIterations: 2})
+		if err != ErrMaxIterations {
+			t.Errorf("%s: unexpected error: got %v, want %v", method.name, err, ErrMaxIterations)
+		}
+		if res == nil || res.Iterations != 2 {
+			t.Errorf("%s: unexpected result: %+v", method.name, res)
+		}
+	}
+}
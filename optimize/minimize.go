@@ -164,6 +164,10 @@ func Minimize(p Problem, initX []float64, settings *Settings, method Method) (*R
 	if err != nil {
 		return nil, err
 	}
+	scale := p.Scale
+	if scale != nil {
+		p, initX, settings = scaleProblem(p, initX, settings)
+	}
 
 	optLoc := newLocation(dim) // This must have an allocated X field.
 	optLoc.F = math.Inf(1)
@@ -194,6 +198,9 @@ func Minimize(p Problem, initX []float64, settings *Settings, method Method) (*R
 	if settings.Recorder != nil && err == nil {
 		err = settings.Recorder.Record(optLoc, PostIteration, stats)
 	}
+	if scale != nil {
+		unscaleLocation(optLoc, scale)
+	}
 	stats.Runtime = time.Since(startTime)
 	return &Result{
 		Location: *optLoc,
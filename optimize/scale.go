@@ -0,0 +1,109 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+// scaleProblem returns the problem p expressed in the variables scaled by
+// p.Scale, and the corresponding initial location and settings.
+func scaleProblem(p Problem, initX []float64, settings *Settings) (Problem, []float64, *Settings) {
+	scale := p.Scale
+	if len(scale) != len(initX) {
+		panic("optimize: scale length mismatch")
+	}
+	for _, s := range scale {
+		if !(s > 0) || math.IsInf(s, 1) {
+			panic("optimize: scale not positive and finite")
+		}
+	}
+
+	// unscaled returns the location in the original
+	// variables corresponding to the scaled location y.
+	unscaled := func(y []float64) []float64 {
+		x := make([]float64, len(y))
+		for i, v := range y {
+			x[i] = v * scale[i]
+		}
+		return x
+	}
+
+	sp := Problem{
+		Func: func(y []float64) float64 {
+			return p.Func(unscaled(y))
+		},
+		Status: p.Status,
+	}
+	if p.Grad != nil {
+		sp.Grad = func(grad, y []float64) {
+			p.Grad(grad, unscaled(y))
+			for i, s := range scale {
+				grad[i] *= s
+			}
+		}
+	}
+	if p.Hess != nil {
+		sp.Hess = func(hess *mat.SymDense, y []float64) {
+			p.Hess(hess, unscaled(y))
+			scaleHessian(hess, scale, false)
+		}
+	}
+
+	y := make([]float64, len(initX))
+	for i, v := range initX {
+		y[i] = v / scale[i]
+	}
+
+	if settings.InitValues != nil {
+		s := *settings
+		init := *settings.InitValues
+		if init.Gradient != nil {
+			init.Gradient = make([]float64, len(settings.InitValues.Gradient))
+			for i, g := range settings.InitValues.Gradient {
+				init.Gradient[i] = g * scale[i]
+			}
+		}
+		if init.Hessian != nil && !init.Hessian.IsEmpty() {
+			init.Hessian = mat.NewSymDense(len(scale), nil)
+			init.Hessian.CopySym(settings.InitValues.Hessian)
+			scaleHessian(init.Hessian, scale, false)
+		}
+		s.InitValues = &init
+		settings = &s
+	}
+	return sp, y, settings
+}
+
+// unscaleLocation converts loc from the variables scaled by
+// scale to the original variables.
+func unscaleLocation(loc *Location, scale []float64) {
+	for i, s := range scale {
+		loc.X[i] *= s
+	}
+	for i := range loc.Gradient {
+		loc.Gradient[i] /= scale[i]
+	}
+	if loc.Hessian != nil && !loc.Hessian.IsEmpty() {
+		scaleHessian(loc.Hessian, scale, true)
+	}
+}
+
+// scaleHessian scales the Hessian h by diag(scale) on both sides,
+// or by its inverse if inverse is true.
+func scaleHessian(h *mat.SymDense, scale []float64, inverse bool) {
+	n := h.SymmetricDim()
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			if inverse {
+				h.SetSym(i, j, h.At(i, j)/(scale[i]*scale[j]))
+			} else {
+				h.SetSym(i, j, h.At(i, j)*scale[i]*scale[j])
+			}
+		}
+	}
+}
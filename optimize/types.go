@@ -136,6 +136,19 @@ type Problem struct {
 	// not able to evaluate itself. The user can use one of the pre-provided Status
 	// constants, or may call NewStatus to create a custom Status value.
 	Status func() (Status, error)
+
+	// Scale, if not nil, holds the typical magnitude of each variable,
+	// and must have the same length as the initial location and only
+	// positive elements. Minimize then optimizes over the scaled
+	// variables x[i]/Scale[i], which can improve the convergence of
+	// methods that are sensitive to the relative scaling of the
+	// variables. Suitable scales may be found using CheckScaling.
+	//
+	// The Location in the Result returned by Minimize is given in the
+	// original variables. Locations passed to Settings.Recorder and
+	// Settings.Converger, and the gradient used for the
+	// Settings.GradientThreshold test, are in the scaled variables.
+	Scale []float64
 }
 
 // Available describes the functions available to call in Problem.
@@ -0,0 +1,379 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package balltree
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+	"sort"
+)
+
+// Comparable is the element interface for values stored in a ball tree.
+type Comparable interface {
+	// Distance returns the distance between the receiver and the
+	// parameter. The returned distance must satisfy the properties
+	// of distances in a metric space.
+	//
+	// - a.Distance(a) == 0
+	// - a.Distance(b) >= 0
+	// - a.Distance(b) == b.Distance(a)
+	// - a.Distance(b) <= a.Distance(c)+c.Distance(b)
+	//
+	Distance(Comparable) float64
+}
+
+// Point represents a point in a Euclidean k-d space that satisfies the Comparable
+// interface.
+type Point []float64
+
+// Distance returns the Euclidean distance between c and the receiver. The concrete
+// type of c must be Point.
+func (p Point) Distance(c Comparable) float64 {
+	q := c.(Point)
+	var sum float64
+	for dim, c := range p {
+		d := c - q[dim]
+		sum += d * d
+	}
+	return math.Sqrt(sum)
+}
+
+// Node holds a single point value in a ball tree. The ball centered
+// on Point with radius Radius contains all the values stored in the
+// subtree rooted at the node.
+type Node struct {
+	Point       Comparable
+	Radius      float64
+	Left, Right *Node
+}
+
+// Tree implements a ball tree creation and nearest neighbor search.
+type Tree struct {
+	Root  *Node
+	Count int
+}
+
+// New returns a ball tree constructed from the values in p. The order of
+// elements in p will be altered after New returns. Points in p must not
+// be infinitely distant.
+func New(p []Comparable) (t *Tree, err error) {
+	b := builder{work: make([]float64, len(p))}
+
+	defer func() {
+		switch r := recover(); r {
+		case nil:
+		case pointAtInfinity:
+			t = nil
+			err = pointAtInfinity
+		default:
+			panic(r)
+		}
+	}()
+
+	t = &Tree{
+		Root:  b.build(p),
+		Count: len(p),
+	}
+	return t, nil
+}
+
+var pointAtInfinity = errors.New("balltree: point at infinity")
+
+// builder performs ball tree construction by recursively splitting
+// the set of values between two distant pivots.
+type builder struct {
+	work []float64
+}
+
+// build returns the ball tree of the values in s with the
+// center of the root ball at s[0].
+func (b *builder) build(s []Comparable) *Node {
+	if len(s) == 0 {
+		return nil
+	}
+	n := Node{Point: s[0]}
+	if len(s) == 1 {
+		return &n
+	}
+
+	// Find the radius of the ball and the value
+	// furthest from the center to use as the
+	// first pivot.
+	rest := s[1:]
+	dists := b.distances(n.Point, rest)
+	far := 0
+	for i, d := range dists {
+		if d > n.Radius {
+			n.Radius = d
+			far = i
+		}
+	}
+	if len(rest) == 1 {
+		n.Left = &Node{Point: rest[0]}
+		return &n
+	}
+
+	// Use the value furthest from the first pivot
+	// as the second pivot and partition the values
+	// by their closest pivot.
+	rest[0], rest[far] = rest[far], rest[0]
+	left := rest[0]
+	dists = b.distances(left, rest)
+	far = 0
+	for i, d := range dists {
+		if d > dists[far] {
+			far = i
+		}
+	}
+	rest[len(rest)-1], rest[far] = rest[far], rest[len(rest)-1]
+	right := rest[len(rest)-1]
+
+	// Partition rest so that values closer to left
+	// come first, keeping left at the start and right
+	// at the end.
+	i, j := 1, len(rest)-2
+	for i <= j {
+		p := rest[i]
+		dl := left.Distance(p)
+		dr := right.Distance(p)
+		if math.IsInf(dl, 0) || math.IsInf(dr, 0) {
+			panic(pointAtInfinity)
+		}
+		if dl <= dr {
+			i++
+			continue
+		}
+		rest[i], rest[j] = rest[j], rest[i]
+		j--
+	}
+	// Move right to the start of its partition.
+	rest[i], rest[len(rest)-1] = rest[len(rest)-1], rest[i]
+
+	n.Left = b.build(rest[:i])
+	n.Right = b.build(rest[i:])
+	return &n
+}
+
+// distances returns the distances from v to each element
+// of s, panicking if any distance is infinite.
+func (b *builder) distances(v Comparable, s []Comparable) []float64 {
+	b.work = b.work[:len(s)]
+	for i, p := range s {
+		d := v.Distance(p)
+		if math.IsInf(d, 0) {
+			panic(pointAtInfinity)
+		}
+		b.work[i] = d
+	}
+	return b.work
+}
+
+// Len returns the number of elements in the tree.
+func (t *Tree) Len() int { return t.Count }
+
+var inf = math.Inf(1)
+
+// Nearest returns the nearest value to the query and the distance between them.
+func (t *Tree) Nearest(q Comparable) (Comparable, float64) {
+	if t.Root == nil {
+		return nil, inf
+	}
+	n, dist := t.Root.search(q, q.Distance(t.Root.Point), inf)
+	if n == nil {
+		return nil, inf
+	}
+	return n.Point, dist
+}
+
+// search returns the nearest node to q within the subtree rooted at
+// n if it is closer than dist. The distance from q to n.Point is d.
+func (n *Node) search(q Comparable, d, dist float64) (*Node, float64) {
+	if d-n.Radius > dist {
+		return nil, inf
+	}
+	var bn *Node
+	if d < dist {
+		bn, dist = n, d
+	}
+
+	first, second := n.Left, n.Right
+	var df, ds float64
+	if first != nil {
+		df = q.Distance(first.Point)
+	}
+	if second != nil {
+		ds = q.Distance(second.Point)
+		if first == nil || ds < df {
+			first, second = second, first
+			df, ds = ds, df
+		}
+	}
+	if first != nil {
+		if fn, fd := first.search(q, df, dist); fn != nil && fd < dist {
+			bn, dist = fn, fd
+		}
+	}
+	if second != nil {
+		if sn, sd := second.search(q, ds, dist); sn != nil && sd < dist {
+			bn, dist = sn, sd
+		}
+	}
+	return bn, dist
+}
+
+// ComparableDist holds a Comparable and a distance to a specific query. A nil Comparable
+// is used to mark the end of the heap, so clients should not store nil values except for
+// this purpose.
+type ComparableDist struct {
+	Comparable Comparable
+	Dist       float64
+}
+
+// Heap is a max heap sorted on Dist.
+type Heap []ComparableDist
+
+func (h *Heap) Max() ComparableDist  { return (*h)[0] }
+func (h *Heap) Len() int             { return len(*h) }
+func (h *Heap) Less(i, j int) bool   { return (*h)[i].Comparable == nil || (*h)[i].Dist > (*h)[j].Dist }
+func (h *Heap) Swap(i, j int)        { (*h)[i], (*h)[j] = (*h)[j], (*h)[i] }
+func (h *Heap) Push(x interface{})   { (*h) = append(*h, x.(ComparableDist)) }
+func (h *Heap) Pop() (i interface{}) { i, *h = (*h)[len(*h)-1], (*h)[:len(*h)-1]; return i }
+
+// NKeeper is a Keeper that retains the n best ComparableDists that have been passed to Keep.
+type NKeeper struct {
+	Heap
+}
+
+// NewNKeeper returns an NKeeper with the max value of the heap set to infinite distance. The
+// returned NKeeper is able to retain at most n values.
+func NewNKeeper(n int) *NKeeper {
+	k := NKeeper{make(Heap, 1, n)}
+	k.Heap[0].Dist = inf
+	return &k
+}
+
+// Keep adds c to the heap if its distance is less than the maximum value of the heap. If adding
+// c would increase the size of the heap beyond the initial maximum length, the maximum value of
+// the heap is dropped.
+func (k *NKeeper) Keep(c ComparableDist) {
+	if c.Dist <= k.Heap[0].Dist { // Favour later finds to displace sentinel.
+		if len(k.Heap) == cap(k.Heap) {
+			heap.Pop(k)
+		}
+		heap.Push(k, c)
+	}
+}
+
+// DistKeeper is a Keeper that retains the ComparableDists within the specified distance of the
+// query that it is called to Keep.
+type DistKeeper struct {
+	Heap
+}
+
+// NewDistKeeper returns an DistKeeper with the maximum value of the heap set to d.
+func NewDistKeeper(d float64) *DistKeeper { return &DistKeeper{Heap{{Dist: d}}} }
+
+// Keep adds c to the heap if its distance is less than or equal to the max value of the heap.
+func (k *DistKeeper) Keep(c ComparableDist) {
+	if c.Dist <= k.Heap[0].Dist {
+		heap.Push(k, c)
+	}
+}
+
+// Keeper implements a conditional max heap sorted on the Dist field of the ComparableDist type.
+// Ball tree search is guided by the distance stored in the max value of the heap.
+type Keeper interface {
+	Keep(ComparableDist) // Keep conditionally pushes the provided ComparableDist onto the heap.
+	Max() ComparableDist // Max returns the maximum element of the Keeper.
+	heap.Interface
+}
+
+// NearestSet finds the nearest values to the query accepted by the provided Keeper, k.
+// k must be able to return a ComparableDist specifying the maximum acceptable distance
+// when Max() is called, and retains the results of the search in min sorted order after
+// the call to NearestSet returns.
+// If a sentinel ComparableDist with a nil Comparable is used by the Keeper to mark the
+// maximum distance, NearestSet will remove it before returning.
+func (t *Tree) NearestSet(k Keeper, q Comparable) {
+	if t.Root == nil {
+		return
+	}
+	t.Root.searchSet(q, q.Distance(t.Root.Point), k)
+
+	// Check whether we have retained a sentinel
+	// and flag removal if we have.
+	removeSentinel := k.Len() != 0 && k.Max().Comparable == nil
+
+	sort.Sort(sort.Reverse(k))
+
+	// This abuses the interface to drop the max.
+	// It is reasonable to do this because we know
+	// that the maximum value will now be at element
+	// zero, which is removed by the Pop method.
+	if removeSentinel {
+		k.Pop()
+	}
+}
+
+// searchSet offers the values in the subtree rooted at n to k. The
+// distance from q to n.Point is d.
+func (n *Node) searchSet(q Comparable, d float64, k Keeper) {
+	if d-n.Radius > k.Max().Dist {
+		return
+	}
+	k.Keep(ComparableDist{Comparable: n.Point, Dist: d})
+
+	first, second := n.Left, n.Right
+	var df, ds float64
+	if first != nil {
+		df = q.Distance(first.Point)
+	}
+	if second != nil {
+		ds = q.Distance(second.Point)
+		if first == nil || ds < df {
+			first, second = second, first
+			df, ds = ds, df
+		}
+	}
+	if first != nil {
+		first.searchSet(q, df, k)
+	}
+	if second != nil {
+		second.searchSet(q, ds, k)
+	}
+}
+
+// Operation is a function that operates on a Comparable. The tree depth of the point
+// is also provided. If done is returned true, the Operation is indicating that no
+// further work needs to be done and so the Do function should traverse no further.
+type Operation func(Comparable, int) (done bool)
+
+// Do performs fn on all values stored in the tree. A boolean is returned indicating whether the
+// Do traversal was interrupted by an Operation returning true. If fn alters stored values' distance
+// relationships, future tree operation behaviors are undefined.
+func (t *Tree) Do(fn Operation) bool {
+	if t.Root == nil {
+		return false
+	}
+	return t.Root.do(fn, 0)
+}
+
+func (n *Node) do(fn Operation, depth int) (done bool) {
+	done = fn(n.Point, depth)
+	if done {
+		return
+	}
+	if n.Left != nil {
+		done = n.Left.do(fn, depth+1)
+		if done {
+			return
+		}
+	}
+	if n.Right != nil {
+		done = n.Right.do(fn, depth+1)
+	}
+	return
+}
@@ -0,0 +1,178 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package balltree
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+var wpData = []Comparable{
+	Point{2, 3},
+	Point{5, 4},
+	Point{9, 6},
+	Point{4, 7},
+	Point{8, 1},
+	Point{7, 2},
+}
+
+func randData(rnd *rand.Rand, n, dims int) []Comparable {
+	p := make([]Comparable, n)
+	for i := range p {
+		v := make(Point, dims)
+		for j := range v {
+			v[j] = 1000 * rnd.Float64()
+		}
+		p[i] = v
+	}
+	return p
+}
+
+func TestNew(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, data := range [][]Comparable{
+		nil,
+		wpData[:1],
+		wpData[:2],
+		wpData,
+		{Point{1, 1}, Point{1, 1}, Point{1, 1}},
+		randData(rnd, 1000, 3),
+	} {
+		data = append([]Comparable(nil), data...)
+		tree, err := New(data)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if tree.Len() != len(data) {
+			t.Errorf("unexpected tree length: got:%d want:%d", tree.Len(), len(data))
+		}
+		var n int
+		tree.Do(func(Comparable, int) bool { n++; return false })
+		if n != len(data) {
+			t.Errorf("unexpected number of values in tree: got:%d want:%d", n, len(data))
+		}
+		if tree.Root != nil && !tree.Root.isBallTree() {
+			t.Error("tree is not a ball tree")
+		}
+	}
+
+	_, err := New([]Comparable{Point{0}, Point{math.Inf(1)}, Point{1}})
+	if err != pointAtInfinity {
+		t.Errorf("unexpected error for point at infinity: got:%v want:%v", err, pointAtInfinity)
+	}
+}
+
+// isBallTree returns whether all the values in each subtree
+// are within the radius of the subtree's root.
+func (n *Node) isBallTree() bool {
+	if n == nil {
+		return true
+	}
+	ok := true
+	var check func(m *Node)
+	check = func(m *Node) {
+		if m == nil {
+			return
+		}
+		if n.Point.Distance(m.Point) > n.Radius {
+			ok = false
+		}
+		check(m.Left)
+		check(m.Right)
+	}
+	check(n.Left)
+	check(n.Right)
+	return ok && n.Left.isBallTree() && n.Right.isBallTree()
+}
+
+func nearestN(n int, q Comparable, p []Comparable) []float64 {
+	d := make([]float64, len(p))
+	for i, c := range p {
+		d[i] = q.Distance(c)
+	}
+	sort.Float64s(d)
+	if n < len(d) {
+		d = d[:n]
+	}
+	return d
+}
+
+func TestNearest(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := randData(rnd, 5000, 4)
+	want := append([]Comparable(nil), data...)
+	tree, err := New(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i := 0; i < 500; i++ {
+		q := randData(rnd, 1, 4)[0]
+		_, got := tree.Nearest(q)
+		if w := nearestN(1, q, want)[0]; got != w {
+			t.Errorf("unexpected nearest distance for query %d: got:%v want:%v", i, got, w)
+		}
+	}
+
+	empty, _ := New(nil)
+	if p, d := empty.Nearest(Point{0, 0}); p != nil || !math.IsInf(d, 1) {
+		t.Errorf("unexpected result for empty tree: %v %v", p, d)
+	}
+}
+
+func TestNearestSet(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := randData(rnd, 2000, 3)
+	want := append([]Comparable(nil), data...)
+	tree, err := New(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i := 0; i < 100; i++ {
+		q := randData(rnd, 1, 3)[0]
+
+		for _, n := range []int{1, 5, 20} {
+			k := NewNKeeper(n)
+			tree.NearestSet(k, q)
+			w := nearestN(n, q, want)
+			if len(k.Heap) != len(w) {
+				t.Fatalf("unexpected number of neighbors: got:%d want:%d", len(k.Heap), len(w))
+			}
+			for j, c := range k.Heap {
+				if c.Dist != w[j] {
+					t.Errorf("unexpected distance for neighbor %d of query %d: got:%v want:%v", j, i, c.Dist, w[j])
+				}
+			}
+		}
+
+		const r = 100
+		k := NewDistKeeper(r)
+		tree.NearestSet(k, q)
+		var n int
+		for _, c := range want {
+			if q.Distance(c) <= r {
+				n++
+			}
+		}
+		if len(k.Heap) != n {
+			t.Errorf("unexpected number of values within %v of query %d: got:%d want:%d", r, i, len(k.Heap), n)
+		}
+	}
+}
+
+func BenchmarkNearest(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	tree, err := New(randData(rnd, 100000, 3))
+	if err != nil {
+		b.Fatalf("unexpected error: %v", err)
+	}
+	queries := randData(rnd, 1000, 3)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree.Nearest(queries[i%len(queries)])
+	}
+}
@@ -0,0 +1,13 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package balltree implements a metric ball tree. Ball trees provide an
+// efficient search for nearest neighbors in a metric space, requiring
+// only a distance function between stored values.
+//
+// See S. M. Omohundro, "Five Balltree Construction Algorithms",
+// ICSI Technical Report TR-89-063, 1989 and J. K. Uhlmann, "Satisfying
+// general proximity/similarity queries with metric trees", Information
+// Processing Letters 40(4):175–179, 1991 for details of ball trees.
+package balltree // import "gonum.org/v1/gonum/spatial/balltree"
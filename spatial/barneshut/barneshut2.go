@@ -40,11 +40,37 @@ func Gravity2(_, _ Particle2, m1, m2 float64, v r2.Vec) r2.Vec {
 	return r2.Scale((m1*m2)/(d2*math.Sqrt(d2)), v)
 }
 
+// SoftenedGravity2 returns a Force2 that calculates a Plummer softened
+// gravitational force on m1 by m2, equal to (m1⋅m2)⋅v/(‖v‖²+ε²)^(3/2)
+// where ε is the softening length eps. Softening limits the magnitude
+// of the force between close particles. SoftenedGravity2 ignores the
+// identity of the interacting particles and returns a zero vector when
+// the two particles are coincident.
+func SoftenedGravity2(eps float64) Force2 {
+	eps2 := eps * eps
+	return func(_, _ Particle2, m1, m2 float64, v r2.Vec) r2.Vec {
+		d2 := v.X*v.X + v.Y*v.Y
+		if d2 == 0 {
+			return r2.Vec{}
+		}
+		d2 += eps2
+		return r2.Scale((m1*m2)/(d2*math.Sqrt(d2)), v)
+	}
+}
+
 // Plane implements Barnes-Hut force approximation calculations.
 type Plane struct {
 	root tile
 
 	Particles []Particle2
+
+	// Period specifies periodic boundary conditions for the
+	// plane. If a component of Period is positive, the plane is
+	// treated as periodic in that dimension with the given
+	// period and interactions are calculated between nearest
+	// periodic images using the minimum image convention.
+	// Particles must lie within a single period of the domain.
+	Period r2.Vec
 }
 
 // NewPlane returns a new Plane. If the plane is too large to allow
@@ -126,7 +152,7 @@ var planeTooBig = errors.New("barneshut: plane too big")
 func (q *Plane) ForceOn(p Particle2, theta float64, f Force2) (force r2.Vec) {
 	var empty tile
 	if theta > 0 && q.root != empty {
-		return q.root.forceOn(p, p.Coord2(), p.Mass(), theta, f)
+		return q.root.forceOn(p, p.Coord2(), p.Mass(), theta, f, q.Period)
 	}
 
 	// For the degenerate case, just iterate over the
@@ -135,11 +161,31 @@ func (q *Plane) ForceOn(p Particle2, theta float64, f Force2) (force r2.Vec) {
 	m := p.Mass()
 	pv := p.Coord2()
 	for _, e := range q.Particles {
-		v = r2.Add(v, f(p, e, m, e.Mass(), r2.Sub(e.Coord2(), pv)))
+		v = r2.Add(v, f(p, e, m, e.Mass(), minImage2(r2.Sub(e.Coord2(), pv), q.Period)))
 	}
 	return v
 }
 
+// Forces returns the force vectors acting on each of the particles in
+// q.Particles, calculated as for ForceOn. The force on q.Particles[i] is
+// stored in dst[i]. If dst is nil, a new slice is allocated, otherwise
+// dst must have the same length as q.Particles. The calculation is
+// distributed over the given number of concurrent workers; if workers
+// is less than one, runtime.GOMAXPROCS(0) workers are used. The result
+// does not depend on the number of workers.
+func (q *Plane) Forces(dst []r2.Vec, theta float64, f Force2, workers int) []r2.Vec {
+	if dst == nil {
+		dst = make([]r2.Vec, len(q.Particles))
+	}
+	if len(dst) != len(q.Particles) {
+		panic("barneshut: destination length mismatch")
+	}
+	parallelFor(len(q.Particles), workers, func(i int) {
+		dst[i] = q.ForceOn(q.Particles[i], theta, f)
+	})
+	return dst
+}
+
 // tile is a quad tree quadrant with Barnes-Hut extensions.
 type tile struct {
 	particle Particle2
@@ -256,19 +302,56 @@ func (t *tile) summarize() (center r2.Vec, mass float64) {
 
 // forceOn returns a force vector on p given p's mass m and the force
 // calculation function, using the Barnes-Hut theta approximation parameter.
-func (t *tile) forceOn(p Particle2, pt r2.Vec, m, theta float64, f Force2) (vector r2.Vec) {
+// Displacements are wrapped according to period.
+func (t *tile) forceOn(p Particle2, pt r2.Vec, m, theta float64, f Force2, period r2.Vec) (vector r2.Vec) {
 	s := ((t.bounds.Max.X - t.bounds.Min.X) + (t.bounds.Max.Y - t.bounds.Min.Y)) / 2
-	d := math.Hypot(pt.X-t.center.X, pt.Y-t.center.Y)
-	if s/d < theta || t.particle != nil {
-		return f(p, t.particle, m, t.mass, r2.Sub(t.center, pt))
+	v := minImage2(r2.Sub(t.center, pt), period)
+	d := math.Hypot(v.X, v.Y)
+	if t.particle != nil || (s/d < theta && t.withinImage(pt, v, period)) {
+		return f(p, t.particle, m, t.mass, v)
 	}
 
-	var v r2.Vec
+	v = r2.Vec{}
 	for _, d := range &t.nodes {
 		if d == nil {
 			continue
 		}
-		v = r2.Add(v, d.forceOn(p, pt, m, theta, f))
+		v = r2.Add(v, d.forceOn(p, pt, m, theta, f, period))
+	}
+	return v
+}
+
+// withinImage returns whether all of t lies within half a period
+// of pt in each periodic dimension when t's center of mass is
+// displaced from pt by v. The Barnes-Hut approximation is only valid
+// for nodes that do not straddle the periodic image boundary.
+func (t *tile) withinImage(pt, v, period r2.Vec) bool {
+	shift := r2.Sub(v, r2.Sub(t.center, pt))
+	if period.X > 0 {
+		lo := t.bounds.Min.X - pt.X + shift.X
+		hi := t.bounds.Max.X - pt.X + shift.X
+		if lo < -period.X/2 || period.X/2 < hi {
+			return false
+		}
+	}
+	if period.Y > 0 {
+		lo := t.bounds.Min.Y - pt.Y + shift.Y
+		hi := t.bounds.Max.Y - pt.Y + shift.Y
+		if lo < -period.Y/2 || period.Y/2 < hi {
+			return false
+		}
+	}
+	return true
+}
+
+// minImage2 returns the displacement v wrapped to its nearest
+// periodic image for each positive component of period.
+func minImage2(v, period r2.Vec) r2.Vec {
+	if period.X > 0 {
+		v.X -= period.X * math.Round(v.X/period.X)
+	}
+	if period.Y > 0 {
+		v.Y -= period.Y * math.Round(v.Y/period.Y)
 	}
 	return v
 }
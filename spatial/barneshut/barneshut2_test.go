@@ -464,6 +464,111 @@ func TestPlaneForceOn(t *testing.T) {
 	}
 }
 
+func TestPlanePeriodic(t *testing.T) {
+	t.Parallel()
+	const (
+		n    = 2000
+		size = 100
+		tol  = 0.05
+	)
+	rnd := rand.New(rand.NewSource(1))
+	particles := make([]Particle2, n)
+	for i := range particles {
+		particles[i] = particle2{x: size * rnd.Float64(), y: size * rnd.Float64(), m: 1}
+	}
+	period := r2.Vec{X: size, Y: size}
+
+	plane, err := NewPlane(particles)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	plane.Period = period
+
+	for _, theta := range []float64{0.3, 0.6} {
+		var ssd, ss float64
+		for _, p := range particles {
+			var want r2.Vec
+			m := p.Mass()
+			pv := p.Coord2()
+			for _, e := range particles {
+				v := minImage2(r2.Sub(e.Coord2(), pv), period)
+				want = r2.Add(want, Gravity2(p, e, m, e.Mass(), v))
+			}
+			got := plane.ForceOn(p, theta, Gravity2)
+			d := r2.Sub(got, want)
+			ssd += r2.Dot(d, d)
+			ss += r2.Dot(want, want)
+		}
+		if rel := math.Sqrt(ssd / ss); rel > tol {
+			t.Errorf("relative error for periodic approximation too high with theta=%v: %v", theta, rel)
+		}
+	}
+
+	// A pair of particles close across the boundary
+	// attract each other through the boundary.
+	pair := []Particle2{
+		particle2{x: 1, y: size / 2, m: 1},
+		particle2{x: size - 1, y: size / 2, m: 1},
+	}
+	plane, err = NewPlane(pair)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	plane.Period = period
+	for _, theta := range []float64{0, 0.5} {
+		got := plane.ForceOn(pair[0], theta, Gravity2)
+		want := r2.Vec{X: -0.25}
+		if r2.Norm(r2.Sub(got, want)) > 1e-12 {
+			t.Errorf("unexpected force across boundary with theta=%v: got:%v want:%v", theta, got, want)
+		}
+	}
+}
+
+func TestSoftenedGravity2(t *testing.T) {
+	t.Parallel()
+	v := r2.Vec{X: 3, Y: 4}
+	if got, want := SoftenedGravity2(0)(nil, nil, 2, 3, v), Gravity2(nil, nil, 2, 3, v); got != want {
+		t.Errorf("unexpected unsoftened force: got:%v want:%v", got, want)
+	}
+	got := SoftenedGravity2(5)(nil, nil, 2, 3, v)
+	want := r2.Scale(6/(50*math.Sqrt(50)), v)
+	if r2.Norm(r2.Sub(got, want)) > 1e-15 {
+		t.Errorf("unexpected softened force: got:%v want:%v", got, want)
+	}
+	if got := SoftenedGravity2(5)(nil, nil, 2, 3, r2.Vec{}); got != (r2.Vec{}) {
+		t.Errorf("unexpected force for coincident particles: got:%v want:zero", got)
+	}
+}
+
+func TestPlaneForces(t *testing.T) {
+	t.Parallel()
+	const (
+		n    = 1000
+		size = 1000
+	)
+	rnd := rand.New(rand.NewSource(1))
+	particles := make([]Particle2, n)
+	for i := range particles {
+		particles[i] = particle2{x: size * rnd.Float64(), y: size * rnd.Float64(), m: 1}
+	}
+	plane, err := NewPlane(particles)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, theta := range []float64{0, 0.5} {
+		want := make([]r2.Vec, n)
+		for i, p := range particles {
+			want[i] = plane.ForceOn(p, theta, Gravity2)
+		}
+		for _, workers := range []int{0, 1, 4, 2 * n} {
+			got := plane.Forces(nil, theta, Gravity2, workers)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("unexpected forces with theta=%v workers=%d", theta, workers)
+			}
+		}
+	}
+}
+
 var (
 	fv2sink   r2.Vec
 	planeSink *Plane
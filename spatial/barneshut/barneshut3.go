@@ -40,11 +40,37 @@ func Gravity3(_, _ Particle3, m1, m2 float64, v r3.Vec) r3.Vec {
 	return r3.Scale((m1*m2)/(d2*math.Sqrt(d2)), v)
 }
 
+// SoftenedGravity3 returns a Force3 that calculates a Plummer softened
+// gravitational force on m1 by m2, equal to (m1⋅m2)⋅v/(‖v‖²+ε²)^(3/2)
+// where ε is the softening length eps. Softening limits the magnitude
+// of the force between close particles. SoftenedGravity3 ignores the
+// identity of the interacting particles and returns a zero vector when
+// the two particles are coincident.
+func SoftenedGravity3(eps float64) Force3 {
+	eps2 := eps * eps
+	return func(_, _ Particle3, m1, m2 float64, v r3.Vec) r3.Vec {
+		d2 := v.X*v.X + v.Y*v.Y + v.Z*v.Z
+		if d2 == 0 {
+			return r3.Vec{}
+		}
+		d2 += eps2
+		return r3.Scale((m1*m2)/(d2*math.Sqrt(d2)), v)
+	}
+}
+
 // Volume implements Barnes-Hut force approximation calculations.
 type Volume struct {
 	root bucket
 
 	Particles []Particle3
+
+	// Period specifies periodic boundary conditions for the
+	// volume. If a component of Period is positive, the volume is
+	// treated as periodic in that dimension with the given
+	// period and interactions are calculated between nearest
+	// periodic images using the minimum image convention.
+	// Particles must lie within a single period of the domain.
+	Period r3.Vec
 }
 
 // NewVolume returns a new Volume. If the volume is too large to allow
@@ -132,7 +158,7 @@ var volumeTooBig = errors.New("barneshut: volume too big")
 func (q *Volume) ForceOn(p Particle3, theta float64, f Force3) (force r3.Vec) {
 	var empty bucket
 	if theta > 0 && q.root != empty {
-		return q.root.forceOn(p, p.Coord3(), p.Mass(), theta, f)
+		return q.root.forceOn(p, p.Coord3(), p.Mass(), theta, f, q.Period)
 	}
 
 	// For the degenerate case, just iterate over the
@@ -141,11 +167,31 @@ func (q *Volume) ForceOn(p Particle3, theta float64, f Force3) (force r3.Vec) {
 	m := p.Mass()
 	pv := p.Coord3()
 	for _, e := range q.Particles {
-		v = r3.Add(v, f(p, e, m, e.Mass(), r3.Sub(e.Coord3(), pv)))
+		v = r3.Add(v, f(p, e, m, e.Mass(), minImage3(r3.Sub(e.Coord3(), pv), q.Period)))
 	}
 	return v
 }
 
+// Forces returns the force vectors acting on each of the particles in
+// q.Particles, calculated as for ForceOn. The force on q.Particles[i] is
+// stored in dst[i]. If dst is nil, a new slice is allocated, otherwise
+// dst must have the same length as q.Particles. The calculation is
+// distributed over the given number of concurrent workers; if workers
+// is less than one, runtime.GOMAXPROCS(0) workers are used. The result
+// does not depend on the number of workers.
+func (q *Volume) Forces(dst []r3.Vec, theta float64, f Force3, workers int) []r3.Vec {
+	if dst == nil {
+		dst = make([]r3.Vec, len(q.Particles))
+	}
+	if len(dst) != len(q.Particles) {
+		panic("barneshut: destination length mismatch")
+	}
+	parallelFor(len(q.Particles), workers, func(i int) {
+		dst[i] = q.ForceOn(q.Particles[i], theta, f)
+	})
+	return dst
+}
+
 // bucket is an oct tree octant with Barnes-Hut extensions.
 type bucket struct {
 	particle Particle3
@@ -307,19 +353,66 @@ func (b *bucket) summarize() (center r3.Vec, mass float64) {
 
 // forceOn returns a force vector on p given p's mass m and the force
 // calculation function, using the Barnes-Hut theta approximation parameter.
-func (b *bucket) forceOn(p Particle3, pt r3.Vec, m, theta float64, f Force3) (vector r3.Vec) {
+// Displacements are wrapped according to period.
+func (b *bucket) forceOn(p Particle3, pt r3.Vec, m, theta float64, f Force3, period r3.Vec) (vector r3.Vec) {
 	s := ((b.bounds.Max.X - b.bounds.Min.X) + (b.bounds.Max.Y - b.bounds.Min.Y) + (b.bounds.Max.Z - b.bounds.Min.Z)) / 3
-	d := math.Hypot(math.Hypot(pt.X-b.center.X, pt.Y-b.center.Y), pt.Z-b.center.Z)
-	if s/d < theta || b.particle != nil {
-		return f(p, b.particle, m, b.mass, r3.Sub(b.center, pt))
+	v := minImage3(r3.Sub(b.center, pt), period)
+	d := math.Hypot(math.Hypot(v.X, v.Y), v.Z)
+	if b.particle != nil || (s/d < theta && b.withinImage(pt, v, period)) {
+		return f(p, b.particle, m, b.mass, v)
 	}
 
-	var v r3.Vec
+	v = r3.Vec{}
 	for _, d := range &b.nodes {
 		if d == nil {
 			continue
 		}
-		v = r3.Add(v, d.forceOn(p, pt, m, theta, f))
+		v = r3.Add(v, d.forceOn(p, pt, m, theta, f, period))
+	}
+	return v
+}
+
+// withinImage returns whether all of b lies within half a period
+// of pt in each periodic dimension when b's center of mass is
+// displaced from pt by v. The Barnes-Hut approximation is only valid
+// for nodes that do not straddle the periodic image boundary.
+func (b *bucket) withinImage(pt, v, period r3.Vec) bool {
+	shift := r3.Sub(v, r3.Sub(b.center, pt))
+	if period.X > 0 {
+		lo := b.bounds.Min.X - pt.X + shift.X
+		hi := b.bounds.Max.X - pt.X + shift.X
+		if lo < -period.X/2 || period.X/2 < hi {
+			return false
+		}
+	}
+	if period.Y > 0 {
+		lo := b.bounds.Min.Y - pt.Y + shift.Y
+		hi := b.bounds.Max.Y - pt.Y + shift.Y
+		if lo < -period.Y/2 || period.Y/2 < hi {
+			return false
+		}
+	}
+	if period.Z > 0 {
+		lo := b.bounds.Min.Z - pt.Z + shift.Z
+		hi := b.bounds.Max.Z - pt.Z + shift.Z
+		if lo < -period.Z/2 || period.Z/2 < hi {
+			return false
+		}
+	}
+	return true
+}
+
+// minImage3 returns the displacement v wrapped to its nearest
+// periodic image for each positive component of period.
+func minImage3(v, period r3.Vec) r3.Vec {
+	if period.X > 0 {
+		v.X -= period.X * math.Round(v.X/period.X)
+	}
+	if period.Y > 0 {
+		v.Y -= period.Y * math.Round(v.Y/period.Y)
+	}
+	if period.Z > 0 {
+		v.Z -= period.Z * math.Round(v.Z/period.Z)
 	}
 	return v
 }
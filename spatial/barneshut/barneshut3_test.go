@@ -461,6 +461,111 @@ func TestVolumeForceOn(t *testing.T) {
 	}
 }
 
+func TestVolumePeriodic(t *testing.T) {
+	t.Parallel()
+	const (
+		n    = 2000
+		size = 100
+		tol  = 0.05
+	)
+	rnd := rand.New(rand.NewSource(1))
+	particles := make([]Particle3, n)
+	for i := range particles {
+		particles[i] = particle3{x: size * rnd.Float64(), y: size * rnd.Float64(), z: size * rnd.Float64(), m: 1}
+	}
+	period := r3.Vec{X: size, Y: size, Z: size}
+
+	volume, err := NewVolume(particles)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	volume.Period = period
+
+	for _, theta := range []float64{0.3, 0.6} {
+		var ssd, ss float64
+		for _, p := range particles {
+			var want r3.Vec
+			m := p.Mass()
+			pv := p.Coord3()
+			for _, e := range particles {
+				v := minImage3(r3.Sub(e.Coord3(), pv), period)
+				want = r3.Add(want, Gravity3(p, e, m, e.Mass(), v))
+			}
+			got := volume.ForceOn(p, theta, Gravity3)
+			d := r3.Sub(got, want)
+			ssd += r3.Dot(d, d)
+			ss += r3.Dot(want, want)
+		}
+		if rel := math.Sqrt(ssd / ss); rel > tol {
+			t.Errorf("relative error for periodic approximation too high with theta=%v: %v", theta, rel)
+		}
+	}
+
+	// A pair of particles close across the boundary
+	// attract each other through the boundary.
+	pair := []Particle3{
+		particle3{x: 1, y: size / 2, m: 1},
+		particle3{x: size - 1, y: size / 2, m: 1},
+	}
+	volume, err = NewVolume(pair)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	volume.Period = period
+	for _, theta := range []float64{0, 0.5} {
+		got := volume.ForceOn(pair[0], theta, Gravity3)
+		want := r3.Vec{X: -0.25}
+		if r3.Norm(r3.Sub(got, want)) > 1e-12 {
+			t.Errorf("unexpected force across boundary with theta=%v: got:%v want:%v", theta, got, want)
+		}
+	}
+}
+
+func TestSoftenedGravity3(t *testing.T) {
+	t.Parallel()
+	v := r3.Vec{X: 3, Y: 4}
+	if got, want := SoftenedGravity3(0)(nil, nil, 2, 3, v), Gravity3(nil, nil, 2, 3, v); got != want {
+		t.Errorf("unexpected unsoftened force: got:%v want:%v", got, want)
+	}
+	got := SoftenedGravity3(5)(nil, nil, 2, 3, v)
+	want := r3.Scale(6/(50*math.Sqrt(50)), v)
+	if r3.Norm(r3.Sub(got, want)) > 1e-15 {
+		t.Errorf("unexpected softened force: got:%v want:%v", got, want)
+	}
+	if got := SoftenedGravity3(5)(nil, nil, 2, 3, r3.Vec{}); got != (r3.Vec{}) {
+		t.Errorf("unexpected force for coincident particles: got:%v want:zero", got)
+	}
+}
+
+func TestVolumeForces(t *testing.T) {
+	t.Parallel()
+	const (
+		n    = 1000
+		size = 1000
+	)
+	rnd := rand.New(rand.NewSource(1))
+	particles := make([]Particle3, n)
+	for i := range particles {
+		particles[i] = particle3{x: size * rnd.Float64(), y: size * rnd.Float64(), z: size * rnd.Float64(), m: 1}
+	}
+	volume, err := NewVolume(particles)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, theta := range []float64{0, 0.5} {
+		want := make([]r3.Vec, n)
+		for i, p := range particles {
+			want[i] = volume.ForceOn(p, theta, Gravity3)
+		}
+		for _, workers := range []int{0, 1, 4, 2 * n} {
+			got := volume.Forces(nil, theta, Gravity3, workers)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("unexpected forces with theta=%v workers=%d", theta, workers)
+			}
+		}
+	}
+}
+
 var (
 	fv3sink    r3.Vec
 	volumeSink *Volume
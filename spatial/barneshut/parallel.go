@@ -0,0 +1,42 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package barneshut
+
+import (
+	"runtime"
+	"sync"
+)
+
+// parallelFor calls fn for each index in [0, n) using the given number of
+// concurrent workers. If workers is less than one, runtime.GOMAXPROCS(0)
+// workers are used.
+func parallelFor(n, workers int, fn func(i int)) {
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > n {
+		workers = n
+	}
+	if workers <= 1 {
+		for i := 0; i < n; i++ {
+			fn(i)
+		}
+		return
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func(w int) {
+			defer wg.Done()
+			// Interleave indices so that workers
+			// share spatially clustered particles.
+			for i := w; i < n; i += workers {
+				fn(i)
+			}
+		}(w)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,282 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package covertree
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+	"sort"
+)
+
+// Comparable is the element interface for values stored in a cover tree.
+type Comparable interface {
+	// Distance returns the distance between the receiver and the
+	// parameter. The returned distance must satisfy the properties
+	// of distances in a metric space.
+	//
+	// - a.Distance(a) == 0
+	// - a.Distance(b) >= 0
+	// - a.Distance(b) == b.Distance(a)
+	// - a.Distance(b) <= a.Distance(c)+c.Distance(b)
+	//
+	Distance(Comparable) float64
+}
+
+// Point represents a point in a Euclidean k-d space that satisfies the Comparable
+// interface.
+type Point []float64
+
+// Distance returns the Euclidean distance between c and the receiver. The concrete
+// type of c must be Point.
+func (p Point) Distance(c Comparable) float64 {
+	q := c.(Point)
+	var sum float64
+	for dim, c := range p {
+		d := c - q[dim]
+		sum += d * d
+	}
+	return math.Sqrt(sum)
+}
+
+// Node holds a single point value in a cover tree. Each child of a node
+// at level l is within 2^l of the node, and MaxDist is the greatest
+// distance between the node and any value stored in its subtree.
+type Node struct {
+	Point    Comparable
+	Level    int
+	MaxDist  float64
+	Children []*Node
+}
+
+// coverDist returns the covering distance of the node.
+func (n *Node) coverDist() float64 {
+	return math.Ldexp(1, n.Level)
+}
+
+// Tree implements a cover tree creation and nearest neighbor search.
+type Tree struct {
+	Root  *Node
+	Count int
+}
+
+// New returns a cover tree constructed from the values in p. Points in p
+// must not be infinitely distant.
+func New(p []Comparable) (*Tree, error) {
+	var t Tree
+	for _, c := range p {
+		err := t.Insert(c)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &t, nil
+}
+
+var pointAtInfinity = errors.New("covertree: point at infinity")
+
+// Insert adds a value to the tree. If c is infinitely distant from the
+// root of the tree, Insert returns an error and the tree is not altered.
+func (t *Tree) Insert(c Comparable) error {
+	if t.Root == nil {
+		t.Root = &Node{Point: c}
+		t.Count++
+		return nil
+	}
+	d := t.Root.Point.Distance(c)
+	if math.IsInf(d, 0) || math.IsNaN(d) {
+		return pointAtInfinity
+	}
+	if d > t.Root.coverDist() {
+		// Raise the level of the root until it covers c.
+		// This preserves the covering invariant for the
+		// existing children of the root.
+		_, e := math.Frexp(d)
+		t.Root.Level = e
+	}
+	t.Root.insert(c, d)
+	t.Count++
+	return nil
+}
+
+// insert adds c, at distance d from n, to the subtree rooted at n.
+// The value c must be within the covering distance of n.
+func (n *Node) insert(c Comparable, d float64) {
+	n.MaxDist = math.Max(n.MaxDist, d)
+	for _, ch := range n.Children {
+		dc := ch.Point.Distance(c)
+		if dc <= ch.coverDist() {
+			ch.insert(c, dc)
+			return
+		}
+	}
+	n.Children = append(n.Children, &Node{Point: c, Level: n.Level - 1})
+}
+
+// Len returns the number of elements in the tree.
+func (t *Tree) Len() int { return t.Count }
+
+var inf = math.Inf(1)
+
+// Nearest returns the nearest value to the query and the distance between them.
+func (t *Tree) Nearest(q Comparable) (Comparable, float64) {
+	if t.Root == nil {
+		return nil, inf
+	}
+	k := NewNKeeper(1)
+	t.NearestSet(k, q)
+	if len(k.Heap) == 0 {
+		return nil, inf
+	}
+	return k.Heap[0].Comparable, k.Heap[0].Dist
+}
+
+// ComparableDist holds a Comparable and a distance to a specific query. A nil Comparable
+// is used to mark the end of the heap, so clients should not store nil values except for
+// this purpose.
+type ComparableDist struct {
+	Comparable Comparable
+	Dist       float64
+}
+
+// Heap is a max heap sorted on Dist.
+type Heap []ComparableDist
+
+func (h *Heap) Max() ComparableDist  { return (*h)[0] }
+func (h *Heap) Len() int             { return len(*h) }
+func (h *Heap) Less(i, j int) bool   { return (*h)[i].Comparable == nil || (*h)[i].Dist > (*h)[j].Dist }
+func (h *Heap) Swap(i, j int)        { (*h)[i], (*h)[j] = (*h)[j], (*h)[i] }
+func (h *Heap) Push(x interface{})   { (*h) = append(*h, x.(ComparableDist)) }
+func (h *Heap) Pop() (i interface{}) { i, *h = (*h)[len(*h)-1], (*h)[:len(*h)-1]; return i }
+
+// NKeeper is a Keeper that retains the n best ComparableDists that have been passed to Keep.
+type NKeeper struct {
+	Heap
+}
+
+// NewNKeeper returns an NKeeper with the max value of the heap set to infinite distance. The
+// returned NKeeper is able to retain at most n values.
+func NewNKeeper(n int) *NKeeper {
+	k := NKeeper{make(Heap, 1, n)}
+	k.Heap[0].Dist = inf
+	return &k
+}
+
+// Keep adds c to the heap if its distance is less than the maximum value of the heap. If adding
+// c would increase the size of the heap beyond the initial maximum length, the maximum value of
+// the heap is dropped.
+func (k *NKeeper) Keep(c ComparableDist) {
+	if c.Dist <= k.Heap[0].Dist { // Favour later finds to displace sentinel.
+		if len(k.Heap) == cap(k.Heap) {
+			heap.Pop(k)
+		}
+		heap.Push(k, c)
+	}
+}
+
+// DistKeeper is a Keeper that retains the ComparableDists within the specified distance of the
+// query that it is called to Keep.
+type DistKeeper struct {
+	Heap
+}
+
+// NewDistKeeper returns an DistKeeper with the maximum value of the heap set to d.
+func NewDistKeeper(d float64) *DistKeeper { return &DistKeeper{Heap{{Dist: d}}} }
+
+// Keep adds c to the heap if its distance is less than or equal to the max value of the heap.
+func (k *DistKeeper) Keep(c ComparableDist) {
+	if c.Dist <= k.Heap[0].Dist {
+		heap.Push(k, c)
+	}
+}
+
+// Keeper implements a conditional max heap sorted on the Dist field of the ComparableDist type.
+// Cover tree search is guided by the distance stored in the max value of the heap.
+type Keeper interface {
+	Keep(ComparableDist) // Keep conditionally pushes the provided ComparableDist onto the heap.
+	Max() ComparableDist // Max returns the maximum element of the Keeper.
+	heap.Interface
+}
+
+// NearestSet finds the nearest values to the query accepted by the provided Keeper, k.
+// k must be able to return a ComparableDist specifying the maximum acceptable distance
+// when Max() is called, and retains the results of the search in min sorted order after
+// the call to NearestSet returns.
+// If a sentinel ComparableDist with a nil Comparable is used by the Keeper to mark the
+// maximum distance, NearestSet will remove it before returning.
+func (t *Tree) NearestSet(k Keeper, q Comparable) {
+	if t.Root == nil {
+		return
+	}
+	t.Root.searchSet(q, q.Distance(t.Root.Point), k)
+
+	// Check whether we have retained a sentinel
+	// and flag removal if we have.
+	removeSentinel := k.Len() != 0 && k.Max().Comparable == nil
+
+	sort.Sort(sort.Reverse(k))
+
+	// This abuses the interface to drop the max.
+	// It is reasonable to do this because we know
+	// that the maximum value will now be at element
+	// zero, which is removed by the Pop method.
+	if removeSentinel {
+		k.Pop()
+	}
+}
+
+// searchSet offers the values in the subtree rooted at n to k. The
+// distance from q to n.Point is d.
+func (n *Node) searchSet(q Comparable, d float64, k Keeper) {
+	if d-n.MaxDist > k.Max().Dist {
+		return
+	}
+	k.Keep(ComparableDist{Comparable: n.Point, Dist: d})
+	if len(n.Children) == 0 {
+		return
+	}
+
+	// Visit the children in order of increasing
+	// distance to improve pruning.
+	dists := make([]float64, len(n.Children))
+	order := make([]int, len(n.Children))
+	for i, ch := range n.Children {
+		dists[i] = q.Distance(ch.Point)
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool { return dists[order[i]] < dists[order[j]] })
+	for _, i := range order {
+		n.Children[i].searchSet(q, dists[i], k)
+	}
+}
+
+// Operation is a function that operates on a Comparable. The tree depth of the point
+// is also provided. If done is returned true, the Operation is indicating that no
+// further work needs to be done and so the Do function should traverse no further.
+type Operation func(Comparable, int) (done bool)
+
+// Do performs fn on all values stored in the tree. A boolean is returned indicating whether the
+// Do traversal was interrupted by an Operation returning true. If fn alters stored values' distance
+// relationships, future tree operation behaviors are undefined.
+func (t *Tree) Do(fn Operation) bool {
+	if t.Root == nil {
+		return false
+	}
+	return t.Root.do(fn, 0)
+}
+
+func (n *Node) do(fn Operation, depth int) (done bool) {
+	done = fn(n.Point, depth)
+	if done {
+		return
+	}
+	for _, ch := range n.Children {
+		done = ch.do(fn, depth+1)
+		if done {
+			return
+		}
+	}
+	return
+}
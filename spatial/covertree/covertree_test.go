@@ -0,0 +1,204 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package covertree
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+var wpData = []Comparable{
+	Point{2, 3},
+	Point{5, 4},
+	Point{9, 6},
+	Point{4, 7},
+	Point{8, 1},
+	Point{7, 2},
+}
+
+func randData(rnd *rand.Rand, n, dims int) []Comparable {
+	p := make([]Comparable, n)
+	for i := range p {
+		v := make(Point, dims)
+		for j := range v {
+			v[j] = 1000 * rnd.Float64()
+		}
+		p[i] = v
+	}
+	return p
+}
+
+func TestNew(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, data := range [][]Comparable{
+		nil,
+		wpData[:1],
+		wpData[:2],
+		wpData,
+		{Point{1, 1}, Point{1, 1}, Point{1, 1}},
+		randData(rnd, 1000, 3),
+	} {
+		data = append([]Comparable(nil), data...)
+		tree, err := New(data)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if tree.Len() != len(data) {
+			t.Errorf("unexpected tree length: got:%d want:%d", tree.Len(), len(data))
+		}
+		var n int
+		tree.Do(func(Comparable, int) bool { n++; return false })
+		if n != len(data) {
+			t.Errorf("unexpected number of values in tree: got:%d want:%d", n, len(data))
+		}
+		if tree.Root != nil && !tree.Root.isCoverTree() {
+			t.Error("tree is not a cover tree")
+		}
+	}
+
+	_, err := New([]Comparable{Point{0}, Point{math.Inf(1)}, Point{1}})
+	if err != pointAtInfinity {
+		t.Errorf("unexpected error for point at infinity: got:%v want:%v", err, pointAtInfinity)
+	}
+}
+
+// isCoverTree returns whether each child is within the covering
+// distance of its parent and all values in each subtree are within
+// MaxDist of the subtree's root.
+func (n *Node) isCoverTree() bool {
+	ok := true
+	var check func(m *Node)
+	check = func(m *Node) {
+		if n.Point.Distance(m.Point) > n.MaxDist {
+			ok = false
+		}
+		for _, c := range m.Children {
+			check(c)
+		}
+	}
+	for _, c := range n.Children {
+		if c.Level >= n.Level || n.Point.Distance(c.Point) > n.coverDist() {
+			return false
+		}
+		check(c)
+		if !c.isCoverTree() {
+			return false
+		}
+	}
+	return ok
+}
+
+func TestInsert(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := randData(rnd, 500, 2)
+	var tree Tree
+	for i, p := range data {
+		if err := tree.Insert(p); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if tree.Len() != i+1 {
+			t.Errorf("unexpected tree length: got:%d want:%d", tree.Len(), i+1)
+		}
+	}
+	if !tree.Root.isCoverTree() {
+		t.Error("tree is not a cover tree after insertion")
+	}
+	if err := tree.Insert(Point{math.Inf(1), 0}); err != pointAtInfinity {
+		t.Errorf("unexpected error for point at infinity: got:%v want:%v", err, pointAtInfinity)
+	}
+	if tree.Len() != len(data) {
+		t.Errorf("unexpected tree length after failed insertion: got:%d want:%d", tree.Len(), len(data))
+	}
+}
+
+func nearestN(n int, q Comparable, p []Comparable) []float64 {
+	d := make([]float64, len(p))
+	for i, c := range p {
+		d[i] = q.Distance(c)
+	}
+	sort.Float64s(d)
+	if n < len(d) {
+		d = d[:n]
+	}
+	return d
+}
+
+func TestNearest(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := randData(rnd, 5000, 4)
+	want := append([]Comparable(nil), data...)
+	tree, err := New(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i := 0; i < 500; i++ {
+		q := randData(rnd, 1, 4)[0]
+		_, got := tree.Nearest(q)
+		if w := nearestN(1, q, want)[0]; got != w {
+			t.Errorf("unexpected nearest distance for query %d: got:%v want:%v", i, got, w)
+		}
+	}
+
+	empty, _ := New(nil)
+	if p, d := empty.Nearest(Point{0, 0}); p != nil || !math.IsInf(d, 1) {
+		t.Errorf("unexpected result for empty tree: %v %v", p, d)
+	}
+}
+
+func TestNearestSet(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := randData(rnd, 2000, 3)
+	want := append([]Comparable(nil), data...)
+	tree, err := New(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i := 0; i < 100; i++ {
+		q := randData(rnd, 1, 3)[0]
+
+		for _, n := range []int{1, 5, 20} {
+			k := NewNKeeper(n)
+			tree.NearestSet(k, q)
+			w := nearestN(n, q, want)
+			if len(k.Heap) != len(w) {
+				t.Fatalf("unexpected number of neighbors: got:%d want:%d", len(k.Heap), len(w))
+			}
+			for j, c := range k.Heap {
+				if c.Dist != w[j] {
+					t.Errorf("unexpected distance for neighbor %d of query %d: got:%v want:%v", j, i, c.Dist, w[j])
+				}
+			}
+		}
+
+		const r = 100
+		k := NewDistKeeper(r)
+		tree.NearestSet(k, q)
+		var n int
+		for _, c := range want {
+			if q.Distance(c) <= r {
+				n++
+			}
+		}
+		if len(k.Heap) != n {
+			t.Errorf("unexpected number of values within %v of query %d: got:%d want:%d", r, i, len(k.Heap), n)
+		}
+	}
+}
+
+func BenchmarkNearest(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	tree, err := New(randData(rnd, 100000, 3))
+	if err != nil {
+		b.Fatalf("unexpected error: %v", err)
+	}
+	queries := randData(rnd, 1000, 3)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree.Nearest(queries[i%len(queries)])
+	}
+}
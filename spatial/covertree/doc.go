@@ -0,0 +1,13 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package covertree implements a cover tree. Cover trees provide an
+// efficient search for nearest neighbors in a metric space, requiring
+// only a distance function between stored values, and support
+// incremental insertion.
+//
+// See A. Beygelzimer, S. Kakade and J. Langford, "Cover trees for nearest
+// neighbor", ICML 2006 and M. Izbicki and C. R. Shelton, "Faster cover
+// trees", ICML 2015 for details of cover trees.
+package covertree // import "gonum.org/v1/gonum/spatial/covertree"
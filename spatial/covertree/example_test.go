@@ -0,0 +1,66 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package covertree_test
+
+import (
+	"fmt"
+	"log"
+
+	"gonum.org/v1/gonum/spatial/covertree"
+)
+
+// word is a string that satisfies the covertree.Comparable interface
+// using the Levenshtein edit distance.
+type word string
+
+func (w word) Distance(c covertree.Comparable) float64 {
+	a, b := []rune(w), []rune(c.(word))
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return float64(prev[len(b)])
+}
+
+func ExampleTree_editDistance() {
+	var words []covertree.Comparable
+	for _, w := range []string{
+		"gonum", "golang", "gopher", "matrix", "vector",
+		"graph", "spatial", "stat", "optimize", "integrate",
+	} {
+		words = append(words, word(w))
+	}
+	t, err := covertree.New(words)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	q := word("gofer")
+	w, d := t.Nearest(q)
+	fmt.Printf("%s is closest to %s, d=%v\n", w, q, d)
+
+	k := covertree.NewDistKeeper(3)
+	t.NearestSet(k, word("grap"))
+	for _, c := range k.Heap {
+		fmt.Printf("%s d=%v\n", c.Comparable, c.Dist)
+	}
+
+	// Output:
+	// gopher is closest to gofer, d=2
+	// graph d=1
+	// stat d=3
+}
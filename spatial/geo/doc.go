@@ -0,0 +1,7 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package geo provides geodesic calculations on the ellipsoid and
+// map projections between geographic coordinates and planar points.
+package geo // import "gonum.org/v1/gonum/spatial/geo"
@@ -0,0 +1,184 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geo
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+// dms returns the decimal degrees of the given degrees, minutes and seconds.
+func dms(d, m, s float64) float64 {
+	return math.Copysign(math.Abs(d)+m/60+s/3600, d)
+}
+
+func TestInverse(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		p1, p2   LatLon
+		dist     float64
+		az1, az2 float64
+		tol      float64
+	}{
+		{
+			// Example from Vincenty's paper and Geoscience Australia.
+			name: "Flinders Peak to Buninyong",
+			p1:   LatLon{Lat: dms(-37, 57, 3.72030), Lon: dms(144, 25, 29.52440)},
+			p2:   LatLon{Lat: dms(-37, 39, 10.15610), Lon: dms(143, 55, 35.38390)},
+			dist: 54972.271,
+			az1:  dms(306, 52, 5.37) - 360,
+			az2:  dms(127, 10, 25.07) - 180,
+			tol:  1e-3,
+		},
+		{
+			name: "equator",
+			p1:   LatLon{Lat: 0, Lon: 0},
+			p2:   LatLon{Lat: 0, Lon: 1},
+			dist: WGS84.A * deg,
+			az1:  90,
+			az2:  90,
+			tol:  1e-6,
+		},
+		{
+			// Quarter meridian length of the WGS84 ellipsoid.
+			name: "meridian",
+			p1:   LatLon{Lat: 0, Lon: 10},
+			p2:   LatLon{Lat: 90, Lon: 10},
+			dist: 10001965.729,
+			az1:  0,
+			az2:  0,
+			tol:  1e-3,
+		},
+		{
+			name: "coincident",
+			p1:   LatLon{Lat: 51.5, Lon: -0.1},
+			p2:   LatLon{Lat: 51.5, Lon: -0.1},
+		},
+	} {
+		dist, az1, az2, err := WGS84.Inverse(test.p1, test.p2)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !scalar.EqualWithinAbs(dist, test.dist, test.tol) {
+			t.Errorf("%s: unexpected distance: got:%.6f want:%.6f", test.name, dist, test.dist)
+		}
+		if !scalar.EqualWithinAbs(az1, test.az1, 1e-5) {
+			t.Errorf("%s: unexpected initial azimuth: got:%v want:%v", test.name, az1, test.az1)
+		}
+		if !scalar.EqualWithinAbs(az2, test.az2, 1e-5) {
+			t.Errorf("%s: unexpected final azimuth: got:%v want:%v", test.name, az2, test.az2)
+		}
+	}
+
+	// Nearly antipodal points may fail to converge.
+	_, _, _, err := WGS84.Inverse(LatLon{Lat: 0, Lon: 0}, LatLon{Lat: 0.5, Lon: 179.7})
+	if err != ErrNoConvergence {
+		t.Errorf("unexpected error for nearly antipodal points: got:%v want:%v", err, ErrNoConvergence)
+	}
+	if d := WGS84.Distance(LatLon{Lat: 0, Lon: 0}, LatLon{Lat: 0.5, Lon: 179.7}); !math.IsNaN(d) {
+		t.Errorf("unexpected distance for nearly antipodal points: got:%v want:NaN", d)
+	}
+}
+
+func TestDirectInverse(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		p := LatLon{Lat: 170*rnd.Float64() - 85, Lon: 360*rnd.Float64() - 180}
+		az := 360*rnd.Float64() - 180
+		dist := 1e7 * rnd.Float64()
+		q, az2 := WGS84.Direct(p, az, dist)
+
+		gotDist, gotAz1, gotAz2, err := WGS84.Inverse(p, q)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if !scalar.EqualWithinAbs(gotDist, dist, 1e-4) {
+			t.Errorf("unexpected distance from %v at %v: got:%v want:%v", p, az, gotDist, dist)
+		}
+		if !scalar.EqualWithinAbs(gotAz1, az, 1e-7) {
+			t.Errorf("unexpected initial azimuth from %v: got:%v want:%v", p, gotAz1, az)
+		}
+		if d := math.Abs(normalizeLon(gotAz2 - az2)); d > 1e-7 {
+			t.Errorf("unexpected final azimuth from %v: got:%v want:%v", p, gotAz2, az2)
+		}
+	}
+}
+
+func TestWebMercator(t *testing.T) {
+	for _, test := range []struct {
+		p    LatLon
+		want r2.Vec
+	}{
+		{p: LatLon{0, 0}, want: r2.Vec{}},
+		{p: LatLon{0, 180}, want: r2.Vec{X: math.Pi * webMercatorRadius}},
+		{p: LatLon{WebMercatorMaxLat, -180}, want: r2.Vec{X: -math.Pi * webMercatorRadius, Y: math.Pi * webMercatorRadius}},
+	} {
+		got := WebMercator(test.p)
+		if r2.Norm(r2.Sub(got, test.want)) > 1e-6 {
+			t.Errorf("unexpected projection of %v: got:%v want:%v", test.p, got, test.want)
+		}
+	}
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		p := LatLon{Lat: 170*rnd.Float64() - 85, Lon: 360*rnd.Float64() - 180}
+		got := InverseWebMercator(WebMercator(p))
+		if !scalar.EqualWithinAbs(got.Lat, p.Lat, 1e-10) || !scalar.EqualWithinAbs(got.Lon, p.Lon, 1e-10) {
+			t.Errorf("unexpected round trip for %v: got:%v", p, got)
+		}
+	}
+}
+
+func TestUTM(t *testing.T) {
+	for _, test := range []struct {
+		p     LatLon
+		zone  int
+		want  r2.Vec
+		north bool
+	}{
+		{p: LatLon{0, 3}, zone: 31, want: r2.Vec{X: 500000, Y: 0}, north: true},
+		// The northing on the central meridian is the scaled meridian arc.
+		{p: LatLon{45, 3}, zone: 31, want: r2.Vec{X: 500000, Y: 0.9996 * 4984944.378}, north: true},
+		{p: LatLon{-45, -75}, zone: 18, want: r2.Vec{X: 500000, Y: 10000000 - 0.9996*4984944.378}, north: false},
+	} {
+		if zone := UTMZone(test.p); zone != test.zone {
+			t.Errorf("unexpected zone for %v: got:%d want:%d", test.p, zone, test.zone)
+		}
+		got, north := WGS84.UTM(test.p, test.zone)
+		if north != test.north {
+			t.Errorf("unexpected hemisphere for %v: got:%t want:%t", test.p, north, test.north)
+		}
+		if r2.Norm(r2.Sub(got, test.want)) > 1e-3 {
+			t.Errorf("unexpected projection of %v: got:%v want:%v", test.p, got, test.want)
+		}
+	}
+
+	// Points symmetric about the central meridian
+	// have eastings symmetric about the false easting.
+	a, _ := WGS84.UTM(LatLon{Lat: 30, Lon: 1}, 31)
+	b, _ := WGS84.UTM(LatLon{Lat: 30, Lon: 5}, 31)
+	if !scalar.EqualWithinAbs(a.X+b.X, 1e6, 1e-6) || !scalar.EqualWithinAbs(a.Y, b.Y, 1e-6) {
+		t.Errorf("projection not symmetric about central meridian: %v %v", a, b)
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		p := LatLon{Lat: 160*rnd.Float64() - 80, Lon: 360*rnd.Float64() - 180}
+		zone := UTMZone(p)
+		v, north := WGS84.UTM(p, zone)
+		got := WGS84.InverseUTM(v, zone, north)
+		// The round trip error in metres.
+		d := WGS84.Distance(p, got)
+		if d > 1e-3 {
+			t.Errorf("unexpected round trip for %v: got:%v error=%vm", p, got, d)
+		}
+	}
+}
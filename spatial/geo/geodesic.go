@@ -0,0 +1,178 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geo
+
+import (
+	"errors"
+	"math"
+)
+
+// LatLon is a geographic position given by its latitude and longitude
+// in degrees.
+type LatLon struct {
+	Lat, Lon float64
+}
+
+// Ellipsoid is an oblate ellipsoid of revolution used as a model of
+// the shape of the Earth.
+type Ellipsoid struct {
+	// A is the semi-major axis of the ellipsoid in metres.
+	A float64
+	// F is the flattening of the ellipsoid.
+	F float64
+}
+
+// WGS84 is the World Geodetic System 1984 reference ellipsoid.
+var WGS84 = Ellipsoid{A: 6378137, F: 1 / 298.257223563}
+
+// B returns the semi-minor axis of the ellipsoid.
+func (e Ellipsoid) B() float64 {
+	return e.A * (1 - e.F)
+}
+
+// ErrNoConvergence is returned by Inverse when the iterative solution
+// fails to converge, which may happen for nearly antipodal points.
+var ErrNoConvergence = errors.New("geo: geodesic calculation did not converge")
+
+const (
+	deg = math.Pi / 180
+
+	// maxIter is the maximum number of iterations
+	// for Vincenty's formulae.
+	maxIter = 200
+)
+
+// Inverse returns the length in metres of the geodesic between p1 and p2
+// on the ellipsoid and the forward azimuths in degrees of the geodesic at
+// p1 and p2. Azimuths are measured clockwise from north and are in the
+// range (-180, 180]. Inverse uses Vincenty's formulae and returns
+// ErrNoConvergence if the solution does not converge.
+//
+// See T. Vincenty, "Direct and inverse solutions of geodesics on the
+// ellipsoid with application of nested equations", Survey Review
+// 23(176):88–93, 1975.
+func (e Ellipsoid) Inverse(p1, p2 LatLon) (dist, az1, az2 float64, err error) {
+	a, f := e.A, e.F
+	b := e.B()
+
+	l := (p2.Lon - p1.Lon) * deg
+	u1 := math.Atan((1 - f) * math.Tan(p1.Lat*deg))
+	u2 := math.Atan((1 - f) * math.Tan(p2.Lat*deg))
+	sinU1, cosU1 := math.Sincos(u1)
+	sinU2, cosU2 := math.Sincos(u2)
+
+	var (
+		sinSigma, cosSigma, sigma float64
+		cos2Alpha, cos2SigmaM     float64
+		sinLambda, cosLambda      float64
+	)
+	lambda := l
+	converged := false
+	for i := 0; i < maxIter; i++ {
+		sinLambda, cosLambda = math.Sincos(lambda)
+		sinSigma = math.Hypot(cosU2*sinLambda, cosU1*sinU2-sinU1*cosU2*cosLambda)
+		if sinSigma == 0 {
+			// Coincident points.
+			return 0, 0, 0, nil
+		}
+		cosSigma = sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma = math.Atan2(sinSigma, cosSigma)
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cos2Alpha = 1 - sinAlpha*sinAlpha
+		if cos2Alpha != 0 {
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cos2Alpha
+		} else {
+			// Equatorial line.
+			cos2SigmaM = 0
+		}
+		c := f / 16 * cos2Alpha * (4 + f*(4-3*cos2Alpha))
+		prev := lambda
+		lambda = l + (1-c)*f*sinAlpha*(sigma+c*sinSigma*(cos2SigmaM+c*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+		if math.Abs(lambda-prev) < 1e-12 {
+			converged = true
+			break
+		}
+	}
+	if !converged {
+		return math.NaN(), math.NaN(), math.NaN(), ErrNoConvergence
+	}
+
+	uSq := cos2Alpha * (a*a - b*b) / (b * b)
+	bigA := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+	bigB := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+	deltaSigma := bigB * sinSigma * (cos2SigmaM + bigB/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+		bigB/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+	dist = b * bigA * (sigma - deltaSigma)
+
+	az1 = math.Atan2(cosU2*sinLambda, cosU1*sinU2-sinU1*cosU2*cosLambda) / deg
+	az2 = math.Atan2(cosU1*sinLambda, -sinU1*cosU2+cosU1*sinU2*cosLambda) / deg
+	return dist, az1, az2, nil
+}
+
+// Distance returns the length in metres of the geodesic between p1 and p2
+// on the ellipsoid. If the geodesic calculation does not converge, Distance
+// returns NaN.
+func (e Ellipsoid) Distance(p1, p2 LatLon) float64 {
+	d, _, _, err := e.Inverse(p1, p2)
+	if err != nil {
+		return math.NaN()
+	}
+	return d
+}
+
+// Direct returns the position reached by travelling dist metres along the
+// geodesic leaving p with the azimuth az in degrees, and the forward azimuth
+// in degrees of the geodesic at that position. Direct uses Vincenty's
+// formulae.
+func (e Ellipsoid) Direct(p LatLon, az, dist float64) (q LatLon, az2 float64) {
+	a, f := e.A, e.F
+	b := e.B()
+
+	sinAlpha1, cosAlpha1 := math.Sincos(az * deg)
+	tanU1 := (1 - f) * math.Tan(p.Lat*deg)
+	cosU1 := 1 / math.Sqrt(1+tanU1*tanU1)
+	sinU1 := tanU1 * cosU1
+	sigma1 := math.Atan2(tanU1, cosAlpha1)
+	sinAlpha := cosU1 * sinAlpha1
+	cos2Alpha := 1 - sinAlpha*sinAlpha
+	uSq := cos2Alpha * (a*a - b*b) / (b * b)
+	bigA := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+	bigB := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+
+	var sinSigma, cosSigma, cos2SigmaM float64
+	sigma := dist / (b * bigA)
+	for i := 0; i < maxIter; i++ {
+		cos2SigmaM = math.Cos(2*sigma1 + sigma)
+		sinSigma, cosSigma = math.Sincos(sigma)
+		deltaSigma := bigB * sinSigma * (cos2SigmaM + bigB/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+			bigB/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+		prev := sigma
+		sigma = dist/(b*bigA) + deltaSigma
+		if math.Abs(sigma-prev) < 1e-12 {
+			break
+		}
+	}
+	cos2SigmaM = math.Cos(2*sigma1 + sigma)
+	sinSigma, cosSigma = math.Sincos(sigma)
+
+	x := sinU1*sinSigma - cosU1*cosSigma*cosAlpha1
+	lat := math.Atan2(sinU1*cosSigma+cosU1*sinSigma*cosAlpha1, (1-f)*math.Hypot(sinAlpha, x))
+	lambda := math.Atan2(sinSigma*sinAlpha1, cosU1*cosSigma-sinU1*sinSigma*cosAlpha1)
+	c := f / 16 * cos2Alpha * (4 + f*(4-3*cos2Alpha))
+	l := lambda - (1-c)*f*sinAlpha*(sigma+c*sinSigma*(cos2SigmaM+c*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+
+	q = LatLon{Lat: lat / deg, Lon: normalizeLon(p.Lon + l/deg)}
+	az2 = math.Atan2(sinAlpha, -x) / deg
+	return q, az2
+}
+
+// normalizeLon returns lon reduced to the range [-180, 180).
+func normalizeLon(lon float64) float64 {
+	lon = math.Mod(lon+180, 360)
+	if lon < 0 {
+		lon += 360
+	}
+	return lon - 180
+}
@@ -0,0 +1,158 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geo
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/spatial/r2"
+)
+
+// webMercatorRadius is the radius of the sphere used by the
+// Web Mercator projection.
+const webMercatorRadius = 6378137
+
+// WebMercatorMaxLat is the maximum absolute latitude in degrees that can
+// be represented by the Web Mercator projection within its square extent.
+var WebMercatorMaxLat = math.Atan(math.Sinh(math.Pi)) / deg
+
+// WebMercator returns the Web Mercator (EPSG:3857) projection of p in
+// metres. The X component is easting and the Y component is northing.
+func WebMercator(p LatLon) r2.Vec {
+	return r2.Vec{
+		X: webMercatorRadius * p.Lon * deg,
+		Y: webMercatorRadius * math.Log(math.Tan(math.Pi/4+p.Lat*deg/2)),
+	}
+}
+
+// InverseWebMercator returns the geographic position corresponding to the
+// Web Mercator (EPSG:3857) projected point v.
+func InverseWebMercator(v r2.Vec) LatLon {
+	return LatLon{
+		Lat: (2*math.Atan(math.Exp(v.Y/webMercatorRadius)) - math.Pi/2) / deg,
+		Lon: v.X / webMercatorRadius / deg,
+	}
+}
+
+// UTM parameters.
+const (
+	utmScale         = 0.9996
+	utmFalseEasting  = 500e3
+	utmFalseNorthing = 10000e3
+)
+
+// UTMZone returns the Universal Transverse Mercator zone number containing
+// p. The special zones around Norway and Svalbard are not considered.
+func UTMZone(p LatLon) int {
+	zone := int(math.Floor((normalizeLon(p.Lon)+180)/6)) + 1
+	if zone > 60 {
+		zone = 60
+	}
+	return zone
+}
+
+// centralMeridian returns the central meridian of the UTM zone in radians.
+func centralMeridian(zone int) float64 {
+	return (float64(zone-1)*6 - 180 + 3) * deg
+}
+
+// tmSeries holds the series coefficients for the transverse Mercator
+// projection of an ellipsoid.
+//
+// See C. F. F. Karney, "Transverse Mercator with an accuracy of a few
+// nanometers", Journal of Geodesy 85(8):475–485, 2011.
+type tmSeries struct {
+	a           float64 // Rectifying radius.
+	e           float64 // Eccentricity.
+	alpha, beta [3]float64
+	delta       [3]float64
+}
+
+func (e Ellipsoid) tmSeries() tmSeries {
+	n := e.F / (2 - e.F)
+	n2 := n * n
+	n3 := n2 * n
+	return tmSeries{
+		a: e.A / (1 + n) * (1 + n2/4 + n2*n2/64),
+		e: 2 * math.Sqrt(n) / (1 + n),
+		alpha: [3]float64{
+			n/2 - 2*n2/3 + 5*n3/16,
+			13*n2/48 - 3*n3/5,
+			61 * n3 / 240,
+		},
+		beta: [3]float64{
+			n/2 - 2*n2/3 + 37*n3/96,
+			n2/48 + n3/15,
+			17 * n3 / 480,
+		},
+		delta: [3]float64{
+			2*n - 2*n2/3 - 2*n3,
+			7*n2/3 - 8*n3/5,
+			56 * n3 / 15,
+		},
+	}
+}
+
+// UTM returns the Universal Transverse Mercator projection of p on the
+// ellipsoid in the given zone, in metres. The X component is easting and
+// the Y component is northing. If p is in the southern hemisphere, north
+// is false and the northing includes the 10,000 km false northing.
+// The zone for p can be obtained using UTMZone.
+func (e Ellipsoid) UTM(p LatLon, zone int) (v r2.Vec, north bool) {
+	k := e.tmSeries()
+	phi := p.Lat * deg
+	lambda := normalizeLon(p.Lon)*deg - centralMeridian(zone)
+	sinLambda, cosLambda := math.Sincos(lambda)
+
+	sinPhi := math.Sin(phi)
+	t := math.Sinh(math.Atanh(sinPhi) - k.e*math.Atanh(k.e*sinPhi))
+	xi := math.Atan2(t, cosLambda)
+	eta := math.Atanh(sinLambda / math.Sqrt(1+t*t))
+
+	x, y := eta, xi
+	for j, a := range k.alpha {
+		jj := 2 * float64(j+1)
+		s, c := math.Sincos(jj * xi)
+		x += a * c * math.Sinh(jj*eta)
+		y += a * s * math.Cosh(jj*eta)
+	}
+	north = p.Lat >= 0
+	v = r2.Vec{
+		X: utmFalseEasting + utmScale*k.a*x,
+		Y: utmScale * k.a * y,
+	}
+	if !north {
+		v.Y += utmFalseNorthing
+	}
+	return v, north
+}
+
+// InverseUTM returns the geographic position on the ellipsoid of the
+// Universal Transverse Mercator projected point v in the given zone and
+// hemisphere.
+func (e Ellipsoid) InverseUTM(v r2.Vec, zone int, north bool) LatLon {
+	k := e.tmSeries()
+	y := v.Y
+	if !north {
+		y -= utmFalseNorthing
+	}
+	xi := y / (utmScale * k.a)
+	eta := (v.X - utmFalseEasting) / (utmScale * k.a)
+
+	xiP, etaP := xi, eta
+	for j, b := range k.beta {
+		jj := 2 * float64(j+1)
+		s, c := math.Sincos(jj * xi)
+		xiP -= b * s * math.Cosh(jj*eta)
+		etaP -= b * c * math.Sinh(jj*eta)
+	}
+	chi := math.Asin(math.Sin(xiP) / math.Cosh(etaP))
+	phi := chi
+	for j, d := range k.delta {
+		phi += d * math.Sin(2*float64(j+1)*chi)
+	}
+	lambda := centralMeridian(zone) + math.Atan2(math.Sinh(etaP), math.Cos(xiP))
+	return LatLon{Lat: phi / deg, Lon: normalizeLon(lambda / deg)}
+}
@@ -369,7 +369,12 @@ func (t *Tree) NearestSet(k Keeper, q Comparable) {
 		return
 	}
 	t.Root.searchSet(q, k)
+	finishKeeper(k)
+}
 
+// finishKeeper sorts the values retained by k and removes
+// the sentinel if one has been retained.
+func finishKeeper(k Keeper) {
 	// Check whether we have retained a sentinel
 	// and flag removal if we have.
 	removeSentinel := k.Len() != 0 && k.Max().Comparable == nil
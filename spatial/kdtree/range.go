@@ -0,0 +1,154 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kdtree
+
+import "math"
+
+// RangeSearch returns all the values in the tree within the distance r of
+// the query, sorted by ascending distance. The Dist field of each returned
+// ComparableDist holds the squared distance from q as returned by the
+// Distance method.
+func (t *Tree) RangeSearch(q Comparable, r float64) []ComparableDist {
+	if t.Root == nil {
+		return nil
+	}
+	k := NewDistKeeper(r * r)
+	t.NearestSet(k, q)
+	if len(k.Heap) == 0 {
+		return nil
+	}
+	return k.Heap
+}
+
+// BoxSearch returns all the values in the tree that are within the
+// axis-aligned box b, including its boundary. Values are returned in
+// the tree's traversal order. BoxSearch will panic if b is nil.
+func (t *Tree) BoxSearch(b *Bounding) []Comparable {
+	var found []Comparable
+	t.Root.boxSearch(b, &found)
+	return found
+}
+
+func (n *Node) boxSearch(b *Bounding, found *[]Comparable) {
+	if n == nil {
+		return
+	}
+	if n.Bounding != nil && !n.Bounding.overlaps(b) {
+		return
+	}
+	if b.Min.Compare(n.Point, n.Plane) <= 0 {
+		n.Left.boxSearch(b, found)
+	}
+	if b.Contains(n.Point) {
+		*found = append(*found, n.Point)
+	}
+	if 0 <= b.Max.Compare(n.Point, n.Plane) {
+		n.Right.boxSearch(b, found)
+	}
+}
+
+// overlaps returns whether the volumes of a and b intersect.
+func (a *Bounding) overlaps(b *Bounding) bool {
+	for d := Dim(0); d < Dim(a.Min.Dims()); d++ {
+		if a.Max.Compare(b.Min, d) < 0 || 0 < a.Min.Compare(b.Max, d) {
+			return false
+		}
+	}
+	return true
+}
+
+// Periodic describes periodic boundary conditions for a set of Points,
+// for example the simulation cell of a molecular simulation. Points
+// are expected to lie within the half-open box [Min, Max) and distances
+// between points are measured using the minimum image convention.
+type Periodic struct {
+	Min, Max Point
+}
+
+// Wrap returns p translated into the periodic domain.
+func (p Periodic) Wrap(q Point) Point {
+	w := make(Point, len(q))
+	for d, v := range q {
+		l := p.Max[d] - p.Min[d]
+		v -= l * math.Floor((v-p.Min[d])/l)
+		if v >= p.Max[d] {
+			// Guard against rounding up to the upper bound.
+			v = p.Min[d]
+		}
+		w[d] = v
+	}
+	return w
+}
+
+// Distance returns the squared Euclidean distance between the nearest
+// periodic images of a and b.
+func (p Periodic) Distance(a, b Point) float64 {
+	var sum float64
+	for d := range a {
+		l := p.Max[d] - p.Min[d]
+		v := a[d] - b[d]
+		v -= l * math.Round(v/l)
+		sum += v * v
+	}
+	return sum
+}
+
+// NearestSetPeriodic finds the nearest values to the query accepted by the
+// provided Keeper, k, treating the tree's domain as periodic according to p.
+// The values stored in the tree must be Points within the periodic domain.
+// Results are retained in k in the same way as for NearestSet, with
+// distances measured using the minimum image convention.
+//
+// Each stored value is considered once for each periodic image of the query
+// that lies within the search distance of the domain, so if the search
+// distance exceeds half the smallest period of the domain, a value may be
+// kept more than once.
+func (t *Tree) NearestSetPeriodic(k Keeper, q Point, p Periodic) {
+	if t.Root == nil {
+		return
+	}
+	q = p.Wrap(q)
+	t.Root.searchSet(q, k)
+
+	// Search each non-trivial image of the query that
+	// may be within the search distance of the domain.
+	dims := len(q)
+	n := 1
+	for range q {
+		n *= 3
+	}
+	img := make(Point, dims)
+	for i := 1; i < n; i++ {
+		// Decode i as a combination of shifts
+		// in {0, +1, -1} for each dimension.
+		var sum float64
+		c := i
+		for d := range img {
+			s := c % 3
+			c /= 3
+			if s == 0 {
+				img[d] = q[d]
+				continue
+			}
+			l := p.Max[d] - p.Min[d]
+			// Squared distance from the image to the domain.
+			var gap float64
+			if s == 1 {
+				img[d] = q[d] + l
+				gap = img[d] - p.Max[d]
+			} else {
+				img[d] = q[d] - l
+				gap = p.Min[d] - img[d]
+			}
+			if gap > 0 {
+				sum += gap * gap
+			}
+		}
+		if sum <= k.Max().Dist {
+			t.Root.searchSet(append(Point(nil), img...), k)
+		}
+	}
+	finishKeeper(k)
+}
@@ -0,0 +1,167 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package kdtree
+
+import (
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func randPoints(rnd *rand.Rand, n, dims int, scale float64) Points {
+	p := make(Points, n)
+	for i := range p {
+		p[i] = make(Point, dims)
+		for d := range p[i] {
+			p[i][d] = scale * rnd.Float64()
+		}
+	}
+	return p
+}
+
+func TestRangeSearch(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, bounding := range []bool{false, true} {
+		data := randPoints(rnd, 1000, 3, 10)
+		want := append(Points(nil), data...)
+		tree := New(data, bounding)
+		for i := 0; i < 50; i++ {
+			q := randPoints(rnd, 1, 3, 10)[0]
+			r := 3 * rnd.Float64()
+			got := tree.RangeSearch(q, r)
+
+			var n int
+			for _, p := range want {
+				if q.Distance(p) <= r*r {
+					n++
+				}
+			}
+			if len(got) != n {
+				t.Errorf("unexpected number of points within %v of %v: got:%d want:%d", r, q, len(got), n)
+			}
+			if !sort.SliceIsSorted(got, func(i, j int) bool { return got[i].Dist < got[j].Dist }) {
+				t.Error("range search result not sorted")
+			}
+			for _, c := range got {
+				if c.Dist > r*r {
+					t.Errorf("point %v outside range: %v > %v", c.Comparable, c.Dist, r*r)
+				}
+			}
+		}
+	}
+
+	tree := New(Points{}, false)
+	if got := tree.RangeSearch(Point{0, 0}, 1); len(got) != 0 {
+		t.Errorf("unexpected result for empty tree: %v", got)
+	}
+}
+
+func TestBoxSearch(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, bounding := range []bool{false, true} {
+		// Use integer coordinates so that points
+		// lie on box boundaries and have ties.
+		data := make(Points, 500)
+		for i := range data {
+			data[i] = Point{float64(rnd.Intn(20)), float64(rnd.Intn(20))}
+		}
+		want := append(Points(nil), data...)
+		tree := New(data, bounding)
+		for i := 0; i < 50; i++ {
+			x0, x1 := float64(rnd.Intn(20)), float64(rnd.Intn(20))
+			y0, y1 := float64(rnd.Intn(20)), float64(rnd.Intn(20))
+			if x0 > x1 {
+				x0, x1 = x1, x0
+			}
+			if y0 > y1 {
+				y0, y1 = y1, y0
+			}
+			b := &Bounding{Min: Point{x0, y0}, Max: Point{x1, y1}}
+			got := tree.BoxSearch(b)
+			var n int
+			for _, p := range want {
+				if b.Contains(p) {
+					n++
+				}
+			}
+			if len(got) != n {
+				t.Errorf("unexpected number of points in %v: got:%d want:%d", b, len(got), n)
+			}
+			for _, p := range got {
+				if !b.Contains(p) {
+					t.Errorf("point %v outside box %v", p, b)
+				}
+			}
+		}
+	}
+}
+
+func TestPeriodic(t *testing.T) {
+	p := Periodic{Min: Point{0, -1}, Max: Point{10, 1}}
+	for _, test := range []struct {
+		q, want Point
+	}{
+		{q: Point{5, 0}, want: Point{5, 0}},
+		{q: Point{12, 1.5}, want: Point{2, -0.5}},
+		{q: Point{-1, -1.5}, want: Point{9, 0.5}},
+		{q: Point{10, 1}, want: Point{0, -1}},
+	} {
+		got := p.Wrap(test.q)
+		if got.Distance(test.want) > 1e-24 {
+			t.Errorf("unexpected wrapped point for %v: got:%v want:%v", test.q, got, test.want)
+		}
+	}
+	if got := p.Distance(Point{0.5, 0}, Point{9.5, 0}); got != 1 {
+		t.Errorf("unexpected periodic distance: got:%v want:1", got)
+	}
+}
+
+func TestNearestSetPeriodic(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	p := Periodic{Min: Point{0, 0, 0}, Max: Point{10, 10, 10}}
+	data := randPoints(rnd, 1000, 3, 10)
+	want := append(Points(nil), data...)
+	tree := New(data, false)
+	for i := 0; i < 50; i++ {
+		q := randPoints(rnd, 1, 3, 10)[0]
+
+		// Neighbor list within a cutoff.
+		const r = 2.5
+		k := NewDistKeeper(r * r)
+		tree.NearestSetPeriodic(k, q, p)
+		var n int
+		for _, w := range want {
+			if p.Distance(q, w) <= r*r {
+				n++
+			}
+		}
+		if len(k.Heap) != n {
+			t.Errorf("unexpected number of periodic neighbors of %v: got:%d want:%d", q, len(k.Heap), n)
+		}
+		for _, c := range k.Heap {
+			if d := p.Distance(q, c.Comparable.(Point)); d > r*r || d-c.Dist > 1e-12 || c.Dist-d > 1e-12 {
+				t.Errorf("unexpected periodic neighbor distance: got:%v want:%v", c.Dist, d)
+			}
+		}
+
+		// Nearest neighbors.
+		nk := NewNKeeper(5)
+		tree.NearestSetPeriodic(nk, q, p)
+		dists := make([]float64, len(want))
+		for j, w := range want {
+			dists[j] = p.Distance(q, w)
+		}
+		sort.Float64s(dists)
+		if len(nk.Heap) != 5 {
+			t.Fatalf("unexpected number of nearest neighbors: got:%d want:5", len(nk.Heap))
+		}
+		for j, c := range nk.Heap {
+			if d := c.Dist - dists[j]; d > 1e-12 || d < -1e-12 {
+				t.Errorf("unexpected distance for neighbor %d: got:%v want:%v", j, c.Dist, dists[j])
+			}
+		}
+	}
+}
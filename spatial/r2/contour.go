@@ -0,0 +1,213 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r2
+
+import "math"
+
+// Grid is a scalar field sampled on a rectilinear grid. The method set
+// of Grid matches the GridXYZ interface of gonum.org/v1/plot/plotter.
+type Grid interface {
+	// Dims returns the dimensions of the grid.
+	Dims() (c, r int)
+
+	// Z returns the value of a grid value at (c, r).
+	// It will panic if c or r are out of bounds for the grid.
+	Z(c, r int) float64
+
+	// X returns the coordinate for the column at the index c.
+	// It will panic if c is out of bounds for the grid.
+	X(c int) float64
+
+	// Y returns the coordinate for the row at the index r.
+	// It will panic if r is out of bounds for the grid.
+	Y(r int) float64
+}
+
+// Contour returns the iso-contour lines of the field sampled by g at the
+// given level, calculated using the marching squares algorithm with linear
+// interpolation along cell edges. The X and Y coordinates of the grid must
+// be strictly increasing.
+//
+// Each returned polyline is oriented so that values below level are on its
+// left. Closed contours are returned with their first point repeated at the
+// end, and so closed contours around minima have positive area when taken as
+// a Polygon. Open contours start and end on the boundary of the grid. Ambiguous
+// saddle cells are resolved using the mean of the cell's corner values. Cells
+// with a NaN corner value are ignored.
+func Contour(g Grid, level float64) [][]Vec {
+	cols, rows := g.Dims()
+	c := contourer{g: g, level: level, index: make(map[gridEdge]int)}
+	for j := 0; j < rows-1; j++ {
+		for i := 0; i < cols-1; i++ {
+			c.cell(i, j)
+		}
+	}
+	return c.stitch()
+}
+
+// gridEdge is an edge of a grid starting at the grid point (c, r)
+// and extending one cell in the positive x direction when vertical
+// is false or the positive y direction when vertical is true.
+type gridEdge struct {
+	c, r     int
+	vertical bool
+}
+
+// contourer holds the state of a marching squares calculation.
+type contourer struct {
+	g     Grid
+	level float64
+
+	// points holds the crossing points on grid
+	// edges and index maps the grid edges to
+	// their crossing points.
+	points []Vec
+	index  map[gridEdge]int
+
+	// segments holds the directed contour
+	// segments as indices into points.
+	segments [][2]int
+}
+
+// cell adds the contour segments of the grid cell with its lower
+// left corner at (i, j).
+func (c *contourer) cell(i, j int) {
+	// The cell corners in counter-clockwise order
+	// and the edges following each corner.
+	corners := [4][2]int{{i, j}, {i + 1, j}, {i + 1, j + 1}, {i, j + 1}}
+	edges := [4]gridEdge{{i, j, false}, {i + 1, j, true}, {i, j + 1, false}, {i, j, true}}
+
+	var (
+		z     [4]float64
+		above [4]bool
+	)
+	for k, p := range corners {
+		z[k] = c.g.Z(p[0], p[1])
+		if math.IsNaN(z[k]) {
+			return
+		}
+		above[k] = z[k] >= c.level
+	}
+
+	// Find the edges crossed by the contour, noting
+	// those where the walk around the cell passes
+	// from above the level to below it.
+	var down, cross []int
+	for k := range edges {
+		if above[k] != above[(k+1)%4] {
+			cross = append(cross, k)
+			if above[k] {
+				down = append(down, k)
+			}
+		}
+	}
+
+	// Each segment enters the cell on an edge with
+	// a crossing from below to above and leaves on
+	// an edge with a crossing from above to below,
+	// so that the lower values are on its left.
+	switch len(cross) {
+	case 0:
+	case 2:
+		end := down[0]
+		start := cross[0]
+		if start == end {
+			start = cross[1]
+		}
+		c.addSegment(edges[start], edges[end])
+	case 4:
+		// Saddle cell. If the centre is above the level,
+		// the corners below the level are separated from
+		// each other and each segment turns around a corner
+		// below the level, otherwise the segments turn
+		// around the corners above the level.
+		mean := (z[0] + z[1] + z[2] + z[3]) / 4
+		for _, end := range down {
+			start := (end + 1) % 4
+			if mean < c.level {
+				start = (end + 3) % 4
+			}
+			c.addSegment(edges[start], edges[end])
+		}
+	default:
+		panic("r2: invalid contour crossing count")
+	}
+}
+
+// addSegment adds a directed contour segment between the crossing
+// points on the grid edges a and b.
+func (c *contourer) addSegment(a, b gridEdge) {
+	c.segments = append(c.segments, [2]int{c.point(a), c.point(b)})
+}
+
+// point returns the index of the crossing point on the grid edge e,
+// calculating it if necessary.
+func (c *contourer) point(e gridEdge) int {
+	idx, ok := c.index[e]
+	if ok {
+		return idx
+	}
+	c0, r0 := e.c, e.r
+	c1, r1 := c0+1, r0
+	if e.vertical {
+		c1, r1 = c0, r0+1
+	}
+	z0 := c.g.Z(c0, r0)
+	z1 := c.g.Z(c1, r1)
+	t := (c.level - z0) / (z1 - z0)
+	p0 := Vec{X: c.g.X(c0), Y: c.g.Y(r0)}
+	p1 := Vec{X: c.g.X(c1), Y: c.g.Y(r1)}
+	idx = len(c.points)
+	c.index[e] = idx
+	c.points = append(c.points, Add(p0, Scale(t, Sub(p1, p0))))
+	return idx
+}
+
+// stitch joins the contour segments into polylines.
+func (c *contourer) stitch() [][]Vec {
+	if len(c.segments) == 0 {
+		return nil
+	}
+
+	// Each crossing point starts at most one segment
+	// and ends at most one segment.
+	next := make(map[int]int, len(c.segments))
+	ends := make(map[int]bool, len(c.segments))
+	for i, s := range c.segments {
+		next[s[0]] = i
+		ends[s[1]] = true
+	}
+
+	var lines [][]Vec
+	used := make([]bool, len(c.segments))
+	follow := func(i int) []Vec {
+		line := []Vec{c.points[c.segments[i][0]]}
+		for {
+			used[i] = true
+			end := c.segments[i][1]
+			line = append(line, c.points[end])
+			var ok bool
+			i, ok = next[end]
+			if !ok || used[i] {
+				return line
+			}
+		}
+	}
+
+	// Open contours start at points that do not
+	// end any segment.
+	for i, s := range c.segments {
+		if !ends[s[0]] {
+			lines = append(lines, follow(i))
+		}
+	}
+	// All remaining segments are part of closed contours.
+	for i := range c.segments {
+		if !used[i] {
+			lines = append(lines, follow(i))
+		}
+	}
+	return lines
+}
@@ -0,0 +1,144 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r2
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// funcGrid is a Grid sampling f on a regular grid.
+type funcGrid struct {
+	min, max Vec
+	c, r     int
+	f        func(Vec) float64
+}
+
+func (g funcGrid) Dims() (c, r int) { return g.c, g.r }
+func (g funcGrid) Z(c, r int) float64 {
+	return g.f(Vec{X: g.X(c), Y: g.Y(r)})
+}
+func (g funcGrid) X(c int) float64 {
+	return g.min.X + (g.max.X-g.min.X)*float64(c)/float64(g.c-1)
+}
+func (g funcGrid) Y(r int) float64 {
+	return g.min.Y + (g.max.Y-g.min.Y)*float64(r)/float64(g.r-1)
+}
+
+func TestContourCircle(t *testing.T) {
+	g := funcGrid{
+		min: Vec{-2, -2}, max: Vec{2, 2},
+		c: 81, r: 81,
+		f: func(p Vec) float64 { return Norm(p) },
+	}
+	lines := Contour(g, 1)
+	if len(lines) != 1 {
+		t.Fatalf("unexpected number of contours: got:%d want:1", len(lines))
+	}
+	line := lines[0]
+	if line[0] != line[len(line)-1] {
+		t.Errorf("circle contour not closed")
+	}
+	for _, p := range line {
+		if !scalar.EqualWithinAbs(Norm(p), 1, 2e-3) {
+			t.Errorf("contour point not on circle: %v", p)
+		}
+	}
+	area := Polygon(line[:len(line)-1]).Area()
+	if !scalar.EqualWithinAbs(area, math.Pi, 1e-2) {
+		t.Errorf("unexpected contour area: got:%v want:%v", area, math.Pi)
+	}
+
+	// Contours around maxima are clockwise.
+	g.f = func(p Vec) float64 { return -Norm(p) }
+	lines = Contour(g, -1)
+	if len(lines) != 1 {
+		t.Fatalf("unexpected number of contours: got:%d want:1", len(lines))
+	}
+	line = lines[0]
+	area = Polygon(line[:len(line)-1]).Area()
+	if !scalar.EqualWithinAbs(area, -math.Pi, 1e-2) {
+		t.Errorf("unexpected contour area: got:%v want:%v", area, -math.Pi)
+	}
+}
+
+func TestContourOpen(t *testing.T) {
+	g := funcGrid{
+		min: Vec{0, 0}, max: Vec{1, 1},
+		c: 11, r: 7,
+		f: func(p Vec) float64 { return p.X + p.Y },
+	}
+	lines := Contour(g, 0.75)
+	if len(lines) != 1 {
+		t.Fatalf("unexpected number of contours: got:%d want:1", len(lines))
+	}
+	line := lines[0]
+	for _, p := range line {
+		if !scalar.EqualWithinAbs(p.X+p.Y, 0.75, 1e-14) {
+			t.Errorf("contour point not on line: %v", p)
+		}
+	}
+	first, last := line[0], line[len(line)-1]
+	want := [2]Vec{{0.75, 0}, {0, 0.75}}
+	if Norm(Sub(first, want[0])) > 1e-14 || Norm(Sub(last, want[1])) > 1e-14 {
+		t.Errorf("unexpected contour end points: got:%v %v want:%v %v", first, last, want[0], want[1])
+	}
+
+	if lines := Contour(g, 3); lines != nil {
+		t.Errorf("unexpected contours outside field range: %v", lines)
+	}
+}
+
+func TestContourSaddle(t *testing.T) {
+	// A single cell with high values on one diagonal
+	// and low values on the other.
+	g := funcGrid{
+		min: Vec{-1, -1}, max: Vec{1, 1},
+		c: 2, r: 2,
+		f: func(p Vec) float64 { return p.X * p.Y },
+	}
+	for _, test := range []struct {
+		level float64
+		// corners are the corners separated from
+		// the others by the contour segments.
+		corners [2]Vec
+	}{
+		// Centre value 0 is above the level so the low
+		// corners are separated.
+		{level: -0.5, corners: [2]Vec{{1, -1}, {-1, 1}}},
+		// Centre value 0 is below the level so the high
+		// corners are separated.
+		{level: 0.5, corners: [2]Vec{{1, 1}, {-1, -1}}},
+	} {
+		lines := Contour(g, test.level)
+		if len(lines) != 2 {
+			t.Errorf("unexpected number of contours for level %v: got:%d want:2", test.level, len(lines))
+			continue
+		}
+		for _, line := range lines {
+			mid := Scale(0.5, Add(line[0], line[1]))
+			var corner Vec
+			var near bool
+			for _, c := range test.corners {
+				if Norm(Sub(mid, c)) < 1 {
+					corner = c
+					near = true
+				}
+			}
+			if !near {
+				t.Errorf("unexpected saddle contour for level %v: %v", test.level, line)
+				continue
+			}
+			// Values below the level are on the left.
+			d := Sub(line[1], line[0])
+			left := Cross(d, Sub(corner, line[0])) > 0
+			if below := g.f(corner) < test.level; left != below {
+				t.Errorf("unexpected contour orientation for level %v: %v", test.level, line)
+			}
+		}
+	}
+}
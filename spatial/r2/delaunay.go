@@ -0,0 +1,517 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r2
+
+// Delaunay is a Delaunay triangulation of a set of points in the plane.
+type Delaunay struct {
+	// Points is the set of points that were triangulated.
+	Points []Vec
+
+	// Triangles holds the triangles of the triangulation
+	// as indices into Points. The vertices of each triangle
+	// are stored in counter-clockwise order.
+	Triangles [][3]int
+
+	// neighbors holds the indices of the triangles adjacent
+	// to each triangle. neighbors[t][i] is the triangle that
+	// shares the edge opposite vertex i of triangle t, or -1
+	// if that edge is on the convex hull.
+	neighbors [][3]int
+
+	// incident holds for each point the index of a triangle
+	// that has the point as a vertex, or -1 if the point is
+	// not part of the triangulation.
+	incident []int
+}
+
+// NewDelaunay returns the Delaunay triangulation of the points in pts
+// computed using the Bowyer-Watson algorithm. The pts slice is retained
+// by the returned Delaunay and must not be altered.
+//
+// The triangulation is constructed incrementally. The triangles whose
+// circumcircles contain each inserted point are found by walking from the
+// most recently created triangle, so the expected cost of construction is
+// O(n^1.5) for points in random order. Points outside the current convex
+// hull are handled using ghost triangles that join each edge of the hull
+// to a vertex at infinity, so the triangles of the convex hull are never
+// lost.
+//
+// Duplicate points are not included in the triangulation. If pts has
+// fewer than three non-collinear points, the returned triangulation
+// has no triangles.
+func NewDelaunay(pts []Vec) *Delaunay {
+	d := &Delaunay{Points: pts, incident: make([]int, len(pts))}
+	for i := range d.incident {
+		d.incident[i] = -1
+	}
+
+	// Find an initial non-degenerate triangle.
+	if len(pts) < 3 {
+		return d
+	}
+	i0, i1, i2 := 0, -1, -1
+	for i, p := range pts {
+		if p != pts[i0] {
+			i1 = i
+			break
+		}
+	}
+	if i1 < 0 {
+		return d
+	}
+	for i, p := range pts {
+		if Orient(pts[i0], pts[i1], p) != 0 {
+			i2 = i
+			break
+		}
+	}
+	if i2 < 0 {
+		return d
+	}
+	if Orient(pts[i0], pts[i1], pts[i2]) < 0 {
+		i1, i2 = i2, i1
+	}
+
+	b := newBowyerWatson(pts, i0, i1, i2)
+	for i := range pts {
+		if i != i0 && i != i1 && i != i2 {
+			b.insert(i)
+		}
+	}
+	b.finalize(d)
+	return d
+}
+
+// ghost is the vertex at infinity used by bowyerWatson.
+const ghost = -1
+
+// bowyerWatson is an incrementally constructed Delaunay triangulation.
+// Each edge of the convex hull of the triangulation is joined to the
+// ghost vertex by a ghost triangle, so every triangle has three neighbors.
+// The vertices of all triangles, including ghost triangles, are stored
+// in counter-clockwise order, with the ghost vertex taken to lie outside
+// the hull.
+type bowyerWatson struct {
+	pts []Vec
+
+	tris      [][3]int
+	neighbors [][3]int
+
+	// last is the most recently created triangle and is
+	// the start of the walk for the next insertion.
+	last int
+
+	// mark holds for each triangle the insertion at which
+	// it was found to be in conflict with the inserted point.
+	mark []int
+	// step is used to vary the order in which the edges of
+	// a triangle are tested during the walk, which ensures
+	// that the walk terminates.
+	step int
+
+	// Storage reused between insertions.
+	stack  []int
+	bad    []int
+	cavity []cavityEdge
+	start  map[int]int
+	end    map[int]int
+}
+
+// cavityEdge is an edge on the boundary of the region of triangles in
+// conflict with an inserted point.
+type cavityEdge struct {
+	a, b  int // The counter-clockwise ordered end points of the edge.
+	outer int // The triangle outside the region sharing the edge.
+	back  int // The position of the edge in the outer triangle.
+}
+
+// newBowyerWatson returns a triangulation of the counter-clockwise
+// ordered points i0, i1 and i2 of pts.
+func newBowyerWatson(pts []Vec, i0, i1, i2 int) *bowyerWatson {
+	return &bowyerWatson{
+		pts: pts,
+		tris: [][3]int{
+			{i0, i1, i2},
+			{i1, i0, ghost},
+			{i2, i1, ghost},
+			{i0, i2, ghost},
+		},
+		neighbors: [][3]int{
+			{2, 3, 1},
+			{3, 2, 0},
+			{1, 3, 0},
+			{2, 1, 0},
+		},
+		mark:  []int{-1, -1, -1, -1},
+		start: make(map[int]int),
+		end:   make(map[int]int),
+	}
+}
+
+// insert adds the ith point to the triangulation.
+func (b *bowyerWatson) insert(i int) {
+	p := b.pts[i]
+	seed, ok := b.locate(p)
+	if !ok {
+		// p is a duplicate of a previously inserted point.
+		return
+	}
+
+	// Find the connected region of triangles whose circumcircles
+	// contain p and the edges on its boundary.
+	b.bad = b.bad[:0]
+	b.cavity = b.cavity[:0]
+	b.mark[seed] = i
+	b.stack = append(b.stack[:0], seed)
+	for len(b.stack) > 0 {
+		t := b.stack[len(b.stack)-1]
+		b.stack = b.stack[:len(b.stack)-1]
+		b.bad = append(b.bad, t)
+		for k, o := range b.neighbors[t] {
+			if b.mark[o] == i {
+				continue
+			}
+			if b.conflicts(o, p) {
+				b.mark[o] = i
+				b.stack = append(b.stack, o)
+				continue
+			}
+			b.cavity = append(b.cavity, cavityEdge{
+				a:     b.tris[t][(k+1)%3],
+				b:     b.tris[t][(k+2)%3],
+				outer: o,
+				back:  b.neighborIndex(o, t),
+			})
+		}
+	}
+
+	// Re-triangulate the region by joining each boundary edge to p,
+	// reusing the storage of the removed triangles.
+	clear(b.start)
+	clear(b.end)
+	for j, e := range b.cavity {
+		var t int
+		if j < len(b.bad) {
+			t = b.bad[j]
+			b.tris[t] = [3]int{e.a, e.b, i}
+		} else {
+			t = len(b.tris)
+			b.tris = append(b.tris, [3]int{e.a, e.b, i})
+			b.neighbors = append(b.neighbors, [3]int{})
+			b.mark = append(b.mark, -1)
+		}
+		b.neighbors[t][2] = e.outer
+		b.neighbors[e.outer][e.back] = t
+		b.start[e.a] = t
+		b.end[e.b] = t
+	}
+	for _, e := range b.cavity {
+		t := b.start[e.a]
+		b.neighbors[t][0] = b.start[e.b]
+		b.neighbors[t][1] = b.end[e.a]
+	}
+	b.last = b.start[b.cavity[0].a]
+}
+
+// locate returns a triangle in conflict with p found by walking from the
+// most recently created triangle. If p is a vertex of the triangulation,
+// locate returns false.
+func (b *bowyerWatson) locate(p Vec) (tri int, ok bool) {
+	t := b.last
+	if b.isGhost(t) {
+		if b.conflicts(t, p) {
+			return t, true
+		}
+		t = b.neighbors[t][b.ghostIndex(t)]
+	}
+	for steps := 0; steps < len(b.tris); steps++ {
+		tv := b.tris[t]
+		next := -1
+		b.step++
+		for j := 0; j < 3; j++ {
+			k := (j + b.step) % 3
+			if Orient(b.pts[tv[(k+1)%3]], b.pts[tv[(k+2)%3]], p) < 0 {
+				next = b.neighbors[t][k]
+				break
+			}
+		}
+		if next < 0 {
+			// p is in the closure of t.
+			for _, v := range tv {
+				if b.pts[v] == p {
+					return -1, false
+				}
+			}
+			return t, true
+		}
+		if b.isGhost(next) {
+			// p is outside the convex hull, beyond the
+			// edge shared by t and next.
+			return next, true
+		}
+		t = next
+	}
+
+	// Fall back to a linear scan if the walk failed to
+	// terminate due to floating point error.
+	for t, tv := range b.tris {
+		if b.isGhost(t) {
+			continue
+		}
+		for _, v := range tv {
+			if b.pts[v] == p {
+				return -1, false
+			}
+		}
+	}
+	for t := range b.tris {
+		if b.conflicts(t, p) {
+			return t, true
+		}
+	}
+	return -1, false
+}
+
+// conflicts returns whether p is inside the circumcircle of the triangle t.
+// The circumcircle of a ghost triangle is the open half-plane beyond its
+// hull edge together with the interior of the edge.
+func (b *bowyerWatson) conflicts(t int, p Vec) bool {
+	tv := b.tris[t]
+	for k, v := range tv {
+		if v != ghost {
+			continue
+		}
+		u, w := b.pts[tv[(k+1)%3]], b.pts[tv[(k+2)%3]]
+		o := Orient(u, w, p)
+		return o > 0 || (o == 0 && Dot(Sub(p, u), Sub(p, w)) < 0)
+	}
+	return inCircle(b.pts[tv[0]], b.pts[tv[1]], b.pts[tv[2]], p) > 0
+}
+
+// isGhost returns whether t is a ghost triangle.
+func (b *bowyerWatson) isGhost(t int) bool {
+	tv := b.tris[t]
+	return tv[0] == ghost || tv[1] == ghost || tv[2] == ghost
+}
+
+// ghostIndex returns the position of the ghost vertex in the ghost
+// triangle t.
+func (b *bowyerWatson) ghostIndex(t int) int {
+	for k, v := range b.tris[t] {
+		if v == ghost {
+			return k
+		}
+	}
+	panic("r2: not a ghost triangle")
+}
+
+// neighborIndex returns the position of the neighbor o of the triangle t.
+func (b *bowyerWatson) neighborIndex(t, o int) int {
+	for k, u := range b.neighbors[t] {
+		if u == o {
+			return k
+		}
+	}
+	panic("r2: triangles not adjacent")
+}
+
+// finalize stores the triangles of the triangulation that are not ghost
+// triangles and their adjacency information in d.
+func (b *bowyerWatson) finalize(d *Delaunay) {
+	index := make([]int, len(b.tris))
+	for t, tv := range b.tris {
+		if b.isGhost(t) {
+			index[t] = -1
+			continue
+		}
+		index[t] = len(d.Triangles)
+		d.Triangles = append(d.Triangles, tv)
+	}
+	d.neighbors = make([][3]int, len(d.Triangles))
+	for t, nb := range b.neighbors {
+		i := index[t]
+		if i < 0 {
+			continue
+		}
+		for k, o := range nb {
+			d.neighbors[i][k] = index[o]
+		}
+		for _, v := range b.tris[t] {
+			d.incident[v] = i
+		}
+	}
+}
+
+// Triangle returns the ith triangle of the triangulation.
+func (d *Delaunay) Triangle(i int) Triangle {
+	t := d.Triangles[i]
+	return Triangle{d.Points[t[0]], d.Points[t[1]], d.Points[t[2]]}
+}
+
+// Neighbors returns the indices of the triangles adjacent to the ith triangle.
+// The jth element of the returned array is the triangle sharing the edge
+// opposite the jth vertex of the ith triangle, or -1 if the edge is on the
+// convex hull of the triangulation.
+func (d *Delaunay) Neighbors(i int) [3]int {
+	return d.neighbors[i]
+}
+
+// Locate returns the index of a triangle containing the point p. If p is
+// outside the convex hull of the triangulation, Locate returns -1 and false.
+// Points lying on an edge shared by two triangles may be reported as being
+// in either triangle.
+func (d *Delaunay) Locate(p Vec) (tri int, ok bool) {
+	if len(d.Triangles) == 0 {
+		return -1, false
+	}
+
+	// Perform a visibility walk from the first triangle.
+	// The walk terminates for Delaunay triangulations, but
+	// limit the number of steps to guard against failure
+	// due to floating point error.
+	t := 0
+	for steps := 0; steps <= len(d.Triangles); steps++ {
+		tv := d.Triangles[t]
+		next := -1
+		for k := 0; k < 3; k++ {
+			a, b := d.Points[tv[(k+1)%3]], d.Points[tv[(k+2)%3]]
+			if Orient(a, b, p) < 0 {
+				next = d.neighbors[t][k]
+				if next < 0 {
+					return -1, false
+				}
+				break
+			}
+		}
+		if next < 0 {
+			return t, true
+		}
+		t = next
+	}
+
+	// Fall back to a linear scan.
+	for i := range d.Triangles {
+		if d.contains(i, p) {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// contains returns whether the ith triangle contains p.
+func (d *Delaunay) contains(i int, p Vec) bool {
+	t := d.Triangles[i]
+	for k := 0; k < 3; k++ {
+		if Orient(d.Points[t[k]], d.Points[t[(k+1)%3]], p) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Voronoi is a Voronoi diagram dual to a Delaunay triangulation.
+type Voronoi struct {
+	// Sites is the set of generating points of the diagram.
+	Sites []Vec
+
+	// Vertices holds the vertices of the diagram. The ith
+	// vertex is the circumcenter of the ith triangle of the
+	// dual Delaunay triangulation.
+	Vertices []Vec
+
+	// Cells holds the indices into Vertices of the vertices
+	// of the cell of each site, in counter-clockwise order.
+	Cells [][]int
+
+	// Bounded indicates whether each cell is bounded. Cells
+	// of sites on the convex hull of the triangulation are
+	// unbounded and the first and last vertices of such cells
+	// are the origins of rays extending to infinity.
+	Bounded []bool
+}
+
+// Voronoi returns the Voronoi diagram dual to the triangulation.
+func (d *Delaunay) Voronoi() Voronoi {
+	v := Voronoi{
+		Sites:    d.Points,
+		Vertices: make([]Vec, len(d.Triangles)),
+		Cells:    make([][]int, len(d.Points)),
+		Bounded:  make([]bool, len(d.Points)),
+	}
+	for i := range d.Triangles {
+		v.Vertices[i] = circumcenter(d.Triangle(i))
+	}
+	for p, start := range d.incident {
+		if start < 0 {
+			continue
+		}
+
+		// Walk clockwise around p until the hull is
+		// reached or the walk returns to the start.
+		t := start
+		bounded := true
+		for {
+			prev := d.neighbors[t][(d.vertexIndex(t, p)+2)%3]
+			if prev < 0 {
+				bounded = false
+				break
+			}
+			t = prev
+			if t == start {
+				break
+			}
+		}
+
+		// Collect the triangles counter-clockwise around p.
+		first := t
+		var cell []int
+		for {
+			cell = append(cell, t)
+			t = d.neighbors[t][(d.vertexIndex(t, p)+1)%3]
+			if t < 0 || t == first {
+				break
+			}
+		}
+		v.Cells[p] = cell
+		v.Bounded[p] = bounded
+	}
+	return v
+}
+
+// vertexIndex returns the position of point p in the tth triangle.
+func (d *Delaunay) vertexIndex(t, p int) int {
+	for k, v := range d.Triangles[t] {
+		if v == p {
+			return k
+		}
+	}
+	panic("r2: point not in triangle")
+}
+
+// circumcenter returns the center of the circle passing through
+// the vertices of t.
+func circumcenter(t Triangle) Vec {
+	b := Sub(t[1], t[0])
+	c := Sub(t[2], t[0])
+	d := 2 * Cross(b, c)
+	b2 := Norm2(b)
+	c2 := Norm2(c)
+	return Add(t[0], Vec{
+		X: (c.Y*b2 - b.Y*c2) / d,
+		Y: (b.X*c2 - c.X*b2) / d,
+	})
+}
+
+// inCircle returns a positive value if d lies inside the circle passing
+// through the counter-clockwise ordered points a, b and c, a negative value
+// if it lies outside and zero if it lies on the circle.
+func inCircle(a, b, c, d Vec) float64 {
+	ad := Sub(a, d)
+	bd := Sub(b, d)
+	cd := Sub(c, d)
+	return Norm2(ad)*Cross(bd, cd) -
+		Norm2(bd)*Cross(ad, cd) +
+		Norm2(cd)*Cross(ad, bd)
+}
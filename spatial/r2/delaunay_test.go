@@ -0,0 +1,258 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r2
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestDelaunay(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{3, 4, 10, 50, 200} {
+		pts := make([]Vec, n)
+		for i := range pts {
+			pts[i] = Vec{X: rnd.Float64(), Y: rnd.Float64()}
+		}
+		d := NewDelaunay(pts)
+
+		hull := 0
+		for i, tri := range d.Triangles {
+			if Orient(pts[tri[0]], pts[tri[1]], pts[tri[2]]) <= 0 {
+				t.Errorf("n=%d: triangle %d not counter-clockwise", n, i)
+			}
+			for j, p := range pts {
+				if j == tri[0] || j == tri[1] || j == tri[2] {
+					continue
+				}
+				if inCircle(pts[tri[0]], pts[tri[1]], pts[tri[2]], p) > 1e-12 {
+					t.Errorf("n=%d: point %d inside circumcircle of triangle %d", n, j, i)
+				}
+			}
+			for k, nb := range d.Neighbors(i) {
+				if nb < 0 {
+					hull++
+					continue
+				}
+				var found bool
+				for _, back := range d.Neighbors(nb) {
+					if back == i {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("n=%d: neighbor %d of triangle %d across vertex %d not reciprocal", n, nb, i, k)
+				}
+			}
+		}
+		// Euler's formula for a triangulation of n points
+		// with h points on the hull gives 2n-2-h triangles.
+		if want := 2*n - 2 - hull; len(d.Triangles) != want {
+			t.Errorf("n=%d: unexpected number of triangles: got:%d want:%d", n, len(d.Triangles), want)
+		}
+
+		for i := 0; i < 100; i++ {
+			p := Vec{X: rnd.Float64(), Y: rnd.Float64()}
+			tri, ok := d.Locate(p)
+			var want bool
+			for j := range d.Triangles {
+				if d.contains(j, p) {
+					want = true
+					break
+				}
+			}
+			if ok != want {
+				t.Errorf("n=%d: unexpected location result for %v: got:%t want:%t", n, p, ok, want)
+			}
+			if ok && !d.contains(tri, p) {
+				t.Errorf("n=%d: located triangle %d does not contain %v", n, tri, p)
+			}
+		}
+	}
+}
+
+func TestDelaunayHull(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		name string
+		pts  func() []Vec
+	}{
+		{
+			name: "uniform",
+			pts: func() []Vec {
+				pts := make([]Vec, 10000)
+				for i := range pts {
+					pts[i] = Vec{X: rnd.Float64(), Y: rnd.Float64()}
+				}
+				return pts
+			},
+		},
+		{
+			name: "normal",
+			pts: func() []Vec {
+				pts := make([]Vec, 10000)
+				for i := range pts {
+					pts[i] = Vec{X: rnd.NormFloat64(), Y: rnd.NormFloat64()}
+				}
+				return pts
+			},
+		},
+		{
+			name: "circle",
+			pts: func() []Vec {
+				pts := make([]Vec, 1000)
+				for i := range pts {
+					theta := 2 * math.Pi * rnd.Float64()
+					pts[i] = Vec{X: math.Cos(theta), Y: math.Sin(theta)}
+				}
+				return pts
+			},
+		},
+		{
+			name: "grid",
+			pts: func() []Vec {
+				var pts []Vec
+				for _, i := range rnd.Perm(2500) {
+					pts = append(pts, Vec{X: float64(i % 50), Y: float64(i / 50)})
+				}
+				return pts
+			},
+		},
+	} {
+		pts := test.pts()
+		d := NewDelaunay(pts)
+		hull := ConvexHull(pts)
+
+		// Euler's formula for a triangulation of n points
+		// with h points on the boundary of the convex hull
+		// gives 2n-2-h triangles.
+		var h int
+		for _, p := range pts {
+			for i := range hull {
+				a, b := hull[i], hull[(i+1)%len(hull)]
+				if Orient(a, b, p) == 0 && Dot(Sub(p, a), Sub(p, b)) <= 0 {
+					h++
+					break
+				}
+			}
+		}
+		if want := 2*len(pts) - 2 - h; len(d.Triangles) != want {
+			t.Errorf("%s: unexpected number of triangles: got:%d want:%d", test.name, len(d.Triangles), want)
+		}
+
+		// The triangles must cover the convex hull.
+		var area float64
+		for i := range d.Triangles {
+			area += d.Triangle(i).Area()
+		}
+		if want := hull.Area(); !scalar.EqualWithinRel(area, want, 1e-12) {
+			t.Errorf("%s: unexpected covered area: got:%v want:%v", test.name, area, want)
+		}
+
+		b := Box{Min: pts[0], Max: pts[0]}
+		for _, p := range pts {
+			b.Min = minElem(b.Min, p)
+			b.Max = maxElem(b.Max, p)
+		}
+		size := b.Size()
+		for i := 0; i < 1000; i++ {
+			p := Vec{X: b.Min.X + size.X*rnd.Float64(), Y: b.Min.Y + size.Y*rnd.Float64()}
+			tri, ok := d.Locate(p)
+			if want := hull.Contains(p); ok != want {
+				t.Errorf("%s: unexpected location result for %v: got:%t want:%t", test.name, p, ok, want)
+			}
+			if ok && !d.contains(tri, p) {
+				t.Errorf("%s: located triangle %d does not contain %v", test.name, tri, p)
+			}
+		}
+	}
+}
+
+func TestDelaunayDegenerate(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		pts  []Vec
+		want int
+	}{
+		{name: "empty", pts: nil, want: 0},
+		{name: "two", pts: []Vec{{0, 0}, {1, 1}}, want: 0},
+		{name: "collinear", pts: []Vec{{0, 0}, {1, 1}, {2, 2}, {3, 3}}, want: 0},
+		{name: "duplicate", pts: []Vec{{0, 0}, {1, 0}, {0, 1}, {1, 0}}, want: 1},
+		{name: "square", pts: []Vec{{0, 0}, {1, 0}, {1, 1}, {0, 1}}, want: 2},
+	} {
+		d := NewDelaunay(test.pts)
+		if len(d.Triangles) != test.want {
+			t.Errorf("%s: unexpected number of triangles: got:%d want:%d", test.name, len(d.Triangles), test.want)
+		}
+	}
+}
+
+func TestVoronoi(t *testing.T) {
+	// A 3×3 grid, slightly perturbed to avoid co-circular
+	// points. The perturbation moves the middle points of
+	// the top and right edges of the grid inside the convex
+	// hull, so only the cells of the six hull vertices are
+	// unbounded.
+	var pts []Vec
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			pts = append(pts, Vec{X: float64(i) + 1e-3*float64(j*j), Y: float64(j) + 1e-3*float64(i*i)})
+		}
+	}
+	hull := ConvexHull(pts)
+	if len(hull) != 6 {
+		t.Fatalf("unexpected number of hull vertices: got:%d want:6", len(hull))
+	}
+	d := NewDelaunay(pts)
+	v := d.Voronoi()
+	for i, b := range v.Bounded {
+		want := true
+		for _, h := range hull {
+			if pts[i] == h {
+				want = false
+			}
+		}
+		if b != want {
+			t.Errorf("unexpected boundedness for cell %d: got:%t want:%t", i, b, want)
+		}
+	}
+
+	// Every vertex of a cell is equidistant from the cell's
+	// site and the other sites of the vertex's triangle, and
+	// no site is closer.
+	for i, cell := range v.Cells {
+		for _, vi := range cell {
+			c := v.Vertices[vi]
+			r := Norm(Sub(c, v.Sites[i]))
+			for j, s := range v.Sites {
+				if Norm(Sub(c, s)) < r-1e-9 {
+					t.Errorf("site %d closer to vertex %d of cell %d than site", j, vi, i)
+				}
+			}
+			for _, k := range d.Triangles[vi] {
+				if !scalar.EqualWithinAbsOrRel(Norm(Sub(c, v.Sites[k])), r, 1e-9, 1e-9) {
+					t.Errorf("vertex %d of cell %d not equidistant from triangle vertices", vi, i)
+				}
+			}
+		}
+	}
+
+	// The bounded cell is a convex polygon in counter-clockwise order.
+	cell := v.Cells[4]
+	if len(cell) < 3 {
+		t.Fatalf("unexpected number of vertices in center cell: %d", len(cell))
+	}
+	for k := range cell {
+		a := v.Vertices[cell[k]]
+		b := v.Vertices[cell[(k+1)%len(cell)]]
+		c := v.Vertices[cell[(k+2)%len(cell)]]
+		if Orient(a, b, c) < -1e-12 {
+			t.Errorf("center cell not counter-clockwise convex at vertex %d", k)
+		}
+	}
+}
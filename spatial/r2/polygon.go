@@ -0,0 +1,120 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r2
+
+import "sort"
+
+// Polygon is a simple polygon described by its vertices. The last
+// vertex is implicitly connected to the first.
+type Polygon []Vec
+
+// Area returns the signed area of the polygon. The area is positive
+// if the vertices are in counter-clockwise order and negative if they
+// are in clockwise order.
+func (p Polygon) Area() float64 {
+	if len(p) < 3 {
+		return 0
+	}
+	// Shoelace formula, with vertices translated to
+	// the first vertex to reduce cancellation error.
+	var a float64
+	o := p[0]
+	for i := 1; i < len(p)-1; i++ {
+		a += Cross(Sub(p[i], o), Sub(p[i+1], o))
+	}
+	return a / 2
+}
+
+// Centroid returns the centroid of the area enclosed by the polygon.
+// If the polygon has zero area the result has NaN components.
+func (p Polygon) Centroid() Vec {
+	if len(p) == 0 {
+		return Vec{}
+	}
+	var (
+		a float64
+		c Vec
+	)
+	o := p[0]
+	for i := 1; i < len(p)-1; i++ {
+		u, v := Sub(p[i], o), Sub(p[i+1], o)
+		w := Cross(u, v)
+		a += w
+		c = Add(c, Scale(w, Add(u, v)))
+	}
+	return Add(o, Scale(1/(3*a), c))
+}
+
+// Contains returns whether v is inside the polygon or on its boundary.
+func (p Polygon) Contains(v Vec) bool {
+	// Compute the winding number of the polygon around v.
+	// See D. Sunday, "Inclusion of a Point in a Polygon".
+	var wn int
+	for i, a := range p {
+		b := p[(i+1)%len(p)]
+		o := Orient(a, b, v)
+		if o == 0 && (Segment{a, b}).bounds(v) {
+			return true
+		}
+		if a.Y <= v.Y {
+			if b.Y > v.Y && o > 0 {
+				wn++
+			}
+		} else if b.Y <= v.Y && o < 0 {
+			wn--
+		}
+	}
+	return wn != 0
+}
+
+// ConvexHull returns the convex hull of the points in pts computed using
+// Andrew's monotone chain algorithm. The vertices of the returned polygon
+// are in counter-clockwise order starting from the point with the lowest
+// X coordinate, and do not include points lying on the edges of the hull.
+// The pts slice is not altered.
+func ConvexHull(pts []Vec) Polygon {
+	if len(pts) < 3 {
+		hull := make(Polygon, 0, len(pts))
+		for _, p := range pts {
+			if len(hull) == 0 || hull[0] != p {
+				hull = append(hull, p)
+			}
+		}
+		return hull
+	}
+
+	sorted := make([]Vec, len(pts))
+	copy(sorted, pts)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].X != sorted[j].X {
+			return sorted[i].X < sorted[j].X
+		}
+		return sorted[i].Y < sorted[j].Y
+	})
+
+	hull := make(Polygon, 0, 2*len(sorted))
+	// Lower hull.
+	for _, p := range sorted {
+		for len(hull) >= 2 && Orient(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	// Upper hull.
+	lower := len(hull) + 1
+	for i := len(sorted) - 2; i >= 0; i-- {
+		p := sorted[i]
+		for len(hull) >= lower && Orient(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	// The last point is the same as the first.
+	hull = hull[:len(hull)-1]
+	if len(hull) == 2 && hull[0] == hull[1] {
+		hull = hull[:1]
+	}
+	return hull
+}
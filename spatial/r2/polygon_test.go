@@ -0,0 +1,169 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r2
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestPolygonArea(t *testing.T) {
+	for _, test := range []struct {
+		p        Polygon
+		area     float64
+		centroid Vec
+	}{
+		{p: Polygon{{0, 0}, {1, 0}, {1, 1}, {0, 1}}, area: 1, centroid: Vec{0.5, 0.5}},
+		{p: Polygon{{0, 0}, {0, 1}, {1, 1}, {1, 0}}, area: -1, centroid: Vec{0.5, 0.5}},
+		{p: Polygon{{0, 0}, {4, 0}, {0, 3}}, area: 6, centroid: Vec{4.0 / 3, 1}},
+		{
+			// L-shape composed of a 2×1 and a 1×1 square.
+			p:        Polygon{{0, 0}, {2, 0}, {2, 1}, {1, 1}, {1, 2}, {0, 2}},
+			area:     3,
+			centroid: Vec{(2*1 + 1*0.5) / 3, (2*0.5 + 1*1.5) / 3},
+		},
+	} {
+		if got := test.p.Area(); !scalar.EqualWithinAbs(got, test.area, 1e-14) {
+			t.Errorf("unexpected area for %v: got:%v want:%v", test.p, got, test.area)
+		}
+		got := test.p.Centroid()
+		if !scalar.EqualWithinAbs(got.X, test.centroid.X, 1e-14) || !scalar.EqualWithinAbs(got.Y, test.centroid.Y, 1e-14) {
+			t.Errorf("unexpected centroid for %v: got:%v want:%v", test.p, got, test.centroid)
+		}
+	}
+}
+
+func TestPolygonContains(t *testing.T) {
+	// A concave polygon.
+	p := Polygon{{0, 0}, {4, 0}, {4, 4}, {2, 1}, {0, 4}}
+	for _, test := range []struct {
+		v    Vec
+		want bool
+	}{
+		{v: Vec{1, 1}, want: true},
+		{v: Vec{3, 1}, want: true},
+		{v: Vec{2, 2}, want: false},
+		{v: Vec{2, 0.5}, want: true},
+		{v: Vec{5, 1}, want: false},
+		{v: Vec{-1, 0}, want: false},
+		{v: Vec{0, 0}, want: true},
+		{v: Vec{2, 0}, want: true},
+		{v: Vec{4, 2}, want: true},
+		{v: Vec{2, 1}, want: true},
+	} {
+		if got := p.Contains(test.v); got != test.want {
+			t.Errorf("unexpected result for containment of %v: got:%t want:%t", test.v, got, test.want)
+		}
+	}
+}
+
+func TestConvexHull(t *testing.T) {
+	for _, test := range []struct {
+		pts  []Vec
+		want Polygon
+	}{
+		{pts: nil, want: Polygon{}},
+		{pts: []Vec{{1, 1}}, want: Polygon{{1, 1}}},
+		{pts: []Vec{{1, 1}, {1, 1}, {1, 1}}, want: Polygon{{1, 1}}},
+		{pts: []Vec{{0, 0}, {1, 1}, {2, 2}, {3, 3}}, want: Polygon{{0, 0}, {3, 3}}},
+		{
+			pts:  []Vec{{0, 0}, {1, 0}, {2, 0}, {2, 2}, {1, 1}, {0, 2}, {1, 2}, {0.5, 0.5}},
+			want: Polygon{{0, 0}, {2, 0}, {2, 2}, {0, 2}},
+		},
+	} {
+		got := ConvexHull(test.pts)
+		if len(got) != len(test.want) {
+			t.Errorf("unexpected hull for %v: got:%v want:%v", test.pts, got, test.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != test.want[i] {
+				t.Errorf("unexpected hull for %v: got:%v want:%v", test.pts, got, test.want)
+				break
+			}
+		}
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		pts := make([]Vec, 100)
+		for j := range pts {
+			r := math.Sqrt(rnd.Float64())
+			theta := 2 * math.Pi * rnd.Float64()
+			pts[j] = Vec{X: r * math.Cos(theta), Y: r * math.Sin(theta)}
+		}
+		hull := ConvexHull(pts)
+		if hull.Area() <= 0 {
+			t.Errorf("hull not counter-clockwise")
+		}
+		for j := range hull {
+			if Orient(hull[j], hull[(j+1)%len(hull)], hull[(j+2)%len(hull)]) <= 0 {
+				t.Errorf("hull not strictly convex at vertex %d", j)
+			}
+		}
+		for _, p := range pts {
+			if !hull.Contains(p) {
+				t.Errorf("hull does not contain %v", p)
+			}
+		}
+	}
+}
+
+func TestOrient(t *testing.T) {
+	// Points near a line where naive evaluation of the
+	// orientation determinant gives inconsistent results.
+	a := Vec{X: 0.5, Y: 0.5}
+	b := Vec{X: 12, Y: 12}
+	c := Vec{X: 24, Y: 24}
+	const ulp = 0x1p-53
+	for i := 0; i < 64; i++ {
+		for j := 0; j < 64; j++ {
+			p := Vec{X: a.X + float64(i)*ulp, Y: a.Y + float64(j)*ulp}
+			got := Orient(p, b, c)
+			want := orientExact(p, b, c)
+			if math.Signbit(got) != math.Signbit(want) || (got == 0) != (want == 0) {
+				t.Errorf("unexpected orientation sign for %v: got:%v want:%v", p, got, want)
+			}
+		}
+	}
+	if got := Orient(Vec{0, 0}, Vec{1, 0}, Vec{0, 1}); got != 1 {
+		t.Errorf("unexpected orientation: got:%v want:1", got)
+	}
+	if got := Orient(Vec{0, 0}, Vec{0, 1}, Vec{1, 0}); got != -1 {
+		t.Errorf("unexpected orientation: got:%v want:-1", got)
+	}
+}
+
+func TestSegmentIntersection(t *testing.T) {
+	for _, test := range []struct {
+		s, u Segment
+		ok   bool
+		want Segment
+	}{
+		{s: Segment{{0, 0}, {2, 2}}, u: Segment{{0, 2}, {2, 0}}, ok: true, want: Segment{{1, 1}, {1, 1}}},
+		{s: Segment{{0, 0}, {1, 1}}, u: Segment{{0, 2}, {2, 4}}, ok: false},
+		{s: Segment{{0, 0}, {1, 0}}, u: Segment{{1, 0}, {1, 1}}, ok: true, want: Segment{{1, 0}, {1, 0}}},
+		{s: Segment{{0, 0}, {1, 0}}, u: Segment{{2, 0}, {3, 0}}, ok: false},
+		{s: Segment{{0, 0}, {2, 0}}, u: Segment{{3, 0}, {1, 0}}, ok: true, want: Segment{{1, 0}, {2, 0}}},
+		{s: Segment{{0, 0}, {4, 0}}, u: Segment{{1, 0}, {2, 0}}, ok: true, want: Segment{{1, 0}, {2, 0}}},
+		{s: Segment{{1, 1}, {1, 1}}, u: Segment{{0, 0}, {2, 2}}, ok: true, want: Segment{{1, 1}, {1, 1}}},
+		{s: Segment{{0, 0}, {1, 0}}, u: Segment{{0.5, 1}, {0.5, 2}}, ok: false},
+	} {
+		got, ok := test.s.Intersection(test.u)
+		if ok != test.ok {
+			t.Errorf("unexpected intersection result for %v and %v: got:%t want:%t", test.s, test.u, ok, test.ok)
+			continue
+		}
+		if ok != test.u.Intersects(test.s) {
+			t.Errorf("intersection test not symmetric for %v and %v", test.s, test.u)
+		}
+		if got != test.want {
+			t.Errorf("unexpected intersection for %v and %v: got:%v want:%v", test.s, test.u, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r2
+
+import (
+	"math"
+	"math/big"
+)
+
+// Orient returns twice the signed area of the triangle abc. The result is
+// positive if a, b and c are in counter-clockwise order, negative if they
+// are in clockwise order and zero if they are collinear. The sign of the
+// result is computed exactly.
+func Orient(a, b, c Vec) float64 {
+	// Use a floating point filter to avoid exact
+	// evaluation when the sign is unambiguous.
+	// See J. R. Shewchuk, "Adaptive Precision Floating-Point
+	// Arithmetic and Fast Robust Geometric Predicates",
+	// Discrete & Computational Geometry 18(3):305–363, 1997.
+	const errBound = (3 + 16*epsilon) * epsilon
+	l := (b.X - a.X) * (c.Y - a.Y)
+	r := (b.Y - a.Y) * (c.X - a.X)
+	det := l - r
+	if math.Abs(det) > errBound*(math.Abs(l)+math.Abs(r)) {
+		return det
+	}
+	return orientExact(a, b, c)
+}
+
+// epsilon is half the machine epsilon for float64.
+const epsilon = 0x1p-53
+
+// orientExact returns the orientation determinant of a, b and c computed
+// using exact rational arithmetic and rounded to the nearest float64.
+func orientExact(a, b, c Vec) float64 {
+	var ax, ay, bx, by, cx, cy big.Rat
+	ax.SetFloat64(a.X)
+	ay.SetFloat64(a.Y)
+	bx.SetFloat64(b.X)
+	by.SetFloat64(b.Y)
+	cx.SetFloat64(c.X)
+	cy.SetFloat64(c.Y)
+
+	var l, r, t big.Rat
+	l.Mul(t.Sub(&bx, &ax), r.Sub(&cy, &ay))
+	var u, v big.Rat
+	r.Mul(u.Sub(&by, &ay), v.Sub(&cx, &ax))
+	det, _ := l.Sub(&l, &r).Float64()
+	return det
+}
+
+// Segment is a line segment between two points.
+type Segment [2]Vec
+
+// Length returns the length of the segment.
+func (s Segment) Length() float64 {
+	return Norm(Sub(s[1], s[0]))
+}
+
+// Intersects returns whether the segments s and t have at least
+// one point in common. The segment end points are considered to
+// be part of the segments.
+func (s Segment) Intersects(t Segment) bool {
+	d1 := Orient(t[0], t[1], s[0])
+	d2 := Orient(t[0], t[1], s[1])
+	d3 := Orient(s[0], s[1], t[0])
+	d4 := Orient(s[0], s[1], t[1])
+	if ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) &&
+		((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0)) {
+		return true
+	}
+	return (d1 == 0 && t.bounds(s[0])) ||
+		(d2 == 0 && t.bounds(s[1])) ||
+		(d3 == 0 && s.bounds(t[0])) ||
+		(d4 == 0 && s.bounds(t[1]))
+}
+
+// Intersection returns the intersection of the segments s and t and
+// whether the segments intersect. If the segments cross or touch at a
+// single point, both end points of the returned segment are that point.
+// If the segments are collinear and overlap, the returned segment is the
+// overlapping part, ordered in the direction of s.
+func (s Segment) Intersection(t Segment) (Segment, bool) {
+	if !s.Intersects(t) {
+		return Segment{}, false
+	}
+	d := Sub(s[1], s[0])
+	e := Sub(t[1], t[0])
+	if Orient(s[0], s[1], t[0]) != 0 || Orient(s[0], s[1], t[1]) != 0 {
+		// The segments are not collinear, so they
+		// intersect at a single point.
+		den := Cross(d, e)
+		if den == 0 {
+			// One of the segments is a point.
+			if d == (Vec{}) {
+				return Segment{s[0], s[0]}, true
+			}
+			return Segment{t[0], t[0]}, true
+		}
+		u := Cross(Sub(t[0], s[0]), e) / den
+		u = math.Max(0, math.Min(1, u))
+		p := Add(s[0], Scale(u, d))
+		return Segment{p, p}, true
+	}
+
+	// The segments are collinear. Project the end points
+	// of t onto s and clip to the extent of s.
+	if d == (Vec{}) {
+		return Segment{s[0], s[0]}, true
+	}
+	proj := func(p Vec) float64 { return Dot(Sub(p, s[0]), d) }
+	lo, hi := proj(t[0]), proj(t[1])
+	a, b := t[0], t[1]
+	if lo > hi {
+		lo, hi = hi, lo
+		a, b = b, a
+	}
+	if lo < 0 {
+		a = s[0]
+	}
+	if hi > Norm2(d) {
+		b = s[1]
+	}
+	return Segment{a, b}, true
+}
+
+// bounds returns whether p, which must be collinear with s,
+// lies within the bounding box of s.
+func (s Segment) bounds(p Vec) bool {
+	return math.Min(s[0].X, s[1].X) <= p.X && p.X <= math.Max(s[0].X, s[1].X) &&
+		math.Min(s[0].Y, s[1].Y) <= p.Y && p.Y <= math.Max(s[0].Y, s[1].Y)
+}
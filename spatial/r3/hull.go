@@ -0,0 +1,429 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import "math"
+
+// Mesh is a triangle mesh. The Volume, Centroid and Contains methods
+// are only meaningful for closed meshes.
+type Mesh struct {
+	// Vertices is the set of vertices of the mesh.
+	Vertices []Vec
+
+	// Faces holds the triangular faces of the mesh as
+	// indices into Vertices. The vertices of each face
+	// are ordered counter-clockwise when viewed from
+	// outside the mesh, so the face normals point outwards.
+	Faces [][3]int
+
+	// Adjacency holds the indices of the faces adjacent
+	// to each face. Adjacency[f][i] is the face sharing
+	// the edge opposite vertex i of face f, or -1 if the
+	// edge is on the boundary of an open mesh.
+	Adjacency [][3]int
+}
+
+// Triangle returns the ith face of the mesh.
+func (m Mesh) Triangle(i int) Triangle {
+	f := m.Faces[i]
+	return Triangle{m.Vertices[f[0]], m.Vertices[f[1]], m.Vertices[f[2]]}
+}
+
+// Area returns the surface area of the mesh.
+func (m Mesh) Area() float64 {
+	var a float64
+	for i := range m.Faces {
+		a += Norm(m.Triangle(i).Normal())
+	}
+	return a / 2
+}
+
+// Volume returns the volume enclosed by the mesh.
+func (m Mesh) Volume() float64 {
+	if len(m.Faces) == 0 {
+		return 0
+	}
+	// Sum the signed volumes of the tetrahedra formed
+	// by each face and a reference point.
+	o := m.Vertices[m.Faces[0][0]]
+	var v float64
+	for i := range m.Faces {
+		t := m.Triangle(i)
+		v += Dot(Sub(t[0], o), Cross(Sub(t[1], o), Sub(t[2], o)))
+	}
+	return v / 6
+}
+
+// Centroid returns the centroid of the volume enclosed by the mesh.
+func (m Mesh) Centroid() Vec {
+	if len(m.Faces) == 0 {
+		return Vec{}
+	}
+	o := m.Vertices[m.Faces[0][0]]
+	var (
+		v float64
+		c Vec
+	)
+	for i := range m.Faces {
+		t := m.Triangle(i)
+		a, b, d := Sub(t[0], o), Sub(t[1], o), Sub(t[2], o)
+		w := Dot(a, Cross(b, d))
+		v += w
+		c = Add(c, Scale(w, Add(Add(a, b), d)))
+	}
+	return Add(o, Scale(1/(4*v), c))
+}
+
+// Contains returns whether p is within the mesh, which must be convex.
+func (m Mesh) Contains(p Vec) bool {
+	if len(m.Faces) == 0 {
+		return false
+	}
+	for i := range m.Faces {
+		t := m.Triangle(i)
+		if Dot(t.Normal(), Sub(p, t[0])) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// ConvexHull returns the convex hull of the points in pts computed using
+// the quickhull algorithm. Coplanar faces of the hull are represented by
+// more than one triangle. If the points are all coplanar, the returned
+// mesh is empty.
+//
+// See C. B. Barber, D. P. Dobkin and H. Huhdanpaa, "The quickhull algorithm
+// for convex hulls", ACM Trans. Math. Softw. 22(4):469–483, 1996.
+func ConvexHull(pts []Vec) Mesh {
+	if len(pts) < 4 {
+		return Mesh{}
+	}
+	var maxAbs Vec
+	for _, p := range pts {
+		maxAbs = maxElem(maxAbs, absElem(p))
+	}
+	eps := 3 * 0x1p-52 * (maxAbs.X + maxAbs.Y + maxAbs.Z)
+
+	h := hull{pts: pts, eps: eps, edges: make(map[[2]int]int)}
+	if !h.init() {
+		return Mesh{}
+	}
+	h.build()
+	return h.mesh()
+}
+
+// hull is a convex hull under construction.
+type hull struct {
+	pts   []Vec
+	eps   float64
+	faces []hullFace
+
+	// edges maps directed edges of live
+	// faces to the face they belong to.
+	edges map[[2]int]int
+}
+
+// hullFace is a face of a hull under construction.
+type hullFace struct {
+	v       [3]int
+	normal  Vec // Unit outward normal.
+	offset  float64
+	outside []int
+	alive   bool
+}
+
+func (f *hullFace) distance(p Vec) float64 {
+	return Dot(f.normal, p) - f.offset
+}
+
+// extremePair returns the indices of the pair of extreme points of pts with
+// the greatest separation along a coordinate axis.
+func extremePair(pts []Vec) (a, b int) {
+	var lo, hi [3]int
+	for i, p := range pts {
+		for k := 0; k < 3; k++ {
+			if comp(p, k) < comp(pts[lo[k]], k) {
+				lo[k] = i
+			}
+			if comp(p, k) > comp(pts[hi[k]], k) {
+				hi[k] = i
+			}
+		}
+	}
+	a, b = lo[0], hi[0]
+	extent := comp(pts[b], 0) - comp(pts[a], 0)
+	for k := 1; k < 3; k++ {
+		if e := comp(pts[hi[k]], k) - comp(pts[lo[k]], k); e > extent {
+			a, b, extent = lo[k], hi[k], e
+		}
+	}
+	return a, b
+}
+
+// init constructs the initial tetrahedron and assigns the
+// remaining points to its faces. It returns false if the
+// points are coplanar.
+func (h *hull) init() bool {
+	pts := h.pts
+
+	a, b := extremePair(pts)
+	if Norm(Sub(pts[b], pts[a])) <= h.eps {
+		return false
+	}
+
+	// Find the point furthest from the line ab.
+	c := -1
+	var best float64
+	ab := Sub(pts[b], pts[a])
+	for i, p := range pts {
+		d := Norm(Cross(ab, Sub(p, pts[a])))
+		if d > best {
+			best, c = d, i
+		}
+	}
+	if c < 0 || best/Norm(ab) <= h.eps {
+		return false
+	}
+
+	// Find the point furthest from the plane abc.
+	n := Unit(Cross(ab, Sub(pts[c], pts[a])))
+	d := -1
+	best = 0
+	for i, p := range pts {
+		dist := math.Abs(Dot(n, Sub(p, pts[a])))
+		if dist > best {
+			best, d = dist, i
+		}
+	}
+	if d < 0 || best <= h.eps {
+		return false
+	}
+
+	// Orient the faces of the tetrahedron outwards.
+	if Dot(n, Sub(pts[d], pts[a])) > 0 {
+		b, c = c, b
+	}
+	h.addFace(a, b, c)
+	h.addFace(a, d, b)
+	h.addFace(b, d, c)
+	h.addFace(c, d, a)
+
+	for i := range pts {
+		if i == a || i == b || i == c || i == d {
+			continue
+		}
+		h.assign(i, []int{0, 1, 2, 3})
+	}
+	return true
+}
+
+// comp returns the kth component of p.
+func comp(p Vec, k int) float64 {
+	switch k {
+	case 0:
+		return p.X
+	case 1:
+		return p.Y
+	default:
+		return p.Z
+	}
+}
+
+// addFace adds a face with the given counter-clockwise
+// ordered vertices and returns its index.
+func (h *hull) addFace(a, b, c int) int {
+	n := Unit(Cross(Sub(h.pts[b], h.pts[a]), Sub(h.pts[c], h.pts[a])))
+	f := hullFace{
+		v:      [3]int{a, b, c},
+		normal: n,
+		offset: Dot(n, h.pts[a]),
+		alive:  true,
+	}
+	idx := len(h.faces)
+	h.faces = append(h.faces, f)
+	h.edges[[2]int{a, b}] = idx
+	h.edges[[2]int{b, c}] = idx
+	h.edges[[2]int{c, a}] = idx
+	return idx
+}
+
+// assign adds point i to the outside set of the first face in
+// faces that it is above. Points not above any face are discarded.
+func (h *hull) assign(i int, faces []int) {
+	p := h.pts[i]
+	for _, f := range faces {
+		if h.faces[f].distance(p) > h.eps {
+			h.faces[f].outside = append(h.faces[f].outside, i)
+			return
+		}
+	}
+}
+
+// build adds points to the hull until no face has a
+// non-empty outside set.
+func (h *hull) build() {
+	var (
+		visible []int
+		horizon [][2]int
+		orphans []int
+		created []int
+	)
+	for f := 0; f < len(h.faces); f++ {
+		for h.faces[f].alive && len(h.faces[f].outside) != 0 {
+			// Find the outside point furthest from the face.
+			face := &h.faces[f]
+			apex := face.outside[0]
+			best := face.distance(h.pts[apex])
+			for _, i := range face.outside[1:] {
+				if d := face.distance(h.pts[i]); d > best {
+					apex, best = i, d
+				}
+			}
+			p := h.pts[apex]
+
+			// Find the faces visible from the apex
+			// and the horizon edges bounding them.
+			visible = append(visible[:0], f)
+			horizon = horizon[:0]
+			seen := map[int]bool{f: true}
+			for k := 0; k < len(visible); k++ {
+				v := h.faces[visible[k]].v
+				for j := 0; j < 3; j++ {
+					e := [2]int{v[j], v[(j+1)%3]}
+					nb := h.edges[[2]int{e[1], e[0]}]
+					if seen[nb] {
+						continue
+					}
+					if h.faces[nb].distance(p) > h.eps {
+						seen[nb] = true
+						visible = append(visible, nb)
+					} else {
+						horizon = append(horizon, e)
+					}
+				}
+			}
+
+			// Remove the visible faces, collecting
+			// their outside points.
+			orphans = orphans[:0]
+			for _, vf := range visible {
+				face := &h.faces[vf]
+				face.alive = false
+				for _, i := range face.outside {
+					if i != apex {
+						orphans = append(orphans, i)
+					}
+				}
+				face.outside = nil
+				for j := 0; j < 3; j++ {
+					e := [2]int{face.v[j], face.v[(j+1)%3]}
+					if h.edges[e] == vf {
+						delete(h.edges, e)
+					}
+				}
+			}
+
+			// Construct the cone of new faces from the
+			// horizon to the apex.
+			created = created[:0]
+			for _, e := range horizon {
+				created = append(created, h.addFace(e[0], e[1], apex))
+			}
+			for _, i := range orphans {
+				h.assign(i, created)
+			}
+		}
+	}
+}
+
+// mesh returns the mesh of live faces of the hull.
+func (h *hull) mesh() Mesh {
+	var m Mesh
+	index := make(map[int]int)
+	faceIndex := make(map[int]int)
+	for i, f := range h.faces {
+		if !f.alive {
+			continue
+		}
+		faceIndex[i] = len(m.Faces)
+		var tri [3]int
+		for k, v := range f.v {
+			idx, ok := index[v]
+			if !ok {
+				idx = len(m.Vertices)
+				index[v] = idx
+				m.Vertices = append(m.Vertices, h.pts[v])
+			}
+			tri[k] = idx
+		}
+		m.Faces = append(m.Faces, tri)
+	}
+	m.Adjacency = make([][3]int, 0, len(m.Faces))
+	for _, f := range h.faces {
+		if !f.alive {
+			continue
+		}
+		var adj [3]int
+		for k := 0; k < 3; k++ {
+			// The edge opposite vertex k.
+			a, b := f.v[(k+1)%3], f.v[(k+2)%3]
+			adj[k] = faceIndex[h.edges[[2]int{b, a}]]
+		}
+		m.Adjacency = append(m.Adjacency, adj)
+	}
+	return m
+}
+
+// Halfspace is the set of points x satisfying Normal·x ≤ Offset.
+type Halfspace struct {
+	Normal Vec
+	Offset float64
+}
+
+// Contains returns whether p is in the halfspace.
+func (h Halfspace) Contains(p Vec) bool {
+	return Dot(h.Normal, p) <= h.Offset
+}
+
+// HalfspaceIntersection returns the convex polytope formed by the
+// intersection of the halfspaces in hs as a triangle mesh. The point
+// interior must lie strictly inside all the halfspaces. If interior is
+// not strictly inside all the halfspaces or the intersection is unbounded,
+// HalfspaceIntersection returns an empty mesh and false.
+func HalfspaceIntersection(hs []Halfspace, interior Vec) (Mesh, bool) {
+	// Translate the halfspaces so that interior is at the
+	// origin and compute the polar dual of the intersection.
+	// Each halfspace n·x ≤ d corresponds to the dual point n/d
+	// and each face of the convex hull of the dual points
+	// corresponds to a vertex of the intersection.
+	dual := make([]Vec, len(hs))
+	for i, h := range hs {
+		d := h.Offset - Dot(h.Normal, interior)
+		if !(d > 0) {
+			return Mesh{}, false
+		}
+		dual[i] = Scale(1/d, h.Normal)
+	}
+	// The origin must be strictly inside the dual hull
+	// for the intersection to be bounded.
+	dh := ConvexHull(dual)
+	if len(dh.Faces) == 0 {
+		return Mesh{}, false
+	}
+	verts := make([]Vec, 0, len(dh.Faces))
+	for i := range dh.Faces {
+		t := dh.Triangle(i)
+		n := t.Normal()
+		d := Dot(n, t[0])
+		if !(d > 0) {
+			return Mesh{}, false
+		}
+		// The plane n·y = d is the polar of the point n/d.
+		verts = append(verts, Add(interior, Scale(1/d, n)))
+	}
+	m := ConvexHull(verts)
+	return m, len(m.Faces) != 0
+}
@@ -0,0 +1,176 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestConvexHull(t *testing.T) {
+	// The unit cube with interior and face points.
+	var pts []Vec
+	for _, x := range []float64{0, 0.5, 1} {
+		for _, y := range []float64{0, 0.5, 1} {
+			for _, z := range []float64{0, 0.5, 1} {
+				pts = append(pts, Vec{X: x, Y: y, Z: z})
+			}
+		}
+	}
+	m := ConvexHull(pts)
+	checkMesh(t, "cube", m)
+	if len(m.Vertices) != 8 {
+		t.Errorf("unexpected number of cube hull vertices: got:%d want:8", len(m.Vertices))
+	}
+	if len(m.Faces) != 12 {
+		t.Errorf("unexpected number of cube hull faces: got:%d want:12", len(m.Faces))
+	}
+	if got := m.Volume(); !scalar.EqualWithinAbs(got, 1, 1e-14) {
+		t.Errorf("unexpected cube volume: got:%v want:1", got)
+	}
+	if got := m.Area(); !scalar.EqualWithinAbs(got, 6, 1e-14) {
+		t.Errorf("unexpected cube area: got:%v want:6", got)
+	}
+	if got, want := m.Centroid(), (Vec{0.5, 0.5, 0.5}); Norm(Sub(got, want)) > 1e-14 {
+		t.Errorf("unexpected cube centroid: got:%v want:%v", got, want)
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 10; i++ {
+		pts := make([]Vec, 500)
+		for j := range pts {
+			pts[j] = Vec{X: rnd.NormFloat64(), Y: rnd.NormFloat64(), Z: rnd.NormFloat64()}
+		}
+		m := ConvexHull(pts)
+		checkMesh(t, "random", m)
+		for _, p := range pts {
+			for f := range m.Faces {
+				tri := m.Triangle(f)
+				if d := Dot(Unit(tri.Normal()), Sub(p, tri[0])); d > 1e-12 {
+					t.Errorf("point %v outside hull face %d by %v", p, f, d)
+				}
+			}
+		}
+	}
+
+	// Points on a sphere are all hull vertices.
+	pts = pts[:0]
+	for i := 0; i < 200; i++ {
+		p := Unit(Vec{X: rnd.NormFloat64(), Y: rnd.NormFloat64(), Z: rnd.NormFloat64()})
+		pts = append(pts, p)
+	}
+	m = ConvexHull(pts)
+	checkMesh(t, "sphere", m)
+	if len(m.Vertices) != len(pts) {
+		t.Errorf("unexpected number of sphere hull vertices: got:%d want:%d", len(m.Vertices), len(pts))
+	}
+
+	// Coplanar points have no hull.
+	m = ConvexHull([]Vec{{0, 0, 0}, {1, 0, 0}, {0, 1, 0}, {1, 1, 0}, {0.5, 0.5, 0}})
+	if len(m.Faces) != 0 {
+		t.Errorf("unexpected hull for coplanar points: %v", m.Faces)
+	}
+}
+
+func TestExtremePair(t *testing.T) {
+	// The points have extents of 2, 2.5 and 2.4 along the x,
+	// y and z axes, and their x and y extreme points share the
+	// same z coordinate.
+	pts := []Vec{
+		{X: 0, Y: 0, Z: 0},
+		{X: 2, Y: 0, Z: 0},
+		{X: 0.5, Y: -1, Z: 0},
+		{X: 0.5, Y: 1.5, Z: 0},
+		{X: 0.5, Y: 0.2, Z: -1.2},
+		{X: 0.5, Y: 0.2, Z: 1.2},
+	}
+	a, b := extremePair(pts)
+	if a != 2 || b != 3 {
+		t.Errorf("unexpected extreme pair: got:(%d,%d) want:(2,3)", a, b)
+	}
+}
+
+func TestHalfspaceIntersection(t *testing.T) {
+	// An axis-aligned box with a redundant halfspace.
+	hs := []Halfspace{
+		{Normal: Vec{1, 0, 0}, Offset: 2},
+		{Normal: Vec{-1, 0, 0}, Offset: 0},
+		{Normal: Vec{0, 1, 0}, Offset: 3},
+		{Normal: Vec{0, -1, 0}, Offset: 0},
+		{Normal: Vec{0, 0, 1}, Offset: 4},
+		{Normal: Vec{0, 0, -1}, Offset: 0},
+		{Normal: Vec{1, 1, 1}, Offset: 100},
+	}
+	m, ok := HalfspaceIntersection(hs, Vec{1, 1, 1})
+	if !ok {
+		t.Fatal("unexpected failure for box intersection")
+	}
+	checkMesh(t, "box", m)
+	if len(m.Vertices) != 8 {
+		t.Errorf("unexpected number of box vertices: got:%d want:8", len(m.Vertices))
+	}
+	if got := m.Volume(); !scalar.EqualWithinAbsOrRel(got, 24, 1e-12, 1e-12) {
+		t.Errorf("unexpected box volume: got:%v want:24", got)
+	}
+
+	// Cutting a corner from the box.
+	hs = append(hs, Halfspace{Normal: Vec{1, 1, 1}, Offset: 8})
+	m, ok = HalfspaceIntersection(hs, Vec{1, 1, 1})
+	if !ok {
+		t.Fatal("unexpected failure for cut box intersection")
+	}
+	checkMesh(t, "cut box", m)
+	// The corner removed is a tetrahedron with legs of length 1.
+	if got, want := m.Volume(), 24-1.0/6; !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+		t.Errorf("unexpected cut box volume: got:%v want:%v", got, want)
+	}
+
+	// Unbounded and infeasible intersections.
+	if _, ok := HalfspaceIntersection(hs[:5], Vec{1, 1, 1}); ok {
+		t.Error("expected failure for unbounded intersection")
+	}
+	if _, ok := HalfspaceIntersection(hs, Vec{-1, 1, 1}); ok {
+		t.Error("expected failure for exterior point")
+	}
+}
+
+// checkMesh checks that m is a closed, consistently oriented, convex mesh.
+func checkMesh(t *testing.T, name string, m Mesh) {
+	t.Helper()
+	if len(m.Adjacency) != len(m.Faces) {
+		t.Errorf("%s: adjacency length mismatch: %d != %d", name, len(m.Adjacency), len(m.Faces))
+		return
+	}
+	// Euler's formula for a closed triangulated surface.
+	if got, want := len(m.Faces), 2*len(m.Vertices)-4; got != want {
+		t.Errorf("%s: unexpected number of faces: got:%d want:%d", name, got, want)
+	}
+	c := m.Centroid()
+	for i, f := range m.Faces {
+		tri := m.Triangle(i)
+		if Dot(tri.Normal(), Sub(tri[0], c)) <= 0 {
+			t.Errorf("%s: face %d not oriented outwards", name, i)
+		}
+		for k, nb := range m.Adjacency[i] {
+			a, b := f[(k+1)%3], f[(k+2)%3]
+			g := m.Faces[nb]
+			var found bool
+			for j := 0; j < 3; j++ {
+				if g[j] == b && g[(j+1)%3] == a {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("%s: face %d does not share edge {%d,%d} with neighbor %d", name, i, a, b, nb)
+			}
+		}
+	}
+	if v := m.Volume(); !(v > 0) || math.IsInf(v, 0) {
+		t.Errorf("%s: unexpected volume: %v", name, v)
+	}
+}
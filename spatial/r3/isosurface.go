@@ -0,0 +1,225 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import "math"
+
+// Grid is a scalar field sampled on a rectilinear grid.
+type Grid interface {
+	// Dims returns the number of grid points along
+	// each axis.
+	Dims() (nx, ny, nz int)
+
+	// At returns the value of the field at the grid
+	// point (i, j, k). It will panic if i, j or k are
+	// out of bounds for the grid.
+	At(i, j, k int) float64
+
+	// X, Y and Z return the coordinates for the grid
+	// planes at the given index along each axis. They
+	// will panic if the index is out of bounds for
+	// the grid.
+	X(i int) float64
+	Y(j int) float64
+	Z(k int) float64
+}
+
+// Isosurface returns the iso-surface of the field sampled by g at the
+// given level as a triangle mesh, calculated using the marching cubes
+// algorithm with linear interpolation along cell edges. The coordinates
+// of the grid along each axis must be strictly increasing.
+//
+// Each grid cell is decomposed into six tetrahedra sharing the diagonal
+// of the cell. This avoids the ambiguous configurations of the classic
+// marching cubes case table and ensures that the returned surface has no
+// holes within the grid. The face normals of the mesh point in the direction
+// of increasing field values, so a surface enclosing a region of values
+// below level has a positive volume. The surface is open where it meets
+// the boundary of the grid. Cells with a NaN corner value are ignored.
+func Isosurface(g Grid, level float64) Mesh {
+	nx, ny, nz := g.Dims()
+	s := surfacer{g: g, level: level, index: make(map[[2][3]int]int)}
+	for k := 0; k < nz-1; k++ {
+		for j := 0; j < ny-1; j++ {
+			for i := 0; i < nx-1; i++ {
+				s.cell(i, j, k)
+			}
+		}
+	}
+	return s.mesh()
+}
+
+// cellTetrahedra holds the decomposition of a cube into six tetrahedra
+// around the diagonal from corner 0 to corner 7. Corners are indexed by
+// x + 2y + 4z for the corner offsets x, y and z. The decomposition divides
+// each face of the cube along the diagonal from its lowest to its highest
+// corner, so the decompositions of adjacent cells agree on shared faces.
+var cellTetrahedra = [6][4]int{
+	{0, 1, 3, 7},
+	{0, 1, 5, 7},
+	{0, 2, 3, 7},
+	{0, 2, 6, 7},
+	{0, 4, 5, 7},
+	{0, 4, 6, 7},
+}
+
+// surfacer holds the state of a marching cubes calculation.
+type surfacer struct {
+	g     Grid
+	level float64
+
+	// index maps grid edges to vertices
+	// of the mesh.
+	index map[[2][3]int]int
+	m     Mesh
+}
+
+// cell adds the triangles of the grid cell with its lowest corner at
+// (i, j, k).
+func (s *surfacer) cell(i, j, k int) {
+	var (
+		corners [8][3]int
+		vals    [8]float64
+	)
+	for c := range corners {
+		p := [3]int{i + c&1, j + c>>1&1, k + c>>2&1}
+		corners[c] = p
+		vals[c] = s.g.At(p[0], p[1], p[2])
+		if math.IsNaN(vals[c]) {
+			return
+		}
+	}
+	for _, tet := range cellTetrahedra {
+		var (
+			p [4][3]int
+			v [4]float64
+		)
+		for n, c := range tet {
+			p[n] = corners[c]
+			v[n] = vals[c]
+		}
+		s.tetrahedron(p, v)
+	}
+}
+
+// tetrahedron adds the triangles of the iso-surface within the
+// tetrahedron with the grid point vertices p and field values v.
+func (s *surfacer) tetrahedron(p [4][3]int, v [4]float64) {
+	var below, above []int
+	for n, val := range v {
+		if val < s.level {
+			below = append(below, n)
+		} else {
+			above = append(above, n)
+		}
+	}
+	switch len(below) {
+	case 0, 4:
+		return
+	case 1:
+		b := below[0]
+		s.triangle(
+			s.vertex(p[b], p[above[0]], v[b], v[above[0]]),
+			s.vertex(p[b], p[above[1]], v[b], v[above[1]]),
+			s.vertex(p[b], p[above[2]], v[b], v[above[2]]),
+			p[b], p[above[0]],
+		)
+	case 3:
+		a := above[0]
+		s.triangle(
+			s.vertex(p[below[0]], p[a], v[below[0]], v[a]),
+			s.vertex(p[below[1]], p[a], v[below[1]], v[a]),
+			s.vertex(p[below[2]], p[a], v[below[2]], v[a]),
+			p[below[0]], p[a],
+		)
+	case 2:
+		// The surface is a quadrilateral separating
+		// the pair below the level from the pair above.
+		b0, b1 := below[0], below[1]
+		a0, a1 := above[0], above[1]
+		q0 := s.vertex(p[b0], p[a0], v[b0], v[a0])
+		q1 := s.vertex(p[b0], p[a1], v[b0], v[a1])
+		q2 := s.vertex(p[b1], p[a1], v[b1], v[a1])
+		q3 := s.vertex(p[b1], p[a0], v[b1], v[a0])
+		s.triangle(q0, q1, q2, p[b0], p[a0])
+		s.triangle(q0, q2, q3, p[b0], p[a0])
+	}
+}
+
+// vertex returns the index of the mesh vertex on the grid edge between
+// the grid points a and b with field values va below the level and vb at
+// or above the level, calculating it if necessary.
+func (s *surfacer) vertex(a, b [3]int, va, vb float64) int {
+	// Vertices at grid points are shared between
+	// all the edges that meet at the grid point.
+	var key [2][3]int
+	switch {
+	case vb == s.level:
+		key = [2][3]int{b, b}
+	case a[0] < b[0] || (a[0] == b[0] && (a[1] < b[1] || (a[1] == b[1] && a[2] < b[2]))):
+		key = [2][3]int{a, b}
+	default:
+		key = [2][3]int{b, a}
+	}
+	idx, ok := s.index[key]
+	if ok {
+		return idx
+	}
+	pa := s.point(a)
+	pb := s.point(b)
+	t := (s.level - va) / (vb - va)
+	idx = len(s.m.Vertices)
+	s.index[key] = idx
+	s.m.Vertices = append(s.m.Vertices, Add(pa, Scale(t, Sub(pb, pa))))
+	return idx
+}
+
+// point returns the location of the grid point p.
+func (s *surfacer) point(p [3]int) Vec {
+	return Vec{X: s.g.X(p[0]), Y: s.g.Y(p[1]), Z: s.g.Z(p[2])}
+}
+
+// triangle adds the triangle with the vertex indices a, b and c to the
+// mesh, oriented so that its normal points from the grid point lo below
+// the level towards the grid point hi above the level. Degenerate triangles
+// are not added.
+func (s *surfacer) triangle(a, b, c int, lo, hi [3]int) {
+	if a == b || b == c || c == a {
+		return
+	}
+	vs := s.m.Vertices
+	n := Cross(Sub(vs[b], vs[a]), Sub(vs[c], vs[a]))
+	if Dot(n, Sub(s.point(hi), s.point(lo))) < 0 {
+		b, c = c, b
+	}
+	s.m.Faces = append(s.m.Faces, [3]int{a, b, c})
+}
+
+// mesh returns the completed mesh with its face adjacencies.
+func (s *surfacer) mesh() Mesh {
+	m := s.m
+	if len(m.Faces) == 0 {
+		return Mesh{}
+	}
+	edges := make(map[[2]int]int, 3*len(m.Faces))
+	for i, f := range m.Faces {
+		for k := 0; k < 3; k++ {
+			edges[[2]int{f[k], f[(k+1)%3]}] = i
+		}
+	}
+	m.Adjacency = make([][3]int, len(m.Faces))
+	for i, f := range m.Faces {
+		for k := 0; k < 3; k++ {
+			// The edge opposite vertex k.
+			a, b := f[(k+1)%3], f[(k+2)%3]
+			adj, ok := edges[[2]int{b, a}]
+			if !ok {
+				adj = -1
+			}
+			m.Adjacency[i][k] = adj
+		}
+	}
+	return m
+}
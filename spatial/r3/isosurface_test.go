@@ -0,0 +1,115 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// funcGrid is a Grid sampling f on a regular grid.
+type funcGrid struct {
+	min, max Vec
+	n        int
+	f        func(Vec) float64
+}
+
+func (g funcGrid) Dims() (nx, ny, nz int) { return g.n, g.n, g.n }
+func (g funcGrid) At(i, j, k int) float64 {
+	return g.f(Vec{X: g.X(i), Y: g.Y(j), Z: g.Z(k)})
+}
+func (g funcGrid) X(i int) float64 { return g.min.X + (g.max.X-g.min.X)*float64(i)/float64(g.n-1) }
+func (g funcGrid) Y(j int) float64 { return g.min.Y + (g.max.Y-g.min.Y)*float64(j)/float64(g.n-1) }
+func (g funcGrid) Z(k int) float64 { return g.min.Z + (g.max.Z-g.min.Z)*float64(k)/float64(g.n-1) }
+
+func TestIsosurfaceSphere(t *testing.T) {
+	g := funcGrid{
+		min: Vec{-1.5, -1.5, -1.5}, max: Vec{1.5, 1.5, 1.5},
+		n: 31,
+		f: func(p Vec) float64 { return Norm(p) },
+	}
+	m := Isosurface(g, 1)
+	if len(m.Faces) == 0 {
+		t.Fatal("empty sphere iso-surface")
+	}
+	for _, v := range m.Vertices {
+		if !scalar.EqualWithinAbs(Norm(v), 1, 1e-2) {
+			t.Errorf("vertex not on sphere: %v", v)
+		}
+	}
+
+	// The surface is closed with genus zero.
+	edges := make(map[[2]int]bool)
+	for i, f := range m.Faces {
+		for k, nb := range m.Adjacency[i] {
+			if nb < 0 {
+				t.Errorf("face %d has no neighbor opposite vertex %d", i, k)
+			}
+			a, b := f[(k+1)%3], f[(k+2)%3]
+			if a > b {
+				a, b = b, a
+			}
+			edges[[2]int{a, b}] = true
+		}
+	}
+	if chi := len(m.Vertices) - len(edges) + len(m.Faces); chi != 2 {
+		t.Errorf("unexpected Euler characteristic: got:%d want:2", chi)
+	}
+
+	if v := m.Volume(); !scalar.EqualWithinRel(v, 4*math.Pi/3, 2e-2) {
+		t.Errorf("unexpected volume: got:%v want:%v", v, 4*math.Pi/3)
+	}
+	if a := m.Area(); !scalar.EqualWithinRel(a, 4*math.Pi, 2e-2) {
+		t.Errorf("unexpected area: got:%v want:%v", a, 4*math.Pi)
+	}
+	if c := m.Centroid(); Norm(c) > 1e-10 {
+		t.Errorf("unexpected centroid: got:%v want:%v", c, Vec{})
+	}
+
+	// Normals point towards increasing values.
+	for i := range m.Faces {
+		tri := m.Triangle(i)
+		if Dot(tri.Normal(), tri.Centroid()) <= 0 {
+			t.Errorf("face %d not oriented outwards", i)
+		}
+	}
+
+	if m := Isosurface(g, 10); len(m.Faces) != 0 || len(m.Vertices) != 0 {
+		t.Errorf("unexpected iso-surface outside field range: %d faces", len(m.Faces))
+	}
+}
+
+func TestIsosurfacePlane(t *testing.T) {
+	g := funcGrid{
+		min: Vec{0, 0, 0}, max: Vec{1, 1, 1},
+		n: 5,
+		f: func(p Vec) float64 { return p.X + 2*p.Y + 3*p.Z },
+	}
+	const level = 2.9
+	m := Isosurface(g, level)
+	n := Unit(Vec{1, 2, 3})
+	var boundary int
+	for i := range m.Faces {
+		tri := m.Triangle(i)
+		for _, v := range tri {
+			if !scalar.EqualWithinAbs(v.X+2*v.Y+3*v.Z, level, 1e-12) {
+				t.Errorf("vertex not on plane: %v", v)
+			}
+		}
+		if Dot(Unit(tri.Normal()), n) < 1-1e-12 {
+			t.Errorf("face %d not oriented towards increasing values", i)
+		}
+		for _, nb := range m.Adjacency[i] {
+			if nb < 0 {
+				boundary++
+			}
+		}
+	}
+	if boundary == 0 {
+		t.Error("expected open surface")
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/spatial/kdtree"
+)
+
+// Similarity is a similarity transformation composed of a rotation,
+// a uniform scaling and a translation. A Similarity with a Scale of
+// one is a rigid transformation.
+type Similarity struct {
+	Rotation    Rotation
+	Scale       float64
+	Translation Vec
+}
+
+// Transform returns the transformation of p, Scale⋅Rotation(p)+Translation.
+func (s Similarity) Transform(p Vec) Vec {
+	return Add(Scale(s.Scale, s.Rotation.Rotate(p)), s.Translation)
+}
+
+// Kabsch returns the rigid transformation that best aligns the points in
+// src with the corresponding points in dst, minimizing the weighted sum of
+// squared distances
+//
+//	∑_i weights[i]⋅‖dst[i] - (R(src[i])+t)‖²
+//
+// over rotations R and translations t. If weights is nil, all the points are
+// equally weighted. Kabsch will panic if the lengths of dst, src and non-nil
+// weights are not equal or if they are empty.
+//
+// See W. Kabsch, "A solution for the best rotation to relate two sets of
+// vectors", Acta Crystallographica A32:922–923, 1976.
+func Kabsch(dst, src []Vec, weights []float64) Similarity {
+	return align(dst, src, weights, false)
+}
+
+// Umeyama returns the similarity transformation that best aligns the points
+// in src with the corresponding points in dst, minimizing the weighted sum
+// of squared distances
+//
+//	∑_i weights[i]⋅‖dst[i] - (c⋅R(src[i])+t)‖²
+//
+// over rotations R, scales c and translations t. If weights is nil, all the
+// points are equally weighted. Umeyama will panic if the lengths of dst, src
+// and non-nil weights are not equal or if they are empty.
+//
+// See S. Umeyama, "Least-squares estimation of transformation parameters
+// between two point patterns", IEEE Transactions on Pattern Analysis and
+// Machine Intelligence 13(4):376–380, 1991.
+func Umeyama(dst, src []Vec, weights []float64) Similarity {
+	return align(dst, src, weights, true)
+}
+
+// align returns the optimal alignment of src to dst, optionally
+// including a scaling.
+func align(dst, src []Vec, weights []float64, scale bool) Similarity {
+	if len(dst) != len(src) || (weights != nil && len(weights) != len(src)) {
+		panic("r3: length mismatch")
+	}
+	if len(src) == 0 {
+		panic("r3: no points to align")
+	}
+
+	// Find the weighted centroids of the point sets.
+	var (
+		cd, cs Vec
+		sum    float64
+	)
+	for i := range src {
+		w := 1.0
+		if weights != nil {
+			w = weights[i]
+		}
+		cd = Add(cd, Scale(w, dst[i]))
+		cs = Add(cs, Scale(w, src[i]))
+		sum += w
+	}
+	cd = Scale(1/sum, cd)
+	cs = Scale(1/sum, cs)
+
+	// Form the cross-covariance of the centered points
+	// and the variance of the source points.
+	var (
+		cov, outer Mat
+		variance   float64
+	)
+	for i := range src {
+		w := 1.0
+		if weights != nil {
+			w = weights[i]
+		}
+		d := Sub(dst[i], cd)
+		s := Sub(src[i], cs)
+		outer.Outer(w/sum, d, s)
+		cov.Add(&cov, &outer)
+		variance += w / sum * Norm2(s)
+	}
+
+	var svd mat.SVD
+	ok := svd.Factorize(&cov, mat.SVDFull)
+	if !ok {
+		panic("r3: SVD factorization failed")
+	}
+	var u, v mat.Dense
+	svd.UTo(&u)
+	svd.VTo(&v)
+	sv := svd.Values(nil)
+
+	// Correct for reflections so that the
+	// result is a proper rotation.
+	d := 1.0
+	if mat.Det(&u)*mat.Det(&v) < 0 {
+		d = -1
+	}
+	var r mat.Dense
+	u.Mul(&u, mat.NewDiagDense(3, []float64{1, 1, d}))
+	r.Mul(&u, v.T())
+	rot := NewRotationFromMat(&r)
+
+	c := 1.0
+	if scale && variance > 0 {
+		c = (sv[0] + sv[1] + d*sv[2]) / variance
+	}
+	return Similarity{
+		Rotation:    rot,
+		Scale:       c,
+		Translation: Sub(cd, Scale(c, rot.Rotate(cs))),
+	}
+}
+
+// ICPSettings holds settings for iterative closest point registration.
+type ICPSettings struct {
+	// MaxIterations is the maximum number of iterations
+	// to perform. If MaxIterations is zero, a default
+	// of 50 is used.
+	MaxIterations int
+
+	// Tolerance is the convergence tolerance for the
+	// change in root mean square alignment error between
+	// iterations. If Tolerance is zero, a default of
+	// 1e-10 is used.
+	Tolerance float64
+
+	// MaxDistance is the maximum distance between
+	// corresponding points for the pair to be used in
+	// the alignment. If MaxDistance is zero, all pairs
+	// are used.
+	MaxDistance float64
+
+	// Scale specifies whether to estimate a uniform
+	// scaling in addition to the rigid transformation.
+	Scale bool
+}
+
+// ICPResult holds the result of an iterative closest point registration.
+type ICPResult struct {
+	// Transform is the transformation aligning the
+	// source points to the destination points.
+	Transform Similarity
+
+	// RMSD is the root mean square distance between
+	// the transformed source points used in the final
+	// alignment and their closest destination points.
+	RMSD float64
+
+	// Iterations is the number of iterations performed.
+	Iterations int
+
+	// Converged indicates whether the alignment error
+	// converged within the iteration limit.
+	Converged bool
+}
+
+// ICP aligns the points in src to the point cloud dst using the iterative
+// closest point algorithm, starting from the initial transformation init.
+// At each iteration, the transformed source points are matched to their
+// nearest points in dst using a k-d tree and the transformation is updated
+// using Kabsch, or Umeyama if settings.Scale is true. If settings is nil,
+// default settings are used. A zero init is treated as the identity.
+// ICP will panic if dst or src is empty.
+//
+// See P. J. Besl and N. D. McKay, "A method for registration of 3-D shapes",
+// IEEE Transactions on Pattern Analysis and Machine Intelligence
+// 14(2):239–256, 1992.
+func ICP(dst, src []Vec, init Similarity, settings *ICPSettings) ICPResult {
+	if len(dst) == 0 || len(src) == 0 {
+		panic("r3: no points to align")
+	}
+	var s ICPSettings
+	if settings != nil {
+		s = *settings
+	}
+	if s.MaxIterations == 0 {
+		s.MaxIterations = 50
+	}
+	if s.Tolerance == 0 {
+		s.Tolerance = 1e-10
+	}
+	if init.Rotation == (Rotation{}) {
+		init.Rotation = Rotation{Real: 1}
+	}
+	if init.Scale == 0 {
+		init.Scale = 1
+	}
+
+	pts := make(kdtree.Points, len(dst))
+	for i, p := range dst {
+		pts[i] = kdtree.Point{p.X, p.Y, p.Z}
+	}
+	tree := kdtree.New(pts, false)
+
+	res := ICPResult{Transform: init, RMSD: math.Inf(1)}
+	matched := make([]Vec, 0, len(src))
+	used := make([]Vec, 0, len(src))
+	maxDist2 := s.MaxDistance * s.MaxDistance
+	for res.Iterations < s.MaxIterations {
+		res.Iterations++
+
+		// Match the transformed source points
+		// to their nearest destination points.
+		matched = matched[:0]
+		used = used[:0]
+		var ssd float64
+		for _, p := range src {
+			q := res.Transform.Transform(p)
+			c, d2 := tree.Nearest(kdtree.Point{q.X, q.Y, q.Z})
+			if s.MaxDistance > 0 && d2 > maxDist2 {
+				continue
+			}
+			n := c.(kdtree.Point)
+			matched = append(matched, Vec{X: n[0], Y: n[1], Z: n[2]})
+			used = append(used, p)
+			ssd += d2
+		}
+		if len(used) == 0 {
+			break
+		}
+		rmsd := math.Sqrt(ssd / float64(len(used)))
+		if math.Abs(res.RMSD-rmsd) <= s.Tolerance {
+			res.RMSD = rmsd
+			res.Converged = true
+			break
+		}
+		res.RMSD = rmsd
+
+		res.Transform = align(matched, used, nil, s.Scale)
+	}
+	return res
+}
@@ -0,0 +1,128 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/num/quat"
+)
+
+func randomCloud(rnd *rand.Rand, n int) []Vec {
+	pts := make([]Vec, n)
+	for i := range pts {
+		pts[i] = Vec{X: rnd.NormFloat64(), Y: 2 * rnd.NormFloat64(), Z: 3 * rnd.NormFloat64()}
+	}
+	return pts
+}
+
+func transformAll(s Similarity, pts []Vec) []Vec {
+	dst := make([]Vec, len(pts))
+	for i, p := range pts {
+		dst[i] = s.Transform(p)
+	}
+	return dst
+}
+
+func sameSimilarity(a, b Similarity, tol float64) bool {
+	// q and -q represent the same rotation.
+	return math.Abs(qdot(quat.Number(a.Rotation), quat.Number(b.Rotation))) > 1-tol &&
+		scalar.EqualWithinAbs(a.Scale, b.Scale, tol) &&
+		Norm(Sub(a.Translation, b.Translation)) < tol
+}
+
+func TestKabschUmeyama(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		src := randomCloud(rnd, 10+rnd.Intn(20))
+		want := Similarity{
+			Rotation:    NewRotation(2*math.Pi*rnd.Float64(), Vec{X: rnd.NormFloat64(), Y: rnd.NormFloat64(), Z: rnd.NormFloat64()}),
+			Scale:       1,
+			Translation: Vec{X: rnd.NormFloat64(), Y: rnd.NormFloat64(), Z: rnd.NormFloat64()},
+		}
+		dst := transformAll(want, src)
+		if got := Kabsch(dst, src, nil); !sameSimilarity(got, want, 1e-10) {
+			t.Errorf("unexpected Kabsch transform: got:%+v want:%+v", got, want)
+		}
+
+		want.Scale = 0.5 + 2*rnd.Float64()
+		dst = transformAll(want, src)
+		if got := Umeyama(dst, src, nil); !sameSimilarity(got, want, 1e-10) {
+			t.Errorf("unexpected Umeyama transform: got:%+v want:%+v", got, want)
+		}
+
+		// Outliers with zero weight do not affect the result.
+		weights := make([]float64, len(src))
+		for j := range weights {
+			weights[j] = 1 + rnd.Float64()
+		}
+		weights[0] = 0
+		dst[0] = Add(dst[0], Vec{X: 100})
+		if got := Umeyama(dst, src, weights); !sameSimilarity(got, want, 1e-10) {
+			t.Errorf("unexpected weighted Umeyama transform: got:%+v want:%+v", got, want)
+		}
+	}
+}
+
+func TestKabschReflection(t *testing.T) {
+	// A reflected point set must still be aligned
+	// with a proper rotation.
+	src := []Vec{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}, {1, 1, 1}}
+	dst := make([]Vec, len(src))
+	for i, p := range src {
+		dst[i] = Vec{X: -p.X, Y: p.Y, Z: p.Z}
+	}
+	got := Kabsch(dst, src, nil)
+	if n := Norm(Vec{got.Rotation.Imag, got.Rotation.Jmag, got.Rotation.Kmag}); !scalar.EqualWithinAbs(math.Hypot(got.Rotation.Real, n), 1, 1e-14) {
+		t.Errorf("rotation not normalized: %v", got.Rotation)
+	}
+	if det := got.Rotation.Mat().Det(); !scalar.EqualWithinAbs(det, 1, 1e-12) {
+		t.Errorf("unexpected rotation determinant: got:%v want:1", det)
+	}
+}
+
+func TestICP(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	dst := randomCloud(rnd, 500)
+	want := Similarity{
+		Rotation:    NewRotation(0.2, Vec{X: 1, Y: 1, Z: 0}),
+		Scale:       1,
+		Translation: Vec{X: 0.1, Y: -0.2, Z: 0.05},
+	}
+	inv := Similarity{
+		Rotation: want.Rotation.Inverse(),
+		Scale:    1,
+	}
+	inv.Translation = Scale(-1, inv.Rotation.Rotate(want.Translation))
+
+	// src is a noise-free subset of dst moved by the
+	// inverse of want, so aligning src to dst recovers want.
+	src := transformAll(inv, dst[:200])
+
+	res := ICP(dst, src, Similarity{}, nil)
+	if !res.Converged {
+		t.Errorf("ICP did not converge after %d iterations", res.Iterations)
+	}
+	if res.RMSD > 1e-8 {
+		t.Errorf("unexpected RMSD: got:%v want:0", res.RMSD)
+	}
+	if !sameSimilarity(res.Transform, want, 1e-8) {
+		t.Errorf("unexpected ICP transform: got:%+v want:%+v", res.Transform, want)
+	}
+
+	// Scale estimation.
+	want.Scale = 1.1
+	inv.Scale = 1 / want.Scale
+	inv.Translation = Scale(-inv.Scale, inv.Rotation.Rotate(want.Translation))
+	src = transformAll(inv, dst[:200])
+	res = ICP(dst, src, Similarity{}, &ICPSettings{Scale: true, MaxIterations: 200})
+	if !sameSimilarity(res.Transform, want, 1e-6) {
+		t.Errorf("unexpected scaled ICP transform: got:%+v want:%+v", res.Transform, want)
+	}
+}
@@ -7,18 +7,10 @@ package r3
 import (
 	"math"
 
+	"gonum.org/v1/gonum/mat"
 	"gonum.org/v1/gonum/num/quat"
 )
 
-// TODO: possibly useful additions to the current rotation API:
-//  - create rotations from Euler angles (NewRotationFromEuler?)
-//  - create rotations from rotation matrices (NewRotationFromMatrix?)
-//  - return the equivalent Euler angles from a Rotation
-//
-// Euler angles have issues (see [1] for a discussion).
-// We should think carefully before adding them in.
-// [1]: http://www.euclideanspace.com/maths/geometry/rotations/conversions/quaternionToEuler/
-
 // Rotation describes a rotation in space.
 type Rotation quat.Number
 
@@ -56,3 +48,261 @@ func (r Rotation) isIdentity() bool {
 func raise(p Vec) quat.Number {
 	return quat.Number{Imag: p.X, Jmag: p.Y, Kmag: p.Z}
 }
+
+// NewRotationFromMat returns the rotation corresponding to the 3×3 rotation
+// matrix m. If m is not a rotation matrix, the returned rotation is the
+// rotation closest to m in the sense of Shepperd's method.
+// NewRotationFromMat will panic if m is not 3×3.
+func NewRotationFromMat(m mat.Matrix) Rotation {
+	r, c := m.Dims()
+	if r != 3 || c != 3 {
+		panic(mat.ErrShape)
+	}
+	var a [3][3]float64
+	for i := range a {
+		for j := range a[i] {
+			a[i][j] = m.At(i, j)
+		}
+	}
+	return Rotation(quat.FromMatrix(a))
+}
+
+// EulerSequence specifies the order of the elemental rotations
+// used to describe a rotation by Euler angles. The rotations
+// are performed about the axes of the fixed reference frame
+// (extrinsic rotations) in the order given by the sequence name,
+// so XYZ is a rotation about x followed by rotation about y
+// followed by rotation about z. This is equivalent to intrinsic
+// rotations about the rotating body axes in the reverse order.
+type EulerSequence int
+
+// Tait-Bryan Euler angle sequences.
+const (
+	XYZ EulerSequence = iota
+	XZY
+	YXZ
+	YZX
+	ZXY
+	ZYX
+)
+
+// axes returns the axis indices of the sequence and
+// whether the sequence is a cyclic permutation of XYZ.
+func (seq EulerSequence) axes() (i, j, k int, cyclic bool) {
+	switch seq {
+	case XYZ:
+		return 0, 1, 2, true
+	case XZY:
+		return 0, 2, 1, false
+	case YXZ:
+		return 1, 0, 2, false
+	case YZX:
+		return 1, 2, 0, true
+	case ZXY:
+		return 2, 0, 1, true
+	case ZYX:
+		return 2, 1, 0, false
+	default:
+		panic("r3: invalid Euler sequence")
+	}
+}
+
+// NewRotationFromEuler returns the rotation described by the Euler angles
+// a, b and c, applied about the first, second and third axes of seq
+// respectively.
+//
+// Euler angle representations suffer from gimbal lock when the second
+// angle is ±π/2; see https://en.wikipedia.org/wiki/Euler_angles.
+func NewRotationFromEuler(seq EulerSequence, a, b, c float64) Rotation {
+	i, j, k, _ := seq.axes()
+	r1 := elemental(i, a)
+	r2 := elemental(j, b)
+	r3 := elemental(k, c)
+	return Rotation(quat.Mul(r3, quat.Mul(r2, r1)))
+}
+
+// elemental returns the unit quaternion for a rotation
+// by alpha about the axis with index axis.
+func elemental(axis int, alpha float64) quat.Number {
+	var q quat.Number
+	sin, cos := math.Sincos(alpha / 2)
+	q.Real = cos
+	switch axis {
+	case 0:
+		q.Imag = sin
+	case 1:
+		q.Jmag = sin
+	case 2:
+		q.Kmag = sin
+	}
+	return q
+}
+
+// Euler returns the Euler angles describing the receiver using the
+// rotation sequence seq. The second angle, b, is in [-π/2, π/2] and
+// the first and third angles, a and c, are in [-π, π]. When the rotation
+// is in gimbal lock, a is returned as zero.
+func (r Rotation) Euler(seq EulerSequence) (a, b, c float64) {
+	i, j, k, cyclic := seq.axes()
+	m := r.Mat()
+	s := 1.0
+	if !cyclic {
+		s = -1
+	}
+	sinb := -s * m.At(k, i)
+	b = math.Asin(math.Max(-1, math.Min(1, sinb)))
+	const tol = 1e-12
+	if 1-math.Abs(sinb) < tol {
+		// Gimbal lock; the first and third rotations
+		// are about the same axis. Attribute all of
+		// the rotation to the third angle.
+		return 0, b, math.Atan2(-s*m.At(i, j), m.At(j, j))
+	}
+	a = math.Atan2(s*m.At(k, j), m.At(k, k))
+	c = math.Atan2(s*m.At(j, i), m.At(i, i))
+	return a, b, c
+}
+
+// Inverse returns the inverse of the rotation r.
+func (r Rotation) Inverse() Rotation {
+	return Rotation(quat.Conj(quat.Number(r)))
+}
+
+// Compose returns the rotation obtained by applying r
+// followed by s.
+func Compose(r, s Rotation) Rotation {
+	return Rotation(quat.Mul(quat.Number(s), quat.Number(r)))
+}
+
+// Slerp returns the spherical linear interpolation between r0 and r1
+// for t in [0,1]; 0 corresponds to r0 and 1 corresponds to r1. The
+// interpolation follows the shortest path between the rotations.
+func Slerp(r0, r1 Rotation, t float64) Rotation {
+	return Rotation(quat.Slerp(quat.Number(r0), quat.Number(r1), t))
+}
+
+// qdot returns the four-dimensional dot product of p and q.
+func qdot(p, q quat.Number) float64 {
+	return p.Real*q.Real + p.Imag*q.Imag + p.Jmag*q.Jmag + p.Kmag*q.Kmag
+}
+
+// MeanRotation returns the weighted average of the rotations in rots
+// computed as the rotation maximizing the weighted sum of squared
+// quaternion inner products. If weights is nil, all rotations are
+// weighted equally. MeanRotation will panic if rots is empty or if
+// weights is not nil and has a different length to rots.
+//
+// See F. L. Markley, Y. Cheng, J. L. Crassidis and Y. Oshman,
+// "Averaging Quaternions", Journal of Guidance, Control, and Dynamics
+// 30(4):1193–1197, 2007.
+func MeanRotation(rots []Rotation, weights []float64) Rotation {
+	if len(rots) == 0 {
+		panic("r3: no rotations to average")
+	}
+	if weights != nil && len(weights) != len(rots) {
+		panic(mat.ErrShape)
+	}
+	m := mat.NewSymDense(4, nil)
+	for n, r := range rots {
+		w := 1.0
+		if weights != nil {
+			w = weights[n]
+		}
+		q := [4]float64{r.Real, r.Imag, r.Jmag, r.Kmag}
+		for i := 0; i < 4; i++ {
+			for j := i; j < 4; j++ {
+				m.SetSym(i, j, m.At(i, j)+w*q[i]*q[j])
+			}
+		}
+	}
+	var eig mat.EigenSym
+	if !eig.Factorize(m, true) {
+		panic("r3: eigendecomposition failed")
+	}
+	var v mat.Dense
+	eig.VectorsTo(&v)
+	// Eigenvalues are in ascending order.
+	q := quat.Number{Real: v.At(0, 3), Imag: v.At(1, 3), Jmag: v.At(2, 3), Kmag: v.At(3, 3)}
+	if q.Real < 0 {
+		q = quat.Scale(-1, q)
+	}
+	return Rotation(quat.Scale(1/quat.Abs(q), q))
+}
+
+// RotationSpline is a smooth interpolating curve through a sequence
+// of rotations using spherical quadrangle (SQUAD) interpolation.
+type RotationSpline struct {
+	q []quat.Number
+	s []quat.Number
+}
+
+// NewRotationSpline returns a spline interpolating the rotations in rots,
+// which must all be unit quaternions. NewRotationSpline will panic if rots
+// is empty.
+func NewRotationSpline(rots []Rotation) *RotationSpline {
+	if len(rots) == 0 {
+		panic("r3: no rotations to interpolate")
+	}
+	n := len(rots)
+	q := make([]quat.Number, n)
+	for i, r := range rots {
+		q[i] = quat.Number(r)
+		// Ensure consecutive quaternions are in the same
+		// hemisphere so the spline takes the shortest path.
+		if i > 0 && qdot(q[i-1], q[i]) < 0 {
+			q[i] = quat.Scale(-1, q[i])
+		}
+	}
+
+	// Compute the intermediate control points
+	//  s_i = q_i exp(-(log(q_i^-1 q_{i+1}) + log(q_i^-1 q_{i-1}))/4)
+	// with end points duplicated.
+	s := make([]quat.Number, n)
+	for i := range q {
+		prev := q[max(i-1, 0)]
+		next := q[min(i+1, n-1)]
+		inv := quat.Conj(q[i])
+		l := quat.Add(quat.Log(quat.Mul(inv, next)), quat.Log(quat.Mul(inv, prev)))
+		s[i] = quat.Mul(q[i], quat.Exp(quat.Scale(-0.25, l)))
+	}
+	return &RotationSpline{q: q, s: s}
+}
+
+// Len returns the number of rotations interpolated by the spline.
+func (s *RotationSpline) Len() int {
+	return len(s.q)
+}
+
+// At returns the interpolated rotation at t. The ith rotation used to
+// construct the spline is at t=i. Values of t outside [0, Len()-1] are
+// clamped to that range.
+func (s *RotationSpline) At(t float64) Rotation {
+	n := len(s.q)
+	if n == 1 || t <= 0 {
+		return Rotation(s.q[0])
+	}
+	if t >= float64(n-1) {
+		return Rotation(s.q[n-1])
+	}
+	i := int(t)
+	h := t - float64(i)
+	a := slerpLong(s.q[i], s.q[i+1], h)
+	b := slerpLong(s.s[i], s.s[i+1], h)
+	return Rotation(slerpLong(a, b, 2*h*(1-h)))
+}
+
+// slerpLong returns the spherical linear interpolation between p and q
+// without enforcing the shortest path, as required by SQUAD.
+func slerpLong(p, q quat.Number, t float64) quat.Number {
+	d := math.Max(-1, math.Min(1, qdot(p, q)))
+	if math.Abs(d) > 1-1e-12 {
+		r := quat.Add(quat.Scale(1-t, p), quat.Scale(t, q))
+		return quat.Scale(1/quat.Abs(r), r)
+	}
+	theta := math.Acos(d)
+	sin := math.Sin(theta)
+	return quat.Add(
+		quat.Scale(math.Sin((1-t)*theta)/sin, p),
+		quat.Scale(math.Sin(t*theta)/sin, q),
+	)
+}
@@ -0,0 +1,196 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package r3
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+	"gonum.org/v1/gonum/num/quat"
+)
+
+func randRotation(rnd *rand.Rand) Rotation {
+	q := quat.Number{Real: rnd.NormFloat64(), Imag: rnd.NormFloat64(), Jmag: rnd.NormFloat64(), Kmag: rnd.NormFloat64()}
+	return Rotation(quat.Scale(1/quat.Abs(q), q))
+}
+
+// sameRotation returns whether r and s represent the same rotation
+// to within tol.
+func sameRotation(r, s Rotation, tol float64) bool {
+	return 1-math.Abs(qdot(quat.Number(r), quat.Number(s))) < tol
+}
+
+func TestRotationFromMat(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	rots := []Rotation{
+		{Real: 1},
+		{Imag: 1},
+		{Jmag: 1},
+		{Kmag: 1},
+		NewRotation(math.Pi, Vec{1, 1, 0}),
+	}
+	for i := 0; i < 100; i++ {
+		rots = append(rots, randRotation(rnd))
+	}
+	for _, r := range rots {
+		got := NewRotationFromMat(r.Mat())
+		if !sameRotation(got, r, 1e-14) {
+			t.Errorf("unexpected rotation from matrix: got:%v want:%v", got, r)
+		}
+		if got.Real < 0 {
+			t.Errorf("rotation from matrix not in canonical form: %v", got)
+		}
+	}
+}
+
+func TestRotationEuler(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, seq := range []EulerSequence{XYZ, XZY, YXZ, YZX, ZXY, ZYX} {
+		for i := 0; i < 50; i++ {
+			a := math.Pi * (2*rnd.Float64() - 1)
+			b := math.Pi / 2 * (2*rnd.Float64() - 1)
+			c := math.Pi * (2*rnd.Float64() - 1)
+			if i < 2 {
+				// Gimbal lock.
+				b = math.Pi / 2 * float64(1-2*i)
+			}
+			r := NewRotationFromEuler(seq, a, b, c)
+
+			// Check against composition of elemental rotations.
+			axes := [3]Vec{{X: 1}, {Y: 1}, {Z: 1}}
+			ai, aj, ak, _ := seq.axes()
+			p := Vec{1, 2, 3}
+			want := NewRotation(c, axes[ak]).Rotate(NewRotation(b, axes[aj]).Rotate(NewRotation(a, axes[ai]).Rotate(p)))
+			if got := r.Rotate(p); Norm(Sub(got, want)) > 1e-12 {
+				t.Errorf("seq %d: unexpected rotation: got:%v want:%v", seq, got, want)
+			}
+
+			ga, gb, gc := r.Euler(seq)
+			got := NewRotationFromEuler(seq, ga, gb, gc)
+			if !sameRotation(got, r, 1e-12) {
+				t.Errorf("seq %d: Euler angle round trip failed for %v %v %v: got angles %v %v %v", seq, a, b, c, ga, gb, gc)
+			}
+			if i >= 2 && (math.Abs(ga-a) > 1e-9 || math.Abs(gb-b) > 1e-9 || math.Abs(gc-c) > 1e-9) {
+				t.Errorf("seq %d: unexpected Euler angles: got:%v %v %v want:%v %v %v", seq, ga, gb, gc, a, b, c)
+			}
+		}
+	}
+}
+
+func TestSlerp(t *testing.T) {
+	r0 := NewRotation(math.Pi/4, Vec{X: 1})
+	r1 := NewRotation(math.Pi, Vec{X: 1})
+	for i := 0; i <= 10; i++ {
+		tt := float64(i) / 10
+		got := Slerp(r0, r1, tt)
+		want := NewRotation(math.Pi/4+tt*3*math.Pi/4, Vec{X: 1})
+		if !sameRotation(got, want, 1e-14) {
+			t.Errorf("unexpected slerp at %v: got:%v want:%v", tt, got, want)
+		}
+	}
+
+	// Interpolation follows the shortest path for
+	// quaternions in opposite hemispheres.
+	r2 := Rotation(quat.Scale(-1, quat.Number(r1)))
+	got := Slerp(r0, r2, 0.5)
+	want := NewRotation(5*math.Pi/8, Vec{X: 1})
+	if !sameRotation(got, want, 1e-14) {
+		t.Errorf("unexpected slerp for opposite hemispheres: got:%v want:%v", got, want)
+	}
+}
+
+func TestComposeInverse(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		r := randRotation(rnd)
+		s := randRotation(rnd)
+		p := Vec{1, -2, 3}
+		got := Compose(r, s).Rotate(p)
+		want := s.Rotate(r.Rotate(p))
+		if Norm(Sub(got, want)) > 1e-12 {
+			t.Errorf("unexpected composition: got:%v want:%v", got, want)
+		}
+		if got := r.Inverse().Rotate(r.Rotate(p)); Norm(Sub(got, p)) > 1e-12 {
+			t.Errorf("unexpected inverse: got:%v want:%v", got, p)
+		}
+	}
+}
+
+func TestMeanRotation(t *testing.T) {
+	// Symmetric perturbations about a rotation average to it.
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 10; i++ {
+		r := randRotation(rnd)
+		var rots []Rotation
+		for _, axis := range []Vec{{X: 1}, {Y: 1}, {Z: 1}} {
+			for _, alpha := range []float64{-0.2, 0.2} {
+				rots = append(rots, Compose(NewRotation(alpha, axis), r))
+			}
+		}
+		// Sign flips do not affect the average.
+		rots[0] = Rotation(quat.Scale(-1, quat.Number(rots[0])))
+		got := MeanRotation(rots, nil)
+		if !sameRotation(got, r, 1e-14) {
+			t.Errorf("unexpected mean rotation: got:%v want:%v", got, r)
+		}
+	}
+
+	r0 := NewRotation(0, Vec{Z: 1})
+	r1 := NewRotation(1, Vec{Z: 1})
+	got := MeanRotation([]Rotation{r0, r1}, []float64{1, 1})
+	want := NewRotation(0.5, Vec{Z: 1})
+	if !sameRotation(got, want, 1e-14) {
+		t.Errorf("unexpected weighted mean rotation: got:%v want:%v", got, want)
+	}
+	got = MeanRotation([]Rotation{r0, r1}, []float64{0, 1})
+	if !sameRotation(got, r1, 1e-14) {
+		t.Errorf("unexpected weighted mean rotation: got:%v want:%v", got, r1)
+	}
+}
+
+func TestRotationSpline(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	rots := make([]Rotation, 6)
+	for i := range rots {
+		rots[i] = randRotation(rnd)
+	}
+	s := NewRotationSpline(rots)
+	if s.Len() != len(rots) {
+		t.Errorf("unexpected spline length: got:%d want:%d", s.Len(), len(rots))
+	}
+	for i, r := range rots {
+		if got := s.At(float64(i)); !sameRotation(got, r, 1e-12) {
+			t.Errorf("spline does not interpolate knot %d: got:%v want:%v", i, got, r)
+		}
+	}
+	// The spline is continuous and stays on the unit sphere.
+	const h = 1e-6
+	for tt := 0.0; tt < float64(len(rots)-1); tt += 0.05 {
+		a := s.At(tt)
+		b := s.At(tt + h)
+		if n := quat.Abs(quat.Number(a)); math.Abs(n-1) > 1e-12 {
+			t.Errorf("spline not unit at %v: |q|=%v", tt, n)
+		}
+		if !sameRotation(a, b, 1e-9) {
+			t.Errorf("spline discontinuous at %v", tt)
+		}
+	}
+
+	// A spline through rotations about a single axis with
+	// constant angular velocity matches slerp away from
+	// the end segments.
+	rots = rots[:0]
+	for i := 0; i < 4; i++ {
+		rots = append(rots, NewRotation(0.5*float64(i), Vec{1, 1, 1}))
+	}
+	s = NewRotationSpline(rots)
+	for tt := 1.0; tt <= 2; tt += 0.1 {
+		want := NewRotation(0.5*tt, Vec{1, 1, 1})
+		if got := s.At(tt); !sameRotation(got, want, 1e-12) {
+			t.Errorf("unexpected spline value at %v: got:%v want:%v", tt, got, want)
+		}
+	}
+}
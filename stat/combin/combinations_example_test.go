@@ -299,3 +299,51 @@ func ExampleIndexToPermutation() {
 	// 22 [3 1 2] 22
 	// 23 [3 2 1] 23
 }
+
+func ExamplePartitionGenerator() {
+	// PartitionGenerator constructs an iterator for the ways of
+	// writing an integer as a sum of positive integers.
+	gen := combin.NewPartitionGenerator(5)
+	for gen.Next() {
+		fmt.Println(gen.Partition(nil))
+	}
+	// Output:
+	// [5]
+	// [4 1]
+	// [3 2]
+	// [3 1 1]
+	// [2 2 1]
+	// [2 1 1 1]
+	// [1 1 1 1 1]
+}
+
+func ExampleGrayCodeGenerator() {
+	// GrayCodeGenerator iterates over all subsets of a set such that
+	// consecutive subsets differ by a single element. This allows
+	// a quantity over the subset, here the sum of the chosen values,
+	// to be updated incrementally.
+	values := []int{1, 2, 4}
+	gen := combin.NewGrayCodeGenerator(len(values))
+	var sum int
+	for gen.Next() {
+		elem, added := gen.Flipped()
+		switch {
+		case elem < 0:
+			// The empty set.
+		case added:
+			sum += values[elem]
+		default:
+			sum -= values[elem]
+		}
+		fmt.Println(gen.Subset(nil), sum)
+	}
+	// Output:
+	// [false false false] 0
+	// [true false false] 1
+	// [true true false] 3
+	// [false true false] 2
+	// [false true true] 6
+	// [true true true] 7
+	// [true false true] 5
+	// [false false true] 4
+}
@@ -0,0 +1,555 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package combin
+
+import (
+	"math"
+	"math/bits"
+	"sort"
+
+	"golang.org/x/exp/rand"
+)
+
+// NumMultisetPermutations returns the number of distinct permutations of the
+// multiset in which element i occurs counts[i] times, the multinomial
+// coefficient
+//
+//	(Σ counts[i])! / Π counts[i]!
+//
+// All counts must be non-negative, otherwise NumMultisetPermutations will panic.
+// No check is made for overflow.
+func NumMultisetPermutations(counts []int) int {
+	num := 1
+	var n int
+	for _, c := range counts {
+		if c < 0 {
+			panic(errNegInput)
+		}
+		n += c
+		num *= Binomial(n, c)
+	}
+	return num
+}
+
+// MultisetPermutations generates all of the distinct permutations of the
+// multiset in which element i occurs counts[i] times. The permutations are
+// returned in lexicographic order. The returned slice has length
+// NumMultisetPermutations(counts) and each inner slice has length Σ counts[i].
+//
+// All counts must be non-negative, otherwise MultisetPermutations will panic.
+//
+// MultisetPermutationGenerator may alternatively be used to generate the
+// permutations iteratively instead of collectively.
+func MultisetPermutations(counts []int) [][]int {
+	gen := NewMultisetPermutationGenerator(counts)
+	data := make([][]int, 0, NumMultisetPermutations(counts))
+	for gen.Next() {
+		data = append(data, gen.Permutation(nil))
+	}
+	return data
+}
+
+// MultisetPermutationGenerator generates the distinct permutations of a
+// multiset iteratively in lexicographic order. The MultisetPermutations
+// function may be called to generate all permutations collectively.
+type MultisetPermutationGenerator struct {
+	permutation []int
+	started     bool
+	done        bool
+}
+
+// NewMultisetPermutationGenerator returns a MultisetPermutationGenerator for
+// generating the distinct permutations of the multiset in which element i
+// occurs counts[i] times.
+//
+// All counts must be non-negative, otherwise NewMultisetPermutationGenerator
+// will panic.
+func NewMultisetPermutationGenerator(counts []int) *MultisetPermutationGenerator {
+	var n int
+	for _, c := range counts {
+		if c < 0 {
+			panic(errNegInput)
+		}
+		n += c
+	}
+	perm := make([]int, 0, n)
+	for i, c := range counts {
+		for j := 0; j < c; j++ {
+			perm = append(perm, i)
+		}
+	}
+	return &MultisetPermutationGenerator{permutation: perm}
+}
+
+// Next advances the iterator if there are permutations remaining to be generated,
+// and returns false if all permutations have been generated. Next must be called
+// to initialize the first value before calling Permutation or Permutation will
+// panic. The value returned by Permutation is only changed during calls to Next.
+func (g *MultisetPermutationGenerator) Next() bool {
+	if g.done {
+		return false
+	}
+	if !g.started {
+		g.started = true
+		return true
+	}
+	if !nextMultisetPermutation(g.permutation) {
+		g.done = true
+		return false
+	}
+	return true
+}
+
+// Permutation returns the current permutation. If dst is non-nil, it must have
+// the length of the multiset and the result will be stored in-place into dst.
+// If dst is nil a new slice will be allocated and returned. If all of the
+// permutations have already been constructed (Next() returns false),
+// Permutation will panic.
+//
+// Next must be called to initialize the first value before calling Permutation
+// or Permutation will panic. The value returned by Permutation is only changed
+// during calls to Next.
+func (g *MultisetPermutationGenerator) Permutation(dst []int) []int {
+	if g.done {
+		panic("combin: all permutations have been generated")
+	}
+	if !g.started {
+		panic("combin: Permutation called before Next")
+	}
+	if dst == nil {
+		dst = make([]int, len(g.permutation))
+	} else if len(dst) != len(g.permutation) {
+		panic(badInput)
+	}
+	copy(dst, g.permutation)
+	return dst
+}
+
+// nextMultisetPermutation permutes s into the lexicographically next
+// permutation and returns whether s was not the last permutation.
+func nextMultisetPermutation(s []int) bool {
+	i := len(s) - 2
+	for i >= 0 && s[i] >= s[i+1] {
+		i--
+	}
+	if i < 0 {
+		return false
+	}
+	j := len(s) - 1
+	for s[j] <= s[i] {
+		j--
+	}
+	s[i], s[j] = s[j], s[i]
+	for l, r := i+1, len(s)-1; l < r; l, r = l+1, r-1 {
+		s[l], s[r] = s[r], s[l]
+	}
+	return true
+}
+
+// NumCompositions returns the number of compositions of n into k positive
+// parts, the number of ordered sequences of k positive integers summing to n.
+//
+// n and k must be non-negative, otherwise NumCompositions will panic.
+func NumCompositions(n, k int) int {
+	if n < 0 || k < 0 {
+		panic(errNegInput)
+	}
+	if k == 0 {
+		if n == 0 {
+			return 1
+		}
+		return 0
+	}
+	if n < k {
+		return 0
+	}
+	return Binomial(n-1, k-1)
+}
+
+// CompositionGenerator generates the compositions of an integer iteratively
+// in lexicographic order.
+type CompositionGenerator struct {
+	n, k int
+
+	cuts *CombinationGenerator
+	cut  []int
+
+	remaining int
+	started   bool
+}
+
+// NewCompositionGenerator returns a CompositionGenerator for generating the
+// compositions of n into k positive parts.
+//
+// The weak compositions of n into k non-negative parts can be obtained by
+// generating the compositions of n+k into k parts and subtracting one from
+// each part.
+//
+// n and k must be non-negative, otherwise NewCompositionGenerator will panic.
+func NewCompositionGenerator(n, k int) *CompositionGenerator {
+	g := &CompositionGenerator{
+		n:         n,
+		k:         k,
+		remaining: NumCompositions(n, k),
+	}
+	if k > 0 && n >= k {
+		g.cuts = NewCombinationGenerator(n-1, k-1)
+		g.cut = make([]int, k-1)
+	}
+	return g
+}
+
+// Next advances the iterator if there are compositions remaining to be generated,
+// and returns false if all compositions have been generated. Next must be called
+// to initialize the first value before calling Composition or Composition will
+// panic. The value returned by Composition is only changed during calls to Next.
+func (g *CompositionGenerator) Next() bool {
+	if g.remaining <= 0 {
+		g.remaining = -1
+		return false
+	}
+	g.started = true
+	if g.cuts != nil {
+		g.cuts.Next()
+		g.cuts.Combination(g.cut)
+	}
+	g.remaining--
+	return true
+}
+
+// Composition returns the current composition. If dst is non-nil, it must have
+// length k and the result will be stored in-place into dst. If dst is nil a new
+// slice will be allocated and returned. If all of the compositions have already
+// been constructed (Next() returns false), Composition will panic.
+//
+// Next must be called to initialize the first value before calling Composition
+// or Composition will panic. The value returned by Composition is only changed
+// during calls to Next.
+func (g *CompositionGenerator) Composition(dst []int) []int {
+	if g.remaining == -1 {
+		panic("combin: all compositions have been generated")
+	}
+	if !g.started {
+		panic("combin: Composition called before Next")
+	}
+	if dst == nil {
+		dst = make([]int, g.k)
+	} else if len(dst) != g.k {
+		panic(badInput)
+	}
+	if g.k == 0 {
+		return dst
+	}
+	// The cuts are the positions of the k-1 part
+	// boundaries among the n-1 gaps between units.
+	prev := 0
+	for i, c := range g.cut {
+		dst[i] = c + 1 - prev
+		prev = c + 1
+	}
+	dst[g.k-1] = g.n - prev
+	return dst
+}
+
+// NumPartitions returns the number of partitions of n, the number of ways
+// of writing n as an unordered sum of positive integers.
+//
+// n must be non-negative, otherwise NumPartitions will panic.
+// No check is made for overflow.
+func NumPartitions(n int) int {
+	if n < 0 {
+		panic(errNegInput)
+	}
+	p := make([]int, n+1)
+	p[0] = 1
+	for part := 1; part <= n; part++ {
+		for i := part; i <= n; i++ {
+			p[i] += p[i-part]
+		}
+	}
+	return p[n]
+}
+
+// PartitionGenerator generates the partitions of an integer iteratively.
+// Each partition is represented by its parts in non-increasing order and the
+// partitions are generated in reverse lexicographic order, starting with the
+// single part n and ending with n parts of one.
+type PartitionGenerator struct {
+	n         int
+	partition []int
+	started   bool
+	done      bool
+}
+
+// NewPartitionGenerator returns a PartitionGenerator for generating the
+// partitions of n.
+//
+// n must be non-negative, otherwise NewPartitionGenerator will panic.
+func NewPartitionGenerator(n int) *PartitionGenerator {
+	if n < 0 {
+		panic(errNegInput)
+	}
+	return &PartitionGenerator{n: n, partition: make([]int, 0, n)}
+}
+
+// Next advances the iterator if there are partitions remaining to be generated,
+// and returns false if all partitions have been generated. Next must be called
+// to initialize the first value before calling Partition or Partition will
+// panic. The value returned by Partition is only changed during calls to Next.
+func (g *PartitionGenerator) Next() bool {
+	if g.done {
+		return false
+	}
+	if !g.started {
+		g.started = true
+		if g.n > 0 {
+			g.partition = append(g.partition, g.n)
+		}
+		return true
+	}
+
+	// Find the last part greater than one.
+	p := g.partition
+	i := len(p) - 1
+	for i >= 0 && p[i] == 1 {
+		i--
+	}
+	if i < 0 {
+		g.done = true
+		return false
+	}
+
+	// Decrement it and redistribute the removed
+	// unit and any trailing ones into parts no
+	// larger than the decremented part.
+	rem := len(p) - i
+	p[i]--
+	v := p[i]
+	p = p[:i+1]
+	for rem > v {
+		p = append(p, v)
+		rem -= v
+	}
+	if rem > 0 {
+		p = append(p, rem)
+	}
+	g.partition = p
+	return true
+}
+
+// Partition returns the current partition with its parts in non-increasing
+// order. If dst has sufficient capacity, the result will be stored in-place
+// into dst[:len(partition)], otherwise a new slice will be allocated and
+// returned. If all of the partitions have already been constructed (Next()
+// returns false), Partition will panic.
+//
+// Next must be called to initialize the first value before calling Partition
+// or Partition will panic. The value returned by Partition is only changed
+// during calls to Next.
+func (g *PartitionGenerator) Partition(dst []int) []int {
+	if g.done {
+		panic("combin: all partitions have been generated")
+	}
+	if !g.started {
+		panic("combin: Partition called before Next")
+	}
+	if cap(dst) < len(g.partition) {
+		dst = make([]int, len(g.partition))
+	}
+	dst = dst[:len(g.partition)]
+	copy(dst, g.partition)
+	return dst
+}
+
+// GrayCodeGenerator iterates over all subsets of a set of size n in binary
+// reflected Gray code order, so that consecutive subsets differ by the
+// addition or removal of exactly one element. This allows quantities that
+// depend on a subset to be updated incrementally during an exhaustive search.
+type GrayCodeGenerator struct {
+	n       int
+	idx     uint64
+	end     uint64
+	subset  []bool
+	flipped int
+	started bool
+}
+
+// NewGrayCodeGenerator returns a GrayCodeGenerator for iterating over the
+// 2^n subsets of a set of size n, starting with the empty set.
+//
+// n must be non-negative and less than 64, otherwise NewGrayCodeGenerator
+// will panic.
+func NewGrayCodeGenerator(n int) *GrayCodeGenerator {
+	if n < 0 {
+		panic(errNegInput)
+	}
+	if n >= 64 {
+		panic("combin: set size too large")
+	}
+	return &GrayCodeGenerator{
+		n:       n,
+		end:     1 << uint(n),
+		subset:  make([]bool, n),
+		flipped: -1,
+	}
+}
+
+// Next advances the iterator if there are subsets remaining to be generated,
+// and returns false if all subsets have been generated. Next must be called
+// to initialize the first value before calling Subset or Subset will panic.
+// The value returned by Subset is only changed during calls to Next.
+func (g *GrayCodeGenerator) Next() bool {
+	if !g.started {
+		g.started = true
+		return true
+	}
+	if g.idx+1 >= g.end {
+		g.idx = g.end
+		return false
+	}
+	g.idx++
+	g.flipped = bits.TrailingZeros64(g.idx)
+	g.subset[g.flipped] = !g.subset[g.flipped]
+	return true
+}
+
+// Subset returns the current subset, with element i a member if the ith
+// element of the returned slice is true. If dst is non-nil, it must have
+// length n and the result will be stored in-place into dst. If dst is nil a
+// new slice will be allocated and returned. If all of the subsets have already
+// been constructed (Next() returns false), Subset will panic.
+func (g *GrayCodeGenerator) Subset(dst []bool) []bool {
+	g.checkCurrent()
+	if dst == nil {
+		dst = make([]bool, g.n)
+	} else if len(dst) != g.n {
+		panic(badInput)
+	}
+	copy(dst, g.subset)
+	return dst
+}
+
+// Flipped returns the element that was added to or removed from the previous
+// subset by the last call to Next, and whether it was added. For the first,
+// empty, subset Flipped returns -1 and false. If all of the subsets have
+// already been constructed (Next() returns false), Flipped will panic.
+func (g *GrayCodeGenerator) Flipped() (elem int, added bool) {
+	g.checkCurrent()
+	if g.flipped < 0 {
+		return -1, false
+	}
+	return g.flipped, g.subset[g.flipped]
+}
+
+func (g *GrayCodeGenerator) checkCurrent() {
+	if !g.started {
+		panic("combin: Subset called before Next")
+	}
+	if g.idx == g.end {
+		panic("combin: all subsets have been generated")
+	}
+}
+
+// RandomCombination returns a combination of k elements chosen uniformly at
+// random from a set of size n, in increasing order, without enumerating the
+// combinations. If dst is non-nil, it must have length k and the result will
+// be stored in-place into dst. If dst is nil a new slice will be allocated and
+// returned. RandomCombination takes O(k log k) time and O(k) space.
+//
+// If src is nil, the global random source is used.
+//
+// n and k must be non-negative with n >= k, otherwise RandomCombination will
+// panic.
+func RandomCombination(dst []int, n, k int, src rand.Source) []int {
+	if n < 0 || k < 0 {
+		panic(errNegInput)
+	}
+	if n < k {
+		panic(badSetSize)
+	}
+	if dst == nil {
+		dst = make([]int, k)
+	} else if len(dst) != k {
+		panic(badInput)
+	}
+	intn := rand.Intn
+	if src != nil {
+		intn = rand.New(src).Intn
+	}
+
+	// Use Floyd's algorithm to sample k distinct elements.
+	chosen := make(map[int]struct{}, k)
+	var i int
+	for j := n - k; j < n; j++ {
+		t := intn(j + 1)
+		if _, ok := chosen[t]; ok {
+			t = j
+		}
+		chosen[t] = struct{}{}
+		dst[i] = t
+		i++
+	}
+	sort.Ints(dst)
+	return dst
+}
+
+// WeightedCombination returns a combination of k elements chosen at random
+// without replacement from a set of size len(weights), with the probability of
+// choosing each element at each successive draw proportional to its weight
+// among the elements not yet chosen. The combination is returned in increasing
+// order. If dst is non-nil, it must have length k and the result will be stored
+// in-place into dst. If dst is nil a new slice will be allocated and returned.
+//
+// If src is nil, the global random source is used.
+//
+// All weights must be non-negative and at least k weights must be positive,
+// otherwise WeightedCombination will panic.
+//
+// See https://doi.org/10.1016/j.ipl.2005.11.003 for details of the algorithm.
+func WeightedCombination(dst []int, weights []float64, k int, src rand.Source) []int {
+	if k < 0 {
+		panic(errNegInput)
+	}
+	if dst == nil {
+		dst = make([]int, k)
+	} else if len(dst) != k {
+		panic(badInput)
+	}
+	rnd := rand.Float64
+	if src != nil {
+		rnd = rand.New(src).Float64
+	}
+
+	// Assign each element the key u^(1/w), stored as
+	// log(u)/w, and choose the k elements with the
+	// largest keys.
+	type keyed struct {
+		idx int
+		key float64
+	}
+	keys := make([]keyed, 0, len(weights))
+	for i, w := range weights {
+		if w < 0 || math.IsNaN(w) {
+			panic("combin: invalid weight")
+		}
+		if w == 0 {
+			continue
+		}
+		var u float64
+		for u == 0 {
+			u = rnd()
+		}
+		keys = append(keys, keyed{idx: i, key: math.Log(u) / w})
+	}
+	if len(keys) < k {
+		panic("combin: too few positive weights")
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].key > keys[j].key })
+	for i := range dst {
+		dst[i] = keys[i].idx
+	}
+	sort.Ints(dst)
+	return dst
+}
@@ -0,0 +1,308 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package combin
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+// lexLess returns whether a is lexicographically before b.
+func lexLess(a, b []int) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return len(a) < len(b)
+}
+
+func TestMultisetPermutations(t *testing.T) {
+	t.Parallel()
+	for _, counts := range [][]int{
+		{},
+		{0},
+		{3},
+		{1, 1, 1},
+		{2, 1},
+		{2, 0, 2},
+		{1, 2, 3},
+		{3, 1, 2, 1},
+	} {
+		want := NumMultisetPermutations(counts)
+		perms := MultisetPermutations(counts)
+		if len(perms) != want {
+			t.Errorf("unexpected number of permutations for %v: got:%d want:%d", counts, len(perms), want)
+		}
+		seen := make(map[string]bool)
+		for i, p := range perms {
+			got := make([]int, len(counts))
+			for _, v := range p {
+				got[v]++
+			}
+			if !reflect.DeepEqual(got, counts) && len(counts) != 0 {
+				t.Errorf("permutation %v is not of the multiset %v", p, counts)
+			}
+			key := intSliceToKey(p)
+			if seen[key] {
+				t.Errorf("duplicate permutation %v of %v", p, counts)
+			}
+			seen[key] = true
+			if i > 0 && !lexLess(perms[i-1], p) {
+				t.Errorf("permutations of %v not in lexicographic order: %v then %v", counts, perms[i-1], p)
+			}
+		}
+	}
+
+	// Distinct elements give the same permutations as PermutationGenerator.
+	got := MultisetPermutations([]int{1, 1, 1, 1})
+	want := Permutations(4, 4)
+	sort.Slice(want, func(i, j int) bool { return lexLess(want[i], want[j]) })
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected permutations of a set:\ngot: %v\nwant:%v", got, want)
+	}
+
+	gen := NewMultisetPermutationGenerator([]int{1, 1})
+	if !panics(func() { gen.Permutation(nil) }) {
+		t.Errorf("expected panic before Next")
+	}
+	for gen.Next() {
+	}
+	if !panics(func() { gen.Permutation(nil) }) {
+		t.Errorf("expected panic after exhaustion")
+	}
+	if !panics(func() { NewMultisetPermutationGenerator([]int{1, -1}) }) {
+		t.Errorf("expected panic for negative count")
+	}
+}
+
+func TestCompositions(t *testing.T) {
+	t.Parallel()
+	for n := 0; n <= 8; n++ {
+		for k := 0; k <= n+1; k++ {
+			want := NumCompositions(n, k)
+			gen := NewCompositionGenerator(n, k)
+			var prev []int
+			var count int
+			for gen.Next() {
+				c := gen.Composition(nil)
+				if len(c) != k {
+					t.Fatalf("unexpected composition length for n=%d k=%d: %v", n, k, c)
+				}
+				var sum int
+				for _, v := range c {
+					if v < 1 {
+						t.Errorf("non-positive part in composition of n=%d k=%d: %v", n, k, c)
+					}
+					sum += v
+				}
+				if sum != n {
+					t.Errorf("composition of n=%d k=%d does not sum to n: %v", n, k, c)
+				}
+				if prev != nil && !lexLess(prev, c) {
+					t.Errorf("compositions of n=%d k=%d not in lexicographic order: %v then %v", n, k, prev, c)
+				}
+				prev = c
+				count++
+			}
+			if count != want {
+				t.Errorf("unexpected number of compositions for n=%d k=%d: got:%d want:%d", n, k, count, want)
+			}
+		}
+	}
+
+	gen := NewCompositionGenerator(4, 2)
+	var got [][]int
+	for gen.Next() {
+		got = append(got, gen.Composition(nil))
+	}
+	want := [][]int{{1, 3}, {2, 2}, {3, 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected compositions of 4 into 2 parts: got:%v want:%v", got, want)
+	}
+	if !panics(func() { gen.Composition(nil) }) {
+		t.Errorf("expected panic after exhaustion")
+	}
+}
+
+func TestPartitions(t *testing.T) {
+	t.Parallel()
+	// Number of partitions from OEIS A000041.
+	wantNum := []int{1, 1, 2, 3, 5, 7, 11, 15, 22, 30, 42, 56, 77, 101, 135, 176, 231}
+	for n, want := range wantNum {
+		if got := NumPartitions(n); got != want {
+			t.Errorf("unexpected NumPartitions(%d): got:%d want:%d", n, got, want)
+		}
+		gen := NewPartitionGenerator(n)
+		var prev []int
+		var count int
+		dst := make([]int, n)
+		for gen.Next() {
+			p := gen.Partition(dst)
+			var sum int
+			for i, v := range p {
+				if v < 1 || (i > 0 && v > p[i-1]) {
+					t.Errorf("invalid partition of %d: %v", n, p)
+				}
+				sum += v
+			}
+			if sum != n {
+				t.Errorf("partition of %d does not sum to n: %v", n, p)
+			}
+			if prev != nil && !lexLess(p, prev) {
+				t.Errorf("partitions of %d not in reverse lexicographic order: %v then %v", n, prev, p)
+			}
+			prev = append(prev[:0], p...)
+			count++
+		}
+		if count != want {
+			t.Errorf("unexpected number of partitions of %d: got:%d want:%d", n, count, want)
+		}
+	}
+
+	gen := NewPartitionGenerator(4)
+	var got [][]int
+	for gen.Next() {
+		got = append(got, gen.Partition(nil))
+	}
+	want := [][]int{{4}, {3, 1}, {2, 2}, {2, 1, 1}, {1, 1, 1, 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected partitions of 4: got:%v want:%v", got, want)
+	}
+}
+
+func TestGrayCodeGenerator(t *testing.T) {
+	t.Parallel()
+	for n := 0; n <= 10; n++ {
+		gen := NewGrayCodeGenerator(n)
+		seen := make(map[uint64]bool)
+		var prev []bool
+		for gen.Next() {
+			s := gen.Subset(nil)
+			var key uint64
+			for i, in := range s {
+				if in {
+					key |= 1 << uint(i)
+				}
+			}
+			if seen[key] {
+				t.Errorf("duplicate subset for n=%d: %v", n, s)
+			}
+			seen[key] = true
+
+			elem, added := gen.Flipped()
+			if prev == nil {
+				if key != 0 || elem != -1 {
+					t.Errorf("unexpected first subset for n=%d: %v flipped %d", n, s, elem)
+				}
+			} else {
+				var diff int
+				for i := range s {
+					if s[i] != prev[i] {
+						diff++
+						if i != elem || s[i] != added {
+							t.Errorf("unexpected flipped element for n=%d: got:(%d, %t) changed:%d", n, elem, added, i)
+						}
+					}
+				}
+				if diff != 1 {
+					t.Errorf("consecutive subsets for n=%d differ by %d elements", n, diff)
+				}
+			}
+			prev = s
+		}
+		if len(seen) != 1<<uint(n) {
+			t.Errorf("unexpected number of subsets for n=%d: got:%d want:%d", n, len(seen), 1<<uint(n))
+		}
+		if !panics(func() { gen.Subset(nil) }) {
+			t.Errorf("expected panic after exhaustion")
+		}
+	}
+}
+
+func TestRandomCombination(t *testing.T) {
+	t.Parallel()
+	const (
+		n       = 6
+		k       = 3
+		samples = 100000
+	)
+	src := rand.NewSource(1)
+	counts := make(map[int]int)
+	dst := make([]int, k)
+	for i := 0; i < samples; i++ {
+		c := RandomCombination(dst, n, k, src)
+		for j := 1; j < k; j++ {
+			if c[j] <= c[j-1] {
+				t.Fatalf("combination not strictly increasing: %v", c)
+			}
+		}
+		if c[0] < 0 || c[k-1] >= n {
+			t.Fatalf("combination out of range: %v", c)
+		}
+		counts[CombinationIndex(c, n, k)]++
+	}
+	want := float64(samples) / float64(Binomial(n, k))
+	for idx := 0; idx < Binomial(n, k); idx++ {
+		if math.Abs(float64(counts[idx])-want) > 5*math.Sqrt(want) {
+			t.Errorf("non-uniform frequency of combination %d: got:%d want:%v", idx, counts[idx], want)
+		}
+	}
+
+	if got := RandomCombination(nil, 5, 5, src); !reflect.DeepEqual(got, []int{0, 1, 2, 3, 4}) {
+		t.Errorf("unexpected full combination: %v", got)
+	}
+	if !panics(func() { RandomCombination(nil, 2, 3, src) }) {
+		t.Errorf("expected panic for n < k")
+	}
+}
+
+func TestWeightedCombination(t *testing.T) {
+	t.Parallel()
+	const samples = 100000
+	src := rand.NewSource(1)
+
+	// With k=1 the selection probability is
+	// proportional to the weights.
+	weights := []float64{1, 2, 0, 3, 4}
+	counts := make([]int, len(weights))
+	dst := make([]int, 1)
+	for i := 0; i < samples; i++ {
+		counts[WeightedCombination(dst, weights, 1, src)[0]]++
+	}
+	for i, w := range weights {
+		want := samples * w / 10
+		if math.Abs(float64(counts[i])-want) > 5*math.Sqrt(want)+1 {
+			t.Errorf("unexpected frequency of element %d: got:%d want:%v", i, counts[i], want)
+		}
+	}
+
+	// Zero weight elements are never chosen.
+	for i := 0; i < 100; i++ {
+		c := WeightedCombination(nil, weights, 4, src)
+		if !reflect.DeepEqual(c, []int{0, 1, 3, 4}) {
+			t.Fatalf("unexpected combination: %v", c)
+		}
+	}
+
+	if !panics(func() { WeightedCombination(nil, weights, 5, src) }) {
+		t.Errorf("expected panic for too few positive weights")
+	}
+	if !panics(func() { WeightedCombination(nil, []float64{1, -1}, 1, src) }) {
+		t.Errorf("expected panic for negative weight")
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return
+}
@@ -0,0 +1,308 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+	"slices"
+	"sort"
+)
+
+// GoodmanKruskalGamma returns the weighted Goodman–Kruskal γ rank correlation
+// between the samples of x and y.
+//
+//	γ = (C - D) / (C + D)
+//
+// where C and D are the numbers of concordant and discordant pairs. Pairs that
+// are tied in either x or y are not counted. If weights are specified then each
+// pair is weighted by weights[i] * weights[j]. If there are no untied pairs,
+// GoodmanKruskalGamma returns NaN.
+//
+// The lengths of x and y must be equal. If weights is nil then all of the
+// weights are 1. If weights is not nil, then len(x) must equal len(weights).
+//
+// GoodmanKruskalGamma takes O(n log n) time.
+func GoodmanKruskalGamma(x, y, weights []float64) float64 {
+	if len(x) != len(y) {
+		panic("stat: slice length mismatch")
+	}
+	if weights != nil && len(weights) != len(x) {
+		panic("stat: slice length mismatch")
+	}
+	c, d, _ := concordantPairs(x, y, nil, weights)
+	return (c - d) / (c + d)
+}
+
+// ConcordanceIndex returns Harrell's concordance index, the C-index, between
+// the predicted scores and the observed values.
+//
+//	C = (C_c + T / 2) / (C_c + D + T)
+//
+// where C_c, D and T are the numbers of comparable pairs for which the order of
+// the predicted scores is concordant with, discordant with or tied relative to
+// the order of the observed values. A pair (i, j) with observed[i] < observed[j]
+// is comparable if observed[i] is an event; pairs with tied observed values are
+// not comparable. A C-index of 1 indicates perfect ranking, 0.5 indicates random
+// ranking and 0 indicates perfect anti-ranking. If there are no comparable
+// pairs, ConcordanceIndex returns NaN.
+//
+// The predicted scores are expected to increase with the observed values. When
+// used for survival analysis with observed survival times and predicted risks,
+// the C-index is one minus the returned value.
+//
+// If events is nil, all observations are events, otherwise events[i] indicates
+// whether observed[i] is an event and false indicates the observation is right
+// censored. If weights are specified then each pair is weighted by
+// weights[i] * weights[j]. The lengths of predicted and observed must be equal
+// and if events or weights are not nil their lengths must equal len(observed).
+//
+// ConcordanceIndex takes O(n log n) time.
+func ConcordanceIndex(predicted, observed []float64, events []bool, weights []float64) float64 {
+	if len(predicted) != len(observed) {
+		panic("stat: slice length mismatch")
+	}
+	if events != nil && len(events) != len(observed) {
+		panic("stat: slice length mismatch")
+	}
+	if weights != nil && len(weights) != len(observed) {
+		panic("stat: slice length mismatch")
+	}
+	c, d, t := concordantPairs(observed, predicted, events, weights)
+	return (c + t/2) / (c + d + t)
+}
+
+// concordantPairs returns the weighted sums of concordant, discordant and
+// y-tied pairs over all pairs (i, j) with x[i] < x[j] and, if events is not
+// nil, events[i] true. Pairs tied in x are not counted.
+func concordantPairs(x, y []float64, events []bool, weights []float64) (c, d, t float64) {
+	n := len(x)
+	if n < 2 {
+		return 0, 0, 0
+	}
+	weight := func(i int) float64 {
+		if weights == nil {
+			return 1
+		}
+		return weights[i]
+	}
+
+	// Rank the values of y, with ties sharing a rank.
+	ys := make([]float64, n)
+	copy(ys, y)
+	sort.Float64s(ys)
+	ys = slices.Compact(ys)
+	ranks := make([]int, n)
+	for i, v := range y {
+		ranks[i] = sort.SearchFloat64s(ys, v)
+	}
+
+	// Process the elements in order of decreasing x,
+	// accumulating the weights of all elements with
+	// strictly larger x into a Fenwick tree indexed
+	// by the rank of y.
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return x[order[i]] > x[order[j]] })
+
+	tree := make(fenwick, len(ys))
+	var total float64
+	for first := 0; first < n; {
+		last := first + 1
+		for last < n && x[order[last]] == x[order[first]] {
+			last++
+		}
+		for _, i := range order[first:last] {
+			if events != nil && !events[i] {
+				continue
+			}
+			r := ranks[i]
+			below := tree.sum(r)
+			tied := tree.sum(r+1) - below
+			w := weight(i)
+			c += w * (total - below - tied)
+			d += w * below
+			t += w * tied
+		}
+		for _, i := range order[first:last] {
+			w := weight(i)
+			tree.add(ranks[i], w)
+			total += w
+		}
+		first = last
+	}
+	return c, d, t
+}
+
+// fenwick is a binary indexed tree of partial sums.
+type fenwick []float64
+
+// add adds v to element i.
+func (f fenwick) add(i int, v float64) {
+	for i++; i <= len(f); i += i & -i {
+		f[i-1] += v
+	}
+}
+
+// sum returns the sum of the elements before i.
+func (f fenwick) sum(i int) float64 {
+	var s float64
+	for ; i > 0; i -= i & -i {
+		s += f[i-1]
+	}
+	return s
+}
+
+// WeightedKendall returns Vigna's weighted Kendall τ rank correlation between
+// the samples of x and y. Weighted τ is a generalization of Kendall's τ_b in
+// which exchanges between elements of high importance have a greater effect on
+// the correlation than exchanges between elements of low importance.
+//
+// The importance of the element of rank r, with rank zero being the most
+// important, is given by weigher(r). The rank of the elements is determined by
+// decreasing lexicographical order of the (x, y) pairs and separately of the
+// (y, x) pairs, and the returned value is the mean of the two correlations
+// computed with these ranks. If weigher is nil the hyperbolic weigher
+// 1/(r+1) is used.
+//
+// If additive is true, the weight of a pair of elements is the sum of their
+// importances, otherwise it is the product.
+//
+// The lengths of x and y must be equal. WeightedKendall returns NaN if either
+// x or y is constant.
+//
+// WeightedKendall takes O(n log n) time.
+//
+// See https://doi.org/10.1145/2736277.2741088 for details.
+func WeightedKendall(x, y []float64, weigher func(rank int) float64, additive bool) float64 {
+	if len(x) != len(y) {
+		panic("stat: slice length mismatch")
+	}
+	if weigher == nil {
+		weigher = func(r int) float64 { return 1 / float64(r+1) }
+	}
+	return (weightedRankedTau(x, y, weigher, additive) + weightedRankedTau(y, x, weigher, additive)) / 2
+}
+
+// weightedRankedTau returns the weighted τ for x and y using ranks determined
+// by the decreasing lexicographical order of the (x, y) pairs.
+func weightedRankedTau(x, y []float64, weigher func(int) float64, additive bool) float64 {
+	n := len(x)
+	if n < 2 {
+		return math.NaN()
+	}
+
+	// Sort by increasing lexicographical order of (x, y).
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+	sort.SliceStable(perm, func(i, j int) bool {
+		a, b := perm[i], perm[j]
+		if x[a] != x[b] {
+			return x[a] < x[b]
+		}
+		return y[a] < y[b]
+	})
+	w := make([]float64, n)
+	for r, i := range perm {
+		w[i] = weigher(n - 1 - r)
+	}
+
+	// tiedWeight returns the weight of pairs within runs of
+	// elements in perm order that are tied according to eq.
+	tiedWeight := func(eq func(i, j int) bool) (tied float64, constant bool) {
+		first := 0
+		s := w[perm[0]]
+		sq := s * s
+		flush := func(i int) {
+			if additive {
+				tied += s * float64(i-first-1)
+			} else {
+				tied += (s*s - sq) / 2
+			}
+		}
+		for i := 1; i < n; i++ {
+			if !eq(perm[first], perm[i]) {
+				flush(i)
+				first = i
+				s, sq = 0, 0
+			}
+			v := w[perm[i]]
+			s += v
+			sq += v * v
+		}
+		flush(n)
+		return tied, first == 0
+	}
+
+	joint, _ := tiedWeight(func(i, j int) bool { return x[i] == x[j] && y[i] == y[j] })
+	xTied, constant := tiedWeight(func(i, j int) bool { return x[i] == x[j] })
+	if constant {
+		return math.NaN()
+	}
+
+	// Weigh the exchanges needed to sort perm by y
+	// using a merge sort.
+	var exchanges float64
+	tmp := make([]int, n)
+	var weigh func(offset, length int) float64
+	weigh = func(offset, length int) float64 {
+		if length == 1 {
+			return w[perm[offset]]
+		}
+		length0 := length / 2
+		length1 := length - length0
+		middle := offset + length0
+		residual := weigh(offset, length0)
+		weight := weigh(middle, length1) + residual
+		if y[perm[middle-1]] < y[perm[middle]] {
+			return weight
+		}
+
+		var i, j, k int
+		for j < length0 && k < length1 {
+			if y[perm[offset+j]] <= y[perm[middle+k]] {
+				tmp[i] = perm[offset+j]
+				residual -= w[tmp[i]]
+				j++
+			} else {
+				tmp[i] = perm[middle+k]
+				if additive {
+					exchanges += w[tmp[i]]*float64(length0-j) + residual
+				} else {
+					exchanges += w[tmp[i]] * residual
+				}
+				k++
+			}
+			i++
+		}
+		copy(perm[offset+i:offset+i+length0-j], perm[offset+j:offset+length0])
+		copy(perm[offset:offset+i], tmp[:i])
+		return weight
+	}
+	weigh(0, n)
+
+	yTied, constant := tiedWeight(func(i, j int) bool { return y[i] == y[j] })
+	if constant {
+		return math.NaN()
+	}
+
+	var s, sq float64
+	for _, v := range w {
+		s += v
+		sq += v * v
+	}
+	var tot float64
+	if additive {
+		tot = s * float64(n-1)
+	} else {
+		tot = (s*s - sq) / 2
+	}
+
+	tau := ((tot - (yTied + xTied - joint)) - 2*exchanges) / math.Sqrt(tot-xTied) / math.Sqrt(tot-yTied)
+	return math.Max(-1, math.Min(1, tau))
+}
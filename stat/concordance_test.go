@@ -0,0 +1,325 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+// sign returns the sign of v.
+func sign(v float64) float64 {
+	switch {
+	case v < 0:
+		return -1
+	case v > 0:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// randTied returns a slice of n random values with many ties.
+func randTied(n, levels int, rnd *rand.Rand) []float64 {
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = float64(rnd.Intn(levels))
+	}
+	return x
+}
+
+func naiveGoodmanKruskalGamma(x, y, weights []float64) float64 {
+	var c, d float64
+	for i := range x {
+		for j := i + 1; j < len(x); j++ {
+			w := 1.0
+			if weights != nil {
+				w = weights[i] * weights[j]
+			}
+			switch s := sign(x[i]-x[j]) * sign(y[i]-y[j]); {
+			case s > 0:
+				c += w
+			case s < 0:
+				d += w
+			}
+		}
+	}
+	return (c - d) / (c + d)
+}
+
+func TestGoodmanKruskalGamma(t *testing.T) {
+	t.Parallel()
+	for i, test := range []struct {
+		x, y, weights []float64
+		want          float64
+	}{
+		{
+			x:    []float64{0, 1, 2, 3},
+			y:    []float64{0, 1, 2, 3},
+			want: 1,
+		},
+		{
+			x:    []float64{0, 1, 2, 3},
+			y:    []float64{3, 2, 1, 0},
+			want: -1,
+		},
+		{
+			// Ties in either variable are ignored.
+			x:    []float64{1, 1, 2, 2, 3},
+			y:    []float64{1, 2, 2, 3, 3},
+			want: 1,
+		},
+		{
+			x:    []float64{8, -3, 7, 8, -4},
+			y:    []float64{10, 5, 6, 3, -1},
+			want: 5.0 / 9,
+		},
+		{
+			x:    []float64{1, 1, 1},
+			y:    []float64{1, 2, 3},
+			want: math.NaN(),
+		},
+	} {
+		got := GoodmanKruskalGamma(test.x, test.y, test.weights)
+		if !scalar.Same(got, test.want) && !scalar.EqualWithinAbsOrRel(got, test.want, 1e-14, 1e-14) {
+			t.Errorf("unexpected gamma for test %d: got:%v want:%v", i, got, test.want)
+		}
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{2, 5, 20, 100} {
+		for _, levels := range []int{2, 5, 1000} {
+			x := randTied(n, levels, rnd)
+			y := randTied(n, levels, rnd)
+			weights := make([]float64, n)
+			for i := range weights {
+				weights[i] = rnd.Float64()
+			}
+			for _, w := range [][]float64{nil, weights} {
+				got := GoodmanKruskalGamma(x, y, w)
+				want := naiveGoodmanKruskalGamma(x, y, w)
+				if !scalar.Same(got, want) && !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+					t.Errorf("unexpected gamma for n=%d levels=%d weighted=%t: got:%v want:%v",
+						n, levels, w != nil, got, want)
+				}
+			}
+		}
+	}
+
+	if !panics(func() { GoodmanKruskalGamma(make([]float64, 2), make([]float64, 3), nil) }) {
+		t.Errorf("GoodmanKruskalGamma did not panic with length mismatch")
+	}
+	if !panics(func() { GoodmanKruskalGamma(make([]float64, 3), make([]float64, 3), make([]float64, 2)) }) {
+		t.Errorf("GoodmanKruskalGamma did not panic with weights length mismatch")
+	}
+}
+
+func naiveConcordanceIndex(pred, obs []float64, events []bool, weights []float64) float64 {
+	var c, d, tied float64
+	for i := range obs {
+		for j := range obs {
+			if obs[i] >= obs[j] || (events != nil && !events[i]) {
+				continue
+			}
+			w := 1.0
+			if weights != nil {
+				w = weights[i] * weights[j]
+			}
+			switch {
+			case pred[i] < pred[j]:
+				c += w
+			case pred[i] > pred[j]:
+				d += w
+			default:
+				tied += w
+			}
+		}
+	}
+	return (c + tied/2) / (c + d + tied)
+}
+
+func TestConcordanceIndex(t *testing.T) {
+	t.Parallel()
+	for i, test := range []struct {
+		pred, obs []float64
+		events    []bool
+		want      float64
+	}{
+		{
+			pred: []float64{1, 2, 3, 4},
+			obs:  []float64{10, 20, 30, 40},
+			want: 1,
+		},
+		{
+			pred: []float64{4, 3, 2, 1},
+			obs:  []float64{10, 20, 30, 40},
+			want: 0,
+		},
+		{
+			pred: []float64{1, 1, 1, 1},
+			obs:  []float64{10, 20, 30, 40},
+			want: 0.5,
+		},
+		{
+			// The censored first observation only forms
+			// comparable pairs with earlier events.
+			pred:   []float64{4, 1, 2, 3},
+			obs:    []float64{10, 20, 30, 40},
+			events: []bool{false, true, true, true},
+			want:   1,
+		},
+		{
+			pred: []float64{1, 2},
+			obs:  []float64{1, 1},
+			want: math.NaN(),
+		},
+	} {
+		got := ConcordanceIndex(test.pred, test.obs, test.events, nil)
+		if !scalar.Same(got, test.want) && !scalar.EqualWithinAbsOrRel(got, test.want, 1e-14, 1e-14) {
+			t.Errorf("unexpected C-index for test %d: got:%v want:%v", i, got, test.want)
+		}
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{2, 5, 20, 100} {
+		for _, levels := range []int{2, 5, 1000} {
+			pred := randTied(n, levels, rnd)
+			obs := randTied(n, levels, rnd)
+			events := make([]bool, n)
+			weights := make([]float64, n)
+			for i := range events {
+				events[i] = rnd.Float64() < 0.7
+				weights[i] = rnd.Float64()
+			}
+			for _, e := range [][]bool{nil, events} {
+				for _, w := range [][]float64{nil, weights} {
+					got := ConcordanceIndex(pred, obs, e, w)
+					want := naiveConcordanceIndex(pred, obs, e, w)
+					if !scalar.Same(got, want) && !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+						t.Errorf("unexpected C-index for n=%d levels=%d censored=%t weighted=%t: got:%v want:%v",
+							n, levels, e != nil, w != nil, got, want)
+					}
+				}
+			}
+		}
+	}
+
+	if !panics(func() { ConcordanceIndex(make([]float64, 2), make([]float64, 3), nil, nil) }) {
+		t.Errorf("ConcordanceIndex did not panic with length mismatch")
+	}
+	if !panics(func() { ConcordanceIndex(make([]float64, 3), make([]float64, 3), make([]bool, 2), nil) }) {
+		t.Errorf("ConcordanceIndex did not panic with events length mismatch")
+	}
+	if !panics(func() { ConcordanceIndex(make([]float64, 3), make([]float64, 3), nil, make([]float64, 2)) }) {
+		t.Errorf("ConcordanceIndex did not panic with weights length mismatch")
+	}
+}
+
+// naiveWeightedRankedTau returns the weighted τ of x and y computed from its
+// definition using ranks determined by decreasing lexicographical order of
+// the (x, y) pairs.
+func naiveWeightedRankedTau(x, y []float64, weigher func(int) float64, additive bool) float64 {
+	n := len(x)
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := order[i], order[j]
+		if x[a] != x[b] {
+			return x[a] > x[b]
+		}
+		return y[a] > y[b]
+	})
+	w := make([]float64, n)
+	for r, i := range order {
+		w[i] = weigher(r)
+	}
+	var num, xw, yw float64
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			wij := w[i] * w[j]
+			if additive {
+				wij = w[i] + w[j]
+			}
+			sx := sign(x[i] - x[j])
+			sy := sign(y[i] - y[j])
+			num += wij * sx * sy
+			if sx != 0 {
+				xw += wij
+			}
+			if sy != 0 {
+				yw += wij
+			}
+		}
+	}
+	return num / math.Sqrt(xw*yw)
+}
+
+func TestWeightedKendall(t *testing.T) {
+	t.Parallel()
+	// Values obtained from scipy.stats.weightedtau.
+	x := []float64{12, 2, 1, 12, 2}
+	y := []float64{1, 4, 7, 1, 0}
+	for _, test := range []struct {
+		additive bool
+		want     float64
+	}{
+		{additive: true, want: -0.56694968153682723},
+		{additive: false, want: -0.62205716951801038},
+	} {
+		got := WeightedKendall(x, y, nil, test.additive)
+		if !scalar.EqualWithinAbsOrRel(got, test.want, 1e-14, 1e-14) {
+			t.Errorf("unexpected weighted tau for additive=%t: got:%v want:%v", test.additive, got, test.want)
+		}
+	}
+
+	// A constant weigher gives Kendall's τ_b.
+	one := func(int) float64 { return 1 }
+	got := WeightedKendall([]float64{8, -3, 7, 8, -4}, []float64{10, 5, 6, 3, -1}, one, true)
+	want := 5 / math.Sqrt(9*10)
+	if !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+		t.Errorf("unexpected weighted tau with constant weigher: got:%v want:%v", got, want)
+	}
+
+	if got := WeightedKendall([]float64{1, 1, 1}, []float64{1, 2, 3}, nil, true); !math.IsNaN(got) {
+		t.Errorf("unexpected weighted tau for constant data: got:%v want:NaN", got)
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	weighers := []func(int) float64{
+		nil,
+		one,
+		func(r int) float64 { return 1 / math.Log(float64(r)+2) },
+	}
+	for _, n := range []int{2, 5, 20, 100} {
+		for _, levels := range []int{2, 5, 1000} {
+			x := randTied(n, levels, rnd)
+			y := randTied(n, levels, rnd)
+			for wi, weigher := range weighers {
+				for _, additive := range []bool{true, false} {
+					got := WeightedKendall(x, y, weigher, additive)
+					if weigher == nil {
+						weigher = func(r int) float64 { return 1 / float64(r+1) }
+					}
+					want := (naiveWeightedRankedTau(x, y, weigher, additive) + naiveWeightedRankedTau(y, x, weigher, additive)) / 2
+					if !scalar.Same(got, want) && !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+						t.Errorf("unexpected weighted tau for n=%d levels=%d weigher=%d additive=%t: got:%v want:%v",
+							n, levels, wi, additive, got, want)
+					}
+				}
+			}
+		}
+	}
+
+	if !panics(func() { WeightedKendall(make([]float64, 2), make([]float64, 3), nil, true) }) {
+		t.Errorf("WeightedKendall did not panic with length mismatch")
+	}
+}
@@ -0,0 +1,330 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distmv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+// LowRankDiag is a symmetric positive definite covariance matrix represented
+// as a diagonal matrix plus a low-rank term
+//
+//	Σ = D + F * Fᵀ
+//
+// where D is a d×d diagonal matrix with positive entries and F is a d×k
+// factor loading matrix. This is the covariance of a factor model with k
+// common factors and independent noise. When k is much less than d, the
+// matrix can be stored, inverted and used for sampling in O(d*k) memory and
+// O(d*k²) time, without forming the d×d matrix Σ. Use NewLowRankDiag to
+// construct.
+type LowRankDiag struct {
+	diag   []float64
+	factor *mat.Dense
+
+	// scaled is D^{-1/2} * F.
+	scaled *mat.Dense
+	// capChol is the Cholesky factorization of the k×k capacitance
+	// matrix I + Fᵀ * D⁻¹ * F used to apply Σ⁻¹ by the Woodbury identity.
+	capChol mat.Cholesky
+	logDet  float64
+}
+
+var _ mat.Symmetric = (*LowRankDiag)(nil)
+
+// NewLowRankDiag returns the covariance matrix D + F * Fᵀ where D is the
+// diagonal matrix with the given diagonal entries and F is the given factor
+// matrix. The inputs are copied.
+//
+// NewLowRankDiag panics if len(diag) is zero or not equal to the number of
+// rows of factor. If an element of diag is not positive, NewLowRankDiag
+// returns nil and false.
+func NewLowRankDiag(diag []float64, factor mat.Matrix) (cov *LowRankDiag, ok bool) {
+	d := len(diag)
+	if d == 0 {
+		panic(badZeroDimension)
+	}
+	r, k := factor.Dims()
+	if r != d {
+		panic(badSizeMismatch)
+	}
+	for _, v := range diag {
+		if !(v > 0) {
+			return nil, false
+		}
+	}
+	cov = &LowRankDiag{
+		diag:   make([]float64, d),
+		factor: mat.DenseCopyOf(factor),
+		scaled: mat.NewDense(d, k, nil),
+	}
+	copy(cov.diag, diag)
+	for i, v := range diag {
+		cov.logDet += math.Log(v)
+		s := 1 / math.Sqrt(v)
+		for j := 0; j < k; j++ {
+			cov.scaled.Set(i, j, s*cov.factor.At(i, j))
+		}
+	}
+
+	// By the matrix determinant lemma, |D + F*Fᵀ| = |D| |I + Fᵀ*D⁻¹*F|.
+	capacitance := mat.NewSymDense(k, nil)
+	capacitance.SymOuterK(1, cov.scaled.T())
+	for i := 0; i < k; i++ {
+		capacitance.SetSym(i, i, capacitance.At(i, i)+1)
+	}
+	if !cov.capChol.Factorize(capacitance) {
+		return nil, false
+	}
+	cov.logDet += cov.capChol.LogDet()
+	return cov, true
+}
+
+// Dims returns the dimensions of the matrix.
+func (cov *LowRankDiag) Dims() (r, c int) {
+	return len(cov.diag), len(cov.diag)
+}
+
+// SymmetricDim returns the dimension of the matrix.
+func (cov *LowRankDiag) SymmetricDim() int {
+	return len(cov.diag)
+}
+
+// At returns the element at row i, column j of the matrix.
+func (cov *LowRankDiag) At(i, j int) float64 {
+	d := len(cov.diag)
+	if uint(i) >= uint(d) {
+		panic(mat.ErrRowAccess)
+	}
+	if uint(j) >= uint(d) {
+		panic(mat.ErrColAccess)
+	}
+	v := mat.Dot(cov.factor.RowView(i), cov.factor.RowView(j))
+	if i == j {
+		v += cov.diag[i]
+	}
+	return v
+}
+
+// T returns the receiver, the transpose of a symmetric matrix.
+func (cov *LowRankDiag) T() mat.Matrix {
+	return cov
+}
+
+// Rank returns the number of columns k of the factor matrix F.
+func (cov *LowRankDiag) Rank() int {
+	_, k := cov.factor.Dims()
+	return k
+}
+
+// LogDet returns the log of the determinant of the matrix.
+func (cov *LowRankDiag) LogDet() float64 {
+	return cov.logDet
+}
+
+// SolveVecTo finds the vector x that solves Σ * x = b and stores the result
+// in-place into dst. SolveVecTo uses the Woodbury identity
+//
+//	Σ⁻¹ = D⁻¹ - D⁻¹ * F * (I + Fᵀ * D⁻¹ * F)⁻¹ * Fᵀ * D⁻¹
+//
+// so that only the k×k capacitance matrix is factorized.
+func (cov *LowRankDiag) SolveVecTo(dst *mat.VecDense, b mat.Vector) error {
+	d := len(cov.diag)
+	if b.Len() != d {
+		panic(badSizeMismatch)
+	}
+	// Work in the scaled variables y = D^{-1/2} * b, in which
+	// Σ⁻¹ = D^{-1/2} * (I - G * C⁻¹ * Gᵀ) * D^{-1/2} with G = D^{-1/2} * F.
+	y := make([]float64, d)
+	for i, v := range cov.diag {
+		y[i] = b.AtVec(i) / math.Sqrt(v)
+	}
+	yVec := mat.NewVecDense(d, y)
+	var u mat.VecDense
+	u.MulVec(cov.scaled.T(), yVec)
+	err := cov.capChol.SolveVecTo(&u, &u)
+	if err != nil {
+		return err
+	}
+	var gu mat.VecDense
+	gu.MulVec(cov.scaled, &u)
+	yVec.SubVec(yVec, &gu)
+	for i, v := range cov.diag {
+		y[i] /= math.Sqrt(v)
+	}
+	if dst.IsEmpty() {
+		dst.ReuseAsVec(d)
+	} else if dst.Len() != d {
+		panic(badSizeMismatch)
+	}
+	dst.CopyVec(yVec)
+	return nil
+}
+
+// mahalanobisSq returns (x-μ)ᵀ * Σ⁻¹ * (x-μ) without forming Σ⁻¹.
+func (cov *LowRankDiag) mahalanobisSq(x, mu []float64) float64 {
+	d := len(cov.diag)
+	y := make([]float64, d)
+	for i, v := range cov.diag {
+		y[i] = (x[i] - mu[i]) / math.Sqrt(v)
+	}
+	yVec := mat.NewVecDense(d, y)
+	var u, w mat.VecDense
+	u.MulVec(cov.scaled.T(), yVec)
+	err := cov.capChol.SolveVecTo(&w, &u)
+	if err != nil {
+		return math.NaN()
+	}
+	return floats.Dot(y, y) - mat.Dot(&u, &w)
+}
+
+// transformNormal overwrites dst with μ + D^{1/2} * z + F * w, where z
+// contains the d values in normal and w contains k further standard normal
+// values drawn using normFloat64.
+func (cov *LowRankDiag) transformNormal(dst, normal, mu []float64, normFloat64 func() float64) {
+	k := cov.Rank()
+	w := make([]float64, k)
+	for i := range w {
+		w[i] = normFloat64()
+	}
+	for i, v := range cov.diag {
+		dst[i] = math.Sqrt(v) * normal[i]
+	}
+	dstVec := mat.NewVecDense(len(dst), dst)
+	var fw mat.VecDense
+	fw.MulVec(cov.factor, mat.NewVecDense(k, w))
+	dstVec.AddVec(dstVec, &fw)
+	floats.Add(dst, mu)
+}
+
+// NormalLowRank is a multivariate normal distribution whose covariance matrix
+// is represented by a LowRankDiag. Sampling and evaluating the density of a
+// d-dimensional NormalLowRank with a rank k covariance term cost O(d*k) and
+// do not require forming the d×d covariance matrix. Use NewNormalLowRank to
+// construct.
+type NormalLowRank struct {
+	mu  []float64
+	cov *LowRankDiag
+	src rand.Source
+}
+
+// NewNormalLowRank creates a new NormalLowRank with the given mean and
+// covariance matrix. The covariance matrix is retained by the returned
+// distribution and must not be modified. NewNormalLowRank panics if len(mu) is
+// not equal to cov.SymmetricDim().
+func NewNormalLowRank(mu []float64, cov *LowRankDiag, src rand.Source) *NormalLowRank {
+	if len(mu) != cov.SymmetricDim() {
+		panic(badSizeMismatch)
+	}
+	n := &NormalLowRank{
+		mu:  make([]float64, len(mu)),
+		cov: cov,
+		src: src,
+	}
+	copy(n.mu, mu)
+	return n
+}
+
+// CovarianceMatrix stores the covariance matrix of the distribution in dst.
+// Forming the covariance matrix costs O(d²*k).
+//
+// If the dst matrix is empty it will be resized to the correct dimensions,
+// otherwise dst must match the dimension of the receiver or CovarianceMatrix
+// will panic.
+func (n *NormalLowRank) CovarianceMatrix(dst *mat.SymDense) {
+	d := n.Dim()
+	if dst.IsEmpty() {
+		*dst = *(dst.GrowSym(d).(*mat.SymDense))
+	} else if dst.SymmetricDim() != d {
+		panic("normal: input matrix size mismatch")
+	}
+	dst.SymOuterK(1, n.cov.factor)
+	for i, v := range n.cov.diag {
+		dst.SetSym(i, i, dst.At(i, i)+v)
+	}
+}
+
+// Dim returns the dimension of the distribution.
+func (n *NormalLowRank) Dim() int {
+	return len(n.mu)
+}
+
+// Entropy returns the differential entropy of the distribution.
+func (n *NormalLowRank) Entropy() float64 {
+	return float64(n.Dim())/2*(1+logTwoPi) + 0.5*n.cov.LogDet()
+}
+
+// LogProb computes the log of the pdf of the point x.
+func (n *NormalLowRank) LogProb(x []float64) float64 {
+	d := n.Dim()
+	if len(x) != d {
+		panic(badSizeMismatch)
+	}
+	c := -0.5*float64(d)*logTwoPi - 0.5*n.cov.LogDet()
+	return c - 0.5*n.cov.mahalanobisSq(x, n.mu)
+}
+
+// Mean returns the mean of the probability distribution.
+//
+// If dst is not nil, the mean will be stored in-place into dst and returned,
+// otherwise a new slice will be allocated first. If dst is not nil, it must
+// have length equal to the dimension of the distribution.
+func (n *NormalLowRank) Mean(dst []float64) []float64 {
+	dst = reuseAs(dst, n.Dim())
+	copy(dst, n.mu)
+	return dst
+}
+
+// Prob computes the value of the probability density function at x.
+func (n *NormalLowRank) Prob(x []float64) float64 {
+	return math.Exp(n.LogProb(x))
+}
+
+// Rand generates a random sample according to the distribution.
+//
+// If dst is not nil, the sample will be stored in-place into dst and returned,
+// otherwise a new slice will be allocated first. If dst is not nil, it must
+// have length equal to the dimension of the distribution.
+func (n *NormalLowRank) Rand(dst []float64) []float64 {
+	return NormalRandCov(dst, n.mu, n.cov, n.src)
+}
+
+// ScoreInput returns the gradient of the log-probability with respect to the
+// input x. That is, ScoreInput computes
+//
+//	∇_x log(p(x))
+//
+// If dst is not nil, the score will be stored in-place into dst and returned,
+// otherwise a new slice will be allocated first. If dst is not nil, it must
+// have length equal to the dimension of the distribution.
+func (n *NormalLowRank) ScoreInput(dst, x []float64) []float64 {
+	// The derivative is -Σ^-1 (x-μ). See Normal.ScoreInput.
+	if len(x) != n.Dim() {
+		panic(badInputLength)
+	}
+	dst = reuseAs(dst, n.Dim())
+
+	floats.SubTo(dst, x, n.mu)
+	dstVec := mat.NewVecDense(len(dst), dst)
+	err := n.cov.SolveVecTo(dstVec, dstVec)
+	if err != nil {
+		panic(err)
+	}
+	floats.Scale(-1, dst)
+	return dst
+}
+
+// SetMean changes the mean of the normal distribution. SetMean panics if
+// len(mu) does not equal the dimension of the normal distribution.
+func (n *NormalLowRank) SetMean(mu []float64) {
+	if len(mu) != n.Dim() {
+		panic(badSizeMismatch)
+	}
+	copy(n.mu, mu)
+}
@@ -0,0 +1,135 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package distmv
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestNormalLowRank(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for cas, test := range []struct {
+		d, k int
+	}{
+		{1, 1},
+		{3, 1},
+		{5, 2},
+		{20, 3},
+		{50, 50},
+	} {
+		d, k := test.d, test.k
+		diag := make([]float64, d)
+		for i := range diag {
+			diag[i] = 0.5 + rnd.Float64()
+		}
+		factor := mat.NewDense(d, k, nil)
+		for i := 0; i < d; i++ {
+			for j := 0; j < k; j++ {
+				factor.Set(i, j, rnd.NormFloat64())
+			}
+		}
+		mu := make([]float64, d)
+		for i := range mu {
+			mu[i] = rnd.NormFloat64()
+		}
+
+		cov, ok := NewLowRankDiag(diag, factor)
+		if !ok {
+			t.Fatalf("case %d: unexpected failure", cas)
+		}
+		want := mat.NewSymDense(d, nil)
+		want.SymOuterK(1, factor)
+		for i, v := range diag {
+			want.SetSym(i, i, want.At(i, i)+v)
+		}
+		if !mat.EqualApprox(cov, want, tol) {
+			t.Errorf("case %d: covariance mismatch", cas)
+		}
+		if cov.Rank() != k {
+			t.Errorf("case %d: unexpected rank: got %d, want %d", cas, cov.Rank(), k)
+		}
+
+		var chol mat.Cholesky
+		if !chol.Factorize(want) {
+			t.Fatalf("case %d: bad test: covariance not positive definite", cas)
+		}
+		if got := cov.LogDet(); math.Abs(got-chol.LogDet()) > tol*float64(d) {
+			t.Errorf("case %d: LogDet mismatch: got %v, want %v", cas, got, chol.LogDet())
+		}
+
+		b := mat.NewVecDense(d, nil)
+		for i := 0; i < d; i++ {
+			b.SetVec(i, rnd.NormFloat64())
+		}
+		var x, xWant mat.VecDense
+		if err := cov.SolveVecTo(&x, b); err != nil {
+			t.Errorf("case %d: unexpected error: %v", cas, err)
+		}
+		if err := chol.SolveVecTo(&xWant, b); err != nil {
+			t.Fatalf("case %d: bad test: %v", cas, err)
+		}
+		if !mat.EqualApprox(&x, &xWant, tol) {
+			t.Errorf("case %d: SolveVecTo mismatch", cas)
+		}
+
+		dist := NewNormalLowRank(mu, cov, nil)
+		ref, ok := NewNormal(mu, want, nil)
+		if !ok {
+			t.Fatalf("case %d: bad test: NewNormal failed", cas)
+		}
+		if dist.Dim() != d {
+			t.Errorf("case %d: unexpected dimension: got %d, want %d", cas, dist.Dim(), d)
+		}
+		if got, want := dist.Entropy(), ref.Entropy(); math.Abs(got-want) > tol*float64(d) {
+			t.Errorf("case %d: Entropy mismatch: got %v, want %v", cas, got, want)
+		}
+		for trial := 0; trial < 5; trial++ {
+			loc := make([]float64, d)
+			for i := range loc {
+				loc[i] = mu[i] + rnd.NormFloat64()
+			}
+			if got, want := dist.LogProb(loc), ref.LogProb(loc); math.Abs(got-want) > tol*float64(d) {
+				t.Errorf("case %d: LogProb mismatch: got %v, want %v", cas, got, want)
+			}
+			if got, want := dist.ScoreInput(nil, loc), ref.ScoreInput(nil, loc); !floats.EqualApprox(got, want, tol) {
+				t.Errorf("case %d: ScoreInput mismatch:\ngot  %v\nwant %v", cas, got, want)
+			}
+		}
+	}
+
+	if _, ok := NewLowRankDiag([]float64{1, 0}, mat.NewDense(2, 1, nil)); ok {
+		t.Errorf("expected failure for non-positive diagonal")
+	}
+}
+
+func TestNormalLowRankRand(t *testing.T) {
+	const numSamples = 1_000_000
+	const tol = 1e-2
+
+	diag := []float64{0.5, 1, 2, 0.25}
+	factor := mat.NewDense(4, 2, []float64{
+		1, 0,
+		0.5, 1,
+		-1, 0.5,
+		0, -0.5,
+	})
+	mu := []float64{-1, 2, 0, 3}
+	cov, ok := NewLowRankDiag(diag, factor)
+	if !ok {
+		t.Fatal("bad test: covariance not positive definite")
+	}
+	dist := NewNormalLowRank(mu, cov, rand.NewSource(1))
+	samples := mat.NewDense(numSamples, len(mu), nil)
+	generateSamples(samples, dist)
+	checkMean(t, 0, samples, dist, tol)
+	checkCov(t, 0, samples, dist, tol)
+}
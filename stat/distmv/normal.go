@@ -401,9 +401,9 @@ func (ed *PositivePartEigenSym) RawValues() []float64 { return ed.vals }
 // otherwise a new slice will be allocated first. If dst is not nil, it must
 // have length equal to the dimension of the distribution.
 //
-// cov should be *mat.Cholesky, *mat.PivotedCholesky or EigenSym, otherwise
-// NormalRandCov will be very inefficient because a pivoted Cholesky
-// factorization of cov will be computed for every sample.
+// cov should be *mat.Cholesky, *mat.PivotedCholesky, EigenSym or
+// *LowRankDiag, otherwise NormalRandCov will be very inefficient because a
+// pivoted Cholesky factorization of cov will be computed for every sample.
 //
 // If cov is an EigenSym, all eigenvalues returned by RawValues must be
 // non-negative, otherwise NormalRandCov will panic.
@@ -413,18 +413,18 @@ func NormalRandCov(dst, mean []float64, cov mat.Symmetric, src rand.Source) []fl
 		panic(badInputLength)
 	}
 	dst = reuseAs(dst, n)
-	if src == nil {
-		for i := range dst {
-			dst[i] = rand.NormFloat64()
-		}
-	} else {
-		rnd := rand.New(src)
-		for i := range dst {
-			dst[i] = rnd.NormFloat64()
-		}
+	normFloat64 := rand.NormFloat64
+	if src != nil {
+		normFloat64 = rand.New(src).NormFloat64
+	}
+	for i := range dst {
+		dst[i] = normFloat64()
 	}
 
 	switch cov := cov.(type) {
+	case *LowRankDiag:
+		cov.transformNormal(dst, dst, mean, normFloat64)
+		return dst
 	case *mat.Cholesky:
 		dstVec := mat.NewVecDense(n, dst)
 		dstVec.MulVec(cov.RawU().T(), dstVec)
@@ -0,0 +1,269 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+	"sort"
+
+	"gonum.org/v1/gonum/mathext"
+)
+
+// MutualInformationKSG returns the Kraskov–Stögbauer–Grassberger k-nearest
+// neighbor estimate of the mutual information between the continuous samples
+// x and y. The natural logarithm is used.
+//
+//	I(X;Y) = ψ(k) + ψ(N) - <ψ(n_x+1) + ψ(n_y+1)>
+//
+// where ψ is the digamma function, ε_i is the distance from (x_i, y_i) to its
+// kth nearest neighbor using the maximum norm, and n_x and n_y are the numbers of
+// points whose marginal distances from x_i and y_i are strictly less than ε_i.
+// The estimate may be slightly negative for independent samples.
+//
+// The lengths of x and y must be equal and k must be positive and less than
+// len(x), otherwise MutualInformationKSG will panic. Larger values of k reduce
+// the variance of the estimate at the cost of increased bias. Samples should
+// not contain duplicate points.
+//
+// See https://doi.org/10.1103/PhysRevE.69.066138 for details.
+func MutualInformationKSG(x, y []float64, k int) float64 {
+	if len(x) != len(y) {
+		panic("stat: slice length mismatch")
+	}
+	n := len(x)
+	if k <= 0 || n <= k {
+		panic("stat: invalid neighbor count")
+	}
+
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool { return x[order[i]] < x[order[j]] })
+	xs := make([]float64, n)
+	for i, o := range order {
+		xs[i] = x[o]
+	}
+	ys := make([]float64, n)
+	copy(ys, y)
+	sort.Float64s(ys)
+
+	// dist holds the k smallest joint distances
+	// found so far in increasing order.
+	dist := make([]float64, 0, k)
+	var sum float64
+	for p, i := range order {
+		dist = dist[:0]
+		consider := func(q int) bool {
+			j := order[q]
+			dx := math.Abs(x[j] - x[i])
+			if len(dist) == k && dx >= dist[k-1] {
+				// All further points in this
+				// direction are farther.
+				return false
+			}
+			d := math.Max(dx, math.Abs(y[j]-y[i]))
+			insertSmallest(&dist, d, k)
+			return true
+		}
+		for l, r := p-1, p+1; l >= 0 || r < n; {
+			if l >= 0 && !consider(l) {
+				l = -1
+			} else {
+				l--
+			}
+			if r < n && !consider(r) {
+				r = n
+			} else {
+				r++
+			}
+		}
+		eps := dist[k-1]
+
+		nx := countWithin(xs, x[i], eps) - 1
+		ny := countWithin(ys, y[i], eps) - 1
+		sum += mathext.Digamma(float64(nx+1)) + mathext.Digamma(float64(ny+1))
+	}
+	return mathext.Digamma(float64(k)) + mathext.Digamma(float64(n)) - sum/float64(n)
+}
+
+// insertSmallest inserts v into the sorted slice *s retaining at most
+// the k smallest values.
+func insertSmallest(s *[]float64, v float64, k int) {
+	d := *s
+	if len(d) == k {
+		if v >= d[k-1] {
+			return
+		}
+		d = d[:k-1]
+	}
+	i := sort.SearchFloat64s(d, v)
+	d = append(d, 0)
+	copy(d[i+1:], d[i:])
+	d[i] = v
+	*s = d
+}
+
+// countWithin returns the number of elements of the sorted slice s that are
+// strictly within eps of v. Distances are compared directly rather than
+// through v±eps so that the count is consistent with the distances used to
+// determine eps.
+func countWithin(s []float64, v, eps float64) int {
+	lo := sort.Search(len(s), func(i int) bool { return v-s[i] < eps })
+	hi := sort.Search(len(s), func(i int) bool { return s[i]-v >= eps })
+	return hi - lo
+}
+
+// DifferentialEntropy returns the Kozachenko–Leonenko k-nearest neighbor
+// estimate of the differential entropy of the continuous univariate sample x.
+// The natural logarithm is used.
+//
+//	H(X) = ψ(N) - ψ(k) + log(2) + <log(r_i)>
+//
+// where ψ is the digamma function and r_i is the distance from x_i to its kth
+// nearest neighbor.
+//
+// k must be positive and less than len(x), otherwise DifferentialEntropy will
+// panic. If x contains duplicate values, DifferentialEntropy may return -Inf.
+//
+// See https://doi.org/10.1103/PhysRevE.69.066138 for details.
+func DifferentialEntropy(x []float64, k int) float64 {
+	n := len(x)
+	if k <= 0 || n <= k {
+		panic("stat: invalid neighbor count")
+	}
+	xs := make([]float64, n)
+	copy(xs, x)
+	sort.Float64s(xs)
+
+	var sum float64
+	for i, v := range xs {
+		// Merge the neighbors on either side
+		// to find the kth nearest.
+		l, r := i-1, i+1
+		var d float64
+		for c := 0; c < k; c++ {
+			switch {
+			case l < 0:
+				d = xs[r] - v
+				r++
+			case r >= n:
+				d = v - xs[l]
+				l--
+			case v-xs[l] <= xs[r]-v:
+				d = v - xs[l]
+				l--
+			default:
+				d = xs[r] - v
+				r++
+			}
+		}
+		sum += math.Log(d)
+	}
+	return mathext.Digamma(float64(n)) - mathext.Digamma(float64(k)) + math.Ln2 + sum/float64(n)
+}
+
+// MutualInformationBinned returns the plug-in estimate of the mutual
+// information between the samples x and y after binning them into a
+// two-dimensional histogram. The natural logarithm is used.
+//
+//	I(X;Y) = sum_{i,j} p_{ij} log(p_{ij} / (p_i p_j))
+//
+// The weight of data point (x[k], y[k]) is placed into bin (i, j) if
+// xDividers[i] <= x[k] < xDividers[i+1] and yDividers[j] <= y[k] < yDividers[j+1].
+// The "span" function in the floats package can assist with bin creation.
+//
+// The following conditions on the inputs apply:
+//   - The lengths of x and y must be equal.
+//   - The values in the dividers must be sorted, and there must be at least two.
+//   - All values of x and y must be within the range of their dividers.
+//   - If weights is nil then all of the weights are 1.
+//   - If weights is not nil, then len(x) must equal len(weights).
+//
+// The plug-in estimate is biased upwards for small samples.
+func MutualInformationBinned(x, y, xDividers, yDividers, weights []float64) float64 {
+	mi, _, _ := binnedInformation(x, y, xDividers, yDividers, weights)
+	return mi
+}
+
+// NormalizedMutualInformation returns the binned mutual information between
+// the samples x and y normalized by the geometric mean of the binned marginal
+// entropies,
+//
+//	NMI(X;Y) = I(X;Y) / sqrt(H(X) H(Y))
+//
+// which lies in the interval [0, 1]. If either marginal entropy is zero,
+// NormalizedMutualInformation returns NaN.
+//
+// See MutualInformationBinned for the conditions on the inputs.
+func NormalizedMutualInformation(x, y, xDividers, yDividers, weights []float64) float64 {
+	mi, hx, hy := binnedInformation(x, y, xDividers, yDividers, weights)
+	if hx == 0 || hy == 0 {
+		return math.NaN()
+	}
+	return mi / math.Sqrt(hx*hy)
+}
+
+// binnedInformation returns the plug-in estimates of the mutual information
+// and marginal entropies of the binned samples x and y.
+func binnedInformation(x, y, xDividers, yDividers, weights []float64) (mi, hx, hy float64) {
+	if len(x) != len(y) {
+		panic("stat: slice length mismatch")
+	}
+	if weights != nil && len(weights) != len(x) {
+		panic("stat: slice length mismatch")
+	}
+	nx := len(xDividers) - 1
+	ny := len(yDividers) - 1
+	if nx < 1 || ny < 1 {
+		panic("stat: fewer than two dividers")
+	}
+	if !sort.Float64sAreSorted(xDividers) || !sort.Float64sAreSorted(yDividers) {
+		panic("stat: dividers are not sorted")
+	}
+
+	joint := make([]float64, nx*ny)
+	px := make([]float64, nx)
+	py := make([]float64, ny)
+	var total float64
+	for k := range x {
+		i := binIndex(xDividers, x[k])
+		j := binIndex(yDividers, y[k])
+		w := 1.0
+		if weights != nil {
+			w = weights[k]
+		}
+		joint[i*ny+j] += w
+		px[i] += w
+		py[j] += w
+		total += w
+	}
+	if total == 0 {
+		return math.NaN(), math.NaN(), math.NaN()
+	}
+	for i, v := range px {
+		px[i] = v / total
+	}
+	for j, v := range py {
+		py[j] = v / total
+	}
+	for i := 0; i < nx; i++ {
+		for j := 0; j < ny; j++ {
+			p := joint[i*ny+j] / total
+			if p != 0 {
+				mi += p * math.Log(p/(px[i]*py[j]))
+			}
+		}
+	}
+	return mi, Entropy(px), Entropy(py)
+}
+
+// binIndex returns the index of the bin of the sorted dividers containing v.
+func binIndex(dividers []float64, v float64) int {
+	if v < dividers[0] || dividers[len(dividers)-1] <= v {
+		panic("stat: value outside divider range")
+	}
+	return sort.Search(len(dividers), func(i int) bool { return dividers[i] > v }) - 1
+}
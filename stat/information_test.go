@@ -0,0 +1,201 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mathext"
+)
+
+// naiveMutualInformationKSG returns the KSG estimate computed by
+// exhaustive search.
+func naiveMutualInformationKSG(x, y []float64, k int) float64 {
+	n := len(x)
+	var sum float64
+	for i := range x {
+		d := make([]float64, 0, n-1)
+		for j := range x {
+			if j != i {
+				d = append(d, math.Max(math.Abs(x[i]-x[j]), math.Abs(y[i]-y[j])))
+			}
+		}
+		sort.Float64s(d)
+		eps := d[k-1]
+		var nx, ny int
+		for j := range x {
+			if j == i {
+				continue
+			}
+			if math.Abs(x[i]-x[j]) < eps {
+				nx++
+			}
+			if math.Abs(y[i]-y[j]) < eps {
+				ny++
+			}
+		}
+		sum += mathext.Digamma(float64(nx+1)) + mathext.Digamma(float64(ny+1))
+	}
+	return mathext.Digamma(float64(k)) + mathext.Digamma(float64(n)) - sum/float64(n)
+}
+
+// correlatedNormal returns n samples from a standard bivariate normal
+// distribution with correlation rho.
+func correlatedNormal(n int, rho float64, rnd *rand.Rand) (x, y []float64) {
+	x = make([]float64, n)
+	y = make([]float64, n)
+	for i := range x {
+		a := rnd.NormFloat64()
+		b := rnd.NormFloat64()
+		x[i] = a
+		y[i] = rho*a + math.Sqrt(1-rho*rho)*b
+	}
+	return x, y
+}
+
+func TestMutualInformationKSG(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+
+	for _, n := range []int{5, 20, 100} {
+		for _, k := range []int{1, 3} {
+			if k >= n {
+				continue
+			}
+			x, y := correlatedNormal(n, 0.6, rnd)
+			got := MutualInformationKSG(x, y, k)
+			want := naiveMutualInformationKSG(x, y, k)
+			if !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+				t.Errorf("unexpected KSG estimate for n=%d k=%d: got:%v want:%v", n, k, got, want)
+			}
+		}
+	}
+
+	for _, rho := range []float64{0, 0.5, 0.9} {
+		x, y := correlatedNormal(4000, rho, rnd)
+		got := MutualInformationKSG(x, y, 4)
+		want := -0.5 * math.Log(1-rho*rho)
+		if math.Abs(got-want) > 0.03 {
+			t.Errorf("unexpected KSG estimate for rho=%v: got:%v want:%v", rho, got, want)
+		}
+	}
+
+	if !panics(func() { MutualInformationKSG(make([]float64, 3), make([]float64, 4), 1) }) {
+		t.Errorf("MutualInformationKSG did not panic with length mismatch")
+	}
+	if !panics(func() { MutualInformationKSG(make([]float64, 3), make([]float64, 3), 3) }) {
+		t.Errorf("MutualInformationKSG did not panic with too large k")
+	}
+}
+
+func TestDifferentialEntropy(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	const n = 20000
+
+	normal := make([]float64, n)
+	uniform := make([]float64, n)
+	for i := range normal {
+		normal[i] = 2 * rnd.NormFloat64()
+		uniform[i] = 3 * rnd.Float64()
+	}
+	for _, test := range []struct {
+		name string
+		x    []float64
+		want float64
+	}{
+		{name: "normal", x: normal, want: 0.5 * math.Log(2*math.Pi*math.E*4)},
+		{name: "uniform", x: uniform, want: math.Log(3)},
+	} {
+		for _, k := range []int{3, 5} {
+			got := DifferentialEntropy(test.x, k)
+			if math.Abs(got-test.want) > 0.03 {
+				t.Errorf("unexpected entropy for %s k=%d: got:%v want:%v", test.name, k, got, test.want)
+			}
+		}
+	}
+
+	// Entropy of the sample is invariant to its order and
+	// shifts by log(a) under scaling by a.
+	x := []float64{0.3, 1.7, -0.2, 0.9, 2.5, 1.1}
+	got := DifferentialEntropy(x, 2)
+	scaled := make([]float64, len(x))
+	for i, v := range x {
+		scaled[len(x)-1-i] = 10 * v
+	}
+	want := got + math.Log(10)
+	if got := DifferentialEntropy(scaled, 2); !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+		t.Errorf("unexpected entropy for scaled data: got:%v want:%v", got, want)
+	}
+
+	if !panics(func() { DifferentialEntropy(make([]float64, 3), 0) }) {
+		t.Errorf("DifferentialEntropy did not panic with zero k")
+	}
+}
+
+func TestMutualInformationBinned(t *testing.T) {
+	t.Parallel()
+	div := []float64{0, 1, 2, 3, 4}
+
+	// Identical variables share all of their information.
+	x := []float64{0.5, 1.5, 2.5, 3.5, 0.5, 1.5, 2.5, 3.5}
+	got := MutualInformationBinned(x, x, div, div, nil)
+	if !scalar.EqualWithinAbsOrRel(got, math.Log(4), 1e-14, 1e-14) {
+		t.Errorf("unexpected MI for identical data: got:%v want:%v", got, math.Log(4))
+	}
+	if got := NormalizedMutualInformation(x, x, div, div, nil); !scalar.EqualWithinAbsOrRel(got, 1, 1e-14, 1e-14) {
+		t.Errorf("unexpected NMI for identical data: got:%v want:1", got)
+	}
+
+	// A complete grid is independent.
+	var gx, gy []float64
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			gx = append(gx, float64(i)+0.5)
+			gy = append(gy, float64(j)+0.5)
+		}
+	}
+	if got := MutualInformationBinned(gx, gy, div, div, nil); math.Abs(got) > 1e-14 {
+		t.Errorf("unexpected MI for independent data: got:%v want:0", got)
+	}
+	if got := NormalizedMutualInformation(gx, gy, div, div, nil); math.Abs(got) > 1e-14 {
+		t.Errorf("unexpected NMI for independent data: got:%v want:0", got)
+	}
+
+	// Weights act as repeated observations.
+	wx := []float64{0.5, 1.5, 0.5}
+	wy := []float64{0.5, 0.5, 1.5}
+	weights := []float64{2, 1, 3}
+	var rx, ry []float64
+	for i, w := range weights {
+		for j := 0; j < int(w); j++ {
+			rx = append(rx, wx[i])
+			ry = append(ry, wy[i])
+		}
+	}
+	got = MutualInformationBinned(wx, wy, div, div, weights)
+	want := MutualInformationBinned(rx, ry, div, div, nil)
+	if !scalar.EqualWithinAbsOrRel(got, want, 1e-14, 1e-14) {
+		t.Errorf("unexpected weighted MI: got:%v want:%v", got, want)
+	}
+
+	// Constant data has no entropy.
+	c := []float64{0.5, 0.5, 0.5}
+	if got := NormalizedMutualInformation(c, []float64{0.5, 1.5, 2.5}, div, div, nil); !math.IsNaN(got) {
+		t.Errorf("unexpected NMI for constant data: got:%v want:NaN", got)
+	}
+
+	if !panics(func() { MutualInformationBinned([]float64{4}, []float64{0}, div, div, nil) }) {
+		t.Errorf("MutualInformationBinned did not panic with value outside dividers")
+	}
+	if !panics(func() { MutualInformationBinned([]float64{1}, []float64{1, 2}, div, div, nil) }) {
+		t.Errorf("MutualInformationBinned did not panic with length mismatch")
+	}
+}
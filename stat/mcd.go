@@ -0,0 +1,275 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+	"sort"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/mathext"
+)
+
+const (
+	// mcdTrials is the number of random initial subsets tried.
+	mcdTrials = 500
+	// mcdInitialSteps is the number of C-steps applied to each
+	// initial subset.
+	mcdInitialSteps = 2
+	// mcdCandidates is the number of best initial subsets that are
+	// iterated to convergence.
+	mcdCandidates = 10
+	// mcdMaxSteps limits the number of C-steps applied to a candidate.
+	mcdMaxSteps = 100
+	// mcdReweight is the quantile of the χ² distribution used to
+	// identify outliers in the reweighting step.
+	mcdReweight = 0.975
+)
+
+// CovarianceMatrixMCD calculates a robust estimate of the location and the
+// covariance matrix of the data in x using the Minimum Covariance
+// Determinant (MCD) estimator. The covariance estimate is stored in dst and
+// the location estimate is returned in mean.
+//
+// The raw MCD estimate is the mean and covariance of the h rows of x whose
+// covariance matrix has the smallest determinant. If h is zero, the subset
+// size (n+p+1)/2 is used, which gives the estimator the highest breakdown
+// point, where n and p are the number of rows and columns of x. The raw
+// estimate is scaled to be consistent at the normal distribution and then
+// reweighted by recomputing the mean and covariance over the rows whose
+// squared Mahalanobis distance is within the 0.975 quantile of the χ²
+// distribution with p degrees of freedom. The indices of these rows are
+// returned in ascending order in support; the remaining rows of x may be
+// regarded as outliers.
+//
+// The minimizing subset is searched for with the FAST-MCD algorithm from
+// random initial subsets drawn using src. If src is nil, the global random
+// source is used. The cost of the search is O(n*p²) for each of several
+// hundred initial subsets.
+//
+// If the minimum covariance determinant is zero, at least h rows of x lie
+// on a hyperplane. In this case ok is false, dst holds the singular raw
+// covariance of those rows, mean holds their mean and support holds their
+// indices.
+//
+// CovarianceMatrixMCD panics if h is not zero and is not in (p, n], or if
+// dst is not empty and does not have the same number of columns as x.
+//
+// See "A fast algorithm for the minimum covariance determinant estimator",
+// P. J. Rousseeuw and K. Van Driessen, Technometrics 41 (1999).
+func CovarianceMatrixMCD(dst *mat.SymDense, x mat.Matrix, h int, src rand.Source) (mean []float64, support []int, ok bool) {
+	n, p := x.Dims()
+	if h == 0 {
+		h = (n + p + 1) / 2
+	}
+	if h <= p || n < h {
+		panic("stat: invalid MCD subset size")
+	}
+	if dst.IsEmpty() {
+		*dst = *(dst.GrowSym(p).(*mat.SymDense))
+	} else if dst.SymmetricDim() != p {
+		panic(mat.ErrShape)
+	}
+	perm := rand.Perm
+	if src != nil {
+		perm = rand.New(src).Perm
+	}
+
+	m := newMCD(x, h)
+
+	// Apply a few C-steps to subsets grown from random
+	// (p+1)-subsets and keep the best candidates.
+	var candidates []mcdSubset
+	for trial := 0; trial < mcdTrials; trial++ {
+		rows := perm(n)
+		size := p + 1
+		for !m.fit(rows[:size]) {
+			if size == n {
+				break
+			}
+			size++
+		}
+		if !m.valid {
+			continue
+		}
+		s := m.nearest()
+		for i := 0; i < mcdInitialSteps && s.det > 0; i++ {
+			s = m.cstep(s.rows)
+		}
+		if s.det == 0 {
+			return m.exactFit(dst, s.rows)
+		}
+		candidates = append(candidates, s)
+	}
+	if len(candidates) == 0 {
+		// Every subset of x is singular.
+		all := make([]int, n)
+		for i := range all {
+			all[i] = i
+		}
+		return m.exactFit(dst, all[:h])
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].det < candidates[j].det })
+	if len(candidates) > mcdCandidates {
+		candidates = candidates[:mcdCandidates]
+	}
+
+	// Iterate the best candidates to convergence.
+	best := mcdSubset{det: math.Inf(1)}
+	for _, s := range candidates {
+		for i := 0; i < mcdMaxSteps; i++ {
+			next := m.cstep(s.rows)
+			if next.det >= s.det {
+				break
+			}
+			s = next
+		}
+		if s.det == 0 {
+			return m.exactFit(dst, s.rows)
+		}
+		if s.det < best.det {
+			best = s
+		}
+	}
+
+	// Scale the raw estimate for consistency at the normal distribution.
+	if !m.fit(best.rows) {
+		return m.exactFit(dst, best.rows)
+	}
+	d2 := make([]float64, n)
+	copy(d2, m.dist)
+	sort.Float64s(d2)
+	scale := Quantile(0.5, Empirical, d2, nil) / chiSquareQuantile(0.5, p)
+	for i := range m.dist {
+		m.dist[i] /= scale
+	}
+
+	// Reweight using the rows that are not identified as outliers.
+	cut := chiSquareQuantile(mcdReweight, p)
+	for i, d := range m.dist {
+		if d <= cut {
+			support = append(support, i)
+		}
+	}
+	mean = make([]float64, p)
+	m.meanCov(dst, mean, support)
+	return mean, support, true
+}
+
+// chiSquareQuantile returns the q quantile of the χ² distribution with k
+// degrees of freedom.
+func chiSquareQuantile(q float64, k int) float64 {
+	return 2 * mathext.GammaIncRegInv(float64(k)/2, q)
+}
+
+// mcdSubset is a subset of the rows of the data and the determinant of
+// its covariance matrix.
+type mcdSubset struct {
+	rows []int
+	det  float64
+}
+
+// mcd holds the state of a FAST-MCD search.
+type mcd struct {
+	x *mat.Dense
+	h int
+
+	mean []float64
+	cov  mat.SymDense
+	chol mat.Cholesky
+
+	// valid indicates that the last fit was non-singular, in which
+	// case dist holds the squared Mahalanobis distances of the rows
+	// of x under the fitted mean and covariance.
+	valid bool
+	det   float64
+	dist  []float64
+	order []int
+}
+
+func newMCD(x mat.Matrix, h int) *mcd {
+	n, p := x.Dims()
+	return &mcd{
+		x:     mat.DenseCopyOf(x),
+		h:     h,
+		mean:  make([]float64, p),
+		cov:   *mat.NewSymDense(p, nil),
+		dist:  make([]float64, n),
+		order: make([]int, n),
+	}
+}
+
+// fit computes the mean and covariance of the given rows and, if the
+// covariance is non-singular, the distances of all rows of x. It returns
+// whether the covariance is non-singular.
+func (m *mcd) fit(rows []int) bool {
+	m.meanCov(&m.cov, m.mean, rows)
+	m.valid = false
+	m.det = 0
+	if !m.chol.Factorize(&m.cov) {
+		return false
+	}
+	mahalanobisSqRows(m.dist, m.x, mat.NewVecDense(len(m.mean), m.mean), &m.chol)
+	if math.IsNaN(m.dist[0]) {
+		return false
+	}
+	m.valid = true
+	m.det = m.chol.Det()
+	return true
+}
+
+// nearest returns the h rows of x closest to the current fit along with
+// the determinant of the current fit.
+func (m *mcd) nearest() mcdSubset {
+	for i := range m.order {
+		m.order[i] = i
+	}
+	sort.Slice(m.order, func(i, j int) bool { return m.dist[m.order[i]] < m.dist[m.order[j]] })
+	rows := make([]int, m.h)
+	copy(rows, m.order[:m.h])
+	return mcdSubset{rows: rows, det: m.det}
+}
+
+// cstep performs a concentration step from the given rows, returning the
+// h rows closest to the fit of rows and the determinant of the covariance
+// of those rows. The determinant of the returned subset is not greater
+// than that of rows.
+func (m *mcd) cstep(rows []int) mcdSubset {
+	if !m.fit(rows) {
+		return mcdSubset{rows: rows, det: 0}
+	}
+	next := m.nearest()
+	if !m.fit(next.rows) {
+		return mcdSubset{rows: next.rows, det: 0}
+	}
+	next.det = m.det
+	return next
+}
+
+// meanCov stores the mean and maximum likelihood covariance of the given
+// rows of x in mean and cov.
+func (m *mcd) meanCov(cov *mat.SymDense, mean []float64, rows []int) {
+	_, p := m.x.Dims()
+	sub := mat.NewDense(len(rows), p, nil)
+	for i, r := range rows {
+		sub.SetRow(i, m.x.RawRowView(r))
+	}
+	for j := range mean {
+		mean[j] = Mean(mat.Col(nil, j, sub), nil)
+	}
+	empiricalCovariance(cov, sub)
+}
+
+// exactFit stores the raw estimate for the singular subset rows.
+func (m *mcd) exactFit(dst *mat.SymDense, rows []int) (mean []float64, support []int, ok bool) {
+	_, p := m.x.Dims()
+	mean = make([]float64, p)
+	m.meanCov(dst, mean, rows)
+	support = append([]int(nil), rows...)
+	sort.Ints(support)
+	return mean, support, false
+}
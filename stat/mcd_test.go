@@ -0,0 +1,136 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stat
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestCovarianceMatrixMCD(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		n, p     int
+		outliers int
+	}{
+		{n: 100, p: 2, outliers: 0},
+		{n: 200, p: 3, outliers: 40},
+		{n: 300, p: 5, outliers: 75},
+	} {
+		// Inliers are drawn from N(mu, sigma) with sigma = L*Lᵀ.
+		mu := make([]float64, test.p)
+		for j := range mu {
+			mu[j] = float64(j)
+		}
+		l := mat.NewTriDense(test.p, mat.Lower, nil)
+		for i := 0; i < test.p; i++ {
+			l.SetTri(i, i, 1+float64(i)/2)
+			for j := 0; j < i; j++ {
+				l.SetTri(i, j, 0.3)
+			}
+		}
+		var sigma mat.SymDense
+		sigma.SymOuterK(1, l)
+
+		x := mat.NewDense(test.n, test.p, nil)
+		z := mat.NewVecDense(test.p, nil)
+		var row mat.VecDense
+		for i := 0; i < test.n; i++ {
+			for j := 0; j < test.p; j++ {
+				z.SetVec(j, rnd.NormFloat64())
+			}
+			row.MulVec(l, z)
+			for j := 0; j < test.p; j++ {
+				v := row.AtVec(j) + mu[j]
+				if i < test.outliers {
+					// Outliers are shifted far from the bulk.
+					v += 15
+				}
+				x.Set(i, j, v)
+			}
+		}
+
+		var cov mat.SymDense
+		mean, support, ok := CovarianceMatrixMCD(&cov, x, 0, rand.NewSource(2))
+		if !ok {
+			t.Errorf("unexpected exact fit for n=%d p=%d", test.n, test.p)
+			continue
+		}
+		for _, i := range support {
+			if i < test.outliers {
+				t.Errorf("outlier %d in support for n=%d p=%d", i, test.n, test.p)
+				break
+			}
+		}
+		if len(support) < (test.n-test.outliers)*9/10 {
+			t.Errorf("unexpectedly small support for n=%d p=%d: got %d of %d inliers",
+				test.n, test.p, len(support), test.n-test.outliers)
+		}
+		for j, m := range mean {
+			if math.Abs(m-mu[j]) > 0.5 {
+				t.Errorf("unexpected mean for n=%d p=%d: got:%v want:%v", test.n, test.p, mean, mu)
+				break
+			}
+		}
+		var diff mat.Dense
+		diff.Sub(&cov, &sigma)
+		if mat.Norm(&diff, 2) > 0.5*mat.Norm(&sigma, 2) {
+			t.Errorf("unexpected covariance for n=%d p=%d:\ngot: %v\nwant:%v",
+				test.n, test.p, mat.Formatted(&cov), mat.Formatted(&sigma))
+		}
+
+		var chol mat.Cholesky
+		if !chol.Factorize(&cov) {
+			t.Errorf("robust covariance not positive definite for n=%d p=%d", test.n, test.p)
+		}
+	}
+}
+
+func TestCovarianceMatrixMCDExactFit(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	const n = 50
+	// Most points lie on the line y = 2x+1.
+	x := mat.NewDense(n, 2, nil)
+	for i := 0; i < n; i++ {
+		v := rnd.NormFloat64()
+		x.Set(i, 0, v)
+		x.Set(i, 1, 2*v+1)
+		if i < 10 {
+			x.Set(i, 1, rnd.NormFloat64())
+		}
+	}
+	var cov mat.SymDense
+	_, support, ok := CovarianceMatrixMCD(&cov, x, 0, rand.NewSource(1))
+	if ok {
+		t.Fatal("expected exact fit")
+	}
+	for _, i := range support {
+		if i < 10 {
+			t.Errorf("off-line point %d in exact fit support", i)
+		}
+	}
+	if det := mat.Det(&cov); math.Abs(det) > 1e-10 {
+		t.Errorf("exact fit covariance not singular: det=%v", det)
+	}
+}
+
+func TestCovarianceMatrixMCDPanics(t *testing.T) {
+	t.Parallel()
+	x := mat.NewDense(10, 3, nil)
+	for _, h := range []int{-1, 3, 11} {
+		if !panics(func() { CovarianceMatrixMCD(&mat.SymDense{}, x, h, nil) }) {
+			t.Errorf("expected panic for h=%d", h)
+		}
+	}
+	if !panics(func() { CovarianceMatrixMCD(mat.NewSymDense(2, nil), x, 0, nil) }) {
+		t.Errorf("expected panic for dst size mismatch")
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sampleuv
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+)
+
+// Alias provides sampling with replacement from a collection of items with
+// non-uniform probability in constant time per sample using Walker's alias
+// method. The alias table is constructed in linear time with the method of
+//
+//	Vose, M. D. "A linear algorithm for generating random numbers with a
+//	given distribution." IEEE Transactions on Software Engineering 17.9
+//	(1991)
+//
+// Alias is preferable to Weighted when many samples are drawn with
+// replacement from a fixed, large collection.
+type Alias struct {
+	// prob and alias hold the alias table. Index i is returned
+	// with probability prob[i] when column i is chosen, and
+	// alias[i] is returned otherwise.
+	prob  []float64
+	alias []int
+	rnd   *rand.Rand
+}
+
+// NewAlias returns an Alias for the weights w. If src is nil, rand.Rand is
+// used as the random number generator.
+//
+// NewAlias will panic if w is empty, if any weight is negative, infinite or
+// NaN, or if all weights are zero.
+func NewAlias(w []float64, src rand.Source) Alias {
+	n := len(w)
+	if n == 0 {
+		panic("sampleuv: no weights")
+	}
+	var sum float64
+	for _, v := range w {
+		if v < 0 || math.IsInf(v, 1) || math.IsNaN(v) {
+			panic("sampleuv: invalid weight")
+		}
+		sum += v
+	}
+	if sum == 0 {
+		panic("sampleuv: zero total weight")
+	}
+
+	s := Alias{
+		prob:  make([]float64, n),
+		alias: make([]int, n),
+	}
+	if src != nil {
+		s.rnd = rand.New(src)
+	}
+
+	// Scale the weights to have mean one and partition the
+	// items into those below and above the mean.
+	small := make([]int, 0, n)
+	large := make([]int, 0, n)
+	for i, v := range w {
+		s.prob[i] = v * float64(n) / sum
+		if s.prob[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+	// Fill each small column with probability mass from a
+	// large item, which becomes its alias.
+	for len(small) != 0 && len(large) != 0 {
+		l := small[len(small)-1]
+		small = small[:len(small)-1]
+		g := large[len(large)-1]
+		s.alias[l] = g
+		s.prob[g] -= 1 - s.prob[l]
+		if s.prob[g] < 1 {
+			large = large[:len(large)-1]
+			small = append(small, g)
+		}
+	}
+	// The remaining columns are full up to rounding error.
+	for _, i := range large {
+		s.prob[i] = 1
+		s.alias[i] = i
+	}
+	for _, i := range small {
+		s.prob[i] = 1
+		s.alias[i] = i
+	}
+	return s
+}
+
+// Len returns the number of items held by the Alias.
+func (s Alias) Len() int { return len(s.prob) }
+
+// Rand returns an index from the Alias with probability proportional to the
+// weight of the item.
+func (s Alias) Rand() int {
+	var r float64
+	if s.rnd == nil {
+		r = rand.Float64()
+	} else {
+		r = s.rnd.Float64()
+	}
+	r *= float64(len(s.prob))
+	i := int(r)
+	if i == len(s.prob) {
+		// Guard against rounding at the upper end.
+		i--
+	}
+	if r-float64(i) < s.prob[i] {
+		return i
+	}
+	return s.alias[i]
+}
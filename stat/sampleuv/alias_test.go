@@ -0,0 +1,81 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sampleuv
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestAlias(t *testing.T) {
+	t.Parallel()
+	const samples = 1e6
+	for _, w := range [][]float64{
+		{1},
+		{1, 1},
+		{1, 2, 3, 4},
+		{0, 5, 0, 1, 0},
+		{1 << 0, 1 << 1, 1 << 2, 1 << 3, 1 << 4, 1 << 5, 1 << 6, 1 << 7, 1 << 8, 1 << 9},
+		{1e-3, 1, 1e3},
+	} {
+		name := fmt.Sprint(w)
+		s := NewAlias(w, rand.NewSource(1))
+		if s.Len() != len(w) {
+			t.Errorf("%s: unexpected length: got %d, want %d", name, s.Len(), len(w))
+		}
+
+		// Check that the table reproduces the distribution exactly.
+		sum := floats.Sum(w)
+		table := make([]float64, len(w))
+		for i, p := range s.prob {
+			table[i] += p
+			table[s.alias[i]] += 1 - p
+		}
+		for i, v := range table {
+			want := w[i] / sum * float64(len(w))
+			if math.Abs(v-want) > 1e-12*float64(len(w)) {
+				t.Errorf("%s: unexpected table mass for item %d: got %v, want %v", name, i, v, want)
+			}
+		}
+
+		counts := make([]float64, len(w))
+		for i := 0; i < samples; i++ {
+			counts[s.Rand()]++
+		}
+		for i, c := range counts {
+			want := w[i] / sum * samples
+			if w[i] == 0 {
+				if c != 0 {
+					t.Errorf("%s: sampled zero-weight item %d", name, i)
+				}
+				continue
+			}
+			// Allow five standard deviations of the binomial count.
+			if d := math.Abs(c - want); d > 5*math.Sqrt(want)+1 {
+				t.Errorf("%s: unexpected count for item %d: got %v, want %v", name, i, c, want)
+			}
+		}
+	}
+
+	for _, w := range [][]float64{nil, {0, 0}, {1, -1}, {1, math.NaN()}, {math.Inf(1)}} {
+		if !panics(func() { NewAlias(w, nil) }) {
+			t.Errorf("expected panic for weights %v", w)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		r := recover()
+		panicked = r != nil
+	}()
+	fn()
+	return
+}
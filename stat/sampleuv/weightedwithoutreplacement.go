@@ -0,0 +1,98 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sampleuv
+
+import (
+	"container/heap"
+	"math"
+	"sort"
+
+	"golang.org/x/exp/rand"
+)
+
+// WeightedWithoutReplacement samples len(idxs) indices from [0, len(w))
+// without replacement with probability proportional to the weights w. That
+// is, upon return the elements of idxs will be unique indices, in the order
+// in which successive weighted draws removing each drawn item would have
+// returned them. Items with zero weight are never sampled. If src is non-nil
+// it will be used to generate random numbers, otherwise the default source
+// from the math/rand package will be used.
+//
+// WeightedWithoutReplacement uses the one-pass algorithm of
+//
+//	Efraimidis, P. S., and Spirakis, P. G. "Weighted random sampling with a
+//	reservoir." Information Processing Letters 97.5 (2006)
+//
+// which takes O(n log k) time for n weights and k samples. To repeatedly take
+// samples from a collection whose weights change, use Weighted instead.
+//
+// WeightedWithoutReplacement will panic if any weight is negative, infinite
+// or NaN, or if len(idxs) is larger than the number of positive weights.
+func WeightedWithoutReplacement(idxs []int, w []float64, src rand.Source) {
+	k := len(idxs)
+	if k == 0 {
+		return
+	}
+	var rnd func() float64
+	if src != nil {
+		rnd = rand.New(src).Float64
+	} else {
+		rnd = rand.Float64
+	}
+
+	// Each item is given the key u^(1/w) for u uniform on (0,1),
+	// computed as log(u)/w for numerical stability. The k items with
+	// the largest keys form the sample. The reservoir is a min-heap
+	// of keys so that the smallest retained key can be replaced.
+	res := make(keyedIndexes, 0, k)
+	for i, v := range w {
+		if v < 0 || math.IsInf(v, 1) || math.IsNaN(v) {
+			panic("sampleuv: invalid weight")
+		}
+		if v == 0 {
+			continue
+		}
+		u := rnd()
+		for u == 0 {
+			u = rnd()
+		}
+		key := math.Log(u) / v
+		if len(res) < k {
+			heap.Push(&res, keyedIndex{key: key, idx: i})
+			continue
+		}
+		if key > res[0].key {
+			res[0] = keyedIndex{key: key, idx: i}
+			heap.Fix(&res, 0)
+		}
+	}
+	if len(res) < k {
+		panic("sampleuv: too few positive weights")
+	}
+	sort.Sort(sort.Reverse(res))
+	for i, ki := range res {
+		idxs[i] = ki.idx
+	}
+}
+
+// keyedIndex is an index with its sampling key.
+type keyedIndex struct {
+	key float64
+	idx int
+}
+
+// keyedIndexes is a min-heap of keyed indexes.
+type keyedIndexes []keyedIndex
+
+func (h keyedIndexes) Len() int            { return len(h) }
+func (h keyedIndexes) Less(i, j int) bool  { return h[i].key < h[j].key }
+func (h keyedIndexes) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *keyedIndexes) Push(x interface{}) { *h = append(*h, x.(keyedIndex)) }
+func (h *keyedIndexes) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
@@ -0,0 +1,81 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sampleuv
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestWeightedWithoutReplacement(t *testing.T) {
+	t.Parallel()
+	const trials = 200000
+	rnd := rand.NewSource(1)
+	w := []float64{1, 2, 0, 3, 4}
+	sum := floats.Sum(w)
+
+	// The probability that item i is in a sample of size two drawn
+	// sequentially without replacement.
+	want := make([]float64, len(w))
+	for i, wi := range w {
+		want[i] = wi / sum
+		for j, wj := range w {
+			if j != i {
+				want[i] += wj / sum * wi / (sum - wj)
+			}
+		}
+	}
+
+	first := make([]float64, len(w))
+	incl := make([]float64, len(w))
+	idxs := make([]int, 2)
+	for n := 0; n < trials; n++ {
+		WeightedWithoutReplacement(idxs, w, rnd)
+		if idxs[0] == idxs[1] {
+			t.Fatalf("sample contains duplicates: %v", idxs)
+		}
+		first[idxs[0]]++
+		for _, i := range idxs {
+			incl[i]++
+		}
+	}
+	for i := range w {
+		if w[i] == 0 {
+			if incl[i] != 0 {
+				t.Errorf("sampled zero-weight item %d", i)
+			}
+			continue
+		}
+		// The first item is a single weighted draw.
+		if wantFirst := w[i] / sum * trials; math.Abs(first[i]-wantFirst) > 5*math.Sqrt(wantFirst) {
+			t.Errorf("unexpected first-draw count for item %d: got %v, want %v", i, first[i], wantFirst)
+		}
+		if wantIncl := want[i] * trials; math.Abs(incl[i]-wantIncl) > 5*math.Sqrt(wantIncl) {
+			t.Errorf("unexpected inclusion count for item %d: got %v, want %v", i, incl[i], wantIncl)
+		}
+	}
+
+	// Taking all positive-weight items returns each exactly once.
+	all := make([]int, 4)
+	WeightedWithoutReplacement(all, w, rnd)
+	seen := make(map[int]bool)
+	for _, i := range all {
+		if seen[i] || w[i] == 0 {
+			t.Errorf("unexpected sample of all items: %v", all)
+		}
+		seen[i] = true
+	}
+
+	if !panics(func() { WeightedWithoutReplacement(make([]int, 5), w, nil) }) {
+		t.Errorf("expected panic for too few positive weights")
+	}
+	if !panics(func() { WeightedWithoutReplacement(make([]int, 1), []float64{1, -1}, nil) }) {
+		t.Errorf("expected panic for negative weight")
+	}
+}
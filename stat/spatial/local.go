@@ -0,0 +1,217 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package spatial
+
+import (
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+)
+
+// LocalMoransI returns the Local Moran's I statistic, Anselin's local indicator
+// of spatial association (LISA), for element i of the data using the provided
+// locality matrix.
+//
+//	I_i = (z_i / m_2) \sum_j w_{ij} z_j
+//
+//	z_i = x_i - \bar X
+//	m_2 = (\sum_j z_j^2) / n
+//
+// The sum of I_i over all elements is equal to S_0 times the Global Moran's I
+// of the data, where S_0 is the sum of all elements of locality. Positive values
+// indicate that element i is surrounded by similar values and negative values
+// that it is a spatial outlier. LocalMoransI returns NaN if the data is constant.
+//
+// LocalMoransI will panic if locality is not a square matrix with dimensions the
+// same as the length of data or if i is not a valid index into data.
+//
+// See https://doi.org/10.1111/j.1538-4632.1995.tb00338.x.
+//
+// Weighted Local Moran's I is not currently implemented and LocalMoransI will
+// panic if weights is not nil.
+func LocalMoransI(i int, data, weights []float64, locality mat.Matrix) float64 {
+	if weights != nil {
+		panic("spatial: weighted data not yet implemented")
+	}
+	if r, c := locality.Dims(); r != len(data) || c != len(data) {
+		panic("spatial: data length mismatch")
+	}
+
+	mean := stat.Mean(data, nil)
+	var m2 float64
+	for _, v := range data {
+		v -= mean
+		m2 += v * v
+	}
+	m2 /= float64(len(data))
+
+	return (data[i] - mean) / m2 * spatialLag(i, data, mean, locality)
+}
+
+// spatialLag returns the locality weighted sum of the deviations from mean
+// of the neighbors of element i.
+func spatialLag(i int, data []float64, mean float64, locality mat.Matrix) float64 {
+	var lag float64
+	if doer, ok := locality.(mat.RowNonZeroDoer); ok {
+		doer.DoRowNonZero(i, func(_, j int, w float64) {
+			lag += w * (data[j] - mean)
+		})
+	} else {
+		for j, v := range data {
+			lag += locality.At(i, j) * (v - mean)
+		}
+	}
+	return lag
+}
+
+// Quadrant is the Moran scatterplot quadrant of an element of spatial data.
+type Quadrant int
+
+const (
+	// Unclassified indicates that the element or its spatial lag
+	// lies on the mean of the data.
+	Unclassified Quadrant = iota
+	// HighHigh indicates a high value surrounded by high values, a hot spot.
+	HighHigh
+	// LowLow indicates a low value surrounded by low values, a cold spot.
+	LowLow
+	// HighLow indicates a high value surrounded by low values.
+	HighLow
+	// LowHigh indicates a low value surrounded by high values.
+	LowHigh
+)
+
+func (q Quadrant) String() string {
+	switch q {
+	case Unclassified:
+		return "Unclassified"
+	case HighHigh:
+		return "HighHigh"
+	case LowLow:
+		return "LowLow"
+	case HighLow:
+		return "HighLow"
+	case LowHigh:
+		return "LowHigh"
+	default:
+		return "Quadrant(invalid)"
+	}
+}
+
+// MoranQuadrant returns the Moran scatterplot quadrant of element i of the data
+// using the provided locality matrix. The quadrant is determined by the signs of
+// the deviation of element i from the mean of the data and of its spatial lag.
+// Together with a significance test of LocalMoransI, the quadrant classifies
+// element i as a member of a cluster or as a spatial outlier.
+//
+// MoranQuadrant will panic if locality is not a square matrix with dimensions the
+// same as the length of data or if i is not a valid index into data.
+//
+// Weighted Moran quadrants are not currently implemented and MoranQuadrant will
+// panic if weights is not nil.
+func MoranQuadrant(i int, data, weights []float64, locality mat.Matrix) Quadrant {
+	if weights != nil {
+		panic("spatial: weighted data not yet implemented")
+	}
+	if r, c := locality.Dims(); r != len(data) || c != len(data) {
+		panic("spatial: data length mismatch")
+	}
+
+	mean := stat.Mean(data, nil)
+	z := data[i] - mean
+	lag := spatialLag(i, data, mean, locality)
+	switch {
+	case z > 0 && lag > 0:
+		return HighHigh
+	case z < 0 && lag < 0:
+		return LowLow
+	case z > 0 && lag < 0:
+		return HighLow
+	case z < 0 && lag > 0:
+		return LowHigh
+	default:
+		return Unclassified
+	}
+}
+
+// LocalStatistic is a local indicator of spatial association for element i
+// of the data. LocalMoransI and GetisOrdGStar are LocalStatistic functions.
+type LocalStatistic func(i int, data, weights []float64, locality mat.Matrix) float64
+
+// PermutationPValue returns a pseudo p-value for the local statistic of element i
+// of the data using conditional randomization. The value of element i is held
+// fixed while the remaining values are randomly permuted n times and the statistic
+// is recalculated for each permutation. The returned value is
+//
+//	p = (m + 1) / (n + 1)
+//
+// where m is the number of permutations producing a statistic at least as extreme
+// as the observed value in the direction of the observed value, that is the
+// smaller of the counts of permuted statistics greater than or equal to and less
+// than or equal to the observed statistic.
+//
+// The weights are permuted with the data. If src is nil, the global random
+// source is used.
+//
+// PermutationPValue will panic if n is not positive or if i is not a valid
+// index into data. Any panics from statistic are propagated.
+//
+// See https://doi.org/10.1111/j.1538-4632.1995.tb00338.x.
+func PermutationPValue(i int, data, weights []float64, locality mat.Matrix, statistic LocalStatistic, n int, src rand.Source) float64 {
+	if n <= 0 {
+		panic("spatial: non-positive permutation count")
+	}
+	if i < 0 || len(data) <= i {
+		panic("spatial: index out of range")
+	}
+	if weights != nil && len(weights) != len(data) {
+		panic("spatial: weights length mismatch")
+	}
+	shuffle := rand.Shuffle
+	if src != nil {
+		shuffle = rand.New(src).Shuffle
+	}
+
+	observed := statistic(i, data, weights, locality)
+
+	// Permute copies of the data with element i
+	// swapped to the end of the slice and
+	// excluded from the shuffle.
+	last := len(data) - 1
+	pd := make([]float64, len(data))
+	copy(pd, data)
+	pd[i], pd[last] = pd[last], pd[i]
+	var pw []float64
+	if weights != nil {
+		pw = make([]float64, len(weights))
+		copy(pw, weights)
+		pw[i], pw[last] = pw[last], pw[i]
+	}
+	swap := func(a, b int) {
+		pd[a], pd[b] = pd[b], pd[a]
+	}
+	if pw != nil {
+		swap = func(a, b int) {
+			pd[a], pd[b] = pd[b], pd[a]
+			pw[a], pw[b] = pw[b], pw[a]
+		}
+	}
+
+	var above, below int
+	for k := 0; k < n; k++ {
+		shuffle(last, swap)
+		swap(i, last)
+		s := statistic(i, pd, pw, locality)
+		swap(i, last)
+		if s >= observed {
+			above++
+		}
+		if s <= observed {
+			below++
+		}
+	}
+	return float64(min(above, below)+1) / float64(n+1)
+}
@@ -0,0 +1,126 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package spatial
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats/scalar"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestLocalMoransI(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-12
+	for ti, test := range spatialTests {
+		rnd := rand.New(rand.NewSource(1))
+		data := make([]float64, test.n)
+		step := (test.to - test.from) / float64(test.n)
+		for i := range data {
+			data[i] = test.fn(test.from+step*float64(i), i, rnd)
+		}
+		locality := test.locality(test.n, test.wide, false)
+
+		var sum float64
+		for i := range data {
+			sum += LocalMoransI(i, data, nil, locality)
+		}
+		var s0 float64
+		r, c := locality.Dims()
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				s0 += locality.At(i, j)
+			}
+		}
+		global, _, _ := GlobalMoransI(data, nil, locality)
+		if !scalar.EqualWithinAbsOrRel(sum, s0*global, tol, tol) {
+			t.Errorf("unexpected sum of Local Moran's I for test %d: got:%v want:%v", ti, sum, s0*global)
+		}
+	}
+}
+
+func TestMoranQuadrant(t *testing.T) {
+	t.Parallel()
+	data := []float64{4, 4, 4, 0, 0, 0, 4, 0, 0, 0}
+	locality := simpleAdjacency(len(data), 1, false)
+	want := []Quadrant{
+		HighHigh, HighHigh, HighHigh, LowHigh, LowLow,
+		LowHigh, HighLow, LowHigh, LowLow, LowLow,
+	}
+	for i := range data {
+		got := MoranQuadrant(i, data, nil, locality)
+		if got != want[i] {
+			t.Errorf("unexpected quadrant for element %d: got:%v want:%v", i, got, want[i])
+		}
+	}
+
+	constant := []float64{1, 1, 1, 1}
+	for i := range constant {
+		got := MoranQuadrant(i, constant, nil, simpleAdjacency(len(constant), 1, false))
+		if got != Unclassified {
+			t.Errorf("unexpected quadrant for constant element %d: got:%v want:%v", i, got, Unclassified)
+		}
+	}
+}
+
+func TestPermutationPValue(t *testing.T) {
+	t.Parallel()
+	const (
+		n     = 40
+		perms = 999
+	)
+	// A hot spot in the middle of otherwise low noisy data.
+	rnd := rand.New(rand.NewSource(1))
+	data := make([]float64, n)
+	for i := range data {
+		data[i] = rnd.Float64()
+		if 15 <= i && i < 25 {
+			data[i] += 10
+		}
+	}
+	for _, locality := range []mat.Matrix{
+		simpleAdjacency(n, 2, true),
+		simpleAdjacencyBand(n, 2, true),
+	} {
+		for _, test := range []struct {
+			name string
+			stat LocalStatistic
+		}{
+			{name: "LocalMoransI", stat: LocalMoransI},
+			{name: "GetisOrdGStar", stat: GetisOrdGStar},
+		} {
+			for i := range data {
+				p := PermutationPValue(i, data, nil, locality, test.stat, perms, rand.NewSource(uint64(i)))
+				if p <= 0 || 0.5+1.0/(perms+1) < p {
+					t.Errorf("p-value out of range for %s element %d: %v", test.name, i, p)
+				}
+				// The center of the hot spot is unambiguously significant.
+				if 17 <= i && i < 23 && p > 0.01 {
+					t.Errorf("unexpected non-significant p-value for %s element %d: %v", test.name, i, p)
+				}
+			}
+
+			// Permutation must not modify the input data.
+			orig := make([]float64, n)
+			copy(orig, data)
+			PermutationPValue(0, data, nil, locality, test.stat, 10, rand.NewSource(1))
+			for i := range data {
+				if data[i] != orig[i] {
+					t.Fatalf("PermutationPValue modified input data at %d", i)
+				}
+			}
+		}
+	}
+
+	// Equal sources produce equal p-values.
+	locality := simpleAdjacency(n, 2, true)
+	p1 := PermutationPValue(5, data, nil, locality, LocalMoransI, perms, rand.NewSource(7))
+	p2 := PermutationPValue(5, data, nil, locality, LocalMoransI, perms, rand.NewSource(7))
+	if p1 != p2 {
+		t.Errorf("p-values not reproducible: %v != %v", p1, p2)
+	}
+}
@@ -7,6 +7,8 @@ package spatial_test
 import (
 	"fmt"
 
+	"golang.org/x/exp/rand"
+
 	"gonum.org/v1/gonum/mat"
 	"gonum.org/v1/gonum/stat/spatial"
 )
@@ -140,3 +142,43 @@ func ExampleGetisOrdGStar_banded() {
 	// v=0 G*i=-0.2673
 	// v=0 G*i=-1.225
 }
+
+func ExampleLocalMoransI() {
+	data := []float64{2, 3, 2, 9, 8, 9, 3, 2, 3, 2}
+
+	// The locality here describes spatial neighbor
+	// relationships excluding self.
+	locality := mat.NewBandDense(10, 10, 1, 1, []float64{
+		0, 0, 1,
+		1, 0, 1,
+		1, 0, 1,
+		1, 0, 1,
+		1, 0, 1,
+		1, 0, 1,
+		1, 0, 1,
+		1, 0, 1,
+		1, 0, 1,
+		1, 0, 0,
+	})
+
+	src := rand.NewSource(1)
+	for i, v := range data {
+		li := spatial.LocalMoransI(i, data, nil, locality)
+		q := spatial.MoranQuadrant(i, data, nil, locality)
+		p := spatial.PermutationPValue(i, data, nil, locality, spatial.LocalMoransI, 999, src)
+		fmt.Printf("v=%v I_i=% .4f p=%.3f %v\n", v, li, p, q)
+	}
+
+	// Output:
+	//
+	// v=2 I_i= 0.3555 p=0.639 LowLow
+	// v=3 I_i= 0.7111 p=0.172 LowLow
+	// v=2 I_i=-0.9298 p=0.238 LowHigh
+	// v=9 I_i= 0.7824 p=0.398 HighHigh
+	// v=8 I_i= 4.1356 p=0.035 HighHigh
+	// v=9 I_i= 1.3413 p=0.305 HighHigh
+	// v=3 I_i=-0.3710 p=0.493 LowHigh
+	// v=2 I_i= 0.7111 p=0.442 LowLow
+	// v=3 I_i= 0.7111 p=0.166 LowLow
+	// v=2 I_i= 0.3555 p=0.654 LowLow
+}
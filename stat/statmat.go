@@ -17,14 +17,50 @@ import (
 //
 // If weights is not nil the weighted covariance of x is calculated. weights
 // must have length equal to the number of rows in input data matrix and
-// must not contain negative elements.
+// must not contain negative elements. The weights are interpreted as
+// frequency weights; see WeightedCovarianceMatrix for other interpretations.
 // The dst matrix must either be empty or have the same number of
 // columns as the input data matrix.
 func CovarianceMatrix(dst *mat.SymDense, x mat.Matrix, weights []float64) {
+	WeightedCovarianceMatrix(dst, x, weights, FrequencyWeights)
+}
+
+// WeightKind specifies how the weights of a weighted covariance are
+// interpreted.
+type WeightKind int
+
+const (
+	// FrequencyWeights are counts of the number of times each observation
+	// occurs. The weighted covariance is normalized by
+	//
+	//	sum_i {w_i} - 1
+	FrequencyWeights WeightKind = iota
+
+	// ReliabilityWeights measure the relative importance or precision of
+	// each observation and only their ratios are significant. The weighted
+	// covariance is normalized by
+	//
+	//	sum_i {w_i} - sum_i {w_i^2} / sum_i {w_i}
+	//
+	// which gives an unbiased estimate that does not change when all the
+	// weights are scaled by the same factor.
+	ReliabilityWeights
+)
+
+// WeightedCovarianceMatrix calculates the covariance matrix of the data in
+// x as CovarianceMatrix does, interpreting weights according to kind. The
+// result is stored in dst. If weights is nil, the result does not depend
+// on kind.
+//
+// WeightedCovarianceMatrix will panic if kind is not a valid WeightKind.
+func WeightedCovarianceMatrix(dst *mat.SymDense, x mat.Matrix, weights []float64, kind WeightKind) {
 	// This is the matrix version of the two-pass algorithm. It doesn't use the
 	// additional floating point error correction that the Covariance function uses
 	// to reduce the impact of rounding during centering.
 
+	if kind != FrequencyWeights && kind != ReliabilityWeights {
+		panic("stat: bad weight kind")
+	}
 	r, c := x.Dims()
 
 	if dst.IsEmpty() {
@@ -33,21 +69,12 @@ func CovarianceMatrix(dst *mat.SymDense, x mat.Matrix, weights []float64) {
 		panic(mat.ErrShape)
 	}
 
-	var xt mat.Dense
-	xt.CloneFrom(x.T())
-	// Subtract the mean of each of the columns.
-	for i := 0; i < c; i++ {
-		v := xt.RawRowView(i)
-		// This will panic with ErrShape if len(weights) != len(v), so
-		// we don't have to check the size later.
-		mean := Mean(v, weights)
-		floats.AddConst(-mean, v)
-	}
+	xt := centeredTranspose(x, weights)
 
 	if weights == nil {
 		// Calculate the normalization factor
 		// scaled by the sample size.
-		dst.SymOuterK(1/(float64(r)-1), &xt)
+		dst.SymOuterK(1/(float64(r)-1), xt)
 		return
 	}
 
@@ -67,7 +94,143 @@ func CovarianceMatrix(dst *mat.SymDense, x mat.Matrix, weights []float64) {
 
 	// Calculate the normalization factor
 	// scaled by the weighted sample size.
-	dst.SymOuterK(1/(floats.Sum(weights)-1), &xt)
+	sum := floats.Sum(weights)
+	norm := sum - 1
+	if kind == ReliabilityWeights {
+		norm = sum - floats.Dot(weights, weights)/sum
+	}
+	dst.SymOuterK(1/norm, xt)
+}
+
+// centeredTranspose returns the transpose of x with the weighted mean of
+// each column of x subtracted.
+func centeredTranspose(x mat.Matrix, weights []float64) *mat.Dense {
+	_, c := x.Dims()
+	var xt mat.Dense
+	xt.CloneFrom(x.T())
+	// Subtract the mean of each of the columns.
+	for i := 0; i < c; i++ {
+		v := xt.RawRowView(i)
+		// This will panic with ErrShape if len(weights) != len(v), so
+		// we don't have to check the size later.
+		mean := Mean(v, weights)
+		floats.AddConst(-mean, v)
+	}
+	return &xt
+}
+
+// CovarianceMatrixLedoitWolf calculates the Ledoit-Wolf shrinkage estimate
+// of the covariance matrix of the data in x, stores it in dst and returns
+// the shrinkage intensity s. The estimate is
+//
+//	(1-s) * S + s * μ * I
+//
+// where S is the maximum likelihood sample covariance, normalized by the
+// number of observations, μ = tr(S)/p and p is the number of columns of x.
+// The intensity s in [0, 1] asymptotically minimizes the expected squared
+// Frobenius distance of the estimate from the population covariance. Unlike
+// S, the estimate is well conditioned even when the number of variables
+// exceeds the number of observations.
+//
+// The dst matrix must either be empty or have the same number of columns
+// as x.
+//
+// See "A well-conditioned estimator for large-dimensional covariance
+// matrices", O. Ledoit and M. Wolf, J. Multivar. Anal. 88 (2004).
+func CovarianceMatrixLedoitWolf(dst *mat.SymDense, x mat.Matrix) (shrinkage float64) {
+	xt, mu := empiricalCovariance(dst, x)
+	r, c := x.Dims()
+	n := float64(r)
+	p := float64(c)
+
+	// The sum over observations of the fourth power of their norms.
+	var sum4 float64
+	for k := 0; k < r; k++ {
+		var sum2 float64
+		for i := 0; i < c; i++ {
+			v := xt.At(i, k)
+			sum2 += v * v
+		}
+		sum4 += sum2 * sum2
+	}
+	frob2 := frobeniusSq(dst)
+	beta := (sum4/n - frob2) / (p * n)
+	delta := (frob2 - p*mu*mu) / p
+	beta = math.Min(beta, delta)
+	if beta != 0 {
+		shrinkage = beta / delta
+	}
+	shrink(dst, shrinkage, mu)
+	return shrinkage
+}
+
+// CovarianceMatrixOAS calculates the oracle approximating shrinkage estimate
+// of the covariance matrix of the data in x, stores it in dst and returns
+// the shrinkage intensity s. The estimate has the form described for
+// CovarianceMatrixLedoitWolf, with the intensity chosen to approximate the
+// oracle that minimizes the expected squared Frobenius error for Gaussian
+// data. For Gaussian data with few observations the OAS estimate usually
+// has a smaller error than the Ledoit-Wolf estimate.
+//
+// The dst matrix must either be empty or have the same number of columns
+// as x.
+//
+// See "Shrinkage algorithms for MMSE covariance estimation", Y. Chen,
+// A. Wiesel, Y. C. Eldar and A. O. Hero, IEEE Trans. Signal Process. 58
+// (2010).
+func CovarianceMatrixOAS(dst *mat.SymDense, x mat.Matrix) (shrinkage float64) {
+	_, mu := empiricalCovariance(dst, x)
+	r, c := x.Dims()
+	n := float64(r)
+	p := float64(c)
+
+	alpha := frobeniusSq(dst) / (p * p)
+	num := alpha + mu*mu
+	den := (n + 1) * (alpha - mu*mu/p)
+	shrinkage = 1
+	if den != 0 {
+		shrinkage = math.Min(num/den, 1)
+	}
+	shrink(dst, shrinkage, mu)
+	return shrinkage
+}
+
+// empiricalCovariance stores the maximum likelihood sample covariance of the
+// data in x into dst and returns the centered transpose of x and the mean of
+// the diagonal of the covariance.
+func empiricalCovariance(dst *mat.SymDense, x mat.Matrix) (xt *mat.Dense, mu float64) {
+	r, c := x.Dims()
+	if dst.IsEmpty() {
+		*dst = *(dst.GrowSym(c).(*mat.SymDense))
+	} else if n := dst.SymmetricDim(); n != c {
+		panic(mat.ErrShape)
+	}
+	xt = centeredTranspose(x, nil)
+	dst.SymOuterK(1/float64(r), xt)
+	return xt, mat.Trace(dst) / float64(c)
+}
+
+// frobeniusSq returns the square of the Frobenius norm of s.
+func frobeniusSq(s *mat.SymDense) float64 {
+	n := s.SymmetricDim()
+	var sum float64
+	for i := 0; i < n; i++ {
+		v := s.At(i, i)
+		sum += v * v
+		for j := i + 1; j < n; j++ {
+			v := s.At(i, j)
+			sum += 2 * v * v
+		}
+	}
+	return sum
+}
+
+// shrink replaces s with (1-shrinkage)*s + shrinkage*mu*I.
+func shrink(s *mat.SymDense, shrinkage, mu float64) {
+	s.ScaleSym(1-shrinkage, s)
+	for i := 0; i < s.SymmetricDim(); i++ {
+		s.SetSym(i, i, s.At(i, i)+shrinkage*mu)
+	}
 }
 
 // CorrelationMatrix returns the correlation matrix calculated from a matrix
@@ -140,3 +303,50 @@ func Mahalanobis(x, y mat.Vector, chol *mat.Cholesky) float64 {
 	}
 	return math.Sqrt(mat.Dot(&tmp, &diff))
 }
+
+// MahalanobisRows computes the Mahalanobis distance of each row of x from
+// the column vector mu given the Cholesky decomposition of Σ, as described
+// for Mahalanobis, and stores the result in dst. If dst is nil, a new slice
+// is allocated and returned.
+//
+// MahalanobisRows panics if dst is not nil and its length is not equal to the
+// number of rows of x, or if the number of columns of x, the length of mu and
+// the size of Σ differ. Each element of dst is NaN if the linear solve fails.
+func MahalanobisRows(dst []float64, x mat.Matrix, mu mat.Vector, chol *mat.Cholesky) []float64 {
+	r, _ := x.Dims()
+	if dst == nil {
+		dst = make([]float64, r)
+	} else if len(dst) != r {
+		panic(mat.ErrShape)
+	}
+	mahalanobisSqRows(dst, x, mu, chol)
+	for i, v := range dst {
+		dst[i] = math.Sqrt(v)
+	}
+	return dst
+}
+
+// mahalanobisSqRows stores the squared Mahalanobis distance of each row of x
+// from mu into dst.
+func mahalanobisSqRows(dst []float64, x mat.Matrix, mu mat.Vector, chol *mat.Cholesky) {
+	r, c := x.Dims()
+	if mu.Len() != c || chol.SymmetricDim() != c {
+		panic(mat.ErrShape)
+	}
+	diff := mat.NewDense(c, r, nil)
+	diff.Copy(x.T())
+	for i := 0; i < c; i++ {
+		floats.AddConst(-mu.AtVec(i), diff.RawRowView(i))
+	}
+	var tmp mat.Dense
+	err := chol.SolveTo(&tmp, diff)
+	if err != nil {
+		for i := range dst {
+			dst[i] = math.NaN()
+		}
+		return
+	}
+	for j := range dst {
+		dst[j] = mat.Dot(diff.ColView(j), tmp.ColView(j))
+	}
+}
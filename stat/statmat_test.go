@@ -11,6 +11,7 @@ import (
 	"golang.org/x/exp/rand"
 
 	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/floats/scalar"
 	"gonum.org/v1/gonum/mat"
 )
 
@@ -108,6 +109,217 @@ func TestCovarianceMatrix(t *testing.T) {
 	}
 }
 
+func TestWeightedCovarianceMatrix(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	const r, c = 20, 4
+	x := mat.NewDense(r, c, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			x.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	weights := make([]float64, r)
+	for i := range weights {
+		weights[i] = float64(1 + rnd.Intn(4))
+	}
+
+	for _, kind := range []WeightKind{FrequencyWeights, ReliabilityWeights} {
+		var got mat.SymDense
+		WeightedCovarianceMatrix(&got, x, weights, kind)
+		want := naiveWeightedCov(x, weights, kind)
+		if !mat.EqualApprox(&got, want, tol) {
+			t.Errorf("kind %d: unexpected covariance:\ngot  %v\nwant %v", kind, mat.Formatted(&got), mat.Formatted(want))
+		}
+
+		// Without weights the kinds agree with CovarianceMatrix.
+		var unweighted, cov mat.SymDense
+		WeightedCovarianceMatrix(&unweighted, x, nil, kind)
+		CovarianceMatrix(&cov, x, nil)
+		if !mat.EqualApprox(&unweighted, &cov, tol) {
+			t.Errorf("kind %d: unweighted covariance does not match CovarianceMatrix", kind)
+		}
+	}
+
+	// Frequency weights are equivalent to repeating observations.
+	var rows []float64
+	for i, w := range weights {
+		for k := 0; k < int(w); k++ {
+			rows = append(rows, x.RawRowView(i)...)
+		}
+	}
+	var freq, repeated mat.SymDense
+	WeightedCovarianceMatrix(&freq, x, weights, FrequencyWeights)
+	CovarianceMatrix(&repeated, mat.NewDense(len(rows)/c, c, rows), nil)
+	if !mat.EqualApprox(&freq, &repeated, tol) {
+		t.Errorf("frequency weighted covariance does not match repeated observations")
+	}
+
+	// Reliability weights are invariant to scaling.
+	scaled := make([]float64, r)
+	floats.ScaleTo(scaled, 0.01, weights)
+	var rel, relScaled mat.SymDense
+	WeightedCovarianceMatrix(&rel, x, weights, ReliabilityWeights)
+	WeightedCovarianceMatrix(&relScaled, x, scaled, ReliabilityWeights)
+	if !mat.EqualApprox(&rel, &relScaled, tol) {
+		t.Errorf("reliability weighted covariance changed with weight scale")
+	}
+
+	if !panics(func() { WeightedCovarianceMatrix(&mat.SymDense{}, x, weights, 2) }) {
+		t.Errorf("WeightedCovarianceMatrix did not panic with bad weight kind")
+	}
+}
+
+// naiveWeightedCov returns the weighted covariance of the columns of x
+// computed directly from its definition.
+func naiveWeightedCov(x *mat.Dense, weights []float64, kind WeightKind) *mat.SymDense {
+	r, c := x.Dims()
+	var v1, v2 float64
+	for _, w := range weights {
+		v1 += w
+		v2 += w * w
+	}
+	norm := v1 - 1
+	if kind == ReliabilityWeights {
+		norm = v1 - v2/v1
+	}
+	mean := make([]float64, c)
+	for j := range mean {
+		for i := 0; i < r; i++ {
+			mean[j] += weights[i] * x.At(i, j)
+		}
+		mean[j] /= v1
+	}
+	cov := mat.NewSymDense(c, nil)
+	for j := 0; j < c; j++ {
+		for k := j; k < c; k++ {
+			var sum float64
+			for i := 0; i < r; i++ {
+				sum += weights[i] * (x.At(i, j) - mean[j]) * (x.At(i, k) - mean[k])
+			}
+			cov.SetSym(j, k, sum/norm)
+		}
+	}
+	return cov
+}
+
+func TestCovarianceMatrixShrinkage(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		r, c int
+	}{
+		{r: 50, c: 3},
+		{r: 10, c: 10},
+		{r: 5, c: 20},
+	} {
+		x := mat.NewDense(test.r, test.c, nil)
+		for i := 0; i < test.r; i++ {
+			for j := 0; j < test.c; j++ {
+				// Give the variables different scales so that the
+				// sample covariance is far from the target.
+				x.Set(i, j, float64(j+1)*rnd.NormFloat64())
+			}
+		}
+		for _, method := range []struct {
+			name      string
+			estimate  func(*mat.SymDense, mat.Matrix) float64
+			shrinkage func(s *mat.SymDense, n int) float64
+		}{
+			{name: "LedoitWolf", estimate: CovarianceMatrixLedoitWolf, shrinkage: naiveLedoitWolf(x)},
+			{name: "OAS", estimate: CovarianceMatrixOAS, shrinkage: naiveOAS},
+		} {
+			var got mat.SymDense
+			s := method.estimate(&got, x)
+
+			var emp mat.SymDense
+			CovarianceMatrix(&emp, x, nil)
+			emp.ScaleSym(float64(test.r-1)/float64(test.r), &emp)
+			wantS := method.shrinkage(&emp, test.r)
+			if math.Abs(s-wantS) > tol {
+				t.Errorf("%s r=%d c=%d: unexpected shrinkage: got %v, want %v", method.name, test.r, test.c, s, wantS)
+			}
+			if s < 0 || s > 1 {
+				t.Errorf("%s r=%d c=%d: shrinkage %v out of range", method.name, test.r, test.c, s)
+			}
+			mu := mat.Trace(&emp) / float64(test.c)
+			want := mat.NewSymDense(test.c, nil)
+			want.ScaleSym(1-s, &emp)
+			for i := 0; i < test.c; i++ {
+				want.SetSym(i, i, want.At(i, i)+s*mu)
+			}
+			if !mat.EqualApprox(&got, want, tol) {
+				t.Errorf("%s r=%d c=%d: unexpected estimate", method.name, test.r, test.c)
+			}
+
+			// The shrunk estimate is positive definite even when the
+			// sample covariance is singular.
+			var chol mat.Cholesky
+			if !chol.Factorize(&got) {
+				t.Errorf("%s r=%d c=%d: estimate not positive definite", method.name, test.r, test.c)
+			}
+		}
+	}
+
+	if !panics(func() { CovarianceMatrixLedoitWolf(mat.NewSymDense(1, nil), mat.NewDense(5, 2, nil)) }) {
+		t.Errorf("CovarianceMatrixLedoitWolf did not panic with preallocation size mismatch")
+	}
+	if !panics(func() { CovarianceMatrixOAS(mat.NewSymDense(1, nil), mat.NewDense(5, 2, nil)) }) {
+		t.Errorf("CovarianceMatrixOAS did not panic with preallocation size mismatch")
+	}
+}
+
+// naiveLedoitWolf returns a function computing the Ledoit-Wolf shrinkage
+// intensity for the data in x from its definition in terms of the maximum
+// likelihood covariance s.
+func naiveLedoitWolf(x *mat.Dense) func(s *mat.SymDense, n int) float64 {
+	return func(s *mat.SymDense, n int) float64 {
+		p := s.SymmetricDim()
+		mu := mat.Trace(s) / float64(p)
+		means := make([]float64, p)
+		for j := range means {
+			means[j] = floats.Sum(mat.Col(nil, j, x)) / float64(n)
+		}
+		// d² = |S - μI|²_F / p.
+		var d2 float64
+		for i := 0; i < p; i++ {
+			for j := 0; j < p; j++ {
+				v := s.At(i, j)
+				if i == j {
+					v -= mu
+				}
+				d2 += v * v
+			}
+		}
+		d2 /= float64(p)
+		// b̄² = 1/n² Σ_k |x_k x_kᵀ - S|²_F / p.
+		var b2 float64
+		for k := 0; k < n; k++ {
+			for i := 0; i < p; i++ {
+				for j := 0; j < p; j++ {
+					v := (x.At(k, i)-means[i])*(x.At(k, j)-means[j]) - s.At(i, j)
+					b2 += v * v
+				}
+			}
+		}
+		b2 /= float64(n) * float64(n) * float64(p)
+		return math.Min(b2, d2) / d2
+	}
+}
+
+// naiveOAS returns the OAS shrinkage intensity computed from the maximum
+// likelihood covariance s of n observations.
+func naiveOAS(s *mat.SymDense, n int) float64 {
+	p := float64(s.SymmetricDim())
+	var ss mat.Dense
+	ss.Mul(s, s)
+	trS2 := mat.Trace(&ss)
+	tr2S := mat.Trace(s) * mat.Trace(s)
+	num := trS2/(p*p) + tr2S/(p*p)
+	den := (float64(n) + 1) * (trS2/(p*p) - tr2S/(p*p*p))
+	return math.Min(num/den, 1)
+}
+
 func TestCorrelationMatrix(t *testing.T) {
 	for i, test := range []struct {
 		data    *mat.Dense
@@ -303,6 +515,40 @@ func TestMahalanobis(t *testing.T) {
 	}
 }
 
+func TestMahalanobisRows(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	const r, c = 20, 4
+	x := mat.NewDense(r, c, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			x.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	mu := mat.NewVecDense(c, []float64{0.5, -1, 2, 0})
+	var sigma mat.SymDense
+	CovarianceMatrix(&sigma, x, nil)
+	var chol mat.Cholesky
+	if !chol.Factorize(&sigma) {
+		t.Fatal("bad test")
+	}
+
+	got := MahalanobisRows(nil, x, mu, &chol)
+	for i, d := range got {
+		want := Mahalanobis(x.RowView(i), mu, &chol)
+		if !scalar.EqualWithinAbsOrRel(d, want, 1e-12, 1e-12) {
+			t.Errorf("unexpected distance for row %d: got:%v want:%v", i, d, want)
+		}
+	}
+
+	if !panics(func() { MahalanobisRows(make([]float64, r-1), x, mu, &chol) }) {
+		t.Errorf("MahalanobisRows did not panic with dst size mismatch")
+	}
+	if !panics(func() { MahalanobisRows(nil, x, mat.NewVecDense(c+1, nil), &chol) }) {
+		t.Errorf("MahalanobisRows did not panic with mu size mismatch")
+	}
+}
+
 // benchmarks
 
 func randMat(r, c int) mat.Matrix {
@@ -0,0 +1,174 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// The unitgen program generates statically typed dimensional quantities
+// for derived dimensions that are not provided by the unit package. The
+// generated types have the same API as the unit package's own types:
+// a Unit method, a From method, a self-returning interface method and
+// a Format method that prints the value with its units.
+//
+// The types are described by a JSON configuration file, for example
+//
+//	{
+//		"units": [
+//			{
+//				"type": "SpectralIrradiance",
+//				"receiver": "e",
+//				"symbol": "W m^-3",
+//				"comment": "SpectralIrradiance represents a spectral irradiance in watts per cubic metre",
+//				"dimensions": {"kg": 1, "m": -1, "s": -3}
+//			},
+//			{
+//				"type": "Jerk",
+//				"dimensions": {"m": 1, "s": -3}
+//			}
+//		]
+//	}
+//
+// The dimensions are given by the symbols of the base dimensions of the
+// unit package: A, m, cd, kg, mol, K, s and rad. Optional fields are
+// "receiver", the name of the method receiver, "symbol", the units
+// printed by the Format method, "comment", the type's documentation,
+// "constant", the name of a constant of the type with a value of one,
+// "constants", a list of additional constants given as objects with
+// "name" and "value" fields where the value is a Go constant expression
+// in the output package, and "interface", the name of the interface
+// satisfied by the self-returning method. The constants list may only be
+// given when a constant is named.
+//
+// unitgen is intended to be called by go generate, for example
+//
+//	//go:generate go run gonum.org/v1/gonum/unit/cmd/unitgen -config units.json
+//
+// and writes one file for each type, and optionally a test file, in the
+// output directory.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"gonum.org/v1/gonum/unit/internal/unitgen"
+)
+
+// config is the JSON configuration for unitgen.
+type config struct {
+	Units []struct {
+		Type       string         `json:"type"`
+		Receiver   string         `json:"receiver"`
+		Symbol     string         `json:"symbol"`
+		Comment    string         `json:"comment"`
+		Constant   string         `json:"constant"`
+		Interface  string         `json:"interface"`
+		Dimensions map[string]int `json:"dimensions"`
+		Constants  []struct {
+			Name  string `json:"name"`
+			Value string `json:"value"`
+		} `json:"constants"`
+	} `json:"units"`
+}
+
+func main() {
+	path := flag.String("config", "", "specify the JSON configuration file (required)")
+	pkg := flag.String("package", os.Getenv("GOPACKAGE"), "specify the name of the output package")
+	out := flag.String("out", ".", "specify the output directory")
+	tests := flag.Bool("tests", false, "generate tests for the types")
+	flag.Parse()
+	if *path == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *pkg == "" {
+		log.Fatal("unitgen: missing package name")
+	}
+
+	b, err := os.ReadFile(*path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	units, err := parseConfig(b)
+	if err != nil {
+		log.Fatalf("unitgen: invalid configuration %s: %v", *path, err)
+	}
+
+	target := unitgen.Target{Package: *pkg, External: true}
+	for _, u := range units {
+		write(filepath.Join(*out, unitgen.FileName(u)), unitgen.Source, u, target)
+		if *tests {
+			write(filepath.Join(*out, unitgen.TestFileName(u)), unitgen.TestSource, u, target)
+		}
+	}
+}
+
+// parseConfig returns the units described by the JSON configuration in b.
+func parseConfig(b []byte) ([]unitgen.Unit, error) {
+	var cfg config
+	err := json.Unmarshal(b, &cfg)
+	if err != nil {
+		return nil, err
+	}
+	units := make([]unitgen.Unit, 0, len(cfg.Units))
+	seen := make(map[string]bool)
+	for _, c := range cfg.Units {
+		if seen[c.Type] {
+			return nil, fmt.Errorf("repeated type %q", c.Type)
+		}
+		seen[c.Type] = true
+
+		u := unitgen.Unit{
+			DimensionName: c.Type,
+			Receiver:      c.Receiver,
+			PrintString:   c.Symbol,
+			Name:          c.Constant,
+			TypeComment:   strings.TrimSuffix(c.Comment, "."),
+			ErForm:        c.Interface,
+		}
+		if u.Receiver == "" {
+			r, _ := utf8.DecodeRuneInString(c.Type)
+			u.Receiver = string(unicode.ToLower(r))
+		}
+		for sym, pow := range c.Dimensions {
+			name, ok := unitgen.DimensionNamed(sym)
+			if !ok {
+				return nil, fmt.Errorf("unknown dimension %q for %s", sym, c.Type)
+			}
+			u.Dimensions = append(u.Dimensions, unitgen.Dimension{Name: name, Power: pow})
+		}
+		unitgen.SortDimensions(u.Dimensions)
+		if u.PrintString == "" {
+			u.PrintString = u.Units()
+		}
+		if u.TypeComment == "" {
+			u.TypeComment = fmt.Sprintf("%s represents a quantity in %s", c.Type, u.PrintString)
+		}
+		if len(c.Constants) != 0 && u.Name == "" {
+			return nil, fmt.Errorf("constants without unit constant for %s", c.Type)
+		}
+		for _, k := range c.Constants {
+			u.ExtraConstant = append(u.ExtraConstant, unitgen.Constant{Name: k.Name, Value: k.Value})
+		}
+		units = append(units, u)
+	}
+	sort.Slice(units, func(i, j int) bool { return units[i].DimensionName < units[j].DimensionName })
+	return units, nil
+}
+
+func write(filename string, source func(unitgen.Unit, unitgen.Target) ([]byte, error), u unitgen.Unit, target unitgen.Target) {
+	b, err := source(u, target)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = os.WriteFile(filename, b, 0o664)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
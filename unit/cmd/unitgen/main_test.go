@@ -0,0 +1,75 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"gonum.org/v1/gonum/unit/internal/unitgen"
+)
+
+func TestParseConfig(t *testing.T) {
+	t.Parallel()
+	got, err := parseConfig([]byte(`{
+	"units": [
+		{
+			"type": "SpectralIrradiance",
+			"receiver": "e",
+			"symbol": "W m^-3",
+			"comment": "SpectralIrradiance represents a spectral irradiance in watts per cubic metre.",
+			"dimensions": {"s": -3, "kg": 1, "m": -1}
+		},
+		{
+			"type": "Jerk",
+			"constant": "MetrePerSecondCubed",
+			"constants": [{"name": "StandardJerk", "value": "9.80665 * MetrePerSecondCubed"}],
+			"dimensions": {"m": 1, "s": -3}
+		}
+	]
+}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []unitgen.Unit{
+		{
+			DimensionName: "Jerk",
+			Receiver:      "j",
+			PrintString:   "m s^-3",
+			Name:          "MetrePerSecondCubed",
+			TypeComment:   "Jerk represents a quantity in m s^-3",
+			ExtraConstant: []unitgen.Constant{{Name: "StandardJerk", Value: "9.80665 * MetrePerSecondCubed"}},
+			Dimensions: []unitgen.Dimension{
+				{Name: unitgen.LengthName, Power: 1},
+				{Name: unitgen.TimeName, Power: -3},
+			},
+		},
+		{
+			DimensionName: "SpectralIrradiance",
+			Receiver:      "e",
+			PrintString:   "W m^-3",
+			TypeComment:   "SpectralIrradiance represents a spectral irradiance in watts per cubic metre",
+			Dimensions: []unitgen.Dimension{
+				{Name: unitgen.LengthName, Power: -1},
+				{Name: unitgen.MassName, Power: 1},
+				{Name: unitgen.TimeName, Power: -3},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected units:\ngot: %#v\nwant:%#v", got, want)
+	}
+
+	for _, in := range []string{
+		`{"units": [{"type": "Jerk", "dimensions": {"ft": 1}}]}`,
+		`{"units": [{"type": "Jerk"}, {"type": "Jerk"}]}`,
+		`{"units": [{"type": "Jerk", "constants": [{"name": "A", "value": "1"}]}]}`,
+		`{"units": [`,
+	} {
+		if _, err := parseConfig([]byte(in)); err == nil {
+			t.Errorf("expected error for %s", in)
+		}
+	}
+}
@@ -84,6 +84,23 @@
 //	var energy unit.Energy
 //	err := energy.From(acc)
 //
+// The Value type is an immutable alternative to Unit whose arithmetic
+// methods return errors rather than panicking on dimensional mismatch.
+// Values can be parsed from text with ParseValue, allowing quantities
+// read from configuration files or user input to be checked.
+//
+//	g, err := unit.ParseValue("9.81 m/s^2")
+//	...
+//	w := g.Mul(70 * unit.Kilogram)
+//
+// Constants are provided for common imperial, US customary and CGS units,
+// such as Foot, Pound, PoundPerSquareInch and Erg, and ParseValue recognizes
+// their symbols. Further named units can be added with RegisterUnit.
+//
+//	err := unit.RegisterUnit("ftm", 6*unit.Foot)
+//	...
+//	d, err := unit.Convert(20, "ftm", "m")
+//
 // Domain-specific problems may need custom dimensions, and for this purpose
 // NewDimension should be used to help avoid accidental overlap between
 // packages. For example, results from a blood test may be measured in
@@ -8,73 +8,29 @@
 package main
 
 import (
-	"bytes"
-	"go/format"
 	"log"
 	"os"
-	"strings"
-	"text/template"
 
-	"gonum.org/v1/gonum/unit"
+	"gonum.org/v1/gonum/unit/internal/unitgen"
 )
 
-type Unit struct {
-	DimensionName string
-	Receiver      string
-	PowerOffset   int    // from normal (for example, mass base unit is kg, not g)
-	PrintString   string // print string for the unit (kg for mass)
-	ExtraConstant []Constant
-	Name          string
-	TypeComment   string // text to comment the type
-	Dimensions    []Dimension
-	ErForm        string // for Xxxer interface
-}
-
-type Dimension struct {
-	Name  string
-	Power int
-}
-
-func (u Unit) Units() string {
-	dims := make(unit.Dimensions)
-	for _, d := range u.Dimensions {
-		dims[dimOf[d.Name]] = d.Power
-	}
-	return dims.String()
-}
+type (
+	Unit      = unitgen.Unit
+	Dimension = unitgen.Dimension
+	Constant  = unitgen.Constant
+)
 
 const (
-	AngleName             string = "AngleDim"
-	CurrentName           string = "CurrentDim"
-	LengthName            string = "LengthDim"
-	LuminousIntensityName string = "LuminousIntensityDim"
-	MassName              string = "MassDim"
-	MoleName              string = "MoleDim"
-	TemperatureName       string = "TemperatureDim"
-	TimeName              string = "TimeDim"
+	AngleName             = unitgen.AngleName
+	CurrentName           = unitgen.CurrentName
+	LengthName            = unitgen.LengthName
+	LuminousIntensityName = unitgen.LuminousIntensityName
+	MassName              = unitgen.MassName
+	MoleName              = unitgen.MoleName
+	TemperatureName       = unitgen.TemperatureName
+	TimeName              = unitgen.TimeName
 )
 
-var dimOf = map[string]unit.Dimension{
-	"AngleDim":             unit.AngleDim,
-	"CurrentDim":           unit.CurrentDim,
-	"LengthDim":            unit.LengthDim,
-	"LuminousIntensityDim": unit.LuminousIntensityDim,
-	"MassDim":              unit.MassDim,
-	"MoleDim":              unit.MoleDim,
-	"TemperatureDim":       unit.TemperatureDim,
-	"TimeDim":              unit.TimeDim,
-}
-
-type Constant struct {
-	Name  string
-	Value string
-}
-
-type Prefix struct {
-	Name  string
-	Power int
-}
-
 var Units = []Unit{
 	// Base units.
 	{
@@ -431,236 +387,23 @@ var Units = []Unit{
 
 // Generate generates a file for each of the units
 func main() {
+	target := unitgen.Target{Package: "unit"}
 	for _, unit := range Units {
-		generate(unit)
-		generateTest(unit)
+		generate(unitgen.FileName(unit), unitgen.Source, unit, target)
+		generate(unitgen.TestFileName(unit), unitgen.TestSource, unit, target)
 	}
 }
 
-const headerTemplate = `// Code generated by "go generate gonum.org/v1/gonum/unit”; DO NOT EDIT.
-
-// Copyright ©2014 The Gonum Authors. All rights reserved.
-// Use of this source code is governed by a BSD-style
-// license that can be found in the LICENSE file.
-
-package unit
-
-import (
-	"errors"
-	"fmt"
-	"math"
-	{{if .PrintString}}"unicode/utf8"{{end}}
-)
-
-// {{.TypeComment}}.
-type {{.DimensionName}} float64
-`
-
-var header = template.Must(template.New("header").Parse(headerTemplate))
-
-const constTemplate = `
-const {{if .ExtraConstant}}({{end}}
-	{{.Name}} {{.DimensionName}} = {{if .PowerOffset}} 1e{{.PowerOffset}} {{else}} 1 {{end}}
-	{{$name := .Name}}
-	{{range .ExtraConstant}} {{.Name}} = {{.Value}}
-	{{end}}
-{{if .ExtraConstant}}){{end}}
-`
-
-var prefix = template.Must(template.New("prefix").Parse(constTemplate))
-
-const methodTemplate = `
-// Unit converts the {{.DimensionName}} to a *Unit.
-func ({{.Receiver}} {{.DimensionName}}) Unit() *Unit {
-	return New(float64({{.Receiver}}), Dimensions{
-		{{range .Dimensions}} {{.Name}}: {{.Power}},
-		{{end}}
-		})
-}
-
-// {{.DimensionName}} allows {{.DimensionName}} to implement a {{if .ErForm}}{{.ErForm}}{{else}}{{.DimensionName}}er{{end}} interface.
-func ({{.Receiver}} {{.DimensionName}}) {{.DimensionName}}() {{.DimensionName}} {
-	return {{.Receiver}}
-}
-
-// From converts the unit into the receiver. From returns an
-// error if there is a mismatch in dimension.
-func ({{.Receiver}} *{{.DimensionName}}) From(u Uniter) error {
-	if !DimensionsMatch(u, {{if .Name}}{{.Name}}{{else}}{{.DimensionName}}(0){{end}}){
-		*{{.Receiver}} = {{.DimensionName}}(math.NaN())
-		return errors.New("unit: dimension mismatch")
-	}
-	*{{.Receiver}} = {{.DimensionName}}(u.Unit().Value())
-	return nil
-}
-`
-
-var methods = template.Must(template.New("methods").Parse(methodTemplate))
-
-const formatTemplate = `
-func ({{.Receiver}} {{.DimensionName}}) Format(fs fmt.State, c rune) {
-	switch c {
-	case 'v':
-		if fs.Flag('#') {
-			fmt.Fprintf(fs, "%T(%v)", {{.Receiver}}, float64({{.Receiver}}))
-			return
-		}
-		fallthrough
-	case 'e', 'E', 'f', 'F', 'g', 'G':
-		p, pOk := fs.Precision()
-		w, wOk := fs.Width()
-		{{if .PrintString}}const unit = " {{.PrintString}}"
-		switch {
-		case pOk && wOk:
-			fmt.Fprintf(fs, "%*.*"+string(c), pos(w-utf8.RuneCount([]byte(unit))), p, float64({{.Receiver}}))
-		case pOk:
-			fmt.Fprintf(fs, "%.*"+string(c), p, float64({{.Receiver}}))
-		case wOk:
-			fmt.Fprintf(fs, "%*"+string(c), pos(w-utf8.RuneCount([]byte(unit))), float64({{.Receiver}}))
-		default:
-			fmt.Fprintf(fs, "%"+string(c), float64({{.Receiver}}))
-		}
-		fmt.Fprint(fs, unit)
-	default:
-		fmt.Fprintf(fs, "%%!%c(%T=%g {{.PrintString}})", c, {{.Receiver}}, float64({{.Receiver}})) {{else}} switch {
-		case pOk && wOk:
-			fmt.Fprintf(fs, "%*.*"+string(c), w, p, float64({{.Receiver}}))
-		case pOk:
-			fmt.Fprintf(fs, "%.*"+string(c), p, float64({{.Receiver}}))
-		case wOk:
-			fmt.Fprintf(fs, "%*"+string(c), w, float64({{.Receiver}}))
-		default:
-			fmt.Fprintf(fs, "%"+string(c), float64({{.Receiver}}))
-		}
-	default:
-		fmt.Fprintf(fs, "%%!%c(%T=%g)", c, {{.Receiver}}, float64({{.Receiver}})) {{end}}
-	}
-}
-`
-
-var form = template.Must(template.New("format").Parse(formatTemplate))
-
-func generate(unit Unit) {
-	lowerName := strings.ToLower(unit.DimensionName)
-	filename := lowerName + ".go"
-	f, err := os.Create(filename)
-	if err != nil {
-		log.Fatal(err)
-	}
-	defer f.Close()
-
-	var buf bytes.Buffer
-
-	err = header.Execute(&buf, unit)
+func generate(filename string, source func(unitgen.Unit, unitgen.Target) ([]byte, error), unit Unit, target unitgen.Target) {
+	b, err := source(unit, target)
 	if err != nil {
-		log.Fatal(err)
-	}
-
-	if unit.Name != "" {
-		err = prefix.Execute(&buf, unit)
-		if err != nil {
-			log.Fatal(err)
+		if b != nil {
+			os.WriteFile(filename, b, 0o664) // This is here to debug bad format.
 		}
-	}
-
-	err = methods.Execute(&buf, unit)
-	if err != nil {
 		log.Fatal(err)
 	}
-
-	err = form.Execute(&buf, unit)
+	err = os.WriteFile(filename, b, 0o664)
 	if err != nil {
 		log.Fatal(err)
 	}
-
-	b, err := format.Source(buf.Bytes())
-	if err != nil {
-		f.Write(buf.Bytes()) // This is here to debug bad format
-		log.Fatalf("error formatting %q: %s", unit.DimensionName, err)
-	}
-
-	f.Write(b)
-}
-
-const testTemplate = `// Code generated by "go generate gonum.org/v1/gonum/unit; DO NOT EDIT.
-
-// Copyright ©2019 The Gonum Authors. All rights reserved.
-// Use of this source code is governed by a BSD-style
-// license that can be found in the LICENSE file.
-
-package unit
-
-import (
-	"fmt"
-	"testing"
-)
-
-func Test{{.DimensionName}}(t *testing.T) {
-	t.Parallel()
-	for _, value := range []float64{-1, 0, 1} {
-		var got {{.DimensionName}}
-		err := got.From({{.DimensionName}}(value).Unit())
-		if err != nil {
-			t.Errorf("unexpected error for %T conversion: %v", got, err)
-		}
-		if got != {{.DimensionName}}(value) {
-			t.Errorf("unexpected result from round trip of %T(%v): got: %v want: %v", got, value, got, value)
-		}
-		if got != got.{{.DimensionName}}() {
-			t.Errorf("unexpected result from self interface method call: got: %#v want: %#v", got, value)
-		}
-		err = got.From(ether(1))
-		if err == nil {
-			t.Errorf("expected error for ether to %T conversion", got)
-		}
-	}
-}
-
-func Test{{.DimensionName}}Format(t *testing.T) {
-	t.Parallel()
-	for _, test := range []struct{
-		value  {{.DimensionName}}
-		format string
-		want   string
-	}{
-		{1.23456789, "%v", "1.23456789{{with .PrintString}} {{.}}{{end}}"},
-		{1.23456789, "%.1v", "1{{with .PrintString}} {{.}}{{end}}"},
-		{1.23456789, "%20.1v", "{{if .PrintString}}{{$s := printf "1 %s" .PrintString}}{{printf "%20s" $s}}{{else}}{{printf "%20s" "1"}}{{end}}"},
-		{1.23456789, "%20v", "{{if .PrintString}}{{$s := printf "1.23456789 %s" .PrintString}}{{printf "%20s" $s}}{{else}}{{printf "%20s" "1.23456789"}}{{end}}"},
-		{1.23456789, "%1v", "1.23456789{{with .PrintString}} {{.}}{{end}}"},
-		{1.23456789, "%#v", "unit.{{.DimensionName}}(1.23456789)"},
-		{1.23456789, "%s", "%!s(unit.{{.DimensionName}}=1.23456789{{with .PrintString}} {{.}}{{end}})"},
-	} {
-		got := fmt.Sprintf(test.format, test.value)
-		if got != test.want {
-			t.Errorf("Format %q %v: got: %q want: %q", test.format, test.value, got, test.want)
-		}
-	}
-}
-`
-
-var tests = template.Must(template.New("test").Parse(testTemplate))
-
-func generateTest(unit Unit) {
-	lowerName := strings.ToLower(unit.DimensionName)
-	filename := lowerName + "_test.go"
-	f, err := os.Create(filename)
-	if err != nil {
-		log.Fatal(err)
-	}
-	defer f.Close()
-
-	var buf bytes.Buffer
-	err = tests.Execute(&buf, unit)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	b, err := format.Source(buf.Bytes())
-	if err != nil {
-		f.Write(buf.Bytes()) // This is here to debug bad format.
-		log.Fatalf("error formatting test for %q: %s", unit.DimensionName, err)
-	}
-
-	f.Write(b)
 }
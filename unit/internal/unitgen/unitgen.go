@@ -0,0 +1,414 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package unitgen generates the source for statically typed dimensional
+// quantities. It is used to generate the types of the unit package and,
+// through the unitgen command, types for user-defined derived dimensions
+// in other packages.
+package unitgen // import "gonum.org/v1/gonum/unit/internal/unitgen"
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"sort"
+	"strings"
+	"text/template"
+
+	"gonum.org/v1/gonum/unit"
+)
+
+// Unit describes a dimensional type to generate.
+type Unit struct {
+	DimensionName string
+	Receiver      string
+	PowerOffset   int    // from normal (for example, mass base unit is kg, not g)
+	PrintString   string // print string for the unit (kg for mass)
+	ExtraConstant []Constant
+	Name          string
+	TypeComment   string // text to comment the type
+	Dimensions    []Dimension
+	ErForm        string // for Xxxer interface
+}
+
+// Dimension is a named base dimension and its power.
+type Dimension struct {
+	Name  string
+	Power int
+}
+
+// Constant is an additional named constant of a generated type.
+type Constant struct {
+	Name  string
+	Value string
+}
+
+// Units returns the string representation of the unit's dimensions.
+func (u Unit) Units() string {
+	dims := make(unit.Dimensions)
+	for _, d := range u.Dimensions {
+		dims[dimOf[d.Name]] = d.Power
+	}
+	return dims.String()
+}
+
+// Names of the base dimensions.
+const (
+	AngleName             string = "AngleDim"
+	CurrentName           string = "CurrentDim"
+	LengthName            string = "LengthDim"
+	LuminousIntensityName string = "LuminousIntensityDim"
+	MassName              string = "MassDim"
+	MoleName              string = "MoleDim"
+	TemperatureName       string = "TemperatureDim"
+	TimeName              string = "TimeDim"
+)
+
+var dimOf = map[string]unit.Dimension{
+	"AngleDim":             unit.AngleDim,
+	"CurrentDim":           unit.CurrentDim,
+	"LengthDim":            unit.LengthDim,
+	"LuminousIntensityDim": unit.LuminousIntensityDim,
+	"MassDim":              unit.MassDim,
+	"MoleDim":              unit.MoleDim,
+	"TemperatureDim":       unit.TemperatureDim,
+	"TimeDim":              unit.TimeDim,
+}
+
+// DimensionNamed returns the name of the base dimension with the given
+// symbol, for example "LengthDim" for "m".
+func DimensionNamed(symbol string) (name string, ok bool) {
+	for name, d := range dimOf {
+		if d.String() == symbol {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// Target describes the package that generated code is written into.
+type Target struct {
+	// Package is the name of the package.
+	Package string
+
+	// External indicates that the package is not the
+	// unit package, so references to the unit package's
+	// identifiers must be qualified.
+	External bool
+}
+
+// data is the value passed to the templates.
+type data struct {
+	Unit
+	Target
+
+	// Q is the qualifier for identifiers
+	// of the unit package.
+	Q string
+}
+
+func newData(u Unit, t Target) (data, error) {
+	if !token.IsIdentifier(u.DimensionName) || !token.IsExported(u.DimensionName) {
+		return data{}, fmt.Errorf("unitgen: invalid type name %q", u.DimensionName)
+	}
+	if !token.IsIdentifier(u.Receiver) {
+		return data{}, fmt.Errorf("unitgen: invalid receiver name %q for %s", u.Receiver, u.DimensionName)
+	}
+	if u.Name != "" && !token.IsIdentifier(u.Name) {
+		return data{}, fmt.Errorf("unitgen: invalid constant name %q for %s", u.Name, u.DimensionName)
+	}
+	if u.ErForm != "" && !token.IsIdentifier(u.ErForm) {
+		return data{}, fmt.Errorf("unitgen: invalid interface name %q for %s", u.ErForm, u.DimensionName)
+	}
+	seen := make(map[string]bool)
+	for _, d := range u.Dimensions {
+		if _, ok := dimOf[d.Name]; !ok {
+			return data{}, fmt.Errorf("unitgen: unknown dimension %q for %s", d.Name, u.DimensionName)
+		}
+		if seen[d.Name] {
+			return data{}, fmt.Errorf("unitgen: repeated dimension %q for %s", d.Name, u.DimensionName)
+		}
+		seen[d.Name] = true
+		if d.Power == 0 {
+			return data{}, fmt.Errorf("unitgen: zero power of %q for %s", d.Name, u.DimensionName)
+		}
+	}
+	if t.External && len(u.Dimensions) == 0 {
+		// The generated tests rely on the type
+		// not being dimensionless.
+		return data{}, fmt.Errorf("unitgen: no dimensions for %s", u.DimensionName)
+	}
+	var q string
+	if t.External {
+		if !token.IsIdentifier(t.Package) {
+			return data{}, fmt.Errorf("unitgen: invalid package name %q", t.Package)
+		}
+		q = "unit."
+	}
+	return data{Unit: u, Target: t, Q: q}, nil
+}
+
+// SortDimensions sorts dims by name, the order used by the unit package.
+func SortDimensions(dims []Dimension) {
+	sort.Slice(dims, func(i, j int) bool { return dims[i].Name < dims[j].Name })
+}
+
+// FileName returns the base name of the file holding the generated
+// source for u.
+func FileName(u Unit) string {
+	return strings.ToLower(u.DimensionName) + ".go"
+}
+
+// TestFileName returns the base name of the file holding the generated
+// tests for u.
+func TestFileName(u Unit) string {
+	return strings.ToLower(u.DimensionName) + "_test.go"
+}
+
+const headerTemplate = `{{if .External}}// Code generated by "unitgen"; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	{{if .PrintString}}"unicode/utf8"{{end}}
+
+	"gonum.org/v1/gonum/unit"
+)
+{{else}}// Code generated by "go generate gonum.org/v1/gonum/unit”; DO NOT EDIT.
+
+// Copyright ©2014 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	{{if .PrintString}}"unicode/utf8"{{end}}
+)
+{{end}}
+// {{.TypeComment}}.
+type {{.DimensionName}} float64
+`
+
+var header = template.Must(template.New("header").Parse(headerTemplate))
+
+const constTemplate = `
+const {{if .ExtraConstant}}({{end}}
+	{{.Name}} {{.DimensionName}} = {{if .PowerOffset}} 1e{{.PowerOffset}} {{else}} 1 {{end}}
+	{{$name := .Name}}
+	{{range .ExtraConstant}} {{.Name}} = {{.Value}}
+	{{end}}
+{{if .ExtraConstant}}){{end}}
+`
+
+var prefix = template.Must(template.New("prefix").Parse(constTemplate))
+
+const methodTemplate = `
+// Unit converts the {{.DimensionName}} to a *Unit.
+func ({{.Receiver}} {{.DimensionName}}) Unit() *{{.Q}}Unit {
+	return {{.Q}}New(float64({{.Receiver}}), {{.Q}}Dimensions{
+		{{range .Dimensions}} {{$.Q}}{{.Name}}: {{.Power}},
+		{{end}}
+		})
+}
+
+// {{.DimensionName}} allows {{.DimensionName}} to implement a {{if .ErForm}}{{.ErForm}}{{else}}{{.DimensionName}}er{{end}} interface.
+func ({{.Receiver}} {{.DimensionName}}) {{.DimensionName}}() {{.DimensionName}} {
+	return {{.Receiver}}
+}
+
+// From converts the unit into the receiver. From returns an
+// error if there is a mismatch in dimension.
+func ({{.Receiver}} *{{.DimensionName}}) From(u {{.Q}}Uniter) error {
+	if !{{.Q}}DimensionsMatch(u, {{if .Name}}{{.Name}}{{else}}{{.DimensionName}}(0){{end}}){
+		*{{.Receiver}} = {{.DimensionName}}(math.NaN())
+		return errors.New("unit: dimension mismatch")
+	}
+	*{{.Receiver}} = {{.DimensionName}}(u.Unit().Value())
+	return nil
+}
+`
+
+var methods = template.Must(template.New("methods").Parse(methodTemplate))
+
+// The width of the numeric part of a formatted value is clamped to
+// be non-negative using the unit package's pos function, or the max
+// builtin in other packages.
+const formatTemplate = `{{define "width"}}{{if .External}}max(w-utf8.RuneCount([]byte(unit)), 0){{else}}pos(w-utf8.RuneCount([]byte(unit))){{end}}{{end}}
+func ({{.Receiver}} {{.DimensionName}}) Format(fs fmt.State, c rune) {
+	switch c {
+	case 'v':
+		if fs.Flag('#') {
+			fmt.Fprintf(fs, "%T(%v)", {{.Receiver}}, float64({{.Receiver}}))
+			return
+		}
+		fallthrough
+	case 'e', 'E', 'f', 'F', 'g', 'G':
+		p, pOk := fs.Precision()
+		w, wOk := fs.Width()
+		{{if .PrintString}}const unit = " {{.PrintString}}"
+		switch {
+		case pOk && wOk:
+			fmt.Fprintf(fs, "%*.*"+string(c), {{template "width" .}}, p, float64({{.Receiver}}))
+		case pOk:
+			fmt.Fprintf(fs, "%.*"+string(c), p, float64({{.Receiver}}))
+		case wOk:
+			fmt.Fprintf(fs, "%*"+string(c), {{template "width" .}}, float64({{.Receiver}}))
+		default:
+			fmt.Fprintf(fs, "%"+string(c), float64({{.Receiver}}))
+		}
+		fmt.Fprint(fs, unit)
+	default:
+		fmt.Fprintf(fs, "%%!%c(%T=%g {{.PrintString}})", c, {{.Receiver}}, float64({{.Receiver}})) {{else}} switch {
+		case pOk && wOk:
+			fmt.Fprintf(fs, "%*.*"+string(c), w, p, float64({{.Receiver}}))
+		case pOk:
+			fmt.Fprintf(fs, "%.*"+string(c), p, float64({{.Receiver}}))
+		case wOk:
+			fmt.Fprintf(fs, "%*"+string(c), w, float64({{.Receiver}}))
+		default:
+			fmt.Fprintf(fs, "%"+string(c), float64({{.Receiver}}))
+		}
+	default:
+		fmt.Fprintf(fs, "%%!%c(%T=%g)", c, {{.Receiver}}, float64({{.Receiver}})) {{end}}
+	}
+}
+`
+
+var form = template.Must(template.New("format").Parse(formatTemplate))
+
+// Source returns the formatted Go source for the type described by u,
+// written for the target package t.
+func Source(u Unit, t Target) ([]byte, error) {
+	d, err := newData(u, t)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err = header.Execute(&buf, d)
+	if err != nil {
+		return nil, err
+	}
+	if u.Name != "" {
+		err = prefix.Execute(&buf, d)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = methods.Execute(&buf, d)
+	if err != nil {
+		return nil, err
+	}
+	err = form.Execute(&buf, d)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		return buf.Bytes(), fmt.Errorf("unitgen: error formatting %q: %w", u.DimensionName, err)
+	}
+	return b, nil
+}
+
+const testTemplate = `{{if .External}}// Code generated by "unitgen"; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"fmt"
+	"testing"
+
+	"gonum.org/v1/gonum/unit"
+)
+{{else}}// Code generated by "go generate gonum.org/v1/gonum/unit; DO NOT EDIT.
+
+// Copyright ©2019 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unit
+
+import (
+	"fmt"
+	"testing"
+)
+{{end}}
+func Test{{.DimensionName}}(t *testing.T) {
+	t.Parallel()
+	for _, value := range []float64{-1, 0, 1} {
+		var got {{.DimensionName}}
+		err := got.From({{.DimensionName}}(value).Unit())
+		if err != nil {
+			t.Errorf("unexpected error for %T conversion: %v", got, err)
+		}
+		if got != {{.DimensionName}}(value) {
+			t.Errorf("unexpected result from round trip of %T(%v): got: %v want: %v", got, value, got, value)
+		}
+		if got != got.{{.DimensionName}}() {
+			t.Errorf("unexpected result from self interface method call: got: %#v want: %#v", got, value)
+		}
+		{{if .External}}err = got.From(unit.Dimless(1))
+		if err == nil {
+			t.Errorf("expected error for dimensionless to %T conversion", got)
+		}{{else}}err = got.From(ether(1))
+		if err == nil {
+			t.Errorf("expected error for ether to %T conversion", got)
+		}{{end}}
+	}
+}
+
+func Test{{.DimensionName}}Format(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct{
+		value  {{.DimensionName}}
+		format string
+		want   string
+	}{
+		{1.23456789, "%v", "1.23456789{{with .PrintString}} {{.}}{{end}}"},
+		{1.23456789, "%.1v", "1{{with .PrintString}} {{.}}{{end}}"},
+		{1.23456789, "%20.1v", "{{if .PrintString}}{{$s := printf "1 %s" .PrintString}}{{printf "%20s" $s}}{{else}}{{printf "%20s" "1"}}{{end}}"},
+		{1.23456789, "%20v", "{{if .PrintString}}{{$s := printf "1.23456789 %s" .PrintString}}{{printf "%20s" $s}}{{else}}{{printf "%20s" "1.23456789"}}{{end}}"},
+		{1.23456789, "%1v", "1.23456789{{with .PrintString}} {{.}}{{end}}"},
+		{1.23456789, "%#v", "{{.Package}}.{{.DimensionName}}(1.23456789)"},
+		{1.23456789, "%s", "%!s({{.Package}}.{{.DimensionName}}=1.23456789{{with .PrintString}} {{.}}{{end}})"},
+	} {
+		got := fmt.Sprintf(test.format, test.value)
+		if got != test.want {
+			t.Errorf("Format %q %v: got: %q want: %q", test.format, test.value, got, test.want)
+		}
+	}
+}
+`
+
+var tests = template.Must(template.New("test").Parse(testTemplate))
+
+// TestSource returns the formatted Go source for the tests of the type
+// described by u, written for the target package t.
+func TestSource(u Unit, t Target) ([]byte, error) {
+	d, err := newData(u, t)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err = tests.Execute(&buf, d)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		return buf.Bytes(), fmt.Errorf("unitgen: error formatting test for %q: %w", u.DimensionName, err)
+	}
+	return b, nil
+}
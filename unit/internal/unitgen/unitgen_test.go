@@ -0,0 +1,164 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unitgen
+
+import (
+	"bytes"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var torque = Unit{
+	DimensionName: "Torque",
+	Receiver:      "t",
+	PrintString:   "N m",
+	Name:          "Newtonmetre",
+	TypeComment:   "Torque represents a torque in Newton metres",
+	Dimensions: []Dimension{
+		{Name: LengthName, Power: 2},
+		{Name: MassName, Power: 1},
+		{Name: TimeName, Power: -2},
+	},
+	ErForm: "Torquer",
+}
+
+func TestSourceBuiltin(t *testing.T) {
+	t.Parallel()
+	target := Target{Package: "unit"}
+	for _, test := range []struct {
+		source func(Unit, Target) ([]byte, error)
+		file   string
+	}{
+		{source: Source, file: FileName(torque)},
+		{source: TestSource, file: TestFileName(torque)},
+	} {
+		got, err := test.source(torque, target)
+		if err != nil {
+			t.Fatalf("unexpected error generating %s: %v", test.file, err)
+		}
+		want, err := os.ReadFile(filepath.Join("..", "..", test.file))
+		if err != nil {
+			t.Fatalf("unexpected error reading %s: %v", test.file, err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("generated source does not match %s", test.file)
+		}
+	}
+}
+
+func TestSourceExternal(t *testing.T) {
+	t.Parallel()
+	units := []Unit{
+		{
+			DimensionName: "SpectralIrradiance",
+			Receiver:      "e",
+			PrintString:   "W m^-3",
+			Name:          "WattPerCubicMetre",
+			TypeComment:   "SpectralIrradiance represents a spectral irradiance in watts per cubic metre",
+			ExtraConstant: []Constant{
+				{Name: "WattPerSquareMetreNanometre", Value: "1e9 * WattPerCubicMetre"},
+			},
+			Dimensions: []Dimension{
+				{Name: LengthName, Power: -1},
+				{Name: MassName, Power: 1},
+				{Name: TimeName, Power: -3},
+			},
+		},
+		{
+			DimensionName: "Jerk",
+			Receiver:      "j",
+			TypeComment:   "Jerk represents a jerk in metres per second cubed",
+			Dimensions: []Dimension{
+				{Name: LengthName, Power: 1},
+				{Name: TimeName, Power: -3},
+			},
+		},
+	}
+	target := Target{Package: "radiometry", External: true}
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, u := range units {
+		for _, source := range []func(Unit, Target) ([]byte, error){Source, TestSource} {
+			b, err := source(u, target)
+			if err != nil {
+				t.Fatalf("unexpected error generating %s: %v", u.DimensionName, err)
+			}
+			f, err := parser.ParseFile(fset, u.DimensionName+".go", b, 0)
+			if err != nil {
+				t.Fatalf("unexpected error parsing generated source: %v", err)
+			}
+			files = append(files, f)
+		}
+	}
+
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	pkg, err := conf.Check(target.Package, fset, files, nil)
+	if err != nil {
+		t.Fatalf("unexpected error type checking generated source: %v", err)
+	}
+	for _, name := range []string{"SpectralIrradiance", "WattPerCubicMetre", "WattPerSquareMetreNanometre", "Jerk"} {
+		if pkg.Scope().Lookup(name) == nil {
+			t.Errorf("missing declaration of %s", name)
+		}
+	}
+	var uniter *types.Interface
+	for _, imp := range pkg.Imports() {
+		if imp.Path() == "gonum.org/v1/gonum/unit" {
+			uniter = imp.Scope().Lookup("Uniter").Type().Underlying().(*types.Interface)
+		}
+	}
+	if uniter == nil {
+		t.Fatal("generated source does not import the unit package")
+	}
+	for _, name := range []string{"SpectralIrradiance", "Jerk"} {
+		if !types.Implements(pkg.Scope().Lookup(name).Type(), uniter) {
+			t.Errorf("%s does not implement unit.Uniter", name)
+		}
+	}
+}
+
+func TestSourceInvalid(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name   string
+		u      Unit
+		target Target
+	}{
+		{name: "unexported type", u: Unit{DimensionName: "torque", Receiver: "t"}},
+		{name: "bad receiver", u: Unit{DimensionName: "Torque", Receiver: "1t"}},
+		{name: "unknown dimension", u: Unit{DimensionName: "Torque", Receiver: "t", Dimensions: []Dimension{{Name: "ForceDim", Power: 1}}}},
+		{name: "repeated dimension", u: Unit{DimensionName: "Torque", Receiver: "t", Dimensions: []Dimension{{Name: MassName, Power: 1}, {Name: MassName, Power: 1}}}},
+		{name: "zero power", u: Unit{DimensionName: "Torque", Receiver: "t", Dimensions: []Dimension{{Name: MassName}}}},
+		{name: "dimensionless external", u: Unit{DimensionName: "Ratio", Receiver: "r"}, target: Target{Package: "p", External: true}},
+		{name: "bad package", u: torque, target: Target{Package: "a-b", External: true}},
+	} {
+		if _, err := Source(test.u, test.target); err == nil {
+			t.Errorf("expected error for %s", test.name)
+		}
+	}
+}
+
+func TestDimensionNamed(t *testing.T) {
+	t.Parallel()
+	for sym, want := range map[string]string{
+		"A": CurrentName, "m": LengthName, "cd": LuminousIntensityName, "kg": MassName,
+		"mol": MoleName, "K": TemperatureName, "s": TimeName, "rad": AngleName,
+	} {
+		got, ok := DimensionNamed(sym)
+		if !ok || got != want {
+			t.Errorf("unexpected dimension for %q: got:%q want:%q", sym, got, want)
+		}
+	}
+	if _, ok := DimensionNamed("g"); ok {
+		t.Error("expected no dimension for g")
+	}
+}
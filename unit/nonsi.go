@@ -0,0 +1,141 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unit
+
+import "math"
+
+// Non-SI units of length.
+const (
+	Inch         Length = 0.0254
+	Foot                = 12 * Inch
+	Yard                = 3 * Foot
+	Mile                = 1760 * Yard
+	NauticalMile Length = 1852
+	Angstrom     Length = 1e-10
+)
+
+// Non-SI units of mass. The pound is the international avoirdupois pound.
+const (
+	Pound    Mass = 0.45359237
+	Ounce         = Pound / 16
+	Grain         = Pound / 7000
+	Stone         = 14 * Pound
+	ShortTon      = 2000 * Pound
+	LongTon       = 2240 * Pound
+	Tonne    Mass = 1000
+)
+
+// Non-SI units of area.
+const (
+	Acre    Area = 4046.8564224
+	Hectare Area = 1e4
+)
+
+// Non-SI units of volume. The US customary units are for liquid measure.
+const (
+	USGallon     Volume = 231 * 0.0254 * 0.0254 * 0.0254
+	USQuart             = USGallon / 4
+	USPint              = USGallon / 8
+	USFluidOunce        = USGallon / 128
+
+	ImperialGallon     Volume = 4.54609e-3
+	ImperialQuart             = ImperialGallon / 4
+	ImperialPint              = ImperialGallon / 8
+	ImperialFluidOunce        = ImperialGallon / 160
+)
+
+// Non-SI units of time.
+const (
+	Day  = 24 * Hour
+	Week = 7 * Day
+)
+
+// Non-SI units of plane angle.
+const (
+	Degree    Angle = math.Pi / 180
+	Arcminute       = Degree / 60
+	Arcsecond       = Arcminute / 60
+	Turn      Angle = 2 * math.Pi
+)
+
+// Non-SI units of velocity.
+const (
+	Knot             Velocity = 1852.0 / 3600
+	MilePerHour      Velocity = 0.44704
+	KilometrePerHour Velocity = 1 / 3.6
+)
+
+// Non-SI units of force. The pound-force is defined using the standard
+// acceleration of gravity.
+const (
+	PoundForce Force = 0.45359237 * 9.80665
+	Dyne       Force = 1e-5
+)
+
+// Non-SI units of pressure.
+const (
+	PoundPerSquareInch  Pressure = 0.45359237 * 9.80665 / (0.0254 * 0.0254)
+	Atmosphere          Pressure = 101325
+	Bar                 Pressure = 1e5
+	Torr                         = Atmosphere / 760
+	MillimetreOfMercury Pressure = 133.322387415
+	Barye               Pressure = 0.1
+)
+
+// Non-SI units of energy. The calorie is the thermochemical calorie and the
+// British thermal unit is the International Table British thermal unit.
+const (
+	Calorie            Energy = 4.184
+	BritishThermalUnit Energy = 1055.05585262
+	Erg                Energy = 1e-7
+	Electronvolt       Energy = 1.602176634e-19
+	KilowattHour       Energy = 3.6e6
+)
+
+// Non-SI units of power. The horsepower is the mechanical horsepower.
+const (
+	Horsepower       Power = 550 * 0.3048 * 0.45359237 * 9.80665
+	MetricHorsepower Power = 735.49875
+)
+
+// Non-SI units of acceleration.
+const Gal Acceleration = 0.01
+
+// CGS units of magnetism.
+const (
+	Gauss   MagneticFluxDensity = 1e-4
+	Maxwell MagneticFlux        = 1e-8
+)
+
+// Temperature scale constants. Rankine is the size of one degree on the
+// Rankine and Fahrenheit scales, and is the same as one kelvin times 5/9.
+const (
+	Rankine Temperature = 5.0 / 9
+
+	celsiusOffset    = 273.15
+	fahrenheitOffset = 459.67
+)
+
+// FromCelsius returns the thermodynamic temperature corresponding to the
+// temperature c on the Celsius scale.
+func FromCelsius(c float64) Temperature {
+	return Temperature(c + celsiusOffset)
+}
+
+// FromFahrenheit returns the thermodynamic temperature corresponding to the
+// temperature f on the Fahrenheit scale.
+func FromFahrenheit(f float64) Temperature {
+	return Temperature((f + fahrenheitOffset) * float64(Rankine))
+}
+
+// Celsius returns the temperature on the Celsius scale.
+func (t Temperature) Celsius() float64 {
+	return float64(t) - celsiusOffset
+}
+
+// Fahrenheit returns the temperature on the Fahrenheit scale.
+func (t Temperature) Fahrenheit() float64 {
+	return float64(t)/float64(Rankine) - fahrenheitOffset
+}
@@ -0,0 +1,69 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unit
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestNonSIConstants(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{name: "foot", got: float64(Foot), want: 0.3048},
+		{name: "mile", got: float64(Mile), want: 1609.344},
+		{name: "ounce", got: float64(Ounce), want: 0.028349523125},
+		{name: "stone", got: float64(Stone), want: 6.35029318},
+		{name: "grain", got: float64(Grain), want: 64.79891e-6},
+		{name: "acre", got: float64(Acre), want: 43560 * float64(Foot*Foot)},
+		{name: "US gallon", got: float64(USGallon), want: 3.785411784e-3},
+		{name: "US fluid ounce", got: float64(USFluidOunce), want: 29.5735295625e-6},
+		{name: "imperial pint", got: float64(ImperialPint), want: 568.26125e-6},
+		{name: "day", got: float64(Day), want: 86400},
+		{name: "arcsecond", got: float64(Arcsecond), want: 4.84813681109536e-6},
+		{name: "knot", got: float64(Knot) * float64(Hour), want: 1852},
+		{name: "mile per hour", got: float64(MilePerHour) * float64(Hour), want: float64(Mile)},
+		{name: "pound-force", got: float64(PoundForce), want: 4.4482216152605},
+		{name: "psi", got: float64(PoundPerSquareInch), want: 6894.757293168361},
+		{name: "torr", got: float64(Torr), want: 133.32236842105263},
+		{name: "horsepower", got: float64(Horsepower), want: 745.69987158227022},
+		{name: "kilowatt hour", got: float64(KilowattHour), want: 1000 * float64(Watt) * float64(Hour)},
+	} {
+		if !scalar.EqualWithinRel(test.got, test.want, 1e-14) {
+			t.Errorf("unexpected value for %s: got:%v want:%v", test.name, test.got, test.want)
+		}
+	}
+}
+
+func TestTemperatureScales(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		celsius, fahrenheit float64
+		kelvin              Temperature
+	}{
+		{celsius: -273.15, fahrenheit: -459.67, kelvin: 0},
+		{celsius: -40, fahrenheit: -40, kelvin: 233.15},
+		{celsius: 0, fahrenheit: 32, kelvin: 273.15},
+		{celsius: 100, fahrenheit: 212, kelvin: 373.15},
+	} {
+		if got := FromCelsius(test.celsius); !scalar.EqualWithinAbs(float64(got), float64(test.kelvin), 1e-12) {
+			t.Errorf("unexpected temperature from %v°C: got:%v want:%v", test.celsius, got, test.kelvin)
+		}
+		if got := FromFahrenheit(test.fahrenheit); !scalar.EqualWithinAbs(float64(got), float64(test.kelvin), 1e-12) {
+			t.Errorf("unexpected temperature from %v°F: got:%v want:%v", test.fahrenheit, got, test.kelvin)
+		}
+		if got := test.kelvin.Celsius(); !scalar.EqualWithinAbs(got, test.celsius, 1e-12) {
+			t.Errorf("unexpected Celsius temperature for %v: got:%v want:%v", test.kelvin, got, test.celsius)
+		}
+		if got := test.kelvin.Fahrenheit(); !scalar.EqualWithinAbs(got, test.fahrenheit, 1e-12) {
+			t.Errorf("unexpected Fahrenheit temperature for %v: got:%v want:%v", test.kelvin, got, test.fahrenheit)
+		}
+	}
+}
@@ -0,0 +1,372 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// siSymbols holds the SI unit symbols recognized by ParseValue and their
+// values in SI base units. All these symbols, except kg, may be used with
+// an SI prefix.
+var siSymbols = map[string]Value{
+	// Base units. The gram is used for prefixed masses.
+	"A":   {Dimensions{CurrentDim: 1}, 1},
+	"m":   {Dimensions{LengthDim: 1}, 1},
+	"cd":  {Dimensions{LuminousIntensityDim: 1}, 1},
+	"kg":  {Dimensions{MassDim: 1}, 1},
+	"g":   {Dimensions{MassDim: 1}, 1e-3},
+	"mol": {Dimensions{MoleDim: 1}, 1},
+	"K":   {Dimensions{TemperatureDim: 1}, 1},
+	"s":   {Dimensions{TimeDim: 1}, 1},
+	"rad": {Dimensions{AngleDim: 1}, 1},
+
+	// Derived units with special symbols.
+	"Hz":  {Dimensions{TimeDim: -1}, 1},
+	"N":   {Dimensions{MassDim: 1, LengthDim: 1, TimeDim: -2}, 1},
+	"Pa":  {Dimensions{MassDim: 1, LengthDim: -1, TimeDim: -2}, 1},
+	"J":   {Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -2}, 1},
+	"W":   {Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -3}, 1},
+	"C":   {Dimensions{CurrentDim: 1, TimeDim: 1}, 1},
+	"V":   {Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -3, CurrentDim: -1}, 1},
+	"F":   {Dimensions{MassDim: -1, LengthDim: -2, TimeDim: 4, CurrentDim: 2}, 1},
+	"Ω":   {Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -3, CurrentDim: -2}, 1},
+	"S":   {Dimensions{MassDim: -1, LengthDim: -2, TimeDim: 3, CurrentDim: 2}, 1},
+	"Wb":  {Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -2, CurrentDim: -1}, 1},
+	"T":   {Dimensions{MassDim: 1, TimeDim: -2, CurrentDim: -1}, 1},
+	"H":   {Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -2, CurrentDim: -2}, 1},
+	"Bq":  {Dimensions{TimeDim: -1}, 1},
+	"Gy":  {Dimensions{LengthDim: 2, TimeDim: -2}, 1},
+	"Sv":  {Dimensions{LengthDim: 2, TimeDim: -2}, 1},
+	"kat": {Dimensions{MoleDim: 1, TimeDim: -1}, 1},
+
+	// Units in use with SI.
+	"L": {Dimensions{LengthDim: 3}, 1e-3},
+	"l": {Dimensions{LengthDim: 3}, 1e-3},
+}
+
+// siPrefixes holds the SI prefix symbols recognized by ParseValue.
+var siPrefixes = []struct {
+	symbol string
+	factor float64
+}{
+	// Deca must precede deci.
+	{"da", Deca},
+
+	{"Y", Yotta}, {"Z", Zetta}, {"E", Exa}, {"P", Peta}, {"T", Tera},
+	{"G", Giga}, {"M", Mega}, {"k", Kilo}, {"h", Hecto},
+	{"d", Deci}, {"c", Centi}, {"m", Milli},
+	{"μ", Micro}, {"µ", Micro}, {"u", Micro},
+	{"n", Nano}, {"p", Pico}, {"f", Femto}, {"a", Atto}, {"z", Zepto}, {"y", Yocto},
+}
+
+// lookupSymbol returns the value of the unit with the given symbol.
+// Symbols are SI unit symbols, symbols of dimensions created by
+// NewDimension, non-SI unit symbols, symbols added by RegisterUnit,
+// or any of these with an SI prefix. Unprefixed symbols take
+// precedence over prefixed symbols.
+func lookupSymbol(sym string) (Value, bool) {
+	if v, ok := unprefixedSymbol(sym); ok {
+		return v, true
+	}
+	for _, p := range siPrefixes {
+		rest, ok := strings.CutPrefix(sym, p.symbol)
+		if !ok || rest == "" || rest == "kg" {
+			continue
+		}
+		if v, ok := unprefixedSymbol(rest); ok {
+			v.value *= p.factor
+			return v, true
+		}
+	}
+	return Value{}, false
+}
+
+// unprefixedSymbol returns the value of the unit with the given symbol
+// without considering SI prefixes.
+func unprefixedSymbol(sym string) (Value, bool) {
+	if sym == "Ω" { // Ohm sign.
+		sym = "Ω"
+	}
+	if v, ok := siSymbols[sym]; ok {
+		return NewValue(v.value, v.dimensions), true
+	}
+	if sym == "Å" { // Angstrom sign.
+		sym = "Å"
+	}
+	mu.RLock()
+	d, isDim := dimensions[sym]
+	r, isRegistered := registered[sym]
+	mu.RUnlock()
+	if isDim && d != reserved {
+		return NewValue(1, Dimensions{d: 1}), true
+	}
+	if v, ok := nonSISymbols[sym]; ok {
+		return NewValue(v.value, v.dimensions), true
+	}
+	if isRegistered {
+		return NewValue(r.value, r.dimensions), true
+	}
+	return Value{}, false
+}
+
+// ParseValue parses a dimensional value from s. The value is given by a
+// floating point number, optionally followed by white space and a unit
+// expression. Unit expressions are products and quotients of unit symbols
+// and parenthesized unit expressions, each of which may be raised to an
+// integer power. For example, each of the following
+//
+//	9.81 m/s^2
+//	9.81 m·s⁻²
+//	9.81 m s^-2
+//	0.00981 km/(s*s)
+//
+// is parsed as the same value. Multiplication is indicated by white space,
+// '*', '·' or '⋅', and each '/' divides by the single factor that follows
+// it, so "J/mol·K" is equal to "J·K/mol". Powers are written with '^'
+// or with superscript digits.
+//
+// Recognized unit symbols are the SI base units, the derived SI units with
+// special symbols, the litre and the gram, the symbols of dimensions created
+// with NewDimension, and each of these with an SI prefix. Common non-SI
+// units are also recognized, including the imperial and US customary units
+// in, ft, yd, mi, lb, oz, gal, lbf and psi, the CGS units dyn, erg, Ba, Gal
+// and Mx, and the units nmi, Å, t, ha, min, h, d, °, deg, arcmin, arcsec, kn,
+// mph, atm, bar, Torr, mmHg, cal, Btu, eV and hp. The symbol gal is the US
+// gallon. Units added with RegisterUnit are recognized in the same way.
+// The output of the String method of Value is accepted by ParseValue.
+func ParseValue(s string) (Value, error) {
+	num, rest := splitNumber(strings.TrimSpace(s))
+	x, err := strconv.ParseFloat(num, 64)
+	if err != nil {
+		return Value{}, fmt.Errorf("unit: invalid number in %q", s)
+	}
+	u, err := ParseUnit(rest)
+	if err != nil {
+		return Value{}, err
+	}
+	u.value *= x
+	return u, nil
+}
+
+// ParseUnit parses the unit expression s, returning its value. The syntax
+// of unit expressions is described in the documentation for ParseValue. An
+// empty expression is parsed as the dimensionless value one.
+func ParseUnit(s string) (Value, error) {
+	p := parser{src: s, runes: []rune(s)}
+	return p.expr(false)
+}
+
+// splitNumber splits s into a leading floating point number and the
+// remaining text.
+func splitNumber(s string) (num, rest string) {
+	// Try the first word as a whole to handle
+	// values such as Inf and NaN.
+	if i := strings.IndexFunc(s, unicode.IsSpace); i >= 0 {
+		if _, err := strconv.ParseFloat(s[:i], 64); err == nil {
+			return s[:i], s[i:]
+		}
+	} else if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return s, ""
+	}
+
+	i := 0
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		i++
+	}
+	digits := 0
+	for ; i < len(s) && '0' <= s[i] && s[i] <= '9'; i++ {
+		digits++
+	}
+	if i < len(s) && s[i] == '.' {
+		i++
+		for ; i < len(s) && '0' <= s[i] && s[i] <= '9'; i++ {
+			digits++
+		}
+	}
+	if digits != 0 && i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		j := i + 1
+		if j < len(s) && (s[j] == '+' || s[j] == '-') {
+			j++
+		}
+		if j < len(s) && '0' <= s[j] && s[j] <= '9' {
+			for j < len(s) && '0' <= s[j] && s[j] <= '9' {
+				j++
+			}
+			i = j
+		}
+	}
+	return s[:i], s[i:]
+}
+
+// parser is a recursive descent parser for unit expressions.
+type parser struct {
+	src   string
+	runes []rune
+	pos   int
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("unit: invalid unit expression %q: %s", p.src, fmt.Sprintf(format, args...))
+}
+
+// expr parses a product of factors. If inGroup is true, expr
+// returns at a closing parenthesis without consuming it.
+func (p *parser) expr(inGroup bool) (Value, error) {
+	v := NewValue(1, nil)
+	divide, empty := false, true
+	for p.pos < len(p.runes) {
+		r := p.runes[p.pos]
+		switch {
+		case unicode.IsSpace(r) || r == '*' || r == '·' || r == '⋅':
+			p.pos++
+		case r == '/':
+			if divide {
+				return Value{}, p.errorf("unexpected '/' at offset %d", p.pos)
+			}
+			divide = true
+			p.pos++
+		case r == ')':
+			if !inGroup {
+				return Value{}, p.errorf("unexpected ')' at offset %d", p.pos)
+			}
+			if divide {
+				return Value{}, p.errorf("missing divisor")
+			}
+			if empty {
+				return Value{}, p.errorf("empty group")
+			}
+			return v, nil
+		default:
+			f, err := p.factor()
+			if err != nil {
+				return Value{}, err
+			}
+			if divide {
+				v = v.Div(f)
+			} else {
+				v = v.Mul(f)
+			}
+			divide, empty = false, false
+		}
+	}
+	if divide {
+		return Value{}, p.errorf("missing divisor")
+	}
+	if inGroup {
+		return Value{}, p.errorf("missing ')'")
+	}
+	return v, nil
+}
+
+// factor parses a unit symbol or parenthesized expression with an
+// optional integer power.
+func (p *parser) factor() (Value, error) {
+	var v Value
+	if p.runes[p.pos] == '(' {
+		p.pos++
+		var err error
+		v, err = p.expr(true)
+		if err != nil {
+			return Value{}, err
+		}
+		p.pos++ // Consume ')'.
+	} else {
+		start := p.pos
+		for p.pos < len(p.runes) && isSymbolRune(p.runes[p.pos]) {
+			p.pos++
+		}
+		if p.pos == start {
+			return Value{}, p.errorf("unexpected %q at offset %d", p.runes[p.pos], p.pos)
+		}
+		sym := string(p.runes[start:p.pos])
+		var ok bool
+		v, ok = lookupSymbol(sym)
+		if !ok {
+			return Value{}, fmt.Errorf("unit: unknown unit symbol %q", sym)
+		}
+	}
+	n, ok, err := p.power()
+	if err != nil {
+		return Value{}, err
+	}
+	if ok {
+		v = v.Pow(n)
+	}
+	return v, nil
+}
+
+// isSymbolRune returns whether r may be part of a unit symbol.
+func isSymbolRune(r rune) bool {
+	return unicode.IsLetter(r) || r == '°'
+}
+
+// superscripts maps superscript digits to their values.
+var superscripts = map[rune]int{
+	'⁰': 0, '¹': 1, '²': 2, '³': 3, '⁴': 4,
+	'⁵': 5, '⁶': 6, '⁷': 7, '⁸': 8, '⁹': 9,
+}
+
+// power parses an optional integer power.
+func (p *parser) power() (n int, ok bool, err error) {
+	if p.pos >= len(p.runes) {
+		return 0, false, nil
+	}
+	r := p.runes[p.pos]
+	if r == '^' {
+		p.pos++
+		paren := p.pos < len(p.runes) && p.runes[p.pos] == '('
+		if paren {
+			p.pos++
+		}
+		start := p.pos
+		if p.pos < len(p.runes) && (p.runes[p.pos] == '-' || p.runes[p.pos] == '+') {
+			p.pos++
+		}
+		for p.pos < len(p.runes) && '0' <= p.runes[p.pos] && p.runes[p.pos] <= '9' {
+			p.pos++
+		}
+		n, err := strconv.Atoi(string(p.runes[start:p.pos]))
+		if err != nil {
+			return 0, false, p.errorf("invalid power at offset %d", start)
+		}
+		if paren {
+			if p.pos >= len(p.runes) || p.runes[p.pos] != ')' {
+				return 0, false, p.errorf("missing ')' in power")
+			}
+			p.pos++
+		}
+		return n, true, nil
+	}
+
+	sign := 1
+	start := p.pos
+	if r == '⁻' || r == '⁺' {
+		if r == '⁻' {
+			sign = -1
+		}
+		p.pos++
+	}
+	var digits int
+	for p.pos < len(p.runes) {
+		d, isDigit := superscripts[p.runes[p.pos]]
+		if !isDigit {
+			break
+		}
+		n = 10*n + d
+		digits++
+		p.pos++
+	}
+	if digits == 0 {
+		if p.pos != start {
+			return 0, false, p.errorf("invalid power at offset %d", start)
+		}
+		return 0, false, nil
+	}
+	return sign * n, true, nil
+}
@@ -0,0 +1,132 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unit
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestParseValue(t *testing.T) {
+	t.Parallel()
+	accel := Dimensions{LengthDim: 1, TimeDim: -2}
+	for _, test := range []struct {
+		in   string
+		want Value
+	}{
+		{in: "3.5", want: NewValue(3.5, nil)},
+		{in: "-2e3", want: NewValue(-2000, nil)},
+		{in: "3.5 kg·m/s^2", want: NewValue(3.5, Dimensions{MassDim: 1, LengthDim: 1, TimeDim: -2})},
+		{in: "3.5 N", want: NewValue(3.5, Dimensions{MassDim: 1, LengthDim: 1, TimeDim: -2})},
+		{in: "9.81 m/s^2", want: NewValue(9.81, accel)},
+		{in: "9.81 m·s⁻²", want: NewValue(9.81, accel)},
+		{in: "9.81 m s^-2", want: NewValue(9.81, accel)},
+		{in: "9.81 m s^(-2)", want: NewValue(9.81, accel)},
+		{in: "9.81m/s/s", want: NewValue(9.81, accel)},
+		{in: "0.00981 km/(s*s)", want: NewValue(9.81, accel)},
+		{in: "  9.81   m ⋅ s^-2  ", want: NewValue(9.81, accel)},
+		{in: "1 km²", want: NewValue(1e6, Dimensions{LengthDim: 2})},
+		{in: "2 mm", want: NewValue(2e-3, Dimensions{LengthDim: 1})},
+		{in: "2 ms", want: NewValue(2e-3, Dimensions{TimeDim: 1})},
+		{in: "3 mol", want: NewValue(3, Dimensions{MoleDim: 1})},
+		{in: "3 mmol", want: NewValue(3e-3, Dimensions{MoleDim: 1})},
+		{in: "250 mg", want: NewValue(250e-6, Dimensions{MassDim: 1})},
+		{in: "4 μs", want: NewValue(4e-6, Dimensions{TimeDim: 1})},
+		{in: "4 us", want: NewValue(4e-6, Dimensions{TimeDim: 1})},
+		{in: "5 dam", want: NewValue(50, Dimensions{LengthDim: 1})},
+		{in: "5 dL", want: NewValue(5e-4, Dimensions{LengthDim: 3})},
+		{in: "1 T", want: NewValue(1, Dimensions{MassDim: 1, TimeDim: -2, CurrentDim: -1})},
+		{in: "1 Ts", want: NewValue(1e12, Dimensions{TimeDim: 1})},
+		{in: "10 kΩ", want: NewValue(1e4, Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -3, CurrentDim: -2})},
+		{in: "10 kΩ", want: NewValue(1e4, Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -3, CurrentDim: -2})},
+		{in: "8.314 J/(mol·K)", want: NewValue(8.314, Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -2, MoleDim: -1, TemperatureDim: -1})},
+		{in: "50 /s", want: NewValue(50, Dimensions{TimeDim: -1})},
+		{in: "1 kg/kg", want: NewValue(1, nil)},
+	} {
+		got, err := ParseValue(test.in)
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", test.in, err)
+			continue
+		}
+		if !DimensionsMatch(got, test.want) {
+			t.Errorf("unexpected dimensions for %q: got:%v want:%v", test.in, got, test.want)
+		}
+		if !scalar.EqualWithinRel(got.Value(), test.want.Value(), 1e-14) {
+			t.Errorf("unexpected value for %q: got:%v want:%v", test.in, got.Value(), test.want.Value())
+		}
+	}
+
+	for _, in := range []string{
+		"",
+		"kg",
+		"3.5 furlong",
+		"3.5 kg//s",
+		"3.5 kg/",
+		"3.5 (kg",
+		"3.5 kg)",
+		"3.5 ()",
+		"3.5 kg^",
+		"3.5 kg^x",
+		"3.5 kg^(2",
+		"3.5 m?",
+		"3.5 kkg",
+	} {
+		if _, err := ParseValue(in); err == nil {
+			t.Errorf("expected error for %q", in)
+		}
+	}
+}
+
+func TestParseValueSpecial(t *testing.T) {
+	t.Parallel()
+	v, err := ParseValue("NaN m")
+	if err != nil || !math.IsNaN(v.Value()) || !DimensionsMatch(v, Length(0)) {
+		t.Errorf("unexpected result for NaN: got:%v err:%v", v, err)
+	}
+	v, err = ParseValue("-Inf")
+	if err != nil || !math.IsInf(v.Value(), -1) {
+		t.Errorf("unexpected result for -Inf: got:%v err:%v", v, err)
+	}
+}
+
+func TestParseValueRoundTrip(t *testing.T) {
+	t.Parallel()
+	for _, u := range []Uniter{
+		Acceleration(9.81),
+		Energy(-1.5e-19),
+		Capacitance(4.7e-9),
+		Resistance(1e3),
+		Dimless(0.1),
+		NewValue(6.62607015e-34, Dimensions{MassDim: 1, LengthDim: 2, TimeDim: -1}),
+	} {
+		want := ValueOf(u)
+		got, err := ParseValue(want.String())
+		if err != nil {
+			t.Errorf("unexpected error for %v: %v", want, err)
+			continue
+		}
+		if !DimensionsMatch(got, want) || got.Value() != want.Value() {
+			t.Errorf("unexpected round trip: got:%v want:%v", got, want)
+		}
+	}
+}
+
+func TestParseValueNewDimension(t *testing.T) {
+	// Not parallel since NewDimension alters global state.
+	d := NewDimension("wbc")
+	v, err := ParseValue("3 kwbc/L")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := NewValue(3e6, Dimensions{d: 1, LengthDim: -3})
+	if !DimensionsMatch(v, want) || !scalar.EqualWithinRel(v.Value(), want.Value(), 1e-14) {
+		t.Errorf("unexpected value: got:%v want:%v", v, want)
+	}
+	if got := v.String(); got != "3e+06 wbc m^-3" {
+		t.Errorf("unexpected string: got:%q want:%q", got, "3e+06 wbc m^-3")
+	}
+}
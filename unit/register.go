@@ -0,0 +1,150 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"unicode"
+)
+
+// nonSISymbols holds the symbols of the non-SI units recognized by
+// ParseValue and their values in SI base units.
+var nonSISymbols = map[string]Value{
+	// Length.
+	"in":  ValueOf(Inch),
+	"ft":  ValueOf(Foot),
+	"yd":  ValueOf(Yard),
+	"mi":  ValueOf(Mile),
+	"nmi": ValueOf(NauticalMile),
+	"Å":   ValueOf(Angstrom),
+
+	// Mass.
+	"lb": ValueOf(Pound),
+	"oz": ValueOf(Ounce),
+	"gr": ValueOf(Grain),
+	"st": ValueOf(Stone),
+	"t":  ValueOf(Tonne),
+
+	// Area and volume.
+	"ac":  ValueOf(Acre),
+	"ha":  ValueOf(Hectare),
+	"gal": ValueOf(USGallon),
+	"qt":  ValueOf(USQuart),
+	"pt":  ValueOf(USPint),
+
+	// Time.
+	"min": ValueOf(Minute),
+	"h":   ValueOf(Hour),
+	"d":   ValueOf(Day),
+
+	// Plane angle.
+	"°":      ValueOf(Degree),
+	"deg":    ValueOf(Degree),
+	"arcmin": ValueOf(Arcminute),
+	"arcsec": ValueOf(Arcsecond),
+
+	// Mechanics.
+	"kn":   ValueOf(Knot),
+	"mph":  ValueOf(MilePerHour),
+	"Gal":  ValueOf(Gal),
+	"lbf":  ValueOf(PoundForce),
+	"dyn":  ValueOf(Dyne),
+	"psi":  ValueOf(PoundPerSquareInch),
+	"atm":  ValueOf(Atmosphere),
+	"bar":  ValueOf(Bar),
+	"Torr": ValueOf(Torr),
+	"mmHg": ValueOf(MillimetreOfMercury),
+	"Ba":   ValueOf(Barye),
+	"cal":  ValueOf(Calorie),
+	"Btu":  ValueOf(BritishThermalUnit),
+	"erg":  ValueOf(Erg),
+	"eV":   ValueOf(Electronvolt),
+	"hp":   ValueOf(Horsepower),
+
+	// Magnetism.
+	"Mx": ValueOf(Maxwell),
+}
+
+// registered holds the units added by RegisterUnit. It is protected by mu.
+var registered = make(map[string]Value)
+
+// ErrSymbolExists is returned by RegisterUnit when the requested symbol
+// is already in use.
+var ErrSymbolExists = errors.New("unit: symbol already in use")
+
+// RegisterUnit registers the unit u under the given symbol so that it is
+// recognized by ParseValue, ParseUnit and Convert. The symbol may then be
+// used with an SI prefix, with the unprefixed symbol taking precedence over
+// a prefixed interpretation of an existing symbol. For example
+//
+//	err := unit.RegisterUnit("ftm", 6*unit.Foot)
+//
+// registers the fathom, after which "20 ftm" is parsed as a length of
+// 36.576 m.
+//
+// The symbol must consist only of letters. RegisterUnit returns
+// ErrSymbolExists if the symbol is an SI or built-in non-SI unit symbol,
+// the symbol of a dimension created by NewDimension, a reserved SI symbol
+// or a previously registered symbol. The value of u must be finite and
+// positive. A registered symbol is reserved, so it can not subsequently
+// be passed to NewDimension.
+func RegisterUnit(symbol string, u Uniter) error {
+	if symbol == "" {
+		return errors.New("unit: empty unit symbol")
+	}
+	for _, r := range symbol {
+		if !unicode.IsLetter(r) {
+			return fmt.Errorf("unit: invalid unit symbol %q", symbol)
+		}
+	}
+	v := ValueOf(u)
+	if v.value <= 0 || math.IsInf(v.value, 0) || math.IsNaN(v.value) {
+		return fmt.Errorf("unit: invalid value for unit %q: %v", symbol, v.value)
+	}
+	if _, ok := siSymbols[symbol]; ok {
+		return ErrSymbolExists
+	}
+	if _, ok := nonSISymbols[symbol]; ok {
+		return ErrSymbolExists
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := dimensions[symbol]; ok {
+		return ErrSymbolExists
+	}
+	if _, ok := registered[symbol]; ok {
+		return ErrSymbolExists
+	}
+	registered[symbol] = v
+	dimensions[symbol] = reserved
+	return nil
+}
+
+// Convert converts the quantity x expressed in the units of the unit
+// expression from to the units of the unit expression to. For example
+//
+//	km, err := unit.Convert(26.2, "mi", "km")
+//
+// returns the length of a marathon in kilometres. Convert returns
+// ErrDimensionMismatch if the dimensions of from and to do not match.
+// The syntax of unit expressions is described in the documentation for
+// ParseValue.
+func Convert(x float64, from, to string) (float64, error) {
+	f, err := ParseUnit(from)
+	if err != nil {
+		return 0, err
+	}
+	t, err := ParseUnit(to)
+	if err != nil {
+		return 0, err
+	}
+	if !f.dimensions.matches(t.dimensions) {
+		return 0, ErrDimensionMismatch
+	}
+	return x * f.value / t.value, nil
+}
@@ -0,0 +1,157 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unit
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestParseNonSI(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		in   string
+		want Uniter
+	}{
+		{in: "12 in", want: Foot},
+		{in: "1 mi", want: Mile},
+		{in: "1 nmi", want: NauticalMile},
+		{in: "3 Å", want: 3 * Angstrom},
+		{in: "3 Å", want: 3 * Angstrom},
+		{in: "16 oz", want: Pound},
+		{in: "2 t", want: 2 * Tonne},
+		{in: "1 kt", want: 1e6 * Kilogram},
+		{in: "90 min", want: 90 * Minute},
+		{in: "1.5 h", want: 90 * Minute},
+		{in: "7 d", want: Week},
+		{in: "5 dm", want: 0.5 * Metre},
+		{in: "180 °", want: 180 * Degree},
+		{in: "30 arcmin", want: Degree / 2},
+		{in: "60 mph", want: 60 * MilePerHour},
+		{in: "1 lbf", want: PoundForce},
+		{in: "32 psi", want: 32 * PoundPerSquareInch},
+		{in: "1 atm", want: Atmosphere},
+		{in: "1013.25 hPa", want: Atmosphere},
+		{in: "1013.25 mbar", want: Atmosphere},
+		{in: "760 mmHg", want: 760 * MillimetreOfMercury},
+		{in: "1 kcal", want: 1000 * Calorie},
+		{in: "13.6 eV", want: 13.6 * Electronvolt},
+		{in: "1 MeV", want: 1e6 * Electronvolt},
+		{in: "2 ha", want: 2 * Hectare},
+		{in: "1 gal", want: USGallon},
+		{in: "8 pt", want: USGallon},
+		{in: "1 Gal", want: Gal},
+		{in: "1 erg/s", want: 1e-7 * Watt},
+		{in: "1 lbf/in^2", want: PoundPerSquareInch},
+		{in: "1 ft·lbf", want: Energy(Foot) * Energy(PoundForce)},
+	} {
+		got, err := ParseValue(test.in)
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", test.in, err)
+			continue
+		}
+		want := ValueOf(test.want)
+		if !DimensionsMatch(got, want) {
+			t.Errorf("unexpected dimensions for %q: got:%v want:%v", test.in, got, want)
+		}
+		if !scalar.EqualWithinRel(got.Value(), want.Value(), 1e-14) {
+			t.Errorf("unexpected value for %q: got:%v want:%v", test.in, got.Value(), want.Value())
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		x        float64
+		from, to string
+		want     float64
+	}{
+		{x: 26.2, from: "mi", to: "km", want: 42.1648128},
+		{x: 1, from: "atm", to: "psi", want: 14.695948775513449},
+		{x: 100, from: "km/h", to: "mph", want: 62.13711922373339},
+		{x: 1, from: "Btu", to: "cal", want: 252.1644007218},
+		{x: 1, from: "hp", to: "kW", want: 0.74569987158227022},
+		{x: 1, from: "gal", to: "L", want: 3.785411784},
+	} {
+		got, err := Convert(test.x, test.from, test.to)
+		if err != nil {
+			t.Errorf("unexpected error converting %v %s to %s: %v", test.x, test.from, test.to, err)
+			continue
+		}
+		if !scalar.EqualWithinRel(got, test.want, 1e-12) {
+			t.Errorf("unexpected conversion of %v %s to %s: got:%v want:%v", test.x, test.from, test.to, got, test.want)
+		}
+	}
+
+	if _, err := Convert(1, "mi", "kg"); err != ErrDimensionMismatch {
+		t.Errorf("unexpected error for mismatched conversion: got:%v want:%v", err, ErrDimensionMismatch)
+	}
+	if _, err := Convert(1, "furlong", "m"); err == nil {
+		t.Error("expected error for unknown unit")
+	}
+}
+
+func TestRegisterUnit(t *testing.T) {
+	// Not parallel since RegisterUnit alters global state.
+	if err := RegisterUnit("ftm", 6*Foot); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, err := ParseValue("20 ftm")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := 120 * Foot; !DimensionsMatch(got, want) || !scalar.EqualWithinRel(got.Value(), float64(want), 1e-14) {
+		t.Errorf("unexpected value: got:%v want:%v", got, want)
+	}
+	got, err = ParseValue("1 kftm")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := 6000 * Foot; !scalar.EqualWithinRel(got.Value(), float64(want), 1e-14) {
+		t.Errorf("unexpected prefixed value: got:%v want:%v", got, want)
+	}
+	var l Length
+	if err := l.From(got); err != nil {
+		t.Errorf("unexpected error converting to Length: %v", err)
+	}
+	if !SymbolExists("ftm") {
+		t.Error("expected registered symbol to exist")
+	}
+
+	// Units with dimensions created by NewDimension.
+	wbc := NewDimension("cell")
+	if err := RegisterUnit("slide", NewValue(1e4, Dimensions{wbc: 1})); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, err = ParseValue("3 slide/mL")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := NewValue(3e10, Dimensions{wbc: 1, LengthDim: -3}); !DimensionsMatch(got, want) || !scalar.EqualWithinRel(got.Value(), want.Value(), 1e-14) {
+		t.Errorf("unexpected value: got:%v want:%v", got, want)
+	}
+
+	for _, sym := range []string{"ftm", "m", "kg", "ft", "bar", "k", "cell"} {
+		if err := RegisterUnit(sym, Metre); err != ErrSymbolExists {
+			t.Errorf("unexpected error registering %q: got:%v want:%v", sym, err, ErrSymbolExists)
+		}
+	}
+	for _, test := range []struct {
+		sym string
+		u   Uniter
+	}{
+		{sym: "", u: Metre},
+		{sym: "f2", u: Metre},
+		{sym: "a b", u: Metre},
+		{sym: "neg", u: -Metre},
+		{sym: "zero", u: Length(0)},
+	} {
+		if err := RegisterUnit(test.sym, test.u); err == nil {
+			t.Errorf("expected error registering %q", test.sym)
+		}
+	}
+}
@@ -0,0 +1,284 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unit
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Uncertain is a dimensional value with a standard uncertainty. Arithmetic
+// on Uncertain values propagates the uncertainties to first order under the
+// assumption that the operands are uncorrelated.
+//
+// See JCGM 100:2008, "Evaluation of measurement data — Guide to the expression
+// of uncertainty in measurement", section 5.1.
+type Uncertain struct {
+	value Value
+	sigma float64
+}
+
+// NewUncertain returns an Uncertain with the value of v and the standard
+// uncertainty sigma, given in the same SI base units as v. NewUncertain
+// will panic if sigma is negative.
+func NewUncertain(v Uniter, sigma float64) Uncertain {
+	if sigma < 0 {
+		panic("unit: negative uncertainty")
+	}
+	return Uncertain{value: ValueOf(v), sigma: sigma}
+}
+
+// Unit returns the value of the receiver without its uncertainty as a
+// new *Unit.
+func (u Uncertain) Unit() *Unit {
+	return u.value.Unit()
+}
+
+// Value returns the value of the receiver without its uncertainty.
+func (u Uncertain) Value() Value {
+	return u.value
+}
+
+// Uncertainty returns the standard uncertainty of the receiver.
+func (u Uncertain) Uncertainty() Value {
+	return Value{dimensions: u.value.dimensions.clone(), value: u.sigma}
+}
+
+// Relative returns the relative standard uncertainty of the receiver,
+// the ratio of the standard uncertainty to the magnitude of the value.
+func (u Uncertain) Relative() float64 {
+	return u.sigma / math.Abs(u.value.value)
+}
+
+// Add returns the sum of the receiver and v. Add returns
+// ErrDimensionMismatch if the dimensions of u and v do not match.
+func (u Uncertain) Add(v Uncertain) (Uncertain, error) {
+	sum, err := u.value.Add(v.value)
+	if err != nil {
+		return Uncertain{}, err
+	}
+	return Uncertain{value: sum, sigma: math.Hypot(u.sigma, v.sigma)}, nil
+}
+
+// Sub returns the difference between the receiver and v. Sub returns
+// ErrDimensionMismatch if the dimensions of u and v do not match.
+func (u Uncertain) Sub(v Uncertain) (Uncertain, error) {
+	diff, err := u.value.Sub(v.value)
+	if err != nil {
+		return Uncertain{}, err
+	}
+	return Uncertain{value: diff, sigma: math.Hypot(u.sigma, v.sigma)}, nil
+}
+
+// Mul returns the product of the receiver and v.
+func (u Uncertain) Mul(v Uncertain) Uncertain {
+	a, b := u.value.value, v.value.value
+	return Uncertain{
+		value: u.value.Mul(v.value),
+		sigma: math.Hypot(b*u.sigma, a*v.sigma),
+	}
+}
+
+// Div returns the quotient of the receiver and v.
+func (u Uncertain) Div(v Uncertain) Uncertain {
+	a, b := u.value.value, v.value.value
+	return Uncertain{
+		value: u.value.Div(v.value),
+		sigma: math.Hypot(u.sigma/b, a*v.sigma/(b*b)),
+	}
+}
+
+// Scale returns the receiver multiplied by the exact factor f.
+func (u Uncertain) Scale(f float64) Uncertain {
+	return Uncertain{
+		value: Value{dimensions: u.value.dimensions.clone(), value: f * u.value.value},
+		sigma: math.Abs(f) * u.sigma,
+	}
+}
+
+// Pow returns the receiver raised to the integer power n.
+func (u Uncertain) Pow(n int) Uncertain {
+	if n == 0 {
+		return Uncertain{value: u.value.Pow(0)}
+	}
+	return Uncertain{
+		value: u.value.Pow(n),
+		sigma: math.Abs(float64(n)*powi(u.value.value, n-1)) * u.sigma,
+	}
+}
+
+// String returns the receiver formatted as a value and uncertainty followed
+// by the units of the value, for example "9.81 ± 0.02 m/s²". The uncertainty
+// is rounded to one significant figure, or two if its leading digit is one,
+// and the value is rounded to the same decimal place.
+func (u Uncertain) String() string {
+	v, s := u.value.value, u.sigma
+	var str string
+	if s == 0 || math.IsInf(s, 0) || math.IsNaN(s) || math.IsInf(v, 0) || math.IsNaN(v) {
+		str = strconv.FormatFloat(v, 'g', -1, 64) + " ± " + strconv.FormatFloat(s, 'g', -1, 64)
+	} else {
+		// Find the decimal place of the last
+		// significant figure of the uncertainty.
+		e := int(math.Floor(math.Log10(s)))
+		if math.Round(s/math.Pow10(e)) >= 10 {
+			e++
+		}
+		if math.Floor(s/math.Pow10(e)) < 2 {
+			e--
+		}
+		prec := 0
+		if e < 0 {
+			prec = -e
+		}
+		scale := math.Pow10(e)
+		v = math.Round(v/scale) * scale
+		s = math.Round(s/scale) * scale
+		str = strconv.FormatFloat(v, 'f', prec, 64) + " ± " + strconv.FormatFloat(s, 'f', prec, 64)
+	}
+	if units := u.value.dimensions.symbols(); units != "" {
+		str += " " + units
+	}
+	return str
+}
+
+// Format makes Uncertain satisfy the fmt.Formatter interface. The %v and
+// %s verbs format the receiver using its String method. The floating point
+// verbs format both the value and the uncertainty using the given precision,
+// for example "%.3f" formats the value 9.8123 with uncertainty 0.0234 in
+// metres as "9.812 ± 0.023 m".
+func (u Uncertain) Format(fs fmt.State, c rune) {
+	switch c {
+	case 'v':
+		if fs.Flag('#') {
+			fmt.Fprintf(fs, "%T{value:%#v, sigma:%v}", u, u.value, u.sigma)
+			return
+		}
+		fallthrough
+	case 's':
+		fmt.Fprint(fs, u.String())
+	case 'e', 'E', 'f', 'F', 'g', 'G':
+		p, ok := fs.Precision()
+		if !ok {
+			p = -1
+		}
+		fmt.Fprintf(fs, "%.*"+string(c)+" ± %.*"+string(c), p, u.value.value, p, u.sigma)
+		if units := u.value.dimensions.symbols(); units != "" {
+			fmt.Fprint(fs, " "+units)
+		}
+	default:
+		fmt.Fprintf(fs, "%%!%c(%T=%s)", c, u, u.String())
+	}
+}
+
+// ParseUncertain parses an uncertain dimensional value from s. The value
+// and its uncertainty are separated by "±" or "+/-", and the uncertainty
+// may be followed by a unit expression that applies to both, for example
+// "9.81 ± 0.02 m/s²" or "9.81 +/- 0.02 m s^-2". The syntax of the
+// unit expression is described in the documentation for ParseValue.
+func ParseUncertain(s string) (Uncertain, error) {
+	val, sigma, ok := strings.Cut(s, "±")
+	if !ok {
+		val, sigma, ok = strings.Cut(s, "+/-")
+	}
+	if !ok {
+		return Uncertain{}, fmt.Errorf("unit: missing uncertainty in %q", s)
+	}
+	x, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+	if err != nil {
+		return Uncertain{}, fmt.Errorf("unit: invalid number in %q", s)
+	}
+	num, rest := splitNumber(strings.TrimSpace(sigma))
+	sig, err := strconv.ParseFloat(num, 64)
+	if err != nil {
+		return Uncertain{}, fmt.Errorf("unit: invalid uncertainty in %q", s)
+	}
+	if sig < 0 {
+		return Uncertain{}, fmt.Errorf("unit: negative uncertainty in %q", s)
+	}
+	// The unit expression applies to both
+	// the value and the uncertainty.
+	scale, err := ParseUnit(rest)
+	if err != nil {
+		return Uncertain{}, err
+	}
+	return Uncertain{
+		value: Value{dimensions: scale.dimensions, value: x * scale.value},
+		sigma: sig * scale.value,
+	}, nil
+}
+
+// symbols returns a typographic representation of the dimensions, for
+// example "kg·m²/(s³·A)". The returned string can be parsed by ParseUnit.
+func (d Dimensions) symbols() string {
+	var num, den []atom
+	for dimension, power := range d {
+		switch {
+		case power > 0:
+			num = append(num, atom{dimension, power})
+		case power < 0:
+			den = append(den, atom{dimension, -power})
+		}
+	}
+	byName := func(a, b atom) int { return cmp.Compare(a.String(), b.String()) }
+	slices.SortFunc(num, byName)
+	slices.SortFunc(den, byName)
+
+	var b bytes.Buffer
+	write := func(atoms []atom) {
+		for i, a := range atoms {
+			if i > 0 {
+				b.WriteString("·")
+			}
+			b.WriteString(a.Dimension.String())
+			if a.pow != 1 {
+				b.WriteString(superscript(a.pow))
+			}
+		}
+	}
+	switch {
+	case len(num) == 0 && len(den) == 0:
+		return ""
+	case len(den) == 0:
+		write(num)
+	case len(num) == 0:
+		// Write reciprocal units with
+		// negative powers.
+		for i := range den {
+			den[i].pow = -den[i].pow
+		}
+		write(den)
+	default:
+		write(num)
+		b.WriteString("/")
+		if len(den) > 1 {
+			b.WriteString("(")
+		}
+		write(den)
+		if len(den) > 1 {
+			b.WriteString(")")
+		}
+	}
+	return b.String()
+}
+
+// superscript returns n formatted with superscript digits.
+func superscript(n int) string {
+	const digits = "⁰¹²³⁴⁵⁶⁷⁸⁹"
+	var b strings.Builder
+	if n < 0 {
+		b.WriteString("⁻")
+		n = -n
+	}
+	for _, c := range strconv.Itoa(n) {
+		i := int(c - '0')
+		b.WriteString(string([]rune(digits)[i]))
+	}
+	return b.String()
+}
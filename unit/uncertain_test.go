@@ -0,0 +1,149 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unit
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/floats/scalar"
+)
+
+func TestUncertainArithmetic(t *testing.T) {
+	t.Parallel()
+	a := NewUncertain(Length(3), 0.3)
+	b := NewUncertain(Length(4), 0.4)
+
+	sum, err := a.Add(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sum.Value().Value() != 7 || sum.Uncertainty().Value() != 0.5 {
+		t.Errorf("unexpected sum: got:%v want:7 ± 0.5 m", sum)
+	}
+	diff, err := a.Sub(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff.Value().Value() != -1 || diff.Uncertainty().Value() != 0.5 {
+		t.Errorf("unexpected difference: got:%v want:-1 ± 0.5 m", diff)
+	}
+	if _, err := a.Add(NewUncertain(Time(1), 0)); err != ErrDimensionMismatch {
+		t.Errorf("unexpected error for mismatched Add: got:%v want:%v", err, ErrDimensionMismatch)
+	}
+
+	// Relative uncertainties add in quadrature
+	// for products and quotients.
+	prod := a.Mul(b)
+	if !DimensionsMatch(prod, Area(0)) || prod.Value().Value() != 12 {
+		t.Errorf("unexpected product: got:%v want:12 m²", prod)
+	}
+	if want := math.Sqrt(2) * 0.1; !scalar.EqualWithinAbs(prod.Relative(), want, 1e-15) {
+		t.Errorf("unexpected relative uncertainty of product: got:%v want:%v", prod.Relative(), want)
+	}
+	quo := a.Div(b)
+	if len(quo.Value().Dimensions()) != 0 || quo.Value().Value() != 0.75 {
+		t.Errorf("unexpected quotient: got:%v want:0.75", quo)
+	}
+	if want := math.Sqrt(2) * 0.1; !scalar.EqualWithinAbs(quo.Relative(), want, 1e-15) {
+		t.Errorf("unexpected relative uncertainty of quotient: got:%v want:%v", quo.Relative(), want)
+	}
+
+	// Powers multiply the relative uncertainty.
+	cube := a.Pow(3)
+	if !DimensionsMatch(cube, Volume(0)) || cube.Value().Value() != 27 {
+		t.Errorf("unexpected cube: got:%v want:27 m³", cube)
+	}
+	if !scalar.EqualWithinAbs(cube.Relative(), 0.3, 1e-15) {
+		t.Errorf("unexpected relative uncertainty of cube: got:%v want:0.3", cube.Relative())
+	}
+	inv := a.Pow(-1)
+	if !scalar.EqualWithinAbs(inv.Relative(), 0.1, 1e-15) {
+		t.Errorf("unexpected relative uncertainty of inverse: got:%v want:0.1", inv.Relative())
+	}
+	if one := a.Pow(0); one.Value().Value() != 1 || one.Uncertainty().Value() != 0 {
+		t.Errorf("unexpected zero power: got:%v want:1 ± 0", one)
+	}
+
+	scaled := a.Scale(-2)
+	if scaled.Value().Value() != -6 || scaled.Uncertainty().Value() != 0.6 {
+		t.Errorf("unexpected scaled value: got:%v want:-6 ± 0.6 m", scaled)
+	}
+
+	// The product of a value with zero uncertainty.
+	exact := NewUncertain(Dimless(2), 0)
+	if got := exact.Mul(a); got.Value().Value() != 6 || got.Uncertainty().Value() != 0.6 {
+		t.Errorf("unexpected product with exact value: got:%v want:6 ± 0.6 m", got)
+	}
+}
+
+func TestUncertainFormat(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		u      Uncertain
+		format string
+		want   string
+	}{
+		{NewUncertain(Acceleration(9.8123), 0.0234), "%v", "9.81 ± 0.02 m/s²"},
+		{NewUncertain(Acceleration(9.8123), 0.0234), "%s", "9.81 ± 0.02 m/s²"},
+		{NewUncertain(Acceleration(9.8123), 0.0134), "%v", "9.812 ± 0.013 m/s²"},
+		{NewUncertain(Acceleration(9.8123), 0.0234), "%.3f", "9.812 ± 0.023 m/s²"},
+		{NewUncertain(Length(1234.5), 98), "%v", "1230 ± 100 m"},
+		{NewUncertain(Length(1234.5), 23), "%v", "1230 ± 20 m"},
+		{NewUncertain(Energy(1.5), 0), "%v", "1.5 ± 0 kg·m²/s²"},
+		{NewUncertain(Voltage(1.5), 0.25), "%v", "1.5 ± 0.3 kg·m²/(A·s³)"},
+		{NewUncertain(Frequency(50), 1.5), "%v", "50.0 ± 1.5 s⁻¹"},
+		{NewUncertain(Dimless(0.5), 0.05), "%v", "0.50 ± 0.05"},
+		{NewUncertain(Dimless(0.5), 0.05), "%d", "%!d(unit.Uncertain=0.50 ± 0.05)"},
+	} {
+		if got := fmt.Sprintf(test.format, test.u); got != test.want {
+			t.Errorf("unexpected format %q: got:%q want:%q", test.format, got, test.want)
+		}
+	}
+}
+
+func TestParseUncertain(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		in          string
+		value       float64
+		uncertainty float64
+		dims        Dimensions
+	}{
+		{in: "9.81 ± 0.02 m/s²", value: 9.81, uncertainty: 0.02, dims: Dimensions{LengthDim: 1, TimeDim: -2}},
+		{in: "9.81 +/- 0.02 m s^-2", value: 9.81, uncertainty: 0.02, dims: Dimensions{LengthDim: 1, TimeDim: -2}},
+		{in: "12.5±0.5 mm", value: 12.5e-3, uncertainty: 0.5e-3, dims: Dimensions{LengthDim: 1}},
+		{in: "0.5 ± 0.05", value: 0.5, uncertainty: 0.05},
+		{in: "1.5 ± 0.3 kg·m²/(A·s³)", value: 1.5, uncertainty: 0.3, dims: Dimensions{MassDim: 1, LengthDim: 2, CurrentDim: -1, TimeDim: -3}},
+	} {
+		got, err := ParseUncertain(test.in)
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", test.in, err)
+			continue
+		}
+		if !DimensionsMatch(got, NewValue(0, test.dims)) {
+			t.Errorf("unexpected dimensions for %q: got:%v want:%v", test.in, got.Value().Dimensions(), test.dims)
+		}
+		if !scalar.EqualWithinRel(got.Value().Value(), test.value, 1e-14) {
+			t.Errorf("unexpected value for %q: got:%v want:%v", test.in, got.Value().Value(), test.value)
+		}
+		if !scalar.EqualWithinRel(got.Uncertainty().Value(), test.uncertainty, 1e-14) {
+			t.Errorf("unexpected uncertainty for %q: got:%v want:%v", test.in, got.Uncertainty().Value(), test.uncertainty)
+		}
+	}
+
+	for _, in := range []string{
+		"9.81 m/s²",
+		"x ± 0.02",
+		"9.81 ± x",
+		"9.81 ± -0.02",
+		"9.81 ± 0.02 furlong",
+	} {
+		if _, err := ParseUncertain(in); err == nil {
+			t.Errorf("expected error for %q", in)
+		}
+	}
+}
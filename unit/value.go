@@ -0,0 +1,202 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unit
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+)
+
+// ErrDimensionMismatch is returned when an operation is performed on
+// values with incompatible dimensions.
+var ErrDimensionMismatch = errors.New("unit: dimension mismatch")
+
+// Value is an immutable dimensional value. Unlike Unit, the arithmetic
+// methods of Value do not alter the receiver, and dimensional mismatches
+// are reported as errors rather than panics, so Value is suited to holding
+// quantities obtained at run time, for example from configuration files
+// or user input. The zero Value is a dimensionless zero.
+type Value struct {
+	dimensions Dimensions
+	value      float64
+}
+
+// NewValue returns a Value with the given value in SI base units and
+// dimensions.
+func NewValue(value float64, d Dimensions) Value {
+	return Value{dimensions: d.clone(), value: value}
+}
+
+// ValueOf returns the Value of u.
+func ValueOf(u Uniter) Value {
+	a := u.Unit()
+	return Value{dimensions: a.dimensions.clone(), value: a.value}
+}
+
+// Unit returns the value as a new *Unit.
+func (v Value) Unit() *Unit {
+	return New(v.value, v.dimensions)
+}
+
+// Value returns the raw value of the receiver in SI base units.
+func (v Value) Value() float64 {
+	return v.value
+}
+
+// Dimensions returns a copy of the dimensions of the receiver.
+func (v Value) Dimensions() Dimensions {
+	return v.dimensions.clone()
+}
+
+// In returns the receiver expressed as a multiple of u. In returns
+// ErrDimensionMismatch if the dimensions of v and u do not match.
+func (v Value) In(u Uniter) (float64, error) {
+	a := u.Unit()
+	if !v.dimensions.matches(a.dimensions.clone()) {
+		return 0, ErrDimensionMismatch
+	}
+	return v.value / a.value, nil
+}
+
+// Add returns the sum of the receiver and u. Add returns
+// ErrDimensionMismatch if the dimensions of v and u do not match.
+func (v Value) Add(u Uniter) (Value, error) {
+	a := u.Unit()
+	if !v.dimensions.matches(a.dimensions.clone()) {
+		return Value{}, ErrDimensionMismatch
+	}
+	return Value{dimensions: v.dimensions.clone(), value: v.value + a.value}, nil
+}
+
+// Sub returns the difference between the receiver and u. Sub returns
+// ErrDimensionMismatch if the dimensions of v and u do not match.
+func (v Value) Sub(u Uniter) (Value, error) {
+	a := u.Unit()
+	if !v.dimensions.matches(a.dimensions.clone()) {
+		return Value{}, ErrDimensionMismatch
+	}
+	return Value{dimensions: v.dimensions.clone(), value: v.value - a.value}, nil
+}
+
+// Mul returns the product of the receiver and u.
+func (v Value) Mul(u Uniter) Value {
+	a := u.Unit()
+	return Value{dimensions: v.dimensions.combine(a.dimensions, 1), value: v.value * a.value}
+}
+
+// Div returns the quotient of the receiver and u.
+func (v Value) Div(u Uniter) Value {
+	a := u.Unit()
+	return Value{dimensions: v.dimensions.combine(a.dimensions, -1), value: v.value / a.value}
+}
+
+// Pow returns the receiver raised to the integer power n.
+func (v Value) Pow(n int) Value {
+	d := make(Dimensions, len(v.dimensions))
+	for dim, pow := range v.dimensions {
+		if pow*n != 0 {
+			d[dim] = pow * n
+		}
+	}
+	return Value{dimensions: d, value: powi(v.value, n)}
+}
+
+// powi returns x raised to the integer power n.
+func powi(x float64, n int) float64 {
+	if n < 0 {
+		return 1 / powi(x, -n)
+	}
+	r := 1.0
+	for ; n > 0; n >>= 1 {
+		if n&1 != 0 {
+			r *= x
+		}
+		x *= x
+	}
+	return r
+}
+
+// combine returns the dimensions of d multiplied by o raised to the
+// power sign. Zero powers are removed from the result.
+func (d Dimensions) combine(o Dimensions, sign int) Dimensions {
+	c := d.clone()
+	if c == nil {
+		c = make(Dimensions, len(o))
+	}
+	for dim, pow := range o {
+		if p := c[dim] + sign*pow; p != 0 {
+			c[dim] = p
+		} else {
+			delete(c, dim)
+		}
+	}
+	return c
+}
+
+// String returns the value formatted in SI base units using the shortest
+// representation of the value that allows it to be recovered by ParseValue.
+func (v Value) String() string {
+	s := strconv.FormatFloat(v.value, 'g', -1, 64)
+	if units := v.dimensions.String(); units != "" {
+		s += " " + units
+	}
+	return s
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v Value) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface using
+// ParseValue.
+func (v *Value) UnmarshalText(text []byte) error {
+	p, err := ParseValue(string(text))
+	if err != nil {
+		return err
+	}
+	*v = p
+	return nil
+}
+
+// Format makes Value satisfy the fmt.Formatter interface. The value is
+// formatted as for Unit, except that no trailing space is written for
+// dimensionless values. The %s verb formats the value using its String
+// method.
+func (v Value) Format(fs fmt.State, c rune) {
+	switch c {
+	case 's':
+		fmt.Fprint(fs, v.String())
+	case 'v':
+		if fs.Flag('#') {
+			fmt.Fprintf(fs, "%T{dimensions:%#v, value:%v}", v, v.dimensions, v.value)
+			return
+		}
+		fallthrough
+	case 'e', 'E', 'f', 'F', 'g', 'G':
+		p, pOk := fs.Precision()
+		w, wOk := fs.Width()
+		units := v.dimensions.String()
+		if units != "" {
+			units = " " + units
+		}
+		w -= utf8.RuneCountInString(units)
+		switch {
+		case pOk && wOk:
+			fmt.Fprintf(fs, "%*.*"+string(c), pos(w), p, v.value)
+		case pOk:
+			fmt.Fprintf(fs, "%.*"+string(c), p, v.value)
+		case wOk:
+			fmt.Fprintf(fs, "%*"+string(c), pos(w), v.value)
+		default:
+			fmt.Fprintf(fs, "%"+string(c), v.value)
+		}
+		fmt.Fprint(fs, units)
+	default:
+		fmt.Fprintf(fs, "%%!%c(%T=%s)", c, v, v.String())
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package unit
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestValueArithmetic(t *testing.T) {
+	t.Parallel()
+	m := ValueOf(Mass(2))
+	a := ValueOf(Acceleration(9.81))
+
+	f := m.Mul(a)
+	var force Force
+	if err := force.From(f); err != nil {
+		t.Errorf("unexpected error converting to force: %v", err)
+	}
+	if force != 19.62 {
+		t.Errorf("unexpected force: got:%v want:19.62", force)
+	}
+	if len(m.Dimensions()) != 1 || len(a.Dimensions()) != 2 {
+		t.Errorf("operands altered by Mul: %v %v", m, a)
+	}
+
+	sum, err := f.Add(Force(0.38))
+	if err != nil {
+		t.Errorf("unexpected error for Add: %v", err)
+	}
+	if got, _ := sum.In(Force(1)); got != 20 {
+		t.Errorf("unexpected sum: got:%v want:20", got)
+	}
+	diff, err := f.Sub(Force(0.62))
+	if err != nil {
+		t.Errorf("unexpected error for Sub: %v", err)
+	}
+	if got, _ := diff.In(Force(1)); got != 19 {
+		t.Errorf("unexpected difference: got:%v want:19", got)
+	}
+	if _, err := f.Add(m); err != ErrDimensionMismatch {
+		t.Errorf("unexpected error for mismatched Add: got:%v want:%v", err, ErrDimensionMismatch)
+	}
+	if _, err := f.Sub(Length(1)); err != ErrDimensionMismatch {
+		t.Errorf("unexpected error for mismatched Sub: got:%v want:%v", err, ErrDimensionMismatch)
+	}
+
+	back := f.Div(a)
+	if !DimensionsMatch(back, Mass(0)) || back.Value() != 2 {
+		t.Errorf("unexpected quotient: got:%v want:%v", back, m)
+	}
+	if d := f.Div(f); len(d.Dimensions()) != 0 || d.Value() != 1 {
+		t.Errorf("unexpected self quotient: got:%v want:1", d)
+	}
+
+	area := ValueOf(Length(3)).Pow(2)
+	if !DimensionsMatch(area, Area(0)) || area.Value() != 9 {
+		t.Errorf("unexpected square: got:%v want:9 m^2", area)
+	}
+	inv := ValueOf(Time(4)).Pow(-1)
+	if !DimensionsMatch(inv, Frequency(0)) || inv.Value() != 0.25 {
+		t.Errorf("unexpected inverse: got:%v want:0.25 s^-1", inv)
+	}
+	if one := inv.Pow(0); len(one.Dimensions()) != 0 || one.Value() != 1 {
+		t.Errorf("unexpected zero power: got:%v want:1", one)
+	}
+
+	km, err := ValueOf(Length(1500)).In(Kilo * Metre)
+	if err != nil {
+		t.Errorf("unexpected error for In: %v", err)
+	}
+	if km != 1.5 {
+		t.Errorf("unexpected conversion: got:%v want:1.5", km)
+	}
+	if _, err := ValueOf(Length(1)).In(Second); err != ErrDimensionMismatch {
+		t.Errorf("unexpected error for mismatched In: got:%v want:%v", err, ErrDimensionMismatch)
+	}
+}
+
+func TestValueFormat(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		v      Value
+		format string
+		want   string
+	}{
+		{NewValue(9.81, Dimensions{MassDim: 1, TimeDim: -2}), "%v", "9.81 kg s^-2"},
+		{NewValue(9.81, Dimensions{MassDim: 1, TimeDim: -2}), "%s", "9.81 kg s^-2"},
+		{NewValue(9.81, Dimensions{MassDim: 1, TimeDim: -2}), "%.1f", "9.8 kg s^-2"},
+		{NewValue(9.81, Dimensions{MassDim: 1, TimeDim: -2}), "%20f", "    9.810000 kg s^-2"},
+		{NewValue(9.81, Dimensions{MassDim: 1, TimeDim: -2}), "%d", "%!d(unit.Value=9.81 kg s^-2)"},
+		{NewValue(2.5, nil), "%v", "2.5"},
+		{NewValue(2.5, Dimensions{LengthDim: 0}), "%6v", "   2.5"},
+		{Value{}, "%v", "0"},
+	} {
+		if got := fmt.Sprintf(test.format, test.v); got != test.want {
+			t.Errorf("unexpected format %q: got:%q want:%q", test.format, got, test.want)
+		}
+	}
+}
+
+func TestValueText(t *testing.T) {
+	t.Parallel()
+	type config struct {
+		Speed Value
+		Mass  Value
+	}
+	in := []byte(`{"Speed":"3.6 km/fortnight","Mass":"250 g"}`)
+	var c config
+	err := json.Unmarshal(in, &c)
+	if err == nil {
+		t.Fatal("expected error for unknown unit symbol")
+	}
+
+	in = []byte(`{"Speed":"1 km/ks","Mass":"250 g"}`)
+	err = json.Unmarshal(in, &c)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !DimensionsMatch(c.Speed, Velocity(0)) || c.Speed.Value() != 1 {
+		t.Errorf("unexpected speed: got:%v want:1 m s^-1", c.Speed)
+	}
+	if !DimensionsMatch(c.Mass, Mass(0)) || c.Mass.Value() != 0.25 {
+		t.Errorf("unexpected mass: got:%v want:0.25 kg", c.Mass)
+	}
+
+	out, err := json.Marshal(c)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := `{"Speed":"1 m s^-1","Mass":"0.25 kg"}`; string(out) != want {
+		t.Errorf("unexpected marshaled value: got:%s want:%s", out, want)
+	}
+}